@@ -1,15 +1,23 @@
 package main
 
 import (
+	"errors"
 	"fmt"
 	"os"
 
 	"github.com/superplanehq/superplane/pkg/cli"
+	"github.com/superplanehq/superplane/pkg/cli/core"
 )
 
 func main() {
 	if err := cli.RootCmd.Execute(); err != nil {
 		fmt.Println(err)
+
+		var exitErr *core.ExitError
+		if errors.As(err, &exitErr) {
+			os.Exit(exitErr.Code)
+		}
+
 		os.Exit(1)
 	}
 }
@@ -0,0 +1 @@
+ALTER TABLE workflow_nodes ADD COLUMN error_channel_enabled boolean NOT NULL DEFAULT false;
@@ -0,0 +1,15 @@
+CREATE TABLE workflow_node_execution_logs (
+  id BIGSERIAL PRIMARY KEY,
+  workflow_id UUID NOT NULL REFERENCES workflows(id) ON DELETE CASCADE,
+  node_id CHARACTER VARYING(128) NOT NULL,
+  execution_id UUID NOT NULL,
+  stream CHARACTER VARYING(32) NOT NULL,
+  message TEXT NOT NULL,
+  created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_workflow_node_execution_logs_execution_id
+  ON workflow_node_execution_logs (execution_id, id);
+
+CREATE INDEX idx_workflow_node_execution_logs_workflow_node
+  ON workflow_node_execution_logs (workflow_id, node_id);
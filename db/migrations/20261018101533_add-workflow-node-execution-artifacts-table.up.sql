@@ -0,0 +1,16 @@
+CREATE TABLE workflow_node_execution_artifacts (
+  id UUID NOT NULL DEFAULT uuid_generate_v4() PRIMARY KEY,
+  workflow_id UUID NOT NULL REFERENCES workflows(id) ON DELETE CASCADE,
+  node_id CHARACTER VARYING(128) NOT NULL,
+  execution_id UUID NOT NULL,
+  name CHARACTER VARYING(255) NOT NULL,
+  content_type CHARACTER VARYING(255) NOT NULL,
+  size BIGINT NOT NULL,
+  sha256 CHARACTER VARYING(64) NOT NULL,
+  storage_key TEXT NOT NULL,
+  created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+  UNIQUE (execution_id, name)
+);
+
+CREATE INDEX idx_workflow_node_execution_artifacts_workflow_node
+  ON workflow_node_execution_artifacts (workflow_id, node_id);
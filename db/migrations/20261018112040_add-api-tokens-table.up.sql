@@ -0,0 +1,15 @@
+CREATE TABLE api_tokens (
+  id UUID NOT NULL DEFAULT uuid_generate_v4() PRIMARY KEY,
+  organization_id UUID NOT NULL REFERENCES organizations(id) ON DELETE CASCADE,
+  user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+  name CHARACTER VARYING(128) NOT NULL,
+  token_hash CHARACTER VARYING(255) NOT NULL UNIQUE,
+  scopes JSONB NOT NULL DEFAULT '[]'::jsonb,
+  expires_at TIMESTAMP WITH TIME ZONE,
+  last_used_at TIMESTAMP WITH TIME ZONE,
+  created_by UUID,
+  created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+  UNIQUE (user_id, name)
+);
+
+CREATE INDEX idx_api_tokens_organization_user ON api_tokens (organization_id, user_id);
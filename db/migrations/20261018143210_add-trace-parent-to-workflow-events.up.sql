@@ -0,0 +1 @@
+ALTER TABLE workflow_events ADD COLUMN trace_parent CHARACTER VARYING(64);
@@ -0,0 +1,7 @@
+ALTER TABLE workflow_node_executions ADD COLUMN started_at TIMESTAMP;
+ALTER TABLE workflow_node_executions ADD COLUMN finished_at TIMESTAMP;
+
+UPDATE workflow_node_executions SET started_at = created_at WHERE state IN ('started', 'finished');
+UPDATE workflow_node_executions SET finished_at = updated_at WHERE state = 'finished';
+
+CREATE INDEX idx_workflow_node_executions_workflow_finished_at ON workflow_node_executions(workflow_id, finished_at);
@@ -0,0 +1,8 @@
+CREATE TABLE workflow_dora_settings (
+  workflow_id UUID NOT NULL PRIMARY KEY REFERENCES workflows(id) ON DELETE CASCADE,
+  deployment_nodes JSONB NOT NULL DEFAULT '[]',
+  incident_nodes JSONB NOT NULL DEFAULT '[]',
+  updated_by UUID,
+  created_at TIMESTAMP NOT NULL,
+  updated_at TIMESTAMP NOT NULL
+);
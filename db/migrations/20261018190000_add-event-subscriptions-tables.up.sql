@@ -0,0 +1,34 @@
+CREATE TABLE event_subscriptions (
+  id UUID NOT NULL DEFAULT uuid_generate_v4() PRIMARY KEY,
+  organization_id UUID NOT NULL REFERENCES organizations(id) ON DELETE CASCADE,
+  name CHARACTER VARYING(128) NOT NULL,
+  url TEXT NOT NULL,
+  secret BYTEA NOT NULL,
+  event_types JSONB NOT NULL DEFAULT '[]'::jsonb,
+  canvas_ids JSONB NOT NULL DEFAULT '[]'::jsonb,
+  node_ids JSONB NOT NULL DEFAULT '[]'::jsonb,
+  enabled BOOLEAN NOT NULL DEFAULT true,
+  created_by UUID,
+  created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+  updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+  UNIQUE (organization_id, name)
+);
+
+CREATE TABLE event_subscription_deliveries (
+  id UUID NOT NULL DEFAULT uuid_generate_v4() PRIMARY KEY,
+  subscription_id UUID NOT NULL REFERENCES event_subscriptions(id) ON DELETE CASCADE,
+  event_id UUID NOT NULL,
+  event_type CHARACTER VARYING(64) NOT NULL,
+  payload JSONB NOT NULL,
+  state CHARACTER VARYING(32) NOT NULL,
+  attempts INTEGER NOT NULL DEFAULT 0,
+  next_attempt_at TIMESTAMP WITH TIME ZONE NOT NULL,
+  response_status INTEGER,
+  last_error TEXT,
+  delivered_at TIMESTAMP WITH TIME ZONE,
+  created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+  updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_event_subscription_deliveries_pending ON event_subscription_deliveries (next_attempt_at) WHERE state = 'pending';
+CREATE INDEX idx_event_subscription_deliveries_subscription ON event_subscription_deliveries (subscription_id, created_at);
@@ -0,0 +1,21 @@
+CREATE TABLE organization_sso_configs (
+  id UUID NOT NULL DEFAULT uuid_generate_v4() PRIMARY KEY,
+  organization_id UUID NOT NULL UNIQUE REFERENCES organizations(id) ON DELETE CASCADE,
+  protocol CHARACTER VARYING(16) NOT NULL,
+  enabled BOOLEAN NOT NULL DEFAULT false,
+  enforce_sso BOOLEAN NOT NULL DEFAULT false,
+  saml_idp_metadata_url TEXT,
+  saml_idp_metadata TEXT,
+  oidc_issuer_url TEXT,
+  oidc_client_id TEXT,
+  oidc_client_secret BYTEA,
+  email_attribute CHARACTER VARYING(255) NOT NULL DEFAULT 'email',
+  name_attribute CHARACTER VARYING(255) NOT NULL DEFAULT 'name',
+  groups_attribute CHARACTER VARYING(255) NOT NULL DEFAULT 'groups',
+  default_role CHARACTER VARYING(255) NOT NULL DEFAULT 'org_viewer',
+  group_mappings JSONB NOT NULL DEFAULT '[]'::jsonb,
+  updated_by UUID REFERENCES users(id) ON DELETE SET NULL,
+  created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+  updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+  CONSTRAINT organization_sso_configs_protocol_check CHECK (protocol IN ('saml', 'oidc'))
+);
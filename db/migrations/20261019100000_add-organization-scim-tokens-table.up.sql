@@ -0,0 +1,7 @@
+CREATE TABLE organization_scim_tokens (
+  organization_id UUID NOT NULL PRIMARY KEY REFERENCES organizations(id) ON DELETE CASCADE,
+  token_hash CHARACTER VARYING(255) NOT NULL UNIQUE,
+  created_by UUID REFERENCES users(id) ON DELETE SET NULL,
+  created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+  last_used_at TIMESTAMP WITH TIME ZONE
+);
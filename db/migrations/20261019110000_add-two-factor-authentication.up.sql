@@ -0,0 +1,27 @@
+BEGIN;
+
+CREATE TABLE account_two_factor_auth (
+  account_id UUID NOT NULL PRIMARY KEY REFERENCES accounts(id) ON DELETE CASCADE,
+  secret BYTEA NOT NULL,
+  recovery_codes JSONB NOT NULL DEFAULT '[]'::jsonb,
+  last_used_step BIGINT NOT NULL DEFAULT 0,
+  enabled_at TIMESTAMP WITH TIME ZONE,
+  created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+  updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE TABLE two_factor_events (
+  id UUID NOT NULL PRIMARY KEY DEFAULT uuid_generate_v4(),
+  account_id UUID REFERENCES accounts(id) ON DELETE CASCADE,
+  organization_id UUID REFERENCES organizations(id) ON DELETE CASCADE,
+  actor_id UUID REFERENCES accounts(id) ON DELETE SET NULL,
+  type CHARACTER VARYING(64) NOT NULL,
+  remote_addr CHARACTER VARYING(255) NOT NULL DEFAULT '',
+  created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_two_factor_events_account_id_created_at ON two_factor_events(account_id, created_at);
+
+ALTER TABLE organizations ADD COLUMN require_two_factor BOOLEAN NOT NULL DEFAULT false;
+
+COMMIT;
@@ -0,0 +1,34 @@
+BEGIN;
+
+CREATE TABLE notification_preferences (
+  user_id UUID NOT NULL PRIMARY KEY REFERENCES users(id) ON DELETE CASCADE,
+  organization_id UUID NOT NULL REFERENCES organizations(id) ON DELETE CASCADE,
+  channels JSONB NOT NULL DEFAULT '[]'::jsonb,
+  quiet_hours_start CHARACTER VARYING(5) NOT NULL DEFAULT '',
+  quiet_hours_end CHARACTER VARYING(5) NOT NULL DEFAULT '',
+  timezone CHARACTER VARYING(64) NOT NULL DEFAULT 'UTC',
+  digest_interval_minutes INTEGER NOT NULL DEFAULT 0,
+  created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+  updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE TABLE pending_notifications (
+  id UUID NOT NULL PRIMARY KEY DEFAULT uuid_generate_v4(),
+  organization_id UUID NOT NULL REFERENCES organizations(id) ON DELETE CASCADE,
+  user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+  channel CHARACTER VARYING(32) NOT NULL,
+  target TEXT NOT NULL DEFAULT '',
+  title TEXT NOT NULL,
+  body TEXT NOT NULL DEFAULT '',
+  url TEXT NOT NULL DEFAULT '',
+  url_label TEXT NOT NULL DEFAULT '',
+  attempts INTEGER NOT NULL DEFAULT 0,
+  last_error TEXT,
+  deliver_after TIMESTAMP WITH TIME ZONE NOT NULL,
+  created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_pending_notifications_deliver_after ON pending_notifications(deliver_after);
+CREATE INDEX idx_pending_notifications_user_id_channel ON pending_notifications(user_id, channel, target);
+
+COMMIT;
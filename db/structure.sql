@@ -71,6 +71,21 @@ CREATE TABLE public.account_providers (
 );
 
 
+--
+-- Name: account_two_factor_auth; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.account_two_factor_auth (
+    account_id uuid NOT NULL,
+    secret bytea NOT NULL,
+    recovery_codes jsonb DEFAULT '[]'::jsonb NOT NULL,
+    last_used_step bigint DEFAULT 0 NOT NULL,
+    enabled_at timestamp with time zone,
+    created_at timestamp with time zone DEFAULT now() NOT NULL,
+    updated_at timestamp with time zone DEFAULT now() NOT NULL
+);
+
+
 --
 -- Name: accounts; Type: TABLE; Schema: public; Owner: -
 --
@@ -84,6 +99,24 @@ CREATE TABLE public.accounts (
 );
 
 
+--
+-- Name: api_tokens; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.api_tokens (
+    id uuid DEFAULT public.uuid_generate_v4() NOT NULL,
+    organization_id uuid NOT NULL,
+    user_id uuid NOT NULL,
+    name character varying(128) NOT NULL,
+    token_hash character varying(255) NOT NULL,
+    scopes jsonb DEFAULT '[]'::jsonb NOT NULL,
+    expires_at timestamp with time zone,
+    last_used_at timestamp with time zone,
+    created_by uuid,
+    created_at timestamp with time zone DEFAULT now() NOT NULL
+);
+
+
 --
 -- Name: app_installation_requests; Type: TABLE; Schema: public; Owner: -
 --
@@ -250,6 +283,47 @@ CREATE TABLE public.email_settings (
 );
 
 
+--
+-- Name: event_subscription_deliveries; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.event_subscription_deliveries (
+    id uuid DEFAULT public.uuid_generate_v4() NOT NULL,
+    subscription_id uuid NOT NULL,
+    event_id uuid NOT NULL,
+    event_type character varying(64) NOT NULL,
+    payload jsonb NOT NULL,
+    state character varying(32) NOT NULL,
+    attempts integer DEFAULT 0 NOT NULL,
+    next_attempt_at timestamp with time zone NOT NULL,
+    response_status integer,
+    last_error text,
+    delivered_at timestamp with time zone,
+    created_at timestamp with time zone DEFAULT now() NOT NULL,
+    updated_at timestamp with time zone DEFAULT now() NOT NULL
+);
+
+
+--
+-- Name: event_subscriptions; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.event_subscriptions (
+    id uuid DEFAULT public.uuid_generate_v4() NOT NULL,
+    organization_id uuid NOT NULL,
+    name character varying(128) NOT NULL,
+    url text NOT NULL,
+    secret bytea NOT NULL,
+    event_types jsonb DEFAULT '[]'::jsonb NOT NULL,
+    canvas_ids jsonb DEFAULT '[]'::jsonb NOT NULL,
+    node_ids jsonb DEFAULT '[]'::jsonb NOT NULL,
+    enabled boolean DEFAULT true NOT NULL,
+    created_by uuid,
+    created_at timestamp with time zone DEFAULT now() NOT NULL,
+    updated_at timestamp with time zone DEFAULT now() NOT NULL
+);
+
+
 --
 -- Name: group_metadata; Type: TABLE; Schema: public; Owner: -
 --
@@ -279,6 +353,23 @@ CREATE TABLE public.installation_metadata (
 );
 
 
+--
+-- Name: notification_preferences; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.notification_preferences (
+    user_id uuid NOT NULL,
+    organization_id uuid NOT NULL,
+    channels jsonb DEFAULT '[]'::jsonb NOT NULL,
+    quiet_hours_start character varying(5) DEFAULT ''::character varying NOT NULL,
+    quiet_hours_end character varying(5) DEFAULT ''::character varying NOT NULL,
+    timezone character varying(64) DEFAULT 'UTC'::character varying NOT NULL,
+    digest_interval_minutes integer DEFAULT 0 NOT NULL,
+    created_at timestamp with time zone DEFAULT now() NOT NULL,
+    updated_at timestamp with time zone DEFAULT now() NOT NULL
+);
+
+
 --
 -- Name: organization_agent_settings; Type: TABLE; Schema: public; Owner: -
 --
@@ -330,6 +421,46 @@ CREATE TABLE public.organization_invite_links (
 );
 
 
+--
+-- Name: organization_scim_tokens; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.organization_scim_tokens (
+    organization_id uuid NOT NULL,
+    token_hash character varying(255) NOT NULL,
+    created_by uuid,
+    created_at timestamp with time zone DEFAULT now() NOT NULL,
+    last_used_at timestamp with time zone
+);
+
+
+--
+-- Name: organization_sso_configs; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.organization_sso_configs (
+    id uuid DEFAULT public.uuid_generate_v4() NOT NULL,
+    organization_id uuid NOT NULL,
+    protocol character varying(16) NOT NULL,
+    enabled boolean DEFAULT false NOT NULL,
+    enforce_sso boolean DEFAULT false NOT NULL,
+    saml_idp_metadata_url text,
+    saml_idp_metadata text,
+    oidc_issuer_url text,
+    oidc_client_id text,
+    oidc_client_secret bytea,
+    email_attribute character varying(255) DEFAULT 'email'::character varying NOT NULL,
+    name_attribute character varying(255) DEFAULT 'name'::character varying NOT NULL,
+    groups_attribute character varying(255) DEFAULT 'groups'::character varying NOT NULL,
+    default_role character varying(255) DEFAULT 'org_viewer'::character varying NOT NULL,
+    group_mappings jsonb DEFAULT '[]'::jsonb NOT NULL,
+    updated_by uuid,
+    created_at timestamp with time zone DEFAULT now() NOT NULL,
+    updated_at timestamp with time zone DEFAULT now() NOT NULL,
+    CONSTRAINT organization_sso_configs_protocol_check CHECK (((protocol)::text = ANY ((ARRAY['saml'::character varying, 'oidc'::character varying])::text[])))
+);
+
+
 --
 -- Name: organizations; Type: TABLE; Schema: public; Owner: -
 --
@@ -341,7 +472,29 @@ CREATE TABLE public.organizations (
     created_at timestamp without time zone DEFAULT CURRENT_TIMESTAMP,
     updated_at timestamp without time zone DEFAULT CURRENT_TIMESTAMP,
     deleted_at timestamp without time zone,
-    description text DEFAULT ''::text
+    description text DEFAULT ''::text,
+    require_two_factor boolean DEFAULT false NOT NULL
+);
+
+
+--
+-- Name: pending_notifications; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.pending_notifications (
+    id uuid DEFAULT public.uuid_generate_v4() NOT NULL,
+    organization_id uuid NOT NULL,
+    user_id uuid NOT NULL,
+    channel character varying(32) NOT NULL,
+    target text DEFAULT ''::text NOT NULL,
+    title text NOT NULL,
+    body text DEFAULT ''::text NOT NULL,
+    url text DEFAULT ''::text NOT NULL,
+    url_label text DEFAULT ''::text NOT NULL,
+    attempts integer DEFAULT 0 NOT NULL,
+    last_error text,
+    deliver_after timestamp with time zone NOT NULL,
+    created_at timestamp with time zone DEFAULT now() NOT NULL
 );
 
 
@@ -388,6 +541,21 @@ CREATE TABLE public.secrets (
 );
 
 
+--
+-- Name: two_factor_events; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.two_factor_events (
+    id uuid DEFAULT public.uuid_generate_v4() NOT NULL,
+    account_id uuid,
+    organization_id uuid,
+    actor_id uuid,
+    type character varying(64) NOT NULL,
+    remote_addr character varying(255) DEFAULT ''::character varying NOT NULL,
+    created_at timestamp with time zone DEFAULT now() NOT NULL
+);
+
+
 --
 -- Name: users; Type: TABLE; Schema: public; Owner: -
 --
@@ -427,6 +595,20 @@ CREATE TABLE public.webhooks (
 );
 
 
+--
+-- Name: workflow_dora_settings; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.workflow_dora_settings (
+    workflow_id uuid NOT NULL,
+    deployment_nodes jsonb DEFAULT '[]'::jsonb NOT NULL,
+    incident_nodes jsonb DEFAULT '[]'::jsonb NOT NULL,
+    updated_by uuid,
+    created_at timestamp without time zone NOT NULL,
+    updated_at timestamp without time zone NOT NULL
+);
+
+
 --
 -- Name: workflow_events; Type: TABLE; Schema: public; Owner: -
 --
@@ -440,7 +622,26 @@ CREATE TABLE public.workflow_events (
     state character varying(32) NOT NULL,
     execution_id uuid,
     created_at timestamp without time zone NOT NULL,
-    custom_name text
+    custom_name text,
+    trace_parent character varying(64)
+);
+
+
+--
+-- Name: workflow_node_execution_artifacts; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.workflow_node_execution_artifacts (
+    id uuid DEFAULT public.uuid_generate_v4() NOT NULL,
+    workflow_id uuid NOT NULL,
+    node_id character varying(128) NOT NULL,
+    execution_id uuid NOT NULL,
+    name character varying(255) NOT NULL,
+    content_type character varying(255) NOT NULL,
+    size bigint NOT NULL,
+    sha256 character varying(64) NOT NULL,
+    storage_key text NOT NULL,
+    created_at timestamp with time zone DEFAULT now() NOT NULL
 );
 
 
@@ -459,6 +660,40 @@ CREATE TABLE public.workflow_node_execution_kvs (
 );
 
 
+--
+-- Name: workflow_node_execution_logs; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.workflow_node_execution_logs (
+    id bigint NOT NULL,
+    workflow_id uuid NOT NULL,
+    node_id character varying(128) NOT NULL,
+    execution_id uuid NOT NULL,
+    stream character varying(32) NOT NULL,
+    message text NOT NULL,
+    created_at timestamp with time zone DEFAULT now() NOT NULL
+);
+
+
+--
+-- Name: workflow_node_execution_logs_id_seq; Type: SEQUENCE; Schema: public; Owner: -
+--
+
+CREATE SEQUENCE public.workflow_node_execution_logs_id_seq
+    START WITH 1
+    INCREMENT BY 1
+    NO MINVALUE
+    NO MAXVALUE
+    CACHE 1;
+
+
+--
+-- Name: workflow_node_execution_logs_id_seq; Type: SEQUENCE OWNED BY; Schema: public; Owner: -
+--
+
+ALTER SEQUENCE public.workflow_node_execution_logs_id_seq OWNED BY public.workflow_node_execution_logs.id;
+
+
 --
 -- Name: workflow_node_executions; Type: TABLE; Schema: public; Owner: -
 --
@@ -479,7 +714,9 @@ CREATE TABLE public.workflow_node_executions (
     configuration jsonb DEFAULT '{}'::jsonb NOT NULL,
     created_at timestamp without time zone NOT NULL,
     updated_at timestamp without time zone NOT NULL,
-    cancelled_by uuid
+    cancelled_by uuid,
+    started_at timestamp without time zone,
+    finished_at timestamp without time zone
 );
 
 
@@ -536,7 +773,8 @@ CREATE TABLE public.workflow_nodes (
     parent_node_id character varying(128),
     deleted_at timestamp with time zone,
     app_installation_id uuid,
-    state_reason character varying(255) DEFAULT NULL::character varying
+    state_reason character varying(255) DEFAULT NULL::character varying,
+    error_channel_enabled boolean DEFAULT false NOT NULL
 );
 
 
@@ -566,6 +804,13 @@ CREATE TABLE public.workflows (
 ALTER TABLE ONLY public.casbin_rule ALTER COLUMN id SET DEFAULT nextval('public.casbin_rule_id_seq'::regclass);
 
 
+--
+-- Name: workflow_node_execution_logs id; Type: DEFAULT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.workflow_node_execution_logs ALTER COLUMN id SET DEFAULT nextval('public.workflow_node_execution_logs_id_seq'::regclass);
+
+
 --
 -- Name: account_password_auth account_password_auth_account_id_key; Type: CONSTRAINT; Schema: public; Owner: -
 --
@@ -606,6 +851,14 @@ ALTER TABLE ONLY public.account_providers
     ADD CONSTRAINT account_providers_provider_provider_id_key UNIQUE (provider, provider_id);
 
 
+--
+-- Name: account_two_factor_auth account_two_factor_auth_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.account_two_factor_auth
+    ADD CONSTRAINT account_two_factor_auth_pkey PRIMARY KEY (account_id);
+
+
 --
 -- Name: accounts accounts_email_key; Type: CONSTRAINT; Schema: public; Owner: -
 --
@@ -622,6 +875,30 @@ ALTER TABLE ONLY public.accounts
     ADD CONSTRAINT accounts_pkey PRIMARY KEY (id);
 
 
+--
+-- Name: api_tokens api_tokens_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.api_tokens
+    ADD CONSTRAINT api_tokens_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: api_tokens api_tokens_token_hash_key; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.api_tokens
+    ADD CONSTRAINT api_tokens_token_hash_key UNIQUE (token_hash);
+
+
+--
+-- Name: api_tokens api_tokens_user_id_name_key; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.api_tokens
+    ADD CONSTRAINT api_tokens_user_id_name_key UNIQUE (user_id, name);
+
+
 --
 -- Name: app_installation_requests app_installation_requests_pkey; Type: CONSTRAINT; Schema: public; Owner: -
 --
@@ -718,6 +995,30 @@ ALTER TABLE ONLY public.email_settings
     ADD CONSTRAINT email_settings_provider_key UNIQUE (provider);
 
 
+--
+-- Name: event_subscription_deliveries event_subscription_deliveries_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.event_subscription_deliveries
+    ADD CONSTRAINT event_subscription_deliveries_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: event_subscriptions event_subscriptions_organization_id_name_key; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.event_subscriptions
+    ADD CONSTRAINT event_subscriptions_organization_id_name_key UNIQUE (organization_id, name);
+
+
+--
+-- Name: event_subscriptions event_subscriptions_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.event_subscriptions
+    ADD CONSTRAINT event_subscriptions_pkey PRIMARY KEY (id);
+
+
 --
 -- Name: group_metadata group_metadata_pkey; Type: CONSTRAINT; Schema: public; Owner: -
 --
@@ -734,6 +1035,14 @@ ALTER TABLE ONLY public.installation_metadata
     ADD CONSTRAINT installation_metadata_pkey PRIMARY KEY (id);
 
 
+--
+-- Name: notification_preferences notification_preferences_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.notification_preferences
+    ADD CONSTRAINT notification_preferences_pkey PRIMARY KEY (user_id);
+
+
 --
 -- Name: organization_agent_settings organization_agent_settings_organization_id_key; Type: CONSTRAINT; Schema: public; Owner: -
 --
@@ -782,6 +1091,38 @@ ALTER TABLE ONLY public.organization_invite_links
     ADD CONSTRAINT organization_invite_links_token_key UNIQUE (token);
 
 
+--
+-- Name: organization_scim_tokens organization_scim_tokens_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.organization_scim_tokens
+    ADD CONSTRAINT organization_scim_tokens_pkey PRIMARY KEY (organization_id);
+
+
+--
+-- Name: organization_scim_tokens organization_scim_tokens_token_hash_key; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.organization_scim_tokens
+    ADD CONSTRAINT organization_scim_tokens_token_hash_key UNIQUE (token_hash);
+
+
+--
+-- Name: organization_sso_configs organization_sso_configs_organization_id_key; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.organization_sso_configs
+    ADD CONSTRAINT organization_sso_configs_organization_id_key UNIQUE (organization_id);
+
+
+--
+-- Name: organization_sso_configs organization_sso_configs_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.organization_sso_configs
+    ADD CONSTRAINT organization_sso_configs_pkey PRIMARY KEY (id);
+
+
 --
 -- Name: organizations organizations_name_key; Type: CONSTRAINT; Schema: public; Owner: -
 --
@@ -798,6 +1139,14 @@ ALTER TABLE ONLY public.organizations
     ADD CONSTRAINT organizations_pkey PRIMARY KEY (id);
 
 
+--
+-- Name: pending_notifications pending_notifications_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.pending_notifications
+    ADD CONSTRAINT pending_notifications_pkey PRIMARY KEY (id);
+
+
 --
 -- Name: role_metadata role_metadata_pkey; Type: CONSTRAINT; Schema: public; Owner: -
 --
@@ -846,6 +1195,14 @@ ALTER TABLE ONLY public.role_metadata
     ADD CONSTRAINT uq_role_metadata_key UNIQUE (role_name, domain_type, domain_id);
 
 
+--
+-- Name: two_factor_events two_factor_events_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.two_factor_events
+    ADD CONSTRAINT two_factor_events_pkey PRIMARY KEY (id);
+
+
 --
 -- Name: users users_pkey; Type: CONSTRAINT; Schema: public; Owner: -
 --
@@ -862,6 +1219,14 @@ ALTER TABLE ONLY public.webhooks
     ADD CONSTRAINT webhooks_pkey PRIMARY KEY (id);
 
 
+--
+-- Name: workflow_dora_settings workflow_dora_settings_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.workflow_dora_settings
+    ADD CONSTRAINT workflow_dora_settings_pkey PRIMARY KEY (workflow_id);
+
+
 --
 -- Name: workflow_events workflow_events_pkey; Type: CONSTRAINT; Schema: public; Owner: -
 --
@@ -870,6 +1235,30 @@ ALTER TABLE ONLY public.workflow_events
     ADD CONSTRAINT workflow_events_pkey PRIMARY KEY (id);
 
 
+--
+-- Name: workflow_node_execution_logs workflow_node_execution_logs_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.workflow_node_execution_logs
+    ADD CONSTRAINT workflow_node_execution_logs_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: workflow_node_execution_artifacts workflow_node_execution_artifacts_execution_id_name_key; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.workflow_node_execution_artifacts
+    ADD CONSTRAINT workflow_node_execution_artifacts_execution_id_name_key UNIQUE (execution_id, name);
+
+
+--
+-- Name: workflow_node_execution_artifacts workflow_node_execution_artifacts_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.workflow_node_execution_artifacts
+    ADD CONSTRAINT workflow_node_execution_artifacts_pkey PRIMARY KEY (id);
+
+
 --
 -- Name: workflow_node_execution_kvs workflow_node_execution_kvs_pkey; Type: CONSTRAINT; Schema: public; Owner: -
 --
@@ -947,6 +1336,13 @@ CREATE INDEX idx_account_providers_account_id ON public.account_providers USING
 CREATE INDEX idx_account_providers_provider ON public.account_providers USING btree (provider);
 
 
+--
+-- Name: idx_api_tokens_organization_user; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX idx_api_tokens_organization_user ON public.api_tokens USING btree (organization_id, user_id);
+
+
 --
 -- Name: idx_app_installation_requests_installation_id; Type: INDEX; Schema: public; Owner: -
 --
@@ -1059,6 +1455,20 @@ CREATE INDEX idx_casbin_rule_v1 ON public.casbin_rule USING btree (v1);
 CREATE INDEX idx_casbin_rule_v2 ON public.casbin_rule USING btree (v2);
 
 
+--
+-- Name: idx_event_subscription_deliveries_pending; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX idx_event_subscription_deliveries_pending ON public.event_subscription_deliveries USING btree (next_attempt_at) WHERE ((state)::text = 'pending'::text);
+
+
+--
+-- Name: idx_event_subscription_deliveries_subscription; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX idx_event_subscription_deliveries_subscription ON public.event_subscription_deliveries USING btree (subscription_id, created_at);
+
+
 --
 -- Name: idx_group_metadata_lookup; Type: INDEX; Schema: public; Owner: -
 --
@@ -1087,6 +1497,20 @@ CREATE INDEX idx_organization_agent_settings_organization_id ON public.organizat
 CREATE INDEX idx_organizations_deleted_at ON public.organizations USING btree (deleted_at);
 
 
+--
+-- Name: idx_pending_notifications_deliver_after; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX idx_pending_notifications_deliver_after ON public.pending_notifications USING btree (deliver_after);
+
+
+--
+-- Name: idx_pending_notifications_user_id_channel; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX idx_pending_notifications_user_id_channel ON public.pending_notifications USING btree (user_id, channel, target);
+
+
 --
 -- Name: idx_role_metadata_lookup; Type: INDEX; Schema: public; Owner: -
 --
@@ -1094,6 +1518,13 @@ CREATE INDEX idx_organizations_deleted_at ON public.organizations USING btree (d
 CREATE INDEX idx_role_metadata_lookup ON public.role_metadata USING btree (role_name, domain_type, domain_id);
 
 
+--
+-- Name: idx_two_factor_events_account_id_created_at; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX idx_two_factor_events_account_id_created_at ON public.two_factor_events USING btree (account_id, created_at);
+
+
 --
 -- Name: idx_webhooks_app_installation_id; Type: INDEX; Schema: public; Owner: -
 --
@@ -1129,6 +1560,13 @@ CREATE INDEX idx_workflow_events_state ON public.workflow_events USING btree (st
 CREATE INDEX idx_workflow_events_workflow_node_id ON public.workflow_events USING btree (workflow_id, node_id);
 
 
+--
+-- Name: idx_workflow_node_execution_artifacts_workflow_node; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX idx_workflow_node_execution_artifacts_workflow_node ON public.workflow_node_execution_artifacts USING btree (workflow_id, node_id);
+
+
 --
 -- Name: idx_workflow_node_execution_kvs_ekv; Type: INDEX; Schema: public; Owner: -
 --
@@ -1143,6 +1581,20 @@ CREATE INDEX idx_workflow_node_execution_kvs_ekv ON public.workflow_node_executi
 CREATE INDEX idx_workflow_node_execution_kvs_workflow_node_key_value ON public.workflow_node_execution_kvs USING btree (workflow_id, node_id, key, value);
 
 
+--
+-- Name: idx_workflow_node_execution_logs_execution_id; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX idx_workflow_node_execution_logs_execution_id ON public.workflow_node_execution_logs USING btree (execution_id, id);
+
+
+--
+-- Name: idx_workflow_node_execution_logs_workflow_node; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX idx_workflow_node_execution_logs_workflow_node ON public.workflow_node_execution_logs USING btree (workflow_id, node_id);
+
+
 --
 -- Name: idx_workflow_node_executions_event_id; Type: INDEX; Schema: public; Owner: -
 --
@@ -1185,6 +1637,13 @@ CREATE INDEX idx_workflow_node_executions_root_event_id ON public.workflow_node_
 CREATE INDEX idx_workflow_node_executions_state_created_at ON public.workflow_node_executions USING btree (state, created_at DESC);
 
 
+--
+-- Name: idx_workflow_node_executions_workflow_finished_at; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX idx_workflow_node_executions_workflow_finished_at ON public.workflow_node_executions USING btree (workflow_id, finished_at);
+
+
 --
 -- Name: idx_workflow_node_executions_workflow_node_id; Type: INDEX; Schema: public; Owner: -
 --
@@ -1285,6 +1744,30 @@ ALTER TABLE ONLY public.account_providers
     ADD CONSTRAINT account_providers_account_id_fkey FOREIGN KEY (account_id) REFERENCES public.accounts(id);
 
 
+--
+-- Name: account_two_factor_auth account_two_factor_auth_account_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.account_two_factor_auth
+    ADD CONSTRAINT account_two_factor_auth_account_id_fkey FOREIGN KEY (account_id) REFERENCES public.accounts(id) ON DELETE CASCADE;
+
+
+--
+-- Name: api_tokens api_tokens_organization_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.api_tokens
+    ADD CONSTRAINT api_tokens_organization_id_fkey FOREIGN KEY (organization_id) REFERENCES public.organizations(id) ON DELETE CASCADE;
+
+
+--
+-- Name: api_tokens api_tokens_user_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.api_tokens
+    ADD CONSTRAINT api_tokens_user_id_fkey FOREIGN KEY (user_id) REFERENCES public.users(id) ON DELETE CASCADE;
+
+
 --
 -- Name: app_installation_requests app_installation_requests_app_installation_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
 --
@@ -1349,6 +1832,110 @@ ALTER TABLE ONLY public.canvas_memories
     ADD CONSTRAINT canvas_memories_canvas_id_fkey FOREIGN KEY (canvas_id) REFERENCES public.workflows(id) ON DELETE CASCADE;
 
 
+--
+-- Name: event_subscription_deliveries event_subscription_deliveries_subscription_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.event_subscription_deliveries
+    ADD CONSTRAINT event_subscription_deliveries_subscription_id_fkey FOREIGN KEY (subscription_id) REFERENCES public.event_subscriptions(id) ON DELETE CASCADE;
+
+
+--
+-- Name: event_subscriptions event_subscriptions_organization_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.event_subscriptions
+    ADD CONSTRAINT event_subscriptions_organization_id_fkey FOREIGN KEY (organization_id) REFERENCES public.organizations(id) ON DELETE CASCADE;
+
+
+--
+-- Name: notification_preferences notification_preferences_organization_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.notification_preferences
+    ADD CONSTRAINT notification_preferences_organization_id_fkey FOREIGN KEY (organization_id) REFERENCES public.organizations(id) ON DELETE CASCADE;
+
+
+--
+-- Name: notification_preferences notification_preferences_user_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.notification_preferences
+    ADD CONSTRAINT notification_preferences_user_id_fkey FOREIGN KEY (user_id) REFERENCES public.users(id) ON DELETE CASCADE;
+
+
+--
+-- Name: organization_scim_tokens organization_scim_tokens_created_by_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.organization_scim_tokens
+    ADD CONSTRAINT organization_scim_tokens_created_by_fkey FOREIGN KEY (created_by) REFERENCES public.users(id) ON DELETE SET NULL;
+
+
+--
+-- Name: organization_scim_tokens organization_scim_tokens_organization_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.organization_scim_tokens
+    ADD CONSTRAINT organization_scim_tokens_organization_id_fkey FOREIGN KEY (organization_id) REFERENCES public.organizations(id) ON DELETE CASCADE;
+
+
+--
+-- Name: organization_sso_configs organization_sso_configs_organization_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.organization_sso_configs
+    ADD CONSTRAINT organization_sso_configs_organization_id_fkey FOREIGN KEY (organization_id) REFERENCES public.organizations(id) ON DELETE CASCADE;
+
+
+--
+-- Name: organization_sso_configs organization_sso_configs_updated_by_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.organization_sso_configs
+    ADD CONSTRAINT organization_sso_configs_updated_by_fkey FOREIGN KEY (updated_by) REFERENCES public.users(id) ON DELETE SET NULL;
+
+
+--
+-- Name: pending_notifications pending_notifications_organization_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.pending_notifications
+    ADD CONSTRAINT pending_notifications_organization_id_fkey FOREIGN KEY (organization_id) REFERENCES public.organizations(id) ON DELETE CASCADE;
+
+
+--
+-- Name: pending_notifications pending_notifications_user_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.pending_notifications
+    ADD CONSTRAINT pending_notifications_user_id_fkey FOREIGN KEY (user_id) REFERENCES public.users(id) ON DELETE CASCADE;
+
+
+--
+-- Name: two_factor_events two_factor_events_account_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.two_factor_events
+    ADD CONSTRAINT two_factor_events_account_id_fkey FOREIGN KEY (account_id) REFERENCES public.accounts(id) ON DELETE CASCADE;
+
+
+--
+-- Name: two_factor_events two_factor_events_actor_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.two_factor_events
+    ADD CONSTRAINT two_factor_events_actor_id_fkey FOREIGN KEY (actor_id) REFERENCES public.accounts(id) ON DELETE SET NULL;
+
+
+--
+-- Name: two_factor_events two_factor_events_organization_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.two_factor_events
+    ADD CONSTRAINT two_factor_events_organization_id_fkey FOREIGN KEY (organization_id) REFERENCES public.organizations(id) ON DELETE CASCADE;
+
+
 --
 -- Name: workflow_node_execution_kvs fk_wnek_workflow; Type: FK CONSTRAINT; Schema: public; Owner: -
 --
@@ -1469,6 +2056,14 @@ ALTER TABLE ONLY public.webhooks
     ADD CONSTRAINT webhooks_app_installation_id_fkey FOREIGN KEY (app_installation_id) REFERENCES public.app_installations(id);
 
 
+--
+-- Name: workflow_dora_settings workflow_dora_settings_workflow_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.workflow_dora_settings
+    ADD CONSTRAINT workflow_dora_settings_workflow_id_fkey FOREIGN KEY (workflow_id) REFERENCES public.workflows(id) ON DELETE CASCADE;
+
+
 --
 -- Name: workflow_events workflow_events_execution_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
 --
@@ -1485,6 +2080,22 @@ ALTER TABLE ONLY public.workflow_events
     ADD CONSTRAINT workflow_events_workflow_id_fkey FOREIGN KEY (workflow_id) REFERENCES public.workflows(id);
 
 
+--
+-- Name: workflow_node_execution_artifacts workflow_node_execution_artifacts_workflow_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.workflow_node_execution_artifacts
+    ADD CONSTRAINT workflow_node_execution_artifacts_workflow_id_fkey FOREIGN KEY (workflow_id) REFERENCES public.workflows(id) ON DELETE CASCADE;
+
+
+--
+-- Name: workflow_node_execution_logs workflow_node_execution_logs_workflow_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.workflow_node_execution_logs
+    ADD CONSTRAINT workflow_node_execution_logs_workflow_id_fkey FOREIGN KEY (workflow_id) REFERENCES public.workflows(id) ON DELETE CASCADE;
+
+
 --
 -- Name: workflow_node_execution_kvs workflow_node_execution_kvs_execution_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
 --
@@ -1629,7 +2240,7 @@ SET row_security = off;
 --
 
 COPY public.schema_migrations (version, dirty) FROM stdin;
-20260227135919	f
+20261019120000	f
 \.
 
 
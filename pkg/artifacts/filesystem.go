@@ -0,0 +1,120 @@
+package artifacts
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+type FilesystemStorage struct {
+	root string
+}
+
+func NewFilesystemStorage(root string) (*FilesystemStorage, error) {
+	err := os.MkdirAll(root, 0o750)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create artifact directory: %w", err)
+	}
+
+	return &FilesystemStorage{root: root}, nil
+}
+
+func (s *FilesystemStorage) Put(ctx context.Context, key string, contentType string, content []byte) error {
+	path, err := s.pathFor(key)
+	if err != nil {
+		return err
+	}
+
+	err = os.MkdirAll(filepath.Dir(path), 0o750)
+	if err != nil {
+		return fmt.Errorf("failed to create artifact directory: %w", err)
+	}
+
+	//
+	// Write to a temporary file first and rename it,
+	// so readers never see a partially written artifact.
+	//
+	tmp, err := os.CreateTemp(filepath.Dir(path), ".upload-*")
+	if err != nil {
+		return fmt.Errorf("failed to create artifact file: %w", err)
+	}
+
+	defer os.Remove(tmp.Name())
+
+	_, err = tmp.Write(content)
+	if err != nil {
+		tmp.Close()
+		return fmt.Errorf("failed to write artifact: %w", err)
+	}
+
+	err = tmp.Close()
+	if err != nil {
+		return fmt.Errorf("failed to write artifact: %w", err)
+	}
+
+	return os.Rename(tmp.Name(), path)
+}
+
+func (s *FilesystemStorage) Get(ctx context.Context, key string) ([]byte, error) {
+	path, err := s.pathFor(key)
+	if err != nil {
+		return nil, err
+	}
+
+	content, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, ErrArtifactNotFound
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to read artifact: %w", err)
+	}
+
+	return content, nil
+}
+
+func (s *FilesystemStorage) Open(ctx context.Context, key string) (io.ReadCloser, error) {
+	path, err := s.pathFor(key)
+	if err != nil {
+		return nil, err
+	}
+
+	file, err := os.Open(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, ErrArtifactNotFound
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to read artifact: %w", err)
+	}
+
+	return file, nil
+}
+
+func (s *FilesystemStorage) Delete(ctx context.Context, key string) error {
+	path, err := s.pathFor(key)
+	if err != nil {
+		return err
+	}
+
+	err = os.Remove(path)
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return fmt.Errorf("failed to delete artifact: %w", err)
+	}
+
+	return nil
+}
+
+func (s *FilesystemStorage) pathFor(key string) (string, error) {
+	cleaned := filepath.Clean("/" + key)
+	if cleaned == "/" || strings.Contains(key, "..") {
+		return "", fmt.Errorf("invalid artifact key %q", key)
+	}
+
+	return filepath.Join(s.root, filepath.FromSlash(cleaned)), nil
+}
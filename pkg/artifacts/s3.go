@@ -0,0 +1,218 @@
+package artifacts
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	v4 "github.com/aws/aws-sdk-go-v2/aws/signer/v4"
+	awsconfig "github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/credentials"
+)
+
+type S3Config struct {
+	Bucket string
+	Region string
+	Prefix string
+
+	//
+	// If no access keys are given, credentials come from
+	// the default AWS chain: environment, shared config files,
+	// or the role of the instance, task or pod.
+	//
+	AccessKeyID     string
+	SecretAccessKey string
+
+	//
+	// Endpoint and UsePathStyle are used for S3-compatible
+	// services like MinIO or Cloudflare R2.
+	// If no endpoint is given, AWS S3 is used.
+	//
+	Endpoint     string
+	UsePathStyle bool
+}
+
+type S3Storage struct {
+	config      S3Config
+	credentials aws.CredentialsProvider
+	signer      *v4.Signer
+	client      *http.Client
+}
+
+func NewS3Storage(config S3Config) (*S3Storage, error) {
+	if config.Bucket == "" {
+		return nil, fmt.Errorf("ARTIFACT_S3_BUCKET is required")
+	}
+
+	if config.Region == "" {
+		return nil, fmt.Errorf("ARTIFACT_S3_REGION is required")
+	}
+
+	provider, err := newS3CredentialsProvider(config)
+	if err != nil {
+		return nil, err
+	}
+
+	return &S3Storage{
+		config:      config,
+		credentials: provider,
+		signer:      v4.NewSigner(),
+		client:      &http.Client{Timeout: 5 * time.Minute},
+	}, nil
+}
+
+func newS3CredentialsProvider(config S3Config) (aws.CredentialsProvider, error) {
+	if config.AccessKeyID == "" && config.SecretAccessKey == "" {
+		cfg, err := awsconfig.LoadDefaultConfig(context.Background(), awsconfig.WithRegion(config.Region))
+		if err != nil {
+			return nil, fmt.Errorf("failed to load AWS credentials: %w", err)
+		}
+
+		return cfg.Credentials, nil
+	}
+
+	if config.AccessKeyID == "" || config.SecretAccessKey == "" {
+		return nil, fmt.Errorf("ARTIFACT_S3_ACCESS_KEY_ID and ARTIFACT_S3_SECRET_ACCESS_KEY must be set together")
+	}
+
+	return credentials.NewStaticCredentialsProvider(config.AccessKeyID, config.SecretAccessKey, ""), nil
+}
+
+func (s *S3Storage) Put(ctx context.Context, key string, contentType string, content []byte) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, s.objectURL(key), bytes.NewReader(content))
+	if err != nil {
+		return fmt.Errorf("failed to build S3 request: %w", err)
+	}
+
+	if contentType != "" {
+		req.Header.Set("Content-Type", contentType)
+	}
+
+	_, err = s.do(req, content)
+	return err
+}
+
+func (s *S3Storage) Get(ctx context.Context, key string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, s.objectURL(key), nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build S3 request: %w", err)
+	}
+
+	return s.do(req, nil)
+}
+
+func (s *S3Storage) Open(ctx context.Context, key string) (io.ReadCloser, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, s.objectURL(key), nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build S3 request: %w", err)
+	}
+
+	res, err := s.send(req, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return res.Body, nil
+}
+
+func (s *S3Storage) Delete(ctx context.Context, key string) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, s.objectURL(key), nil)
+	if err != nil {
+		return fmt.Errorf("failed to build S3 request: %w", err)
+	}
+
+	_, err = s.do(req, nil)
+	if err == ErrArtifactNotFound {
+		return nil
+	}
+
+	return err
+}
+
+func (s *S3Storage) objectURL(key string) string {
+	objectKey := strings.TrimPrefix(key, "/")
+	if s.config.Prefix != "" {
+		objectKey = strings.TrimSuffix(s.config.Prefix, "/") + "/" + objectKey
+	}
+
+	escapedKey := (&url.URL{Path: objectKey}).EscapedPath()
+
+	endpoint := strings.TrimSuffix(s.config.Endpoint, "/")
+	if endpoint == "" {
+		endpoint = fmt.Sprintf("https://s3.%s.amazonaws.com", s.config.Region)
+	}
+
+	if s.config.UsePathStyle {
+		return fmt.Sprintf("%s/%s/%s", endpoint, s.config.Bucket, escapedKey)
+	}
+
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return fmt.Sprintf("%s/%s/%s", endpoint, s.config.Bucket, escapedKey)
+	}
+
+	return fmt.Sprintf("%s://%s.%s/%s", u.Scheme, s.config.Bucket, u.Host, escapedKey)
+}
+
+func (s *S3Storage) do(req *http.Request, payload []byte) ([]byte, error) {
+	res, err := s.send(req, payload)
+	if err != nil {
+		return nil, err
+	}
+
+	defer res.Body.Close()
+
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read S3 response: %w", err)
+	}
+
+	return body, nil
+}
+
+//
+// send signs and sends the request.
+// On success, the caller must close the response body.
+//
+
+func (s *S3Storage) send(req *http.Request, payload []byte) (*http.Response, error) {
+	hash := sha256.Sum256(payload)
+	payloadHash := hex.EncodeToString(hash[:])
+	req.Header.Set("X-Amz-Content-Sha256", payloadHash)
+
+	creds, err := s.credentials.Retrieve(req.Context())
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve AWS credentials: %w", err)
+	}
+
+	err = s.signer.SignHTTP(req.Context(), creds, req, payloadHash, "s3", s.config.Region, time.Now())
+	if err != nil {
+		return nil, fmt.Errorf("failed to sign S3 request: %w", err)
+	}
+
+	res, err := s.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("S3 request failed: %w", err)
+	}
+
+	if res.StatusCode >= 200 && res.StatusCode < 300 {
+		return res, nil
+	}
+
+	defer res.Body.Close()
+
+	if res.StatusCode == http.StatusNotFound {
+		return nil, ErrArtifactNotFound
+	}
+
+	body, _ := io.ReadAll(io.LimitReader(res.Body, 4096))
+	return nil, fmt.Errorf("S3 request failed with %d: %s", res.StatusCode, string(body))
+}
@@ -0,0 +1,108 @@
+package artifacts
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+)
+
+const (
+	StorageTypeFilesystem = "filesystem"
+	StorageTypeS3         = "s3"
+
+	//
+	// Maximum size of a single artifact.
+	//
+	MaxArtifactSize = 50 * 1024 * 1024
+)
+
+var (
+	ErrArtifactNotFound     = errors.New("artifact not found")
+	ErrStorageNotConfigured = errors.New("artifact storage is not configured, set ARTIFACT_STORAGE_PATH or ARTIFACT_STORAGE=s3")
+)
+
+//
+// Storage is where the contents of execution artifacts are kept.
+// The database only holds the artifact metadata and the key used here.
+//
+
+type Storage interface {
+	Put(ctx context.Context, key string, contentType string, content []byte) error
+	Get(ctx context.Context, key string) ([]byte, error)
+
+	//
+	// Open streams the content of an artifact,
+	// so big artifacts are not kept in memory.
+	// The caller must close the reader.
+	//
+	Open(ctx context.Context, key string) (io.ReadCloser, error)
+	Delete(ctx context.Context, key string) error
+}
+
+var (
+	storageInstance Storage
+	storageMutex    sync.Mutex
+)
+
+//
+// DefaultStorage returns the storage configured through
+// the ARTIFACT_STORAGE* environment variables.
+//
+
+func DefaultStorage() (Storage, error) {
+	storageMutex.Lock()
+	defer storageMutex.Unlock()
+
+	if storageInstance != nil {
+		return storageInstance, nil
+	}
+
+	storage, err := NewStorageFromEnv()
+	if err != nil {
+		return nil, err
+	}
+
+	storageInstance = storage
+	return storageInstance, nil
+}
+
+// SetDefaultStorage overrides the storage returned by DefaultStorage.
+func SetDefaultStorage(storage Storage) {
+	storageMutex.Lock()
+	defer storageMutex.Unlock()
+	storageInstance = storage
+}
+
+func NewStorageFromEnv() (Storage, error) {
+	storageType := os.Getenv("ARTIFACT_STORAGE")
+	switch storageType {
+	case "", StorageTypeFilesystem:
+		//
+		// Artifacts must outlive the process and be visible to the API
+		// and the workers, so there is no default directory.
+		//
+		path := os.Getenv("ARTIFACT_STORAGE_PATH")
+		if path == "" {
+			return nil, ErrStorageNotConfigured
+		}
+
+		return NewFilesystemStorage(path)
+
+	case StorageTypeS3:
+		return NewS3Storage(S3Config{
+			Bucket:          os.Getenv("ARTIFACT_S3_BUCKET"),
+			Region:          os.Getenv("ARTIFACT_S3_REGION"),
+			Endpoint:        os.Getenv("ARTIFACT_S3_ENDPOINT"),
+			Prefix:          os.Getenv("ARTIFACT_S3_PREFIX"),
+			AccessKeyID:     os.Getenv("ARTIFACT_S3_ACCESS_KEY_ID"),
+			SecretAccessKey: os.Getenv("ARTIFACT_S3_SECRET_ACCESS_KEY"),
+			UsePathStyle:    os.Getenv("ARTIFACT_S3_PATH_STYLE") == "yes",
+		})
+
+	default:
+		return nil, fmt.Errorf("unknown artifact storage %q", storageType)
+	}
+}
@@ -0,0 +1,201 @@
+package artifacts
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test__NewStorageFromEnv(t *testing.T) {
+	t.Run("nothing configured -> error", func(t *testing.T) {
+		t.Setenv("ARTIFACT_STORAGE", "")
+		t.Setenv("ARTIFACT_STORAGE_PATH", "")
+		_, err := NewStorageFromEnv()
+		assert.ErrorIs(t, err, ErrStorageNotConfigured)
+	})
+
+	t.Run("filesystem without path -> error", func(t *testing.T) {
+		t.Setenv("ARTIFACT_STORAGE", StorageTypeFilesystem)
+		t.Setenv("ARTIFACT_STORAGE_PATH", "")
+		_, err := NewStorageFromEnv()
+		assert.ErrorIs(t, err, ErrStorageNotConfigured)
+	})
+
+	t.Run("path -> filesystem storage", func(t *testing.T) {
+		t.Setenv("ARTIFACT_STORAGE", "")
+		t.Setenv("ARTIFACT_STORAGE_PATH", t.TempDir())
+		storage, err := NewStorageFromEnv()
+		require.NoError(t, err)
+		assert.IsType(t, &FilesystemStorage{}, storage)
+	})
+
+	t.Run("unknown storage -> error", func(t *testing.T) {
+		t.Setenv("ARTIFACT_STORAGE", "ftp")
+		_, err := NewStorageFromEnv()
+		assert.ErrorContains(t, err, "unknown artifact storage")
+	})
+}
+
+func Test__FilesystemStorage(t *testing.T) {
+	storage, err := NewFilesystemStorage(t.TempDir())
+	require.NoError(t, err)
+
+	t.Run("put, get and delete", func(t *testing.T) {
+		require.NoError(t, storage.Put(context.Background(), "canvas/execution/reports/junit.xml", "application/xml", []byte("<testsuites/>")))
+
+		content, err := storage.Get(context.Background(), "canvas/execution/reports/junit.xml")
+		require.NoError(t, err)
+		assert.Equal(t, "<testsuites/>", string(content))
+
+		require.NoError(t, storage.Delete(context.Background(), "canvas/execution/reports/junit.xml"))
+		_, err = storage.Get(context.Background(), "canvas/execution/reports/junit.xml")
+		assert.ErrorIs(t, err, ErrArtifactNotFound)
+	})
+
+	t.Run("open streams the content", func(t *testing.T) {
+		require.NoError(t, storage.Put(context.Background(), "canvas/execution/log.txt", "text/plain", []byte("line")))
+
+		reader, err := storage.Open(context.Background(), "canvas/execution/log.txt")
+		require.NoError(t, err)
+		defer reader.Close()
+
+		content, err := io.ReadAll(reader)
+		require.NoError(t, err)
+		assert.Equal(t, "line", string(content))
+
+		_, err = storage.Open(context.Background(), "does/not/exist")
+		assert.ErrorIs(t, err, ErrArtifactNotFound)
+	})
+
+	t.Run("put replaces existing content", func(t *testing.T) {
+		require.NoError(t, storage.Put(context.Background(), "a/b/c.txt", "text/plain", []byte("first")))
+		require.NoError(t, storage.Put(context.Background(), "a/b/c.txt", "text/plain", []byte("second")))
+
+		content, err := storage.Get(context.Background(), "a/b/c.txt")
+		require.NoError(t, err)
+		assert.Equal(t, "second", string(content))
+	})
+
+	t.Run("deleting missing artifact is not an error", func(t *testing.T) {
+		require.NoError(t, storage.Delete(context.Background(), "does/not/exist"))
+	})
+
+	t.Run("keys cannot escape the root directory", func(t *testing.T) {
+		err := storage.Put(context.Background(), "../outside.txt", "text/plain", []byte("nope"))
+		require.ErrorContains(t, err, "invalid artifact key")
+	})
+}
+
+func Test__S3Storage(t *testing.T) {
+	var mutex sync.Mutex
+	objects := map[string][]byte{}
+	contentTypes := map[string]string{}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !strings.HasPrefix(r.Header.Get("Authorization"), "AWS4-HMAC-SHA256 Credential=access-key/") {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+
+		if r.Header.Get("X-Amz-Content-Sha256") == "" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		mutex.Lock()
+		defer mutex.Unlock()
+
+		switch r.Method {
+		case http.MethodPut:
+			body, _ := io.ReadAll(r.Body)
+			objects[r.URL.Path] = body
+			contentTypes[r.URL.Path] = r.Header.Get("Content-Type")
+		case http.MethodGet:
+			body, ok := objects[r.URL.Path]
+			if !ok {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			_, _ = w.Write(body)
+		case http.MethodDelete:
+			delete(objects, r.URL.Path)
+			w.WriteHeader(http.StatusNoContent)
+		}
+	}))
+
+	defer server.Close()
+
+	storage, err := NewS3Storage(S3Config{
+		Bucket:          "artifacts",
+		Region:          "us-east-1",
+		Prefix:          "superplane",
+		Endpoint:        server.URL,
+		UsePathStyle:    true,
+		AccessKeyID:     "access-key",
+		SecretAccessKey: "secret-key",
+	})
+
+	require.NoError(t, err)
+
+	require.NoError(t, storage.Put(context.Background(), "canvas/execution/plan.txt", "text/plain", []byte("plan")))
+	assert.Equal(t, []byte("plan"), objects["/artifacts/superplane/canvas/execution/plan.txt"])
+	assert.Equal(t, "text/plain", contentTypes["/artifacts/superplane/canvas/execution/plan.txt"])
+
+	content, err := storage.Get(context.Background(), "canvas/execution/plan.txt")
+	require.NoError(t, err)
+	assert.Equal(t, "plan", string(content))
+
+	reader, err := storage.Open(context.Background(), "canvas/execution/plan.txt")
+	require.NoError(t, err)
+	content, err = io.ReadAll(reader)
+	require.NoError(t, err)
+	require.NoError(t, reader.Close())
+	assert.Equal(t, "plan", string(content))
+
+	require.NoError(t, storage.Delete(context.Background(), "canvas/execution/plan.txt"))
+	_, err = storage.Get(context.Background(), "canvas/execution/plan.txt")
+	assert.ErrorIs(t, err, ErrArtifactNotFound)
+	_, err = storage.Open(context.Background(), "canvas/execution/plan.txt")
+	assert.ErrorIs(t, err, ErrArtifactNotFound)
+}
+
+func Test__S3Storage_Credentials(t *testing.T) {
+	t.Run("no access keys -> default credential chain", func(t *testing.T) {
+		t.Setenv("AWS_ACCESS_KEY_ID", "env-access-key")
+		t.Setenv("AWS_SECRET_ACCESS_KEY", "env-secret-key")
+
+		storage, err := NewS3Storage(S3Config{Bucket: "artifacts", Region: "eu-west-1"})
+		require.NoError(t, err)
+
+		credentials, err := storage.credentials.Retrieve(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, "env-access-key", credentials.AccessKeyID)
+	})
+
+	t.Run("only one access key -> error", func(t *testing.T) {
+		_, err := NewS3Storage(S3Config{Bucket: "artifacts", Region: "eu-west-1", AccessKeyID: "access-key"})
+		require.ErrorContains(t, err, "must be set together")
+	})
+}
+
+func Test__S3Storage_ObjectURL(t *testing.T) {
+	storage, err := NewS3Storage(S3Config{
+		Bucket:          "artifacts",
+		Region:          "eu-west-1",
+		AccessKeyID:     "access-key",
+		SecretAccessKey: "secret-key",
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, "https://artifacts.s3.eu-west-1.amazonaws.com/a/b%20c.txt", storage.objectURL("a/b c.txt"))
+
+	_, err = NewS3Storage(S3Config{Region: "eu-west-1"})
+	require.ErrorContains(t, err, "ARTIFACT_S3_BUCKET is required")
+}
@@ -23,6 +23,7 @@ import (
 	"github.com/superplanehq/superplane/pkg/database"
 	"github.com/superplanehq/superplane/pkg/jwt"
 	"github.com/superplanehq/superplane/pkg/models"
+	"github.com/superplanehq/superplane/pkg/twofactor"
 	"github.com/superplanehq/superplane/pkg/utils"
 	"gorm.io/gorm"
 )
@@ -37,6 +38,7 @@ type Handler struct {
 	templateDir          string
 	blockSignup          bool
 	passwordLoginEnabled bool
+	ssoBaseURL           string
 }
 
 type ProviderConfig struct {
@@ -90,9 +92,12 @@ func (a *Handler) RegisterRoutes(router *mux.Router) {
 	router.HandleFunc("/auth/config", a.handleAuthConfig).Methods("GET")
 	if a.passwordLoginEnabled {
 		router.HandleFunc("/login", a.handlePasswordLogin).Methods("POST")
+		router.HandleFunc("/login/two-factor", a.handleTwoFactorLogin).Methods("POST")
 		router.HandleFunc("/signup", a.handlePasswordSignup).Methods("POST")
 	}
 
+	a.registerSSORoutes(router)
+
 	//
 	// If we are running the application locally,
 	// we provide handlers that auto-autenticate to
@@ -355,6 +360,19 @@ func (a *Handler) handlePasswordLogin(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	// Ask for the second factor, if the account has one
+	twoFactorEnabled, err := twofactor.IsEnabled(account.ID)
+	if err != nil {
+		log.Errorf("Error checking two-factor authentication for %s: %v", account.Email, err)
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+
+	if twoFactorEnabled {
+		a.requestTwoFactor(w, r, account)
+		return
+	}
+
 	// Accept pending invitations
 	err = a.acceptPendingInvitations(account)
 	if err != nil {
@@ -364,7 +382,7 @@ func (a *Handler) handlePasswordLogin(w http.ResponseWriter, r *http.Request) {
 	}
 
 	// Generate JWT token
-	token, err := a.jwtSigner.Generate(account.ID.String(), 24*time.Hour)
+	token, err := a.jwtSigner.GenerateWithClaims(account.ID.String(), 24*time.Hour, SessionClaims(AuthMethodPassword))
 	if err != nil {
 		log.Errorf("Failed to generate token for password login: %v", err)
 		http.Error(w, "Internal server error", http.StatusInternalServerError)
@@ -372,15 +390,7 @@ func (a *Handler) handlePasswordLogin(w http.ResponseWriter, r *http.Request) {
 	}
 
 	// Set cookie
-	http.SetCookie(w, &http.Cookie{
-		Name:     "account_token",
-		Value:    token,
-		Path:     "/",
-		MaxAge:   int(24 * time.Hour.Seconds()),
-		HttpOnly: true,
-		Secure:   r.TLS != nil,
-		SameSite: http.SameSiteLaxMode,
-	})
+	SetAccountCookie(w, r, token)
 
 	// Redirect
 	redirectURL := getRedirectURL(r)
@@ -469,21 +479,13 @@ func (a *Handler) handlePasswordSignup(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	token, err := a.jwtSigner.Generate(account.ID.String(), 24*time.Hour)
+	token, err := a.jwtSigner.GenerateWithClaims(account.ID.String(), 24*time.Hour, SessionClaims(AuthMethodPassword))
 	if err != nil {
 		http.Error(w, "Internal server error", http.StatusInternalServerError)
 		return
 	}
 
-	http.SetCookie(w, &http.Cookie{
-		Name:     "account_token",
-		Value:    token,
-		Path:     "/",
-		MaxAge:   int(24 * time.Hour.Seconds()),
-		HttpOnly: true,
-		Secure:   r.TLS != nil,
-		SameSite: http.SameSiteLaxMode,
-	})
+	SetAccountCookie(w, r, token)
 
 	redirectURL := getRedirectURL(r)
 	http.Redirect(w, r, redirectURL, http.StatusSeeOther)
@@ -0,0 +1,572 @@
+package authentication
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/markbates/goth"
+	log "github.com/sirupsen/logrus"
+	"github.com/superplanehq/superplane/pkg/database"
+	"github.com/superplanehq/superplane/pkg/models"
+	"github.com/superplanehq/superplane/pkg/sso"
+	"gorm.io/gorm"
+)
+
+const (
+	//
+	// Account tokens issued through an organization's identity provider
+	// carry the organization ID in this claim. Those sessions only grant
+	// access to that organization, since its identity provider is only
+	// trusted to assert identities for its own members.
+	//
+	SSOOrganizationClaim = "sso_org"
+
+	//
+	// Short-lived tokens used to carry login state through
+	// the identity provider are marked with this purpose,
+	// so they are never accepted as account tokens.
+	//
+	TokenPurposeClaim = "purpose"
+	ssoStatePurpose   = "sso_state"
+	ssoStateDuration  = 10 * time.Minute
+	ssoNonceCookie    = "sso_nonce"
+)
+
+var ErrSSOAccountNotLinked = errors.New("an account with this email exists and is not linked to the identity provider")
+
+//
+// InitializeSSO enables per-organization SAML and OIDC logins.
+// The base URL is used to build the ACS and callback URLs
+// registered with the organization's identity provider.
+//
+
+func (a *Handler) InitializeSSO(baseURL string) {
+	a.ssoBaseURL = baseURL
+}
+
+func (a *Handler) registerSSORoutes(router *mux.Router) {
+	if a.ssoBaseURL == "" {
+		return
+	}
+
+	router.HandleFunc("/auth/sso/{organization_id}", a.handleSSOLogin).Methods("GET")
+	router.HandleFunc("/auth/sso/{organization_id}/saml/metadata", a.handleSAMLMetadata).Methods("GET")
+	router.HandleFunc("/auth/sso/{organization_id}/saml/acs", a.handleSAMLACS).Methods("POST")
+	router.HandleFunc("/auth/sso/{organization_id}/oidc/callback", a.handleOIDCCallback).Methods("GET")
+}
+
+func (a *Handler) handleSSOLogin(w http.ResponseWriter, r *http.Request) {
+	config, err := findEnabledSSOConfig(mux.Vars(r)["organization_id"])
+	if err != nil {
+		http.Error(w, "Single sign-on is not configured", http.StatusNotFound)
+		return
+	}
+
+	//
+	// A member signed in some other way links their account
+	// to the identity provider by starting an SSO login.
+	// The identity provider is never trusted to pick the account itself.
+	//
+	state := ssoState{
+		RedirectURL:   getRedirectURL(r),
+		LinkAccountID: a.sessionAccountID(r),
+	}
+
+	switch config.Protocol {
+	case models.SSOProtocolSAML:
+		a.beginSAMLLogin(w, r, config, state)
+	case models.SSOProtocolOIDC:
+		a.beginOIDCLogin(w, r, config, state)
+	default:
+		http.Error(w, "Single sign-on is not configured", http.StatusNotFound)
+	}
+}
+
+func (a *Handler) beginSAMLLogin(w http.ResponseWriter, r *http.Request, config *models.OrganizationSSOConfig, state ssoState) {
+	sp, err := sso.NewSAMLServiceProvider(config, a.ssoBaseURL)
+	if err != nil {
+		log.Errorf("Error building SAML service provider for organization %s: %v", config.OrganizationID, err)
+		http.Error(w, "Single sign-on is misconfigured", http.StatusInternalServerError)
+		return
+	}
+
+	request, err := sp.NewAuthRequest()
+	if err != nil {
+		log.Errorf("Error creating SAML request for organization %s: %v", config.OrganizationID, err)
+		http.Error(w, "Single sign-on is misconfigured", http.StatusInternalServerError)
+		return
+	}
+
+	//
+	// The identity provider posts the response back cross-site,
+	// so cookies are not available on the ACS endpoint.
+	// The request ID and redirect travel in a signed RelayState instead.
+	//
+	state.Nonce = request.ID
+	relayState, err := a.newSSOState(config, state)
+	if err != nil {
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+
+	location, err := request.RedirectURL(relayState)
+	if err != nil {
+		log.Errorf("Error creating SAML redirect for organization %s: %v", config.OrganizationID, err)
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+
+	http.Redirect(w, r, location, http.StatusFound)
+}
+
+func (a *Handler) beginOIDCLogin(w http.ResponseWriter, r *http.Request, config *models.OrganizationSSOConfig, state ssoState) {
+	rp, err := a.newOIDCRelyingParty(r, config)
+	if err != nil {
+		log.Errorf("Error building OIDC relying party for organization %s: %v", config.OrganizationID, err)
+		http.Error(w, "Single sign-on is misconfigured", http.StatusInternalServerError)
+		return
+	}
+
+	nonce, err := randomSSONonce()
+	if err != nil {
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+
+	state.Nonce = nonce
+	signedState, err := a.newSSOState(config, state)
+	if err != nil {
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+
+	//
+	// The callback is a top-level GET navigation, so a lax cookie
+	// is sent along with it. Requiring it to match the state
+	// binds the login to the browser that started it.
+	//
+	http.SetCookie(w, &http.Cookie{
+		Name:     ssoNonceCookie,
+		Value:    nonce,
+		Path:     "/auth/sso/",
+		MaxAge:   int(ssoStateDuration.Seconds()),
+		HttpOnly: true,
+		Secure:   r.TLS != nil,
+		SameSite: http.SameSiteLaxMode,
+	})
+
+	http.Redirect(w, r, rp.AuthURL(signedState, nonce), http.StatusFound)
+}
+
+func (a *Handler) handleSAMLMetadata(w http.ResponseWriter, r *http.Request) {
+	config, err := findEnabledSSOConfig(mux.Vars(r)["organization_id"])
+	if err != nil || config.Protocol != models.SSOProtocolSAML {
+		http.Error(w, "Not found", http.StatusNotFound)
+		return
+	}
+
+	sp, err := sso.NewSAMLServiceProvider(config, a.ssoBaseURL)
+	if err != nil {
+		http.Error(w, "Single sign-on is misconfigured", http.StatusInternalServerError)
+		return
+	}
+
+	metadata, err := sp.Metadata()
+	if err != nil {
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/samlmetadata+xml")
+	_, _ = w.Write(metadata)
+}
+
+func (a *Handler) handleSAMLACS(w http.ResponseWriter, r *http.Request) {
+	config, err := findEnabledSSOConfig(mux.Vars(r)["organization_id"])
+	if err != nil || config.Protocol != models.SSOProtocolSAML {
+		http.Error(w, "Single sign-on is not configured", http.StatusNotFound)
+		return
+	}
+
+	if err := r.ParseForm(); err != nil {
+		http.Error(w, "Invalid form data", http.StatusBadRequest)
+		return
+	}
+
+	state, err := a.validateSSOState(config, r.PostForm.Get("RelayState"))
+	if err != nil {
+		log.Warnf("Invalid SAML relay state for organization %s: %v", config.OrganizationID, err)
+		http.Error(w, "Authentication failed", http.StatusUnauthorized)
+		return
+	}
+
+	sp, err := sso.NewSAMLServiceProvider(config, a.ssoBaseURL)
+	if err != nil {
+		http.Error(w, "Single sign-on is misconfigured", http.StatusInternalServerError)
+		return
+	}
+
+	identity, err := sp.ParseResponse(r.PostForm.Get("SAMLResponse"), state.Nonce)
+	if err != nil {
+		log.Warnf("Rejected SAML response for organization %s: %v", config.OrganizationID, err)
+		http.Error(w, "Authentication failed", http.StatusUnauthorized)
+		return
+	}
+
+	a.completeSSOLogin(w, r, config, identity, state)
+}
+
+func (a *Handler) handleOIDCCallback(w http.ResponseWriter, r *http.Request) {
+	config, err := findEnabledSSOConfig(mux.Vars(r)["organization_id"])
+	if err != nil || config.Protocol != models.SSOProtocolOIDC {
+		http.Error(w, "Single sign-on is not configured", http.StatusNotFound)
+		return
+	}
+
+	query := r.URL.Query()
+	if providerError := query.Get("error"); providerError != "" {
+		log.Warnf("OIDC login for organization %s failed at the provider: %s", config.OrganizationID, providerError)
+		http.Error(w, "Authentication failed", http.StatusUnauthorized)
+		return
+	}
+
+	state, err := a.validateSSOState(config, query.Get("state"))
+	if err != nil {
+		log.Warnf("Invalid OIDC state for organization %s: %v", config.OrganizationID, err)
+		http.Error(w, "Authentication failed", http.StatusUnauthorized)
+		return
+	}
+
+	cookie, err := r.Cookie(ssoNonceCookie)
+	if err != nil || cookie.Value != state.Nonce {
+		http.Error(w, "Authentication failed", http.StatusUnauthorized)
+		return
+	}
+
+	http.SetCookie(w, &http.Cookie{
+		Name:     ssoNonceCookie,
+		Value:    "",
+		Path:     "/auth/sso/",
+		MaxAge:   -1,
+		HttpOnly: true,
+		Secure:   r.TLS != nil,
+		SameSite: http.SameSiteLaxMode,
+	})
+
+	rp, err := a.newOIDCRelyingParty(r, config)
+	if err != nil {
+		log.Errorf("Error building OIDC relying party for organization %s: %v", config.OrganizationID, err)
+		http.Error(w, "Single sign-on is misconfigured", http.StatusInternalServerError)
+		return
+	}
+
+	identity, err := rp.Exchange(r.Context(), query.Get("code"), state.Nonce)
+	if err != nil {
+		log.Warnf("Rejected OIDC login for organization %s: %v", config.OrganizationID, err)
+		http.Error(w, "Authentication failed", http.StatusUnauthorized)
+		return
+	}
+
+	a.completeSSOLogin(w, r, config, identity, state)
+}
+
+func (a *Handler) completeSSOLogin(w http.ResponseWriter, r *http.Request, config *models.OrganizationSSOConfig, identity *sso.Identity, state ssoState) {
+	account, err := a.ProvisionSSOIdentity(config, identity, state.LinkAccountID)
+	if errors.Is(err, ErrSSOAccountNotLinked) {
+		log.Warnf("Rejected SSO login of %s for organization %s: account exists and is not linked", identity.Email, config.OrganizationID)
+		http.Error(w, "An account with this email already exists. Sign in to it first, then sign in with single sign-on to link it.", http.StatusConflict)
+		return
+	}
+
+	if err != nil {
+		log.Errorf("Error provisioning SSO user %s for organization %s: %v", identity.Email, config.OrganizationID, err)
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+
+	token, err := a.jwtSigner.GenerateWithClaims(account.ID.String(), 24*time.Hour, map[string]any{
+		SSOOrganizationClaim: config.OrganizationID.String(),
+	})
+
+	if err != nil {
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+
+	http.SetCookie(w, &http.Cookie{
+		Name:     "account_token",
+		Value:    token,
+		Path:     "/",
+		MaxAge:   int(24 * time.Hour.Seconds()),
+		HttpOnly: true,
+		Secure:   r.TLS != nil,
+		SameSite: http.SameSiteLaxMode,
+	})
+
+	http.Redirect(w, r, state.RedirectURL, http.StatusSeeOther)
+}
+
+//
+// ProvisionSSOIdentity links the identity to an account and makes sure
+// the account is a member of the organization (just-in-time provisioning),
+// with the role and groups its identity provider groups map to.
+//
+// Accounts are global, but an identity provider is configured by the admins
+// of one organization, so the email it asserts is not proof of owning an account.
+// An identity is only linked to an existing account that has it linked already,
+// or that the user proved to own by being signed in to it (linkAccountID).
+// Otherwise, only new accounts are created.
+//
+
+func (a *Handler) ProvisionSSOIdentity(config *models.OrganizationSSOConfig, identity *sso.Identity, linkAccountID string) (*models.Account, error) {
+	providerName := config.AccountProviderName()
+
+	account, err := models.FindAccountByProvider(providerName, identity.Subject)
+	if err != nil {
+		if !errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, err
+		}
+
+		account, err = models.FindAccountByEmail(identity.Email)
+		switch {
+		case err == nil:
+			if account.ID.String() != linkAccountID {
+				return nil, ErrSSOAccountNotLinked
+			}
+
+		case errors.Is(err, gorm.ErrRecordNotFound):
+			account, err = models.CreateAccount(identity.Name, identity.Email)
+			if err != nil {
+				return nil, err
+			}
+
+		default:
+			return nil, err
+		}
+	}
+
+	err = updateAccountProviders(a.encryptor, account, goth.User{
+		Provider: providerName,
+		UserID:   identity.Subject,
+		Email:    account.Email,
+		Name:     identity.Name,
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to link account provider: %w", err)
+	}
+
+	user, created, err := a.findOrCreateSSOUser(config, account)
+	if err != nil {
+		return nil, err
+	}
+
+	a.syncSSOAccess(config, user, created, sso.ResolveAccess(config.GroupMappings, identity.Groups))
+	return account, nil
+}
+
+func (a *Handler) findOrCreateSSOUser(config *models.OrganizationSSOConfig, account *models.Account) (*models.User, bool, error) {
+	orgID := config.OrganizationID.String()
+
+	user, err := models.FindMaybeDeletedUserByEmail(orgID, account.Email)
+	if err == nil && !user.DeletedAt.Valid {
+		return user, false, nil
+	}
+
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, false, err
+	}
+
+	err = database.Conn().Transaction(func(tx *gorm.DB) error {
+		if user != nil && user.DeletedAt.Valid {
+			return user.RestoreInTransaction(tx)
+		}
+
+		var txErr error
+		user, txErr = models.CreateUserInTransaction(tx, config.OrganizationID, account.ID, account.Email, account.Name)
+		return txErr
+	})
+
+	if err != nil {
+		return nil, false, err
+	}
+
+	return user, true, nil
+}
+
+//
+// Role and group updates are best effort: a mapping pointing to a group
+// or role that no longer exists is logged, but does not block the login.
+// The owner role is never assigned or taken away through SSO.
+//
+
+func (a *Handler) syncSSOAccess(config *models.OrganizationSSOConfig, user *models.User, created bool, access *sso.Access) {
+	orgID := config.OrganizationID.String()
+	userID := user.ID.String()
+
+	role := access.Role
+	if role == "" && created {
+		role = config.DefaultRole
+		if role == "" {
+			role = models.RoleOrgViewer
+		}
+	}
+
+	if role != "" && role != models.RoleOrgOwner && !a.isOrganizationOwner(userID, orgID) {
+		if err := a.authService.AssignRole(userID, role, orgID, models.DomainTypeOrganization); err != nil {
+			log.Warnf("Failed to assign SSO role %s to user %s in organization %s: %v", role, userID, orgID, err)
+
+			if created {
+				_ = a.authService.AssignRole(userID, models.RoleOrgViewer, orgID, models.DomainTypeOrganization)
+			}
+		}
+	}
+
+	for _, group := range access.ManagedGroups {
+		members, err := a.authService.GetGroupUsers(orgID, models.DomainTypeOrganization, group)
+		if err != nil {
+			log.Warnf("Failed to list members of SSO group %s in organization %s: %v", group, orgID, err)
+			continue
+		}
+
+		isMember := slices.Contains(members, userID)
+		shouldBeMember := slices.Contains(access.Groups, group)
+
+		switch {
+		case shouldBeMember && !isMember:
+			err = a.authService.AddUserToGroup(orgID, models.DomainTypeOrganization, userID, group)
+		case !shouldBeMember && isMember:
+			err = a.authService.RemoveUserFromGroup(orgID, models.DomainTypeOrganization, userID, group)
+		}
+
+		if err != nil {
+			log.Warnf("Failed to sync SSO group %s for user %s in organization %s: %v", group, userID, orgID, err)
+		}
+	}
+}
+
+func (a *Handler) isOrganizationOwner(userID, orgID string) bool {
+	roles, err := a.authService.GetUserRolesForOrg(userID, orgID)
+	if err != nil {
+		return false
+	}
+
+	for _, role := range roles {
+		if role.Name == models.RoleOrgOwner {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (a *Handler) newOIDCRelyingParty(r *http.Request, config *models.OrganizationSSOConfig) (*sso.OIDCRelyingParty, error) {
+	clientSecret, err := a.encryptor.Decrypt(r.Context(), config.OIDCClientSecret, []byte(config.OrganizationID.String()))
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt client secret: %v", err)
+	}
+
+	return sso.NewOIDCRelyingParty(r.Context(), config, string(clientSecret), a.ssoBaseURL)
+}
+
+//
+// ssoState is the login state carried through the identity provider.
+// The nonce is the OIDC nonce, or the SAML request ID.
+//
+
+type ssoState struct {
+	Nonce         string
+	RedirectURL   string
+	LinkAccountID string
+}
+
+func (a *Handler) newSSOState(config *models.OrganizationSSOConfig, state ssoState) (string, error) {
+	return a.jwtSigner.GenerateWithClaims(config.OrganizationID.String(), ssoStateDuration, map[string]any{
+		TokenPurposeClaim: ssoStatePurpose,
+		"nonce":           state.Nonce,
+		"redirect":        state.RedirectURL,
+		"link_account":    state.LinkAccountID,
+	})
+}
+
+func (a *Handler) validateSSOState(config *models.OrganizationSSOConfig, signedState string) (ssoState, error) {
+	claims, err := a.jwtSigner.ValidateAndGetClaims(signedState)
+	if err != nil {
+		return ssoState{}, err
+	}
+
+	if claims["sub"] != config.OrganizationID.String() || claims[TokenPurposeClaim] != ssoStatePurpose {
+		return ssoState{}, fmt.Errorf("state was not issued for this organization")
+	}
+
+	state := ssoState{}
+	state.Nonce, _ = claims["nonce"].(string)
+	if state.Nonce == "" {
+		return ssoState{}, fmt.Errorf("state has no nonce")
+	}
+
+	state.RedirectURL, _ = claims["redirect"].(string)
+	if !isValidRedirectURL(state.RedirectURL) {
+		state.RedirectURL = "/"
+	}
+
+	state.LinkAccountID, _ = claims["link_account"].(string)
+	return state, nil
+}
+
+//
+// sessionAccountID returns the account of a regular session,
+// or an empty string. SSO sessions don't count, since they were
+// started by an identity provider in the first place.
+//
+
+func (a *Handler) sessionAccountID(r *http.Request) string {
+	cookie, err := r.Cookie("account_token")
+	if err != nil {
+		return ""
+	}
+
+	claims, err := a.jwtSigner.ValidateAndGetClaims(cookie.Value)
+	if err != nil {
+		return ""
+	}
+
+	if _, ok := claims[TokenPurposeClaim]; ok {
+		return ""
+	}
+
+	if _, ok := claims[SSOOrganizationClaim]; ok {
+		return ""
+	}
+
+	accountID, _ := claims["sub"].(string)
+	return accountID
+}
+
+func findEnabledSSOConfig(organizationID string) (*models.OrganizationSSOConfig, error) {
+	config, err := models.FindOrganizationSSOConfig(organizationID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !config.Enabled {
+		return nil, gorm.ErrRecordNotFound
+	}
+
+	return config, nil
+}
+
+func randomSSONonce() (string, error) {
+	buf := make([]byte, 32)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(buf), nil
+}
@@ -0,0 +1,231 @@
+package authentication
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/models"
+	"github.com/superplanehq/superplane/pkg/sso"
+	"github.com/superplanehq/superplane/test/support"
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+)
+
+func Test__ProvisionSSOIdentity(t *testing.T) {
+	handler, r := setupAuthHandler(t, false)
+	orgID := r.Organization.ID.String()
+
+	require.NoError(t, r.AuthService.CreateGroup(orgID, models.DomainTypeOrganization, "engineers", models.RoleOrgViewer, "Engineers", ""))
+
+	config := &models.OrganizationSSOConfig{
+		OrganizationID: r.Organization.ID,
+		Protocol:       models.SSOProtocolOIDC,
+		Enabled:        true,
+		DefaultRole:    models.RoleOrgViewer,
+		GroupMappings: datatypes.JSONSlice[models.SSOGroupMapping]{
+			{IdPGroup: "engineering", Group: "engineers"},
+			{IdPGroup: "admins", Role: models.RoleOrgAdmin},
+		},
+	}
+
+	roleOf := func(t *testing.T, userID string) string {
+		roles, err := r.AuthService.GetUserRolesForOrg(userID, orgID)
+		require.NoError(t, err)
+		require.NotEmpty(t, roles)
+		return roles[0].Name
+	}
+
+	t.Run("new identity -> account and user created with default role", func(t *testing.T) {
+		account, err := handler.ProvisionSSOIdentity(config, &sso.Identity{
+			Subject: "sso-1",
+			Email:   "sso-1@example.com",
+			Name:    "SSO One",
+		}, "")
+		require.NoError(t, err)
+		assert.Equal(t, "sso-1@example.com", account.Email)
+
+		user, err := models.FindActiveUserByEmail(orgID, account.Email)
+		require.NoError(t, err)
+		assert.Equal(t, models.RoleOrgViewer, roleOf(t, user.ID.String()))
+
+		provider, err := models.FindAccountByProvider(config.AccountProviderName(), "sso-1")
+		require.NoError(t, err)
+		assert.Equal(t, account.ID, provider.ID)
+	})
+
+	t.Run("group mappings -> role and groups synced on every login", func(t *testing.T) {
+		identity := &sso.Identity{
+			Subject: "sso-2",
+			Email:   "sso-2@example.com",
+			Name:    "SSO Two",
+			Groups:  []string{"engineering", "admins"},
+		}
+
+		account, err := handler.ProvisionSSOIdentity(config, identity, "")
+		require.NoError(t, err)
+
+		user, err := models.FindActiveUserByEmail(orgID, account.Email)
+		require.NoError(t, err)
+		assert.Equal(t, models.RoleOrgAdmin, roleOf(t, user.ID.String()))
+
+		members, err := r.AuthService.GetGroupUsers(orgID, models.DomainTypeOrganization, "engineers")
+		require.NoError(t, err)
+		assert.Contains(t, members, user.ID.String())
+
+		identity.Groups = []string{}
+		_, err = handler.ProvisionSSOIdentity(config, identity, "")
+		require.NoError(t, err)
+
+		members, err = r.AuthService.GetGroupUsers(orgID, models.DomainTypeOrganization, "engineers")
+		require.NoError(t, err)
+		assert.NotContains(t, members, user.ID.String())
+	})
+
+	t.Run("existing account signed in -> linked and role untouched", func(t *testing.T) {
+		account, err := handler.ProvisionSSOIdentity(config, &sso.Identity{
+			Subject: "sso-owner",
+			Email:   r.Account.Email,
+			Name:    r.Account.Name,
+			Groups:  []string{"admins"},
+		}, r.Account.ID.String())
+		require.NoError(t, err)
+		assert.Equal(t, r.Account.ID, account.ID)
+		assert.Equal(t, models.RoleOrgOwner, roleOf(t, r.User.String()))
+
+		//
+		// Once linked, later logins don't need a session.
+		//
+		account, err = handler.ProvisionSSOIdentity(config, &sso.Identity{
+			Subject: "sso-owner",
+			Email:   r.Account.Email,
+			Name:    r.Account.Name,
+		}, "")
+		require.NoError(t, err)
+		assert.Equal(t, r.Account.ID, account.ID)
+	})
+
+	t.Run("existing account of another organization -> not linked by email", func(t *testing.T) {
+		victim, err := models.CreateAccount("Victim", "victim@example.com")
+		require.NoError(t, err)
+
+		otherOrganization, err := models.CreateOrganization("other-org", "")
+		require.NoError(t, err)
+		_, err = models.CreateUser(otherOrganization.ID, victim.ID, victim.Email, victim.Name)
+		require.NoError(t, err)
+
+		identity := &sso.Identity{Subject: "attacker", Email: victim.Email, Name: "Attacker"}
+
+		_, err = handler.ProvisionSSOIdentity(config, identity, "")
+		require.ErrorIs(t, err, ErrSSOAccountNotLinked)
+
+		_, err = handler.ProvisionSSOIdentity(config, identity, r.Account.ID.String())
+		require.ErrorIs(t, err, ErrSSOAccountNotLinked)
+
+		_, err = models.FindAccountByProvider(config.AccountProviderName(), "attacker")
+		assert.ErrorIs(t, err, gorm.ErrRecordNotFound)
+
+		_, err = models.FindActiveUserByEmail(orgID, victim.Email)
+		assert.ErrorIs(t, err, gorm.ErrRecordNotFound)
+	})
+}
+
+func Test__SAMLLogin(t *testing.T) {
+	handler, r := setupAuthHandler(t, false)
+
+	router := mux.NewRouter()
+	server := httptest.NewServer(router)
+	t.Cleanup(server.Close)
+
+	handler.InitializeSSO(server.URL)
+	handler.RegisterRoutes(router)
+
+	idp := support.NewTestIdentityProvider(t)
+	idp.SetUser(support.TestIdPUser{
+		Subject: "00u1",
+		Email:   "saml-user@example.com",
+		Name:    "SAML User",
+	})
+
+	metadata := idp.SAMLMetadata()
+	require.NoError(t, models.UpsertOrganizationSSOConfig(&models.OrganizationSSOConfig{
+		OrganizationID:  r.Organization.ID,
+		Protocol:        models.SSOProtocolSAML,
+		Enabled:         true,
+		SAMLIdPMetadata: &metadata,
+		EmailAttribute:  models.SSODefaultEmailAttribute,
+		NameAttribute:   models.SSODefaultNameAttribute,
+		GroupsAttribute: models.SSODefaultGroupsAttribute,
+		DefaultRole:     models.RoleOrgViewer,
+	}))
+
+	client := &http.Client{
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+
+	orgID := r.Organization.ID.String()
+	resp, err := client.Get(server.URL + sso.SAMLMetadataPath(orgID))
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	spMetadata, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+
+	require.NoError(t, idp.RegisterServiceProvider(spMetadata))
+
+	login := func(t *testing.T) (string, string) {
+		resp, err := client.Get(server.URL + sso.LoginPath(orgID) + "?redirect=%2Fcanvases")
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		require.Equal(t, http.StatusFound, resp.StatusCode)
+
+		samlResponse, relayState, err := idp.SAMLLogin(resp.Header.Get("Location"))
+		require.NoError(t, err)
+		return samlResponse, relayState
+	}
+
+	t.Run("valid response -> session cookie and redirect", func(t *testing.T) {
+		samlResponse, relayState := login(t)
+
+		resp, err := client.PostForm(server.URL+sso.SAMLACSPath(orgID), url.Values{
+			"SAMLResponse": {samlResponse},
+			"RelayState":   {relayState},
+		})
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		require.Equal(t, http.StatusSeeOther, resp.StatusCode)
+		assert.Equal(t, "/canvases", resp.Header.Get("Location"))
+
+		var token string
+		for _, cookie := range resp.Cookies() {
+			if cookie.Name == "account_token" {
+				token = cookie.Value
+			}
+		}
+
+		require.NotEmpty(t, token)
+		_, err = models.FindActiveUserByEmail(orgID, "saml-user@example.com")
+		require.NoError(t, err)
+	})
+
+	t.Run("tampered relay state -> unauthorized", func(t *testing.T) {
+		samlResponse, _ := login(t)
+
+		resp, err := client.PostForm(server.URL+sso.SAMLACSPath(orgID), url.Values{
+			"SAMLResponse": {samlResponse},
+			"RelayState":   {"not-a-valid-state"},
+		})
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+	})
+}
@@ -0,0 +1,183 @@
+package authentication
+
+import (
+	"errors"
+	"net/http"
+	"slices"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/superplanehq/superplane/pkg/models"
+	"github.com/superplanehq/superplane/pkg/twofactor"
+)
+
+const (
+	//
+	// Account tokens list the methods used to authenticate (RFC 8176),
+	// so organizations requiring two-factor authentication can tell
+	// password sessions that went through a second factor apart
+	// from the ones that did not.
+	//
+	AuthMethodsClaim   = "amr"
+	AuthMethodPassword = "pwd"
+	AuthMethodOTP      = "otp"
+
+	//
+	// After a valid password, accounts with two-factor authentication
+	// get a short-lived token proving the first factor,
+	// exchanged for an account token once the code is verified.
+	//
+	TwoFactorRequiredHeader = "X-Two-Factor-Required"
+	twoFactorPurpose        = "two_factor"
+	twoFactorCookie         = "two_factor_token"
+	twoFactorDuration       = 5 * time.Minute
+)
+
+func SessionClaims(methods ...string) map[string]any {
+	return map[string]any{AuthMethodsClaim: methods}
+}
+
+func SessionAuthMethods(claims map[string]any) []string {
+	values, _ := claims[AuthMethodsClaim].([]any)
+
+	methods := make([]string, 0, len(values))
+	for _, value := range values {
+		if method, ok := value.(string); ok {
+			methods = append(methods, method)
+		}
+	}
+
+	return methods
+}
+
+//
+// A password session is one started with a password,
+// and it only counts as two-factor if a one-time password
+// or a recovery code was also verified.
+//
+
+func IsPasswordSession(claims map[string]any) bool {
+	return slices.Contains(SessionAuthMethods(claims), AuthMethodPassword)
+}
+
+func IsTwoFactorSession(claims map[string]any) bool {
+	return slices.Contains(SessionAuthMethods(claims), AuthMethodOTP)
+}
+
+func SetAccountCookie(w http.ResponseWriter, r *http.Request, token string) {
+	http.SetCookie(w, &http.Cookie{
+		Name:     "account_token",
+		Value:    token,
+		Path:     "/",
+		MaxAge:   int(24 * time.Hour.Seconds()),
+		HttpOnly: true,
+		Secure:   r.TLS != nil,
+		SameSite: http.SameSiteLaxMode,
+	})
+}
+
+func (a *Handler) requestTwoFactor(w http.ResponseWriter, r *http.Request, account *models.Account) {
+	token, err := a.jwtSigner.GenerateWithClaims(account.ID.String(), twoFactorDuration, map[string]any{
+		TokenPurposeClaim: twoFactorPurpose,
+	})
+
+	if err != nil {
+		log.Errorf("Failed to generate two-factor token for %s: %v", account.Email, err)
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+
+	http.SetCookie(w, &http.Cookie{
+		Name:     twoFactorCookie,
+		Value:    token,
+		Path:     "/login",
+		MaxAge:   int(twoFactorDuration.Seconds()),
+		HttpOnly: true,
+		Secure:   r.TLS != nil,
+		SameSite: http.SameSiteStrictMode,
+	})
+
+	w.Header().Set(TwoFactorRequiredHeader, "true")
+	http.Error(w, "Two-factor authentication required", http.StatusUnauthorized)
+}
+
+func (a *Handler) handleTwoFactorLogin(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseForm(); err != nil {
+		http.Error(w, "Invalid form data", http.StatusBadRequest)
+		return
+	}
+
+	code := r.FormValue("code")
+	if code == "" {
+		http.Error(w, "Verification code is required", http.StatusBadRequest)
+		return
+	}
+
+	account, err := a.findTwoFactorAccount(r)
+	if err != nil {
+		http.Error(w, "Two-factor login expired, sign in again", http.StatusUnauthorized)
+		return
+	}
+
+	_, err = twofactor.Verify(r.Context(), a.encryptor, account, code, r.RemoteAddr)
+	if err != nil {
+		switch {
+		case errors.Is(err, twofactor.ErrInvalidCode):
+			http.Error(w, "Invalid verification code", http.StatusUnauthorized)
+		case errors.Is(err, twofactor.ErrTooManyAttempts):
+			http.Error(w, "Too many failed attempts, try again later", http.StatusTooManyRequests)
+		case errors.Is(err, twofactor.ErrNotEnabled):
+			http.Error(w, "Two-factor login expired, sign in again", http.StatusUnauthorized)
+		default:
+			log.Errorf("Error verifying two-factor code for %s: %v", account.Email, err)
+			http.Error(w, "Internal server error", http.StatusInternalServerError)
+		}
+
+		return
+	}
+
+	if err := a.acceptPendingInvitations(account); err != nil {
+		log.Errorf("Error accepting pending invitations for %s: %v", account.Email, err)
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+
+	token, err := a.jwtSigner.GenerateWithClaims(account.ID.String(), 24*time.Hour, SessionClaims(AuthMethodPassword, AuthMethodOTP))
+	if err != nil {
+		log.Errorf("Failed to generate token for two-factor login: %v", err)
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+
+	http.SetCookie(w, &http.Cookie{
+		Name:     twoFactorCookie,
+		Value:    "",
+		Path:     "/login",
+		MaxAge:   -1,
+		HttpOnly: true,
+		Secure:   r.TLS != nil,
+		SameSite: http.SameSiteStrictMode,
+	})
+
+	SetAccountCookie(w, r, token)
+	http.Redirect(w, r, getRedirectURL(r), http.StatusSeeOther)
+}
+
+func (a *Handler) findTwoFactorAccount(r *http.Request) (*models.Account, error) {
+	cookie, err := r.Cookie(twoFactorCookie)
+	if err != nil {
+		return nil, err
+	}
+
+	claims, err := a.jwtSigner.ValidateAndGetClaims(cookie.Value)
+	if err != nil {
+		return nil, err
+	}
+
+	if claims[TokenPurposeClaim] != twoFactorPurpose {
+		return nil, errors.New("token is not a two-factor token")
+	}
+
+	accountID, _ := claims["sub"].(string)
+	return models.FindAccountByID(accountID)
+}
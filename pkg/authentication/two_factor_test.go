@@ -0,0 +1,135 @@
+package authentication
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/crypto"
+	"github.com/superplanehq/superplane/pkg/jwt"
+	"github.com/superplanehq/superplane/pkg/models"
+	"github.com/superplanehq/superplane/pkg/totp"
+	"github.com/superplanehq/superplane/pkg/twofactor"
+	"github.com/superplanehq/superplane/test/support"
+)
+
+func Test__TwoFactorLogin(t *testing.T) {
+	r := support.Setup(t)
+	signer := jwt.NewSigner("test-secret")
+	handler := NewHandler(signer, r.Encryptor, r.AuthService, "test", "/templates", false, true)
+
+	router := mux.NewRouter()
+	handler.RegisterRoutes(router)
+
+	account, err := models.CreateAccount("Alice", "alice@example.com")
+	require.NoError(t, err)
+
+	passwordHash, err := crypto.HashPassword("password")
+	require.NoError(t, err)
+	_, err = models.CreateAccountPasswordAuth(account.ID, passwordHash)
+	require.NoError(t, err)
+
+	post := func(path string, form url.Values, cookies ...*http.Cookie) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, path, strings.NewReader(form.Encode()))
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		for _, cookie := range cookies {
+			req.AddCookie(cookie)
+		}
+
+		res := httptest.NewRecorder()
+		router.ServeHTTP(res, req)
+		return res
+	}
+
+	findCookie := func(res *httptest.ResponseRecorder, name string) *http.Cookie {
+		for _, cookie := range res.Result().Cookies() {
+			if cookie.Name == name {
+				return cookie
+			}
+		}
+
+		return nil
+	}
+
+	login := url.Values{"email": {account.Email}, "password": {"password"}}
+
+	t.Run("two-factor not enabled -> password session", func(t *testing.T) {
+		res := post("/login", login)
+		require.Equal(t, http.StatusSeeOther, res.Code)
+
+		cookie := findCookie(res, "account_token")
+		require.NotNil(t, cookie)
+
+		claims, err := signer.ValidateAndGetClaims(cookie.Value)
+		require.NoError(t, err)
+		assert.True(t, IsPasswordSession(claims))
+		assert.False(t, IsTwoFactorSession(claims))
+	})
+
+	enrollment, err := twofactor.Begin(context.Background(), r.Encryptor, account)
+	require.NoError(t, err)
+	code, err := totp.GenerateCode(enrollment.Secret, totp.Step(time.Now().Add(-totp.Period)))
+	require.NoError(t, err)
+	recoveryCodes, err := twofactor.Enable(context.Background(), r.Encryptor, account, code, "")
+	require.NoError(t, err)
+
+	var challenge *http.Cookie
+
+	t.Run("two-factor enabled -> code requested, no session", func(t *testing.T) {
+		res := post("/login", login)
+		require.Equal(t, http.StatusUnauthorized, res.Code)
+		assert.Equal(t, "true", res.Header().Get(TwoFactorRequiredHeader))
+		assert.Nil(t, findCookie(res, "account_token"))
+
+		challenge = findCookie(res, twoFactorCookie)
+		require.NotNil(t, challenge)
+	})
+
+	t.Run("challenge token is not an account token", func(t *testing.T) {
+		claims, err := signer.ValidateAndGetClaims(challenge.Value)
+		require.NoError(t, err)
+		assert.Equal(t, twoFactorPurpose, claims[TokenPurposeClaim])
+	})
+
+	t.Run("no challenge -> unauthorized", func(t *testing.T) {
+		res := post("/login/two-factor", url.Values{"code": {recoveryCodes[0]}})
+		assert.Equal(t, http.StatusUnauthorized, res.Code)
+	})
+
+	t.Run("wrong code -> unauthorized", func(t *testing.T) {
+		res := post("/login/two-factor", url.Values{"code": {"000000-0000"}}, challenge)
+		assert.Equal(t, http.StatusUnauthorized, res.Code)
+		assert.Nil(t, findCookie(res, "account_token"))
+	})
+
+	t.Run("valid code -> two-factor session", func(t *testing.T) {
+		code, err := totp.GenerateCode(enrollment.Secret, totp.Step(time.Now()))
+		require.NoError(t, err)
+
+		res := post("/login/two-factor?redirect=%2Fcanvases", url.Values{"code": {code}}, challenge)
+		require.Equal(t, http.StatusSeeOther, res.Code)
+		assert.Equal(t, "/canvases", res.Header().Get("Location"))
+
+		cookie := findCookie(res, "account_token")
+		require.NotNil(t, cookie)
+
+		claims, err := signer.ValidateAndGetClaims(cookie.Value)
+		require.NoError(t, err)
+		assert.Equal(t, account.ID.String(), claims["sub"])
+		assert.True(t, IsPasswordSession(claims))
+		assert.True(t, IsTwoFactorSession(claims))
+	})
+
+	t.Run("recovery code -> two-factor session", func(t *testing.T) {
+		res := post("/login/two-factor", url.Values{"code": {recoveryCodes[0]}}, challenge)
+		require.Equal(t, http.StatusSeeOther, res.Code)
+		require.NotNil(t, findCookie(res, "account_token"))
+	})
+}
@@ -12,6 +12,7 @@ import (
 	pbRoles "github.com/superplanehq/superplane/pkg/protos/roles"
 	pbSecrets "github.com/superplanehq/superplane/pkg/protos/secrets"
 	pbServiceAccounts "github.com/superplanehq/superplane/pkg/protos/service_accounts"
+	pbSubscriptions "github.com/superplanehq/superplane/pkg/protos/subscriptions"
 	pbUsers "github.com/superplanehq/superplane/pkg/protos/users"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
@@ -29,6 +30,12 @@ type AuthorizationRule struct {
 	Resource   string
 	Action     string
 	DomainType string
+
+	//
+	// Optional, finer-grained permission that API tokens
+	// can be scoped to, instead of the full resource:action.
+	//
+	Scope string
 }
 
 type AuthorizationInterceptor struct {
@@ -37,7 +44,14 @@ type AuthorizationInterceptor struct {
 }
 
 func NewAuthorizationInterceptor(authService Authorization) *AuthorizationInterceptor {
-	rules := map[string]AuthorizationRule{
+	return &AuthorizationInterceptor{
+		authService: authService,
+		rules:       defaultRules(),
+	}
+}
+
+func defaultRules() map[string]AuthorizationRule {
+	return map[string]AuthorizationRule{
 		// Secrets rules
 		pbSecrets.Secrets_CreateSecret_FullMethodName:     {Resource: "secrets", Action: "create", DomainType: models.DomainTypeOrganization},
 		pbSecrets.Secrets_UpdateSecret_FullMethodName:     {Resource: "secrets", Action: "update", DomainType: models.DomainTypeOrganization},
@@ -84,6 +98,16 @@ func NewAuthorizationInterceptor(authService Authorization) *AuthorizationInterc
 		pbOrganization.Organizations_UpdateAgentSettings_FullMethodName:      {Resource: "org", Action: "update", DomainType: models.DomainTypeOrganization},
 		pbOrganization.Organizations_SetAgentOpenAIKey_FullMethodName:        {Resource: "org", Action: "update", DomainType: models.DomainTypeOrganization},
 		pbOrganization.Organizations_DeleteAgentOpenAIKey_FullMethodName:     {Resource: "org", Action: "update", DomainType: models.DomainTypeOrganization},
+		pbOrganization.Organizations_GetSSOConfig_FullMethodName:             {Resource: "org", Action: "read", DomainType: models.DomainTypeOrganization},
+		pbOrganization.Organizations_UpdateSSOConfig_FullMethodName:          {Resource: "org", Action: "update", DomainType: models.DomainTypeOrganization},
+		pbOrganization.Organizations_DeleteSSOConfig_FullMethodName:          {Resource: "org", Action: "update", DomainType: models.DomainTypeOrganization},
+		pbOrganization.Organizations_GetSCIMConfig_FullMethodName:            {Resource: "org", Action: "read", DomainType: models.DomainTypeOrganization},
+		pbOrganization.Organizations_ResetSCIMToken_FullMethodName:           {Resource: "org", Action: "update", DomainType: models.DomainTypeOrganization},
+		pbOrganization.Organizations_DeleteSCIMToken_FullMethodName:          {Resource: "org", Action: "update", DomainType: models.DomainTypeOrganization},
+		pbOrganization.Organizations_GetTwoFactorPolicy_FullMethodName:       {Resource: "org", Action: "read", DomainType: models.DomainTypeOrganization},
+		pbOrganization.Organizations_UpdateTwoFactorPolicy_FullMethodName:    {Resource: "org", Action: "update", DomainType: models.DomainTypeOrganization},
+		pbOrganization.Organizations_ResetUserTwoFactor_FullMethodName:       {Resource: "members", Action: "update", DomainType: models.DomainTypeOrganization},
+		pbOrganization.Organizations_ListTwoFactorEvents_FullMethodName:      {Resource: "members", Action: "update", DomainType: models.DomainTypeOrganization},
 		pbOrganization.Organizations_RemoveUser_FullMethodName:               {Resource: "members", Action: "delete", DomainType: models.DomainTypeOrganization},
 		pbOrganization.Organizations_DeleteOrganization_FullMethodName:       {Resource: "org", Action: "delete", DomainType: models.DomainTypeOrganization},
 		pbOrganization.Organizations_CreateIntegration_FullMethodName:        {Resource: "integrations", Action: "create", DomainType: models.DomainTypeOrganization},
@@ -93,6 +117,14 @@ func NewAuthorizationInterceptor(authService Authorization) *AuthorizationInterc
 		pbOrganization.Organizations_DescribeIntegration_FullMethodName:      {Resource: "integrations", Action: "read", DomainType: models.DomainTypeOrganization},
 		pbOrganization.Organizations_ListIntegrationResources_FullMethodName: {Resource: "integrations", Action: "read", DomainType: models.DomainTypeOrganization},
 
+		// Event subscriptions rules
+		pbSubscriptions.Subscriptions_CreateSubscription_FullMethodName:         {Resource: "org", Action: "update", DomainType: models.DomainTypeOrganization},
+		pbSubscriptions.Subscriptions_ListSubscriptions_FullMethodName:          {Resource: "org", Action: "read", DomainType: models.DomainTypeOrganization},
+		pbSubscriptions.Subscriptions_DescribeSubscription_FullMethodName:       {Resource: "org", Action: "read", DomainType: models.DomainTypeOrganization},
+		pbSubscriptions.Subscriptions_UpdateSubscription_FullMethodName:         {Resource: "org", Action: "update", DomainType: models.DomainTypeOrganization},
+		pbSubscriptions.Subscriptions_DeleteSubscription_FullMethodName:         {Resource: "org", Action: "update", DomainType: models.DomainTypeOrganization},
+		pbSubscriptions.Subscriptions_ListSubscriptionDeliveries_FullMethodName: {Resource: "org", Action: "read", DomainType: models.DomainTypeOrganization},
+
 		// Blueprints rules
 		pbBlueprints.Blueprints_ListBlueprints_FullMethodName:    {Resource: "blueprints", Action: "read", DomainType: models.DomainTypeOrganization},
 		pbBlueprints.Blueprints_DescribeBlueprint_FullMethodName: {Resource: "blueprints", Action: "read", DomainType: models.DomainTypeOrganization},
@@ -115,12 +147,22 @@ func NewAuthorizationInterceptor(authService Authorization) *AuthorizationInterc
 		pbCanvases.Canvases_ListChildExecutions_FullMethodName:       {Resource: "canvases", Action: "read", DomainType: models.DomainTypeOrganization},
 		pbCanvases.Canvases_ListCanvasMemories_FullMethodName:        {Resource: "canvases", Action: "read", DomainType: models.DomainTypeOrganization},
 		pbCanvases.Canvases_DeleteCanvasMemory_FullMethodName:        {Resource: "canvases", Action: "update", DomainType: models.DomainTypeOrganization},
+		pbCanvases.Canvases_GetExecutionLogs_FullMethodName:          {Resource: "canvases", Action: "read", DomainType: models.DomainTypeOrganization},
+		pbCanvases.Canvases_ListExecutionArtifacts_FullMethodName:    {Resource: "canvases", Action: "read", DomainType: models.DomainTypeOrganization},
+		pbCanvases.Canvases_GetCanvasStats_FullMethodName:            {Resource: "canvases", Action: "read", DomainType: models.DomainTypeOrganization},
+		pbCanvases.Canvases_GetCanvasDoraSettings_FullMethodName:     {Resource: "canvases", Action: "read", DomainType: models.DomainTypeOrganization},
+		pbCanvases.Canvases_UpdateCanvasDoraSettings_FullMethodName:  {Resource: "canvases", Action: "update", DomainType: models.DomainTypeOrganization},
+		pbCanvases.Canvases_GetCanvasDoraMetrics_FullMethodName:      {Resource: "canvases", Action: "read", DomainType: models.DomainTypeOrganization},
+		pbCanvases.Canvases_ExportCanvasDoraMetrics_FullMethodName:   {Resource: "canvases", Action: "read", DomainType: models.DomainTypeOrganization},
+		pbCanvases.Canvases_WatchCanvas_FullMethodName:               {Resource: "canvases", Action: "read", DomainType: models.DomainTypeOrganization},
+		pbCanvases.Canvases_WatchExecution_FullMethodName:            {Resource: "canvases", Action: "read", DomainType: models.DomainTypeOrganization},
+		pbCanvases.Canvases_DownloadExecutionArtifact_FullMethodName: {Resource: "canvases", Action: "read", DomainType: models.DomainTypeOrganization},
 		pbCanvases.Canvases_CancelExecution_FullMethodName:           {Resource: "canvases", Action: "update", DomainType: models.DomainTypeOrganization},
 		pbCanvases.Canvases_ResolveExecutionErrors_FullMethodName:    {Resource: "canvases", Action: "update", DomainType: models.DomainTypeOrganization},
 		pbCanvases.Canvases_InvokeNodeExecutionAction_FullMethodName: {Resource: "canvases", Action: "update", DomainType: models.DomainTypeOrganization},
 		pbCanvases.Canvases_InvokeNodeTriggerAction_FullMethodName:   {Resource: "canvases", Action: "update", DomainType: models.DomainTypeOrganization},
 		pbCanvases.Canvases_ListNodeEvents_FullMethodName:            {Resource: "canvases", Action: "read", DomainType: models.DomainTypeOrganization},
-		pbCanvases.Canvases_EmitNodeEvent_FullMethodName:             {Resource: "canvases", Action: "update", DomainType: models.DomainTypeOrganization},
+		pbCanvases.Canvases_EmitNodeEvent_FullMethodName:             {Resource: "canvases", Action: "update", DomainType: models.DomainTypeOrganization, Scope: ScopeEventsEmit},
 		pbCanvases.Canvases_SendAiMessage_FullMethodName:             {Resource: "canvases", Action: "update", DomainType: models.DomainTypeOrganization},
 
 		// Service Accounts rules
@@ -131,58 +173,113 @@ func NewAuthorizationInterceptor(authService Authorization) *AuthorizationInterc
 		pbServiceAccounts.ServiceAccounts_DeleteServiceAccount_FullMethodName:          {Resource: "service_accounts", Action: "delete", DomainType: models.DomainTypeOrganization},
 		pbServiceAccounts.ServiceAccounts_RegenerateServiceAccountToken_FullMethodName: {Resource: "service_accounts", Action: "update", DomainType: models.DomainTypeOrganization},
 	}
-
-	return &AuthorizationInterceptor{
-		authService: authService,
-		rules:       rules,
-	}
 }
 
 func (a *AuthorizationInterceptor) UnaryInterceptor() grpc.UnaryServerInterceptor {
 	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
-		rule, requiresAuth := a.rules[info.FullMethod]
-		if !requiresAuth {
-			return handler(ctx, req)
+		newContext, err := a.authorize(ctx, info.FullMethod, req)
+		if err != nil {
+			return nil, err
 		}
 
-		md, ok := metadata.FromIncomingContext(ctx)
-		if !ok {
-			log.Errorf("Metadata not found in context")
-			return nil, status.Error(codes.NotFound, "Not found")
-		}
+		return handler(newContext, req)
+	}
+}
 
-		userMeta, ok := md["x-user-id"]
-		if !ok || len(userMeta) == 0 {
-			log.Errorf("User not found in metadata, metadata %v", md)
-			return nil, status.Error(codes.NotFound, "Not found")
-		}
+//
+// StreamInterceptor authorizes server-streaming methods.
+// The request is only known after the first message is received,
+// so the check happens on the first RecvMsg of the handler.
+//
 
-		orgMeta, ok := md["x-organization-id"]
-		if !ok || len(orgMeta) == 0 {
-			log.Errorf("Organization not found in metadata, metadata %v", md)
-			return nil, status.Error(codes.NotFound, "Not found")
+func (a *AuthorizationInterceptor) StreamInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if info.IsClientStream {
+			return status.Error(codes.Unimplemented, "client streaming is not supported")
 		}
 
-		userID := userMeta[0]
-		organizationID := orgMeta[0]
-		org, err := models.FindOrganizationByID(organizationID)
-		if err != nil {
-			return nil, status.Error(codes.NotFound, "organization not found")
-		}
+		return handler(srv, &authorizedStream{ServerStream: stream, interceptor: a, method: info.FullMethod})
+	}
+}
 
-		allowed, err := a.authService.CheckOrganizationPermission(userID, org.ID.String(), rule.Resource, rule.Action)
-		if err != nil {
-			return nil, err
-		}
+type authorizedStream struct {
+	grpc.ServerStream
+	interceptor *AuthorizationInterceptor
+	method      string
+	ctx         context.Context
+}
 
-		if !allowed {
-			log.Warnf("User %s tried to %s %s in organization %s", userID, rule.Action, rule.Resource, org.ID.String())
-			return nil, status.Error(codes.NotFound, "Not found")
-		}
+func (s *authorizedStream) Context() context.Context {
+	if s.ctx != nil {
+		return s.ctx
+	}
 
-		newContext := context.WithValue(ctx, OrganizationContextKey, organizationID)
-		newContext = context.WithValue(newContext, DomainTypeContextKey, models.DomainTypeOrganization)
-		newContext = context.WithValue(newContext, DomainIdContextKey, organizationID)
-		return handler(newContext, req)
+	return s.ServerStream.Context()
+}
+
+func (s *authorizedStream) RecvMsg(m interface{}) error {
+	err := s.ServerStream.RecvMsg(m)
+	if err != nil || s.ctx != nil {
+		return err
+	}
+
+	ctx, err := s.interceptor.authorize(s.ServerStream.Context(), s.method, m)
+	if err != nil {
+		return err
+	}
+
+	s.ctx = ctx
+	return nil
+}
+
+func (a *AuthorizationInterceptor) authorize(ctx context.Context, method string, req interface{}) (context.Context, error) {
+	rule, requiresAuth := a.rules[method]
+	err := checkTokenScopes(ctx, method, rule, requiresAuth, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if !requiresAuth {
+		return ctx, nil
 	}
+
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		log.Errorf("Metadata not found in context")
+		return nil, status.Error(codes.NotFound, "Not found")
+	}
+
+	userMeta, ok := md["x-user-id"]
+	if !ok || len(userMeta) == 0 {
+		log.Errorf("User not found in metadata, metadata %v", md)
+		return nil, status.Error(codes.NotFound, "Not found")
+	}
+
+	orgMeta, ok := md["x-organization-id"]
+	if !ok || len(orgMeta) == 0 {
+		log.Errorf("Organization not found in metadata, metadata %v", md)
+		return nil, status.Error(codes.NotFound, "Not found")
+	}
+
+	userID := userMeta[0]
+	organizationID := orgMeta[0]
+	org, err := models.FindOrganizationByID(organizationID)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, "organization not found")
+	}
+
+	allowed, err := a.authService.CheckOrganizationPermission(userID, org.ID.String(), rule.Resource, rule.Action)
+	if err != nil {
+		return nil, err
+	}
+
+	if !allowed {
+		log.Warnf("User %s tried to %s %s in organization %s", userID, rule.Action, rule.Resource, org.ID.String())
+		return nil, status.Error(codes.NotFound, "Not found")
+	}
+
+	newContext := context.WithValue(ctx, OrganizationContextKey, organizationID)
+	newContext = context.WithValue(newContext, DomainTypeContextKey, models.DomainTypeOrganization)
+	newContext = context.WithValue(newContext, DomainIdContextKey, organizationID)
+	return newContext, nil
 }
@@ -0,0 +1,84 @@
+package authorization
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/models"
+	pbCanvases "github.com/superplanehq/superplane/pkg/protos/canvases"
+)
+
+func Test__ValidateScopes(t *testing.T) {
+	t.Run("valid scopes -> ok", func(t *testing.T) {
+		require.NoError(t, ValidateScopes([]models.APITokenScope{
+			{Permission: "*"},
+			{Permission: "canvases:read"},
+			{Permission: "secrets:*"},
+			{Permission: "events:emit", Canvases: []string{"canvas-1"}},
+		}))
+	})
+
+	t.Run("no scopes -> error", func(t *testing.T) {
+		require.ErrorContains(t, ValidateScopes(nil), "at least one scope is required")
+	})
+
+	t.Run("unknown scope -> error", func(t *testing.T) {
+		err := ValidateScopes([]models.APITokenScope{{Permission: "canvases:destroy"}})
+		require.ErrorContains(t, err, "unknown scope 'canvases:destroy'")
+	})
+
+	t.Run("canvas restriction on non-canvas scope -> error", func(t *testing.T) {
+		err := ValidateScopes([]models.APITokenScope{{Permission: "secrets:read", Canvases: []string{"canvas-1"}}})
+		require.ErrorContains(t, err, "cannot be restricted to canvases")
+	})
+}
+
+func Test__ScopesAllow(t *testing.T) {
+	rules := defaultRules()
+	describeCanvas := rules[pbCanvases.Canvases_DescribeCanvas_FullMethodName]
+	updateCanvas := rules[pbCanvases.Canvases_UpdateCanvas_FullMethodName]
+	emitEvent := rules[pbCanvases.Canvases_EmitNodeEvent_FullMethodName]
+	listCanvases := rules[pbCanvases.Canvases_ListCanvases_FullMethodName]
+
+	t.Run("exact permission", func(t *testing.T) {
+		scopes := []models.APITokenScope{{Permission: "canvases:read"}}
+		assert.True(t, ScopesAllow(scopes, describeCanvas, "canvas-1"))
+		assert.False(t, ScopesAllow(scopes, updateCanvas, "canvas-1"))
+	})
+
+	t.Run("resource wildcard", func(t *testing.T) {
+		scopes := []models.APITokenScope{{Permission: "canvases:*"}}
+		assert.True(t, ScopesAllow(scopes, describeCanvas, "canvas-1"))
+		assert.True(t, ScopesAllow(scopes, updateCanvas, "canvas-1"))
+	})
+
+	t.Run("finer-grained scope only covers its own rule", func(t *testing.T) {
+		scopes := []models.APITokenScope{{Permission: ScopeEventsEmit}}
+		assert.True(t, ScopesAllow(scopes, emitEvent, "canvas-1"))
+		assert.False(t, ScopesAllow(scopes, updateCanvas, "canvas-1"))
+	})
+
+	t.Run("broader permission covers finer-grained rules", func(t *testing.T) {
+		scopes := []models.APITokenScope{{Permission: "canvases:update"}}
+		assert.True(t, ScopesAllow(scopes, emitEvent, "canvas-1"))
+	})
+
+	t.Run("scopes restricted to canvases", func(t *testing.T) {
+		scopes := []models.APITokenScope{{Permission: ScopeEventsEmit, Canvases: []string{"canvas-1"}}}
+		assert.True(t, ScopesAllow(scopes, emitEvent, "canvas-1"))
+		assert.False(t, ScopesAllow(scopes, emitEvent, "canvas-2"))
+		assert.False(t, ScopesAllow(scopes, emitEvent, ""))
+	})
+
+	t.Run("requests without a canvas are not covered by restricted scopes", func(t *testing.T) {
+		scopes := []models.APITokenScope{{Permission: "canvases:read", Canvases: []string{"canvas-1"}}}
+		assert.False(t, ScopesAllow(scopes, listCanvases, ""))
+	})
+}
+
+func Test__CanvasIDFromRequest(t *testing.T) {
+	assert.Equal(t, "canvas-1", canvasIDFromRequest(&pbCanvases.DescribeCanvasRequest{Id: "canvas-1"}))
+	assert.Equal(t, "canvas-1", canvasIDFromRequest(&pbCanvases.EmitNodeEventRequest{CanvasId: "canvas-1"}))
+	assert.Equal(t, "", canvasIDFromRequest(&pbCanvases.ListCanvasesRequest{}))
+}
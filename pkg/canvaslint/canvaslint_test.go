@@ -0,0 +1,100 @@
+package canvaslint
+
+import (
+	"os"
+	"testing"
+
+	"github.com/ghodss/yaml"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/openapi_client"
+)
+
+func loadSpec(t *testing.T, path string) openapi_client.CanvasesCanvasSpec {
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+
+	spec := openapi_client.CanvasesCanvasSpec{}
+	require.NoError(t, yaml.Unmarshal(data, &spec))
+	return spec
+}
+
+func Test__DefaultCatalog(t *testing.T) {
+	catalog, err := DefaultCatalog()
+	require.NoError(t, err)
+	require.Contains(t, catalog.Components, "if")
+	assert.Equal(t, []string{"true", "false"}, catalog.Components["if"].OutputChannels)
+	assert.Contains(t, catalog.Triggers, "start")
+	assert.Contains(t, catalog.Components, "slack.sendTextMessage")
+}
+
+func Test__Lint(t *testing.T) {
+	catalog, err := DefaultCatalog()
+	require.NoError(t, err)
+
+	problems := Lint(loadSpec(t, "testdata/broken.yaml"), catalog)
+	messages := []string{}
+	for _, problem := range problems {
+		messages = append(messages, problem.String())
+	}
+
+	assert.ElementsMatch(t, []string{
+		`error: node Is main (check): configuration.expression: invalid expression "$['Start'].data.ref ==": unexpected token EOF (1:22)`,
+		`error: node Call (call): field 'method': must be one of: GET, POST, PUT, DELETE, PATCH`,
+		`warning: node Notify (notify): no integration selected, the node won't run until one is`,
+		`error: node Notify (notify): configuration.text: invalid expression "$['Call'].data.status )": unexpected token Bracket(")") (1:24)`,
+		`warning: node Mystery (mystery): unknown component "mystery", unless a plugin or integration of the organization provides it`,
+		`error: edge 1: Is main (check) has no output channel "approved", expected one of: true, false`,
+		`error: edge 3: the error channel of Call (call) is not enabled`,
+		`error: the canvas has a cycle, through: A (a), B (b)`,
+		`warning: node Mystery (mystery): unreachable, no trigger leads to this node`,
+		`warning: node A (a): unreachable, no trigger leads to this node`,
+		`warning: node B (b): unreachable, no trigger leads to this node`,
+	}, messages)
+}
+
+func Test__LintValidCanvas(t *testing.T) {
+	catalog, err := DefaultCatalog()
+	require.NoError(t, err)
+
+	spec := loadSpec(t, "testdata/broken.yaml")
+	spec.Nodes = spec.Nodes[:3]
+	spec.Nodes[1].Configuration["expression"] = "$['Start'].data.ref == 'refs/heads/main'"
+	spec.Nodes[2].Configuration["method"] = "GET"
+	spec.Edges = []openapi_client.ComponentsEdge{
+		{SourceId: openapi_client.PtrString("start"), TargetId: openapi_client.PtrString("check")},
+		{SourceId: openapi_client.PtrString("check"), TargetId: openapi_client.PtrString("call"), Channel: openapi_client.PtrString("true")},
+	}
+
+	assert.Empty(t, Lint(spec, catalog))
+}
+
+func Test__Schema(t *testing.T) {
+	catalog, err := DefaultCatalog()
+	require.NoError(t, err)
+
+	schema := Schema(catalog)
+	defs := schema["$defs"].(map[string]any)
+
+	t.Run("node types come from the API enum", func(t *testing.T) {
+		node := defs["ComponentsNode"].(map[string]any)
+		properties := node["properties"].(map[string]any)
+		assert.Equal(t, []string{"TYPE_COMPONENT", "TYPE_BLUEPRINT", "TYPE_TRIGGER", "TYPE_WIDGET"}, properties["type"].(map[string]any)["enum"])
+		assert.NotContains(t, properties, "errorMessage")
+	})
+
+	t.Run("component configurations -> fields with options and required", func(t *testing.T) {
+		http := defs["component.http"].(map[string]any)
+		assert.Contains(t, http["required"], "url")
+
+		method := http["properties"].(map[string]any)["method"].(map[string]any)
+		assert.Equal(t, []string{"GET", "POST", "PUT", "DELETE", "PATCH"}, method["enum"])
+		assert.Equal(t, "POST", method["default"])
+	})
+
+	t.Run("component names are listed for completion", func(t *testing.T) {
+		ref := defs["NodeComponentRef"].(map[string]any)
+		names := ref["properties"].(map[string]any)["name"].(map[string]any)["enum"]
+		assert.Contains(t, names, "slack.sendTextMessage")
+	})
+}
@@ -0,0 +1,124 @@
+// Package canvaslint checks canvas files without a server,
+// and generates the JSON Schema of the canvas YAML format.
+//
+// Components, triggers and widgets are described by a catalog
+// generated from the registry, so the CLI doesn't need to include
+// every integration to know their configuration fields.
+package canvaslint
+
+import (
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/superplanehq/superplane/pkg/configuration"
+	"github.com/superplanehq/superplane/pkg/core"
+)
+
+//go:embed catalog.json
+var catalogJSON []byte
+
+type Catalog struct {
+	Components map[string]*Entry `json:"components"`
+	Triggers   map[string]*Entry `json:"triggers"`
+	Widgets    map[string]*Entry `json:"widgets"`
+}
+
+type Entry struct {
+	Name          string                `json:"name"`
+	Label         string                `json:"label"`
+	Description   string                `json:"description,omitempty"`
+	Configuration []configuration.Field `json:"configuration"`
+
+	//
+	// Output channels of components. Components with dynamic channels
+	// are described with the ones they use for an empty configuration.
+	//
+	OutputChannels []string `json:"outputChannels,omitempty"`
+}
+
+//
+// DefaultCatalog is the catalog built into the CLI,
+// generated with make gen.canvas.schema.
+//
+
+func DefaultCatalog() (*Catalog, error) {
+	catalog := Catalog{}
+	err := json.Unmarshal(catalogJSON, &catalog)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load component catalog: %w", err)
+	}
+
+	return &catalog, nil
+}
+
+func NewCatalog(components []core.Component, triggers []core.Trigger, widgets []core.Widget) *Catalog {
+	catalog := &Catalog{
+		Components: map[string]*Entry{},
+		Triggers:   map[string]*Entry{},
+		Widgets:    map[string]*Entry{},
+	}
+
+	for _, component := range components {
+		catalog.Components[component.Name()] = &Entry{
+			Name:           component.Name(),
+			Label:          component.Label(),
+			Description:    component.Description(),
+			Configuration:  component.Configuration(),
+			OutputChannels: outputChannels(component),
+		}
+	}
+
+	for _, trigger := range triggers {
+		catalog.Triggers[trigger.Name()] = &Entry{
+			Name:          trigger.Name(),
+			Label:         trigger.Label(),
+			Description:   trigger.Description(),
+			Configuration: trigger.Configuration(),
+		}
+	}
+
+	for _, widget := range widgets {
+		catalog.Widgets[widget.Name()] = &Entry{
+			Name:          widget.Name(),
+			Label:         widget.Label(),
+			Description:   widget.Description(),
+			Configuration: widget.Configuration(),
+		}
+	}
+
+	return catalog
+}
+
+func outputChannels(component core.Component) (channels []string) {
+	defer func() {
+		if recover() != nil {
+			channels = []string{core.DefaultOutputChannel.Name}
+		}
+	}()
+
+	for _, channel := range component.OutputChannels(map[string]any{}) {
+		channels = append(channels, channel.Name)
+	}
+
+	if len(channels) == 0 {
+		return []string{core.DefaultOutputChannel.Name}
+	}
+
+	return channels
+}
+
+func (c *Catalog) Marshal() ([]byte, error) {
+	return json.MarshalIndent(c, "", "  ")
+}
+
+func sortedNames(entries map[string]*Entry) []string {
+	names := make([]string, 0, len(entries))
+	for name := range entries {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+	return names
+}
@@ -0,0 +1,432 @@
+package canvaslint
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/expr-lang/expr/parser"
+	"github.com/superplanehq/superplane/pkg/configuration"
+	"github.com/superplanehq/superplane/pkg/core"
+	"github.com/superplanehq/superplane/pkg/openapi_client"
+)
+
+const (
+	SeverityError   = "error"
+	SeverityWarning = "warning"
+)
+
+//
+// Problem is something wrong in a canvas.
+// Errors are rejected by the server, or break the canvas when it runs.
+// Warnings are accepted, but are most likely mistakes.
+//
+
+type Problem struct {
+	Severity string `json:"severity"`
+	Node     string `json:"node,omitempty"`
+	Edge     *int   `json:"edge,omitempty"`
+	Message  string `json:"message"`
+}
+
+func (p Problem) String() string {
+	switch {
+	case p.Node != "":
+		return fmt.Sprintf("%s: node %s: %s", p.Severity, p.Node, p.Message)
+	case p.Edge != nil:
+		return fmt.Sprintf("%s: edge %d: %s", p.Severity, *p.Edge, p.Message)
+	default:
+		return fmt.Sprintf("%s: %s", p.Severity, p.Message)
+	}
+}
+
+func (p Problem) IsError() bool {
+	return p.Severity == SeverityError
+}
+
+var expressionRegex = regexp.MustCompile(`(?s)\{\{(.*?)\}\}`)
+
+type linter struct {
+	catalog  *Catalog
+	nodes    map[string]openapi_client.ComponentsNode
+	problems []Problem
+}
+
+//
+// Lint checks the spec of a canvas.
+// Blueprint nodes are only checked for their edges,
+// since blueprints are defined in the organization.
+//
+
+func Lint(spec openapi_client.CanvasesCanvasSpec, catalog *Catalog) []Problem {
+	l := &linter{
+		catalog:  catalog,
+		nodes:    map[string]openapi_client.ComponentsNode{},
+		problems: []Problem{},
+	}
+
+	for i, node := range spec.Nodes {
+		l.checkNode(i, node)
+	}
+
+	for i, edge := range spec.Edges {
+		l.checkEdge(i, edge)
+	}
+
+	l.checkCycles(spec)
+	l.checkReachability(spec)
+	return l.problems
+}
+
+func (l *linter) nodeError(node openapi_client.ComponentsNode, format string, args ...any) {
+	l.problems = append(l.problems, Problem{Severity: SeverityError, Node: describeNode(node), Message: fmt.Sprintf(format, args...)})
+}
+
+func (l *linter) nodeWarning(node openapi_client.ComponentsNode, format string, args ...any) {
+	l.problems = append(l.problems, Problem{Severity: SeverityWarning, Node: describeNode(node), Message: fmt.Sprintf(format, args...)})
+}
+
+func (l *linter) edgeError(index int, format string, args ...any) {
+	l.problems = append(l.problems, Problem{Severity: SeverityError, Edge: &index, Message: fmt.Sprintf(format, args...)})
+}
+
+func describeNode(node openapi_client.ComponentsNode) string {
+	if node.GetName() == "" || node.GetName() == node.GetId() {
+		return node.GetId()
+	}
+
+	return fmt.Sprintf("%s (%s)", node.GetName(), node.GetId())
+}
+
+func (l *linter) checkNode(index int, node openapi_client.ComponentsNode) {
+	if node.GetId() == "" {
+		l.problems = append(l.problems, Problem{Severity: SeverityError, Message: fmt.Sprintf("node %d: id is required", index)})
+		return
+	}
+
+	if _, ok := l.nodes[node.GetId()]; ok {
+		l.nodeError(node, "duplicate node id")
+		return
+	}
+
+	l.nodes[node.GetId()] = node
+	if node.GetName() == "" {
+		l.nodeError(node, "name is required")
+	}
+
+	entry, ok := l.findEntry(node)
+	if !ok {
+		return
+	}
+
+	err := configuration.ValidateConfiguration(entry.Configuration, node.Configuration)
+	if err != nil {
+		l.nodeError(node, "%v", err)
+	}
+
+	l.checkExpressions(node, entry.Configuration, node.Configuration, "configuration")
+}
+
+//
+// findEntry finds what a node uses in the catalog,
+// and reports the node if it's not there.
+//
+
+func (l *linter) findEntry(node openapi_client.ComponentsNode) (*Entry, bool) {
+	var refName string
+	var entries map[string]*Entry
+	var what string
+
+	switch node.GetType() {
+	case openapi_client.COMPONENTSNODETYPE_TYPE_COMPONENT:
+		refName, entries, what = node.Component.GetName(), l.catalog.Components, "component"
+	case openapi_client.COMPONENTSNODETYPE_TYPE_TRIGGER:
+		refName, entries, what = node.Trigger.GetName(), l.catalog.Triggers, "trigger"
+	case openapi_client.COMPONENTSNODETYPE_TYPE_WIDGET:
+		refName, entries, what = node.Widget.GetName(), l.catalog.Widgets, "widget"
+	case openapi_client.COMPONENTSNODETYPE_TYPE_BLUEPRINT:
+		if node.Blueprint.GetId() == "" {
+			l.nodeError(node, "blueprint id is required")
+		}
+
+		return nil, false
+	default:
+		l.nodeError(node, "invalid node type %q", node.GetType())
+		return nil, false
+	}
+
+	if refName == "" {
+		l.nodeError(node, "%s name is required", what)
+		return nil, false
+	}
+
+	entry, ok := entries[refName]
+	if !ok && what == "widget" {
+		l.nodeError(node, "unknown %s %q", what, refName)
+		return nil, false
+	}
+
+	//
+	// Plugins and declarative integrations register components and triggers
+	// when the server starts, so they are not in the catalog,
+	// and their nodes can't be told apart from typos.
+	//
+	if !ok {
+		l.nodeWarning(node, "unknown %s %q, unless a plugin or integration of the organization provides it", what, refName)
+		return nil, false
+	}
+
+	//
+	// Components and triggers of integrations run with an integration of the organization,
+	// which templates and files from other organizations don't have yet.
+	//
+	if what != "widget" && strings.Contains(refName, ".") && node.Integration.GetId() == "" && node.Integration.GetName() == "" {
+		l.nodeWarning(node, "no integration selected, the node won't run until one is")
+	}
+
+	return entry, true
+}
+
+//
+// checkExpressions parses every {{ }} expression in the configuration,
+// and the values of expression fields, which are expressions as a whole.
+//
+
+func (l *linter) checkExpressions(node openapi_client.ComponentsNode, fields []configuration.Field, values map[string]any, path string) {
+	fieldTypes := map[string]string{}
+	for _, field := range fields {
+		fieldTypes[field.Name] = field.Type
+	}
+
+	for _, key := range sortedKeys(values) {
+		fieldPath := path + "." + key
+		text, ok := values[key].(string)
+		if ok && fieldTypes[key] == configuration.FieldTypeExpression && !expressionRegex.MatchString(text) {
+			l.checkExpression(node, fieldPath, text)
+			continue
+		}
+
+		l.checkEmbeddedExpressions(node, fieldPath, values[key])
+	}
+}
+
+func (l *linter) checkEmbeddedExpressions(node openapi_client.ComponentsNode, path string, value any) {
+	switch v := value.(type) {
+	case string:
+		for _, match := range expressionRegex.FindAllStringSubmatch(v, -1) {
+			l.checkExpression(node, path, match[1])
+		}
+
+	case map[string]any:
+		for _, key := range sortedKeys(v) {
+			l.checkEmbeddedExpressions(node, path+"."+key, v[key])
+		}
+
+	case []any:
+		for i, item := range v {
+			l.checkEmbeddedExpressions(node, fmt.Sprintf("%s[%d]", path, i), item)
+		}
+	}
+}
+
+func (l *linter) checkExpression(node openapi_client.ComponentsNode, path, expression string) {
+	if strings.TrimSpace(expression) == "" {
+		l.nodeError(node, "%s: empty expression", path)
+		return
+	}
+
+	_, err := parser.Parse(expression)
+	if err != nil {
+		l.nodeError(node, "%s: invalid expression %q: %s", path, strings.TrimSpace(expression), firstLine(err.Error()))
+	}
+}
+
+func firstLine(text string) string {
+	line, _, _ := strings.Cut(text, "\n")
+	return line
+}
+
+func (l *linter) checkEdge(index int, edge openapi_client.ComponentsEdge) {
+	source, sourceExists := l.nodes[edge.GetSourceId()]
+	target, targetExists := l.nodes[edge.GetTargetId()]
+	if edge.GetSourceId() == "" || edge.GetTargetId() == "" {
+		l.edgeError(index, "sourceId and targetId are required")
+		return
+	}
+
+	if !sourceExists {
+		l.edgeError(index, "source node %s not found", edge.GetSourceId())
+	}
+
+	if !targetExists {
+		l.edgeError(index, "target node %s not found", edge.GetTargetId())
+	}
+
+	if !sourceExists || !targetExists {
+		return
+	}
+
+	if source.GetType() == openapi_client.COMPONENTSNODETYPE_TYPE_WIDGET || target.GetType() == openapi_client.COMPONENTSNODETYPE_TYPE_WIDGET {
+		l.edgeError(index, "widget nodes can't be connected")
+		return
+	}
+
+	channel := edge.GetChannel()
+	if channel == "" {
+		channel = core.DefaultOutputChannel.Name
+	}
+
+	if channel == core.ErrorOutputChannel.Name {
+		if !source.GetErrorChannelEnabled() {
+			l.edgeError(index, "the error channel of %s is not enabled", describeNode(source))
+		}
+
+		return
+	}
+
+	channels := l.outputChannels(source)
+	if channels != nil && !contains(channels, channel) {
+		l.edgeError(index, "%s has no output channel %q, expected one of: %s", describeNode(source), channel, strings.Join(channels, ", "))
+	}
+}
+
+//
+// outputChannels returns nil when the channels of a node are unknown.
+//
+
+func (l *linter) outputChannels(node openapi_client.ComponentsNode) []string {
+	switch node.GetType() {
+	case openapi_client.COMPONENTSNODETYPE_TYPE_TRIGGER:
+		return []string{core.DefaultOutputChannel.Name}
+	case openapi_client.COMPONENTSNODETYPE_TYPE_COMPONENT:
+		entry, ok := l.catalog.Components[node.Component.GetName()]
+		if !ok {
+			return nil
+		}
+
+		return entry.OutputChannels
+	default:
+		return nil
+	}
+}
+
+//
+// checkCycles removes nodes without incoming edges until none is left.
+// The nodes that remain are in a cycle, or after one.
+//
+
+func (l *linter) checkCycles(spec openapi_client.CanvasesCanvasSpec) {
+	inDegree := map[string]int{}
+	graph := map[string][]string{}
+	for id := range l.nodes {
+		inDegree[id] = 0
+	}
+
+	for _, edge := range spec.Edges {
+		_, sourceOK := l.nodes[edge.GetSourceId()]
+		_, targetOK := l.nodes[edge.GetTargetId()]
+		if !sourceOK || !targetOK {
+			continue
+		}
+
+		graph[edge.GetSourceId()] = append(graph[edge.GetSourceId()], edge.GetTargetId())
+		inDegree[edge.GetTargetId()]++
+	}
+
+	queue := []string{}
+	for id, degree := range inDegree {
+		if degree == 0 {
+			queue = append(queue, id)
+		}
+	}
+
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+		delete(inDegree, current)
+		for _, next := range graph[current] {
+			inDegree[next]--
+			if inDegree[next] == 0 {
+				queue = append(queue, next)
+			}
+		}
+	}
+
+	if len(inDegree) == 0 {
+		return
+	}
+
+	remaining := []string{}
+	for _, node := range spec.Nodes {
+		if _, ok := inDegree[node.GetId()]; ok {
+			remaining = append(remaining, describeNode(node))
+		}
+	}
+
+	l.problems = append(l.problems, Problem{
+		Severity: SeverityError,
+		Message:  fmt.Sprintf("the canvas has a cycle, through: %s", strings.Join(remaining, ", ")),
+	})
+}
+
+//
+// checkReachability reports nodes that no trigger leads to,
+// since they never run.
+//
+
+func (l *linter) checkReachability(spec openapi_client.CanvasesCanvasSpec) {
+	graph := map[string][]string{}
+	for _, edge := range spec.Edges {
+		graph[edge.GetSourceId()] = append(graph[edge.GetSourceId()], edge.GetTargetId())
+	}
+
+	reached := map[string]bool{}
+	queue := []string{}
+	for _, node := range spec.Nodes {
+		if node.GetType() == openapi_client.COMPONENTSNODETYPE_TYPE_TRIGGER {
+			queue = append(queue, node.GetId())
+			reached[node.GetId()] = true
+		}
+	}
+
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+		for _, next := range graph[current] {
+			if !reached[next] {
+				reached[next] = true
+				queue = append(queue, next)
+			}
+		}
+	}
+
+	for _, node := range spec.Nodes {
+		switch node.GetType() {
+		case openapi_client.COMPONENTSNODETYPE_TYPE_COMPONENT, openapi_client.COMPONENTSNODETYPE_TYPE_BLUEPRINT:
+			if node.GetId() != "" && !reached[node.GetId()] {
+				l.nodeWarning(node, "unreachable, no trigger leads to this node")
+			}
+		}
+	}
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
+
+func sortedKeys(m map[string]any) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+	return keys
+}
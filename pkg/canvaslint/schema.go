@@ -0,0 +1,371 @@
+package canvaslint
+
+import (
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/superplanehq/superplane/pkg/configuration"
+	"github.com/superplanehq/superplane/pkg/openapi_client"
+)
+
+const SchemaVersion = "https://json-schema.org/draft/2020-12/schema"
+
+//
+// Schema returns the JSON Schema of canvas files.
+//
+// The structure of the spec comes from the API types, and the configuration
+// of each node from the fields of its component, trigger or widget,
+// so editors can complete and check configurations too.
+//
+
+func Schema(catalog *Catalog) map[string]any {
+	generator := &schemaGenerator{defs: map[string]any{}}
+	spec := generator.typeSchema(reflect.TypeOf(openapi_client.CanvasesCanvasSpec{}))
+	metadata := map[string]any{
+		"type": "object",
+		"properties": map[string]any{
+			"name":        map[string]any{"type": "string", "minLength": 1},
+			"description": map[string]any{"type": "string"},
+		},
+		"required": []string{"name"},
+	}
+
+	generator.addRefNames("NodeComponentRef", sortedNames(catalog.Components))
+	generator.addRefNames("NodeTriggerRef", sortedNames(catalog.Triggers))
+	generator.addRefNames("NodeWidgetRef", sortedNames(catalog.Widgets))
+	generator.addConfigurations(catalog)
+
+	return map[string]any{
+		"$schema":     SchemaVersion,
+		"title":       "SuperPlane canvas",
+		"description": "A canvas file, as used by superplane canvases create and update.",
+		"type":        "object",
+		"properties": map[string]any{
+			"apiVersion": map[string]any{"const": "v1"},
+			"kind":       map[string]any{"const": "Canvas"},
+			"metadata":   metadata,
+			"spec":       spec,
+		},
+		"required": []string{"apiVersion", "kind", "metadata", "spec"},
+		"$defs":    generator.defs,
+	}
+}
+
+type schemaGenerator struct {
+	defs map[string]any
+}
+
+var enumValues = map[reflect.Type][]string{
+	reflect.TypeOf(openapi_client.ComponentsNodeType("")): enumStrings(openapi_client.AllowedComponentsNodeTypeEnumValues),
+}
+
+//
+// Fields set by the server, which files don't need.
+//
+
+var serverFields = map[string]bool{
+	"errorMessage":   true,
+	"warningMessage": true,
+	"metadata":       true,
+}
+
+func (g *schemaGenerator) typeSchema(t reflect.Type) map[string]any {
+	if t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+
+	if values, ok := enumValues[t]; ok {
+		return map[string]any{"type": "string", "enum": values}
+	}
+
+	if t == reflect.TypeOf(time.Time{}) {
+		return map[string]any{"type": "string", "format": "date-time"}
+	}
+
+	switch t.Kind() {
+	case reflect.String:
+		return map[string]any{"type": "string"}
+	case reflect.Bool:
+		return map[string]any{"type": "boolean"}
+	case reflect.Int, reflect.Int32, reflect.Int64:
+		return map[string]any{"type": "integer"}
+	case reflect.Float32, reflect.Float64:
+		return map[string]any{"type": "number"}
+	case reflect.Slice:
+		return map[string]any{"type": "array", "items": g.typeSchema(t.Elem())}
+	case reflect.Map:
+		return map[string]any{"type": "object"}
+	case reflect.Struct:
+		return g.structSchema(t)
+	default:
+		return map[string]any{}
+	}
+}
+
+//
+// Structs are defined once in $defs, and referenced from there.
+//
+
+func (g *schemaGenerator) structSchema(t reflect.Type) map[string]any {
+	ref := map[string]any{"$ref": "#/$defs/" + t.Name()}
+	if _, ok := g.defs[t.Name()]; ok {
+		return ref
+	}
+
+	properties := map[string]any{}
+	schema := map[string]any{"type": "object", "properties": properties}
+	g.defs[t.Name()] = schema
+
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		name := strings.Split(field.Tag.Get("json"), ",")[0]
+		if name == "" || name == "-" || !field.IsExported() || serverFields[name] {
+			continue
+		}
+
+		properties[name] = g.typeSchema(field.Type)
+	}
+
+	return ref
+}
+
+func (g *schemaGenerator) addRefNames(def string, names []string) {
+	schema, ok := g.defs[def].(map[string]any)
+	if !ok || len(names) == 0 {
+		return
+	}
+
+	properties := schema["properties"].(map[string]any)
+	properties["name"] = map[string]any{"type": "string", "enum": names}
+	schema["required"] = []string{"name"}
+}
+
+//
+// addConfigurations defines the configuration of every component, trigger and widget,
+// and selects one of them in nodes, depending on the reference of the node.
+//
+
+func (g *schemaGenerator) addConfigurations(catalog *Catalog) {
+	node, ok := g.defs["ComponentsNode"].(map[string]any)
+	if !ok {
+		return
+	}
+
+	node["required"] = []string{"id", "name", "type"}
+	conditions := []any{}
+	refs := []struct {
+		property string
+		entries  map[string]*Entry
+	}{
+		{"component", catalog.Components},
+		{"trigger", catalog.Triggers},
+		{"widget", catalog.Widgets},
+	}
+
+	for _, ref := range refs {
+		for _, name := range sortedNames(ref.entries) {
+			entry := ref.entries[name]
+			def := ref.property + "." + name
+			g.defs[def] = fieldsSchema(entry.Configuration, entry.Label)
+			conditions = append(conditions, map[string]any{
+				"if": map[string]any{
+					"properties": map[string]any{
+						ref.property: map[string]any{
+							"properties": map[string]any{"name": map[string]any{"const": name}},
+							"required":   []string{"name"},
+						},
+					},
+					"required": []string{ref.property},
+				},
+				"then": map[string]any{
+					"properties": map[string]any{
+						"configuration": map[string]any{"$ref": "#/$defs/" + def},
+					},
+				},
+			})
+		}
+	}
+
+	node["allOf"] = conditions
+}
+
+//
+// fieldsSchema mirrors configuration.ValidateConfiguration.
+// Fields required only under some conditions are left optional.
+//
+
+func fieldsSchema(fields []configuration.Field, description string) map[string]any {
+	properties := map[string]any{}
+	required := []string{}
+	for _, field := range fields {
+		properties[field.Name] = fieldSchema(field)
+		if field.Required {
+			required = append(required, field.Name)
+		}
+	}
+
+	schema := map[string]any{"type": "object", "properties": properties}
+	if description != "" {
+		schema["description"] = description
+	}
+
+	if len(required) > 0 {
+		schema["required"] = required
+	}
+
+	return schema
+}
+
+func fieldSchema(field configuration.Field) map[string]any {
+	schema := valueSchema(field)
+	title := field.Label
+	if title == "" {
+		title = field.Name
+	}
+
+	schema["title"] = title
+	if field.Description != "" {
+		schema["description"] = field.Description
+	}
+
+	if field.Default != nil {
+		schema["default"] = field.Default
+	}
+
+	return schema
+}
+
+func valueSchema(field configuration.Field) map[string]any {
+	options := field.TypeOptions
+	if options == nil {
+		options = &configuration.TypeOptions{}
+	}
+
+	switch field.Type {
+	case configuration.FieldTypeNumber:
+		schema := map[string]any{"type": "number"}
+		if options.Number != nil && options.Number.Min != nil {
+			schema["minimum"] = *options.Number.Min
+		}
+
+		if options.Number != nil && options.Number.Max != nil {
+			schema["maximum"] = *options.Number.Max
+		}
+
+		return schema
+
+	case configuration.FieldTypeBool:
+		return map[string]any{"type": "boolean"}
+
+	case configuration.FieldTypeSelect:
+		schema := map[string]any{"type": "string"}
+		if options.Select != nil && len(options.Select.Options) > 0 {
+			schema["enum"] = optionValues(options.Select.Options)
+		}
+
+		return schema
+
+	case configuration.FieldTypeMultiSelect:
+		items := map[string]any{"type": "string"}
+		if options.MultiSelect != nil && len(options.MultiSelect.Options) > 0 {
+			items["enum"] = optionValues(options.MultiSelect.Options)
+		}
+
+		return map[string]any{"type": "array", "items": items}
+
+	case configuration.FieldTypeDaysOfWeek:
+		return map[string]any{
+			"type":     "array",
+			"minItems": 1,
+			"items": map[string]any{
+				"type": "string",
+				"enum": []string{"monday", "tuesday", "wednesday", "thursday", "friday", "saturday", "sunday"},
+			},
+		}
+
+	case configuration.FieldTypeIntegrationResource:
+		if options.Resource != nil && options.Resource.Multi {
+			return map[string]any{"type": "array", "items": map[string]any{"type": "string"}}
+		}
+
+		return map[string]any{"type": "string"}
+
+	case configuration.FieldTypeList:
+		schema := map[string]any{"type": "array"}
+		if field.Required {
+			schema["minItems"] = 1
+		}
+
+		if options.List == nil {
+			return schema
+		}
+
+		if options.List.MaxItems != nil {
+			schema["maxItems"] = *options.List.MaxItems
+		}
+
+		if options.List.ItemDefinition != nil {
+			schema["items"] = valueSchema(configuration.Field{
+				Type:        options.List.ItemDefinition.Type,
+				TypeOptions: &configuration.TypeOptions{Object: &configuration.ObjectTypeOptions{Schema: options.List.ItemDefinition.Schema}},
+			})
+		}
+
+		return schema
+
+	case configuration.FieldTypeObject:
+		if options.Object != nil && len(options.Object.Schema) > 0 {
+			return fieldsSchema(options.Object.Schema, "")
+		}
+
+		return map[string]any{"type": []string{"object", "array", "string"}}
+
+	case configuration.FieldTypeAnyPredicateList, configuration.FieldTypeTimeRange:
+		return map[string]any{}
+
+	default:
+		schema := map[string]any{"type": "string"}
+		minLength, maxLength := stringLimits(field.Type, options)
+		if minLength != nil {
+			schema["minLength"] = *minLength
+		}
+
+		if maxLength != nil {
+			schema["maxLength"] = *maxLength
+		}
+
+		return schema
+	}
+}
+
+func stringLimits(fieldType string, options *configuration.TypeOptions) (*int, *int) {
+	switch {
+	case fieldType == configuration.FieldTypeString && options.String != nil:
+		return options.String.MinLength, options.String.MaxLength
+	case fieldType == configuration.FieldTypeText && options.Text != nil:
+		return options.Text.MinLength, options.Text.MaxLength
+	case fieldType == configuration.FieldTypeExpression && options.Expression != nil:
+		return options.Expression.MinLength, options.Expression.MaxLength
+	default:
+		return nil, nil
+	}
+}
+
+func optionValues(options []configuration.FieldOption) []string {
+	values := make([]string, len(options))
+	for i, option := range options {
+		values[i] = option.Value
+	}
+
+	return values
+}
+
+func enumStrings[T ~string](values []T) []string {
+	result := make([]string, len(values))
+	for i, value := range values {
+		result[i] = string(value)
+	}
+
+	return result
+}
@@ -0,0 +1,262 @@
+// Package canvastest runs test suites for canvases without a server.
+//
+// A suite points to a canvas file, and each of its tests emits a root event
+// from a trigger, and checks what happens downstream: which nodes run,
+// their configuration after expressions are resolved, and what they emit.
+//
+// Nodes run in an in-process engine, following the edges and channels
+// of the canvas. Built-in components without side effects, like filter, if and merge,
+// run for real. Every other node, including all integration components,
+// must have a mocked output, so tests never reach external services.
+package canvastest
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/ghodss/yaml"
+)
+
+const (
+	SuiteKind      = "CanvasTest"
+	DefaultChannel = "default"
+)
+
+type Suite struct {
+	APIVersion string        `json:"apiVersion"`
+	Kind       string        `json:"kind"`
+	Metadata   SuiteMetadata `json:"metadata"`
+	Spec       SuiteSpec     `json:"spec"`
+
+	//
+	// File the suite was loaded from,
+	// and the canvas under test, loaded from the path in the spec.
+	//
+	File   string  `json:"-"`
+	Canvas *Canvas `json:"-"`
+}
+
+type SuiteMetadata struct {
+	Name string `json:"name"`
+}
+
+type SuiteSpec struct {
+	//
+	// Path to the canvas file, relative to the suite file.
+	//
+	Canvas string `json:"canvas"`
+	Tests  []Test `json:"tests"`
+}
+
+type Test struct {
+	Name string `json:"name"`
+
+	//
+	// Name or ID of the trigger node emitting the root event.
+	// Not needed if the canvas has a single trigger.
+	//
+	Trigger string `json:"trigger"`
+
+	//
+	// Data and type of the root event.
+	// The type defaults to the name of the trigger.
+	//
+	Event     any    `json:"event"`
+	EventType string `json:"eventType"`
+
+	Mocks  []Mock       `json:"mocks"`
+	Expect Expectations `json:"expect"`
+}
+
+//
+// Mock replaces the execution of a node, or of every node
+// using a component, like an integration action.
+// Mocks for a node take precedence over mocks for its component.
+//
+// A mock either emits Output on a channel, or fails with a message.
+//
+
+type Mock struct {
+	Node      string `json:"node"`
+	Component string `json:"component"`
+	Channel   string `json:"channel"`
+	Type      string `json:"type"`
+	Output    any    `json:"output"`
+	Fail      string `json:"fail"`
+}
+
+//
+// Nodes are referenced by name or ID in expectations.
+//
+// Path is the list of nodes executed after the trigger, in order.
+// Configurations and outputs only need the fields being checked;
+// other fields are ignored.
+//
+
+type Expectations struct {
+	Path           []string                  `json:"path"`
+	NotExecuted    []string                  `json:"notExecuted"`
+	Configurations map[string]map[string]any `json:"configurations"`
+	Outputs        map[string]ExpectedOutput `json:"outputs"`
+}
+
+type ExpectedOutput struct {
+	Channel string `json:"channel"`
+	Data    any    `json:"data"`
+	Failed  bool   `json:"failed"`
+}
+
+//
+// Canvas is the part of a canvas file used by tests.
+// Both canvas resources and canvas templates can be used.
+//
+
+type Canvas struct {
+	Metadata struct {
+		Name string `json:"name"`
+	} `json:"metadata"`
+
+	Spec struct {
+		Nodes []Node `json:"nodes"`
+		Edges []Edge `json:"edges"`
+	} `json:"spec"`
+}
+
+type Node struct {
+	ID            string         `json:"id"`
+	Name          string         `json:"name"`
+	Type          string         `json:"type"`
+	Configuration map[string]any `json:"configuration"`
+	Component     *Ref           `json:"component"`
+	Trigger       *Ref           `json:"trigger"`
+	Blueprint     *Ref           `json:"blueprint"`
+}
+
+type Ref struct {
+	Name string `json:"name"`
+	ID   string `json:"id"`
+}
+
+type Edge struct {
+	SourceID string `json:"sourceId"`
+	TargetID string `json:"targetId"`
+	Channel  string `json:"channel"`
+}
+
+const (
+	NodeTypeComponent = "TYPE_COMPONENT"
+	NodeTypeBlueprint = "TYPE_BLUEPRINT"
+	NodeTypeTrigger   = "TYPE_TRIGGER"
+	NodeTypeWidget    = "TYPE_WIDGET"
+)
+
+//
+// ComponentName is the name of the component or trigger used by the node.
+//
+
+func (n *Node) ComponentName() string {
+	switch {
+	case n.Trigger != nil:
+		return n.Trigger.Name
+	case n.Component != nil:
+		return n.Component.Name
+	case n.Blueprint != nil:
+		return n.Blueprint.Name
+	default:
+		return ""
+	}
+}
+
+func (n *Node) label() string {
+	if n.Name == "" {
+		return n.ID
+	}
+
+	return n.Name
+}
+
+func LoadSuite(path string) (*Suite, error) {
+	// #nosec
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read test suite: %w", err)
+	}
+
+	suite, err := ParseSuite(data)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+
+	canvasPath := suite.Spec.Canvas
+	if !filepath.IsAbs(canvasPath) {
+		canvasPath = filepath.Join(filepath.Dir(path), canvasPath)
+	}
+
+	canvas, err := LoadCanvas(canvasPath)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+
+	suite.File = path
+	suite.Canvas = canvas
+	if suite.Metadata.Name == "" {
+		suite.Metadata.Name = canvas.Metadata.Name
+	}
+
+	return suite, nil
+}
+
+func ParseSuite(data []byte) (*Suite, error) {
+	suite := Suite{}
+	err := yaml.Unmarshal(data, &suite)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse test suite: %w", err)
+	}
+
+	if suite.Kind != SuiteKind {
+		return nil, fmt.Errorf("unsupported resource kind %q", suite.Kind)
+	}
+
+	if suite.Spec.Canvas == "" {
+		return nil, fmt.Errorf("spec.canvas is required")
+	}
+
+	if len(suite.Spec.Tests) == 0 {
+		return nil, fmt.Errorf("spec.tests is empty")
+	}
+
+	for i, test := range suite.Spec.Tests {
+		if test.Name == "" {
+			return nil, fmt.Errorf("test %d: name is required", i+1)
+		}
+
+		for _, mock := range test.Mocks {
+			if (mock.Node == "") == (mock.Component == "") {
+				return nil, fmt.Errorf("test %q: mocks need either a node or a component", test.Name)
+			}
+		}
+	}
+
+	return &suite, nil
+}
+
+func LoadCanvas(path string) (*Canvas, error) {
+	// #nosec
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read canvas: %w", err)
+	}
+
+	canvas := Canvas{}
+	err = yaml.Unmarshal(data, &canvas)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse canvas: %w", err)
+	}
+
+	if len(canvas.Spec.Nodes) == 0 {
+		return nil, fmt.Errorf("canvas %s has no nodes", path)
+	}
+
+	return &canvas, nil
+}
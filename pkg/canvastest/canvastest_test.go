@@ -0,0 +1,292 @@
+package canvastest
+
+import (
+	"bytes"
+	"encoding/xml"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/workers/contexts"
+)
+
+func Test__LoadSuite(t *testing.T) {
+	t.Run("suite and canvas are loaded -> canvas path is relative to the suite", func(t *testing.T) {
+		suite, err := LoadSuite("testdata/deploy.test.yaml")
+		require.NoError(t, err)
+		assert.Equal(t, "Deploy", suite.Metadata.Name)
+		assert.Equal(t, "testdata/deploy.test.yaml", suite.File)
+		assert.Len(t, suite.Spec.Tests, 4)
+		assert.Len(t, suite.Canvas.Spec.Nodes, 10)
+	})
+
+	t.Run("missing suite file -> error", func(t *testing.T) {
+		_, err := LoadSuite("testdata/missing.test.yaml")
+		require.ErrorContains(t, err, "failed to read test suite")
+	})
+}
+
+func Test__ParseSuite(t *testing.T) {
+	tests := []struct {
+		name  string
+		suite string
+		err   string
+	}{
+		{
+			name:  "wrong kind",
+			suite: "kind: Canvas\nspec:\n  canvas: a.yaml\n",
+			err:   `unsupported resource kind "Canvas"`,
+		},
+		{
+			name:  "no canvas",
+			suite: "kind: CanvasTest\nspec:\n  tests: [{name: a}]\n",
+			err:   "spec.canvas is required",
+		},
+		{
+			name:  "no tests",
+			suite: "kind: CanvasTest\nspec:\n  canvas: a.yaml\n",
+			err:   "spec.tests is empty",
+		},
+		{
+			name:  "test without name",
+			suite: "kind: CanvasTest\nspec:\n  canvas: a.yaml\n  tests: [{}]\n",
+			err:   "test 1: name is required",
+		},
+		{
+			name:  "mock for both node and component",
+			suite: "kind: CanvasTest\nspec:\n  canvas: a.yaml\n  tests: [{name: a, mocks: [{node: a, component: b}]}]\n",
+			err:   `test "a": mocks need either a node or a component`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name+" -> error", func(t *testing.T) {
+			_, err := ParseSuite([]byte(test.suite))
+			require.ErrorContains(t, err, test.err)
+		})
+	}
+}
+
+func Test__RunSuite(t *testing.T) {
+	suite, err := LoadSuite("testdata/deploy.test.yaml")
+	require.NoError(t, err)
+
+	result := RunSuite(suite)
+	require.Len(t, result.Tests, 4)
+	for _, test := range result.Tests {
+		assert.True(t, test.Passed(), "%s: %s %v", test.Name, test.Error, test.Failures)
+	}
+
+	assert.Equal(t, 0, result.Failed())
+}
+
+func Test__RunTest(t *testing.T) {
+	canvas := loadCanvas(t, "testdata/deploy.yaml")
+	event := map[string]any{
+		"ref":         "refs/heads/main",
+		"after":       "abc123",
+		"head_commit": map[string]any{"message": "hotfix"},
+		"repository":  map[string]any{"name": "hello"},
+	}
+
+	deployed := []Mock{
+		{Node: "Deploy", Output: map[string]any{"body": map[string]any{"version": "1.2.3"}}},
+		{Component: "slack.sendTextMessage", Output: map[string]any{}},
+	}
+
+	t.Run("unmet expectations -> failures", func(t *testing.T) {
+		result := RunTest(canvas, Test{
+			Name:  "wrong",
+			Event: event,
+			Mocks: deployed,
+			Expect: Expectations{
+				Path:        []string{"Only main", "Deploy"},
+				NotExecuted: []string{"Deploy", "Unknown"},
+				Configurations: map[string]map[string]any{
+					"Notify": {"text": "Deployed 2.0.0 of hello"},
+					"Skip":   {},
+				},
+				Outputs: map[string]ExpectedOutput{
+					"Done":      {Channel: "fail"},
+					"Deploy":    {Failed: true},
+					"Is hotfix": {Data: map[string]any{"x": 1}},
+				},
+			},
+		})
+
+		require.Empty(t, result.Error)
+		assert.Equal(t, []string{
+			"path: expected [Only main, Deploy], got [Only main, Is hotfix, Deploy, Notify, Record, Done, Finish]",
+			"notExecuted: node Deploy was executed",
+			"notExecuted: node Unknown not found",
+			`configurations: node Notify: $.text: expected "Deployed 2.0.0 of hello", got "Deployed 1.2.3 of hello"`,
+			"configurations: node Skip was not executed",
+			"outputs: node Deploy: expected execution to fail, but it passed",
+			"outputs: node Done: nothing emitted on channel fail, channels: [success]",
+			"outputs: node Is hotfix: $.x: missing",
+		}, result.Failures)
+	})
+
+	t.Run("node without mock -> error", func(t *testing.T) {
+		result := RunTest(canvas, Test{Name: "no mocks", Event: event})
+		assert.Equal(t, "node Deploy (http) needs a mocked output", result.Error)
+		assert.Equal(t, []string{"Only main", "Is hotfix"}, result.Path)
+		assert.False(t, result.Passed())
+	})
+
+	t.Run("expression error -> node fails", func(t *testing.T) {
+		result := RunTest(canvas, Test{
+			Name:  "bad event",
+			Event: map[string]any{"ref": "refs/heads/main", "head_commit": map[string]any{"message": "hotfix"}},
+			Mocks: deployed,
+			Expect: Expectations{
+				Outputs: map[string]ExpectedOutput{"Deploy": {Failed: true}},
+			},
+		})
+
+		require.Empty(t, result.Error)
+		assert.Empty(t, result.Failures)
+		assert.Equal(t, []string{"Only main", "Is hotfix", "Deploy"}, result.Path)
+	})
+
+	t.Run("unknown trigger -> error", func(t *testing.T) {
+		result := RunTest(canvas, Test{Name: "unknown", Trigger: "Deploy"})
+		assert.Equal(t, "node Deploy is not a trigger", result.Error)
+	})
+
+	t.Run("loop -> error", func(t *testing.T) {
+		canvas := &Canvas{}
+		canvas.Spec.Nodes = []Node{
+			{ID: "start", Type: NodeTypeTrigger, Trigger: &Ref{Name: "start"}},
+			{ID: "a", Name: "A", Type: NodeTypeComponent, Component: &Ref{Name: "noop"}},
+		}
+		canvas.Spec.Edges = []Edge{
+			{SourceID: "start", TargetID: "a", Channel: "default"},
+			{SourceID: "a", TargetID: "a", Channel: "default"},
+		}
+
+		result := RunTest(canvas, Test{Name: "loop"})
+		assert.Equal(t, "more than 1000 executions, the canvas may have a loop", result.Error)
+	})
+}
+
+func Test__ConfigurationBuilder(t *testing.T) {
+	canvas := &Canvas{}
+	canvas.Spec.Nodes = []Node{
+		{ID: "push", Name: "Push", Type: NodeTypeTrigger, Trigger: &Ref{Name: "github.onPush"}},
+		{ID: "build", Name: "Build", Type: NodeTypeComponent, Component: &Ref{Name: "noop"}},
+		{ID: "test", Name: "Test", Type: NodeTypeComponent, Component: &Ref{Name: "noop"}},
+		{ID: "deploy", Name: "Deploy", Type: NodeTypeComponent, Component: &Ref{Name: "http"}},
+	}
+	canvas.Spec.Edges = []Edge{
+		{SourceID: "push", TargetID: "build"},
+		{SourceID: "build", TargetID: "test"},
+		{SourceID: "test", TargetID: "deploy"},
+	}
+
+	engine, err := NewEngine(canvas)
+	require.NoError(t, err)
+
+	root := engine.store.emit("push", DefaultChannel, nil, map[string]any{"data": map[string]any{"ref": "main"}})
+	engine.rootEventID = root.ID
+	build := engine.store.createExecution("build", root.ID, root)
+	built := engine.store.emit("build", DefaultChannel, &build.ID, map[string]any{"data": map[string]any{"step": 1.0}})
+	test := engine.store.createExecution("test", root.ID, built)
+	tested := engine.store.emit("test", DefaultChannel, &test.ID, map[string]any{"data": map[string]any{"step": 2.0}})
+
+	node := engine.FindNode("Deploy")
+	builder := func() *contexts.NodeConfigurationBuilder {
+		return engine.configurationBuilder(node, tested)
+	}
+
+	t.Run("edges without a channel -> default channel", func(t *testing.T) {
+		items := engine.route(built)
+		require.Len(t, items, 1)
+		assert.Equal(t, "test", items[0].target.ID)
+	})
+
+	t.Run("expressions are replaced by their string value", func(t *testing.T) {
+		config, err := builder().Build(map[string]any{
+			"build":  "{{ $['Build'].data.step }}",
+			"nested": map[string]any{"list": []any{"{{ root().data.ref }}", 1.0}},
+			"input":  "{{ $['Test'].data.step }}",
+			"plain":  "no expressions",
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, map[string]any{
+			"build":  "1",
+			"nested": map[string]any{"list": []any{"main", 1.0}},
+			"input":  "2",
+			"plain":  "no expressions",
+		}, config)
+	})
+
+	t.Run("previous() walks the chain", func(t *testing.T) {
+		config, err := builder().Build(map[string]any{
+			"one":   "{{ previous().data.step }}",
+			"two":   "{{ previous(2).data.step }}",
+			"three": "{{ previous(3).data.ref }}",
+			"four":  "{{ previous(4) }}",
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, map[string]any{"one": "2", "two": "1", "three": "main", "four": "<nil>"}, config)
+	})
+
+	t.Run("artifacts -> error", func(t *testing.T) {
+		_, err := builder().Build(map[string]any{"a": "{{ artifact('Build', 'report') }}"})
+		require.ErrorContains(t, err, "artifacts are not available in canvas tests")
+	})
+
+	t.Run("expression env for components", func(t *testing.T) {
+		env, err := builder().BuildExpressionEnv("$['Build'] && root() && previous(2)")
+		require.NoError(t, err)
+		assert.Equal(t, map[string]any{"data": map[string]any{"ref": "main"}}, env["__root"])
+		assert.Equal(t, map[string]any{"2": map[string]any{"data": map[string]any{"step": 1.0}}}, env["__previousByDepth"])
+		assert.Contains(t, env["$"], "Build")
+	})
+}
+
+func Test__WriteJUnit(t *testing.T) {
+	results := []*SuiteResult{
+		{
+			Name:     "Deploy",
+			File:     "deploy.test.yaml",
+			Duration: 1500 * time.Millisecond,
+			Tests: []TestResult{
+				{Name: "passes", Duration: time.Second},
+				{Name: "fails", Failures: []string{"path: a", "outputs: b"}},
+				{Name: "errors", Error: "node A (http) needs a mocked output"},
+			},
+		},
+	}
+
+	output := bytes.Buffer{}
+	require.NoError(t, WriteJUnit(&output, results))
+
+	report := junitTestSuites{}
+	require.NoError(t, xml.Unmarshal(output.Bytes(), &report))
+	assert.Equal(t, 3, report.Tests)
+	assert.Equal(t, 1, report.Failures)
+	assert.Equal(t, 1, report.Errors)
+	assert.Equal(t, "1.500", report.Time)
+
+	require.Len(t, report.Suites, 1)
+	cases := report.Suites[0].TestCases
+	require.Len(t, cases, 3)
+	assert.Equal(t, "Deploy", cases[0].ClassName)
+	assert.Equal(t, "1.000", cases[0].Time)
+	assert.Nil(t, cases[0].Failure)
+	assert.Equal(t, "path: a\noutputs: b", cases[1].Failure.Text)
+	assert.Equal(t, "node A (http) needs a mocked output", cases[2].Error.Message)
+}
+
+func loadCanvas(t *testing.T, path string) *Canvas {
+	t.Helper()
+
+	canvas, err := LoadCanvas(path)
+	require.NoError(t, err)
+	return canvas
+}
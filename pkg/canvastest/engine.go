@@ -0,0 +1,533 @@
+package canvastest
+
+import (
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	log "github.com/sirupsen/logrus"
+	"github.com/superplanehq/superplane/pkg/core"
+	"github.com/superplanehq/superplane/pkg/crypto"
+	"github.com/superplanehq/superplane/pkg/models"
+	"github.com/superplanehq/superplane/pkg/registry"
+	"github.com/superplanehq/superplane/pkg/testkit"
+	"github.com/superplanehq/superplane/pkg/workers/contexts"
+
+	_ "github.com/superplanehq/superplane/pkg/components/addmemory"
+	_ "github.com/superplanehq/superplane/pkg/components/approval"
+	_ "github.com/superplanehq/superplane/pkg/components/filter"
+	_ "github.com/superplanehq/superplane/pkg/components/http"
+	_ "github.com/superplanehq/superplane/pkg/components/humaninput"
+	_ "github.com/superplanehq/superplane/pkg/components/if"
+	_ "github.com/superplanehq/superplane/pkg/components/merge"
+	_ "github.com/superplanehq/superplane/pkg/components/noop"
+	_ "github.com/superplanehq/superplane/pkg/components/ssh"
+	_ "github.com/superplanehq/superplane/pkg/components/timegate"
+	_ "github.com/superplanehq/superplane/pkg/components/wait"
+)
+
+//
+// Executions allowed in a single test,
+// so canvases with loops don't run forever.
+//
+
+const MaxExecutions = 1000
+
+//
+// Components are found in the same registry the server uses.
+// Only components without side effects run for real,
+// see core.SideEffectFreeComponent.
+//
+
+var loadRegistry = sync.OnceValues(func() (*registry.Registry, error) {
+	return registry.NewRegistry(crypto.NewNoOpEncryptor(), registry.HTTPOptions{})
+})
+
+//
+// Execution is the outcome of a node receiving an event.
+//
+
+type Execution struct {
+	Node          *Node
+	Configuration map[string]any
+	Outputs       []testkit.Output
+	Failed        bool
+	Error         string
+}
+
+//
+// Payload returns the data of the first payload emitted on a channel.
+// An empty channel matches the first channel with payloads.
+//
+
+func (e *Execution) Payload(channel string) (any, bool) {
+	for _, output := range e.Outputs {
+		if channel != "" && output.Channel != channel {
+			continue
+		}
+
+		if len(output.Payloads) > 0 {
+			return normalize(output.Payloads[0]), true
+		}
+	}
+
+	return nil, false
+}
+
+func (e *Execution) Channels() []string {
+	channels := []string{}
+	for _, output := range e.Outputs {
+		channels = append(channels, output.Channel)
+	}
+
+	return channels
+}
+
+type Engine struct {
+	canvas   *Canvas
+	nodes    map[string]*Node
+	registry *registry.Registry
+	memory   *testkit.CanvasMemory
+	logger   *log.Entry
+
+	//
+	// Events and executions of the test,
+	// read by the NodeConfigurationBuilder to resolve expressions.
+	//
+	store       *store
+	rootEventID uuid.UUID
+
+	//
+	// Executions in the order they finished.
+	//
+	executions []*Execution
+
+	//
+	// Executions created by components during ProcessQueueItem,
+	// which may receive more than one event, like merge.
+	//
+	pending map[string][]*pendingExecution
+	count   int
+}
+
+type pendingExecution struct {
+	id  uuid.UUID
+	kit *testkit.Kit
+}
+
+type queueItem struct {
+	target *Node
+	event  *models.CanvasEvent
+}
+
+func NewEngine(canvas *Canvas) (*Engine, error) {
+	reg, err := loadRegistry()
+	if err != nil {
+		return nil, err
+	}
+
+	nodes := make(map[string]*Node, len(canvas.Spec.Nodes))
+	for i := range canvas.Spec.Nodes {
+		node := &canvas.Spec.Nodes[i]
+		nodes[node.ID] = node
+	}
+
+	logger := log.New()
+	logger.SetLevel(log.PanicLevel)
+
+	return &Engine{
+		canvas:   canvas,
+		nodes:    nodes,
+		registry: reg,
+		memory:   &testkit.CanvasMemory{},
+		logger:   log.NewEntry(logger),
+		store:    newStore(canvas),
+		pending:  map[string][]*pendingExecution{},
+	}, nil
+}
+
+//
+// FindNode finds a node by name or ID.
+//
+
+func (e *Engine) FindNode(ref string) *Node {
+	if node, ok := e.nodes[ref]; ok {
+		return node
+	}
+
+	for i := range e.canvas.Spec.Nodes {
+		if e.canvas.Spec.Nodes[i].Name == ref {
+			return &e.canvas.Spec.Nodes[i]
+		}
+	}
+
+	return nil
+}
+
+//
+// Run emits the root event of a test from its trigger,
+// and processes events until there is nothing left to run.
+// Executions are returned in the order they finished.
+//
+// Errors are only returned when the test can't run,
+// like when a node has no mocked output.
+// Failed executions are part of the result.
+//
+
+func (e *Engine) Run(test Test) ([]*Execution, error) {
+	trigger, err := e.findTrigger(test.Trigger)
+	if err != nil {
+		return nil, err
+	}
+
+	eventType := test.EventType
+	if eventType == "" {
+		eventType = trigger.ComponentName()
+	}
+
+	root := e.store.emit(trigger.ID, DefaultChannel, nil, wrap(eventType, test.Event))
+	e.rootEventID = root.ID
+	queue := e.route(root)
+
+	for len(queue) > 0 {
+		item := queue[0]
+		queue = queue[1:]
+
+		e.count++
+		if e.count > MaxExecutions {
+			return e.executions, fmt.Errorf("more than %d executions, the canvas may have a loop", MaxExecutions)
+		}
+
+		next, err := e.process(test, item)
+		if err != nil {
+			return e.executions, err
+		}
+
+		queue = append(queue, next...)
+	}
+
+	return e.executions, nil
+}
+
+func (e *Engine) findTrigger(ref string) (*Node, error) {
+	if ref != "" {
+		node := e.FindNode(ref)
+		if node == nil {
+			return nil, fmt.Errorf("trigger %s not found", ref)
+		}
+
+		if node.Type != NodeTypeTrigger {
+			return nil, fmt.Errorf("node %s is not a trigger", ref)
+		}
+
+		return node, nil
+	}
+
+	triggers := []*Node{}
+	for i := range e.canvas.Spec.Nodes {
+		if e.canvas.Spec.Nodes[i].Type == NodeTypeTrigger {
+			triggers = append(triggers, &e.canvas.Spec.Nodes[i])
+		}
+	}
+
+	switch len(triggers) {
+	case 0:
+		return nil, fmt.Errorf("canvas has no triggers")
+	case 1:
+		return triggers[0], nil
+	default:
+		return nil, fmt.Errorf("canvas has %d triggers, the test needs to specify one", len(triggers))
+	}
+}
+
+//
+// route sends an event through all the edges leaving
+// the channel it was emitted on, in the same way the EventRouter does.
+//
+
+func (e *Engine) route(event *models.CanvasEvent) []queueItem {
+	items := []queueItem{}
+	for _, edge := range e.store.canvas.FindEdges(event.NodeID, event.Channel) {
+		target, ok := e.nodes[edge.TargetID]
+		if !ok || target.Type == NodeTypeWidget {
+			continue
+		}
+
+		items = append(items, queueItem{target: target, event: event})
+	}
+
+	return items
+}
+
+func (e *Engine) process(test Test, item queueItem) ([]queueItem, error) {
+	node := item.target
+	component := e.findComponent(node)
+
+	builder := e.configurationBuilder(node, item.event)
+	if component != nil {
+		builder = builder.WithConfigurationFields(component.Configuration())
+	}
+
+	config, err := builder.Build(valueOrEmpty(node.Configuration))
+	if err != nil {
+		e.finish(&Execution{Node: node, Failed: true, Error: fmt.Sprintf("error building configuration: %v", err)})
+		return nil, nil
+	}
+
+	mock := findMock(test.Mocks, node)
+	if mock != nil {
+		return e.runMock(node, config, mock, item.event), nil
+	}
+
+	if component == nil || !core.IsSideEffectFree(component) {
+		return nil, fmt.Errorf("node %s (%s) needs a mocked output", node.label(), node.ComponentName())
+	}
+
+	return e.runComponent(node, component, config, item.event), nil
+}
+
+func (e *Engine) findComponent(node *Node) core.Component {
+	if node.Type != NodeTypeComponent {
+		return nil
+	}
+
+	component, err := e.registry.GetComponent(node.ComponentName())
+	if err != nil {
+		return nil
+	}
+
+	return component
+}
+
+//
+// configurationBuilder is set up like in BuildProcessQueueContext,
+// with the event received by the node as its input.
+//
+
+func (e *Engine) configurationBuilder(node *Node, event *models.CanvasEvent) *contexts.NodeConfigurationBuilder {
+	return contexts.NewNodeConfigurationBuilderWithStore(e.store).
+		WithNodeID(node.ID).
+		WithRootEvent(&e.rootEventID).
+		WithPreviousExecution(event.ExecutionID).
+		WithInput(map[string]any{event.NodeID: event.Data.Data()})
+}
+
+func (e *Engine) runMock(node *Node, config map[string]any, mock *Mock, event *models.CanvasEvent) []queueItem {
+	execution := &Execution{Node: node, Configuration: config}
+	if mock.Fail != "" {
+		execution.Failed = true
+		execution.Error = mock.Fail
+		e.finish(execution)
+		return nil
+	}
+
+	channel := mock.Channel
+	if channel == "" {
+		channel = DefaultChannel
+	}
+
+	payloadType := mock.Type
+	if payloadType == "" {
+		payloadType = node.ComponentName()
+	}
+
+	execution.Outputs = []testkit.Output{{Channel: channel, Type: payloadType, Payloads: []any{mock.Output}}}
+	e.finish(execution)
+
+	record := e.store.createExecution(node.ID, e.rootEventID, event)
+	return e.routeOutputs(execution, record.ID)
+}
+
+//
+// runComponent drives a component like the workers do:
+// ProcessQueueItem decides whether an execution runs,
+// and Execute only runs for executions that are not finished yet.
+//
+
+func (e *Engine) runComponent(node *Node, component core.Component, config map[string]any, event *models.CanvasEvent) []queueItem {
+	input := event.Data.Data()
+	expressionEnv := func(expression string) (map[string]any, error) {
+		return e.configurationBuilder(node, event).BuildExpressionEnv(expression)
+	}
+
+	ctx := core.ProcessQueueContext{
+		WorkflowID:    e.canvas.Metadata.Name,
+		NodeID:        node.ID,
+		SourceNodeID:  event.NodeID,
+		RootEventID:   e.rootEventID.String(),
+		EventID:       event.ID.String(),
+		Configuration: config,
+		Input:         input,
+		ExpressionEnv: expressionEnv,
+	}
+
+	executions := map[uuid.UUID]*pendingExecution{}
+	newContext := func(pending *pendingExecution) *core.ExecutionContext {
+		pending.kit.Configuration = config
+		pending.kit.ExpressionEnv = expressionEnv
+		execution := pending.kit.ExecutionContext(input)
+		execution.ID = pending.id
+		executions[pending.id] = pending
+		return &execution
+	}
+
+	ctx.CreateExecution = func() (*core.ExecutionContext, error) {
+		kit := testkit.New()
+		kit.WorkflowID = e.canvas.Metadata.Name
+		kit.NodeID = node.ID
+		kit.Logger = e.logger
+		kit.CanvasMemory = e.memory
+		record := e.store.createExecution(node.ID, e.rootEventID, event)
+		pending := &pendingExecution{id: record.ID, kit: kit}
+		e.pending[node.ID] = append(e.pending[node.ID], pending)
+		return newContext(pending), nil
+	}
+
+	ctx.FindExecutionByKV = func(key, value string) (*core.ExecutionContext, error) {
+		for _, pending := range e.pending[node.ID] {
+			if pending.kit.ExecutionState.KVs[key] == value {
+				return newContext(pending), nil
+			}
+		}
+
+		return nil, nil
+	}
+
+	ctx.DequeueItem = func() error { return nil }
+	ctx.UpdateNodeState = func(state string) error { return nil }
+	ctx.CountDistinctIncomingSources = func() (int, error) { return e.countIncomingSources(node), nil }
+	ctx.DefaultProcessing = func() (*uuid.UUID, error) {
+		execution, err := ctx.CreateExecution()
+		if err != nil {
+			return nil, err
+		}
+
+		return &execution.ID, nil
+	}
+
+	id, err := component.ProcessQueueItem(ctx)
+	if err != nil {
+		e.finish(&Execution{Node: node, Configuration: config, Failed: true, Error: err.Error()})
+		return nil
+	}
+
+	if id == nil {
+		return nil
+	}
+
+	pending, ok := executions[*id]
+	if !ok {
+		e.finish(&Execution{Node: node, Configuration: config, Failed: true, Error: "execution not found"})
+		return nil
+	}
+
+	state := pending.kit.ExecutionState
+	if !state.IsFinished() {
+		execution := newContext(pending)
+		err = component.Execute(*execution)
+		if err != nil {
+			e.finish(&Execution{Node: node, Configuration: config, Failed: true, Error: err.Error()})
+			return nil
+		}
+	}
+
+	if !state.IsFinished() {
+		return nil
+	}
+
+	execution := &Execution{
+		Node:          node,
+		Configuration: config,
+		Outputs:       state.Outputs,
+		Failed:        !state.Passed,
+		Error:         state.FailureMessage,
+	}
+
+	e.finish(execution)
+	if execution.Failed {
+		return nil
+	}
+
+	return e.routeOutputs(execution, pending.id)
+}
+
+func (e *Engine) routeOutputs(execution *Execution, executionID uuid.UUID) []queueItem {
+	items := []queueItem{}
+	for _, output := range execution.Outputs {
+		for _, payload := range output.Payloads {
+			event := e.store.emit(execution.Node.ID, output.Channel, &executionID, wrap(output.Type, payload))
+			items = append(items, e.route(event)...)
+		}
+	}
+
+	return items
+}
+
+func (e *Engine) finish(execution *Execution) {
+	e.executions = append(e.executions, execution)
+}
+
+func (e *Engine) countIncomingSources(node *Node) int {
+	sources := map[string]bool{}
+	for _, edge := range e.canvas.Spec.Edges {
+		if edge.TargetID == node.ID {
+			sources[edge.SourceID] = true
+		}
+	}
+
+	return len(sources)
+}
+
+func findMock(mocks []Mock, node *Node) *Mock {
+	for i := range mocks {
+		if mocks[i].Node != "" && (mocks[i].Node == node.ID || mocks[i].Node == node.Name) {
+			return &mocks[i]
+		}
+	}
+
+	for i := range mocks {
+		if mocks[i].Component != "" && mocks[i].Component == node.ComponentName() {
+			return &mocks[i]
+		}
+	}
+
+	return nil
+}
+
+//
+// Events are stored as JSON by the engine,
+// so payloads are normalized before expressions see them.
+//
+
+func wrap(payloadType string, payload any) any {
+	return normalize(map[string]any{
+		"type":      payloadType,
+		"timestamp": time.Now().UTC(),
+		"data":      payload,
+	})
+}
+
+func normalize(value any) any {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return value
+	}
+
+	var normalized any
+	err = json.Unmarshal(data, &normalized)
+	if err != nil {
+		return value
+	}
+
+	return normalized
+}
+
+func valueOrEmpty(config map[string]any) map[string]any {
+	if config == nil {
+		return map[string]any{}
+	}
+
+	return config
+}
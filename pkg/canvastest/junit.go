@@ -0,0 +1,108 @@
+package canvastest
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strings"
+)
+
+//
+// JUnit XML, in the format read by CI systems.
+// Each suite file is a testsuite, and each test a testcase.
+//
+
+type junitTestSuites struct {
+	XMLName  xml.Name         `xml:"testsuites"`
+	Tests    int              `xml:"tests,attr"`
+	Failures int              `xml:"failures,attr"`
+	Errors   int              `xml:"errors,attr"`
+	Time     string           `xml:"time,attr"`
+	Suites   []junitTestSuite `xml:"testsuite"`
+}
+
+type junitTestSuite struct {
+	Name      string          `xml:"name,attr"`
+	File      string          `xml:"file,attr,omitempty"`
+	Tests     int             `xml:"tests,attr"`
+	Failures  int             `xml:"failures,attr"`
+	Errors    int             `xml:"errors,attr"`
+	Time      string          `xml:"time,attr"`
+	TestCases []junitTestCase `xml:"testcase"`
+}
+
+type junitTestCase struct {
+	Name      string        `xml:"name,attr"`
+	ClassName string        `xml:"classname,attr"`
+	Time      string        `xml:"time,attr"`
+	Failure   *junitMessage `xml:"failure,omitempty"`
+	Error     *junitMessage `xml:"error,omitempty"`
+}
+
+type junitMessage struct {
+	Message string `xml:"message,attr"`
+	Text    string `xml:",chardata"`
+}
+
+func WriteJUnit(w io.Writer, results []*SuiteResult) error {
+	report := junitTestSuites{}
+	total := 0.0
+
+	for _, result := range results {
+		suite := junitTestSuite{
+			Name: result.Name,
+			File: result.File,
+			Time: seconds(result.Duration.Seconds()),
+		}
+
+		for _, test := range result.Tests {
+			testCase := junitTestCase{
+				Name:      test.Name,
+				ClassName: result.Name,
+				Time:      seconds(test.Duration.Seconds()),
+			}
+
+			switch {
+			case test.Error != "":
+				suite.Errors++
+				testCase.Error = &junitMessage{Message: test.Error, Text: test.Error}
+			case len(test.Failures) > 0:
+				suite.Failures++
+				testCase.Failure = &junitMessage{
+					Message: fmt.Sprintf("%d expectations not met", len(test.Failures)),
+					Text:    strings.Join(test.Failures, "\n"),
+				}
+			}
+
+			suite.Tests++
+			suite.TestCases = append(suite.TestCases, testCase)
+		}
+
+		report.Tests += suite.Tests
+		report.Failures += suite.Failures
+		report.Errors += suite.Errors
+		report.Suites = append(report.Suites, suite)
+		total += result.Duration.Seconds()
+	}
+
+	report.Time = seconds(total)
+
+	_, err := io.WriteString(w, xml.Header)
+	if err != nil {
+		return err
+	}
+
+	encoder := xml.NewEncoder(w)
+	encoder.Indent("", "  ")
+	err = encoder.Encode(report)
+	if err != nil {
+		return err
+	}
+
+	_, err = io.WriteString(w, "\n")
+	return err
+}
+
+func seconds(value float64) string {
+	return fmt.Sprintf("%.3f", value)
+}
@@ -0,0 +1,288 @@
+package canvastest
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+)
+
+type SuiteResult struct {
+	Name     string        `json:"name"`
+	File     string        `json:"file"`
+	Tests    []TestResult  `json:"tests"`
+	Duration time.Duration `json:"duration"`
+}
+
+type TestResult struct {
+	Name     string        `json:"name"`
+	Duration time.Duration `json:"duration"`
+
+	//
+	// Error is set when the test could not run,
+	// and Failures lists the expectations that were not met.
+	//
+	Error    string   `json:"error,omitempty"`
+	Failures []string `json:"failures,omitempty"`
+
+	Path []string `json:"path"`
+}
+
+func (r *TestResult) Passed() bool {
+	return r.Error == "" && len(r.Failures) == 0
+}
+
+func (r *SuiteResult) Failed() int {
+	failed := 0
+	for _, test := range r.Tests {
+		if !test.Passed() {
+			failed++
+		}
+	}
+
+	return failed
+}
+
+func RunSuite(suite *Suite) *SuiteResult {
+	started := time.Now()
+	result := &SuiteResult{Name: suite.Metadata.Name, File: suite.File}
+	for _, test := range suite.Spec.Tests {
+		result.Tests = append(result.Tests, RunTest(suite.Canvas, test))
+	}
+
+	result.Duration = time.Since(started)
+	return result
+}
+
+//
+// RunTest runs a test against a fresh engine,
+// so tests in a suite don't share state, like canvas memory.
+//
+
+func RunTest(canvas *Canvas, test Test) TestResult {
+	started := time.Now()
+	result := TestResult{Name: test.Name}
+
+	engine, err := NewEngine(canvas)
+	if err != nil {
+		result.Error = err.Error()
+		return result
+	}
+
+	executions, err := engine.Run(test)
+	result.Duration = time.Since(started)
+	for _, execution := range executions {
+		result.Path = append(result.Path, execution.Node.label())
+	}
+
+	if err != nil {
+		result.Error = err.Error()
+		return result
+	}
+
+	result.Failures = check(engine, test.Expect, executions)
+	return result
+}
+
+func check(engine *Engine, expect Expectations, executions []*Execution) []string {
+	failures := []string{}
+	failures = append(failures, checkPath(engine, expect.Path, executions)...)
+
+	for _, ref := range expect.NotExecuted {
+		node := engine.FindNode(ref)
+		if node == nil {
+			failures = append(failures, fmt.Sprintf("notExecuted: node %s not found", ref))
+			continue
+		}
+
+		if lastExecution(executions, node) != nil {
+			failures = append(failures, fmt.Sprintf("notExecuted: node %s was executed", ref))
+		}
+	}
+
+	for _, ref := range sortedKeys(expect.Configurations) {
+		execution, failure := findExecution(engine, executions, ref)
+		if failure != "" {
+			failures = append(failures, "configurations: "+failure)
+			continue
+		}
+
+		err := matchSubset("$", normalize(expect.Configurations[ref]), normalize(execution.Configuration))
+		if err != nil {
+			failures = append(failures, fmt.Sprintf("configurations: node %s: %v", ref, err))
+		}
+	}
+
+	for _, ref := range sortedKeys(expect.Outputs) {
+		execution, failure := findExecution(engine, executions, ref)
+		if failure != "" {
+			failures = append(failures, "outputs: "+failure)
+			continue
+		}
+
+		failure = checkOutput(expect.Outputs[ref], execution)
+		if failure != "" {
+			failures = append(failures, fmt.Sprintf("outputs: node %s: %s", ref, failure))
+		}
+	}
+
+	return failures
+}
+
+func checkPath(engine *Engine, expected []string, executions []*Execution) []string {
+	if expected == nil {
+		return nil
+	}
+
+	actual := []string{}
+	matches := len(expected) == len(executions)
+	for i, execution := range executions {
+		actual = append(actual, execution.Node.label())
+		if matches && engine.FindNode(expected[i]) != execution.Node {
+			matches = false
+		}
+	}
+
+	if matches {
+		return nil
+	}
+
+	return []string{fmt.Sprintf("path: expected [%s], got [%s]", strings.Join(expected, ", "), strings.Join(actual, ", "))}
+}
+
+func checkOutput(expected ExpectedOutput, execution *Execution) string {
+	if expected.Failed {
+		if !execution.Failed {
+			return "expected execution to fail, but it passed"
+		}
+
+		return ""
+	}
+
+	if execution.Failed {
+		return fmt.Sprintf("execution failed: %s", execution.Error)
+	}
+
+	payload, ok := execution.Payload(expected.Channel)
+	if !ok {
+		if expected.Channel != "" {
+			return fmt.Sprintf("nothing emitted on channel %s, channels: %v", expected.Channel, execution.Channels())
+		}
+
+		return "nothing emitted"
+	}
+
+	if expected.Data == nil {
+		return ""
+	}
+
+	err := matchSubset("$", normalize(expected.Data), payload)
+	if err != nil {
+		return err.Error()
+	}
+
+	return ""
+}
+
+func findExecution(engine *Engine, executions []*Execution, ref string) (*Execution, string) {
+	node := engine.FindNode(ref)
+	if node == nil {
+		return nil, fmt.Sprintf("node %s not found", ref)
+	}
+
+	execution := lastExecution(executions, node)
+	if execution == nil {
+		return nil, fmt.Sprintf("node %s was not executed", ref)
+	}
+
+	return execution, ""
+}
+
+func lastExecution(executions []*Execution, node *Node) *Execution {
+	for i := len(executions) - 1; i >= 0; i-- {
+		if executions[i].Node == node {
+			return executions[i]
+		}
+	}
+
+	return nil
+}
+
+//
+// matchSubset checks that every field in the expected value
+// has the same value in the actual one. Lists must have the same length.
+//
+
+func matchSubset(path string, expected, actual any) error {
+	switch e := expected.(type) {
+	case map[string]any:
+		a, ok := actual.(map[string]any)
+		if !ok {
+			return fmt.Errorf("%s: expected an object, got %s", path, describe(actual))
+		}
+
+		for _, key := range sortedKeys(e) {
+			value, ok := a[key]
+			if !ok {
+				return fmt.Errorf("%s.%s: missing", path, key)
+			}
+
+			err := matchSubset(path+"."+key, e[key], value)
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+
+	case []any:
+		a, ok := actual.([]any)
+		if !ok {
+			return fmt.Errorf("%s: expected a list, got %s", path, describe(actual))
+		}
+
+		if len(e) != len(a) {
+			return fmt.Errorf("%s: expected %d items, got %d", path, len(e), len(a))
+		}
+
+		for i := range e {
+			err := matchSubset(fmt.Sprintf("%s[%d]", path, i), e[i], a[i])
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+
+	default:
+		if !reflect.DeepEqual(expected, actual) {
+			return fmt.Errorf("%s: expected %s, got %s", path, describe(expected), describe(actual))
+		}
+
+		return nil
+	}
+}
+
+func describe(value any) string {
+	switch value.(type) {
+	case map[string]any:
+		return "an object"
+	case []any:
+		return "a list"
+	case string:
+		return fmt.Sprintf("%q", value)
+	default:
+		return fmt.Sprintf("%v", value)
+	}
+}
+
+func sortedKeys[T any](m map[string]T) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,164 @@
+package canvastest
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/superplanehq/superplane/pkg/models"
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+)
+
+//
+// store keeps the events and executions of a test in memory.
+// It is the ChainStore of the NodeConfigurationBuilder from the workers,
+// so configurations and expressions are resolved in the same way
+// they are with the database.
+//
+
+type store struct {
+	canvas     *models.Canvas
+	nodes      []models.CanvasNode
+	events     []*models.CanvasEvent
+	executions map[uuid.UUID]*models.CanvasNodeExecution
+	clock      time.Time
+}
+
+func newStore(canvas *Canvas) *store {
+	s := &store{
+		canvas:     &models.Canvas{},
+		executions: map[uuid.UUID]*models.CanvasNodeExecution{},
+		clock:      time.Now(),
+	}
+
+	for _, node := range canvas.Spec.Nodes {
+		s.nodes = append(s.nodes, models.CanvasNode{NodeID: node.ID, Name: node.Name, Type: node.Type})
+	}
+
+	for _, edge := range canvas.Spec.Edges {
+		channel := edge.Channel
+		if channel == "" {
+			channel = DefaultChannel
+		}
+
+		s.canvas.Edges = append(s.canvas.Edges, models.Edge{SourceID: edge.SourceID, TargetID: edge.TargetID, Channel: channel})
+	}
+
+	return s
+}
+
+//
+// Executions in a chain are ordered by creation time,
+// so every record gets a later one than the previous.
+//
+
+func (s *store) now() *time.Time {
+	s.clock = s.clock.Add(time.Microsecond)
+	now := s.clock
+	return &now
+}
+
+func (s *store) emit(nodeID, channel string, executionID *uuid.UUID, payload any) *models.CanvasEvent {
+	event := &models.CanvasEvent{
+		ID:          uuid.New(),
+		NodeID:      nodeID,
+		Channel:     channel,
+		Data:        datatypes.NewJSONType(payload),
+		ExecutionID: executionID,
+		CreatedAt:   s.now(),
+	}
+
+	s.events = append(s.events, event)
+	return event
+}
+
+func (s *store) createExecution(nodeID string, rootEventID uuid.UUID, event *models.CanvasEvent) *models.CanvasNodeExecution {
+	execution := &models.CanvasNodeExecution{
+		ID:                  uuid.New(),
+		NodeID:              nodeID,
+		RootEventID:         rootEventID,
+		EventID:             event.ID,
+		PreviousExecutionID: event.ExecutionID,
+		CreatedAt:           s.now(),
+	}
+
+	s.executions[execution.ID] = execution
+	return execution
+}
+
+func (s *store) ListNodes() ([]models.CanvasNode, error) {
+	return s.nodes, nil
+}
+
+func (s *store) ListEdges() ([]models.Edge, error) {
+	return s.canvas.Edges, nil
+}
+
+func (s *store) FindEvent(id uuid.UUID) (*models.CanvasEvent, error) {
+	for _, event := range s.events {
+		if event.ID == id {
+			return event, nil
+		}
+	}
+
+	return nil, gorm.ErrRecordNotFound
+}
+
+func (s *store) ListPreviousExecutions(executionID uuid.UUID) ([]models.CanvasNodeExecution, error) {
+	executions := []models.CanvasNodeExecution{}
+	next := &executionID
+	for next != nil {
+		execution, ok := s.executions[*next]
+		if !ok {
+			break
+		}
+
+		executions = append(executions, *execution)
+		next = execution.PreviousExecutionID
+	}
+
+	return executions, nil
+}
+
+func (s *store) ListRootEventExecutions(rootEventID uuid.UUID, nodeIDs []string) ([]models.CanvasNodeExecution, error) {
+	included := make(map[string]bool, len(nodeIDs))
+	for _, nodeID := range nodeIDs {
+		included[nodeID] = true
+	}
+
+	executions := []models.CanvasNodeExecution{}
+	for _, execution := range s.executions {
+		if execution.RootEventID == rootEventID && included[execution.NodeID] {
+			executions = append(executions, *execution)
+		}
+	}
+
+	return executions, nil
+}
+
+func (s *store) ListExecutionEvents(executionIDs []uuid.UUID) ([]models.CanvasEvent, error) {
+	included := make(map[uuid.UUID]bool, len(executionIDs))
+	for _, executionID := range executionIDs {
+		included[executionID] = true
+	}
+
+	events := []models.CanvasEvent{}
+	for _, event := range s.events {
+		if event.ExecutionID != nil && included[*event.ExecutionID] {
+			events = append(events, *event)
+		}
+	}
+
+	return events, nil
+}
+
+var errNoArtifacts = errors.New("artifacts are not available in canvas tests")
+
+func (s *store) FindArtifact(executionID uuid.UUID, name string) (*models.CanvasNodeExecutionArtifact, error) {
+	return nil, errNoArtifacts
+}
+
+func (s *store) ReadArtifact(artifact *models.CanvasNodeExecutionArtifact) ([]byte, error) {
+	return nil, errNoArtifacts
+}
@@ -0,0 +1,215 @@
+package canvases
+
+import (
+	"encoding/base64"
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/superplanehq/superplane/pkg/cli/core"
+	"github.com/superplanehq/superplane/pkg/openapi_client"
+)
+
+type doraMetricsCommand struct {
+	since    *time.Duration
+	interval *string
+	csv      *bool
+}
+
+func (c *doraMetricsCommand) Execute(ctx core.CommandContext) error {
+	canvasID, err := doraCanvasID(ctx)
+	if err != nil {
+		return err
+	}
+
+	if *c.since <= 0 {
+		return fmt.Errorf("--since must be a positive duration")
+	}
+
+	interval, err := parseDoraInterval(*c.interval)
+	if err != nil {
+		return err
+	}
+
+	to := time.Now()
+	from := to.Add(-*c.since)
+
+	if *c.csv {
+		response, _, err := ctx.API.CanvasAPI.
+			CanvasesExportCanvasDoraMetrics(ctx.Context, canvasID).
+			From(from).
+			To(to).
+			Interval(string(interval)).
+			Execute()
+
+		if err != nil {
+			return err
+		}
+
+		content, err := base64.StdEncoding.DecodeString(response.GetContent())
+		if err != nil {
+			return fmt.Errorf("failed to decode CSV: %w", err)
+		}
+
+		_, err = ctx.Cmd.OutOrStdout().Write(content)
+		return err
+	}
+
+	response, _, err := ctx.API.CanvasAPI.
+		CanvasesGetCanvasDoraMetrics(ctx.Context, canvasID).
+		From(from).
+		To(to).
+		Interval(string(interval)).
+		Execute()
+
+	if err != nil {
+		return err
+	}
+
+	if !ctx.Renderer.IsText() {
+		return ctx.Renderer.Render(response)
+	}
+
+	return ctx.Renderer.RenderText(func(stdout io.Writer) error {
+		writer := tabwriter.NewWriter(stdout, 0, 8, 2, ' ', 0)
+		_, _ = fmt.Fprintln(writer, "PERIOD\tDEPLOYS\tFAILED\tPER_DAY\tLEAD_TIME\tFAILURE_RATE\tINCIDENTS\tMTTR")
+
+		for _, metrics := range response.GetSeries() {
+			writeDoraRow(writer, metrics.GetStart().Format(time.DateOnly), metrics)
+		}
+
+		writeDoraRow(writer, "TOTAL", response.GetSummary())
+		return writer.Flush()
+	})
+}
+
+func writeDoraRow(writer io.Writer, period string, metrics openapi_client.CanvasesDoraMetrics) {
+	_, _ = fmt.Fprintf(
+		writer,
+		"%s\t%s\t%s\t%.2f\t%s\t%.1f%%\t%s\t%s\n",
+		period,
+		metrics.GetDeployments(),
+		metrics.GetFailedDeployments(),
+		metrics.GetDeploymentFrequencyPerDay(),
+		formatStatsDuration(metrics.GetLeadTimeSeconds()),
+		metrics.GetChangeFailureRate()*100,
+		metrics.GetIncidents(),
+		formatStatsDuration(metrics.GetMttrSeconds()),
+	)
+}
+
+func parseDoraInterval(value string) (openapi_client.CanvasesDoraInterval, error) {
+	switch strings.ToLower(strings.TrimSpace(value)) {
+	case "", "day":
+		return openapi_client.CANVASESDORAINTERVAL_DORA_INTERVAL_DAY, nil
+	case "week":
+		return openapi_client.CANVASESDORAINTERVAL_DORA_INTERVAL_WEEK, nil
+	}
+
+	return "", fmt.Errorf("invalid --interval %q: must be day or week", value)
+}
+
+type doraSettingsCommand struct {
+	deployments *[]string
+	incidents   *[]string
+	clear       *bool
+}
+
+func (c *doraSettingsCommand) Execute(ctx core.CommandContext) error {
+	canvasID, err := doraCanvasID(ctx)
+	if err != nil {
+		return err
+	}
+
+	var settings openapi_client.CanvasesDoraSettings
+	if len(*c.deployments) == 0 && len(*c.incidents) == 0 && !*c.clear {
+		response, _, err := ctx.API.CanvasAPI.CanvasesGetCanvasDoraSettings(ctx.Context, canvasID).Execute()
+		if err != nil {
+			return err
+		}
+
+		settings = response.GetSettings()
+	} else {
+		body := openapi_client.CanvasesUpdateCanvasDoraSettingsBody{}
+		body.SetSettings(parseDoraSettingsFlags(*c.deployments, *c.incidents))
+
+		response, _, err := ctx.API.CanvasAPI.
+			CanvasesUpdateCanvasDoraSettings(ctx.Context, canvasID).
+			Body(body).
+			Execute()
+
+		if err != nil {
+			return err
+		}
+
+		settings = response.GetSettings()
+	}
+
+	if !ctx.Renderer.IsText() {
+		return ctx.Renderer.Render(settings)
+	}
+
+	return ctx.Renderer.RenderText(func(stdout io.Writer) error {
+		writer := tabwriter.NewWriter(stdout, 0, 8, 2, ' ', 0)
+		_, _ = fmt.Fprintln(writer, "NODE\tTYPE\tFIELDS")
+
+		for _, node := range settings.GetDeploymentNodes() {
+			_, _ = fmt.Fprintf(writer, "%s\tdeployment\tcommit=%s\n", node.GetNodeId(), node.GetCommitTimestampField())
+		}
+
+		for _, node := range settings.GetIncidentNodes() {
+			_, _ = fmt.Fprintf(writer, "%s\tincident\tstarted=%s resolved=%s\n", node.GetNodeId(), node.GetStartedAtField(), node.GetResolvedAtField())
+		}
+
+		return writer.Flush()
+	})
+}
+
+//
+// Deployments are passed as node[=commit-timestamp-field],
+// and incidents as node[=started-at-field[,resolved-at-field]].
+//
+
+func parseDoraSettingsFlags(deployments, incidents []string) openapi_client.CanvasesDoraSettings {
+	settings := openapi_client.CanvasesDoraSettings{}
+	deploymentNodes := []openapi_client.DoraSettingsDeploymentNode{}
+	for _, value := range deployments {
+		nodeID, field, _ := strings.Cut(value, "=")
+		node := openapi_client.DoraSettingsDeploymentNode{}
+		node.SetNodeId(strings.TrimSpace(nodeID))
+		node.SetCommitTimestampField(strings.TrimSpace(field))
+		deploymentNodes = append(deploymentNodes, node)
+	}
+
+	incidentNodes := []openapi_client.DoraSettingsIncidentNode{}
+	for _, value := range incidents {
+		nodeID, fields, _ := strings.Cut(value, "=")
+		startedAt, resolvedAt, _ := strings.Cut(fields, ",")
+		node := openapi_client.DoraSettingsIncidentNode{}
+		node.SetNodeId(strings.TrimSpace(nodeID))
+		node.SetStartedAtField(strings.TrimSpace(startedAt))
+		node.SetResolvedAtField(strings.TrimSpace(resolvedAt))
+		incidentNodes = append(incidentNodes, node)
+	}
+
+	settings.SetDeploymentNodes(deploymentNodes)
+	settings.SetIncidentNodes(incidentNodes)
+	return settings
+}
+
+func doraCanvasID(ctx core.CommandContext) (string, error) {
+	target := ""
+	if len(ctx.Args) == 1 {
+		target = ctx.Args[0]
+	} else if ctx.Config != nil {
+		target = strings.TrimSpace(ctx.Config.GetActiveCanvas())
+	}
+
+	if target == "" {
+		return "", fmt.Errorf("<name-or-id> (or an active canvas) is required")
+	}
+
+	return findCanvasID(ctx, ctx.API, target)
+}
@@ -3,6 +3,7 @@ package canvases
 import (
 	"fmt"
 	"io"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/superplanehq/superplane/pkg/cli/commands/canvases/models"
@@ -10,9 +11,22 @@ import (
 	"github.com/superplanehq/superplane/pkg/openapi_client"
 )
 
-type getCommand struct{}
+type getCommand struct {
+	Watch       *bool
+	ExecutionID *string
+}
 
 func (c *getCommand) Execute(ctx core.CommandContext) error {
+	watch := c.Watch != nil && *c.Watch
+	executionID := ""
+	if c.ExecutionID != nil {
+		executionID = *c.ExecutionID
+	}
+
+	if executionID != "" && !watch {
+		return fmt.Errorf("--execution-id can only be used with --watch")
+	}
+
 	canvasID, err := findCanvasID(ctx, ctx.API, ctx.Args[0])
 	if err != nil {
 		return err
@@ -23,7 +37,30 @@ func (c *getCommand) Execute(ctx core.CommandContext) error {
 		return err
 	}
 
-	resource := models.CanvasResourceFromCanvas(*response.Canvas)
+	err = c.render(ctx, models.CanvasResourceFromCanvas(*response.Canvas))
+	if err != nil {
+		return err
+	}
+
+	if !watch {
+		return nil
+	}
+
+	//
+	// The stream of an execution is ended by the server once the execution finishes.
+	// The stream of a canvas goes on until the command is interrupted.
+	//
+	path := core.CanvasWatchPath(canvasID)
+	if executionID != "" {
+		path = core.ExecutionWatchPath(canvasID, executionID)
+	}
+
+	return core.WatchUntilClosed(ctx.Context, ctx.API, path, func(change openapi_client.CanvasesCanvasChange) error {
+		return renderChange(ctx, change)
+	})
+}
+
+func (c *getCommand) render(ctx core.CommandContext, resource models.Canvas) error {
 	if !ctx.Renderer.IsText() {
 		return ctx.Renderer.Render(resource)
 	}
@@ -37,6 +74,47 @@ func (c *getCommand) Execute(ctx core.CommandContext) error {
 	})
 }
 
+func renderChange(ctx core.CommandContext, change openapi_client.CanvasesCanvasChange) error {
+	if !ctx.Renderer.IsText() {
+		return ctx.Renderer.Render(change)
+	}
+
+	return ctx.Renderer.RenderText(func(stdout io.Writer) error {
+		timestamp := change.GetTimestamp().Format(time.RFC3339)
+		switch {
+		case change.Execution != nil:
+			execution := change.GetExecution()
+			_, err := fmt.Fprintf(
+				stdout,
+				"%s execution %s node=%s state=%s result=%s\n",
+				timestamp,
+				execution.GetId(),
+				execution.GetNodeId(),
+				execution.GetState(),
+				execution.GetResult(),
+			)
+			return err
+
+		case change.Event != nil:
+			event := change.GetEvent()
+			_, err := fmt.Fprintf(stdout, "%s event %s node=%s channel=%s\n", timestamp, event.GetId(), event.GetNodeId(), event.GetChannel())
+			return err
+
+		case change.QueueItem != nil:
+			queueItem := change.GetQueueItem()
+			action := "queued"
+			if change.GetType() == openapi_client.CANVASESCANVASCHANGETYPE_CANVAS_CHANGE_TYPE_QUEUE_ITEM_DELETED {
+				action = "dequeued"
+			}
+
+			_, err := fmt.Fprintf(stdout, "%s queue item %s node=%s %s\n", timestamp, queueItem.GetId(), queueItem.GetNodeId(), action)
+			return err
+		}
+
+		return nil
+	})
+}
+
 func findCanvasID(ctx core.CommandContext, client *openapi_client.APIClient, nameOrID string) (string, error) {
 	if _, err := uuid.Parse(nameOrID); err == nil {
 		return nameOrID, nil
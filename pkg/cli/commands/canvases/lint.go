@@ -0,0 +1,217 @@
+package canvases
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/superplanehq/superplane/pkg/canvaslint"
+	"github.com/superplanehq/superplane/pkg/cli/commands/canvases/models"
+	"github.com/superplanehq/superplane/pkg/cli/commands/resources"
+	"github.com/superplanehq/superplane/pkg/cli/core"
+	"github.com/superplanehq/superplane/pkg/openapi_client"
+)
+
+type lintCommand struct {
+	strict *bool
+}
+
+type lintResult struct {
+	File     string               `json:"file"`
+	Canvas   string               `json:"canvas,omitempty"`
+	Problems []canvaslint.Problem `json:"problems"`
+}
+
+func (c *lintCommand) Execute(ctx core.CommandContext) error {
+	catalog, err := canvaslint.DefaultCatalog()
+	if err != nil {
+		return err
+	}
+
+	files, err := findCanvasFiles(ctx.Args)
+	if err != nil {
+		return err
+	}
+
+	results := []lintResult{}
+	for _, file := range files {
+		results = append(results, lintFile(file.path, file.explicit, catalog)...)
+	}
+
+	if len(results) == 0 {
+		return fmt.Errorf("no canvases found")
+	}
+
+	errors, warnings := 0, 0
+	for _, result := range results {
+		for _, problem := range result.Problems {
+			if problem.IsError() {
+				errors++
+			} else {
+				warnings++
+			}
+		}
+	}
+
+	err = renderLintResults(ctx, results, errors, warnings)
+	if err != nil {
+		return err
+	}
+
+	if errors > 0 || (*c.strict && warnings > 0) {
+		return fmt.Errorf("found %d errors and %d warnings", errors, warnings)
+	}
+
+	return nil
+}
+
+type canvasFile struct {
+	path     string
+	explicit bool
+}
+
+//
+// Files given as arguments must have canvases.
+// Directories are searched for .yaml and .yml files,
+// and documents of other kinds in them are skipped.
+//
+
+func findCanvasFiles(args []string) ([]canvasFile, error) {
+	files := []canvasFile{}
+	for _, arg := range args {
+		info, err := os.Stat(arg)
+		if err != nil {
+			return nil, err
+		}
+
+		if !info.IsDir() {
+			files = append(files, canvasFile{path: arg, explicit: true})
+			continue
+		}
+
+		found := []canvasFile{}
+		err = filepath.WalkDir(arg, func(path string, entry os.DirEntry, err error) error {
+			if err != nil {
+				return err
+			}
+
+			if !entry.IsDir() && (strings.HasSuffix(path, ".yaml") || strings.HasSuffix(path, ".yml")) {
+				found = append(found, canvasFile{path: path})
+			}
+
+			return nil
+		})
+
+		if err != nil {
+			return nil, err
+		}
+
+		sort.Slice(found, func(i, j int) bool { return found[i].path < found[j].path })
+		files = append(files, found...)
+	}
+
+	return files, nil
+}
+
+func lintFile(path string, explicit bool, catalog *canvaslint.Catalog) []lintResult {
+	// #nosec
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return []lintResult{fileProblem(path, fmt.Sprintf("failed to read file: %v", err))}
+	}
+
+	results := []lintResult{}
+	for _, document := range resources.SplitDocuments(data) {
+		_, kind, err := core.ParseYamlResourceHeaders(document)
+		if err != nil {
+			if explicit {
+				results = append(results, fileProblem(path, err.Error()))
+			}
+
+			continue
+		}
+
+		if kind != models.CanvasKind {
+			continue
+		}
+
+		canvas, err := models.ParseCanvas(document)
+		if err != nil {
+			results = append(results, fileProblem(path, err.Error()))
+			continue
+		}
+
+		spec := openapi_client.CanvasesCanvasSpec{}
+		if canvas.Spec != nil {
+			spec = *canvas.Spec
+		}
+
+		results = append(results, lintResult{
+			File:     path,
+			Canvas:   canvas.Metadata.GetName(),
+			Problems: canvaslint.Lint(spec, catalog),
+		})
+	}
+
+	if explicit && len(results) == 0 {
+		return []lintResult{fileProblem(path, "no canvases in file")}
+	}
+
+	return results
+}
+
+func fileProblem(path, message string) lintResult {
+	return lintResult{
+		File:     path,
+		Problems: []canvaslint.Problem{{Severity: canvaslint.SeverityError, Message: message}},
+	}
+}
+
+func renderLintResults(ctx core.CommandContext, results []lintResult, errors, warnings int) error {
+	if !ctx.Renderer.IsText() {
+		return ctx.Renderer.Render(results)
+	}
+
+	return ctx.Renderer.RenderText(func(stdout io.Writer) error {
+		for _, result := range results {
+			name := result.File
+			if result.Canvas != "" {
+				name = fmt.Sprintf("%s (%s)", result.Canvas, result.File)
+			}
+
+			if len(result.Problems) == 0 {
+				_, _ = fmt.Fprintf(stdout, "%s: ok\n", name)
+				continue
+			}
+
+			_, _ = fmt.Fprintf(stdout, "%s\n", name)
+			for _, problem := range result.Problems {
+				_, _ = fmt.Fprintf(stdout, "  %s\n", problem)
+			}
+		}
+
+		_, _ = fmt.Fprintf(stdout, "\n%d errors, %d warnings\n", errors, warnings)
+		return nil
+	})
+}
+
+type schemaCommand struct{}
+
+func (c *schemaCommand) Execute(ctx core.CommandContext) error {
+	catalog, err := canvaslint.DefaultCatalog()
+	if err != nil {
+		return err
+	}
+
+	data, err := json.MarshalIndent(canvaslint.Schema(catalog), "", "  ")
+	if err != nil {
+		return err
+	}
+
+	_, err = fmt.Fprintln(ctx.Cmd.OutOrStdout(), string(data))
+	return err
+}
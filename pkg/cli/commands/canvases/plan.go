@@ -0,0 +1,166 @@
+package canvases
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/superplanehq/superplane/pkg/openapi_client"
+)
+
+var planSymbols = map[openapi_client.CanvasPlanAction]string{
+	openapi_client.CANVASPLANACTION_ACTION_ADD:    "+",
+	openapi_client.CANVASPLANACTION_ACTION_CHANGE: "~",
+	openapi_client.CANVASPLANACTION_ACTION_REMOVE: "-",
+}
+
+func planHasChanges(plan openapi_client.CanvasesCanvasPlan) bool {
+	return len(plan.GetCanvas()) > 0 || len(plan.GetNodes()) > 0 || len(plan.GetEdges()) > 0
+}
+
+//
+// renderCanvasPlan prints a plan like terraform plan does:
+// one line per change, with the changed fields under each node,
+// followed by what the update does outside of the canvas.
+//
+
+func renderCanvasPlan(stdout io.Writer, plan openapi_client.CanvasesCanvasPlan, canvas openapi_client.CanvasesCanvas) {
+	if !planHasChanges(plan) {
+		_, _ = fmt.Fprintln(stdout, "No changes to nodes or edges.")
+		return
+	}
+
+	if len(plan.GetCanvas()) > 0 {
+		_, _ = fmt.Fprintln(stdout, "Canvas:")
+		for _, field := range plan.GetCanvas() {
+			_, _ = fmt.Fprintf(stdout, "  ~ %s\n", formatFieldChange(field))
+		}
+
+		_, _ = fmt.Fprintln(stdout)
+	}
+
+	if len(plan.GetNodes()) > 0 {
+		_, _ = fmt.Fprintln(stdout, "Nodes:")
+		for _, node := range plan.GetNodes() {
+			_, _ = fmt.Fprintf(stdout, "  %s %s\n", planSymbols[node.GetAction()], formatPlanNode(node.GetNodeName(), node.GetNodeId(), node.GetRef()))
+			for _, field := range node.GetFields() {
+				_, _ = fmt.Fprintf(stdout, "      %s\n", formatFieldChange(field))
+			}
+		}
+
+		_, _ = fmt.Fprintln(stdout)
+	}
+
+	if len(plan.GetEdges()) > 0 {
+		_, _ = fmt.Fprintln(stdout, "Edges:")
+		for _, edge := range plan.GetEdges() {
+			_, _ = fmt.Fprintf(stdout, "  %s %s -> %s (%s)\n", planSymbols[edge.GetAction()], edge.GetSourceId(), edge.GetTargetId(), edge.GetChannel())
+		}
+
+		_, _ = fmt.Fprintln(stdout)
+	}
+
+	if len(plan.GetWebhooks()) > 0 {
+		_, _ = fmt.Fprintln(stdout, "Webhooks:")
+		for _, webhook := range plan.GetWebhooks() {
+			_, _ = fmt.Fprintf(stdout, "  %s %s: %s\n", planSymbols[webhook.GetAction()], webhook.GetNodeId(), describeWebhookChange(webhook))
+		}
+
+		_, _ = fmt.Fprintln(stdout)
+	}
+
+	if len(plan.GetSubscriptions()) > 0 {
+		_, _ = fmt.Fprintln(stdout, "Integration subscriptions:")
+		for _, subscription := range plan.GetSubscriptions() {
+			_, _ = fmt.Fprintf(stdout, "  %s %s: %s\n", planSymbols[subscription.GetAction()], subscription.GetNodeId(), describeSubscriptionChange(subscription))
+		}
+
+		_, _ = fmt.Fprintln(stdout)
+	}
+
+	if len(plan.GetOrphaned()) > 0 {
+		_, _ = fmt.Fprintln(stdout, "Orphaned work:")
+		for _, node := range plan.GetOrphaned() {
+			_, _ = fmt.Fprintf(
+				stdout,
+				"  ! %s: %s running executions and %s queue items will not be processed\n",
+				node.GetNodeId(),
+				node.GetRunningExecutions(),
+				node.GetQueueItems(),
+			)
+		}
+
+		_, _ = fmt.Fprintln(stdout)
+	}
+
+	if canvas.Spec != nil {
+		errors := []string{}
+		for _, node := range canvas.Spec.GetNodes() {
+			if node.GetErrorMessage() != "" {
+				errors = append(errors, fmt.Sprintf("  ! %s: %s", node.GetId(), node.GetErrorMessage()))
+			}
+		}
+
+		if len(errors) > 0 {
+			_, _ = fmt.Fprintln(stdout, "Nodes with errors, which will not run:")
+			_, _ = fmt.Fprintln(stdout, strings.Join(errors, "\n"))
+			_, _ = fmt.Fprintln(stdout)
+		}
+	}
+
+	_, _ = fmt.Fprintln(stdout, planSummary(plan))
+}
+
+func planSummary(plan openapi_client.CanvasesCanvasPlan) string {
+	counts := map[openapi_client.CanvasPlanAction]int{}
+	for _, node := range plan.GetNodes() {
+		counts[node.GetAction()]++
+	}
+
+	return fmt.Sprintf(
+		"Plan: %d nodes to add, %d to change, %d to remove, %d edges changed.",
+		counts[openapi_client.CANVASPLANACTION_ACTION_ADD],
+		counts[openapi_client.CANVASPLANACTION_ACTION_CHANGE],
+		counts[openapi_client.CANVASPLANACTION_ACTION_REMOVE],
+		len(plan.GetEdges()),
+	)
+}
+
+func formatPlanNode(name, id, ref string) string {
+	if name == "" || name == id {
+		return fmt.Sprintf("%s (%s)", id, ref)
+	}
+
+	return fmt.Sprintf("%s (%s, %s)", name, id, ref)
+}
+
+func formatFieldChange(field openapi_client.CanvasPlanFieldChange) string {
+	return fmt.Sprintf("%s: %s -> %s", field.GetPath(), formatPlanValue(field.GetBefore()), formatPlanValue(field.GetAfter()))
+}
+
+func formatPlanValue(value string) string {
+	if value == "" {
+		return "(none)"
+	}
+
+	return value
+}
+
+func describeWebhookChange(webhook openapi_client.CanvasPlanWebhookChange) string {
+	switch webhook.GetAction() {
+	case openapi_client.CANVASPLANACTION_ACTION_ADD:
+		return "a webhook will be provisioned"
+	case openapi_client.CANVASPLANACTION_ACTION_REMOVE:
+		return fmt.Sprintf("webhook %s will be torn down", webhook.GetWebhookId())
+	default:
+		return fmt.Sprintf("webhook %s may be reconfigured", webhook.GetWebhookId())
+	}
+}
+
+func describeSubscriptionChange(subscription openapi_client.CanvasPlanSubscriptionChange) string {
+	if subscription.GetAction() == openapi_client.CANVASPLANACTION_ACTION_REMOVE {
+		return fmt.Sprintf("subscription to integration %s will be deleted", subscription.GetIntegrationId())
+	}
+
+	return fmt.Sprintf("subscription to integration %s will be set up again", subscription.GetIntegrationId())
+}
@@ -1,6 +1,8 @@
 package canvases
 
 import (
+	"time"
+
 	"github.com/spf13/cobra"
 	"github.com/superplanehq/superplane/pkg/cli/core"
 )
@@ -19,12 +21,26 @@ func NewCommand(options core.BindOptions) *cobra.Command {
 	}
 	core.Bind(listCmd, &listCommand{}, options)
 
+	var watch bool
+	var watchExecutionID string
 	getCmd := &cobra.Command{
 		Use:   "get <name-or-id>",
 		Short: "Get a canvas",
-		Args:  cobra.ExactArgs(1),
+		Long: `Shows a canvas.
+
+With --watch, the command keeps streaming the changes of the canvas,
+its events, executions and queue items, until it is interrupted.
+With --execution-id, only the changes of that execution and its child executions
+are streamed, and the command exits when the execution finishes.`,
+		Example: `  superplane canvases get my-canvas --watch --execution-id <execution-id>`,
+		Args:    cobra.ExactArgs(1),
 	}
-	core.Bind(getCmd, &getCommand{}, options)
+	getCmd.Flags().BoolVarP(&watch, "watch", "w", false, "stream the changes of the canvas after showing it")
+	getCmd.Flags().StringVar(&watchExecutionID, "execution-id", "", "with --watch, only stream the changes of this execution and exit when it finishes")
+	core.Bind(getCmd, &getCommand{
+		Watch:       &watch,
+		ExecutionID: &watchExecutionID,
+	}, options)
 
 	activeCmd := &cobra.Command{
 		Use:   "active [canvas-id]",
@@ -47,27 +63,132 @@ func NewCommand(options core.BindOptions) *cobra.Command {
 	var updateAutoLayout string
 	var updateAutoLayoutScope string
 	var updateAutoLayoutNodes []string
+	var updateDryRun bool
+	var updateYes bool
 	updateCmd := &cobra.Command{
 		Use:   "update [name-or-id]",
 		Short: "Update a canvas from a file",
+		Long:  "Shows the changes the update makes, including webhooks, integration subscriptions and queued work affected by removed nodes, and applies them after confirmation. Use --yes to apply them without confirmation, which is required with JSON or YAML output, or without a terminal.",
 		Args:  cobra.MaximumNArgs(1),
 	}
 	updateCmd.Flags().StringVarP(&updateFile, "file", "f", "", "filename, directory, or URL to files to use to update the resource")
 	updateCmd.Flags().StringVar(&updateAutoLayout, "auto-layout", "", "automatically arrange the canvas (supported: horizontal)")
 	updateCmd.Flags().StringVar(&updateAutoLayoutScope, "auto-layout-scope", "", "scope for auto layout (full-canvas, connected-component, exact-set)")
 	updateCmd.Flags().StringArrayVar(&updateAutoLayoutNodes, "auto-layout-node", nil, "node id seed for auto layout (repeatable)")
+	updateCmd.Flags().BoolVar(&updateDryRun, "dry-run", false, "show the changes without applying them")
+	updateCmd.Flags().BoolVarP(&updateYes, "yes", "y", false, "apply the changes without asking for confirmation")
 	core.Bind(updateCmd, &updateCommand{
 		file:            &updateFile,
 		autoLayout:      &updateAutoLayout,
 		autoLayoutScope: &updateAutoLayoutScope,
 		autoLayoutNodes: &updateAutoLayoutNodes,
+		dryRun:          &updateDryRun,
+		yes:             &updateYes,
+	}, options)
+
+	var runNode string
+	var runChannel string
+	var runInputs []string
+	runCmd := &cobra.Command{
+		Use:   "run [name-or-id]",
+		Short: "Run a canvas manually from its start trigger",
+		Long:  "Emits an event from the start trigger of the canvas. Inputs declared on the trigger are passed with --input, converted to their declared types and validated before the run starts.",
+		Args:  cobra.MaximumNArgs(1),
+	}
+	runCmd.Flags().StringVar(&runNode, "node", "", "id or name of the start trigger node (required if the canvas has more than one)")
+	runCmd.Flags().StringVar(&runChannel, "channel", "default", "output channel to emit the event on")
+	runCmd.Flags().StringArrayVar(&runInputs, "input", nil, "input value as key=value (repeatable)")
+	core.Bind(runCmd, &runCommand{
+		node:    &runNode,
+		channel: &runChannel,
+		inputs:  &runInputs,
 	}, options)
 
+	var statsSince time.Duration
+	statsCmd := &cobra.Command{
+		Use:   "stats [name-or-id]",
+		Short: "Show execution stats for a canvas and its nodes",
+		Long:  "Shows success rates, p50/p95 durations and throughput of the executions that finished in the given period.",
+		Args:  cobra.MaximumNArgs(1),
+	}
+	statsCmd.Flags().DurationVar(&statsSince, "since", 24*time.Hour, "period to aggregate executions over, ending now")
+	core.Bind(statsCmd, &statsCommand{since: &statsSince}, options)
+
+	doraCmd := &cobra.Command{
+		Use:   "dora",
+		Short: "DORA metrics computed from canvas runs",
+	}
+
+	var doraSince time.Duration
+	var doraInterval string
+	var doraCSV bool
+	doraMetricsCmd := &cobra.Command{
+		Use:   "metrics [name-or-id]",
+		Short: "Show the DORA metrics of a canvas",
+		Long:  "Shows deployment frequency, lead time for changes, change failure rate and MTTR for the deployment and incident nodes of the canvas. A deployment failed if an incident started after it, before the next deployment.",
+		Args:  cobra.MaximumNArgs(1),
+	}
+	doraMetricsCmd.Flags().DurationVar(&doraSince, "since", 30*24*time.Hour, "period to compute the metrics over, ending now")
+	doraMetricsCmd.Flags().StringVar(&doraInterval, "interval", "day", "interval of the time series (day, week)")
+	doraMetricsCmd.Flags().BoolVar(&doraCSV, "csv", false, "print the time series as CSV")
+	core.Bind(doraMetricsCmd, &doraMetricsCommand{since: &doraSince, interval: &doraInterval, csv: &doraCSV}, options)
+
+	var doraDeployments []string
+	var doraIncidents []string
+	var doraClear bool
+	doraSettingsCmd := &cobra.Command{
+		Use:   "settings [name-or-id]",
+		Short: "Show or replace the deployment and incident nodes of a canvas",
+		Long:  "Without flags, shows the current settings. With --deployment or --incident, replaces them. Fields are dot-separated paths into the root event data.",
+		Args:  cobra.MaximumNArgs(1),
+	}
+	doraSettingsCmd.Flags().StringArrayVar(&doraDeployments, "deployment", nil, "deployment node as node-id[=commit-timestamp-field] (repeatable)")
+	doraSettingsCmd.Flags().StringArrayVar(&doraIncidents, "incident", nil, "incident node as node-id[=started-at-field[,resolved-at-field]] (repeatable)")
+	doraSettingsCmd.Flags().BoolVar(&doraClear, "clear", false, "remove all deployment and incident nodes")
+	core.Bind(doraSettingsCmd, &doraSettingsCommand{deployments: &doraDeployments, incidents: &doraIncidents, clear: &doraClear}, options)
+
+	var testJUnit string
+	testCmd := &cobra.Command{
+		Use:   "test <file-or-directory>...",
+		Short: "Run canvas test suites",
+		Long:  "Runs the tests of each suite against an in-process engine, without a server. Nodes with external side effects need mocked outputs. Directories are searched for *.test.yaml files.",
+		Args:  cobra.MinimumNArgs(1),
+	}
+	testCmd.Flags().StringVar(&testJUnit, "junit", "", "write a JUnit XML report to this path (- for stdout)")
+	core.Bind(testCmd, &testCommand{junit: &testJUnit}, options)
+
+	var lintStrict bool
+	lintCmd := &cobra.Command{
+		Use:   "lint <file-or-directory>...",
+		Short: "Check canvas files without a server",
+		Long:  "Checks components, configurations, edge channels, expressions, cycles and unreachable nodes. Directories are searched for YAML files with Canvas resources.",
+		Args:  cobra.MinimumNArgs(1),
+	}
+	lintCmd.Flags().BoolVar(&lintStrict, "strict", false, "fail on warnings too")
+	core.Bind(lintCmd, &lintCommand{strict: &lintStrict}, options)
+
+	schemaCmd := &cobra.Command{
+		Use:   "schema",
+		Short: "Print the JSON Schema of canvas files",
+		Long:  "Prints the JSON Schema of canvas files, with the configuration of every component, for editor completion and validation.",
+		Args:  cobra.NoArgs,
+	}
+	core.Bind(schemaCmd, &schemaCommand{}, options)
+
+	doraCmd.AddCommand(doraMetricsCmd)
+	doraCmd.AddCommand(doraSettingsCmd)
+
 	root.AddCommand(listCmd)
 	root.AddCommand(getCmd)
 	root.AddCommand(activeCmd)
 	root.AddCommand(createCmd)
 	root.AddCommand(updateCmd)
+	root.AddCommand(runCmd)
+	root.AddCommand(statsCmd)
+	root.AddCommand(testCmd)
+	root.AddCommand(lintCmd)
+	root.AddCommand(schemaCmd)
+	root.AddCommand(doraCmd)
 
 	return root
 }
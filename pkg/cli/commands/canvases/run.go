@@ -0,0 +1,143 @@
+package canvases
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/superplanehq/superplane/pkg/cli/core"
+	"github.com/superplanehq/superplane/pkg/openapi_client"
+	manual "github.com/superplanehq/superplane/pkg/triggers/start"
+)
+
+const startTriggerName = "start"
+
+type runCommand struct {
+	node    *string
+	channel *string
+	inputs  *[]string
+}
+
+func (c *runCommand) Execute(ctx core.CommandContext) error {
+	target := ""
+	if len(ctx.Args) == 1 {
+		target = ctx.Args[0]
+	} else if ctx.Config != nil {
+		target = strings.TrimSpace(ctx.Config.GetActiveCanvas())
+	}
+
+	if target == "" {
+		return fmt.Errorf("<name-or-id> (or an active canvas) is required")
+	}
+
+	canvasID, err := findCanvasID(ctx, ctx.API, target)
+	if err != nil {
+		return err
+	}
+
+	inputs, err := core.ParseInputs(*c.inputs)
+	if err != nil {
+		return err
+	}
+
+	response, _, err := ctx.API.CanvasAPI.CanvasesDescribeCanvas(ctx.Context, canvasID).Execute()
+	if err != nil {
+		return err
+	}
+
+	canvas := response.GetCanvas()
+	spec := canvas.GetSpec()
+	node, err := findStartNode(spec.GetNodes(), *c.node)
+	if err != nil {
+		return err
+	}
+
+	inputs, err = startInputs(node, inputs)
+	if err != nil {
+		return err
+	}
+
+	body := openapi_client.CanvasesEmitNodeEventBody{}
+	body.SetChannel(*c.channel)
+	body.SetData(inputs)
+
+	result, _, err := ctx.API.CanvasNodeAPI.
+		CanvasesEmitNodeEvent(ctx.Context, canvasID, node.GetId()).
+		Body(body).
+		Execute()
+
+	if err != nil {
+		return err
+	}
+
+	if !ctx.Renderer.IsText() {
+		return ctx.Renderer.Render(result)
+	}
+
+	return ctx.Renderer.RenderText(func(stdout io.Writer) error {
+		_, err := fmt.Fprintf(stdout, "Started %s (event %s)\n", node.GetName(), result.GetEventId())
+		return err
+	})
+}
+
+//
+// The --input values are strings, so they are coerced into the types
+// of the inputs declared on the start trigger, and checked the same way
+// the server does, before the run is started.
+//
+
+func startInputs(node *openapi_client.ComponentsNode, inputs map[string]any) (map[string]any, error) {
+	spec, err := manual.DecodeSpec(node.GetConfiguration())
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the inputs of %s: %w", node.GetName(), err)
+	}
+
+	values, err := spec.ValidateInput(inputs)
+	if err != nil {
+		return nil, fmt.Errorf("invalid --input: %w", err)
+	}
+
+	return values, nil
+}
+
+//
+// Finds the start trigger node to run.
+// If no node is specified, the canvas must have exactly one start trigger.
+//
+
+func findStartNode(nodes []openapi_client.ComponentsNode, nodeIDOrName string) (*openapi_client.ComponentsNode, error) {
+	var candidates []openapi_client.ComponentsNode
+	for _, node := range nodes {
+		trigger, ok := node.GetTriggerOk()
+		if !ok || trigger.GetName() != startTriggerName {
+			continue
+		}
+
+		if nodeIDOrName == "" || node.GetId() == nodeIDOrName || node.GetName() == nodeIDOrName {
+			candidates = append(candidates, node)
+		}
+	}
+
+	if len(candidates) == 1 {
+		return &candidates[0], nil
+	}
+
+	if nodeIDOrName != "" {
+		if len(candidates) == 0 {
+			return nil, fmt.Errorf("start trigger %q not found", nodeIDOrName)
+		}
+
+		return nil, fmt.Errorf("multiple start triggers named %q found; use the node id", nodeIDOrName)
+	}
+
+	if len(candidates) == 0 {
+		return nil, fmt.Errorf("canvas has no start trigger")
+	}
+
+	names := make([]string, 0, len(candidates))
+	for _, candidate := range candidates {
+		names = append(names, fmt.Sprintf("%s (%s)", candidate.GetName(), candidate.GetId()))
+	}
+
+	return nil, fmt.Errorf("canvas has multiple start triggers, use --node to pick one of: %s", strings.Join(names, ", "))
+}
@@ -0,0 +1,91 @@
+package canvases
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/superplanehq/superplane/pkg/cli/core"
+	"github.com/superplanehq/superplane/pkg/openapi_client"
+)
+
+type statsCommand struct {
+	since *time.Duration
+}
+
+func (c *statsCommand) Execute(ctx core.CommandContext) error {
+	target := ""
+	if len(ctx.Args) == 1 {
+		target = ctx.Args[0]
+	} else if ctx.Config != nil {
+		target = strings.TrimSpace(ctx.Config.GetActiveCanvas())
+	}
+
+	if target == "" {
+		return fmt.Errorf("<name-or-id> (or an active canvas) is required")
+	}
+
+	if *c.since <= 0 {
+		return fmt.Errorf("--since must be a positive duration")
+	}
+
+	canvasID, err := findCanvasID(ctx, ctx.API, target)
+	if err != nil {
+		return err
+	}
+
+	to := time.Now()
+	response, _, err := ctx.API.CanvasAPI.
+		CanvasesGetCanvasStats(ctx.Context, canvasID).
+		From(to.Add(-*c.since)).
+		To(to).
+		Execute()
+
+	if err != nil {
+		return err
+	}
+
+	if !ctx.Renderer.IsText() {
+		return ctx.Renderer.Render(response)
+	}
+
+	return ctx.Renderer.RenderText(func(stdout io.Writer) error {
+		writer := tabwriter.NewWriter(stdout, 0, 8, 2, ' ', 0)
+		_, _ = fmt.Fprintln(writer, "NODE\tCOMPONENT\tTOTAL\tPASSED\tFAILED\tCANCELLED\tSUCCESS\tP50\tP95\tPER_HOUR")
+
+		for _, node := range response.GetNodes() {
+			name := node.GetNodeName()
+			if name == "" {
+				name = node.GetNodeId()
+			}
+
+			writeStatsRow(writer, name, node.GetComponent(), node.GetStats())
+		}
+
+		writeStatsRow(writer, "TOTAL", "", response.GetCanvas())
+		return writer.Flush()
+	})
+}
+
+func writeStatsRow(writer io.Writer, name, component string, stats openapi_client.CanvasesCanvasExecutionStats) {
+	_, _ = fmt.Fprintf(
+		writer,
+		"%s\t%s\t%s\t%s\t%s\t%s\t%.1f%%\t%s\t%s\t%.2f\n",
+		name,
+		component,
+		stats.GetTotal(),
+		stats.GetPassed(),
+		stats.GetFailed(),
+		stats.GetCancelled(),
+		stats.GetSuccessRate()*100,
+		formatStatsDuration(stats.GetP50DurationSeconds()),
+		formatStatsDuration(stats.GetP95DurationSeconds()),
+		stats.GetExecutionsPerHour(),
+	)
+}
+
+func formatStatsDuration(seconds float64) string {
+	return time.Duration(seconds * float64(time.Second)).Round(time.Millisecond).String()
+}
@@ -0,0 +1,153 @@
+package canvases
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/superplanehq/superplane/pkg/canvastest"
+	"github.com/superplanehq/superplane/pkg/cli/core"
+)
+
+type testCommand struct {
+	junit *string
+}
+
+func (c *testCommand) Execute(ctx core.CommandContext) error {
+	files, err := findTestFiles(ctx.Args)
+	if err != nil {
+		return err
+	}
+
+	results := []*canvastest.SuiteResult{}
+	for _, file := range files {
+		suite, err := canvastest.LoadSuite(file)
+		if err != nil {
+			return err
+		}
+
+		results = append(results, canvastest.RunSuite(suite))
+	}
+
+	if *c.junit != "" {
+		err := writeJUnit(ctx, *c.junit, results)
+		if err != nil {
+			return err
+		}
+	}
+
+	//
+	// With --junit -, stdout only has the report.
+	//
+	if *c.junit != "-" {
+		err := renderTestResults(ctx, results)
+		if err != nil {
+			return err
+		}
+	}
+
+	total, failed := 0, 0
+	for _, result := range results {
+		total += len(result.Tests)
+		failed += result.Failed()
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("%d of %d canvas tests failed", failed, total)
+	}
+
+	return nil
+}
+
+//
+// Directories are searched for files ending in .test.yaml or .test.yml.
+//
+
+func findTestFiles(args []string) ([]string, error) {
+	files := []string{}
+	for _, arg := range args {
+		info, err := os.Stat(arg)
+		if err != nil {
+			return nil, err
+		}
+
+		if !info.IsDir() {
+			files = append(files, arg)
+			continue
+		}
+
+		err = filepath.WalkDir(arg, func(path string, entry os.DirEntry, err error) error {
+			if err != nil {
+				return err
+			}
+
+			if !entry.IsDir() && (strings.HasSuffix(path, ".test.yaml") || strings.HasSuffix(path, ".test.yml")) {
+				files = append(files, path)
+			}
+
+			return nil
+		})
+
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if len(files) == 0 {
+		return nil, fmt.Errorf("no canvas test files found")
+	}
+
+	sort.Strings(files)
+	return files, nil
+}
+
+func writeJUnit(ctx core.CommandContext, path string, results []*canvastest.SuiteResult) error {
+	if path == "-" {
+		return canvastest.WriteJUnit(ctx.Cmd.OutOrStdout(), results)
+	}
+
+	// #nosec
+	file, err := os.Create(path)
+	if err != nil {
+		return fmt.Errorf("failed to create JUnit report: %w", err)
+	}
+
+	defer file.Close()
+	return canvastest.WriteJUnit(file, results)
+}
+
+func renderTestResults(ctx core.CommandContext, results []*canvastest.SuiteResult) error {
+	if !ctx.Renderer.IsText() {
+		return ctx.Renderer.Render(results)
+	}
+
+	return ctx.Renderer.RenderText(func(stdout io.Writer) error {
+		total, failed := 0, 0
+		for _, result := range results {
+			_, _ = fmt.Fprintf(stdout, "%s (%s)\n", result.Name, result.File)
+			for _, test := range result.Tests {
+				total++
+				if test.Passed() {
+					_, _ = fmt.Fprintf(stdout, "  PASS  %s\n", test.Name)
+					continue
+				}
+
+				failed++
+				_, _ = fmt.Fprintf(stdout, "  FAIL  %s\n", test.Name)
+				if test.Error != "" {
+					_, _ = fmt.Fprintf(stdout, "        %s\n", test.Error)
+				}
+
+				for _, failure := range test.Failures {
+					_, _ = fmt.Fprintf(stdout, "        %s\n", failure)
+				}
+			}
+		}
+
+		_, _ = fmt.Fprintf(stdout, "\n%d passed, %d failed\n", total-failed, failed)
+		return nil
+	})
+}
@@ -2,6 +2,7 @@ package canvases
 
 import (
 	"fmt"
+	"io"
 	"os"
 	"strings"
 
@@ -15,6 +16,8 @@ type updateCommand struct {
 	autoLayout      *string
 	autoLayoutScope *string
 	autoLayoutNodes *[]string
+	dryRun          *bool
+	yes             *bool
 }
 
 func (c *updateCommand) Execute(ctx core.CommandContext) error {
@@ -69,11 +72,89 @@ func (c *updateCommand) Execute(ctx core.CommandContext) error {
 		body.SetAutoLayout(*autoLayout)
 	}
 
-	_, _, err = ctx.API.CanvasAPI.
+	return c.planAndUpdate(ctx, canvasID, body)
+}
+
+//
+// The update is first sent as a dry run, to show its plan,
+// and applied after it is confirmed, unless --yes is given.
+//
+
+func (c *updateCommand) planAndUpdate(ctx core.CommandContext, canvasID string, body openapi_client.CanvasesUpdateCanvasBody) error {
+	dryRun := c.dryRun != nil && *c.dryRun
+	yes := c.yes != nil && *c.yes
+
+	body.SetDryRun(true)
+	response, _, err := ctx.API.CanvasAPI.
 		CanvasesUpdateCanvas(ctx.Context, canvasID).
 		Body(body).
 		Execute()
-	return err
+	if err != nil {
+		return err
+	}
+
+	plan := response.GetPlan()
+	if !ctx.Renderer.IsText() {
+		if dryRun {
+			return ctx.Renderer.Render(plan)
+		}
+
+		if !yes {
+			return fmt.Errorf("--yes is required to update a canvas without text output")
+		}
+
+		return c.update(ctx, canvasID, body)
+	}
+
+	err = ctx.Renderer.RenderText(func(stdout io.Writer) error {
+		renderCanvasPlan(stdout, plan, response.GetCanvas())
+		return nil
+	})
+	if err != nil || dryRun {
+		return err
+	}
+
+	//
+	// Plans leave out positions, so updates without changes
+	// are still applied, for the layout, but don't need confirmation.
+	//
+	if !yes && planHasChanges(plan) {
+		confirmed, err := core.ConfirmChanges(ctx)
+		if err != nil {
+			return err
+		}
+
+		if !confirmed {
+			return fmt.Errorf("update cancelled")
+		}
+	}
+
+	err = c.update(ctx, canvasID, body)
+	if err != nil {
+		return err
+	}
+
+	return ctx.Renderer.RenderText(func(stdout io.Writer) error {
+		_, _ = fmt.Fprintf(stdout, "Canvas %s updated.\n", canvasID)
+		return nil
+	})
+}
+
+func (c *updateCommand) update(ctx core.CommandContext, canvasID string, body openapi_client.CanvasesUpdateCanvasBody) error {
+	body.SetDryRun(false)
+	response, _, err := ctx.API.CanvasAPI.
+		CanvasesUpdateCanvas(ctx.Context, canvasID).
+		Body(body).
+		Execute()
+	if err != nil {
+		return err
+	}
+
+	if ctx.Renderer.IsText() {
+		return nil
+	}
+
+	return ctx.Renderer.Render(response.GetCanvas())
 }
 
 func loadCanvasFromFile(filePath string) (string, openapi_client.CanvasesCanvas, error) {
@@ -0,0 +1,60 @@
+package events
+
+import (
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/superplanehq/superplane/pkg/cli/core"
+	"github.com/superplanehq/superplane/pkg/openapi_client"
+)
+
+type EmitEventCommand struct {
+	Channel *string
+	Data    *string
+	Wait    *bool
+	Timeout *time.Duration
+}
+
+func (c *EmitEventCommand) Execute(ctx core.CommandContext) error {
+	data, err := core.ParseData(*c.Data)
+	if err != nil {
+		return err
+	}
+
+	canvasID, err := core.FindCanvasID(ctx, ctx.Args[0])
+	if err != nil {
+		return err
+	}
+
+	node, err := core.FindCanvasNode(ctx, canvasID, ctx.Args[1])
+	if err != nil {
+		return err
+	}
+
+	body := openapi_client.CanvasesEmitNodeEventBody{}
+	body.SetChannel(*c.Channel)
+	body.SetData(data)
+
+	response, _, err := ctx.API.CanvasNodeAPI.
+		CanvasesEmitNodeEvent(ctx.Context, canvasID, node.GetId()).
+		Body(body).
+		Execute()
+
+	if err != nil {
+		return err
+	}
+
+	if *c.Wait {
+		return core.WaitForChain(ctx, canvasID, response.GetEventId(), *c.Timeout)
+	}
+
+	if !ctx.Renderer.IsText() {
+		return ctx.Renderer.Render(response)
+	}
+
+	return ctx.Renderer.RenderText(func(stdout io.Writer) error {
+		_, err := fmt.Fprintf(stdout, "Event emitted from %s on %s: %s\n", node.GetName(), *c.Channel, response.GetEventId())
+		return err
+	})
+}
@@ -1,6 +1,8 @@
 package events
 
 import (
+	"time"
+
 	"github.com/spf13/cobra"
 	"github.com/superplanehq/superplane/pkg/cli/core"
 )
@@ -11,10 +13,14 @@ func NewCommand(options core.BindOptions) *cobra.Command {
 	var eventID string
 	var limit int64
 	var before string
+	var channel string
+	var data string
+	var wait bool
+	var timeout time.Duration
 
 	root := &cobra.Command{
 		Use:     "events",
-		Short:   "List canvas events and executions",
+		Short:   "Emit and list canvas events and executions",
 		Aliases: []string{"event"},
 	}
 
@@ -53,8 +59,35 @@ func NewCommand(options core.BindOptions) *cobra.Command {
 		EventID:  &eventID,
 	}, options)
 
+	//
+	// Emit command
+	//
+	emitCmd := &cobra.Command{
+		Use:   "emit <canvas> <node>",
+		Short: "Emit an event from a node, starting a new execution chain",
+		Long: `Emits an event from a node, as if the node produced it.
+The canvas and the node can be given by name or ID.
+
+With --wait, the command blocks until the execution chain started by the event finishes,
+and exits with 0 if it passed, 1 if it failed and 2 if it was cancelled.`,
+		Example: `  superplane events emit my-canvas "GitHub Push" --data @payload.json
+  superplane events emit my-canvas "Manual Run" --data '{"ref": "main"}' --wait`,
+		Args: cobra.ExactArgs(2),
+	}
+	emitCmd.Flags().StringVar(&channel, "channel", "default", "output channel to emit the event on")
+	emitCmd.Flags().StringVar(&data, "data", "", "event data as a JSON object, or @file to read it from a file")
+	emitCmd.Flags().BoolVar(&wait, "wait", false, "wait for the execution chain to finish and exit with its outcome")
+	emitCmd.Flags().DurationVar(&timeout, "timeout", 0, "maximum time to wait with --wait, e.g. 10m (default no limit)")
+	core.Bind(emitCmd, &EmitEventCommand{
+		Channel: &channel,
+		Data:    &data,
+		Wait:    &wait,
+		Timeout: &timeout,
+	}, options)
+
 	root.AddCommand(listCmd)
 	root.AddCommand(listExecutionsCmd)
+	root.AddCommand(emitCmd)
 
 	return root
 }
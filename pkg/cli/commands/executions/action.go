@@ -0,0 +1,55 @@
+package executions
+
+import (
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/superplanehq/superplane/pkg/cli/core"
+	"github.com/superplanehq/superplane/pkg/openapi_client"
+)
+
+type InvokeActionCommand struct {
+	CanvasID   *string
+	Parameters *[]string
+	Wait       *bool
+	Timeout    *time.Duration
+}
+
+func (c *InvokeActionCommand) Execute(ctx core.CommandContext) error {
+	canvasID, err := core.ResolveCanvasID(ctx, *c.CanvasID)
+	if err != nil {
+		return err
+	}
+
+	executionID, actionName := ctx.Args[0], ctx.Args[1]
+	parameters, err := core.ParseParameters(*c.Parameters)
+	if err != nil {
+		return err
+	}
+
+	body := openapi_client.CanvasesInvokeNodeExecutionActionBody{}
+	body.SetParameters(parameters)
+
+	response, _, err := ctx.API.CanvasNodeExecutionAPI.
+		CanvasesInvokeNodeExecutionAction(ctx.Context, canvasID, executionID, actionName).
+		Body(body).
+		Execute()
+
+	if err != nil {
+		return err
+	}
+
+	if *c.Wait {
+		return core.WaitForChain(ctx, canvasID, response.GetRootEventId(), *c.Timeout)
+	}
+
+	if !ctx.Renderer.IsText() {
+		return ctx.Renderer.Render(response)
+	}
+
+	return ctx.Renderer.RenderText(func(stdout io.Writer) error {
+		_, err := fmt.Fprintf(stdout, "Action %s invoked on execution %s\n", actionName, executionID)
+		return err
+	})
+}
@@ -0,0 +1,201 @@
+package executions
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/superplanehq/superplane/pkg/cli/core"
+)
+
+type ListArtifactsCommand struct {
+	CanvasID    *string
+	ExecutionID *string
+}
+
+func (c *ListArtifactsCommand) Execute(ctx core.CommandContext) error {
+	canvasID, err := core.ResolveCanvasID(ctx, *c.CanvasID)
+	if err != nil {
+		return err
+	}
+
+	response, _, err := ctx.API.CanvasNodeExecutionAPI.
+		CanvasesListExecutionArtifacts(ctx.Context, canvasID, *c.ExecutionID).
+		Execute()
+
+	if err != nil {
+		return err
+	}
+
+	if !ctx.Renderer.IsText() {
+		return ctx.Renderer.Render(response)
+	}
+
+	return ctx.Renderer.RenderText(func(stdout io.Writer) error {
+		writer := tabwriter.NewWriter(stdout, 0, 8, 2, ' ', 0)
+		_, _ = fmt.Fprintln(writer, "NAME\tCONTENT_TYPE\tSIZE\tSHA256\tCREATED_AT")
+		for _, artifact := range response.GetArtifacts() {
+			_, _ = fmt.Fprintf(
+				writer,
+				"%s\t%s\t%s\t%s\t%s\n",
+				artifact.GetName(),
+				artifact.GetContentType(),
+				artifact.GetSize(),
+				artifact.GetSha256(),
+				artifact.GetCreatedAt().Format(time.RFC3339),
+			)
+		}
+
+		return writer.Flush()
+	})
+}
+
+type DownloadArtifactCommand struct {
+	CanvasID    *string
+	ExecutionID *string
+	Name        *string
+	Output      *string
+}
+
+//
+// downloadChunk is one message of the download stream.
+// The API streams artifacts in chunks, as JSON messages separated by newlines.
+//
+
+type downloadChunk struct {
+	Result *struct {
+		Content []byte `json:"content"`
+	} `json:"result"`
+	Error *struct {
+		Message string `json:"message"`
+	} `json:"error"`
+}
+
+func (c *DownloadArtifactCommand) Execute(ctx core.CommandContext) error {
+	canvasID, err := core.ResolveCanvasID(ctx, *c.CanvasID)
+	if err != nil {
+		return err
+	}
+
+	response, err := c.openDownload(ctx, canvasID)
+	if err != nil {
+		return err
+	}
+
+	defer response.Body.Close()
+
+	//
+	// "-" writes the artifact to stdout, so it can be piped to other commands.
+	// Without --output, the artifact is written to the current directory.
+	//
+	output := *c.Output
+	if output == "-" {
+		_, err := readDownload(response.Body, ctx.Cmd.OutOrStdout())
+		return err
+	}
+
+	if output == "" {
+		output = filepath.Base(*c.Name)
+	}
+
+	file, err := os.Create(output)
+	if err != nil {
+		return fmt.Errorf("failed to write artifact to %s: %w", output, err)
+	}
+
+	size, err := readDownload(response.Body, file)
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+
+	if err != nil {
+		_ = os.Remove(output)
+		return fmt.Errorf("failed to write artifact to %s: %w", output, err)
+	}
+
+	return ctx.Renderer.RenderText(func(stdout io.Writer) error {
+		_, err := fmt.Fprintf(stdout, "Downloaded %s (%d bytes) to %s\n", *c.Name, size, output)
+		return err
+	})
+}
+
+func (c *DownloadArtifactCommand) openDownload(ctx core.CommandContext, canvasID string) (*http.Response, error) {
+	config := ctx.API.GetConfig()
+	baseURL, err := config.ServerURLWithContext(ctx.Context, "CanvasNodeExecutionAPIService.CanvasesDownloadExecutionArtifact")
+	if err != nil {
+		return nil, err
+	}
+
+	endpoint := fmt.Sprintf(
+		"%s/api/v1/canvases/%s/executions/%s/artifacts/download?name=%s",
+		strings.TrimRight(baseURL, "/"),
+		url.PathEscape(canvasID),
+		url.PathEscape(*c.ExecutionID),
+		url.QueryEscape(*c.Name),
+	)
+
+	request, err := http.NewRequestWithContext(ctx.Context, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	for header, value := range config.DefaultHeader {
+		request.Header.Set(header, value)
+	}
+
+	request.Header.Set("Accept", "application/json")
+
+	//
+	// The client of the API has a timeout for each request,
+	// which would end the download of large artifacts, so it uses a client without it.
+	//
+	client := &http.Client{}
+	if config.HTTPClient != nil {
+		client.Transport = config.HTTPClient.Transport
+	}
+
+	return client.Do(request)
+}
+
+//
+// readDownload writes the content of the download stream to w,
+// and returns the size of the artifact.
+//
+
+func readDownload(body io.Reader, w io.Writer) (int64, error) {
+	decoder := json.NewDecoder(body)
+	size := int64(0)
+	for {
+		chunk := downloadChunk{}
+		err := decoder.Decode(&chunk)
+		if err == io.EOF {
+			return size, nil
+		}
+
+		if err != nil {
+			return size, fmt.Errorf("invalid download stream: %w", err)
+		}
+
+		if chunk.Error != nil {
+			return size, errors.New(chunk.Error.Message)
+		}
+
+		if chunk.Result == nil {
+			continue
+		}
+
+		n, err := w.Write(chunk.Result.Content)
+		size += int64(n)
+		if err != nil {
+			return size, err
+		}
+	}
+}
@@ -0,0 +1,98 @@
+package executions
+
+import (
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/superplanehq/superplane/pkg/cli/core"
+	"github.com/superplanehq/superplane/pkg/openapi_client"
+)
+
+const logsPollInterval = 2 * time.Second
+
+type ExecutionLogsCommand struct {
+	CanvasID    *string
+	ExecutionID *string
+	Follow      *bool
+}
+
+func (c *ExecutionLogsCommand) Execute(ctx core.CommandContext) error {
+	canvasID, err := core.ResolveCanvasID(ctx, *c.CanvasID)
+	if err != nil {
+		return err
+	}
+
+	after := ""
+	for {
+		request := ctx.API.CanvasNodeExecutionAPI.
+			CanvasesGetExecutionLogs(ctx.Context, canvasID, *c.ExecutionID)
+
+		if after != "" {
+			request = request.After(after)
+		}
+
+		response, _, err := request.Execute()
+		if err != nil {
+			return err
+		}
+
+		err = c.render(ctx, response.GetLogs())
+		if err != nil {
+			return err
+		}
+
+		if response.GetLastId() != "" {
+			after = response.GetLastId()
+		}
+
+		if response.GetHasMore() {
+			continue
+		}
+
+		//
+		// Without --follow, we stop after all the current logs are displayed.
+		// With --follow, we keep polling for new logs until the execution finishes.
+		//
+		if c.Follow == nil || !*c.Follow || response.GetFinished() {
+			return nil
+		}
+
+		select {
+		case <-ctx.Context.Done():
+			return ctx.Context.Err()
+		case <-time.After(logsPollInterval):
+		}
+	}
+}
+
+func (c *ExecutionLogsCommand) render(ctx core.CommandContext, logs []openapi_client.CanvasesCanvasNodeExecutionLog) error {
+	if !ctx.Renderer.IsText() {
+		for _, log := range logs {
+			err := ctx.Renderer.Render(log)
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+
+	return ctx.Renderer.RenderText(func(stdout io.Writer) error {
+		for _, log := range logs {
+			_, err := fmt.Fprintf(
+				stdout,
+				"%s %-6s %s\n",
+				log.GetCreatedAt().Format(time.RFC3339),
+				log.GetStream(),
+				log.GetMessage(),
+			)
+
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
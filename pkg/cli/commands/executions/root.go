@@ -1,6 +1,8 @@
 package executions
 
 import (
+	"time"
+
 	"github.com/spf13/cobra"
 	"github.com/superplanehq/superplane/pkg/cli/core"
 )
@@ -11,6 +13,12 @@ func NewCommand(options core.BindOptions) *cobra.Command {
 	var executionID string
 	var limit int64
 	var before string
+	var follow bool
+	var artifactName string
+	var output string
+	var parameters []string
+	var wait bool
+	var timeout time.Duration
 
 	root := &cobra.Command{
 		Use:     "executions",
@@ -48,8 +56,87 @@ func NewCommand(options core.BindOptions) *cobra.Command {
 		ExecutionID: &executionID,
 	}, options)
 
+	logsCmd := &cobra.Command{
+		Use:   "logs",
+		Short: "Show the logs of an execution",
+		Args:  cobra.NoArgs,
+	}
+	logsCmd.Flags().StringVar(&canvasID, "canvas-id", "", "canvas ID")
+	logsCmd.Flags().StringVar(&executionID, "execution-id", "", "execution ID")
+	logsCmd.Flags().BoolVarP(&follow, "follow", "f", false, "keep streaming new logs until the execution finishes")
+	_ = logsCmd.MarkFlagRequired("execution-id")
+	core.Bind(logsCmd, &ExecutionLogsCommand{
+		CanvasID:    &canvasID,
+		ExecutionID: &executionID,
+		Follow:      &follow,
+	}, options)
+
+	actionCmd := &cobra.Command{
+		Use:   "action <execution-id> <action>",
+		Short: "Invoke an action on an execution, like approving it",
+		Long: `Invokes an action of the component running an execution, like approve or reject for approvals.
+
+With --wait, the command blocks until the execution chain of the execution finishes,
+and exits with 0 if it passed, 1 if it failed and 2 if it was cancelled.`,
+		Example: `  superplane executions action <execution-id> approve --param index=0 --param comment="Looks good" --wait`,
+		Args:    cobra.ExactArgs(2),
+	}
+	actionCmd.Flags().StringVar(&canvasID, "canvas-id", "", "canvas ID")
+	actionCmd.Flags().StringArrayVar(&parameters, "param", nil, "action parameter as key=value (repeatable)")
+	actionCmd.Flags().BoolVar(&wait, "wait", false, "wait for the execution chain to finish and exit with its outcome")
+	actionCmd.Flags().DurationVar(&timeout, "timeout", 0, "maximum time to wait with --wait, e.g. 10m (default no limit)")
+	core.Bind(actionCmd, &InvokeActionCommand{
+		CanvasID:   &canvasID,
+		Parameters: &parameters,
+		Wait:       &wait,
+		Timeout:    &timeout,
+	}, options)
+
+	artifactsCmd := &cobra.Command{
+		Use:     "artifacts",
+		Short:   "Manage execution artifacts",
+		Aliases: []string{"artifact"},
+	}
+
+	listArtifactsCmd := &cobra.Command{
+		Use:   "list",
+		Short: "List the artifacts of an execution",
+		Args:  cobra.NoArgs,
+	}
+	listArtifactsCmd.Flags().StringVar(&canvasID, "canvas-id", "", "canvas ID")
+	listArtifactsCmd.Flags().StringVar(&executionID, "execution-id", "", "execution ID")
+	_ = listArtifactsCmd.MarkFlagRequired("execution-id")
+	core.Bind(listArtifactsCmd, &ListArtifactsCommand{
+		CanvasID:    &canvasID,
+		ExecutionID: &executionID,
+	}, options)
+
+	downloadArtifactCmd := &cobra.Command{
+		Use:   "download",
+		Short: "Download an artifact of an execution",
+		Args:  cobra.NoArgs,
+	}
+	downloadArtifactCmd.Flags().StringVar(&canvasID, "canvas-id", "", "canvas ID")
+	downloadArtifactCmd.Flags().StringVar(&executionID, "execution-id", "", "execution ID")
+	downloadArtifactCmd.Flags().StringVar(&artifactName, "name", "", "artifact name")
+	downloadArtifactCmd.Flags().StringVarP(&output, "output-file", "O", "", "file to write the artifact to, or - for stdout")
+	_ = downloadArtifactCmd.MarkFlagRequired("execution-id")
+	_ = downloadArtifactCmd.MarkFlagRequired("name")
+	core.Bind(downloadArtifactCmd, &DownloadArtifactCommand{
+		CanvasID:    &canvasID,
+		ExecutionID: &executionID,
+		Name:        &artifactName,
+		Output:      &output,
+	}, options)
+
+	artifactsCmd.AddCommand(listArtifactsCmd)
+	artifactsCmd.AddCommand(downloadArtifactCmd)
+
 	root.AddCommand(listCmd)
 	root.AddCommand(cancelCmd)
+	root.AddCommand(logsCmd)
+	root.AddCommand(actionCmd)
+	root.AddCommand(artifactsCmd)
 
 	return root
 }
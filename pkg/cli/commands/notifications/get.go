@@ -0,0 +1,68 @@
+package notifications
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/superplanehq/superplane/pkg/cli/core"
+	"github.com/superplanehq/superplane/pkg/openapi_client"
+)
+
+const channelTypePrefix = "NOTIFICATION_CHANNEL_TYPE_"
+
+type getCommand struct{}
+
+func (c *getCommand) Execute(ctx core.CommandContext) error {
+	response, _, err := ctx.API.MeAPI.MeGetNotificationPreferences(ctx.Context).Execute()
+	if err != nil {
+		return err
+	}
+
+	preferences := response.GetPreferences()
+	if !ctx.Renderer.IsText() {
+		return ctx.Renderer.Render(preferences)
+	}
+
+	return ctx.Renderer.RenderText(func(stdout io.Writer) error {
+		return renderPreferences(stdout, preferences)
+	})
+}
+
+func renderPreferences(stdout io.Writer, preferences openapi_client.MeNotificationPreferences) error {
+	writer := tabwriter.NewWriter(stdout, 0, 8, 2, ' ', 0)
+	_, _ = fmt.Fprintln(writer, "CHANNEL\tTARGET")
+
+	for _, channel := range preferences.GetChannels() {
+		_, _ = fmt.Fprintf(writer, "%s\t%s\n", formatChannelType(channel.GetType()), channel.GetTarget())
+	}
+
+	if err := writer.Flush(); err != nil {
+		return err
+	}
+
+	if len(preferences.GetChannels()) == 0 {
+		_, _ = fmt.Fprintln(stdout, "No channels, notifications are muted.")
+	}
+
+	quietHours := "none"
+	if preferences.HasQuietHours() {
+		hours := preferences.GetQuietHours()
+		quietHours = fmt.Sprintf("%s-%s (%s)", hours.GetStart(), hours.GetEnd(), preferences.GetTimezone())
+	}
+
+	digest := "off"
+	if preferences.GetDigestIntervalMinutes() > 0 {
+		digest = "every " + (time.Duration(preferences.GetDigestIntervalMinutes()) * time.Minute).String()
+	}
+
+	_, _ = fmt.Fprintf(stdout, "\nQuiet hours: %s\n", quietHours)
+	_, err := fmt.Fprintf(stdout, "Digest: %s\n", digest)
+	return err
+}
+
+func formatChannelType(channelType openapi_client.MeNotificationChannelType) string {
+	return strings.ToLower(strings.TrimPrefix(string(channelType), channelTypePrefix))
+}
@@ -0,0 +1,59 @@
+package notifications
+
+import (
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/superplanehq/superplane/pkg/cli/core"
+)
+
+func NewCommand(options core.BindOptions) *cobra.Command {
+	root := &cobra.Command{
+		Use:     "notifications",
+		Short:   "Manage how you receive notifications",
+		Aliases: []string{"notification"},
+	}
+
+	getCmd := &cobra.Command{
+		Use:   "get",
+		Short: "Show your notification preferences",
+		Args:  cobra.NoArgs,
+	}
+	core.Bind(getCmd, &getCommand{}, options)
+
+	updateCmd := &cobra.Command{
+		Use:   "update",
+		Short: "Update your notification preferences",
+		Long: `Update how you receive the notifications sent by components, like approvals.
+
+Channels are given as email, slack=<member-id>, telegram=<chat-id> or webhook=<url>,
+and replace your current channels. Slack and Telegram messages are sent through
+the Slack and Telegram integrations of the organization.
+
+Notifications sent during quiet hours are delivered when they end.
+With a digest interval, notifications are batched and sent at most once per interval.`,
+		Example: `  superplane notifications update --channel email --channel slack=U0123ABCD
+  superplane notifications update --quiet-hours 22:00-07:00 --timezone Europe/Berlin
+  superplane notifications update --digest 1h`,
+		Args: cobra.NoArgs,
+	}
+	var channels []string
+	var quietHours string
+	var timezone string
+	var digest time.Duration
+	updateCmd.Flags().StringArrayVar(&channels, "channel", nil, "channel to receive notifications on (repeatable)")
+	updateCmd.Flags().StringVar(&quietHours, "quiet-hours", "", "quiet hours as HH:MM-HH:MM, or empty to disable them")
+	updateCmd.Flags().StringVar(&timezone, "timezone", "", "timezone of the quiet hours, e.g. Europe/Berlin")
+	updateCmd.Flags().DurationVar(&digest, "digest", 0, "batch notifications into a digest sent at most once per interval, e.g. 30m (0 disables it)")
+	core.Bind(updateCmd, &updateCommand{
+		channels:   &channels,
+		quietHours: &quietHours,
+		timezone:   &timezone,
+		digest:     &digest,
+	}, options)
+
+	root.AddCommand(getCmd)
+	root.AddCommand(updateCmd)
+
+	return root
+}
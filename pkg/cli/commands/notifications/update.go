@@ -0,0 +1,108 @@
+package notifications
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/superplanehq/superplane/pkg/cli/core"
+	"github.com/superplanehq/superplane/pkg/openapi_client"
+)
+
+type updateCommand struct {
+	channels   *[]string
+	quietHours *string
+	timezone   *string
+	digest     *time.Duration
+}
+
+func (c *updateCommand) Execute(ctx core.CommandContext) error {
+	current, _, err := ctx.API.MeAPI.MeGetNotificationPreferences(ctx.Context).Execute()
+	if err != nil {
+		return err
+	}
+
+	preferences := current.GetPreferences()
+	flags := ctx.Cmd.Flags()
+
+	if flags.Changed("channel") {
+		channels, err := parseChannels(*c.channels)
+		if err != nil {
+			return err
+		}
+
+		preferences.SetChannels(channels)
+	}
+
+	if flags.Changed("quiet-hours") {
+		if *c.quietHours == "" {
+			preferences.QuietHours = nil
+		} else {
+			start, end, ok := strings.Cut(*c.quietHours, "-")
+			if !ok {
+				return fmt.Errorf("invalid quiet hours %q, expected HH:MM-HH:MM", *c.quietHours)
+			}
+
+			quietHours := openapi_client.MeQuietHours{}
+			quietHours.SetStart(strings.TrimSpace(start))
+			quietHours.SetEnd(strings.TrimSpace(end))
+			preferences.SetQuietHours(quietHours)
+		}
+	}
+
+	if flags.Changed("timezone") {
+		preferences.SetTimezone(*c.timezone)
+	}
+
+	if flags.Changed("digest") {
+		if *c.digest < 0 || *c.digest%time.Minute != 0 {
+			return fmt.Errorf("--digest must be a whole, non-negative number of minutes")
+		}
+
+		preferences.SetDigestIntervalMinutes(int32(*c.digest / time.Minute))
+	}
+
+	request := openapi_client.MeUpdateNotificationPreferencesRequest{}
+	request.SetPreferences(preferences)
+
+	response, _, err := ctx.API.MeAPI.MeUpdateNotificationPreferences(ctx.Context).Body(request).Execute()
+	if err != nil {
+		return err
+	}
+
+	if !ctx.Renderer.IsText() {
+		return ctx.Renderer.Render(response.GetPreferences())
+	}
+
+	return ctx.Renderer.RenderText(func(stdout io.Writer) error {
+		_, _ = fmt.Fprintln(stdout, "Notification preferences updated")
+		_, _ = fmt.Fprintln(stdout)
+		return renderPreferences(stdout, response.GetPreferences())
+	})
+}
+
+//
+// Channels are given as <type> or <type>=<target>.
+//
+
+func parseChannels(values []string) ([]openapi_client.MeNotificationChannel, error) {
+	channels := make([]openapi_client.MeNotificationChannel, 0, len(values))
+	for _, value := range values {
+		name, target, _ := strings.Cut(strings.TrimSpace(value), "=")
+		channelType := openapi_client.MeNotificationChannelType(channelTypePrefix + strings.ToUpper(name))
+		if !channelType.IsValid() || channelType == openapi_client.MENOTIFICATIONCHANNELTYPE_NOTIFICATION_CHANNEL_TYPE_UNSPECIFIED {
+			return nil, fmt.Errorf("invalid channel %q, expected email, slack=<member-id>, telegram=<chat-id> or webhook=<url>", value)
+		}
+
+		channel := openapi_client.MeNotificationChannel{}
+		channel.SetType(channelType)
+		if target != "" {
+			channel.SetTarget(target)
+		}
+
+		channels = append(channels, channel)
+	}
+
+	return channels, nil
+}
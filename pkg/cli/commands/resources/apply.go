@@ -0,0 +1,166 @@
+package resources
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/spf13/cobra"
+	"github.com/superplanehq/superplane/pkg/cli/core"
+)
+
+func NewApplyCommand(options core.BindOptions) *cobra.Command {
+	var files []string
+	var prune bool
+	var dryRun bool
+	var updateSecrets bool
+	var yes bool
+
+	cmd := &cobra.Command{
+		Use:   "apply -f <file-or-directory>",
+		Short: "Create, update and delete resources from files",
+		Long: "Applies the resources in multi-document YAML files: canvases, blueprints, secrets, integrations, groups and roles. " +
+			"The plan is printed and confirmed before anything changes, unless --yes is given. Resources are applied in dependency order, so blueprints are created before the canvases using them.",
+		Args: cobra.NoArgs,
+	}
+
+	cmd.Flags().StringArrayVarP(&files, "file", "f", nil, "file or directory with resources (repeatable)")
+	cmd.Flags().BoolVar(&prune, "prune", false, "delete resources of the kinds in the files that are not in the files")
+	cmd.Flags().BoolVar(&dryRun, "dry-run", false, "print the plan without applying it")
+	cmd.Flags().BoolVar(&updateSecrets, "update-secrets", false, "apply the values of existing secrets again, even if their keys didn't change")
+	cmd.Flags().BoolVarP(&yes, "yes", "y", false, "apply the changes without asking for confirmation")
+	_ = cmd.MarkFlagRequired("file")
+
+	core.Bind(cmd, &applyCommand{
+		files:         &files,
+		prune:         &prune,
+		dryRun:        &dryRun,
+		updateSecrets: &updateSecrets,
+		yes:           &yes,
+	}, options)
+
+	return cmd
+}
+
+type applyCommand struct {
+	files         *[]string
+	prune         *bool
+	dryRun        *bool
+	updateSecrets *bool
+	yes           *bool
+}
+
+type applyResult struct {
+	Plan    *Plan `json:"plan"`
+	Applied bool  `json:"applied"`
+}
+
+func (c *applyCommand) Execute(ctx core.CommandContext) error {
+	resources, err := LoadPaths(*c.files)
+	if err != nil {
+		return err
+	}
+
+	client := newClient(ctx)
+	plan, err := buildPlan(client, resources, planOptions{prune: *c.prune, updateSecrets: *c.updateSecrets})
+	if err != nil {
+		return err
+	}
+
+	if !ctx.Renderer.IsText() {
+		if *c.dryRun {
+			return ctx.Renderer.Render(applyResult{Plan: plan, Applied: false})
+		}
+
+		if !*c.yes && len(plan.Changes) > 0 {
+			return fmt.Errorf("--yes is required to apply resources without text output")
+		}
+
+		err := applyPlan(client, plan, nil)
+		if err != nil {
+			return err
+		}
+
+		return ctx.Renderer.Render(applyResult{Plan: plan, Applied: true})
+	}
+
+	err = ctx.Renderer.RenderText(func(stdout io.Writer) error {
+		renderPlan(stdout, plan)
+		return nil
+	})
+	if err != nil || *c.dryRun || len(plan.Changes) == 0 {
+		return err
+	}
+
+	//
+	// Deletions from --prune can't be undone,
+	// so the plan is confirmed before anything changes.
+	//
+	if !*c.yes {
+		confirmed, err := core.ConfirmChanges(ctx)
+		if err != nil {
+			return err
+		}
+
+		if !confirmed {
+			return fmt.Errorf("apply cancelled")
+		}
+	}
+
+	return ctx.Renderer.RenderText(func(stdout io.Writer) error {
+		_, _ = fmt.Fprintln(stdout)
+		return applyPlan(client, plan, stdout)
+	})
+}
+
+//
+// applyPlan stops at the first change that fails.
+// Changes applied before it are not reverted,
+// so running apply again continues from there.
+//
+
+func applyPlan(c *client, plan *Plan, stdout io.Writer) error {
+	for _, change := range plan.Changes {
+		k := findKind(change.Kind)
+
+		var err error
+		switch change.Action {
+		case ActionCreate:
+			change.Desired.id, err = k.create(c, change.Desired)
+		case ActionUpdate:
+			err = k.update(c, change.Current, change.Desired)
+		case ActionDelete:
+			err = k.delete(c, change.Current)
+		}
+
+		if err != nil {
+			return fmt.Errorf("failed to %s %s: %w", change.Action, change.Key(), err)
+		}
+
+		if stdout != nil {
+			_, _ = fmt.Fprintf(stdout, "%s: %sd\n", change.Key(), change.Action)
+		}
+	}
+
+	return nil
+}
+
+var actionSymbols = map[string]string{
+	ActionCreate: "+",
+	ActionUpdate: "~",
+	ActionDelete: "-",
+}
+
+func renderPlan(stdout io.Writer, plan *Plan) {
+	for _, change := range plan.Changes {
+		_, _ = fmt.Fprintf(stdout, "%s %s\n", actionSymbols[change.Action], change.Key())
+		for _, line := range change.Diff {
+			_, _ = fmt.Fprintf(stdout, "    %s\n", line)
+		}
+	}
+
+	if len(plan.Changes) > 0 {
+		_, _ = fmt.Fprintln(stdout)
+	}
+
+	_, _ = fmt.Fprintln(stdout, plan.Summary())
+}
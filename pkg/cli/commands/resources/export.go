@@ -0,0 +1,134 @@
+package resources
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/superplanehq/superplane/pkg/cli/core"
+)
+
+func NewExportCommand(options core.BindOptions) *cobra.Command {
+	var dir string
+	var kindFilter []string
+
+	cmd := &cobra.Command{
+		Use:   "export",
+		Short: "Export the resources of the organization to files",
+		Long: "Prints the resources of the organization as multi-document YAML, or writes one file per resource with --dir. " +
+			"The output can be applied with superplane apply. Secret values are not exported: they become references to environment variables.",
+		Args: cobra.NoArgs,
+	}
+
+	cmd.Flags().StringVar(&dir, "dir", "", "directory to write one file per resource to, in a directory per kind")
+	cmd.Flags().StringArrayVar(&kindFilter, "kind", nil, "only export this kind of resource (repeatable)")
+	core.Bind(cmd, &exportCommand{dir: &dir, kinds: &kindFilter}, options)
+
+	return cmd
+}
+
+type exportCommand struct {
+	dir   *string
+	kinds *[]string
+}
+
+func (c *exportCommand) Execute(ctx core.CommandContext) error {
+	exported := map[string]bool{}
+	for _, name := range *c.kinds {
+		if findKind(name) == nil {
+			return fmt.Errorf("unsupported resource kind %q, supported kinds are %s", name, strings.Join(kindNames(), ", "))
+		}
+
+		exported[name] = true
+	}
+
+	resources, err := exportResources(newClient(ctx), exported)
+	if err != nil {
+		return err
+	}
+
+	if *c.dir != "" {
+		return writeResourceFiles(ctx, *c.dir, resources)
+	}
+
+	if !ctx.Renderer.IsText() {
+		return ctx.Renderer.Render(resources)
+	}
+
+	return ctx.Renderer.RenderText(func(stdout io.Writer) error {
+		data, err := WriteDocuments(resources)
+		if err != nil {
+			return err
+		}
+
+		_, err = stdout.Write(data)
+		return err
+	})
+}
+
+//
+// exportResources lists all the kinds, or only the ones given.
+// Blueprints are always listed, so canvases reference them by name.
+//
+
+func exportResources(c *client, exported map[string]bool) ([]*Resource, error) {
+	resources := []*Resource{}
+	for _, k := range kinds {
+		if len(exported) > 0 && !exported[k.name] && k.name != BlueprintKind {
+			continue
+		}
+
+		listed, err := k.list(c)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list %s: %w", k.plural, err)
+		}
+
+		if len(exported) > 0 && !exported[k.name] {
+			continue
+		}
+
+		for _, resource := range listed {
+			if k.protected != nil && k.protected(resource) {
+				continue
+			}
+
+			resources = append(resources, k.normalize(c, resource))
+		}
+	}
+
+	return resources, nil
+}
+
+func writeResourceFiles(ctx core.CommandContext, dir string, resources []*Resource) error {
+	for _, resource := range resources {
+		path := filepath.Join(dir, findKind(resource.Kind).plural, fileName(resource.Name())+".yaml")
+		data, err := WriteDocuments([]*Resource{resource})
+		if err != nil {
+			return err
+		}
+
+		err = os.MkdirAll(filepath.Dir(path), 0o755)
+		if err != nil {
+			return err
+		}
+
+		// #nosec
+		err = os.WriteFile(path, data, 0o644)
+		if err != nil {
+			return fmt.Errorf("failed to write %s: %w", path, err)
+		}
+
+		if ctx.Renderer.IsText() {
+			_, _ = fmt.Fprintln(ctx.Cmd.OutOrStdout(), path)
+		}
+	}
+
+	return nil
+}
+
+func fileName(name string) string {
+	return strings.Trim(nonAlphanumeric.ReplaceAllString(strings.ToLower(name), "-"), "-")
+}
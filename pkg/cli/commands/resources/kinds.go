@@ -0,0 +1,1017 @@
+package resources
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/superplanehq/superplane/pkg/cli/core"
+	"github.com/superplanehq/superplane/pkg/openapi_client"
+)
+
+const (
+	CanvasKind      = "Canvas"
+	BlueprintKind   = "Blueprint"
+	SecretKind      = "Secret"
+	IntegrationKind = "Integration"
+	GroupKind       = "Group"
+	RoleKind        = "Role"
+)
+
+//
+// kind knows how to manage one kind of resource through the API.
+//
+// Resources returned by list are normalized, and resources from files
+// are normalized with the same function before they are compared,
+// so fields managed by the server never show up in plans.
+//
+
+type kind struct {
+	name   string
+	plural string
+
+	list      func(c *client) ([]*Resource, error)
+	normalize func(c *client, resource *Resource) *Resource
+	create    func(c *client, resource *Resource) (string, error)
+	update    func(c *client, current, desired *Resource) error
+	delete    func(c *client, current *Resource) error
+
+	//
+	// diff replaces the default comparison,
+	// for kinds where some fields can't be read back.
+	//
+	diff func(c *client, current, desired *Resource) []string
+
+	//
+	// validate checks resources from files, before anything is listed.
+	//
+	validate func(resource *Resource) error
+
+	//
+	// protected resources are never deleted or exported,
+	// like the default roles of an organization.
+	//
+	protected func(resource *Resource) bool
+}
+
+//
+// Kinds in the order they are applied, so resources are created
+// after the ones they depend on: groups use roles, canvases use blueprints.
+// Deletions happen in the reverse order.
+//
+
+var kinds = []*kind{
+	roleKind,
+	groupKind,
+	secretKind,
+	integrationKind,
+	blueprintKind,
+	canvasKind,
+}
+
+func findKind(name string) *kind {
+	for _, k := range kinds {
+		if k.name == name {
+			return k
+		}
+	}
+
+	return nil
+}
+
+func kindNames() []string {
+	names := []string{}
+	for _, k := range kinds {
+		names = append(names, k.name)
+	}
+
+	return names
+}
+
+func kindOrder(name string) int {
+	for i, k := range kinds {
+		if k.name == name {
+			return i
+		}
+	}
+
+	return len(kinds)
+}
+
+//
+// client holds what resources need from the API,
+// and the IDs of blueprints, since canvases reference them by ID,
+// while files reference them by name.
+//
+
+type client struct {
+	ctx            core.CommandContext
+	organizationID string
+	blueprintIDs   map[string]string
+	blueprintNames map[string]string
+}
+
+func newClient(ctx core.CommandContext) *client {
+	return &client{
+		ctx:            ctx,
+		blueprintIDs:   map[string]string{},
+		blueprintNames: map[string]string{},
+	}
+}
+
+func (c *client) organization() (string, error) {
+	if c.organizationID != "" {
+		return c.organizationID, nil
+	}
+
+	me, _, err := c.ctx.API.MeAPI.MeMe(c.ctx.Context).Execute()
+	if err != nil {
+		return "", err
+	}
+
+	if strings.TrimSpace(me.GetOrganizationId()) == "" {
+		return "", fmt.Errorf("organization id not found for authenticated user")
+	}
+
+	c.organizationID = me.GetOrganizationId()
+	return c.organizationID, nil
+}
+
+func (c *client) addBlueprint(name, id string) {
+	c.blueprintIDs[name] = id
+	c.blueprintNames[id] = name
+}
+
+const organizationDomainType = openapi_client.AUTHORIZATIONDOMAINTYPE_DOMAIN_TYPE_ORGANIZATION
+
+var defaultRoles = map[string]bool{
+	"org_owner":  true,
+	"org_admin":  true,
+	"org_viewer": true,
+}
+
+var roleKind = &kind{
+	name:   RoleKind,
+	plural: "roles",
+
+	list: func(c *client) ([]*Resource, error) {
+		organizationID, err := c.organization()
+		if err != nil {
+			return nil, err
+		}
+
+		response, _, err := c.ctx.API.RolesAPI.RolesListRoles(c.ctx.Context).
+			DomainType(string(organizationDomainType)).
+			DomainId(organizationID).
+			Execute()
+		if err != nil {
+			return nil, err
+		}
+
+		resources := []*Resource{}
+		for _, role := range response.GetRoles() {
+			resource, err := fromAPI(RoleKind, role)
+			if err != nil {
+				return nil, err
+			}
+
+			resources = append(resources, resource)
+		}
+
+		return resources, nil
+	},
+
+	normalize: func(c *client, resource *Resource) *Resource {
+		normalized := newResource(resource, "name")
+		normalized.Spec = copyFields(resource.Spec, "displayName", "description", "permissions", "inheritedRole")
+
+		//
+		// The API returns the inherited role with all its permissions.
+		//
+		if inherited, ok := normalized.Spec["inheritedRole"].(map[string]any); ok {
+			metadata, _ := inherited["metadata"].(map[string]any)
+			normalized.Spec["inheritedRole"] = metadata["name"]
+		}
+
+		if permissions, ok := normalized.Spec["permissions"].([]any); ok {
+			for _, permission := range permissions {
+				if p, ok := permission.(map[string]any); ok {
+					delete(p, "domainType")
+				}
+			}
+		}
+
+		return compactResource(normalized)
+	},
+
+	create: func(c *client, resource *Resource) (string, error) {
+		organizationID, role, err := roleToAPI(c, resource)
+		if err != nil {
+			return "", err
+		}
+
+		request := openapi_client.RolesCreateRoleRequest{}
+		request.SetDomainType(organizationDomainType)
+		request.SetDomainId(organizationID)
+		request.SetRole(role)
+		_, _, err = c.ctx.API.RolesAPI.RolesCreateRole(c.ctx.Context).Body(request).Execute()
+		return resource.Name(), err
+	},
+
+	update: func(c *client, current, desired *Resource) error {
+		organizationID, role, err := roleToAPI(c, desired)
+		if err != nil {
+			return err
+		}
+
+		body := openapi_client.RolesUpdateRoleBody{}
+		body.SetDomainType(organizationDomainType)
+		body.SetDomainId(organizationID)
+		body.SetRole(role)
+		_, _, err = c.ctx.API.RolesAPI.RolesUpdateRole(c.ctx.Context, current.Name()).Body(body).Execute()
+		return err
+	},
+
+	delete: func(c *client, current *Resource) error {
+		organizationID, err := c.organization()
+		if err != nil {
+			return err
+		}
+
+		_, _, err = c.ctx.API.RolesAPI.RolesDeleteRole(c.ctx.Context, current.Name()).
+			DomainType(string(organizationDomainType)).
+			DomainId(organizationID).
+			Execute()
+		return err
+	},
+
+	protected: func(resource *Resource) bool {
+		return defaultRoles[resource.Name()]
+	},
+}
+
+func roleToAPI(c *client, resource *Resource) (string, openapi_client.RolesRole, error) {
+	role := openapi_client.RolesRole{}
+	organizationID, err := c.organization()
+	if err != nil {
+		return "", role, err
+	}
+
+	spec := copyFields(resource.Spec, "displayName", "description", "permissions")
+	if permissions, ok := spec["permissions"].([]any); ok {
+		for _, permission := range permissions {
+			if p, ok := permission.(map[string]any); ok {
+				p["domainType"] = string(organizationDomainType)
+			}
+		}
+	}
+
+	if inherited, ok := resource.Spec["inheritedRole"].(string); ok && inherited != "" {
+		spec["inheritedRole"] = map[string]any{"metadata": map[string]any{"name": inherited}}
+	}
+
+	err = convert(map[string]any{"metadata": map[string]any{"name": resource.Name()}, "spec": spec}, &role)
+	return organizationID, role, err
+}
+
+var groupKind = &kind{
+	name:   GroupKind,
+	plural: "groups",
+
+	list: func(c *client) ([]*Resource, error) {
+		organizationID, err := c.organization()
+		if err != nil {
+			return nil, err
+		}
+
+		response, _, err := c.ctx.API.GroupsAPI.GroupsListGroups(c.ctx.Context).
+			DomainType(string(organizationDomainType)).
+			DomainId(organizationID).
+			Execute()
+		if err != nil {
+			return nil, err
+		}
+
+		resources := []*Resource{}
+		for _, group := range response.GetGroups() {
+			resource, err := fromAPI(GroupKind, group)
+			if err != nil {
+				return nil, err
+			}
+
+			resources = append(resources, resource)
+		}
+
+		return resources, nil
+	},
+
+	normalize: func(c *client, resource *Resource) *Resource {
+		normalized := newResource(resource, "name")
+		normalized.Spec = copyFields(resource.Spec, "role", "displayName", "description")
+		return compactResource(normalized)
+	},
+
+	create: func(c *client, resource *Resource) (string, error) {
+		organizationID, group, err := groupToAPI(c, resource)
+		if err != nil {
+			return "", err
+		}
+
+		request := openapi_client.GroupsCreateGroupRequest{}
+		request.SetDomainType(organizationDomainType)
+		request.SetDomainId(organizationID)
+		request.SetGroup(group)
+		_, _, err = c.ctx.API.GroupsAPI.GroupsCreateGroup(c.ctx.Context).Body(request).Execute()
+		return resource.Name(), err
+	},
+
+	update: func(c *client, current, desired *Resource) error {
+		organizationID, group, err := groupToAPI(c, desired)
+		if err != nil {
+			return err
+		}
+
+		body := openapi_client.GroupsUpdateGroupBody{}
+		body.SetDomainType(organizationDomainType)
+		body.SetDomainId(organizationID)
+		body.SetGroup(group)
+		_, _, err = c.ctx.API.GroupsAPI.GroupsUpdateGroup(c.ctx.Context, current.Name()).Body(body).Execute()
+		return err
+	},
+
+	delete: func(c *client, current *Resource) error {
+		organizationID, err := c.organization()
+		if err != nil {
+			return err
+		}
+
+		_, _, err = c.ctx.API.GroupsAPI.GroupsDeleteGroup(c.ctx.Context, current.Name()).
+			DomainType(string(organizationDomainType)).
+			DomainId(organizationID).
+			Execute()
+		return err
+	},
+}
+
+func groupToAPI(c *client, resource *Resource) (string, openapi_client.GroupsGroup, error) {
+	group := openapi_client.GroupsGroup{}
+	organizationID, err := c.organization()
+	if err != nil {
+		return "", group, err
+	}
+
+	err = convert(map[string]any{"metadata": map[string]any{"name": resource.Name()}, "spec": resource.Spec}, &group)
+	return organizationID, group, err
+}
+
+var secretKind = &kind{
+	name:   SecretKind,
+	plural: "secrets",
+
+	list: func(c *client) ([]*Resource, error) {
+		organizationID, err := c.organization()
+		if err != nil {
+			return nil, err
+		}
+
+		response, _, err := c.ctx.API.SecretAPI.SecretsListSecrets(c.ctx.Context).
+			DomainType(string(organizationDomainType)).
+			DomainId(organizationID).
+			Execute()
+		if err != nil {
+			return nil, err
+		}
+
+		resources := []*Resource{}
+		for _, secret := range response.GetSecrets() {
+			resource, err := fromAPI(SecretKind, secret)
+			if err != nil {
+				return nil, err
+			}
+
+			local, _ := resource.Spec["local"].(map[string]any)
+			data, _ := local["data"].(map[string]any)
+			for key := range data {
+				data[key] = map[string]any{"fromEnv": envName(resource.Name(), key)}
+			}
+
+			resources = append(resources, resource)
+		}
+
+		return resources, nil
+	},
+
+	//
+	// The API never returns secret values, so secrets are compared by
+	// their keys, and values become references to environment variables in exports.
+	//
+	validate: func(resource *Resource) error {
+		local, _ := resource.Spec["local"].(map[string]any)
+		data, _ := local["data"].(map[string]any)
+		for _, key := range sortedKeys(data) {
+			if !isReference(data[key]) {
+				return fmt.Errorf("secret key %s must be a reference, with fromEnv or fromFile", key)
+			}
+		}
+
+		return nil
+	},
+
+	normalize: func(c *client, resource *Resource) *Resource {
+		normalized := newResource(resource, "name")
+		normalized.Spec = copyFields(resource.Spec, "provider", "local")
+		if normalized.Spec["provider"] == nil {
+			normalized.Spec["provider"] = string(openapi_client.SECRETPROVIDER_PROVIDER_LOCAL)
+		}
+
+		return compactResource(normalized)
+	},
+
+	diff: func(c *client, current, desired *Resource) []string {
+		return diffValues("spec", secretShape(current), secretShape(desired))
+	},
+
+	create: func(c *client, resource *Resource) (string, error) {
+		organizationID, secret, err := secretToAPI(c, resource)
+		if err != nil {
+			return "", err
+		}
+
+		request := openapi_client.SecretsCreateSecretRequest{}
+		request.SetDomainType(organizationDomainType)
+		request.SetDomainId(organizationID)
+		request.SetSecret(secret)
+		response, _, err := c.ctx.API.SecretAPI.SecretsCreateSecret(c.ctx.Context).Body(request).Execute()
+		if err != nil {
+			return "", err
+		}
+
+		created := response.GetSecret()
+		metadata := created.GetMetadata()
+		return metadata.GetId(), nil
+	},
+
+	update: func(c *client, current, desired *Resource) error {
+		organizationID, secret, err := secretToAPI(c, desired)
+		if err != nil {
+			return err
+		}
+
+		body := openapi_client.SecretsUpdateSecretBody{}
+		body.SetDomainType(organizationDomainType)
+		body.SetDomainId(organizationID)
+		body.SetSecret(secret)
+		_, _, err = c.ctx.API.SecretAPI.SecretsUpdateSecret(c.ctx.Context, current.id).Body(body).Execute()
+		return err
+	},
+
+	delete: func(c *client, current *Resource) error {
+		organizationID, err := c.organization()
+		if err != nil {
+			return err
+		}
+
+		_, _, err = c.ctx.API.SecretAPI.SecretsDeleteSecret(c.ctx.Context, current.id).
+			DomainType(string(organizationDomainType)).
+			DomainId(organizationID).
+			Execute()
+		return err
+	},
+}
+
+//
+// secretShape is what can be compared in a secret: its provider and keys.
+//
+
+func secretShape(resource *Resource) map[string]any {
+	keys := []any{}
+	local, _ := resource.Spec["local"].(map[string]any)
+	data, _ := local["data"].(map[string]any)
+	for _, key := range sortedKeys(data) {
+		keys = append(keys, key)
+	}
+
+	return map[string]any{"provider": resource.Spec["provider"], "keys": keys}
+}
+
+func secretToAPI(c *client, resource *Resource) (string, openapi_client.SecretsSecret, error) {
+	secret := openapi_client.SecretsSecret{}
+	organizationID, err := c.organization()
+	if err != nil {
+		return "", secret, err
+	}
+
+	local, _ := resource.Spec["local"].(map[string]any)
+	data, _ := local["data"].(map[string]any)
+	values := map[string]any{}
+	for key, reference := range data {
+		value, err := resolveReference(reference, resource.file)
+		if err != nil {
+			return "", secret, fmt.Errorf("secret %s, key %s: %w", resource.Name(), key, err)
+		}
+
+		values[key] = value
+	}
+
+	err = convert(map[string]any{
+		"metadata": map[string]any{"name": resource.Name()},
+		"spec": map[string]any{
+			"provider": resource.Spec["provider"],
+			"local":    map[string]any{"data": values},
+		},
+	}, &secret)
+
+	return organizationID, secret, err
+}
+
+var integrationKind = &kind{
+	name:   IntegrationKind,
+	plural: "integrations",
+
+	list: func(c *client) ([]*Resource, error) {
+		organizationID, err := c.organization()
+		if err != nil {
+			return nil, err
+		}
+
+		response, _, err := c.ctx.API.OrganizationAPI.OrganizationsListIntegrations(c.ctx.Context, organizationID).Execute()
+		if err != nil {
+			return nil, err
+		}
+
+		resources := []*Resource{}
+		for _, integration := range response.GetIntegrations() {
+			resource, err := fromAPI(IntegrationKind, integration)
+			if err != nil {
+				return nil, err
+			}
+
+			resources = append(resources, resource)
+		}
+
+		return resources, nil
+	},
+
+	normalize: func(c *client, resource *Resource) *Resource {
+		normalized := newResource(resource, "name")
+		normalized.Spec = copyFields(resource.Spec, "integrationName", "configuration")
+		return compactResource(normalized)
+	},
+
+	//
+	// Configuration values set from references can't be read back,
+	// so they are not compared.
+	//
+	diff: func(c *client, current, desired *Resource) []string {
+		currentSpec := copyFields(current.Spec, "integrationName", "configuration")
+		desiredConfiguration, _ := desired.Spec["configuration"].(map[string]any)
+		currentConfiguration, _ := currentSpec["configuration"].(map[string]any)
+		if currentConfiguration != nil {
+			for key, value := range desiredConfiguration {
+				if isReference(value) {
+					currentConfiguration[key] = value
+				}
+			}
+		}
+
+		return diffValues("spec", compact(currentSpec), desired.Spec)
+	},
+
+	create: func(c *client, resource *Resource) (string, error) {
+		organizationID, err := c.organization()
+		if err != nil {
+			return "", err
+		}
+
+		configuration, err := resolveReferences(resource.Spec["configuration"], resource.file)
+		if err != nil {
+			return "", fmt.Errorf("integration %s: %w", resource.Name(), err)
+		}
+
+		body := openapi_client.OrganizationsCreateIntegrationBody{}
+		body.SetName(resource.Name())
+		body.SetIntegrationName(fmt.Sprint(resource.Spec["integrationName"]))
+		if configuration, ok := configuration.(map[string]any); ok {
+			body.SetConfiguration(configuration)
+		}
+
+		response, _, err := c.ctx.API.OrganizationAPI.OrganizationsCreateIntegration(c.ctx.Context, organizationID).Body(body).Execute()
+		if err != nil {
+			return "", err
+		}
+
+		integration := response.GetIntegration()
+		metadata := integration.GetMetadata()
+		return metadata.GetId(), nil
+	},
+
+	update: func(c *client, current, desired *Resource) error {
+		organizationID, err := c.organization()
+		if err != nil {
+			return err
+		}
+
+		configuration, err := resolveReferences(desired.Spec["configuration"], desired.file)
+		if err != nil {
+			return fmt.Errorf("integration %s: %w", desired.Name(), err)
+		}
+
+		body := openapi_client.OrganizationsUpdateIntegrationBody{}
+		body.SetName(desired.Name())
+		if configuration, ok := configuration.(map[string]any); ok {
+			body.SetConfiguration(configuration)
+		}
+
+		_, _, err = c.ctx.API.OrganizationAPI.OrganizationsUpdateIntegration(c.ctx.Context, organizationID, current.id).Body(body).Execute()
+		return err
+	},
+
+	delete: func(c *client, current *Resource) error {
+		organizationID, err := c.organization()
+		if err != nil {
+			return err
+		}
+
+		_, _, err = c.ctx.API.OrganizationAPI.OrganizationsDeleteIntegration(c.ctx.Context, organizationID, current.id).Execute()
+		return err
+	},
+}
+
+var blueprintKind = &kind{
+	name:   BlueprintKind,
+	plural: "blueprints",
+
+	list: func(c *client) ([]*Resource, error) {
+		response, _, err := c.ctx.API.BlueprintAPI.BlueprintsListBlueprints(c.ctx.Context).Execute()
+		if err != nil {
+			return nil, err
+		}
+
+		resources := []*Resource{}
+		for _, blueprint := range response.GetBlueprints() {
+			c.addBlueprint(blueprint.GetName(), blueprint.GetId())
+
+			resource := &Resource{APIVersion: core.APIVersion, Kind: BlueprintKind, id: blueprint.GetId()}
+			fields := map[string]any{}
+			err := convert(blueprint, &fields)
+			if err != nil {
+				return nil, err
+			}
+
+			resource.Metadata = copyFields(fields, "name", "description")
+			resource.Spec = copyFields(fields, "nodes", "edges", "configuration", "outputChannels", "icon", "color")
+			resources = append(resources, resource)
+		}
+
+		return resources, nil
+	},
+
+	normalize: func(c *client, resource *Resource) *Resource {
+		normalized := newResource(resource, "name", "description")
+		normalized.Spec = copyFields(resource.Spec, "nodes", "edges", "configuration", "outputChannels", "icon", "color")
+		normalizeNodes(c, normalized.Spec)
+		return compactResource(normalized)
+	},
+
+	create: func(c *client, resource *Resource) (string, error) {
+		blueprint, err := blueprintToAPI(c, resource)
+		if err != nil {
+			return "", err
+		}
+
+		request := openapi_client.BlueprintsCreateBlueprintRequest{}
+		request.SetBlueprint(blueprint)
+		response, _, err := c.ctx.API.BlueprintAPI.BlueprintsCreateBlueprint(c.ctx.Context).Body(request).Execute()
+		if err != nil {
+			return "", err
+		}
+
+		created := response.GetBlueprint()
+		c.addBlueprint(resource.Name(), created.GetId())
+		return created.GetId(), nil
+	},
+
+	update: func(c *client, current, desired *Resource) error {
+		blueprint, err := blueprintToAPI(c, desired)
+		if err != nil {
+			return err
+		}
+
+		body := openapi_client.BlueprintsUpdateBlueprintBody{}
+		body.SetBlueprint(blueprint)
+		_, _, err = c.ctx.API.BlueprintAPI.BlueprintsUpdateBlueprint(c.ctx.Context, current.id).Body(body).Execute()
+		return err
+	},
+
+	delete: func(c *client, current *Resource) error {
+		_, _, err := c.ctx.API.BlueprintAPI.BlueprintsDeleteBlueprint(c.ctx.Context, current.id).Execute()
+		return err
+	},
+}
+
+func blueprintToAPI(c *client, resource *Resource) (openapi_client.BlueprintsBlueprint, error) {
+	blueprint := openapi_client.BlueprintsBlueprint{}
+	fields := copyFields(resource.Spec, "nodes", "edges", "configuration", "outputChannels", "icon", "color")
+	fields["name"] = resource.Name()
+	fields["description"] = resource.Metadata["description"]
+
+	err := resolveBlueprintRefs(c, fields)
+	if err != nil {
+		return blueprint, fmt.Errorf("blueprint %s: %w", resource.Name(), err)
+	}
+
+	err = convert(compact(fields), &blueprint)
+	return blueprint, err
+}
+
+var canvasKind = &kind{
+	name:   CanvasKind,
+	plural: "canvases",
+
+	list: func(c *client) ([]*Resource, error) {
+		response, _, err := c.ctx.API.CanvasAPI.CanvasesListCanvases(c.ctx.Context).Execute()
+		if err != nil {
+			return nil, err
+		}
+
+		resources := []*Resource{}
+		for _, item := range response.GetCanvases() {
+			metadata := item.GetMetadata()
+			described, _, err := c.ctx.API.CanvasAPI.CanvasesDescribeCanvas(c.ctx.Context, metadata.GetId()).Execute()
+			if err != nil {
+				return nil, err
+			}
+
+			resource, err := fromAPI(CanvasKind, described.GetCanvas())
+			if err != nil {
+				return nil, err
+			}
+
+			resources = append(resources, resource)
+		}
+
+		return resources, nil
+	},
+
+	normalize: func(c *client, resource *Resource) *Resource {
+		normalized := newResource(resource, "name", "description")
+		normalized.Spec = copyFields(resource.Spec, "nodes", "edges")
+		normalizeNodes(c, normalized.Spec)
+		return compactResource(normalized)
+	},
+
+	create: func(c *client, resource *Resource) (string, error) {
+		canvas, err := canvasToAPI(c, resource)
+		if err != nil {
+			return "", err
+		}
+
+		request := openapi_client.CanvasesCreateCanvasRequest{}
+		request.SetCanvas(canvas)
+		response, _, err := c.ctx.API.CanvasAPI.CanvasesCreateCanvas(c.ctx.Context).Body(request).Execute()
+		if err != nil {
+			return "", err
+		}
+
+		created := response.GetCanvas()
+		metadata := created.GetMetadata()
+		return metadata.GetId(), nil
+	},
+
+	update: func(c *client, current, desired *Resource) error {
+		canvas, err := canvasToAPI(c, desired)
+		if err != nil {
+			return err
+		}
+
+		body := openapi_client.CanvasesUpdateCanvasBody{}
+		body.SetCanvas(canvas)
+		_, _, err = c.ctx.API.CanvasAPI.CanvasesUpdateCanvas(c.ctx.Context, current.id).Body(body).Execute()
+		return err
+	},
+
+	delete: func(c *client, current *Resource) error {
+		_, _, err := c.ctx.API.CanvasAPI.CanvasesDeleteCanvas(c.ctx.Context, current.id).Execute()
+		return err
+	},
+}
+
+func canvasToAPI(c *client, resource *Resource) (openapi_client.CanvasesCanvas, error) {
+	canvas := openapi_client.CanvasesCanvas{}
+	spec := copyFields(resource.Spec, "nodes", "edges")
+	err := resolveBlueprintRefs(c, spec)
+	if err != nil {
+		return canvas, fmt.Errorf("canvas %s: %w", resource.Name(), err)
+	}
+
+	err = convert(map[string]any{
+		"metadata": copyFields(resource.Metadata, "name", "description"),
+		"spec":     compact(spec),
+	}, &canvas)
+
+	return canvas, err
+}
+
+//
+// Fields of nodes set by the server, or that only change at runtime.
+//
+
+var serverNodeFields = []string{"errorMessage", "warningMessage", "metadata", "paused"}
+
+//
+// normalizeNodes removes server fields from nodes,
+// and references blueprints by name, so files work across organizations.
+//
+
+func normalizeNodes(c *client, spec map[string]any) {
+	nodes, _ := spec["nodes"].([]any)
+	for _, node := range nodes {
+		n, ok := node.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		for _, field := range serverNodeFields {
+			delete(n, field)
+		}
+
+		blueprint, _ := n["blueprint"].(map[string]any)
+		id, _ := blueprint["id"].(string)
+		if name, ok := c.blueprintNames[id]; ok {
+			n["blueprint"] = map[string]any{"name": name}
+		}
+	}
+}
+
+//
+// resolveBlueprintRefs replaces blueprint names in nodes by their IDs.
+// Blueprints are applied before canvases, so new blueprints have IDs by then.
+//
+
+func resolveBlueprintRefs(c *client, spec map[string]any) error {
+	nodes, _ := spec["nodes"].([]any)
+	for _, node := range nodes {
+		n, ok := node.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		blueprint, _ := n["blueprint"].(map[string]any)
+		name, _ := blueprint["name"].(string)
+		if name == "" {
+			continue
+		}
+
+		id, ok := c.blueprintIDs[name]
+		if !ok {
+			return fmt.Errorf("blueprint %s not found", name)
+		}
+
+		n["blueprint"] = map[string]any{"id": id}
+	}
+
+	return nil
+}
+
+//
+// blueprintDependencies are the names of the blueprints used by a resource.
+//
+
+func blueprintDependencies(resource *Resource) []string {
+	names := []string{}
+	nodes, _ := resource.Spec["nodes"].([]any)
+	for _, node := range nodes {
+		n, _ := node.(map[string]any)
+		blueprint, _ := n["blueprint"].(map[string]any)
+		if name, ok := blueprint["name"].(string); ok && name != "" {
+			names = append(names, name)
+		}
+	}
+
+	return names
+}
+
+//
+// fromAPI turns an API object with metadata and spec into a resource.
+//
+
+func fromAPI(kindName string, object any) (*Resource, error) {
+	fields := map[string]any{}
+	err := convert(object, &fields)
+	if err != nil {
+		return nil, err
+	}
+
+	resource := &Resource{APIVersion: core.APIVersion, Kind: kindName}
+	resource.Metadata, _ = fields["metadata"].(map[string]any)
+	resource.Spec, _ = fields["spec"].(map[string]any)
+	if resource.Metadata == nil {
+		resource.Metadata = map[string]any{}
+	}
+
+	if resource.Spec == nil {
+		resource.Spec = map[string]any{}
+	}
+
+	resource.id, _ = resource.Metadata["id"].(string)
+	return resource, nil
+}
+
+func newResource(resource *Resource, metadataFields ...string) *Resource {
+	return &Resource{
+		APIVersion: core.APIVersion,
+		Kind:       resource.Kind,
+		Metadata:   copyFields(resource.Metadata, metadataFields...),
+		id:         resource.id,
+		file:       resource.file,
+	}
+}
+
+//
+// copyFields deep copies some fields of a map,
+// so normalizing a resource never changes the original.
+//
+
+func copyFields(source map[string]any, fields ...string) map[string]any {
+	result := map[string]any{}
+	for _, field := range fields {
+		if value, ok := source[field]; ok {
+			result[field] = deepCopy(value)
+		}
+	}
+
+	return result
+}
+
+func deepCopy(value any) any {
+	var copied any
+	err := convert(value, &copied)
+	if err != nil {
+		return value
+	}
+
+	return copied
+}
+
+func convert(from any, to any) error {
+	data, err := json.Marshal(from)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(data, to)
+}
+
+//
+// compact removes empty values, so fields left out of files
+// match fields the API returns with their zero value.
+//
+
+func compact(value any) any {
+	switch v := value.(type) {
+	case map[string]any:
+		result := map[string]any{}
+		for key, item := range v {
+			item = compact(item)
+			if !isEmpty(item) {
+				result[key] = item
+			}
+		}
+
+		return result
+
+	case []any:
+		result := make([]any, len(v))
+		for i, item := range v {
+			result[i] = compact(item)
+		}
+
+		return result
+
+	default:
+		return v
+	}
+}
+
+func compactResource(resource *Resource) *Resource {
+	resource.Metadata, _ = compact(resource.Metadata).(map[string]any)
+	resource.Spec, _ = compact(resource.Spec).(map[string]any)
+	return resource
+}
+
+func isEmpty(value any) bool {
+	switch v := value.(type) {
+	case nil:
+		return true
+	case string:
+		return v == ""
+	case bool:
+		return !v
+	case map[string]any:
+		return len(v) == 0
+	case []any:
+		return len(v) == 0
+	default:
+		return false
+	}
+}
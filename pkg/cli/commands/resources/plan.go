@@ -0,0 +1,366 @@
+package resources
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+)
+
+const (
+	ActionCreate = "create"
+	ActionUpdate = "update"
+	ActionDelete = "delete"
+)
+
+//
+// Change is what apply does to one resource.
+// Current is nil for creations, and Desired is nil for deletions.
+//
+
+type Change struct {
+	Action  string    `json:"action"`
+	Kind    string    `json:"kind"`
+	Name    string    `json:"name"`
+	Diff    []string  `json:"diff,omitempty"`
+	Current *Resource `json:"-"`
+	Desired *Resource `json:"-"`
+}
+
+func (c *Change) Key() string {
+	return c.Kind + "/" + c.Name
+}
+
+type Plan struct {
+	Changes   []*Change `json:"changes"`
+	Unchanged int       `json:"unchanged"`
+}
+
+func (p *Plan) Count(action string) int {
+	count := 0
+	for _, change := range p.Changes {
+		if change.Action == action {
+			count++
+		}
+	}
+
+	return count
+}
+
+func (p *Plan) Summary() string {
+	return fmt.Sprintf(
+		"Plan: %d to create, %d to update, %d to delete, %d unchanged.",
+		p.Count(ActionCreate),
+		p.Count(ActionUpdate),
+		p.Count(ActionDelete),
+		p.Unchanged,
+	)
+}
+
+type planOptions struct {
+	prune bool
+
+	//
+	// Secret values can't be read back, so existing secrets
+	// are only updated when their keys change, or when this is set.
+	//
+	updateSecrets bool
+}
+
+//
+// buildPlan compares the resources in files with the ones in the organization.
+// Only the kinds that appear in the files are listed, and pruned.
+//
+
+func buildPlan(c *client, desired []*Resource, options planOptions) (*Plan, error) {
+	desiredKinds := map[string]bool{}
+	for _, resource := range desired {
+		desiredKinds[resource.Kind] = true
+	}
+
+	//
+	// Canvases reference blueprints by ID,
+	// so blueprints are always listed before canvases are compared.
+	//
+	listed := map[string]bool{}
+	if desiredKinds[CanvasKind] {
+		listed[BlueprintKind] = true
+	}
+
+	current := map[string]*Resource{}
+	for _, k := range kinds {
+		if !desiredKinds[k.name] && !listed[k.name] {
+			continue
+		}
+
+		resources, err := k.list(c)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list %s: %w", k.plural, err)
+		}
+
+		if !desiredKinds[k.name] {
+			continue
+		}
+
+		for _, resource := range resources {
+			current[resource.Key()] = k.normalize(c, resource)
+		}
+	}
+
+	return comparePlan(c, desired, current, options)
+}
+
+func comparePlan(c *client, desired []*Resource, current map[string]*Resource, options planOptions) (*Plan, error) {
+	ordered, err := orderResources(desired)
+	if err != nil {
+		return nil, err
+	}
+
+	plan := &Plan{Changes: []*Change{}}
+	inFiles := map[string]bool{}
+	for _, resource := range ordered {
+		k := findKind(resource.Kind)
+		normalized := k.normalize(c, resource)
+		inFiles[resource.Key()] = true
+
+		existing, ok := current[resource.Key()]
+		if !ok {
+			plan.Changes = append(plan.Changes, &Change{Action: ActionCreate, Kind: resource.Kind, Name: resource.Name(), Desired: normalized})
+			continue
+		}
+
+		normalized.id = existing.id
+		diff := diffResources(c, k, existing, normalized)
+		if len(diff) == 0 && resource.Kind == SecretKind && options.updateSecrets {
+			diff = []string{"values are applied again"}
+		}
+
+		if len(diff) == 0 {
+			plan.Unchanged++
+			continue
+		}
+
+		plan.Changes = append(plan.Changes, &Change{
+			Action:  ActionUpdate,
+			Kind:    resource.Kind,
+			Name:    resource.Name(),
+			Diff:    diff,
+			Current: existing,
+			Desired: normalized,
+		})
+	}
+
+	if !options.prune {
+		return plan, nil
+	}
+
+	//
+	// Deletions run after everything else, in the reverse order,
+	// so canvases stop using blueprints before the blueprints are deleted.
+	//
+	deletions := []*Change{}
+	for _, key := range sortedKeys(current) {
+		resource := current[key]
+		k := findKind(resource.Kind)
+		if inFiles[key] || (k.protected != nil && k.protected(resource)) {
+			continue
+		}
+
+		deletions = append(deletions, &Change{Action: ActionDelete, Kind: resource.Kind, Name: resource.Name(), Current: resource})
+	}
+
+	sort.SliceStable(deletions, func(i, j int) bool {
+		return kindOrder(deletions[i].Kind) > kindOrder(deletions[j].Kind)
+	})
+
+	plan.Changes = append(plan.Changes, deletions...)
+	return plan, nil
+}
+
+func diffResources(c *client, k *kind, current, desired *Resource) []string {
+	if k.diff != nil {
+		return append(diffValues("metadata", current.Metadata, desired.Metadata), k.diff(c, current, desired)...)
+	}
+
+	return append(diffValues("metadata", current.Metadata, desired.Metadata), diffValues("spec", current.Spec, desired.Spec)...)
+}
+
+//
+// orderResources sorts resources in the order they are applied:
+// by kind, and blueprints after the blueprints their nodes use.
+//
+
+func orderResources(resources []*Resource) ([]*Resource, error) {
+	ordered := append([]*Resource{}, resources...)
+	sort.SliceStable(ordered, func(i, j int) bool {
+		return kindOrder(ordered[i].Kind) < kindOrder(ordered[j].Kind)
+	})
+
+	blueprints := map[string]*Resource{}
+	for _, resource := range ordered {
+		if resource.Kind == BlueprintKind {
+			blueprints[resource.Name()] = resource
+		}
+	}
+
+	sortedBlueprints := []*Resource{}
+	state := map[string]int{}
+	var visit func(resource *Resource, path []string) error
+	visit = func(resource *Resource, path []string) error {
+		switch state[resource.Name()] {
+		case 1:
+			return fmt.Errorf("blueprints depend on each other: %v", append(path, resource.Name()))
+		case 2:
+			return nil
+		}
+
+		state[resource.Name()] = 1
+		for _, dependency := range blueprintDependencies(resource) {
+			if blueprint, ok := blueprints[dependency]; ok {
+				err := visit(blueprint, append(path, resource.Name()))
+				if err != nil {
+					return err
+				}
+			}
+		}
+
+		state[resource.Name()] = 2
+		sortedBlueprints = append(sortedBlueprints, resource)
+		return nil
+	}
+
+	for _, resource := range ordered {
+		if resource.Kind != BlueprintKind {
+			continue
+		}
+
+		err := visit(resource, []string{})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	i := 0
+	for index, resource := range ordered {
+		if resource.Kind == BlueprintKind {
+			ordered[index] = sortedBlueprints[i]
+			i++
+		}
+	}
+
+	return ordered, nil
+}
+
+//
+// diffValues describes the differences between two values, one line per field,
+// like spec.nodes[id=deploy].configuration.url: "a" -> "b".
+// Items of lists are matched by ID when all of them have one.
+//
+
+func diffValues(path string, current, desired any) []string {
+	if reflect.DeepEqual(current, desired) {
+		return nil
+	}
+
+	currentMap, currentIsMap := current.(map[string]any)
+	desiredMap, desiredIsMap := desired.(map[string]any)
+	if currentIsMap && desiredIsMap {
+		keys := map[string]any{}
+		for key := range currentMap {
+			keys[key] = nil
+		}
+
+		for key := range desiredMap {
+			keys[key] = nil
+		}
+
+		lines := []string{}
+		for _, key := range sortedKeys(keys) {
+			lines = append(lines, diffValues(path+"."+key, currentMap[key], desiredMap[key])...)
+		}
+
+		return lines
+	}
+
+	currentList, currentIsList := current.([]any)
+	desiredList, desiredIsList := desired.([]any)
+	if currentIsList && desiredIsList {
+		currentByID, currentHasIDs := itemsByID(currentList)
+		desiredByID, desiredHasIDs := itemsByID(desiredList)
+		if currentHasIDs && desiredHasIDs {
+			lines := []string{}
+			for _, item := range desiredList {
+				id := item.(map[string]any)["id"].(string)
+				lines = append(lines, diffValues(fmt.Sprintf("%s[id=%s]", path, id), currentByID[id], item)...)
+			}
+
+			for _, item := range currentList {
+				id := item.(map[string]any)["id"].(string)
+				if _, ok := desiredByID[id]; !ok {
+					lines = append(lines, diffValues(fmt.Sprintf("%s[id=%s]", path, id), item, nil)...)
+				}
+			}
+
+			return lines
+		}
+
+		if len(currentList) == len(desiredList) {
+			lines := []string{}
+			for i := range desiredList {
+				lines = append(lines, diffValues(fmt.Sprintf("%s[%d]", path, i), currentList[i], desiredList[i])...)
+			}
+
+			return lines
+		}
+	}
+
+	return []string{fmt.Sprintf("%s: %s -> %s", path, describe(current), describe(desired))}
+}
+
+func itemsByID(items []any) (map[string]any, bool) {
+	byID := map[string]any{}
+	for _, item := range items {
+		fields, ok := item.(map[string]any)
+		if !ok {
+			return nil, false
+		}
+
+		id, ok := fields["id"].(string)
+		if !ok || id == "" {
+			return nil, false
+		}
+
+		byID[id] = item
+	}
+
+	return byID, true
+}
+
+const maxDescribedLength = 80
+
+func describe(value any) string {
+	if value == nil {
+		return "(none)"
+	}
+
+	data, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprint(value)
+	}
+
+	if len(data) > maxDescribedLength {
+		return string(data[:maxDescribedLength-3]) + "..."
+	}
+
+	return string(data)
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+	return keys
+}
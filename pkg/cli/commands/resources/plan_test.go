@@ -0,0 +1,196 @@
+package resources
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/cli/core"
+)
+
+func testResource(kind, name string, spec map[string]any) *Resource {
+	return &Resource{
+		APIVersion: core.APIVersion,
+		Kind:       kind,
+		Metadata:   map[string]any{"name": name},
+		Spec:       spec,
+	}
+}
+
+func blueprintUsing(name string, dependencies ...string) *Resource {
+	nodes := []any{}
+	for _, dependency := range dependencies {
+		nodes = append(nodes, map[string]any{
+			"id":        dependency,
+			"type":      "TYPE_BLUEPRINT",
+			"blueprint": map[string]any{"name": dependency},
+		})
+	}
+
+	return testResource(BlueprintKind, name, map[string]any{"nodes": nodes})
+}
+
+func resourceKeys(resources []*Resource) []string {
+	keys := []string{}
+	for _, resource := range resources {
+		keys = append(keys, resource.Key())
+	}
+
+	return keys
+}
+
+func changeKeys(plan *Plan) []string {
+	keys := []string{}
+	for _, change := range plan.Changes {
+		keys = append(keys, change.Action+" "+change.Key())
+	}
+
+	return keys
+}
+
+func Test__OrderResources(t *testing.T) {
+	t.Run("resources are sorted by kind -> dependencies first", func(t *testing.T) {
+		ordered, err := orderResources([]*Resource{
+			testResource(CanvasKind, "main", nil),
+			testResource(BlueprintKind, "deploy", nil),
+			testResource(IntegrationKind, "github", nil),
+			testResource(SecretKind, "token", nil),
+			testResource(GroupKind, "engineering", nil),
+			testResource(RoleKind, "deployer", nil),
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, []string{
+			"Role/deployer",
+			"Group/engineering",
+			"Secret/token",
+			"Integration/github",
+			"Blueprint/deploy",
+			"Canvas/main",
+		}, resourceKeys(ordered))
+	})
+
+	t.Run("blueprint using other blueprints -> after them", func(t *testing.T) {
+		ordered, err := orderResources([]*Resource{
+			testResource(CanvasKind, "main", nil),
+			blueprintUsing("release", "deploy", "notify"),
+			blueprintUsing("deploy", "build"),
+			blueprintUsing("notify"),
+			blueprintUsing("build"),
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, []string{
+			"Blueprint/build",
+			"Blueprint/deploy",
+			"Blueprint/notify",
+			"Blueprint/release",
+			"Canvas/main",
+		}, resourceKeys(ordered))
+	})
+
+	t.Run("blueprints not in the files -> ignored", func(t *testing.T) {
+		ordered, err := orderResources([]*Resource{blueprintUsing("deploy", "existing")})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"Blueprint/deploy"}, resourceKeys(ordered))
+	})
+
+	t.Run("blueprints depending on each other -> error", func(t *testing.T) {
+		_, err := orderResources([]*Resource{
+			blueprintUsing("a", "b"),
+			blueprintUsing("b", "c"),
+			blueprintUsing("c", "a"),
+		})
+
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "blueprints depend on each other")
+	})
+
+	t.Run("blueprint using itself -> error", func(t *testing.T) {
+		_, err := orderResources([]*Resource{blueprintUsing("a", "a")})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "blueprints depend on each other")
+	})
+}
+
+func Test__ComparePlan(t *testing.T) {
+	c := newClient(core.CommandContext{})
+
+	current := map[string]*Resource{
+		"Group/engineering": testResource(GroupKind, "engineering", map[string]any{"role": "org_viewer"}),
+		"Group/platform":    testResource(GroupKind, "platform", map[string]any{"role": "org_admin"}),
+	}
+
+	desired := []*Resource{
+		testResource(GroupKind, "engineering", map[string]any{"role": "org_viewer"}),
+		testResource(GroupKind, "platform", map[string]any{"role": "org_owner"}),
+		testResource(GroupKind, "support", map[string]any{"role": "org_viewer"}),
+	}
+
+	t.Run("new, changed and unchanged resources -> creates and updates", func(t *testing.T) {
+		plan, err := comparePlan(c, desired, current, planOptions{})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"update Group/platform", "create Group/support"}, changeKeys(plan))
+		assert.Equal(t, []string{`spec.role: "org_admin" -> "org_owner"`}, plan.Changes[0].Diff)
+		assert.Equal(t, 1, plan.Unchanged)
+	})
+
+	t.Run("resources not in the files without prune -> kept", func(t *testing.T) {
+		plan, err := comparePlan(c, desired[:1], current, planOptions{})
+		require.NoError(t, err)
+		assert.Empty(t, plan.Changes)
+		assert.Equal(t, 1, plan.Unchanged)
+	})
+
+	t.Run("unchanged secrets with updateSecrets -> updated", func(t *testing.T) {
+		secret := testResource(SecretKind, "token", map[string]any{
+			"provider": "PROVIDER_LOCAL",
+			"local":    map[string]any{"data": map[string]any{"value": "env:TOKEN"}},
+		})
+
+		plan, err := comparePlan(c, []*Resource{secret}, map[string]*Resource{"Secret/token": secret}, planOptions{updateSecrets: true})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"update Secret/token"}, changeKeys(plan))
+	})
+
+	t.Run("prune -> deletions last, in reverse kind order", func(t *testing.T) {
+		current := map[string]*Resource{
+			"Role/deployer":     testResource(RoleKind, "deployer", nil),
+			"Group/platform":    testResource(GroupKind, "platform", nil),
+			"Blueprint/deploy":  testResource(BlueprintKind, "deploy", nil),
+			"Canvas/old":        testResource(CanvasKind, "old", nil),
+			"Secret/old-token":  testResource(SecretKind, "old-token", map[string]any{"provider": "PROVIDER_LOCAL"}),
+			"Blueprint/release": testResource(BlueprintKind, "release", nil),
+		}
+
+		desired := []*Resource{
+			testResource(CanvasKind, "main", nil),
+			testResource(BlueprintKind, "release", nil),
+		}
+
+		plan, err := comparePlan(c, desired, current, planOptions{prune: true})
+		require.NoError(t, err)
+		assert.Equal(t, []string{
+			"create Canvas/main",
+			"delete Canvas/old",
+			"delete Blueprint/deploy",
+			"delete Secret/old-token",
+			"delete Group/platform",
+			"delete Role/deployer",
+		}, changeKeys(plan))
+		assert.Equal(t, 1, plan.Unchanged)
+	})
+
+	t.Run("prune -> default roles are never deleted", func(t *testing.T) {
+		current := map[string]*Resource{
+			"Role/org_owner":  testResource(RoleKind, "org_owner", nil),
+			"Role/org_admin":  testResource(RoleKind, "org_admin", nil),
+			"Role/org_viewer": testResource(RoleKind, "org_viewer", nil),
+			"Role/deployer":   testResource(RoleKind, "deployer", nil),
+		}
+
+		plan, err := comparePlan(c, []*Resource{}, current, planOptions{prune: true})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"delete Role/deployer"}, changeKeys(plan))
+	})
+}
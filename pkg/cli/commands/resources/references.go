@@ -0,0 +1,115 @@
+package resources
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+//
+// Values that shouldn't be committed to files, like secret values
+// and integration credentials, are set with references:
+//
+//   fromEnv: NAME      - the value of an environment variable
+//   fromFile: path     - the contents of a file, relative to the resource file
+//
+
+func isReference(value any) bool {
+	reference, ok := value.(map[string]any)
+	if !ok || len(reference) != 1 {
+		return false
+	}
+
+	_, fromEnv := reference["fromEnv"]
+	_, fromFile := reference["fromFile"]
+	return fromEnv || fromFile
+}
+
+func resolveReference(value any, file string) (string, error) {
+	if !isReference(value) {
+		return "", fmt.Errorf("values must be references, with fromEnv or fromFile")
+	}
+
+	reference := value.(map[string]any)
+	if name, ok := reference["fromEnv"].(string); ok {
+		value, found := os.LookupEnv(name)
+		if !found {
+			return "", fmt.Errorf("environment variable %s is not set", name)
+		}
+
+		return value, nil
+	}
+
+	path, ok := reference["fromFile"].(string)
+	if !ok || path == "" {
+		return "", fmt.Errorf("fromFile must be a path")
+	}
+
+	if !filepath.IsAbs(path) && file != "" {
+		path = filepath.Join(filepath.Dir(file), path)
+	}
+
+	// #nosec
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return "", fmt.Errorf("failed to read %s: %w", path, err)
+	}
+
+	return strings.TrimRight(string(data), "\n"), nil
+}
+
+//
+// resolveReferences replaces all the references in a value,
+// leaving everything else as it is.
+//
+
+func resolveReferences(value any, file string) (any, error) {
+	if isReference(value) {
+		return resolveReference(value, file)
+	}
+
+	switch v := value.(type) {
+	case map[string]any:
+		result := map[string]any{}
+		for key, item := range v {
+			resolved, err := resolveReferences(item, file)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", key, err)
+			}
+
+			result[key] = resolved
+		}
+
+		return result, nil
+
+	case []any:
+		result := make([]any, len(v))
+		for i, item := range v {
+			resolved, err := resolveReferences(item, file)
+			if err != nil {
+				return nil, err
+			}
+
+			result[i] = resolved
+		}
+
+		return result, nil
+
+	default:
+		return v, nil
+	}
+}
+
+var nonAlphanumeric = regexp.MustCompile(`[^A-Za-z0-9]+`)
+
+//
+// envName is the environment variable used for a secret key in exports,
+// like DOCKERHUB_TOKEN for the key token of the secret dockerhub.
+//
+
+func envName(secret, key string) string {
+	name := nonAlphanumeric.ReplaceAllString(secret+"_"+key, "_")
+	return strings.ToUpper(strings.Trim(name, "_"))
+}
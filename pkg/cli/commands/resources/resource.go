@@ -0,0 +1,223 @@
+package resources
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/ghodss/yaml"
+	"github.com/superplanehq/superplane/pkg/cli/core"
+)
+
+//
+// Resource is any kind of resource, as it appears in files.
+// Resources are kept as plain maps, so they can be compared
+// and written back to files, and are only converted
+// to API types when they are sent to the API.
+//
+
+type Resource struct {
+	APIVersion string         `json:"apiVersion"`
+	Kind       string         `json:"kind"`
+	Metadata   map[string]any `json:"metadata"`
+	Spec       map[string]any `json:"spec,omitempty"`
+
+	//
+	// ID of the resource in the organization, if it exists,
+	// and the file it was loaded from, if any.
+	//
+	id   string
+	file string
+}
+
+func (r *Resource) Name() string {
+	name, _ := r.Metadata["name"].(string)
+	return name
+}
+
+func (r *Resource) Key() string {
+	return r.Kind + "/" + r.Name()
+}
+
+var documentSeparator = regexp.MustCompile(`(?m)^---\s*$`)
+
+//
+// ParseDocuments parses all the resources in a multi-document YAML file.
+// Empty documents are skipped.
+//
+
+func ParseDocuments(data []byte, file string) ([]*Resource, error) {
+	resources := []*Resource{}
+	for i, document := range SplitDocuments(data) {
+		resource, err := parseDocument(document)
+		if err != nil {
+			return nil, fmt.Errorf("%s: document %d: %w", file, i+1, err)
+		}
+
+		resource.file = file
+		resources = append(resources, resource)
+	}
+
+	return resources, nil
+}
+
+//
+// SplitDocuments splits a multi-document YAML file,
+// without the empty documents.
+//
+
+func SplitDocuments(data []byte) [][]byte {
+	documents := [][]byte{}
+	for _, document := range documentSeparator.Split(string(data), -1) {
+		if strings.TrimSpace(stripComments(document)) != "" {
+			documents = append(documents, []byte(document))
+		}
+	}
+
+	return documents
+}
+
+func parseDocument(data []byte) (*Resource, error) {
+	apiVersion, kind, err := core.ParseYamlResourceHeaders(data)
+	if err != nil {
+		return nil, err
+	}
+
+	if apiVersion != core.APIVersion {
+		return nil, fmt.Errorf("unsupported apiVersion %q", apiVersion)
+	}
+
+	k := findKind(kind)
+	if k == nil {
+		return nil, fmt.Errorf("unsupported resource kind %q, supported kinds are %s", kind, strings.Join(kindNames(), ", "))
+	}
+
+	resource := Resource{}
+	err = yaml.Unmarshal(data, &resource)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse %s resource: %w", kind, err)
+	}
+
+	if resource.Metadata == nil || resource.Name() == "" {
+		return nil, fmt.Errorf("%s metadata.name is required", kind)
+	}
+
+	if resource.Spec == nil {
+		resource.Spec = map[string]any{}
+	}
+
+	if k.validate != nil {
+		err = k.validate(&resource)
+		if err != nil {
+			return nil, fmt.Errorf("%s %s: %w", kind, resource.Name(), err)
+		}
+	}
+
+	return &resource, nil
+}
+
+func stripComments(document string) string {
+	lines := []string{}
+	for _, line := range strings.Split(document, "\n") {
+		if !strings.HasPrefix(strings.TrimSpace(line), "#") {
+			lines = append(lines, line)
+		}
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+//
+// LoadPaths loads resources from files and directories.
+// Directories are read recursively, for files ending in .yaml or .yml.
+// The same resource can't be defined twice.
+//
+
+func LoadPaths(paths []string) ([]*Resource, error) {
+	files := []string{}
+	for _, path := range paths {
+		info, err := os.Stat(path)
+		if err != nil {
+			return nil, err
+		}
+
+		if !info.IsDir() {
+			files = append(files, path)
+			continue
+		}
+
+		err = filepath.WalkDir(path, func(file string, entry os.DirEntry, err error) error {
+			if err != nil {
+				return err
+			}
+
+			if !entry.IsDir() && (strings.HasSuffix(file, ".yaml") || strings.HasSuffix(file, ".yml")) {
+				files = append(files, file)
+			}
+
+			return nil
+		})
+
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	sort.Strings(files)
+
+	resources := []*Resource{}
+	definedIn := map[string]string{}
+	for _, file := range files {
+		// #nosec
+		data, err := os.ReadFile(file)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read resource file: %w", err)
+		}
+
+		parsed, err := ParseDocuments(data, file)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, resource := range parsed {
+			if previous, ok := definedIn[resource.Key()]; ok {
+				return nil, fmt.Errorf("%s is defined in both %s and %s", resource.Key(), previous, file)
+			}
+
+			definedIn[resource.Key()] = file
+			resources = append(resources, resource)
+		}
+	}
+
+	if len(resources) == 0 {
+		return nil, fmt.Errorf("no resources found")
+	}
+
+	return resources, nil
+}
+
+//
+// WriteDocuments writes resources as a multi-document YAML file.
+//
+
+func WriteDocuments(resources []*Resource) ([]byte, error) {
+	buffer := bytes.Buffer{}
+	for i, resource := range resources {
+		if i > 0 {
+			buffer.WriteString("---\n")
+		}
+
+		data, err := yaml.Marshal(resource)
+		if err != nil {
+			return nil, err
+		}
+
+		buffer.Write(data)
+	}
+
+	return buffer.Bytes(), nil
+}
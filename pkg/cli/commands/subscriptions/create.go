@@ -0,0 +1,42 @@
+package subscriptions
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/superplanehq/superplane/pkg/cli/core"
+	"github.com/superplanehq/superplane/pkg/openapi_client"
+)
+
+type createCommand struct {
+	flags *subscriptionFlags
+}
+
+func (c *createCommand) Execute(ctx core.CommandContext) error {
+	subscription := openapi_client.SubscriptionsSubscription{}
+	subscription.SetName(ctx.Args[0])
+	subscription.SetUrl(c.flags.url)
+	subscription.SetEventTypes(c.flags.eventTypes)
+	subscription.SetCanvasIds(c.flags.canvases)
+	subscription.SetNodeIds(c.flags.nodes)
+
+	request := openapi_client.SubscriptionsCreateSubscriptionRequest{}
+	request.SetSubscription(subscription)
+
+	response, _, err := ctx.API.SubscriptionsAPI.SubscriptionsCreateSubscription(ctx.Context).Body(request).Execute()
+	if err != nil {
+		return err
+	}
+
+	if !ctx.Renderer.IsText() {
+		return ctx.Renderer.Render(response)
+	}
+
+	return ctx.Renderer.RenderText(func(stdout io.Writer) error {
+		created := response.GetSubscription()
+		_, _ = fmt.Fprintf(stdout, "Subscription created: %s (%s)\n", created.GetName(), created.GetId())
+		_, _ = fmt.Fprintln(stdout, "Deliveries are signed with the secret below. Make sure to copy it now, it will not be shown again:")
+		_, err := fmt.Fprintln(stdout, response.GetSecret())
+		return err
+	})
+}
@@ -0,0 +1,61 @@
+package subscriptions
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/superplanehq/superplane/pkg/cli/core"
+)
+
+type deliveriesCommand struct {
+	limit *int64
+}
+
+func (c *deliveriesCommand) Execute(ctx core.CommandContext) error {
+	if *c.limit <= 0 {
+		return fmt.Errorf("--limit must be positive")
+	}
+
+	response, _, err := ctx.API.SubscriptionsAPI.
+		SubscriptionsListSubscriptionDeliveries(ctx.Context, ctx.Args[0]).
+		Limit(*c.limit).
+		Execute()
+
+	if err != nil {
+		return err
+	}
+
+	deliveries := response.GetDeliveries()
+	if !ctx.Renderer.IsText() {
+		return ctx.Renderer.Render(deliveries)
+	}
+
+	return ctx.Renderer.RenderText(func(stdout io.Writer) error {
+		writer := tabwriter.NewWriter(stdout, 0, 8, 2, ' ', 0)
+		_, _ = fmt.Fprintln(writer, "ID\tEVENT\tSTATE\tATTEMPTS\tSTATUS\tCREATED_AT\tERROR")
+
+		for _, delivery := range deliveries {
+			responseStatus := "-"
+			if delivery.HasResponseStatus() {
+				responseStatus = fmt.Sprintf("%d", delivery.GetResponseStatus())
+			}
+
+			_, _ = fmt.Fprintf(
+				writer,
+				"%s\t%s\t%s\t%d\t%s\t%s\t%s\n",
+				delivery.GetId(),
+				delivery.GetEventType(),
+				strings.ToLower(strings.TrimPrefix(string(delivery.GetState()), "DELIVERY_STATE_")),
+				delivery.GetAttempts(),
+				responseStatus,
+				delivery.GetCreatedAt().Format(time.RFC3339),
+				delivery.GetLastError(),
+			)
+		}
+
+		return writer.Flush()
+	})
+}
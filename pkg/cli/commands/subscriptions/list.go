@@ -0,0 +1,81 @@
+package subscriptions
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/superplanehq/superplane/pkg/cli/core"
+	"github.com/superplanehq/superplane/pkg/openapi_client"
+)
+
+type listCommand struct{}
+
+func (c *listCommand) Execute(ctx core.CommandContext) error {
+	response, _, err := ctx.API.SubscriptionsAPI.SubscriptionsListSubscriptions(ctx.Context).Execute()
+	if err != nil {
+		return err
+	}
+
+	subscriptions := response.GetSubscriptions()
+	if !ctx.Renderer.IsText() {
+		return ctx.Renderer.Render(subscriptions)
+	}
+
+	return ctx.Renderer.RenderText(func(stdout io.Writer) error {
+		writer := tabwriter.NewWriter(stdout, 0, 8, 2, ' ', 0)
+		_, _ = fmt.Fprintln(writer, "ID\tNAME\tURL\tEVENTS\tENABLED")
+
+		for _, subscription := range subscriptions {
+			writeSubscriptionRow(writer, subscription)
+		}
+
+		return writer.Flush()
+	})
+}
+
+type getCommand struct{}
+
+func (c *getCommand) Execute(ctx core.CommandContext) error {
+	response, _, err := ctx.API.SubscriptionsAPI.SubscriptionsDescribeSubscription(ctx.Context, ctx.Args[0]).Execute()
+	if err != nil {
+		return err
+	}
+
+	subscription := response.GetSubscription()
+	if !ctx.Renderer.IsText() {
+		return ctx.Renderer.Render(subscription)
+	}
+
+	return ctx.Renderer.RenderText(func(stdout io.Writer) error {
+		_, _ = fmt.Fprintf(stdout, "ID: %s\n", subscription.GetId())
+		_, _ = fmt.Fprintf(stdout, "Name: %s\n", subscription.GetName())
+		_, _ = fmt.Fprintf(stdout, "URL: %s\n", subscription.GetUrl())
+		_, _ = fmt.Fprintf(stdout, "Events: %s\n", strings.Join(subscription.GetEventTypes(), ", "))
+		_, _ = fmt.Fprintf(stdout, "Canvases: %s\n", formatFilter(subscription.GetCanvasIds()))
+		_, _ = fmt.Fprintf(stdout, "Nodes: %s\n", formatFilter(subscription.GetNodeIds()))
+		_, err := fmt.Fprintf(stdout, "Enabled: %t\n", subscription.GetEnabled())
+		return err
+	})
+}
+
+func writeSubscriptionRow(writer io.Writer, subscription openapi_client.SubscriptionsSubscription) {
+	_, _ = fmt.Fprintf(
+		writer,
+		"%s\t%s\t%s\t%s\t%t\n",
+		subscription.GetId(),
+		subscription.GetName(),
+		subscription.GetUrl(),
+		strings.Join(subscription.GetEventTypes(), ","),
+		subscription.GetEnabled(),
+	)
+}
+
+func formatFilter(values []string) string {
+	if len(values) == 0 {
+		return "all"
+	}
+
+	return strings.Join(values, ", ")
+}
@@ -0,0 +1,99 @@
+package subscriptions
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/superplanehq/superplane/pkg/cli/core"
+)
+
+func NewCommand(options core.BindOptions) *cobra.Command {
+	root := &cobra.Command{
+		Use:     "subscriptions",
+		Short:   "Manage outbound event subscriptions",
+		Long:    "Event subscriptions send signed webhooks to external systems when executions finish, approvals are requested or nodes fail.",
+		Aliases: []string{"subscription"},
+	}
+
+	listCmd := &cobra.Command{
+		Use:   "list",
+		Short: "List event subscriptions",
+		Args:  cobra.NoArgs,
+	}
+	core.Bind(listCmd, &listCommand{}, options)
+
+	getCmd := &cobra.Command{
+		Use:   "get <id>",
+		Short: "Get an event subscription",
+		Args:  cobra.ExactArgs(1),
+	}
+	core.Bind(getCmd, &getCommand{}, options)
+
+	var createFlags subscriptionFlags
+	createCmd := &cobra.Command{
+		Use:   "create <name>",
+		Short: "Create an event subscription",
+		Long: `Create an event subscription.
+
+Event types are execution.finished, approval.requested and node.error.
+Without --canvas or --node, events from all canvases and nodes are sent.
+The secret used to sign the deliveries is only shown once.`,
+		Example: `  superplane subscriptions create portal --url https://portal.example.com/hooks --event execution.finished --canvas <canvas-id>`,
+		Args:    cobra.ExactArgs(1),
+	}
+	createFlags.register(createCmd)
+	_ = createCmd.MarkFlagRequired("url")
+	_ = createCmd.MarkFlagRequired("event")
+	core.Bind(createCmd, &createCommand{flags: &createFlags}, options)
+
+	var updateFlags subscriptionFlags
+	var updateName string
+	var updateEnabled bool
+	updateCmd := &cobra.Command{
+		Use:   "update <id>",
+		Short: "Update an event subscription",
+		Long:  "Only the given flags are changed. Repeatable flags replace the current values.",
+		Args:  cobra.ExactArgs(1),
+	}
+	updateFlags.register(updateCmd)
+	updateCmd.Flags().StringVar(&updateName, "name", "", "new name for the subscription")
+	updateCmd.Flags().BoolVar(&updateEnabled, "enabled", true, "enable or disable the subscription")
+	core.Bind(updateCmd, &updateCommand{flags: &updateFlags, name: &updateName, enabled: &updateEnabled}, options)
+
+	deleteCmd := &cobra.Command{
+		Use:   "delete <id>",
+		Short: "Delete an event subscription",
+		Args:  cobra.ExactArgs(1),
+	}
+	core.Bind(deleteCmd, &deleteCommand{}, options)
+
+	var deliveriesLimit int64
+	deliveriesCmd := &cobra.Command{
+		Use:   "deliveries <id>",
+		Short: "Show the delivery log of an event subscription",
+		Args:  cobra.ExactArgs(1),
+	}
+	deliveriesCmd.Flags().Int64Var(&deliveriesLimit, "limit", 50, "maximum number of deliveries to show")
+	core.Bind(deliveriesCmd, &deliveriesCommand{limit: &deliveriesLimit}, options)
+
+	root.AddCommand(listCmd)
+	root.AddCommand(getCmd)
+	root.AddCommand(createCmd)
+	root.AddCommand(updateCmd)
+	root.AddCommand(deleteCmd)
+	root.AddCommand(deliveriesCmd)
+
+	return root
+}
+
+type subscriptionFlags struct {
+	url        string
+	eventTypes []string
+	canvases   []string
+	nodes      []string
+}
+
+func (f *subscriptionFlags) register(cmd *cobra.Command) {
+	cmd.Flags().StringVar(&f.url, "url", "", "URL the events are sent to")
+	cmd.Flags().StringArrayVar(&f.eventTypes, "event", nil, "event type to send (repeatable)")
+	cmd.Flags().StringArrayVar(&f.canvases, "canvas", nil, "only send events from this canvas (repeatable)")
+	cmd.Flags().StringArrayVar(&f.nodes, "node", nil, "only send events from this node (repeatable)")
+}
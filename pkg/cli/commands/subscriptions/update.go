@@ -0,0 +1,81 @@
+package subscriptions
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/superplanehq/superplane/pkg/cli/core"
+)
+
+type updateCommand struct {
+	flags   *subscriptionFlags
+	name    *string
+	enabled *bool
+}
+
+func (c *updateCommand) Execute(ctx core.CommandContext) error {
+	id := ctx.Args[0]
+	current, _, err := ctx.API.SubscriptionsAPI.SubscriptionsDescribeSubscription(ctx.Context, id).Execute()
+	if err != nil {
+		return err
+	}
+
+	subscription := current.GetSubscription()
+	flags := ctx.Cmd.Flags()
+
+	if flags.Changed("name") {
+		subscription.SetName(*c.name)
+	}
+
+	if flags.Changed("url") {
+		subscription.SetUrl(c.flags.url)
+	}
+
+	if flags.Changed("event") {
+		subscription.SetEventTypes(c.flags.eventTypes)
+	}
+
+	if flags.Changed("canvas") {
+		subscription.SetCanvasIds(c.flags.canvases)
+	}
+
+	if flags.Changed("node") {
+		subscription.SetNodeIds(c.flags.nodes)
+	}
+
+	if flags.Changed("enabled") {
+		subscription.SetEnabled(*c.enabled)
+	}
+
+	response, _, err := ctx.API.SubscriptionsAPI.SubscriptionsUpdateSubscription(ctx.Context, id).Body(subscription).Execute()
+	if err != nil {
+		return err
+	}
+
+	if !ctx.Renderer.IsText() {
+		return ctx.Renderer.Render(response.GetSubscription())
+	}
+
+	return ctx.Renderer.RenderText(func(stdout io.Writer) error {
+		_, err := fmt.Fprintf(stdout, "Subscription updated: %s\n", id)
+		return err
+	})
+}
+
+type deleteCommand struct{}
+
+func (c *deleteCommand) Execute(ctx core.CommandContext) error {
+	response, _, err := ctx.API.SubscriptionsAPI.SubscriptionsDeleteSubscription(ctx.Context, ctx.Args[0]).Execute()
+	if err != nil {
+		return err
+	}
+
+	if ctx.Renderer.IsText() {
+		return ctx.Renderer.RenderText(func(stdout io.Writer) error {
+			_, err := fmt.Fprintf(stdout, "Subscription deleted: %s\n", ctx.Args[0])
+			return err
+		})
+	}
+
+	return ctx.Renderer.Render(response)
+}
@@ -0,0 +1,93 @@
+package tokens
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/superplanehq/superplane/pkg/cli/core"
+	"github.com/superplanehq/superplane/pkg/openapi_client"
+)
+
+type createCommand struct {
+	scopes         *[]string
+	expiresIn      *time.Duration
+	serviceAccount *string
+}
+
+func (c *createCommand) Execute(ctx core.CommandContext) error {
+	scopes, err := parseScopes(*c.scopes)
+	if err != nil {
+		return err
+	}
+
+	request := openapi_client.TokensCreateTokenRequest{}
+	request.SetName(ctx.Args[0])
+	request.SetScopes(scopes)
+
+	if *c.expiresIn < 0 {
+		return fmt.Errorf("--expires-in must be positive")
+	}
+
+	if *c.expiresIn > 0 {
+		request.SetExpiresAt(time.Now().Add(*c.expiresIn))
+	}
+
+	if *c.serviceAccount != "" {
+		request.SetServiceAccountId(*c.serviceAccount)
+	}
+
+	response, _, err := ctx.API.TokensAPI.TokensCreateToken(ctx.Context).Body(request).Execute()
+	if err != nil {
+		return err
+	}
+
+	if !ctx.Renderer.IsText() {
+		return ctx.Renderer.Render(response)
+	}
+
+	return ctx.Renderer.RenderText(func(stdout io.Writer) error {
+		token := response.GetToken()
+		_, _ = fmt.Fprintf(stdout, "Token created: %s (%s)\n", token.GetName(), token.GetId())
+		_, _ = fmt.Fprintln(stdout, "Make sure to copy it now, it will not be shown again:")
+		_, err := fmt.Fprintln(stdout, response.GetValue())
+		return err
+	})
+}
+
+//
+// Scopes are given as <permission> or <permission>=<canvas-id>,<canvas-id>.
+//
+
+func parseScopes(values []string) ([]openapi_client.TokensTokenScope, error) {
+	scopes := make([]openapi_client.TokensTokenScope, 0, len(values))
+	for _, value := range values {
+		permission, canvases, hasCanvases := strings.Cut(strings.TrimSpace(value), "=")
+		if permission == "" {
+			return nil, fmt.Errorf("invalid scope %q", value)
+		}
+
+		scope := openapi_client.TokensTokenScope{}
+		scope.SetPermission(permission)
+
+		if hasCanvases {
+			ids := []string{}
+			for _, id := range strings.Split(canvases, ",") {
+				if id = strings.TrimSpace(id); id != "" {
+					ids = append(ids, id)
+				}
+			}
+
+			if len(ids) == 0 {
+				return nil, fmt.Errorf("invalid scope %q: no canvases given", value)
+			}
+
+			scope.SetCanvasIds(ids)
+		}
+
+		scopes = append(scopes, scope)
+	}
+
+	return scopes, nil
+}
@@ -0,0 +1,74 @@
+package tokens
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/superplanehq/superplane/pkg/cli/core"
+	"github.com/superplanehq/superplane/pkg/openapi_client"
+)
+
+type listCommand struct {
+	serviceAccount *string
+}
+
+func (c *listCommand) Execute(ctx core.CommandContext) error {
+	request := ctx.API.TokensAPI.TokensListTokens(ctx.Context)
+	if *c.serviceAccount != "" {
+		request = request.ServiceAccountId(*c.serviceAccount)
+	}
+
+	response, _, err := request.Execute()
+	if err != nil {
+		return err
+	}
+
+	tokens := response.GetTokens()
+	if !ctx.Renderer.IsText() {
+		return ctx.Renderer.Render(tokens)
+	}
+
+	return ctx.Renderer.RenderText(func(stdout io.Writer) error {
+		writer := tabwriter.NewWriter(stdout, 0, 8, 2, ' ', 0)
+		_, _ = fmt.Fprintln(writer, "ID\tNAME\tSCOPES\tEXPIRES_AT\tLAST_USED_AT")
+
+		for _, token := range tokens {
+			_, _ = fmt.Fprintf(
+				writer,
+				"%s\t%s\t%s\t%s\t%s\n",
+				token.GetId(),
+				token.GetName(),
+				formatScopes(token.GetScopes()),
+				formatTime(token.HasExpiresAt(), token.GetExpiresAt(), "never"),
+				formatTime(token.HasLastUsedAt(), token.GetLastUsedAt(), "never"),
+			)
+		}
+
+		return writer.Flush()
+	})
+}
+
+func formatScopes(scopes []openapi_client.TokensTokenScope) string {
+	values := make([]string, 0, len(scopes))
+	for _, scope := range scopes {
+		value := scope.GetPermission()
+		if len(scope.GetCanvasIds()) > 0 {
+			value += "=" + strings.Join(scope.GetCanvasIds(), ",")
+		}
+
+		values = append(values, value)
+	}
+
+	return strings.Join(values, " ")
+}
+
+func formatTime(isSet bool, t time.Time, fallback string) string {
+	if !isSet {
+		return fallback
+	}
+
+	return t.Format(time.RFC3339)
+}
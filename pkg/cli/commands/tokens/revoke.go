@@ -0,0 +1,26 @@
+package tokens
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/superplanehq/superplane/pkg/cli/core"
+)
+
+type revokeCommand struct{}
+
+func (c *revokeCommand) Execute(ctx core.CommandContext) error {
+	response, _, err := ctx.API.TokensAPI.TokensRevokeToken(ctx.Context, ctx.Args[0]).Execute()
+	if err != nil {
+		return err
+	}
+
+	if ctx.Renderer.IsText() {
+		return ctx.Renderer.RenderText(func(stdout io.Writer) error {
+			_, err := fmt.Fprintf(stdout, "Token revoked: %s\n", ctx.Args[0])
+			return err
+		})
+	}
+
+	return ctx.Renderer.Render(response)
+}
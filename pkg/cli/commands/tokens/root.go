@@ -0,0 +1,62 @@
+package tokens
+
+import (
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/superplanehq/superplane/pkg/cli/core"
+)
+
+func NewCommand(options core.BindOptions) *cobra.Command {
+	root := &cobra.Command{
+		Use:     "tokens",
+		Short:   "Manage API tokens",
+		Aliases: []string{"token"},
+	}
+
+	listCmd := &cobra.Command{
+		Use:   "list",
+		Short: "List API tokens",
+		Args:  cobra.NoArgs,
+	}
+	var listServiceAccount string
+	listCmd.Flags().StringVar(&listServiceAccount, "service-account", "", "list the tokens of this service account instead of your own")
+	core.Bind(listCmd, &listCommand{serviceAccount: &listServiceAccount}, options)
+
+	createCmd := &cobra.Command{
+		Use:   "create <name>",
+		Short: "Create an API token",
+		Long: `Create a named API token.
+
+Scopes restrict what the token can do, on top of the permissions of its owner.
+Use "*" for full access, or permissions like canvases:read and events:emit.
+Canvas permissions can be restricted to specific canvases with permission=<canvas-id>,<canvas-id>.`,
+		Example: `  superplane tokens create ci --scope events:emit=<canvas-id> --expires-in 720h`,
+		Args:    cobra.ExactArgs(1),
+	}
+	var createScopes []string
+	var createExpiresIn time.Duration
+	var createServiceAccount string
+	createCmd.Flags().StringArrayVar(&createScopes, "scope", nil, "permission granted to the token (repeatable)")
+	createCmd.Flags().DurationVar(&createExpiresIn, "expires-in", 0, "how long the token is valid for, e.g. 720h (never expires if not set)")
+	createCmd.Flags().StringVar(&createServiceAccount, "service-account", "", "create the token for this service account instead of yourself")
+	_ = createCmd.MarkFlagRequired("scope")
+	core.Bind(createCmd, &createCommand{
+		scopes:         &createScopes,
+		expiresIn:      &createExpiresIn,
+		serviceAccount: &createServiceAccount,
+	}, options)
+
+	revokeCmd := &cobra.Command{
+		Use:   "revoke <id>",
+		Short: "Revoke an API token",
+		Args:  cobra.ExactArgs(1),
+	}
+	core.Bind(revokeCmd, &revokeCommand{}, options)
+
+	root.AddCommand(listCmd)
+	root.AddCommand(createCmd)
+	root.AddCommand(revokeCmd)
+
+	return root
+}
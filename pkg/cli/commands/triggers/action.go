@@ -0,0 +1,63 @@
+package triggers
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/superplanehq/superplane/pkg/cli/core"
+	"github.com/superplanehq/superplane/pkg/openapi_client"
+)
+
+type InvokeActionCommand struct {
+	Parameters *[]string
+}
+
+func (c *InvokeActionCommand) Execute(ctx core.CommandContext) error {
+	canvasID, err := core.FindCanvasID(ctx, ctx.Args[0])
+	if err != nil {
+		return err
+	}
+
+	node, err := core.FindCanvasNode(ctx, canvasID, ctx.Args[1])
+	if err != nil {
+		return err
+	}
+
+	parameters, err := core.ParseParameters(*c.Parameters)
+	if err != nil {
+		return err
+	}
+
+	actionName := ctx.Args[2]
+	body := openapi_client.CanvasesInvokeNodeTriggerActionBody{}
+	body.SetParameters(parameters)
+
+	response, _, err := ctx.API.CanvasNodeAPI.
+		CanvasesInvokeNodeTriggerAction(ctx.Context, canvasID, node.GetId(), actionName).
+		Body(body).
+		Execute()
+
+	if err != nil {
+		return err
+	}
+
+	if !ctx.Renderer.IsText() {
+		return ctx.Renderer.Render(response)
+	}
+
+	return ctx.Renderer.RenderText(func(stdout io.Writer) error {
+		_, _ = fmt.Fprintf(stdout, "Action %s invoked on %s\n", actionName, node.GetName())
+		if len(response.GetResult()) == 0 {
+			return nil
+		}
+
+		result, err := json.MarshalIndent(response.GetResult(), "", "  ")
+		if err != nil {
+			return err
+		}
+
+		_, err = fmt.Fprintf(stdout, "%s\n", result)
+		return err
+	})
+}
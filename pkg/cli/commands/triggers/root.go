@@ -0,0 +1,76 @@
+package triggers
+
+import (
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/superplanehq/superplane/pkg/cli/core"
+)
+
+func NewCommand(options core.BindOptions) *cobra.Command {
+	var template string
+	var data string
+	var inputs []string
+	var channel string
+	var parameters []string
+	var wait bool
+	var timeout time.Duration
+
+	root := &cobra.Command{
+		Use:     "triggers",
+		Short:   "Run canvas triggers and invoke their actions",
+		Aliases: []string{"trigger"},
+	}
+
+	//
+	// Run command
+	//
+	runCmd := &cobra.Command{
+		Use:   "run <canvas> <node>",
+		Short: "Run a manual run trigger, starting a new execution chain",
+		Long: `Runs a manual run trigger, like the Run button of the UI.
+The canvas and the node can be given by name or ID.
+
+The payload is one of the templates of the trigger, picked with --template, or the JSON given with --data.
+Triggers that declare inputs take them with --input instead.
+
+With --wait, the command blocks until the execution chain finishes,
+and exits with 0 if it passed, 1 if it failed and 2 if it was cancelled.`,
+		Example: `  superplane triggers run my-canvas "Manual Run" --template "Hello World" --wait
+  superplane triggers run my-canvas "Deploy" --input environment=production --input replicas=3`,
+		Args: cobra.ExactArgs(2),
+	}
+	runCmd.Flags().StringVar(&template, "template", "", "name of the trigger template to use as payload")
+	runCmd.Flags().StringVar(&data, "data", "", "payload as a JSON object, or @file to read it from a file")
+	runCmd.Flags().StringArrayVar(&inputs, "input", nil, "input value as key=value (repeatable)")
+	runCmd.Flags().StringVar(&channel, "channel", "default", "output channel to emit the event on")
+	runCmd.Flags().BoolVar(&wait, "wait", false, "wait for the execution chain to finish and exit with its outcome")
+	runCmd.Flags().DurationVar(&timeout, "timeout", 0, "maximum time to wait with --wait, e.g. 10m (default no limit)")
+	core.Bind(runCmd, &RunTriggerCommand{
+		Template: &template,
+		Data:     &data,
+		Inputs:   &inputs,
+		Channel:  &channel,
+		Wait:     &wait,
+		Timeout:  &timeout,
+	}, options)
+
+	//
+	// Action command
+	//
+	actionCmd := &cobra.Command{
+		Use:     "action <canvas> <node> <action>",
+		Short:   "Invoke an action of a trigger",
+		Example: `  superplane triggers action my-canvas "Webhook" resetAuthentication`,
+		Args:    cobra.ExactArgs(3),
+	}
+	actionCmd.Flags().StringArrayVar(&parameters, "param", nil, "action parameter as key=value (repeatable)")
+	core.Bind(actionCmd, &InvokeActionCommand{
+		Parameters: &parameters,
+	}, options)
+
+	root.AddCommand(runCmd)
+	root.AddCommand(actionCmd)
+
+	return root
+}
@@ -0,0 +1,132 @@
+package triggers
+
+import (
+	"fmt"
+	"io"
+	"maps"
+	"strings"
+	"time"
+
+	"github.com/superplanehq/superplane/pkg/cli/core"
+	"github.com/superplanehq/superplane/pkg/openapi_client"
+)
+
+const startTriggerName = "start"
+
+type RunTriggerCommand struct {
+	Template *string
+	Data     *string
+	Inputs   *[]string
+	Channel  *string
+	Wait     *bool
+	Timeout  *time.Duration
+}
+
+func (c *RunTriggerCommand) Execute(ctx core.CommandContext) error {
+	canvasID, err := core.FindCanvasID(ctx, ctx.Args[0])
+	if err != nil {
+		return err
+	}
+
+	node, err := core.FindCanvasNode(ctx, canvasID, ctx.Args[1])
+	if err != nil {
+		return err
+	}
+
+	trigger, ok := node.GetTriggerOk()
+	if !ok || trigger.GetName() != startTriggerName {
+		return fmt.Errorf("%s is not a manual run trigger; use \"superplane events emit\" to emit events from other nodes", node.GetName())
+	}
+
+	payload, err := c.payload(node)
+	if err != nil {
+		return err
+	}
+
+	body := openapi_client.CanvasesEmitNodeEventBody{}
+	body.SetChannel(*c.Channel)
+	body.SetData(payload)
+
+	response, _, err := ctx.API.CanvasNodeAPI.
+		CanvasesEmitNodeEvent(ctx.Context, canvasID, node.GetId()).
+		Body(body).
+		Execute()
+
+	if err != nil {
+		return err
+	}
+
+	if *c.Wait {
+		return core.WaitForChain(ctx, canvasID, response.GetEventId(), *c.Timeout)
+	}
+
+	if !ctx.Renderer.IsText() {
+		return ctx.Renderer.Render(response)
+	}
+
+	return ctx.Renderer.RenderText(func(stdout io.Writer) error {
+		_, err := fmt.Fprintf(stdout, "Started %s (event %s)\n", node.GetName(), response.GetEventId())
+		return err
+	})
+}
+
+//
+// The payload starts from the template or the data given,
+// and the inputs are set on top of it.
+//
+
+func (c *RunTriggerCommand) payload(node *openapi_client.ComponentsNode) (map[string]any, error) {
+	if *c.Template != "" && *c.Data != "" {
+		return nil, fmt.Errorf("--template and --data cannot be used together")
+	}
+
+	payload, err := core.ParseData(*c.Data)
+	if err != nil {
+		return nil, err
+	}
+
+	if *c.Template != "" {
+		payload, err = findTemplate(node, *c.Template)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	inputs, err := core.ParseInputs(*c.Inputs)
+	if err != nil {
+		return nil, err
+	}
+
+	maps.Copy(payload, inputs)
+	return payload, nil
+}
+
+func findTemplate(node *openapi_client.ComponentsNode, name string) (map[string]any, error) {
+	templates, _ := node.GetConfiguration()["templates"].([]any)
+	names := []string{}
+	for _, t := range templates {
+		template, ok := t.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		templateName, _ := template["name"].(string)
+		if templateName != name {
+			names = append(names, templateName)
+			continue
+		}
+
+		payload, ok := template["payload"].(map[string]any)
+		if !ok {
+			return map[string]any{}, nil
+		}
+
+		return maps.Clone(payload), nil
+	}
+
+	if len(names) == 0 {
+		return nil, fmt.Errorf("template %q not found: %s has no templates", name, node.GetName())
+	}
+
+	return nil, fmt.Errorf("template %q not found, available templates: %s", name, strings.Join(names, ", "))
+}
@@ -0,0 +1,155 @@
+package tui
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/superplanehq/superplane/pkg/cli/core"
+	"github.com/superplanehq/superplane/pkg/openapi_client"
+)
+
+func setNodePaused(a *app, canvasID string, node openapi_client.ComponentsNode, paused bool) error {
+	body := openapi_client.CanvasesUpdateNodePauseBody{}
+	body.SetPaused(paused)
+
+	_, _, err := a.ctx.API.CanvasNodeAPI.
+		CanvasesUpdateNodePause(a.ctx.Context, canvasID, node.GetId()).
+		Body(body).
+		Execute()
+	if err != nil {
+		return err
+	}
+
+	if paused {
+		a.setStatus("Paused %s", node.GetName())
+	} else {
+		a.setStatus("Resumed %s", node.GetName())
+	}
+
+	a.refresh()
+	return nil
+}
+
+//
+// Events are emitted as "<channel> <JSON data>",
+// like `default {"ref": "main"}`. Both parts are optional.
+//
+
+func askEmitEvent(a *app, canvasID string, node openapi_client.ComponentsNode) {
+	label := fmt.Sprintf("Emit event from %s (channel and JSON data):", node.GetName())
+	a.ask(label, func(input string) error {
+		channel, data, err := parseEmitInput(input)
+		if err != nil {
+			return err
+		}
+
+		body := openapi_client.CanvasesEmitNodeEventBody{}
+		body.SetChannel(channel)
+		body.SetData(data)
+
+		response, _, err := a.ctx.API.CanvasNodeAPI.
+			CanvasesEmitNodeEvent(a.ctx.Context, canvasID, node.GetId()).
+			Body(body).
+			Execute()
+		if err != nil {
+			return err
+		}
+
+		a.setStatus("Emitted event %s on %s", shortID(response.GetEventId()), channel)
+		return nil
+	})
+}
+
+func parseEmitInput(input string) (string, map[string]any, error) {
+	input = strings.TrimSpace(input)
+	channel := "default"
+	if input != "" && !strings.HasPrefix(input, "{") {
+		channel, input, _ = strings.Cut(input, " ")
+		input = strings.TrimSpace(input)
+	}
+
+	data := map[string]any{}
+	if input == "" {
+		return channel, data, nil
+	}
+
+	err := json.Unmarshal([]byte(input), &data)
+	if err != nil {
+		return "", nil, fmt.Errorf("event data must be a JSON object: %w", err)
+	}
+
+	return channel, data, nil
+}
+
+func cancelExecution(a *app, canvasID string, execution openapi_client.CanvasesCanvasNodeExecution) {
+	a.confirm(fmt.Sprintf("Cancel execution %s?", shortID(execution.GetId())), func() error {
+		_, _, err := a.ctx.API.CanvasNodeExecutionAPI.
+			CanvasesCancelExecution(a.ctx.Context, canvasID, execution.GetId()).
+			Body(map[string]any{}).
+			Execute()
+		if err != nil {
+			return err
+		}
+
+		a.setStatus("Cancelled execution %s", shortID(execution.GetId()))
+		return nil
+	})
+}
+
+//
+// Actions are invoked as "<name> key=value ...", like "approve index=0".
+// Values that are valid JSON, like numbers, are sent as such.
+//
+
+func askExecutionAction(a *app, canvasID string, execution openapi_client.CanvasesCanvasNodeExecution) {
+	label := fmt.Sprintf("Action for %s (name key=value ...):", shortID(execution.GetId()))
+	a.ask(label, func(input string) error {
+		name, parameters, err := parseActionInput(input)
+		if err != nil {
+			return err
+		}
+
+		body := openapi_client.CanvasesInvokeNodeExecutionActionBody{}
+		body.SetParameters(parameters)
+
+		_, _, err = a.ctx.API.CanvasNodeExecutionAPI.
+			CanvasesInvokeNodeExecutionAction(a.ctx.Context, canvasID, execution.GetId(), name).
+			Body(body).
+			Execute()
+		if err != nil {
+			return err
+		}
+
+		a.setStatus("Invoked %s on execution %s", name, shortID(execution.GetId()))
+		return nil
+	})
+}
+
+func parseActionInput(input string) (string, map[string]any, error) {
+	fields := strings.Fields(input)
+	if len(fields) == 0 {
+		return "", nil, fmt.Errorf("action name is required")
+	}
+
+	parameters, err := core.ParseParameters(fields[1:])
+	if err != nil {
+		return "", nil, err
+	}
+
+	return fields[0], parameters, nil
+}
+
+func deleteQueueItem(a *app, canvasID, nodeID string, item openapi_client.CanvasesCanvasNodeQueueItem) {
+	a.confirm(fmt.Sprintf("Delete queue item %s?", shortID(item.GetId())), func() error {
+		_, _, err := a.ctx.API.CanvasNodeAPI.
+			CanvasesDeleteNodeQueueItem(a.ctx.Context, canvasID, nodeID, item.GetId()).
+			Execute()
+		if err != nil {
+			return err
+		}
+
+		a.setStatus("Deleted queue item %s", shortID(item.GetId()))
+		return nil
+	})
+}
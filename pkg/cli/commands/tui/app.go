@@ -0,0 +1,275 @@
+package tui
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/superplanehq/superplane/pkg/cli/core"
+	"github.com/superplanehq/superplane/pkg/openapi_client"
+)
+
+const (
+	//
+	// Views are refreshed on this interval too,
+	// in case the watch stream is not available.
+	//
+	pollInterval = 10 * time.Second
+
+	//
+	// Changes arrive in bursts, like an execution finishing
+	// and the next one starting, so they are refreshed together.
+	//
+	changeDebounce = 300 * time.Millisecond
+)
+
+//
+// A view is one screen of the TUI. Views are kept in a stack:
+// enter pushes a view, and escape goes back to the previous one.
+//
+
+type view interface {
+	title() string
+	keys() string
+	canvasID() string
+	refresh(a *app) error
+	render(height int) []line
+	handleKey(a *app, key string) error
+}
+
+//
+// Views that can use the changes streamed by the watch API,
+// instead of only being refreshed when they happen.
+//
+
+type changeApplier interface {
+	applyChange(change openapi_client.CanvasesCanvasChange)
+}
+
+type prompt struct {
+	label  string
+	input  string
+	submit func(input string) error
+}
+
+type app struct {
+	ctx     core.CommandContext
+	views   []view
+	prompt  *prompt
+	status  string
+	failed  bool
+	watcher *watcher
+	quit    bool
+}
+
+func newApp(ctx core.CommandContext, first view) *app {
+	return &app{
+		ctx:     ctx,
+		views:   []view{first},
+		watcher: newWatcher(ctx.Context, ctx.API),
+	}
+}
+
+func (a *app) run(t *terminal) error {
+	keys := make(chan string)
+	go t.readKeys(keys)
+	defer a.watcher.stop()
+
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+
+	var debounce <-chan time.Time
+	a.refresh()
+
+	for !a.quit {
+		_, height := t.size()
+		t.draw(a.render(height))
+
+		select {
+		case key, ok := <-keys:
+			if !ok {
+				return nil
+			}
+
+			a.handleKey(key)
+
+		case change := <-a.watcher.changes:
+			if applier, ok := a.current().(changeApplier); ok {
+				applier.applyChange(change)
+			}
+
+			if debounce == nil {
+				debounce = time.After(changeDebounce)
+			}
+
+		case <-debounce:
+			debounce = nil
+			a.refresh()
+
+		case <-ticker.C:
+			a.refresh()
+
+		case <-a.ctx.Context.Done():
+			return nil
+		}
+	}
+
+	return nil
+}
+
+func (a *app) current() view {
+	return a.views[len(a.views)-1]
+}
+
+func (a *app) push(v view) {
+	a.views = append(a.views, v)
+	a.status = ""
+	a.refresh()
+}
+
+func (a *app) pop() {
+	if len(a.views) == 1 {
+		a.quit = true
+		return
+	}
+
+	a.views = a.views[:len(a.views)-1]
+	a.status = ""
+	a.refresh()
+}
+
+func (a *app) refresh() {
+	a.watcher.watch(a.current().canvasID())
+
+	err := a.current().refresh(a)
+	if err != nil {
+		a.setError(err)
+	}
+}
+
+func (a *app) setStatus(format string, args ...any) {
+	a.status = fmt.Sprintf(format, args...)
+	a.failed = false
+}
+
+func (a *app) setError(err error) {
+	a.status = describeError(err)
+	a.failed = true
+}
+
+//
+// ask shows a prompt in the status line, and calls submit with the answer.
+// confirm asks for y before running actions that can't be undone.
+//
+
+func (a *app) ask(label string, submit func(input string) error) {
+	a.prompt = &prompt{label: label, submit: submit}
+}
+
+func (a *app) confirm(question string, action func() error) {
+	a.ask(question+" [y/N]", func(input string) error {
+		if strings.ToLower(strings.TrimSpace(input)) != "y" {
+			a.setStatus("Cancelled")
+			return nil
+		}
+
+		return action()
+	})
+}
+
+func (a *app) handleKey(key string) {
+	if key == KeyCtrlC {
+		a.quit = true
+		return
+	}
+
+	if a.prompt != nil {
+		a.handlePromptKey(key)
+		return
+	}
+
+	switch key {
+	case "q":
+		a.quit = true
+	case KeyEscape, KeyLeft:
+		a.pop()
+	case "r":
+		a.refresh()
+	default:
+		err := a.current().handleKey(a, key)
+		if err != nil {
+			a.setError(err)
+		}
+	}
+}
+
+func (a *app) handlePromptKey(key string) {
+	p := a.prompt
+	switch key {
+	case KeyEscape:
+		a.prompt = nil
+	case KeyEnter:
+		a.prompt = nil
+		err := p.submit(p.input)
+		if err != nil {
+			a.setError(err)
+			return
+		}
+
+		a.refresh()
+	case KeyBackspace:
+		runes := []rune(p.input)
+		if len(runes) > 0 {
+			p.input = string(runes[:len(runes)-1])
+		}
+	default:
+		if len([]rune(key)) == 1 {
+			p.input += key
+		}
+	}
+}
+
+//
+// The screen has a header with the path to the current view,
+// the view itself, and a status line with the keys of the view.
+//
+
+func (a *app) render(height int) []line {
+	titles := make([]string, len(a.views))
+	for i, v := range a.views {
+		titles[i] = v.title()
+	}
+
+	lines := []line{
+		styled(styleHeader, "SuperPlane › %s", strings.Join(titles, " › ")),
+		text(""),
+	}
+
+	body := a.current().render(height - 4)
+	for len(body) < height-4 {
+		body = append(body, text(""))
+	}
+
+	lines = append(lines, body...)
+
+	switch {
+	case a.prompt != nil:
+		lines = append(lines, text("%s %s█", a.prompt.label, a.prompt.input))
+	case a.failed:
+		lines = append(lines, styled(styleError, "%s", a.status))
+	default:
+		lines = append(lines, text("%s", a.status))
+	}
+
+	help := a.current().keys() + "  r refresh  esc back  q quit"
+	lines = append(lines, styled(styleDim, "%s  [%s]", help, a.watcher.state()))
+	return lines
+}
+
+func describeError(err error) string {
+	if apiErr, ok := err.(*openapi_client.GenericOpenAPIError); ok && len(apiErr.Body()) > 0 {
+		return fmt.Sprintf("%s: %s", apiErr.Error(), strings.TrimSpace(string(apiErr.Body())))
+	}
+
+	return err.Error()
+}
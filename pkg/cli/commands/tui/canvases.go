@@ -0,0 +1,180 @@
+package tui
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/superplanehq/superplane/pkg/openapi_client"
+)
+
+type canvasesView struct {
+	canvases []openapi_client.CanvasesCanvas
+	cursor   cursor
+}
+
+func (v *canvasesView) title() string    { return "Canvases" }
+func (v *canvasesView) canvasID() string { return "" }
+func (v *canvasesView) keys() string     { return "enter open" }
+
+func (v *canvasesView) refresh(a *app) error {
+	response, _, err := a.ctx.API.CanvasAPI.CanvasesListCanvases(a.ctx.Context).Execute()
+	if err != nil {
+		return err
+	}
+
+	v.canvases = response.GetCanvases()
+	sort.SliceStable(v.canvases, func(i, j int) bool {
+		return v.canvases[i].Metadata.GetName() < v.canvases[j].Metadata.GetName()
+	})
+
+	return nil
+}
+
+func (v *canvasesView) render(height int) []line {
+	rows := [][]string{}
+	for _, canvas := range v.canvases {
+		rows = append(rows, []string{
+			canvas.Metadata.GetName(),
+			canvas.Metadata.GetId(),
+			timeAgo(canvas.Metadata.UpdatedAt),
+		})
+	}
+
+	return table([]string{"NAME", "ID", "UPDATED"}, rows, &v.cursor, height)
+}
+
+func (v *canvasesView) handleKey(a *app, key string) error {
+	if v.cursor.handleKey(key, len(v.canvases)) {
+		return nil
+	}
+
+	if (key == KeyEnter || key == KeyRight) && len(v.canvases) > 0 {
+		canvas := v.canvases[v.cursor.index]
+		a.push(&canvasView{id: canvas.Metadata.GetId(), name: canvas.Metadata.GetName()})
+	}
+
+	return nil
+}
+
+//
+// canvasView shows the nodes of a canvas, with the state of their last execution
+// and whether items are waiting in their queue.
+//
+
+type canvasView struct {
+	id     string
+	name   string
+	nodes  []openapi_client.ComponentsNode
+	last   map[string]openapi_client.CanvasesCanvasNodeExecution
+	queued map[string]bool
+	cursor cursor
+}
+
+func (v *canvasView) title() string    { return v.name }
+func (v *canvasView) canvasID() string { return v.id }
+func (v *canvasView) keys() string {
+	return "enter node  v events  p pause/resume  e emit event"
+}
+
+func (v *canvasView) refresh(a *app) error {
+	response, _, err := a.ctx.API.CanvasAPI.CanvasesDescribeCanvas(a.ctx.Context, v.id).Execute()
+	if err != nil {
+		return err
+	}
+
+	canvas := response.GetCanvas()
+	v.name = canvas.Metadata.GetName()
+	v.nodes = []openapi_client.ComponentsNode{}
+	if canvas.Spec != nil {
+		for _, node := range canvas.Spec.GetNodes() {
+			if node.GetType() != openapi_client.COMPONENTSNODETYPE_TYPE_WIDGET {
+				v.nodes = append(v.nodes, node)
+			}
+		}
+	}
+
+	v.last = map[string]openapi_client.CanvasesCanvasNodeExecution{}
+	v.queued = map[string]bool{}
+	if canvas.Status != nil {
+		for _, execution := range canvas.Status.GetLastExecutions() {
+			v.last[execution.GetNodeId()] = execution
+		}
+
+		for _, item := range canvas.Status.GetNextQueueItems() {
+			v.queued[item.GetNodeId()] = true
+		}
+	}
+
+	return nil
+}
+
+func (v *canvasView) render(height int) []line {
+	rows := [][]string{}
+	for _, node := range v.nodes {
+		rows = append(rows, []string{
+			node.GetName(),
+			node.GetId(),
+			nodeRef(node),
+			v.nodeState(node),
+			v.lastRun(node),
+			queueState(v.queued[node.GetId()]),
+		})
+	}
+
+	return table([]string{"NAME", "ID", "TYPE", "STATE", "LAST RUN", "QUEUE"}, rows, &v.cursor, height)
+}
+
+func (v *canvasView) nodeState(node openapi_client.ComponentsNode) string {
+	switch {
+	case node.GetErrorMessage() != "":
+		return "error: " + node.GetErrorMessage()
+	case node.GetPaused():
+		return "paused"
+	default:
+		return "active"
+	}
+}
+
+func (v *canvasView) lastRun(node openapi_client.ComponentsNode) string {
+	execution, ok := v.last[node.GetId()]
+	if !ok {
+		return "-"
+	}
+
+	return fmt.Sprintf("%s %s", executionState(execution), timeAgo(execution.UpdatedAt))
+}
+
+func queueState(queued bool) string {
+	if queued {
+		return "waiting"
+	}
+
+	return "-"
+}
+
+func (v *canvasView) handleKey(a *app, key string) error {
+	if v.cursor.handleKey(key, len(v.nodes)) {
+		return nil
+	}
+
+	if key == "v" {
+		a.push(&eventsView{canvas: v.id})
+		return nil
+	}
+
+	if len(v.nodes) == 0 {
+		return nil
+	}
+
+	node := v.nodes[v.cursor.index]
+	switch key {
+	case KeyEnter, KeyRight:
+		a.push(&nodeView{canvas: v.id, node: node})
+	case "p":
+		return setNodePaused(a, v.id, node, !node.GetPaused())
+	case "e":
+		askEmitEvent(a, v.id, node)
+	}
+
+	return nil
+}
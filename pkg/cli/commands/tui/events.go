@@ -0,0 +1,135 @@
+package tui
+
+import (
+	"fmt"
+
+	"github.com/superplanehq/superplane/pkg/openapi_client"
+)
+
+type eventsView struct {
+	canvas string
+	events []openapi_client.CanvasesCanvasEventWithExecutions
+	cursor cursor
+}
+
+func (v *eventsView) title() string    { return "Events" }
+func (v *eventsView) canvasID() string { return v.canvas }
+func (v *eventsView) keys() string     { return "enter chain" }
+
+func (v *eventsView) refresh(a *app) error {
+	response, _, err := a.ctx.API.CanvasEventAPI.
+		CanvasesListCanvasEvents(a.ctx.Context, v.canvas).
+		Limit(listLimit).
+		Execute()
+	if err != nil {
+		return err
+	}
+
+	v.events = response.GetEvents()
+	return nil
+}
+
+func (v *eventsView) render(height int) []line {
+	rows := [][]string{}
+	for _, event := range v.events {
+		rows = append(rows, []string{
+			event.GetId(),
+			event.GetNodeId(),
+			event.GetChannel(),
+			fmt.Sprintf("%d", len(event.GetExecutions())),
+			timeAgo(event.CreatedAt),
+		})
+	}
+
+	return table([]string{"ID", "NODE", "CHANNEL", "EXECUTIONS", "CREATED"}, rows, &v.cursor, height)
+}
+
+func (v *eventsView) handleKey(a *app, key string) error {
+	if v.cursor.handleKey(key, len(v.events)) || len(v.events) == 0 {
+		return nil
+	}
+
+	if key == KeyEnter || key == KeyRight {
+		event := v.events[v.cursor.index]
+		a.push(&chainView{canvas: v.canvas, event: openapi_client.CanvasesCanvasEvent{
+			Id:        event.Id,
+			NodeId:    event.NodeId,
+			Channel:   event.Channel,
+			CreatedAt: event.CreatedAt,
+		}})
+	}
+
+	return nil
+}
+
+//
+// chainView shows the executions started from a root event,
+// in the order they ran, with the child executions of blueprints under their parent.
+//
+
+type chainView struct {
+	canvas     string
+	event      openapi_client.CanvasesCanvasEvent
+	executions []openapi_client.CanvasesCanvasNodeExecution
+	depths     []int
+	cursor     cursor
+}
+
+func (v *chainView) title() string    { return "Event " + shortID(v.event.GetId()) }
+func (v *chainView) canvasID() string { return v.canvas }
+func (v *chainView) keys() string     { return "enter open  c cancel  a action" }
+
+func (v *chainView) refresh(a *app) error {
+	response, _, err := a.ctx.API.CanvasEventAPI.
+		CanvasesListEventExecutions(a.ctx.Context, v.canvas, v.event.GetId()).
+		Execute()
+	if err != nil {
+		return err
+	}
+
+	v.executions = nil
+	v.depths = nil
+	for _, execution := range response.GetExecutions() {
+		v.executions = append(v.executions, execution)
+		v.depths = append(v.depths, 0)
+		for _, child := range execution.GetChildExecutions() {
+			v.executions = append(v.executions, child)
+			v.depths = append(v.depths, 1)
+		}
+	}
+
+	return nil
+}
+
+func (v *chainView) render(height int) []line {
+	lines := []line{
+		text("Emitted by %s on %s, %s", v.event.GetNodeId(), v.event.GetChannel(), timeAgo(v.event.CreatedAt)),
+		text(""),
+	}
+
+	rows := [][]string{}
+	for i, execution := range v.executions {
+		node := execution.GetNodeId()
+		if v.depths[i] > 0 {
+			node = "└ " + node
+		}
+
+		rows = append(rows, []string{
+			node,
+			execution.GetId(),
+			executionState(execution),
+			timeAgo(execution.CreatedAt),
+			execution.GetResultMessage(),
+		})
+	}
+
+	return append(lines, table([]string{"NODE", "EXECUTION", "STATE", "STARTED", "MESSAGE"}, rows, &v.cursor, height-len(lines))...)
+}
+
+func (v *chainView) handleKey(a *app, key string) error {
+	if v.cursor.handleKey(key, len(v.executions)) || len(v.executions) == 0 {
+		return nil
+	}
+
+	return handleExecutionKey(a, v.canvas, v.executions[v.cursor.index], key)
+}
@@ -0,0 +1,200 @@
+package tui
+
+import (
+	"encoding/json"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/superplanehq/superplane/pkg/openapi_client"
+)
+
+//
+// executionView shows an execution, its outputs and its logs.
+// The view follows new logs while it is scrolled to the bottom.
+//
+
+type executionView struct {
+	canvas    string
+	execution openapi_client.CanvasesCanvasNodeExecution
+	logs      []openapi_client.CanvasesCanvasNodeExecutionLog
+	lastLogID string
+	scroll    int
+	follow    bool
+	height    int
+}
+
+func (v *executionView) title() string    { return "Execution " + shortID(v.execution.GetId()) }
+func (v *executionView) canvasID() string { return v.canvas }
+func (v *executionView) keys() string     { return "↑↓ scroll  c cancel  a action  o chain" }
+
+func (v *executionView) refresh(a *app) error {
+	if v.execution.RootEvent != nil {
+		response, _, err := a.ctx.API.CanvasEventAPI.
+			CanvasesListEventExecutions(a.ctx.Context, v.canvas, v.execution.RootEvent.GetId()).
+			Execute()
+		if err != nil {
+			return err
+		}
+
+		for _, execution := range response.GetExecutions() {
+			v.applyExecution(execution)
+			for _, child := range execution.GetChildExecutions() {
+				v.applyExecution(child)
+			}
+		}
+	}
+
+	for {
+		request := a.ctx.API.CanvasNodeExecutionAPI.
+			CanvasesGetExecutionLogs(a.ctx.Context, v.canvas, v.execution.GetId())
+
+		if v.lastLogID != "" {
+			request = request.After(v.lastLogID)
+		}
+
+		response, _, err := request.Execute()
+		if err != nil {
+			return err
+		}
+
+		v.logs = append(v.logs, response.GetLogs()...)
+		if response.GetLastId() != "" {
+			v.lastLogID = response.GetLastId()
+		}
+
+		if !response.GetHasMore() {
+			return nil
+		}
+	}
+}
+
+func (v *executionView) applyExecution(execution openapi_client.CanvasesCanvasNodeExecution) {
+	if execution.GetId() != v.execution.GetId() {
+		return
+	}
+
+	rootEvent := v.execution.RootEvent
+	v.execution = execution
+	if v.execution.RootEvent == nil {
+		v.execution.RootEvent = rootEvent
+	}
+}
+
+func (v *executionView) applyChange(change openapi_client.CanvasesCanvasChange) {
+	if change.Execution != nil {
+		v.applyExecution(*change.Execution)
+	}
+}
+
+func (v *executionView) content() []line {
+	e := v.execution
+	lines := []line{
+		text("Node:     %s", e.GetNodeId()),
+		text("State:    %s", executionState(e)),
+		text("Created:  %s", formatTime(e.CreatedAt)),
+		text("Updated:  %s", formatTime(e.UpdatedAt)),
+	}
+
+	if e.GetResultMessage() != "" {
+		lines = append(lines, text("Message:  %s", e.GetResultMessage()))
+	}
+
+	if e.RootEvent != nil {
+		lines = append(lines, text("Event:    %s", e.RootEvent.GetId()))
+	}
+
+	lines = append(lines, text(""), styled(styleHeader, "Outputs"))
+	if len(e.GetOutputs()) == 0 {
+		lines = append(lines, styled(styleDim, "No outputs."))
+	}
+
+	channels := make([]string, 0, len(e.GetOutputs()))
+	for channel := range e.GetOutputs() {
+		channels = append(channels, channel)
+	}
+
+	sort.Strings(channels)
+	for _, channel := range channels {
+		lines = append(lines, text("%s:", channel))
+		for _, outputLine := range jsonLines(e.GetOutputs()[channel]) {
+			lines = append(lines, text("  %s", outputLine))
+		}
+	}
+
+	lines = append(lines, text(""), styled(styleHeader, "Logs"))
+	if len(v.logs) == 0 {
+		lines = append(lines, styled(styleDim, "No logs."))
+	}
+
+	for _, log := range v.logs {
+		style := styleNone
+		if log.GetStream() == "stderr" {
+			style = styleError
+		}
+
+		for _, message := range strings.Split(strings.TrimRight(log.GetMessage(), "\n"), "\n") {
+			lines = append(lines, styled(style, "%s %s", log.GetCreatedAt().Local().Format("15:04:05"), message))
+		}
+	}
+
+	return lines
+}
+
+func (v *executionView) render(height int) []line {
+	lines := v.content()
+	v.height = height
+
+	maxScroll := max(0, len(lines)-height)
+	if v.follow || v.scroll > maxScroll {
+		v.scroll = maxScroll
+	}
+
+	return lines[v.scroll:min(len(lines), v.scroll+height)]
+}
+
+func (v *executionView) handleKey(a *app, key string) error {
+	switch key {
+	case KeyUp, "k":
+		v.scrollBy(-1)
+	case KeyDown, "j":
+		v.scrollBy(1)
+	case KeyPageUp:
+		v.scrollBy(-v.height)
+	case KeyPageDown:
+		v.scrollBy(v.height)
+	case "o":
+		if v.execution.RootEvent != nil {
+			a.push(&chainView{canvas: v.canvas, event: *v.execution.RootEvent})
+		}
+	case "c":
+		cancelExecution(a, v.canvas, v.execution)
+	case "a":
+		askExecutionAction(a, v.canvas, v.execution)
+	}
+
+	return nil
+}
+
+func (v *executionView) scrollBy(delta int) {
+	maxScroll := max(0, len(v.content())-v.height)
+	v.scroll = max(0, min(v.scroll+delta, maxScroll))
+	v.follow = v.scroll == maxScroll
+}
+
+func jsonLines(value any) []string {
+	data, err := json.MarshalIndent(value, "", "  ")
+	if err != nil {
+		return []string{err.Error()}
+	}
+
+	return strings.Split(string(data), "\n")
+}
+
+func formatTime(t *time.Time) string {
+	if t == nil {
+		return "-"
+	}
+
+	return t.Local().Format(time.RFC3339) + " (" + timeAgo(t) + ")"
+}
@@ -0,0 +1,198 @@
+package tui
+
+import (
+	"github.com/superplanehq/superplane/pkg/openapi_client"
+)
+
+const listLimit = 50
+
+const (
+	tabExecutions = iota
+	tabQueue
+	tabEvents
+)
+
+var tabNames = []string{"Executions", "Queue", "Events"}
+
+//
+// nodeView shows the executions, queue items and events of a node,
+// in tabs switched with tab.
+//
+
+type nodeView struct {
+	canvas     string
+	node       openapi_client.ComponentsNode
+	tab        int
+	executions []openapi_client.CanvasesCanvasNodeExecution
+	queue      []openapi_client.CanvasesCanvasNodeQueueItem
+	events     []openapi_client.CanvasesCanvasEvent
+	cursors    [3]cursor
+}
+
+func (v *nodeView) title() string    { return v.node.GetName() }
+func (v *nodeView) canvasID() string { return v.canvas }
+
+func (v *nodeView) keys() string {
+	switch v.tab {
+	case tabExecutions:
+		return "tab switch  enter open  c cancel  a action"
+	case tabQueue:
+		return "tab switch  d delete"
+	default:
+		return "tab switch  enter chain"
+	}
+}
+
+func (v *nodeView) refresh(a *app) error {
+	nodeID := v.node.GetId()
+	executions, _, err := a.ctx.API.CanvasNodeAPI.
+		CanvasesListNodeExecutions(a.ctx.Context, v.canvas, nodeID).
+		Limit(listLimit).
+		Execute()
+	if err != nil {
+		return err
+	}
+
+	queue, _, err := a.ctx.API.CanvasNodeAPI.
+		CanvasesListNodeQueueItems(a.ctx.Context, v.canvas, nodeID).
+		Limit(listLimit).
+		Execute()
+	if err != nil {
+		return err
+	}
+
+	events, _, err := a.ctx.API.CanvasNodeAPI.
+		CanvasesListNodeEvents(a.ctx.Context, v.canvas, nodeID).
+		Limit(listLimit).
+		Execute()
+	if err != nil {
+		return err
+	}
+
+	v.executions = executions.GetExecutions()
+	v.queue = queue.GetItems()
+	v.events = events.GetEvents()
+	return nil
+}
+
+func (v *nodeView) render(height int) []line {
+	tabs := ""
+	for i, name := range tabNames {
+		if i == v.tab {
+			tabs += "[" + name + "]  "
+		} else {
+			tabs += " " + name + "   "
+		}
+	}
+
+	lines := []line{styled(styleHeader, "%s", tabs), text("")}
+	c := &v.cursors[v.tab]
+	height -= len(lines)
+
+	switch v.tab {
+	case tabExecutions:
+		return append(lines, executionsTable(v.executions, c, height)...)
+
+	case tabQueue:
+		rows := [][]string{}
+		for _, item := range v.queue {
+			rows = append(rows, []string{
+				item.GetId(),
+				rootEventID(item.RootEvent),
+				timeAgo(item.CreatedAt),
+			})
+		}
+
+		return append(lines, table([]string{"ID", "ROOT EVENT", "CREATED"}, rows, c, height)...)
+
+	default:
+		rows := [][]string{}
+		for _, event := range v.events {
+			rows = append(rows, []string{
+				event.GetId(),
+				event.GetChannel(),
+				event.GetCustomName(),
+				timeAgo(event.CreatedAt),
+			})
+		}
+
+		return append(lines, table([]string{"ID", "CHANNEL", "NAME", "CREATED"}, rows, c, height)...)
+	}
+}
+
+func executionsTable(executions []openapi_client.CanvasesCanvasNodeExecution, c *cursor, height int) []line {
+	rows := [][]string{}
+	for _, execution := range executions {
+		rows = append(rows, []string{
+			execution.GetId(),
+			executionState(execution),
+			timeAgo(execution.CreatedAt),
+			timeAgo(execution.UpdatedAt),
+			execution.GetResultMessage(),
+		})
+	}
+
+	return table([]string{"ID", "STATE", "CREATED", "UPDATED", "MESSAGE"}, rows, c, height)
+}
+
+func rootEventID(event *openapi_client.CanvasesCanvasEvent) string {
+	if event == nil {
+		return "-"
+	}
+
+	return event.GetId()
+}
+
+func (v *nodeView) handleKey(a *app, key string) error {
+	if key == KeyTab {
+		v.tab = (v.tab + 1) % len(tabNames)
+		return nil
+	}
+
+	c := &v.cursors[v.tab]
+	switch v.tab {
+	case tabExecutions:
+		if c.handleKey(key, len(v.executions)) || len(v.executions) == 0 {
+			return nil
+		}
+
+		return handleExecutionKey(a, v.canvas, v.executions[c.index], key)
+
+	case tabQueue:
+		if c.handleKey(key, len(v.queue)) || len(v.queue) == 0 {
+			return nil
+		}
+
+		if key == "d" {
+			deleteQueueItem(a, v.canvas, v.node.GetId(), v.queue[c.index])
+		}
+
+	default:
+		if c.handleKey(key, len(v.events)) || len(v.events) == 0 {
+			return nil
+		}
+
+		if key == KeyEnter || key == KeyRight {
+			a.push(&chainView{canvas: v.canvas, event: v.events[c.index]})
+		}
+	}
+
+	return nil
+}
+
+//
+// Keys shared by the lists of executions.
+//
+
+func handleExecutionKey(a *app, canvasID string, execution openapi_client.CanvasesCanvasNodeExecution, key string) error {
+	switch key {
+	case KeyEnter, KeyRight:
+		a.push(&executionView{canvas: canvasID, execution: execution})
+	case "c":
+		cancelExecution(a, canvasID, execution)
+	case "a":
+		askExecutionAction(a, canvasID, execution)
+	}
+
+	return nil
+}
@@ -0,0 +1,70 @@
+// Package tui is an interactive terminal dashboard for canvases,
+// their nodes, events and executions, kept up to date by the watch API.
+package tui
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/superplanehq/superplane/pkg/cli/core"
+)
+
+func NewCommand(options core.BindOptions) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "tui [canvas-name-or-id]",
+		Short: "Open an interactive dashboard of canvases and executions",
+		Long: `Opens a terminal dashboard that lists canvases, shows the nodes of a canvas with their live state,
+and drills into events, execution chains, outputs and logs.
+
+Executions can be cancelled and their actions invoked, like approving, queue items deleted,
+nodes paused and resumed, and events emitted. Press q to quit.`,
+		Args: cobra.MaximumNArgs(1),
+	}
+
+	core.Bind(cmd, &tuiCommand{}, options)
+	return cmd
+}
+
+type tuiCommand struct{}
+
+func (c *tuiCommand) Execute(ctx core.CommandContext) error {
+	in, ok := ctx.Cmd.InOrStdin().(*os.File)
+	if !ok {
+		return fmt.Errorf("the tui needs an interactive terminal")
+	}
+
+	canvases := &canvasesView{}
+	a := newApp(ctx, canvases)
+	if len(ctx.Args) == 1 {
+		canvas, err := findCanvas(ctx, ctx.Args[0])
+		if err != nil {
+			return err
+		}
+
+		a.views = append(a.views, canvas)
+	}
+
+	t, err := openTerminal(in, ctx.Cmd.OutOrStdout())
+	if err != nil {
+		return err
+	}
+
+	defer t.Close()
+	return a.run(t)
+}
+
+func findCanvas(ctx core.CommandContext, nameOrID string) (*canvasView, error) {
+	response, _, err := ctx.API.CanvasAPI.CanvasesListCanvases(ctx.Context).Execute()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, canvas := range response.GetCanvases() {
+		if canvas.Metadata.GetId() == nameOrID || canvas.Metadata.GetName() == nameOrID {
+			return &canvasView{id: canvas.Metadata.GetId(), name: canvas.Metadata.GetName()}, nil
+		}
+	}
+
+	return nil, fmt.Errorf("canvas %q not found", nameOrID)
+}
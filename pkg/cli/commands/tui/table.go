@@ -0,0 +1,163 @@
+package tui
+
+import (
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/superplanehq/superplane/pkg/openapi_client"
+)
+
+//
+// cursor is the selected row of a list,
+// and the first row shown, for lists longer than the screen.
+//
+
+type cursor struct {
+	index  int
+	offset int
+}
+
+func (c *cursor) handleKey(key string, count int) bool {
+	switch key {
+	case KeyUp, "k":
+		c.index--
+	case KeyDown, "j":
+		c.index++
+	case KeyPageUp:
+		c.index -= 10
+	case KeyPageDown:
+		c.index += 10
+	default:
+		return false
+	}
+
+	c.clamp(count)
+	return true
+}
+
+func (c *cursor) clamp(count int) {
+	c.index = max(0, min(c.index, count-1))
+}
+
+func (c *cursor) window(height, count int) (int, int) {
+	c.clamp(count)
+	if c.index < c.offset {
+		c.offset = c.index
+	}
+
+	if c.index >= c.offset+height {
+		c.offset = c.index - height + 1
+	}
+
+	return c.offset, min(count, c.offset+height)
+}
+
+//
+// table renders rows in aligned columns, with the selected row highlighted.
+//
+
+func table(headers []string, rows [][]string, c *cursor, height int) []line {
+	if len(rows) == 0 {
+		return []line{styled(styleDim, "Nothing here yet.")}
+	}
+
+	widths := make([]int, len(headers))
+	for i, header := range headers {
+		widths[i] = utf8.RuneCountInString(header)
+	}
+
+	for _, row := range rows {
+		for i, cell := range row {
+			widths[i] = max(widths[i], min(utf8.RuneCountInString(cell), 60))
+		}
+	}
+
+	lines := []line{styled(styleHeader, "%s", formatRow(headers, widths))}
+	start, end := c.window(height-1, len(rows))
+	for i := start; i < end; i++ {
+		style := styleNone
+		if i == c.index {
+			style = styleSelected
+		}
+
+		lines = append(lines, line{text: formatRow(rows[i], widths), style: style})
+	}
+
+	return lines
+}
+
+func formatRow(cells []string, widths []int) string {
+	parts := make([]string, len(cells))
+	for i, cell := range cells {
+		cell = truncate(cell, widths[i])
+		parts[i] = cell + strings.Repeat(" ", widths[i]-utf8.RuneCountInString(cell))
+	}
+
+	return strings.TrimRight(strings.Join(parts, "  "), " ")
+}
+
+func shortID(id string) string {
+	if len(id) > 8 {
+		return id[:8]
+	}
+
+	return id
+}
+
+func timeAgo(t *time.Time) string {
+	if t == nil || t.IsZero() {
+		return "-"
+	}
+
+	elapsed := time.Since(*t).Round(time.Second)
+	switch {
+	case elapsed < time.Minute:
+		return fmt.Sprintf("%ds ago", int(elapsed.Seconds()))
+	case elapsed < time.Hour:
+		return fmt.Sprintf("%dm ago", int(elapsed.Minutes()))
+	case elapsed < 24*time.Hour:
+		return fmt.Sprintf("%dh ago", int(elapsed.Hours()))
+	default:
+		return t.Local().Format("2006-01-02 15:04")
+	}
+}
+
+//
+// executionState is the state of an execution as one word:
+// pending or running, and the result once it finished.
+//
+
+func executionState(execution openapi_client.CanvasesCanvasNodeExecution) string {
+	switch execution.GetState() {
+	case openapi_client.CANVASNODEEXECUTIONSTATE_STATE_PENDING:
+		return "pending"
+	case openapi_client.CANVASNODEEXECUTIONSTATE_STATE_STARTED:
+		return "running"
+	}
+
+	switch execution.GetResult() {
+	case openapi_client.CANVASNODEEXECUTIONRESULT_RESULT_PASSED:
+		return "passed"
+	case openapi_client.CANVASNODEEXECUTIONRESULT_RESULT_FAILED:
+		return "failed"
+	case openapi_client.CANVASNODEEXECUTIONRESULT_RESULT_CANCELLED:
+		return "cancelled"
+	default:
+		return "finished"
+	}
+}
+
+func nodeRef(node openapi_client.ComponentsNode) string {
+	switch {
+	case node.Component != nil:
+		return node.Component.GetName()
+	case node.Trigger != nil:
+		return node.Trigger.GetName()
+	case node.Blueprint != nil:
+		return "blueprint"
+	default:
+		return ""
+	}
+}
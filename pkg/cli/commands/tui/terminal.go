@@ -0,0 +1,219 @@
+package tui
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"unicode/utf8"
+
+	"golang.org/x/term"
+)
+
+const (
+	KeyUp        = "up"
+	KeyDown      = "down"
+	KeyLeft      = "left"
+	KeyRight     = "right"
+	KeyPageUp    = "pgup"
+	KeyPageDown  = "pgdown"
+	KeyEnter     = "enter"
+	KeyEscape    = "esc"
+	KeyTab       = "tab"
+	KeyBackspace = "backspace"
+	KeyCtrlC     = "ctrl+c"
+)
+
+//
+// terminal puts the terminal in raw mode, on the alternate screen,
+// and restores it when closed, so the shell is left as it was.
+//
+
+type terminal struct {
+	in    *os.File
+	out   io.Writer
+	state *term.State
+}
+
+func openTerminal(in *os.File, out io.Writer) (*terminal, error) {
+	if !term.IsTerminal(int(in.Fd())) {
+		return nil, fmt.Errorf("the tui needs an interactive terminal")
+	}
+
+	state, err := term.MakeRaw(int(in.Fd()))
+	if err != nil {
+		return nil, fmt.Errorf("failed to set up terminal: %w", err)
+	}
+
+	t := &terminal{in: in, out: out, state: state}
+	_, _ = io.WriteString(out, "\x1b[?1049h\x1b[?25l")
+	return t, nil
+}
+
+func (t *terminal) Close() {
+	_, _ = io.WriteString(t.out, "\x1b[?25h\x1b[?1049l")
+	_ = term.Restore(int(t.in.Fd()), t.state)
+}
+
+func (t *terminal) size() (int, int) {
+	width, height, err := term.GetSize(int(t.in.Fd()))
+	if err != nil || width <= 0 || height <= 0 {
+		return 80, 24
+	}
+
+	return width, height
+}
+
+const (
+	styleNone     = ""
+	styleHeader   = "\x1b[1m"
+	styleSelected = "\x1b[7m"
+	styleDim      = "\x1b[2m"
+	styleError    = "\x1b[31m"
+	styleReset    = "\x1b[0m"
+)
+
+type line struct {
+	text  string
+	style string
+}
+
+func text(format string, args ...any) line {
+	return line{text: fmt.Sprintf(format, args...)}
+}
+
+func styled(style string, format string, args ...any) line {
+	return line{text: fmt.Sprintf(format, args...), style: style}
+}
+
+//
+// draw replaces the screen with the lines, in one write,
+// so the screen doesn't flicker while it is updated.
+// Styles are applied after lines are cut to the width of the screen.
+//
+
+func (t *terminal) draw(lines []line) {
+	width, height := t.size()
+
+	var buf bytes.Buffer
+	buf.WriteString("\x1b[H")
+	for i := 0; i < height; i++ {
+		if i < len(lines) {
+			content := truncate(lines[i].text, width)
+			if lines[i].style != styleNone {
+				buf.WriteString(lines[i].style + content + styleReset)
+			} else {
+				buf.WriteString(content)
+			}
+		}
+
+		buf.WriteString("\x1b[K")
+		if i < height-1 {
+			buf.WriteString("\r\n")
+		}
+	}
+
+	_, _ = t.out.Write(buf.Bytes())
+}
+
+//
+// readKeys sends the keys pressed until the input is closed.
+// Escape sequences of special keys are read in one chunk,
+// since terminals write them at once.
+//
+
+func (t *terminal) readKeys(keys chan<- string) {
+	buf := make([]byte, 64)
+	for {
+		n, err := t.in.Read(buf)
+		if err != nil {
+			close(keys)
+			return
+		}
+
+		for _, key := range parseKeys(buf[:n]) {
+			keys <- key
+		}
+	}
+}
+
+var escapeSequences = map[string]string{
+	"\x1b[A":  KeyUp,
+	"\x1b[B":  KeyDown,
+	"\x1b[C":  KeyRight,
+	"\x1b[D":  KeyLeft,
+	"\x1bOA":  KeyUp,
+	"\x1bOB":  KeyDown,
+	"\x1bOC":  KeyRight,
+	"\x1bOD":  KeyLeft,
+	"\x1b[5~": KeyPageUp,
+	"\x1b[6~": KeyPageDown,
+}
+
+func parseKeys(data []byte) []string {
+	keys := []string{}
+	for len(data) > 0 {
+		if data[0] == 0x1b {
+			if len(data) == 1 {
+				return append(keys, KeyEscape)
+			}
+
+			matched := false
+			for sequence, key := range escapeSequences {
+				if bytes.HasPrefix(data, []byte(sequence)) {
+					keys = append(keys, key)
+					data = data[len(sequence):]
+					matched = true
+					break
+				}
+			}
+
+			//
+			// Unknown sequences are dropped whole.
+			//
+			if !matched {
+				return keys
+			}
+
+			continue
+		}
+
+		switch data[0] {
+		case '\r', '\n':
+			keys = append(keys, KeyEnter)
+		case '\t':
+			keys = append(keys, KeyTab)
+		case 0x7f, 0x08:
+			keys = append(keys, KeyBackspace)
+		case 0x03:
+			keys = append(keys, KeyCtrlC)
+		default:
+			r, size := utf8.DecodeRune(data)
+			if r != utf8.RuneError && r >= 0x20 {
+				keys = append(keys, string(r))
+			}
+
+			data = data[size:]
+			continue
+		}
+
+		data = data[1:]
+	}
+
+	return keys
+}
+
+func truncate(line string, width int) string {
+	line = strings.ReplaceAll(line, "\t", "  ")
+	if utf8.RuneCountInString(line) <= width {
+		return line
+	}
+
+	runes := []rune(line)
+	if width <= 1 {
+		return string(runes[:width])
+	}
+
+	return string(runes[:width-1]) + "…"
+}
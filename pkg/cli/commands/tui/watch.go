@@ -0,0 +1,143 @@
+package tui
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/superplanehq/superplane/pkg/cli/core"
+	"github.com/superplanehq/superplane/pkg/openapi_client"
+)
+
+const (
+	watchRetryMin = time.Second
+	watchRetryMax = 30 * time.Second
+)
+
+//
+// watcher follows the changes of one canvas through the watch API,
+// as server-sent events. When the stream breaks, it reconnects
+// with the resume token of the last change, so no change is missed.
+//
+
+type watcher struct {
+	ctx     context.Context
+	api     *openapi_client.APIClient
+	changes chan openapi_client.CanvasesCanvasChange
+
+	mu       sync.Mutex
+	canvasID string
+	cancel   context.CancelFunc
+	status   string
+}
+
+func newWatcher(ctx context.Context, api *openapi_client.APIClient) *watcher {
+	return &watcher{
+		ctx:     ctx,
+		api:     api,
+		changes: make(chan openapi_client.CanvasesCanvasChange, 64),
+	}
+}
+
+//
+// watch switches to the changes of a canvas.
+// An empty ID stops watching.
+//
+
+func (w *watcher) watch(canvasID string) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if canvasID == w.canvasID {
+		return
+	}
+
+	if w.cancel != nil {
+		w.cancel()
+		w.cancel = nil
+	}
+
+	w.canvasID = canvasID
+	w.status = ""
+	if canvasID == "" {
+		return
+	}
+
+	ctx, cancel := context.WithCancel(w.ctx)
+	w.cancel = cancel
+	go w.run(ctx, canvasID)
+}
+
+func (w *watcher) stop() {
+	w.watch("")
+}
+
+func (w *watcher) state() string {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if w.canvasID == "" {
+		return "not watching"
+	}
+
+	return w.status
+}
+
+func (w *watcher) setState(ctx context.Context, status string) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if ctx.Err() == nil {
+		w.status = status
+	}
+}
+
+func (w *watcher) run(ctx context.Context, canvasID string) {
+	resumeToken := ""
+	retry := watchRetryMin
+
+	for ctx.Err() == nil {
+		w.setState(ctx, "connecting")
+
+		connected, err := w.stream(ctx, canvasID, &resumeToken)
+		if ctx.Err() != nil {
+			return
+		}
+
+		if connected {
+			retry = watchRetryMin
+		}
+
+		w.setState(ctx, fmt.Sprintf("polling, watch unavailable: %v", err))
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(retry):
+		}
+
+		retry = min(retry*2, watchRetryMax)
+	}
+}
+
+func (w *watcher) stream(ctx context.Context, canvasID string, resumeToken *string) (bool, error) {
+	return core.StreamChanges(
+		ctx,
+		w.api,
+		core.CanvasWatchPath(canvasID),
+		resumeToken,
+		func() { w.setState(ctx, "live") },
+		func(change openapi_client.CanvasesCanvasChange) error {
+			//
+			// Views are refreshed on the next poll when changes are dropped.
+			//
+			select {
+			case w.changes <- change:
+			default:
+			}
+
+			return nil
+		},
+	)
+}
@@ -0,0 +1,152 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"text/tabwriter"
+	"time"
+
+	"github.com/superplanehq/superplane/pkg/openapi_client"
+)
+
+const chainPollInterval = 2 * time.Second
+
+//
+// Exit codes for commands that wait for an execution chain.
+//
+
+const (
+	ExitCodeFailed    = 1
+	ExitCodeCancelled = 2
+)
+
+type ExitError struct {
+	Code int
+	Err  error
+}
+
+func (e *ExitError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *ExitError) Unwrap() error {
+	return e.Err
+}
+
+type ChainOutcome string
+
+const (
+	ChainPassed    ChainOutcome = "passed"
+	ChainFailed    ChainOutcome = "failed"
+	ChainCancelled ChainOutcome = "cancelled"
+)
+
+type chainResult struct {
+	EventID    string                                       `json:"eventId"`
+	Outcome    ChainOutcome                                 `json:"outcome"`
+	Executions []openapi_client.CanvasesCanvasNodeExecution `json:"executions"`
+}
+
+//
+// WaitForChain polls the executions started by a root event until its chain is finished,
+// then renders them and returns an ExitError if the chain did not pass.
+// A zero timeout waits forever.
+//
+
+func WaitForChain(ctx CommandContext, canvasID, rootEventID string, timeout time.Duration) error {
+	waitCtx := ctx.Context
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		waitCtx, cancel = context.WithTimeout(waitCtx, timeout)
+		defer cancel()
+	}
+
+	if ctx.Renderer.IsText() {
+		_, _ = fmt.Fprintf(ctx.Cmd.ErrOrStderr(), "Waiting for the execution chain of event %s to finish...\n", rootEventID)
+	}
+
+	for {
+		response, _, err := ctx.API.CanvasEventAPI.
+			CanvasesListEventExecutions(waitCtx, canvasID, rootEventID).
+			Execute()
+
+		if errors.Is(waitCtx.Err(), context.DeadlineExceeded) {
+			return fmt.Errorf("timed out after %s waiting for the execution chain to finish", timeout)
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if response.GetFinished() {
+			return renderChain(ctx, rootEventID, response.GetExecutions())
+		}
+
+		select {
+		case <-waitCtx.Done():
+		case <-time.After(chainPollInterval):
+		}
+	}
+}
+
+//
+// A chain fails if any of its executions failed, unless the error was resolved,
+// and is cancelled if any of its executions was cancelled.
+//
+
+func chainOutcome(executions []openapi_client.CanvasesCanvasNodeExecution) ChainOutcome {
+	outcome := ChainPassed
+	for _, execution := range executions {
+		switch execution.GetResult() {
+		case openapi_client.CANVASNODEEXECUTIONRESULT_RESULT_FAILED:
+			if execution.GetResultReason() != openapi_client.CANVASNODEEXECUTIONRESULTREASON_RESULT_REASON_ERROR_RESOLVED {
+				return ChainFailed
+			}
+		case openapi_client.CANVASNODEEXECUTIONRESULT_RESULT_CANCELLED:
+			outcome = ChainCancelled
+		}
+	}
+
+	return outcome
+}
+
+func renderChain(ctx CommandContext, rootEventID string, executions []openapi_client.CanvasesCanvasNodeExecution) error {
+	outcome := chainOutcome(executions)
+	var err error
+	if !ctx.Renderer.IsText() {
+		err = ctx.Renderer.Render(chainResult{EventID: rootEventID, Outcome: outcome, Executions: executions})
+	} else {
+		err = ctx.Renderer.RenderText(func(stdout io.Writer) error {
+			writer := tabwriter.NewWriter(stdout, 0, 8, 2, ' ', 0)
+			_, _ = fmt.Fprintln(writer, "NODE_ID\tEXECUTION_ID\tRESULT\tMESSAGE")
+			for _, execution := range executions {
+				_, _ = fmt.Fprintf(
+					writer,
+					"%s\t%s\t%s\t%s\n",
+					execution.GetNodeId(),
+					execution.GetId(),
+					execution.GetResult(),
+					execution.GetResultMessage(),
+				)
+			}
+
+			_, _ = fmt.Fprintf(writer, "\nExecution chain %s\n", outcome)
+			return writer.Flush()
+		})
+	}
+
+	if err != nil {
+		return err
+	}
+
+	switch outcome {
+	case ChainFailed:
+		return &ExitError{Code: ExitCodeFailed, Err: fmt.Errorf("execution chain of event %s failed", rootEventID)}
+	case ChainCancelled:
+		return &ExitError{Code: ExitCodeCancelled, Err: fmt.Errorf("execution chain of event %s was cancelled", rootEventID)}
+	default:
+		return nil
+	}
+}
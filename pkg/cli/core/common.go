@@ -1,10 +1,14 @@
 package core
 
 import (
+	"bufio"
+	"encoding/json"
 	"fmt"
+	"os"
 	"strings"
 
 	"github.com/ghodss/yaml"
+	"github.com/google/uuid"
 	"github.com/superplanehq/superplane/pkg/openapi_client"
 )
 
@@ -70,3 +74,154 @@ func ResolveCanvasID(ctx CommandContext, canvasID string) (string, error) {
 
 	return activeCanvas, nil
 }
+
+func FindCanvasID(ctx CommandContext, nameOrID string) (string, error) {
+	nameOrID = strings.TrimSpace(nameOrID)
+	if _, err := uuid.Parse(nameOrID); err == nil {
+		return nameOrID, nil
+	}
+
+	response, _, err := ctx.API.CanvasAPI.CanvasesListCanvases(ctx.Context).Execute()
+	if err != nil {
+		return "", err
+	}
+
+	var ids []string
+	for _, canvas := range response.GetCanvases() {
+		if canvas.Metadata != nil && canvas.Metadata.GetName() == nameOrID {
+			ids = append(ids, canvas.Metadata.GetId())
+		}
+	}
+
+	if len(ids) == 0 {
+		return "", fmt.Errorf("canvas %q not found", nameOrID)
+	}
+
+	if len(ids) > 1 {
+		return "", fmt.Errorf("multiple canvases named %q found; use the canvas id", nameOrID)
+	}
+
+	return ids[0], nil
+}
+
+func FindCanvasNode(ctx CommandContext, canvasID, nameOrID string) (*openapi_client.ComponentsNode, error) {
+	response, _, err := ctx.API.CanvasAPI.CanvasesDescribeCanvas(ctx.Context, canvasID).Execute()
+	if err != nil {
+		return nil, err
+	}
+
+	canvas := response.GetCanvas()
+	spec := canvas.GetSpec()
+
+	var matches []openapi_client.ComponentsNode
+	for _, node := range spec.GetNodes() {
+		if node.GetId() == nameOrID {
+			return &node, nil
+		}
+
+		if node.GetName() == nameOrID {
+			matches = append(matches, node)
+		}
+	}
+
+	if len(matches) == 0 {
+		return nil, fmt.Errorf("node %q not found", nameOrID)
+	}
+
+	if len(matches) > 1 {
+		return nil, fmt.Errorf("multiple nodes named %q found; use the node id", nameOrID)
+	}
+
+	return &matches[0], nil
+}
+
+//
+// ParseData reads a JSON object given inline, or from a file with @path.
+//
+
+func ParseData(value string) (map[string]any, error) {
+	data := map[string]any{}
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return data, nil
+	}
+
+	raw := []byte(value)
+	if path, ok := strings.CutPrefix(value, "@"); ok {
+		// #nosec
+		content, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read data file: %w", err)
+		}
+
+		raw = content
+	}
+
+	err := json.Unmarshal(raw, &data)
+	if err != nil {
+		return nil, fmt.Errorf("data must be a JSON object: %w", err)
+	}
+
+	return data, nil
+}
+
+//
+// ParseParameters parses key=value pairs.
+// Values that are valid JSON, like numbers and booleans, are kept as such.
+//
+
+func ParseParameters(values []string) (map[string]any, error) {
+	parameters := map[string]any{}
+	for _, value := range values {
+		key, v, ok := strings.Cut(value, "=")
+		key = strings.TrimSpace(key)
+		if !ok || key == "" {
+			return nil, fmt.Errorf("invalid parameter %q: expected key=value", value)
+		}
+
+		var parsed any
+		if json.Unmarshal([]byte(v), &parsed) == nil {
+			parameters[key] = parsed
+		} else {
+			parameters[key] = v
+		}
+	}
+
+	return parameters, nil
+}
+
+//
+// ParseInputs parses the key=value inputs of a manual run.
+// Values are sent as strings, and the server converts them to the declared input types.
+//
+
+func ParseInputs(values []string) (map[string]any, error) {
+	inputs := map[string]any{}
+	for _, value := range values {
+		key, v, ok := strings.Cut(value, "=")
+		key = strings.TrimSpace(key)
+		if !ok || key == "" {
+			return nil, fmt.Errorf("invalid --input %q: expected key=value", value)
+		}
+
+		inputs[key] = v
+	}
+
+	return inputs, nil
+}
+
+//
+// ConfirmChanges asks for confirmation before a command changes resources.
+// Only "yes" confirms, like terraform apply.
+//
+
+func ConfirmChanges(ctx CommandContext) (bool, error) {
+	_, _ = fmt.Fprint(ctx.Cmd.OutOrStdout(), "\nApply these changes? Only 'yes' will be accepted: ")
+	reader := bufio.NewReader(ctx.Cmd.InOrStdin())
+	input, err := reader.ReadString('\n')
+	if err != nil && input == "" {
+		return false, fmt.Errorf("failed to read confirmation, use --yes to skip it: %w", err)
+	}
+
+	return strings.TrimSpace(input) == "yes", nil
+}
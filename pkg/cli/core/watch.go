@@ -0,0 +1,243 @@
+package core
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/superplanehq/superplane/pkg/openapi_client"
+)
+
+const (
+	watchRetryMin = time.Second
+	watchRetryMax = 30 * time.Second
+)
+
+//
+// ErrWatchClosed is returned when the server ends a watch stream.
+// Execution streams are ended once the execution is finished.
+//
+
+var ErrWatchClosed = errors.New("stream closed")
+
+type watchMessage struct {
+	Result *struct {
+		Change *openapi_client.CanvasesCanvasChange `json:"change"`
+	} `json:"result"`
+	Error map[string]any `json:"error"`
+}
+
+//
+// CanvasWatchPath and ExecutionWatchPath are the paths
+// of the watch API, streaming changes as server-sent events.
+//
+
+func CanvasWatchPath(canvasID string) string {
+	return "/api/v1/canvases/" + url.PathEscape(canvasID) + "/watch"
+}
+
+func ExecutionWatchPath(canvasID, executionID string) string {
+	return "/api/v1/canvases/" + url.PathEscape(canvasID) + "/executions/" + url.PathEscape(executionID) + "/watch"
+}
+
+//
+// StreamChanges opens one watch stream and calls handle for each change,
+// until the stream ends. Heartbeats are not passed to handle,
+// but they still update the resume token, so the stream can be resumed
+// from where it stopped. onConnect, if given, is called once the stream is open.
+// It returns true if the stream was open.
+//
+
+func StreamChanges(
+	ctx context.Context,
+	api *openapi_client.APIClient,
+	path string,
+	resumeToken *string,
+	onConnect func(),
+	handle func(openapi_client.CanvasesCanvasChange) error,
+) (bool, error) {
+	request, err := newWatchRequest(ctx, api, path, *resumeToken)
+	if err != nil {
+		return false, err
+	}
+
+	//
+	// The client of the API has a timeout for each request,
+	// which would end the stream, so streams use a client without it.
+	//
+	config := api.GetConfig()
+	client := &http.Client{}
+	if config.HTTPClient != nil {
+		client.Transport = config.HTTPClient.Transport
+	}
+
+	response, err := client.Do(request)
+	if err != nil {
+		return false, err
+	}
+
+	defer response.Body.Close()
+
+	if response.StatusCode != http.StatusOK {
+		return false, fmt.Errorf("status %d", response.StatusCode)
+	}
+
+	if onConnect != nil {
+		onConnect()
+	}
+
+	return true, ReadChanges(response.Body, resumeToken, handle)
+}
+
+//
+// WatchUntilClosed streams changes with StreamChanges, and reconnects
+// with the last resume token when the stream breaks, until the server
+// ends the stream or the context is done.
+//
+
+func WatchUntilClosed(
+	ctx context.Context,
+	api *openapi_client.APIClient,
+	path string,
+	handle func(openapi_client.CanvasesCanvasChange) error,
+) error {
+	resumeToken := ""
+	retry := watchRetryMin
+
+	for {
+		connected, err := StreamChanges(ctx, api, path, &resumeToken, nil, handle)
+		if errors.Is(err, ErrWatchClosed) {
+			return nil
+		}
+
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+
+		var stopErr *watchStopError
+		if errors.As(err, &stopErr) {
+			return stopErr.err
+		}
+
+		//
+		// The stream can't be resumed without a token,
+		// so it is only retried once it was open.
+		//
+		if !connected && resumeToken == "" {
+			return fmt.Errorf("failed to watch: %w", err)
+		}
+
+		if connected {
+			retry = watchRetryMin
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(retry):
+		}
+
+		retry = min(retry*2, watchRetryMax)
+	}
+}
+
+//
+// watchStopError is returned for errors that reconnecting won't fix,
+// like an error sent by the server or returned by the handler.
+//
+
+type watchStopError struct {
+	err error
+}
+
+func (e *watchStopError) Error() string {
+	return e.err.Error()
+}
+
+func (e *watchStopError) Unwrap() error {
+	return e.err
+}
+
+func newWatchRequest(ctx context.Context, api *openapi_client.APIClient, path, resumeToken string) (*http.Request, error) {
+	config := api.GetConfig()
+	baseURL, err := config.ServerURL(0, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	endpoint := strings.TrimSuffix(baseURL, "/") + path
+	if resumeToken != "" {
+		endpoint += "?resumeToken=" + url.QueryEscape(resumeToken)
+	}
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	for header, value := range config.DefaultHeader {
+		request.Header.Set(header, value)
+	}
+
+	request.Header.Set("Accept", "text/event-stream")
+	return request, nil
+}
+
+//
+// ReadChanges reads server-sent events until the stream ends.
+// Each event has one data line with a JSON message.
+//
+
+func ReadChanges(body io.Reader, resumeToken *string, handle func(openapi_client.CanvasesCanvasChange) error) error {
+	reader := bufio.NewReader(body)
+	for {
+		raw, err := reader.ReadString('\n')
+		if err != nil {
+			if err == io.EOF {
+				return ErrWatchClosed
+			}
+
+			return err
+		}
+
+		data, ok := strings.CutPrefix(strings.TrimRight(raw, "\r\n"), "data: ")
+		if !ok {
+			continue
+		}
+
+		message := watchMessage{}
+		err = json.Unmarshal([]byte(data), &message)
+		if err != nil {
+			return fmt.Errorf("invalid message: %w", err)
+		}
+
+		if message.Error != nil {
+			return &watchStopError{err: fmt.Errorf("%v", message.Error["message"])}
+		}
+
+		if message.Result == nil || message.Result.Change == nil {
+			continue
+		}
+
+		change := *message.Result.Change
+		if change.GetResumeToken() != "" {
+			*resumeToken = change.GetResumeToken()
+		}
+
+		if change.GetType() == openapi_client.CANVASESCANVASCHANGETYPE_CANVAS_CHANGE_TYPE_HEARTBEAT {
+			continue
+		}
+
+		err = handle(change)
+		if err != nil {
+			return &watchStopError{err: err}
+		}
+	}
+}
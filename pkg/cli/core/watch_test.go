@@ -0,0 +1,47 @@
+package core
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/openapi_client"
+)
+
+func Test__ReadChanges(t *testing.T) {
+	t.Run("stream ends -> changes handled and resume token updated", func(t *testing.T) {
+		body := strings.Join([]string{
+			`data: {"result":{"change":{"type":"CANVAS_CHANGE_TYPE_HEARTBEAT","resumeToken":"t1"}}}`,
+			``,
+			`data: {"result":{"change":{"type":"CANVAS_CHANGE_TYPE_EXECUTION_UPDATED","resumeToken":"t2","execution":{"id":"e1"}}}}`,
+			``,
+		}, "\n")
+
+		resumeToken := ""
+		changes := []openapi_client.CanvasesCanvasChange{}
+		err := ReadChanges(strings.NewReader(body), &resumeToken, func(change openapi_client.CanvasesCanvasChange) error {
+			changes = append(changes, change)
+			return nil
+		})
+
+		require.ErrorIs(t, err, ErrWatchClosed)
+		assert.Equal(t, "t2", resumeToken)
+		require.Len(t, changes, 1)
+		assert.Equal(t, "e1", changes[0].Execution.GetId())
+	})
+
+	t.Run("error message -> stops the watch", func(t *testing.T) {
+		body := `data: {"error":{"message":"execution not found"}}` + "\n"
+
+		resumeToken := ""
+		err := ReadChanges(strings.NewReader(body), &resumeToken, func(openapi_client.CanvasesCanvasChange) error {
+			return nil
+		})
+
+		var stopErr *watchStopError
+		require.True(t, errors.As(err, &stopErr))
+		assert.EqualError(t, err, "execution not found")
+	})
+}
@@ -15,8 +15,14 @@ import (
 	executions "github.com/superplanehq/superplane/pkg/cli/commands/executions"
 	index "github.com/superplanehq/superplane/pkg/cli/commands/index"
 	integrations "github.com/superplanehq/superplane/pkg/cli/commands/integrations"
+	notifications "github.com/superplanehq/superplane/pkg/cli/commands/notifications"
 	queue "github.com/superplanehq/superplane/pkg/cli/commands/queue"
+	resources "github.com/superplanehq/superplane/pkg/cli/commands/resources"
 	secrets "github.com/superplanehq/superplane/pkg/cli/commands/secrets"
+	subscriptions "github.com/superplanehq/superplane/pkg/cli/commands/subscriptions"
+	tokens "github.com/superplanehq/superplane/pkg/cli/commands/tokens"
+	triggers "github.com/superplanehq/superplane/pkg/cli/commands/triggers"
+	tui "github.com/superplanehq/superplane/pkg/cli/commands/tui"
 	"github.com/superplanehq/superplane/pkg/cli/core"
 )
 
@@ -56,8 +62,15 @@ func init() {
 	RootCmd.AddCommand(events.NewCommand(options))
 	RootCmd.AddCommand(index.NewCommand(options))
 	RootCmd.AddCommand(integrations.NewCommand(options))
+	RootCmd.AddCommand(notifications.NewCommand(options))
 	RootCmd.AddCommand(queue.NewCommand(options))
+	RootCmd.AddCommand(resources.NewApplyCommand(options))
+	RootCmd.AddCommand(resources.NewExportCommand(options))
 	RootCmd.AddCommand(secrets.NewCommand(options))
+	RootCmd.AddCommand(subscriptions.NewCommand(options))
+	RootCmd.AddCommand(tokens.NewCommand(options))
+	RootCmd.AddCommand(triggers.NewCommand(options))
+	RootCmd.AddCommand(tui.NewCommand(options))
 }
 
 func initConfig() {
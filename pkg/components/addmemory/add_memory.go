@@ -233,3 +233,7 @@ func (c *AddMemory) HandleWebhook(ctx core.WebhookRequestContext) (int, error) {
 func (c *AddMemory) Cleanup(ctx core.SetupContext) error {
 	return nil
 }
+
+func (c *AddMemory) SideEffectFree() bool {
+	return true
+}
@@ -15,6 +15,8 @@ import (
 	"github.com/superplanehq/superplane/pkg/registry"
 )
 
+const ComponentName = "approval"
+
 const (
 	StatePending  = "pending"
 	StateApproved = "approved"
@@ -30,7 +32,7 @@ const (
 )
 
 func init() {
-	registry.RegisterComponent("approval", &Approval{})
+	registry.RegisterComponent(ComponentName, &Approval{})
 }
 
 /*
@@ -286,7 +288,7 @@ func approvalItemToRecord(ctx core.ExecutionContext, item Item, index int) (*Rec
 type Approval struct{}
 
 func (a *Approval) Name() string {
-	return "approval"
+	return ComponentName
 }
 
 func (a *Approval) Label() string {
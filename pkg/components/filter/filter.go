@@ -278,3 +278,7 @@ func (f *Filter) HandleWebhook(ctx core.WebhookRequestContext) (int, error) {
 func (f *Filter) Cleanup(ctx core.SetupContext) error {
 	return nil
 }
+
+func (f *Filter) SideEffectFree() bool {
+	return true
+}
@@ -49,6 +49,7 @@ type Spec struct {
 	TimeoutStrategy *string     `json:"timeoutStrategy,omitempty"`
 	TimeoutSeconds  *int        `json:"timeoutSeconds,omitempty"`
 	Retries         *int        `json:"retries,omitempty"`
+	SaveResponseAs  *string     `json:"saveResponseAs,omitempty"`
 }
 
 type RetryMetadata struct {
@@ -108,6 +109,13 @@ The component emits the response with:
 - **status**: HTTP status code
 - **headers**: Response headers
 - **body**: Parsed response body (JSON if possible, otherwise string)
+- **artifact**: The stored artifact, if **Save response as artifact** is set
+
+## Artifacts
+
+Enable **Save response as artifact** to store the raw response body as an execution artifact with the given name.
+This is useful for responses that are too big or not meant to be parsed, like reports or generated files.
+Downstream nodes can read it with ` + "`artifactContent(\"Node name\", \"artifact name\")`" + `.
 
 ## Error Handling & Retries
 
@@ -116,6 +124,9 @@ Configure timeout and retry behavior:
 - **Exponential backoff**: Timeout increases with each retry (capped at 120s)
 - **Success codes**: Define which status codes are considered successful (default: 2xx)
 
+Every attempt is written to the execution log, with its status.
+The body of failed responses is written too, up to 4 KB. Headers and query parameters are never written, since they can have credentials.
+
 ## Output Events
 
 - **http.request.finished**: Emitted on successful request
@@ -431,6 +442,15 @@ func (e *HTTP) Configuration() []configuration.Field {
 			},
 			Default: "3",
 		},
+		{
+			Name:        "saveResponseAs",
+			Type:        configuration.FieldTypeString,
+			Label:       "Save response as artifact",
+			Required:    false,
+			Togglable:   true,
+			Description: "Store the raw response body as an execution artifact with this name",
+			Placeholder: "response.json",
+		},
 	}
 }
 
@@ -494,8 +514,10 @@ func (e *HTTP) Execute(ctx core.ExecutionContext) error {
 func (e *HTTP) executeHTTPRequest(ctx core.ExecutionContext, spec Spec, retryMetadata RetryMetadata) error {
 	currentTimeout := e.calculateTimeoutForAttempt(retryMetadata.TimeoutStrategy, retryMetadata.TimeoutSeconds, retryMetadata.Attempt)
 
+	writeStdout(ctx.Logs, "%s %s (attempt %d of %d)", spec.Method, logURL(spec.URL), retryMetadata.Attempt+1, retryMetadata.MaxRetries+1)
 	resp, err := e.executeRequest(ctx.HTTP, spec, currentTimeout)
 	if err != nil {
+		writeStderr(ctx.Logs, "request failed: %v", err)
 		if retryMetadata.Attempt < retryMetadata.MaxRetries {
 			return e.scheduleRetry(ctx, err.Error(), retryMetadata)
 		}
@@ -511,7 +533,8 @@ func (e *HTTP) executeHTTPRequest(ctx core.ExecutionContext, spec Spec, retryMet
 	}
 
 	if !isSuccess && retryMetadata.Attempt < retryMetadata.MaxRetries {
-
+		writeStderr(ctx.Logs, "received status %d, retrying", resp.StatusCode)
+		resp.Body.Close()
 		return e.scheduleRetry(ctx, fmt.Sprintf("HTTP status %d", resp.StatusCode), retryMetadata)
 	}
 
@@ -557,6 +580,7 @@ func (e *HTTP) handleRetryRequest(ctx core.ActionContext) error {
 		Requests:       ctx.Requests,
 		Auth:           ctx.Auth,
 		HTTP:           ctx.HTTP,
+		Logs:           ctx.Logs,
 	}
 
 	return e.executeHTTPRequest(execCtx, spec, retryMetadata)
@@ -674,6 +698,7 @@ func (e *HTTP) processResponse(ctx core.ExecutionContext, resp *http.Response, s
 
 	respBody, err := io.ReadAll(resp.Body)
 	if err != nil {
+		writeStderr(ctx.Logs, "failed to read response body: %v", err)
 		// Get current metadata and update with final result
 		metadata := ctx.Metadata.Get()
 		var retryMetadata RetryMetadata
@@ -717,6 +742,15 @@ func (e *HTTP) processResponse(ctx core.ExecutionContext, resp *http.Response, s
 		"body":    bodyData,
 	}
 
+	if spec.SaveResponseAs != nil && *spec.SaveResponseAs != "" && ctx.Artifacts != nil {
+		artifact, err := ctx.Artifacts.Put(*spec.SaveResponseAs, resp.Header.Get("Content-Type"), respBody)
+		if err != nil {
+			return fmt.Errorf("failed to save response as artifact: %w", err)
+		}
+
+		response["artifact"] = artifact
+	}
+
 	var isSuccess bool
 	if spec.SuccessCodes != nil && *spec.SuccessCodes != "" {
 		isSuccess = e.matchesSuccessCode(resp.StatusCode, *spec.SuccessCodes)
@@ -747,10 +781,13 @@ func (e *HTTP) processResponse(ctx core.ExecutionContext, resp *http.Response, s
 	}
 
 	if !isSuccess {
+		writeStderr(ctx.Logs, "received status %d", resp.StatusCode)
+		writeResponseBody(ctx.Logs, respBody)
 		ctx.ExecutionState.Fail(models.CanvasNodeExecutionResultReasonError, fmt.Sprintf("HTTP request failed with status %d", resp.StatusCode))
 		return nil
 	}
 
+	writeStdout(ctx.Logs, "received status %d", resp.StatusCode)
 	err = ctx.ExecutionState.Emit(
 		core.DefaultOutputChannel.Name,
 		eventType,
@@ -764,6 +801,59 @@ func (e *HTTP) processResponse(ctx core.ExecutionContext, resp *http.Response, s
 	return nil
 }
 
+//
+// Requests and responses are written to the execution log,
+// so users can see why a request failed.
+// Headers and query parameters can have credentials,
+// so only the method, the URL without them and the status are written,
+// and the body of failed responses, up to maxLoggedBodySize.
+//
+
+const maxLoggedBodySize = 4096
+
+func logURL(raw string) string {
+	parsed, err := url.Parse(raw)
+	if err != nil {
+		return raw
+	}
+
+	parsed.User = nil
+	parsed.RawQuery = ""
+	parsed.Fragment = ""
+	return parsed.String()
+}
+
+func writeStdout(logs core.ExecutionLogContext, format string, args ...any) {
+	if logs != nil {
+		_ = logs.Stdout(fmt.Sprintf(format, args...))
+	}
+}
+
+func writeStderr(logs core.ExecutionLogContext, format string, args ...any) {
+	if logs != nil {
+		_ = logs.Stderr(fmt.Sprintf(format, args...))
+	}
+}
+
+func writeResponseBody(logs core.ExecutionLogContext, body []byte) {
+	if logs == nil || len(body) == 0 {
+		return
+	}
+
+	truncated := len(body) > maxLoggedBodySize
+	if truncated {
+		body = body[:maxLoggedBodySize]
+	}
+
+	for _, line := range strings.Split(strings.TrimRight(string(body), "\n"), "\n") {
+		_ = logs.Stderr(strings.TrimSuffix(line, "\r"))
+	}
+
+	if truncated {
+		_ = logs.Stderr(fmt.Sprintf("response body truncated to %d bytes", maxLoggedBodySize))
+	}
+}
+
 func (e *HTTP) matchesSuccessCode(statusCode int, successCodes string) bool {
 	if successCodes == "" {
 		successCodes = "2xx"
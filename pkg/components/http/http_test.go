@@ -13,6 +13,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/superplanehq/superplane/pkg/core"
+	"github.com/superplanehq/superplane/pkg/testkit"
 	"github.com/superplanehq/superplane/test/support/contexts"
 )
 
@@ -1187,3 +1188,60 @@ func TestHTTP__RetryMetadata_Progression(t *testing.T) {
 
 	assert.Equal(t, int32(3), atomic.LoadInt32(&requestCount))
 }
+
+func TestHTTP__Execute__SaveResponseAsArtifact(t *testing.T) {
+	h := &HTTP{}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/csv")
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte("id,name\n1,test\n"))
+	}))
+	defer server.Close()
+
+	ctx, stateCtx, _ := createExecutionContext(map[string]any{
+		"method":         "GET",
+		"url":            server.URL,
+		"saveResponseAs": "report.csv",
+	})
+
+	artifactCtx := &contexts.ArtifactContext{}
+	ctx.Artifacts = artifactCtx
+
+	err := h.Execute(ctx)
+	require.NoError(t, err)
+	assert.True(t, stateCtx.Passed)
+	assert.Equal(t, "id,name\n1,test\n", string(artifactCtx.Artifacts["report.csv"]))
+
+	payload := stateCtx.Payloads[0].(map[string]any)
+	response := payload["data"].(map[string]any)
+	artifact := response["artifact"].(*core.Artifact)
+	assert.Equal(t, "report.csv", artifact.Name)
+	assert.Equal(t, "text/csv", artifact.ContentType)
+	assert.Equal(t, int64(15), artifact.Size)
+}
+
+func TestHTTP__Execute__WritesExecutionLogs(t *testing.T) {
+	h := &HTTP{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte("invalid token\n\nsee docs"))
+	}))
+	defer server.Close()
+
+	logs := &testkit.Logs{}
+	ctx, stateCtx, _ := createExecutionContext(map[string]any{
+		"method":      "GET",
+		"url":         server.URL + "/deploy",
+		"queryParams": []map[string]any{{"key": "token", "value": "secret"}},
+	})
+	ctx.Logs = logs
+
+	err := h.Execute(ctx)
+	require.NoError(t, err)
+	assert.True(t, stateCtx.Finished)
+	assert.False(t, stateCtx.Passed)
+
+	assert.Equal(t, []string{"GET " + server.URL + "/deploy (attempt 1 of 1)"}, logs.StdoutLines)
+	assert.Equal(t, []string{"received status 400", "invalid token", "", "see docs"}, logs.StderrLines)
+}
@@ -0,0 +1,568 @@
+package humaninput
+
+import (
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/mitchellh/mapstructure"
+	"github.com/superplanehq/superplane/pkg/configuration"
+	"github.com/superplanehq/superplane/pkg/core"
+	"github.com/superplanehq/superplane/pkg/models"
+	"github.com/superplanehq/superplane/pkg/registry"
+)
+
+const (
+	ComponentName = "humanInput"
+	PayloadType   = "humanInput.submitted"
+	ActionSubmit  = "submit"
+
+	StatePending   = "pending"
+	StateSubmitted = "submitted"
+
+	ResponderTypeAnyone = "anyone"
+	ResponderTypeUser   = "user"
+	ResponderTypeRole   = "role"
+	ResponderTypeGroup  = "group"
+)
+
+func init() {
+	registry.RegisterComponent(ComponentName, &HumanInput{})
+}
+
+/*
+ * Configuration for the component.
+ * Filled when the component is added to a blueprint/workflow.
+ */
+type Config struct {
+	Instructions string                    `json:"instructions" mapstructure:"instructions"`
+	Fields       []configuration.FormField `json:"fields" mapstructure:"fields"`
+	Responders   []Responder               `json:"responders" mapstructure:"responders"`
+}
+
+type Responder struct {
+	Type  string `mapstructure:"type" json:"type"`
+	User  string `mapstructure:"user" json:"user,omitempty"`
+	Role  string `mapstructure:"role" json:"role,omitempty"`
+	Group string `mapstructure:"group" json:"group,omitempty"`
+}
+
+/*
+ * Metadata for the component.
+ * Responders are resolved when the execution starts,
+ * so the UI can show who is expected to answer.
+ */
+type Metadata struct {
+	State      string            `mapstructure:"state" json:"state"`
+	Responders []ResponderRecord `mapstructure:"responders" json:"responders"`
+	Submission *Submission       `mapstructure:"submission" json:"submission,omitempty"`
+}
+
+type ResponderRecord struct {
+	Type  string     `mapstructure:"type" json:"type"`
+	User  *core.User `mapstructure:"user" json:"user,omitempty"`
+	Role  *string    `mapstructure:"role" json:"role,omitempty"`
+	Group *string    `mapstructure:"group" json:"group,omitempty"`
+}
+
+type Submission struct {
+	Values      map[string]any `mapstructure:"values" json:"values"`
+	SubmittedBy *core.User     `mapstructure:"submittedBy" json:"submittedBy,omitempty"`
+	SubmittedAt string         `mapstructure:"submittedAt" json:"submittedAt"`
+}
+
+type HumanInput struct{}
+
+func (h *HumanInput) Name() string {
+	return ComponentName
+}
+
+func (h *HumanInput) Label() string {
+	return "Human Input"
+}
+
+func (h *HumanInput) Description() string {
+	return "Pause the run until someone fills in a form"
+}
+
+func (h *HumanInput) Documentation() string {
+	return `The Human Input component pauses workflow execution until one of the specified users, groups, or roles fills in a form. The submitted values are emitted downstream.
+
+## Use Cases
+
+- **Rollbacks**: Ask for the version to roll back to
+- **Incident response**: Ask for the incident severity before paging
+- **Audit trails**: Ask for a reason before running a sensitive operation
+
+## How It Works
+
+1. When the Human Input component executes, it notifies the configured responders
+2. The workflow pauses and waits for the form to be submitted
+3. A responder fills in the form from the workflow UI
+4. The submitted values are validated against the form fields and emitted on the default channel
+
+The first valid submission completes the execution.
+
+## Configuration
+
+- **Instructions**: Optional text shown above the form and in the notification
+- **Fields**: Form fields to fill in. Each field has a name, a label, a type and optional description, default value and required flag. Supported types are string, text, number, boolean, select, git-ref, integration-resource and user.
+- **Responders**: List of users, groups, or roles who may submit the form
+  - **Any user**: Any authenticated user can submit
+  - **Specific user**: Only the specified user can submit
+  - **Group**: Any member of the specified group can submit
+  - **Role**: Any user with the specified role can submit
+
+## Output
+
+The emitted payload contains the submitted values, keyed by field name, and who submitted them, e.g. ` + "`{{ $['Human Input'].data.values.version }}`" + `.
+
+## Actions
+
+- **submit**: Submit the form values`
+}
+
+func (h *HumanInput) Icon() string {
+	return "clipboard-list"
+}
+
+func (h *HumanInput) Color() string {
+	return "orange"
+}
+
+func (h *HumanInput) OutputChannels(configuration any) []core.OutputChannel {
+	return []core.OutputChannel{core.DefaultOutputChannel}
+}
+
+func (h *HumanInput) Configuration() []configuration.Field {
+	return []configuration.Field{
+		{
+			Name:        "instructions",
+			Label:       "Instructions",
+			Type:        configuration.FieldTypeText,
+			Description: "Shown above the form and in the notification sent to responders",
+		},
+		{
+			Name:        "fields",
+			Label:       "Fields",
+			Description: "Form fields to fill in",
+			Type:        configuration.FieldTypeList,
+			Required:    true,
+			TypeOptions: &configuration.TypeOptions{
+				List: &configuration.ListTypeOptions{
+					ItemLabel: "Field",
+					ItemDefinition: &configuration.ListItemDefinition{
+						Type:   configuration.FieldTypeObject,
+						Schema: configuration.FormFieldSchema(),
+					},
+				},
+			},
+		},
+		{
+			Name:        "responders",
+			Label:       "Responders",
+			Description: "List of users, groups, or roles who may submit the form",
+			Type:        configuration.FieldTypeList,
+			Required:    true,
+			Default:     `[{"type":"anyone"}]`,
+			TypeOptions: &configuration.TypeOptions{
+				List: &configuration.ListTypeOptions{
+					ItemLabel: "Responder",
+					ItemDefinition: &configuration.ListItemDefinition{
+						Type: configuration.FieldTypeObject,
+						Schema: []configuration.Field{
+							{
+								Name:     "type",
+								Label:    "Request input from",
+								Type:     configuration.FieldTypeSelect,
+								Required: true,
+								Default:  ResponderTypeAnyone,
+								TypeOptions: &configuration.TypeOptions{
+									Select: &configuration.SelectTypeOptions{
+										Options: []configuration.FieldOption{
+											{Value: ResponderTypeAnyone, Label: "Any user"},
+											{Value: ResponderTypeUser, Label: "Specific user"},
+											{Value: ResponderTypeGroup, Label: "Group"},
+											{Value: ResponderTypeRole, Label: "Role"},
+										},
+									},
+								},
+							},
+							{
+								Name:  "user",
+								Label: "User",
+								Type:  configuration.FieldTypeUser,
+								VisibilityConditions: []configuration.VisibilityCondition{
+									{Field: "type", Values: []string{ResponderTypeUser}},
+								},
+							},
+							{
+								Name:  "role",
+								Label: "Role",
+								Type:  configuration.FieldTypeRole,
+								VisibilityConditions: []configuration.VisibilityCondition{
+									{Field: "type", Values: []string{ResponderTypeRole}},
+								},
+							},
+							{
+								Name:  "group",
+								Label: "Group",
+								Type:  configuration.FieldTypeGroup,
+								VisibilityConditions: []configuration.VisibilityCondition{
+									{Field: "type", Values: []string{ResponderTypeGroup}},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (h *HumanInput) Setup(ctx core.SetupContext) error {
+	config, err := decodeConfig(ctx.Configuration)
+	if err != nil {
+		return err
+	}
+
+	if len(config.Fields) == 0 {
+		return fmt.Errorf("at least one field is required")
+	}
+
+	if err := configuration.ValidateFormFields(config.Fields); err != nil {
+		return err
+	}
+
+	if len(config.Responders) == 0 {
+		return fmt.Errorf("at least one responder is required")
+	}
+
+	for i, responder := range config.Responders {
+		if err := validateResponder(responder); err != nil {
+			return fmt.Errorf("responder %d: %w", i, err)
+		}
+	}
+
+	return nil
+}
+
+func validateResponder(responder Responder) error {
+	switch responder.Type {
+	case ResponderTypeAnyone:
+		return nil
+	case ResponderTypeUser:
+		if _, err := uuid.Parse(responder.User); err != nil {
+			return fmt.Errorf("invalid user")
+		}
+		return nil
+	case ResponderTypeRole:
+		if responder.Role == "" {
+			return fmt.Errorf("role is required")
+		}
+		return nil
+	case ResponderTypeGroup:
+		if responder.Group == "" {
+			return fmt.Errorf("group is required")
+		}
+		return nil
+	}
+
+	return fmt.Errorf("unsupported responder type: %s", responder.Type)
+}
+
+func (h *HumanInput) ProcessQueueItem(ctx core.ProcessQueueContext) (*uuid.UUID, error) {
+	return ctx.DefaultProcessing()
+}
+
+func (h *HumanInput) Execute(ctx core.ExecutionContext) error {
+	config, err := decodeConfig(ctx.Configuration)
+	if err != nil {
+		return err
+	}
+
+	responders := []ResponderRecord{}
+	for _, responder := range config.Responders {
+		record, err := responderToRecord(ctx.Auth, responder)
+		if err != nil {
+			return err
+		}
+
+		responders = append(responders, *record)
+	}
+
+	metadata := &Metadata{
+		State:      StatePending,
+		Responders: responders,
+	}
+
+	err = ctx.Metadata.Set(metadata)
+	if err != nil {
+		return fmt.Errorf("error setting metadata: %v", err)
+	}
+
+	if ctx.Notifications != nil {
+		if err := h.notifyResponders(ctx, config, metadata); err != nil {
+			if ctx.Logger != nil {
+				ctx.Logger.Warnf("failed to send human input notification: %v", err)
+			}
+		}
+	}
+
+	return nil
+}
+
+func responderToRecord(auth core.AuthContext, responder Responder) (*ResponderRecord, error) {
+	switch responder.Type {
+	case ResponderTypeAnyone:
+		return &ResponderRecord{Type: responder.Type}, nil
+
+	case ResponderTypeUser:
+		userID, err := uuid.Parse(responder.User)
+		if err != nil {
+			return nil, err
+		}
+
+		user, err := auth.GetUser(userID)
+		if err != nil {
+			return nil, err
+		}
+
+		return &ResponderRecord{Type: responder.Type, User: user}, nil
+
+	case ResponderTypeRole:
+		role := responder.Role
+		return &ResponderRecord{Type: responder.Type, Role: &role}, nil
+
+	case ResponderTypeGroup:
+		group := responder.Group
+		return &ResponderRecord{Type: responder.Type, Group: &group}, nil
+	}
+
+	return nil, fmt.Errorf("unsupported responder type: %s", responder.Type)
+}
+
+func (h *HumanInput) Actions() []core.Action {
+	return []core.Action{
+		{
+			Name:           ActionSubmit,
+			Description:    "Submit the form",
+			UserAccessible: true,
+			Parameters: []configuration.Field{
+				{
+					Name:        "values",
+					Label:       "Values",
+					Type:        configuration.FieldTypeObject,
+					Description: "Values for the form fields, keyed by field name",
+					Required:    true,
+				},
+			},
+		},
+	}
+}
+
+func (h *HumanInput) HandleAction(ctx core.ActionContext) error {
+	if ctx.Name != ActionSubmit {
+		return fmt.Errorf("unknown action: %s", ctx.Name)
+	}
+
+	config, err := decodeConfig(ctx.Configuration)
+	if err != nil {
+		return err
+	}
+
+	var metadata Metadata
+	err = mapstructure.Decode(ctx.Metadata.Get(), &metadata)
+	if err != nil {
+		return fmt.Errorf("failed to parse metadata: %w", err)
+	}
+
+	if metadata.State != StatePending {
+		return fmt.Errorf("form was already submitted")
+	}
+
+	if err := canRespond(ctx.Auth, metadata.Responders); err != nil {
+		return err
+	}
+
+	values, ok := ctx.Parameters["values"].(map[string]any)
+	if !ok {
+		return fmt.Errorf("values are required")
+	}
+
+	values, err = configuration.ValidateFormValues(config.Fields, values)
+	if err != nil {
+		return err
+	}
+
+	err = configuration.ValidateFormResources(config.Fields, values, ctx.IntegrationResources)
+	if err != nil {
+		return err
+	}
+
+	err = configuration.ValidateFormUsers(config.Fields, values, ctx.Members)
+	if err != nil {
+		return err
+	}
+
+	metadata.State = StateSubmitted
+	metadata.Submission = &Submission{
+		Values:      values,
+		SubmittedBy: ctx.Auth.AuthenticatedUser(),
+		SubmittedAt: time.Now().Format(time.RFC3339),
+	}
+
+	if err := ctx.Metadata.Set(metadata); err != nil {
+		return err
+	}
+
+	return ctx.ExecutionState.Emit(
+		core.DefaultOutputChannel.Name,
+		PayloadType,
+		[]any{metadata.Submission},
+	)
+}
+
+//
+// The authenticated user may respond if they match any of the responders.
+//
+
+func canRespond(auth core.AuthContext, responders []ResponderRecord) error {
+	user := auth.AuthenticatedUser()
+	if user == nil {
+		return fmt.Errorf("user not authenticated")
+	}
+
+	for _, responder := range responders {
+		switch responder.Type {
+		case ResponderTypeAnyone:
+			return nil
+
+		case ResponderTypeUser:
+			if responder.User != nil && responder.User.ID == user.ID {
+				return nil
+			}
+
+		case ResponderTypeRole:
+			if responder.Role == nil {
+				continue
+			}
+
+			hasRole, err := auth.HasRole(*responder.Role)
+			if err != nil {
+				return fmt.Errorf("error checking role %s: %v", *responder.Role, err)
+			}
+
+			if hasRole {
+				return nil
+			}
+
+		case ResponderTypeGroup:
+			if responder.Group == nil {
+				continue
+			}
+
+			inGroup, err := auth.InGroup(*responder.Group)
+			if err != nil {
+				return fmt.Errorf("error checking group %s: %v", *responder.Group, err)
+			}
+
+			if inGroup {
+				return nil
+			}
+		}
+	}
+
+	return fmt.Errorf("user is not allowed to submit this form")
+}
+
+func (h *HumanInput) Cancel(ctx core.ExecutionContext) error {
+	return nil
+}
+
+func (h *HumanInput) HandleWebhook(ctx core.WebhookRequestContext) (int, error) {
+	return http.StatusOK, nil
+}
+
+func (h *HumanInput) notifyResponders(ctx core.ExecutionContext, config *Config, metadata *Metadata) error {
+	url := ""
+	if ctx.BaseURL != "" && ctx.OrganizationID != "" && ctx.WorkflowID != "" && ctx.NodeID != "" {
+		url = fmt.Sprintf(
+			"%s/%s/canvases/%s?sidebar=1&node=%s",
+			strings.TrimRight(ctx.BaseURL, "/"),
+			ctx.OrganizationID,
+			ctx.WorkflowID,
+			ctx.NodeID,
+		)
+	}
+
+	title := "Input required"
+	body := "A canvas run is waiting for your input. Please visit the URL below to fill in the form."
+	if config.Instructions != "" {
+		body = config.Instructions + "\n\n" + body
+	}
+
+	emails := []string{}
+	groups := []string{}
+	roles := []string{}
+
+	for _, responder := range metadata.Responders {
+		switch responder.Type {
+		case ResponderTypeAnyone:
+			roles = append(roles, models.RoleOrgViewer, models.RoleOrgAdmin, models.RoleOrgOwner)
+
+		case ResponderTypeUser:
+			if responder.User != nil && responder.User.Email != "" {
+				emails = append(emails, responder.User.Email)
+			}
+
+		case ResponderTypeRole:
+			if responder.Role != nil && *responder.Role != "" {
+				roles = append(roles, *responder.Role)
+			}
+
+		case ResponderTypeGroup:
+			if responder.Group != nil && *responder.Group != "" {
+				groups = append(groups, *responder.Group)
+			}
+		}
+	}
+
+	receivers := core.NotificationReceivers{
+		Emails: uniqueSorted(emails),
+		Groups: uniqueSorted(groups),
+		Roles:  uniqueSorted(roles),
+	}
+
+	return ctx.Notifications.Send(title, body, url, "Open form", receivers)
+}
+
+func uniqueSorted(values []string) []string {
+	slices.Sort(values)
+	return slices.Compact(values)
+}
+
+func decodeConfig(input any) (*Config, error) {
+	config := Config{}
+	decoder, err := mapstructure.NewDecoder(&mapstructure.DecoderConfig{
+		Result:           &config,
+		WeaklyTypedInput: true,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if err := decoder.Decode(input); err != nil {
+		return nil, fmt.Errorf("failed to decode configuration: %w", err)
+	}
+
+	return &config, nil
+}
+
+func (h *HumanInput) Cleanup(ctx core.SetupContext) error {
+	return nil
+}
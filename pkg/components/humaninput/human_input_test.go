@@ -0,0 +1,181 @@
+package humaninput
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/core"
+	"github.com/superplanehq/superplane/test/support/contexts"
+)
+
+var testConfig = map[string]any{
+	"instructions": "Pick the version to roll back to",
+	"fields": []any{
+		map[string]any{"name": "version", "type": "string", "required": true},
+		map[string]any{"name": "severity", "type": "select", "options": []any{"sev1", "sev2"}, "default": "sev2"},
+		map[string]any{"name": "replicas", "type": "number", "min": 1},
+	},
+	"responders": []any{
+		map[string]any{"type": "role", "role": "org_admin"},
+		map[string]any{"type": "group", "group": "sre"},
+	},
+}
+
+func Test__HumanInput__Setup(t *testing.T) {
+	component := &HumanInput{}
+
+	t.Run("valid configuration -> ok", func(t *testing.T) {
+		require.NoError(t, component.Setup(core.SetupContext{Configuration: testConfig}))
+	})
+
+	t.Run("no fields -> error", func(t *testing.T) {
+		err := component.Setup(core.SetupContext{
+			Configuration: map[string]any{
+				"responders": []any{map[string]any{"type": "anyone"}},
+			},
+		})
+
+		require.ErrorContains(t, err, "at least one field is required")
+	})
+
+	t.Run("invalid field -> error", func(t *testing.T) {
+		err := component.Setup(core.SetupContext{
+			Configuration: map[string]any{
+				"fields":     []any{map[string]any{"name": "x", "type": "select"}},
+				"responders": []any{map[string]any{"type": "anyone"}},
+			},
+		})
+
+		require.ErrorContains(t, err, "select fields require at least one option")
+	})
+
+	t.Run("no responders -> error", func(t *testing.T) {
+		err := component.Setup(core.SetupContext{
+			Configuration: map[string]any{
+				"fields": []any{map[string]any{"name": "x", "type": "string"}},
+			},
+		})
+
+		require.ErrorContains(t, err, "at least one responder is required")
+	})
+
+	t.Run("invalid responder -> error", func(t *testing.T) {
+		err := component.Setup(core.SetupContext{
+			Configuration: map[string]any{
+				"fields":     []any{map[string]any{"name": "x", "type": "string"}},
+				"responders": []any{map[string]any{"type": "user", "user": "not-a-uuid"}},
+			},
+		})
+
+		require.ErrorContains(t, err, "responder 0: invalid user")
+	})
+}
+
+func Test__HumanInput__Execute(t *testing.T) {
+	component := &HumanInput{}
+	userID := uuid.NewString()
+	metadataCtx := &contexts.MetadataContext{}
+	notificationCtx := &contexts.NotificationContext{}
+	stateCtx := &contexts.ExecutionStateContext{}
+
+	config := map[string]any{
+		"fields": testConfig["fields"],
+		"responders": []any{
+			map[string]any{"type": "user", "user": userID},
+			map[string]any{"type": "group", "group": "sre"},
+		},
+	}
+
+	err := component.Execute(core.ExecutionContext{
+		Configuration:  config,
+		Metadata:       metadataCtx,
+		ExecutionState: stateCtx,
+		Notifications:  notificationCtx,
+		Auth: &contexts.AuthContext{
+			Users: map[string]*core.User{userID: {ID: userID, Email: "alex@example.com"}},
+		},
+	})
+
+	require.NoError(t, err)
+	assert.False(t, stateCtx.Finished)
+
+	metadata, ok := metadataCtx.Metadata.(*Metadata)
+	require.True(t, ok)
+	assert.Equal(t, StatePending, metadata.State)
+	require.Len(t, metadata.Responders, 2)
+	assert.Equal(t, "alex@example.com", metadata.Responders[0].User.Email)
+	assert.Equal(t, "sre", *metadata.Responders[1].Group)
+
+	require.Len(t, notificationCtx.Sent, 1)
+	assert.Equal(t, []string{"alex@example.com"}, notificationCtx.Sent[0].Receivers.Emails)
+	assert.Equal(t, []string{"sre"}, notificationCtx.Sent[0].Receivers.Groups)
+}
+
+func Test__HumanInput__HandleAction(t *testing.T) {
+	component := &HumanInput{}
+	role := "org_admin"
+
+	newCtx := func(auth *contexts.AuthContext, values map[string]any) (core.ActionContext, *contexts.MetadataContext, *contexts.ExecutionStateContext) {
+		metadataCtx := &contexts.MetadataContext{
+			Metadata: Metadata{
+				State:      StatePending,
+				Responders: []ResponderRecord{{Type: ResponderTypeRole, Role: &role}},
+			},
+		}
+
+		stateCtx := &contexts.ExecutionStateContext{}
+		return core.ActionContext{
+			Name:           ActionSubmit,
+			Configuration:  testConfig,
+			Parameters:     map[string]any{"values": values},
+			Metadata:       metadataCtx,
+			ExecutionState: stateCtx,
+			Auth:           auth,
+		}, metadataCtx, stateCtx
+	}
+
+	allowed := &contexts.AuthContext{
+		User:  &core.User{ID: "user-1", Name: "Alex"},
+		Roles: map[string]struct{}{role: {}},
+	}
+
+	t.Run("valid submission -> values are emitted", func(t *testing.T) {
+		ctx, metadataCtx, stateCtx := newCtx(allowed, map[string]any{"version": "v1.2.3", "replicas": "3"})
+		require.NoError(t, component.HandleAction(ctx))
+
+		assert.True(t, stateCtx.Passed)
+		assert.Equal(t, core.DefaultOutputChannel.Name, stateCtx.Channel)
+		assert.Equal(t, PayloadType, stateCtx.Type)
+		require.Len(t, stateCtx.Payloads, 1)
+
+		submission := stateCtx.Payloads[0].(map[string]any)["data"].(*Submission)
+		assert.Equal(t, map[string]any{"version": "v1.2.3", "severity": "sev2", "replicas": float64(3)}, submission.Values)
+		assert.Equal(t, "user-1", submission.SubmittedBy.ID)
+
+		metadata := metadataCtx.Metadata.(Metadata)
+		assert.Equal(t, StateSubmitted, metadata.State)
+	})
+
+	t.Run("user not allowed -> error", func(t *testing.T) {
+		ctx, _, stateCtx := newCtx(&contexts.AuthContext{User: &core.User{ID: "user-2"}}, map[string]any{"version": "v1"})
+		require.ErrorContains(t, component.HandleAction(ctx), "not allowed")
+		assert.False(t, stateCtx.Finished)
+	})
+
+	t.Run("invalid values -> error", func(t *testing.T) {
+		ctx, _, stateCtx := newCtx(allowed, map[string]any{"severity": "sev3"})
+		require.ErrorContains(t, component.HandleAction(ctx), "field 'version' is required")
+		assert.False(t, stateCtx.Finished)
+
+		ctx, _, _ = newCtx(allowed, map[string]any{"version": "v1", "replicas": 0})
+		require.ErrorContains(t, component.HandleAction(ctx), "must be at least 1")
+	})
+
+	t.Run("already submitted -> error", func(t *testing.T) {
+		ctx, metadataCtx, _ := newCtx(allowed, map[string]any{"version": "v1"})
+		metadataCtx.Metadata = Metadata{State: StateSubmitted}
+		require.ErrorContains(t, component.HandleAction(ctx), "already submitted")
+	})
+}
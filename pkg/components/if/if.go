@@ -291,3 +291,7 @@ func (f *If) HandleWebhook(ctx core.WebhookRequestContext) (int, error) {
 func (f *If) Cleanup(ctx core.SetupContext) error {
 	return nil
 }
+
+func (f *If) SideEffectFree() bool {
+	return true
+}
@@ -542,3 +542,7 @@ func durationFrom(value int, unit string) time.Duration {
 func (m *Merge) Cleanup(ctx core.SetupContext) error {
 	return nil
 }
+
+func (m *Merge) SideEffectFree() bool {
+	return true
+}
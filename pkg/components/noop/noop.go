@@ -96,3 +96,7 @@ func (c *NoOp) HandleWebhook(ctx core.WebhookRequestContext) (int, error) {
 func (c *NoOp) Cleanup(ctx core.SetupContext) error {
 	return nil
 }
+
+func (c *NoOp) SideEffectFree() bool {
+	return true
+}
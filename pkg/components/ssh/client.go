@@ -4,9 +4,11 @@ import (
 	"bytes"
 	"encoding/base64"
 	"fmt"
+	"io"
 	"strings"
 	"time"
 
+	"github.com/superplanehq/superplane/pkg/core"
 	"golang.org/x/crypto/ssh"
 )
 
@@ -32,9 +34,10 @@ type Client struct {
 }
 
 type CommandResult struct {
-	Stdout   string `json:"stdout"`
-	Stderr   string `json:"stderr"`
-	ExitCode int    `json:"exitCode"`
+	Stdout    string          `json:"stdout"`
+	Stderr    string          `json:"stderr"`
+	ExitCode  int             `json:"exitCode"`
+	Artifacts []core.Artifact `json:"artifacts,omitempty"`
 }
 
 func NewClientKey(host string, port int, username string, privateKey, passphrase []byte) *Client {
@@ -138,7 +141,10 @@ func parseSigner(keyBytes []byte, passphrase []byte) (ssh.Signer, error) {
 	return ssh.ParsePrivateKey(keyBytes)
 }
 
-func (c *Client) ExecuteCommand(command string, timeout time.Duration) (*CommandResult, error) {
+// ExecuteCommand runs the command and collects its output.
+// If stdoutWriter / stderrWriter are given, the output is also
+// written to them while the command is running.
+func (c *Client) ExecuteCommand(command string, timeout time.Duration, stdoutWriter, stderrWriter io.Writer) (*CommandResult, error) {
 	conn, err := c.Connect()
 	if err != nil {
 		return nil, err
@@ -151,8 +157,8 @@ func (c *Client) ExecuteCommand(command string, timeout time.Duration) (*Command
 	defer session.Close()
 
 	var stdout, stderr bytes.Buffer
-	session.Stdout = &stdout
-	session.Stderr = &stderr
+	session.Stdout = teeWriter(&stdout, stdoutWriter)
+	session.Stderr = teeWriter(&stderr, stderrWriter)
 
 	if timeout > 0 {
 		go func() {
@@ -182,6 +188,102 @@ func (c *Client) ExecuteCommand(command string, timeout time.Duration) (*Command
 	}, nil
 }
 
+// ReadFile returns the contents of a file on the remote host.
+// Relative paths are resolved from the working directory, if one is given.
+func (c *Client) ReadFile(path string, workingDirectory string, maxSize int, timeout time.Duration) ([]byte, error) {
+	command := fmt.Sprintf("head -c %d -- %s", maxSize+1, shellQuote(path))
+	if workingDirectory != "" {
+		command = fmt.Sprintf("cd %s && %s", shellQuote(workingDirectory), command)
+	}
+
+	conn, err := c.Connect()
+	if err != nil {
+		return nil, err
+	}
+
+	session, err := conn.NewSession()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create session: %w", err)
+	}
+	defer session.Close()
+
+	var stdout, stderr bytes.Buffer
+	session.Stdout = &stdout
+	session.Stderr = &stderr
+
+	if timeout > 0 {
+		go func() {
+			time.Sleep(timeout)
+			_ = session.Close()
+		}()
+	}
+
+	err = session.Run(command)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %s", path, strings.TrimSpace(stderr.String()+" "+err.Error()))
+	}
+
+	if stdout.Len() > maxSize {
+		return nil, fmt.Errorf("%s is larger than %d bytes", path, maxSize)
+	}
+
+	return stdout.Bytes(), nil
+}
+
+func shellQuote(value string) string {
+	return "'" + strings.ReplaceAll(value, "'", `'"'"'`) + "'"
+}
+
+func teeWriter(buffer *bytes.Buffer, writer io.Writer) io.Writer {
+	if writer == nil {
+		return buffer
+	}
+
+	return io.MultiWriter(buffer, writer)
+}
+
+// lineWriter forwards output written to it line by line.
+// Call Flush() when done writing, to forward any incomplete last line.
+type lineWriter struct {
+	write func(line string) error
+	buf   []byte
+}
+
+func newLineWriter(write func(line string) error) *lineWriter {
+	return &lineWriter{write: write}
+}
+
+func (w *lineWriter) Write(p []byte) (int, error) {
+	w.buf = append(w.buf, p...)
+
+	for {
+		i := bytes.IndexByte(w.buf, '\n')
+		if i < 0 {
+			break
+		}
+
+		line := strings.TrimSuffix(string(w.buf[:i]), "\r")
+		w.buf = w.buf[i+1:]
+
+		//
+		// Failing to forward the output should not
+		// affect the command, so errors are ignored here.
+		//
+		_ = w.write(line)
+	}
+
+	return len(p), nil
+}
+
+func (w *lineWriter) Flush() {
+	if len(w.buf) == 0 {
+		return
+	}
+
+	_ = w.write(string(w.buf))
+	w.buf = nil
+}
+
 func (c *Client) Close() error {
 	if c.conn != nil {
 		err := c.conn.Close()
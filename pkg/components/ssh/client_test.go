@@ -0,0 +1,49 @@
+package ssh
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLineWriter_ForwardsCompleteLines(t *testing.T) {
+	lines := []string{}
+	writer := newLineWriter(func(line string) error {
+		lines = append(lines, line)
+		return nil
+	})
+
+	_, err := writer.Write([]byte("first line\nsecond "))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"first line"}, lines)
+
+	_, err = writer.Write([]byte("line\r\nthird"))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"first line", "second line"}, lines)
+
+	writer.Flush()
+	assert.Equal(t, []string{"first line", "second line", "third"}, lines)
+
+	writer.Flush()
+	assert.Len(t, lines, 3)
+}
+
+func TestLineWriter_KeepsBlankLines(t *testing.T) {
+	lines := []string{}
+	writer := newLineWriter(func(line string) error {
+		lines = append(lines, line)
+		return nil
+	})
+
+	_, err := writer.Write([]byte("first\n\n\r\nlast\n"))
+	require.NoError(t, err)
+
+	writer.Flush()
+	assert.Equal(t, []string{"first", "", "", "last"}, lines)
+}
+
+func TestShellQuote(t *testing.T) {
+	assert.Equal(t, `'/tmp/report.xml'`, shellQuote("/tmp/report.xml"))
+	assert.Equal(t, `'it'"'"'s here'`, shellQuote("it's here"))
+}
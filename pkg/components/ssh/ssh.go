@@ -8,6 +8,7 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/mitchellh/mapstructure"
+	"github.com/superplanehq/superplane/pkg/artifacts"
 	"github.com/superplanehq/superplane/pkg/configuration"
 	"github.com/superplanehq/superplane/pkg/core"
 	"github.com/superplanehq/superplane/pkg/registry"
@@ -55,6 +56,12 @@ type Spec struct {
 	WorkingDirectory string               `json:"workingDirectory,omitempty" mapstructure:"workingDirectory"`
 	Timeout          int                  `json:"timeout" mapstructure:"timeout"`
 	ConnectionRetry  *ConnectionRetrySpec `json:"connectionRetry,omitempty" mapstructure:"connectionRetry"`
+	Artifacts        []ArtifactSpec       `json:"artifacts,omitempty" mapstructure:"artifacts"`
+}
+
+type ArtifactSpec struct {
+	Name string `json:"name" mapstructure:"name"`
+	Path string `json:"path" mapstructure:"path"`
 }
 
 type ExecutionMetadata struct {
@@ -70,6 +77,7 @@ type ExecutionMetadata struct {
 	MaxRetries       int                  `json:"maxRetries" mapstructure:"maxRetries"`
 	IntervalSeconds  int                  `json:"intervalSeconds" mapstructure:"intervalSeconds"`
 	Authentication   AuthSpec             `json:"authentication" mapstructure:"authentication"`
+	Artifacts        []ArtifactSpec       `json:"artifacts,omitempty" mapstructure:"artifacts"`
 }
 
 type ConnectionRetryState struct {
@@ -100,6 +108,7 @@ Choose **SSH key** or **Password**, then select the organization Secret and the
 - **Working directory**: Optional; Changes to this directory before running the command.
 - **Timeout (seconds)**: How long the command may run (default 60).
 - **Connection retry** (optional): Enable to retry connecting when the host is not reachable yet (e.g. server still booting). Set number of retries and interval between attempts.
+- **Artifacts** (optional): Files to collect from the remote host after the command runs, e.g. test reports. Each file is attached to the execution under the given name. Files that cannot be read are reported in the execution log.
 
 ## Output
 
@@ -279,6 +288,38 @@ func (c *SSHCommand) Configuration() []configuration.Field {
 				},
 			},
 		},
+		{
+			Name:        "artifacts",
+			Label:       "Artifacts",
+			Type:        configuration.FieldTypeList,
+			Required:    false,
+			Togglable:   true,
+			Description: "Files to collect from the remote host after the command runs",
+			TypeOptions: &configuration.TypeOptions{
+				List: &configuration.ListTypeOptions{
+					ItemLabel: "Artifact",
+					ItemDefinition: &configuration.ListItemDefinition{
+						Type: configuration.FieldTypeObject,
+						Schema: []configuration.Field{
+							{
+								Name:        "name",
+								Type:        configuration.FieldTypeString,
+								Label:       "Name",
+								Required:    true,
+								Placeholder: "junit.xml",
+							},
+							{
+								Name:        "path",
+								Type:        configuration.FieldTypeString,
+								Label:       "Path",
+								Required:    true,
+								Placeholder: "/tmp/reports/junit.xml",
+							},
+						},
+					},
+				},
+			},
+		},
 	}
 }
 
@@ -329,6 +370,12 @@ func (c *SSHCommand) Setup(ctx core.SetupContext) error {
 		}
 	}
 
+	for _, artifact := range spec.Artifacts {
+		if artifact.Name == "" || artifact.Path == "" {
+			return errors.New("artifacts: name and path are required")
+		}
+	}
+
 	return nil
 }
 
@@ -351,6 +398,7 @@ func (c *SSHCommand) Execute(ctx core.ExecutionContext) error {
 		MaxRetries:       spec.ConnectionRetry.Retries,
 		IntervalSeconds:  spec.ConnectionRetry.IntervalSeconds,
 		Authentication:   spec.Authentication,
+		Artifacts:        spec.Artifacts,
 	}
 
 	err = ctx.Metadata.Set(metadata)
@@ -363,6 +411,8 @@ func (c *SSHCommand) Execute(ctx core.ExecutionContext) error {
 		requestsCtx:  ctx.Requests,
 		stateCtx:     ctx.ExecutionState,
 		metadataCtx:  ctx.Metadata,
+		logsCtx:      ctx.Logs,
+		artifactsCtx: ctx.Artifacts,
 		execMetadata: metadata,
 	}
 
@@ -386,6 +436,8 @@ func (c *SSHCommand) HandleAction(ctx core.ActionContext) error {
 			requestsCtx:  ctx.Requests,
 			stateCtx:     ctx.ExecutionState,
 			metadataCtx:  ctx.Metadata,
+			logsCtx:      ctx.Logs,
+			artifactsCtx: ctx.Artifacts,
 			execMetadata: metadata,
 		}
 
@@ -396,10 +448,12 @@ func (c *SSHCommand) HandleAction(ctx core.ActionContext) error {
 }
 
 type ExecuteSSHContext struct {
-	secretsCtx  core.SecretsContext
-	requestsCtx core.RequestContext
-	stateCtx    core.ExecutionStateContext
-	metadataCtx core.MetadataContext
+	secretsCtx   core.SecretsContext
+	requestsCtx  core.RequestContext
+	stateCtx     core.ExecutionStateContext
+	metadataCtx  core.MetadataContext
+	logsCtx      core.ExecutionLogContext
+	artifactsCtx core.ArtifactContext
 
 	execMetadata ExecutionMetadata
 }
@@ -411,7 +465,7 @@ func (c *SSHCommand) executeSSH(ctx ExecuteSSHContext) error {
 	}
 	defer client.Close()
 
-	result, err := client.ExecuteCommand(ctx.execMetadata.Command, time.Duration(ctx.execMetadata.Timeout)*time.Second)
+	result, err := c.executeCommand(client, ctx)
 	if c.isConnectError(err) {
 		if c.shouldRetry(ctx.execMetadata.ConnectionRetry, ctx.metadataCtx) {
 			err = c.incrementRetryCount(ctx.metadataCtx)
@@ -442,6 +496,8 @@ func (c *SSHCommand) executeSSH(ctx ExecuteSSHContext) error {
 		return err
 	}
 
+	result.Artifacts = c.collectArtifacts(client, ctx)
+
 	err = c.setResultMetadata(ctx.metadataCtx, result)
 	if err != nil {
 		return err
@@ -455,6 +511,61 @@ func (c *SSHCommand) executeSSH(ctx ExecuteSSHContext) error {
 	return ctx.stateCtx.Emit(channel, "ssh.command.executed", []any{result})
 }
 
+func (c *SSHCommand) executeCommand(client *Client, ctx ExecuteSSHContext) (*CommandResult, error) {
+	timeout := time.Duration(ctx.execMetadata.Timeout) * time.Second
+	if ctx.logsCtx == nil {
+		return client.ExecuteCommand(ctx.execMetadata.Command, timeout, nil, nil)
+	}
+
+	//
+	// Stream the command output to the execution log,
+	// so it can be followed while the command is running.
+	//
+	stdout := newLineWriter(ctx.logsCtx.Stdout)
+	stderr := newLineWriter(ctx.logsCtx.Stderr)
+	defer stdout.Flush()
+	defer stderr.Flush()
+
+	return client.ExecuteCommand(ctx.execMetadata.Command, timeout, stdout, stderr)
+}
+
+// collectArtifacts reads the configured files from the remote host
+// and attaches them to the execution. A file that cannot be collected
+// does not fail the execution, since the command itself already ran.
+func (c *SSHCommand) collectArtifacts(client *Client, ctx ExecuteSSHContext) []core.Artifact {
+	if ctx.artifactsCtx == nil || len(ctx.execMetadata.Artifacts) == 0 {
+		return nil
+	}
+
+	timeout := time.Duration(ctx.execMetadata.Timeout) * time.Second
+	collected := []core.Artifact{}
+	for _, spec := range ctx.execMetadata.Artifacts {
+		content, err := client.ReadFile(spec.Path, ctx.execMetadata.WorkingDirectory, artifacts.MaxArtifactSize, timeout)
+		if err != nil {
+			c.logArtifactError(ctx, spec, err)
+			continue
+		}
+
+		artifact, err := ctx.artifactsCtx.Put(spec.Name, "", content)
+		if err != nil {
+			c.logArtifactError(ctx, spec, err)
+			continue
+		}
+
+		collected = append(collected, *artifact)
+	}
+
+	return collected
+}
+
+func (c *SSHCommand) logArtifactError(ctx ExecuteSSHContext, spec ArtifactSpec, err error) {
+	if ctx.logsCtx == nil {
+		return
+	}
+
+	_ = ctx.logsCtx.Stderr(fmt.Sprintf("failed to collect artifact %s: %v", spec.Name, err))
+}
+
 func (c *SSHCommand) shouldRetry(retrySpec *ConnectionRetrySpec, metadata core.MetadataContext) bool {
 	if retrySpec == nil || !retrySpec.Enabled {
 		return false
@@ -0,0 +1,424 @@
+package configuration
+
+import (
+	"fmt"
+	"maps"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+/*
+ * Field types that can be used in user-facing forms,
+ * e.g. the inputs of a manual run, or the fields
+ * of a form a human needs to fill in during a run.
+ */
+var FormFieldTypes = []string{
+	FieldTypeString,
+	FieldTypeText,
+	FieldTypeNumber,
+	FieldTypeBool,
+	FieldTypeSelect,
+	FieldTypeGitRef,
+	FieldTypeIntegrationResource,
+	FieldTypeUser,
+}
+
+var formFieldNameRegex = regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_]*$`)
+
+/*
+ * FormField is a user-defined form field, declared in the configuration of a node.
+ * It is converted into a Field for validation and rendering.
+ */
+type FormField struct {
+	Name          string   `json:"name" mapstructure:"name"`
+	Label         string   `json:"label" mapstructure:"label"`
+	Type          string   `json:"type" mapstructure:"type"`
+	Description   string   `json:"description" mapstructure:"description"`
+	Required      bool     `json:"required" mapstructure:"required"`
+	Default       any      `json:"default" mapstructure:"default"`
+	Options       []string `json:"options" mapstructure:"options"`
+	Min           *int     `json:"min" mapstructure:"min"`
+	Max           *int     `json:"max" mapstructure:"max"`
+	IntegrationID string   `json:"integrationId" mapstructure:"integrationId"`
+	ResourceType  string   `json:"resourceType" mapstructure:"resourceType"`
+}
+
+/*
+ * FormFieldSchema returns the schema used to declare form fields
+ * in the configuration of a node, as items of a list field.
+ */
+func FormFieldSchema() []Field {
+	typeOptions := make([]FieldOption, 0, len(FormFieldTypes))
+	for _, t := range FormFieldTypes {
+		typeOptions = append(typeOptions, FieldOption{Label: t, Value: t})
+	}
+
+	return []Field{
+		{
+			Name:        "name",
+			Label:       "Name",
+			Type:        FieldTypeString,
+			Required:    true,
+			Description: "Key used for the value in the event payload",
+		},
+		{
+			Name:  "label",
+			Label: "Label",
+			Type:  FieldTypeString,
+		},
+		{
+			Name:     "type",
+			Label:    "Type",
+			Type:     FieldTypeSelect,
+			Required: true,
+			Default:  FieldTypeString,
+			TypeOptions: &TypeOptions{
+				Select: &SelectTypeOptions{Options: typeOptions},
+			},
+		},
+		{
+			Name:  "description",
+			Label: "Description",
+			Type:  FieldTypeString,
+		},
+		{
+			Name:    "required",
+			Label:   "Required",
+			Type:    FieldTypeBool,
+			Default: false,
+		},
+		{
+			Name:  "default",
+			Label: "Default Value",
+			Type:  FieldTypeString,
+		},
+		{
+			Name:  "options",
+			Label: "Options",
+			Type:  FieldTypeList,
+			TypeOptions: &TypeOptions{
+				List: &ListTypeOptions{
+					ItemLabel:      "Option",
+					ItemDefinition: &ListItemDefinition{Type: FieldTypeString},
+				},
+			},
+			VisibilityConditions: []VisibilityCondition{
+				{Field: "type", Values: []string{FieldTypeSelect}},
+			},
+			RequiredConditions: []RequiredCondition{
+				{Field: "type", Values: []string{FieldTypeSelect}},
+			},
+		},
+		{
+			Name:  "min",
+			Label: "Minimum",
+			Type:  FieldTypeNumber,
+			VisibilityConditions: []VisibilityCondition{
+				{Field: "type", Values: []string{FieldTypeNumber}},
+			},
+		},
+		{
+			Name:  "max",
+			Label: "Maximum",
+			Type:  FieldTypeNumber,
+			VisibilityConditions: []VisibilityCondition{
+				{Field: "type", Values: []string{FieldTypeNumber}},
+			},
+		},
+		{
+			Name:        "integrationId",
+			Label:       "Integration ID",
+			Type:        FieldTypeString,
+			Description: "Integration used to list the resources",
+			VisibilityConditions: []VisibilityCondition{
+				{Field: "type", Values: []string{FieldTypeIntegrationResource}},
+			},
+			RequiredConditions: []RequiredCondition{
+				{Field: "type", Values: []string{FieldTypeIntegrationResource}},
+			},
+		},
+		{
+			Name:        "resourceType",
+			Label:       "Resource Type",
+			Type:        FieldTypeString,
+			Description: "Type of integration resource, e.g. repository",
+			VisibilityConditions: []VisibilityCondition{
+				{Field: "type", Values: []string{FieldTypeIntegrationResource}},
+			},
+			RequiredConditions: []RequiredCondition{
+				{Field: "type", Values: []string{FieldTypeIntegrationResource}},
+			},
+		},
+	}
+}
+
+/*
+ * Field converts the form field into the field
+ * used to validate its value and render it.
+ */
+func (f *FormField) Field() Field {
+	label := f.Label
+	if label == "" {
+		label = f.Name
+	}
+
+	field := Field{
+		Name:        f.Name,
+		Label:       label,
+		Type:        f.Type,
+		Description: f.Description,
+		Required:    f.Required,
+		Default:     f.Default,
+	}
+
+	switch f.Type {
+	case FieldTypeNumber:
+		if f.Min != nil || f.Max != nil {
+			field.TypeOptions = &TypeOptions{
+				Number: &NumberTypeOptions{Min: f.Min, Max: f.Max},
+			}
+		}
+
+	case FieldTypeSelect:
+		options := make([]FieldOption, 0, len(f.Options))
+		for _, option := range f.Options {
+			options = append(options, FieldOption{Label: option, Value: option})
+		}
+
+		field.TypeOptions = &TypeOptions{
+			Select: &SelectTypeOptions{Options: options},
+		}
+
+	case FieldTypeIntegrationResource:
+		field.TypeOptions = &TypeOptions{
+			Resource: &ResourceTypeOptions{Type: f.ResourceType},
+		}
+	}
+
+	return field
+}
+
+/*
+ * ValidateFormFields validates the form field definitions,
+ * including their default values.
+ */
+func ValidateFormFields(fields []FormField) error {
+	names := map[string]bool{}
+	for index, f := range fields {
+		if f.Name == "" {
+			return fmt.Errorf("field %d: name is required", index)
+		}
+
+		if !formFieldNameRegex.MatchString(f.Name) {
+			return fmt.Errorf("field %s: name must only contain letters, numbers and underscores", f.Name)
+		}
+
+		if names[f.Name] {
+			return fmt.Errorf("field %s: duplicate name", f.Name)
+		}
+
+		names[f.Name] = true
+
+		if !slices.Contains(FormFieldTypes, f.Type) {
+			return fmt.Errorf("field %s: invalid type %q", f.Name, f.Type)
+		}
+
+		if f.Type == FieldTypeSelect && len(f.Options) == 0 {
+			return fmt.Errorf("field %s: select fields require at least one option", f.Name)
+		}
+
+		if f.Type == FieldTypeNumber && f.Min != nil && f.Max != nil && *f.Min > *f.Max {
+			return fmt.Errorf("field %s: min must not be greater than max", f.Name)
+		}
+
+		if f.Type == FieldTypeIntegrationResource && (f.IntegrationID == "" || f.ResourceType == "") {
+			return fmt.Errorf("field %s: integration and resource type are required", f.Name)
+		}
+
+		if f.Default == nil {
+			continue
+		}
+
+		value, err := coerceFormValue(f, f.Default)
+		if err != nil {
+			return fmt.Errorf("field %s: invalid default: %w", f.Name, err)
+		}
+
+		err = ValidateConfiguration([]Field{f.Field()}, map[string]any{f.Name: value})
+		if err != nil {
+			return fmt.Errorf("field %s: invalid default: %w", f.Name, err)
+		}
+	}
+
+	return nil
+}
+
+/*
+ * ValidateFormValues checks the values submitted for a form.
+ * Values given as strings - e.g. from CLI flags - are coerced into the declared type,
+ * missing values are replaced by their defaults, and unknown values are rejected.
+ * The returned map only contains the values of the declared fields.
+ */
+func ValidateFormValues(fields []FormField, values map[string]any) (map[string]any, error) {
+	known := map[string]bool{}
+	for _, f := range fields {
+		known[f.Name] = true
+	}
+
+	for _, name := range slices.Sorted(maps.Keys(values)) {
+		if !known[name] {
+			return nil, fmt.Errorf("unknown field '%s'", name)
+		}
+	}
+
+	result := map[string]any{}
+	converted := make([]Field, 0, len(fields))
+	for _, f := range fields {
+		converted = append(converted, f.Field())
+
+		value, ok := values[f.Name]
+		if !ok || value == nil || value == "" {
+			value = f.Default
+		}
+
+		if value == nil {
+			continue
+		}
+
+		coerced, err := coerceFormValue(f, value)
+		if err != nil {
+			return nil, fmt.Errorf("field '%s': %w", f.Name, err)
+		}
+
+		result[f.Name] = coerced
+	}
+
+	if err := ValidateConfiguration(converted, result); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+/*
+ * ResourceLister lists the IDs of the resources of a type in an integration.
+ */
+type ResourceLister interface {
+	ListResourceIDs(integrationID, resourceType string) ([]string, error)
+}
+
+/*
+ * ValidateFormResources checks that the values of integration resource fields
+ * are resources of the integration and type declared by the field.
+ * It runs after ValidateFormValues, since listing resources calls the integration.
+ */
+func ValidateFormResources(fields []FormField, values map[string]any, resources ResourceLister) error {
+	for _, f := range fields {
+		if f.Type != FieldTypeIntegrationResource {
+			continue
+		}
+
+		value, ok := values[f.Name].(string)
+		if !ok || value == "" {
+			continue
+		}
+
+		if resources == nil {
+			return fmt.Errorf("field '%s': integration resources are not available", f.Name)
+		}
+
+		ids, err := resources.ListResourceIDs(f.IntegrationID, f.ResourceType)
+		if err != nil {
+			return fmt.Errorf("field '%s': failed to list %s resources: %w", f.Name, f.ResourceType, err)
+		}
+
+		if !slices.Contains(ids, value) {
+			return fmt.Errorf("field '%s': %s is not a %s of the integration", f.Name, value, f.ResourceType)
+		}
+	}
+
+	return nil
+}
+
+/*
+ * MemberFinder checks that a user is an active member of the organization.
+ */
+type MemberFinder interface {
+	IsActiveMember(userID string) (bool, error)
+}
+
+/*
+ * ValidateFormUsers checks that the values of user fields
+ * are IDs of active members of the organization.
+ * Like ValidateFormResources, it runs after ValidateFormValues.
+ */
+func ValidateFormUsers(fields []FormField, values map[string]any, members MemberFinder) error {
+	for _, f := range fields {
+		if f.Type != FieldTypeUser {
+			continue
+		}
+
+		value, ok := values[f.Name].(string)
+		if !ok || value == "" {
+			continue
+		}
+
+		if members == nil {
+			return fmt.Errorf("field '%s': organization members are not available", f.Name)
+		}
+
+		member, err := members.IsActiveMember(value)
+		if err != nil {
+			return fmt.Errorf("field '%s': failed to find user %s: %w", f.Name, value, err)
+		}
+
+		if !member {
+			return fmt.Errorf("field '%s': %s is not a member of the organization", f.Name, value)
+		}
+	}
+
+	return nil
+}
+
+func coerceFormValue(f FormField, value any) (any, error) {
+	s, isString := value.(string)
+
+	switch f.Type {
+	case FieldTypeNumber:
+		switch v := value.(type) {
+		case int:
+			return float64(v), nil
+		case int64:
+			return float64(v), nil
+		case float64:
+			return v, nil
+		}
+
+		if !isString {
+			return nil, fmt.Errorf("must be a number")
+		}
+
+		n, err := strconv.ParseFloat(strings.TrimSpace(s), 64)
+		if err != nil {
+			return nil, fmt.Errorf("must be a number")
+		}
+
+		return n, nil
+
+	case FieldTypeBool:
+		if !isString {
+			return value, nil
+		}
+
+		b, err := strconv.ParseBool(strings.TrimSpace(s))
+		if err != nil {
+			return nil, fmt.Errorf("must be a boolean")
+		}
+
+		return b, nil
+	}
+
+	return value, nil
+}
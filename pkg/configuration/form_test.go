@@ -0,0 +1,95 @@
+package configuration
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type resourceLister map[string][]string
+
+func (l resourceLister) ListResourceIDs(integrationID, resourceType string) ([]string, error) {
+	ids, ok := l[integrationID+"/"+resourceType]
+	if !ok {
+		return nil, fmt.Errorf("integration %s not found", integrationID)
+	}
+
+	return ids, nil
+}
+
+func Test__ValidateFormResources(t *testing.T) {
+	fields := []FormField{
+		{Name: "environment", Type: FieldTypeString},
+		{Name: "repository", Type: FieldTypeIntegrationResource, IntegrationID: "github", ResourceType: "repository"},
+	}
+
+	resources := resourceLister{"github/repository": {"superplane", "docs"}}
+
+	t.Run("resource of the integration -> ok", func(t *testing.T) {
+		err := ValidateFormResources(fields, map[string]any{"environment": "x", "repository": "docs"}, resources)
+		require.NoError(t, err)
+	})
+
+	t.Run("resource not listed by the integration -> error", func(t *testing.T) {
+		err := ValidateFormResources(fields, map[string]any{"repository": "other"}, resources)
+		assert.ErrorContains(t, err, "other is not a repository of the integration")
+	})
+
+	t.Run("integration not found -> error", func(t *testing.T) {
+		err := ValidateFormResources(fields, map[string]any{"repository": "docs"}, resourceLister{})
+		assert.ErrorContains(t, err, "failed to list repository resources")
+	})
+
+	t.Run("no resource values -> resources are not listed", func(t *testing.T) {
+		err := ValidateFormResources(fields, map[string]any{"environment": "x"}, nil)
+		require.NoError(t, err)
+	})
+
+	t.Run("no lister -> error", func(t *testing.T) {
+		err := ValidateFormResources(fields, map[string]any{"repository": "docs"}, nil)
+		assert.ErrorContains(t, err, "integration resources are not available")
+	})
+}
+
+type memberFinder []string
+
+func (f memberFinder) IsActiveMember(userID string) (bool, error) {
+	for _, id := range f {
+		if id == userID {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+func Test__ValidateFormUsers(t *testing.T) {
+	fields := []FormField{
+		{Name: "environment", Type: FieldTypeString},
+		{Name: "reviewer", Type: FieldTypeUser},
+	}
+
+	members := memberFinder{"user-1", "user-2"}
+
+	t.Run("active member -> ok", func(t *testing.T) {
+		err := ValidateFormUsers(fields, map[string]any{"environment": "x", "reviewer": "user-2"}, members)
+		require.NoError(t, err)
+	})
+
+	t.Run("not a member -> error", func(t *testing.T) {
+		err := ValidateFormUsers(fields, map[string]any{"reviewer": "user-3"}, members)
+		assert.ErrorContains(t, err, "user-3 is not a member of the organization")
+	})
+
+	t.Run("no user values -> members are not checked", func(t *testing.T) {
+		err := ValidateFormUsers(fields, map[string]any{"environment": "x"}, nil)
+		require.NoError(t, err)
+	})
+
+	t.Run("no finder -> error", func(t *testing.T) {
+		err := ValidateFormUsers(fields, map[string]any{"reviewer": "user-1"}, nil)
+		assert.ErrorContains(t, err, "organization members are not available")
+	})
+}
@@ -12,6 +12,17 @@ import (
 
 var DefaultOutputChannel = OutputChannel{Name: "default", Label: "Default"}
 
+/*
+ * Built-in output channel available on every node.
+ * When enabled on the node, failed executions emit
+ * an event with the failure reason and input here.
+ */
+var ErrorOutputChannel = OutputChannel{
+	Name:        "error",
+	Label:       "Error",
+	Description: "Emitted when an execution of the node fails",
+}
+
 var ErrSecretKeyNotFound = errors.New("secret or key not found")
 
 type Component interface {
@@ -123,6 +134,21 @@ type Component interface {
 	Cleanup(ctx SetupContext) error
 }
 
+/*
+ * Optional interface for components without side effects,
+ * which only route and transform events, and don't reach
+ * anything outside of the canvas. Canvas tests run these components
+ * for real, and require a mocked output for every other one.
+ */
+type SideEffectFreeComponent interface {
+	SideEffectFree() bool
+}
+
+func IsSideEffectFree(component Component) bool {
+	c, ok := component.(SideEffectFreeComponent)
+	return ok && c.SideEffectFree()
+}
+
 type OutputChannel struct {
 	Name        string
 	Label       string
@@ -144,6 +170,8 @@ type ExecutionContext struct {
 	Configuration  any
 	ExpressionEnv  func(expression string) (map[string]any, error)
 	Logger         *log.Entry
+	Logs           ExecutionLogContext
+	Artifacts      ArtifactContext
 	HTTP           HTTPContext
 	Metadata       MetadataContext
 	NodeMetadata   MetadataContext
@@ -215,11 +243,52 @@ type ExecutionStateContext interface {
 
 	/*
 	 * Fails the execution.
-	 * No payloads are emitted.
+	 * No payloads are emitted by the component, but the failure
+	 * is emitted on the error channel, if the node has it enabled.
 	 */
 	Fail(reason, message string) error
 }
 
+/*
+ * ExecutionLogContext allows components to write output
+ * to the execution log, which is visible to users.
+ * Everything written to the execution Logger ends up here too.
+ */
+type ExecutionLogContext interface {
+
+	//
+	// Writes standard output produced by the execution, e.g. a command's stdout.
+	//
+	Stdout(message string) error
+
+	//
+	// Writes error output produced by the execution, e.g. a command's stderr.
+	//
+	Stderr(message string) error
+}
+
+/*
+ * ArtifactContext allows components to attach files to the execution,
+ * like test reports, build logs or generated changelogs.
+ * Downstream nodes can reference them in expressions,
+ * and users can list and download them through the API.
+ */
+type ArtifactContext interface {
+
+	//
+	// Stores an artifact for the execution.
+	// Storing an artifact with the same name again replaces it.
+	//
+	Put(name string, contentType string, content []byte) (*Artifact, error)
+}
+
+type Artifact struct {
+	Name        string `json:"name"`
+	ContentType string `json:"contentType"`
+	Size        int64  `json:"size"`
+	SHA256      string `json:"sha256"`
+}
+
 /*
  * RequestContext allows the execution to schedule
  * work with the processing engine.
@@ -251,6 +320,8 @@ type ActionContext struct {
 	Configuration  any
 	Parameters     map[string]any
 	Logger         *log.Entry
+	Logs           ExecutionLogContext
+	Artifacts      ArtifactContext
 	HTTP           HTTPContext
 	Metadata       MetadataContext
 	ExecutionState ExecutionStateContext
@@ -259,6 +330,9 @@ type ActionContext struct {
 	Integration    IntegrationContext
 	Notifications  NotificationContext
 	Secrets        SecretsContext
+
+	IntegrationResources IntegrationResourceContext
+	Members              OrganizationMemberContext
 }
 
 /*
@@ -318,6 +392,14 @@ type AuthContext interface {
 	InGroup(group string) (bool, error)
 }
 
+/*
+ * OrganizationMemberContext checks that users are active members
+ * of the organization, like the values of the user fields of forms.
+ */
+type OrganizationMemberContext interface {
+	IsActiveMember(userID string) (bool, error)
+}
+
 type NotificationReceivers struct {
 	Emails []string
 	Groups []string
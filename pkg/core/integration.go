@@ -162,6 +162,15 @@ type ListResourcesContext struct {
 	Parameters  map[string]string
 }
 
+/*
+ * IntegrationResourceContext lists the resources of any integration
+ * of the organization, to check values that reference them,
+ * like the integration resource fields of forms.
+ */
+type IntegrationResourceContext interface {
+	ListResourceIDs(integrationID, resourceType string) ([]string, error)
+}
+
 type WebhookOptions struct {
 	ID            string
 	URL           string
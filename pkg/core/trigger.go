@@ -81,6 +81,43 @@ type Trigger interface {
 	Cleanup(ctx TriggerContext) error
 }
 
+/*
+ * InputTrigger is implemented by triggers that accept
+ * user-provided input when they are started manually.
+ */
+type InputTrigger interface {
+
+	/*
+	 * Inherits all the methods from Trigger interface.
+	 */
+	Trigger
+
+	/*
+	 * Validate the input given for a manual run against the trigger configuration.
+	 * Returns the normalized input, with defaults applied and values coerced
+	 * to the declared types, which is used as the payload of the emitted event.
+	 */
+	ValidateInput(configuration any, input map[string]any) (map[string]any, error)
+}
+
+/*
+ * FormInputTrigger is implemented by input triggers whose input is a form,
+ * so the values of its integration resource fields can be checked
+ * against the integrations of the organization.
+ */
+type FormInputTrigger interface {
+
+	/*
+	 * Inherits all the methods from InputTrigger interface.
+	 */
+	InputTrigger
+
+	/*
+	 * The form fields declared in the trigger configuration.
+	 */
+	InputFields(configuration any) ([]configuration.FormField, error)
+}
+
 type TriggerContext struct {
 	Logger        *log.Entry
 	Configuration any
@@ -6,12 +6,19 @@ import (
 	"fmt"
 )
 
-func VerifySignature(key []byte, data []byte, signature string) error {
+//
+// Sign returns the hex-encoded HMAC-SHA256 of data.
+//
+
+func Sign(key []byte, data []byte) string {
 	h := hmac.New(sha256.New, key)
 	h.Write(data)
+	return fmt.Sprintf("%x", h.Sum(nil))
+}
 
-	computed := fmt.Sprintf("%x", h.Sum(nil))
-	if computed != signature {
+func VerifySignature(key []byte, data []byte, signature string) error {
+	computed := Sign(key, data)
+	if !hmac.Equal([]byte(computed), []byte(signature)) {
 		return fmt.Errorf("invalid signature")
 	}
 
@@ -22,3 +22,13 @@ func Test__VerifySignature(t *testing.T) {
 		require.Error(t, VerifySignature(key, data, signature))
 	})
 }
+
+func Test__Sign(t *testing.T) {
+	key := []byte("secret key")
+	data := []byte("data to sign")
+
+	signature := Sign(key, data)
+	require.Equal(t, "246df9c6ede92636184fbcf4f03abe33216384885bd018e882870ee3c869967e", signature)
+	require.NoError(t, VerifySignature(key, data, signature))
+	require.Error(t, VerifySignature([]byte("other key"), data, signature))
+}
@@ -0,0 +1,341 @@
+package dora
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"math"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/superplanehq/superplane/pkg/models"
+)
+
+const (
+	IntervalDay  = 24 * time.Hour
+	IntervalWeek = 7 * IntervalDay
+)
+
+//
+// Metrics are the four DORA metrics for a period:
+//
+// - Deployment frequency: successful deployments per day.
+// - Lead time for changes: median time from the commit to its successful deployment.
+// - Change failure rate: share of deployments followed by an incident.
+// - MTTR: mean time from the start of an incident until it is resolved.
+//
+// FailedDeployments are the deployments followed by an incident,
+// not the deployment executions that failed.
+//
+
+type Metrics struct {
+	Start                     time.Time
+	End                       time.Time
+	Deployments               int64
+	FailedDeployments         int64
+	DeploymentFrequencyPerDay float64
+	LeadTimeSeconds           float64
+	ChangeFailureRate         float64
+	Incidents                 int64
+	MTTRSeconds               float64
+}
+
+type period struct {
+	metrics   Metrics
+	leadTimes []float64
+	repairs   []float64
+}
+
+//
+// Compute returns the metrics for the whole time range,
+// and a time series with the metrics for each interval in it.
+// The last interval ends at the end of the time range, so it might be shorter.
+//
+// Deployments are the passed executions of deployment nodes. The lead time goes from
+// the commit timestamp in the root event to the end of the execution, and from
+// the root event itself if the commit timestamp field is not set or not found.
+// Failed executions never reached production, so they are not deployments.
+//
+// Incidents are the passed executions of incident nodes. They start at the started at field
+// of the root event, or the root event itself, and are resolved at the resolved at field,
+// or at the end of the execution. Failed executions don't tell when the incident was resolved,
+// so they are left out of MTTR.
+//
+// A deployment failed if an incident started after it, and before the next deployment.
+// Only incidents in the time range are considered.
+//
+
+func Compute(settings *models.CanvasDoraSettings, executions []models.DoraExecution, from, to time.Time, interval time.Duration) (Metrics, []Metrics) {
+	deploymentNodes := map[string]models.DoraDeploymentNode{}
+	for _, node := range settings.DeploymentNodes {
+		deploymentNodes[node.NodeID] = node
+	}
+
+	incidentNodes := map[string]models.DoraIncidentNode{}
+	for _, node := range settings.IncidentNodes {
+		incidentNodes[node.NodeID] = node
+	}
+
+	summary := &period{metrics: Metrics{Start: from, End: to}}
+	periods := []*period{}
+	for start := from; start.Before(to); start = start.Add(interval) {
+		end := start.Add(interval)
+		if end.After(to) {
+			end = to
+		}
+
+		periods = append(periods, &period{metrics: Metrics{Start: start, End: end}})
+	}
+
+	deployments := []*deployment{}
+	incidentStarts := []time.Time{}
+
+	for _, execution := range executions {
+		if execution.FinishedAt.Before(from) || !execution.FinishedAt.Before(to) {
+			continue
+		}
+
+		if execution.Result != models.CanvasNodeExecutionResultPassed {
+			continue
+		}
+
+		p := periods[int(execution.FinishedAt.Sub(from)/interval)]
+		data := execution.RootEventData.Data()
+
+		if node, ok := deploymentNodes[execution.NodeID]; ok {
+			committedAt := execution.RootEventCreatedAt
+			if t, ok := FieldTime(data, node.CommitTimestampField); ok {
+				committedAt = t
+			}
+
+			leadTime := execution.FinishedAt.Sub(committedAt).Seconds()
+			summary.addDeployment(leadTime)
+			p.addDeployment(leadTime)
+			deployments = append(deployments, &deployment{finishedAt: execution.FinishedAt, period: p})
+			continue
+		}
+
+		if node, ok := incidentNodes[execution.NodeID]; ok {
+			startedAt := execution.RootEventCreatedAt
+			if t, ok := FieldTime(data, node.StartedAtField); ok {
+				startedAt = t
+			}
+
+			resolvedAt := execution.FinishedAt
+			if t, ok := FieldTime(data, node.ResolvedAtField); ok {
+				resolvedAt = t
+			}
+
+			repair := resolvedAt.Sub(startedAt).Seconds()
+			summary.addIncident(repair)
+			p.addIncident(repair)
+			incidentStarts = append(incidentStarts, startedAt)
+		}
+	}
+
+	for _, d := range failedDeployments(deployments, incidentStarts) {
+		summary.metrics.FailedDeployments++
+		d.period.metrics.FailedDeployments++
+	}
+
+	series := make([]Metrics, 0, len(periods))
+	for _, p := range periods {
+		series = append(series, p.finish())
+	}
+
+	return summary.finish(), series
+}
+
+type deployment struct {
+	finishedAt time.Time
+	period     *period
+}
+
+//
+// failedDeployments returns the deployments followed by an incident:
+// the last deployment before each incident started.
+// A deployment followed by several incidents fails only once.
+//
+
+func failedDeployments(deployments []*deployment, incidentStarts []time.Time) []*deployment {
+	slices.SortStableFunc(deployments, func(a, b *deployment) int {
+		return a.finishedAt.Compare(b.finishedAt)
+	})
+
+	failed := map[*deployment]bool{}
+	result := []*deployment{}
+	for _, startedAt := range incidentStarts {
+		i := sort.Search(len(deployments), func(i int) bool {
+			return deployments[i].finishedAt.After(startedAt)
+		})
+
+		if i == 0 || failed[deployments[i-1]] {
+			continue
+		}
+
+		failed[deployments[i-1]] = true
+		result = append(result, deployments[i-1])
+	}
+
+	return result
+}
+
+func (p *period) addDeployment(leadTime float64) {
+	p.metrics.Deployments++
+	p.leadTimes = append(p.leadTimes, math.Max(leadTime, 0))
+}
+
+func (p *period) addIncident(repair float64) {
+	p.metrics.Incidents++
+	p.repairs = append(p.repairs, math.Max(repair, 0))
+}
+
+func (p *period) finish() Metrics {
+	m := p.metrics
+
+	days := m.End.Sub(m.Start).Hours() / 24
+	if days > 0 {
+		m.DeploymentFrequencyPerDay = float64(m.Deployments) / days
+	}
+
+	if m.Deployments > 0 {
+		m.ChangeFailureRate = float64(m.FailedDeployments) / float64(m.Deployments)
+	}
+
+	m.LeadTimeSeconds = median(p.leadTimes)
+	m.MTTRSeconds = mean(p.repairs)
+	return m
+}
+
+func median(values []float64) float64 {
+	if len(values) == 0 {
+		return 0
+	}
+
+	sorted := slices.Clone(values)
+	slices.Sort(sorted)
+
+	middle := len(sorted) / 2
+	if len(sorted)%2 == 1 {
+		return sorted[middle]
+	}
+
+	return (sorted[middle-1] + sorted[middle]) / 2
+}
+
+func mean(values []float64) float64 {
+	if len(values) == 0 {
+		return 0
+	}
+
+	sum := 0.0
+	for _, value := range values {
+		sum += value
+	}
+
+	return sum / float64(len(values))
+}
+
+//
+// FieldTime reads a timestamp from a dot-separated path in the event data,
+// like data.head_commit.timestamp or data.commits.0.timestamp.
+// Strings are parsed as RFC 3339, and numbers as Unix timestamps in seconds or milliseconds.
+//
+
+func FieldTime(data any, path string) (time.Time, bool) {
+	if path == "" {
+		return time.Time{}, false
+	}
+
+	value := data
+	for _, key := range strings.Split(path, ".") {
+		switch v := value.(type) {
+		case map[string]any:
+			value = v[key]
+		case []any:
+			i, err := strconv.Atoi(key)
+			if err != nil || i < 0 || i >= len(v) {
+				return time.Time{}, false
+			}
+
+			value = v[i]
+		default:
+			return time.Time{}, false
+		}
+	}
+
+	switch v := value.(type) {
+	case string:
+		t, err := time.Parse(time.RFC3339Nano, v)
+		if err != nil {
+			return time.Time{}, false
+		}
+
+		return t, true
+	case float64:
+		if v > 1e12 {
+			return time.UnixMilli(int64(v)), true
+		}
+
+		return time.Unix(int64(v), 0), true
+	}
+
+	return time.Time{}, false
+}
+
+func ValidateField(path string) error {
+	if path == "" {
+		return nil
+	}
+
+	for _, key := range strings.Split(path, ".") {
+		if strings.TrimSpace(key) == "" {
+			return fmt.Errorf("invalid field %q", path)
+		}
+	}
+
+	return nil
+}
+
+func WriteCSV(w io.Writer, series []Metrics) error {
+	writer := csv.NewWriter(w)
+	err := writer.Write([]string{
+		"period_start",
+		"period_end",
+		"deployments",
+		"failed_deployments",
+		"deployment_frequency_per_day",
+		"lead_time_seconds",
+		"change_failure_rate",
+		"incidents",
+		"mttr_seconds",
+	})
+
+	if err != nil {
+		return err
+	}
+
+	for _, m := range series {
+		err := writer.Write([]string{
+			m.Start.UTC().Format(time.RFC3339),
+			m.End.UTC().Format(time.RFC3339),
+			strconv.FormatInt(m.Deployments, 10),
+			strconv.FormatInt(m.FailedDeployments, 10),
+			strconv.FormatFloat(m.DeploymentFrequencyPerDay, 'f', 4, 64),
+			strconv.FormatFloat(m.LeadTimeSeconds, 'f', 0, 64),
+			strconv.FormatFloat(m.ChangeFailureRate, 'f', 4, 64),
+			strconv.FormatInt(m.Incidents, 10),
+			strconv.FormatFloat(m.MTTRSeconds, 'f', 0, 64),
+		})
+
+		if err != nil {
+			return err
+		}
+	}
+
+	writer.Flush()
+	return writer.Error()
+}
@@ -0,0 +1,185 @@
+package dora
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/models"
+	"gorm.io/datatypes"
+)
+
+func execution(nodeID, result string, finishedAt time.Time, data map[string]any) models.DoraExecution {
+	return models.DoraExecution{
+		NodeID:             nodeID,
+		Result:             result,
+		FinishedAt:         finishedAt,
+		RootEventData:      datatypes.NewJSONType[any](data),
+		RootEventCreatedAt: finishedAt.Add(-time.Minute),
+	}
+}
+
+func Test__Compute(t *testing.T) {
+	from := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := from.Add(2 * IntervalDay)
+
+	settings := &models.CanvasDoraSettings{
+		DeploymentNodes: []models.DoraDeploymentNode{
+			{NodeID: "deploy", CommitTimestampField: "data.commit.timestamp"},
+		},
+		IncidentNodes: []models.DoraIncidentNode{
+			{NodeID: "incident", StartedAtField: "data.startedAt", ResolvedAtField: "data.resolvedAt"},
+		},
+	}
+
+	commit := func(t time.Time) map[string]any {
+		return map[string]any{"data": map[string]any{"commit": map[string]any{"timestamp": t.Format(time.RFC3339)}}}
+	}
+
+	executions := []models.DoraExecution{
+		execution("deploy", models.CanvasNodeExecutionResultPassed, from.Add(time.Hour), commit(from)),
+		execution("deploy", models.CanvasNodeExecutionResultPassed, from.Add(5*time.Hour), commit(from.Add(2*time.Hour))),
+		execution("deploy", models.CanvasNodeExecutionResultFailed, from.Add(6*time.Hour), commit(from)),
+		execution("deploy", models.CanvasNodeExecutionResultPassed, from.Add(30*time.Hour), nil),
+		execution("incident", models.CanvasNodeExecutionResultPassed, from.Add(26*time.Hour), map[string]any{
+			"data": map[string]any{
+				"startedAt":  float64(from.Add(24 * time.Hour).Unix()),
+				"resolvedAt": float64(from.Add(25 * time.Hour).UnixMilli()),
+			},
+		}),
+		execution("incident", models.CanvasNodeExecutionResultFailed, from.Add(40*time.Hour), map[string]any{
+			"data": map[string]any{"startedAt": float64(from.Add(31 * time.Hour).Unix())},
+		}),
+		execution("other", models.CanvasNodeExecutionResultPassed, from.Add(time.Hour), nil),
+	}
+
+	summary, series := Compute(settings, executions, from, to, IntervalDay)
+
+	//
+	// Failed deployment executions are not deployments,
+	// and failed incident executions are not incidents.
+	// The deployment at 5h is followed by the incident that started at 24h.
+	//
+	t.Run("summary", func(t *testing.T) {
+		assert.Equal(t, from, summary.Start)
+		assert.Equal(t, to, summary.End)
+		assert.Equal(t, int64(3), summary.Deployments)
+		assert.Equal(t, int64(1), summary.FailedDeployments)
+		assert.InDelta(t, 1.5, summary.DeploymentFrequencyPerDay, 0.001)
+		assert.InDelta(t, 1.0/3, summary.ChangeFailureRate, 0.001)
+		assert.InDelta(t, time.Hour.Seconds(), summary.LeadTimeSeconds, 0.001)
+		assert.Equal(t, int64(1), summary.Incidents)
+		assert.InDelta(t, time.Hour.Seconds(), summary.MTTRSeconds, 0.001)
+	})
+
+	t.Run("series", func(t *testing.T) {
+		require.Len(t, series, 2)
+
+		assert.Equal(t, int64(2), series[0].Deployments)
+		assert.Equal(t, int64(1), series[0].FailedDeployments)
+		assert.InDelta(t, 0.5, series[0].ChangeFailureRate, 0.001)
+		assert.InDelta(t, 2, series[0].DeploymentFrequencyPerDay, 0.001)
+		assert.InDelta(t, 2*time.Hour.Seconds(), series[0].LeadTimeSeconds, 0.001)
+		assert.Zero(t, series[0].Incidents)
+
+		//
+		// Without a commit timestamp, the lead time starts at the root event.
+		//
+		assert.Equal(t, int64(1), series[1].Deployments)
+		assert.Zero(t, series[1].FailedDeployments)
+		assert.InDelta(t, time.Minute.Seconds(), series[1].LeadTimeSeconds, 0.001)
+		assert.Equal(t, int64(1), series[1].Incidents)
+	})
+
+	t.Run("last period is shorter", func(t *testing.T) {
+		_, series := Compute(settings, executions, from, to, IntervalWeek)
+		require.Len(t, series, 1)
+		assert.Equal(t, to, series[0].End)
+		assert.InDelta(t, 1.5, series[0].DeploymentFrequencyPerDay, 0.001)
+	})
+
+	t.Run("deployment followed by several incidents -> failed once", func(t *testing.T) {
+		incident := func(finishedAt, startedAt time.Time) models.DoraExecution {
+			return execution("incident", models.CanvasNodeExecutionResultPassed, finishedAt, map[string]any{
+				"data": map[string]any{"startedAt": startedAt.Format(time.RFC3339)},
+			})
+		}
+
+		summary, _ := Compute(settings, []models.DoraExecution{
+			incident(from.Add(2*time.Hour), from.Add(time.Hour)),
+			execution("deploy", models.CanvasNodeExecutionResultPassed, from.Add(3*time.Hour), nil),
+			incident(from.Add(5*time.Hour), from.Add(4*time.Hour)),
+			incident(from.Add(7*time.Hour), from.Add(6*time.Hour)),
+			execution("deploy", models.CanvasNodeExecutionResultPassed, from.Add(8*time.Hour), nil),
+		}, from, to, IntervalDay)
+
+		assert.Equal(t, int64(2), summary.Deployments)
+		assert.Equal(t, int64(1), summary.FailedDeployments)
+		assert.InDelta(t, 0.5, summary.ChangeFailureRate, 0.001)
+		assert.Equal(t, int64(3), summary.Incidents)
+	})
+
+	t.Run("no settings", func(t *testing.T) {
+		summary, series := Compute(&models.CanvasDoraSettings{}, executions, from, to, IntervalDay)
+		assert.Zero(t, summary.Deployments)
+		assert.Zero(t, summary.Incidents)
+		assert.Len(t, series, 2)
+	})
+}
+
+func Test__FieldTime(t *testing.T) {
+	data := map[string]any{
+		"commits": []any{
+			map[string]any{"timestamp": "2026-01-01T10:00:00Z"},
+		},
+		"seconds": float64(1767261600),
+		"millis":  float64(1767261600000),
+		"invalid": "yesterday",
+	}
+
+	expected := time.Date(2026, 1, 1, 10, 0, 0, 0, time.UTC)
+
+	value, ok := FieldTime(data, "commits.0.timestamp")
+	require.True(t, ok)
+	assert.True(t, expected.Equal(value))
+
+	value, ok = FieldTime(data, "seconds")
+	require.True(t, ok)
+	assert.True(t, expected.Equal(value))
+
+	value, ok = FieldTime(data, "millis")
+	require.True(t, ok)
+	assert.True(t, expected.Equal(value))
+
+	for _, path := range []string{"", "invalid", "missing", "commits.1.timestamp", "commits.x", "seconds.value"} {
+		_, ok := FieldTime(data, path)
+		assert.False(t, ok, path)
+	}
+}
+
+func Test__WriteCSV(t *testing.T) {
+	start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	var buf bytes.Buffer
+
+	require.NoError(t, WriteCSV(&buf, []Metrics{
+		{
+			Start:                     start,
+			End:                       start.Add(IntervalDay),
+			Deployments:               3,
+			FailedDeployments:         1,
+			DeploymentFrequencyPerDay: 3,
+			LeadTimeSeconds:           3600,
+			ChangeFailureRate:         0.25,
+			Incidents:                 1,
+			MTTRSeconds:               1800,
+		},
+	}))
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	require.Len(t, lines, 2)
+	assert.Equal(t, "period_start,period_end,deployments,failed_deployments,deployment_frequency_per_day,lead_time_seconds,change_failure_rate,incidents,mttr_seconds", lines[0])
+	assert.Equal(t, "2026-01-01T00:00:00Z,2026-01-02T00:00:00Z,3,1,3.0000,3600,0.2500,1,1800", lines[1])
+}
@@ -0,0 +1,153 @@
+package canvases
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/authentication"
+	"github.com/superplanehq/superplane/pkg/database"
+	"github.com/superplanehq/superplane/pkg/models"
+	pb "github.com/superplanehq/superplane/pkg/protos/canvases"
+	"github.com/superplanehq/superplane/test/support"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/datatypes"
+)
+
+func Test__CanvasDoraMetrics(t *testing.T) {
+	r := support.Setup(t)
+	ctx := authentication.SetUserIdInMetadata(context.Background(), r.User.String())
+
+	node := func(id string) models.CanvasNode {
+		return models.CanvasNode{
+			NodeID: id,
+			Name:   id,
+			Type:   models.NodeTypeComponent,
+			Ref: datatypes.NewJSONType(models.NodeRef{
+				Component: &models.ComponentRef{Name: "noop"},
+			}),
+		}
+	}
+
+	canvas, _ := support.CreateCanvas(
+		t,
+		r.Organization.ID,
+		r.User,
+		[]models.CanvasNode{node("deploy"), node("incident")},
+		[]models.Edge{},
+	)
+
+	t.Run("canvas without settings has no DORA nodes", func(t *testing.T) {
+		response, err := GetCanvasDoraSettings(ctx, r.Organization.ID.String(), canvas.ID)
+		require.NoError(t, err)
+		assert.Empty(t, response.Settings.DeploymentNodes)
+		assert.Empty(t, response.Settings.IncidentNodes)
+		assert.Nil(t, response.Settings.UpdatedAt)
+	})
+
+	t.Run("node that does not exist -> error", func(t *testing.T) {
+		_, err := UpdateCanvasDoraSettings(ctx, r.Organization.ID.String(), canvas.ID, &pb.DoraSettings{
+			DeploymentNodes: []*pb.DoraSettings_DeploymentNode{{NodeId: "does-not-exist"}},
+		})
+
+		s, ok := status.FromError(err)
+		require.True(t, ok)
+		assert.Equal(t, codes.InvalidArgument, s.Code())
+	})
+
+	t.Run("node that is a deployment and an incident -> error", func(t *testing.T) {
+		_, err := UpdateCanvasDoraSettings(ctx, r.Organization.ID.String(), canvas.ID, &pb.DoraSettings{
+			DeploymentNodes: []*pb.DoraSettings_DeploymentNode{{NodeId: "deploy"}},
+			IncidentNodes:   []*pb.DoraSettings_IncidentNode{{NodeId: "deploy"}},
+		})
+
+		s, ok := status.FromError(err)
+		require.True(t, ok)
+		assert.Equal(t, codes.InvalidArgument, s.Code())
+	})
+
+	t.Run("settings are updated", func(t *testing.T) {
+		response, err := UpdateCanvasDoraSettings(ctx, r.Organization.ID.String(), canvas.ID, &pb.DoraSettings{
+			DeploymentNodes: []*pb.DoraSettings_DeploymentNode{{NodeId: "deploy", CommitTimestampField: "commit.timestamp"}},
+			IncidentNodes:   []*pb.DoraSettings_IncidentNode{{NodeId: "incident", ResolvedAtField: "resolvedAt"}},
+		})
+
+		require.NoError(t, err)
+		require.Len(t, response.Settings.DeploymentNodes, 1)
+		assert.Equal(t, "commit.timestamp", response.Settings.DeploymentNodes[0].CommitTimestampField)
+		require.Len(t, response.Settings.IncidentNodes, 1)
+		assert.Equal(t, "resolvedAt", response.Settings.IncidentNodes[0].ResolvedAtField)
+		assert.NotNil(t, response.Settings.UpdatedAt)
+
+		settings, err := models.FindCanvasDoraSettings(canvas.ID)
+		require.NoError(t, err)
+		require.NotNil(t, settings.UpdatedBy)
+		assert.Equal(t, r.User, *settings.UpdatedBy)
+	})
+
+	now := time.Now()
+	finishExecution := func(nodeID, result string, data map[string]any, finishedAt time.Time) {
+		event := support.EmitCanvasEventForNodeWithData(t, canvas.ID, nodeID, "default", nil, data)
+		execution := support.CreateCanvasNodeExecution(t, canvas.ID, nodeID, event.ID, event.ID, nil)
+		require.NoError(t, database.Conn().Model(execution).Updates(map[string]any{
+			"state":       models.CanvasNodeExecutionStateFinished,
+			"result":      result,
+			"finished_at": finishedAt,
+		}).Error)
+	}
+
+	committedAt := now.Add(-3 * time.Hour).UTC().Format(time.RFC3339)
+	finishExecution("deploy", models.CanvasNodeExecutionResultPassed, map[string]any{"commit": map[string]any{"timestamp": committedAt}}, now.Add(-time.Hour))
+	finishExecution("deploy", models.CanvasNodeExecutionResultFailed, map[string]any{}, now.Add(-time.Hour))
+	finishExecution("deploy", models.CanvasNodeExecutionResultCancelled, map[string]any{}, now.Add(-time.Hour))
+	finishExecution("incident", models.CanvasNodeExecutionResultPassed, map[string]any{}, now.Add(-time.Hour))
+
+	t.Run("returns metrics for the canvas", func(t *testing.T) {
+		response, err := GetCanvasDoraMetrics(ctx, r.Organization.ID.String(), canvas.ID, nil, timestamppb.New(now), pb.DoraInterval_DORA_INTERVAL_UNSPECIFIED)
+		require.NoError(t, err)
+
+		assert.Equal(t, pb.DoraInterval_DORA_INTERVAL_DAY, response.Interval)
+		assert.Len(t, response.Series, 30)
+		assert.Equal(t, int64(1), response.Summary.Deployments)
+		assert.Equal(t, int64(1), response.Summary.FailedDeployments)
+		assert.InDelta(t, 0.5, response.Summary.ChangeFailureRate, 0.001)
+		assert.InDelta(t, 2*time.Hour.Seconds(), response.Summary.LeadTimeSeconds, 1)
+		assert.Equal(t, int64(1), response.Summary.Incidents)
+
+		last := response.Series[len(response.Series)-1]
+		assert.Equal(t, int64(1), last.Deployments)
+		assert.Equal(t, int64(1), last.Incidents)
+	})
+
+	t.Run("exports metrics as CSV", func(t *testing.T) {
+		response, err := ExportCanvasDoraMetrics(ctx, r.Organization.ID.String(), canvas.ID, nil, timestamppb.New(now), pb.DoraInterval_DORA_INTERVAL_WEEK)
+		require.NoError(t, err)
+
+		assert.Equal(t, "text/csv", response.ContentType)
+		assert.Equal(t, "dora-"+canvas.ID.String()+".csv", response.Filename)
+
+		lines := strings.Split(strings.TrimSpace(string(response.Content)), "\n")
+		assert.Len(t, lines, 6)
+		assert.True(t, strings.HasPrefix(lines[0], "period_start,period_end,deployments"))
+	})
+
+	t.Run("invalid time range", func(t *testing.T) {
+		_, err := GetCanvasDoraMetrics(ctx, r.Organization.ID.String(), canvas.ID, timestamppb.New(now.Add(-400*24*time.Hour)), timestamppb.New(now), pb.DoraInterval_DORA_INTERVAL_DAY)
+		s, ok := status.FromError(err)
+		require.True(t, ok)
+		assert.Equal(t, codes.InvalidArgument, s.Code())
+	})
+
+	t.Run("canvas from another organization", func(t *testing.T) {
+		_, err := GetCanvasDoraMetrics(ctx, uuid.NewString(), canvas.ID, nil, nil, pb.DoraInterval_DORA_INTERVAL_DAY)
+		s, ok := status.FromError(err)
+		require.True(t, ok)
+		assert.Equal(t, codes.NotFound, s.Code())
+	})
+}
@@ -0,0 +1,456 @@
+package canvases
+
+import (
+	"encoding/json"
+	"reflect"
+	"slices"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/superplanehq/superplane/pkg/grpc/actions"
+	"github.com/superplanehq/superplane/pkg/models"
+	pb "github.com/superplanehq/superplane/pkg/protos/canvases"
+	"github.com/superplanehq/superplane/pkg/registry"
+)
+
+//
+// PlanCanvasUpdate validates an update of a canvas, and returns what it would do,
+// without changing the canvas, setting up nodes or publishing updates.
+//
+// Nodes are only set up when the update is applied, so webhooks
+// and subscriptions created during setup are estimated from the nodes
+// that would be set up, while the ones removed come from the database.
+//
+
+func PlanCanvasUpdate(
+	registry *registry.Registry,
+	organizationID string,
+	id string,
+	pbCanvas *pb.Canvas,
+	autoLayout *pb.CanvasAutoLayout,
+) (*pb.UpdateCanvasResponse, error) {
+	update, err := prepareCanvasUpdate(registry, organizationID, id, pbCanvas, autoLayout)
+	if err != nil {
+		return nil, err
+	}
+
+	plan, err := buildCanvasPlan(registry, update, pbCanvas.Metadata)
+	if err != nil {
+		return nil, actions.ToStatus(err)
+	}
+
+	canvas := *update.canvas
+	canvas.Name = pbCanvas.Metadata.Name
+	canvas.Description = pbCanvas.Metadata.Description
+	canvas.Nodes = update.nodes
+	canvas.Edges = update.edges
+
+	protoCanvas, err := SerializeCanvas(&canvas, false)
+	if err != nil {
+		return nil, actions.ToStatus(err)
+	}
+
+	return &pb.UpdateCanvasResponse{
+		Canvas: protoCanvas,
+		Plan:   plan,
+	}, nil
+}
+
+func buildCanvasPlan(registry *registry.Registry, update *canvasUpdate, metadata *pb.Canvas_Metadata) (*pb.CanvasPlan, error) {
+	plan := &pb.CanvasPlan{
+		Nodes: planNodes(update.canvas.Nodes, update.nodes),
+		Edges: planEdges(update.canvas.Edges, update.edges),
+	}
+
+	plan.Canvas = appendFieldChange(plan.Canvas, "name", update.canvas.Name, metadata.Name)
+	plan.Canvas = appendFieldChange(plan.Canvas, "description", update.canvas.Description, metadata.Description)
+
+	existingNodes, err := models.FindCanvasNodes(update.canvas.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	subscriptions, err := models.ListIntegrationSubscriptionsForCanvas(update.canvas.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	subscriptionsByNode := map[string][]models.IntegrationSubscription{}
+	for _, subscription := range subscriptions {
+		subscriptionsByNode[subscription.NodeID] = append(subscriptionsByNode[subscription.NodeID], subscription)
+	}
+
+	newNodes := map[string]models.Node{}
+	for _, node := range update.expandedNodes {
+		newNodes[node.ID] = node
+	}
+
+	removed := map[string]bool{}
+	for _, node := range existingNodes {
+		if _, ok := newNodes[node.NodeID]; !ok {
+			removed[node.NodeID] = true
+		}
+	}
+
+	err = planRemovedNodes(plan, existingNodes, removed, subscriptionsByNode)
+	if err != nil {
+		return nil, err
+	}
+
+	planSetUpNodes(registry, plan, existingNodes, update.expandedNodes, subscriptionsByNode)
+	return plan, nil
+}
+
+//
+// Nodes are compared by ID, ignoring their position and whether they are collapsed,
+// since those don't change what the canvas does.
+//
+
+func planNodes(before []models.Node, after []models.Node) []*pb.CanvasPlan_NodeChange {
+	changes := []*pb.CanvasPlan_NodeChange{}
+	for _, node := range after {
+		i := slices.IndexFunc(before, func(n models.Node) bool { return n.ID == node.ID })
+		if i == -1 {
+			changes = append(changes, &pb.CanvasPlan_NodeChange{
+				Action:   pb.CanvasPlan_ACTION_ADD,
+				NodeId:   node.ID,
+				NodeName: node.Name,
+				Ref:      nodeRefName(node.Ref),
+			})
+
+			continue
+		}
+
+		fields := nodeFieldChanges(before[i], node)
+		if len(fields) == 0 {
+			continue
+		}
+
+		changes = append(changes, &pb.CanvasPlan_NodeChange{
+			Action:   pb.CanvasPlan_ACTION_CHANGE,
+			NodeId:   node.ID,
+			NodeName: node.Name,
+			Ref:      nodeRefName(node.Ref),
+			Fields:   fields,
+		})
+	}
+
+	for _, node := range before {
+		if !slices.ContainsFunc(after, func(n models.Node) bool { return n.ID == node.ID }) {
+			changes = append(changes, &pb.CanvasPlan_NodeChange{
+				Action:   pb.CanvasPlan_ACTION_REMOVE,
+				NodeId:   node.ID,
+				NodeName: node.Name,
+				Ref:      nodeRefName(node.Ref),
+			})
+		}
+	}
+
+	return changes
+}
+
+func nodeFieldChanges(before, after models.Node) []*pb.CanvasPlan_FieldChange {
+	changes := []*pb.CanvasPlan_FieldChange{}
+	changes = appendFieldChange(changes, "name", before.Name, after.Name)
+	changes = appendFieldChange(changes, "ref", nodeRefName(before.Ref), nodeRefName(after.Ref))
+	changes = appendFieldChange(changes, "integrationId", stringValue(before.IntegrationID), stringValue(after.IntegrationID))
+	changes = appendFieldChange(changes, "errorChannelEnabled", before.ErrorChannelEnabled, after.ErrorChannelEnabled)
+	return append(changes, configurationChanges("configuration", before.Configuration, after.Configuration)...)
+}
+
+//
+// Configurations are compared field by field, going into nested objects,
+// so a change to one field doesn't show the whole configuration.
+// Lists are compared as a whole.
+//
+
+func configurationChanges(path string, before, after map[string]any) []*pb.CanvasPlan_FieldChange {
+	keys := []string{}
+	for key := range before {
+		keys = append(keys, key)
+	}
+
+	for key := range after {
+		if _, ok := before[key]; !ok {
+			keys = append(keys, key)
+		}
+	}
+
+	slices.Sort(keys)
+
+	changes := []*pb.CanvasPlan_FieldChange{}
+	for _, key := range keys {
+		fieldPath := path + "." + key
+		beforeValue, beforeOK := before[key].(map[string]any)
+		afterValue, afterOK := after[key].(map[string]any)
+		if beforeOK && afterOK {
+			changes = append(changes, configurationChanges(fieldPath, beforeValue, afterValue)...)
+			continue
+		}
+
+		changes = appendFieldChange(changes, fieldPath, before[key], after[key])
+	}
+
+	return changes
+}
+
+func appendFieldChange(changes []*pb.CanvasPlan_FieldChange, path string, before, after any) []*pb.CanvasPlan_FieldChange {
+	beforeValue := formatPlanValue(before)
+	afterValue := formatPlanValue(after)
+	if beforeValue == afterValue {
+		return changes
+	}
+
+	return append(changes, &pb.CanvasPlan_FieldChange{
+		Path:   path,
+		Before: beforeValue,
+		After:  afterValue,
+	})
+}
+
+//
+// Values are shown as JSON, and missing values as empty strings.
+//
+
+func formatPlanValue(value any) string {
+	if value == nil || value == "" {
+		return ""
+	}
+
+	data, err := json.Marshal(value)
+	if err != nil {
+		return ""
+	}
+
+	return string(data)
+}
+
+func planEdges(before []models.Edge, after []models.Edge) []*pb.CanvasPlan_EdgeChange {
+	changes := []*pb.CanvasPlan_EdgeChange{}
+	for _, edge := range after {
+		if !slices.Contains(before, edge) {
+			changes = append(changes, edgeChange(pb.CanvasPlan_ACTION_ADD, edge))
+		}
+	}
+
+	for _, edge := range before {
+		if !slices.Contains(after, edge) {
+			changes = append(changes, edgeChange(pb.CanvasPlan_ACTION_REMOVE, edge))
+		}
+	}
+
+	return changes
+}
+
+func edgeChange(action pb.CanvasPlan_Action, edge models.Edge) *pb.CanvasPlan_EdgeChange {
+	return &pb.CanvasPlan_EdgeChange{
+		Action:   action,
+		SourceId: edge.SourceID,
+		TargetId: edge.TargetID,
+		Channel:  edge.Channel,
+	}
+}
+
+//
+// Removing a node deletes its subscriptions, and its webhook,
+// if no node that stays uses it, like models.DeleteCanvasNode does.
+// Executions and queue items of removed nodes are not processed anymore.
+//
+
+func planRemovedNodes(
+	plan *pb.CanvasPlan,
+	existingNodes []models.CanvasNode,
+	removed map[string]bool,
+	subscriptionsByNode map[string][]models.IntegrationSubscription,
+) error {
+	webhooks := map[uuid.UUID]bool{}
+	for _, node := range existingNodes {
+		if !removed[node.NodeID] {
+			continue
+		}
+
+		if node.WebhookID != nil && !webhooks[*node.WebhookID] {
+			webhooks[*node.WebhookID] = true
+			webhookNodes, err := models.FindWebhookNodes(*node.WebhookID)
+			if err != nil {
+				return err
+			}
+
+			stays := func(n models.CanvasNode) bool { return n.WorkflowID != node.WorkflowID || !removed[n.NodeID] }
+			if !slices.ContainsFunc(webhookNodes, stays) {
+				plan.Webhooks = append(plan.Webhooks, &pb.CanvasPlan_WebhookChange{
+					Action:      pb.CanvasPlan_ACTION_REMOVE,
+					NodeId:      node.NodeID,
+					NodeName:    node.Name,
+					WebhookId:   node.WebhookID.String(),
+					Integration: integrationName(node.Ref.Data()),
+				})
+			}
+		}
+
+		for _, subscription := range subscriptionsByNode[node.NodeID] {
+			plan.Subscriptions = append(plan.Subscriptions, &pb.CanvasPlan_SubscriptionChange{
+				Action:        pb.CanvasPlan_ACTION_REMOVE,
+				NodeId:        node.NodeID,
+				NodeName:      node.Name,
+				IntegrationId: subscription.InstallationID.String(),
+			})
+		}
+
+		executions, err := models.CountNodeExecutions(
+			node.WorkflowID,
+			node.NodeID,
+			[]string{models.CanvasNodeExecutionStatePending, models.CanvasNodeExecutionStateStarted},
+			nil,
+		)
+		if err != nil {
+			return err
+		}
+
+		queueItems, err := models.CountNodeQueueItems(node.WorkflowID, node.NodeID)
+		if err != nil {
+			return err
+		}
+
+		if executions > 0 || queueItems > 0 {
+			plan.Orphaned = append(plan.Orphaned, &pb.CanvasPlan_OrphanedNode{
+				NodeId:            node.NodeID,
+				NodeName:          node.Name,
+				RunningExecutions: executions,
+				QueueItems:        queueItems,
+			})
+		}
+	}
+
+	return nil
+}
+
+//
+// New nodes, and nodes with a new configuration or integration, are set up again.
+// Their webhooks and subscriptions may be changed by the setup,
+// and new nodes that receive webhooks will request one.
+//
+
+func planSetUpNodes(
+	registry *registry.Registry,
+	plan *pb.CanvasPlan,
+	existingNodes []models.CanvasNode,
+	newNodes []models.Node,
+	subscriptionsByNode map[string][]models.IntegrationSubscription,
+) {
+	for _, node := range newNodes {
+		if node.Type == models.NodeTypeWidget || (node.ErrorMessage != nil && *node.ErrorMessage != "") {
+			continue
+		}
+
+		existing := findNode(existingNodes, node.ID)
+		if existing == nil {
+			if receivesWebhooks(registry, node) {
+				plan.Webhooks = append(plan.Webhooks, &pb.CanvasPlan_WebhookChange{
+					Action:      pb.CanvasPlan_ACTION_ADD,
+					NodeId:      node.ID,
+					NodeName:    node.Name,
+					Integration: integrationName(node.Ref),
+				})
+			}
+
+			continue
+		}
+
+		if !setupChanged(*existing, node) {
+			continue
+		}
+
+		if existing.WebhookID != nil {
+			plan.Webhooks = append(plan.Webhooks, &pb.CanvasPlan_WebhookChange{
+				Action:      pb.CanvasPlan_ACTION_CHANGE,
+				NodeId:      node.ID,
+				NodeName:    node.Name,
+				WebhookId:   existing.WebhookID.String(),
+				Integration: integrationName(node.Ref),
+			})
+		}
+
+		for _, subscription := range subscriptionsByNode[node.ID] {
+			plan.Subscriptions = append(plan.Subscriptions, &pb.CanvasPlan_SubscriptionChange{
+				Action:        pb.CanvasPlan_ACTION_CHANGE,
+				NodeId:        node.ID,
+				NodeName:      node.Name,
+				IntegrationId: subscription.InstallationID.String(),
+			})
+		}
+	}
+}
+
+func setupChanged(existing models.CanvasNode, node models.Node) bool {
+	if !reflect.DeepEqual(existing.Ref.Data(), node.Ref) {
+		return true
+	}
+
+	existingIntegrationID := ""
+	if existing.AppInstallationID != nil {
+		existingIntegrationID = existing.AppInstallationID.String()
+	}
+
+	if existingIntegrationID != stringValue(node.IntegrationID) {
+		return true
+	}
+
+	return formatPlanValue(existing.Configuration.Data()) != formatPlanValue(node.Configuration)
+}
+
+//
+// Webhooks are used by the webhook trigger,
+// and by nodes of integrations that handle webhooks.
+//
+
+func receivesWebhooks(registry *registry.Registry, node models.Node) bool {
+	if node.Ref.Trigger != nil && node.Ref.Trigger.Name == "webhook" {
+		return true
+	}
+
+	name := integrationName(node.Ref)
+	if name == "" || stringValue(node.IntegrationID) == "" {
+		return false
+	}
+
+	_, ok := registry.WebhookHandlers[name]
+	return ok
+}
+
+func integrationName(ref models.NodeRef) string {
+	name := nodeRefName(ref)
+	if ref.Blueprint != nil {
+		return ""
+	}
+
+	integration, _, found := strings.Cut(name, ".")
+	if !found {
+		return ""
+	}
+
+	return integration
+}
+
+func nodeRefName(ref models.NodeRef) string {
+	switch {
+	case ref.Component != nil:
+		return ref.Component.Name
+	case ref.Trigger != nil:
+		return ref.Trigger.Name
+	case ref.Widget != nil:
+		return ref.Widget.Name
+	case ref.Blueprint != nil:
+		return ref.Blueprint.ID
+	default:
+		return ""
+	}
+}
+
+func stringValue(value *string) string {
+	if value == nil {
+		return ""
+	}
+
+	return *value
+}
@@ -0,0 +1,215 @@
+package canvases
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/superplanehq/superplane/pkg/authentication"
+	"github.com/superplanehq/superplane/pkg/dora"
+	"github.com/superplanehq/superplane/pkg/models"
+	pb "github.com/superplanehq/superplane/pkg/protos/canvases"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
+)
+
+func GetCanvasDoraSettings(ctx context.Context, organizationID string, canvasID uuid.UUID) (*pb.GetCanvasDoraSettingsResponse, error) {
+	canvas, err := findCanvasForDora(organizationID, canvasID)
+	if err != nil {
+		return nil, err
+	}
+
+	settings, err := findOrDefaultDoraSettings(canvas.ID)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to load DORA settings")
+	}
+
+	return &pb.GetCanvasDoraSettingsResponse{
+		Settings: SerializeDoraSettings(settings),
+	}, nil
+}
+
+func UpdateCanvasDoraSettings(ctx context.Context, organizationID string, canvasID uuid.UUID, pbSettings *pb.DoraSettings) (*pb.UpdateCanvasDoraSettingsResponse, error) {
+	userID, ok := authentication.GetUserIdFromMetadata(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "user not authenticated")
+	}
+
+	canvas, err := findCanvasForDora(organizationID, canvasID)
+	if err != nil {
+		return nil, err
+	}
+
+	settings, err := parseDoraSettings(canvas.ID, pbSettings)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	err = validateDoraNodes(canvas.ID, settings)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	updatedBy := uuid.MustParse(userID)
+	settings.UpdatedBy = &updatedBy
+	err = models.SaveCanvasDoraSettings(settings)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to save DORA settings")
+	}
+
+	return &pb.UpdateCanvasDoraSettingsResponse{
+		Settings: SerializeDoraSettings(settings),
+	}, nil
+}
+
+func findCanvasForDora(organizationID string, canvasID uuid.UUID) (*models.Canvas, error) {
+	orgID, err := uuid.Parse(organizationID)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid organization_id")
+	}
+
+	canvas, err := models.FindCanvas(orgID, canvasID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, status.Error(codes.NotFound, "canvas not found")
+		}
+
+		return nil, status.Error(codes.Internal, "failed to load canvas")
+	}
+
+	return canvas, nil
+}
+
+//
+// Canvases without DORA settings have no deployment or incident nodes,
+// so their metrics are all zero.
+//
+
+func findOrDefaultDoraSettings(canvasID uuid.UUID) (*models.CanvasDoraSettings, error) {
+	settings, err := models.FindCanvasDoraSettings(canvasID)
+	if err == nil {
+		return settings, nil
+	}
+
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return &models.CanvasDoraSettings{WorkflowID: canvasID}, nil
+	}
+
+	return nil, err
+}
+
+func parseDoraSettings(canvasID uuid.UUID, pbSettings *pb.DoraSettings) (*models.CanvasDoraSettings, error) {
+	settings := &models.CanvasDoraSettings{
+		WorkflowID:      canvasID,
+		DeploymentNodes: []models.DoraDeploymentNode{},
+		IncidentNodes:   []models.DoraIncidentNode{},
+	}
+
+	if pbSettings == nil {
+		return settings, nil
+	}
+
+	seen := map[string]bool{}
+	for _, node := range pbSettings.DeploymentNodes {
+		if node.NodeId == "" {
+			return nil, errors.New("deployment node id is required")
+		}
+
+		if seen[node.NodeId] {
+			return nil, fmt.Errorf("node %s is listed more than once", node.NodeId)
+		}
+
+		if err := dora.ValidateField(node.CommitTimestampField); err != nil {
+			return nil, err
+		}
+
+		seen[node.NodeId] = true
+		settings.DeploymentNodes = append(settings.DeploymentNodes, models.DoraDeploymentNode{
+			NodeID:               node.NodeId,
+			CommitTimestampField: node.CommitTimestampField,
+		})
+	}
+
+	for _, node := range pbSettings.IncidentNodes {
+		if node.NodeId == "" {
+			return nil, errors.New("incident node id is required")
+		}
+
+		if seen[node.NodeId] {
+			return nil, fmt.Errorf("node %s is listed more than once", node.NodeId)
+		}
+
+		if err := dora.ValidateField(node.StartedAtField); err != nil {
+			return nil, err
+		}
+
+		if err := dora.ValidateField(node.ResolvedAtField); err != nil {
+			return nil, err
+		}
+
+		seen[node.NodeId] = true
+		settings.IncidentNodes = append(settings.IncidentNodes, models.DoraIncidentNode{
+			NodeID:          node.NodeId,
+			StartedAtField:  node.StartedAtField,
+			ResolvedAtField: node.ResolvedAtField,
+		})
+	}
+
+	return settings, nil
+}
+
+func validateDoraNodes(canvasID uuid.UUID, settings *models.CanvasDoraSettings) error {
+	nodeIDs := settings.NodeIDs()
+	if len(nodeIDs) == 0 {
+		return nil
+	}
+
+	nodes, err := models.FindCanvasNodes(canvasID)
+	if err != nil {
+		return err
+	}
+
+	existing := make(map[string]bool, len(nodes))
+	for _, node := range nodes {
+		existing[node.NodeID] = true
+	}
+
+	for _, nodeID := range nodeIDs {
+		if !existing[nodeID] {
+			return fmt.Errorf("node %s not found in canvas", nodeID)
+		}
+	}
+
+	return nil
+}
+
+func SerializeDoraSettings(settings *models.CanvasDoraSettings) *pb.DoraSettings {
+	result := &pb.DoraSettings{
+		DeploymentNodes: []*pb.DoraSettings_DeploymentNode{},
+		IncidentNodes:   []*pb.DoraSettings_IncidentNode{},
+	}
+
+	for _, node := range settings.DeploymentNodes {
+		result.DeploymentNodes = append(result.DeploymentNodes, &pb.DoraSettings_DeploymentNode{
+			NodeId:               node.NodeID,
+			CommitTimestampField: node.CommitTimestampField,
+		})
+	}
+
+	for _, node := range settings.IncidentNodes {
+		result.IncidentNodes = append(result.IncidentNodes, &pb.DoraSettings_IncidentNode{
+			NodeId:          node.NodeID,
+			StartedAtField:  node.StartedAtField,
+			ResolvedAtField: node.ResolvedAtField,
+		})
+	}
+
+	if settings.UpdatedAt != nil {
+		result.UpdatedAt = timestamppb.New(*settings.UpdatedAt)
+	}
+
+	return result
+}
@@ -0,0 +1,90 @@
+package canvases
+
+import (
+	"context"
+	"errors"
+	"io"
+
+	"github.com/google/uuid"
+	log "github.com/sirupsen/logrus"
+	"github.com/superplanehq/superplane/pkg/artifacts"
+	"github.com/superplanehq/superplane/pkg/models"
+	pb "github.com/superplanehq/superplane/pkg/protos/canvases"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+//
+// Artifacts are bigger than the maximum size of a gRPC message,
+// so their contents are sent in chunks of this size.
+//
+
+const ArtifactChunkSize = 1024 * 1024
+
+//
+// DownloadExecutionArtifact sends the content of an artifact in chunks.
+// The first chunk also has the artifact, and empty artifacts are sent
+// as a single chunk without content.
+//
+
+func DownloadExecutionArtifact(ctx context.Context, storage artifacts.Storage, organizationID string, canvasID, executionID uuid.UUID, name string, send func(*pb.DownloadExecutionArtifactResponse) error) error {
+	if name == "" {
+		return status.Error(codes.InvalidArgument, "artifact name is required")
+	}
+
+	_, err := findCanvasExecution(organizationID, canvasID, executionID)
+	if err != nil {
+		return err
+	}
+
+	artifact, err := models.FindNodeExecutionArtifact(canvasID, executionID, name)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return status.Error(codes.NotFound, "artifact not found")
+		}
+
+		return status.Error(codes.Internal, "failed to load artifact")
+	}
+
+	reader, err := storage.Open(ctx, artifact.StorageKey)
+	if err != nil {
+		if errors.Is(err, artifacts.ErrArtifactNotFound) {
+			return status.Error(codes.NotFound, "artifact content not found")
+		}
+
+		log.Errorf("failed to read artifact %s for execution %s: %v", name, executionID, err)
+		return status.Error(codes.Internal, "failed to read artifact")
+	}
+
+	defer reader.Close()
+
+	response := &pb.DownloadExecutionArtifactResponse{Artifact: serializeExecutionArtifact(artifact)}
+	for {
+		chunk := make([]byte, ArtifactChunkSize)
+		n, err := io.ReadFull(reader, chunk)
+		last := errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF)
+		if err != nil && !last {
+			log.Errorf("failed to read artifact %s for execution %s: %v", name, executionID, err)
+			return status.Error(codes.Internal, "failed to read artifact")
+		}
+
+		//
+		// Content that is a multiple of the chunk size ends with an empty read,
+		// which is only sent when it is the first chunk, for empty artifacts.
+		//
+		if n > 0 || response.Artifact != nil {
+			response.Content = chunk[:n]
+			err = send(response)
+			if err != nil {
+				return err
+			}
+		}
+
+		if last {
+			return nil
+		}
+
+		response = &pb.DownloadExecutionArtifactResponse{}
+	}
+}
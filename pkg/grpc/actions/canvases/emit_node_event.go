@@ -8,16 +8,22 @@ import (
 
 	"github.com/google/uuid"
 	log "github.com/sirupsen/logrus"
+	"github.com/superplanehq/superplane/pkg/configuration"
+	"github.com/superplanehq/superplane/pkg/core"
 	"github.com/superplanehq/superplane/pkg/database"
 	"github.com/superplanehq/superplane/pkg/grpc/actions/messages"
 	"github.com/superplanehq/superplane/pkg/models"
 	pb "github.com/superplanehq/superplane/pkg/protos/canvases"
+	"github.com/superplanehq/superplane/pkg/registry"
 	"github.com/superplanehq/superplane/pkg/workers/contexts"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"gorm.io/datatypes"
 )
 
 func EmitNodeEvent(
 	ctx context.Context,
+	registry *registry.Registry,
 	orgID uuid.UUID,
 	canvasID uuid.UUID,
 	nodeID string,
@@ -34,6 +40,11 @@ func EmitNodeEvent(
 		return nil, fmt.Errorf("canvas node not found: %w", err)
 	}
 
+	data, err = validateNodeInput(registry, orgID, node, data)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid input: %v", err)
+	}
+
 	now := time.Now()
 	event := models.CanvasEvent{
 		WorkflowID: canvas.ID,
@@ -65,6 +76,52 @@ func EmitNodeEvent(
 	}, nil
 }
 
+func validateNodeInput(registry *registry.Registry, orgID uuid.UUID, node *models.CanvasNode, data map[string]any) (map[string]any, error) {
+	ref := node.Ref.Data()
+	if node.Type != models.NodeTypeTrigger || ref.Trigger == nil {
+		return data, nil
+	}
+
+	trigger, err := registry.GetTrigger(ref.Trigger.Name)
+	if err != nil {
+		return data, nil
+	}
+
+	inputTrigger, ok := trigger.(core.InputTrigger)
+	if !ok {
+		return data, nil
+	}
+
+	data, err = inputTrigger.ValidateInput(node.Configuration.Data(), data)
+	if err != nil {
+		return nil, err
+	}
+
+	formTrigger, ok := trigger.(core.FormInputTrigger)
+	if !ok {
+		return data, nil
+	}
+
+	fields, err := formTrigger.InputFields(node.Configuration.Data())
+	if err != nil {
+		return nil, err
+	}
+
+	resources := contexts.NewIntegrationResourceContext(database.Conn(), orgID, registry)
+	err = configuration.ValidateFormResources(fields, data, resources)
+	if err != nil {
+		return nil, err
+	}
+
+	members := contexts.NewOrganizationMemberContext(database.Conn(), orgID)
+	err = configuration.ValidateFormUsers(fields, data, members)
+	if err != nil {
+		return nil, err
+	}
+
+	return data, nil
+}
+
 func resolveCustomName(node *models.CanvasNode, payload map[string]any) (*string, error) {
 	config := node.Configuration.Data()
 	if config == nil {
@@ -13,6 +13,8 @@ import (
 	"github.com/superplanehq/superplane/pkg/models"
 	testconsumer "github.com/superplanehq/superplane/test/consumer"
 	"github.com/superplanehq/superplane/test/support"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"gorm.io/datatypes"
 )
 
@@ -23,6 +25,7 @@ func Test__EmitNodeEvent(t *testing.T) {
 	t.Run("canvas not found -> error", func(t *testing.T) {
 		_, err := EmitNodeEvent(
 			ctx,
+			r.Registry,
 			r.Organization.ID,
 			uuid.New(),
 			"node-1",
@@ -53,6 +56,7 @@ func Test__EmitNodeEvent(t *testing.T) {
 
 		_, err := EmitNodeEvent(
 			ctx,
+			r.Registry,
 			r.Organization.ID,
 			canvas.ID,
 			"non-existent-node",
@@ -88,6 +92,7 @@ func Test__EmitNodeEvent(t *testing.T) {
 
 		response, err := EmitNodeEvent(
 			ctx,
+			r.Registry,
 			r.Organization.ID,
 			canvas.ID,
 			"node-1",
@@ -145,6 +150,7 @@ func Test__EmitNodeEvent(t *testing.T) {
 
 		response, err := EmitNodeEvent(
 			ctx,
+			r.Registry,
 			r.Organization.ID,
 			canvas.ID,
 			"node-1",
@@ -187,6 +193,7 @@ func Test__EmitNodeEvent(t *testing.T) {
 
 		_, err := EmitNodeEvent(
 			ctx,
+			r.Registry,
 			r.Organization.ID,
 			canvas.ID,
 			"node-1",
@@ -202,6 +209,7 @@ func Test__EmitNodeEvent(t *testing.T) {
 	t.Run("invalid organization ID -> error", func(t *testing.T) {
 		_, err := EmitNodeEvent(
 			ctx,
+			r.Registry,
 			uuid.New(),
 			uuid.New(),
 			"node-1",
@@ -232,6 +240,7 @@ func Test__EmitNodeEvent(t *testing.T) {
 
 		_, err := EmitNodeEvent(
 			ctx,
+			r.Registry,
 			r.Organization.ID,
 			canvas.ID,
 			"",
@@ -262,6 +271,7 @@ func Test__EmitNodeEvent(t *testing.T) {
 
 		response, err := EmitNodeEvent(
 			ctx,
+			r.Registry,
 			r.Organization.ID,
 			canvas.ID,
 			"node-1",
@@ -282,4 +292,49 @@ func Test__EmitNodeEvent(t *testing.T) {
 		eventData := event.Data.Data()
 		assert.Nil(t, eventData)
 	})
+
+	t.Run("start trigger inputs are validated and normalized", func(t *testing.T) {
+		canvas, _ := support.CreateCanvas(
+			t,
+			r.Organization.ID,
+			r.User,
+			[]models.CanvasNode{
+				{
+					NodeID: "start-1",
+					Name:   "Start",
+					Type:   models.NodeTypeTrigger,
+					Ref: datatypes.NewJSONType(models.NodeRef{
+						Trigger: &models.TriggerRef{Name: "start"},
+					}),
+					Configuration: datatypes.NewJSONType(map[string]any{
+						"inputs": []any{
+							map[string]any{"name": "environment", "type": "select", "required": true, "options": []any{"staging", "production"}},
+							map[string]any{"name": "replicas", "type": "number", "default": "2"},
+						},
+					}),
+				},
+			},
+			[]models.Edge{},
+		)
+
+		_, err := EmitNodeEvent(ctx, r.Registry, r.Organization.ID, canvas.ID, "start-1", "default", map[string]any{})
+		require.Error(t, err)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		assert.Contains(t, err.Error(), "field 'environment' is required")
+
+		_, err = EmitNodeEvent(ctx, r.Registry, r.Organization.ID, canvas.ID, "start-1", "default", map[string]any{"environment": "dev"})
+		require.Error(t, err)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+		_, err = EmitNodeEvent(ctx, r.Registry, r.Organization.ID, canvas.ID, "start-1", "default", map[string]any{"environment": "staging", "other": "x"})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "unknown field 'other'")
+
+		response, err := EmitNodeEvent(ctx, r.Registry, r.Organization.ID, canvas.ID, "start-1", "default", map[string]any{"environment": "production"})
+		require.NoError(t, err)
+
+		event, err := models.FindCanvasEvent(uuid.MustParse(response.EventId))
+		require.NoError(t, err)
+		assert.Equal(t, map[string]any{"environment": "production", "replicas": float64(2)}, event.Data.Data())
+	})
 }
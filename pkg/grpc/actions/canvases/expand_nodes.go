@@ -33,15 +33,16 @@ func expandNodes(organizationID string, nodes []models.Node) ([]models.Node, err
 
 		for _, bn := range b.Nodes {
 			internal := models.Node{
-				ID:            n.ID + ":" + bn.ID,
-				Name:          bn.Name,
-				Type:          bn.Type,
-				Ref:           bn.Ref,
-				Configuration: bn.Configuration,
-				Metadata:      cloneMetadata(bn.Metadata),
-				Position:      bn.Position,
-				IsCollapsed:   bn.IsCollapsed,
-				IntegrationID: bn.IntegrationID,
+				ID:                  n.ID + ":" + bn.ID,
+				Name:                bn.Name,
+				Type:                bn.Type,
+				Ref:                 bn.Ref,
+				Configuration:       bn.Configuration,
+				Metadata:            cloneMetadata(bn.Metadata),
+				Position:            bn.Position,
+				IsCollapsed:         bn.IsCollapsed,
+				IntegrationID:       bn.IntegrationID,
+				ErrorChannelEnabled: bn.ErrorChannelEnabled,
 			}
 
 			expanded = append(expanded, internal)
@@ -0,0 +1,33 @@
+package canvases
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/superplanehq/superplane/pkg/dora"
+	pb "github.com/superplanehq/superplane/pkg/protos/canvases"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func ExportCanvasDoraMetrics(ctx context.Context, organizationID string, canvasID uuid.UUID, from, to *timestamppb.Timestamp, interval pb.DoraInterval) (*pb.ExportCanvasDoraMetricsResponse, error) {
+	_, series, _, err := computeDoraMetrics(organizationID, canvasID, from, to, interval)
+	if err != nil {
+		return nil, err
+	}
+
+	var content bytes.Buffer
+	err = dora.WriteCSV(&content, series)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to export DORA metrics")
+	}
+
+	return &pb.ExportCanvasDoraMetricsResponse{
+		Filename:    fmt.Sprintf("dora-%s.csv", canvasID),
+		ContentType: "text/csv",
+		Content:     content.Bytes(),
+	}, nil
+}
@@ -0,0 +1,115 @@
+package canvases
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/superplanehq/superplane/pkg/dora"
+	"github.com/superplanehq/superplane/pkg/models"
+	pb "github.com/superplanehq/superplane/pkg/protos/canvases"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	DefaultDoraMetricsRange = 30 * 24 * time.Hour
+	MaxDoraMetricsRange     = 365 * 24 * time.Hour
+)
+
+func GetCanvasDoraMetrics(ctx context.Context, organizationID string, canvasID uuid.UUID, from, to *timestamppb.Timestamp, interval pb.DoraInterval) (*pb.GetCanvasDoraMetricsResponse, error) {
+	summary, series, interval, err := computeDoraMetrics(organizationID, canvasID, from, to, interval)
+	if err != nil {
+		return nil, err
+	}
+
+	serializedSeries := make([]*pb.DoraMetrics, 0, len(series))
+	for i := range series {
+		serializedSeries = append(serializedSeries, serializeDoraMetrics(&series[i]))
+	}
+
+	return &pb.GetCanvasDoraMetricsResponse{
+		Summary:  serializeDoraMetrics(&summary),
+		Series:   serializedSeries,
+		Interval: interval,
+	}, nil
+}
+
+func computeDoraMetrics(organizationID string, canvasID uuid.UUID, from, to *timestamppb.Timestamp, interval pb.DoraInterval) (dora.Metrics, []dora.Metrics, pb.DoraInterval, error) {
+	canvas, err := findCanvasForDora(organizationID, canvasID)
+	if err != nil {
+		return dora.Metrics{}, nil, interval, err
+	}
+
+	fromTime, toTime, err := getDoraMetricsRange(from, to)
+	if err != nil {
+		return dora.Metrics{}, nil, interval, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	interval, duration, err := doraIntervalDuration(interval)
+	if err != nil {
+		return dora.Metrics{}, nil, interval, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	settings, err := findOrDefaultDoraSettings(canvas.ID)
+	if err != nil {
+		return dora.Metrics{}, nil, interval, status.Error(codes.Internal, "failed to load DORA settings")
+	}
+
+	executions, err := models.ListDoraExecutions(canvas.ID, settings.NodeIDs(), fromTime, toTime)
+	if err != nil {
+		return dora.Metrics{}, nil, interval, status.Error(codes.Internal, "failed to load executions")
+	}
+
+	summary, series := dora.Compute(settings, executions, fromTime, toTime, duration)
+	return summary, series, interval, nil
+}
+
+func getDoraMetricsRange(from, to *timestamppb.Timestamp) (time.Time, time.Time, error) {
+	toTime := time.Now()
+	if to != nil {
+		toTime = to.AsTime()
+	}
+
+	fromTime := toTime.Add(-DefaultDoraMetricsRange)
+	if from != nil {
+		fromTime = from.AsTime()
+	}
+
+	if !fromTime.Before(toTime) {
+		return time.Time{}, time.Time{}, errors.New("from must be before to")
+	}
+
+	if toTime.Sub(fromTime) > MaxDoraMetricsRange {
+		return time.Time{}, time.Time{}, errors.New("time range cannot be longer than 365 days")
+	}
+
+	return fromTime, toTime, nil
+}
+
+func doraIntervalDuration(interval pb.DoraInterval) (pb.DoraInterval, time.Duration, error) {
+	switch interval {
+	case pb.DoraInterval_DORA_INTERVAL_UNSPECIFIED, pb.DoraInterval_DORA_INTERVAL_DAY:
+		return pb.DoraInterval_DORA_INTERVAL_DAY, dora.IntervalDay, nil
+	case pb.DoraInterval_DORA_INTERVAL_WEEK:
+		return pb.DoraInterval_DORA_INTERVAL_WEEK, dora.IntervalWeek, nil
+	}
+
+	return interval, 0, errors.New("invalid interval")
+}
+
+func serializeDoraMetrics(metrics *dora.Metrics) *pb.DoraMetrics {
+	return &pb.DoraMetrics{
+		Start:                     timestamppb.New(metrics.Start),
+		End:                       timestamppb.New(metrics.End),
+		Deployments:               metrics.Deployments,
+		FailedDeployments:         metrics.FailedDeployments,
+		DeploymentFrequencyPerDay: metrics.DeploymentFrequencyPerDay,
+		LeadTimeSeconds:           metrics.LeadTimeSeconds,
+		ChangeFailureRate:         metrics.ChangeFailureRate,
+		Incidents:                 metrics.Incidents,
+		MttrSeconds:               metrics.MTTRSeconds,
+	}
+}
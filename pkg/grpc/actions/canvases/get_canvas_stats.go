@@ -0,0 +1,118 @@
+package canvases
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/superplanehq/superplane/pkg/models"
+	pb "github.com/superplanehq/superplane/pkg/protos/canvases"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
+)
+
+const (
+	DefaultCanvasStatsRange = 24 * time.Hour
+	MaxCanvasStatsRange     = 90 * 24 * time.Hour
+)
+
+func GetCanvasStats(ctx context.Context, organizationID string, canvasID uuid.UUID, from, to *timestamppb.Timestamp) (*pb.GetCanvasStatsResponse, error) {
+	orgID, err := uuid.Parse(organizationID)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid organization_id")
+	}
+
+	_, err = models.FindCanvas(orgID, canvasID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, status.Error(codes.NotFound, "canvas not found")
+		}
+
+		return nil, status.Error(codes.Internal, "failed to load canvas")
+	}
+
+	fromTime, toTime, err := getCanvasStatsRange(from, to)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	canvasStats, nodeStats, err := models.GetCanvasExecutionStats(canvasID, fromTime, toTime)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to load canvas stats")
+	}
+
+	//
+	// Deleted nodes are included, since they can still
+	// have executions in the time range.
+	//
+	nodes, err := models.FindCanvasNodesUnscoped(canvasID)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to load canvas nodes")
+	}
+
+	nodesByID := make(map[string]*models.CanvasNode, len(nodes))
+	for i := range nodes {
+		nodesByID[nodes[i].NodeID] = &nodes[i]
+	}
+
+	hours := toTime.Sub(fromTime).Hours()
+	serializedNodes := make([]*pb.CanvasNodeExecutionStats, 0, len(nodeStats))
+	for i := range nodeStats {
+		serialized := &pb.CanvasNodeExecutionStats{
+			NodeId: nodeStats[i].NodeID,
+			Stats:  serializeCanvasExecutionStats(&nodeStats[i], hours),
+		}
+
+		if node, ok := nodesByID[nodeStats[i].NodeID]; ok {
+			serialized.NodeName = node.Name
+			serialized.Component = node.ComponentName()
+		}
+
+		serializedNodes = append(serializedNodes, serialized)
+	}
+
+	return &pb.GetCanvasStatsResponse{
+		Canvas: serializeCanvasExecutionStats(canvasStats, hours),
+		Nodes:  serializedNodes,
+		From:   timestamppb.New(fromTime),
+		To:     timestamppb.New(toTime),
+	}, nil
+}
+
+func getCanvasStatsRange(from, to *timestamppb.Timestamp) (time.Time, time.Time, error) {
+	toTime := time.Now()
+	if to != nil {
+		toTime = to.AsTime()
+	}
+
+	fromTime := toTime.Add(-DefaultCanvasStatsRange)
+	if from != nil {
+		fromTime = from.AsTime()
+	}
+
+	if !fromTime.Before(toTime) {
+		return time.Time{}, time.Time{}, errors.New("from must be before to")
+	}
+
+	if toTime.Sub(fromTime) > MaxCanvasStatsRange {
+		return time.Time{}, time.Time{}, errors.New("time range cannot be longer than 90 days")
+	}
+
+	return fromTime, toTime, nil
+}
+
+func serializeCanvasExecutionStats(stats *models.CanvasExecutionStats, hours float64) *pb.CanvasExecutionStats {
+	return &pb.CanvasExecutionStats{
+		Total:              stats.Total,
+		Passed:             stats.Passed,
+		Failed:             stats.Failed,
+		Cancelled:          stats.Cancelled,
+		SuccessRate:        stats.SuccessRate(),
+		P50DurationSeconds: stats.P50DurationSeconds,
+		P95DurationSeconds: stats.P95DurationSeconds,
+		ExecutionsPerHour:  float64(stats.Total) / hours,
+	}
+}
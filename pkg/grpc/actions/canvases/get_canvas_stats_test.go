@@ -0,0 +1,134 @@
+package canvases
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/database"
+	"github.com/superplanehq/superplane/pkg/models"
+	"github.com/superplanehq/superplane/test/support"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/datatypes"
+)
+
+func Test__GetCanvasStats(t *testing.T) {
+	r := support.Setup(t)
+
+	canvas, _ := support.CreateCanvas(
+		t,
+		r.Organization.ID,
+		r.User,
+		[]models.CanvasNode{
+			{
+				NodeID: "node-1",
+				Name:   "Node 1",
+				Type:   models.NodeTypeComponent,
+				Ref: datatypes.NewJSONType(models.NodeRef{
+					Component: &models.ComponentRef{Name: "noop"},
+				}),
+			},
+			{
+				NodeID: "node-2",
+				Name:   "Node 2",
+				Type:   models.NodeTypeComponent,
+				Ref: datatypes.NewJSONType(models.NodeRef{
+					Component: &models.ComponentRef{Name: "http"},
+				}),
+			},
+		},
+		[]models.Edge{},
+	)
+
+	rootEvent := support.EmitCanvasEventForNode(t, canvas.ID, "node-1", "default", nil)
+	finishExecution := func(nodeID, result string, duration time.Duration) {
+		execution := support.CreateCanvasNodeExecution(t, canvas.ID, nodeID, rootEvent.ID, rootEvent.ID, nil)
+		finishedAt := time.Now()
+		startedAt := finishedAt.Add(-duration)
+
+		require.NoError(t, database.Conn().Model(execution).Updates(map[string]any{
+			"state":       models.CanvasNodeExecutionStateFinished,
+			"result":      result,
+			"started_at":  startedAt,
+			"finished_at": finishedAt,
+		}).Error)
+	}
+
+	finishExecution("node-1", models.CanvasNodeExecutionResultPassed, 10*time.Second)
+	finishExecution("node-1", models.CanvasNodeExecutionResultPassed, 20*time.Second)
+	finishExecution("node-1", models.CanvasNodeExecutionResultFailed, 30*time.Second)
+	finishExecution("node-2", models.CanvasNodeExecutionResultCancelled, 5*time.Second)
+
+	//
+	// Pending executions are not counted.
+	//
+	support.CreateCanvasNodeExecution(t, canvas.ID, "node-2", rootEvent.ID, rootEvent.ID, nil)
+
+	t.Run("returns stats for the canvas and its nodes", func(t *testing.T) {
+		response, err := GetCanvasStats(context.Background(), r.Organization.ID.String(), canvas.ID, nil, nil)
+		require.NoError(t, err)
+
+		assert.Equal(t, int64(4), response.Canvas.Total)
+		assert.Equal(t, int64(2), response.Canvas.Passed)
+		assert.Equal(t, int64(1), response.Canvas.Failed)
+		assert.Equal(t, int64(1), response.Canvas.Cancelled)
+		assert.InDelta(t, 0.5, response.Canvas.SuccessRate, 0.001)
+		assert.InDelta(t, 4.0/24.0, response.Canvas.ExecutionsPerHour, 0.001)
+
+		require.Len(t, response.Nodes, 2)
+		assert.Equal(t, "node-1", response.Nodes[0].NodeId)
+		assert.Equal(t, "Node 1", response.Nodes[0].NodeName)
+		assert.Equal(t, "noop", response.Nodes[0].Component)
+		assert.Equal(t, int64(3), response.Nodes[0].Stats.Total)
+		assert.InDelta(t, 2.0/3.0, response.Nodes[0].Stats.SuccessRate, 0.001)
+		assert.InDelta(t, 20, response.Nodes[0].Stats.P50DurationSeconds, 0.5)
+		assert.InDelta(t, 29, response.Nodes[0].Stats.P95DurationSeconds, 0.5)
+
+		assert.Equal(t, "node-2", response.Nodes[1].NodeId)
+		assert.Equal(t, "http", response.Nodes[1].Component)
+		assert.Equal(t, int64(1), response.Nodes[1].Stats.Total)
+		assert.Equal(t, int64(1), response.Nodes[1].Stats.Cancelled)
+		assert.Zero(t, response.Nodes[1].Stats.SuccessRate)
+	})
+
+	t.Run("executions outside of the time range are not counted", func(t *testing.T) {
+		to := time.Now().Add(-time.Hour)
+		response, err := GetCanvasStats(
+			context.Background(),
+			r.Organization.ID.String(),
+			canvas.ID,
+			timestamppb.New(to.Add(-time.Hour)),
+			timestamppb.New(to),
+		)
+
+		require.NoError(t, err)
+		assert.Zero(t, response.Canvas.Total)
+		assert.Zero(t, response.Canvas.SuccessRate)
+		assert.Empty(t, response.Nodes)
+	})
+
+	t.Run("invalid time range", func(t *testing.T) {
+		now := time.Now()
+		_, err := GetCanvasStats(context.Background(), r.Organization.ID.String(), canvas.ID, timestamppb.New(now), timestamppb.New(now.Add(-time.Hour)))
+		s, ok := status.FromError(err)
+		require.True(t, ok)
+		assert.Equal(t, codes.InvalidArgument, s.Code())
+
+		_, err = GetCanvasStats(context.Background(), r.Organization.ID.String(), canvas.ID, timestamppb.New(now.Add(-100*24*time.Hour)), timestamppb.New(now))
+		s, ok = status.FromError(err)
+		require.True(t, ok)
+		assert.Equal(t, codes.InvalidArgument, s.Code())
+	})
+
+	t.Run("canvas from another organization", func(t *testing.T) {
+		_, err := GetCanvasStats(context.Background(), uuid.NewString(), canvas.ID, nil, nil)
+		s, ok := status.FromError(err)
+		require.True(t, ok)
+		assert.Equal(t, codes.NotFound, s.Code())
+	})
+}
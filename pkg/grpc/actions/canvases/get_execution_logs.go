@@ -0,0 +1,66 @@
+package canvases
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/superplanehq/superplane/pkg/grpc/actions/messages"
+	"github.com/superplanehq/superplane/pkg/models"
+	pb "github.com/superplanehq/superplane/pkg/protos/canvases"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	DefaultExecutionLogsLimit = 500
+	MaxExecutionLogsLimit     = 5000
+)
+
+func GetExecutionLogs(ctx context.Context, organizationID string, canvasID, executionID uuid.UUID, after uint64, limit uint32) (*pb.GetExecutionLogsResponse, error) {
+	execution, err := findCanvasExecution(organizationID, canvasID, executionID)
+	if err != nil {
+		return nil, err
+	}
+
+	limit = getExecutionLogsLimit(limit)
+
+	//
+	// We fetch one more record than requested
+	// to know if there are more logs after this page.
+	//
+	logs, err := models.ListNodeExecutionLogs(canvasID, executionID, after, int(limit)+1)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to list execution logs")
+	}
+
+	hasMore := len(logs) > int(limit)
+	if hasMore {
+		logs = logs[:limit]
+	}
+
+	lastID := after
+	serialized := make([]*pb.CanvasNodeExecutionLog, 0, len(logs))
+	for i := range logs {
+		serialized = append(serialized, messages.SerializeExecutionLog(&logs[i]))
+		lastID = logs[i].ID
+	}
+
+	return &pb.GetExecutionLogsResponse{
+		Logs:     serialized,
+		LastId:   lastID,
+		HasMore:  hasMore,
+		Finished: execution.State == models.CanvasNodeExecutionStateFinished,
+	}, nil
+}
+
+func getExecutionLogsLimit(limit uint32) uint32 {
+	if limit == 0 {
+		return DefaultExecutionLogsLimit
+	}
+
+	if limit > MaxExecutionLogsLimit {
+		return MaxExecutionLogsLimit
+	}
+
+	return limit
+}
@@ -0,0 +1,91 @@
+package canvases
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/models"
+	"github.com/superplanehq/superplane/test/support"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/datatypes"
+)
+
+func Test__GetExecutionLogs(t *testing.T) {
+	r := support.Setup(t)
+
+	canvas, _ := support.CreateCanvas(
+		t,
+		r.Organization.ID,
+		r.User,
+		[]models.CanvasNode{
+			{
+				NodeID: "node-1",
+				Name:   "Node 1",
+				Type:   models.NodeTypeComponent,
+				Ref: datatypes.NewJSONType(models.NodeRef{
+					Component: &models.ComponentRef{Name: "noop"},
+				}),
+			},
+		},
+		[]models.Edge{},
+	)
+
+	rootEvent := support.EmitCanvasEventForNode(t, canvas.ID, "node-1", "default", nil)
+	execution := support.CreateCanvasNodeExecution(t, canvas.ID, "node-1", rootEvent.ID, rootEvent.ID, nil)
+
+	for _, message := range []string{"first", "second", "third"} {
+		_, err := models.CreateNodeExecutionLog(canvas.ID, "node-1", execution.ID, models.CanvasNodeExecutionLogStreamStdout, message)
+		require.NoError(t, err)
+	}
+
+	t.Run("returns all logs", func(t *testing.T) {
+		response, err := GetExecutionLogs(context.Background(), r.Organization.ID.String(), canvas.ID, execution.ID, 0, 0)
+		require.NoError(t, err)
+		require.Len(t, response.Logs, 3)
+		assert.Equal(t, "first", response.Logs[0].Message)
+		assert.Equal(t, "stdout", response.Logs[0].Stream)
+		assert.Equal(t, execution.ID.String(), response.Logs[0].ExecutionId)
+		assert.Equal(t, response.Logs[2].Id, response.LastId)
+		assert.False(t, response.HasMore)
+		assert.False(t, response.Finished)
+	})
+
+	t.Run("paginates with after and limit", func(t *testing.T) {
+		response, err := GetExecutionLogs(context.Background(), r.Organization.ID.String(), canvas.ID, execution.ID, 0, 2)
+		require.NoError(t, err)
+		require.Len(t, response.Logs, 2)
+		assert.True(t, response.HasMore)
+
+		response, err = GetExecutionLogs(context.Background(), r.Organization.ID.String(), canvas.ID, execution.ID, response.LastId, 2)
+		require.NoError(t, err)
+		require.Len(t, response.Logs, 1)
+		assert.Equal(t, "third", response.Logs[0].Message)
+		assert.False(t, response.HasMore)
+	})
+
+	t.Run("returns no logs and keeps cursor when there is nothing new", func(t *testing.T) {
+		response, err := GetExecutionLogs(context.Background(), r.Organization.ID.String(), canvas.ID, execution.ID, 0, 0)
+		require.NoError(t, err)
+
+		next, err := GetExecutionLogs(context.Background(), r.Organization.ID.String(), canvas.ID, execution.ID, response.LastId, 0)
+		require.NoError(t, err)
+		assert.Empty(t, next.Logs)
+		assert.Equal(t, response.LastId, next.LastId)
+	})
+
+	t.Run("execution not found", func(t *testing.T) {
+		_, err := GetExecutionLogs(context.Background(), r.Organization.ID.String(), canvas.ID, uuid.New(), 0, 0)
+		require.Error(t, err)
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+
+	t.Run("canvas from another organization", func(t *testing.T) {
+		_, err := GetExecutionLogs(context.Background(), uuid.NewString(), canvas.ID, execution.ID, 0, 0)
+		require.Error(t, err)
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+}
@@ -91,6 +91,9 @@ func InvokeNodeExecutionAction(
 		Auth:           contexts.NewAuthContext(tx, orgID, authService, user),
 		Requests:       contexts.NewExecutionRequestContext(tx, execution),
 		Notifications:  contexts.NewNotificationContext(tx, orgID, canvas.ID),
+
+		IntegrationResources: contexts.NewIntegrationResourceContext(tx, orgID, registry),
+		Members:              contexts.NewOrganizationMemberContext(tx, orgID),
 	}
 
 	if node.AppInstallationID != nil {
@@ -104,7 +107,10 @@ func InvokeNodeExecutionAction(
 		actionCtx.Integration = contexts.NewIntegrationContext(tx, node, integration, encryptor, registry)
 	}
 
-	actionCtx.Logger = logger
+	logs := contexts.NewExecutionLogContext(execution)
+	actionCtx.Logs = logs
+	actionCtx.Artifacts = contexts.NewExecutionArtifactContext(execution)
+	actionCtx.Logger = logs.Logger(logger)
 	err = component.HandleAction(actionCtx)
 	if err != nil {
 		return nil, status.Errorf(codes.InvalidArgument, "action execution failed: %v", err)
@@ -116,7 +122,9 @@ func InvokeNodeExecutionAction(
 		execution.NodeID,
 	).Publish()
 
-	return &pb.InvokeNodeExecutionActionResponse{}, nil
+	return &pb.InvokeNodeExecutionActionResponse{
+		RootEventId: execution.RootEventID.String(),
+	}, nil
 }
 
 func findAction(component core.Component, actionName string) *core.Action {
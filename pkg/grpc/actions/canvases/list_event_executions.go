@@ -43,7 +43,13 @@ func ListEventExecutions(ctx context.Context, registry *registry.Registry, workf
 		return nil, err
 	}
 
+	finished, err := models.IsExecutionChainFinished(workflowUUID, eventUUID)
+	if err != nil {
+		return nil, err
+	}
+
 	return &pb.ListEventExecutionsResponse{
 		Executions: serialized,
+		Finished:   finished,
 	}, nil
 }
@@ -6,6 +6,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/database"
 	"github.com/superplanehq/superplane/pkg/models"
 	"github.com/superplanehq/superplane/test/support"
 	"gorm.io/datatypes"
@@ -168,3 +169,64 @@ func Test__ListEventExecutions__OnlyReturnsExecutionsForSpecificRootEvent(t *tes
 
 	assert.Equal(t, execution1.ID.String(), response.Executions[0].Id)
 }
+
+func Test__ListEventExecutions__ReportsWhenTheChainIsFinished(t *testing.T) {
+	r := support.Setup(t)
+
+	canvas, _ := support.CreateCanvas(
+		t,
+		r.Organization.ID,
+		r.User,
+		[]models.CanvasNode{
+			{
+				NodeID: "node-1",
+				Name:   "Node 1",
+				Type:   models.NodeTypeComponent,
+				Ref: datatypes.NewJSONType(models.NodeRef{
+					Component: &models.ComponentRef{Name: "noop"},
+				}),
+			},
+		},
+		[]models.Edge{},
+	)
+
+	rootEvent := support.EmitCanvasEventForNode(t, canvas.ID, "node-1", "default", nil)
+	isFinished := func() bool {
+		response, err := ListEventExecutions(context.Background(), r.Registry, canvas.ID.String(), rootEvent.ID.String())
+		require.NoError(t, err)
+		return response.Finished
+	}
+
+	setState := func(model any, state string) {
+		require.NoError(t, database.Conn().Model(model).Update("state", state).Error)
+	}
+
+	//
+	// The root event is not routed yet.
+	//
+	assert.False(t, isFinished())
+
+	//
+	// The root event is routed, but it is still in the queue.
+	//
+	setState(rootEvent, models.CanvasEventStateRouted)
+	queueItem := support.CreateQueueItem(t, canvas.ID, "node-1", rootEvent.ID, rootEvent.ID)
+	assert.False(t, isFinished())
+
+	//
+	// The execution is running.
+	//
+	require.NoError(t, database.Conn().Delete(queueItem).Error)
+	execution := support.CreateCanvasNodeExecution(t, canvas.ID, "node-1", rootEvent.ID, rootEvent.ID, nil)
+	assert.False(t, isFinished())
+
+	//
+	// The execution finished, but its output is not routed yet.
+	//
+	setState(execution, models.CanvasNodeExecutionStateFinished)
+	output := support.EmitCanvasEventForNode(t, canvas.ID, "node-1", "default", &execution.ID)
+	assert.False(t, isFinished())
+
+	setState(output, models.CanvasEventStateRouted)
+	assert.True(t, isFinished())
+}
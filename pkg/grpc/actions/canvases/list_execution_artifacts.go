@@ -0,0 +1,78 @@
+package canvases
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/superplanehq/superplane/pkg/models"
+	pb "github.com/superplanehq/superplane/pkg/protos/canvases"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
+)
+
+func ListExecutionArtifacts(ctx context.Context, organizationID string, canvasID, executionID uuid.UUID) (*pb.ListExecutionArtifactsResponse, error) {
+	_, err := findCanvasExecution(organizationID, canvasID, executionID)
+	if err != nil {
+		return nil, err
+	}
+
+	artifacts, err := models.ListNodeExecutionArtifacts(canvasID, executionID)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to list execution artifacts")
+	}
+
+	serialized := make([]*pb.CanvasNodeExecutionArtifact, 0, len(artifacts))
+	for i := range artifacts {
+		serialized = append(serialized, serializeExecutionArtifact(&artifacts[i]))
+	}
+
+	return &pb.ListExecutionArtifactsResponse{Artifacts: serialized}, nil
+}
+
+func findCanvasExecution(organizationID string, canvasID, executionID uuid.UUID) (*models.CanvasNodeExecution, error) {
+	orgID, err := uuid.Parse(organizationID)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid organization_id")
+	}
+
+	_, err = models.FindCanvas(orgID, canvasID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, status.Error(codes.NotFound, "canvas not found")
+		}
+
+		return nil, status.Error(codes.Internal, "failed to load canvas")
+	}
+
+	execution, err := models.FindNodeExecution(canvasID, executionID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, status.Error(codes.NotFound, "execution not found")
+		}
+
+		return nil, status.Error(codes.Internal, "failed to load execution")
+	}
+
+	return execution, nil
+}
+
+func serializeExecutionArtifact(artifact *models.CanvasNodeExecutionArtifact) *pb.CanvasNodeExecutionArtifact {
+	serialized := &pb.CanvasNodeExecutionArtifact{
+		Id:          artifact.ID.String(),
+		ExecutionId: artifact.ExecutionID.String(),
+		NodeId:      artifact.NodeID,
+		Name:        artifact.Name,
+		ContentType: artifact.ContentType,
+		Size:        artifact.Size,
+		Sha256:      artifact.SHA256,
+	}
+
+	if artifact.CreatedAt != nil {
+		serialized.CreatedAt = timestamppb.New(*artifact.CreatedAt)
+	}
+
+	return serialized
+}
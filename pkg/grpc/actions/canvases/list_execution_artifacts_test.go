@@ -0,0 +1,128 @@
+package canvases
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/artifacts"
+	"github.com/superplanehq/superplane/pkg/models"
+	pb "github.com/superplanehq/superplane/pkg/protos/canvases"
+	"github.com/superplanehq/superplane/pkg/workers/contexts"
+	"github.com/superplanehq/superplane/test/support"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/datatypes"
+)
+
+func Test__ListAndDownloadExecutionArtifacts(t *testing.T) {
+	r := support.Setup(t)
+
+	storage, err := artifacts.NewFilesystemStorage(t.TempDir())
+	require.NoError(t, err)
+
+	canvas, _ := support.CreateCanvas(
+		t,
+		r.Organization.ID,
+		r.User,
+		[]models.CanvasNode{
+			{
+				NodeID: "node-1",
+				Name:   "Node 1",
+				Type:   models.NodeTypeComponent,
+				Ref: datatypes.NewJSONType(models.NodeRef{
+					Component: &models.ComponentRef{Name: "noop"},
+				}),
+			},
+		},
+		[]models.Edge{},
+	)
+
+	rootEvent := support.EmitCanvasEventForNode(t, canvas.ID, "node-1", "default", nil)
+	execution := support.CreateCanvasNodeExecution(t, canvas.ID, "node-1", rootEvent.ID, rootEvent.ID, nil)
+
+	artifactCtx := contexts.NewExecutionArtifactContext(execution).WithStorage(storage)
+	_, err = artifactCtx.Put("report.txt", "text/plain", []byte("all tests passed"))
+	require.NoError(t, err)
+	_, err = artifactCtx.Put("coverage/index.html", "text/html", []byte("<html></html>"))
+	require.NoError(t, err)
+
+	t.Run("lists artifacts sorted by name", func(t *testing.T) {
+		response, err := ListExecutionArtifacts(context.Background(), r.Organization.ID.String(), canvas.ID, execution.ID)
+		require.NoError(t, err)
+		require.Len(t, response.Artifacts, 2)
+		assert.Equal(t, "coverage/index.html", response.Artifacts[0].Name)
+		assert.Equal(t, "report.txt", response.Artifacts[1].Name)
+		assert.Equal(t, "text/plain", response.Artifacts[1].ContentType)
+		assert.Equal(t, int64(16), response.Artifacts[1].Size)
+		assert.Equal(t, execution.ID.String(), response.Artifacts[1].ExecutionId)
+		assert.NotNil(t, response.Artifacts[1].CreatedAt)
+	})
+
+	t.Run("list for unknown execution", func(t *testing.T) {
+		_, err := ListExecutionArtifacts(context.Background(), r.Organization.ID.String(), canvas.ID, uuid.New())
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+
+	download := func(organizationID, name string) ([]*pb.DownloadExecutionArtifactResponse, error) {
+		chunks := []*pb.DownloadExecutionArtifactResponse{}
+		err := DownloadExecutionArtifact(context.Background(), storage, organizationID, canvas.ID, execution.ID, name, func(chunk *pb.DownloadExecutionArtifactResponse) error {
+			chunks = append(chunks, chunk)
+			return nil
+		})
+
+		return chunks, err
+	}
+
+	t.Run("downloads artifact content", func(t *testing.T) {
+		chunks, err := download(r.Organization.ID.String(), "report.txt")
+		require.NoError(t, err)
+		require.Len(t, chunks, 1)
+		assert.Equal(t, "report.txt", chunks[0].Artifact.Name)
+		assert.Equal(t, "all tests passed", string(chunks[0].Content))
+	})
+
+	t.Run("downloads large artifact in chunks", func(t *testing.T) {
+		content := bytes.Repeat([]byte("a"), 2*ArtifactChunkSize+1)
+		_, err := artifactCtx.Put("large.bin", "application/octet-stream", content)
+		require.NoError(t, err)
+
+		chunks, err := download(r.Organization.ID.String(), "large.bin")
+		require.NoError(t, err)
+		require.Len(t, chunks, 3)
+		assert.Equal(t, "large.bin", chunks[0].Artifact.Name)
+		assert.Nil(t, chunks[1].Artifact)
+		assert.Nil(t, chunks[2].Artifact)
+		assert.Len(t, chunks[0].Content, ArtifactChunkSize)
+		assert.Len(t, chunks[1].Content, ArtifactChunkSize)
+		assert.Len(t, chunks[2].Content, 1)
+	})
+
+	t.Run("artifact of exactly one chunk -> single chunk", func(t *testing.T) {
+		_, err := artifactCtx.Put("chunk.bin", "application/octet-stream", bytes.Repeat([]byte("a"), ArtifactChunkSize))
+		require.NoError(t, err)
+
+		chunks, err := download(r.Organization.ID.String(), "chunk.bin")
+		require.NoError(t, err)
+		require.Len(t, chunks, 1)
+		assert.Len(t, chunks[0].Content, ArtifactChunkSize)
+	})
+
+	t.Run("download requires a name", func(t *testing.T) {
+		_, err := download(r.Organization.ID.String(), "")
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("download unknown artifact", func(t *testing.T) {
+		_, err := download(r.Organization.ID.String(), "missing.txt")
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+
+	t.Run("download from canvas in another organization", func(t *testing.T) {
+		_, err := download(uuid.NewString(), "report.txt")
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+}
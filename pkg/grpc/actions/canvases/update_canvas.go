@@ -40,52 +40,21 @@ func UpdateCanvasWithAutoLayout(
 	autoLayout *pb.CanvasAutoLayout,
 	webhookBaseURL string,
 ) (*pb.UpdateCanvasResponse, error) {
-	canvasID, err := uuid.Parse(id)
-	if err != nil {
-		return nil, status.Errorf(codes.InvalidArgument, "invalid canvas id: %v", err)
-	}
-
-	existingCanvas, err := models.FindCanvas(uuid.MustParse(organizationID), canvasID)
-	if err != nil {
-		if errors.Is(err, gorm.ErrRecordNotFound) {
-			if _, templateErr := models.FindCanvasTemplate(canvasID); templateErr == nil {
-				return nil, status.Error(codes.FailedPrecondition, "templates are read-only")
-			}
-		}
-		return nil, status.Errorf(codes.NotFound, "canvas not found: %v", err)
-	}
-
-	if existingCanvas.IsTemplate {
-		return nil, status.Error(codes.FailedPrecondition, "templates are read-only")
-	}
-
-	nodes, edges, err := ParseCanvas(registry, organizationID, pbCanvas)
-	if err != nil {
-		return nil, actions.ToStatus(err)
-	}
-
-	nodes, edges, err = applyCanvasAutoLayout(nodes, edges, autoLayout)
-	if err != nil {
-		return nil, actions.ToStatus(err)
-	}
-
-	existingNodesUnscoped, err := models.FindCanvasNodesUnscoped(canvasID)
+	update, err := prepareCanvasUpdate(registry, organizationID, id, pbCanvas, autoLayout)
 	if err != nil {
-		return nil, actions.ToStatus(err)
+		return nil, err
 	}
 
-	nodes, edges, _ = remapNodeIDsForConflicts(nodes, edges, existingNodesUnscoped)
-
+	existingCanvas := update.canvas
+	canvasID := existingCanvas.ID
+	nodes := update.nodes
+	edges := update.edges
+	expandedNodes := update.expandedNodes
 	parentNodesByNodeID := make(map[string]*models.Node)
 	for i := range nodes {
 		parentNodesByNodeID[nodes[i].ID] = &nodes[i]
 	}
 
-	expandedNodes, err := expandNodes(organizationID, nodes)
-	if err != nil {
-		return nil, actions.ToStatus(err)
-	}
-
 	now := time.Now()
 
 	err = database.Conn().Transaction(func(tx *gorm.DB) error {
@@ -180,6 +149,74 @@ func UpdateCanvasWithAutoLayout(
 	}, nil
 }
 
+//
+// canvasUpdate holds a validated update of a canvas,
+// before anything is written to the database.
+//
+
+type canvasUpdate struct {
+	canvas        *models.Canvas
+	nodes         []models.Node
+	edges         []models.Edge
+	expandedNodes []models.Node
+}
+
+func prepareCanvasUpdate(
+	registry *registry.Registry,
+	organizationID string,
+	id string,
+	pbCanvas *pb.Canvas,
+	autoLayout *pb.CanvasAutoLayout,
+) (*canvasUpdate, error) {
+	canvasID, err := uuid.Parse(id)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid canvas id: %v", err)
+	}
+
+	existingCanvas, err := models.FindCanvas(uuid.MustParse(organizationID), canvasID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			if _, templateErr := models.FindCanvasTemplate(canvasID); templateErr == nil {
+				return nil, status.Error(codes.FailedPrecondition, "templates are read-only")
+			}
+		}
+		return nil, status.Errorf(codes.NotFound, "canvas not found: %v", err)
+	}
+
+	if existingCanvas.IsTemplate {
+		return nil, status.Error(codes.FailedPrecondition, "templates are read-only")
+	}
+
+	nodes, edges, err := ParseCanvas(registry, organizationID, pbCanvas)
+	if err != nil {
+		return nil, actions.ToStatus(err)
+	}
+
+	nodes, edges, err = applyCanvasAutoLayout(nodes, edges, autoLayout)
+	if err != nil {
+		return nil, actions.ToStatus(err)
+	}
+
+	existingNodesUnscoped, err := models.FindCanvasNodesUnscoped(canvasID)
+	if err != nil {
+		return nil, actions.ToStatus(err)
+	}
+
+	nodes, edges, _ = remapNodeIDsForConflicts(nodes, edges, existingNodesUnscoped)
+
+	expandedNodes, err := expandNodes(organizationID, nodes)
+	if err != nil {
+		return nil, actions.ToStatus(err)
+	}
+
+	return &canvasUpdate{
+		canvas:        existingCanvas,
+		nodes:         nodes,
+		edges:         edges,
+		expandedNodes: expandedNodes,
+	}, nil
+}
+
 // Remap node IDs that conflict with soft-deleted workflow_nodes entries so we
 // can preserve historical records while still allowing new nodes with similar
 // names to be created in the same workflow.
@@ -259,6 +296,7 @@ func upsertNode(tx *gorm.DB, existingNodes []models.CanvasNode, node models.Node
 		existingNode.Configuration = datatypes.NewJSONType(node.Configuration)
 		existingNode.Position = datatypes.NewJSONType(node.Position)
 		existingNode.IsCollapsed = node.IsCollapsed
+		existingNode.ErrorChannelEnabled = node.ErrorChannelEnabled
 		existingNode.AppInstallationID = appInstallationID
 
 		if node.ErrorMessage != nil && *node.ErrorMessage != "" {
@@ -305,21 +343,22 @@ func upsertNode(tx *gorm.DB, existingNodes []models.CanvasNode, node models.Node
 	}
 
 	canvasNode := models.CanvasNode{
-		WorkflowID:        workflowID,
-		NodeID:            node.ID,
-		ParentNodeID:      parentNodeID,
-		Name:              node.Name,
-		State:             initialState,
-		StateReason:       stateReason,
-		Type:              node.Type,
-		Ref:               datatypes.NewJSONType(node.Ref),
-		Configuration:     datatypes.NewJSONType(node.Configuration),
-		Position:          datatypes.NewJSONType(node.Position),
-		IsCollapsed:       node.IsCollapsed,
-		Metadata:          datatypes.NewJSONType(node.Metadata),
-		AppInstallationID: appInstallationID,
-		CreatedAt:         &now,
-		UpdatedAt:         &now,
+		WorkflowID:          workflowID,
+		NodeID:              node.ID,
+		ParentNodeID:        parentNodeID,
+		Name:                node.Name,
+		State:               initialState,
+		StateReason:         stateReason,
+		Type:                node.Type,
+		Ref:                 datatypes.NewJSONType(node.Ref),
+		Configuration:       datatypes.NewJSONType(node.Configuration),
+		Position:            datatypes.NewJSONType(node.Position),
+		IsCollapsed:         node.IsCollapsed,
+		ErrorChannelEnabled: node.ErrorChannelEnabled,
+		Metadata:            datatypes.NewJSONType(node.Metadata),
+		AppInstallationID:   appInstallationID,
+		CreatedAt:           &now,
+		UpdatedAt:           &now,
 	}
 
 	err := tx.Create(&canvasNode).Error
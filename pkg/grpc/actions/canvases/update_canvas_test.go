@@ -1411,3 +1411,128 @@ func TestUpdateCanvasWithAutoLayout_UnknownSeedNode(t *testing.T) {
 	require.Error(t, err)
 	assert.Contains(t, err.Error(), "auto_layout.node_ids contains unknown node")
 }
+
+func TestPlanCanvasUpdate_DescribesChangesWithoutApplying(t *testing.T) {
+	r := support.Setup(t)
+	defer r.Close()
+
+	canvas, _ := support.CreateCanvas(
+		t,
+		r.Organization.ID,
+		r.User,
+		[]models.CanvasNode{
+			{
+				NodeID:        "node-1",
+				Name:          "Node 1",
+				Type:          models.NodeTypeComponent,
+				Ref:           datatypes.NewJSONType(models.NodeRef{Component: &models.ComponentRef{Name: "noop"}}),
+				Configuration: datatypes.NewJSONType(map[string]any{"a": "1", "nested": map[string]any{"b": "2", "c": "3"}}),
+			},
+			{
+				NodeID: "node-2",
+				Name:   "Node 2",
+				Type:   models.NodeTypeComponent,
+				Ref:    datatypes.NewJSONType(models.NodeRef{Component: &models.ComponentRef{Name: "noop"}}),
+			},
+		},
+		[]models.Edge{{SourceID: "node-1", TargetID: "node-2", Channel: "default"}},
+	)
+
+	webhook := models.Webhook{State: models.WebhookStateReady}
+	require.NoError(t, database.Conn().Create(&webhook).Error)
+	require.NoError(t, database.Conn().
+		Model(&models.CanvasNode{}).
+		Where("workflow_id = ? AND node_id = ?", canvas.ID, "node-2").
+		Update("webhook_id", webhook.ID).
+		Error)
+
+	event := support.EmitCanvasEventForNode(t, canvas.ID, "node-1", "default", nil)
+	support.CreateCanvasNodeExecution(t, canvas.ID, "node-2", event.ID, event.ID, nil)
+	support.CreateQueueItem(t, canvas.ID, "node-2", event.ID, event.ID)
+
+	nodeConfig, err := structpb.NewStruct(map[string]any{"a": "1", "nested": map[string]any{"b": "2", "c": "4"}})
+	require.NoError(t, err)
+	hookConfig, err := structpb.NewStruct(map[string]any{"authentication": "signature"})
+	require.NoError(t, err)
+
+	canvasPb := &pb.Canvas{
+		Metadata: &pb.Canvas_Metadata{
+			Name:        canvas.Name,
+			Description: canvas.Description,
+		},
+		Spec: &pb.Canvas_Spec{
+			Nodes: []*componentpb.Node{
+				{
+					Id:            "node-1",
+					Name:          "Node 1",
+					Type:          componentpb.Node_TYPE_COMPONENT,
+					Component:     &componentpb.Node_ComponentRef{Name: "noop"},
+					Configuration: nodeConfig,
+					Position:      &componentpb.Position{X: 100, Y: 100},
+				},
+				{
+					Id:            "hook",
+					Name:          "Hook",
+					Type:          componentpb.Node_TYPE_TRIGGER,
+					Trigger:       &componentpb.Node_TriggerRef{Name: "webhook"},
+					Configuration: hookConfig,
+				},
+			},
+			Edges: []*componentpb.Edge{
+				{SourceId: "hook", TargetId: "node-1", Channel: "default"},
+			},
+		},
+	}
+
+	response, err := PlanCanvasUpdate(r.Registry, r.Organization.ID.String(), canvas.ID.String(), canvasPb, nil)
+	require.NoError(t, err)
+	require.NotNil(t, response.Plan)
+	require.Len(t, response.Canvas.Spec.Nodes, 2)
+
+	plan := response.Plan
+	assert.Empty(t, plan.Canvas)
+	require.Len(t, plan.Nodes, 3)
+	assert.Equal(t, pb.CanvasPlan_ACTION_CHANGE, plan.Nodes[0].Action)
+	assert.Equal(t, "node-1", plan.Nodes[0].NodeId)
+	require.Len(t, plan.Nodes[0].Fields, 1)
+	assert.Equal(t, "configuration.nested.c", plan.Nodes[0].Fields[0].Path)
+	assert.Equal(t, `"3"`, plan.Nodes[0].Fields[0].Before)
+	assert.Equal(t, `"4"`, plan.Nodes[0].Fields[0].After)
+	assert.Equal(t, pb.CanvasPlan_ACTION_ADD, plan.Nodes[1].Action)
+	assert.Equal(t, "hook", plan.Nodes[1].NodeId)
+	assert.Equal(t, "webhook", plan.Nodes[1].Ref)
+	assert.Equal(t, pb.CanvasPlan_ACTION_REMOVE, plan.Nodes[2].Action)
+	assert.Equal(t, "node-2", plan.Nodes[2].NodeId)
+
+	require.Len(t, plan.Edges, 2)
+	assert.Equal(t, pb.CanvasPlan_ACTION_ADD, plan.Edges[0].Action)
+	assert.Equal(t, "hook", plan.Edges[0].SourceId)
+	assert.Equal(t, pb.CanvasPlan_ACTION_REMOVE, plan.Edges[1].Action)
+	assert.Equal(t, "node-2", plan.Edges[1].TargetId)
+
+	require.Len(t, plan.Webhooks, 2)
+	assert.Equal(t, pb.CanvasPlan_ACTION_REMOVE, plan.Webhooks[0].Action)
+	assert.Equal(t, webhook.ID.String(), plan.Webhooks[0].WebhookId)
+	assert.Equal(t, pb.CanvasPlan_ACTION_ADD, plan.Webhooks[1].Action)
+	assert.Equal(t, "hook", plan.Webhooks[1].NodeId)
+
+	require.Len(t, plan.Orphaned, 1)
+	assert.Equal(t, "node-2", plan.Orphaned[0].NodeId)
+	assert.Equal(t, int64(1), plan.Orphaned[0].RunningExecutions)
+	assert.Equal(t, int64(1), plan.Orphaned[0].QueueItems)
+
+	//
+	// Nothing is changed.
+	//
+	nodes, err := models.FindCanvasNodes(canvas.ID)
+	require.NoError(t, err)
+	require.Len(t, nodes, 2)
+
+	updatedCanvas, err := models.FindCanvas(r.Organization.ID, canvas.ID)
+	require.NoError(t, err)
+	assert.Len(t, updatedCanvas.Nodes, 2)
+	assert.Len(t, updatedCanvas.Edges, 1)
+
+	_, err = models.FindWebhook(webhook.ID)
+	require.NoError(t, err)
+}
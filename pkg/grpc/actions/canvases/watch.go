@@ -0,0 +1,492 @@
+package canvases
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	log "github.com/sirupsen/logrus"
+	"github.com/superplanehq/superplane/pkg/models"
+	pb "github.com/superplanehq/superplane/pkg/protos/canvases"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	WatchPollInterval      = time.Second
+	WatchHeartbeatInterval = 15 * time.Second
+
+	//
+	// Rows are read again for a while after their timestamps,
+	// because a transaction can commit after rows with later timestamps
+	// were already seen. Changes are delivered at least once.
+	//
+	watchOverlap = 5 * time.Second
+
+	watchMaxResumeAge  = 24 * time.Hour
+	watchPageSize      = 500
+	watchMaxPages      = 10
+	watchMaxQueueItems = 1000
+)
+
+//
+// The watchers poll the database instead of consuming the RabbitMQ messages
+// used by the websocket hub, so a stream resumed with a token
+// also gets the changes that happened while the client was disconnected.
+//
+// Queue items are deleted when consumed, so the token carries the IDs
+// of the queue items the client knows about, and the deletions are
+// found by comparing them with the current queue.
+//
+
+type watchResumeToken struct {
+	Cursor     time.Time `json:"c"`
+	QueueItems []string  `json:"q,omitempty"`
+}
+
+func encodeWatchResumeToken(cursor time.Time, queueItems map[string]string) string {
+	token := watchResumeToken{Cursor: cursor.UTC()}
+	for id := range queueItems {
+		token.QueueItems = append(token.QueueItems, id)
+	}
+
+	sort.Strings(token.QueueItems)
+	data, _ := json.Marshal(token)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+func decodeWatchResumeToken(value string, now time.Time) (*watchResumeToken, error) {
+	data, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid resume token")
+	}
+
+	var token watchResumeToken
+	err = json.Unmarshal(data, &token)
+	if err != nil || token.Cursor.IsZero() {
+		return nil, status.Error(codes.InvalidArgument, "invalid resume token")
+	}
+
+	if now.Sub(token.Cursor) > watchMaxResumeAge {
+		return nil, status.Error(codes.InvalidArgument, "resume token expired")
+	}
+
+	if token.Cursor.After(now) {
+		token.Cursor = now
+	}
+
+	return &token, nil
+}
+
+type watchChange struct {
+	change *pb.CanvasChange
+
+	//
+	// Applied to the tracked queue items before
+	// the resume token of the change is generated.
+	//
+	apply func()
+}
+
+type watcher struct {
+	canvasID    uuid.UUID
+	executionID *uuid.UUID
+	cursor      time.Time
+	floor       time.Time
+	sent        map[string]time.Time
+	queueItems  map[string]string
+	lastSentAt  time.Time
+	send        func(*pb.CanvasChange) error
+}
+
+//
+// newWatcher creates a watcher for the events, executions and queue items of a canvas.
+// If executionID is given, only that execution, its child executions and their
+// output events are watched, and queue items are not tracked.
+//
+
+func newWatcher(canvasID uuid.UUID, executionID *uuid.UUID, resumeToken string, send func(*pb.CanvasChange) error) (*watcher, error) {
+	w := &watcher{
+		canvasID:    canvasID,
+		executionID: executionID,
+		cursor:      time.Now(),
+		sent:        map[string]time.Time{},
+		send:        send,
+	}
+
+	var token *watchResumeToken
+	if resumeToken != "" {
+		var err error
+		token, err = decodeWatchResumeToken(resumeToken, w.cursor)
+		if err != nil {
+			return nil, err
+		}
+
+		w.cursor = token.Cursor
+	} else {
+		//
+		// A new stream only sends the changes made after it started,
+		// so rows are not read again from before that.
+		//
+		w.floor = w.cursor
+	}
+
+	if executionID != nil {
+		return w, nil
+	}
+
+	w.queueItems = map[string]string{}
+	if token != nil {
+		for _, id := range token.QueueItems {
+			w.queueItems[id] = ""
+		}
+
+		return w, nil
+	}
+
+	//
+	// Without a resume token, the stream starts from the current queue,
+	// so only the changes made after the stream started are sent.
+	//
+	queueItems, err := models.ListCanvasQueueItems(canvasID, watchMaxQueueItems)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, queueItem := range queueItems {
+		w.queueItems[queueItem.ID.String()] = queueItem.NodeID
+	}
+
+	return w, nil
+}
+
+//
+// run polls for changes until the context is done.
+// The check function is called before each poll, to verify
+// that the watched resource still exists, and to stop
+// the stream after the changes of a finished execution are sent.
+//
+
+func (w *watcher) run(ctx context.Context, check func() (bool, error)) error {
+	err := w.sendHeartbeat()
+	if err != nil {
+		return err
+	}
+
+	ticker := time.NewTicker(WatchPollInterval)
+	defer ticker.Stop()
+
+	for {
+		finished, err := check()
+		if err != nil {
+			return err
+		}
+
+		err = w.poll()
+		if err != nil {
+			if _, ok := status.FromError(err); ok {
+				return err
+			}
+
+			log.Errorf("Error watching canvas %s: %v", w.canvasID, err)
+			return status.Error(codes.Internal, "failed to watch canvas")
+		}
+
+		if finished {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+func (w *watcher) poll() error {
+	now := time.Now()
+	since := laterOf(w.cursor.Add(-watchOverlap), w.floor)
+	newCursor := w.cursor
+	changes := []watchChange{}
+
+	events, eventsTruncated, err := listAfter(
+		since,
+		func(after time.Time) ([]models.CanvasEvent, error) {
+			return models.ListCanvasEventsCreatedAfter(w.canvasID, w.executionID, after, watchPageSize)
+		},
+		func(event models.CanvasEvent) time.Time { return *event.CreatedAt },
+	)
+
+	if err != nil {
+		return err
+	}
+
+	for _, event := range events {
+		newCursor = laterOf(newCursor, *event.CreatedAt)
+		if !w.markSent("event:"+event.ID.String(), *event.CreatedAt) {
+			continue
+		}
+
+		serialized, err := SerializeCanvasEvent(event)
+		if err != nil {
+			return err
+		}
+
+		changes = append(changes, watchChange{change: &pb.CanvasChange{
+			Type:      pb.CanvasChangeType_CANVAS_CHANGE_TYPE_EVENT_CREATED,
+			Timestamp: timestamppb.New(*event.CreatedAt),
+			Event:     serialized,
+		}})
+	}
+
+	executions, executionsTruncated, err := listAfter(
+		since,
+		func(after time.Time) ([]models.CanvasNodeExecution, error) {
+			return models.ListNodeExecutionsUpdatedAfter(w.canvasID, w.executionID, after, watchPageSize)
+		},
+		func(execution models.CanvasNodeExecution) time.Time { return *execution.UpdatedAt },
+	)
+
+	if err != nil {
+		return err
+	}
+
+	updated := []models.CanvasNodeExecution{}
+	for _, execution := range executions {
+		newCursor = laterOf(newCursor, *execution.UpdatedAt)
+		if w.markSent("execution:"+execution.ID.String(), *execution.UpdatedAt) {
+			updated = append(updated, execution)
+		}
+	}
+
+	if len(updated) > 0 {
+		serializedExecutions, err := SerializeNodeExecutions(updated, []models.CanvasNodeExecution{})
+		if err != nil {
+			return err
+		}
+
+		for i, serialized := range serializedExecutions {
+			changes = append(changes, watchChange{change: &pb.CanvasChange{
+				Type:      pb.CanvasChangeType_CANVAS_CHANGE_TYPE_EXECUTION_UPDATED,
+				Timestamp: timestamppb.New(*updated[i].UpdatedAt),
+				Execution: serialized,
+			}})
+		}
+	}
+
+	if w.queueItems != nil {
+		queueChanges, err := w.queueChanges(now)
+		if err != nil {
+			return err
+		}
+
+		changes = append(changes, queueChanges...)
+	}
+
+	//
+	// The cursor only moves past rows that were read,
+	// so it can't go beyond the last row of a truncated list.
+	//
+	truncated := false
+	if eventsTruncated {
+		truncated = true
+		newCursor = earlierOf(newCursor, *events[len(events)-1].CreatedAt)
+	}
+
+	if executionsTruncated {
+		truncated = true
+		newCursor = earlierOf(newCursor, *executions[len(executions)-1].UpdatedAt)
+	}
+
+	if !truncated {
+		newCursor = laterOf(newCursor, now.Add(-watchOverlap))
+	}
+
+	for i, change := range changes {
+		if change.apply != nil {
+			change.apply()
+		}
+
+		cursor := w.cursor
+		if i == len(changes)-1 {
+			cursor = newCursor
+		}
+
+		change.change.ResumeToken = encodeWatchResumeToken(cursor, w.queueItems)
+		err := w.sendChange(change.change)
+		if err != nil {
+			return err
+		}
+	}
+
+	w.cursor = newCursor
+	w.pruneSent(newCursor.Add(-watchOverlap))
+
+	if time.Since(w.lastSentAt) >= WatchHeartbeatInterval {
+		return w.sendHeartbeat()
+	}
+
+	return nil
+}
+
+//
+// Only the oldest watchMaxQueueItems queue items are tracked.
+// While the queue is longer than that, newer queue items are
+// reported once older ones are consumed, and deletions are only
+// reported for queue items that would be in the list.
+//
+
+func (w *watcher) queueChanges(now time.Time) ([]watchChange, error) {
+	queueItems, err := models.ListCanvasQueueItems(w.canvasID, watchMaxQueueItems)
+	if err != nil {
+		return nil, err
+	}
+
+	changes := []watchChange{}
+	current := make(map[string]bool, len(queueItems))
+	for _, queueItem := range queueItems {
+		current[queueItem.ID.String()] = true
+	}
+
+	if len(queueItems) < watchMaxQueueItems {
+		deleted := []string{}
+		for id := range w.queueItems {
+			if !current[id] {
+				deleted = append(deleted, id)
+			}
+		}
+
+		sort.Strings(deleted)
+		for _, id := range deleted {
+			changes = append(changes, watchChange{
+				change: &pb.CanvasChange{
+					Type:      pb.CanvasChangeType_CANVAS_CHANGE_TYPE_QUEUE_ITEM_DELETED,
+					Timestamp: timestamppb.New(now),
+					QueueItem: &pb.CanvasNodeQueueItem{
+						Id:       id,
+						CanvasId: w.canvasID.String(),
+						NodeId:   w.queueItems[id],
+					},
+				},
+				apply: func() { delete(w.queueItems, id) },
+			})
+		}
+	}
+
+	created := []models.CanvasNodeQueueItem{}
+	for _, queueItem := range queueItems {
+		if _, ok := w.queueItems[queueItem.ID.String()]; ok {
+			w.queueItems[queueItem.ID.String()] = queueItem.NodeID
+			continue
+		}
+
+		created = append(created, queueItem)
+	}
+
+	if len(created) == 0 {
+		return changes, nil
+	}
+
+	serialized, err := SerializeNodeQueueItems(created)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, queueItem := range created {
+		changes = append(changes, watchChange{
+			change: &pb.CanvasChange{
+				Type:      pb.CanvasChangeType_CANVAS_CHANGE_TYPE_QUEUE_ITEM_CREATED,
+				Timestamp: timestamppb.New(*queueItem.CreatedAt),
+				QueueItem: serialized[i],
+			},
+			apply: func() { w.queueItems[queueItem.ID.String()] = queueItem.NodeID },
+		})
+	}
+
+	return changes, nil
+}
+
+func (w *watcher) sendHeartbeat() error {
+	return w.sendChange(&pb.CanvasChange{
+		Type:        pb.CanvasChangeType_CANVAS_CHANGE_TYPE_HEARTBEAT,
+		Timestamp:   timestamppb.Now(),
+		ResumeToken: encodeWatchResumeToken(w.cursor, w.queueItems),
+	})
+}
+
+func (w *watcher) sendChange(change *pb.CanvasChange) error {
+	err := w.send(change)
+	if err != nil {
+		return err
+	}
+
+	w.lastSentAt = time.Now()
+	return nil
+}
+
+//
+// markSent records a version of a row as sent,
+// returning false if it was already sent by this stream.
+//
+
+func (w *watcher) markSent(key string, timestamp time.Time) bool {
+	if sent, ok := w.sent[key]; ok && !timestamp.After(sent) {
+		return false
+	}
+
+	w.sent[key] = timestamp
+	return true
+}
+
+func (w *watcher) pruneSent(before time.Time) {
+	for key, timestamp := range w.sent {
+		if timestamp.Before(before) {
+			delete(w.sent, key)
+		}
+	}
+}
+
+//
+// listAfter reads the rows after a timestamp, a page at a time.
+// It stops after watchMaxPages pages, returning true if there could be more rows.
+//
+
+func listAfter[T any](after time.Time, list func(time.Time) ([]T, error), timestamp func(T) time.Time) ([]T, bool, error) {
+	result := []T{}
+	for range watchMaxPages {
+		page, err := list(after)
+		if err != nil {
+			return nil, false, err
+		}
+
+		result = append(result, page...)
+		if len(page) < watchPageSize {
+			return result, false, nil
+		}
+
+		after = timestamp(page[len(page)-1])
+	}
+
+	return result, true, nil
+}
+
+func laterOf(a, b time.Time) time.Time {
+	if b.After(a) {
+		return b
+	}
+
+	return a
+}
+
+func earlierOf(a, b time.Time) time.Time {
+	if b.Before(a) {
+		return b
+	}
+
+	return a
+}
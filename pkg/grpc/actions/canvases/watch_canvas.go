@@ -0,0 +1,47 @@
+package canvases
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/superplanehq/superplane/pkg/models"
+	pb "github.com/superplanehq/superplane/pkg/protos/canvases"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+func WatchCanvas(ctx context.Context, organizationID string, canvasID uuid.UUID, resumeToken string, send func(*pb.CanvasChange) error) error {
+	orgID, err := uuid.Parse(organizationID)
+	if err != nil {
+		return status.Error(codes.InvalidArgument, "invalid organization_id")
+	}
+
+	err = findWatchedCanvas(orgID, canvasID)
+	if err != nil {
+		return err
+	}
+
+	watcher, err := newWatcher(canvasID, nil, resumeToken, send)
+	if err != nil {
+		return err
+	}
+
+	return watcher.run(ctx, func() (bool, error) {
+		return false, findWatchedCanvas(orgID, canvasID)
+	})
+}
+
+func findWatchedCanvas(orgID, canvasID uuid.UUID) error {
+	_, err := models.FindCanvas(orgID, canvasID)
+	if err == nil {
+		return nil
+	}
+
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return status.Error(codes.NotFound, "canvas not found")
+	}
+
+	return status.Error(codes.Internal, "failed to load canvas")
+}
@@ -0,0 +1,318 @@
+package canvases
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/database"
+	"github.com/superplanehq/superplane/pkg/models"
+	pb "github.com/superplanehq/superplane/pkg/protos/canvases"
+	"github.com/superplanehq/superplane/test/support"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+)
+
+type watchResult struct {
+	changes chan *pb.CanvasChange
+	done    chan error
+	cancel  context.CancelFunc
+}
+
+func startWatch(watch func(context.Context, func(*pb.CanvasChange) error) error) *watchResult {
+	ctx, cancel := context.WithCancel(context.Background())
+	result := &watchResult{
+		changes: make(chan *pb.CanvasChange, 100),
+		done:    make(chan error, 1),
+		cancel:  cancel,
+	}
+
+	go func() {
+		result.done <- watch(ctx, func(change *pb.CanvasChange) error {
+			result.changes <- change
+			return nil
+		})
+	}()
+
+	return result
+}
+
+func (w *watchResult) next(t *testing.T, changeType pb.CanvasChangeType) *pb.CanvasChange {
+	return w.nextMatching(t, changeType, func(*pb.CanvasChange) bool { return true })
+}
+
+func (w *watchResult) nextMatching(t *testing.T, changeType pb.CanvasChangeType, match func(*pb.CanvasChange) bool) *pb.CanvasChange {
+	timeout := time.After(5 * time.Second)
+	for {
+		select {
+		case change := <-w.changes:
+			if change.Type == changeType && match(change) {
+				return change
+			}
+		case <-timeout:
+			require.FailNow(t, "timed out waiting for change", changeType.String())
+			return nil
+		}
+	}
+}
+
+func Test__WatchCanvas(t *testing.T) {
+	r := support.Setup(t)
+	orgID := r.Organization.ID.String()
+
+	canvas, _ := support.CreateCanvas(
+		t,
+		r.Organization.ID,
+		r.User,
+		[]models.CanvasNode{
+			{
+				NodeID: "node-1",
+				Name:   "node-1",
+				Type:   models.NodeTypeComponent,
+				Ref:    datatypes.NewJSONType(models.NodeRef{Component: &models.ComponentRef{Name: "noop"}}),
+			},
+		},
+		[]models.Edge{},
+	)
+
+	t.Run("canvas that does not exist -> error", func(t *testing.T) {
+		err := WatchCanvas(context.Background(), orgID, uuid.New(), "", func(*pb.CanvasChange) error { return nil })
+		s, ok := status.FromError(err)
+		require.True(t, ok)
+		assert.Equal(t, codes.NotFound, s.Code())
+	})
+
+	t.Run("invalid resume token -> error", func(t *testing.T) {
+		err := WatchCanvas(context.Background(), orgID, canvas.ID, "not-a-token", func(*pb.CanvasChange) error { return nil })
+		s, ok := status.FromError(err)
+		require.True(t, ok)
+		assert.Equal(t, codes.InvalidArgument, s.Code())
+	})
+
+	t.Run("expired resume token -> error", func(t *testing.T) {
+		token := encodeWatchResumeToken(time.Now().Add(-48*time.Hour), nil)
+		err := WatchCanvas(context.Background(), orgID, canvas.ID, token, func(*pb.CanvasChange) error { return nil })
+		s, ok := status.FromError(err)
+		require.True(t, ok)
+		assert.Equal(t, codes.InvalidArgument, s.Code())
+		assert.Equal(t, "resume token expired", s.Message())
+	})
+
+	t.Run("streams events, executions and queue items", func(t *testing.T) {
+		watch := startWatch(func(ctx context.Context, send func(*pb.CanvasChange) error) error {
+			return WatchCanvas(ctx, orgID, canvas.ID, "", send)
+		})
+
+		defer watch.cancel()
+
+		heartbeat := watch.next(t, pb.CanvasChangeType_CANVAS_CHANGE_TYPE_HEARTBEAT)
+		assert.NotEmpty(t, heartbeat.ResumeToken)
+
+		event := support.EmitCanvasEventForNode(t, canvas.ID, "node-1", "default", nil)
+		change := watch.next(t, pb.CanvasChangeType_CANVAS_CHANGE_TYPE_EVENT_CREATED)
+		assert.Equal(t, event.ID.String(), change.Event.Id)
+		assert.NotEmpty(t, change.ResumeToken)
+
+		queueItem := support.CreateQueueItem(t, canvas.ID, "node-1", event.ID, event.ID)
+		change = watch.next(t, pb.CanvasChangeType_CANVAS_CHANGE_TYPE_QUEUE_ITEM_CREATED)
+		assert.Equal(t, queueItem.ID.String(), change.QueueItem.Id)
+
+		execution := support.CreateCanvasNodeExecution(t, canvas.ID, "node-1", event.ID, event.ID, nil)
+		change = watch.next(t, pb.CanvasChangeType_CANVAS_CHANGE_TYPE_EXECUTION_UPDATED)
+		assert.Equal(t, execution.ID.String(), change.Execution.Id)
+		assert.Equal(t, pb.CanvasNodeExecution_STATE_PENDING, change.Execution.State)
+
+		require.NoError(t, queueItem.Delete(database.Conn()))
+		change = watch.next(t, pb.CanvasChangeType_CANVAS_CHANGE_TYPE_QUEUE_ITEM_DELETED)
+		assert.Equal(t, queueItem.ID.String(), change.QueueItem.Id)
+		assert.Equal(t, "node-1", change.QueueItem.NodeId)
+
+		require.NoError(t, database.Conn().Transaction(func(tx *gorm.DB) error {
+			return execution.StartInTransaction(tx)
+		}))
+
+		change = watch.next(t, pb.CanvasChangeType_CANVAS_CHANGE_TYPE_EXECUTION_UPDATED)
+		assert.Equal(t, execution.ID.String(), change.Execution.Id)
+		assert.Equal(t, pb.CanvasNodeExecution_STATE_STARTED, change.Execution.State)
+	})
+
+	t.Run("resumed stream sends the changes made while disconnected", func(t *testing.T) {
+		rootEvent := support.EmitCanvasEventForNode(t, canvas.ID, "node-1", "default", nil)
+		queueItem := support.CreateQueueItem(t, canvas.ID, "node-1", rootEvent.ID, rootEvent.ID)
+
+		watch := startWatch(func(ctx context.Context, send func(*pb.CanvasChange) error) error {
+			return WatchCanvas(ctx, orgID, canvas.ID, "", send)
+		})
+
+		token := watch.next(t, pb.CanvasChangeType_CANVAS_CHANGE_TYPE_HEARTBEAT).ResumeToken
+		watch.cancel()
+		require.NoError(t, <-watch.done)
+
+		event := support.EmitCanvasEventForNode(t, canvas.ID, "node-1", "default", nil)
+		require.NoError(t, queueItem.Delete(database.Conn()))
+
+		watch = startWatch(func(ctx context.Context, send func(*pb.CanvasChange) error) error {
+			return WatchCanvas(ctx, orgID, canvas.ID, token, send)
+		})
+
+		defer watch.cancel()
+
+		//
+		// Changes from right before the token are sent again,
+		// so look for the ones made while disconnected.
+		//
+		watch.nextMatching(t, pb.CanvasChangeType_CANVAS_CHANGE_TYPE_EVENT_CREATED, func(change *pb.CanvasChange) bool {
+			return change.Event.Id == event.ID.String()
+		})
+
+		change := watch.next(t, pb.CanvasChangeType_CANVAS_CHANGE_TYPE_QUEUE_ITEM_DELETED)
+		assert.Equal(t, queueItem.ID.String(), change.QueueItem.Id)
+	})
+
+	t.Run("stream ends when the canvas is deleted", func(t *testing.T) {
+		other, _ := support.CreateCanvas(t, r.Organization.ID, r.User, []models.CanvasNode{}, []models.Edge{})
+		watch := startWatch(func(ctx context.Context, send func(*pb.CanvasChange) error) error {
+			return WatchCanvas(ctx, orgID, other.ID, "", send)
+		})
+
+		defer watch.cancel()
+
+		watch.next(t, pb.CanvasChangeType_CANVAS_CHANGE_TYPE_HEARTBEAT)
+		require.NoError(t, other.SoftDelete())
+
+		select {
+		case err := <-watch.done:
+			s, ok := status.FromError(err)
+			require.True(t, ok)
+			assert.Equal(t, codes.NotFound, s.Code())
+		case <-time.After(5 * time.Second):
+			require.FailNow(t, "stream did not end")
+		}
+	})
+}
+
+func Test__WatchExecution(t *testing.T) {
+	r := support.Setup(t)
+	orgID := r.Organization.ID.String()
+
+	canvas, _ := support.CreateCanvas(
+		t,
+		r.Organization.ID,
+		r.User,
+		[]models.CanvasNode{
+			{
+				NodeID: "node-1",
+				Name:   "node-1",
+				Type:   models.NodeTypeComponent,
+				Ref:    datatypes.NewJSONType(models.NodeRef{Component: &models.ComponentRef{Name: "noop"}}),
+			},
+		},
+		[]models.Edge{},
+	)
+
+	rootEvent := support.EmitCanvasEventForNode(t, canvas.ID, "node-1", "default", nil)
+
+	t.Run("execution that does not exist -> error", func(t *testing.T) {
+		err := WatchExecution(context.Background(), orgID, canvas.ID, uuid.New(), "", func(*pb.CanvasChange) error { return nil })
+		s, ok := status.FromError(err)
+		require.True(t, ok)
+		assert.Equal(t, codes.NotFound, s.Code())
+	})
+
+	t.Run("streams the execution until it finishes", func(t *testing.T) {
+		execution := support.CreateCanvasNodeExecution(t, canvas.ID, "node-1", rootEvent.ID, rootEvent.ID, nil)
+		other := support.CreateCanvasNodeExecution(t, canvas.ID, "node-1", rootEvent.ID, rootEvent.ID, nil)
+
+		watch := startWatch(func(ctx context.Context, send func(*pb.CanvasChange) error) error {
+			return WatchExecution(ctx, orgID, canvas.ID, execution.ID, "", send)
+		})
+
+		defer watch.cancel()
+
+		watch.next(t, pb.CanvasChangeType_CANVAS_CHANGE_TYPE_HEARTBEAT)
+
+		require.NoError(t, database.Conn().Transaction(func(tx *gorm.DB) error {
+			err := other.StartInTransaction(tx)
+			if err != nil {
+				return err
+			}
+
+			err = execution.StartInTransaction(tx)
+			if err != nil {
+				return err
+			}
+
+			_, err = execution.PassInTransaction(tx, map[string][]any{"default": {map[string]any{"a": "b"}}})
+			return err
+		}))
+
+		select {
+		case err := <-watch.done:
+			require.NoError(t, err)
+		case <-time.After(5 * time.Second):
+			require.FailNow(t, "stream did not end")
+		}
+
+		close(watch.changes)
+		executionUpdates := 0
+		outputEvents := 0
+		for change := range watch.changes {
+			switch change.Type {
+			case pb.CanvasChangeType_CANVAS_CHANGE_TYPE_EXECUTION_UPDATED:
+				assert.Equal(t, execution.ID.String(), change.Execution.Id)
+				assert.Equal(t, pb.CanvasNodeExecution_STATE_FINISHED, change.Execution.State)
+				executionUpdates++
+			case pb.CanvasChangeType_CANVAS_CHANGE_TYPE_EVENT_CREATED:
+				assert.Equal(t, "node-1", change.Event.NodeId)
+				assert.Equal(t, "default", change.Event.Channel)
+				outputEvents++
+			}
+		}
+
+		assert.Equal(t, 1, executionUpdates)
+		assert.Equal(t, 1, outputEvents)
+	})
+
+	t.Run("finished execution -> stream ends right away", func(t *testing.T) {
+		execution := support.CreateCanvasNodeExecution(t, canvas.ID, "node-1", rootEvent.ID, rootEvent.ID, nil)
+		require.NoError(t, database.Conn().Model(execution).Update("state", models.CanvasNodeExecutionStateFinished).Error)
+
+		watch := startWatch(func(ctx context.Context, send func(*pb.CanvasChange) error) error {
+			return WatchExecution(ctx, orgID, canvas.ID, execution.ID, "", send)
+		})
+
+		defer watch.cancel()
+
+		select {
+		case err := <-watch.done:
+			require.NoError(t, err)
+		case <-time.After(5 * time.Second):
+			require.FailNow(t, "stream did not end")
+		}
+	})
+}
+
+func Test__WatchResumeToken(t *testing.T) {
+	now := time.Now()
+
+	t.Run("round trip", func(t *testing.T) {
+		cursor := now.Add(-time.Minute)
+		value := encodeWatchResumeToken(cursor, map[string]string{"b": "node-1", "a": ""})
+
+		token, err := decodeWatchResumeToken(value, now)
+		require.NoError(t, err)
+		assert.True(t, cursor.Equal(token.Cursor))
+		assert.Equal(t, []string{"a", "b"}, token.QueueItems)
+	})
+
+	t.Run("cursor in the future is moved to now", func(t *testing.T) {
+		token, err := decodeWatchResumeToken(encodeWatchResumeToken(now.Add(time.Hour), nil), now)
+		require.NoError(t, err)
+		assert.True(t, now.Equal(token.Cursor))
+	})
+}
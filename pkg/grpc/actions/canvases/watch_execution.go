@@ -0,0 +1,63 @@
+package canvases
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/superplanehq/superplane/pkg/models"
+	pb "github.com/superplanehq/superplane/pkg/protos/canvases"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+//
+// WatchExecution streams the changes of an execution,
+// and ends the stream once the execution is finished
+// and all its changes were sent.
+//
+
+func WatchExecution(ctx context.Context, organizationID string, canvasID, executionID uuid.UUID, resumeToken string, send func(*pb.CanvasChange) error) error {
+	orgID, err := uuid.Parse(organizationID)
+	if err != nil {
+		return status.Error(codes.InvalidArgument, "invalid organization_id")
+	}
+
+	err = findWatchedCanvas(orgID, canvasID)
+	if err != nil {
+		return err
+	}
+
+	_, err = findWatchedExecution(canvasID, executionID)
+	if err != nil {
+		return err
+	}
+
+	watcher, err := newWatcher(canvasID, &executionID, resumeToken, send)
+	if err != nil {
+		return err
+	}
+
+	return watcher.run(ctx, func() (bool, error) {
+		execution, err := findWatchedExecution(canvasID, executionID)
+		if err != nil {
+			return false, err
+		}
+
+		return execution.State == models.CanvasNodeExecutionStateFinished, nil
+	})
+}
+
+func findWatchedExecution(canvasID, executionID uuid.UUID) (*models.CanvasNodeExecution, error) {
+	execution, err := models.FindNodeExecution(canvasID, executionID)
+	if err == nil {
+		return execution, nil
+	}
+
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, status.Error(codes.NotFound, "execution not found")
+	}
+
+	return nil, status.Error(codes.Internal, "failed to load execution")
+}
@@ -703,16 +703,17 @@ func ProtoToNodes(nodes []*componentpb.Node) []models.Node {
 		}
 
 		result[i] = models.Node{
-			ID:             node.Id,
-			Name:           node.Name,
-			Type:           ProtoToNodeType(node.Type),
-			Ref:            ProtoToNodeRef(node),
-			Configuration:  node.Configuration.AsMap(),
-			Position:       ProtoToPosition(node.Position),
-			IsCollapsed:    node.IsCollapsed,
-			IntegrationID:  integrationID,
-			ErrorMessage:   errorMessage,
-			WarningMessage: warningMessage,
+			ID:                  node.Id,
+			Name:                node.Name,
+			Type:                ProtoToNodeType(node.Type),
+			Ref:                 ProtoToNodeRef(node),
+			Configuration:       node.Configuration.AsMap(),
+			Position:            ProtoToPosition(node.Position),
+			IsCollapsed:         node.IsCollapsed,
+			IntegrationID:       integrationID,
+			ErrorMessage:        errorMessage,
+			WarningMessage:      warningMessage,
+			ErrorChannelEnabled: node.ErrorChannelEnabled,
 		}
 	}
 	return result
@@ -722,11 +723,12 @@ func NodesToProto(nodes []models.Node) []*componentpb.Node {
 	result := make([]*componentpb.Node, len(nodes))
 	for i, node := range nodes {
 		result[i] = &componentpb.Node{
-			Id:          node.ID,
-			Name:        node.Name,
-			Type:        NodeTypeToProto(node.Type),
-			Position:    PositionToProto(node.Position),
-			IsCollapsed: node.IsCollapsed,
+			Id:                  node.ID,
+			Name:                node.Name,
+			Type:                NodeTypeToProto(node.Type),
+			Position:            PositionToProto(node.Position),
+			IsCollapsed:         node.IsCollapsed,
+			ErrorChannelEnabled: node.ErrorChannelEnabled,
 		}
 
 		if node.Ref.Component != nil {
@@ -0,0 +1,26 @@
+package me
+
+import (
+	"context"
+
+	"github.com/superplanehq/superplane/pkg/models"
+	pb "github.com/superplanehq/superplane/pkg/protos/me"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func GetNotificationPreferences(ctx context.Context) (*pb.GetNotificationPreferencesResponse, error) {
+	user, err := findCurrentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	preference, err := models.FindNotificationPreference(user.OrganizationID, user.ID)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to load notification preferences")
+	}
+
+	return &pb.GetNotificationPreferencesResponse{
+		Preferences: serializeNotificationPreferences(preference),
+	}, nil
+}
@@ -0,0 +1,106 @@
+package me
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/superplanehq/superplane/pkg/authentication"
+	"github.com/superplanehq/superplane/pkg/models"
+	pb "github.com/superplanehq/superplane/pkg/protos/me"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var notificationChannelTypes = map[string]pb.NotificationChannelType{
+	models.NotificationChannelEmail:    pb.NotificationChannelType_NOTIFICATION_CHANNEL_TYPE_EMAIL,
+	models.NotificationChannelSlack:    pb.NotificationChannelType_NOTIFICATION_CHANNEL_TYPE_SLACK,
+	models.NotificationChannelTelegram: pb.NotificationChannelType_NOTIFICATION_CHANNEL_TYPE_TELEGRAM,
+	models.NotificationChannelWebhook:  pb.NotificationChannelType_NOTIFICATION_CHANNEL_TYPE_WEBHOOK,
+}
+
+func findCurrentUser(ctx context.Context) (*models.User, error) {
+	userID, userIsSet := authentication.GetUserIdFromMetadata(ctx)
+	if !userIsSet {
+		return nil, status.Error(codes.Unauthenticated, "user not authenticated")
+	}
+
+	orgID, orgIsSet := authentication.GetOrganizationIdFromMetadata(ctx)
+	if !orgIsSet {
+		return nil, status.Error(codes.Unauthenticated, "user not authenticated")
+	}
+
+	user, err := models.FindActiveUserByID(orgID, userID)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, "user not found")
+	}
+
+	return user, nil
+}
+
+func serializeNotificationPreferences(preference *models.NotificationPreference) *pb.NotificationPreferences {
+	channels := make([]*pb.NotificationChannel, 0, len(preference.Channels))
+	for _, channel := range preference.Channels {
+		channels = append(channels, &pb.NotificationChannel{
+			Type:   notificationChannelTypes[channel.Type],
+			Target: channel.Target,
+		})
+	}
+
+	result := &pb.NotificationPreferences{
+		Channels:              channels,
+		Timezone:              preference.Timezone,
+		DigestIntervalMinutes: int32(preference.DigestIntervalMinutes),
+	}
+
+	if preference.HasQuietHours() {
+		result.QuietHours = &pb.QuietHours{
+			Start: preference.QuietHoursStart,
+			End:   preference.QuietHoursEnd,
+		}
+	}
+
+	return result
+}
+
+func deserializeNotificationPreferences(orgID, userID uuid.UUID, preferences *pb.NotificationPreferences) (*models.NotificationPreference, error) {
+	preference := &models.NotificationPreference{
+		UserID:                userID,
+		OrganizationID:        orgID,
+		Channels:              []models.NotificationChannel{},
+		Timezone:              preferences.Timezone,
+		DigestIntervalMinutes: int(preferences.DigestIntervalMinutes),
+	}
+
+	if preference.Timezone == "" {
+		preference.Timezone = "UTC"
+	}
+
+	if preferences.QuietHours != nil {
+		preference.QuietHoursStart = preferences.QuietHours.Start
+		preference.QuietHoursEnd = preferences.QuietHours.End
+	}
+
+	names := notificationChannelTypeNames()
+	for _, channel := range preferences.Channels {
+		channelType, ok := names[channel.Type]
+		if !ok {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid channel type %s", channel.Type)
+		}
+
+		preference.Channels = append(preference.Channels, models.NotificationChannel{
+			Type:   channelType,
+			Target: channel.Target,
+		})
+	}
+
+	return preference, nil
+}
+
+func notificationChannelTypeNames() map[pb.NotificationChannelType]string {
+	names := make(map[pb.NotificationChannelType]string, len(notificationChannelTypes))
+	for name, channelType := range notificationChannelTypes {
+		names[channelType] = name
+	}
+
+	return names
+}
@@ -0,0 +1,105 @@
+package me
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/database"
+	"github.com/superplanehq/superplane/pkg/models"
+	pb "github.com/superplanehq/superplane/pkg/protos/me"
+	"github.com/superplanehq/superplane/test/support"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func Test__NotificationPreferences(t *testing.T) {
+	r := support.Setup(t)
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(
+		"x-user-id", r.User.String(),
+		"x-organization-id", r.Organization.ID.String(),
+	))
+
+	t.Run("no preferences -> email right away", func(t *testing.T) {
+		response, err := GetNotificationPreferences(ctx)
+		require.NoError(t, err)
+		require.Len(t, response.Preferences.Channels, 1)
+		assert.Equal(t, pb.NotificationChannelType_NOTIFICATION_CHANNEL_TYPE_EMAIL, response.Preferences.Channels[0].Type)
+		assert.Nil(t, response.Preferences.QuietHours)
+		assert.Equal(t, "UTC", response.Preferences.Timezone)
+		assert.Zero(t, response.Preferences.DigestIntervalMinutes)
+	})
+
+	t.Run("update preferences -> saved", func(t *testing.T) {
+		_, err := UpdateNotificationPreferences(ctx, &pb.NotificationPreferences{
+			Channels: []*pb.NotificationChannel{
+				{Type: pb.NotificationChannelType_NOTIFICATION_CHANNEL_TYPE_EMAIL},
+				{Type: pb.NotificationChannelType_NOTIFICATION_CHANNEL_TYPE_WEBHOOK, Target: "https://example.com/hook"},
+			},
+			QuietHours:            &pb.QuietHours{Start: "22:00", End: "07:00"},
+			Timezone:              "Europe/Berlin",
+			DigestIntervalMinutes: 30,
+		})
+		require.NoError(t, err)
+
+		response, err := GetNotificationPreferences(ctx)
+		require.NoError(t, err)
+		require.Len(t, response.Preferences.Channels, 2)
+		assert.Equal(t, pb.NotificationChannelType_NOTIFICATION_CHANNEL_TYPE_WEBHOOK, response.Preferences.Channels[1].Type)
+		assert.Equal(t, "https://example.com/hook", response.Preferences.Channels[1].Target)
+		assert.Equal(t, "22:00", response.Preferences.QuietHours.Start)
+		assert.Equal(t, "07:00", response.Preferences.QuietHours.End)
+		assert.Equal(t, "Europe/Berlin", response.Preferences.Timezone)
+		assert.Equal(t, int32(30), response.Preferences.DigestIntervalMinutes)
+	})
+
+	t.Run("update again -> replaced", func(t *testing.T) {
+		response, err := UpdateNotificationPreferences(ctx, &pb.NotificationPreferences{})
+		require.NoError(t, err)
+		assert.Empty(t, response.Preferences.Channels)
+		assert.Nil(t, response.Preferences.QuietHours)
+		assert.Equal(t, "UTC", response.Preferences.Timezone)
+	})
+
+	t.Run("invalid preferences -> invalid argument", func(t *testing.T) {
+		_, err := UpdateNotificationPreferences(ctx, &pb.NotificationPreferences{
+			Channels: []*pb.NotificationChannel{
+				{Type: pb.NotificationChannelType_NOTIFICATION_CHANNEL_TYPE_UNSPECIFIED},
+			},
+		})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+		_, err = UpdateNotificationPreferences(ctx, &pb.NotificationPreferences{
+			QuietHours: &pb.QuietHours{Start: "22:00"},
+		})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("slack without a ready integration -> failed precondition", func(t *testing.T) {
+		slack := &pb.NotificationPreferences{
+			Channels: []*pb.NotificationChannel{
+				{Type: pb.NotificationChannelType_NOTIFICATION_CHANNEL_TYPE_SLACK, Target: "U0123"},
+			},
+		}
+
+		_, err := UpdateNotificationPreferences(ctx, slack)
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+		integration, err := models.CreateIntegration(uuid.New(), r.Organization.ID, "slack", "slack", map[string]any{})
+		require.NoError(t, err)
+		require.NoError(t, database.Conn().Model(integration).Update("state", models.IntegrationStateReady).Error)
+
+		response, err := UpdateNotificationPreferences(ctx, slack)
+		require.NoError(t, err)
+		require.Len(t, response.Preferences.Channels, 1)
+		assert.Equal(t, "U0123", response.Preferences.Channels[0].Target)
+	})
+
+	t.Run("no user -> unauthenticated", func(t *testing.T) {
+		_, err := GetNotificationPreferences(context.Background())
+		assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	})
+}
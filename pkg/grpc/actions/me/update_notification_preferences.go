@@ -0,0 +1,56 @@
+package me
+
+import (
+	"context"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/superplanehq/superplane/pkg/models"
+	"github.com/superplanehq/superplane/pkg/notifications"
+	pb "github.com/superplanehq/superplane/pkg/protos/me"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+//
+// Slack and Telegram messages are sent through the integrations
+// of the organization, so one needs to be ready before they can be used.
+//
+
+func UpdateNotificationPreferences(ctx context.Context, preferences *pb.NotificationPreferences) (*pb.UpdateNotificationPreferencesResponse, error) {
+	user, err := findCurrentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if preferences == nil {
+		return nil, status.Error(codes.InvalidArgument, "preferences are required")
+	}
+
+	preference, err := deserializeNotificationPreferences(user.OrganizationID, user.ID, preferences)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := notifications.ValidatePreference(preference); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	for _, channel := range preference.Channels {
+		if channel.Type != models.NotificationChannelSlack && channel.Type != models.NotificationChannelTelegram {
+			continue
+		}
+
+		if _, err := models.FindOldestReadyIntegration(user.OrganizationID, channel.Type); err != nil {
+			return nil, status.Errorf(codes.FailedPrecondition, "the organization has no %s integration ready", channel.Type)
+		}
+	}
+
+	if err := models.SaveNotificationPreference(preference); err != nil {
+		log.Errorf("Error saving notification preferences for user %s: %v", user.ID, err)
+		return nil, status.Error(codes.Internal, "failed to save notification preferences")
+	}
+
+	return &pb.UpdateNotificationPreferencesResponse{
+		Preferences: serializeNotificationPreferences(preference),
+	}, nil
+}
@@ -1,6 +1,8 @@
 package messages
 
 import (
+	"context"
+
 	pb "github.com/superplanehq/superplane/pkg/protos/canvases"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
@@ -25,3 +27,7 @@ func NewCanvasExecutionMessage(canvasId string, executionID, nodeID string) Canv
 func (m CanvasExecutionMessage) Publish() error {
 	return Publish(WorkflowExchange, WorkflowExecutionRoutingKey, toBytes(m.message))
 }
+
+func (m CanvasExecutionMessage) PublishWithContext(ctx context.Context) error {
+	return PublishWithContext(ctx, WorkflowExchange, WorkflowExecutionRoutingKey, toBytes(m.message))
+}
@@ -0,0 +1,43 @@
+package messages
+
+import (
+	"github.com/superplanehq/superplane/pkg/models"
+	pb "github.com/superplanehq/superplane/pkg/protos/canvases"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const WorkflowExecutionLogRoutingKey = "workflow-execution-log"
+
+type CanvasExecutionLogMessage struct {
+	message *pb.CanvasNodeExecutionLogMessage
+}
+
+func NewCanvasExecutionLogMessage(canvasId string, log *models.CanvasNodeExecutionLog) CanvasExecutionLogMessage {
+	return CanvasExecutionLogMessage{
+		message: &pb.CanvasNodeExecutionLogMessage{
+			CanvasId:  canvasId,
+			Log:       SerializeExecutionLog(log),
+			Timestamp: timestamppb.Now(),
+		},
+	}
+}
+
+func (m CanvasExecutionLogMessage) Publish() error {
+	return Publish(WorkflowExchange, WorkflowExecutionLogRoutingKey, toBytes(m.message))
+}
+
+func SerializeExecutionLog(log *models.CanvasNodeExecutionLog) *pb.CanvasNodeExecutionLog {
+	serialized := &pb.CanvasNodeExecutionLog{
+		Id:          log.ID,
+		ExecutionId: log.ExecutionID.String(),
+		NodeId:      log.NodeID,
+		Stream:      log.Stream,
+		Message:     log.Message,
+	}
+
+	if log.CreatedAt != nil {
+		serialized.CreatedAt = timestamppb.New(*log.CreatedAt)
+	}
+
+	return serialized
+}
@@ -1,8 +1,15 @@
 package messages
 
 import (
+	"context"
+	"reflect"
+
+	amqp "github.com/rabbitmq/amqp091-go"
 	"github.com/renderedtext/go-tackle"
 	config "github.com/superplanehq/superplane/pkg/config"
+	"github.com/superplanehq/superplane/pkg/telemetry"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/reflect/protoreflect"
 )
@@ -10,20 +17,84 @@ import (
 const WorkflowExchange = "superplane.workflow-exchange"
 
 func Publish(exchange string, routingKey string, message []byte) error {
+	return PublishWithContext(context.Background(), exchange, routingKey, message)
+}
+
+//
+// PublishWithContext adds the trace context in ctx to the message headers,
+// so consumers can attach their work to the trace that published the message.
+//
+
+func PublishWithContext(ctx context.Context, exchange string, routingKey string, message []byte) error {
 	amqpURL, err := config.RabbitMQURL()
 
 	if err != nil {
 		return err
 	}
 
+	headers := amqp.Table{}
+	for key, value := range telemetry.InjectTraceContext(ctx) {
+		headers[key] = value
+	}
+
 	return tackle.PublishMessage(&tackle.PublishParams{
 		Body:       message,
+		Headers:    headers,
 		AmqpURL:    amqpURL,
 		RoutingKey: routingKey,
 		Exchange:   exchange,
 	})
 }
 
+//
+// StartConsumeSpan starts the span for processing a delivery,
+// as a child of the span that published the message, if any.
+//
+
+func StartConsumeSpan(delivery tackle.Delivery, routingKey string) (context.Context, trace.Span) {
+	ctx := telemetry.ExtractTraceContext(context.Background(), deliveryHeaders(delivery))
+	return telemetry.StartSpan(ctx, "canvas.message", attribute.String("messaging.routing_key", routingKey))
+}
+
+//
+// tackle.Delivery doesn't expose the message headers,
+// so they are read from the amqp091 delivery it wraps.
+// Deliveries of other types, like the fake ones in tests, have no headers.
+//
+
+func deliveryHeaders(delivery tackle.Delivery) map[string]string {
+	values := map[string]string{}
+
+	v := reflect.ValueOf(delivery)
+	if v.Kind() != reflect.Pointer || v.IsNil() || v.Elem().Kind() != reflect.Struct {
+		return values
+	}
+
+	d := v.Elem().FieldByName("dlry")
+	if !d.IsValid() || d.Kind() != reflect.Pointer || d.IsNil() {
+		return values
+	}
+
+	headers := d.Elem().FieldByName("Headers")
+	if !headers.IsValid() || headers.Kind() != reflect.Map {
+		return values
+	}
+
+	iter := headers.MapRange()
+	for iter.Next() {
+		value := iter.Value()
+		if value.Kind() == reflect.Interface {
+			value = value.Elem()
+		}
+
+		if value.Kind() == reflect.String {
+			values[iter.Key().String()] = value.String()
+		}
+	}
+
+	return values
+}
+
 func toBytes(m protoreflect.ProtoMessage) []byte {
 	body, err := proto.Marshal(m)
 	if err != nil {
@@ -0,0 +1,81 @@
+package messages
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	amqp "github.com/rabbitmq/amqp091-go"
+	"github.com/renderedtext/go-tackle"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/config"
+	"github.com/superplanehq/superplane/pkg/telemetry"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func Test__PublishAndConsumeTraceContext(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+
+	amqpURL, err := config.RabbitMQURL()
+	require.NoError(t, err)
+
+	routingKey := "trace-test-" + uuid.NewString()
+	spans := make(chan trace.SpanContext, 1)
+	consumer := tackle.NewConsumer()
+	go consumer.Start(&tackle.Options{
+		URL:            amqpURL,
+		RemoteExchange: WorkflowExchange,
+		Service:        fmt.Sprintf("TraceTestConsumer.%s", uuid.NewString()),
+		RoutingKey:     routingKey,
+	}, func(d tackle.Delivery) error {
+		_, span := StartConsumeSpan(d, routingKey)
+		defer span.End()
+		spans <- span.SpanContext()
+		return nil
+	})
+
+	defer consumer.Stop()
+	require.Eventually(t, func() bool { return consumer.State == tackle.StateListening }, 5*time.Second, 100*time.Millisecond)
+
+	ctx, publishSpan := telemetry.StartSpan(context.Background(), "canvas.node_execution")
+	require.NoError(t, PublishWithContext(ctx, WorkflowExchange, routingKey, []byte("hello")))
+	publishSpan.End()
+
+	select {
+	case consumed := <-spans:
+		assert.Equal(t, publishSpan.SpanContext().TraceID(), consumed.TraceID())
+		assert.NotEqual(t, publishSpan.SpanContext().SpanID(), consumed.SpanID())
+	case <-time.After(5 * time.Second):
+		t.Fatal("message was not consumed")
+	}
+
+	require.Eventually(t, func() bool { return len(recorder.Ended()) == 2 }, time.Second, 10*time.Millisecond)
+	consumeSpan := recorder.Ended()[1]
+	assert.Equal(t, "canvas.message", consumeSpan.Name())
+	assert.Equal(t, publishSpan.SpanContext().SpanID(), consumeSpan.Parent().SpanID())
+}
+
+func Test__DeliveryHeaders(t *testing.T) {
+	t.Run("amqp delivery -> string headers", func(t *testing.T) {
+		delivery := tackle.NewDelivery(&amqp.Delivery{Headers: amqp.Table{
+			"traceparent": "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01",
+			"x-retries":   int32(1),
+		}})
+
+		assert.Equal(t, map[string]string{
+			"traceparent": "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01",
+		}, deliveryHeaders(delivery))
+	})
+
+	t.Run("fake delivery -> no headers", func(t *testing.T) {
+		assert.Empty(t, deliveryHeaders(tackle.NewFakeDelivery([]byte("hello"))))
+	})
+}
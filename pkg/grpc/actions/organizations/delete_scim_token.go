@@ -0,0 +1,16 @@
+package organizations
+
+import (
+	"github.com/superplanehq/superplane/pkg/models"
+	pb "github.com/superplanehq/superplane/pkg/protos/organizations"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func DeleteSCIMToken(orgID string) (*pb.DeleteSCIMTokenResponse, error) {
+	if err := models.DeleteOrganizationSCIMToken(orgID); err != nil {
+		return nil, status.Error(codes.Internal, "failed to delete SCIM token")
+	}
+
+	return &pb.DeleteSCIMTokenResponse{}, nil
+}
@@ -0,0 +1,16 @@
+package organizations
+
+import (
+	"github.com/superplanehq/superplane/pkg/models"
+	pb "github.com/superplanehq/superplane/pkg/protos/organizations"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func DeleteSSOConfig(orgID string) (*pb.DeleteSSOConfigResponse, error) {
+	if err := models.DeleteOrganizationSSOConfig(orgID); err != nil {
+		return nil, status.Error(codes.Internal, "failed to delete single sign-on configuration")
+	}
+
+	return &pb.DeleteSSOConfigResponse{}, nil
+}
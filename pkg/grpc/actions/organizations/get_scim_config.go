@@ -0,0 +1,26 @@
+package organizations
+
+import (
+	"errors"
+
+	"github.com/superplanehq/superplane/pkg/models"
+	pb "github.com/superplanehq/superplane/pkg/protos/organizations"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+func GetSCIMConfig(orgID, baseURL string) (*pb.GetSCIMConfigResponse, error) {
+	token, err := models.FindOrganizationSCIMToken(orgID)
+	if err != nil {
+		if !errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, status.Error(codes.Internal, "failed to load SCIM configuration")
+		}
+
+		token = nil
+	}
+
+	return &pb.GetSCIMConfigResponse{
+		ScimConfig: serializeSCIMConfig(orgID, baseURL, token),
+	}, nil
+}
@@ -0,0 +1,26 @@
+package organizations
+
+import (
+	"errors"
+
+	"github.com/superplanehq/superplane/pkg/models"
+	pb "github.com/superplanehq/superplane/pkg/protos/organizations"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+func GetSSOConfig(orgID, baseURL string) (*pb.GetSSOConfigResponse, error) {
+	config, err := models.FindOrganizationSSOConfig(orgID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, status.Error(codes.NotFound, "single sign-on is not configured")
+		}
+
+		return nil, status.Error(codes.Internal, "failed to load single sign-on configuration")
+	}
+
+	return &pb.GetSSOConfigResponse{
+		SsoConfig: serializeSSOConfig(config, baseURL),
+	}, nil
+}
@@ -0,0 +1,24 @@
+package organizations
+
+import (
+	log "github.com/sirupsen/logrus"
+	"github.com/superplanehq/superplane/pkg/models"
+	pb "github.com/superplanehq/superplane/pkg/protos/organizations"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func GetTwoFactorPolicy(orgID string) (*pb.GetTwoFactorPolicyResponse, error) {
+	organization, err := models.FindOrganizationByID(orgID)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, "organization not found")
+	}
+
+	policy, err := serializeTwoFactorPolicy(organization)
+	if err != nil {
+		log.Errorf("Error loading two-factor policy for organization %s: %v", orgID, err)
+		return nil, status.Error(codes.Internal, "failed to load two-factor policy")
+	}
+
+	return &pb.GetTwoFactorPolicyResponse{Policy: policy}, nil
+}
@@ -0,0 +1,63 @@
+package organizations
+
+import (
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/superplanehq/superplane/pkg/models"
+	pb "github.com/superplanehq/superplane/pkg/protos/organizations"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	DefaultTwoFactorEventsLimit = 50
+	MaxTwoFactorEventsLimit     = 200
+)
+
+func ListTwoFactorEvents(orgID string, limit uint32, before *timestamppb.Timestamp) (*pb.ListTwoFactorEventsResponse, error) {
+	if limit == 0 {
+		limit = DefaultTwoFactorEventsLimit
+	}
+
+	if limit > MaxTwoFactorEventsLimit {
+		limit = MaxTwoFactorEventsLimit
+	}
+
+	var beforeTime *time.Time
+	if before != nil {
+		t := before.AsTime()
+		beforeTime = &t
+	}
+
+	//
+	// One more event than requested is loaded
+	// to know if there is a next page.
+	//
+	events, err := models.ListTwoFactorEventsForOrganization(orgID, int(limit)+1, beforeTime)
+	if err != nil {
+		log.Errorf("Error listing two-factor events for organization %s: %v", orgID, err)
+		return nil, status.Error(codes.Internal, "failed to list two-factor events")
+	}
+
+	hasNextPage := len(events) > int(limit)
+	if hasNextPage {
+		events = events[:limit]
+	}
+
+	response := &pb.ListTwoFactorEventsResponse{
+		Events:      []*pb.TwoFactorEvent{},
+		HasNextPage: hasNextPage,
+	}
+
+	for i := range events {
+		response.Events = append(response.Events, serializeTwoFactorEvent(&events[i]))
+	}
+
+	if len(events) > 0 {
+		response.LastTimestamp = timestamppb.New(events[len(events)-1].CreatedAt)
+	}
+
+	return response, nil
+}
@@ -0,0 +1,47 @@
+package organizations
+
+import (
+	"github.com/google/uuid"
+	"github.com/superplanehq/superplane/pkg/crypto"
+	"github.com/superplanehq/superplane/pkg/models"
+	pb "github.com/superplanehq/superplane/pkg/protos/organizations"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+//
+// The plain token is only returned here.
+// Only its hash is stored, so a lost token has to be reset.
+//
+
+func ResetSCIMToken(orgID, baseURL, requesterUserID string) (*pb.ResetSCIMTokenResponse, error) {
+	organizationID, err := uuid.Parse(orgID)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid organization id")
+	}
+
+	createdBy, err := optionalUUID(requesterUserID)
+	if err != nil {
+		return nil, err
+	}
+
+	plainToken, err := crypto.Base64String(64)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to generate token")
+	}
+
+	token := &models.OrganizationSCIMToken{
+		OrganizationID: organizationID,
+		TokenHash:      crypto.HashToken(plainToken),
+		CreatedBy:      createdBy,
+	}
+
+	if err := models.ResetOrganizationSCIMToken(token); err != nil {
+		return nil, status.Error(codes.Internal, "failed to reset SCIM token")
+	}
+
+	return &pb.ResetSCIMTokenResponse{
+		Token:      plainToken,
+		ScimConfig: serializeSCIMConfig(orgID, baseURL, token),
+	}, nil
+}
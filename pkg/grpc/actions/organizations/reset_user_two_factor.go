@@ -0,0 +1,34 @@
+package organizations
+
+import (
+	log "github.com/sirupsen/logrus"
+	"github.com/superplanehq/superplane/pkg/database"
+	"github.com/superplanehq/superplane/pkg/models"
+	pb "github.com/superplanehq/superplane/pkg/protos/organizations"
+	"github.com/superplanehq/superplane/pkg/twofactor"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+func ResetUserTwoFactor(orgID, requesterUserID, userID string) (*pb.ResetUserTwoFactorResponse, error) {
+	user, err := models.FindActiveUserByID(orgID, userID)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, "user not found")
+	}
+
+	if user.AccountID == nil {
+		return nil, status.Error(codes.FailedPrecondition, "user has no account")
+	}
+
+	err = database.Conn().Transaction(func(tx *gorm.DB) error {
+		return twofactor.Reset(tx, *user.AccountID, user.OrganizationID, requesterAccountID(orgID, requesterUserID))
+	})
+
+	if err != nil {
+		log.Errorf("Error resetting two-factor authentication for user %s: %v", userID, err)
+		return nil, status.Error(codes.Internal, "failed to reset two-factor authentication")
+	}
+
+	return &pb.ResetUserTwoFactorResponse{}, nil
+}
@@ -0,0 +1,29 @@
+package organizations
+
+import (
+	"strings"
+
+	"github.com/superplanehq/superplane/pkg/models"
+	pb "github.com/superplanehq/superplane/pkg/protos/organizations"
+	"github.com/superplanehq/superplane/pkg/scim"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func serializeSCIMConfig(orgID, baseURL string, token *models.OrganizationSCIMToken) *pb.SCIMConfig {
+	config := &pb.SCIMConfig{
+		OrganizationId: orgID,
+		BaseUrl:        strings.TrimRight(baseURL, "/") + scim.BasePath,
+	}
+
+	if token == nil {
+		return config
+	}
+
+	config.TokenConfigured = true
+	config.TokenCreatedAt = timestamppb.New(token.CreatedAt)
+	if token.LastUsedAt != nil {
+		config.TokenLastUsedAt = timestamppb.New(*token.LastUsedAt)
+	}
+
+	return config
+}
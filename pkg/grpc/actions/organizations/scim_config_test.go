@@ -0,0 +1,50 @@
+package organizations
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/crypto"
+	"github.com/superplanehq/superplane/pkg/models"
+	"github.com/superplanehq/superplane/test/support"
+)
+
+func Test__SCIMConfig(t *testing.T) {
+	r := support.Setup(t)
+	orgID := r.Organization.ID.String()
+
+	t.Run("no token -> not configured", func(t *testing.T) {
+		resp, err := GetSCIMConfig(orgID, "http://superplane.test/")
+		require.NoError(t, err)
+		assert.Equal(t, "http://superplane.test/scim/v2", resp.ScimConfig.BaseUrl)
+		assert.False(t, resp.ScimConfig.TokenConfigured)
+	})
+
+	t.Run("reset token -> new token replaces the old one", func(t *testing.T) {
+		first, err := ResetSCIMToken(orgID, "http://superplane.test", r.User.String())
+		require.NoError(t, err)
+		require.NotEmpty(t, first.Token)
+		assert.True(t, first.ScimConfig.TokenConfigured)
+
+		second, err := ResetSCIMToken(orgID, "http://superplane.test", r.User.String())
+		require.NoError(t, err)
+		assert.NotEqual(t, first.Token, second.Token)
+
+		_, err = models.FindOrganizationSCIMTokenByHash(crypto.HashToken(first.Token))
+		require.Error(t, err)
+
+		token, err := models.FindOrganizationSCIMTokenByHash(crypto.HashToken(second.Token))
+		require.NoError(t, err)
+		assert.Equal(t, r.Organization.ID, token.OrganizationID)
+	})
+
+	t.Run("delete token -> not configured", func(t *testing.T) {
+		_, err := DeleteSCIMToken(orgID)
+		require.NoError(t, err)
+
+		resp, err := GetSCIMConfig(orgID, "http://superplane.test")
+		require.NoError(t, err)
+		assert.False(t, resp.ScimConfig.TokenConfigured)
+	})
+}
@@ -0,0 +1,99 @@
+package organizations
+
+import (
+	"strings"
+
+	"github.com/superplanehq/superplane/pkg/models"
+	pb "github.com/superplanehq/superplane/pkg/protos/organizations"
+	"github.com/superplanehq/superplane/pkg/sso"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func serializeSSOConfig(config *models.OrganizationSSOConfig, baseURL string) *pb.SSOConfig {
+	orgID := config.OrganizationID.String()
+	baseURL = strings.TrimRight(baseURL, "/")
+
+	mappings := make([]*pb.SSOGroupMapping, 0, len(config.GroupMappings))
+	for _, mapping := range config.GroupMappings {
+		mappings = append(mappings, &pb.SSOGroupMapping{
+			IdpGroup: mapping.IdPGroup,
+			Group:    mapping.Group,
+			Role:     mapping.Role,
+		})
+	}
+
+	result := &pb.SSOConfig{
+		OrganizationId:  orgID,
+		Protocol:        ssoProtocolToProto(config.Protocol),
+		Enabled:         config.Enabled,
+		EnforceSso:      config.EnforceSSO,
+		EmailAttribute:  config.EmailAttribute,
+		NameAttribute:   config.NameAttribute,
+		GroupsAttribute: config.GroupsAttribute,
+		DefaultRole:     config.DefaultRole,
+		GroupMappings:   mappings,
+		LoginUrl:        baseURL + sso.LoginPath(orgID),
+		UpdatedAt:       timestamppb.New(config.UpdatedAt),
+	}
+
+	if config.UpdatedBy != nil {
+		result.UpdatedBy = config.UpdatedBy.String()
+	}
+
+	switch config.Protocol {
+	case models.SSOProtocolSAML:
+		result.Saml = &pb.SSOSAMLSettings{
+			SpEntityId: baseURL + sso.SAMLMetadataPath(orgID),
+			SpAcsUrl:   baseURL + sso.SAMLACSPath(orgID),
+		}
+
+		if config.SAMLIdPMetadataURL != nil {
+			result.Saml.IdpMetadataUrl = *config.SAMLIdPMetadataURL
+		}
+
+		if config.SAMLIdPMetadata != nil {
+			result.Saml.IdpMetadata = *config.SAMLIdPMetadata
+			if metadata, err := sso.ParseSAMLMetadata([]byte(*config.SAMLIdPMetadata)); err == nil {
+				result.Saml.IdpEntityId = metadata.EntityID
+			}
+		}
+
+	case models.SSOProtocolOIDC:
+		result.Oidc = &pb.SSOOIDCSettings{
+			ClientSecretConfigured: len(config.OIDCClientSecret) > 0,
+			RedirectUrl:            baseURL + sso.OIDCCallbackPath(orgID),
+		}
+
+		if config.OIDCIssuerURL != nil {
+			result.Oidc.IssuerUrl = *config.OIDCIssuerURL
+		}
+
+		if config.OIDCClientID != nil {
+			result.Oidc.ClientId = *config.OIDCClientID
+		}
+	}
+
+	return result
+}
+
+func ssoProtocolToProto(protocol string) pb.SSOProtocol {
+	switch protocol {
+	case models.SSOProtocolSAML:
+		return pb.SSOProtocol_SSO_PROTOCOL_SAML
+	case models.SSOProtocolOIDC:
+		return pb.SSOProtocol_SSO_PROTOCOL_OIDC
+	default:
+		return pb.SSOProtocol_SSO_PROTOCOL_UNSPECIFIED
+	}
+}
+
+func ssoProtocolFromProto(protocol pb.SSOProtocol) string {
+	switch protocol {
+	case pb.SSOProtocol_SSO_PROTOCOL_SAML:
+		return models.SSOProtocolSAML
+	case pb.SSOProtocol_SSO_PROTOCOL_OIDC:
+		return models.SSOProtocolOIDC
+	default:
+		return ""
+	}
+}
@@ -0,0 +1,132 @@
+package organizations
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/models"
+	pb "github.com/superplanehq/superplane/pkg/protos/organizations"
+	"github.com/superplanehq/superplane/test/support"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const testSSOBaseURL = "http://superplane.test"
+
+func Test__SSOConfig(t *testing.T) {
+	r := support.Setup(t)
+	ctx := context.Background()
+	orgID := r.Organization.ID.String()
+	idp := support.NewTestIdentityProvider(t)
+
+	update := func(in *pb.SSOConfig) (*pb.UpdateSSOConfigResponse, error) {
+		return UpdateSSOConfig(ctx, r.Encryptor, r.AuthService, testSSOBaseURL, orgID, r.User.String(), in)
+	}
+
+	t.Run("not configured -> not found", func(t *testing.T) {
+		_, err := GetSSOConfig(orgID, testSSOBaseURL)
+		s, ok := status.FromError(err)
+		require.True(t, ok)
+		assert.Equal(t, codes.NotFound, s.Code())
+	})
+
+	t.Run("missing protocol -> invalid argument", func(t *testing.T) {
+		_, err := update(&pb.SSOConfig{Enabled: true})
+		s, ok := status.FromError(err)
+		require.True(t, ok)
+		assert.Equal(t, codes.InvalidArgument, s.Code())
+	})
+
+	t.Run("owner default role -> invalid argument", func(t *testing.T) {
+		_, err := update(&pb.SSOConfig{
+			Protocol:    pb.SSOProtocol_SSO_PROTOCOL_SAML,
+			DefaultRole: models.RoleOrgOwner,
+			Saml:        &pb.SSOSAMLSettings{IdpMetadata: idp.SAMLMetadata()},
+		})
+
+		s, ok := status.FromError(err)
+		require.True(t, ok)
+		assert.Equal(t, codes.InvalidArgument, s.Code())
+	})
+
+	t.Run("mapping to unknown group -> invalid argument", func(t *testing.T) {
+		_, err := update(&pb.SSOConfig{
+			Protocol:      pb.SSOProtocol_SSO_PROTOCOL_SAML,
+			Saml:          &pb.SSOSAMLSettings{IdpMetadata: idp.SAMLMetadata()},
+			GroupMappings: []*pb.SSOGroupMapping{{IdpGroup: "engineering", Group: "does-not-exist"}},
+		})
+
+		s, ok := status.FromError(err)
+		require.True(t, ok)
+		assert.Equal(t, codes.InvalidArgument, s.Code())
+	})
+
+	t.Run("SAML config -> stored with service provider URLs", func(t *testing.T) {
+		resp, err := update(&pb.SSOConfig{
+			Protocol:   pb.SSOProtocol_SSO_PROTOCOL_SAML,
+			Enabled:    true,
+			EnforceSso: true,
+			Saml:       &pb.SSOSAMLSettings{IdpMetadata: idp.SAMLMetadata()},
+		})
+		require.NoError(t, err)
+
+		config := resp.SsoConfig
+		assert.True(t, config.Enabled)
+		assert.True(t, config.EnforceSso)
+		assert.Equal(t, models.RoleOrgViewer, config.DefaultRole)
+		assert.Equal(t, models.SSODefaultEmailAttribute, config.EmailAttribute)
+		assert.Equal(t, testSSOBaseURL+"/auth/sso/"+orgID, config.LoginUrl)
+		assert.Equal(t, testSSOBaseURL+"/auth/sso/"+orgID+"/saml/acs", config.Saml.SpAcsUrl)
+		assert.NotEmpty(t, config.Saml.IdpEntityId)
+		assert.Equal(t, r.User.String(), config.UpdatedBy)
+
+		got, err := GetSSOConfig(orgID, testSSOBaseURL)
+		require.NoError(t, err)
+		assert.Equal(t, config.Saml.IdpEntityId, got.SsoConfig.Saml.IdpEntityId)
+	})
+
+	t.Run("OIDC config -> secret encrypted and never returned", func(t *testing.T) {
+		resp, err := update(&pb.SSOConfig{
+			Protocol: pb.SSOProtocol_SSO_PROTOCOL_OIDC,
+			Enabled:  true,
+			Oidc: &pb.SSOOIDCSettings{
+				IssuerUrl:    idp.Issuer(),
+				ClientId:     support.TestIdPClientID,
+				ClientSecret: support.TestIdPClientSecret,
+			},
+		})
+		require.NoError(t, err)
+		assert.Nil(t, resp.SsoConfig.Saml)
+		assert.True(t, resp.SsoConfig.Oidc.ClientSecretConfigured)
+		assert.Empty(t, resp.SsoConfig.Oidc.ClientSecret)
+
+		stored, err := models.FindOrganizationSSOConfig(orgID)
+		require.NoError(t, err)
+		assert.Nil(t, stored.SAMLIdPMetadata)
+		assert.NotEqual(t, []byte(support.TestIdPClientSecret), stored.OIDCClientSecret)
+
+		//
+		// Updating without a secret keeps the existing one.
+		//
+		_, err = update(&pb.SSOConfig{
+			Protocol: pb.SSOProtocol_SSO_PROTOCOL_OIDC,
+			Oidc: &pb.SSOOIDCSettings{
+				IssuerUrl: idp.Issuer(),
+				ClientId:  support.TestIdPClientID,
+			},
+		})
+		require.NoError(t, err)
+	})
+
+	t.Run("delete -> config removed", func(t *testing.T) {
+		_, err := DeleteSSOConfig(orgID)
+		require.NoError(t, err)
+
+		_, err = GetSSOConfig(orgID, testSSOBaseURL)
+		s, ok := status.FromError(err)
+		require.True(t, ok)
+		assert.Equal(t, codes.NotFound, s.Code())
+	})
+}
@@ -0,0 +1,101 @@
+package organizations
+
+import (
+	"slices"
+
+	"github.com/google/uuid"
+	"github.com/superplanehq/superplane/pkg/models"
+	pb "github.com/superplanehq/superplane/pkg/protos/organizations"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var twoFactorEventTypes = map[string]pb.TwoFactorEventType{
+	models.TwoFactorEventEnrolled:                 pb.TwoFactorEventType_TWO_FACTOR_EVENT_TYPE_ENROLLED,
+	models.TwoFactorEventDisabled:                 pb.TwoFactorEventType_TWO_FACTOR_EVENT_TYPE_DISABLED,
+	models.TwoFactorEventRecoveryCodesRegenerated: pb.TwoFactorEventType_TWO_FACTOR_EVENT_TYPE_RECOVERY_CODES_REGENERATED,
+	models.TwoFactorEventLoginSucceeded:           pb.TwoFactorEventType_TWO_FACTOR_EVENT_TYPE_LOGIN_SUCCEEDED,
+	models.TwoFactorEventVerificationFailed:       pb.TwoFactorEventType_TWO_FACTOR_EVENT_TYPE_VERIFICATION_FAILED,
+	models.TwoFactorEventRecoveryCodeUsed:         pb.TwoFactorEventType_TWO_FACTOR_EVENT_TYPE_RECOVERY_CODE_USED,
+	models.TwoFactorEventReset:                    pb.TwoFactorEventType_TWO_FACTOR_EVENT_TYPE_RESET,
+	models.TwoFactorEventPolicyEnabled:            pb.TwoFactorEventType_TWO_FACTOR_EVENT_TYPE_POLICY_ENABLED,
+	models.TwoFactorEventPolicyDisabled:           pb.TwoFactorEventType_TWO_FACTOR_EVENT_TYPE_POLICY_DISABLED,
+}
+
+//
+// The policy lists the active members with an account, so admins can see
+// who still needs to enable two-factor authentication. Members without
+// a password sign in through a provider, and are not affected by the policy.
+//
+
+func serializeTwoFactorPolicy(organization *models.Organization) (*pb.TwoFactorPolicy, error) {
+	users, err := models.FindMaybeDeletedHumanUsersByOrganization(organization.ID.String())
+	if err != nil {
+		return nil, err
+	}
+
+	accountIDs := []uuid.UUID{}
+	for _, user := range users {
+		if user.AccountID != nil && !user.DeletedAt.Valid {
+			accountIDs = append(accountIDs, *user.AccountID)
+		}
+	}
+
+	withPassword, err := models.ListAccountIDsWithPasswordAuth(accountIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	withTwoFactor, err := models.ListAccountIDsWithTwoFactorEnabled(accountIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	members := []*pb.TwoFactorMember{}
+	for _, user := range users {
+		if user.AccountID == nil || user.DeletedAt.Valid {
+			continue
+		}
+
+		members = append(members, &pb.TwoFactorMember{
+			UserId:        user.ID.String(),
+			Email:         user.GetEmail(),
+			PasswordLogin: slices.Contains(withPassword, *user.AccountID),
+			Enabled:       slices.Contains(withTwoFactor, *user.AccountID),
+		})
+	}
+
+	return &pb.TwoFactorPolicy{
+		OrganizationId: organization.ID.String(),
+		Required:       organization.RequireTwoFactor,
+		Members:        members,
+	}, nil
+}
+
+func serializeTwoFactorEvent(event *models.TwoFactorEvent) *pb.TwoFactorEvent {
+	return &pb.TwoFactorEvent{
+		Id:           event.ID.String(),
+		Type:         twoFactorEventTypes[event.Type],
+		AccountEmail: event.AccountEmail,
+		ActorEmail:   event.ActorEmail,
+		RemoteAddr:   event.RemoteAddr,
+		CreatedAt:    timestamppb.New(event.CreatedAt),
+	}
+}
+
+//
+// Organization changes are attributed to the account of the requester.
+// Service accounts have none, so their changes have no actor.
+//
+
+func requesterAccountID(orgID, requesterUserID string) *uuid.UUID {
+	if requesterUserID == "" {
+		return nil
+	}
+
+	user, err := models.FindActiveUserByID(orgID, requesterUserID)
+	if err != nil {
+		return nil
+	}
+
+	return user.AccountID
+}
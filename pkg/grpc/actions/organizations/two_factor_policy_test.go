@@ -0,0 +1,120 @@
+package organizations
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/crypto"
+	"github.com/superplanehq/superplane/pkg/models"
+	pb "github.com/superplanehq/superplane/pkg/protos/organizations"
+	"github.com/superplanehq/superplane/pkg/totp"
+	"github.com/superplanehq/superplane/pkg/twofactor"
+	"github.com/superplanehq/superplane/test/support"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func Test__TwoFactorPolicy(t *testing.T) {
+	r := support.Setup(t)
+	orgID := r.Organization.ID.String()
+
+	passwordHash, err := crypto.HashPassword("password")
+	require.NoError(t, err)
+	_, err = models.CreateAccountPasswordAuth(r.Account.ID, passwordHash)
+	require.NoError(t, err)
+
+	t.Run("not required by default -> members listed with their status", func(t *testing.T) {
+		resp, err := GetTwoFactorPolicy(orgID)
+		require.NoError(t, err)
+		assert.False(t, resp.Policy.Required)
+		require.Len(t, resp.Policy.Members, 1)
+		assert.Equal(t, r.User.String(), resp.Policy.Members[0].UserId)
+		assert.True(t, resp.Policy.Members[0].PasswordLogin)
+		assert.False(t, resp.Policy.Members[0].Enabled)
+	})
+
+	t.Run("require two-factor -> policy updated and audited", func(t *testing.T) {
+		resp, err := UpdateTwoFactorPolicy(orgID, r.User.String(), true)
+		require.NoError(t, err)
+		assert.True(t, resp.Policy.Required)
+
+		organization, err := models.FindOrganizationByID(orgID)
+		require.NoError(t, err)
+		assert.True(t, organization.RequireTwoFactor)
+
+		events, err := ListTwoFactorEvents(orgID, 0, nil)
+		require.NoError(t, err)
+		require.Len(t, events.Events, 1)
+		assert.Equal(t, pb.TwoFactorEventType_TWO_FACTOR_EVENT_TYPE_POLICY_ENABLED, events.Events[0].Type)
+		assert.Equal(t, r.Account.Email, events.Events[0].ActorEmail)
+	})
+
+	t.Run("same policy again -> nothing recorded", func(t *testing.T) {
+		_, err := UpdateTwoFactorPolicy(orgID, r.User.String(), true)
+		require.NoError(t, err)
+
+		events, err := ListTwoFactorEvents(orgID, 0, nil)
+		require.NoError(t, err)
+		assert.Len(t, events.Events, 1)
+	})
+
+	t.Run("reset member two-factor -> removed and audited", func(t *testing.T) {
+		enrollment, err := twofactor.Begin(context.Background(), r.Encryptor, r.Account)
+		require.NoError(t, err)
+		code, err := totp.GenerateCode(enrollment.Secret, totp.Step(time.Now()))
+		require.NoError(t, err)
+		_, err = twofactor.Enable(context.Background(), r.Encryptor, r.Account, code, "")
+		require.NoError(t, err)
+
+		policy, err := GetTwoFactorPolicy(orgID)
+		require.NoError(t, err)
+		assert.True(t, policy.Policy.Members[0].Enabled)
+
+		_, err = ResetUserTwoFactor(orgID, r.User.String(), r.User.String())
+		require.NoError(t, err)
+
+		policy, err = GetTwoFactorPolicy(orgID)
+		require.NoError(t, err)
+		assert.False(t, policy.Policy.Members[0].Enabled)
+
+		events, err := ListTwoFactorEvents(orgID, 1, nil)
+		require.NoError(t, err)
+		require.Len(t, events.Events, 1)
+		assert.Equal(t, pb.TwoFactorEventType_TWO_FACTOR_EVENT_TYPE_RESET, events.Events[0].Type)
+		assert.Equal(t, r.Account.Email, events.Events[0].AccountEmail)
+		assert.True(t, events.HasNextPage)
+
+		next, err := ListTwoFactorEvents(orgID, 10, events.LastTimestamp)
+		require.NoError(t, err)
+		assert.NotEmpty(t, next.Events)
+		assert.False(t, next.HasNextPage)
+	})
+
+	t.Run("reset unknown member -> not found", func(t *testing.T) {
+		_, err := ResetUserTwoFactor(orgID, r.User.String(), "00000000-0000-0000-0000-000000000000")
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+
+	t.Run("events of other organizations are not listed", func(t *testing.T) {
+		before, err := ListTwoFactorEvents(orgID, 0, nil)
+		require.NoError(t, err)
+
+		other, err := models.CreateOrganization(support.RandomName("org"), "")
+		require.NoError(t, err)
+
+		_, err = UpdateTwoFactorPolicy(other.ID.String(), "", true)
+		require.NoError(t, err)
+
+		after, err := ListTwoFactorEvents(orgID, 0, nil)
+		require.NoError(t, err)
+		assert.Len(t, after.Events, len(before.Events))
+
+		otherEvents, err := ListTwoFactorEvents(other.ID.String(), 0, nil)
+		require.NoError(t, err)
+		require.Len(t, otherEvents.Events, 1)
+		assert.Empty(t, otherEvents.Events[0].ActorEmail)
+	})
+}
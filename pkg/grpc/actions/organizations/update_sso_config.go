@@ -0,0 +1,277 @@
+package organizations
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/superplanehq/superplane/pkg/authorization"
+	"github.com/superplanehq/superplane/pkg/crypto"
+	"github.com/superplanehq/superplane/pkg/models"
+	pb "github.com/superplanehq/superplane/pkg/protos/organizations"
+	"github.com/superplanehq/superplane/pkg/sso"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+)
+
+func UpdateSSOConfig(
+	ctx context.Context,
+	encryptor crypto.Encryptor,
+	authService authorization.Authorization,
+	baseURL string,
+	orgID string,
+	requesterUserID string,
+	in *pb.SSOConfig,
+) (*pb.UpdateSSOConfigResponse, error) {
+	if in == nil {
+		return nil, status.Error(codes.InvalidArgument, "sso_config is required")
+	}
+
+	organizationID, err := uuid.Parse(orgID)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid organization id")
+	}
+
+	updatedBy, err := optionalUUID(requesterUserID)
+	if err != nil {
+		return nil, err
+	}
+
+	protocol := ssoProtocolFromProto(in.Protocol)
+	if protocol == "" {
+		return nil, status.Error(codes.InvalidArgument, "protocol must be SAML or OIDC")
+	}
+
+	existing, err := models.FindOrganizationSSOConfig(orgID)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, status.Error(codes.Internal, "failed to load single sign-on configuration")
+	}
+
+	now := time.Now()
+	config := &models.OrganizationSSOConfig{
+		OrganizationID:  organizationID,
+		Protocol:        protocol,
+		Enabled:         in.Enabled,
+		EnforceSSO:      in.EnforceSso,
+		EmailAttribute:  valueOrDefault(in.EmailAttribute, models.SSODefaultEmailAttribute),
+		NameAttribute:   valueOrDefault(in.NameAttribute, models.SSODefaultNameAttribute),
+		GroupsAttribute: valueOrDefault(in.GroupsAttribute, models.SSODefaultGroupsAttribute),
+		DefaultRole:     valueOrDefault(in.DefaultRole, models.RoleOrgViewer),
+		UpdatedBy:       updatedBy,
+		CreatedAt:       now,
+		UpdatedAt:       now,
+	}
+
+	//
+	// Settings for the other protocol are dropped,
+	// so switching protocols never leaves stale secrets behind.
+	//
+
+	if existing != nil && existing.Protocol != protocol {
+		existing = nil
+	}
+
+	switch protocol {
+	case models.SSOProtocolSAML:
+		err = applySAMLSettings(ctx, config, existing, in.Saml)
+	case models.SSOProtocolOIDC:
+		err = applyOIDCSettings(ctx, encryptor, baseURL, config, existing, in.Oidc)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if err := validateSSORole(authService, orgID, config.DefaultRole); err != nil {
+		return nil, err
+	}
+
+	mappings, err := validateSSOGroupMappings(authService, orgID, in.GroupMappings)
+	if err != nil {
+		return nil, err
+	}
+
+	config.GroupMappings = mappings
+
+	if err := models.UpsertOrganizationSSOConfig(config); err != nil {
+		return nil, status.Error(codes.Internal, "failed to update single sign-on configuration")
+	}
+
+	return &pb.UpdateSSOConfigResponse{
+		SsoConfig: serializeSSOConfig(config, baseURL),
+	}, nil
+}
+
+func applySAMLSettings(ctx context.Context, config, existing *models.OrganizationSSOConfig, in *pb.SSOSAMLSettings) error {
+	if in == nil {
+		in = &pb.SSOSAMLSettings{}
+	}
+
+	metadataURL := strings.TrimSpace(in.IdpMetadataUrl)
+	metadata := strings.TrimSpace(in.IdpMetadata)
+
+	switch {
+	case metadata != "":
+		if _, err := sso.ParseSAMLMetadata([]byte(metadata)); err != nil {
+			return status.Error(codes.InvalidArgument, err.Error())
+		}
+
+	case metadataURL != "":
+		fetched, err := sso.FetchSAMLMetadata(ctx, metadataURL)
+		if err != nil {
+			return status.Error(codes.InvalidArgument, err.Error())
+		}
+
+		metadata = fetched
+
+	case existing != nil && existing.SAMLIdPMetadata != nil:
+		metadata = *existing.SAMLIdPMetadata
+		metadataURL = valueOrEmpty(existing.SAMLIdPMetadataURL)
+
+	default:
+		return status.Error(codes.InvalidArgument, "SAML requires idp_metadata or idp_metadata_url")
+	}
+
+	config.SAMLIdPMetadata = &metadata
+	if metadataURL != "" {
+		config.SAMLIdPMetadataURL = &metadataURL
+	}
+
+	return nil
+}
+
+func applyOIDCSettings(
+	ctx context.Context,
+	encryptor crypto.Encryptor,
+	baseURL string,
+	config, existing *models.OrganizationSSOConfig,
+	in *pb.SSOOIDCSettings,
+) error {
+	if in == nil {
+		in = &pb.SSOOIDCSettings{}
+	}
+
+	issuerURL := strings.TrimRight(strings.TrimSpace(in.IssuerUrl), "/")
+	clientID := strings.TrimSpace(in.ClientId)
+	if issuerURL == "" || clientID == "" {
+		return status.Error(codes.InvalidArgument, "OIDC requires issuer_url and client_id")
+	}
+
+	config.OIDCIssuerURL = &issuerURL
+	config.OIDCClientID = &clientID
+
+	clientSecret := in.ClientSecret
+	if clientSecret == "" {
+		if existing == nil || len(existing.OIDCClientSecret) == 0 {
+			return status.Error(codes.InvalidArgument, "OIDC requires client_secret")
+		}
+
+		decrypted, err := encryptor.Decrypt(ctx, existing.OIDCClientSecret, []byte(config.OrganizationID.String()))
+		if err != nil {
+			return status.Error(codes.Internal, "failed to decrypt client secret")
+		}
+
+		clientSecret = string(decrypted)
+	}
+
+	//
+	// Running discovery now surfaces a wrong issuer URL
+	// when the configuration is saved, not when members try to log in.
+	//
+
+	if _, err := sso.NewOIDCRelyingParty(ctx, config, clientSecret, baseURL); err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	encrypted, err := encryptor.Encrypt(ctx, []byte(clientSecret), []byte(config.OrganizationID.String()))
+	if err != nil {
+		return status.Error(codes.Internal, "failed to encrypt client secret")
+	}
+
+	config.OIDCClientSecret = encrypted
+	return nil
+}
+
+//
+// The owner role cannot be handed out through SSO:
+// ownership changes should always be an explicit action by an owner.
+//
+
+func validateSSORole(authService authorization.Authorization, orgID, role string) error {
+	if role == models.RoleOrgOwner {
+		return status.Error(codes.InvalidArgument, "the owner role cannot be assigned through single sign-on")
+	}
+
+	if _, err := authService.GetRoleDefinition(role, models.DomainTypeOrganization, orgID); err != nil {
+		return status.Errorf(codes.InvalidArgument, "role %s not found", role)
+	}
+
+	return nil
+}
+
+func validateSSOGroupMappings(
+	authService authorization.Authorization,
+	orgID string,
+	in []*pb.SSOGroupMapping,
+) (datatypes.JSONSlice[models.SSOGroupMapping], error) {
+	mappings := datatypes.JSONSlice[models.SSOGroupMapping]{}
+	if len(in) == 0 {
+		return mappings, nil
+	}
+
+	groups, err := authService.GetGroups(orgID, models.DomainTypeOrganization)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to list groups")
+	}
+
+	for _, mapping := range in {
+		idpGroup := strings.TrimSpace(mapping.IdpGroup)
+		if idpGroup == "" {
+			return nil, status.Error(codes.InvalidArgument, "group mapping requires idp_group")
+		}
+
+		if mapping.Group == "" && mapping.Role == "" {
+			return nil, status.Errorf(codes.InvalidArgument, "group mapping for %s requires a group or a role", idpGroup)
+		}
+
+		if mapping.Group != "" && !slices.Contains(groups, mapping.Group) {
+			return nil, status.Errorf(codes.InvalidArgument, "group %s not found", mapping.Group)
+		}
+
+		if mapping.Role != "" {
+			if err := validateSSORole(authService, orgID, mapping.Role); err != nil {
+				return nil, err
+			}
+		}
+
+		mappings = append(mappings, models.SSOGroupMapping{
+			IdPGroup: idpGroup,
+			Group:    mapping.Group,
+			Role:     mapping.Role,
+		})
+	}
+
+	return mappings, nil
+}
+
+func valueOrDefault(value, defaultValue string) string {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return defaultValue
+	}
+
+	return value
+}
+
+func valueOrEmpty(value *string) string {
+	if value == nil {
+		return ""
+	}
+
+	return *value
+}
@@ -0,0 +1,59 @@
+package organizations
+
+import (
+	log "github.com/sirupsen/logrus"
+	"github.com/superplanehq/superplane/pkg/database"
+	"github.com/superplanehq/superplane/pkg/models"
+	pb "github.com/superplanehq/superplane/pkg/protos/organizations"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+//
+// Requiring two-factor authentication takes effect on the next request
+// of each member: password sessions without a second factor are rejected
+// until the member enables it and signs in again.
+//
+
+func UpdateTwoFactorPolicy(orgID, requesterUserID string, required bool) (*pb.UpdateTwoFactorPolicyResponse, error) {
+	organization, err := models.FindOrganizationByID(orgID)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, "organization not found")
+	}
+
+	if organization.RequireTwoFactor != required {
+		eventType := models.TwoFactorEventPolicyDisabled
+		if required {
+			eventType = models.TwoFactorEventPolicyEnabled
+		}
+
+		err = database.Conn().Transaction(func(tx *gorm.DB) error {
+			err := tx.Model(organization).Update("require_two_factor", required).Error
+			if err != nil {
+				return err
+			}
+
+			return models.CreateTwoFactorEventInTransaction(tx, &models.TwoFactorEvent{
+				OrganizationID: &organization.ID,
+				ActorID:        requesterAccountID(orgID, requesterUserID),
+				Type:           eventType,
+			})
+		})
+
+		if err != nil {
+			log.Errorf("Error updating two-factor policy for organization %s: %v", orgID, err)
+			return nil, status.Error(codes.Internal, "failed to update two-factor policy")
+		}
+
+		organization.RequireTwoFactor = required
+	}
+
+	policy, err := serializeTwoFactorPolicy(organization)
+	if err != nil {
+		log.Errorf("Error loading two-factor policy for organization %s: %v", orgID, err)
+		return nil, status.Error(codes.Internal, "failed to load two-factor policy")
+	}
+
+	return &pb.UpdateTwoFactorPolicyResponse{Policy: policy}, nil
+}
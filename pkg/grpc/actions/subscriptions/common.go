@@ -0,0 +1,186 @@
+package subscriptions
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"slices"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/superplanehq/superplane/pkg/authentication"
+	"github.com/superplanehq/superplane/pkg/models"
+	pb "github.com/superplanehq/superplane/pkg/protos/subscriptions"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
+)
+
+func currentUser(ctx context.Context) (string, string, error) {
+	userID, userIsSet := authentication.GetUserIdFromMetadata(ctx)
+	if !userIsSet {
+		return "", "", status.Error(codes.Unauthenticated, "user not authenticated")
+	}
+
+	orgID, orgIsSet := authentication.GetOrganizationIdFromMetadata(ctx)
+	if !orgIsSet {
+		return "", "", status.Error(codes.Unauthenticated, "user not authenticated")
+	}
+
+	return userID, orgID, nil
+}
+
+func findSubscription(orgID, id string) (*models.EventSubscription, error) {
+	if _, err := uuid.Parse(id); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid subscription id")
+	}
+
+	subscription, err := models.FindEventSubscription(orgID, id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, status.Error(codes.NotFound, "subscription not found")
+		}
+
+		return nil, status.Error(codes.Internal, "failed to find subscription")
+	}
+
+	return subscription, nil
+}
+
+//
+// validateSubscription checks the fields that can be set through the API,
+// and applies them to the subscription.
+//
+
+func validateSubscription(orgID string, subscription *models.EventSubscription, spec *pb.Subscription) error {
+	if spec == nil {
+		return errors.New("subscription is required")
+	}
+
+	name := strings.TrimSpace(spec.Name)
+	if name == "" {
+		return errors.New("name is required")
+	}
+
+	u, err := url.Parse(strings.TrimSpace(spec.Url))
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return errors.New("url must be an absolute http or https URL")
+	}
+
+	if len(spec.EventTypes) == 0 {
+		return errors.New("at least one event type is required")
+	}
+
+	for _, eventType := range spec.EventTypes {
+		if !slices.Contains(models.EventSubscriptionEventTypes, eventType) {
+			return fmt.Errorf("unknown event type '%s'", eventType)
+		}
+	}
+
+	organizationID, err := uuid.Parse(orgID)
+	if err != nil {
+		return errors.New("invalid organization")
+	}
+
+	for _, canvasID := range spec.CanvasIds {
+		id, err := uuid.Parse(canvasID)
+		if err != nil {
+			return fmt.Errorf("invalid canvas id '%s'", canvasID)
+		}
+
+		_, err = models.FindCanvas(organizationID, id)
+		if err != nil {
+			return fmt.Errorf("canvas %s not found", canvasID)
+		}
+	}
+
+	subscription.Name = name
+	subscription.URL = u.String()
+	subscription.EventTypes = uniqueValues(spec.EventTypes)
+	subscription.CanvasIDs = uniqueValues(spec.CanvasIds)
+	subscription.NodeIDs = uniqueValues(spec.NodeIds)
+	subscription.Enabled = spec.Enabled
+	return nil
+}
+
+func uniqueValues(values []string) []string {
+	result := []string{}
+	for _, value := range values {
+		value = strings.TrimSpace(value)
+		if value != "" && !slices.Contains(result, value) {
+			result = append(result, value)
+		}
+	}
+
+	return result
+}
+
+func serializeSubscription(subscription *models.EventSubscription) *pb.Subscription {
+	s := &pb.Subscription{
+		Id:         subscription.ID.String(),
+		Name:       subscription.Name,
+		Url:        subscription.URL,
+		EventTypes: subscription.EventTypes,
+		CanvasIds:  subscription.CanvasIDs,
+		NodeIds:    subscription.NodeIDs,
+		Enabled:    subscription.Enabled,
+	}
+
+	if subscription.CreatedBy != nil {
+		s.CreatedBy = subscription.CreatedBy.String()
+	}
+
+	if subscription.CreatedAt != nil {
+		s.CreatedAt = timestamppb.New(*subscription.CreatedAt)
+	}
+
+	if subscription.UpdatedAt != nil {
+		s.UpdatedAt = timestamppb.New(*subscription.UpdatedAt)
+	}
+
+	return s
+}
+
+func serializeDelivery(delivery *models.EventSubscriptionDelivery) *pb.Delivery {
+	d := &pb.Delivery{
+		Id:            delivery.ID.String(),
+		EventId:       delivery.EventID.String(),
+		EventType:     delivery.EventType,
+		State:         deliveryStateToProto(delivery.State),
+		Attempts:      int32(delivery.Attempts),
+		NextAttemptAt: timestamppb.New(delivery.NextAttemptAt),
+	}
+
+	if delivery.ResponseStatus != nil {
+		d.ResponseStatus = int32(*delivery.ResponseStatus)
+	}
+
+	if delivery.LastError != nil {
+		d.LastError = *delivery.LastError
+	}
+
+	if delivery.DeliveredAt != nil {
+		d.DeliveredAt = timestamppb.New(*delivery.DeliveredAt)
+	}
+
+	if delivery.CreatedAt != nil {
+		d.CreatedAt = timestamppb.New(*delivery.CreatedAt)
+	}
+
+	return d
+}
+
+func deliveryStateToProto(state string) pb.DeliveryState {
+	switch state {
+	case models.EventSubscriptionDeliveryStatePending:
+		return pb.DeliveryState_DELIVERY_STATE_PENDING
+	case models.EventSubscriptionDeliveryStateDelivered:
+		return pb.DeliveryState_DELIVERY_STATE_DELIVERED
+	case models.EventSubscriptionDeliveryStateFailed:
+		return pb.DeliveryState_DELIVERY_STATE_FAILED
+	default:
+		return pb.DeliveryState_DELIVERY_STATE_UNKNOWN
+	}
+}
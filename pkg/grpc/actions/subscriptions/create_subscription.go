@@ -0,0 +1,62 @@
+package subscriptions
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/superplanehq/superplane/pkg/crypto"
+	"github.com/superplanehq/superplane/pkg/models"
+	pb "github.com/superplanehq/superplane/pkg/protos/subscriptions"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func CreateSubscription(ctx context.Context, encryptor crypto.Encryptor, req *pb.CreateSubscriptionRequest) (*pb.CreateSubscriptionResponse, error) {
+	userID, orgID, err := currentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	subscription := &models.EventSubscription{
+		ID:             uuid.New(),
+		OrganizationID: uuid.MustParse(orgID),
+	}
+
+	err = validateSubscription(orgID, subscription, req.Subscription)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	//
+	// The secret is bound to the subscription through the associated data,
+	// so it cannot be used by other subscriptions.
+	//
+	secret, encryptedSecret, err := crypto.NewRandomKey(ctx, encryptor, subscription.ID.String())
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to generate secret")
+	}
+
+	//
+	// Subscriptions always start enabled.
+	// They can be disabled later, without losing their delivery log.
+	//
+	createdBy := uuid.MustParse(userID)
+	subscription.Enabled = true
+	subscription.Secret = encryptedSecret
+	subscription.CreatedBy = &createdBy
+
+	err = models.CreateEventSubscription(subscription)
+	if err != nil {
+		if errors.Is(err, models.ErrNameAlreadyUsed) {
+			return nil, status.Errorf(codes.AlreadyExists, "subscription %s already exists", subscription.Name)
+		}
+
+		return nil, status.Error(codes.Internal, "failed to create subscription")
+	}
+
+	return &pb.CreateSubscriptionResponse{
+		Subscription: serializeSubscription(subscription),
+		Secret:       secret,
+	}, nil
+}
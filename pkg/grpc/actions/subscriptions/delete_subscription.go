@@ -0,0 +1,28 @@
+package subscriptions
+
+import (
+	"context"
+
+	pb "github.com/superplanehq/superplane/pkg/protos/subscriptions"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func DeleteSubscription(ctx context.Context, req *pb.DeleteSubscriptionRequest) (*pb.DeleteSubscriptionResponse, error) {
+	_, orgID, err := currentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	subscription, err := findSubscription(orgID, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	err = subscription.Delete()
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to delete subscription")
+	}
+
+	return &pb.DeleteSubscriptionResponse{}, nil
+}
@@ -0,0 +1,23 @@
+package subscriptions
+
+import (
+	"context"
+
+	pb "github.com/superplanehq/superplane/pkg/protos/subscriptions"
+)
+
+func DescribeSubscription(ctx context.Context, req *pb.DescribeSubscriptionRequest) (*pb.DescribeSubscriptionResponse, error) {
+	_, orgID, err := currentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	subscription, err := findSubscription(orgID, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.DescribeSubscriptionResponse{
+		Subscription: serializeSubscription(subscription),
+	}, nil
+}
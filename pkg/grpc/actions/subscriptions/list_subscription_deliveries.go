@@ -0,0 +1,51 @@
+package subscriptions
+
+import (
+	"context"
+
+	"github.com/superplanehq/superplane/pkg/models"
+	pb "github.com/superplanehq/superplane/pkg/protos/subscriptions"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	DefaultDeliveriesLimit = 50
+	MaxDeliveriesLimit     = 500
+)
+
+func ListSubscriptionDeliveries(ctx context.Context, req *pb.ListSubscriptionDeliveriesRequest) (*pb.ListSubscriptionDeliveriesResponse, error) {
+	_, orgID, err := currentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	subscription, err := findSubscription(orgID, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	limit := int(req.Limit)
+	if limit == 0 {
+		limit = DefaultDeliveriesLimit
+	}
+
+	if limit > MaxDeliveriesLimit {
+		limit = MaxDeliveriesLimit
+	}
+
+	deliveries, err := models.ListEventSubscriptionDeliveries(subscription.ID, limit)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to list deliveries")
+	}
+
+	response := &pb.ListSubscriptionDeliveriesResponse{
+		Deliveries: make([]*pb.Delivery, 0, len(deliveries)),
+	}
+
+	for i := range deliveries {
+		response.Deliveries = append(response.Deliveries, serializeDelivery(&deliveries[i]))
+	}
+
+	return response, nil
+}
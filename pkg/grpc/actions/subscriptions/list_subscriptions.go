@@ -0,0 +1,32 @@
+package subscriptions
+
+import (
+	"context"
+
+	"github.com/superplanehq/superplane/pkg/models"
+	pb "github.com/superplanehq/superplane/pkg/protos/subscriptions"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func ListSubscriptions(ctx context.Context, req *pb.ListSubscriptionsRequest) (*pb.ListSubscriptionsResponse, error) {
+	_, orgID, err := currentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	subscriptions, err := models.ListEventSubscriptions(orgID)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to list subscriptions")
+	}
+
+	response := &pb.ListSubscriptionsResponse{
+		Subscriptions: make([]*pb.Subscription, 0, len(subscriptions)),
+	}
+
+	for i := range subscriptions {
+		response.Subscriptions = append(response.Subscriptions, serializeSubscription(&subscriptions[i]))
+	}
+
+	return response, nil
+}
@@ -0,0 +1,138 @@
+package subscriptions
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/models"
+	pb "github.com/superplanehq/superplane/pkg/protos/subscriptions"
+	"github.com/superplanehq/superplane/test/support"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func userContext(userID, orgID string) context.Context {
+	return metadata.NewIncomingContext(context.Background(), metadata.Pairs(
+		"x-user-id", userID,
+		"x-organization-id", orgID,
+	))
+}
+
+func Test__Subscriptions(t *testing.T) {
+	r := support.Setup(t)
+	orgID := r.Organization.ID.String()
+	ctx := userContext(r.User.String(), orgID)
+	canvas, _ := support.CreateCanvas(t, r.Organization.ID, r.User, []models.CanvasNode{}, []models.Edge{})
+
+	spec := func() *pb.Subscription {
+		return &pb.Subscription{
+			Name:       "portal",
+			Url:        "https://portal.example.com/hooks/superplane",
+			EventTypes: []string{models.EventSubscriptionEventExecutionFinished},
+			CanvasIds:  []string{canvas.ID.String()},
+		}
+	}
+
+	t.Run("unauthenticated user -> error", func(t *testing.T) {
+		_, err := CreateSubscription(context.Background(), r.Encryptor, &pb.CreateSubscriptionRequest{Subscription: spec()})
+		s, ok := status.FromError(err)
+		require.True(t, ok)
+		assert.Equal(t, codes.Unauthenticated, s.Code())
+	})
+
+	t.Run("invalid subscription -> error", func(t *testing.T) {
+		invalid := []func(*pb.Subscription){
+			func(s *pb.Subscription) { s.Name = "" },
+			func(s *pb.Subscription) { s.Url = "ftp://example.com" },
+			func(s *pb.Subscription) { s.Url = "/relative" },
+			func(s *pb.Subscription) { s.EventTypes = nil },
+			func(s *pb.Subscription) { s.EventTypes = []string{"canvas.exploded"} },
+			func(s *pb.Subscription) { s.CanvasIds = []string{uuid.NewString()} },
+		}
+
+		for _, change := range invalid {
+			subscription := spec()
+			change(subscription)
+
+			_, err := CreateSubscription(ctx, r.Encryptor, &pb.CreateSubscriptionRequest{Subscription: subscription})
+			s, ok := status.FromError(err)
+			require.True(t, ok)
+			assert.Equal(t, codes.InvalidArgument, s.Code())
+		}
+	})
+
+	var subscriptionID string
+
+	t.Run("subscription is created with a secret", func(t *testing.T) {
+		response, err := CreateSubscription(ctx, r.Encryptor, &pb.CreateSubscriptionRequest{Subscription: spec()})
+		require.NoError(t, err)
+		require.NotEmpty(t, response.Secret)
+
+		assert.Equal(t, "portal", response.Subscription.Name)
+		assert.True(t, response.Subscription.Enabled)
+		assert.Equal(t, r.User.String(), response.Subscription.CreatedBy)
+		subscriptionID = response.Subscription.Id
+
+		subscription, err := models.FindEventSubscription(orgID, subscriptionID)
+		require.NoError(t, err)
+		secret, err := r.Encryptor.Decrypt(context.Background(), subscription.Secret, []byte(subscriptionID))
+		require.NoError(t, err)
+		assert.Equal(t, response.Secret, string(secret))
+	})
+
+	t.Run("name already used -> error", func(t *testing.T) {
+		_, err := CreateSubscription(ctx, r.Encryptor, &pb.CreateSubscriptionRequest{Subscription: spec()})
+		s, ok := status.FromError(err)
+		require.True(t, ok)
+		assert.Equal(t, codes.AlreadyExists, s.Code())
+	})
+
+	t.Run("list and describe", func(t *testing.T) {
+		list, err := ListSubscriptions(ctx, &pb.ListSubscriptionsRequest{})
+		require.NoError(t, err)
+		require.Len(t, list.Subscriptions, 1)
+		assert.Equal(t, subscriptionID, list.Subscriptions[0].Id)
+
+		describe, err := DescribeSubscription(ctx, &pb.DescribeSubscriptionRequest{Id: subscriptionID})
+		require.NoError(t, err)
+		assert.Equal(t, []string{canvas.ID.String()}, describe.Subscription.CanvasIds)
+
+		_, err = DescribeSubscription(userContext(r.User.String(), uuid.NewString()), &pb.DescribeSubscriptionRequest{Id: subscriptionID})
+		s, ok := status.FromError(err)
+		require.True(t, ok)
+		assert.Equal(t, codes.NotFound, s.Code())
+	})
+
+	t.Run("update", func(t *testing.T) {
+		updated := spec()
+		updated.EventTypes = []string{models.EventSubscriptionEventNodeError, models.EventSubscriptionEventApprovalRequested}
+		updated.CanvasIds = nil
+		updated.Enabled = false
+
+		response, err := UpdateSubscription(ctx, &pb.UpdateSubscriptionRequest{Id: subscriptionID, Subscription: updated})
+		require.NoError(t, err)
+		assert.False(t, response.Subscription.Enabled)
+		assert.Empty(t, response.Subscription.CanvasIds)
+		assert.Len(t, response.Subscription.EventTypes, 2)
+	})
+
+	t.Run("list deliveries", func(t *testing.T) {
+		response, err := ListSubscriptionDeliveries(ctx, &pb.ListSubscriptionDeliveriesRequest{Id: subscriptionID})
+		require.NoError(t, err)
+		assert.Empty(t, response.Deliveries)
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		_, err := DeleteSubscription(ctx, &pb.DeleteSubscriptionRequest{Id: subscriptionID})
+		require.NoError(t, err)
+
+		_, err = DescribeSubscription(ctx, &pb.DescribeSubscriptionRequest{Id: subscriptionID})
+		s, ok := status.FromError(err)
+		require.True(t, ok)
+		assert.Equal(t, codes.NotFound, s.Code())
+	})
+}
@@ -0,0 +1,41 @@
+package subscriptions
+
+import (
+	"context"
+	"errors"
+
+	"github.com/superplanehq/superplane/pkg/models"
+	pb "github.com/superplanehq/superplane/pkg/protos/subscriptions"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func UpdateSubscription(ctx context.Context, req *pb.UpdateSubscriptionRequest) (*pb.UpdateSubscriptionResponse, error) {
+	_, orgID, err := currentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	subscription, err := findSubscription(orgID, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	err = validateSubscription(orgID, subscription, req.Subscription)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	err = subscription.Update()
+	if err != nil {
+		if errors.Is(err, models.ErrNameAlreadyUsed) {
+			return nil, status.Errorf(codes.AlreadyExists, "subscription %s already exists", subscription.Name)
+		}
+
+		return nil, status.Error(codes.Internal, "failed to update subscription")
+	}
+
+	return &pb.UpdateSubscriptionResponse{
+		Subscription: serializeSubscription(subscription),
+	}, nil
+}
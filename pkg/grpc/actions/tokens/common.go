@@ -0,0 +1,104 @@
+package tokens
+
+import (
+	"context"
+	"errors"
+
+	"github.com/superplanehq/superplane/pkg/authentication"
+	"github.com/superplanehq/superplane/pkg/authorization"
+	"github.com/superplanehq/superplane/pkg/models"
+	pb "github.com/superplanehq/superplane/pkg/protos/tokens"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
+)
+
+func currentUser(ctx context.Context) (string, string, error) {
+	userID, userIsSet := authentication.GetUserIdFromMetadata(ctx)
+	if !userIsSet {
+		return "", "", status.Error(codes.Unauthenticated, "user not authenticated")
+	}
+
+	orgID, orgIsSet := authentication.GetOrganizationIdFromMetadata(ctx)
+	if !orgIsSet {
+		return "", "", status.Error(codes.Unauthenticated, "user not authenticated")
+	}
+
+	return userID, orgID, nil
+}
+
+//
+// Tokens belong to the current user, unless a service account is given.
+// Managing the tokens of a service account requires the same permission
+// as the other service account operations.
+//
+
+func findTokenOwner(authService authorization.Authorization, orgID, userID, serviceAccountID, action string) (*models.User, error) {
+	if serviceAccountID == "" {
+		user, err := models.FindActiveUserByID(orgID, userID)
+		if err != nil {
+			return nil, status.Error(codes.NotFound, "user not found")
+		}
+
+		return user, nil
+	}
+
+	allowed, err := authService.CheckOrganizationPermission(userID, orgID, "service_accounts", action)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to check permissions")
+	}
+
+	if !allowed {
+		return nil, status.Error(codes.NotFound, "service account not found")
+	}
+
+	user, err := models.FindActiveUserByID(orgID, serviceAccountID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, status.Error(codes.NotFound, "service account not found")
+		}
+
+		return nil, status.Error(codes.Internal, "failed to find service account")
+	}
+
+	if !user.IsServiceAccount() {
+		return nil, status.Error(codes.NotFound, "service account not found")
+	}
+
+	return user, nil
+}
+
+func serializeToken(token *models.APIToken) *pb.Token {
+	t := &pb.Token{
+		Id:     token.ID.String(),
+		Name:   token.Name,
+		UserId: token.UserID.String(),
+		Scopes: []*pb.TokenScope{},
+	}
+
+	for _, scope := range token.Scopes {
+		t.Scopes = append(t.Scopes, &pb.TokenScope{
+			Permission: scope.Permission,
+			CanvasIds:  scope.Canvases,
+		})
+	}
+
+	if token.ExpiresAt != nil {
+		t.ExpiresAt = timestamppb.New(*token.ExpiresAt)
+	}
+
+	if token.LastUsedAt != nil {
+		t.LastUsedAt = timestamppb.New(*token.LastUsedAt)
+	}
+
+	if token.CreatedAt != nil {
+		t.CreatedAt = timestamppb.New(*token.CreatedAt)
+	}
+
+	if token.CreatedBy != nil {
+		t.CreatedBy = token.CreatedBy.String()
+	}
+
+	return t
+}
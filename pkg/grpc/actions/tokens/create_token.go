@@ -0,0 +1,86 @@
+package tokens
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/superplanehq/superplane/pkg/authorization"
+	"github.com/superplanehq/superplane/pkg/crypto"
+	"github.com/superplanehq/superplane/pkg/models"
+	pb "github.com/superplanehq/superplane/pkg/protos/tokens"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func CreateToken(ctx context.Context, authService authorization.Authorization, req *pb.CreateTokenRequest) (*pb.CreateTokenResponse, error) {
+	userID, orgID, err := currentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	name := strings.TrimSpace(req.Name)
+	if name == "" {
+		return nil, status.Error(codes.InvalidArgument, "name is required")
+	}
+
+	scopes := make([]models.APITokenScope, 0, len(req.Scopes))
+	for _, scope := range req.Scopes {
+		scopes = append(scopes, models.APITokenScope{
+			Permission: scope.Permission,
+			Canvases:   scope.CanvasIds,
+		})
+	}
+
+	err = authorization.ValidateScopes(scopes)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid scopes: %v", err)
+	}
+
+	var expiresAt *time.Time
+	if req.ExpiresAt != nil {
+		t := req.ExpiresAt.AsTime()
+		if !t.After(time.Now()) {
+			return nil, status.Error(codes.InvalidArgument, "expiration must be in the future")
+		}
+
+		expiresAt = &t
+	}
+
+	owner, err := findTokenOwner(authService, orgID, userID, req.ServiceAccountId, "update")
+	if err != nil {
+		return nil, err
+	}
+
+	plainToken, err := crypto.Base64String(64)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to generate token")
+	}
+
+	createdBy := uuid.MustParse(userID)
+	token := &models.APIToken{
+		OrganizationID: owner.OrganizationID,
+		UserID:         owner.ID,
+		Name:           name,
+		TokenHash:      crypto.HashToken(plainToken),
+		Scopes:         scopes,
+		ExpiresAt:      expiresAt,
+		CreatedBy:      &createdBy,
+	}
+
+	err = models.CreateAPIToken(token)
+	if err != nil {
+		if errors.Is(err, models.ErrNameAlreadyUsed) {
+			return nil, status.Errorf(codes.AlreadyExists, "token %s already exists", name)
+		}
+
+		return nil, status.Error(codes.Internal, "failed to create token")
+	}
+
+	return &pb.CreateTokenResponse{
+		Token: serializeToken(token),
+		Value: plainToken,
+	}, nil
+}
@@ -0,0 +1,35 @@
+package tokens
+
+import (
+	"context"
+
+	"github.com/superplanehq/superplane/pkg/authorization"
+	"github.com/superplanehq/superplane/pkg/models"
+	pb "github.com/superplanehq/superplane/pkg/protos/tokens"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func ListTokens(ctx context.Context, authService authorization.Authorization, req *pb.ListTokensRequest) (*pb.ListTokensResponse, error) {
+	userID, orgID, err := currentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	owner, err := findTokenOwner(authService, orgID, userID, req.ServiceAccountId, "read")
+	if err != nil {
+		return nil, err
+	}
+
+	tokens, err := models.ListAPITokens(orgID, owner.ID.String())
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to list tokens")
+	}
+
+	response := &pb.ListTokensResponse{Tokens: []*pb.Token{}}
+	for _, token := range tokens {
+		response.Tokens = append(response.Tokens, serializeToken(&token))
+	}
+
+	return response, nil
+}
@@ -0,0 +1,46 @@
+package tokens
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/superplanehq/superplane/pkg/authorization"
+	"github.com/superplanehq/superplane/pkg/models"
+	pb "github.com/superplanehq/superplane/pkg/protos/tokens"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func RevokeToken(ctx context.Context, authService authorization.Authorization, req *pb.RevokeTokenRequest) (*pb.RevokeTokenResponse, error) {
+	userID, orgID, err := currentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := uuid.Parse(req.Id); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid token id")
+	}
+
+	token, err := models.FindAPIToken(orgID, req.Id)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, "token not found")
+	}
+
+	//
+	// Users can always revoke their own tokens.
+	// Tokens of service accounts are revoked by whoever can update them.
+	//
+	if token.UserID.String() != userID {
+		_, err := findTokenOwner(authService, orgID, userID, token.UserID.String(), "update")
+		if err != nil {
+			return nil, status.Error(codes.NotFound, "token not found")
+		}
+	}
+
+	err = token.Delete()
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to revoke token")
+	}
+
+	return &pb.RevokeTokenResponse{}, nil
+}
@@ -0,0 +1,165 @@
+package tokens
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/crypto"
+	"github.com/superplanehq/superplane/pkg/database"
+	"github.com/superplanehq/superplane/pkg/models"
+	pb "github.com/superplanehq/superplane/pkg/protos/tokens"
+	"github.com/superplanehq/superplane/test/support"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func userContext(userID, orgID string) context.Context {
+	return metadata.NewIncomingContext(context.Background(), metadata.Pairs(
+		"x-user-id", userID,
+		"x-organization-id", orgID,
+	))
+}
+
+func Test__CreateToken(t *testing.T) {
+	r := support.Setup(t)
+	orgID := r.Organization.ID.String()
+	ctx := userContext(r.User.String(), orgID)
+
+	t.Run("unauthenticated user -> error", func(t *testing.T) {
+		_, err := CreateToken(context.Background(), r.AuthService, &pb.CreateTokenRequest{Name: "ci"})
+		s, ok := status.FromError(err)
+		require.True(t, ok)
+		assert.Equal(t, codes.Unauthenticated, s.Code())
+	})
+
+	t.Run("invalid scopes -> error", func(t *testing.T) {
+		_, err := CreateToken(ctx, r.AuthService, &pb.CreateTokenRequest{
+			Name:   "ci",
+			Scopes: []*pb.TokenScope{{Permission: "canvases:destroy"}},
+		})
+
+		s, ok := status.FromError(err)
+		require.True(t, ok)
+		assert.Equal(t, codes.InvalidArgument, s.Code())
+		assert.Contains(t, s.Message(), "unknown scope")
+	})
+
+	t.Run("expiration in the past -> error", func(t *testing.T) {
+		_, err := CreateToken(ctx, r.AuthService, &pb.CreateTokenRequest{
+			Name:      "ci",
+			Scopes:    []*pb.TokenScope{{Permission: "canvases:read"}},
+			ExpiresAt: timestamppb.New(time.Now().Add(-time.Hour)),
+		})
+
+		s, ok := status.FromError(err)
+		require.True(t, ok)
+		assert.Equal(t, codes.InvalidArgument, s.Code())
+	})
+
+	t.Run("token is created for the current user", func(t *testing.T) {
+		expiresAt := time.Now().Add(24 * time.Hour)
+		response, err := CreateToken(ctx, r.AuthService, &pb.CreateTokenRequest{
+			Name:      "deploys",
+			Scopes:    []*pb.TokenScope{{Permission: "events:emit", CanvasIds: []string{"canvas-1"}}},
+			ExpiresAt: timestamppb.New(expiresAt),
+		})
+
+		require.NoError(t, err)
+		require.NotEmpty(t, response.Value)
+		assert.Equal(t, "deploys", response.Token.Name)
+		assert.Equal(t, r.User.String(), response.Token.UserId)
+		require.Len(t, response.Token.Scopes, 1)
+		assert.Equal(t, []string{"canvas-1"}, response.Token.Scopes[0].CanvasIds)
+
+		token, err := models.FindAPITokenByHash(crypto.HashToken(response.Value))
+		require.NoError(t, err)
+		assert.Equal(t, response.Token.Id, token.ID.String())
+		assert.WithinDuration(t, expiresAt, *token.ExpiresAt, time.Second)
+	})
+
+	t.Run("duplicate name -> error", func(t *testing.T) {
+		_, err := CreateToken(ctx, r.AuthService, &pb.CreateTokenRequest{
+			Name:   "deploys",
+			Scopes: []*pb.TokenScope{{Permission: "canvases:read"}},
+		})
+
+		s, ok := status.FromError(err)
+		require.True(t, ok)
+		assert.Equal(t, codes.AlreadyExists, s.Code())
+	})
+
+	t.Run("token is created for a service account", func(t *testing.T) {
+		serviceAccount, err := models.CreateServiceAccount(database.Conn(), r.Organization.ID, support.RandomName("sa"), nil, r.User)
+		require.NoError(t, err)
+
+		response, err := CreateToken(ctx, r.AuthService, &pb.CreateTokenRequest{
+			Name:             "ci",
+			Scopes:           []*pb.TokenScope{{Permission: "*"}},
+			ServiceAccountId: serviceAccount.ID.String(),
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, serviceAccount.ID.String(), response.Token.UserId)
+	})
+
+	t.Run("user without permission cannot create service account tokens", func(t *testing.T) {
+		viewer := support.CreateUser(t, r, r.Organization.ID)
+		serviceAccount, err := models.CreateServiceAccount(database.Conn(), r.Organization.ID, support.RandomName("sa"), nil, r.User)
+		require.NoError(t, err)
+
+		_, err = CreateToken(userContext(viewer.ID.String(), orgID), r.AuthService, &pb.CreateTokenRequest{
+			Name:             "ci",
+			Scopes:           []*pb.TokenScope{{Permission: "*"}},
+			ServiceAccountId: serviceAccount.ID.String(),
+		})
+
+		s, ok := status.FromError(err)
+		require.True(t, ok)
+		assert.Equal(t, codes.NotFound, s.Code())
+	})
+}
+
+func Test__ListAndRevokeTokens(t *testing.T) {
+	r := support.Setup(t)
+	orgID := r.Organization.ID.String()
+	ctx := userContext(r.User.String(), orgID)
+
+	created, err := CreateToken(ctx, r.AuthService, &pb.CreateTokenRequest{
+		Name:   "ci",
+		Scopes: []*pb.TokenScope{{Permission: "canvases:read"}},
+	})
+	require.NoError(t, err)
+
+	t.Run("tokens of the current user are listed", func(t *testing.T) {
+		response, err := ListTokens(ctx, r.AuthService, &pb.ListTokensRequest{})
+		require.NoError(t, err)
+		require.Len(t, response.Tokens, 1)
+		assert.Equal(t, created.Token.Id, response.Tokens[0].Id)
+	})
+
+	t.Run("other users cannot revoke the token", func(t *testing.T) {
+		viewer := support.CreateUser(t, r, r.Organization.ID)
+		_, err := RevokeToken(userContext(viewer.ID.String(), orgID), r.AuthService, &pb.RevokeTokenRequest{Id: created.Token.Id})
+
+		s, ok := status.FromError(err)
+		require.True(t, ok)
+		assert.Equal(t, codes.NotFound, s.Code())
+	})
+
+	t.Run("token is revoked", func(t *testing.T) {
+		_, err := RevokeToken(ctx, r.AuthService, &pb.RevokeTokenRequest{Id: created.Token.Id})
+		require.NoError(t, err)
+
+		response, err := ListTokens(ctx, r.AuthService, &pb.ListTokensRequest{})
+		require.NoError(t, err)
+		assert.Empty(t, response.Tokens)
+
+		_, err = models.FindAPITokenByHash(crypto.HashToken(created.Value))
+		require.Error(t, err)
+	})
+}
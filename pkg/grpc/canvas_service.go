@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/google/uuid"
+	"github.com/superplanehq/superplane/pkg/artifacts"
 	"github.com/superplanehq/superplane/pkg/authorization"
 	"github.com/superplanehq/superplane/pkg/crypto"
 	"github.com/superplanehq/superplane/pkg/grpc/actions/canvases"
@@ -52,6 +53,10 @@ func (s *CanvasService) UpdateCanvas(ctx context.Context, req *pb.UpdateCanvasRe
 		return nil, status.Error(codes.InvalidArgument, "canvas is required")
 	}
 	organizationID := ctx.Value(authorization.OrganizationContextKey).(string)
+	if req.DryRun {
+		return canvases.PlanCanvasUpdate(s.registry, organizationID, req.Id, req.Canvas, req.AutoLayout)
+	}
+
 	return canvases.UpdateCanvasWithAutoLayout(
 		ctx,
 		s.encryptor,
@@ -116,6 +121,7 @@ func (s *CanvasService) EmitNodeEvent(ctx context.Context, req *pb.EmitNodeEvent
 
 	return canvases.EmitNodeEvent(
 		ctx,
+		s.registry,
 		uuid.MustParse(organizationID),
 		canvasID,
 		req.NodeId,
@@ -233,6 +239,57 @@ func (s *CanvasService) CancelExecution(ctx context.Context, req *pb.CancelExecu
 	return canvases.CancelExecution(ctx, s.authService, s.encryptor, organizationID, s.registry, canvasID, executionID)
 }
 
+func (s *CanvasService) GetExecutionLogs(ctx context.Context, req *pb.GetExecutionLogsRequest) (*pb.GetExecutionLogsResponse, error) {
+	canvasID, err := uuid.Parse(req.CanvasId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid canvas_id")
+	}
+
+	executionID, err := uuid.Parse(req.ExecutionId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid execution_id")
+	}
+
+	organizationID := ctx.Value(authorization.OrganizationContextKey).(string)
+	return canvases.GetExecutionLogs(ctx, organizationID, canvasID, executionID, req.After, req.Limit)
+}
+
+func (s *CanvasService) ListExecutionArtifacts(ctx context.Context, req *pb.ListExecutionArtifactsRequest) (*pb.ListExecutionArtifactsResponse, error) {
+	canvasID, err := uuid.Parse(req.CanvasId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid canvas_id")
+	}
+
+	executionID, err := uuid.Parse(req.ExecutionId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid execution_id")
+	}
+
+	organizationID := ctx.Value(authorization.OrganizationContextKey).(string)
+	return canvases.ListExecutionArtifacts(ctx, organizationID, canvasID, executionID)
+}
+
+func (s *CanvasService) DownloadExecutionArtifact(req *pb.DownloadExecutionArtifactRequest, stream pb.Canvases_DownloadExecutionArtifactServer) error {
+	canvasID, err := uuid.Parse(req.CanvasId)
+	if err != nil {
+		return status.Error(codes.InvalidArgument, "invalid canvas_id")
+	}
+
+	executionID, err := uuid.Parse(req.ExecutionId)
+	if err != nil {
+		return status.Error(codes.InvalidArgument, "invalid execution_id")
+	}
+
+	storage, err := artifacts.DefaultStorage()
+	if err != nil {
+		return status.Error(codes.Internal, "artifact storage is not available")
+	}
+
+	ctx := stream.Context()
+	organizationID := ctx.Value(authorization.OrganizationContextKey).(string)
+	return canvases.DownloadExecutionArtifact(ctx, storage, organizationID, canvasID, executionID, req.Name, stream.Send)
+}
+
 func (s *CanvasService) ResolveExecutionErrors(ctx context.Context, req *pb.ResolveExecutionErrorsRequest) (*pb.ResolveExecutionErrorsResponse, error) {
 	canvasID, err := uuid.Parse(req.CanvasId)
 	if err != nil {
@@ -255,3 +312,84 @@ func (s *CanvasService) SendAiMessage(ctx context.Context, req *pb.SendAiMessage
 	organizationID := ctx.Value(authorization.OrganizationContextKey).(string)
 	return canvases.SendAiMessage(ctx, s.registry, s.encryptor, organizationID, req)
 }
+
+func (s *CanvasService) GetCanvasStats(ctx context.Context, req *pb.GetCanvasStatsRequest) (*pb.GetCanvasStatsResponse, error) {
+	canvasID, err := uuid.Parse(req.CanvasId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid canvas_id")
+	}
+
+	organizationID := ctx.Value(authorization.OrganizationContextKey).(string)
+	return canvases.GetCanvasStats(ctx, organizationID, canvasID, req.From, req.To)
+}
+
+func (s *CanvasService) GetCanvasDoraSettings(ctx context.Context, req *pb.GetCanvasDoraSettingsRequest) (*pb.GetCanvasDoraSettingsResponse, error) {
+	canvasID, err := uuid.Parse(req.CanvasId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid canvas_id")
+	}
+
+	organizationID := ctx.Value(authorization.OrganizationContextKey).(string)
+	return canvases.GetCanvasDoraSettings(ctx, organizationID, canvasID)
+}
+
+func (s *CanvasService) UpdateCanvasDoraSettings(ctx context.Context, req *pb.UpdateCanvasDoraSettingsRequest) (*pb.UpdateCanvasDoraSettingsResponse, error) {
+	canvasID, err := uuid.Parse(req.CanvasId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid canvas_id")
+	}
+
+	organizationID := ctx.Value(authorization.OrganizationContextKey).(string)
+	return canvases.UpdateCanvasDoraSettings(ctx, organizationID, canvasID, req.Settings)
+}
+
+func (s *CanvasService) GetCanvasDoraMetrics(ctx context.Context, req *pb.GetCanvasDoraMetricsRequest) (*pb.GetCanvasDoraMetricsResponse, error) {
+	canvasID, err := uuid.Parse(req.CanvasId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid canvas_id")
+	}
+
+	organizationID := ctx.Value(authorization.OrganizationContextKey).(string)
+	return canvases.GetCanvasDoraMetrics(ctx, organizationID, canvasID, req.From, req.To, req.Interval)
+}
+
+func (s *CanvasService) ExportCanvasDoraMetrics(ctx context.Context, req *pb.ExportCanvasDoraMetricsRequest) (*pb.ExportCanvasDoraMetricsResponse, error) {
+	canvasID, err := uuid.Parse(req.CanvasId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid canvas_id")
+	}
+
+	organizationID := ctx.Value(authorization.OrganizationContextKey).(string)
+	return canvases.ExportCanvasDoraMetrics(ctx, organizationID, canvasID, req.From, req.To, req.Interval)
+}
+
+func (s *CanvasService) WatchCanvas(req *pb.WatchCanvasRequest, stream pb.Canvases_WatchCanvasServer) error {
+	canvasID, err := uuid.Parse(req.CanvasId)
+	if err != nil {
+		return status.Error(codes.InvalidArgument, "invalid canvas_id")
+	}
+
+	ctx := stream.Context()
+	organizationID := ctx.Value(authorization.OrganizationContextKey).(string)
+	return canvases.WatchCanvas(ctx, organizationID, canvasID, req.ResumeToken, func(change *pb.CanvasChange) error {
+		return stream.Send(&pb.WatchCanvasResponse{Change: change})
+	})
+}
+
+func (s *CanvasService) WatchExecution(req *pb.WatchExecutionRequest, stream pb.Canvases_WatchExecutionServer) error {
+	canvasID, err := uuid.Parse(req.CanvasId)
+	if err != nil {
+		return status.Error(codes.InvalidArgument, "invalid canvas_id")
+	}
+
+	executionID, err := uuid.Parse(req.ExecutionId)
+	if err != nil {
+		return status.Error(codes.InvalidArgument, "invalid execution_id")
+	}
+
+	ctx := stream.Context()
+	organizationID := ctx.Value(authorization.OrganizationContextKey).(string)
+	return canvases.WatchExecution(ctx, organizationID, canvasID, executionID, req.ResumeToken, func(change *pb.CanvasChange) error {
+		return stream.Send(&pb.WatchExecutionResponse{Change: change})
+	})
+}
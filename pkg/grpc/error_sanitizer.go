@@ -35,6 +35,23 @@ func sanitizeErrorUnaryInterceptor() grpc.UnaryServerInterceptor {
 	}
 }
 
+func sanitizeErrorStreamInterceptor() grpc.StreamServerInterceptor {
+	return func(srv any, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		err := handler(srv, stream)
+		if err != nil {
+			if s, ok := status.FromError(err); ok {
+				if s.Code() == codes.Internal {
+					log.WithError(err).Errorf("grpc internal error: %s", info.FullMethod)
+				}
+			} else {
+				log.WithError(err).Errorf("grpc internal error: %s", info.FullMethod)
+			}
+			return sanitizeError(err)
+		}
+		return nil
+	}
+}
+
 func sanitizeError(err error) error {
 	if err == nil {
 		return nil
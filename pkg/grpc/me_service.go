@@ -21,3 +21,11 @@ func (s *MeService) Me(ctx context.Context, req *emptypb.Empty) (*pb.User, error
 func (s *MeService) RegenerateToken(ctx context.Context, req *emptypb.Empty) (*pb.RegenerateTokenResponse, error) {
 	return me.RegenerateToken(ctx)
 }
+
+func (s *MeService) GetNotificationPreferences(ctx context.Context, req *emptypb.Empty) (*pb.GetNotificationPreferencesResponse, error) {
+	return me.GetNotificationPreferences(ctx)
+}
+
+func (s *MeService) UpdateNotificationPreferences(ctx context.Context, req *pb.UpdateNotificationPreferencesRequest) (*pb.UpdateNotificationPreferencesResponse, error) {
+	return me.UpdateNotificationPreferences(ctx, req.Preferences)
+}
@@ -136,6 +136,102 @@ func (s *OrganizationService) DeleteAgentOpenAIKey(
 	return organizations.DeleteAgentOpenAIKey(orgID, userID)
 }
 
+func (s *OrganizationService) GetSSOConfig(
+	ctx context.Context,
+	req *pb.GetSSOConfigRequest,
+) (*pb.GetSSOConfigResponse, error) {
+	orgID := ctx.Value(authorization.DomainIdContextKey).(string)
+	return organizations.GetSSOConfig(orgID, s.baseURL)
+}
+
+func (s *OrganizationService) UpdateSSOConfig(
+	ctx context.Context,
+	req *pb.UpdateSSOConfigRequest,
+) (*pb.UpdateSSOConfigResponse, error) {
+	orgID := ctx.Value(authorization.DomainIdContextKey).(string)
+	userID, err := userIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return organizations.UpdateSSOConfig(ctx, s.encryptor, s.authorizationService, s.baseURL, orgID, userID, req.SsoConfig)
+}
+
+func (s *OrganizationService) DeleteSSOConfig(
+	ctx context.Context,
+	req *pb.DeleteSSOConfigRequest,
+) (*pb.DeleteSSOConfigResponse, error) {
+	orgID := ctx.Value(authorization.DomainIdContextKey).(string)
+	return organizations.DeleteSSOConfig(orgID)
+}
+
+func (s *OrganizationService) GetSCIMConfig(
+	ctx context.Context,
+	req *pb.GetSCIMConfigRequest,
+) (*pb.GetSCIMConfigResponse, error) {
+	orgID := ctx.Value(authorization.DomainIdContextKey).(string)
+	return organizations.GetSCIMConfig(orgID, s.baseURL)
+}
+
+func (s *OrganizationService) ResetSCIMToken(
+	ctx context.Context,
+	req *pb.ResetSCIMTokenRequest,
+) (*pb.ResetSCIMTokenResponse, error) {
+	orgID := ctx.Value(authorization.DomainIdContextKey).(string)
+	userID, err := userIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return organizations.ResetSCIMToken(orgID, s.baseURL, userID)
+}
+
+func (s *OrganizationService) DeleteSCIMToken(
+	ctx context.Context,
+	req *pb.DeleteSCIMTokenRequest,
+) (*pb.DeleteSCIMTokenResponse, error) {
+	orgID := ctx.Value(authorization.DomainIdContextKey).(string)
+	return organizations.DeleteSCIMToken(orgID)
+}
+
+func (s *OrganizationService) GetTwoFactorPolicy(
+	ctx context.Context,
+	req *pb.GetTwoFactorPolicyRequest,
+) (*pb.GetTwoFactorPolicyResponse, error) {
+	orgID := ctx.Value(authorization.DomainIdContextKey).(string)
+	return organizations.GetTwoFactorPolicy(orgID)
+}
+
+func (s *OrganizationService) UpdateTwoFactorPolicy(
+	ctx context.Context,
+	req *pb.UpdateTwoFactorPolicyRequest,
+) (*pb.UpdateTwoFactorPolicyResponse, error) {
+	orgID := ctx.Value(authorization.DomainIdContextKey).(string)
+	userID, err := userIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return organizations.UpdateTwoFactorPolicy(orgID, userID, req.Required)
+}
+
+func (s *OrganizationService) ResetUserTwoFactor(
+	ctx context.Context,
+	req *pb.ResetUserTwoFactorRequest,
+) (*pb.ResetUserTwoFactorResponse, error) {
+	orgID := ctx.Value(authorization.DomainIdContextKey).(string)
+	userID, err := userIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return organizations.ResetUserTwoFactor(orgID, userID, req.UserId)
+}
+
+func (s *OrganizationService) ListTwoFactorEvents(
+	ctx context.Context,
+	req *pb.ListTwoFactorEventsRequest,
+) (*pb.ListTwoFactorEventsResponse, error) {
+	orgID := ctx.Value(authorization.DomainIdContextKey).(string)
+	return organizations.ListTwoFactorEvents(orgID, req.Limit, req.Before)
+}
+
 func (s *OrganizationService) AcceptInviteLink(ctx context.Context, req *pb.InviteLink) (*structpb.Struct, error) {
 	accountID, err := accountIDFromContext(ctx)
 	if err != nil {
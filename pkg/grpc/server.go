@@ -23,6 +23,8 @@ import (
 	pbRoles "github.com/superplanehq/superplane/pkg/protos/roles"
 	secretPb "github.com/superplanehq/superplane/pkg/protos/secrets"
 	pbServiceAccounts "github.com/superplanehq/superplane/pkg/protos/service_accounts"
+	pbSubscriptions "github.com/superplanehq/superplane/pkg/protos/subscriptions"
+	pbTokens "github.com/superplanehq/superplane/pkg/protos/tokens"
 	triggerPb "github.com/superplanehq/superplane/pkg/protos/triggers"
 	pbUsers "github.com/superplanehq/superplane/pkg/protos/users"
 	widgetPb "github.com/superplanehq/superplane/pkg/protos/widgets"
@@ -77,6 +79,8 @@ func RunServer(baseURL, webhooksBaseURL, basePath string, encryptor crypto.Encry
 		),
 		grpc.ChainStreamInterceptor(
 			recovery.StreamServerInterceptor(opts...),
+			authorization.NewAuthorizationInterceptor(authService).StreamInterceptor(),
+			sanitizeErrorStreamInterceptor(),
 		),
 	)
 
@@ -128,6 +132,12 @@ func RunServer(baseURL, webhooksBaseURL, basePath string, encryptor crypto.Encry
 	serviceAccountsService := NewServiceAccountsService(authService)
 	pbServiceAccounts.RegisterServiceAccountsServer(grpcServer, serviceAccountsService)
 
+	tokensService := NewTokensService(authService)
+	pbTokens.RegisterTokensServer(grpcServer, tokensService)
+
+	subscriptionsService := NewSubscriptionsService(encryptor)
+	pbSubscriptions.RegisterSubscriptionsServer(grpcServer, subscriptionsService)
+
 	reflection.Register(grpcServer)
 
 	//
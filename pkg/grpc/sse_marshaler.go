@@ -0,0 +1,71 @@
+package grpc
+
+import (
+	"bytes"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+
+	pbCanvases "github.com/superplanehq/superplane/pkg/protos/canvases"
+)
+
+const SSEContentType = "text/event-stream"
+
+//
+// SSEMarshaler writes the responses of server-streaming methods
+// as server-sent events, for clients that send "Accept: text/event-stream".
+// The resume token of a canvas change is used as the event ID,
+// so EventSource clients send it back in the Last-Event-ID header when reconnecting.
+//
+
+type SSEMarshaler struct {
+	runtime.JSONPb
+}
+
+type canvasChangeResponse interface {
+	GetChange() *pbCanvases.CanvasChange
+}
+
+func NewSSEMarshaler() *SSEMarshaler {
+	return &SSEMarshaler{
+		JSONPb: runtime.JSONPb{
+			MarshalOptions:   protojson.MarshalOptions{EmitUnpopulated: true},
+			UnmarshalOptions: protojson.UnmarshalOptions{DiscardUnknown: true},
+		},
+	}
+}
+
+func (m *SSEMarshaler) ContentType(_ interface{}) string {
+	return SSEContentType
+}
+
+func (m *SSEMarshaler) Delimiter() []byte {
+	return []byte("\n\n")
+}
+
+func (m *SSEMarshaler) Marshal(v interface{}) ([]byte, error) {
+	data, err := m.JSONPb.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	switch chunk := v.(type) {
+	case map[string]interface{}:
+		if response, ok := chunk["result"].(canvasChangeResponse); ok {
+			if token := response.GetChange().GetResumeToken(); token != "" {
+				buf.WriteString("id: " + token + "\n")
+			}
+		}
+
+	case map[string]proto.Message:
+		if _, ok := chunk["error"]; ok {
+			buf.WriteString("event: error\n")
+		}
+	}
+
+	buf.WriteString("data: ")
+	buf.Write(data)
+	return buf.Bytes(), nil
+}
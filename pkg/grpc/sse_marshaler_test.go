@@ -0,0 +1,51 @@
+package grpc
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	pbCanvases "github.com/superplanehq/superplane/pkg/protos/canvases"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+func Test__SSEMarshaler(t *testing.T) {
+	marshaler := NewSSEMarshaler()
+
+	t.Run("change is written with the resume token as ID", func(t *testing.T) {
+		data, err := marshaler.Marshal(map[string]interface{}{
+			"result": &pbCanvases.WatchCanvasResponse{
+				Change: &pbCanvases.CanvasChange{
+					Type:        pbCanvases.CanvasChangeType_CANVAS_CHANGE_TYPE_HEARTBEAT,
+					ResumeToken: "token-1",
+				},
+			},
+		})
+
+		require.NoError(t, err)
+		lines := strings.Split(string(data), "\n")
+		require.Len(t, lines, 2)
+		assert.Equal(t, "id: token-1", lines[0])
+		assert.True(t, strings.HasPrefix(lines[1], `data: {"result":{"change":{"type":"CANVAS_CHANGE_TYPE_HEARTBEAT"`))
+	})
+
+	t.Run("errors are written as error events", func(t *testing.T) {
+		data, err := marshaler.Marshal(map[string]proto.Message{
+			"error": status.New(codes.NotFound, "canvas not found").Proto(),
+		})
+
+		require.NoError(t, err)
+		lines := strings.Split(string(data), "\n")
+		require.Len(t, lines, 2)
+		assert.Equal(t, "event: error", lines[0])
+		assert.Contains(t, lines[1], "canvas not found")
+	})
+
+	t.Run("events are delimited by a blank line", func(t *testing.T) {
+		assert.Equal(t, "\n\n", string(marshaler.Delimiter()))
+		assert.Equal(t, SSEContentType, marshaler.ContentType(nil))
+	})
+}
@@ -0,0 +1,44 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/superplanehq/superplane/pkg/crypto"
+	"github.com/superplanehq/superplane/pkg/grpc/actions/subscriptions"
+	pb "github.com/superplanehq/superplane/pkg/protos/subscriptions"
+)
+
+type SubscriptionsService struct {
+	pb.UnimplementedSubscriptionsServer
+	encryptor crypto.Encryptor
+}
+
+func NewSubscriptionsService(encryptor crypto.Encryptor) *SubscriptionsService {
+	return &SubscriptionsService{
+		encryptor: encryptor,
+	}
+}
+
+func (s *SubscriptionsService) CreateSubscription(ctx context.Context, req *pb.CreateSubscriptionRequest) (*pb.CreateSubscriptionResponse, error) {
+	return subscriptions.CreateSubscription(ctx, s.encryptor, req)
+}
+
+func (s *SubscriptionsService) ListSubscriptions(ctx context.Context, req *pb.ListSubscriptionsRequest) (*pb.ListSubscriptionsResponse, error) {
+	return subscriptions.ListSubscriptions(ctx, req)
+}
+
+func (s *SubscriptionsService) DescribeSubscription(ctx context.Context, req *pb.DescribeSubscriptionRequest) (*pb.DescribeSubscriptionResponse, error) {
+	return subscriptions.DescribeSubscription(ctx, req)
+}
+
+func (s *SubscriptionsService) UpdateSubscription(ctx context.Context, req *pb.UpdateSubscriptionRequest) (*pb.UpdateSubscriptionResponse, error) {
+	return subscriptions.UpdateSubscription(ctx, req)
+}
+
+func (s *SubscriptionsService) DeleteSubscription(ctx context.Context, req *pb.DeleteSubscriptionRequest) (*pb.DeleteSubscriptionResponse, error) {
+	return subscriptions.DeleteSubscription(ctx, req)
+}
+
+func (s *SubscriptionsService) ListSubscriptionDeliveries(ctx context.Context, req *pb.ListSubscriptionDeliveriesRequest) (*pb.ListSubscriptionDeliveriesResponse, error) {
+	return subscriptions.ListSubscriptionDeliveries(ctx, req)
+}
@@ -0,0 +1,32 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/superplanehq/superplane/pkg/authorization"
+	"github.com/superplanehq/superplane/pkg/grpc/actions/tokens"
+	pb "github.com/superplanehq/superplane/pkg/protos/tokens"
+)
+
+type TokensService struct {
+	pb.UnimplementedTokensServer
+	authService authorization.Authorization
+}
+
+func NewTokensService(authService authorization.Authorization) *TokensService {
+	return &TokensService{
+		authService: authService,
+	}
+}
+
+func (s *TokensService) CreateToken(ctx context.Context, req *pb.CreateTokenRequest) (*pb.CreateTokenResponse, error) {
+	return tokens.CreateToken(ctx, s.authService, req)
+}
+
+func (s *TokensService) ListTokens(ctx context.Context, req *pb.ListTokensRequest) (*pb.ListTokensResponse, error) {
+	return tokens.ListTokens(ctx, s.authService, req)
+}
+
+func (s *TokensService) RevokeToken(ctx context.Context, req *pb.RevokeTokenRequest) (*pb.RevokeTokenResponse, error) {
+	return tokens.RevokeToken(ctx, s.authService, req)
+}
@@ -0,0 +1,142 @@
+package declarative
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/superplanehq/superplane/pkg/configuration"
+	"github.com/superplanehq/superplane/pkg/core"
+)
+
+//
+// Component sends the request of an action,
+// and emits the response, or what the output maps it to.
+//
+// Expressions in the request and output can use:
+//   config      - the configuration of the node
+//   integration - the configuration of the integration
+//   data        - the input of the execution
+//   response    - the status, headers and body of the response, for the output
+//
+
+type Component struct {
+	integration *Integration
+	spec        ActionSpec
+}
+
+func (c *Component) Name() string {
+	return c.integration.Name() + "." + c.spec.Name
+}
+
+func (c *Component) Label() string {
+	return c.spec.Label
+}
+
+func (c *Component) Description() string {
+	return c.spec.Description
+}
+
+func (c *Component) Documentation() string {
+	return c.spec.Documentation
+}
+
+func (c *Component) Icon() string {
+	if c.spec.Icon == "" {
+		return c.integration.Icon()
+	}
+
+	return c.spec.Icon
+}
+
+func (c *Component) Color() string {
+	if c.spec.Color == "" {
+		return "gray"
+	}
+
+	return c.spec.Color
+}
+
+func (c *Component) ExampleOutput() map[string]any {
+	if c.spec.ExampleOutput == nil {
+		return nil
+	}
+
+	return map[string]any{
+		"type":      c.payloadType(),
+		"data":      c.spec.ExampleOutput,
+		"timestamp": time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC).Format(time.RFC3339),
+	}
+}
+
+func (c *Component) OutputChannels(configuration any) []core.OutputChannel {
+	return []core.OutputChannel{core.DefaultOutputChannel}
+}
+
+func (c *Component) Configuration() []configuration.Field {
+	if c.spec.Configuration == nil {
+		return []configuration.Field{}
+	}
+
+	return c.spec.Configuration
+}
+
+func (c *Component) Setup(ctx core.SetupContext) error {
+	return nil
+}
+
+func (c *Component) ProcessQueueItem(ctx core.ProcessQueueContext) (*uuid.UUID, error) {
+	return ctx.DefaultProcessing()
+}
+
+func (c *Component) Execute(ctx core.ExecutionContext) error {
+	env := map[string]any{
+		"config":      valueOrEmpty(ctx.Configuration),
+		"integration": integrationValues(c.integration.spec.Configuration, ctx.Integration),
+		"data":        valueOrEmpty(ctx.Data),
+	}
+
+	res, err := send(&c.integration.spec, c.spec.Request, env, ctx.HTTP)
+	if err != nil {
+		return err
+	}
+
+	payload := res.Body
+	if c.spec.Output.Payload != nil {
+		env["response"] = res.value()
+		payload, err = render(c.spec.Output.Payload, env)
+		if err != nil {
+			return err
+		}
+	}
+
+	return ctx.ExecutionState.Emit(core.DefaultOutputChannel.Name, c.payloadType(), []any{payload})
+}
+
+func (c *Component) payloadType() string {
+	if c.spec.Output.PayloadType != "" {
+		return c.spec.Output.PayloadType
+	}
+
+	return c.Name()
+}
+
+func (c *Component) Actions() []core.Action {
+	return []core.Action{}
+}
+
+func (c *Component) HandleAction(ctx core.ActionContext) error {
+	return nil
+}
+
+func (c *Component) HandleWebhook(ctx core.WebhookRequestContext) (int, error) {
+	return http.StatusOK, nil
+}
+
+func (c *Component) Cancel(ctx core.ExecutionContext) error {
+	return nil
+}
+
+func (c *Component) Cleanup(ctx core.SetupContext) error {
+	return nil
+}
@@ -0,0 +1,354 @@
+package declarative
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/ghodss/yaml"
+	"github.com/superplanehq/superplane/pkg/configuration"
+)
+
+//
+// Declarative integrations are HTTP integrations defined in YAML files,
+// for APIs that only need a few requests to be useful, without writing Go.
+//
+// A file defines the base URL and authentication of the API,
+// its actions, which become components sending a request and emitting
+// the response, and its triggers, which receive webhooks.
+// Values in requests, outputs and events can use expressions,
+// with the same {{ }} syntax used in canvases.
+//
+
+const (
+	AuthTypeBearer                  = "bearer"
+	AuthTypeBasic                   = "basic"
+	AuthTypeAPIKey                  = "apiKey"
+	AuthTypeOAuth2ClientCredentials = "oauth2ClientCredentials"
+)
+
+const (
+	SignatureAlgorithmSHA1   = "sha1"
+	SignatureAlgorithmSHA256 = "sha256"
+	SignatureAlgorithmSHA512 = "sha512"
+
+	SignatureEncodingHex    = "hex"
+	SignatureEncodingBase64 = "base64"
+)
+
+var namePattern = regexp.MustCompile(`^[a-z][a-zA-Z0-9]*$`)
+
+type Spec struct {
+	Name          string                `json:"name"`
+	Label         string                `json:"label"`
+	Description   string                `json:"description"`
+	Icon          string                `json:"icon"`
+	Instructions  string                `json:"instructions"`
+	BaseURL       string                `json:"baseURL"`
+	Configuration []configuration.Field `json:"configuration"`
+	Auth          *AuthSpec             `json:"auth"`
+
+	//
+	// Request sent when the integration is synced,
+	// to check that the configuration works.
+	//
+	Verify *RequestSpec `json:"verify"`
+
+	Actions  []ActionSpec  `json:"actions"`
+	Triggers []TriggerSpec `json:"triggers"`
+}
+
+type AuthSpec struct {
+	Type string `json:"type"`
+
+	// bearer
+	Token string `json:"token"`
+
+	// basic
+	Username string `json:"username"`
+	Password string `json:"password"`
+
+	// apiKey
+	Header string `json:"header"`
+	Value  string `json:"value"`
+
+	// oauth2ClientCredentials
+	TokenURL     string   `json:"tokenURL"`
+	ClientID     string   `json:"clientID"`
+	ClientSecret string   `json:"clientSecret"`
+	Scopes       []string `json:"scopes"`
+}
+
+//
+// Path can also be a full URL, in which case the base URL is not used.
+// A body that is a string is sent as is, anything else is sent as JSON.
+//
+
+type RequestSpec struct {
+	Method  string            `json:"method"`
+	Path    string            `json:"path"`
+	Headers map[string]string `json:"headers"`
+	Query   map[string]string `json:"query"`
+	Body    any               `json:"body"`
+}
+
+type OutputSpec struct {
+	PayloadType string `json:"payloadType"`
+	Payload     any    `json:"payload"`
+}
+
+type ActionSpec struct {
+	Name          string                `json:"name"`
+	Label         string                `json:"label"`
+	Description   string                `json:"description"`
+	Documentation string                `json:"documentation"`
+	Icon          string                `json:"icon"`
+	Color         string                `json:"color"`
+	Configuration []configuration.Field `json:"configuration"`
+	Request       RequestSpec           `json:"request"`
+	Output        OutputSpec            `json:"output"`
+	ExampleOutput map[string]any        `json:"exampleOutput"`
+}
+
+type TriggerSpec struct {
+	Name          string                `json:"name"`
+	Label         string                `json:"label"`
+	Description   string                `json:"description"`
+	Documentation string                `json:"documentation"`
+	Icon          string                `json:"icon"`
+	Color         string                `json:"color"`
+	Configuration []configuration.Field `json:"configuration"`
+	Webhook       WebhookSpec           `json:"webhook"`
+	ExampleData   map[string]any        `json:"exampleData"`
+}
+
+//
+// Filter is an expression deciding if an event is emitted for the webhook.
+// Requests that don't pass it are accepted, but ignored.
+//
+
+type WebhookSpec struct {
+	Signature *SignatureSpec `json:"signature"`
+	Filter    string         `json:"filter"`
+	Event     EventSpec      `json:"event"`
+}
+
+type SignatureSpec struct {
+	Header    string `json:"header"`
+	Prefix    string `json:"prefix"`
+	Algorithm string `json:"algorithm"`
+	Encoding  string `json:"encoding"`
+	Secret    string `json:"secret"`
+}
+
+type EventSpec struct {
+	Type    string `json:"type"`
+	Payload any    `json:"payload"`
+}
+
+func Parse(data []byte) (*Integration, error) {
+	spec := Spec{}
+	err := yaml.Unmarshal(data, &spec)
+	if err != nil {
+		return nil, fmt.Errorf("invalid YAML: %w", err)
+	}
+
+	err = spec.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	return NewIntegration(spec), nil
+}
+
+//
+// LoadDir loads the integrations from all YAML files in a directory.
+// Files that can't be loaded don't prevent the others from loading;
+// the returned error describes all of them.
+//
+
+func LoadDir(dir string) ([]*Integration, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("error reading %s: %w", dir, err)
+	}
+
+	names := []string{}
+	for _, entry := range entries {
+		extension := filepath.Ext(entry.Name())
+		if !entry.IsDir() && (extension == ".yaml" || extension == ".yml") {
+			names = append(names, entry.Name())
+		}
+	}
+
+	sort.Strings(names)
+
+	integrations := []*Integration{}
+	errs := []error{}
+	for _, name := range names {
+		data, err := os.ReadFile(filepath.Join(dir, name))
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", name, err))
+			continue
+		}
+
+		integration, err := Parse(data)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", name, err))
+			continue
+		}
+
+		integrations = append(integrations, integration)
+	}
+
+	return integrations, errors.Join(errs...)
+}
+
+func (s *Spec) Validate() error {
+	if !namePattern.MatchString(s.Name) {
+		return fmt.Errorf("name must be camelCase, got %q", s.Name)
+	}
+
+	if s.Label == "" {
+		return fmt.Errorf("label is required")
+	}
+
+	if s.BaseURL == "" {
+		return fmt.Errorf("baseURL is required")
+	}
+
+	if s.Auth != nil {
+		err := s.Auth.Validate()
+		if err != nil {
+			return fmt.Errorf("auth: %w", err)
+		}
+	}
+
+	if s.Verify != nil {
+		err := s.Verify.Validate()
+		if err != nil {
+			return fmt.Errorf("verify: %w", err)
+		}
+	}
+
+	names := map[string]bool{}
+	for _, action := range s.Actions {
+		err := validateName(action.Name, action.Label, names)
+		if err != nil {
+			return fmt.Errorf("action %q: %w", action.Name, err)
+		}
+
+		err = action.Request.Validate()
+		if err != nil {
+			return fmt.Errorf("action %s: request: %w", action.Name, err)
+		}
+	}
+
+	names = map[string]bool{}
+	for _, trigger := range s.Triggers {
+		err := validateName(trigger.Name, trigger.Label, names)
+		if err != nil {
+			return fmt.Errorf("trigger %q: %w", trigger.Name, err)
+		}
+
+		err = trigger.Webhook.Validate()
+		if err != nil {
+			return fmt.Errorf("trigger %s: webhook: %w", trigger.Name, err)
+		}
+	}
+
+	return nil
+}
+
+func validateName(name, label string, existing map[string]bool) error {
+	if !namePattern.MatchString(name) {
+		return fmt.Errorf("name must be camelCase")
+	}
+
+	if label == "" {
+		return fmt.Errorf("label is required")
+	}
+
+	if existing[name] {
+		return fmt.Errorf("name is used more than once")
+	}
+
+	existing[name] = true
+	return nil
+}
+
+func (a *AuthSpec) Validate() error {
+	switch a.Type {
+	case AuthTypeBearer:
+		if a.Token == "" {
+			return fmt.Errorf("token is required")
+		}
+
+	case AuthTypeBasic:
+		if a.Username == "" {
+			return fmt.Errorf("username is required")
+		}
+
+	case AuthTypeAPIKey:
+		if a.Header == "" || a.Value == "" {
+			return fmt.Errorf("header and value are required")
+		}
+
+	case AuthTypeOAuth2ClientCredentials:
+		if a.TokenURL == "" || a.ClientID == "" || a.ClientSecret == "" {
+			return fmt.Errorf("tokenURL, clientID and clientSecret are required")
+		}
+
+	default:
+		return fmt.Errorf("unknown type %q", a.Type)
+	}
+
+	return nil
+}
+
+func (r *RequestSpec) Validate() error {
+	switch strings.ToUpper(r.Method) {
+	case http.MethodGet, http.MethodPost, http.MethodPut, http.MethodPatch, http.MethodDelete:
+	default:
+		return fmt.Errorf("unsupported method %q", r.Method)
+	}
+
+	if r.Path == "" {
+		return fmt.Errorf("path is required")
+	}
+
+	return nil
+}
+
+func (w *WebhookSpec) Validate() error {
+	if w.Event.Type == "" {
+		return fmt.Errorf("event type is required")
+	}
+
+	if w.Signature == nil {
+		return nil
+	}
+
+	if w.Signature.Header == "" || w.Signature.Secret == "" {
+		return fmt.Errorf("signature header and secret are required")
+	}
+
+	switch w.Signature.Algorithm {
+	case "", SignatureAlgorithmSHA1, SignatureAlgorithmSHA256, SignatureAlgorithmSHA512:
+	default:
+		return fmt.Errorf("unsupported signature algorithm %q", w.Signature.Algorithm)
+	}
+
+	switch w.Signature.Encoding {
+	case "", SignatureEncodingHex, SignatureEncodingBase64:
+	default:
+		return fmt.Errorf("unsupported signature encoding %q", w.Signature.Encoding)
+	}
+
+	return nil
+}
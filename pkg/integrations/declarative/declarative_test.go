@@ -0,0 +1,370 @@
+package declarative
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/core"
+	"github.com/superplanehq/superplane/pkg/testkit"
+	"github.com/superplanehq/superplane/test/support/contexts"
+)
+
+func loadAcme(t *testing.T) *Integration {
+	data, err := os.ReadFile(filepath.Join("testdata", "acme.yaml"))
+	require.NoError(t, err)
+
+	integration, err := Parse(data)
+	require.NoError(t, err)
+	return integration
+}
+
+func sign(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+func Test__Parse(t *testing.T) {
+	t.Run("valid file -> integration with components and triggers", func(t *testing.T) {
+		integration := loadAcme(t)
+		assert.Equal(t, "acme", integration.Name())
+		assert.Equal(t, "Acme", integration.Label())
+		assert.Equal(t, defaultIcon, integration.Icon())
+		assert.Len(t, integration.Configuration(), 3)
+
+		require.Len(t, integration.Components(), 2)
+		assert.Equal(t, "acme.createDeployment", integration.Components()[0].Name())
+		assert.Equal(t, "acme.listServices", integration.Components()[1].Name())
+		assert.Len(t, integration.Components()[0].Configuration(), 2)
+		assert.Equal(t, "acme.deployment", integration.Components()[0].ExampleOutput()["type"])
+
+		require.Len(t, integration.Triggers(), 1)
+		assert.Equal(t, "acme.onDeployment", integration.Triggers()[0].Name())
+	})
+
+	t.Run("invalid definitions -> error", func(t *testing.T) {
+		cases := map[string]string{
+			"name must be camelCase":      "name: Acme\nlabel: Acme\nbaseURL: https://acme.com",
+			"baseURL is required":         "name: acme\nlabel: Acme",
+			"auth: unknown type":          "name: acme\nlabel: Acme\nbaseURL: https://acme.com\nauth:\n  type: digest",
+			"auth: token is required":     "name: acme\nlabel: Acme\nbaseURL: https://acme.com\nauth:\n  type: bearer",
+			"unsupported method":          "name: acme\nlabel: Acme\nbaseURL: https://acme.com\nactions:\n  - name: a\n    label: A\n    request:\n      method: HEAD\n      path: /",
+			"name is used more than once": "name: acme\nlabel: Acme\nbaseURL: https://acme.com\nactions:\n  - name: a\n    label: A\n    request: {method: GET, path: /}\n  - name: a\n    label: A\n    request: {method: GET, path: /}",
+			"event type is required":      "name: acme\nlabel: Acme\nbaseURL: https://acme.com\ntriggers:\n  - name: a\n    label: A\n    webhook: {}",
+			"unsupported signature algorithm": "name: acme\nlabel: Acme\nbaseURL: https://acme.com\ntriggers:\n  - name: a\n    label: A\n" +
+				"    webhook:\n      event: {type: a}\n      signature: {header: X-Sig, secret: s, algorithm: md5}",
+		}
+
+		for message, definition := range cases {
+			_, err := Parse([]byte(definition))
+			require.Error(t, err, message)
+			assert.Contains(t, err.Error(), message)
+		}
+	})
+}
+
+func Test__LoadDir(t *testing.T) {
+	integrations, err := LoadDir("testdata")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid.yml: baseURL is required")
+
+	require.Len(t, integrations, 1)
+	assert.Equal(t, "acme", integrations[0].Name())
+}
+
+func Test__Integration__Sync(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/me" || r.Header.Get("Authorization") != "Bearer good" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	defer server.Close()
+
+	integration := loadAcme(t)
+
+	t.Run("verify request fails -> error", func(t *testing.T) {
+		integrationCtx := &contexts.IntegrationContext{Configuration: map[string]any{"url": server.URL, "token": "bad"}}
+		err := integration.Sync(core.SyncContext{HTTP: http.DefaultClient, Integration: integrationCtx})
+		require.ErrorContains(t, err, "status 401")
+		assert.NotEqual(t, "ready", integrationCtx.State)
+	})
+
+	t.Run("verify request succeeds -> ready", func(t *testing.T) {
+		integrationCtx := &contexts.IntegrationContext{Configuration: map[string]any{"url": server.URL, "token": "good"}}
+		err := integration.Sync(core.SyncContext{HTTP: http.DefaultClient, Integration: integrationCtx})
+		require.NoError(t, err)
+		assert.Equal(t, "ready", integrationCtx.State)
+	})
+}
+
+func Test__Component__Execute(t *testing.T) {
+	var received *http.Request
+	var receivedBody map[string]any
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received = r
+		data, _ := io.ReadAll(r.Body)
+		receivedBody = nil
+		_ = json.Unmarshal(data, &receivedBody)
+
+		switch r.URL.Path {
+		case "/services/api/deployments":
+			w.WriteHeader(http.StatusCreated)
+			_, _ = w.Write([]byte(`{"id": "dep-1"}`))
+		case "/services":
+			_, _ = w.Write([]byte(`[{"name": "api"}]`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"error": "service not found"}`))
+		}
+	}))
+
+	defer server.Close()
+
+	integration := loadAcme(t)
+	integrationCtx := &contexts.IntegrationContext{Configuration: map[string]any{"url": server.URL, "token": "t0k3n"}}
+
+	t.Run("request is rendered and output is mapped", func(t *testing.T) {
+		kit := testkit.New()
+		kit.HTTP = http.DefaultClient
+		kit.Integration.Configuration = map[string]any{"url": server.URL, "token": "t0k3n"}
+		kit.Configuration = map[string]any{"service": "api", "version": "1.2.3"}
+
+		component := integration.Components()[0]
+		require.NoError(t, kit.Run(component, map[string]any{"dryRun": true, "labels": []any{"a", "b"}}))
+		assert.Equal(t, http.MethodPost, received.Method)
+		assert.Equal(t, "true", received.URL.Query().Get("dryRun"))
+		assert.Equal(t, "Bearer t0k3n", received.Header.Get("Authorization"))
+		assert.Equal(t, "application/json", received.Header.Get("Content-Type"))
+		assert.Equal(t, map[string]any{"version": "1.2.3", "labels": []any{"a", "b"}}, receivedBody)
+
+		testkit.AssertEmitted(t, kit.ExecutionState, core.DefaultOutputChannel.Name, "acme.deployment")
+		payload := testkit.AssertPayload(t, kit.ExecutionState, core.DefaultOutputChannel.Name)
+		assert.Equal(t, map[string]any{"id": "dep-1", "status": float64(201)}, payload)
+		testkit.ValidateExampleOutput(t, component, kit.ExecutionState)
+	})
+
+	t.Run("no output mapping -> response body is emitted", func(t *testing.T) {
+		state := &contexts.ExecutionStateContext{}
+		err := integration.Components()[1].Execute(core.ExecutionContext{
+			Configuration:  map[string]any{},
+			HTTP:           http.DefaultClient,
+			Integration:    integrationCtx,
+			ExecutionState: state,
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, "acme.listServices", state.Type)
+		payload := state.Payloads[0].(map[string]any)["data"]
+		assert.Equal(t, []any{map[string]any{"name": "api"}}, payload)
+	})
+
+	t.Run("request fails -> error with response", func(t *testing.T) {
+		state := &contexts.ExecutionStateContext{}
+		err := integration.Components()[0].Execute(core.ExecutionContext{
+			Configuration:  map[string]any{"service": "missing"},
+			HTTP:           http.DefaultClient,
+			Integration:    integrationCtx,
+			ExecutionState: state,
+		})
+
+		require.ErrorContains(t, err, "status 404")
+		assert.ErrorContains(t, err, "service not found")
+		assert.False(t, state.Finished)
+	})
+}
+
+func Test__Auth(t *testing.T) {
+	tokenRequests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/oauth/token":
+			tokenRequests++
+			_ = r.ParseForm()
+			if r.PostForm.Get("grant_type") != "client_credentials" || r.PostForm.Get("client_secret") != "secret" {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+
+			_, _ = w.Write([]byte(`{"access_token": "access", "expires_in": 3600}`))
+
+		case "/items":
+			_, _ = w.Write([]byte(`{"authorization": "` + r.Header.Get("Authorization") + `", "key": "` + r.Header.Get("X-Api-Key") + `"}`))
+		}
+	}))
+
+	defer server.Close()
+
+	execute := func(t *testing.T, auth string) map[string]any {
+		definition := "name: acme\nlabel: Acme\nbaseURL: " + server.URL + "\n" + auth +
+			"actions:\n  - name: list\n    label: List\n    request: {method: GET, path: /items}\n"
+
+		integration, err := Parse([]byte(definition))
+		require.NoError(t, err)
+
+		state := &contexts.ExecutionStateContext{}
+		err = integration.Components()[0].Execute(core.ExecutionContext{
+			HTTP:           http.DefaultClient,
+			Integration:    &contexts.IntegrationContext{},
+			ExecutionState: state,
+		})
+
+		require.NoError(t, err)
+		return state.Payloads[0].(map[string]any)["data"].(map[string]any)
+	}
+
+	t.Run("basic", func(t *testing.T) {
+		body := execute(t, "auth: {type: basic, username: user, password: pass}\n")
+		assert.Equal(t, "Basic dXNlcjpwYXNz", body["authorization"])
+	})
+
+	t.Run("API key header", func(t *testing.T) {
+		body := execute(t, "auth: {type: apiKey, header: X-Api-Key, value: k3y}\n")
+		assert.Equal(t, "k3y", body["key"])
+	})
+
+	t.Run("OAuth2 client credentials -> token is requested once", func(t *testing.T) {
+		auth := "auth: {type: oauth2ClientCredentials, tokenURL: " + server.URL + "/oauth/token, clientID: id, clientSecret: secret}\n"
+		body := execute(t, auth)
+		assert.Equal(t, "Bearer access", body["authorization"])
+
+		body = execute(t, auth)
+		assert.Equal(t, "Bearer access", body["authorization"])
+		assert.Equal(t, 1, tokenRequests)
+	})
+}
+
+func Test__Request(t *testing.T) {
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/large" {
+			_, _ = w.Write([]byte(strings.Repeat("x", maxResponseBodySize+1)))
+			return
+		}
+
+		_, _ = w.Write([]byte(`{"authorization": "` + r.Header.Get("Authorization") + `"}`))
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(handler))
+	defer server.Close()
+	other := httptest.NewServer(http.HandlerFunc(handler))
+	defer other.Close()
+
+	get := func(path string) (*response, error) {
+		spec := &Spec{BaseURL: server.URL, Auth: &AuthSpec{Type: AuthTypeBearer, Token: "t0ken"}}
+		return send(spec, RequestSpec{Method: http.MethodGet, Path: path}, map[string]any{}, http.DefaultClient)
+	}
+
+	t.Run("path -> authenticated", func(t *testing.T) {
+		res, err := get("/items")
+		require.NoError(t, err)
+		assert.Equal(t, "Bearer t0ken", res.Body.(map[string]any)["authorization"])
+	})
+
+	t.Run("full URL on the base URL origin -> authenticated", func(t *testing.T) {
+		res, err := get(server.URL + "/items")
+		require.NoError(t, err)
+		assert.Equal(t, "Bearer t0ken", res.Body.(map[string]any)["authorization"])
+	})
+
+	t.Run("full URL on another origin -> not authenticated", func(t *testing.T) {
+		res, err := get(other.URL + "/items")
+		require.NoError(t, err)
+		assert.Equal(t, "", res.Body.(map[string]any)["authorization"])
+	})
+
+	t.Run("response too large -> error", func(t *testing.T) {
+		_, err := get("/large")
+		require.ErrorContains(t, err, "response is larger than")
+	})
+}
+
+func Test__Trigger(t *testing.T) {
+	integration := loadAcme(t)
+	trigger := integration.Triggers()[0]
+	integrationCtx := &contexts.IntegrationContext{Configuration: map[string]any{"webhookSecret": "shh"}}
+
+	handle := func(body []byte, signature string, config map[string]any) (int, *contexts.EventContext, error) {
+		headers := http.Header{}
+		headers.Set("X-Acme-Delivery", "d-1")
+		if signature != "" {
+			headers.Set("X-Acme-Signature", signature)
+		}
+
+		events := &contexts.EventContext{}
+		code, err := trigger.HandleWebhook(core.WebhookRequestContext{
+			Body:          body,
+			Headers:       headers,
+			Configuration: config,
+			Integration:   integrationCtx,
+			Events:        events,
+		})
+
+		return code, events, err
+	}
+
+	t.Run("setup -> webhook URL is stored", func(t *testing.T) {
+		metadata := &contexts.MetadataContext{}
+		err := trigger.Setup(core.TriggerContext{Metadata: metadata, Webhook: &contexts.WebhookContext{}})
+		require.NoError(t, err)
+		assert.NotEmpty(t, metadata.Metadata.(TriggerMetadata).URL)
+	})
+
+	t.Run("missing signature -> 403", func(t *testing.T) {
+		code, events, err := handle([]byte(`{}`), "", map[string]any{"service": ""})
+		assert.Equal(t, http.StatusForbidden, code)
+		assert.ErrorContains(t, err, "missing signature")
+		assert.Zero(t, events.Count())
+	})
+
+	t.Run("invalid signature -> 403", func(t *testing.T) {
+		body := []byte(`{"service": "api"}`)
+		code, events, err := handle(body, sign("wrong", body), map[string]any{"service": ""})
+		assert.Equal(t, http.StatusForbidden, code)
+		assert.ErrorContains(t, err, "invalid signature")
+		assert.Zero(t, events.Count())
+	})
+
+	t.Run("secret renders empty -> 403", func(t *testing.T) {
+		integrationCtx.Configuration = map[string]any{}
+		defer func() { integrationCtx.Configuration = map[string]any{"webhookSecret": "shh"} }()
+
+		body := []byte(`{"service": "api"}`)
+		code, events, err := handle(body, sign("", body), map[string]any{"service": ""})
+		assert.Equal(t, http.StatusForbidden, code)
+		assert.ErrorContains(t, err, "error authenticating request")
+		assert.Zero(t, events.Count())
+	})
+
+	t.Run("filter does not match -> 200 and no event", func(t *testing.T) {
+		body := []byte(`{"service": "web", "status": "done"}`)
+		code, events, err := handle(body, sign("shh", body), map[string]any{"service": "api"})
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusOK, code)
+		assert.Zero(t, events.Count())
+	})
+
+	t.Run("valid signature -> event is emitted", func(t *testing.T) {
+		body := []byte(`{"service": "api", "status": "done"}`)
+		code, events, err := handle(body, sign("shh", body), map[string]any{"service": "api"})
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusOK, code)
+		require.Equal(t, 1, events.Count())
+		assert.Equal(t, "acme.deployment", events.Payloads[0].Type)
+		assert.Equal(t, map[string]any{"service": "api", "status": "done", "delivery": "d-1"}, events.Payloads[0].Data)
+	})
+}
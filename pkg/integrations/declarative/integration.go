@@ -0,0 +1,106 @@
+package declarative
+
+import (
+	"fmt"
+
+	"github.com/superplanehq/superplane/pkg/configuration"
+	"github.com/superplanehq/superplane/pkg/core"
+)
+
+const defaultIcon = "globe"
+
+type Integration struct {
+	spec       Spec
+	components []core.Component
+	triggers   []core.Trigger
+}
+
+func NewIntegration(spec Spec) *Integration {
+	integration := &Integration{spec: spec}
+
+	for _, action := range spec.Actions {
+		integration.components = append(integration.components, &Component{integration: integration, spec: action})
+	}
+
+	for _, trigger := range spec.Triggers {
+		integration.triggers = append(integration.triggers, &Trigger{integration: integration, spec: trigger})
+	}
+
+	return integration
+}
+
+func (i *Integration) Name() string {
+	return i.spec.Name
+}
+
+func (i *Integration) Label() string {
+	return i.spec.Label
+}
+
+func (i *Integration) Icon() string {
+	if i.spec.Icon == "" {
+		return defaultIcon
+	}
+
+	return i.spec.Icon
+}
+
+func (i *Integration) Description() string {
+	return i.spec.Description
+}
+
+func (i *Integration) Instructions() string {
+	return i.spec.Instructions
+}
+
+func (i *Integration) Configuration() []configuration.Field {
+	if i.spec.Configuration == nil {
+		return []configuration.Field{}
+	}
+
+	return i.spec.Configuration
+}
+
+func (i *Integration) Components() []core.Component {
+	return i.components
+}
+
+func (i *Integration) Triggers() []core.Trigger {
+	return i.triggers
+}
+
+func (i *Integration) Sync(ctx core.SyncContext) error {
+	if i.spec.Verify != nil {
+		env := map[string]any{
+			"integration": integrationValues(i.spec.Configuration, ctx.Integration),
+		}
+
+		_, err := send(&i.spec, *i.spec.Verify, env, ctx.HTTP)
+		if err != nil {
+			return fmt.Errorf("failed to verify configuration: %w", err)
+		}
+	}
+
+	ctx.Integration.Ready()
+	return nil
+}
+
+func (i *Integration) Cleanup(ctx core.IntegrationCleanupContext) error {
+	return nil
+}
+
+func (i *Integration) Actions() []core.Action {
+	return []core.Action{}
+}
+
+func (i *Integration) HandleAction(ctx core.IntegrationActionContext) error {
+	return nil
+}
+
+func (i *Integration) ListResources(resourceType string, ctx core.ListResourcesContext) ([]core.IntegrationResource, error) {
+	return []core.IntegrationResource{}, nil
+}
+
+func (i *Integration) HandleRequest(ctx core.HTTPRequestContext) {
+	// no-op: webhooks are received by the triggers
+}
@@ -0,0 +1,402 @@
+package declarative
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/superplanehq/superplane/pkg/configuration"
+	"github.com/superplanehq/superplane/pkg/core"
+)
+
+const maxErrorBodyLength = 512
+
+//
+// Responses are emitted as payloads,
+// so bigger responses fail the request instead.
+//
+
+const maxResponseBodySize = 1024 * 1024
+
+type response struct {
+	Status  int
+	Headers map[string]string
+	Body    any
+}
+
+func (r *response) value() map[string]any {
+	return map[string]any{
+		"status":  r.Status,
+		"headers": r.Headers,
+		"body":    r.Body,
+	}
+}
+
+//
+// integrationValues are the values of the integration configuration
+// available to expressions as `integration`. Sensitive values are decrypted,
+// so they can be used for authentication.
+//
+
+func integrationValues(fields []configuration.Field, integration core.IntegrationContext) map[string]any {
+	values := map[string]any{}
+	if integration == nil {
+		return values
+	}
+
+	for _, field := range fields {
+		if field.Type != configuration.FieldTypeString && field.Type != configuration.FieldTypeSelect {
+			continue
+		}
+
+		value, err := integration.GetConfig(field.Name)
+		if err != nil {
+			values[field.Name] = ""
+			continue
+		}
+
+		values[field.Name] = string(value)
+	}
+
+	return values
+}
+
+func send(spec *Spec, request RequestSpec, env map[string]any, httpCtx core.HTTPContext) (*response, error) {
+	req, err := buildRequest(spec, request, env)
+	if err != nil {
+		return nil, err
+	}
+
+	if spec.Auth != nil {
+		sameOrigin, err := isBaseURLOrigin(spec, req.URL, env)
+		if err != nil {
+			return nil, err
+		}
+
+		if sameOrigin {
+			err = authenticate(req, spec.Auth, env, httpCtx)
+			if err != nil {
+				return nil, fmt.Errorf("error authenticating: %w", err)
+			}
+		}
+	}
+
+	res, err := httpCtx.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error sending request: %w", err)
+	}
+
+	defer res.Body.Close()
+
+	body, err := readBody(res.Body)
+	if err != nil {
+		return nil, fmt.Errorf("error reading response: %w", err)
+	}
+
+	if res.StatusCode < 200 || res.StatusCode >= 300 {
+		message := string(body)
+		if len(message) > maxErrorBodyLength {
+			message = message[:maxErrorBodyLength]
+		}
+
+		return nil, fmt.Errorf("request failed with status %d: %s", res.StatusCode, message)
+	}
+
+	headers := map[string]string{}
+	for name := range res.Header {
+		headers[name] = res.Header.Get(name)
+	}
+
+	return &response{
+		Status:  res.StatusCode,
+		Headers: headers,
+		Body:    parseBody(body),
+	}, nil
+}
+
+func buildRequest(spec *Spec, request RequestSpec, env map[string]any) (*http.Request, error) {
+	URL, err := requestURL(spec, request, env)
+	if err != nil {
+		return nil, err
+	}
+
+	var body io.Reader
+	contentType := ""
+	if request.Body != nil {
+		rendered, err := render(request.Body, env)
+		if err != nil {
+			return nil, fmt.Errorf("body: %w", err)
+		}
+
+		if text, ok := rendered.(string); ok {
+			body = strings.NewReader(text)
+			contentType = "text/plain"
+		} else {
+			data, err := json.Marshal(rendered)
+			if err != nil {
+				return nil, fmt.Errorf("error encoding body: %w", err)
+			}
+
+			body = bytes.NewReader(data)
+			contentType = "application/json"
+		}
+	}
+
+	req, err := http.NewRequest(strings.ToUpper(request.Method), URL, body)
+	if err != nil {
+		return nil, fmt.Errorf("error creating request: %w", err)
+	}
+
+	req.Header.Set("Accept", "application/json")
+	if contentType != "" {
+		req.Header.Set("Content-Type", contentType)
+	}
+
+	for name, value := range request.Headers {
+		rendered, err := renderText(value, env)
+		if err != nil {
+			return nil, fmt.Errorf("header %s: %w", name, err)
+		}
+
+		req.Header.Set(name, rendered)
+	}
+
+	return req, nil
+}
+
+func requestURL(spec *Spec, request RequestSpec, env map[string]any) (string, error) {
+	path, err := renderText(request.Path, env)
+	if err != nil {
+		return "", fmt.Errorf("path: %w", err)
+	}
+
+	if !strings.HasPrefix(path, "http://") && !strings.HasPrefix(path, "https://") {
+		baseURL, err := renderText(spec.BaseURL, env)
+		if err != nil {
+			return "", fmt.Errorf("baseURL: %w", err)
+		}
+
+		path = strings.TrimSuffix(baseURL, "/") + "/" + strings.TrimPrefix(path, "/")
+	}
+
+	URL, err := url.Parse(path)
+	if err != nil {
+		return "", fmt.Errorf("invalid URL %q: %w", path, err)
+	}
+
+	if len(request.Query) > 0 {
+		query := URL.Query()
+		for name, value := range request.Query {
+			rendered, err := renderText(value, env)
+			if err != nil {
+				return "", fmt.Errorf("query %s: %w", name, err)
+			}
+
+			if rendered != "" {
+				query.Set(name, rendered)
+			}
+		}
+
+		URL.RawQuery = query.Encode()
+	}
+
+	return URL.String(), nil
+}
+
+func readBody(body io.Reader) ([]byte, error) {
+	data, err := io.ReadAll(io.LimitReader(body, maxResponseBodySize+1))
+	if err != nil {
+		return nil, err
+	}
+
+	if len(data) > maxResponseBodySize {
+		return nil, fmt.Errorf("response is larger than %d bytes", maxResponseBodySize)
+	}
+
+	return data, nil
+}
+
+//
+// Paths can be full URLs, like the ones APIs return for pagination,
+// but only requests to the origin of the base URL are authenticated,
+// so the credentials of the integration are not sent anywhere else.
+//
+
+func isBaseURLOrigin(spec *Spec, URL *url.URL, env map[string]any) (bool, error) {
+	baseURL, err := renderText(spec.BaseURL, env)
+	if err != nil {
+		return false, fmt.Errorf("baseURL: %w", err)
+	}
+
+	base, err := url.Parse(baseURL)
+	if err != nil {
+		return false, fmt.Errorf("invalid baseURL %q: %w", baseURL, err)
+	}
+
+	return base.Host != "" && strings.EqualFold(base.Scheme, URL.Scheme) && strings.EqualFold(base.Host, URL.Host), nil
+}
+
+func parseBody(body []byte) any {
+	if len(bytes.TrimSpace(body)) == 0 {
+		return nil
+	}
+
+	var parsed any
+	err := json.Unmarshal(body, &parsed)
+	if err != nil {
+		return string(body)
+	}
+
+	return parsed
+}
+
+func authenticate(req *http.Request, auth *AuthSpec, env map[string]any, httpCtx core.HTTPContext) error {
+	switch auth.Type {
+	case AuthTypeBearer:
+		token, err := renderText(auth.Token, env)
+		if err != nil {
+			return err
+		}
+
+		req.Header.Set("Authorization", "Bearer "+token)
+
+	case AuthTypeBasic:
+		username, err := renderText(auth.Username, env)
+		if err != nil {
+			return err
+		}
+
+		password, err := renderText(auth.Password, env)
+		if err != nil {
+			return err
+		}
+
+		req.SetBasicAuth(username, password)
+
+	case AuthTypeAPIKey:
+		value, err := renderText(auth.Value, env)
+		if err != nil {
+			return err
+		}
+
+		req.Header.Set(auth.Header, value)
+
+	case AuthTypeOAuth2ClientCredentials:
+		token, err := oauth2Token(auth, env, httpCtx)
+		if err != nil {
+			return err
+		}
+
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+
+	return nil
+}
+
+//
+// OAuth2 access tokens are cached in memory until they expire,
+// to avoid getting a new one for every request.
+//
+
+type cachedToken struct {
+	value     string
+	expiresAt time.Time
+}
+
+var (
+	oauth2Tokens   = map[string]cachedToken{}
+	oauth2TokensMu sync.Mutex
+)
+
+type oauth2TokenResponse struct {
+	AccessToken string `json:"access_token"`
+	ExpiresIn   int    `json:"expires_in"`
+}
+
+func oauth2Token(auth *AuthSpec, env map[string]any, httpCtx core.HTTPContext) (string, error) {
+	tokenURL, err := renderText(auth.TokenURL, env)
+	if err != nil {
+		return "", err
+	}
+
+	clientID, err := renderText(auth.ClientID, env)
+	if err != nil {
+		return "", err
+	}
+
+	clientSecret, err := renderText(auth.ClientSecret, env)
+	if err != nil {
+		return "", err
+	}
+
+	key := tokenURL + "|" + clientID + "|" + clientSecret
+	oauth2TokensMu.Lock()
+	token, ok := oauth2Tokens[key]
+	oauth2TokensMu.Unlock()
+
+	if ok && time.Now().Before(token.expiresAt) {
+		return token.value, nil
+	}
+
+	form := url.Values{}
+	form.Set("grant_type", "client_credentials")
+	form.Set("client_id", clientID)
+	form.Set("client_secret", clientSecret)
+	if len(auth.Scopes) > 0 {
+		form.Set("scope", strings.Join(auth.Scopes, " "))
+	}
+
+	req, err := http.NewRequest(http.MethodPost, tokenURL, strings.NewReader(form.Encode()))
+	if err != nil {
+		return "", err
+	}
+
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+
+	res, err := httpCtx.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("error getting access token: %w", err)
+	}
+
+	defer res.Body.Close()
+
+	body, err := readBody(res.Body)
+	if err != nil {
+		return "", fmt.Errorf("error reading access token: %w", err)
+	}
+
+	if res.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("error getting access token: status %d", res.StatusCode)
+	}
+
+	tokenResponse := oauth2TokenResponse{}
+	err = json.Unmarshal(body, &tokenResponse)
+	if err != nil || tokenResponse.AccessToken == "" {
+		return "", fmt.Errorf("invalid access token response")
+	}
+
+	//
+	// Tokens are refreshed a minute before they expire,
+	// so they don't expire while a request is being sent.
+	//
+	expiresIn := time.Duration(tokenResponse.ExpiresIn) * time.Second
+	if expiresIn <= 0 {
+		expiresIn = 5 * time.Minute
+	}
+
+	oauth2TokensMu.Lock()
+	oauth2Tokens[key] = cachedToken{
+		value:     tokenResponse.AccessToken,
+		expiresAt: time.Now().Add(expiresIn - time.Minute),
+	}
+	oauth2TokensMu.Unlock()
+
+	return tokenResponse.AccessToken, nil
+}
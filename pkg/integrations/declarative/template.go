@@ -0,0 +1,132 @@
+package declarative
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/expr-lang/expr"
+)
+
+var expressionRegex = regexp.MustCompile(`\{\{(.*?)\}\}`)
+
+//
+// render resolves the expressions in strings inside of a value.
+// A string with a single expression and nothing else
+// resolves to the value of the expression, keeping its type,
+// so lists and objects can be passed through as they are.
+//
+
+func render(value any, env map[string]any) (any, error) {
+	switch v := value.(type) {
+	case string:
+		return renderString(v, env)
+
+	case map[string]any:
+		result := make(map[string]any, len(v))
+		for key, item := range v {
+			rendered, err := render(item, env)
+			if err != nil {
+				return nil, err
+			}
+
+			result[key] = rendered
+		}
+
+		return result, nil
+
+	case []any:
+		result := make([]any, 0, len(v))
+		for _, item := range v {
+			rendered, err := render(item, env)
+			if err != nil {
+				return nil, err
+			}
+
+			result = append(result, rendered)
+		}
+
+		return result, nil
+
+	default:
+		return v, nil
+	}
+}
+
+func renderString(value string, env map[string]any) (any, error) {
+	matches := expressionRegex.FindAllStringSubmatchIndex(value, -1)
+	if len(matches) == 0 {
+		return value, nil
+	}
+
+	if len(matches) == 1 && matches[0][0] == 0 && matches[0][1] == len(value) {
+		return evaluate(value[matches[0][2]:matches[0][3]], env)
+	}
+
+	var err error
+	result := expressionRegex.ReplaceAllStringFunc(value, func(match string) string {
+		submatches := expressionRegex.FindStringSubmatch(match)
+		resolved, e := evaluate(submatches[1], env)
+		if e != nil {
+			err = e
+			return ""
+		}
+
+		if resolved == nil {
+			return ""
+		}
+
+		return fmt.Sprintf("%v", resolved)
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+//
+// renderText is used for values that must be strings,
+// like URLs and headers.
+//
+
+func renderText(value string, env map[string]any) (string, error) {
+	rendered, err := renderString(value, env)
+	if err != nil {
+		return "", err
+	}
+
+	if rendered == nil {
+		return "", nil
+	}
+
+	return fmt.Sprintf("%v", rendered), nil
+}
+
+//
+// valueOrEmpty avoids errors in expressions like `data.name`
+// when a node has no configuration or an execution has no input.
+//
+
+func valueOrEmpty(value any) any {
+	if value == nil {
+		return map[string]any{}
+	}
+
+	return value
+}
+
+func evaluate(expression string, env map[string]any) (any, error) {
+	program, err := expr.Compile(strings.TrimSpace(expression), expr.Env(env), expr.AsAny())
+	if err != nil {
+		return nil, fmt.Errorf("invalid expression %q: %w", expression, err)
+	}
+
+	value, err := expr.Run(program, env)
+	if err != nil {
+		return nil, fmt.Errorf("error evaluating %q: %w", expression, err)
+	}
+
+	return value, nil
+}
@@ -0,0 +1,225 @@
+package declarative
+
+import (
+	"crypto/hmac"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"hash"
+	"net/http"
+	"strings"
+
+	"github.com/mitchellh/mapstructure"
+	"github.com/superplanehq/superplane/pkg/configuration"
+	"github.com/superplanehq/superplane/pkg/core"
+)
+
+const MaxEventSize = 64 * 1024
+
+//
+// Trigger emits an event for each webhook received on its URL.
+//
+// Expressions in the signature secret, filter and event can use:
+//   config      - the configuration of the node
+//   integration - the configuration of the integration
+//   body        - the JSON body of the webhook, not available for the secret
+//   headers     - the headers of the webhook, not available for the secret
+//
+
+type Trigger struct {
+	integration *Integration
+	spec        TriggerSpec
+}
+
+type TriggerMetadata struct {
+	URL string `json:"url" mapstructure:"url"`
+}
+
+func (t *Trigger) Name() string {
+	return t.integration.Name() + "." + t.spec.Name
+}
+
+func (t *Trigger) Label() string {
+	return t.spec.Label
+}
+
+func (t *Trigger) Description() string {
+	return t.spec.Description
+}
+
+func (t *Trigger) Documentation() string {
+	return t.spec.Documentation
+}
+
+func (t *Trigger) Icon() string {
+	if t.spec.Icon == "" {
+		return t.integration.Icon()
+	}
+
+	return t.spec.Icon
+}
+
+func (t *Trigger) Color() string {
+	if t.spec.Color == "" {
+		return "gray"
+	}
+
+	return t.spec.Color
+}
+
+func (t *Trigger) ExampleData() map[string]any {
+	return t.spec.ExampleData
+}
+
+func (t *Trigger) Configuration() []configuration.Field {
+	if t.spec.Configuration == nil {
+		return []configuration.Field{}
+	}
+
+	return t.spec.Configuration
+}
+
+func (t *Trigger) Setup(ctx core.TriggerContext) error {
+	metadata := TriggerMetadata{}
+	err := mapstructure.Decode(ctx.Metadata.Get(), &metadata)
+	if err != nil {
+		return fmt.Errorf("failed to parse metadata: %w", err)
+	}
+
+	if metadata.URL != "" {
+		return nil
+	}
+
+	webhookURL, err := ctx.Webhook.Setup()
+	if err != nil {
+		return fmt.Errorf("failed to setup webhook: %w", err)
+	}
+
+	metadata.URL = webhookURL
+	err = ctx.Metadata.Set(metadata)
+	if err != nil {
+		return fmt.Errorf("failed to set metadata: %w", err)
+	}
+
+	return nil
+}
+
+func (t *Trigger) Actions() []core.Action {
+	return []core.Action{}
+}
+
+func (t *Trigger) HandleAction(ctx core.TriggerActionContext) (map[string]any, error) {
+	return nil, nil
+}
+
+func (t *Trigger) HandleWebhook(ctx core.WebhookRequestContext) (int, error) {
+	if len(ctx.Body) > MaxEventSize {
+		return http.StatusRequestEntityTooLarge, fmt.Errorf("payload too large")
+	}
+
+	env := map[string]any{
+		"config":      valueOrEmpty(ctx.Configuration),
+		"integration": integrationValues(t.integration.spec.Configuration, ctx.Integration),
+	}
+
+	if t.spec.Webhook.Signature != nil {
+		err := verifySignature(t.spec.Webhook.Signature, env, ctx.Headers, ctx.Body)
+		if err != nil {
+			return http.StatusForbidden, err
+		}
+	}
+
+	var body any
+	err := json.Unmarshal(ctx.Body, &body)
+	if err != nil {
+		return http.StatusBadRequest, fmt.Errorf("error parsing request body: %v", err)
+	}
+
+	headers := map[string]string{}
+	for name := range ctx.Headers {
+		headers[name] = ctx.Headers.Get(name)
+	}
+
+	env["body"] = body
+	env["headers"] = headers
+
+	if t.spec.Webhook.Filter != "" {
+		accepted, err := renderString(t.spec.Webhook.Filter, env)
+		if err != nil {
+			return http.StatusInternalServerError, fmt.Errorf("error evaluating filter: %v", err)
+		}
+
+		if accepted != true {
+			return http.StatusOK, nil
+		}
+	}
+
+	payload := body
+	if t.spec.Webhook.Event.Payload != nil {
+		payload, err = render(t.spec.Webhook.Event.Payload, env)
+		if err != nil {
+			return http.StatusInternalServerError, fmt.Errorf("error building event: %v", err)
+		}
+	}
+
+	err = ctx.Events.Emit(t.spec.Webhook.Event.Type, payload)
+	if err != nil {
+		return http.StatusInternalServerError, fmt.Errorf("error emitting event: %v", err)
+	}
+
+	return http.StatusOK, nil
+}
+
+func (t *Trigger) Cleanup(ctx core.TriggerContext) error {
+	return nil
+}
+
+func verifySignature(spec *SignatureSpec, env map[string]any, headers http.Header, body []byte) error {
+	signature := strings.TrimPrefix(headers.Get(spec.Header), spec.Prefix)
+	if signature == "" {
+		return fmt.Errorf("missing signature")
+	}
+
+	//
+	// A secret that renders empty, e.g. from an integration field
+	// that is not set, would accept signatures anyone can compute.
+	//
+	secret, err := renderText(spec.Secret, env)
+	if err != nil || secret == "" {
+		return fmt.Errorf("error authenticating request")
+	}
+
+	var received []byte
+	if spec.Encoding == SignatureEncodingBase64 {
+		received, err = base64.StdEncoding.DecodeString(signature)
+	} else {
+		received, err = hex.DecodeString(signature)
+	}
+
+	if err != nil {
+		return fmt.Errorf("invalid signature")
+	}
+
+	mac := hmac.New(signatureHash(spec.Algorithm), []byte(secret))
+	mac.Write(body)
+	if !hmac.Equal(mac.Sum(nil), received) {
+		return fmt.Errorf("invalid signature")
+	}
+
+	return nil
+}
+
+func signatureHash(algorithm string) func() hash.Hash {
+	switch algorithm {
+	case SignatureAlgorithmSHA1:
+		return sha1.New
+	case SignatureAlgorithmSHA512:
+		return sha512.New
+	default:
+		return sha256.New
+	}
+}
@@ -243,6 +243,7 @@ func (r *RunWorkflow) Execute(ctx core.ExecutionContext) error {
 	)
 
 	if err != nil {
+		ctx.Logger.Errorf("Failed to dispatch workflow - repository=%s, workflow=%s, ref=%s: %v", spec.Repository, spec.WorkflowFile, spec.Ref, err)
 		return fmt.Errorf("failed to dispatch workflow: %w", err)
 	}
 
@@ -266,6 +267,7 @@ func (r *RunWorkflow) Execute(ctx core.ExecutionContext) error {
 	})
 
 	if err != nil {
+		ctx.Logger.Errorf("Failed to find the dispatched workflow run: %v", err)
 		return fmt.Errorf("failed to find workflow run: %w", err)
 	}
 
@@ -289,7 +291,7 @@ func (r *RunWorkflow) Execute(ctx core.ExecutionContext) error {
 		return err
 	}
 
-	ctx.Logger.Infof("Started workflow run %d", run.GetID())
+	ctx.Logger.Infof("Started workflow run %d: %s", run.GetID(), run.GetHTMLURL())
 
 	// Schedule poll to check workflow status updates (in case webhook doesn't arrive)
 	return ctx.Requests.ScheduleActionCall("poll", map[string]any{}, WorkflowPollInterval)
@@ -553,6 +555,8 @@ func (r *RunWorkflow) poll(ctx core.ActionContext) error {
 		return err
 	}
 
+	ctx.Logger.Infof("Workflow run %d completed with conclusion %s", run.GetID(), run.GetConclusion())
+
 	// Emit based on conclusion
 	if run.GetConclusion() == WorkflowRunConclusionSuccess {
 		return ctx.ExecutionState.Emit(WorkflowPassedOutputChannel, WorkflowPayloadType, []any{run})
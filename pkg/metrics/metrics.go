@@ -0,0 +1,227 @@
+package metrics
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+const (
+	LabelOrganization = "organization"
+	LabelCanvas       = "canvas"
+	LabelNode         = "node"
+	LabelComponent    = "component"
+
+	//
+	// Once the number of label combinations reaches the limit,
+	// new combinations are recorded with this value in all labels,
+	// so a canvas with lots of nodes can't blow up the number of series.
+	//
+	OverflowLabelValue = "other"
+
+	DefaultMaxSeries = 1000
+)
+
+var AllLabels = []string{LabelOrganization, LabelCanvas, LabelNode, LabelComponent}
+
+//
+// Business metrics about canvas executions, labeled by organization, canvas,
+// node and component. This package does not depend on the models,
+// so the models can record the metrics when executions change state.
+//
+
+var (
+	ready   atomic.Bool
+	limiter *seriesLimiter
+	labels  map[string]bool
+
+	executionsCounter          metric.Int64Counter
+	executionDurationHistogram metric.Float64Histogram
+	queueWaitHistogram         metric.Float64Histogram
+)
+
+type Labels struct {
+	OrganizationID string
+	CanvasID       string
+	NodeID         string
+	Component      string
+}
+
+type Options struct {
+	//
+	// Labels added to the metrics. Dropping high cardinality labels,
+	// like the node, keeps the number of series down on large installations.
+	//
+	Labels []string
+
+	//
+	// Maximum number of label combinations recorded.
+	//
+	MaxSeries int
+}
+
+//
+// OptionsFromEnv reads the cardinality controls from
+// METRICS_LABELS (comma-separated list of labels) and METRICS_MAX_SERIES.
+//
+
+func OptionsFromEnv() (Options, error) {
+	options := Options{
+		Labels:    AllLabels,
+		MaxSeries: DefaultMaxSeries,
+	}
+
+	if value := os.Getenv("METRICS_LABELS"); value != "" {
+		options.Labels = []string{}
+		for _, label := range strings.Split(value, ",") {
+			options.Labels = append(options.Labels, strings.TrimSpace(label))
+		}
+	}
+
+	if value := os.Getenv("METRICS_MAX_SERIES"); value != "" {
+		maxSeries, err := strconv.Atoi(value)
+		if err != nil || maxSeries <= 0 {
+			return Options{}, fmt.Errorf("invalid METRICS_MAX_SERIES: %s", value)
+		}
+
+		options.MaxSeries = maxSeries
+	}
+
+	return options, nil
+}
+
+func Init(meter metric.Meter, options Options) error {
+	enabledLabels := map[string]bool{}
+	for _, label := range options.Labels {
+		if !slices.Contains(AllLabels, label) {
+			return fmt.Errorf("unknown metrics label %s", label)
+		}
+
+		enabledLabels[label] = true
+	}
+
+	var err error
+	executionsCounter, err = meter.Int64Counter(
+		"canvas.node_executions.finished",
+		metric.WithDescription("Number of finished canvas node executions, by result"),
+		metric.WithUnit("1"),
+	)
+	if err != nil {
+		return err
+	}
+
+	executionDurationHistogram, err = meter.Float64Histogram(
+		"canvas.node_executions.duration.seconds",
+		metric.WithDescription("Duration of canvas node executions, from start to finish"),
+		metric.WithUnit("s"),
+	)
+	if err != nil {
+		return err
+	}
+
+	queueWaitHistogram, err = meter.Float64Histogram(
+		"canvas.node_queue.wait.seconds",
+		metric.WithDescription("Time items spend in a node queue before being processed"),
+		metric.WithUnit("s"),
+	)
+	if err != nil {
+		return err
+	}
+
+	labels = enabledLabels
+	limiter = newSeriesLimiter(options.MaxSeries)
+	ready.Store(true)
+	return nil
+}
+
+func Enabled() bool {
+	return ready.Load()
+}
+
+func RecordExecutionFinished(ctx context.Context, l Labels, result string, duration time.Duration) {
+	if !ready.Load() {
+		return
+	}
+
+	attrs := metric.WithAttributes(append(attributes(l), attribute.String("result", result))...)
+	executionsCounter.Add(ctx, 1, attrs)
+	executionDurationHistogram.Record(ctx, duration.Seconds(), attrs)
+}
+
+func RecordQueueWait(ctx context.Context, l Labels, wait time.Duration) {
+	if !ready.Load() {
+		return
+	}
+
+	queueWaitHistogram.Record(ctx, wait.Seconds(), metric.WithAttributes(attributes(l)...))
+}
+
+func attributes(l Labels) []attribute.KeyValue {
+	values := map[string]string{
+		LabelOrganization: l.OrganizationID,
+		LabelCanvas:       l.CanvasID,
+		LabelNode:         l.NodeID,
+		LabelComponent:    l.Component,
+	}
+
+	key := ""
+	for _, label := range AllLabels {
+		if labels[label] {
+			key += values[label] + "|"
+		}
+	}
+
+	overflow := !limiter.allow(key)
+	attrs := []attribute.KeyValue{}
+	for _, label := range AllLabels {
+		if !labels[label] {
+			continue
+		}
+
+		if overflow {
+			attrs = append(attrs, attribute.String(label, OverflowLabelValue))
+		} else {
+			attrs = append(attrs, attribute.String(label, values[label]))
+		}
+	}
+
+	return attrs
+}
+
+type seriesLimiter struct {
+	mu     sync.Mutex
+	max    int
+	series map[string]bool
+}
+
+func newSeriesLimiter(max int) *seriesLimiter {
+	return &seriesLimiter{
+		max:    max,
+		series: map[string]bool{},
+	}
+}
+
+func (l *seriesLimiter) allow(key string) bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.series[key] {
+		return true
+	}
+
+	if len(l.series) >= l.max {
+		return false
+	}
+
+	l.series[key] = true
+	return true
+}
@@ -0,0 +1,130 @@
+package metrics
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+)
+
+func setupMetrics(t *testing.T, options Options) *sdkmetric.ManualReader {
+	reader := sdkmetric.NewManualReader()
+	provider := sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader))
+	require.NoError(t, Init(provider.Meter("test"), options))
+	t.Cleanup(func() { ready.Store(false) })
+	return reader
+}
+
+func collectSums(t *testing.T, reader *sdkmetric.ManualReader, name string) []metricdata.DataPoint[int64] {
+	var data metricdata.ResourceMetrics
+	require.NoError(t, reader.Collect(context.Background(), &data))
+
+	for _, scope := range data.ScopeMetrics {
+		for _, m := range scope.Metrics {
+			if m.Name == name {
+				return m.Data.(metricdata.Sum[int64]).DataPoints
+			}
+		}
+	}
+
+	return nil
+}
+
+func Test__OptionsFromEnv(t *testing.T) {
+	t.Run("defaults", func(t *testing.T) {
+		options, err := OptionsFromEnv()
+		require.NoError(t, err)
+		assert.Equal(t, AllLabels, options.Labels)
+		assert.Equal(t, DefaultMaxSeries, options.MaxSeries)
+	})
+
+	t.Run("custom labels and series limit", func(t *testing.T) {
+		t.Setenv("METRICS_LABELS", "organization, canvas")
+		t.Setenv("METRICS_MAX_SERIES", "50")
+
+		options, err := OptionsFromEnv()
+		require.NoError(t, err)
+		assert.Equal(t, []string{LabelOrganization, LabelCanvas}, options.Labels)
+		assert.Equal(t, 50, options.MaxSeries)
+	})
+
+	t.Run("invalid series limit", func(t *testing.T) {
+		t.Setenv("METRICS_MAX_SERIES", "-1")
+		_, err := OptionsFromEnv()
+		require.ErrorContains(t, err, "invalid METRICS_MAX_SERIES")
+	})
+}
+
+func Test__Init__UnknownLabel(t *testing.T) {
+	provider := sdkmetric.NewMeterProvider()
+	err := Init(provider.Meter("test"), Options{Labels: []string{"execution"}, MaxSeries: 10})
+	require.ErrorContains(t, err, "unknown metrics label execution")
+	assert.False(t, Enabled())
+}
+
+func Test__RecordExecutionFinished(t *testing.T) {
+	t.Run("nothing is recorded if metrics are not initialized", func(t *testing.T) {
+		assert.False(t, Enabled())
+		RecordExecutionFinished(context.Background(), Labels{}, "passed", time.Second)
+	})
+
+	t.Run("records outcome with all labels", func(t *testing.T) {
+		reader := setupMetrics(t, Options{Labels: AllLabels, MaxSeries: 10})
+		labels := Labels{OrganizationID: "org", CanvasID: "canvas", NodeID: "node", Component: "http"}
+
+		RecordExecutionFinished(context.Background(), labels, "passed", time.Second)
+		RecordExecutionFinished(context.Background(), labels, "passed", time.Second)
+		RecordExecutionFinished(context.Background(), labels, "failed", time.Second)
+
+		points := collectSums(t, reader, "canvas.node_executions.finished")
+		require.Len(t, points, 2)
+
+		for _, point := range points {
+			result, _ := point.Attributes.Value("result")
+			component, _ := point.Attributes.Value("component")
+			assert.Equal(t, "http", component.AsString())
+
+			if result.AsString() == "passed" {
+				assert.Equal(t, int64(2), point.Value)
+			} else {
+				assert.Equal(t, int64(1), point.Value)
+			}
+		}
+	})
+
+	t.Run("disabled labels are not added", func(t *testing.T) {
+		reader := setupMetrics(t, Options{Labels: []string{LabelOrganization}, MaxSeries: 10})
+
+		RecordExecutionFinished(context.Background(), Labels{OrganizationID: "org", NodeID: "a"}, "passed", time.Second)
+		RecordExecutionFinished(context.Background(), Labels{OrganizationID: "org", NodeID: "b"}, "passed", time.Second)
+
+		points := collectSums(t, reader, "canvas.node_executions.finished")
+		require.Len(t, points, 1)
+		assert.Equal(t, int64(2), points[0].Value)
+
+		_, hasNode := points[0].Attributes.Value(attribute.Key(LabelNode))
+		assert.False(t, hasNode)
+	})
+
+	t.Run("label combinations over the limit are recorded as other", func(t *testing.T) {
+		reader := setupMetrics(t, Options{Labels: []string{LabelNode}, MaxSeries: 2})
+
+		for _, node := range []string{"a", "b", "c", "d", "a"} {
+			RecordExecutionFinished(context.Background(), Labels{NodeID: node}, "passed", time.Second)
+		}
+
+		values := map[string]int64{}
+		for _, point := range collectSums(t, reader, "canvas.node_executions.finished") {
+			node, _ := point.Attributes.Value(attribute.Key(LabelNode))
+			values[node.AsString()] = point.Value
+		}
+
+		assert.Equal(t, map[string]int64{"a": 2, "b": 1, OverflowLabelValue: 2}, values)
+	})
+}
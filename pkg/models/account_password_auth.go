@@ -66,3 +66,19 @@ func (a *AccountPasswordAuth) UpdatePasswordHashInTransaction(tx *gorm.DB, passw
 	a.UpdatedAt = time.Now()
 	return tx.Save(a).Error
 }
+
+func ListAccountIDsWithPasswordAuth(accountIDs []uuid.UUID) ([]uuid.UUID, error) {
+	var ids []uuid.UUID
+
+	err := database.Conn().
+		Model(&AccountPasswordAuth{}).
+		Where("account_id IN ?", accountIDs).
+		Pluck("account_id", &ids).
+		Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return ids, nil
+}
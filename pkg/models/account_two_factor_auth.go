@@ -0,0 +1,232 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/superplanehq/superplane/pkg/database"
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+//
+// AccountTwoFactorAuth holds the TOTP secret of an account,
+// encrypted with the account ID as associated data, and the hashes
+// of its unused recovery codes. The record exists as soon as enrollment
+// starts, but two-factor authentication is only enabled once the first
+// code is verified, so an abandoned enrollment never locks anyone out.
+//
+
+type AccountTwoFactorAuth struct {
+	AccountID     uuid.UUID `gorm:"type:uuid;primaryKey"`
+	Secret        []byte
+	RecoveryCodes datatypes.JSONSlice[string]
+	LastUsedStep  int64
+	EnabledAt     *time.Time
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+}
+
+func (AccountTwoFactorAuth) TableName() string {
+	return "account_two_factor_auth"
+}
+
+func (a *AccountTwoFactorAuth) IsEnabled() bool {
+	return a.EnabledAt != nil
+}
+
+func FindAccountTwoFactorAuth(accountID uuid.UUID) (*AccountTwoFactorAuth, error) {
+	return FindAccountTwoFactorAuthInTransaction(database.Conn(), accountID)
+}
+
+func FindAccountTwoFactorAuthInTransaction(tx *gorm.DB, accountID uuid.UUID) (*AccountTwoFactorAuth, error) {
+	var auth AccountTwoFactorAuth
+
+	err := tx.
+		Where("account_id = ?", accountID).
+		First(&auth).
+		Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &auth, nil
+}
+
+func FindEnabledAccountTwoFactorAuth(accountID uuid.UUID) (*AccountTwoFactorAuth, error) {
+	var auth AccountTwoFactorAuth
+
+	err := database.Conn().
+		Where("account_id = ?", accountID).
+		Where("enabled_at IS NOT NULL").
+		First(&auth).
+		Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &auth, nil
+}
+
+func ListAccountIDsWithTwoFactorEnabled(accountIDs []uuid.UUID) ([]uuid.UUID, error) {
+	var ids []uuid.UUID
+
+	err := database.Conn().
+		Model(&AccountTwoFactorAuth{}).
+		Where("account_id IN ?", accountIDs).
+		Where("enabled_at IS NOT NULL").
+		Pluck("account_id", &ids).
+		Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return ids, nil
+}
+
+//
+// StartAccountTwoFactorEnrollment replaces any previous, unfinished enrollment.
+// It never touches an enabled record, which has to be disabled first.
+//
+
+func StartAccountTwoFactorEnrollment(accountID uuid.UUID, secret []byte) (*AccountTwoFactorAuth, error) {
+	now := time.Now()
+	auth := &AccountTwoFactorAuth{
+		AccountID:     accountID,
+		Secret:        secret,
+		RecoveryCodes: datatypes.JSONSlice[string]{},
+		CreatedAt:     now,
+		UpdatedAt:     now,
+	}
+
+	result := database.Conn().
+		Clauses(clause.OnConflict{
+			Columns: []clause.Column{{Name: "account_id"}},
+			Where: clause.Where{Exprs: []clause.Expression{
+				clause.Expr{SQL: "account_two_factor_auth.enabled_at IS NULL"},
+			}},
+			DoUpdates: clause.AssignmentColumns([]string{
+				"secret",
+				"recovery_codes",
+				"last_used_step",
+				"created_at",
+				"updated_at",
+			}),
+		}).
+		Create(auth)
+
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return nil, gorm.ErrDuplicatedKey
+	}
+
+	return auth, nil
+}
+
+func (a *AccountTwoFactorAuth) Enable(step int64, recoveryCodeHashes []string) error {
+	now := time.Now()
+	result := database.Conn().
+		Model(a).
+		Where("enabled_at IS NULL").
+		Updates(map[string]any{
+			"enabled_at":     now,
+			"last_used_step": step,
+			"recovery_codes": datatypes.JSONSlice[string](recoveryCodeHashes),
+			"updated_at":     now,
+		})
+
+	if result.Error != nil {
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+
+	a.EnabledAt = &now
+	a.LastUsedStep = step
+	a.RecoveryCodes = recoveryCodeHashes
+	a.UpdatedAt = now
+	return nil
+}
+
+//
+// UseStep records the time step of a verified code.
+// It returns false if that step, or a later one, was already used,
+// so the same code can't be replayed while it is still valid.
+//
+
+func (a *AccountTwoFactorAuth) UseStep(step int64) (bool, error) {
+	result := database.Conn().
+		Model(&AccountTwoFactorAuth{}).
+		Where("account_id = ?", a.AccountID).
+		Where("last_used_step < ?", step).
+		Updates(map[string]any{
+			"last_used_step": step,
+			"updated_at":     time.Now(),
+		})
+
+	if result.Error != nil {
+		return false, result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return false, nil
+	}
+
+	a.LastUsedStep = step
+	return true, nil
+}
+
+//
+// UseRecoveryCode removes the recovery code hash in a single statement,
+// so concurrent logins can't use the same recovery code twice.
+//
+
+func (a *AccountTwoFactorAuth) UseRecoveryCode(hash string) (bool, error) {
+	result := database.Conn().
+		Model(&AccountTwoFactorAuth{}).
+		Where("account_id = ?", a.AccountID).
+		Where("jsonb_exists(recovery_codes, ?)", hash).
+		Updates(map[string]any{
+			"recovery_codes": gorm.Expr("recovery_codes - ?::text", hash),
+			"updated_at":     time.Now(),
+		})
+
+	if result.Error != nil {
+		return false, result.Error
+	}
+
+	return result.RowsAffected == 1, nil
+}
+
+func (a *AccountTwoFactorAuth) UpdateRecoveryCodes(hashes []string) error {
+	a.RecoveryCodes = hashes
+	a.UpdatedAt = time.Now()
+
+	return database.Conn().
+		Model(a).
+		Updates(map[string]any{
+			"recovery_codes": datatypes.JSONSlice[string](hashes),
+			"updated_at":     a.UpdatedAt,
+		}).
+		Error
+}
+
+func DeleteAccountTwoFactorAuth(accountID uuid.UUID) error {
+	return DeleteAccountTwoFactorAuthInTransaction(database.Conn(), accountID)
+}
+
+func DeleteAccountTwoFactorAuthInTransaction(tx *gorm.DB, accountID uuid.UUID) error {
+	return tx.
+		Where("account_id = ?", accountID).
+		Delete(&AccountTwoFactorAuth{}).
+		Error
+}
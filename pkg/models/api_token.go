@@ -0,0 +1,144 @@
+package models
+
+import (
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/superplanehq/superplane/pkg/database"
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+)
+
+//
+// How often the last usage of a token is persisted.
+// Writing it on every request would turn each API call into a write.
+//
+
+const APITokenLastUsedResolution = time.Minute
+
+//
+// APIToken is a named personal access token that belongs
+// to a user or a service account. Unlike the single token
+// kept in users.token_hash, a user can have many of them,
+// each one with its own expiry and set of scopes.
+//
+
+type APIToken struct {
+	ID             uuid.UUID `gorm:"type:uuid;primaryKey;default:uuid_generate_v4()"`
+	OrganizationID uuid.UUID `gorm:"type:uuid;not null"`
+	UserID         uuid.UUID `gorm:"type:uuid;not null"`
+	Name           string
+	TokenHash      string
+	Scopes         datatypes.JSONSlice[APITokenScope]
+	ExpiresAt      *time.Time
+	LastUsedAt     *time.Time
+	CreatedBy      *uuid.UUID
+	CreatedAt      *time.Time
+}
+
+//
+// APITokenScope grants a permission, like canvases:read or events:emit,
+// to the token. If canvases are listed, the permission only applies
+// to requests made against those canvases.
+//
+
+type APITokenScope struct {
+	Permission string   `json:"permission"`
+	Canvases   []string `json:"canvases,omitempty"`
+}
+
+func (t *APIToken) TableName() string {
+	return "api_tokens"
+}
+
+func (t *APIToken) IsExpired() bool {
+	return t.ExpiresAt != nil && !t.ExpiresAt.After(time.Now())
+}
+
+func (t *APIToken) MarkAsUsed() error {
+	now := time.Now()
+	if t.LastUsedAt != nil && now.Sub(*t.LastUsedAt) < APITokenLastUsedResolution {
+		return nil
+	}
+
+	t.LastUsedAt = &now
+	return database.Conn().
+		Model(t).
+		Update("last_used_at", now).
+		Error
+}
+
+func (t *APIToken) Delete() error {
+	return database.Conn().Delete(t).Error
+}
+
+func CreateAPIToken(token *APIToken) error {
+	return CreateAPITokenInTransaction(database.Conn(), token)
+}
+
+func CreateAPITokenInTransaction(tx *gorm.DB, token *APIToken) error {
+	if token.CreatedAt == nil {
+		now := time.Now()
+		token.CreatedAt = &now
+	}
+
+	err := tx.Create(token).Error
+	if err != nil {
+		if strings.Contains(err.Error(), "duplicate key value violates unique constraint") {
+			return ErrNameAlreadyUsed
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+func FindAPITokenByHash(tokenHash string) (*APIToken, error) {
+	var token APIToken
+
+	err := database.Conn().
+		Where("token_hash = ?", tokenHash).
+		First(&token).
+		Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &token, nil
+}
+
+func FindAPIToken(orgID, id string) (*APIToken, error) {
+	var token APIToken
+
+	err := database.Conn().
+		Where("id = ?", id).
+		Where("organization_id = ?", orgID).
+		First(&token).
+		Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &token, nil
+}
+
+func ListAPITokens(orgID, userID string) ([]APIToken, error) {
+	var tokens []APIToken
+
+	err := database.Conn().
+		Where("organization_id = ?", orgID).
+		Where("user_id = ?", userID).
+		Order("created_at DESC").
+		Find(&tokens).
+		Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tokens, nil
+}
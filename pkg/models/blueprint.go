@@ -119,17 +119,18 @@ func FindUnscopedBlueprintInTransaction(tx *gorm.DB, id string) (*Blueprint, err
 }
 
 type Node struct {
-	ID             string         `json:"id"`
-	Name           string         `json:"name"`
-	Type           string         `json:"type"`
-	Ref            NodeRef        `json:"ref"`
-	Configuration  map[string]any `json:"configuration"`
-	Metadata       map[string]any `json:"metadata"`
-	Position       Position       `json:"position"`
-	IsCollapsed    bool           `json:"isCollapsed"`
-	IntegrationID  *string        `json:"integrationId,omitempty"`
-	ErrorMessage   *string        `json:"errorMessage,omitempty"`
-	WarningMessage *string        `json:"warningMessage,omitempty"`
+	ID                  string         `json:"id"`
+	Name                string         `json:"name"`
+	Type                string         `json:"type"`
+	Ref                 NodeRef        `json:"ref"`
+	Configuration       map[string]any `json:"configuration"`
+	Metadata            map[string]any `json:"metadata"`
+	Position            Position       `json:"position"`
+	IsCollapsed         bool           `json:"isCollapsed"`
+	IntegrationID       *string        `json:"integrationId,omitempty"`
+	ErrorMessage        *string        `json:"errorMessage,omitempty"`
+	WarningMessage      *string        `json:"warningMessage,omitempty"`
+	ErrorChannelEnabled bool           `json:"errorChannelEnabled,omitempty"`
 }
 
 type Position struct {
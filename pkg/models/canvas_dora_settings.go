@@ -0,0 +1,131 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/superplanehq/superplane/pkg/database"
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+//
+// CanvasDoraSettings marks the canvas nodes whose executions
+// are deployments or incidents for the DORA metrics of the canvas.
+// Fields are paths into the data of the root event of the execution,
+// like data.head_commit.timestamp for a GitHub push.
+//
+
+type CanvasDoraSettings struct {
+	WorkflowID      uuid.UUID `gorm:"primaryKey"`
+	DeploymentNodes datatypes.JSONSlice[DoraDeploymentNode]
+	IncidentNodes   datatypes.JSONSlice[DoraIncidentNode]
+	UpdatedBy       *uuid.UUID
+	CreatedAt       *time.Time
+	UpdatedAt       *time.Time
+}
+
+type DoraDeploymentNode struct {
+	NodeID               string `json:"nodeId"`
+	CommitTimestampField string `json:"commitTimestampField,omitempty"`
+}
+
+type DoraIncidentNode struct {
+	NodeID          string `json:"nodeId"`
+	StartedAtField  string `json:"startedAtField,omitempty"`
+	ResolvedAtField string `json:"resolvedAtField,omitempty"`
+}
+
+func (s *CanvasDoraSettings) TableName() string {
+	return "workflow_dora_settings"
+}
+
+func (s *CanvasDoraSettings) NodeIDs() []string {
+	nodeIDs := []string{}
+	for _, node := range s.DeploymentNodes {
+		nodeIDs = append(nodeIDs, node.NodeID)
+	}
+
+	for _, node := range s.IncidentNodes {
+		nodeIDs = append(nodeIDs, node.NodeID)
+	}
+
+	return nodeIDs
+}
+
+func FindCanvasDoraSettings(canvasID uuid.UUID) (*CanvasDoraSettings, error) {
+	var settings CanvasDoraSettings
+	err := database.Conn().
+		Where("workflow_id = ?", canvasID).
+		First(&settings).
+		Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &settings, nil
+}
+
+func SaveCanvasDoraSettings(settings *CanvasDoraSettings) error {
+	return SaveCanvasDoraSettingsInTransaction(database.Conn(), settings)
+}
+
+func SaveCanvasDoraSettingsInTransaction(tx *gorm.DB, settings *CanvasDoraSettings) error {
+	now := time.Now()
+	if settings.CreatedAt == nil {
+		settings.CreatedAt = &now
+	}
+
+	settings.UpdatedAt = &now
+
+	return tx.
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "workflow_id"}},
+			DoUpdates: clause.AssignmentColumns([]string{"deployment_nodes", "incident_nodes", "updated_by", "updated_at"}),
+		}).
+		Create(settings).
+		Error
+}
+
+//
+// DoraExecution is a finished execution of a deployment or incident node,
+// with the root event that started its execution chain.
+//
+
+type DoraExecution struct {
+	NodeID             string
+	Result             string
+	FinishedAt         time.Time
+	RootEventData      datatypes.JSONType[any]
+	RootEventCreatedAt time.Time
+}
+
+func ListDoraExecutions(canvasID uuid.UUID, nodeIDs []string, from, to time.Time) ([]DoraExecution, error) {
+	var executions []DoraExecution
+	if len(nodeIDs) == 0 {
+		return executions, nil
+	}
+
+	err := database.Conn().
+		Table("workflow_node_executions AS x").
+		Select("x.node_id, x.result, x.finished_at, e.data AS root_event_data, e.created_at AS root_event_created_at").
+		Joins("JOIN workflow_events AS e ON e.id = x.root_event_id").
+		Where("x.workflow_id = ?", canvasID).
+		Where("x.node_id IN ?", nodeIDs).
+		Where("x.parent_execution_id IS NULL").
+		Where("x.state = ?", CanvasNodeExecutionStateFinished).
+		Where("x.result IN ?", []string{CanvasNodeExecutionResultPassed, CanvasNodeExecutionResultFailed}).
+		Where("x.finished_at >= ?", from).
+		Where("x.finished_at < ?", to).
+		Order("x.finished_at").
+		Scan(&executions).
+		Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return executions, nil
+}
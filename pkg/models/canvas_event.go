@@ -5,6 +5,8 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/superplanehq/superplane/pkg/database"
+	"github.com/superplanehq/superplane/pkg/telemetry"
+	"go.opentelemetry.io/otel/attribute"
 	"gorm.io/datatypes"
 	"gorm.io/gorm"
 	"gorm.io/gorm/clause"
@@ -24,6 +26,7 @@ type CanvasEvent struct {
 	Data        datatypes.JSONType[any]
 	ExecutionID *uuid.UUID
 	State       string
+	TraceParent *string
 	CreatedAt   *time.Time
 }
 
@@ -31,6 +34,45 @@ func (e *CanvasEvent) TableName() string {
 	return "workflow_events"
 }
 
+//
+// Root events start a new trace, and the events emitted by executions
+// join the trace of the root event of their execution chain,
+// so a whole run can be followed across the workers that process it.
+//
+
+func (e *CanvasEvent) BeforeCreate(tx *gorm.DB) error {
+	if e.TraceParent != nil || !telemetry.TracingEnabled() {
+		return nil
+	}
+
+	if e.ExecutionID == nil {
+		e.TraceParent = telemetry.StartTrace(
+			"canvas.event",
+			attribute.String("canvas.id", e.WorkflowID.String()),
+			attribute.String("node.id", e.NodeID),
+			attribute.String("event.channel", e.Channel),
+		)
+
+		return nil
+	}
+
+	var traceParent *string
+	err := tx.Session(&gorm.Session{NewDB: true}).
+		Table("workflow_node_executions AS x").
+		Select("e.trace_parent").
+		Joins("JOIN workflow_events AS e ON e.id = x.root_event_id").
+		Where("x.id = ?", e.ExecutionID).
+		Scan(&traceParent).
+		Error
+
+	if err != nil {
+		return err
+	}
+
+	e.TraceParent = traceParent
+	return nil
+}
+
 func FindCanvasEvents(ids []string) ([]CanvasEvent, error) {
 	var events []CanvasEvent
 	err := database.Conn().
@@ -153,6 +195,39 @@ func ListRootCanvasEvents(canvasID uuid.UUID, limit int, before *time.Time) ([]C
 	return events, nil
 }
 
+//
+// ListCanvasEventsCreatedAfter lists the events of a canvas in creation order.
+// If executionID is given, only the events emitted by that execution
+// and its child executions are returned.
+//
+
+func ListCanvasEventsCreatedAfter(canvasID uuid.UUID, executionID *uuid.UUID, after time.Time, limit int) ([]CanvasEvent, error) {
+	var events []CanvasEvent
+	query := database.Conn().
+		Where("workflow_id = ?", canvasID).
+		Where("created_at > ?", after).
+		Order("created_at ASC").
+		Order("id ASC").
+		Limit(limit)
+
+	if executionID != nil {
+		query = query.Where(
+			"execution_id IN (?)",
+			database.Conn().
+				Model(&CanvasNodeExecution{}).
+				Select("id").
+				Where("id = ? OR parent_execution_id = ?", *executionID, *executionID),
+		)
+	}
+
+	err := query.Find(&events).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return events, nil
+}
+
 func CountRootCanvasEvents(canvasID uuid.UUID) (int64, error) {
 	var count int64
 
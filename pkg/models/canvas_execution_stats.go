@@ -0,0 +1,84 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/superplanehq/superplane/pkg/database"
+	"gorm.io/gorm"
+)
+
+//
+// CanvasExecutionStats aggregates the executions
+// of a canvas, or of one of its nodes, that finished in a time range.
+// Durations go from the moment the execution started until it finished.
+//
+
+type CanvasExecutionStats struct {
+	NodeID             string
+	Total              int64
+	Passed             int64
+	Failed             int64
+	Cancelled          int64
+	P50DurationSeconds float64
+	P95DurationSeconds float64
+}
+
+func (s *CanvasExecutionStats) SuccessRate() float64 {
+	if s.Total == 0 {
+		return 0
+	}
+
+	return float64(s.Passed) / float64(s.Total)
+}
+
+const canvasExecutionStatsColumns = `
+	COUNT(*) AS total,
+	COUNT(*) FILTER (WHERE result = 'passed') AS passed,
+	COUNT(*) FILTER (WHERE result = 'failed') AS failed,
+	COUNT(*) FILTER (WHERE result = 'cancelled') AS cancelled,
+	COALESCE(percentile_cont(0.5) WITHIN GROUP (ORDER BY EXTRACT(EPOCH FROM finished_at - COALESCE(started_at, created_at))), 0) AS p50_duration_seconds,
+	COALESCE(percentile_cont(0.95) WITHIN GROUP (ORDER BY EXTRACT(EPOCH FROM finished_at - COALESCE(started_at, created_at))), 0) AS p95_duration_seconds
+`
+
+//
+// GetCanvasExecutionStats returns the stats for the whole canvas,
+// and the stats for each one of its nodes with executions in the time range.
+// Executions of nodes inside blueprints are counted as part of the blueprint node execution.
+//
+
+func GetCanvasExecutionStats(canvasID uuid.UUID, from, to time.Time) (*CanvasExecutionStats, []CanvasExecutionStats, error) {
+	var canvasStats CanvasExecutionStats
+	err := finishedExecutionsInRange(database.Conn(), canvasID, from, to).
+		Select(canvasExecutionStatsColumns).
+		Scan(&canvasStats).
+		Error
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var nodeStats []CanvasExecutionStats
+	err = finishedExecutionsInRange(database.Conn(), canvasID, from, to).
+		Select("node_id, " + canvasExecutionStatsColumns).
+		Group("node_id").
+		Order("node_id").
+		Scan(&nodeStats).
+		Error
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return &canvasStats, nodeStats, nil
+}
+
+func finishedExecutionsInRange(tx *gorm.DB, canvasID uuid.UUID, from, to time.Time) *gorm.DB {
+	return tx.
+		Table("workflow_node_executions").
+		Where("workflow_id = ?", canvasID).
+		Where("parent_execution_id IS NULL").
+		Where("state = ?", CanvasNodeExecutionStateFinished).
+		Where("finished_at >= ?", from).
+		Where("finished_at < ?", to)
+}
@@ -9,6 +9,7 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/superplanehq/superplane/pkg/database"
+	"github.com/superplanehq/superplane/pkg/metrics"
 	"gorm.io/datatypes"
 	"gorm.io/gorm"
 	"gorm.io/gorm/clause"
@@ -27,23 +28,24 @@ const (
 )
 
 type CanvasNode struct {
-	WorkflowID        uuid.UUID `gorm:"primaryKey"`
-	NodeID            string    `gorm:"primaryKey"`
-	ParentNodeID      *string
-	Name              string
-	State             string
-	StateReason       *string
-	Type              string
-	Position          datatypes.JSONType[Position]
-	Ref               datatypes.JSONType[NodeRef]
-	Configuration     datatypes.JSONType[map[string]any]
-	Metadata          datatypes.JSONType[map[string]any]
-	IsCollapsed       bool
-	WebhookID         *uuid.UUID
-	AppInstallationID *uuid.UUID
-	CreatedAt         *time.Time
-	UpdatedAt         *time.Time
-	DeletedAt         gorm.DeletedAt `gorm:"index"`
+	WorkflowID          uuid.UUID `gorm:"primaryKey"`
+	NodeID              string    `gorm:"primaryKey"`
+	ParentNodeID        *string
+	Name                string
+	State               string
+	StateReason         *string
+	Type                string
+	Position            datatypes.JSONType[Position]
+	Ref                 datatypes.JSONType[NodeRef]
+	Configuration       datatypes.JSONType[map[string]any]
+	Metadata            datatypes.JSONType[map[string]any]
+	IsCollapsed         bool
+	ErrorChannelEnabled bool
+	WebhookID           *uuid.UUID
+	AppInstallationID   *uuid.UUID
+	CreatedAt           *time.Time
+	UpdatedAt           *time.Time
+	DeletedAt           gorm.DeletedAt `gorm:"index"`
 }
 
 func (c *CanvasNode) TableName() string {
@@ -351,6 +353,23 @@ func ListNodeQueueItems(workflowID uuid.UUID, nodeID string, limit int, beforeTi
 	return queueItems, nil
 }
 
+func ListCanvasQueueItems(workflowID uuid.UUID, limit int) ([]CanvasNodeQueueItem, error) {
+	var queueItems []CanvasNodeQueueItem
+	err := database.Conn().
+		Preload("RootEvent").
+		Where("workflow_id = ?", workflowID).
+		Order("created_at ASC").
+		Limit(limit).
+		Find(&queueItems).
+		Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return queueItems, nil
+}
+
 func CountNodeQueueItems(workflowID uuid.UUID, nodeID string) (int64, error) {
 	var totalCount int64
 	countQuery := database.Conn().
@@ -405,3 +424,47 @@ func FindNodeQueueItem(workflowID uuid.UUID, queueItemID uuid.UUID) (*CanvasNode
 
 	return &queueItem, nil
 }
+
+//
+// NodeMetricLabelsInTransaction returns the labels used
+// for the business metrics recorded for a canvas node.
+//
+
+func NodeMetricLabelsInTransaction(tx *gorm.DB, canvasID uuid.UUID, node *CanvasNode) (metrics.Labels, error) {
+	canvas, err := FindCanvasWithoutOrgScopeInTransaction(tx, canvasID)
+	if err != nil {
+		return metrics.Labels{}, err
+	}
+
+	labels := metrics.Labels{
+		OrganizationID: canvas.OrganizationID.String(),
+		CanvasID:       canvasID.String(),
+	}
+
+	if node == nil {
+		return labels, nil
+	}
+
+	labels.NodeID = node.NodeID
+	labels.Component = node.ComponentName()
+	return labels, nil
+}
+
+//
+// ComponentName returns the name of the component or trigger used by the node.
+// For blueprint nodes, the blueprint ID is returned.
+//
+
+func (c *CanvasNode) ComponentName() string {
+	ref := c.Ref.Data()
+	switch {
+	case ref.Component != nil:
+		return ref.Component.Name
+	case ref.Trigger != nil:
+		return ref.Trigger.Name
+	case ref.Blueprint != nil:
+		return ref.Blueprint.ID
+	}
+
+	return ""
+}
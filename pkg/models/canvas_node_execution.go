@@ -1,12 +1,16 @@
 package models
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"time"
 
 	"github.com/google/uuid"
+	log "github.com/sirupsen/logrus"
+	"github.com/superplanehq/superplane/pkg/core"
 	"github.com/superplanehq/superplane/pkg/database"
+	"github.com/superplanehq/superplane/pkg/metrics"
 	"gorm.io/datatypes"
 	"gorm.io/gorm"
 	"gorm.io/gorm/clause"
@@ -24,6 +28,8 @@ const (
 	CanvasNodeExecutionResultReasonOk            = "ok"
 	CanvasNodeExecutionResultReasonError         = "error"
 	CanvasNodeExecutionResultReasonErrorResolved = "error_resolved"
+
+	CanvasNodeExecutionErrorPayloadType = "execution.failed"
 )
 
 type CanvasNodeExecution struct {
@@ -32,6 +38,8 @@ type CanvasNodeExecution struct {
 	NodeID     string
 	CreatedAt  *time.Time
 	UpdatedAt  *time.Time
+	StartedAt  *time.Time
+	FinishedAt *time.Time
 
 	//
 	// Reference to the root WorkflowEvent record that started
@@ -171,6 +179,32 @@ func ListNodeExecutions(workflowID uuid.UUID, nodeID string, states []string, re
 	return executions, nil
 }
 
+//
+// ListNodeExecutionsUpdatedAfter lists the executions of a canvas in update order.
+// If executionID is given, only that execution and its child executions are returned.
+//
+
+func ListNodeExecutionsUpdatedAfter(workflowID uuid.UUID, executionID *uuid.UUID, after time.Time, limit int) ([]CanvasNodeExecution, error) {
+	var executions []CanvasNodeExecution
+	query := database.Conn().
+		Where("workflow_id = ?", workflowID).
+		Where("updated_at > ?", after).
+		Order("updated_at ASC").
+		Order("id ASC").
+		Limit(limit)
+
+	if executionID != nil {
+		query = query.Where("id = ? OR parent_execution_id = ?", *executionID, *executionID)
+	}
+
+	err := query.Find(&executions).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return executions, nil
+}
+
 func ListNodeExecutionsForRootEvents(rootEventIDs []uuid.UUID) ([]CanvasNodeExecution, error) {
 	if len(rootEventIDs) == 0 {
 		return []CanvasNodeExecution{}, nil
@@ -189,6 +223,56 @@ func ListNodeExecutionsForRootEvents(rootEventIDs []uuid.UUID) ([]CanvasNodeExec
 	return executions, nil
 }
 
+//
+// An execution chain is finished once the root event and every event
+// emitted by its executions are routed, no queue item is left for it,
+// and all of its executions, child executions included, are finished.
+//
+
+func IsExecutionChainFinished(workflowID, rootEventID uuid.UUID) (bool, error) {
+	var active bool
+	err := database.Conn().
+		Raw(`
+			SELECT
+				EXISTS (
+					SELECT 1 FROM workflow_events e
+					WHERE e.workflow_id = ?
+					AND e.state = ?
+					AND (
+						e.id = ?
+						OR e.execution_id IN (
+							SELECT x.id FROM workflow_node_executions x
+							WHERE x.workflow_id = ?
+							AND x.root_event_id = ?
+						)
+					)
+				)
+				OR EXISTS (
+					SELECT 1 FROM workflow_node_queue_items q
+					WHERE q.workflow_id = ?
+					AND q.root_event_id = ?
+				)
+				OR EXISTS (
+					SELECT 1 FROM workflow_node_executions x
+					WHERE x.workflow_id = ?
+					AND x.root_event_id = ?
+					AND x.state <> ?
+				)
+		`,
+			workflowID, CanvasEventStatePending, rootEventID, workflowID, rootEventID,
+			workflowID, rootEventID,
+			workflowID, rootEventID, CanvasNodeExecutionStateFinished,
+		).
+		Scan(&active).
+		Error
+
+	if err != nil {
+		return false, err
+	}
+
+	return !active, nil
+}
+
 func CountNodeExecutions(workflowID uuid.UUID, nodeID string, states []string, results []string) (int64, error) {
 	var totalCount int64
 	countQuery := database.Conn().
@@ -366,9 +450,12 @@ func (e *CanvasNodeExecution) StartInTransaction(tx *gorm.DB) error {
 	//
 	// Update the execution state to started.
 	//
+	now := time.Now()
+	e.StartedAt = &now
 	return tx.Model(e).
 		Update("state", CanvasNodeExecutionStateStarted).
-		Update("updated_at", time.Now()).
+		Update("updated_at", now).
+		Update("started_at", now).
 		Error
 }
 
@@ -436,15 +523,23 @@ func (e *CanvasNodeExecution) PassInTransaction(tx *gorm.DB, channelOutputs map[
 	//
 	err = tx.Model(e).
 		Updates(map[string]interface{}{
-			"state":      CanvasNodeExecutionStateFinished,
-			"result":     CanvasNodeExecutionResultPassed,
-			"updated_at": &now,
+			"state":       CanvasNodeExecutionStateFinished,
+			"result":      CanvasNodeExecutionResultPassed,
+			"updated_at":  &now,
+			"finished_at": &now,
 		}).Error
 
 	if err != nil {
 		return nil, err
 	}
 
+	e.recordFinished(tx, node, CanvasNodeExecutionResultPassed, now)
+
+	err = e.enqueueFinishedEvents(tx, CanvasNodeExecutionResultPassed, "", "", now)
+	if err != nil {
+		return nil, err
+	}
+
 	return events, nil
 }
 
@@ -464,6 +559,7 @@ func (e *CanvasNodeExecution) FailInTransaction(tx *gorm.DB, reason, message str
 			"result_reason":  reason,
 			"result_message": message,
 			"updated_at":     &now,
+			"finished_at":    &now,
 		}).Error
 
 	if err != nil {
@@ -478,6 +574,13 @@ func (e *CanvasNodeExecution) FailInTransaction(tx *gorm.DB, reason, message str
 		return err
 	}
 
+	e.recordFinished(tx, node, CanvasNodeExecutionResultFailed, now)
+
+	err = e.enqueueFinishedEvents(tx, CanvasNodeExecutionResultFailed, reason, message, now)
+	if err != nil {
+		return err
+	}
+
 	if node != nil {
 		if node.State != CanvasNodeStatePaused {
 			err := node.UpdateState(tx, CanvasNodeStateReady)
@@ -485,12 +588,25 @@ func (e *CanvasNodeExecution) FailInTransaction(tx *gorm.DB, reason, message str
 				return err
 			}
 		}
+
+		//
+		// If the node has the error channel enabled,
+		// the failure is routed downstream through it.
+		// Child executions are excluded here, since their failure
+		// is propagated to the parent execution below.
+		//
+		if node.ErrorChannelEnabled && e.ParentExecutionID == nil {
+			_, err := e.EmitErrorEventInTransaction(tx, reason, message)
+			if err != nil {
+				return err
+			}
+		}
 	}
 
 	//
-	// Since an execution failure does not emit anything,
-	// we need to update the parent execution here too,
-	// if this execution is a child one.
+	// Child executions never emit on the error channel,
+	// so the parent execution is failed here too,
+	// and emits on it if its node has the error channel enabled.
 	//
 	if e.ParentExecutionID != nil {
 		parent, err := FindNodeExecution(e.WorkflowID, *e.ParentExecutionID)
@@ -504,6 +620,44 @@ func (e *CanvasNodeExecution) FailInTransaction(tx *gorm.DB, reason, message str
 	return nil
 }
 
+// EmitErrorEventInTransaction creates an event on the built-in error channel for a failed execution.
+// The event carries the failure reason, message and the execution input,
+// so nodes connected to the error channel can react to the failure.
+func (e *CanvasNodeExecution) EmitErrorEventInTransaction(tx *gorm.DB, reason, message string) (*CanvasEvent, error) {
+	input, err := e.GetInput(tx)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	event := CanvasEvent{
+		WorkflowID: e.WorkflowID,
+		NodeID:     e.NodeID,
+		Channel:    core.ErrorOutputChannel.Name,
+		Data: datatypes.NewJSONType[any](map[string]any{
+			"type":      CanvasNodeExecutionErrorPayloadType,
+			"timestamp": now,
+			"data": map[string]any{
+				"executionId": e.ID.String(),
+				"nodeId":      e.NodeID,
+				"reason":      reason,
+				"message":     message,
+				"input":       input,
+			},
+		}),
+		ExecutionID: &e.ID,
+		State:       CanvasEventStatePending,
+		CreatedAt:   &now,
+	}
+
+	err = tx.Create(&event).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to create error event: %w", err)
+	}
+
+	return &event, nil
+}
+
 func (e *CanvasNodeExecution) Cancel(cancelledBy *uuid.UUID) error {
 	return e.CancelInTransaction(database.Conn(), cancelledBy)
 }
@@ -517,6 +671,7 @@ func (e *CanvasNodeExecution) CancelInTransaction(tx *gorm.DB, cancelledBy *uuid
 			"result":       CanvasNodeExecutionResultCancelled,
 			"cancelled_by": cancelledBy,
 			"updated_at":   &now,
+			"finished_at":  &now,
 		}).Error
 
 	if err != nil {
@@ -528,6 +683,13 @@ func (e *CanvasNodeExecution) CancelInTransaction(tx *gorm.DB, cancelledBy *uuid
 		return err
 	}
 
+	e.recordFinished(tx, node, CanvasNodeExecutionResultCancelled, now)
+
+	err = e.enqueueFinishedEvents(tx, CanvasNodeExecutionResultCancelled, "", "", now)
+	if err != nil {
+		return err
+	}
+
 	if node != nil {
 		if node.State != CanvasNodeStatePaused {
 			err := node.UpdateState(tx, CanvasNodeStateReady)
@@ -540,6 +702,74 @@ func (e *CanvasNodeExecution) CancelInTransaction(tx *gorm.DB, cancelledBy *uuid
 	return nil
 }
 
+//
+// Records the outcome and the duration of the execution in the business metrics.
+// Executions that never started, like the ones cancelled while pending,
+// are measured from the moment they were created.
+//
+
+func (e *CanvasNodeExecution) recordFinished(tx *gorm.DB, node *CanvasNode, result string, finishedAt time.Time) {
+	if !metrics.Enabled() {
+		return
+	}
+
+	labels, err := NodeMetricLabelsInTransaction(tx, e.WorkflowID, node)
+	if err != nil {
+		log.Warnf("error finding metric labels for execution %s: %v", e.ID, err)
+		return
+	}
+
+	if node == nil {
+		labels.NodeID = e.NodeID
+	}
+
+	startedAt := e.CreatedAt
+	if e.StartedAt != nil {
+		startedAt = e.StartedAt
+	}
+
+	var duration time.Duration
+	if startedAt != nil {
+		duration = finishedAt.Sub(*startedAt)
+	}
+
+	metrics.RecordExecutionFinished(context.Background(), labels, result, duration)
+}
+
+//
+// Sends the outcome of the execution to the event subscriptions of the organization.
+// Executions of the nodes inside a blueprint are left out,
+// since the execution of the blueprint node already covers them.
+//
+
+func (e *CanvasNodeExecution) enqueueFinishedEvents(tx *gorm.DB, result, reason, message string, finishedAt time.Time) error {
+	if e.ParentExecutionID != nil {
+		return nil
+	}
+
+	data := map[string]any{
+		"executionId": e.ID.String(),
+		"rootEventId": e.RootEventID.String(),
+		"result":      result,
+		"finishedAt":  finishedAt,
+	}
+
+	if reason != "" {
+		data["resultReason"] = reason
+		data["resultMessage"] = message
+	}
+
+	events := []EventSubscriptionEvent{
+		NewEventSubscriptionEvent(EventSubscriptionEventExecutionFinished, e.WorkflowID, e.NodeID, data),
+	}
+
+	if result == CanvasNodeExecutionResultFailed && reason == CanvasNodeExecutionResultReasonError {
+		events = append(events, NewEventSubscriptionEvent(EventSubscriptionEventNodeError, e.WorkflowID, e.NodeID, data))
+	}
+
+	return EnqueueEventSubscriptionDeliveriesInTransaction(tx, e.WorkflowID, events...)
+}
+
 func (e *CanvasNodeExecution) GetInput(tx *gorm.DB) (any, error) {
 	event, err := FindCanvasEventInTransaction(tx, e.EventID)
 	if err != nil {
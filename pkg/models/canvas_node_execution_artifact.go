@@ -0,0 +1,110 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/superplanehq/superplane/pkg/database"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+//
+// CanvasNodeExecutionArtifact is a named file attached to an execution,
+// like a test report, a build log or a Terraform plan.
+// The contents are kept in the artifact storage, under StorageKey.
+//
+
+type CanvasNodeExecutionArtifact struct {
+	ID          uuid.UUID `gorm:"type:uuid;primaryKey;default:uuid_generate_v4()"`
+	WorkflowID  uuid.UUID `gorm:"type:uuid;not null"`
+	NodeID      string    `gorm:"type:varchar(128);not null"`
+	ExecutionID uuid.UUID `gorm:"type:uuid;not null"`
+	Name        string    `gorm:"type:varchar(255);not null"`
+	ContentType string    `gorm:"type:varchar(255);not null"`
+	Size        int64     `gorm:"not null"`
+	SHA256      string    `gorm:"column:sha256;type:varchar(64);not null"`
+	StorageKey  string    `gorm:"type:text;not null"`
+	CreatedAt   *time.Time
+}
+
+func (a *CanvasNodeExecutionArtifact) TableName() string {
+	return "workflow_node_execution_artifacts"
+}
+
+func UpsertNodeExecutionArtifact(artifact *CanvasNodeExecutionArtifact) error {
+	return UpsertNodeExecutionArtifactInTransaction(database.Conn(), artifact)
+}
+
+//
+// Writing an artifact with the same name again replaces it,
+// so components can be retried without failing on existing artifacts.
+//
+
+func UpsertNodeExecutionArtifactInTransaction(tx *gorm.DB, artifact *CanvasNodeExecutionArtifact) error {
+	if artifact.CreatedAt == nil {
+		now := time.Now()
+		artifact.CreatedAt = &now
+	}
+
+	return tx.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "execution_id"}, {Name: "name"}},
+		DoUpdates: clause.AssignmentColumns([]string{"content_type", "size", "sha256", "storage_key", "created_at"}),
+	}).Create(artifact).Error
+}
+
+func ListNodeExecutionArtifacts(workflowID, executionID uuid.UUID) ([]CanvasNodeExecutionArtifact, error) {
+	return ListNodeExecutionArtifactsInTransaction(database.Conn(), workflowID, executionID)
+}
+
+func ListNodeExecutionArtifactsInTransaction(tx *gorm.DB, workflowID, executionID uuid.UUID) ([]CanvasNodeExecutionArtifact, error) {
+	var artifacts []CanvasNodeExecutionArtifact
+	err := tx.
+		Where("workflow_id = ?", workflowID).
+		Where("execution_id = ?", executionID).
+		Order("name ASC").
+		Find(&artifacts).
+		Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return artifacts, nil
+}
+
+func FindNodeExecutionArtifact(workflowID, executionID uuid.UUID, name string) (*CanvasNodeExecutionArtifact, error) {
+	return FindNodeExecutionArtifactInTransaction(database.Conn(), workflowID, executionID, name)
+}
+
+func FindNodeExecutionArtifactInTransaction(tx *gorm.DB, workflowID, executionID uuid.UUID, name string) (*CanvasNodeExecutionArtifact, error) {
+	var artifact CanvasNodeExecutionArtifact
+	err := tx.
+		Where("workflow_id = ?", workflowID).
+		Where("execution_id = ?", executionID).
+		Where("name = ?", name).
+		First(&artifact).
+		Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &artifact, nil
+}
+
+func ListNodeArtifactsInTransaction(tx *gorm.DB, workflowID uuid.UUID, nodeID string, limit int) ([]CanvasNodeExecutionArtifact, error) {
+	var artifacts []CanvasNodeExecutionArtifact
+	err := tx.
+		Where("workflow_id = ?", workflowID).
+		Where("node_id = ?", nodeID).
+		Limit(limit).
+		Find(&artifacts).
+		Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return artifacts, nil
+}
@@ -0,0 +1,107 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/superplanehq/superplane/pkg/database"
+	"gorm.io/gorm"
+)
+
+const (
+	CanvasNodeExecutionLogStreamSystem = "system"
+	CanvasNodeExecutionLogStreamStdout = "stdout"
+	CanvasNodeExecutionLogStreamStderr = "stderr"
+
+	//
+	// Maximum number of bytes of log output stored for one execution.
+	// Anything written after the limit is reached is dropped.
+	//
+	CanvasNodeExecutionLogMaxSize = 1024 * 1024
+)
+
+//
+// CanvasNodeExecutionLog is an append-only record of output
+// produced by a canvas node execution. The logger given to components
+// and components themselves (e.g. stdout/stderr of a command) write here,
+// so users can see what happened without access to the server logs.
+//
+
+type CanvasNodeExecutionLog struct {
+	ID          uint64    `gorm:"primaryKey;autoIncrement"`
+	WorkflowID  uuid.UUID `gorm:"type:uuid;not null"`
+	NodeID      string    `gorm:"type:varchar(128);not null"`
+	ExecutionID uuid.UUID `gorm:"type:uuid;not null"`
+	Stream      string    `gorm:"type:varchar(32);not null"`
+	Message     string    `gorm:"type:text;not null"`
+	CreatedAt   *time.Time
+}
+
+func (l *CanvasNodeExecutionLog) TableName() string {
+	return "workflow_node_execution_logs"
+}
+
+func CreateNodeExecutionLog(workflowID uuid.UUID, nodeID string, executionID uuid.UUID, stream, message string) (*CanvasNodeExecutionLog, error) {
+	return CreateNodeExecutionLogInTransaction(database.Conn(), workflowID, nodeID, executionID, stream, message)
+}
+
+func CreateNodeExecutionLogInTransaction(tx *gorm.DB, workflowID uuid.UUID, nodeID string, executionID uuid.UUID, stream, message string) (*CanvasNodeExecutionLog, error) {
+	now := time.Now()
+	record := CanvasNodeExecutionLog{
+		WorkflowID:  workflowID,
+		NodeID:      nodeID,
+		ExecutionID: executionID,
+		Stream:      stream,
+		Message:     message,
+		CreatedAt:   &now,
+	}
+
+	err := tx.Create(&record).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return &record, nil
+}
+
+func ListNodeExecutionLogs(workflowID, executionID uuid.UUID, afterID uint64, limit int) ([]CanvasNodeExecutionLog, error) {
+	return ListNodeExecutionLogsInTransaction(database.Conn(), workflowID, executionID, afterID, limit)
+}
+
+func ListNodeExecutionLogsInTransaction(tx *gorm.DB, workflowID, executionID uuid.UUID, afterID uint64, limit int) ([]CanvasNodeExecutionLog, error) {
+	var logs []CanvasNodeExecutionLog
+	err := tx.
+		Where("workflow_id = ?", workflowID).
+		Where("execution_id = ?", executionID).
+		Where("id > ?", afterID).
+		Order("id ASC").
+		Limit(limit).
+		Find(&logs).
+		Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return logs, nil
+}
+
+func NodeExecutionLogSize(executionID uuid.UUID) (int, error) {
+	return NodeExecutionLogSizeInTransaction(database.Conn(), executionID)
+}
+
+func NodeExecutionLogSizeInTransaction(tx *gorm.DB, executionID uuid.UUID) (int, error) {
+	var size int
+	err := tx.
+		Model(&CanvasNodeExecutionLog{}).
+		Select("COALESCE(SUM(octet_length(message)), 0)").
+		Where("execution_id = ?", executionID).
+		Scan(&size).
+		Error
+
+	if err != nil {
+		return 0, err
+	}
+
+	return size, nil
+}
@@ -0,0 +1,353 @@
+package models
+
+import (
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/superplanehq/superplane/pkg/database"
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const (
+	EventSubscriptionEventExecutionFinished = "execution.finished"
+	EventSubscriptionEventApprovalRequested = "approval.requested"
+	EventSubscriptionEventNodeError         = "node.error"
+
+	EventSubscriptionDeliveryStatePending   = "pending"
+	EventSubscriptionDeliveryStateDelivered = "delivered"
+	EventSubscriptionDeliveryStateFailed    = "failed"
+)
+
+var EventSubscriptionEventTypes = []string{
+	EventSubscriptionEventExecutionFinished,
+	EventSubscriptionEventApprovalRequested,
+	EventSubscriptionEventNodeError,
+}
+
+//
+// EventSubscription sends the canvas activity of an organization
+// to an external URL. Empty canvas and node filters match everything.
+// The secret is encrypted, and used to sign the deliveries.
+//
+
+type EventSubscription struct {
+	ID             uuid.UUID `gorm:"type:uuid;primaryKey;default:uuid_generate_v4()"`
+	OrganizationID uuid.UUID `gorm:"type:uuid;not null"`
+	Name           string
+	URL            string
+	Secret         []byte
+	EventTypes     datatypes.JSONSlice[string]
+	CanvasIDs      datatypes.JSONSlice[string]
+	NodeIDs        datatypes.JSONSlice[string]
+	Enabled        bool
+	CreatedBy      *uuid.UUID
+	CreatedAt      *time.Time
+	UpdatedAt      *time.Time
+}
+
+func (s *EventSubscription) TableName() string {
+	return "event_subscriptions"
+}
+
+func (s *EventSubscription) Matches(event *EventSubscriptionEvent) bool {
+	if !s.Enabled || !slices.Contains(s.EventTypes, event.Type) {
+		return false
+	}
+
+	if len(s.CanvasIDs) > 0 && !slices.Contains(s.CanvasIDs, event.CanvasID) {
+		return false
+	}
+
+	if len(s.NodeIDs) > 0 && !slices.Contains(s.NodeIDs, event.NodeID) {
+		return false
+	}
+
+	return true
+}
+
+func (s *EventSubscription) Update() error {
+	now := time.Now()
+	s.UpdatedAt = &now
+
+	err := database.Conn().Save(s).Error
+	if err != nil {
+		if strings.Contains(err.Error(), "duplicate key value violates unique constraint") {
+			return ErrNameAlreadyUsed
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+func (s *EventSubscription) Delete() error {
+	return database.Conn().Delete(s).Error
+}
+
+func CreateEventSubscription(subscription *EventSubscription) error {
+	return CreateEventSubscriptionInTransaction(database.Conn(), subscription)
+}
+
+func CreateEventSubscriptionInTransaction(tx *gorm.DB, subscription *EventSubscription) error {
+	now := time.Now()
+	subscription.CreatedAt = &now
+	subscription.UpdatedAt = &now
+
+	err := tx.Create(subscription).Error
+	if err != nil {
+		if strings.Contains(err.Error(), "duplicate key value violates unique constraint") {
+			return ErrNameAlreadyUsed
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+func FindEventSubscription(orgID, id string) (*EventSubscription, error) {
+	var subscription EventSubscription
+
+	err := database.Conn().
+		Where("id = ?", id).
+		Where("organization_id = ?", orgID).
+		First(&subscription).
+		Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &subscription, nil
+}
+
+func FindEventSubscriptionByID(id uuid.UUID) (*EventSubscription, error) {
+	var subscription EventSubscription
+
+	err := database.Conn().
+		Where("id = ?", id).
+		First(&subscription).
+		Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &subscription, nil
+}
+
+func ListEventSubscriptions(orgID string) ([]EventSubscription, error) {
+	var subscriptions []EventSubscription
+
+	err := database.Conn().
+		Where("organization_id = ?", orgID).
+		Order("name ASC").
+		Find(&subscriptions).
+		Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return subscriptions, nil
+}
+
+//
+// EventSubscriptionEvent is the payload sent to the subscriptions.
+// The ID is the same for all the deliveries of an event,
+// so receivers can use it to discard duplicates.
+//
+
+type EventSubscriptionEvent struct {
+	ID             string         `json:"id"`
+	Type           string         `json:"type"`
+	Timestamp      time.Time      `json:"timestamp"`
+	OrganizationID string         `json:"organizationId"`
+	CanvasID       string         `json:"canvasId"`
+	NodeID         string         `json:"nodeId"`
+	Data           map[string]any `json:"data"`
+}
+
+func NewEventSubscriptionEvent(eventType string, canvasID uuid.UUID, nodeID string, data map[string]any) EventSubscriptionEvent {
+	return EventSubscriptionEvent{
+		ID:        uuid.NewString(),
+		Type:      eventType,
+		Timestamp: time.Now(),
+		CanvasID:  canvasID.String(),
+		NodeID:    nodeID,
+		Data:      data,
+	}
+}
+
+//
+// Deliveries are created in the same transaction as the change
+// that generated the events, and sent later by the EventSubscriptionDeliveryWorker.
+//
+
+func EnqueueEventSubscriptionDeliveriesInTransaction(tx *gorm.DB, canvasID uuid.UUID, events ...EventSubscriptionEvent) error {
+	if len(events) == 0 {
+		return nil
+	}
+
+	var subscriptions []EventSubscription
+	err := tx.
+		Joins("JOIN workflows ON workflows.organization_id = event_subscriptions.organization_id").
+		Where("workflows.id = ?", canvasID).
+		Where("event_subscriptions.enabled = ?", true).
+		Find(&subscriptions).
+		Error
+
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	deliveries := []EventSubscriptionDelivery{}
+	for _, subscription := range subscriptions {
+		for _, event := range events {
+			event.OrganizationID = subscription.OrganizationID.String()
+			if !subscription.Matches(&event) {
+				continue
+			}
+
+			deliveries = append(deliveries, EventSubscriptionDelivery{
+				SubscriptionID: subscription.ID,
+				EventID:        uuid.MustParse(event.ID),
+				EventType:      event.Type,
+				Payload:        datatypes.NewJSONType(event),
+				State:          EventSubscriptionDeliveryStatePending,
+				NextAttemptAt:  now,
+				CreatedAt:      &now,
+				UpdatedAt:      &now,
+			})
+		}
+	}
+
+	if len(deliveries) == 0 {
+		return nil
+	}
+
+	return tx.Create(&deliveries).Error
+}
+
+type EventSubscriptionDelivery struct {
+	ID             uuid.UUID `gorm:"type:uuid;primaryKey;default:uuid_generate_v4()"`
+	SubscriptionID uuid.UUID
+	EventID        uuid.UUID
+	EventType      string
+	Payload        datatypes.JSONType[EventSubscriptionEvent]
+	State          string
+	Attempts       int
+	NextAttemptAt  time.Time
+	ResponseStatus *int
+	LastError      *string
+	DeliveredAt    *time.Time
+	CreatedAt      *time.Time
+	UpdatedAt      *time.Time
+}
+
+func (d *EventSubscriptionDelivery) TableName() string {
+	return "event_subscription_deliveries"
+}
+
+func (d *EventSubscriptionDelivery) MarkDeliveredInTransaction(tx *gorm.DB, responseStatus int) error {
+	now := time.Now()
+	d.Attempts++
+	d.State = EventSubscriptionDeliveryStateDelivered
+	d.ResponseStatus = &responseStatus
+	d.LastError = nil
+	d.DeliveredAt = &now
+	d.UpdatedAt = &now
+	return tx.Save(d).Error
+}
+
+//
+// ClaimInTransaction moves the next attempt of a delivery to the given time,
+// so other workers don't lock it again while it is being sent.
+//
+
+func (d *EventSubscriptionDelivery) ClaimInTransaction(tx *gorm.DB, until time.Time) error {
+	now := time.Now()
+	d.NextAttemptAt = until
+	d.UpdatedAt = &now
+	return tx.Save(d).Error
+}
+
+//
+// MarkAttemptFailedInTransaction records a failed attempt.
+// Without a next attempt, the delivery is given up on.
+//
+
+func (d *EventSubscriptionDelivery) MarkAttemptFailedInTransaction(tx *gorm.DB, responseStatus *int, message string, nextAttemptAt *time.Time) error {
+	now := time.Now()
+	d.Attempts++
+	d.ResponseStatus = responseStatus
+	d.LastError = &message
+	d.UpdatedAt = &now
+
+	if nextAttemptAt == nil {
+		d.State = EventSubscriptionDeliveryStateFailed
+	} else {
+		d.NextAttemptAt = *nextAttemptAt
+	}
+
+	return tx.Save(d).Error
+}
+
+func ListPendingEventSubscriptionDeliveries(limit int) ([]EventSubscriptionDelivery, error) {
+	var deliveries []EventSubscriptionDelivery
+
+	err := database.Conn().
+		Where("state = ?", EventSubscriptionDeliveryStatePending).
+		Where("next_attempt_at <= ?", time.Now()).
+		Order("next_attempt_at ASC").
+		Limit(limit).
+		Find(&deliveries).
+		Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return deliveries, nil
+}
+
+func LockEventSubscriptionDelivery(tx *gorm.DB, id uuid.UUID) (*EventSubscriptionDelivery, error) {
+	var delivery EventSubscriptionDelivery
+
+	err := tx.
+		Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
+		Where("id = ?", id).
+		Where("state = ?", EventSubscriptionDeliveryStatePending).
+		Where("next_attempt_at <= ?", time.Now()).
+		First(&delivery).
+		Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &delivery, nil
+}
+
+func ListEventSubscriptionDeliveries(subscriptionID uuid.UUID, limit int) ([]EventSubscriptionDelivery, error) {
+	var deliveries []EventSubscriptionDelivery
+
+	err := database.Conn().
+		Where("subscription_id = ?", subscriptionID).
+		Order("created_at DESC").
+		Limit(limit).
+		Find(&deliveries).
+		Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return deliveries, nil
+}
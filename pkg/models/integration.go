@@ -170,8 +170,12 @@ func FindMaybeDeletedIntegrationInTransaction(tx *gorm.DB, integrationID uuid.UU
 }
 
 func FindIntegration(orgID, integrationID uuid.UUID) (*Integration, error) {
+	return FindIntegrationInTransaction(database.Conn(), orgID, integrationID)
+}
+
+func FindIntegrationInTransaction(tx *gorm.DB, orgID, integrationID uuid.UUID) (*Integration, error) {
 	var integration Integration
-	err := database.Conn().
+	err := tx.
 		Where("id = ?", integrationID).
 		Where("organization_id = ?", orgID).
 		First(&integration).
@@ -199,6 +203,28 @@ func FindIntegrationByName(orgID uuid.UUID, integrationName string) (*Integratio
 	return &integration, nil
 }
 
+//
+// FindOldestReadyIntegration is used when something needs an integration
+// of an app, but not a specific one, like Slack direct messages for notifications.
+//
+
+func FindOldestReadyIntegration(orgID uuid.UUID, appName string) (*Integration, error) {
+	var integration Integration
+	err := database.Conn().
+		Where("organization_id = ?", orgID).
+		Where("app_name = ?", appName).
+		Where("state = ?", IntegrationStateReady).
+		Order("created_at ASC").
+		First(&integration).
+		Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &integration, nil
+}
+
 func ListDeletedIntegrations() ([]Integration, error) {
 	var integrations []Integration
 	err := database.Conn().Unscoped().
@@ -78,6 +78,21 @@ func DeleteIntegrationSubscriptionsForNodeInTransaction(tx *gorm.DB, workflowID
 		Error
 }
 
+func ListIntegrationSubscriptionsForCanvas(workflowID uuid.UUID) ([]IntegrationSubscription, error) {
+	var subscriptions []IntegrationSubscription
+	err := database.Conn().
+		Where("workflow_id = ?", workflowID).
+		Order("node_id, created_at").
+		Find(&subscriptions).
+		Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return subscriptions, nil
+}
+
 type NodeSubscription struct {
 	WorkflowID    uuid.UUID
 	NodeID        string
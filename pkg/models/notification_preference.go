@@ -0,0 +1,142 @@
+package models
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/superplanehq/superplane/pkg/database"
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const (
+	NotificationChannelEmail    = "email"
+	NotificationChannelSlack    = "slack"
+	NotificationChannelTelegram = "telegram"
+	NotificationChannelWebhook  = "webhook"
+)
+
+//
+// NotificationChannel is where a user receives notifications.
+// The target depends on the type: the Slack member ID for Slack,
+// the chat ID for Telegram, and the URL for webhooks.
+// Emails always go to the email of the user, so there is no target.
+//
+
+type NotificationChannel struct {
+	Type   string `json:"type"`
+	Target string `json:"target,omitempty"`
+}
+
+//
+// NotificationPreference controls how a user receives the notifications
+// sent by components. Users without one receive them by email, right away.
+//
+// Notifications sent during quiet hours are held until they end,
+// and with a digest interval, notifications are batched into a single one
+// per channel, sent at most once per interval.
+//
+
+type NotificationPreference struct {
+	UserID                uuid.UUID `gorm:"type:uuid;primaryKey"`
+	OrganizationID        uuid.UUID
+	Channels              datatypes.JSONSlice[NotificationChannel]
+	QuietHoursStart       string
+	QuietHoursEnd         string
+	Timezone              string
+	DigestIntervalMinutes int
+	CreatedAt             time.Time
+	UpdatedAt             time.Time
+}
+
+func DefaultNotificationPreference(orgID, userID uuid.UUID) *NotificationPreference {
+	return &NotificationPreference{
+		UserID:         userID,
+		OrganizationID: orgID,
+		Channels:       datatypes.JSONSlice[NotificationChannel]{{Type: NotificationChannelEmail}},
+		Timezone:       "UTC",
+	}
+}
+
+func (p *NotificationPreference) HasQuietHours() bool {
+	return p.QuietHoursStart != "" && p.QuietHoursEnd != ""
+}
+
+func (p *NotificationPreference) DigestInterval() time.Duration {
+	return time.Duration(p.DigestIntervalMinutes) * time.Minute
+}
+
+//
+// FindNotificationPreference returns the default preference
+// for users that never changed theirs.
+//
+
+func FindNotificationPreference(orgID, userID uuid.UUID) (*NotificationPreference, error) {
+	var preference NotificationPreference
+
+	err := database.Conn().
+		Where("organization_id = ?", orgID).
+		Where("user_id = ?", userID).
+		First(&preference).
+		Error
+
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return DefaultNotificationPreference(orgID, userID), nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &preference, nil
+}
+
+func ListNotificationPreferences(orgID uuid.UUID, userIDs []uuid.UUID) (map[uuid.UUID]*NotificationPreference, error) {
+	var preferences []NotificationPreference
+
+	err := database.Conn().
+		Where("organization_id = ?", orgID).
+		Where("user_id IN ?", userIDs).
+		Find(&preferences).
+		Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	result := make(map[uuid.UUID]*NotificationPreference, len(userIDs))
+	for _, userID := range userIDs {
+		result[userID] = DefaultNotificationPreference(orgID, userID)
+	}
+
+	for i := range preferences {
+		result[preferences[i].UserID] = &preferences[i]
+	}
+
+	return result, nil
+}
+
+func SaveNotificationPreference(preference *NotificationPreference) error {
+	now := time.Now()
+	preference.UpdatedAt = now
+	if preference.CreatedAt.IsZero() {
+		preference.CreatedAt = now
+	}
+
+	return database.Conn().
+		Clauses(clause.OnConflict{
+			Columns: []clause.Column{{Name: "user_id"}},
+			DoUpdates: clause.AssignmentColumns([]string{
+				"channels",
+				"quiet_hours_start",
+				"quiet_hours_end",
+				"timezone",
+				"digest_interval_minutes",
+				"updated_at",
+			}),
+		}).
+		Create(preference).
+		Error
+}
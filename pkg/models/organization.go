@@ -17,6 +17,7 @@ type Organization struct {
 	Name             string    `gorm:"uniqueIndex"`
 	Description      string
 	AllowedProviders datatypes.JSONSlice[string]
+	RequireTwoFactor bool
 	CreatedAt        *time.Time
 	UpdatedAt        *time.Time
 	DeletedAt        gorm.DeletedAt `gorm:"index"`
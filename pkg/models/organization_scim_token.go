@@ -0,0 +1,100 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/superplanehq/superplane/pkg/database"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+//
+// OrganizationSCIMToken authenticates the identity provider
+// pushing users and groups to an organization through SCIM.
+// An organization has at most one, and resetting it replaces the old one.
+//
+
+type OrganizationSCIMToken struct {
+	OrganizationID uuid.UUID `gorm:"type:uuid;primaryKey"`
+	TokenHash      string
+	CreatedBy      *uuid.UUID `gorm:"type:uuid"`
+	CreatedAt      time.Time
+	LastUsedAt     *time.Time
+}
+
+func (t *OrganizationSCIMToken) TableName() string {
+	return "organization_scim_tokens"
+}
+
+func (t *OrganizationSCIMToken) MarkAsUsed() error {
+	now := time.Now()
+	if t.LastUsedAt != nil && now.Sub(*t.LastUsedAt) < APITokenLastUsedResolution {
+		return nil
+	}
+
+	t.LastUsedAt = &now
+	return database.Conn().
+		Model(t).
+		Update("last_used_at", now).
+		Error
+}
+
+func FindOrganizationSCIMToken(organizationID string) (*OrganizationSCIMToken, error) {
+	var token OrganizationSCIMToken
+
+	err := database.Conn().
+		Where("organization_id = ?", organizationID).
+		First(&token).
+		Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &token, nil
+}
+
+func FindOrganizationSCIMTokenByHash(tokenHash string) (*OrganizationSCIMToken, error) {
+	var token OrganizationSCIMToken
+
+	err := database.Conn().
+		Where("token_hash = ?", tokenHash).
+		First(&token).
+		Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &token, nil
+}
+
+func ResetOrganizationSCIMToken(token *OrganizationSCIMToken) error {
+	return ResetOrganizationSCIMTokenInTransaction(database.Conn(), token)
+}
+
+func ResetOrganizationSCIMTokenInTransaction(tx *gorm.DB, token *OrganizationSCIMToken) error {
+	token.CreatedAt = time.Now()
+	token.LastUsedAt = nil
+
+	return tx.
+		Clauses(clause.OnConflict{
+			Columns: []clause.Column{{Name: "organization_id"}},
+			DoUpdates: clause.AssignmentColumns([]string{
+				"token_hash",
+				"created_by",
+				"created_at",
+				"last_used_at",
+			}),
+		}).
+		Create(token).
+		Error
+}
+
+func DeleteOrganizationSCIMToken(organizationID string) error {
+	return database.Conn().
+		Where("organization_id = ?", organizationID).
+		Delete(&OrganizationSCIMToken{}).
+		Error
+}
@@ -0,0 +1,133 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/superplanehq/superplane/pkg/database"
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const (
+	SSOProtocolSAML = "saml"
+	SSOProtocolOIDC = "oidc"
+
+	SSODefaultEmailAttribute  = "email"
+	SSODefaultNameAttribute   = "name"
+	SSODefaultGroupsAttribute = "groups"
+)
+
+type OrganizationSSOConfig struct {
+	ID                 uuid.UUID `gorm:"type:uuid;primary_key;default:uuid_generate_v4()"`
+	OrganizationID     uuid.UUID `gorm:"type:uuid;uniqueIndex"`
+	Protocol           string
+	Enabled            bool
+	EnforceSSO         bool    `gorm:"column:enforce_sso"`
+	SAMLIdPMetadataURL *string `gorm:"column:saml_idp_metadata_url"`
+	SAMLIdPMetadata    *string `gorm:"column:saml_idp_metadata"`
+	OIDCIssuerURL      *string `gorm:"column:oidc_issuer_url"`
+	OIDCClientID       *string `gorm:"column:oidc_client_id"`
+	OIDCClientSecret   []byte  `gorm:"column:oidc_client_secret"`
+	EmailAttribute     string
+	NameAttribute      string
+	GroupsAttribute    string
+	DefaultRole        string
+	GroupMappings      datatypes.JSONSlice[SSOGroupMapping]
+	UpdatedBy          *uuid.UUID `gorm:"type:uuid"`
+	CreatedAt          time.Time
+	UpdatedAt          time.Time
+}
+
+//
+// SSOGroupMapping maps a group reported by the identity provider
+// to a SuperPlane group and/or organization role. Empty fields are ignored.
+//
+
+type SSOGroupMapping struct {
+	IdPGroup string `json:"idp_group"`
+	Group    string `json:"group,omitempty"`
+	Role     string `json:"role,omitempty"`
+}
+
+func (c *OrganizationSSOConfig) TableName() string {
+	return "organization_sso_configs"
+}
+
+//
+// SSO is enforced only while it is also enabled,
+// so disabling a broken configuration never locks members out.
+//
+
+func (c *OrganizationSSOConfig) IsEnforced() bool {
+	return c.Enabled && c.EnforceSSO
+}
+
+//
+// AccountProviderName is the provider name used for
+// account providers linked through this organization's identity provider.
+//
+
+func (c *OrganizationSSOConfig) AccountProviderName() string {
+	return "sso:" + c.OrganizationID.String()
+}
+
+func FindOrganizationSSOConfig(organizationID string) (*OrganizationSSOConfig, error) {
+	return FindOrganizationSSOConfigInTransaction(database.Conn(), organizationID)
+}
+
+func FindOrganizationSSOConfigInTransaction(tx *gorm.DB, organizationID string) (*OrganizationSSOConfig, error) {
+	var config OrganizationSSOConfig
+
+	err := tx.
+		Where("organization_id = ?", organizationID).
+		First(&config).
+		Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &config, nil
+}
+
+func UpsertOrganizationSSOConfig(config *OrganizationSSOConfig) error {
+	return UpsertOrganizationSSOConfigInTransaction(database.Conn(), config)
+}
+
+func UpsertOrganizationSSOConfigInTransaction(tx *gorm.DB, config *OrganizationSSOConfig) error {
+	return tx.
+		Clauses(
+			clause.OnConflict{
+				Columns: []clause.Column{{Name: "organization_id"}},
+				DoUpdates: clause.AssignmentColumns([]string{
+					"protocol",
+					"enabled",
+					"enforce_sso",
+					"saml_idp_metadata_url",
+					"saml_idp_metadata",
+					"oidc_issuer_url",
+					"oidc_client_id",
+					"oidc_client_secret",
+					"email_attribute",
+					"name_attribute",
+					"groups_attribute",
+					"default_role",
+					"group_mappings",
+					"updated_by",
+					"updated_at",
+				}),
+			},
+			clause.Returning{},
+		).
+		Create(config).
+		Error
+}
+
+func DeleteOrganizationSSOConfig(organizationID string) error {
+	return database.Conn().
+		Where("organization_id = ?", organizationID).
+		Delete(&OrganizationSSOConfig{}).
+		Error
+}
@@ -0,0 +1,158 @@
+package models
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/superplanehq/superplane/pkg/database"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+//
+// PendingNotification is a notification waiting to be delivered
+// to one of the channels of a user. All the due notifications
+// for the same user, channel and target are delivered together,
+// which is how digests and notifications held during quiet hours are batched.
+//
+
+type PendingNotification struct {
+	ID             uuid.UUID `gorm:"primary_key;default:uuid_generate_v4()"`
+	OrganizationID uuid.UUID
+	UserID         uuid.UUID
+	Channel        string
+	Target         string
+	Title          string
+	Body           string
+	URL            string
+	URLLabel       string
+	Attempts       int
+	LastError      *string
+	DeliverAfter   time.Time
+	CreatedAt      time.Time
+}
+
+type PendingNotificationBatch struct {
+	UserID  uuid.UUID
+	Channel string
+	Target  string
+}
+
+func (n *PendingNotification) Batch() PendingNotificationBatch {
+	return PendingNotificationBatch{UserID: n.UserID, Channel: n.Channel, Target: n.Target}
+}
+
+func CreatePendingNotification(notification *PendingNotification) error {
+	return CreatePendingNotificationInTransaction(database.Conn(), notification)
+}
+
+func CreatePendingNotificationInTransaction(tx *gorm.DB, notification *PendingNotification) error {
+	return tx.Create(notification).Error
+}
+
+//
+// FindPendingNotificationBatchTime returns when the batch that is still
+// collecting notifications for this user, channel and target is delivered.
+// Batches that already failed to be delivered are not extended.
+//
+
+func FindPendingNotificationBatchTime(batch PendingNotificationBatch) (*time.Time, error) {
+	return FindPendingNotificationBatchTimeInTransaction(database.Conn(), batch)
+}
+
+func FindPendingNotificationBatchTimeInTransaction(tx *gorm.DB, batch PendingNotificationBatch) (*time.Time, error) {
+	var notification PendingNotification
+
+	err := tx.
+		Where("user_id = ?", batch.UserID).
+		Where("channel = ?", batch.Channel).
+		Where("target = ?", batch.Target).
+		Where("attempts = 0").
+		Where("deliver_after > ?", time.Now()).
+		Order("deliver_after ASC").
+		First(&notification).
+		Error
+
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &notification.DeliverAfter, nil
+}
+
+func ListDuePendingNotificationBatches(limit int) ([]PendingNotificationBatch, error) {
+	var batches []PendingNotificationBatch
+
+	err := database.Conn().
+		Model(&PendingNotification{}).
+		Select("user_id, channel, target").
+		Where("deliver_after <= ?", time.Now()).
+		Group("user_id, channel, target").
+		Order("MIN(deliver_after) ASC").
+		Limit(limit).
+		Scan(&batches).
+		Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return batches, nil
+}
+
+func LockDuePendingNotifications(tx *gorm.DB, batch PendingNotificationBatch) ([]PendingNotification, error) {
+	var notifications []PendingNotification
+
+	err := tx.
+		Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
+		Where("user_id = ?", batch.UserID).
+		Where("channel = ?", batch.Channel).
+		Where("target = ?", batch.Target).
+		Where("deliver_after <= ?", time.Now()).
+		Order("created_at ASC").
+		Find(&notifications).
+		Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return notifications, nil
+}
+
+//
+// ClaimPendingNotificationsInTransaction moves the delivery of notifications
+// to the given time, so other workers don't lock them again while they are being sent.
+//
+
+func ClaimPendingNotificationsInTransaction(tx *gorm.DB, ids []uuid.UUID, until time.Time) error {
+	return tx.
+		Model(&PendingNotification{}).
+		Where("id IN ?", ids).
+		Update("deliver_after", until).
+		Error
+}
+
+func DeletePendingNotificationsInTransaction(tx *gorm.DB, ids []uuid.UUID) error {
+	return tx.
+		Where("id IN ?", ids).
+		Delete(&PendingNotification{}).
+		Error
+}
+
+func MarkPendingNotificationsFailedInTransaction(tx *gorm.DB, ids []uuid.UUID, message string, deliverAfter time.Time) error {
+	return tx.
+		Model(&PendingNotification{}).
+		Where("id IN ?", ids).
+		Updates(map[string]any{
+			"attempts":      gorm.Expr("attempts + 1"),
+			"last_error":    message,
+			"deliver_after": deliverAfter,
+		}).
+		Error
+}
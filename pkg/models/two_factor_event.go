@@ -0,0 +1,112 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/superplanehq/superplane/pkg/database"
+	"gorm.io/gorm"
+)
+
+const (
+	TwoFactorEventEnrolled                 = "enrolled"
+	TwoFactorEventDisabled                 = "disabled"
+	TwoFactorEventRecoveryCodesRegenerated = "recovery_codes_regenerated"
+	TwoFactorEventLoginSucceeded           = "login_succeeded"
+	TwoFactorEventVerificationFailed       = "verification_failed"
+	TwoFactorEventRecoveryCodeUsed         = "recovery_code_used"
+	TwoFactorEventReset                    = "reset"
+	TwoFactorEventPolicyEnabled            = "policy_enabled"
+	TwoFactorEventPolicyDisabled           = "policy_disabled"
+)
+
+//
+// TwoFactorEvent is an audit record of a change to, or a use of,
+// an account's two-factor authentication. Events caused by an organization,
+// like an admin reset or a policy change, carry the organization ID,
+// and the actor is the account that performed the action.
+// Policy changes are not about any account, so they have no account ID.
+//
+
+type TwoFactorEvent struct {
+	ID             uuid.UUID  `gorm:"type:uuid;primaryKey;default:uuid_generate_v4()"`
+	AccountID      *uuid.UUID `gorm:"type:uuid"`
+	OrganizationID *uuid.UUID `gorm:"type:uuid"`
+	ActorID        *uuid.UUID `gorm:"type:uuid"`
+	Type           string
+	RemoteAddr     string
+	CreatedAt      time.Time
+
+	AccountEmail string `gorm:"->"`
+	ActorEmail   string `gorm:"->"`
+}
+
+func (TwoFactorEvent) TableName() string {
+	return "two_factor_events"
+}
+
+func CreateTwoFactorEvent(event *TwoFactorEvent) error {
+	return CreateTwoFactorEventInTransaction(database.Conn(), event)
+}
+
+func CreateTwoFactorEventInTransaction(tx *gorm.DB, event *TwoFactorEvent) error {
+	if event.CreatedAt.IsZero() {
+		event.CreatedAt = time.Now()
+	}
+
+	return tx.Create(event).Error
+}
+
+func CountTwoFactorEventsSince(accountID uuid.UUID, eventType string, since time.Time) (int64, error) {
+	var count int64
+
+	err := database.Conn().
+		Model(&TwoFactorEvent{}).
+		Where("account_id = ?", accountID).
+		Where("type = ?", eventType).
+		Where("created_at > ?", since).
+		Count(&count).
+		Error
+
+	return count, err
+}
+
+//
+// ListTwoFactorEventsForOrganization returns the events of the organization's
+// members, newest first. Account-level events, like enrollments, are visible
+// to every organization the account is a member of, while events caused by
+// an organization are only visible to that organization.
+//
+
+func ListTwoFactorEventsForOrganization(organizationID string, limit int, before *time.Time) ([]TwoFactorEvent, error) {
+	var events []TwoFactorEvent
+
+	query := database.Conn().
+		Table("two_factor_events").
+		Select("two_factor_events.*, COALESCE(accounts.email, '') AS account_email, COALESCE(actors.email, '') AS actor_email").
+		Joins("LEFT JOIN accounts ON accounts.id = two_factor_events.account_id").
+		Joins("LEFT JOIN accounts AS actors ON actors.id = two_factor_events.actor_id").
+		Where(
+			database.Conn().
+				Where("two_factor_events.organization_id = ?", organizationID).
+				Or("two_factor_events.organization_id IS NULL AND two_factor_events.account_id IN (?)",
+					database.Conn().Table("users").Select("account_id").Where("organization_id = ?", organizationID),
+				),
+		)
+
+	if before != nil {
+		query = query.Where("two_factor_events.created_at < ?", *before)
+	}
+
+	err := query.
+		Order("two_factor_events.created_at DESC").
+		Limit(limit).
+		Find(&events).
+		Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return events, nil
+}
@@ -56,6 +56,12 @@ func (u *User) RestoreInTransaction(tx *gorm.DB) error {
 		Error
 }
 
+func (u *User) UpdateName(name string) error {
+	u.UpdatedAt = time.Now()
+	u.Name = name
+	return database.Conn().Save(u).Error
+}
+
 func (u *User) UpdateTokenHash(tokenHash string) error {
 	u.UpdatedAt = time.Now()
 	u.TokenHash = tokenHash
@@ -170,6 +176,21 @@ func FindMaybeDeletedUserByID(orgID, id string) (*User, error) {
 	return &user, err
 }
 
+// NOTE: this method returns soft deleted users too.
+// It is used by SCIM, where deactivated users still exist for the identity provider.
+func FindMaybeDeletedHumanUsersByOrganization(orgID string) ([]User, error) {
+	var users []User
+
+	err := database.Conn().Unscoped().
+		Where("organization_id = ?", orgID).
+		Where("type = ?", UserTypeHuman).
+		Order("created_at ASC").
+		Find(&users).
+		Error
+
+	return users, err
+}
+
 func ListActiveUsersByID(orgID string, ids []string) ([]User, error) {
 	return ListActiveUsersByIDInTransaction(database.Conn(), orgID, ids)
 }
@@ -0,0 +1,236 @@
+package notifications
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/superplanehq/superplane/pkg/models"
+)
+
+const MaxDigestInterval = 24 * time.Hour
+
+type Message struct {
+	Title    string `json:"title"`
+	Body     string `json:"body,omitempty"`
+	URL      string `json:"url,omitempty"`
+	URLLabel string `json:"url_label,omitempty"`
+}
+
+//
+// DeliveryTime returns when a notification sent now should be delivered.
+// With a digest interval, the notification joins the batch still collecting
+// notifications, if there is one, or starts a new one, delivered after the interval.
+// Anything that would be delivered during quiet hours is held until they end.
+//
+
+func DeliveryTime(preference *models.NotificationPreference, now time.Time, batchTime *time.Time) (time.Time, error) {
+	deliverAt := now
+	if preference.DigestIntervalMinutes > 0 {
+		if batchTime != nil {
+			deliverAt = *batchTime
+		} else {
+			deliverAt = now.Add(preference.DigestInterval())
+		}
+	}
+
+	if !preference.HasQuietHours() {
+		return deliverAt, nil
+	}
+
+	end, err := QuietHoursEnd(preference, deliverAt)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	if end != nil {
+		return *end, nil
+	}
+
+	return deliverAt, nil
+}
+
+//
+// QuietHoursEnd returns when the quiet hours including t end,
+// or nil if t is not within quiet hours. Quiet hours ending
+// before they start, like 22:00-07:00, go through midnight.
+//
+
+func QuietHoursEnd(preference *models.NotificationPreference, t time.Time) (*time.Time, error) {
+	location, err := time.LoadLocation(preference.Timezone)
+	if err != nil {
+		return nil, fmt.Errorf("invalid timezone %q: %w", preference.Timezone, err)
+	}
+
+	start, err := ParseTimeOfDay(preference.QuietHoursStart)
+	if err != nil {
+		return nil, err
+	}
+
+	end, err := ParseTimeOfDay(preference.QuietHoursEnd)
+	if err != nil {
+		return nil, err
+	}
+
+	local := t.In(location)
+	minutes := local.Hour()*60 + local.Minute()
+	endOfToday := time.Date(local.Year(), local.Month(), local.Day(), end/60, end%60, 0, 0, location)
+
+	switch {
+	case start < end && minutes >= start && minutes < end:
+		return &endOfToday, nil
+	case start > end && minutes >= start:
+		endOfTomorrow := endOfToday.AddDate(0, 0, 1)
+		return &endOfTomorrow, nil
+	case start > end && minutes < end:
+		return &endOfToday, nil
+	default:
+		return nil, nil
+	}
+}
+
+//
+// ParseTimeOfDay parses HH:MM into minutes since midnight.
+//
+
+func ParseTimeOfDay(value string) (int, error) {
+	t, err := time.Parse("15:04", value)
+	if err != nil {
+		return 0, fmt.Errorf("invalid time of day %q, expected HH:MM", value)
+	}
+
+	return t.Hour()*60 + t.Minute(), nil
+}
+
+func ValidatePreference(preference *models.NotificationPreference) error {
+	seen := map[models.NotificationChannel]bool{}
+	for _, channel := range preference.Channels {
+		if err := ValidateChannel(channel); err != nil {
+			return err
+		}
+
+		if seen[channel] {
+			return fmt.Errorf("duplicate %s channel", channel.Type)
+		}
+
+		seen[channel] = true
+	}
+
+	if _, err := time.LoadLocation(preference.Timezone); err != nil {
+		return fmt.Errorf("invalid timezone %q", preference.Timezone)
+	}
+
+	if (preference.QuietHoursStart == "") != (preference.QuietHoursEnd == "") {
+		return fmt.Errorf("quiet hours need both a start and an end")
+	}
+
+	if preference.HasQuietHours() {
+		start, err := ParseTimeOfDay(preference.QuietHoursStart)
+		if err != nil {
+			return err
+		}
+
+		end, err := ParseTimeOfDay(preference.QuietHoursEnd)
+		if err != nil {
+			return err
+		}
+
+		if start == end {
+			return fmt.Errorf("quiet hours must not start and end at the same time")
+		}
+	}
+
+	if preference.DigestIntervalMinutes < 0 || preference.DigestInterval() > MaxDigestInterval {
+		return fmt.Errorf("digest interval must be between 0 and %d minutes", int(MaxDigestInterval.Minutes()))
+	}
+
+	return nil
+}
+
+func ValidateChannel(channel models.NotificationChannel) error {
+	switch channel.Type {
+	case models.NotificationChannelEmail:
+		if channel.Target != "" {
+			return fmt.Errorf("email channel does not take a target, notifications go to the email of the user")
+		}
+
+	case models.NotificationChannelSlack:
+		if channel.Target == "" {
+			return fmt.Errorf("slack channel requires the Slack member ID as target")
+		}
+
+	case models.NotificationChannelTelegram:
+		if channel.Target == "" {
+			return fmt.Errorf("telegram channel requires the chat ID as target")
+		}
+
+	case models.NotificationChannelWebhook:
+		u, err := url.Parse(channel.Target)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return fmt.Errorf("webhook channel requires an http or https URL as target")
+		}
+
+	default:
+		return fmt.Errorf("unknown channel type %q", channel.Type)
+	}
+
+	return nil
+}
+
+//
+// Compose turns the notifications of a batch into a single message.
+// A batch with a single notification is delivered as it is.
+//
+
+func Compose(notifications []models.PendingNotification) Message {
+	if len(notifications) == 1 {
+		return Message{
+			Title:    notifications[0].Title,
+			Body:     notifications[0].Body,
+			URL:      notifications[0].URL,
+			URLLabel: notifications[0].URLLabel,
+		}
+	}
+
+	lines := make([]string, 0, len(notifications))
+	for _, notification := range notifications {
+		line := "- " + notification.Title
+		if notification.Body != "" {
+			line += ": " + notification.Body
+		}
+
+		if notification.URL != "" {
+			line += " (" + notification.URL + ")"
+		}
+
+		lines = append(lines, line)
+	}
+
+	return Message{
+		Title: fmt.Sprintf("%d notifications", len(notifications)),
+		Body:  strings.Join(lines, "\n"),
+	}
+}
+
+//
+// Text renders a message for chat channels.
+//
+
+func Text(message Message) string {
+	parts := []string{message.Title}
+	if message.Body != "" {
+		parts = append(parts, message.Body)
+	}
+
+	if message.URL != "" {
+		label := message.URLLabel
+		if label == "" {
+			label = "Open"
+		}
+
+		parts = append(parts, label+": "+message.URL)
+	}
+
+	return strings.Join(parts, "\n\n")
+}
@@ -0,0 +1,154 @@
+package notifications
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/models"
+)
+
+func preference(start, end string, digestMinutes int) *models.NotificationPreference {
+	p := models.DefaultNotificationPreference(uuid.New(), uuid.New())
+	p.QuietHoursStart = start
+	p.QuietHoursEnd = end
+	p.Timezone = "Europe/Berlin"
+	p.DigestIntervalMinutes = digestMinutes
+	return p
+}
+
+func at(t *testing.T, value string) time.Time {
+	location, err := time.LoadLocation("Europe/Berlin")
+	require.NoError(t, err)
+
+	parsed, err := time.ParseInLocation("2006-01-02 15:04", value, location)
+	require.NoError(t, err)
+	return parsed
+}
+
+func Test__DeliveryTime(t *testing.T) {
+	t.Run("no quiet hours and no digest -> now", func(t *testing.T) {
+		now := at(t, "2026-10-18 12:00")
+		deliverAt, err := DeliveryTime(preference("", "", 0), now, nil)
+		require.NoError(t, err)
+		assert.Equal(t, now, deliverAt)
+	})
+
+	t.Run("outside quiet hours -> now", func(t *testing.T) {
+		now := at(t, "2026-10-18 12:00")
+		deliverAt, err := DeliveryTime(preference("22:00", "07:00", 0), now, nil)
+		require.NoError(t, err)
+		assert.Equal(t, now, deliverAt)
+	})
+
+	t.Run("quiet hours through midnight, before midnight -> next morning", func(t *testing.T) {
+		deliverAt, err := DeliveryTime(preference("22:00", "07:00", 0), at(t, "2026-10-18 23:30"), nil)
+		require.NoError(t, err)
+		assert.True(t, at(t, "2026-10-19 07:00").Equal(deliverAt))
+	})
+
+	t.Run("quiet hours through midnight, after midnight -> same morning", func(t *testing.T) {
+		deliverAt, err := DeliveryTime(preference("22:00", "07:00", 0), at(t, "2026-10-19 03:00"), nil)
+		require.NoError(t, err)
+		assert.True(t, at(t, "2026-10-19 07:00").Equal(deliverAt))
+	})
+
+	t.Run("quiet hours within a day -> end of quiet hours", func(t *testing.T) {
+		deliverAt, err := DeliveryTime(preference("12:00", "13:00", 0), at(t, "2026-10-18 12:15"), nil)
+		require.NoError(t, err)
+		assert.True(t, at(t, "2026-10-18 13:00").Equal(deliverAt))
+	})
+
+	t.Run("quiet hours end is exclusive", func(t *testing.T) {
+		now := at(t, "2026-10-18 13:00")
+		deliverAt, err := DeliveryTime(preference("12:00", "13:00", 0), now, nil)
+		require.NoError(t, err)
+		assert.Equal(t, now, deliverAt)
+	})
+
+	t.Run("digest without batch -> after the interval", func(t *testing.T) {
+		now := at(t, "2026-10-18 12:00")
+		deliverAt, err := DeliveryTime(preference("", "", 60), now, nil)
+		require.NoError(t, err)
+		assert.Equal(t, now.Add(time.Hour), deliverAt)
+	})
+
+	t.Run("digest with batch -> joins the batch", func(t *testing.T) {
+		batchTime := at(t, "2026-10-18 12:20")
+		deliverAt, err := DeliveryTime(preference("", "", 60), at(t, "2026-10-18 12:10"), &batchTime)
+		require.NoError(t, err)
+		assert.Equal(t, batchTime, deliverAt)
+	})
+
+	t.Run("digest ending in quiet hours -> end of quiet hours", func(t *testing.T) {
+		deliverAt, err := DeliveryTime(preference("22:00", "07:00", 60), at(t, "2026-10-18 21:30"), nil)
+		require.NoError(t, err)
+		assert.True(t, at(t, "2026-10-19 07:00").Equal(deliverAt))
+	})
+}
+
+func Test__ValidatePreference(t *testing.T) {
+	valid := func() *models.NotificationPreference {
+		p := preference("22:00", "07:00", 30)
+		p.Channels = []models.NotificationChannel{
+			{Type: models.NotificationChannelEmail},
+			{Type: models.NotificationChannelSlack, Target: "U0123"},
+			{Type: models.NotificationChannelTelegram, Target: "-100123"},
+			{Type: models.NotificationChannelWebhook, Target: "https://example.com/hook"},
+		}
+		return p
+	}
+
+	t.Run("valid preference -> no error", func(t *testing.T) {
+		assert.NoError(t, ValidatePreference(valid()))
+	})
+
+	t.Run("no channels -> no error", func(t *testing.T) {
+		p := valid()
+		p.Channels = nil
+		assert.NoError(t, ValidatePreference(p))
+	})
+
+	tests := map[string]func(p *models.NotificationPreference){
+		"unknown channel":           func(p *models.NotificationPreference) { p.Channels[0].Type = "sms" },
+		"email with target":         func(p *models.NotificationPreference) { p.Channels[0].Target = "a@b.com" },
+		"slack without member ID":   func(p *models.NotificationPreference) { p.Channels[1].Target = "" },
+		"telegram without chat ID":  func(p *models.NotificationPreference) { p.Channels[2].Target = "" },
+		"webhook without http URL":  func(p *models.NotificationPreference) { p.Channels[3].Target = "ftp://example.com" },
+		"duplicate channel":         func(p *models.NotificationPreference) { p.Channels[1] = p.Channels[0] },
+		"invalid timezone":          func(p *models.NotificationPreference) { p.Timezone = "Mars/Olympus" },
+		"quiet hours without end":   func(p *models.NotificationPreference) { p.QuietHoursEnd = "" },
+		"invalid quiet hours":       func(p *models.NotificationPreference) { p.QuietHoursStart = "25:00" },
+		"empty quiet hours":         func(p *models.NotificationPreference) { p.QuietHoursEnd = p.QuietHoursStart },
+		"negative digest interval":  func(p *models.NotificationPreference) { p.DigestIntervalMinutes = -1 },
+		"digest interval too large": func(p *models.NotificationPreference) { p.DigestIntervalMinutes = 24*60 + 1 },
+	}
+
+	for name, change := range tests {
+		t.Run(name+" -> error", func(t *testing.T) {
+			p := valid()
+			change(p)
+			assert.Error(t, ValidatePreference(p))
+		})
+	}
+}
+
+func Test__Compose(t *testing.T) {
+	first := models.PendingNotification{Title: "Approval needed", Body: "Deploy to prod", URL: "https://example.com/1", URLLabel: "Review"}
+	second := models.PendingNotification{Title: "Run failed"}
+
+	t.Run("single notification -> delivered as it is", func(t *testing.T) {
+		message := Compose([]models.PendingNotification{first})
+		assert.Equal(t, Message{Title: first.Title, Body: first.Body, URL: first.URL, URLLabel: first.URLLabel}, message)
+		assert.Equal(t, "Approval needed\n\nDeploy to prod\n\nReview: https://example.com/1", Text(message))
+	})
+
+	t.Run("multiple notifications -> digest", func(t *testing.T) {
+		message := Compose([]models.PendingNotification{first, second})
+		assert.Equal(t, "2 notifications", message.Title)
+		assert.Equal(t, "- Approval needed: Deploy to prod (https://example.com/1)\n- Run failed", message.Body)
+		assert.Empty(t, message.URL)
+	})
+}
@@ -18,6 +18,7 @@ import (
 	"net/http"
 	"net/url"
 	"strings"
+	"time"
 )
 
 // CanvasAPIService CanvasAPI service
@@ -482,6 +483,532 @@ func (a *CanvasAPIService) CanvasesDescribeCanvasExecute(r ApiCanvasesDescribeCa
 	return localVarReturnValue, localVarHTTPResponse, nil
 }
 
+type ApiCanvasesExportCanvasDoraMetricsRequest struct {
+	ctx        context.Context
+	ApiService *CanvasAPIService
+	canvasId   string
+	from       *time.Time
+	to         *time.Time
+	interval   *string
+}
+
+func (r ApiCanvasesExportCanvasDoraMetricsRequest) From(from time.Time) ApiCanvasesExportCanvasDoraMetricsRequest {
+	r.from = &from
+	return r
+}
+
+func (r ApiCanvasesExportCanvasDoraMetricsRequest) To(to time.Time) ApiCanvasesExportCanvasDoraMetricsRequest {
+	r.to = &to
+	return r
+}
+
+func (r ApiCanvasesExportCanvasDoraMetricsRequest) Interval(interval string) ApiCanvasesExportCanvasDoraMetricsRequest {
+	r.interval = &interval
+	return r
+}
+
+func (r ApiCanvasesExportCanvasDoraMetricsRequest) Execute() (*CanvasesExportCanvasDoraMetricsResponse, *http.Response, error) {
+	return r.ApiService.CanvasesExportCanvasDoraMetricsExecute(r)
+}
+
+/*
+CanvasesExportCanvasDoraMetrics Export canvas DORA metrics
+
+Returns the DORA metrics time series of a canvas as CSV
+
+	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+	@param canvasId
+	@return ApiCanvasesExportCanvasDoraMetricsRequest
+*/
+func (a *CanvasAPIService) CanvasesExportCanvasDoraMetrics(ctx context.Context, canvasId string) ApiCanvasesExportCanvasDoraMetricsRequest {
+	return ApiCanvasesExportCanvasDoraMetricsRequest{
+		ApiService: a,
+		ctx:        ctx,
+		canvasId:   canvasId,
+	}
+}
+
+// Execute executes the request
+//
+//	@return CanvasesExportCanvasDoraMetricsResponse
+func (a *CanvasAPIService) CanvasesExportCanvasDoraMetricsExecute(r ApiCanvasesExportCanvasDoraMetricsRequest) (*CanvasesExportCanvasDoraMetricsResponse, *http.Response, error) {
+	var (
+		localVarHTTPMethod  = http.MethodGet
+		localVarPostBody    interface{}
+		formFiles           []formFile
+		localVarReturnValue *CanvasesExportCanvasDoraMetricsResponse
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "CanvasAPIService.CanvasesExportCanvasDoraMetrics")
+	if err != nil {
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/api/v1/canvases/{canvasId}/dora/metrics/export"
+	localVarPath = strings.Replace(localVarPath, "{"+"canvasId"+"}", url.PathEscape(parameterValueToString(r.canvasId, "canvasId")), -1)
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+
+	if r.from != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "from", r.from, "", "")
+	}
+	if r.to != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "to", r.to, "", "")
+	}
+	if r.interval != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "interval", r.interval, "", "")
+	} else {
+		var defaultValue string = "DORA_INTERVAL_UNSPECIFIED"
+		r.interval = &defaultValue
+	}
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+		var v GooglerpcStatus
+		err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+		if err != nil {
+			newErr.error = err.Error()
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+		newErr.model = v
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
+
+type ApiCanvasesGetCanvasDoraMetricsRequest struct {
+	ctx        context.Context
+	ApiService *CanvasAPIService
+	canvasId   string
+	from       *time.Time
+	to         *time.Time
+	interval   *string
+}
+
+func (r ApiCanvasesGetCanvasDoraMetricsRequest) From(from time.Time) ApiCanvasesGetCanvasDoraMetricsRequest {
+	r.from = &from
+	return r
+}
+
+func (r ApiCanvasesGetCanvasDoraMetricsRequest) To(to time.Time) ApiCanvasesGetCanvasDoraMetricsRequest {
+	r.to = &to
+	return r
+}
+
+func (r ApiCanvasesGetCanvasDoraMetricsRequest) Interval(interval string) ApiCanvasesGetCanvasDoraMetricsRequest {
+	r.interval = &interval
+	return r
+}
+
+func (r ApiCanvasesGetCanvasDoraMetricsRequest) Execute() (*CanvasesGetCanvasDoraMetricsResponse, *http.Response, error) {
+	return r.ApiService.CanvasesGetCanvasDoraMetricsExecute(r)
+}
+
+/*
+CanvasesGetCanvasDoraMetrics Get canvas DORA metrics
+
+Returns deployment frequency, lead time for changes, change failure rate and MTTR of a canvas over a time range
+
+	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+	@param canvasId
+	@return ApiCanvasesGetCanvasDoraMetricsRequest
+*/
+func (a *CanvasAPIService) CanvasesGetCanvasDoraMetrics(ctx context.Context, canvasId string) ApiCanvasesGetCanvasDoraMetricsRequest {
+	return ApiCanvasesGetCanvasDoraMetricsRequest{
+		ApiService: a,
+		ctx:        ctx,
+		canvasId:   canvasId,
+	}
+}
+
+// Execute executes the request
+//
+//	@return CanvasesGetCanvasDoraMetricsResponse
+func (a *CanvasAPIService) CanvasesGetCanvasDoraMetricsExecute(r ApiCanvasesGetCanvasDoraMetricsRequest) (*CanvasesGetCanvasDoraMetricsResponse, *http.Response, error) {
+	var (
+		localVarHTTPMethod  = http.MethodGet
+		localVarPostBody    interface{}
+		formFiles           []formFile
+		localVarReturnValue *CanvasesGetCanvasDoraMetricsResponse
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "CanvasAPIService.CanvasesGetCanvasDoraMetrics")
+	if err != nil {
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/api/v1/canvases/{canvasId}/dora/metrics"
+	localVarPath = strings.Replace(localVarPath, "{"+"canvasId"+"}", url.PathEscape(parameterValueToString(r.canvasId, "canvasId")), -1)
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+
+	if r.from != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "from", r.from, "", "")
+	}
+	if r.to != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "to", r.to, "", "")
+	}
+	if r.interval != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "interval", r.interval, "", "")
+	} else {
+		var defaultValue string = "DORA_INTERVAL_UNSPECIFIED"
+		r.interval = &defaultValue
+	}
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+		var v GooglerpcStatus
+		err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+		if err != nil {
+			newErr.error = err.Error()
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+		newErr.model = v
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
+
+type ApiCanvasesGetCanvasDoraSettingsRequest struct {
+	ctx        context.Context
+	ApiService *CanvasAPIService
+	canvasId   string
+}
+
+func (r ApiCanvasesGetCanvasDoraSettingsRequest) Execute() (*CanvasesGetCanvasDoraSettingsResponse, *http.Response, error) {
+	return r.ApiService.CanvasesGetCanvasDoraSettingsExecute(r)
+}
+
+/*
+CanvasesGetCanvasDoraSettings Get canvas DORA settings
+
+Returns the deployment and incident nodes used to compute the DORA metrics of a canvas
+
+	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+	@param canvasId
+	@return ApiCanvasesGetCanvasDoraSettingsRequest
+*/
+func (a *CanvasAPIService) CanvasesGetCanvasDoraSettings(ctx context.Context, canvasId string) ApiCanvasesGetCanvasDoraSettingsRequest {
+	return ApiCanvasesGetCanvasDoraSettingsRequest{
+		ApiService: a,
+		ctx:        ctx,
+		canvasId:   canvasId,
+	}
+}
+
+// Execute executes the request
+//
+//	@return CanvasesGetCanvasDoraSettingsResponse
+func (a *CanvasAPIService) CanvasesGetCanvasDoraSettingsExecute(r ApiCanvasesGetCanvasDoraSettingsRequest) (*CanvasesGetCanvasDoraSettingsResponse, *http.Response, error) {
+	var (
+		localVarHTTPMethod  = http.MethodGet
+		localVarPostBody    interface{}
+		formFiles           []formFile
+		localVarReturnValue *CanvasesGetCanvasDoraSettingsResponse
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "CanvasAPIService.CanvasesGetCanvasDoraSettings")
+	if err != nil {
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/api/v1/canvases/{canvasId}/dora/settings"
+	localVarPath = strings.Replace(localVarPath, "{"+"canvasId"+"}", url.PathEscape(parameterValueToString(r.canvasId, "canvasId")), -1)
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+		var v GooglerpcStatus
+		err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+		if err != nil {
+			newErr.error = err.Error()
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+		newErr.model = v
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
+
+type ApiCanvasesGetCanvasStatsRequest struct {
+	ctx        context.Context
+	ApiService *CanvasAPIService
+	canvasId   string
+	from       *time.Time
+	to         *time.Time
+}
+
+func (r ApiCanvasesGetCanvasStatsRequest) From(from time.Time) ApiCanvasesGetCanvasStatsRequest {
+	r.from = &from
+	return r
+}
+
+func (r ApiCanvasesGetCanvasStatsRequest) To(to time.Time) ApiCanvasesGetCanvasStatsRequest {
+	r.to = &to
+	return r
+}
+
+func (r ApiCanvasesGetCanvasStatsRequest) Execute() (*CanvasesGetCanvasStatsResponse, *http.Response, error) {
+	return r.ApiService.CanvasesGetCanvasStatsExecute(r)
+}
+
+/*
+CanvasesGetCanvasStats Get canvas stats
+
+Returns success rates, durations and throughput of the executions of a canvas and its nodes over a time range
+
+	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+	@param canvasId
+	@return ApiCanvasesGetCanvasStatsRequest
+*/
+func (a *CanvasAPIService) CanvasesGetCanvasStats(ctx context.Context, canvasId string) ApiCanvasesGetCanvasStatsRequest {
+	return ApiCanvasesGetCanvasStatsRequest{
+		ApiService: a,
+		ctx:        ctx,
+		canvasId:   canvasId,
+	}
+}
+
+// Execute executes the request
+//
+//	@return CanvasesGetCanvasStatsResponse
+func (a *CanvasAPIService) CanvasesGetCanvasStatsExecute(r ApiCanvasesGetCanvasStatsRequest) (*CanvasesGetCanvasStatsResponse, *http.Response, error) {
+	var (
+		localVarHTTPMethod  = http.MethodGet
+		localVarPostBody    interface{}
+		formFiles           []formFile
+		localVarReturnValue *CanvasesGetCanvasStatsResponse
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "CanvasAPIService.CanvasesGetCanvasStats")
+	if err != nil {
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/api/v1/canvases/{canvasId}/stats"
+	localVarPath = strings.Replace(localVarPath, "{"+"canvasId"+"}", url.PathEscape(parameterValueToString(r.canvasId, "canvasId")), -1)
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+
+	if r.from != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "from", r.from, "", "")
+	}
+	if r.to != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "to", r.to, "", "")
+	}
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+		var v GooglerpcStatus
+		err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+		if err != nil {
+			newErr.error = err.Error()
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+		newErr.model = v
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
+
 type ApiCanvasesListCanvasMemoriesRequest struct {
 	ctx        context.Context
 	ApiService *CanvasAPIService
@@ -956,3 +1483,247 @@ func (a *CanvasAPIService) CanvasesUpdateCanvasExecute(r ApiCanvasesUpdateCanvas
 
 	return localVarReturnValue, localVarHTTPResponse, nil
 }
+
+type ApiCanvasesUpdateCanvasDoraSettingsRequest struct {
+	ctx        context.Context
+	ApiService *CanvasAPIService
+	canvasId   string
+	body       *CanvasesUpdateCanvasDoraSettingsBody
+}
+
+func (r ApiCanvasesUpdateCanvasDoraSettingsRequest) Body(body CanvasesUpdateCanvasDoraSettingsBody) ApiCanvasesUpdateCanvasDoraSettingsRequest {
+	r.body = &body
+	return r
+}
+
+func (r ApiCanvasesUpdateCanvasDoraSettingsRequest) Execute() (*CanvasesUpdateCanvasDoraSettingsResponse, *http.Response, error) {
+	return r.ApiService.CanvasesUpdateCanvasDoraSettingsExecute(r)
+}
+
+/*
+CanvasesUpdateCanvasDoraSettings Update canvas DORA settings
+
+Marks canvas nodes as deployments or incidents, and maps the root event fields used to compute the DORA metrics
+
+	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+	@param canvasId
+	@return ApiCanvasesUpdateCanvasDoraSettingsRequest
+*/
+func (a *CanvasAPIService) CanvasesUpdateCanvasDoraSettings(ctx context.Context, canvasId string) ApiCanvasesUpdateCanvasDoraSettingsRequest {
+	return ApiCanvasesUpdateCanvasDoraSettingsRequest{
+		ApiService: a,
+		ctx:        ctx,
+		canvasId:   canvasId,
+	}
+}
+
+// Execute executes the request
+//
+//	@return CanvasesUpdateCanvasDoraSettingsResponse
+func (a *CanvasAPIService) CanvasesUpdateCanvasDoraSettingsExecute(r ApiCanvasesUpdateCanvasDoraSettingsRequest) (*CanvasesUpdateCanvasDoraSettingsResponse, *http.Response, error) {
+	var (
+		localVarHTTPMethod  = http.MethodPut
+		localVarPostBody    interface{}
+		formFiles           []formFile
+		localVarReturnValue *CanvasesUpdateCanvasDoraSettingsResponse
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "CanvasAPIService.CanvasesUpdateCanvasDoraSettings")
+	if err != nil {
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/api/v1/canvases/{canvasId}/dora/settings"
+	localVarPath = strings.Replace(localVarPath, "{"+"canvasId"+"}", url.PathEscape(parameterValueToString(r.canvasId, "canvasId")), -1)
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+	if r.body == nil {
+		return localVarReturnValue, nil, reportError("body is required and must be specified")
+	}
+
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{"application/json"}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	// body params
+	localVarPostBody = r.body
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+		var v GooglerpcStatus
+		err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+		if err != nil {
+			newErr.error = err.Error()
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+		newErr.model = v
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
+
+type ApiCanvasesWatchCanvasRequest struct {
+	ctx         context.Context
+	ApiService  *CanvasAPIService
+	canvasId    string
+	resumeToken *string
+}
+
+func (r ApiCanvasesWatchCanvasRequest) ResumeToken(resumeToken string) ApiCanvasesWatchCanvasRequest {
+	r.resumeToken = &resumeToken
+	return r
+}
+
+func (r ApiCanvasesWatchCanvasRequest) Execute() (map[string]interface{}, *http.Response, error) {
+	return r.ApiService.CanvasesWatchCanvasExecute(r)
+}
+
+/*
+CanvasesWatchCanvas Watch canvas
+
+Streams the events, executions and queue items of a canvas as they change. Send Accept: text/event-stream to receive server-sent events.
+
+	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+	@param canvasId
+	@return ApiCanvasesWatchCanvasRequest
+*/
+func (a *CanvasAPIService) CanvasesWatchCanvas(ctx context.Context, canvasId string) ApiCanvasesWatchCanvasRequest {
+	return ApiCanvasesWatchCanvasRequest{
+		ApiService: a,
+		ctx:        ctx,
+		canvasId:   canvasId,
+	}
+}
+
+// Execute executes the request
+//
+//	@return map[string]interface{}
+func (a *CanvasAPIService) CanvasesWatchCanvasExecute(r ApiCanvasesWatchCanvasRequest) (map[string]interface{}, *http.Response, error) {
+	var (
+		localVarHTTPMethod  = http.MethodGet
+		localVarPostBody    interface{}
+		formFiles           []formFile
+		localVarReturnValue map[string]interface{}
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "CanvasAPIService.CanvasesWatchCanvas")
+	if err != nil {
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/api/v1/canvases/{canvasId}/watch"
+	localVarPath = strings.Replace(localVarPath, "{"+"canvasId"+"}", url.PathEscape(parameterValueToString(r.canvasId, "canvasId")), -1)
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+
+	if r.resumeToken != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "resumeToken", r.resumeToken, "", "")
+	}
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+		var v GooglerpcStatus
+		err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+		if err != nil {
+			newErr.error = err.Error()
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+		newErr.model = v
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
@@ -150,6 +150,265 @@ func (a *CanvasNodeExecutionAPIService) CanvasesCancelExecutionExecute(r ApiCanv
 	return localVarReturnValue, localVarHTTPResponse, nil
 }
 
+type ApiCanvasesDownloadExecutionArtifactRequest struct {
+	ctx         context.Context
+	ApiService  *CanvasNodeExecutionAPIService
+	canvasId    string
+	executionId string
+	name        *string
+}
+
+func (r ApiCanvasesDownloadExecutionArtifactRequest) Name(name string) ApiCanvasesDownloadExecutionArtifactRequest {
+	r.name = &name
+	return r
+}
+
+func (r ApiCanvasesDownloadExecutionArtifactRequest) Execute() (map[string]interface{}, *http.Response, error) {
+	return r.ApiService.CanvasesDownloadExecutionArtifactExecute(r)
+}
+
+/*
+CanvasesDownloadExecutionArtifact Download execution artifact
+
+Streams the contents of an artifact attached to a canvas node execution in chunks. The first chunk also has the artifact.
+
+	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+	@param canvasId
+	@param executionId
+	@return ApiCanvasesDownloadExecutionArtifactRequest
+*/
+func (a *CanvasNodeExecutionAPIService) CanvasesDownloadExecutionArtifact(ctx context.Context, canvasId string, executionId string) ApiCanvasesDownloadExecutionArtifactRequest {
+	return ApiCanvasesDownloadExecutionArtifactRequest{
+		ApiService:  a,
+		ctx:         ctx,
+		canvasId:    canvasId,
+		executionId: executionId,
+	}
+}
+
+// Execute executes the request
+//
+//	@return map[string]interface{}
+func (a *CanvasNodeExecutionAPIService) CanvasesDownloadExecutionArtifactExecute(r ApiCanvasesDownloadExecutionArtifactRequest) (map[string]interface{}, *http.Response, error) {
+	var (
+		localVarHTTPMethod  = http.MethodGet
+		localVarPostBody    interface{}
+		formFiles           []formFile
+		localVarReturnValue map[string]interface{}
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "CanvasNodeExecutionAPIService.CanvasesDownloadExecutionArtifact")
+	if err != nil {
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/api/v1/canvases/{canvasId}/executions/{executionId}/artifacts/download"
+	localVarPath = strings.Replace(localVarPath, "{"+"canvasId"+"}", url.PathEscape(parameterValueToString(r.canvasId, "canvasId")), -1)
+	localVarPath = strings.Replace(localVarPath, "{"+"executionId"+"}", url.PathEscape(parameterValueToString(r.executionId, "executionId")), -1)
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+
+	if r.name != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "name", r.name, "", "")
+	}
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+		var v GooglerpcStatus
+		err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+		if err != nil {
+			newErr.error = err.Error()
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+		newErr.model = v
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
+
+type ApiCanvasesGetExecutionLogsRequest struct {
+	ctx         context.Context
+	ApiService  *CanvasNodeExecutionAPIService
+	canvasId    string
+	executionId string
+	after       *string
+	limit       *int64
+}
+
+func (r ApiCanvasesGetExecutionLogsRequest) After(after string) ApiCanvasesGetExecutionLogsRequest {
+	r.after = &after
+	return r
+}
+
+func (r ApiCanvasesGetExecutionLogsRequest) Limit(limit int64) ApiCanvasesGetExecutionLogsRequest {
+	r.limit = &limit
+	return r
+}
+
+func (r ApiCanvasesGetExecutionLogsRequest) Execute() (*CanvasesGetExecutionLogsResponse, *http.Response, error) {
+	return r.ApiService.CanvasesGetExecutionLogsExecute(r)
+}
+
+/*
+CanvasesGetExecutionLogs Get execution logs
+
+Returns the log output of a canvas node execution
+
+	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+	@param canvasId
+	@param executionId
+	@return ApiCanvasesGetExecutionLogsRequest
+*/
+func (a *CanvasNodeExecutionAPIService) CanvasesGetExecutionLogs(ctx context.Context, canvasId string, executionId string) ApiCanvasesGetExecutionLogsRequest {
+	return ApiCanvasesGetExecutionLogsRequest{
+		ApiService:  a,
+		ctx:         ctx,
+		canvasId:    canvasId,
+		executionId: executionId,
+	}
+}
+
+// Execute executes the request
+//
+//	@return CanvasesGetExecutionLogsResponse
+func (a *CanvasNodeExecutionAPIService) CanvasesGetExecutionLogsExecute(r ApiCanvasesGetExecutionLogsRequest) (*CanvasesGetExecutionLogsResponse, *http.Response, error) {
+	var (
+		localVarHTTPMethod  = http.MethodGet
+		localVarPostBody    interface{}
+		formFiles           []formFile
+		localVarReturnValue *CanvasesGetExecutionLogsResponse
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "CanvasNodeExecutionAPIService.CanvasesGetExecutionLogs")
+	if err != nil {
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/api/v1/canvases/{canvasId}/executions/{executionId}/logs"
+	localVarPath = strings.Replace(localVarPath, "{"+"canvasId"+"}", url.PathEscape(parameterValueToString(r.canvasId, "canvasId")), -1)
+	localVarPath = strings.Replace(localVarPath, "{"+"executionId"+"}", url.PathEscape(parameterValueToString(r.executionId, "executionId")), -1)
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+
+	if r.after != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "after", r.after, "", "")
+	}
+	if r.limit != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "limit", r.limit, "", "")
+	}
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+		var v GooglerpcStatus
+		err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+		if err != nil {
+			newErr.error = err.Error()
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+		newErr.model = v
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
+
 type ApiCanvasesInvokeNodeExecutionActionRequest struct {
 	ctx         context.Context
 	ApiService  *CanvasNodeExecutionAPIService
@@ -164,7 +423,7 @@ func (r ApiCanvasesInvokeNodeExecutionActionRequest) Body(body CanvasesInvokeNod
 	return r
 }
 
-func (r ApiCanvasesInvokeNodeExecutionActionRequest) Execute() (map[string]interface{}, *http.Response, error) {
+func (r ApiCanvasesInvokeNodeExecutionActionRequest) Execute() (*CanvasesInvokeNodeExecutionActionResponse, *http.Response, error) {
 	return r.ApiService.CanvasesInvokeNodeExecutionActionExecute(r)
 }
 
@@ -191,13 +450,13 @@ func (a *CanvasNodeExecutionAPIService) CanvasesInvokeNodeExecutionAction(ctx co
 
 // Execute executes the request
 //
-//	@return map[string]interface{}
-func (a *CanvasNodeExecutionAPIService) CanvasesInvokeNodeExecutionActionExecute(r ApiCanvasesInvokeNodeExecutionActionRequest) (map[string]interface{}, *http.Response, error) {
+//	@return CanvasesInvokeNodeExecutionActionResponse
+func (a *CanvasNodeExecutionAPIService) CanvasesInvokeNodeExecutionActionExecute(r ApiCanvasesInvokeNodeExecutionActionRequest) (*CanvasesInvokeNodeExecutionActionResponse, *http.Response, error) {
 	var (
 		localVarHTTPMethod  = http.MethodPost
 		localVarPostBody    interface{}
 		formFiles           []formFile
-		localVarReturnValue map[string]interface{}
+		localVarReturnValue *CanvasesInvokeNodeExecutionActionResponse
 	)
 
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "CanvasNodeExecutionAPIService.CanvasesInvokeNodeExecutionAction")
@@ -408,6 +667,122 @@ func (a *CanvasNodeExecutionAPIService) CanvasesListChildExecutionsExecute(r Api
 	return localVarReturnValue, localVarHTTPResponse, nil
 }
 
+type ApiCanvasesListExecutionArtifactsRequest struct {
+	ctx         context.Context
+	ApiService  *CanvasNodeExecutionAPIService
+	canvasId    string
+	executionId string
+}
+
+func (r ApiCanvasesListExecutionArtifactsRequest) Execute() (*CanvasesListExecutionArtifactsResponse, *http.Response, error) {
+	return r.ApiService.CanvasesListExecutionArtifactsExecute(r)
+}
+
+/*
+CanvasesListExecutionArtifacts List execution artifacts
+
+Returns the artifacts attached to a canvas node execution
+
+	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+	@param canvasId
+	@param executionId
+	@return ApiCanvasesListExecutionArtifactsRequest
+*/
+func (a *CanvasNodeExecutionAPIService) CanvasesListExecutionArtifacts(ctx context.Context, canvasId string, executionId string) ApiCanvasesListExecutionArtifactsRequest {
+	return ApiCanvasesListExecutionArtifactsRequest{
+		ApiService:  a,
+		ctx:         ctx,
+		canvasId:    canvasId,
+		executionId: executionId,
+	}
+}
+
+// Execute executes the request
+//
+//	@return CanvasesListExecutionArtifactsResponse
+func (a *CanvasNodeExecutionAPIService) CanvasesListExecutionArtifactsExecute(r ApiCanvasesListExecutionArtifactsRequest) (*CanvasesListExecutionArtifactsResponse, *http.Response, error) {
+	var (
+		localVarHTTPMethod  = http.MethodGet
+		localVarPostBody    interface{}
+		formFiles           []formFile
+		localVarReturnValue *CanvasesListExecutionArtifactsResponse
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "CanvasNodeExecutionAPIService.CanvasesListExecutionArtifacts")
+	if err != nil {
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/api/v1/canvases/{canvasId}/executions/{executionId}/artifacts"
+	localVarPath = strings.Replace(localVarPath, "{"+"canvasId"+"}", url.PathEscape(parameterValueToString(r.canvasId, "canvasId")), -1)
+	localVarPath = strings.Replace(localVarPath, "{"+"executionId"+"}", url.PathEscape(parameterValueToString(r.executionId, "executionId")), -1)
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+		var v GooglerpcStatus
+		err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+		if err != nil {
+			newErr.error = err.Error()
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+		newErr.model = v
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
+
 type ApiCanvasesResolveExecutionErrorsRequest struct {
 	ctx        context.Context
 	ApiService *CanvasNodeExecutionAPIService
@@ -530,3 +905,128 @@ func (a *CanvasNodeExecutionAPIService) CanvasesResolveExecutionErrorsExecute(r
 
 	return localVarReturnValue, localVarHTTPResponse, nil
 }
+
+type ApiCanvasesWatchExecutionRequest struct {
+	ctx         context.Context
+	ApiService  *CanvasNodeExecutionAPIService
+	canvasId    string
+	executionId string
+	resumeToken *string
+}
+
+func (r ApiCanvasesWatchExecutionRequest) ResumeToken(resumeToken string) ApiCanvasesWatchExecutionRequest {
+	r.resumeToken = &resumeToken
+	return r
+}
+
+func (r ApiCanvasesWatchExecutionRequest) Execute() (map[string]interface{}, *http.Response, error) {
+	return r.ApiService.CanvasesWatchExecutionExecute(r)
+}
+
+/*
+CanvasesWatchExecution Watch execution
+
+Streams the changes of an execution, its child executions and output events, until the execution finishes. Send Accept: text/event-stream to receive server-sent events.
+
+	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+	@param canvasId
+	@param executionId
+	@return ApiCanvasesWatchExecutionRequest
+*/
+func (a *CanvasNodeExecutionAPIService) CanvasesWatchExecution(ctx context.Context, canvasId string, executionId string) ApiCanvasesWatchExecutionRequest {
+	return ApiCanvasesWatchExecutionRequest{
+		ApiService:  a,
+		ctx:         ctx,
+		canvasId:    canvasId,
+		executionId: executionId,
+	}
+}
+
+// Execute executes the request
+//
+//	@return map[string]interface{}
+func (a *CanvasNodeExecutionAPIService) CanvasesWatchExecutionExecute(r ApiCanvasesWatchExecutionRequest) (map[string]interface{}, *http.Response, error) {
+	var (
+		localVarHTTPMethod  = http.MethodGet
+		localVarPostBody    interface{}
+		formFiles           []formFile
+		localVarReturnValue map[string]interface{}
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "CanvasNodeExecutionAPIService.CanvasesWatchExecution")
+	if err != nil {
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/api/v1/canvases/{canvasId}/executions/{executionId}/watch"
+	localVarPath = strings.Replace(localVarPath, "{"+"canvasId"+"}", url.PathEscape(parameterValueToString(r.canvasId, "canvasId")), -1)
+	localVarPath = strings.Replace(localVarPath, "{"+"executionId"+"}", url.PathEscape(parameterValueToString(r.executionId, "executionId")), -1)
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+
+	if r.resumeToken != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "resumeToken", r.resumeToken, "", "")
+	}
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+		var v GooglerpcStatus
+		err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+		if err != nil {
+			newErr.error = err.Error()
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+		newErr.model = v
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
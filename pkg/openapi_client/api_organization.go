@@ -767,55 +767,51 @@ func (a *OrganizationAPIService) OrganizationsDeleteOrganizationExecute(r ApiOrg
 	return localVarReturnValue, localVarHTTPResponse, nil
 }
 
-type ApiOrganizationsDescribeIntegrationRequest struct {
-	ctx           context.Context
-	ApiService    *OrganizationAPIService
-	id            string
-	integrationId string
+type ApiOrganizationsDeleteSCIMTokenRequest struct {
+	ctx        context.Context
+	ApiService *OrganizationAPIService
+	id         string
 }
 
-func (r ApiOrganizationsDescribeIntegrationRequest) Execute() (*OrganizationsDescribeIntegrationResponse, *http.Response, error) {
-	return r.ApiService.OrganizationsDescribeIntegrationExecute(r)
+func (r ApiOrganizationsDeleteSCIMTokenRequest) Execute() (map[string]interface{}, *http.Response, error) {
+	return r.ApiService.OrganizationsDeleteSCIMTokenExecute(r)
 }
 
 /*
-OrganizationsDescribeIntegration Describe an integration in an organization
+OrganizationsDeleteSCIMToken Delete organization SCIM token
 
-Returns details of a specific integration in an organization
+Deletes the SCIM token for an organization, disabling SCIM provisioning
 
 	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
 	@param id
-	@param integrationId
-	@return ApiOrganizationsDescribeIntegrationRequest
+	@return ApiOrganizationsDeleteSCIMTokenRequest
 */
-func (a *OrganizationAPIService) OrganizationsDescribeIntegration(ctx context.Context, id string, integrationId string) ApiOrganizationsDescribeIntegrationRequest {
-	return ApiOrganizationsDescribeIntegrationRequest{
-		ApiService:    a,
-		ctx:           ctx,
-		id:            id,
-		integrationId: integrationId,
+func (a *OrganizationAPIService) OrganizationsDeleteSCIMToken(ctx context.Context, id string) ApiOrganizationsDeleteSCIMTokenRequest {
+	return ApiOrganizationsDeleteSCIMTokenRequest{
+		ApiService: a,
+		ctx:        ctx,
+		id:         id,
 	}
 }
 
 // Execute executes the request
 //
-//	@return OrganizationsDescribeIntegrationResponse
-func (a *OrganizationAPIService) OrganizationsDescribeIntegrationExecute(r ApiOrganizationsDescribeIntegrationRequest) (*OrganizationsDescribeIntegrationResponse, *http.Response, error) {
+//	@return map[string]interface{}
+func (a *OrganizationAPIService) OrganizationsDeleteSCIMTokenExecute(r ApiOrganizationsDeleteSCIMTokenRequest) (map[string]interface{}, *http.Response, error) {
 	var (
-		localVarHTTPMethod  = http.MethodGet
+		localVarHTTPMethod  = http.MethodDelete
 		localVarPostBody    interface{}
 		formFiles           []formFile
-		localVarReturnValue *OrganizationsDescribeIntegrationResponse
+		localVarReturnValue map[string]interface{}
 	)
 
-	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "OrganizationAPIService.OrganizationsDescribeIntegration")
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "OrganizationAPIService.OrganizationsDeleteSCIMToken")
 	if err != nil {
 		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
 	}
 
-	localVarPath := localBasePath + "/api/v1/organizations/{id}/integrations/{integrationId}"
+	localVarPath := localBasePath + "/api/v1/organizations/{id}/scim/token"
 	localVarPath = strings.Replace(localVarPath, "{"+"id"+"}", url.PathEscape(parameterValueToString(r.id, "id")), -1)
-	localVarPath = strings.Replace(localVarPath, "{"+"integrationId"+"}", url.PathEscape(parameterValueToString(r.integrationId, "integrationId")), -1)
 
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
@@ -883,27 +879,27 @@ func (a *OrganizationAPIService) OrganizationsDescribeIntegrationExecute(r ApiOr
 	return localVarReturnValue, localVarHTTPResponse, nil
 }
 
-type ApiOrganizationsDescribeOrganizationRequest struct {
+type ApiOrganizationsDeleteSSOConfigRequest struct {
 	ctx        context.Context
 	ApiService *OrganizationAPIService
 	id         string
 }
 
-func (r ApiOrganizationsDescribeOrganizationRequest) Execute() (*OrganizationsDescribeOrganizationResponse, *http.Response, error) {
-	return r.ApiService.OrganizationsDescribeOrganizationExecute(r)
+func (r ApiOrganizationsDeleteSSOConfigRequest) Execute() (map[string]interface{}, *http.Response, error) {
+	return r.ApiService.OrganizationsDeleteSSOConfigExecute(r)
 }
 
 /*
-OrganizationsDescribeOrganization Get organization details
+OrganizationsDeleteSSOConfig Delete organization single sign-on configuration
 
-Returns the details of a specific organization (can be referenced by ID or name)
+Removes single sign-on from an organization, re-enabling other login methods
 
 	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
 	@param id
-	@return ApiOrganizationsDescribeOrganizationRequest
+	@return ApiOrganizationsDeleteSSOConfigRequest
 */
-func (a *OrganizationAPIService) OrganizationsDescribeOrganization(ctx context.Context, id string) ApiOrganizationsDescribeOrganizationRequest {
-	return ApiOrganizationsDescribeOrganizationRequest{
+func (a *OrganizationAPIService) OrganizationsDeleteSSOConfig(ctx context.Context, id string) ApiOrganizationsDeleteSSOConfigRequest {
+	return ApiOrganizationsDeleteSSOConfigRequest{
 		ApiService: a,
 		ctx:        ctx,
 		id:         id,
@@ -912,21 +908,21 @@ func (a *OrganizationAPIService) OrganizationsDescribeOrganization(ctx context.C
 
 // Execute executes the request
 //
-//	@return OrganizationsDescribeOrganizationResponse
-func (a *OrganizationAPIService) OrganizationsDescribeOrganizationExecute(r ApiOrganizationsDescribeOrganizationRequest) (*OrganizationsDescribeOrganizationResponse, *http.Response, error) {
+//	@return map[string]interface{}
+func (a *OrganizationAPIService) OrganizationsDeleteSSOConfigExecute(r ApiOrganizationsDeleteSSOConfigRequest) (map[string]interface{}, *http.Response, error) {
 	var (
-		localVarHTTPMethod  = http.MethodGet
+		localVarHTTPMethod  = http.MethodDelete
 		localVarPostBody    interface{}
 		formFiles           []formFile
-		localVarReturnValue *OrganizationsDescribeOrganizationResponse
+		localVarReturnValue map[string]interface{}
 	)
 
-	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "OrganizationAPIService.OrganizationsDescribeOrganization")
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "OrganizationAPIService.OrganizationsDeleteSSOConfig")
 	if err != nil {
 		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
 	}
 
-	localVarPath := localBasePath + "/api/v1/organizations/{id}"
+	localVarPath := localBasePath + "/api/v1/organizations/{id}/sso"
 	localVarPath = strings.Replace(localVarPath, "{"+"id"+"}", url.PathEscape(parameterValueToString(r.id, "id")), -1)
 
 	localVarHeaderParams := make(map[string]string)
@@ -995,51 +991,55 @@ func (a *OrganizationAPIService) OrganizationsDescribeOrganizationExecute(r ApiO
 	return localVarReturnValue, localVarHTTPResponse, nil
 }
 
-type ApiOrganizationsGetAgentSettingsRequest struct {
-	ctx        context.Context
-	ApiService *OrganizationAPIService
-	id         string
+type ApiOrganizationsDescribeIntegrationRequest struct {
+	ctx           context.Context
+	ApiService    *OrganizationAPIService
+	id            string
+	integrationId string
 }
 
-func (r ApiOrganizationsGetAgentSettingsRequest) Execute() (*OrganizationsGetAgentSettingsResponse, *http.Response, error) {
-	return r.ApiService.OrganizationsGetAgentSettingsExecute(r)
+func (r ApiOrganizationsDescribeIntegrationRequest) Execute() (*OrganizationsDescribeIntegrationResponse, *http.Response, error) {
+	return r.ApiService.OrganizationsDescribeIntegrationExecute(r)
 }
 
 /*
-OrganizationsGetAgentSettings Get organization Agent Mode settings
+OrganizationsDescribeIntegration Describe an integration in an organization
 
-Returns Agent Mode enablement and OpenAI key status for an organization
+Returns details of a specific integration in an organization
 
 	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
 	@param id
-	@return ApiOrganizationsGetAgentSettingsRequest
+	@param integrationId
+	@return ApiOrganizationsDescribeIntegrationRequest
 */
-func (a *OrganizationAPIService) OrganizationsGetAgentSettings(ctx context.Context, id string) ApiOrganizationsGetAgentSettingsRequest {
-	return ApiOrganizationsGetAgentSettingsRequest{
-		ApiService: a,
-		ctx:        ctx,
-		id:         id,
+func (a *OrganizationAPIService) OrganizationsDescribeIntegration(ctx context.Context, id string, integrationId string) ApiOrganizationsDescribeIntegrationRequest {
+	return ApiOrganizationsDescribeIntegrationRequest{
+		ApiService:    a,
+		ctx:           ctx,
+		id:            id,
+		integrationId: integrationId,
 	}
 }
 
 // Execute executes the request
 //
-//	@return OrganizationsGetAgentSettingsResponse
-func (a *OrganizationAPIService) OrganizationsGetAgentSettingsExecute(r ApiOrganizationsGetAgentSettingsRequest) (*OrganizationsGetAgentSettingsResponse, *http.Response, error) {
+//	@return OrganizationsDescribeIntegrationResponse
+func (a *OrganizationAPIService) OrganizationsDescribeIntegrationExecute(r ApiOrganizationsDescribeIntegrationRequest) (*OrganizationsDescribeIntegrationResponse, *http.Response, error) {
 	var (
 		localVarHTTPMethod  = http.MethodGet
 		localVarPostBody    interface{}
 		formFiles           []formFile
-		localVarReturnValue *OrganizationsGetAgentSettingsResponse
+		localVarReturnValue *OrganizationsDescribeIntegrationResponse
 	)
 
-	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "OrganizationAPIService.OrganizationsGetAgentSettings")
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "OrganizationAPIService.OrganizationsDescribeIntegration")
 	if err != nil {
 		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
 	}
 
-	localVarPath := localBasePath + "/api/v1/organizations/{id}/agent-settings"
+	localVarPath := localBasePath + "/api/v1/organizations/{id}/integrations/{integrationId}"
 	localVarPath = strings.Replace(localVarPath, "{"+"id"+"}", url.PathEscape(parameterValueToString(r.id, "id")), -1)
+	localVarPath = strings.Replace(localVarPath, "{"+"integrationId"+"}", url.PathEscape(parameterValueToString(r.integrationId, "integrationId")), -1)
 
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
@@ -1107,27 +1107,27 @@ func (a *OrganizationAPIService) OrganizationsGetAgentSettingsExecute(r ApiOrgan
 	return localVarReturnValue, localVarHTTPResponse, nil
 }
 
-type ApiOrganizationsGetInviteLinkRequest struct {
+type ApiOrganizationsDescribeOrganizationRequest struct {
 	ctx        context.Context
 	ApiService *OrganizationAPIService
 	id         string
 }
 
-func (r ApiOrganizationsGetInviteLinkRequest) Execute() (*OrganizationsGetInviteLinkResponse, *http.Response, error) {
-	return r.ApiService.OrganizationsGetInviteLinkExecute(r)
+func (r ApiOrganizationsDescribeOrganizationRequest) Execute() (*OrganizationsDescribeOrganizationResponse, *http.Response, error) {
+	return r.ApiService.OrganizationsDescribeOrganizationExecute(r)
 }
 
 /*
-OrganizationsGetInviteLink Get an organization invite link
+OrganizationsDescribeOrganization Get organization details
 
-Returns the invite link for an organization
+Returns the details of a specific organization (can be referenced by ID or name)
 
 	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
 	@param id
-	@return ApiOrganizationsGetInviteLinkRequest
+	@return ApiOrganizationsDescribeOrganizationRequest
 */
-func (a *OrganizationAPIService) OrganizationsGetInviteLink(ctx context.Context, id string) ApiOrganizationsGetInviteLinkRequest {
-	return ApiOrganizationsGetInviteLinkRequest{
+func (a *OrganizationAPIService) OrganizationsDescribeOrganization(ctx context.Context, id string) ApiOrganizationsDescribeOrganizationRequest {
+	return ApiOrganizationsDescribeOrganizationRequest{
 		ApiService: a,
 		ctx:        ctx,
 		id:         id,
@@ -1136,21 +1136,21 @@ func (a *OrganizationAPIService) OrganizationsGetInviteLink(ctx context.Context,
 
 // Execute executes the request
 //
-//	@return OrganizationsGetInviteLinkResponse
-func (a *OrganizationAPIService) OrganizationsGetInviteLinkExecute(r ApiOrganizationsGetInviteLinkRequest) (*OrganizationsGetInviteLinkResponse, *http.Response, error) {
+//	@return OrganizationsDescribeOrganizationResponse
+func (a *OrganizationAPIService) OrganizationsDescribeOrganizationExecute(r ApiOrganizationsDescribeOrganizationRequest) (*OrganizationsDescribeOrganizationResponse, *http.Response, error) {
 	var (
 		localVarHTTPMethod  = http.MethodGet
 		localVarPostBody    interface{}
 		formFiles           []formFile
-		localVarReturnValue *OrganizationsGetInviteLinkResponse
+		localVarReturnValue *OrganizationsDescribeOrganizationResponse
 	)
 
-	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "OrganizationAPIService.OrganizationsGetInviteLink")
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "OrganizationAPIService.OrganizationsDescribeOrganization")
 	if err != nil {
 		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
 	}
 
-	localVarPath := localBasePath + "/api/v1/organizations/{id}/invite-link"
+	localVarPath := localBasePath + "/api/v1/organizations/{id}"
 	localVarPath = strings.Replace(localVarPath, "{"+"id"+"}", url.PathEscape(parameterValueToString(r.id, "id")), -1)
 
 	localVarHeaderParams := make(map[string]string)
@@ -1219,69 +1219,56 @@ func (a *OrganizationAPIService) OrganizationsGetInviteLinkExecute(r ApiOrganiza
 	return localVarReturnValue, localVarHTTPResponse, nil
 }
 
-type ApiOrganizationsListIntegrationResourcesRequest struct {
-	ctx           context.Context
-	ApiService    *OrganizationAPIService
-	id            string
-	integrationId string
-	parameters    *string
-}
-
-func (r ApiOrganizationsListIntegrationResourcesRequest) Parameters(parameters string) ApiOrganizationsListIntegrationResourcesRequest {
-	r.parameters = &parameters
-	return r
+type ApiOrganizationsGetAgentSettingsRequest struct {
+	ctx        context.Context
+	ApiService *OrganizationAPIService
+	id         string
 }
 
-func (r ApiOrganizationsListIntegrationResourcesRequest) Execute() (*OrganizationsListIntegrationResourcesResponse, *http.Response, error) {
-	return r.ApiService.OrganizationsListIntegrationResourcesExecute(r)
+func (r ApiOrganizationsGetAgentSettingsRequest) Execute() (*OrganizationsGetAgentSettingsResponse, *http.Response, error) {
+	return r.ApiService.OrganizationsGetAgentSettingsExecute(r)
 }
 
 /*
-OrganizationsListIntegrationResources List integration resources
+OrganizationsGetAgentSettings Get organization Agent Mode settings
 
-Lists resources for an integration
+Returns Agent Mode enablement and OpenAI key status for an organization
 
 	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
 	@param id
-	@param integrationId
-	@return ApiOrganizationsListIntegrationResourcesRequest
+	@return ApiOrganizationsGetAgentSettingsRequest
 */
-func (a *OrganizationAPIService) OrganizationsListIntegrationResources(ctx context.Context, id string, integrationId string) ApiOrganizationsListIntegrationResourcesRequest {
-	return ApiOrganizationsListIntegrationResourcesRequest{
-		ApiService:    a,
-		ctx:           ctx,
-		id:            id,
-		integrationId: integrationId,
+func (a *OrganizationAPIService) OrganizationsGetAgentSettings(ctx context.Context, id string) ApiOrganizationsGetAgentSettingsRequest {
+	return ApiOrganizationsGetAgentSettingsRequest{
+		ApiService: a,
+		ctx:        ctx,
+		id:         id,
 	}
 }
 
 // Execute executes the request
 //
-//	@return OrganizationsListIntegrationResourcesResponse
-func (a *OrganizationAPIService) OrganizationsListIntegrationResourcesExecute(r ApiOrganizationsListIntegrationResourcesRequest) (*OrganizationsListIntegrationResourcesResponse, *http.Response, error) {
+//	@return OrganizationsGetAgentSettingsResponse
+func (a *OrganizationAPIService) OrganizationsGetAgentSettingsExecute(r ApiOrganizationsGetAgentSettingsRequest) (*OrganizationsGetAgentSettingsResponse, *http.Response, error) {
 	var (
 		localVarHTTPMethod  = http.MethodGet
 		localVarPostBody    interface{}
 		formFiles           []formFile
-		localVarReturnValue *OrganizationsListIntegrationResourcesResponse
+		localVarReturnValue *OrganizationsGetAgentSettingsResponse
 	)
 
-	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "OrganizationAPIService.OrganizationsListIntegrationResources")
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "OrganizationAPIService.OrganizationsGetAgentSettings")
 	if err != nil {
 		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
 	}
 
-	localVarPath := localBasePath + "/api/v1/organizations/{id}/integrations/{integrationId}/resources"
+	localVarPath := localBasePath + "/api/v1/organizations/{id}/agent-settings"
 	localVarPath = strings.Replace(localVarPath, "{"+"id"+"}", url.PathEscape(parameterValueToString(r.id, "id")), -1)
-	localVarPath = strings.Replace(localVarPath, "{"+"integrationId"+"}", url.PathEscape(parameterValueToString(r.integrationId, "integrationId")), -1)
 
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
 
-	if r.parameters != nil {
-		parameterAddToHeaderOrQuery(localVarQueryParams, "parameters", r.parameters, "", "")
-	}
 	// to determine the Content-Type header
 	localVarHTTPContentTypes := []string{}
 
@@ -1344,27 +1331,27 @@ func (a *OrganizationAPIService) OrganizationsListIntegrationResourcesExecute(r
 	return localVarReturnValue, localVarHTTPResponse, nil
 }
 
-type ApiOrganizationsListIntegrationsRequest struct {
+type ApiOrganizationsGetInviteLinkRequest struct {
 	ctx        context.Context
 	ApiService *OrganizationAPIService
 	id         string
 }
 
-func (r ApiOrganizationsListIntegrationsRequest) Execute() (*SuperplaneOrganizationsListIntegrationsResponse, *http.Response, error) {
-	return r.ApiService.OrganizationsListIntegrationsExecute(r)
+func (r ApiOrganizationsGetInviteLinkRequest) Execute() (*OrganizationsGetInviteLinkResponse, *http.Response, error) {
+	return r.ApiService.OrganizationsGetInviteLinkExecute(r)
 }
 
 /*
-OrganizationsListIntegrations List integrations in an organization
+OrganizationsGetInviteLink Get an organization invite link
 
-Returns a list of integrations in an organization
+Returns the invite link for an organization
 
 	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
 	@param id
-	@return ApiOrganizationsListIntegrationsRequest
+	@return ApiOrganizationsGetInviteLinkRequest
 */
-func (a *OrganizationAPIService) OrganizationsListIntegrations(ctx context.Context, id string) ApiOrganizationsListIntegrationsRequest {
-	return ApiOrganizationsListIntegrationsRequest{
+func (a *OrganizationAPIService) OrganizationsGetInviteLink(ctx context.Context, id string) ApiOrganizationsGetInviteLinkRequest {
+	return ApiOrganizationsGetInviteLinkRequest{
 		ApiService: a,
 		ctx:        ctx,
 		id:         id,
@@ -1373,21 +1360,21 @@ func (a *OrganizationAPIService) OrganizationsListIntegrations(ctx context.Conte
 
 // Execute executes the request
 //
-//	@return SuperplaneOrganizationsListIntegrationsResponse
-func (a *OrganizationAPIService) OrganizationsListIntegrationsExecute(r ApiOrganizationsListIntegrationsRequest) (*SuperplaneOrganizationsListIntegrationsResponse, *http.Response, error) {
+//	@return OrganizationsGetInviteLinkResponse
+func (a *OrganizationAPIService) OrganizationsGetInviteLinkExecute(r ApiOrganizationsGetInviteLinkRequest) (*OrganizationsGetInviteLinkResponse, *http.Response, error) {
 	var (
 		localVarHTTPMethod  = http.MethodGet
 		localVarPostBody    interface{}
 		formFiles           []formFile
-		localVarReturnValue *SuperplaneOrganizationsListIntegrationsResponse
+		localVarReturnValue *OrganizationsGetInviteLinkResponse
 	)
 
-	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "OrganizationAPIService.OrganizationsListIntegrations")
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "OrganizationAPIService.OrganizationsGetInviteLink")
 	if err != nil {
 		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
 	}
 
-	localVarPath := localBasePath + "/api/v1/organizations/{id}/integrations"
+	localVarPath := localBasePath + "/api/v1/organizations/{id}/invite-link"
 	localVarPath = strings.Replace(localVarPath, "{"+"id"+"}", url.PathEscape(parameterValueToString(r.id, "id")), -1)
 
 	localVarHeaderParams := make(map[string]string)
@@ -1456,27 +1443,27 @@ func (a *OrganizationAPIService) OrganizationsListIntegrationsExecute(r ApiOrgan
 	return localVarReturnValue, localVarHTTPResponse, nil
 }
 
-type ApiOrganizationsListInvitationsRequest struct {
+type ApiOrganizationsGetSCIMConfigRequest struct {
 	ctx        context.Context
 	ApiService *OrganizationAPIService
 	id         string
 }
 
-func (r ApiOrganizationsListInvitationsRequest) Execute() (*OrganizationsListInvitationsResponse, *http.Response, error) {
-	return r.ApiService.OrganizationsListInvitationsExecute(r)
+func (r ApiOrganizationsGetSCIMConfigRequest) Execute() (*OrganizationsGetSCIMConfigResponse, *http.Response, error) {
+	return r.ApiService.OrganizationsGetSCIMConfigExecute(r)
 }
 
 /*
-OrganizationsListInvitations List organization invitations
+OrganizationsGetSCIMConfig Get organization SCIM provisioning configuration
 
-Returns pending invitations for an organization
+Returns the SCIM 2.0 base URL and the status of the SCIM token for an organization
 
 	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
 	@param id
-	@return ApiOrganizationsListInvitationsRequest
+	@return ApiOrganizationsGetSCIMConfigRequest
 */
-func (a *OrganizationAPIService) OrganizationsListInvitations(ctx context.Context, id string) ApiOrganizationsListInvitationsRequest {
-	return ApiOrganizationsListInvitationsRequest{
+func (a *OrganizationAPIService) OrganizationsGetSCIMConfig(ctx context.Context, id string) ApiOrganizationsGetSCIMConfigRequest {
+	return ApiOrganizationsGetSCIMConfigRequest{
 		ApiService: a,
 		ctx:        ctx,
 		id:         id,
@@ -1485,21 +1472,21 @@ func (a *OrganizationAPIService) OrganizationsListInvitations(ctx context.Contex
 
 // Execute executes the request
 //
-//	@return OrganizationsListInvitationsResponse
-func (a *OrganizationAPIService) OrganizationsListInvitationsExecute(r ApiOrganizationsListInvitationsRequest) (*OrganizationsListInvitationsResponse, *http.Response, error) {
+//	@return OrganizationsGetSCIMConfigResponse
+func (a *OrganizationAPIService) OrganizationsGetSCIMConfigExecute(r ApiOrganizationsGetSCIMConfigRequest) (*OrganizationsGetSCIMConfigResponse, *http.Response, error) {
 	var (
 		localVarHTTPMethod  = http.MethodGet
 		localVarPostBody    interface{}
 		formFiles           []formFile
-		localVarReturnValue *OrganizationsListInvitationsResponse
+		localVarReturnValue *OrganizationsGetSCIMConfigResponse
 	)
 
-	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "OrganizationAPIService.OrganizationsListInvitations")
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "OrganizationAPIService.OrganizationsGetSCIMConfig")
 	if err != nil {
 		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
 	}
 
-	localVarPath := localBasePath + "/api/v1/organizations/{id}/invitations"
+	localVarPath := localBasePath + "/api/v1/organizations/{id}/scim"
 	localVarPath = strings.Replace(localVarPath, "{"+"id"+"}", url.PathEscape(parameterValueToString(r.id, "id")), -1)
 
 	localVarHeaderParams := make(map[string]string)
@@ -1568,55 +1555,986 @@ func (a *OrganizationAPIService) OrganizationsListInvitationsExecute(r ApiOrgani
 	return localVarReturnValue, localVarHTTPResponse, nil
 }
 
-type ApiOrganizationsRemoveInvitationRequest struct {
-	ctx          context.Context
-	ApiService   *OrganizationAPIService
-	id           string
-	invitationId string
+type ApiOrganizationsGetSSOConfigRequest struct {
+	ctx        context.Context
+	ApiService *OrganizationAPIService
+	id         string
 }
 
-func (r ApiOrganizationsRemoveInvitationRequest) Execute() (map[string]interface{}, *http.Response, error) {
-	return r.ApiService.OrganizationsRemoveInvitationExecute(r)
+func (r ApiOrganizationsGetSSOConfigRequest) Execute() (*OrganizationsGetSSOConfigResponse, *http.Response, error) {
+	return r.ApiService.OrganizationsGetSSOConfigExecute(r)
 }
 
 /*
-OrganizationsRemoveInvitation Remove an organization invitation
+OrganizationsGetSSOConfig Get organization single sign-on configuration
 
-Removes an organization invitation
+Returns the SAML or OIDC single sign-on configuration for an organization
 
 	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
 	@param id
-	@param invitationId
-	@return ApiOrganizationsRemoveInvitationRequest
+	@return ApiOrganizationsGetSSOConfigRequest
 */
-func (a *OrganizationAPIService) OrganizationsRemoveInvitation(ctx context.Context, id string, invitationId string) ApiOrganizationsRemoveInvitationRequest {
-	return ApiOrganizationsRemoveInvitationRequest{
-		ApiService:   a,
-		ctx:          ctx,
-		id:           id,
-		invitationId: invitationId,
+func (a *OrganizationAPIService) OrganizationsGetSSOConfig(ctx context.Context, id string) ApiOrganizationsGetSSOConfigRequest {
+	return ApiOrganizationsGetSSOConfigRequest{
+		ApiService: a,
+		ctx:        ctx,
+		id:         id,
 	}
 }
 
 // Execute executes the request
 //
-//	@return map[string]interface{}
-func (a *OrganizationAPIService) OrganizationsRemoveInvitationExecute(r ApiOrganizationsRemoveInvitationRequest) (map[string]interface{}, *http.Response, error) {
+//	@return OrganizationsGetSSOConfigResponse
+func (a *OrganizationAPIService) OrganizationsGetSSOConfigExecute(r ApiOrganizationsGetSSOConfigRequest) (*OrganizationsGetSSOConfigResponse, *http.Response, error) {
 	var (
-		localVarHTTPMethod  = http.MethodDelete
+		localVarHTTPMethod  = http.MethodGet
 		localVarPostBody    interface{}
 		formFiles           []formFile
-		localVarReturnValue map[string]interface{}
+		localVarReturnValue *OrganizationsGetSSOConfigResponse
 	)
 
-	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "OrganizationAPIService.OrganizationsRemoveInvitation")
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "OrganizationAPIService.OrganizationsGetSSOConfig")
 	if err != nil {
 		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
 	}
 
-	localVarPath := localBasePath + "/api/v1/organizations/{id}/invitations/{invitationId}"
+	localVarPath := localBasePath + "/api/v1/organizations/{id}/sso"
+	localVarPath = strings.Replace(localVarPath, "{"+"id"+"}", url.PathEscape(parameterValueToString(r.id, "id")), -1)
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+		var v GooglerpcStatus
+		err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+		if err != nil {
+			newErr.error = err.Error()
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+		newErr.model = v
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
+
+type ApiOrganizationsGetTwoFactorPolicyRequest struct {
+	ctx        context.Context
+	ApiService *OrganizationAPIService
+	id         string
+}
+
+func (r ApiOrganizationsGetTwoFactorPolicyRequest) Execute() (*OrganizationsGetTwoFactorPolicyResponse, *http.Response, error) {
+	return r.ApiService.OrganizationsGetTwoFactorPolicyExecute(r)
+}
+
+/*
+OrganizationsGetTwoFactorPolicy Get organization two-factor authentication policy
+
+Returns whether the organization requires two-factor authentication, and the two-factor status of its members
+
+	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+	@param id
+	@return ApiOrganizationsGetTwoFactorPolicyRequest
+*/
+func (a *OrganizationAPIService) OrganizationsGetTwoFactorPolicy(ctx context.Context, id string) ApiOrganizationsGetTwoFactorPolicyRequest {
+	return ApiOrganizationsGetTwoFactorPolicyRequest{
+		ApiService: a,
+		ctx:        ctx,
+		id:         id,
+	}
+}
+
+// Execute executes the request
+//
+//	@return OrganizationsGetTwoFactorPolicyResponse
+func (a *OrganizationAPIService) OrganizationsGetTwoFactorPolicyExecute(r ApiOrganizationsGetTwoFactorPolicyRequest) (*OrganizationsGetTwoFactorPolicyResponse, *http.Response, error) {
+	var (
+		localVarHTTPMethod  = http.MethodGet
+		localVarPostBody    interface{}
+		formFiles           []formFile
+		localVarReturnValue *OrganizationsGetTwoFactorPolicyResponse
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "OrganizationAPIService.OrganizationsGetTwoFactorPolicy")
+	if err != nil {
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/api/v1/organizations/{id}/two-factor"
+	localVarPath = strings.Replace(localVarPath, "{"+"id"+"}", url.PathEscape(parameterValueToString(r.id, "id")), -1)
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+		var v GooglerpcStatus
+		err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+		if err != nil {
+			newErr.error = err.Error()
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+		newErr.model = v
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
+
+type ApiOrganizationsListIntegrationResourcesRequest struct {
+	ctx           context.Context
+	ApiService    *OrganizationAPIService
+	id            string
+	integrationId string
+	parameters    *string
+}
+
+func (r ApiOrganizationsListIntegrationResourcesRequest) Parameters(parameters string) ApiOrganizationsListIntegrationResourcesRequest {
+	r.parameters = &parameters
+	return r
+}
+
+func (r ApiOrganizationsListIntegrationResourcesRequest) Execute() (*OrganizationsListIntegrationResourcesResponse, *http.Response, error) {
+	return r.ApiService.OrganizationsListIntegrationResourcesExecute(r)
+}
+
+/*
+OrganizationsListIntegrationResources List integration resources
+
+Lists resources for an integration
+
+	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+	@param id
+	@param integrationId
+	@return ApiOrganizationsListIntegrationResourcesRequest
+*/
+func (a *OrganizationAPIService) OrganizationsListIntegrationResources(ctx context.Context, id string, integrationId string) ApiOrganizationsListIntegrationResourcesRequest {
+	return ApiOrganizationsListIntegrationResourcesRequest{
+		ApiService:    a,
+		ctx:           ctx,
+		id:            id,
+		integrationId: integrationId,
+	}
+}
+
+// Execute executes the request
+//
+//	@return OrganizationsListIntegrationResourcesResponse
+func (a *OrganizationAPIService) OrganizationsListIntegrationResourcesExecute(r ApiOrganizationsListIntegrationResourcesRequest) (*OrganizationsListIntegrationResourcesResponse, *http.Response, error) {
+	var (
+		localVarHTTPMethod  = http.MethodGet
+		localVarPostBody    interface{}
+		formFiles           []formFile
+		localVarReturnValue *OrganizationsListIntegrationResourcesResponse
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "OrganizationAPIService.OrganizationsListIntegrationResources")
+	if err != nil {
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/api/v1/organizations/{id}/integrations/{integrationId}/resources"
+	localVarPath = strings.Replace(localVarPath, "{"+"id"+"}", url.PathEscape(parameterValueToString(r.id, "id")), -1)
+	localVarPath = strings.Replace(localVarPath, "{"+"integrationId"+"}", url.PathEscape(parameterValueToString(r.integrationId, "integrationId")), -1)
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+
+	if r.parameters != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "parameters", r.parameters, "", "")
+	}
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+		var v GooglerpcStatus
+		err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+		if err != nil {
+			newErr.error = err.Error()
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+		newErr.model = v
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
+
+type ApiOrganizationsListIntegrationsRequest struct {
+	ctx        context.Context
+	ApiService *OrganizationAPIService
+	id         string
+}
+
+func (r ApiOrganizationsListIntegrationsRequest) Execute() (*SuperplaneOrganizationsListIntegrationsResponse, *http.Response, error) {
+	return r.ApiService.OrganizationsListIntegrationsExecute(r)
+}
+
+/*
+OrganizationsListIntegrations List integrations in an organization
+
+Returns a list of integrations in an organization
+
+	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+	@param id
+	@return ApiOrganizationsListIntegrationsRequest
+*/
+func (a *OrganizationAPIService) OrganizationsListIntegrations(ctx context.Context, id string) ApiOrganizationsListIntegrationsRequest {
+	return ApiOrganizationsListIntegrationsRequest{
+		ApiService: a,
+		ctx:        ctx,
+		id:         id,
+	}
+}
+
+// Execute executes the request
+//
+//	@return SuperplaneOrganizationsListIntegrationsResponse
+func (a *OrganizationAPIService) OrganizationsListIntegrationsExecute(r ApiOrganizationsListIntegrationsRequest) (*SuperplaneOrganizationsListIntegrationsResponse, *http.Response, error) {
+	var (
+		localVarHTTPMethod  = http.MethodGet
+		localVarPostBody    interface{}
+		formFiles           []formFile
+		localVarReturnValue *SuperplaneOrganizationsListIntegrationsResponse
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "OrganizationAPIService.OrganizationsListIntegrations")
+	if err != nil {
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/api/v1/organizations/{id}/integrations"
+	localVarPath = strings.Replace(localVarPath, "{"+"id"+"}", url.PathEscape(parameterValueToString(r.id, "id")), -1)
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+		var v GooglerpcStatus
+		err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+		if err != nil {
+			newErr.error = err.Error()
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+		newErr.model = v
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
+
+type ApiOrganizationsListInvitationsRequest struct {
+	ctx        context.Context
+	ApiService *OrganizationAPIService
+	id         string
+}
+
+func (r ApiOrganizationsListInvitationsRequest) Execute() (*OrganizationsListInvitationsResponse, *http.Response, error) {
+	return r.ApiService.OrganizationsListInvitationsExecute(r)
+}
+
+/*
+OrganizationsListInvitations List organization invitations
+
+Returns pending invitations for an organization
+
+	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+	@param id
+	@return ApiOrganizationsListInvitationsRequest
+*/
+func (a *OrganizationAPIService) OrganizationsListInvitations(ctx context.Context, id string) ApiOrganizationsListInvitationsRequest {
+	return ApiOrganizationsListInvitationsRequest{
+		ApiService: a,
+		ctx:        ctx,
+		id:         id,
+	}
+}
+
+// Execute executes the request
+//
+//	@return OrganizationsListInvitationsResponse
+func (a *OrganizationAPIService) OrganizationsListInvitationsExecute(r ApiOrganizationsListInvitationsRequest) (*OrganizationsListInvitationsResponse, *http.Response, error) {
+	var (
+		localVarHTTPMethod  = http.MethodGet
+		localVarPostBody    interface{}
+		formFiles           []formFile
+		localVarReturnValue *OrganizationsListInvitationsResponse
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "OrganizationAPIService.OrganizationsListInvitations")
+	if err != nil {
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/api/v1/organizations/{id}/invitations"
+	localVarPath = strings.Replace(localVarPath, "{"+"id"+"}", url.PathEscape(parameterValueToString(r.id, "id")), -1)
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+		var v GooglerpcStatus
+		err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+		if err != nil {
+			newErr.error = err.Error()
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+		newErr.model = v
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
+
+type ApiOrganizationsListTwoFactorEventsRequest struct {
+	ctx        context.Context
+	ApiService *OrganizationAPIService
+	id         string
+	limit      *int64
+	before     *time.Time
+}
+
+func (r ApiOrganizationsListTwoFactorEventsRequest) Limit(limit int64) ApiOrganizationsListTwoFactorEventsRequest {
+	r.limit = &limit
+	return r
+}
+
+func (r ApiOrganizationsListTwoFactorEventsRequest) Before(before time.Time) ApiOrganizationsListTwoFactorEventsRequest {
+	r.before = &before
+	return r
+}
+
+func (r ApiOrganizationsListTwoFactorEventsRequest) Execute() (*OrganizationsListTwoFactorEventsResponse, *http.Response, error) {
+	return r.ApiService.OrganizationsListTwoFactorEventsExecute(r)
+}
+
+/*
+OrganizationsListTwoFactorEvents List two-factor authentication events
+
+Returns the two-factor authentication audit events of the organization members, newest first
+
+	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+	@param id
+	@return ApiOrganizationsListTwoFactorEventsRequest
+*/
+func (a *OrganizationAPIService) OrganizationsListTwoFactorEvents(ctx context.Context, id string) ApiOrganizationsListTwoFactorEventsRequest {
+	return ApiOrganizationsListTwoFactorEventsRequest{
+		ApiService: a,
+		ctx:        ctx,
+		id:         id,
+	}
+}
+
+// Execute executes the request
+//
+//	@return OrganizationsListTwoFactorEventsResponse
+func (a *OrganizationAPIService) OrganizationsListTwoFactorEventsExecute(r ApiOrganizationsListTwoFactorEventsRequest) (*OrganizationsListTwoFactorEventsResponse, *http.Response, error) {
+	var (
+		localVarHTTPMethod  = http.MethodGet
+		localVarPostBody    interface{}
+		formFiles           []formFile
+		localVarReturnValue *OrganizationsListTwoFactorEventsResponse
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "OrganizationAPIService.OrganizationsListTwoFactorEvents")
+	if err != nil {
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/api/v1/organizations/{id}/two-factor/events"
+	localVarPath = strings.Replace(localVarPath, "{"+"id"+"}", url.PathEscape(parameterValueToString(r.id, "id")), -1)
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+
+	if r.limit != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "limit", r.limit, "", "")
+	}
+	if r.before != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "before", r.before, "", "")
+	}
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+		var v GooglerpcStatus
+		err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+		if err != nil {
+			newErr.error = err.Error()
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+		newErr.model = v
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
+
+type ApiOrganizationsRemoveInvitationRequest struct {
+	ctx          context.Context
+	ApiService   *OrganizationAPIService
+	id           string
+	invitationId string
+}
+
+func (r ApiOrganizationsRemoveInvitationRequest) Execute() (map[string]interface{}, *http.Response, error) {
+	return r.ApiService.OrganizationsRemoveInvitationExecute(r)
+}
+
+/*
+OrganizationsRemoveInvitation Remove an organization invitation
+
+Removes an organization invitation
+
+	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+	@param id
+	@param invitationId
+	@return ApiOrganizationsRemoveInvitationRequest
+*/
+func (a *OrganizationAPIService) OrganizationsRemoveInvitation(ctx context.Context, id string, invitationId string) ApiOrganizationsRemoveInvitationRequest {
+	return ApiOrganizationsRemoveInvitationRequest{
+		ApiService:   a,
+		ctx:          ctx,
+		id:           id,
+		invitationId: invitationId,
+	}
+}
+
+// Execute executes the request
+//
+//	@return map[string]interface{}
+func (a *OrganizationAPIService) OrganizationsRemoveInvitationExecute(r ApiOrganizationsRemoveInvitationRequest) (map[string]interface{}, *http.Response, error) {
+	var (
+		localVarHTTPMethod  = http.MethodDelete
+		localVarPostBody    interface{}
+		formFiles           []formFile
+		localVarReturnValue map[string]interface{}
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "OrganizationAPIService.OrganizationsRemoveInvitation")
+	if err != nil {
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/api/v1/organizations/{id}/invitations/{invitationId}"
+	localVarPath = strings.Replace(localVarPath, "{"+"id"+"}", url.PathEscape(parameterValueToString(r.id, "id")), -1)
+	localVarPath = strings.Replace(localVarPath, "{"+"invitationId"+"}", url.PathEscape(parameterValueToString(r.invitationId, "invitationId")), -1)
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+		var v GooglerpcStatus
+		err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+		if err != nil {
+			newErr.error = err.Error()
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+		newErr.model = v
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
+
+type ApiOrganizationsRemoveUserRequest struct {
+	ctx        context.Context
+	ApiService *OrganizationAPIService
+	id         string
+	userId     string
+}
+
+func (r ApiOrganizationsRemoveUserRequest) Execute() (map[string]interface{}, *http.Response, error) {
+	return r.ApiService.OrganizationsRemoveUserExecute(r)
+}
+
+/*
+OrganizationsRemoveUser Remove a user from an organization
+
+Removes a user from an organization (can be referenced by ID or name)
+
+	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+	@param id
+	@param userId
+	@return ApiOrganizationsRemoveUserRequest
+*/
+func (a *OrganizationAPIService) OrganizationsRemoveUser(ctx context.Context, id string, userId string) ApiOrganizationsRemoveUserRequest {
+	return ApiOrganizationsRemoveUserRequest{
+		ApiService: a,
+		ctx:        ctx,
+		id:         id,
+		userId:     userId,
+	}
+}
+
+// Execute executes the request
+//
+//	@return map[string]interface{}
+func (a *OrganizationAPIService) OrganizationsRemoveUserExecute(r ApiOrganizationsRemoveUserRequest) (map[string]interface{}, *http.Response, error) {
+	var (
+		localVarHTTPMethod  = http.MethodDelete
+		localVarPostBody    interface{}
+		formFiles           []formFile
+		localVarReturnValue map[string]interface{}
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "OrganizationAPIService.OrganizationsRemoveUser")
+	if err != nil {
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/api/v1/organizations/{id}/users/{userId}"
+	localVarPath = strings.Replace(localVarPath, "{"+"id"+"}", url.PathEscape(parameterValueToString(r.id, "id")), -1)
+	localVarPath = strings.Replace(localVarPath, "{"+"userId"+"}", url.PathEscape(parameterValueToString(r.userId, "userId")), -1)
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+		var v GooglerpcStatus
+		err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+		if err != nil {
+			newErr.error = err.Error()
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+		newErr.model = v
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
+
+type ApiOrganizationsResetInviteLinkRequest struct {
+	ctx        context.Context
+	ApiService *OrganizationAPIService
+	id         string
+}
+
+func (r ApiOrganizationsResetInviteLinkRequest) Execute() (*OrganizationsResetInviteLinkResponse, *http.Response, error) {
+	return r.ApiService.OrganizationsResetInviteLinkExecute(r)
+}
+
+/*
+OrganizationsResetInviteLink Reset an organization invite link
+
+Generates a new invite link token for an organization
+
+	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+	@param id
+	@return ApiOrganizationsResetInviteLinkRequest
+*/
+func (a *OrganizationAPIService) OrganizationsResetInviteLink(ctx context.Context, id string) ApiOrganizationsResetInviteLinkRequest {
+	return ApiOrganizationsResetInviteLinkRequest{
+		ApiService: a,
+		ctx:        ctx,
+		id:         id,
+	}
+}
+
+// Execute executes the request
+//
+//	@return OrganizationsResetInviteLinkResponse
+func (a *OrganizationAPIService) OrganizationsResetInviteLinkExecute(r ApiOrganizationsResetInviteLinkRequest) (*OrganizationsResetInviteLinkResponse, *http.Response, error) {
+	var (
+		localVarHTTPMethod  = http.MethodPost
+		localVarPostBody    interface{}
+		formFiles           []formFile
+		localVarReturnValue *OrganizationsResetInviteLinkResponse
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "OrganizationAPIService.OrganizationsResetInviteLink")
+	if err != nil {
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/api/v1/organizations/{id}/invite-link/reset"
 	localVarPath = strings.Replace(localVarPath, "{"+"id"+"}", url.PathEscape(parameterValueToString(r.id, "id")), -1)
-	localVarPath = strings.Replace(localVarPath, "{"+"invitationId"+"}", url.PathEscape(parameterValueToString(r.invitationId, "invitationId")), -1)
 
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
@@ -1684,55 +2602,51 @@ func (a *OrganizationAPIService) OrganizationsRemoveInvitationExecute(r ApiOrgan
 	return localVarReturnValue, localVarHTTPResponse, nil
 }
 
-type ApiOrganizationsRemoveUserRequest struct {
+type ApiOrganizationsResetSCIMTokenRequest struct {
 	ctx        context.Context
 	ApiService *OrganizationAPIService
 	id         string
-	userId     string
 }
 
-func (r ApiOrganizationsRemoveUserRequest) Execute() (map[string]interface{}, *http.Response, error) {
-	return r.ApiService.OrganizationsRemoveUserExecute(r)
+func (r ApiOrganizationsResetSCIMTokenRequest) Execute() (*OrganizationsResetSCIMTokenResponse, *http.Response, error) {
+	return r.ApiService.OrganizationsResetSCIMTokenExecute(r)
 }
 
 /*
-OrganizationsRemoveUser Remove a user from an organization
+OrganizationsResetSCIMToken Reset organization SCIM token
 
-Removes a user from an organization (can be referenced by ID or name)
+Generates a new SCIM token for an organization, replacing the existing one
 
 	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
 	@param id
-	@param userId
-	@return ApiOrganizationsRemoveUserRequest
+	@return ApiOrganizationsResetSCIMTokenRequest
 */
-func (a *OrganizationAPIService) OrganizationsRemoveUser(ctx context.Context, id string, userId string) ApiOrganizationsRemoveUserRequest {
-	return ApiOrganizationsRemoveUserRequest{
+func (a *OrganizationAPIService) OrganizationsResetSCIMToken(ctx context.Context, id string) ApiOrganizationsResetSCIMTokenRequest {
+	return ApiOrganizationsResetSCIMTokenRequest{
 		ApiService: a,
 		ctx:        ctx,
 		id:         id,
-		userId:     userId,
 	}
 }
 
 // Execute executes the request
 //
-//	@return map[string]interface{}
-func (a *OrganizationAPIService) OrganizationsRemoveUserExecute(r ApiOrganizationsRemoveUserRequest) (map[string]interface{}, *http.Response, error) {
+//	@return OrganizationsResetSCIMTokenResponse
+func (a *OrganizationAPIService) OrganizationsResetSCIMTokenExecute(r ApiOrganizationsResetSCIMTokenRequest) (*OrganizationsResetSCIMTokenResponse, *http.Response, error) {
 	var (
-		localVarHTTPMethod  = http.MethodDelete
+		localVarHTTPMethod  = http.MethodPost
 		localVarPostBody    interface{}
 		formFiles           []formFile
-		localVarReturnValue map[string]interface{}
+		localVarReturnValue *OrganizationsResetSCIMTokenResponse
 	)
 
-	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "OrganizationAPIService.OrganizationsRemoveUser")
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "OrganizationAPIService.OrganizationsResetSCIMToken")
 	if err != nil {
 		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
 	}
 
-	localVarPath := localBasePath + "/api/v1/organizations/{id}/users/{userId}"
+	localVarPath := localBasePath + "/api/v1/organizations/{id}/scim/token"
 	localVarPath = strings.Replace(localVarPath, "{"+"id"+"}", url.PathEscape(parameterValueToString(r.id, "id")), -1)
-	localVarPath = strings.Replace(localVarPath, "{"+"userId"+"}", url.PathEscape(parameterValueToString(r.userId, "userId")), -1)
 
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
@@ -1800,51 +2714,55 @@ func (a *OrganizationAPIService) OrganizationsRemoveUserExecute(r ApiOrganizatio
 	return localVarReturnValue, localVarHTTPResponse, nil
 }
 
-type ApiOrganizationsResetInviteLinkRequest struct {
+type ApiOrganizationsResetUserTwoFactorRequest struct {
 	ctx        context.Context
 	ApiService *OrganizationAPIService
 	id         string
+	userId     string
 }
 
-func (r ApiOrganizationsResetInviteLinkRequest) Execute() (*OrganizationsResetInviteLinkResponse, *http.Response, error) {
-	return r.ApiService.OrganizationsResetInviteLinkExecute(r)
+func (r ApiOrganizationsResetUserTwoFactorRequest) Execute() (map[string]interface{}, *http.Response, error) {
+	return r.ApiService.OrganizationsResetUserTwoFactorExecute(r)
 }
 
 /*
-OrganizationsResetInviteLink Reset an organization invite link
+OrganizationsResetUserTwoFactor Reset a member's two-factor authentication
 
-Generates a new invite link token for an organization
+Removes the two-factor authentication of an organization member, so they can enroll again
 
 	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
 	@param id
-	@return ApiOrganizationsResetInviteLinkRequest
+	@param userId
+	@return ApiOrganizationsResetUserTwoFactorRequest
 */
-func (a *OrganizationAPIService) OrganizationsResetInviteLink(ctx context.Context, id string) ApiOrganizationsResetInviteLinkRequest {
-	return ApiOrganizationsResetInviteLinkRequest{
+func (a *OrganizationAPIService) OrganizationsResetUserTwoFactor(ctx context.Context, id string, userId string) ApiOrganizationsResetUserTwoFactorRequest {
+	return ApiOrganizationsResetUserTwoFactorRequest{
 		ApiService: a,
 		ctx:        ctx,
 		id:         id,
+		userId:     userId,
 	}
 }
 
 // Execute executes the request
 //
-//	@return OrganizationsResetInviteLinkResponse
-func (a *OrganizationAPIService) OrganizationsResetInviteLinkExecute(r ApiOrganizationsResetInviteLinkRequest) (*OrganizationsResetInviteLinkResponse, *http.Response, error) {
+//	@return map[string]interface{}
+func (a *OrganizationAPIService) OrganizationsResetUserTwoFactorExecute(r ApiOrganizationsResetUserTwoFactorRequest) (map[string]interface{}, *http.Response, error) {
 	var (
 		localVarHTTPMethod  = http.MethodPost
 		localVarPostBody    interface{}
 		formFiles           []formFile
-		localVarReturnValue *OrganizationsResetInviteLinkResponse
+		localVarReturnValue map[string]interface{}
 	)
 
-	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "OrganizationAPIService.OrganizationsResetInviteLink")
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "OrganizationAPIService.OrganizationsResetUserTwoFactor")
 	if err != nil {
 		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
 	}
 
-	localVarPath := localBasePath + "/api/v1/organizations/{id}/invite-link/reset"
+	localVarPath := localBasePath + "/api/v1/organizations/{id}/users/{userId}/two-factor/reset"
 	localVarPath = strings.Replace(localVarPath, "{"+"id"+"}", url.PathEscape(parameterValueToString(r.id, "id")), -1)
+	localVarPath = strings.Replace(localVarPath, "{"+"userId"+"}", url.PathEscape(parameterValueToString(r.userId, "userId")), -1)
 
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
@@ -2530,3 +3448,249 @@ func (a *OrganizationAPIService) OrganizationsUpdateOrganizationExecute(r ApiOrg
 
 	return localVarReturnValue, localVarHTTPResponse, nil
 }
+
+type ApiOrganizationsUpdateSSOConfigRequest struct {
+	ctx        context.Context
+	ApiService *OrganizationAPIService
+	id         string
+	body       *OrganizationsUpdateSSOConfigBody
+}
+
+func (r ApiOrganizationsUpdateSSOConfigRequest) Body(body OrganizationsUpdateSSOConfigBody) ApiOrganizationsUpdateSSOConfigRequest {
+	r.body = &body
+	return r
+}
+
+func (r ApiOrganizationsUpdateSSOConfigRequest) Execute() (*OrganizationsUpdateSSOConfigResponse, *http.Response, error) {
+	return r.ApiService.OrganizationsUpdateSSOConfigExecute(r)
+}
+
+/*
+OrganizationsUpdateSSOConfig Create or update organization single sign-on configuration
+
+Configures SAML 2.0 or OIDC single sign-on, group mappings and SSO enforcement for an organization
+
+	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+	@param id
+	@return ApiOrganizationsUpdateSSOConfigRequest
+*/
+func (a *OrganizationAPIService) OrganizationsUpdateSSOConfig(ctx context.Context, id string) ApiOrganizationsUpdateSSOConfigRequest {
+	return ApiOrganizationsUpdateSSOConfigRequest{
+		ApiService: a,
+		ctx:        ctx,
+		id:         id,
+	}
+}
+
+// Execute executes the request
+//
+//	@return OrganizationsUpdateSSOConfigResponse
+func (a *OrganizationAPIService) OrganizationsUpdateSSOConfigExecute(r ApiOrganizationsUpdateSSOConfigRequest) (*OrganizationsUpdateSSOConfigResponse, *http.Response, error) {
+	var (
+		localVarHTTPMethod  = http.MethodPut
+		localVarPostBody    interface{}
+		formFiles           []formFile
+		localVarReturnValue *OrganizationsUpdateSSOConfigResponse
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "OrganizationAPIService.OrganizationsUpdateSSOConfig")
+	if err != nil {
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/api/v1/organizations/{id}/sso"
+	localVarPath = strings.Replace(localVarPath, "{"+"id"+"}", url.PathEscape(parameterValueToString(r.id, "id")), -1)
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+	if r.body == nil {
+		return localVarReturnValue, nil, reportError("body is required and must be specified")
+	}
+
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{"application/json"}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	// body params
+	localVarPostBody = r.body
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+		var v GooglerpcStatus
+		err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+		if err != nil {
+			newErr.error = err.Error()
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+		newErr.model = v
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
+
+type ApiOrganizationsUpdateTwoFactorPolicyRequest struct {
+	ctx        context.Context
+	ApiService *OrganizationAPIService
+	id         string
+	body       *OrganizationsUpdateTwoFactorPolicyBody
+}
+
+func (r ApiOrganizationsUpdateTwoFactorPolicyRequest) Body(body OrganizationsUpdateTwoFactorPolicyBody) ApiOrganizationsUpdateTwoFactorPolicyRequest {
+	r.body = &body
+	return r
+}
+
+func (r ApiOrganizationsUpdateTwoFactorPolicyRequest) Execute() (*OrganizationsUpdateTwoFactorPolicyResponse, *http.Response, error) {
+	return r.ApiService.OrganizationsUpdateTwoFactorPolicyExecute(r)
+}
+
+/*
+OrganizationsUpdateTwoFactorPolicy Update organization two-factor authentication policy
+
+Requires, or stops requiring, two-factor authentication for members who sign in with a password
+
+	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+	@param id
+	@return ApiOrganizationsUpdateTwoFactorPolicyRequest
+*/
+func (a *OrganizationAPIService) OrganizationsUpdateTwoFactorPolicy(ctx context.Context, id string) ApiOrganizationsUpdateTwoFactorPolicyRequest {
+	return ApiOrganizationsUpdateTwoFactorPolicyRequest{
+		ApiService: a,
+		ctx:        ctx,
+		id:         id,
+	}
+}
+
+// Execute executes the request
+//
+//	@return OrganizationsUpdateTwoFactorPolicyResponse
+func (a *OrganizationAPIService) OrganizationsUpdateTwoFactorPolicyExecute(r ApiOrganizationsUpdateTwoFactorPolicyRequest) (*OrganizationsUpdateTwoFactorPolicyResponse, *http.Response, error) {
+	var (
+		localVarHTTPMethod  = http.MethodPut
+		localVarPostBody    interface{}
+		formFiles           []formFile
+		localVarReturnValue *OrganizationsUpdateTwoFactorPolicyResponse
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "OrganizationAPIService.OrganizationsUpdateTwoFactorPolicy")
+	if err != nil {
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/api/v1/organizations/{id}/two-factor"
+	localVarPath = strings.Replace(localVarPath, "{"+"id"+"}", url.PathEscape(parameterValueToString(r.id, "id")), -1)
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+	if r.body == nil {
+		return localVarReturnValue, nil, reportError("body is required and must be specified")
+	}
+
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{"application/json"}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	// body params
+	localVarPostBody = r.body
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+		var v GooglerpcStatus
+		err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+		if err != nil {
+			newErr.error = err.Error()
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+		newErr.model = v
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
@@ -75,6 +75,10 @@ type APIClient struct {
 
 	ServiceAccountsAPI *ServiceAccountsAPIService
 
+	SubscriptionsAPI *SubscriptionsAPIService
+
+	TokensAPI *TokensAPIService
+
 	TriggerAPI *TriggerAPIService
 
 	UsersAPI *UsersAPIService
@@ -111,6 +115,8 @@ func NewAPIClient(cfg *Configuration) *APIClient {
 	c.RolesAPI = (*RolesAPIService)(&c.common)
 	c.SecretAPI = (*SecretAPIService)(&c.common)
 	c.ServiceAccountsAPI = (*ServiceAccountsAPIService)(&c.common)
+	c.SubscriptionsAPI = (*SubscriptionsAPIService)(&c.common)
+	c.TokensAPI = (*TokensAPIService)(&c.common)
 	c.TriggerAPI = (*TriggerAPIService)(&c.common)
 	c.UsersAPI = (*UsersAPIService)(&c.common)
 	c.WidgetAPI = (*WidgetAPIService)(&c.common)
@@ -21,6 +21,10 @@ var _ MappedNullable = &CanvasesListEventExecutionsResponse{}
 // CanvasesListEventExecutionsResponse struct for CanvasesListEventExecutionsResponse
 type CanvasesListEventExecutionsResponse struct {
 	Executions []CanvasesCanvasNodeExecution `json:"executions,omitempty"`
+	// True once the chain started by the event is over:
+	// every event of the chain was routed, no queue item is left,
+	// and every execution finished.
+	Finished *bool `json:"finished,omitempty"`
 }
 
 // NewCanvasesListEventExecutionsResponse instantiates a new CanvasesListEventExecutionsResponse object
@@ -72,6 +76,38 @@ func (o *CanvasesListEventExecutionsResponse) SetExecutions(v []CanvasesCanvasNo
 	o.Executions = v
 }
 
+// GetFinished returns the Finished field value if set, zero value otherwise.
+func (o *CanvasesListEventExecutionsResponse) GetFinished() bool {
+	if o == nil || IsNil(o.Finished) {
+		var ret bool
+		return ret
+	}
+	return *o.Finished
+}
+
+// GetFinishedOk returns a tuple with the Finished field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *CanvasesListEventExecutionsResponse) GetFinishedOk() (*bool, bool) {
+	if o == nil || IsNil(o.Finished) {
+		return nil, false
+	}
+	return o.Finished, true
+}
+
+// HasFinished returns a boolean if a field has been set.
+func (o *CanvasesListEventExecutionsResponse) HasFinished() bool {
+	if o != nil && !IsNil(o.Finished) {
+		return true
+	}
+
+	return false
+}
+
+// SetFinished gets a reference to the given bool and assigns it to the Finished field.
+func (o *CanvasesListEventExecutionsResponse) SetFinished(v bool) {
+	o.Finished = &v
+}
+
 func (o CanvasesListEventExecutionsResponse) MarshalJSON() ([]byte, error) {
 	toSerialize, err := o.ToMap()
 	if err != nil {
@@ -85,6 +121,9 @@ func (o CanvasesListEventExecutionsResponse) ToMap() (map[string]interface{}, er
 	if !IsNil(o.Executions) {
 		toSerialize["executions"] = o.Executions
 	}
+	if !IsNil(o.Finished) {
+		toSerialize["finished"] = o.Finished
+	}
 	return toSerialize, nil
 }
 
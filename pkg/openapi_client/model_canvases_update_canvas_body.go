@@ -22,6 +22,7 @@ var _ MappedNullable = &CanvasesUpdateCanvasBody{}
 type CanvasesUpdateCanvasBody struct {
 	Canvas     *CanvasesCanvas           `json:"canvas,omitempty"`
 	AutoLayout *CanvasesCanvasAutoLayout `json:"autoLayout,omitempty"`
+	DryRun     *bool                     `json:"dryRun,omitempty"`
 }
 
 // NewCanvasesUpdateCanvasBody instantiates a new CanvasesUpdateCanvasBody object
@@ -105,6 +106,38 @@ func (o *CanvasesUpdateCanvasBody) SetAutoLayout(v CanvasesCanvasAutoLayout) {
 	o.AutoLayout = &v
 }
 
+// GetDryRun returns the DryRun field value if set, zero value otherwise.
+func (o *CanvasesUpdateCanvasBody) GetDryRun() bool {
+	if o == nil || IsNil(o.DryRun) {
+		var ret bool
+		return ret
+	}
+	return *o.DryRun
+}
+
+// GetDryRunOk returns a tuple with the DryRun field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *CanvasesUpdateCanvasBody) GetDryRunOk() (*bool, bool) {
+	if o == nil || IsNil(o.DryRun) {
+		return nil, false
+	}
+	return o.DryRun, true
+}
+
+// HasDryRun returns a boolean if a field has been set.
+func (o *CanvasesUpdateCanvasBody) HasDryRun() bool {
+	if o != nil && !IsNil(o.DryRun) {
+		return true
+	}
+
+	return false
+}
+
+// SetDryRun gets a reference to the given bool and assigns it to the DryRun field.
+func (o *CanvasesUpdateCanvasBody) SetDryRun(v bool) {
+	o.DryRun = &v
+}
+
 func (o CanvasesUpdateCanvasBody) MarshalJSON() ([]byte, error) {
 	toSerialize, err := o.ToMap()
 	if err != nil {
@@ -121,6 +154,9 @@ func (o CanvasesUpdateCanvasBody) ToMap() (map[string]interface{}, error) {
 	if !IsNil(o.AutoLayout) {
 		toSerialize["autoLayout"] = o.AutoLayout
 	}
+	if !IsNil(o.DryRun) {
+		toSerialize["dryRun"] = o.DryRun
+	}
 	return toSerialize, nil
 }
 
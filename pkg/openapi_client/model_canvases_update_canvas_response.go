@@ -20,7 +20,8 @@ var _ MappedNullable = &CanvasesUpdateCanvasResponse{}
 
 // CanvasesUpdateCanvasResponse struct for CanvasesUpdateCanvasResponse
 type CanvasesUpdateCanvasResponse struct {
-	Canvas *CanvasesCanvas `json:"canvas,omitempty"`
+	Canvas *CanvasesCanvas     `json:"canvas,omitempty"`
+	Plan   *CanvasesCanvasPlan `json:"plan,omitempty"`
 }
 
 // NewCanvasesUpdateCanvasResponse instantiates a new CanvasesUpdateCanvasResponse object
@@ -72,6 +73,38 @@ func (o *CanvasesUpdateCanvasResponse) SetCanvas(v CanvasesCanvas) {
 	o.Canvas = &v
 }
 
+// GetPlan returns the Plan field value if set, zero value otherwise.
+func (o *CanvasesUpdateCanvasResponse) GetPlan() CanvasesCanvasPlan {
+	if o == nil || IsNil(o.Plan) {
+		var ret CanvasesCanvasPlan
+		return ret
+	}
+	return *o.Plan
+}
+
+// GetPlanOk returns a tuple with the Plan field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *CanvasesUpdateCanvasResponse) GetPlanOk() (*CanvasesCanvasPlan, bool) {
+	if o == nil || IsNil(o.Plan) {
+		return nil, false
+	}
+	return o.Plan, true
+}
+
+// HasPlan returns a boolean if a field has been set.
+func (o *CanvasesUpdateCanvasResponse) HasPlan() bool {
+	if o != nil && !IsNil(o.Plan) {
+		return true
+	}
+
+	return false
+}
+
+// SetPlan gets a reference to the given CanvasesCanvasPlan and assigns it to the Plan field.
+func (o *CanvasesUpdateCanvasResponse) SetPlan(v CanvasesCanvasPlan) {
+	o.Plan = &v
+}
+
 func (o CanvasesUpdateCanvasResponse) MarshalJSON() ([]byte, error) {
 	toSerialize, err := o.ToMap()
 	if err != nil {
@@ -85,6 +118,9 @@ func (o CanvasesUpdateCanvasResponse) ToMap() (map[string]interface{}, error) {
 	if !IsNil(o.Canvas) {
 		toSerialize["canvas"] = o.Canvas
 	}
+	if !IsNil(o.Plan) {
+		toSerialize["plan"] = o.Plan
+	}
 	return toSerialize, nil
 }
 
@@ -20,21 +20,22 @@ var _ MappedNullable = &ComponentsNode{}
 
 // ComponentsNode struct for ComponentsNode
 type ComponentsNode struct {
-	Id             *string                   `json:"id,omitempty"`
-	Name           *string                   `json:"name,omitempty"`
-	Type           *ComponentsNodeType       `json:"type,omitempty"`
-	Configuration  map[string]interface{}    `json:"configuration,omitempty"`
-	Metadata       map[string]interface{}    `json:"metadata,omitempty"`
-	Position       *ComponentsPosition       `json:"position,omitempty"`
-	Component      *NodeComponentRef         `json:"component,omitempty"`
-	Blueprint      *NodeBlueprintRef         `json:"blueprint,omitempty"`
-	Trigger        *NodeTriggerRef           `json:"trigger,omitempty"`
-	Widget         *NodeWidgetRef            `json:"widget,omitempty"`
-	IsCollapsed    *bool                     `json:"isCollapsed,omitempty"`
-	Integration    *ComponentsIntegrationRef `json:"integration,omitempty"`
-	ErrorMessage   *string                   `json:"errorMessage,omitempty"`
-	WarningMessage *string                   `json:"warningMessage,omitempty"`
-	Paused         *bool                     `json:"paused,omitempty"`
+	Id                  *string                   `json:"id,omitempty"`
+	Name                *string                   `json:"name,omitempty"`
+	Type                *ComponentsNodeType       `json:"type,omitempty"`
+	Configuration       map[string]interface{}    `json:"configuration,omitempty"`
+	Metadata            map[string]interface{}    `json:"metadata,omitempty"`
+	Position            *ComponentsPosition       `json:"position,omitempty"`
+	Component           *NodeComponentRef         `json:"component,omitempty"`
+	Blueprint           *NodeBlueprintRef         `json:"blueprint,omitempty"`
+	Trigger             *NodeTriggerRef           `json:"trigger,omitempty"`
+	Widget              *NodeWidgetRef            `json:"widget,omitempty"`
+	IsCollapsed         *bool                     `json:"isCollapsed,omitempty"`
+	Integration         *ComponentsIntegrationRef `json:"integration,omitempty"`
+	ErrorMessage        *string                   `json:"errorMessage,omitempty"`
+	WarningMessage      *string                   `json:"warningMessage,omitempty"`
+	Paused              *bool                     `json:"paused,omitempty"`
+	ErrorChannelEnabled *bool                     `json:"errorChannelEnabled,omitempty"`
 }
 
 // NewComponentsNode instantiates a new ComponentsNode object
@@ -538,6 +539,38 @@ func (o *ComponentsNode) SetPaused(v bool) {
 	o.Paused = &v
 }
 
+// GetErrorChannelEnabled returns the ErrorChannelEnabled field value if set, zero value otherwise.
+func (o *ComponentsNode) GetErrorChannelEnabled() bool {
+	if o == nil || IsNil(o.ErrorChannelEnabled) {
+		var ret bool
+		return ret
+	}
+	return *o.ErrorChannelEnabled
+}
+
+// GetErrorChannelEnabledOk returns a tuple with the ErrorChannelEnabled field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *ComponentsNode) GetErrorChannelEnabledOk() (*bool, bool) {
+	if o == nil || IsNil(o.ErrorChannelEnabled) {
+		return nil, false
+	}
+	return o.ErrorChannelEnabled, true
+}
+
+// HasErrorChannelEnabled returns a boolean if a field has been set.
+func (o *ComponentsNode) HasErrorChannelEnabled() bool {
+	if o != nil && !IsNil(o.ErrorChannelEnabled) {
+		return true
+	}
+
+	return false
+}
+
+// SetErrorChannelEnabled gets a reference to the given bool and assigns it to the ErrorChannelEnabled field.
+func (o *ComponentsNode) SetErrorChannelEnabled(v bool) {
+	o.ErrorChannelEnabled = &v
+}
+
 func (o ComponentsNode) MarshalJSON() ([]byte, error) {
 	toSerialize, err := o.ToMap()
 	if err != nil {
@@ -593,6 +626,9 @@ func (o ComponentsNode) ToMap() (map[string]interface{}, error) {
 	if !IsNil(o.Paused) {
 		toSerialize["paused"] = o.Paused
 	}
+	if !IsNil(o.ErrorChannelEnabled) {
+		toSerialize["errorChannelEnabled"] = o.ErrorChannelEnabled
+	}
 	return toSerialize, nil
 }
 
@@ -0,0 +1,332 @@
+package plugins
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/superplanehq/superplane/pkg/core"
+	pb "github.com/superplanehq/superplane/pkg/protos/plugins"
+)
+
+//
+// callbacks answers the callbacks of a plugin during an invocation,
+// using the core contexts given to the component or trigger.
+// Contexts that were not given are nil, and callbacks using them fail.
+//
+
+type callbacks struct {
+	metadata       core.MetadataContext
+	nodeMetadata   core.MetadataContext
+	executionState core.ExecutionStateContext
+	logs           core.ExecutionLogContext
+	artifacts      core.ArtifactContext
+	http           core.HTTPContext
+	secrets        core.SecretsContext
+	requests       core.RequestContext
+	events         core.EventContext
+	notifications  core.NotificationContext
+	webhook        core.NodeWebhookContext
+	auth           core.AuthContext
+}
+
+func (c *callbacks) available() []string {
+	contexts := []string{}
+	add := func(name string, available bool) {
+		if available {
+			contexts = append(contexts, name)
+		}
+	}
+
+	add(ContextMetadata, c.metadata != nil)
+	add(ContextNodeMetadata, c.nodeMetadata != nil)
+	add(ContextExecutionState, c.executionState != nil)
+	add(ContextLogs, c.logs != nil)
+	add(ContextArtifacts, c.artifacts != nil)
+	add(ContextHTTP, c.http != nil)
+	add(ContextSecrets, c.secrets != nil)
+	add(ContextRequests, c.requests != nil)
+	add(ContextEvents, c.events != nil)
+	add(ContextNotifications, c.notifications != nil)
+	add(ContextWebhook, c.webhook != nil)
+	add(ContextAuth, c.auth != nil)
+	return contexts
+}
+
+func (c *callbacks) handle(callback *pb.Callback) *pb.CallbackResponse {
+	response, err := c.dispatch(callback)
+	if response == nil {
+		response = &pb.CallbackResponse{}
+	}
+
+	response.Id = callback.Id
+	if err != nil {
+		response.Error = err.Error()
+	}
+
+	return response
+}
+
+func (c *callbacks) dispatch(callback *pb.Callback) (*pb.CallbackResponse, error) {
+	switch r := callback.Request.(type) {
+	case *pb.Callback_GetMetadata:
+		metadata, err := c.metadataContext(r.GetMetadata.Field)
+		if err != nil {
+			return nil, err
+		}
+
+		return jsonResponse(metadata.Get())
+
+	case *pb.Callback_SetMetadata:
+		metadata, err := c.metadataContext(r.SetMetadata.Field)
+		if err != nil {
+			return nil, err
+		}
+
+		value, err := DecodeJSON(r.SetMetadata.Value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid metadata: %w", err)
+		}
+
+		return nil, metadata.Set(value)
+
+	case *pb.Callback_IsFinished:
+		if c.executionState == nil {
+			return nil, unavailable(ContextExecutionState)
+		}
+
+		return jsonResponse(c.executionState.IsFinished())
+
+	case *pb.Callback_SetKv:
+		if c.executionState == nil {
+			return nil, unavailable(ContextExecutionState)
+		}
+
+		return nil, c.executionState.SetKV(r.SetKv.Key, r.SetKv.Value)
+
+	case *pb.Callback_EmitOutput:
+		if c.executionState == nil {
+			return nil, unavailable(ContextExecutionState)
+		}
+
+		payloads := []any{}
+		if len(r.EmitOutput.Payloads) > 0 {
+			value, err := DecodeJSON(r.EmitOutput.Payloads)
+			if err != nil {
+				return nil, fmt.Errorf("invalid payloads: %w", err)
+			}
+
+			list, ok := value.([]any)
+			if !ok {
+				return nil, fmt.Errorf("payloads must be a list")
+			}
+
+			payloads = list
+		}
+
+		return nil, c.executionState.Emit(r.EmitOutput.Channel, r.EmitOutput.PayloadType, payloads)
+
+	case *pb.Callback_Pass:
+		if c.executionState == nil {
+			return nil, unavailable(ContextExecutionState)
+		}
+
+		return nil, c.executionState.Pass()
+
+	case *pb.Callback_Fail:
+		if c.executionState == nil {
+			return nil, unavailable(ContextExecutionState)
+		}
+
+		return nil, c.executionState.Fail(r.Fail.Reason, r.Fail.Message)
+
+	case *pb.Callback_WriteLog:
+		if c.logs == nil {
+			return nil, unavailable(ContextLogs)
+		}
+
+		if r.WriteLog.Stream == pb.LogStream_LOG_STREAM_STDERR {
+			return nil, c.logs.Stderr(r.WriteLog.Message)
+		}
+
+		return nil, c.logs.Stdout(r.WriteLog.Message)
+
+	case *pb.Callback_PutArtifact:
+		if c.artifacts == nil {
+			return nil, unavailable(ContextArtifacts)
+		}
+
+		artifact, err := c.artifacts.Put(r.PutArtifact.Name, r.PutArtifact.ContentType, r.PutArtifact.Content)
+		if err != nil {
+			return nil, err
+		}
+
+		return jsonResponse(artifact)
+
+	case *pb.Callback_HttpRequest:
+		return c.doHTTP(r.HttpRequest)
+
+	case *pb.Callback_GetSecretKey:
+		if c.secrets == nil {
+			return nil, unavailable(ContextSecrets)
+		}
+
+		value, err := c.secrets.GetKey(r.GetSecretKey.Secret, r.GetSecretKey.Key)
+		if errors.Is(err, core.ErrSecretKeyNotFound) {
+			return &pb.CallbackResponse{NotFound: true}, nil
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		return &pb.CallbackResponse{Value: value}, nil
+
+	case *pb.Callback_ScheduleActionCall:
+		if c.requests == nil {
+			return nil, unavailable(ContextRequests)
+		}
+
+		parameters, err := DecodeJSONMap(r.ScheduleActionCall.Parameters)
+		if err != nil {
+			return nil, fmt.Errorf("invalid parameters: %w", err)
+		}
+
+		interval := time.Duration(r.ScheduleActionCall.IntervalSeconds) * time.Second
+		return nil, c.requests.ScheduleActionCall(r.ScheduleActionCall.Action, parameters, interval)
+
+	case *pb.Callback_EmitEvent:
+		if c.events == nil {
+			return nil, unavailable(ContextEvents)
+		}
+
+		payload, err := DecodeJSON(r.EmitEvent.Payload)
+		if err != nil {
+			return nil, fmt.Errorf("invalid payload: %w", err)
+		}
+
+		return nil, c.events.Emit(r.EmitEvent.PayloadType, payload)
+
+	case *pb.Callback_SendNotification:
+		if c.notifications == nil {
+			return nil, unavailable(ContextNotifications)
+		}
+
+		n := r.SendNotification
+		return nil, c.notifications.Send(n.Title, n.Body, n.Url, n.UrlLabel, core.NotificationReceivers{
+			Emails: n.Emails,
+			Groups: n.Groups,
+			Roles:  n.Roles,
+		})
+
+	case *pb.Callback_SetupWebhook:
+		if c.webhook == nil {
+			return nil, unavailable(ContextWebhook)
+		}
+
+		URL, err := c.webhook.Setup()
+		if err != nil {
+			return nil, err
+		}
+
+		return &pb.CallbackResponse{Value: []byte(URL)}, nil
+
+	case *pb.Callback_GetWebhookSecret:
+		if c.webhook == nil {
+			return nil, unavailable(ContextWebhook)
+		}
+
+		secret, err := c.webhook.GetSecret()
+		if err != nil {
+			return nil, err
+		}
+
+		return &pb.CallbackResponse{Value: secret}, nil
+
+	case *pb.Callback_SetWebhookSecret:
+		if c.webhook == nil {
+			return nil, unavailable(ContextWebhook)
+		}
+
+		return nil, c.webhook.SetSecret(r.SetWebhookSecret.Secret)
+
+	default:
+		return nil, fmt.Errorf("unknown callback")
+	}
+}
+
+func (c *callbacks) metadataContext(field pb.MetadataField) (core.MetadataContext, error) {
+	switch field {
+	case pb.MetadataField_METADATA_FIELD_METADATA:
+		if c.metadata == nil {
+			return nil, unavailable(ContextMetadata)
+		}
+
+		return c.metadata, nil
+
+	case pb.MetadataField_METADATA_FIELD_NODE_METADATA:
+		if c.nodeMetadata == nil {
+			return nil, unavailable(ContextNodeMetadata)
+		}
+
+		return c.nodeMetadata, nil
+
+	default:
+		return nil, fmt.Errorf("unknown metadata field %s", field)
+	}
+}
+
+//
+// HTTP requests of plugins go through the HTTP context of SuperPlane,
+// so they are subject to the same restrictions as the ones
+// from built-in components, like blocked hosts and response size limits.
+//
+
+func (c *callbacks) doHTTP(request *pb.HTTPRequest) (*pb.CallbackResponse, error) {
+	if c.http == nil {
+		return nil, unavailable(ContextHTTP)
+	}
+
+	req, err := http.NewRequest(request.Method, request.Url, bytes.NewReader(request.Body))
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header = DecodeHeaders(request.Headers)
+
+	res, err := c.http.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	defer res.Body.Close()
+
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.CallbackResponse{
+		HttpResponse: &pb.HTTPResponse{
+			StatusCode: int32(res.StatusCode),
+			Headers:    EncodeHeaders(res.Header),
+			Body:       body,
+		},
+	}, nil
+}
+
+func jsonResponse(value any) (*pb.CallbackResponse, error) {
+	data, err := EncodeJSON(value)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.CallbackResponse{Value: data}, nil
+}
+
+func unavailable(context string) error {
+	return fmt.Errorf("%s context is not available", context)
+}
@@ -0,0 +1,149 @@
+package plugins
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/superplanehq/superplane/pkg/configuration"
+	"github.com/superplanehq/superplane/pkg/core"
+	pb "github.com/superplanehq/superplane/pkg/protos/plugins"
+)
+
+//
+// The helpers below convert between the core types
+// and their representation in the plugin protocol.
+// They are used by both SuperPlane and the plugin SDK.
+//
+
+func EncodeJSON(value any) ([]byte, error) {
+	if value == nil {
+		return nil, nil
+	}
+
+	return json.Marshal(value)
+}
+
+func DecodeJSON(data []byte) (any, error) {
+	if len(data) == 0 {
+		return nil, nil
+	}
+
+	var value any
+	err := json.Unmarshal(data, &value)
+	if err != nil {
+		return nil, err
+	}
+
+	return value, nil
+}
+
+func DecodeJSONMap(data []byte) (map[string]any, error) {
+	if len(data) == 0 {
+		return nil, nil
+	}
+
+	var value map[string]any
+	err := json.Unmarshal(data, &value)
+	if err != nil {
+		return nil, err
+	}
+
+	return value, nil
+}
+
+func EncodeHeaders(headers http.Header) map[string]*pb.Header {
+	encoded := make(map[string]*pb.Header, len(headers))
+	for name, values := range headers {
+		encoded[name] = &pb.Header{Values: values}
+	}
+
+	return encoded
+}
+
+func DecodeHeaders(headers map[string]*pb.Header) http.Header {
+	decoded := make(http.Header, len(headers))
+	for name, header := range headers {
+		decoded[name] = header.GetValues()
+	}
+
+	return decoded
+}
+
+func EncodeOutputChannels(channels []core.OutputChannel) []*pb.OutputChannel {
+	encoded := make([]*pb.OutputChannel, 0, len(channels))
+	for _, channel := range channels {
+		encoded = append(encoded, &pb.OutputChannel{
+			Name:        channel.Name,
+			Label:       channel.Label,
+			Description: channel.Description,
+		})
+	}
+
+	return encoded
+}
+
+func DecodeOutputChannels(channels []*pb.OutputChannel) []core.OutputChannel {
+	decoded := make([]core.OutputChannel, 0, len(channels))
+	for _, channel := range channels {
+		decoded = append(decoded, core.OutputChannel{
+			Name:        channel.Name,
+			Label:       channel.Label,
+			Description: channel.Description,
+		})
+	}
+
+	return decoded
+}
+
+func EncodeActions(actions []core.Action) ([]*pb.Action, error) {
+	encoded := make([]*pb.Action, 0, len(actions))
+	for _, action := range actions {
+		parameters, err := EncodeJSON(action.Parameters)
+		if err != nil {
+			return nil, fmt.Errorf("error encoding parameters of action %s: %w", action.Name, err)
+		}
+
+		encoded = append(encoded, &pb.Action{
+			Name:           action.Name,
+			Description:    action.Description,
+			UserAccessible: action.UserAccessible,
+			Parameters:     parameters,
+		})
+	}
+
+	return encoded, nil
+}
+
+func DecodeActions(actions []*pb.Action) ([]core.Action, error) {
+	decoded := make([]core.Action, 0, len(actions))
+	for _, action := range actions {
+		parameters, err := DecodeFields(action.Parameters)
+		if err != nil {
+			return nil, fmt.Errorf("error decoding parameters of action %s: %w", action.Name, err)
+		}
+
+		decoded = append(decoded, core.Action{
+			Name:           action.Name,
+			Description:    action.Description,
+			UserAccessible: action.UserAccessible,
+			Parameters:     parameters,
+		})
+	}
+
+	return decoded, nil
+}
+
+func DecodeFields(data []byte) ([]configuration.Field, error) {
+	fields := []configuration.Field{}
+	if len(data) == 0 {
+		return fields, nil
+	}
+
+	err := json.Unmarshal(data, &fields)
+	if err != nil {
+		return nil, err
+	}
+
+	return fields, nil
+}
@@ -0,0 +1,300 @@
+package plugins
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/superplanehq/superplane/pkg/configuration"
+	"github.com/superplanehq/superplane/pkg/core"
+	pb "github.com/superplanehq/superplane/pkg/protos/plugins"
+)
+
+//
+// Component is a component provided by a plugin.
+//
+
+type Component struct {
+	plugin         *Plugin
+	definition     *pb.ComponentDefinition
+	configuration  []configuration.Field
+	exampleOutput  map[string]any
+	outputChannels []core.OutputChannel
+	actions        []core.Action
+}
+
+func newComponent(plugin *Plugin, definition *pb.ComponentDefinition) (*Component, error) {
+	fields, err := DecodeFields(definition.Configuration)
+	if err != nil {
+		return nil, fmt.Errorf("invalid configuration: %w", err)
+	}
+
+	exampleOutput, err := DecodeJSONMap(definition.ExampleOutput)
+	if err != nil {
+		return nil, fmt.Errorf("invalid example output: %w", err)
+	}
+
+	actions, err := DecodeActions(definition.Actions)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Component{
+		plugin:         plugin,
+		definition:     definition,
+		configuration:  fields,
+		exampleOutput:  exampleOutput,
+		outputChannels: DecodeOutputChannels(definition.OutputChannels),
+		actions:        actions,
+	}, nil
+}
+
+func (c *Component) Name() string {
+	return c.definition.Name
+}
+
+func (c *Component) Label() string {
+	return c.definition.Label
+}
+
+func (c *Component) Description() string {
+	return c.definition.Description
+}
+
+func (c *Component) Documentation() string {
+	return c.definition.Documentation
+}
+
+func (c *Component) Icon() string {
+	return c.definition.Icon
+}
+
+func (c *Component) Color() string {
+	return c.definition.Color
+}
+
+func (c *Component) ExampleOutput() map[string]any {
+	return c.exampleOutput
+}
+
+func (c *Component) Configuration() []configuration.Field {
+	return c.configuration
+}
+
+func (c *Component) Actions() []core.Action {
+	return c.actions
+}
+
+//
+// The output channels given in the definition are used when there is
+// no configuration, or if the plugin can't be reached.
+//
+
+func (c *Component) OutputChannels(config any) []core.OutputChannel {
+	if config == nil || !c.definition.DynamicOutputChannels || !c.plugin.HasCapability(CapabilityDynamicOutputChannels) || !c.plugin.Available() {
+		return c.outputChannels
+	}
+
+	data, err := EncodeJSON(config)
+	if err != nil {
+		return c.outputChannels
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), DefaultQueryTimeout)
+	defer cancel()
+
+	response, err := c.plugin.client.OutputChannels(ctx, &pb.OutputChannelsRequest{
+		Component:     c.Name(),
+		Configuration: data,
+	})
+
+	if err != nil {
+		c.plugin.logger.Errorf("Error getting output channels of %s: %v", c.Name(), err)
+		return c.outputChannels
+	}
+
+	return DecodeOutputChannels(response.OutputChannels)
+}
+
+func (c *Component) Setup(ctx core.SetupContext) error {
+	_, err := c.invoke(pb.Method_METHOD_SETUP, ctx.Configuration, &pb.Invocation{}, &callbacks{
+		metadata: ctx.Metadata,
+		http:     ctx.HTTP,
+		requests: ctx.Requests,
+		webhook:  ctx.Webhook,
+		auth:     ctx.Auth,
+	}, authenticatedUser(ctx.Auth), webhookBaseURL(ctx.Webhook))
+
+	return err
+}
+
+func (c *Component) ProcessQueueItem(ctx core.ProcessQueueContext) (*uuid.UUID, error) {
+	return ctx.DefaultProcessing()
+}
+
+func (c *Component) Execute(ctx core.ExecutionContext) error {
+	invocation, err := executionInvocation(ctx)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.invoke(pb.Method_METHOD_EXECUTE, ctx.Configuration, invocation, executionCallbacks(ctx), authenticatedUser(ctx.Auth), webhookBaseURL(ctx.Webhook))
+	return err
+}
+
+func (c *Component) Cancel(ctx core.ExecutionContext) error {
+	invocation, err := executionInvocation(ctx)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.invoke(pb.Method_METHOD_CANCEL, ctx.Configuration, invocation, executionCallbacks(ctx), authenticatedUser(ctx.Auth), webhookBaseURL(ctx.Webhook))
+	return err
+}
+
+func (c *Component) HandleAction(ctx core.ActionContext) error {
+	parameters, err := EncodeJSON(ctx.Parameters)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.invoke(pb.Method_METHOD_HANDLE_ACTION, ctx.Configuration, &pb.Invocation{
+		Action:     ctx.Name,
+		Parameters: parameters,
+	}, &callbacks{
+		metadata:       ctx.Metadata,
+		executionState: ctx.ExecutionState,
+		logs:           ctx.Logs,
+		artifacts:      ctx.Artifacts,
+		http:           ctx.HTTP,
+		secrets:        ctx.Secrets,
+		requests:       ctx.Requests,
+		notifications:  ctx.Notifications,
+		auth:           ctx.Auth,
+	}, authenticatedUser(ctx.Auth), "")
+
+	return err
+}
+
+func (c *Component) HandleWebhook(ctx core.WebhookRequestContext) (int, error) {
+	result, err := c.invoke(pb.Method_METHOD_HANDLE_WEBHOOK, ctx.Configuration, webhookInvocation(ctx), &callbacks{
+		metadata: ctx.Metadata,
+		http:     ctx.HTTP,
+		events:   ctx.Events,
+		webhook:  ctx.Webhook,
+	}, nil, webhookBaseURL(ctx.Webhook))
+
+	return webhookStatus(result, err)
+}
+
+func (c *Component) Cleanup(ctx core.SetupContext) error {
+	_, err := c.invoke(pb.Method_METHOD_CLEANUP, ctx.Configuration, &pb.Invocation{}, &callbacks{
+		metadata: ctx.Metadata,
+		http:     ctx.HTTP,
+		requests: ctx.Requests,
+		webhook:  ctx.Webhook,
+		auth:     ctx.Auth,
+	}, authenticatedUser(ctx.Auth), webhookBaseURL(ctx.Webhook))
+
+	return err
+}
+
+func (c *Component) invoke(method pb.Method, config any, invocation *pb.Invocation, callbacks *callbacks, user *pb.User, webhookBaseURL string) (*pb.InvocationResult, error) {
+	data, err := EncodeJSON(config)
+	if err != nil {
+		return nil, fmt.Errorf("error encoding configuration: %w", err)
+	}
+
+	invocation.Kind = pb.Kind_KIND_COMPONENT
+	invocation.Name = c.Name()
+	invocation.Method = method
+	invocation.Configuration = data
+	invocation.User = user
+	invocation.WebhookBaseUrl = webhookBaseURL
+	return c.plugin.invoke(invocation, callbacks)
+}
+
+func executionInvocation(ctx core.ExecutionContext) (*pb.Invocation, error) {
+	data, err := EncodeJSON(ctx.Data)
+	if err != nil {
+		return nil, fmt.Errorf("error encoding execution data: %w", err)
+	}
+
+	return &pb.Invocation{
+		Execution: &pb.Execution{
+			Id:             ctx.ID.String(),
+			WorkflowId:     ctx.WorkflowID,
+			OrganizationId: ctx.OrganizationID,
+			NodeId:         ctx.NodeID,
+			SourceNodeId:   ctx.SourceNodeID,
+			BaseUrl:        ctx.BaseURL,
+			Data:           data,
+		},
+	}, nil
+}
+
+func executionCallbacks(ctx core.ExecutionContext) *callbacks {
+	return &callbacks{
+		metadata:       ctx.Metadata,
+		nodeMetadata:   ctx.NodeMetadata,
+		executionState: ctx.ExecutionState,
+		logs:           ctx.Logs,
+		artifacts:      ctx.Artifacts,
+		http:           ctx.HTTP,
+		secrets:        ctx.Secrets,
+		requests:       ctx.Requests,
+		notifications:  ctx.Notifications,
+		webhook:        ctx.Webhook,
+		auth:           ctx.Auth,
+	}
+}
+
+func webhookInvocation(ctx core.WebhookRequestContext) *pb.Invocation {
+	return &pb.Invocation{
+		Webhook: &pb.WebhookRequest{
+			WorkflowId: ctx.WorkflowID,
+			NodeId:     ctx.NodeID,
+			Body:       ctx.Body,
+			Headers:    EncodeHeaders(ctx.Headers),
+		},
+	}
+}
+
+func webhookStatus(result *pb.InvocationResult, err error) (int, error) {
+	status := http.StatusInternalServerError
+	if result != nil && result.StatusCode != 0 {
+		status = int(result.StatusCode)
+	}
+
+	if err != nil {
+		return status, err
+	}
+
+	if result.StatusCode == 0 {
+		return http.StatusOK, nil
+	}
+
+	return status, nil
+}
+
+func authenticatedUser(auth core.AuthContext) *pb.User {
+	if auth == nil {
+		return nil
+	}
+
+	user := auth.AuthenticatedUser()
+	if user == nil {
+		return nil
+	}
+
+	return &pb.User{Id: user.ID, Name: user.Name, Email: user.Email}
+}
+
+func webhookBaseURL(webhook core.NodeWebhookContext) string {
+	if webhook == nil {
+		return ""
+	}
+
+	return webhook.GetBaseURL()
+}
@@ -0,0 +1,322 @@
+package plugins
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sync/atomic"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/superplanehq/superplane/pkg/core"
+	pb "github.com/superplanehq/superplane/pkg/protos/plugins"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+//
+// ProtocolVersion is the version of the plugin protocol.
+// It changes only when changes to the protocol are not backwards compatible,
+// and plugins using a different version are not loaded.
+//
+
+const ProtocolVersion = 1
+
+const (
+	CapabilityComponents            = "components"
+	CapabilityTriggers              = "triggers"
+	CapabilityWebhooks              = "webhooks"
+	CapabilityDynamicOutputChannels = "dynamic_output_channels"
+	CapabilityTriggerInput          = "trigger_input"
+)
+
+//
+// Names of the contexts that can be available to an invocation.
+// They follow the fields of the core contexts.
+//
+
+const (
+	ContextMetadata       = "metadata"
+	ContextNodeMetadata   = "nodeMetadata"
+	ContextExecutionState = "executionState"
+	ContextLogs           = "logs"
+	ContextArtifacts      = "artifacts"
+	ContextHTTP           = "http"
+	ContextSecrets        = "secrets"
+	ContextRequests       = "requests"
+	ContextEvents         = "events"
+	ContextNotifications  = "notifications"
+	ContextWebhook        = "webhook"
+	ContextAuth           = "auth"
+)
+
+var (
+	DefaultHandshakeTimeout = 10 * time.Second
+	DefaultInvokeTimeout    = time.Minute
+	DefaultQueryTimeout     = 5 * time.Second
+)
+
+var (
+	ErrIncompatiblePlugin = errors.New("incompatible plugin")
+	ErrPluginUnavailable  = errors.New("plugin unavailable")
+)
+
+//
+// Plugin is a connection to an out-of-process plugin,
+// providing components and triggers to SuperPlane.
+//
+// The components and triggers returned by it implement the core interfaces
+// by invoking the plugin, and answering its callbacks with the core contexts
+// given to them. Definitions like names and configuration fields
+// are loaded once, when connecting to the plugin.
+//
+// The Integration, CanvasMemory and ExpressionEnv contexts are not
+// available to plugins, and queue items are always processed
+// with the default processing.
+//
+
+type Plugin struct {
+	Address      string
+	Name         string
+	Version      string
+	Capabilities []string
+
+	conn       *grpc.ClientConn
+	client     pb.PluginClient
+	health     grpc_health_v1.HealthClient
+	available  atomic.Bool
+	components []core.Component
+	triggers   []core.Trigger
+	logger     *log.Entry
+}
+
+func Connect(ctx context.Context, address string) (*Plugin, error) {
+	conn, err := grpc.NewClient(address, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return nil, fmt.Errorf("error creating connection: %w", err)
+	}
+
+	plugin := &Plugin{
+		Address: address,
+		conn:    conn,
+		client:  pb.NewPluginClient(conn),
+		health:  grpc_health_v1.NewHealthClient(conn),
+		logger:  log.WithFields(log.Fields{"plugin": address}),
+	}
+
+	err = plugin.load(ctx)
+	if err != nil {
+		_ = conn.Close()
+		return nil, err
+	}
+
+	plugin.available.Store(true)
+	return plugin, nil
+}
+
+//
+// ConnectWithRetry is used when SuperPlane starts,
+// since plugins might be starting at the same time.
+// Incompatible plugins are not retried.
+//
+
+func ConnectWithRetry(ctx context.Context, address string, attempts int, interval time.Duration) (*Plugin, error) {
+	var err error
+	for attempt := 1; attempt <= attempts; attempt++ {
+		var plugin *Plugin
+		plugin, err = Connect(ctx, address)
+		if err == nil {
+			return plugin, nil
+		}
+
+		if errors.Is(err, ErrIncompatiblePlugin) || attempt == attempts {
+			break
+		}
+
+		log.Warnf("Error connecting to plugin %s (attempt %d/%d): %v", address, attempt, attempts, err)
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(interval):
+		}
+	}
+
+	return nil, err
+}
+
+func (p *Plugin) load(ctx context.Context) error {
+	handshakeCtx, cancel := context.WithTimeout(ctx, DefaultHandshakeTimeout)
+	defer cancel()
+
+	handshake, err := p.client.Handshake(handshakeCtx, &pb.HandshakeRequest{ProtocolVersion: ProtocolVersion})
+	if err != nil {
+		return fmt.Errorf("error in handshake: %w", err)
+	}
+
+	if handshake.ProtocolVersion != ProtocolVersion {
+		return fmt.Errorf("%w: plugin uses protocol version %d, but %d is required", ErrIncompatiblePlugin, handshake.ProtocolVersion, ProtocolVersion)
+	}
+
+	if handshake.Name == "" {
+		return fmt.Errorf("%w: plugin has no name", ErrIncompatiblePlugin)
+	}
+
+	p.Name = handshake.Name
+	p.Version = handshake.Version
+	p.Capabilities = handshake.Capabilities
+	p.logger = log.WithFields(log.Fields{"plugin": p.Name, "version": p.Version})
+
+	if p.HasCapability(CapabilityComponents) {
+		response, err := p.client.ListComponents(handshakeCtx, &emptypb.Empty{})
+		if err != nil {
+			return fmt.Errorf("error listing components: %w", err)
+		}
+
+		for _, definition := range response.Components {
+			component, err := newComponent(p, definition)
+			if err != nil {
+				return fmt.Errorf("%w: component %s: %v", ErrIncompatiblePlugin, definition.Name, err)
+			}
+
+			p.components = append(p.components, component)
+		}
+	}
+
+	if p.HasCapability(CapabilityTriggers) {
+		response, err := p.client.ListTriggers(handshakeCtx, &emptypb.Empty{})
+		if err != nil {
+			return fmt.Errorf("error listing triggers: %w", err)
+		}
+
+		for _, definition := range response.Triggers {
+			trigger, err := newTrigger(p, definition)
+			if err != nil {
+				return fmt.Errorf("%w: trigger %s: %v", ErrIncompatiblePlugin, definition.Name, err)
+			}
+
+			p.triggers = append(p.triggers, trigger)
+		}
+	}
+
+	return nil
+}
+
+func (p *Plugin) HasCapability(capability string) bool {
+	return slices.Contains(p.Capabilities, capability)
+}
+
+func (p *Plugin) Components() []core.Component {
+	return p.components
+}
+
+func (p *Plugin) Triggers() []core.Trigger {
+	return p.triggers
+}
+
+func (p *Plugin) Available() bool {
+	return p.available.Load()
+}
+
+func (p *Plugin) Close() error {
+	p.available.Store(false)
+	return p.conn.Close()
+}
+
+//
+// CheckHealth uses the standard gRPC health service of the plugin.
+// While the plugin is not healthy, invocations fail right away,
+// instead of waiting for the invocation timeout.
+//
+
+func (p *Plugin) CheckHealth(ctx context.Context) error {
+	checkCtx, cancel := context.WithTimeout(ctx, DefaultQueryTimeout)
+	defer cancel()
+
+	response, err := p.health.Check(checkCtx, &grpc_health_v1.HealthCheckRequest{})
+	if err == nil && response.Status != grpc_health_v1.HealthCheckResponse_SERVING {
+		err = fmt.Errorf("plugin is %s", response.Status)
+	}
+
+	wasAvailable := p.available.Swap(err == nil)
+	if err != nil && wasAvailable {
+		p.logger.Errorf("Plugin is unavailable: %v", err)
+	}
+
+	if err == nil && !wasAvailable {
+		p.logger.Infof("Plugin is available again")
+	}
+
+	return err
+}
+
+func (p *Plugin) StartHealthChecks(ctx context.Context, interval time.Duration) {
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				_ = p.CheckHealth(ctx)
+			}
+		}
+	}()
+}
+
+//
+// invoke sends the invocation to the plugin,
+// and answers its callbacks until the plugin sends the result.
+//
+
+func (p *Plugin) invoke(invocation *pb.Invocation, callbacks *callbacks) (*pb.InvocationResult, error) {
+	if !p.Available() {
+		return nil, fmt.Errorf("%w: %s", ErrPluginUnavailable, p.Name)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), DefaultInvokeTimeout)
+	defer cancel()
+
+	stream, err := p.client.Invoke(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error invoking plugin %s: %w", p.Name, err)
+	}
+
+	invocation.Contexts = callbacks.available()
+	err = stream.Send(&pb.HostMessage{Message: &pb.HostMessage_Invocation{Invocation: invocation}})
+	if err != nil {
+		return nil, fmt.Errorf("error invoking plugin %s: %w", p.Name, err)
+	}
+
+	for {
+		message, err := stream.Recv()
+		if err != nil {
+			return nil, fmt.Errorf("error invoking plugin %s: %w", p.Name, err)
+		}
+
+		switch m := message.Message.(type) {
+		case *pb.PluginMessage_Callback:
+			response := callbacks.handle(m.Callback)
+			err = stream.Send(&pb.HostMessage{Message: &pb.HostMessage_CallbackResponse{CallbackResponse: response}})
+			if err != nil {
+				return nil, fmt.Errorf("error answering callback of plugin %s: %w", p.Name, err)
+			}
+
+		case *pb.PluginMessage_Result:
+			_ = stream.CloseSend()
+			if m.Result.Error != "" {
+				return m.Result, errors.New(m.Result.Error)
+			}
+
+			return m.Result, nil
+
+		default:
+			return nil, fmt.Errorf("unexpected message from plugin %s", p.Name)
+		}
+	}
+}
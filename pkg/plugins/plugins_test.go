@@ -0,0 +1,437 @@
+package plugins_test
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/mitchellh/mapstructure"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/configuration"
+	"github.com/superplanehq/superplane/pkg/core"
+	"github.com/superplanehq/superplane/pkg/plugins"
+	"github.com/superplanehq/superplane/pkg/plugins/sdk"
+	pb "github.com/superplanehq/superplane/pkg/protos/plugins"
+	"github.com/superplanehq/superplane/test/support/contexts"
+	"google.golang.org/grpc"
+)
+
+func Test__Plugins(t *testing.T) {
+	address, server := serve(t, sdk.Plugin{
+		Name:       "acme",
+		Version:    "1.2.3",
+		Components: []core.Component{&deploy{}},
+		Triggers:   []core.Trigger{&release{}},
+	})
+
+	plugin, err := plugins.Connect(context.Background(), address)
+	require.NoError(t, err)
+	defer plugin.Close()
+
+	t.Run("handshake -> definitions are loaded", func(t *testing.T) {
+		assert.Equal(t, "acme", plugin.Name)
+		assert.Equal(t, "1.2.3", plugin.Version)
+		assert.True(t, plugin.HasCapability(plugins.CapabilityComponents))
+		assert.True(t, plugin.HasCapability(plugins.CapabilityTriggers))
+		assert.True(t, plugin.HasCapability(plugins.CapabilityTriggerInput))
+
+		require.Len(t, plugin.Components(), 1)
+		component := plugin.Components()[0]
+		assert.Equal(t, "acmeDeploy", component.Name())
+		assert.Equal(t, "Deploy", component.Label())
+		assert.Equal(t, map[string]any{"version": "1.0.0"}, component.ExampleOutput())
+		require.Len(t, component.Configuration(), 1)
+		assert.Equal(t, "environment", component.Configuration()[0].Name)
+		assert.True(t, component.Configuration()[0].Required)
+		require.Len(t, component.Actions(), 1)
+		assert.Equal(t, "rollback", component.Actions()[0].Name)
+		assert.Equal(t, []core.OutputChannel{core.DefaultOutputChannel}, component.OutputChannels(nil))
+		assert.Equal(t, "production", component.OutputChannels(map[string]any{"environment": "production"})[0].Name)
+
+		require.Len(t, plugin.Triggers(), 1)
+		assert.Equal(t, "acmeRelease", plugin.Triggers()[0].Name())
+	})
+
+	t.Run("execute -> callbacks answered with the given contexts", func(t *testing.T) {
+		metadata := &contexts.MetadataContext{}
+		nodeMetadata := &contexts.MetadataContext{Metadata: map[string]any{"deployments": 1.0}}
+		state := &contexts.ExecutionStateContext{KVs: map[string]string{}}
+		logs := &logContext{}
+		httpCtx := &contexts.HTTPContext{
+			Responses: []*http.Response{
+				{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader(`2.0.0`))},
+			},
+		}
+
+		executionID := uuid.New()
+		err := plugin.Components()[0].Execute(core.ExecutionContext{
+			ID:             executionID,
+			WorkflowID:     uuid.NewString(),
+			NodeID:         "deploy",
+			Configuration:  map[string]any{"environment": "staging"},
+			Data:           map[string]any{"ref": "main"},
+			Metadata:       metadata,
+			NodeMetadata:   nodeMetadata,
+			ExecutionState: state,
+			Logs:           logs,
+			HTTP:           httpCtx,
+			Secrets:        &secretsContext{values: map[string]string{"acme/token": "s3cr3t"}},
+		})
+
+		require.NoError(t, err)
+
+		require.Len(t, httpCtx.Requests, 1)
+		assert.Equal(t, "https://deploy.acme.com/staging", httpCtx.Requests[0].URL.String())
+		assert.Equal(t, "Bearer s3cr3t", httpCtx.Requests[0].Header.Get("Authorization"))
+		body, _ := io.ReadAll(httpCtx.Requests[0].Body)
+		assert.Equal(t, `{"ref":"main"}`, string(body))
+
+		assert.Equal(t, map[string]any{"execution": executionID.String(), "deployments": 1.0}, metadata.Metadata)
+		assert.Equal(t, []string{"deploying main to staging"}, logs.stdout)
+		assert.Equal(t, "2.0.0", state.KVs["version"])
+		assert.True(t, state.Passed)
+		assert.Equal(t, "staging", state.Channel)
+		require.Len(t, state.Payloads, 1)
+		assert.Equal(t, map[string]any{"version": "2.0.0"}, state.Payloads[0].(map[string]any)["data"])
+	})
+
+	t.Run("execute without a context -> component sees nil", func(t *testing.T) {
+		state := &contexts.ExecutionStateContext{KVs: map[string]string{}}
+		err := plugin.Components()[0].Execute(core.ExecutionContext{
+			ID:             uuid.New(),
+			Configuration:  map[string]any{"environment": "staging"},
+			ExecutionState: state,
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, "missing", state.FailureReason)
+	})
+
+	t.Run("missing secret -> not found error", func(t *testing.T) {
+		state := &contexts.ExecutionStateContext{KVs: map[string]string{}}
+		err := plugin.Components()[0].Execute(core.ExecutionContext{
+			ID:             uuid.New(),
+			Configuration:  map[string]any{"environment": "staging"},
+			ExecutionState: state,
+			Metadata:       &contexts.MetadataContext{},
+			NodeMetadata:   &contexts.MetadataContext{},
+			Logs:           &logContext{},
+			HTTP:           &contexts.HTTPContext{},
+			Secrets:        &secretsContext{},
+		})
+
+		require.ErrorContains(t, err, core.ErrSecretKeyNotFound.Error())
+	})
+
+	t.Run("trigger action and input -> forwarded", func(t *testing.T) {
+		trigger := plugin.Triggers()[0]
+		events := &contexts.EventContext{}
+		output, err := trigger.HandleAction(core.TriggerActionContext{
+			Name:       "release",
+			Parameters: map[string]any{"version": "2.0.0"},
+			Events:     events,
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, map[string]any{"released": true}, output)
+		require.Equal(t, 1, events.Count())
+		assert.Equal(t, "acme.release", events.Payloads[0].Type)
+		assert.Equal(t, map[string]any{"version": "2.0.0"}, events.Payloads[0].Data)
+
+		inputTrigger, ok := trigger.(core.InputTrigger)
+		require.True(t, ok)
+
+		input, err := inputTrigger.ValidateInput(nil, map[string]any{})
+		require.NoError(t, err)
+		assert.Equal(t, map[string]any{"version": "latest"}, input)
+
+		_, err = inputTrigger.ValidateInput(nil, map[string]any{"version": 1})
+		require.ErrorContains(t, err, "version must be a string")
+	})
+
+	t.Run("webhook -> status code returned", func(t *testing.T) {
+		events := &contexts.EventContext{}
+		status, err := plugin.Triggers()[0].HandleWebhook(core.WebhookRequestContext{
+			Body:    []byte(`{"version":"3.0.0"}`),
+			Headers: http.Header{"X-Acme-Token": []string{"wrong"}},
+			Webhook: &contexts.WebhookContext{Secret: "token"},
+			Events:  events,
+		})
+
+		assert.Equal(t, http.StatusForbidden, status)
+		require.ErrorContains(t, err, "invalid token")
+		assert.Zero(t, events.Count())
+	})
+
+	t.Run("plugin stops -> unavailable", func(t *testing.T) {
+		require.NoError(t, plugin.CheckHealth(context.Background()))
+		assert.True(t, plugin.Available())
+
+		server.Stop()
+
+		require.Error(t, plugin.CheckHealth(context.Background()))
+		assert.False(t, plugin.Available())
+
+		err := plugin.Components()[0].Execute(core.ExecutionContext{ID: uuid.New()})
+		require.ErrorIs(t, err, plugins.ErrPluginUnavailable)
+		assert.Equal(t, []core.OutputChannel{core.DefaultOutputChannel}, plugin.Components()[0].OutputChannels(map[string]any{"environment": "production"}))
+	})
+}
+
+func Test__PluginHandshake(t *testing.T) {
+	t.Run("different protocol version -> incompatible", func(t *testing.T) {
+		listener, err := net.Listen("tcp", "127.0.0.1:0")
+		require.NoError(t, err)
+
+		server := grpc.NewServer()
+		pb.RegisterPluginServer(server, &futurePlugin{})
+		go func() { _ = server.Serve(listener) }()
+		defer server.Stop()
+
+		_, err = plugins.Connect(context.Background(), listener.Addr().String())
+		require.ErrorIs(t, err, plugins.ErrIncompatiblePlugin)
+	})
+
+	t.Run("plugin not running -> error", func(t *testing.T) {
+		_, err := plugins.Connect(context.Background(), "127.0.0.1:1")
+		require.Error(t, err)
+		require.NotErrorIs(t, err, plugins.ErrIncompatiblePlugin)
+	})
+
+	t.Run("dotted names -> not served", func(t *testing.T) {
+		_, err := sdk.NewServer(sdk.Plugin{Name: "acme", Components: []core.Component{&dotted{}}})
+		require.ErrorContains(t, err, "must not contain dots")
+	})
+}
+
+func serve(t *testing.T, plugin sdk.Plugin) (string, *grpc.Server) {
+	server, err := sdk.NewServer(plugin)
+	require.NoError(t, err)
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	go func() { _ = server.Serve(listener) }()
+	t.Cleanup(server.Stop)
+
+	return listener.Addr().String(), server
+}
+
+type futurePlugin struct {
+	pb.UnimplementedPluginServer
+}
+
+func (p *futurePlugin) Handshake(ctx context.Context, req *pb.HandshakeRequest) (*pb.HandshakeResponse, error) {
+	return &pb.HandshakeResponse{ProtocolVersion: plugins.ProtocolVersion + 1, Name: "future"}, nil
+}
+
+type logContext struct {
+	stdout []string
+}
+
+func (c *logContext) Stdout(message string) error {
+	c.stdout = append(c.stdout, message)
+	return nil
+}
+
+func (c *logContext) Stderr(message string) error {
+	return nil
+}
+
+type secretsContext struct {
+	values map[string]string
+}
+
+func (c *secretsContext) GetKey(secretName, keyName string) ([]byte, error) {
+	value, ok := c.values[secretName+"/"+keyName]
+	if !ok {
+		return nil, core.ErrSecretKeyNotFound
+	}
+
+	return []byte(value), nil
+}
+
+//
+// deploy is a plugin component using most of the execution contexts.
+//
+
+type deploy struct{}
+
+type deploySpec struct {
+	Environment string `mapstructure:"environment"`
+}
+
+func (d *deploy) Name() string                  { return "acmeDeploy" }
+func (d *deploy) Label() string                 { return "Deploy" }
+func (d *deploy) Description() string           { return "Deploy with Acme" }
+func (d *deploy) Documentation() string         { return "" }
+func (d *deploy) Icon() string                  { return "rocket" }
+func (d *deploy) Color() string                 { return "gray" }
+func (d *deploy) ExampleOutput() map[string]any { return map[string]any{"version": "1.0.0"} }
+
+func (d *deploy) OutputChannels(config any) []core.OutputChannel {
+	spec := deploySpec{}
+	if err := mapstructure.Decode(config, &spec); err != nil || spec.Environment == "" {
+		return []core.OutputChannel{core.DefaultOutputChannel}
+	}
+
+	return []core.OutputChannel{{Name: spec.Environment, Label: spec.Environment}}
+}
+
+func (d *deploy) Configuration() []configuration.Field {
+	return []configuration.Field{
+		{Name: "environment", Label: "Environment", Type: configuration.FieldTypeString, Required: true},
+	}
+}
+
+func (d *deploy) Actions() []core.Action {
+	return []core.Action{{Name: "rollback", UserAccessible: true}}
+}
+
+func (d *deploy) Setup(ctx core.SetupContext) error {
+	return nil
+}
+
+func (d *deploy) ProcessQueueItem(ctx core.ProcessQueueContext) (*uuid.UUID, error) {
+	return ctx.DefaultProcessing()
+}
+
+func (d *deploy) Execute(ctx core.ExecutionContext) error {
+	spec := deploySpec{}
+	if err := mapstructure.Decode(ctx.Configuration, &spec); err != nil {
+		return err
+	}
+
+	if ctx.Secrets == nil {
+		return ctx.ExecutionState.Fail("missing", "secrets are not available")
+	}
+
+	token, err := ctx.Secrets.GetKey("acme", "token")
+	if err != nil {
+		return err
+	}
+
+	data := ctx.Data.(map[string]any)
+	if err := ctx.Logs.Stdout(fmt.Sprintf("deploying %s to %s", data["ref"], spec.Environment)); err != nil {
+		return err
+	}
+
+	request, err := http.NewRequest(http.MethodPost, "https://deploy.acme.com/"+spec.Environment, strings.NewReader(`{"ref":"main"}`))
+	if err != nil {
+		return err
+	}
+
+	request.Header.Set("Authorization", "Bearer "+string(token))
+	response, err := ctx.HTTP.Do(request)
+	if err != nil {
+		return err
+	}
+
+	defer response.Body.Close()
+	version, err := io.ReadAll(response.Body)
+	if err != nil {
+		return err
+	}
+
+	nodeMetadata := ctx.NodeMetadata.Get().(map[string]any)
+	err = ctx.Metadata.Set(map[string]any{"execution": ctx.ID.String(), "deployments": nodeMetadata["deployments"]})
+	if err != nil {
+		return err
+	}
+
+	if err := ctx.ExecutionState.SetKV("version", string(version)); err != nil {
+		return err
+	}
+
+	return ctx.ExecutionState.Emit(spec.Environment, "acme.deploy", []any{map[string]any{"version": string(version)}})
+}
+
+func (d *deploy) HandleAction(ctx core.ActionContext) error {
+	return nil
+}
+
+func (d *deploy) HandleWebhook(ctx core.WebhookRequestContext) (int, error) {
+	return http.StatusOK, nil
+}
+
+func (d *deploy) Cancel(ctx core.ExecutionContext) error {
+	return nil
+}
+
+func (d *deploy) Cleanup(ctx core.SetupContext) error {
+	return nil
+}
+
+type dotted struct {
+	deploy
+}
+
+func (d *dotted) Name() string { return "acme.deploy" }
+
+//
+// release is a plugin trigger accepting input.
+//
+
+type release struct{}
+
+func (r *release) Name() string                         { return "acmeRelease" }
+func (r *release) Label() string                        { return "Release" }
+func (r *release) Description() string                  { return "Acme releases" }
+func (r *release) Documentation() string                { return "" }
+func (r *release) Icon() string                         { return "tag" }
+func (r *release) Color() string                        { return "gray" }
+func (r *release) ExampleData() map[string]any          { return map[string]any{"version": "1.0.0"} }
+func (r *release) Configuration() []configuration.Field { return []configuration.Field{} }
+
+func (r *release) Actions() []core.Action {
+	return []core.Action{{Name: "release"}}
+}
+
+func (r *release) Setup(ctx core.TriggerContext) error {
+	return nil
+}
+
+func (r *release) HandleAction(ctx core.TriggerActionContext) (map[string]any, error) {
+	err := ctx.Events.Emit("acme.release", ctx.Parameters)
+	if err != nil {
+		return nil, err
+	}
+
+	return map[string]any{"released": true}, nil
+}
+
+func (r *release) HandleWebhook(ctx core.WebhookRequestContext) (int, error) {
+	secret, err := ctx.Webhook.GetSecret()
+	if err != nil {
+		return http.StatusInternalServerError, err
+	}
+
+	if ctx.Headers.Get("X-Acme-Token") != string(secret) {
+		return http.StatusForbidden, fmt.Errorf("invalid token")
+	}
+
+	return http.StatusOK, ctx.Events.Emit("acme.release", map[string]any{})
+}
+
+func (r *release) Cleanup(ctx core.TriggerContext) error {
+	return nil
+}
+
+func (r *release) ValidateInput(config any, input map[string]any) (map[string]any, error) {
+	version, ok := input["version"]
+	if !ok {
+		return map[string]any{"version": "latest"}, nil
+	}
+
+	if _, ok := version.(string); !ok {
+		return nil, fmt.Errorf("version must be a string")
+	}
+
+	return input, nil
+}
@@ -0,0 +1,355 @@
+package sdk
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/superplanehq/superplane/pkg/core"
+	"github.com/superplanehq/superplane/pkg/plugins"
+	pb "github.com/superplanehq/superplane/pkg/protos/plugins"
+)
+
+//
+// The core contexts given to plugin components and triggers.
+// Each call is sent to SuperPlane, which answers it
+// with the context given to the invocation there.
+//
+
+type metadataContext struct {
+	client *callbackClient
+	field  pb.MetadataField
+}
+
+func (c *metadataContext) Get() any {
+	response, err := c.client.call(&pb.Callback{
+		Request: &pb.Callback_GetMetadata{GetMetadata: &pb.GetMetadata{Field: c.field}},
+	})
+
+	if err != nil {
+		return nil
+	}
+
+	value, err := plugins.DecodeJSON(response.Value)
+	if err != nil {
+		return nil
+	}
+
+	return value
+}
+
+func (c *metadataContext) Set(value any) error {
+	data, err := plugins.EncodeJSON(value)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.client.call(&pb.Callback{
+		Request: &pb.Callback_SetMetadata{SetMetadata: &pb.SetMetadata{Field: c.field, Value: data}},
+	})
+
+	return err
+}
+
+type executionStateContext struct {
+	client *callbackClient
+}
+
+func (c *executionStateContext) IsFinished() bool {
+	response, err := c.client.call(&pb.Callback{
+		Request: &pb.Callback_IsFinished{IsFinished: &pb.IsFinished{}},
+	})
+
+	if err != nil {
+		return false
+	}
+
+	var finished bool
+	err = json.Unmarshal(response.Value, &finished)
+	return err == nil && finished
+}
+
+func (c *executionStateContext) SetKV(key, value string) error {
+	_, err := c.client.call(&pb.Callback{
+		Request: &pb.Callback_SetKv{SetKv: &pb.SetKV{Key: key, Value: value}},
+	})
+
+	return err
+}
+
+func (c *executionStateContext) Emit(channel, payloadType string, payloads []any) error {
+	data, err := json.Marshal(payloads)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.client.call(&pb.Callback{
+		Request: &pb.Callback_EmitOutput{EmitOutput: &pb.EmitOutput{
+			Channel:     channel,
+			PayloadType: payloadType,
+			Payloads:    data,
+		}},
+	})
+
+	return err
+}
+
+func (c *executionStateContext) Pass() error {
+	_, err := c.client.call(&pb.Callback{
+		Request: &pb.Callback_Pass{Pass: &pb.Pass{}},
+	})
+
+	return err
+}
+
+func (c *executionStateContext) Fail(reason, message string) error {
+	_, err := c.client.call(&pb.Callback{
+		Request: &pb.Callback_Fail{Fail: &pb.Fail{Reason: reason, Message: message}},
+	})
+
+	return err
+}
+
+type logContext struct {
+	client *callbackClient
+}
+
+func (c *logContext) Stdout(message string) error {
+	return c.write(pb.LogStream_LOG_STREAM_STDOUT, message)
+}
+
+func (c *logContext) Stderr(message string) error {
+	return c.write(pb.LogStream_LOG_STREAM_STDERR, message)
+}
+
+func (c *logContext) write(stream pb.LogStream, message string) error {
+	_, err := c.client.call(&pb.Callback{
+		Request: &pb.Callback_WriteLog{WriteLog: &pb.WriteLog{Stream: stream, Message: message}},
+	})
+
+	return err
+}
+
+type artifactContext struct {
+	client *callbackClient
+}
+
+func (c *artifactContext) Put(name string, contentType string, content []byte) (*core.Artifact, error) {
+	response, err := c.client.call(&pb.Callback{
+		Request: &pb.Callback_PutArtifact{PutArtifact: &pb.PutArtifact{
+			Name:        name,
+			ContentType: contentType,
+			Content:     content,
+		}},
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	artifact := core.Artifact{}
+	err = json.Unmarshal(response.Value, &artifact)
+	if err != nil {
+		return nil, fmt.Errorf("invalid artifact: %w", err)
+	}
+
+	return &artifact, nil
+}
+
+type httpContext struct {
+	client *callbackClient
+}
+
+func (c *httpContext) Do(request *http.Request) (*http.Response, error) {
+	var body []byte
+	if request.Body != nil {
+		var err error
+		body, err = io.ReadAll(request.Body)
+		_ = request.Body.Close()
+		if err != nil {
+			return nil, fmt.Errorf("error reading request body: %w", err)
+		}
+	}
+
+	response, err := c.client.call(&pb.Callback{
+		Request: &pb.Callback_HttpRequest{HttpRequest: &pb.HTTPRequest{
+			Method:  request.Method,
+			Url:     request.URL.String(),
+			Headers: plugins.EncodeHeaders(request.Header),
+			Body:    body,
+		}},
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	res := response.GetHttpResponse()
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", res.GetStatusCode(), http.StatusText(int(res.GetStatusCode()))),
+		StatusCode:    int(res.GetStatusCode()),
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        plugins.DecodeHeaders(res.GetHeaders()),
+		Body:          io.NopCloser(bytes.NewReader(res.GetBody())),
+		ContentLength: int64(len(res.GetBody())),
+		Request:       request,
+	}, nil
+}
+
+type secretsContext struct {
+	client *callbackClient
+}
+
+func (c *secretsContext) GetKey(secretName, keyName string) ([]byte, error) {
+	response, err := c.client.call(&pb.Callback{
+		Request: &pb.Callback_GetSecretKey{GetSecretKey: &pb.GetSecretKey{Secret: secretName, Key: keyName}},
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if response.NotFound {
+		return nil, core.ErrSecretKeyNotFound
+	}
+
+	return response.Value, nil
+}
+
+type requestContext struct {
+	client *callbackClient
+}
+
+func (c *requestContext) ScheduleActionCall(actionName string, parameters map[string]any, interval time.Duration) error {
+	data, err := plugins.EncodeJSON(parameters)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.client.call(&pb.Callback{
+		Request: &pb.Callback_ScheduleActionCall{ScheduleActionCall: &pb.ScheduleActionCall{
+			Action:          actionName,
+			Parameters:      data,
+			IntervalSeconds: int64(interval / time.Second),
+		}},
+	})
+
+	return err
+}
+
+type eventContext struct {
+	client *callbackClient
+}
+
+func (c *eventContext) Emit(payloadType string, payload any) error {
+	data, err := plugins.EncodeJSON(payload)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.client.call(&pb.Callback{
+		Request: &pb.Callback_EmitEvent{EmitEvent: &pb.EmitEvent{PayloadType: payloadType, Payload: data}},
+	})
+
+	return err
+}
+
+type notificationContext struct {
+	client *callbackClient
+}
+
+func (c *notificationContext) Send(title, body, url, urlLabel string, receivers core.NotificationReceivers) error {
+	_, err := c.client.call(&pb.Callback{
+		Request: &pb.Callback_SendNotification{SendNotification: &pb.SendNotification{
+			Title:    title,
+			Body:     body,
+			Url:      url,
+			UrlLabel: urlLabel,
+			Emails:   receivers.Emails,
+			Groups:   receivers.Groups,
+			Roles:    receivers.Roles,
+		}},
+	})
+
+	return err
+}
+
+type webhookContext struct {
+	client  *callbackClient
+	baseURL string
+}
+
+func (c *webhookContext) Setup() (string, error) {
+	response, err := c.client.call(&pb.Callback{
+		Request: &pb.Callback_SetupWebhook{SetupWebhook: &pb.SetupWebhook{}},
+	})
+
+	if err != nil {
+		return "", err
+	}
+
+	return string(response.Value), nil
+}
+
+func (c *webhookContext) GetSecret() ([]byte, error) {
+	response, err := c.client.call(&pb.Callback{
+		Request: &pb.Callback_GetWebhookSecret{GetWebhookSecret: &pb.GetWebhookSecret{}},
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return response.Value, nil
+}
+
+func (c *webhookContext) SetSecret(secret []byte) error {
+	_, err := c.client.call(&pb.Callback{
+		Request: &pb.Callback_SetWebhookSecret{SetWebhookSecret: &pb.SetWebhookSecret{Secret: secret}},
+	})
+
+	return err
+}
+
+func (c *webhookContext) ResetSecret() ([]byte, []byte, error) {
+	return nil, nil, fmt.Errorf("resetting webhook secrets is not supported for plugins")
+}
+
+func (c *webhookContext) GetBaseURL() string {
+	return c.baseURL
+}
+
+//
+// Plugins only know about the authenticated user.
+// Users, roles and groups are not available to them.
+//
+
+type authContext struct {
+	user *pb.User
+}
+
+func (c *authContext) AuthenticatedUser() *core.User {
+	if c.user == nil {
+		return nil
+	}
+
+	return &core.User{ID: c.user.Id, Name: c.user.Name, Email: c.user.Email}
+}
+
+func (c *authContext) GetUser(id uuid.UUID) (*core.User, error) {
+	return nil, fmt.Errorf("users are not available to plugins")
+}
+
+func (c *authContext) HasRole(role string) (bool, error) {
+	return false, fmt.Errorf("roles are not available to plugins")
+}
+
+func (c *authContext) InGroup(group string) (bool, error) {
+	return false, fmt.Errorf("groups are not available to plugins")
+}
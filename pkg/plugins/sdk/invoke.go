@@ -0,0 +1,450 @@
+package sdk
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"sync"
+
+	"github.com/google/uuid"
+	log "github.com/sirupsen/logrus"
+	"github.com/superplanehq/superplane/pkg/core"
+	"github.com/superplanehq/superplane/pkg/plugins"
+	pb "github.com/superplanehq/superplane/pkg/protos/plugins"
+)
+
+//
+// Invoke runs the invoked method in the stream goroutine,
+// while a separate goroutine receives the responses to its callbacks.
+//
+
+func (s *pluginServer) Invoke(stream pb.Plugin_InvokeServer) error {
+	message, err := stream.Recv()
+	if err != nil {
+		return err
+	}
+
+	invocation := message.GetInvocation()
+	if invocation == nil {
+		return fmt.Errorf("expected invocation")
+	}
+
+	client := newCallbackClient(stream)
+	go client.receive()
+
+	result := s.invoke(invocation, client)
+	return client.send(&pb.PluginMessage{Message: &pb.PluginMessage_Result{Result: result}})
+}
+
+func (s *pluginServer) invoke(invocation *pb.Invocation, client *callbackClient) (result *pb.InvocationResult) {
+	defer func() {
+		if r := recover(); r != nil {
+			result = &pb.InvocationResult{Error: fmt.Sprintf("%s panicked in %s: %v", invocation.Name, invocation.Method, r)}
+		}
+	}()
+
+	config, err := plugins.DecodeJSON(invocation.Configuration)
+	if err != nil {
+		return &pb.InvocationResult{Error: fmt.Sprintf("invalid configuration: %v", err)}
+	}
+
+	contexts := newContexts(invocation, client)
+
+	switch invocation.Kind {
+	case pb.Kind_KIND_COMPONENT:
+		component, ok := s.components[invocation.Name]
+		if !ok {
+			return &pb.InvocationResult{Error: fmt.Sprintf("component %s not found", invocation.Name)}
+		}
+
+		return invokeComponent(component, invocation, config, contexts)
+
+	case pb.Kind_KIND_TRIGGER:
+		trigger, ok := s.triggers[invocation.Name]
+		if !ok {
+			return &pb.InvocationResult{Error: fmt.Sprintf("trigger %s not found", invocation.Name)}
+		}
+
+		return invokeTrigger(trigger, invocation, config, contexts)
+
+	default:
+		return &pb.InvocationResult{Error: fmt.Sprintf("unknown kind %s", invocation.Kind)}
+	}
+}
+
+func invokeComponent(component core.Component, invocation *pb.Invocation, config any, c *contexts) *pb.InvocationResult {
+	logger := log.WithFields(log.Fields{"component": component.Name()})
+
+	switch invocation.Method {
+	case pb.Method_METHOD_SETUP, pb.Method_METHOD_CLEANUP:
+		ctx := core.SetupContext{
+			Logger:        logger,
+			Configuration: config,
+			HTTP:          c.http,
+			Metadata:      c.metadata,
+			Requests:      c.requests,
+			Auth:          c.auth,
+			Webhook:       c.webhook,
+		}
+
+		if invocation.Method == pb.Method_METHOD_SETUP {
+			return errorResult(component.Setup(ctx))
+		}
+
+		return errorResult(component.Cleanup(ctx))
+
+	case pb.Method_METHOD_EXECUTE, pb.Method_METHOD_CANCEL:
+		ctx, err := executionContext(invocation, config, logger, c)
+		if err != nil {
+			return errorResult(err)
+		}
+
+		if invocation.Method == pb.Method_METHOD_EXECUTE {
+			return errorResult(component.Execute(*ctx))
+		}
+
+		return errorResult(component.Cancel(*ctx))
+
+	case pb.Method_METHOD_HANDLE_ACTION:
+		parameters, err := plugins.DecodeJSONMap(invocation.Parameters)
+		if err != nil {
+			return errorResult(fmt.Errorf("invalid parameters: %w", err))
+		}
+
+		return errorResult(component.HandleAction(core.ActionContext{
+			Name:           invocation.Action,
+			Configuration:  config,
+			Parameters:     parameters,
+			Logger:         logger,
+			Logs:           c.logs,
+			Artifacts:      c.artifacts,
+			HTTP:           c.http,
+			Metadata:       c.metadata,
+			ExecutionState: c.executionState,
+			Auth:           c.auth,
+			Requests:       c.requests,
+			Notifications:  c.notifications,
+			Secrets:        c.secrets,
+		}))
+
+	case pb.Method_METHOD_HANDLE_WEBHOOK:
+		status, err := component.HandleWebhook(webhookRequestContext(invocation, config, logger, c))
+		result := errorResult(err)
+		result.StatusCode = int32(status)
+		return result
+
+	default:
+		return errorResult(fmt.Errorf("unknown method %s", invocation.Method))
+	}
+}
+
+func invokeTrigger(trigger core.Trigger, invocation *pb.Invocation, config any, c *contexts) *pb.InvocationResult {
+	logger := log.WithFields(log.Fields{"trigger": trigger.Name()})
+
+	switch invocation.Method {
+	case pb.Method_METHOD_SETUP, pb.Method_METHOD_CLEANUP:
+		ctx := core.TriggerContext{
+			Logger:        logger,
+			Configuration: config,
+			HTTP:          c.http,
+			Metadata:      c.metadata,
+			Requests:      c.requests,
+			Events:        c.events,
+			Webhook:       c.webhook,
+		}
+
+		if invocation.Method == pb.Method_METHOD_SETUP {
+			return errorResult(trigger.Setup(ctx))
+		}
+
+		return errorResult(trigger.Cleanup(ctx))
+
+	case pb.Method_METHOD_HANDLE_ACTION:
+		parameters, err := plugins.DecodeJSONMap(invocation.Parameters)
+		if err != nil {
+			return errorResult(fmt.Errorf("invalid parameters: %w", err))
+		}
+
+		output, err := trigger.HandleAction(core.TriggerActionContext{
+			Name:          invocation.Action,
+			Parameters:    parameters,
+			Configuration: config,
+			Logger:        logger,
+			HTTP:          c.http,
+			Metadata:      c.metadata,
+			Requests:      c.requests,
+			Events:        c.events,
+			Webhook:       c.webhook,
+		})
+
+		if err != nil {
+			return errorResult(err)
+		}
+
+		data, err := plugins.EncodeJSON(output)
+		if err != nil {
+			return errorResult(fmt.Errorf("error encoding output: %w", err))
+		}
+
+		return &pb.InvocationResult{Output: data}
+
+	case pb.Method_METHOD_HANDLE_WEBHOOK:
+		status, err := trigger.HandleWebhook(webhookRequestContext(invocation, config, logger, c))
+		result := errorResult(err)
+		result.StatusCode = int32(status)
+		return result
+
+	default:
+		return errorResult(fmt.Errorf("unknown method %s", invocation.Method))
+	}
+}
+
+func executionContext(invocation *pb.Invocation, config any, logger *log.Entry, c *contexts) (*core.ExecutionContext, error) {
+	execution := invocation.GetExecution()
+	if execution == nil {
+		return nil, fmt.Errorf("execution is required")
+	}
+
+	ID, err := uuid.Parse(execution.Id)
+	if err != nil {
+		return nil, fmt.Errorf("invalid execution ID: %w", err)
+	}
+
+	data, err := plugins.DecodeJSON(execution.Data)
+	if err != nil {
+		return nil, fmt.Errorf("invalid execution data: %w", err)
+	}
+
+	return &core.ExecutionContext{
+		ID:             ID,
+		WorkflowID:     execution.WorkflowId,
+		OrganizationID: execution.OrganizationId,
+		NodeID:         execution.NodeId,
+		SourceNodeID:   execution.SourceNodeId,
+		BaseURL:        execution.BaseUrl,
+		Data:           data,
+		Configuration:  config,
+		Logger:         logger.WithFields(log.Fields{"execution_id": execution.Id}),
+		Logs:           c.logs,
+		Artifacts:      c.artifacts,
+		HTTP:           c.http,
+		Metadata:       c.metadata,
+		NodeMetadata:   c.nodeMetadata,
+		ExecutionState: c.executionState,
+		Requests:       c.requests,
+		Auth:           c.auth,
+		Notifications:  c.notifications,
+		Secrets:        c.secrets,
+		Webhook:        c.webhook,
+	}, nil
+}
+
+func webhookRequestContext(invocation *pb.Invocation, config any, logger *log.Entry, c *contexts) core.WebhookRequestContext {
+	webhook := invocation.GetWebhook()
+
+	return core.WebhookRequestContext{
+		Body:          webhook.GetBody(),
+		Headers:       plugins.DecodeHeaders(webhook.GetHeaders()),
+		WorkflowID:    webhook.GetWorkflowId(),
+		NodeID:        webhook.GetNodeId(),
+		Configuration: config,
+		Metadata:      c.metadata,
+		Logger:        logger,
+		Webhook:       c.webhook,
+		Events:        c.events,
+		HTTP:          c.http,
+	}
+}
+
+func errorResult(err error) *pb.InvocationResult {
+	if err != nil {
+		return &pb.InvocationResult{Error: err.Error()}
+	}
+
+	return &pb.InvocationResult{}
+}
+
+//
+// callbackClient sends callbacks to SuperPlane
+// and waits for their responses.
+//
+
+type callbackClient struct {
+	stream  pb.Plugin_InvokeServer
+	sendMu  sync.Mutex
+	mu      sync.Mutex
+	nextID  uint64
+	pending map[uint64]chan *pb.CallbackResponse
+	err     error
+}
+
+func newCallbackClient(stream pb.Plugin_InvokeServer) *callbackClient {
+	return &callbackClient{
+		stream:  stream,
+		pending: map[uint64]chan *pb.CallbackResponse{},
+	}
+}
+
+func (c *callbackClient) send(message *pb.PluginMessage) error {
+	c.sendMu.Lock()
+	defer c.sendMu.Unlock()
+	return c.stream.Send(message)
+}
+
+func (c *callbackClient) receive() {
+	for {
+		message, err := c.stream.Recv()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				err = fmt.Errorf("invocation finished")
+			}
+
+			c.fail(err)
+			return
+		}
+
+		response := message.GetCallbackResponse()
+		if response == nil {
+			continue
+		}
+
+		c.mu.Lock()
+		ch, ok := c.pending[response.Id]
+		delete(c.pending, response.Id)
+		c.mu.Unlock()
+
+		if ok {
+			ch <- response
+		}
+	}
+}
+
+func (c *callbackClient) fail(err error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.err = err
+	for id, ch := range c.pending {
+		close(ch)
+		delete(c.pending, id)
+	}
+}
+
+func (c *callbackClient) call(callback *pb.Callback) (*pb.CallbackResponse, error) {
+	c.mu.Lock()
+	if c.err != nil {
+		c.mu.Unlock()
+		return nil, c.err
+	}
+
+	c.nextID++
+	callback.Id = c.nextID
+	ch := make(chan *pb.CallbackResponse, 1)
+	c.pending[callback.Id] = ch
+	c.mu.Unlock()
+
+	err := c.send(&pb.PluginMessage{Message: &pb.PluginMessage_Callback{Callback: callback}})
+	if err != nil {
+		c.mu.Lock()
+		delete(c.pending, callback.Id)
+		c.mu.Unlock()
+		return nil, err
+	}
+
+	var response *pb.CallbackResponse
+	select {
+	case response = <-ch:
+	case <-c.stream.Context().Done():
+		return nil, c.stream.Context().Err()
+	}
+
+	if response == nil {
+		c.mu.Lock()
+		defer c.mu.Unlock()
+		return nil, c.err
+	}
+
+	if response.Error != "" {
+		return response, errors.New(response.Error)
+	}
+
+	return response, nil
+}
+
+//
+// contexts holds the core contexts available to the invocation.
+// The ones SuperPlane did not give are nil, like for built-in components.
+//
+
+type contexts struct {
+	metadata       core.MetadataContext
+	nodeMetadata   core.MetadataContext
+	executionState core.ExecutionStateContext
+	logs           core.ExecutionLogContext
+	artifacts      core.ArtifactContext
+	http           core.HTTPContext
+	secrets        core.SecretsContext
+	requests       core.RequestContext
+	events         core.EventContext
+	notifications  core.NotificationContext
+	webhook        core.NodeWebhookContext
+	auth           core.AuthContext
+}
+
+func newContexts(invocation *pb.Invocation, client *callbackClient) *contexts {
+	available := func(name string) bool {
+		return slices.Contains(invocation.Contexts, name)
+	}
+
+	c := &contexts{}
+	if available(plugins.ContextMetadata) {
+		c.metadata = &metadataContext{client: client, field: pb.MetadataField_METADATA_FIELD_METADATA}
+	}
+
+	if available(plugins.ContextNodeMetadata) {
+		c.nodeMetadata = &metadataContext{client: client, field: pb.MetadataField_METADATA_FIELD_NODE_METADATA}
+	}
+
+	if available(plugins.ContextExecutionState) {
+		c.executionState = &executionStateContext{client: client}
+	}
+
+	if available(plugins.ContextLogs) {
+		c.logs = &logContext{client: client}
+	}
+
+	if available(plugins.ContextArtifacts) {
+		c.artifacts = &artifactContext{client: client}
+	}
+
+	if available(plugins.ContextHTTP) {
+		c.http = &httpContext{client: client}
+	}
+
+	if available(plugins.ContextSecrets) {
+		c.secrets = &secretsContext{client: client}
+	}
+
+	if available(plugins.ContextRequests) {
+		c.requests = &requestContext{client: client}
+	}
+
+	if available(plugins.ContextEvents) {
+		c.events = &eventContext{client: client}
+	}
+
+	if available(plugins.ContextNotifications) {
+		c.notifications = &notificationContext{client: client}
+	}
+
+	if available(plugins.ContextWebhook) {
+		c.webhook = &webhookContext{client: client, baseURL: invocation.WebhookBaseUrl}
+	}
+
+	if available(plugins.ContextAuth) {
+		c.auth = &authContext{user: invocation.User}
+	}
+
+	return c
+}
@@ -0,0 +1,305 @@
+// Package sdk is used to write SuperPlane plugins in Go.
+//
+// Components and triggers of a plugin implement the same core interfaces
+// as the built-in ones. When SuperPlane invokes them, they receive core contexts
+// that send the calls back to SuperPlane, so they work the same way:
+//
+//	func main() {
+//		err := sdk.Serve(":50051", sdk.Plugin{
+//			Name:       "acme",
+//			Version:    "1.0.0",
+//			Components: []core.Component{&Deploy{}},
+//		})
+//
+//		if err != nil {
+//			log.Fatal(err)
+//		}
+//	}
+//
+// SuperPlane loads the plugins listed in the PLUGINS environment variable.
+// Plugin components and triggers can't use the Integration, CanvasMemory
+// and ExpressionEnv contexts, and only the authenticated user is available
+// in the Auth context. ProcessQueueItem is never called for them,
+// since SuperPlane always uses the default queue processing.
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strings"
+
+	"github.com/superplanehq/superplane/pkg/core"
+	"github.com/superplanehq/superplane/pkg/plugins"
+	pb "github.com/superplanehq/superplane/pkg/protos/plugins"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/health"
+	"google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+type Plugin struct {
+	Name       string
+	Version    string
+	Components []core.Component
+	Triggers   []core.Trigger
+}
+
+func Serve(address string, plugin Plugin) error {
+	server, err := NewServer(plugin)
+	if err != nil {
+		return err
+	}
+
+	listener, err := net.Listen("tcp", address)
+	if err != nil {
+		return fmt.Errorf("error listening on %s: %w", address, err)
+	}
+
+	return server.Serve(listener)
+}
+
+//
+// NewServer returns a gRPC server with the plugin and health services
+// registered, for plugins that need control over how it is served.
+//
+
+func NewServer(plugin Plugin) (*grpc.Server, error) {
+	s, err := newPluginServer(plugin)
+	if err != nil {
+		return nil, err
+	}
+
+	server := grpc.NewServer()
+	pb.RegisterPluginServer(server, s)
+	grpc_health_v1.RegisterHealthServer(server, health.NewServer())
+	return server, nil
+}
+
+type pluginServer struct {
+	pb.UnimplementedPluginServer
+
+	plugin     Plugin
+	components map[string]core.Component
+	triggers   map[string]core.Trigger
+}
+
+func newPluginServer(plugin Plugin) (*pluginServer, error) {
+	if plugin.Name == "" {
+		return nil, fmt.Errorf("plugin name is required")
+	}
+
+	s := &pluginServer{
+		plugin:     plugin,
+		components: map[string]core.Component{},
+		triggers:   map[string]core.Trigger{},
+	}
+
+	for _, component := range plugin.Components {
+		err := validateName(component.Name(), s.components)
+		if err != nil {
+			return nil, fmt.Errorf("component %s: %w", component.Name(), err)
+		}
+
+		s.components[component.Name()] = component
+	}
+
+	for _, trigger := range plugin.Triggers {
+		err := validateName(trigger.Name(), s.triggers)
+		if err != nil {
+			return nil, fmt.Errorf("trigger %s: %w", trigger.Name(), err)
+		}
+
+		s.triggers[trigger.Name()] = trigger
+	}
+
+	return s, nil
+}
+
+//
+// Names with dots are reserved for integration components and triggers.
+//
+
+func validateName[T any](name string, existing map[string]T) error {
+	if name == "" {
+		return fmt.Errorf("name is required")
+	}
+
+	if strings.Contains(name, ".") {
+		return fmt.Errorf("name must not contain dots")
+	}
+
+	if _, ok := existing[name]; ok {
+		return fmt.Errorf("name is used more than once")
+	}
+
+	return nil
+}
+
+func (s *pluginServer) Handshake(ctx context.Context, req *pb.HandshakeRequest) (*pb.HandshakeResponse, error) {
+	capabilities := []string{plugins.CapabilityWebhooks, plugins.CapabilityDynamicOutputChannels}
+	if len(s.components) > 0 {
+		capabilities = append(capabilities, plugins.CapabilityComponents)
+	}
+
+	if len(s.triggers) > 0 {
+		capabilities = append(capabilities, plugins.CapabilityTriggers)
+	}
+
+	for _, trigger := range s.triggers {
+		if _, ok := trigger.(core.InputTrigger); ok {
+			capabilities = append(capabilities, plugins.CapabilityTriggerInput)
+			break
+		}
+	}
+
+	return &pb.HandshakeResponse{
+		ProtocolVersion: plugins.ProtocolVersion,
+		Name:            s.plugin.Name,
+		Version:         s.plugin.Version,
+		Capabilities:    capabilities,
+	}, nil
+}
+
+func (s *pluginServer) ListComponents(ctx context.Context, req *emptypb.Empty) (*pb.ListComponentsResponse, error) {
+	definitions := make([]*pb.ComponentDefinition, 0, len(s.plugin.Components))
+	for _, component := range s.plugin.Components {
+		definition, err := componentDefinition(component)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "component %s: %v", component.Name(), err)
+		}
+
+		definitions = append(definitions, definition)
+	}
+
+	return &pb.ListComponentsResponse{Components: definitions}, nil
+}
+
+func (s *pluginServer) ListTriggers(ctx context.Context, req *emptypb.Empty) (*pb.ListTriggersResponse, error) {
+	definitions := make([]*pb.TriggerDefinition, 0, len(s.plugin.Triggers))
+	for _, trigger := range s.plugin.Triggers {
+		definition, err := triggerDefinition(trigger)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "trigger %s: %v", trigger.Name(), err)
+		}
+
+		definitions = append(definitions, definition)
+	}
+
+	return &pb.ListTriggersResponse{Triggers: definitions}, nil
+}
+
+func (s *pluginServer) OutputChannels(ctx context.Context, req *pb.OutputChannelsRequest) (*pb.OutputChannelsResponse, error) {
+	component, ok := s.components[req.Component]
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "component %s not found", req.Component)
+	}
+
+	config, err := plugins.DecodeJSON(req.Configuration)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid configuration: %v", err)
+	}
+
+	return &pb.OutputChannelsResponse{
+		OutputChannels: plugins.EncodeOutputChannels(component.OutputChannels(config)),
+	}, nil
+}
+
+func (s *pluginServer) ValidateInput(ctx context.Context, req *pb.ValidateInputRequest) (*pb.ValidateInputResponse, error) {
+	trigger, ok := s.triggers[req.Trigger]
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "trigger %s not found", req.Trigger)
+	}
+
+	config, err := plugins.DecodeJSON(req.Configuration)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid configuration: %v", err)
+	}
+
+	input, err := plugins.DecodeJSONMap(req.Input)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid input: %v", err)
+	}
+
+	inputTrigger, ok := trigger.(core.InputTrigger)
+	if !ok {
+		return &pb.ValidateInputResponse{Input: req.Input}, nil
+	}
+
+	validated, err := inputTrigger.ValidateInput(config, input)
+	if err != nil {
+		return &pb.ValidateInputResponse{Error: err.Error()}, nil
+	}
+
+	data, err := plugins.EncodeJSON(validated)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "error encoding input: %v", err)
+	}
+
+	return &pb.ValidateInputResponse{Input: data}, nil
+}
+
+func componentDefinition(component core.Component) (*pb.ComponentDefinition, error) {
+	exampleOutput, err := plugins.EncodeJSON(component.ExampleOutput())
+	if err != nil {
+		return nil, fmt.Errorf("error encoding example output: %w", err)
+	}
+
+	config, err := plugins.EncodeJSON(component.Configuration())
+	if err != nil {
+		return nil, fmt.Errorf("error encoding configuration: %w", err)
+	}
+
+	actions, err := plugins.EncodeActions(component.Actions())
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ComponentDefinition{
+		Name:                  component.Name(),
+		Label:                 component.Label(),
+		Description:           component.Description(),
+		Documentation:         component.Documentation(),
+		Icon:                  component.Icon(),
+		Color:                 component.Color(),
+		ExampleOutput:         exampleOutput,
+		Configuration:         config,
+		OutputChannels:        plugins.EncodeOutputChannels(component.OutputChannels(nil)),
+		DynamicOutputChannels: true,
+		Actions:               actions,
+	}, nil
+}
+
+func triggerDefinition(trigger core.Trigger) (*pb.TriggerDefinition, error) {
+	exampleData, err := plugins.EncodeJSON(trigger.ExampleData())
+	if err != nil {
+		return nil, fmt.Errorf("error encoding example data: %w", err)
+	}
+
+	config, err := plugins.EncodeJSON(trigger.Configuration())
+	if err != nil {
+		return nil, fmt.Errorf("error encoding configuration: %w", err)
+	}
+
+	actions, err := plugins.EncodeActions(trigger.Actions())
+	if err != nil {
+		return nil, err
+	}
+
+	_, acceptsInput := trigger.(core.InputTrigger)
+
+	return &pb.TriggerDefinition{
+		Name:          trigger.Name(),
+		Label:         trigger.Label(),
+		Description:   trigger.Description(),
+		Documentation: trigger.Documentation(),
+		Icon:          trigger.Icon(),
+		Color:         trigger.Color(),
+		ExampleData:   exampleData,
+		Configuration: config,
+		Actions:       actions,
+		AcceptsInput:  acceptsInput,
+	}, nil
+}
@@ -0,0 +1,199 @@
+package plugins
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/superplanehq/superplane/pkg/configuration"
+	"github.com/superplanehq/superplane/pkg/core"
+	pb "github.com/superplanehq/superplane/pkg/protos/plugins"
+)
+
+//
+// Trigger is a trigger provided by a plugin.
+// Triggers accepting input for manual runs are returned as InputTrigger.
+//
+
+type Trigger struct {
+	plugin        *Plugin
+	definition    *pb.TriggerDefinition
+	configuration []configuration.Field
+	exampleData   map[string]any
+	actions       []core.Action
+}
+
+type InputTrigger struct {
+	*Trigger
+}
+
+func newTrigger(plugin *Plugin, definition *pb.TriggerDefinition) (core.Trigger, error) {
+	fields, err := DecodeFields(definition.Configuration)
+	if err != nil {
+		return nil, fmt.Errorf("invalid configuration: %w", err)
+	}
+
+	exampleData, err := DecodeJSONMap(definition.ExampleData)
+	if err != nil {
+		return nil, fmt.Errorf("invalid example data: %w", err)
+	}
+
+	actions, err := DecodeActions(definition.Actions)
+	if err != nil {
+		return nil, err
+	}
+
+	trigger := &Trigger{
+		plugin:        plugin,
+		definition:    definition,
+		configuration: fields,
+		exampleData:   exampleData,
+		actions:       actions,
+	}
+
+	if definition.AcceptsInput && plugin.HasCapability(CapabilityTriggerInput) {
+		return &InputTrigger{Trigger: trigger}, nil
+	}
+
+	return trigger, nil
+}
+
+func (t *Trigger) Name() string {
+	return t.definition.Name
+}
+
+func (t *Trigger) Label() string {
+	return t.definition.Label
+}
+
+func (t *Trigger) Description() string {
+	return t.definition.Description
+}
+
+func (t *Trigger) Documentation() string {
+	return t.definition.Documentation
+}
+
+func (t *Trigger) Icon() string {
+	return t.definition.Icon
+}
+
+func (t *Trigger) Color() string {
+	return t.definition.Color
+}
+
+func (t *Trigger) ExampleData() map[string]any {
+	return t.exampleData
+}
+
+func (t *Trigger) Configuration() []configuration.Field {
+	return t.configuration
+}
+
+func (t *Trigger) Actions() []core.Action {
+	return t.actions
+}
+
+func (t *Trigger) Setup(ctx core.TriggerContext) error {
+	_, err := t.invoke(pb.Method_METHOD_SETUP, ctx.Configuration, &pb.Invocation{}, triggerCallbacks(ctx), webhookBaseURL(ctx.Webhook))
+	return err
+}
+
+func (t *Trigger) HandleAction(ctx core.TriggerActionContext) (map[string]any, error) {
+	parameters, err := EncodeJSON(ctx.Parameters)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := t.invoke(pb.Method_METHOD_HANDLE_ACTION, ctx.Configuration, &pb.Invocation{
+		Action:     ctx.Name,
+		Parameters: parameters,
+	}, &callbacks{
+		metadata: ctx.Metadata,
+		http:     ctx.HTTP,
+		requests: ctx.Requests,
+		events:   ctx.Events,
+		webhook:  ctx.Webhook,
+	}, webhookBaseURL(ctx.Webhook))
+
+	if err != nil {
+		return nil, err
+	}
+
+	return DecodeJSONMap(result.Output)
+}
+
+func (t *Trigger) HandleWebhook(ctx core.WebhookRequestContext) (int, error) {
+	result, err := t.invoke(pb.Method_METHOD_HANDLE_WEBHOOK, ctx.Configuration, webhookInvocation(ctx), &callbacks{
+		metadata: ctx.Metadata,
+		http:     ctx.HTTP,
+		events:   ctx.Events,
+		webhook:  ctx.Webhook,
+	}, webhookBaseURL(ctx.Webhook))
+
+	return webhookStatus(result, err)
+}
+
+func (t *Trigger) Cleanup(ctx core.TriggerContext) error {
+	_, err := t.invoke(pb.Method_METHOD_CLEANUP, ctx.Configuration, &pb.Invocation{}, triggerCallbacks(ctx), webhookBaseURL(ctx.Webhook))
+	return err
+}
+
+func (t *Trigger) invoke(method pb.Method, config any, invocation *pb.Invocation, callbacks *callbacks, webhookBaseURL string) (*pb.InvocationResult, error) {
+	data, err := EncodeJSON(config)
+	if err != nil {
+		return nil, fmt.Errorf("error encoding configuration: %w", err)
+	}
+
+	invocation.Kind = pb.Kind_KIND_TRIGGER
+	invocation.Name = t.Name()
+	invocation.Method = method
+	invocation.Configuration = data
+	invocation.WebhookBaseUrl = webhookBaseURL
+	return t.plugin.invoke(invocation, callbacks)
+}
+
+func triggerCallbacks(ctx core.TriggerContext) *callbacks {
+	return &callbacks{
+		metadata: ctx.Metadata,
+		http:     ctx.HTTP,
+		requests: ctx.Requests,
+		events:   ctx.Events,
+		webhook:  ctx.Webhook,
+	}
+}
+
+func (t *InputTrigger) ValidateInput(config any, input map[string]any) (map[string]any, error) {
+	if !t.plugin.Available() {
+		return nil, fmt.Errorf("%w: %s", ErrPluginUnavailable, t.plugin.Name)
+	}
+
+	configData, err := EncodeJSON(config)
+	if err != nil {
+		return nil, err
+	}
+
+	inputData, err := EncodeJSON(input)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), DefaultQueryTimeout)
+	defer cancel()
+
+	response, err := t.plugin.client.ValidateInput(ctx, &pb.ValidateInputRequest{
+		Trigger:       t.Name(),
+		Configuration: configData,
+		Input:         inputData,
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("error validating input with plugin %s: %w", t.plugin.Name, err)
+	}
+
+	if response.Error != "" {
+		return nil, errors.New(response.Error)
+	}
+
+	return DecodeJSONMap(response.Input)
+}
@@ -26,6 +26,113 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type DoraInterval int32
+
+const (
+	DoraInterval_DORA_INTERVAL_UNSPECIFIED DoraInterval = 0
+	DoraInterval_DORA_INTERVAL_DAY         DoraInterval = 1
+	DoraInterval_DORA_INTERVAL_WEEK        DoraInterval = 2
+)
+
+// Enum value maps for DoraInterval.
+var (
+	DoraInterval_name = map[int32]string{
+		0: "DORA_INTERVAL_UNSPECIFIED",
+		1: "DORA_INTERVAL_DAY",
+		2: "DORA_INTERVAL_WEEK",
+	}
+	DoraInterval_value = map[string]int32{
+		"DORA_INTERVAL_UNSPECIFIED": 0,
+		"DORA_INTERVAL_DAY":         1,
+		"DORA_INTERVAL_WEEK":        2,
+	}
+)
+
+func (x DoraInterval) Enum() *DoraInterval {
+	p := new(DoraInterval)
+	*p = x
+	return p
+}
+
+func (x DoraInterval) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DoraInterval) Descriptor() protoreflect.EnumDescriptor {
+	return file_canvases_proto_enumTypes[0].Descriptor()
+}
+
+func (DoraInterval) Type() protoreflect.EnumType {
+	return &file_canvases_proto_enumTypes[0]
+}
+
+func (x DoraInterval) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DoraInterval.Descriptor instead.
+func (DoraInterval) EnumDescriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{0}
+}
+
+type CanvasChangeType int32
+
+const (
+	CanvasChangeType_CANVAS_CHANGE_TYPE_UNKNOWN            CanvasChangeType = 0
+	CanvasChangeType_CANVAS_CHANGE_TYPE_HEARTBEAT          CanvasChangeType = 1
+	CanvasChangeType_CANVAS_CHANGE_TYPE_EVENT_CREATED      CanvasChangeType = 2
+	CanvasChangeType_CANVAS_CHANGE_TYPE_EXECUTION_UPDATED  CanvasChangeType = 3
+	CanvasChangeType_CANVAS_CHANGE_TYPE_QUEUE_ITEM_CREATED CanvasChangeType = 4
+	CanvasChangeType_CANVAS_CHANGE_TYPE_QUEUE_ITEM_DELETED CanvasChangeType = 5
+)
+
+// Enum value maps for CanvasChangeType.
+var (
+	CanvasChangeType_name = map[int32]string{
+		0: "CANVAS_CHANGE_TYPE_UNKNOWN",
+		1: "CANVAS_CHANGE_TYPE_HEARTBEAT",
+		2: "CANVAS_CHANGE_TYPE_EVENT_CREATED",
+		3: "CANVAS_CHANGE_TYPE_EXECUTION_UPDATED",
+		4: "CANVAS_CHANGE_TYPE_QUEUE_ITEM_CREATED",
+		5: "CANVAS_CHANGE_TYPE_QUEUE_ITEM_DELETED",
+	}
+	CanvasChangeType_value = map[string]int32{
+		"CANVAS_CHANGE_TYPE_UNKNOWN":            0,
+		"CANVAS_CHANGE_TYPE_HEARTBEAT":          1,
+		"CANVAS_CHANGE_TYPE_EVENT_CREATED":      2,
+		"CANVAS_CHANGE_TYPE_EXECUTION_UPDATED":  3,
+		"CANVAS_CHANGE_TYPE_QUEUE_ITEM_CREATED": 4,
+		"CANVAS_CHANGE_TYPE_QUEUE_ITEM_DELETED": 5,
+	}
+)
+
+func (x CanvasChangeType) Enum() *CanvasChangeType {
+	p := new(CanvasChangeType)
+	*p = x
+	return p
+}
+
+func (x CanvasChangeType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CanvasChangeType) Descriptor() protoreflect.EnumDescriptor {
+	return file_canvases_proto_enumTypes[1].Descriptor()
+}
+
+func (CanvasChangeType) Type() protoreflect.EnumType {
+	return &file_canvases_proto_enumTypes[1]
+}
+
+func (x CanvasChangeType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CanvasChangeType.Descriptor instead.
+func (CanvasChangeType) EnumDescriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{1}
+}
+
 type CanvasAutoLayout_Algorithm int32
 
 const (
@@ -56,11 +163,11 @@ func (x CanvasAutoLayout_Algorithm) String() string {
 }
 
 func (CanvasAutoLayout_Algorithm) Descriptor() protoreflect.EnumDescriptor {
-	return file_canvases_proto_enumTypes[0].Descriptor()
+	return file_canvases_proto_enumTypes[2].Descriptor()
 }
 
 func (CanvasAutoLayout_Algorithm) Type() protoreflect.EnumType {
-	return &file_canvases_proto_enumTypes[0]
+	return &file_canvases_proto_enumTypes[2]
 }
 
 func (x CanvasAutoLayout_Algorithm) Number() protoreflect.EnumNumber {
@@ -108,11 +215,11 @@ func (x CanvasAutoLayout_Scope) String() string {
 }
 
 func (CanvasAutoLayout_Scope) Descriptor() protoreflect.EnumDescriptor {
-	return file_canvases_proto_enumTypes[1].Descriptor()
+	return file_canvases_proto_enumTypes[3].Descriptor()
 }
 
 func (CanvasAutoLayout_Scope) Type() protoreflect.EnumType {
-	return &file_canvases_proto_enumTypes[1]
+	return &file_canvases_proto_enumTypes[3]
 }
 
 func (x CanvasAutoLayout_Scope) Number() protoreflect.EnumNumber {
@@ -124,6 +231,58 @@ func (CanvasAutoLayout_Scope) EnumDescriptor() ([]byte, []int) {
 	return file_canvases_proto_rawDescGZIP(), []int{6, 1}
 }
 
+type CanvasPlan_Action int32
+
+const (
+	CanvasPlan_ACTION_UNSPECIFIED CanvasPlan_Action = 0
+	CanvasPlan_ACTION_ADD         CanvasPlan_Action = 1
+	CanvasPlan_ACTION_REMOVE      CanvasPlan_Action = 2
+	CanvasPlan_ACTION_CHANGE      CanvasPlan_Action = 3
+)
+
+// Enum value maps for CanvasPlan_Action.
+var (
+	CanvasPlan_Action_name = map[int32]string{
+		0: "ACTION_UNSPECIFIED",
+		1: "ACTION_ADD",
+		2: "ACTION_REMOVE",
+		3: "ACTION_CHANGE",
+	}
+	CanvasPlan_Action_value = map[string]int32{
+		"ACTION_UNSPECIFIED": 0,
+		"ACTION_ADD":         1,
+		"ACTION_REMOVE":      2,
+		"ACTION_CHANGE":      3,
+	}
+)
+
+func (x CanvasPlan_Action) Enum() *CanvasPlan_Action {
+	p := new(CanvasPlan_Action)
+	*p = x
+	return p
+}
+
+func (x CanvasPlan_Action) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CanvasPlan_Action) Descriptor() protoreflect.EnumDescriptor {
+	return file_canvases_proto_enumTypes[4].Descriptor()
+}
+
+func (CanvasPlan_Action) Type() protoreflect.EnumType {
+	return &file_canvases_proto_enumTypes[4]
+}
+
+func (x CanvasPlan_Action) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CanvasPlan_Action.Descriptor instead.
+func (CanvasPlan_Action) EnumDescriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{9, 0}
+}
+
 type CanvasNodeExecution_State int32
 
 const (
@@ -160,11 +319,11 @@ func (x CanvasNodeExecution_State) String() string {
 }
 
 func (CanvasNodeExecution_State) Descriptor() protoreflect.EnumDescriptor {
-	return file_canvases_proto_enumTypes[2].Descriptor()
+	return file_canvases_proto_enumTypes[5].Descriptor()
 }
 
 func (CanvasNodeExecution_State) Type() protoreflect.EnumType {
-	return &file_canvases_proto_enumTypes[2]
+	return &file_canvases_proto_enumTypes[5]
 }
 
 func (x CanvasNodeExecution_State) Number() protoreflect.EnumNumber {
@@ -173,7 +332,7 @@ func (x CanvasNodeExecution_State) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use CanvasNodeExecution_State.Descriptor instead.
 func (CanvasNodeExecution_State) EnumDescriptor() ([]byte, []int) {
-	return file_canvases_proto_rawDescGZIP(), []int{27, 0}
+	return file_canvases_proto_rawDescGZIP(), []int{28, 0}
 }
 
 type CanvasNodeExecution_Result int32
@@ -212,11 +371,11 @@ func (x CanvasNodeExecution_Result) String() string {
 }
 
 func (CanvasNodeExecution_Result) Descriptor() protoreflect.EnumDescriptor {
-	return file_canvases_proto_enumTypes[3].Descriptor()
+	return file_canvases_proto_enumTypes[6].Descriptor()
 }
 
 func (CanvasNodeExecution_Result) Type() protoreflect.EnumType {
-	return &file_canvases_proto_enumTypes[3]
+	return &file_canvases_proto_enumTypes[6]
 }
 
 func (x CanvasNodeExecution_Result) Number() protoreflect.EnumNumber {
@@ -225,7 +384,7 @@ func (x CanvasNodeExecution_Result) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use CanvasNodeExecution_Result.Descriptor instead.
 func (CanvasNodeExecution_Result) EnumDescriptor() ([]byte, []int) {
-	return file_canvases_proto_rawDescGZIP(), []int{27, 1}
+	return file_canvases_proto_rawDescGZIP(), []int{28, 1}
 }
 
 type CanvasNodeExecution_ResultReason int32
@@ -261,11 +420,11 @@ func (x CanvasNodeExecution_ResultReason) String() string {
 }
 
 func (CanvasNodeExecution_ResultReason) Descriptor() protoreflect.EnumDescriptor {
-	return file_canvases_proto_enumTypes[4].Descriptor()
+	return file_canvases_proto_enumTypes[7].Descriptor()
 }
 
 func (CanvasNodeExecution_ResultReason) Type() protoreflect.EnumType {
-	return &file_canvases_proto_enumTypes[4]
+	return &file_canvases_proto_enumTypes[7]
 }
 
 func (x CanvasNodeExecution_ResultReason) Number() protoreflect.EnumNumber {
@@ -274,7 +433,7 @@ func (x CanvasNodeExecution_ResultReason) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use CanvasNodeExecution_ResultReason.Descriptor instead.
 func (CanvasNodeExecution_ResultReason) EnumDescriptor() ([]byte, []int) {
-	return file_canvases_proto_rawDescGZIP(), []int{27, 2}
+	return file_canvases_proto_rawDescGZIP(), []int{28, 2}
 }
 
 type ListCanvasesRequest struct {
@@ -606,6 +765,7 @@ type UpdateCanvasRequest struct {
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	Canvas        *Canvas                `protobuf:"bytes,2,opt,name=canvas,proto3" json:"canvas,omitempty"`
 	AutoLayout    *CanvasAutoLayout      `protobuf:"bytes,3,opt,name=auto_layout,json=autoLayout,proto3" json:"auto_layout,omitempty"`
+	DryRun        bool                   `protobuf:"varint,4,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -661,9 +821,17 @@ func (x *UpdateCanvasRequest) GetAutoLayout() *CanvasAutoLayout {
 	return nil
 }
 
+func (x *UpdateCanvasRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
 type UpdateCanvasResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Canvas        *Canvas                `protobuf:"bytes,1,opt,name=canvas,proto3" json:"canvas,omitempty"`
+	Plan          *CanvasPlan            `protobuf:"bytes,2,opt,name=plan,proto3" json:"plan,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -705,6 +873,99 @@ func (x *UpdateCanvasResponse) GetCanvas() *Canvas {
 	return nil
 }
 
+func (x *UpdateCanvasResponse) GetPlan() *CanvasPlan {
+	if x != nil {
+		return x.Plan
+	}
+	return nil
+}
+
+// CanvasPlan describes what an update does, without doing it.
+// It is returned by UpdateCanvas when dry_run is set.
+type CanvasPlan struct {
+	state         protoimpl.MessageState           `protogen:"open.v1"`
+	Nodes         []*CanvasPlan_NodeChange         `protobuf:"bytes,1,rep,name=nodes,proto3" json:"nodes,omitempty"`
+	Edges         []*CanvasPlan_EdgeChange         `protobuf:"bytes,2,rep,name=edges,proto3" json:"edges,omitempty"`
+	Webhooks      []*CanvasPlan_WebhookChange      `protobuf:"bytes,3,rep,name=webhooks,proto3" json:"webhooks,omitempty"`
+	Subscriptions []*CanvasPlan_SubscriptionChange `protobuf:"bytes,4,rep,name=subscriptions,proto3" json:"subscriptions,omitempty"`
+	Orphaned      []*CanvasPlan_OrphanedNode       `protobuf:"bytes,5,rep,name=orphaned,proto3" json:"orphaned,omitempty"`
+	Canvas        []*CanvasPlan_FieldChange        `protobuf:"bytes,6,rep,name=canvas,proto3" json:"canvas,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CanvasPlan) Reset() {
+	*x = CanvasPlan{}
+	mi := &file_canvases_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CanvasPlan) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CanvasPlan) ProtoMessage() {}
+
+func (x *CanvasPlan) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CanvasPlan.ProtoReflect.Descriptor instead.
+func (*CanvasPlan) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *CanvasPlan) GetNodes() []*CanvasPlan_NodeChange {
+	if x != nil {
+		return x.Nodes
+	}
+	return nil
+}
+
+func (x *CanvasPlan) GetEdges() []*CanvasPlan_EdgeChange {
+	if x != nil {
+		return x.Edges
+	}
+	return nil
+}
+
+func (x *CanvasPlan) GetWebhooks() []*CanvasPlan_WebhookChange {
+	if x != nil {
+		return x.Webhooks
+	}
+	return nil
+}
+
+func (x *CanvasPlan) GetSubscriptions() []*CanvasPlan_SubscriptionChange {
+	if x != nil {
+		return x.Subscriptions
+	}
+	return nil
+}
+
+func (x *CanvasPlan) GetOrphaned() []*CanvasPlan_OrphanedNode {
+	if x != nil {
+		return x.Orphaned
+	}
+	return nil
+}
+
+func (x *CanvasPlan) GetCanvas() []*CanvasPlan_FieldChange {
+	if x != nil {
+		return x.Canvas
+	}
+	return nil
+}
+
 type DeleteCanvasRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -714,7 +975,7 @@ type DeleteCanvasRequest struct {
 
 func (x *DeleteCanvasRequest) Reset() {
 	*x = DeleteCanvasRequest{}
-	mi := &file_canvases_proto_msgTypes[9]
+	mi := &file_canvases_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -726,7 +987,7 @@ func (x *DeleteCanvasRequest) String() string {
 func (*DeleteCanvasRequest) ProtoMessage() {}
 
 func (x *DeleteCanvasRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_canvases_proto_msgTypes[9]
+	mi := &file_canvases_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -739,7 +1000,7 @@ func (x *DeleteCanvasRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteCanvasRequest.ProtoReflect.Descriptor instead.
 func (*DeleteCanvasRequest) Descriptor() ([]byte, []int) {
-	return file_canvases_proto_rawDescGZIP(), []int{9}
+	return file_canvases_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *DeleteCanvasRequest) GetId() string {
@@ -757,7 +1018,7 @@ type DeleteCanvasResponse struct {
 
 func (x *DeleteCanvasResponse) Reset() {
 	*x = DeleteCanvasResponse{}
-	mi := &file_canvases_proto_msgTypes[10]
+	mi := &file_canvases_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -769,7 +1030,7 @@ func (x *DeleteCanvasResponse) String() string {
 func (*DeleteCanvasResponse) ProtoMessage() {}
 
 func (x *DeleteCanvasResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_canvases_proto_msgTypes[10]
+	mi := &file_canvases_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -782,7 +1043,7 @@ func (x *DeleteCanvasResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteCanvasResponse.ProtoReflect.Descriptor instead.
 func (*DeleteCanvasResponse) Descriptor() ([]byte, []int) {
-	return file_canvases_proto_rawDescGZIP(), []int{10}
+	return file_canvases_proto_rawDescGZIP(), []int{11}
 }
 
 type UserRef struct {
@@ -795,7 +1056,7 @@ type UserRef struct {
 
 func (x *UserRef) Reset() {
 	*x = UserRef{}
-	mi := &file_canvases_proto_msgTypes[11]
+	mi := &file_canvases_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -807,7 +1068,7 @@ func (x *UserRef) String() string {
 func (*UserRef) ProtoMessage() {}
 
 func (x *UserRef) ProtoReflect() protoreflect.Message {
-	mi := &file_canvases_proto_msgTypes[11]
+	mi := &file_canvases_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -820,7 +1081,7 @@ func (x *UserRef) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UserRef.ProtoReflect.Descriptor instead.
 func (*UserRef) Descriptor() ([]byte, []int) {
-	return file_canvases_proto_rawDescGZIP(), []int{11}
+	return file_canvases_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *UserRef) GetId() string {
@@ -848,7 +1109,7 @@ type Canvas struct {
 
 func (x *Canvas) Reset() {
 	*x = Canvas{}
-	mi := &file_canvases_proto_msgTypes[12]
+	mi := &file_canvases_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -860,7 +1121,7 @@ func (x *Canvas) String() string {
 func (*Canvas) ProtoMessage() {}
 
 func (x *Canvas) ProtoReflect() protoreflect.Message {
-	mi := &file_canvases_proto_msgTypes[12]
+	mi := &file_canvases_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -873,7 +1134,7 @@ func (x *Canvas) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Canvas.ProtoReflect.Descriptor instead.
 func (*Canvas) Descriptor() ([]byte, []int) {
-	return file_canvases_proto_rawDescGZIP(), []int{12}
+	return file_canvases_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *Canvas) GetMetadata() *Canvas_Metadata {
@@ -909,7 +1170,7 @@ type ListNodeEventsRequest struct {
 
 func (x *ListNodeEventsRequest) Reset() {
 	*x = ListNodeEventsRequest{}
-	mi := &file_canvases_proto_msgTypes[13]
+	mi := &file_canvases_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -921,7 +1182,7 @@ func (x *ListNodeEventsRequest) String() string {
 func (*ListNodeEventsRequest) ProtoMessage() {}
 
 func (x *ListNodeEventsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_canvases_proto_msgTypes[13]
+	mi := &file_canvases_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -934,7 +1195,7 @@ func (x *ListNodeEventsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListNodeEventsRequest.ProtoReflect.Descriptor instead.
 func (*ListNodeEventsRequest) Descriptor() ([]byte, []int) {
-	return file_canvases_proto_rawDescGZIP(), []int{13}
+	return file_canvases_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *ListNodeEventsRequest) GetCanvasId() string {
@@ -977,7 +1238,7 @@ type ListNodeEventsResponse struct {
 
 func (x *ListNodeEventsResponse) Reset() {
 	*x = ListNodeEventsResponse{}
-	mi := &file_canvases_proto_msgTypes[14]
+	mi := &file_canvases_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -989,7 +1250,7 @@ func (x *ListNodeEventsResponse) String() string {
 func (*ListNodeEventsResponse) ProtoMessage() {}
 
 func (x *ListNodeEventsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_canvases_proto_msgTypes[14]
+	mi := &file_canvases_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1002,7 +1263,7 @@ func (x *ListNodeEventsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListNodeEventsResponse.ProtoReflect.Descriptor instead.
 func (*ListNodeEventsResponse) Descriptor() ([]byte, []int) {
-	return file_canvases_proto_rawDescGZIP(), []int{14}
+	return file_canvases_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *ListNodeEventsResponse) GetEvents() []*CanvasEvent {
@@ -1045,7 +1306,7 @@ type EmitNodeEventRequest struct {
 
 func (x *EmitNodeEventRequest) Reset() {
 	*x = EmitNodeEventRequest{}
-	mi := &file_canvases_proto_msgTypes[15]
+	mi := &file_canvases_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1057,7 +1318,7 @@ func (x *EmitNodeEventRequest) String() string {
 func (*EmitNodeEventRequest) ProtoMessage() {}
 
 func (x *EmitNodeEventRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_canvases_proto_msgTypes[15]
+	mi := &file_canvases_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1070,7 +1331,7 @@ func (x *EmitNodeEventRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EmitNodeEventRequest.ProtoReflect.Descriptor instead.
 func (*EmitNodeEventRequest) Descriptor() ([]byte, []int) {
-	return file_canvases_proto_rawDescGZIP(), []int{15}
+	return file_canvases_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *EmitNodeEventRequest) GetCanvasId() string {
@@ -1110,7 +1371,7 @@ type EmitNodeEventResponse struct {
 
 func (x *EmitNodeEventResponse) Reset() {
 	*x = EmitNodeEventResponse{}
-	mi := &file_canvases_proto_msgTypes[16]
+	mi := &file_canvases_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1122,7 +1383,7 @@ func (x *EmitNodeEventResponse) String() string {
 func (*EmitNodeEventResponse) ProtoMessage() {}
 
 func (x *EmitNodeEventResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_canvases_proto_msgTypes[16]
+	mi := &file_canvases_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1135,7 +1396,7 @@ func (x *EmitNodeEventResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EmitNodeEventResponse.ProtoReflect.Descriptor instead.
 func (*EmitNodeEventResponse) Descriptor() ([]byte, []int) {
-	return file_canvases_proto_rawDescGZIP(), []int{16}
+	return file_canvases_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *EmitNodeEventResponse) GetEventId() string {
@@ -1157,7 +1418,7 @@ type ListNodeQueueItemsRequest struct {
 
 func (x *ListNodeQueueItemsRequest) Reset() {
 	*x = ListNodeQueueItemsRequest{}
-	mi := &file_canvases_proto_msgTypes[17]
+	mi := &file_canvases_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1169,7 +1430,7 @@ func (x *ListNodeQueueItemsRequest) String() string {
 func (*ListNodeQueueItemsRequest) ProtoMessage() {}
 
 func (x *ListNodeQueueItemsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_canvases_proto_msgTypes[17]
+	mi := &file_canvases_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1182,7 +1443,7 @@ func (x *ListNodeQueueItemsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListNodeQueueItemsRequest.ProtoReflect.Descriptor instead.
 func (*ListNodeQueueItemsRequest) Descriptor() ([]byte, []int) {
-	return file_canvases_proto_rawDescGZIP(), []int{17}
+	return file_canvases_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *ListNodeQueueItemsRequest) GetCanvasId() string {
@@ -1225,7 +1486,7 @@ type ListNodeQueueItemsResponse struct {
 
 func (x *ListNodeQueueItemsResponse) Reset() {
 	*x = ListNodeQueueItemsResponse{}
-	mi := &file_canvases_proto_msgTypes[18]
+	mi := &file_canvases_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1237,7 +1498,7 @@ func (x *ListNodeQueueItemsResponse) String() string {
 func (*ListNodeQueueItemsResponse) ProtoMessage() {}
 
 func (x *ListNodeQueueItemsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_canvases_proto_msgTypes[18]
+	mi := &file_canvases_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1250,7 +1511,7 @@ func (x *ListNodeQueueItemsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListNodeQueueItemsResponse.ProtoReflect.Descriptor instead.
 func (*ListNodeQueueItemsResponse) Descriptor() ([]byte, []int) {
-	return file_canvases_proto_rawDescGZIP(), []int{18}
+	return file_canvases_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *ListNodeQueueItemsResponse) GetItems() []*CanvasNodeQueueItem {
@@ -1292,7 +1553,7 @@ type DeleteNodeQueueItemRequest struct {
 
 func (x *DeleteNodeQueueItemRequest) Reset() {
 	*x = DeleteNodeQueueItemRequest{}
-	mi := &file_canvases_proto_msgTypes[19]
+	mi := &file_canvases_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1304,7 +1565,7 @@ func (x *DeleteNodeQueueItemRequest) String() string {
 func (*DeleteNodeQueueItemRequest) ProtoMessage() {}
 
 func (x *DeleteNodeQueueItemRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_canvases_proto_msgTypes[19]
+	mi := &file_canvases_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1317,7 +1578,7 @@ func (x *DeleteNodeQueueItemRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteNodeQueueItemRequest.ProtoReflect.Descriptor instead.
 func (*DeleteNodeQueueItemRequest) Descriptor() ([]byte, []int) {
-	return file_canvases_proto_rawDescGZIP(), []int{19}
+	return file_canvases_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *DeleteNodeQueueItemRequest) GetCanvasId() string {
@@ -1349,7 +1610,7 @@ type DeleteNodeQueueItemResponse struct {
 
 func (x *DeleteNodeQueueItemResponse) Reset() {
 	*x = DeleteNodeQueueItemResponse{}
-	mi := &file_canvases_proto_msgTypes[20]
+	mi := &file_canvases_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1361,7 +1622,7 @@ func (x *DeleteNodeQueueItemResponse) String() string {
 func (*DeleteNodeQueueItemResponse) ProtoMessage() {}
 
 func (x *DeleteNodeQueueItemResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_canvases_proto_msgTypes[20]
+	mi := &file_canvases_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1374,7 +1635,7 @@ func (x *DeleteNodeQueueItemResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteNodeQueueItemResponse.ProtoReflect.Descriptor instead.
 func (*DeleteNodeQueueItemResponse) Descriptor() ([]byte, []int) {
-	return file_canvases_proto_rawDescGZIP(), []int{20}
+	return file_canvases_proto_rawDescGZIP(), []int{21}
 }
 
 type UpdateNodePauseRequest struct {
@@ -1388,7 +1649,7 @@ type UpdateNodePauseRequest struct {
 
 func (x *UpdateNodePauseRequest) Reset() {
 	*x = UpdateNodePauseRequest{}
-	mi := &file_canvases_proto_msgTypes[21]
+	mi := &file_canvases_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1400,7 +1661,7 @@ func (x *UpdateNodePauseRequest) String() string {
 func (*UpdateNodePauseRequest) ProtoMessage() {}
 
 func (x *UpdateNodePauseRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_canvases_proto_msgTypes[21]
+	mi := &file_canvases_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1413,7 +1674,7 @@ func (x *UpdateNodePauseRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateNodePauseRequest.ProtoReflect.Descriptor instead.
 func (*UpdateNodePauseRequest) Descriptor() ([]byte, []int) {
-	return file_canvases_proto_rawDescGZIP(), []int{21}
+	return file_canvases_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *UpdateNodePauseRequest) GetCanvasId() string {
@@ -1446,7 +1707,7 @@ type UpdateNodePauseResponse struct {
 
 func (x *UpdateNodePauseResponse) Reset() {
 	*x = UpdateNodePauseResponse{}
-	mi := &file_canvases_proto_msgTypes[22]
+	mi := &file_canvases_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1458,7 +1719,7 @@ func (x *UpdateNodePauseResponse) String() string {
 func (*UpdateNodePauseResponse) ProtoMessage() {}
 
 func (x *UpdateNodePauseResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_canvases_proto_msgTypes[22]
+	mi := &file_canvases_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1471,7 +1732,7 @@ func (x *UpdateNodePauseResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateNodePauseResponse.ProtoReflect.Descriptor instead.
 func (*UpdateNodePauseResponse) Descriptor() ([]byte, []int) {
-	return file_canvases_proto_rawDescGZIP(), []int{22}
+	return file_canvases_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *UpdateNodePauseResponse) GetNode() *components.Node {
@@ -1495,7 +1756,7 @@ type ListNodeExecutionsRequest struct {
 
 func (x *ListNodeExecutionsRequest) Reset() {
 	*x = ListNodeExecutionsRequest{}
-	mi := &file_canvases_proto_msgTypes[23]
+	mi := &file_canvases_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1507,7 +1768,7 @@ func (x *ListNodeExecutionsRequest) String() string {
 func (*ListNodeExecutionsRequest) ProtoMessage() {}
 
 func (x *ListNodeExecutionsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_canvases_proto_msgTypes[23]
+	mi := &file_canvases_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1520,7 +1781,7 @@ func (x *ListNodeExecutionsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListNodeExecutionsRequest.ProtoReflect.Descriptor instead.
 func (*ListNodeExecutionsRequest) Descriptor() ([]byte, []int) {
-	return file_canvases_proto_rawDescGZIP(), []int{23}
+	return file_canvases_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *ListNodeExecutionsRequest) GetCanvasId() string {
@@ -1577,7 +1838,7 @@ type ListNodeExecutionsResponse struct {
 
 func (x *ListNodeExecutionsResponse) Reset() {
 	*x = ListNodeExecutionsResponse{}
-	mi := &file_canvases_proto_msgTypes[24]
+	mi := &file_canvases_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1589,7 +1850,7 @@ func (x *ListNodeExecutionsResponse) String() string {
 func (*ListNodeExecutionsResponse) ProtoMessage() {}
 
 func (x *ListNodeExecutionsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_canvases_proto_msgTypes[24]
+	mi := &file_canvases_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1602,7 +1863,7 @@ func (x *ListNodeExecutionsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListNodeExecutionsResponse.ProtoReflect.Descriptor instead.
 func (*ListNodeExecutionsResponse) Descriptor() ([]byte, []int) {
-	return file_canvases_proto_rawDescGZIP(), []int{24}
+	return file_canvases_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *ListNodeExecutionsResponse) GetExecutions() []*CanvasNodeExecution {
@@ -1643,7 +1904,7 @@ type ListChildExecutionsRequest struct {
 
 func (x *ListChildExecutionsRequest) Reset() {
 	*x = ListChildExecutionsRequest{}
-	mi := &file_canvases_proto_msgTypes[25]
+	mi := &file_canvases_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1655,7 +1916,7 @@ func (x *ListChildExecutionsRequest) String() string {
 func (*ListChildExecutionsRequest) ProtoMessage() {}
 
 func (x *ListChildExecutionsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_canvases_proto_msgTypes[25]
+	mi := &file_canvases_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1668,7 +1929,7 @@ func (x *ListChildExecutionsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListChildExecutionsRequest.ProtoReflect.Descriptor instead.
 func (*ListChildExecutionsRequest) Descriptor() ([]byte, []int) {
-	return file_canvases_proto_rawDescGZIP(), []int{25}
+	return file_canvases_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *ListChildExecutionsRequest) GetCanvasId() string {
@@ -1694,7 +1955,7 @@ type ListChildExecutionsResponse struct {
 
 func (x *ListChildExecutionsResponse) Reset() {
 	*x = ListChildExecutionsResponse{}
-	mi := &file_canvases_proto_msgTypes[26]
+	mi := &file_canvases_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1706,7 +1967,7 @@ func (x *ListChildExecutionsResponse) String() string {
 func (*ListChildExecutionsResponse) ProtoMessage() {}
 
 func (x *ListChildExecutionsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_canvases_proto_msgTypes[26]
+	mi := &file_canvases_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1719,7 +1980,7 @@ func (x *ListChildExecutionsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListChildExecutionsResponse.ProtoReflect.Descriptor instead.
 func (*ListChildExecutionsResponse) Descriptor() ([]byte, []int) {
-	return file_canvases_proto_rawDescGZIP(), []int{26}
+	return file_canvases_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *ListChildExecutionsResponse) GetExecutions() []*CanvasNodeExecution {
@@ -1755,7 +2016,7 @@ type CanvasNodeExecution struct {
 
 func (x *CanvasNodeExecution) Reset() {
 	*x = CanvasNodeExecution{}
-	mi := &file_canvases_proto_msgTypes[27]
+	mi := &file_canvases_proto_msgTypes[28]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1767,7 +2028,7 @@ func (x *CanvasNodeExecution) String() string {
 func (*CanvasNodeExecution) ProtoMessage() {}
 
 func (x *CanvasNodeExecution) ProtoReflect() protoreflect.Message {
-	mi := &file_canvases_proto_msgTypes[27]
+	mi := &file_canvases_proto_msgTypes[28]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1780,7 +2041,7 @@ func (x *CanvasNodeExecution) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CanvasNodeExecution.ProtoReflect.Descriptor instead.
 func (*CanvasNodeExecution) Descriptor() ([]byte, []int) {
-	return file_canvases_proto_rawDescGZIP(), []int{27}
+	return file_canvases_proto_rawDescGZIP(), []int{28}
 }
 
 func (x *CanvasNodeExecution) GetId() string {
@@ -1923,7 +2184,7 @@ type CanvasNodeQueueItem struct {
 
 func (x *CanvasNodeQueueItem) Reset() {
 	*x = CanvasNodeQueueItem{}
-	mi := &file_canvases_proto_msgTypes[28]
+	mi := &file_canvases_proto_msgTypes[29]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1935,7 +2196,7 @@ func (x *CanvasNodeQueueItem) String() string {
 func (*CanvasNodeQueueItem) ProtoMessage() {}
 
 func (x *CanvasNodeQueueItem) ProtoReflect() protoreflect.Message {
-	mi := &file_canvases_proto_msgTypes[28]
+	mi := &file_canvases_proto_msgTypes[29]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1948,7 +2209,7 @@ func (x *CanvasNodeQueueItem) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CanvasNodeQueueItem.ProtoReflect.Descriptor instead.
 func (*CanvasNodeQueueItem) Descriptor() ([]byte, []int) {
-	return file_canvases_proto_rawDescGZIP(), []int{28}
+	return file_canvases_proto_rawDescGZIP(), []int{29}
 }
 
 func (x *CanvasNodeQueueItem) GetId() string {
@@ -2005,7 +2266,7 @@ type InvokeNodeExecutionActionRequest struct {
 
 func (x *InvokeNodeExecutionActionRequest) Reset() {
 	*x = InvokeNodeExecutionActionRequest{}
-	mi := &file_canvases_proto_msgTypes[29]
+	mi := &file_canvases_proto_msgTypes[30]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2017,7 +2278,7 @@ func (x *InvokeNodeExecutionActionRequest) String() string {
 func (*InvokeNodeExecutionActionRequest) ProtoMessage() {}
 
 func (x *InvokeNodeExecutionActionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_canvases_proto_msgTypes[29]
+	mi := &file_canvases_proto_msgTypes[30]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2030,7 +2291,7 @@ func (x *InvokeNodeExecutionActionRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use InvokeNodeExecutionActionRequest.ProtoReflect.Descriptor instead.
 func (*InvokeNodeExecutionActionRequest) Descriptor() ([]byte, []int) {
-	return file_canvases_proto_rawDescGZIP(), []int{29}
+	return file_canvases_proto_rawDescGZIP(), []int{30}
 }
 
 func (x *InvokeNodeExecutionActionRequest) GetCanvasId() string {
@@ -2063,13 +2324,14 @@ func (x *InvokeNodeExecutionActionRequest) GetParameters() *_struct.Struct {
 
 type InvokeNodeExecutionActionResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	RootEventId   string                 `protobuf:"bytes,1,opt,name=root_event_id,json=rootEventId,proto3" json:"root_event_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *InvokeNodeExecutionActionResponse) Reset() {
 	*x = InvokeNodeExecutionActionResponse{}
-	mi := &file_canvases_proto_msgTypes[30]
+	mi := &file_canvases_proto_msgTypes[31]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2081,7 +2343,7 @@ func (x *InvokeNodeExecutionActionResponse) String() string {
 func (*InvokeNodeExecutionActionResponse) ProtoMessage() {}
 
 func (x *InvokeNodeExecutionActionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_canvases_proto_msgTypes[30]
+	mi := &file_canvases_proto_msgTypes[31]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2094,7 +2356,14 @@ func (x *InvokeNodeExecutionActionResponse) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use InvokeNodeExecutionActionResponse.ProtoReflect.Descriptor instead.
 func (*InvokeNodeExecutionActionResponse) Descriptor() ([]byte, []int) {
-	return file_canvases_proto_rawDescGZIP(), []int{30}
+	return file_canvases_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *InvokeNodeExecutionActionResponse) GetRootEventId() string {
+	if x != nil {
+		return x.RootEventId
+	}
+	return ""
 }
 
 type InvokeNodeTriggerActionRequest struct {
@@ -2109,7 +2378,7 @@ type InvokeNodeTriggerActionRequest struct {
 
 func (x *InvokeNodeTriggerActionRequest) Reset() {
 	*x = InvokeNodeTriggerActionRequest{}
-	mi := &file_canvases_proto_msgTypes[31]
+	mi := &file_canvases_proto_msgTypes[32]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2121,7 +2390,7 @@ func (x *InvokeNodeTriggerActionRequest) String() string {
 func (*InvokeNodeTriggerActionRequest) ProtoMessage() {}
 
 func (x *InvokeNodeTriggerActionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_canvases_proto_msgTypes[31]
+	mi := &file_canvases_proto_msgTypes[32]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2134,7 +2403,7 @@ func (x *InvokeNodeTriggerActionRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use InvokeNodeTriggerActionRequest.ProtoReflect.Descriptor instead.
 func (*InvokeNodeTriggerActionRequest) Descriptor() ([]byte, []int) {
-	return file_canvases_proto_rawDescGZIP(), []int{31}
+	return file_canvases_proto_rawDescGZIP(), []int{32}
 }
 
 func (x *InvokeNodeTriggerActionRequest) GetCanvasId() string {
@@ -2174,7 +2443,7 @@ type InvokeNodeTriggerActionResponse struct {
 
 func (x *InvokeNodeTriggerActionResponse) Reset() {
 	*x = InvokeNodeTriggerActionResponse{}
-	mi := &file_canvases_proto_msgTypes[32]
+	mi := &file_canvases_proto_msgTypes[33]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2186,7 +2455,7 @@ func (x *InvokeNodeTriggerActionResponse) String() string {
 func (*InvokeNodeTriggerActionResponse) ProtoMessage() {}
 
 func (x *InvokeNodeTriggerActionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_canvases_proto_msgTypes[32]
+	mi := &file_canvases_proto_msgTypes[33]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2199,7 +2468,7 @@ func (x *InvokeNodeTriggerActionResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use InvokeNodeTriggerActionResponse.ProtoReflect.Descriptor instead.
 func (*InvokeNodeTriggerActionResponse) Descriptor() ([]byte, []int) {
-	return file_canvases_proto_rawDescGZIP(), []int{32}
+	return file_canvases_proto_rawDescGZIP(), []int{33}
 }
 
 func (x *InvokeNodeTriggerActionResponse) GetResult() *_struct.Struct {
@@ -2220,7 +2489,7 @@ type ListCanvasEventsRequest struct {
 
 func (x *ListCanvasEventsRequest) Reset() {
 	*x = ListCanvasEventsRequest{}
-	mi := &file_canvases_proto_msgTypes[33]
+	mi := &file_canvases_proto_msgTypes[34]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2232,7 +2501,7 @@ func (x *ListCanvasEventsRequest) String() string {
 func (*ListCanvasEventsRequest) ProtoMessage() {}
 
 func (x *ListCanvasEventsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_canvases_proto_msgTypes[33]
+	mi := &file_canvases_proto_msgTypes[34]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2245,7 +2514,7 @@ func (x *ListCanvasEventsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListCanvasEventsRequest.ProtoReflect.Descriptor instead.
 func (*ListCanvasEventsRequest) Descriptor() ([]byte, []int) {
-	return file_canvases_proto_rawDescGZIP(), []int{33}
+	return file_canvases_proto_rawDescGZIP(), []int{34}
 }
 
 func (x *ListCanvasEventsRequest) GetCanvasId() string {
@@ -2281,7 +2550,7 @@ type ListCanvasEventsResponse struct {
 
 func (x *ListCanvasEventsResponse) Reset() {
 	*x = ListCanvasEventsResponse{}
-	mi := &file_canvases_proto_msgTypes[34]
+	mi := &file_canvases_proto_msgTypes[35]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2293,7 +2562,7 @@ func (x *ListCanvasEventsResponse) String() string {
 func (*ListCanvasEventsResponse) ProtoMessage() {}
 
 func (x *ListCanvasEventsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_canvases_proto_msgTypes[34]
+	mi := &file_canvases_proto_msgTypes[35]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2306,7 +2575,7 @@ func (x *ListCanvasEventsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListCanvasEventsResponse.ProtoReflect.Descriptor instead.
 func (*ListCanvasEventsResponse) Descriptor() ([]byte, []int) {
-	return file_canvases_proto_rawDescGZIP(), []int{34}
+	return file_canvases_proto_rawDescGZIP(), []int{35}
 }
 
 func (x *ListCanvasEventsResponse) GetEvents() []*CanvasEventWithExecutions {
@@ -2348,7 +2617,7 @@ type CanvasMemory struct {
 
 func (x *CanvasMemory) Reset() {
 	*x = CanvasMemory{}
-	mi := &file_canvases_proto_msgTypes[35]
+	mi := &file_canvases_proto_msgTypes[36]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2360,7 +2629,7 @@ func (x *CanvasMemory) String() string {
 func (*CanvasMemory) ProtoMessage() {}
 
 func (x *CanvasMemory) ProtoReflect() protoreflect.Message {
-	mi := &file_canvases_proto_msgTypes[35]
+	mi := &file_canvases_proto_msgTypes[36]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2373,7 +2642,7 @@ func (x *CanvasMemory) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CanvasMemory.ProtoReflect.Descriptor instead.
 func (*CanvasMemory) Descriptor() ([]byte, []int) {
-	return file_canvases_proto_rawDescGZIP(), []int{35}
+	return file_canvases_proto_rawDescGZIP(), []int{36}
 }
 
 func (x *CanvasMemory) GetId() string {
@@ -2406,7 +2675,7 @@ type ListCanvasMemoriesRequest struct {
 
 func (x *ListCanvasMemoriesRequest) Reset() {
 	*x = ListCanvasMemoriesRequest{}
-	mi := &file_canvases_proto_msgTypes[36]
+	mi := &file_canvases_proto_msgTypes[37]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2418,7 +2687,7 @@ func (x *ListCanvasMemoriesRequest) String() string {
 func (*ListCanvasMemoriesRequest) ProtoMessage() {}
 
 func (x *ListCanvasMemoriesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_canvases_proto_msgTypes[36]
+	mi := &file_canvases_proto_msgTypes[37]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2431,7 +2700,7 @@ func (x *ListCanvasMemoriesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListCanvasMemoriesRequest.ProtoReflect.Descriptor instead.
 func (*ListCanvasMemoriesRequest) Descriptor() ([]byte, []int) {
-	return file_canvases_proto_rawDescGZIP(), []int{36}
+	return file_canvases_proto_rawDescGZIP(), []int{37}
 }
 
 func (x *ListCanvasMemoriesRequest) GetCanvasId() string {
@@ -2450,7 +2719,7 @@ type ListCanvasMemoriesResponse struct {
 
 func (x *ListCanvasMemoriesResponse) Reset() {
 	*x = ListCanvasMemoriesResponse{}
-	mi := &file_canvases_proto_msgTypes[37]
+	mi := &file_canvases_proto_msgTypes[38]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2462,7 +2731,7 @@ func (x *ListCanvasMemoriesResponse) String() string {
 func (*ListCanvasMemoriesResponse) ProtoMessage() {}
 
 func (x *ListCanvasMemoriesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_canvases_proto_msgTypes[37]
+	mi := &file_canvases_proto_msgTypes[38]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2475,7 +2744,7 @@ func (x *ListCanvasMemoriesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListCanvasMemoriesResponse.ProtoReflect.Descriptor instead.
 func (*ListCanvasMemoriesResponse) Descriptor() ([]byte, []int) {
-	return file_canvases_proto_rawDescGZIP(), []int{37}
+	return file_canvases_proto_rawDescGZIP(), []int{38}
 }
 
 func (x *ListCanvasMemoriesResponse) GetItems() []*CanvasMemory {
@@ -2495,7 +2764,7 @@ type DeleteCanvasMemoryRequest struct {
 
 func (x *DeleteCanvasMemoryRequest) Reset() {
 	*x = DeleteCanvasMemoryRequest{}
-	mi := &file_canvases_proto_msgTypes[38]
+	mi := &file_canvases_proto_msgTypes[39]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2507,7 +2776,7 @@ func (x *DeleteCanvasMemoryRequest) String() string {
 func (*DeleteCanvasMemoryRequest) ProtoMessage() {}
 
 func (x *DeleteCanvasMemoryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_canvases_proto_msgTypes[38]
+	mi := &file_canvases_proto_msgTypes[39]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2520,7 +2789,7 @@ func (x *DeleteCanvasMemoryRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteCanvasMemoryRequest.ProtoReflect.Descriptor instead.
 func (*DeleteCanvasMemoryRequest) Descriptor() ([]byte, []int) {
-	return file_canvases_proto_rawDescGZIP(), []int{38}
+	return file_canvases_proto_rawDescGZIP(), []int{39}
 }
 
 func (x *DeleteCanvasMemoryRequest) GetCanvasId() string {
@@ -2545,7 +2814,7 @@ type DeleteCanvasMemoryResponse struct {
 
 func (x *DeleteCanvasMemoryResponse) Reset() {
 	*x = DeleteCanvasMemoryResponse{}
-	mi := &file_canvases_proto_msgTypes[39]
+	mi := &file_canvases_proto_msgTypes[40]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2557,7 +2826,7 @@ func (x *DeleteCanvasMemoryResponse) String() string {
 func (*DeleteCanvasMemoryResponse) ProtoMessage() {}
 
 func (x *DeleteCanvasMemoryResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_canvases_proto_msgTypes[39]
+	mi := &file_canvases_proto_msgTypes[40]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2570,7 +2839,7 @@ func (x *DeleteCanvasMemoryResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteCanvasMemoryResponse.ProtoReflect.Descriptor instead.
 func (*DeleteCanvasMemoryResponse) Descriptor() ([]byte, []int) {
-	return file_canvases_proto_rawDescGZIP(), []int{39}
+	return file_canvases_proto_rawDescGZIP(), []int{40}
 }
 
 type CanvasEvent struct {
@@ -2588,7 +2857,7 @@ type CanvasEvent struct {
 
 func (x *CanvasEvent) Reset() {
 	*x = CanvasEvent{}
-	mi := &file_canvases_proto_msgTypes[40]
+	mi := &file_canvases_proto_msgTypes[41]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2600,7 +2869,7 @@ func (x *CanvasEvent) String() string {
 func (*CanvasEvent) ProtoMessage() {}
 
 func (x *CanvasEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_canvases_proto_msgTypes[40]
+	mi := &file_canvases_proto_msgTypes[41]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2613,7 +2882,7 @@ func (x *CanvasEvent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CanvasEvent.ProtoReflect.Descriptor instead.
 func (*CanvasEvent) Descriptor() ([]byte, []int) {
-	return file_canvases_proto_rawDescGZIP(), []int{40}
+	return file_canvases_proto_rawDescGZIP(), []int{41}
 }
 
 func (x *CanvasEvent) GetId() string {
@@ -2681,7 +2950,7 @@ type CanvasEventWithExecutions struct {
 
 func (x *CanvasEventWithExecutions) Reset() {
 	*x = CanvasEventWithExecutions{}
-	mi := &file_canvases_proto_msgTypes[41]
+	mi := &file_canvases_proto_msgTypes[42]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2693,7 +2962,7 @@ func (x *CanvasEventWithExecutions) String() string {
 func (*CanvasEventWithExecutions) ProtoMessage() {}
 
 func (x *CanvasEventWithExecutions) ProtoReflect() protoreflect.Message {
-	mi := &file_canvases_proto_msgTypes[41]
+	mi := &file_canvases_proto_msgTypes[42]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2706,7 +2975,7 @@ func (x *CanvasEventWithExecutions) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CanvasEventWithExecutions.ProtoReflect.Descriptor instead.
 func (*CanvasEventWithExecutions) Descriptor() ([]byte, []int) {
-	return file_canvases_proto_rawDescGZIP(), []int{41}
+	return file_canvases_proto_rawDescGZIP(), []int{42}
 }
 
 func (x *CanvasEventWithExecutions) GetId() string {
@@ -2775,7 +3044,7 @@ type ListEventExecutionsRequest struct {
 
 func (x *ListEventExecutionsRequest) Reset() {
 	*x = ListEventExecutionsRequest{}
-	mi := &file_canvases_proto_msgTypes[42]
+	mi := &file_canvases_proto_msgTypes[43]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2787,7 +3056,7 @@ func (x *ListEventExecutionsRequest) String() string {
 func (*ListEventExecutionsRequest) ProtoMessage() {}
 
 func (x *ListEventExecutionsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_canvases_proto_msgTypes[42]
+	mi := &file_canvases_proto_msgTypes[43]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2800,7 +3069,7 @@ func (x *ListEventExecutionsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListEventExecutionsRequest.ProtoReflect.Descriptor instead.
 func (*ListEventExecutionsRequest) Descriptor() ([]byte, []int) {
-	return file_canvases_proto_rawDescGZIP(), []int{42}
+	return file_canvases_proto_rawDescGZIP(), []int{43}
 }
 
 func (x *ListEventExecutionsRequest) GetCanvasId() string {
@@ -2818,15 +3087,21 @@ func (x *ListEventExecutionsRequest) GetEventId() string {
 }
 
 type ListEventExecutionsResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Executions    []*CanvasNodeExecution `protobuf:"bytes,1,rep,name=executions,proto3" json:"executions,omitempty"`
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Executions []*CanvasNodeExecution `protobuf:"bytes,1,rep,name=executions,proto3" json:"executions,omitempty"`
+	//
+	// True once the chain started by the event is over:
+	// every event of the chain was routed, no queue item is left,
+	// and every execution finished.
+	//
+	Finished      bool `protobuf:"varint,2,opt,name=finished,proto3" json:"finished,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ListEventExecutionsResponse) Reset() {
 	*x = ListEventExecutionsResponse{}
-	mi := &file_canvases_proto_msgTypes[43]
+	mi := &file_canvases_proto_msgTypes[44]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2838,7 +3113,7 @@ func (x *ListEventExecutionsResponse) String() string {
 func (*ListEventExecutionsResponse) ProtoMessage() {}
 
 func (x *ListEventExecutionsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_canvases_proto_msgTypes[43]
+	mi := &file_canvases_proto_msgTypes[44]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2851,7 +3126,7 @@ func (x *ListEventExecutionsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListEventExecutionsResponse.ProtoReflect.Descriptor instead.
 func (*ListEventExecutionsResponse) Descriptor() ([]byte, []int) {
-	return file_canvases_proto_rawDescGZIP(), []int{43}
+	return file_canvases_proto_rawDescGZIP(), []int{44}
 }
 
 func (x *ListEventExecutionsResponse) GetExecutions() []*CanvasNodeExecution {
@@ -2861,6 +3136,13 @@ func (x *ListEventExecutionsResponse) GetExecutions() []*CanvasNodeExecution {
 	return nil
 }
 
+func (x *ListEventExecutionsResponse) GetFinished() bool {
+	if x != nil {
+		return x.Finished
+	}
+	return false
+}
+
 type CancelExecutionRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	CanvasId      string                 `protobuf:"bytes,1,opt,name=canvas_id,json=canvasId,proto3" json:"canvas_id,omitempty"`
@@ -2871,7 +3153,7 @@ type CancelExecutionRequest struct {
 
 func (x *CancelExecutionRequest) Reset() {
 	*x = CancelExecutionRequest{}
-	mi := &file_canvases_proto_msgTypes[44]
+	mi := &file_canvases_proto_msgTypes[45]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2883,7 +3165,7 @@ func (x *CancelExecutionRequest) String() string {
 func (*CancelExecutionRequest) ProtoMessage() {}
 
 func (x *CancelExecutionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_canvases_proto_msgTypes[44]
+	mi := &file_canvases_proto_msgTypes[45]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2896,7 +3178,7 @@ func (x *CancelExecutionRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CancelExecutionRequest.ProtoReflect.Descriptor instead.
 func (*CancelExecutionRequest) Descriptor() ([]byte, []int) {
-	return file_canvases_proto_rawDescGZIP(), []int{44}
+	return file_canvases_proto_rawDescGZIP(), []int{45}
 }
 
 func (x *CancelExecutionRequest) GetCanvasId() string {
@@ -2921,7 +3203,7 @@ type CancelExecutionResponse struct {
 
 func (x *CancelExecutionResponse) Reset() {
 	*x = CancelExecutionResponse{}
-	mi := &file_canvases_proto_msgTypes[45]
+	mi := &file_canvases_proto_msgTypes[46]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2933,7 +3215,7 @@ func (x *CancelExecutionResponse) String() string {
 func (*CancelExecutionResponse) ProtoMessage() {}
 
 func (x *CancelExecutionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_canvases_proto_msgTypes[45]
+	mi := &file_canvases_proto_msgTypes[46]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2946,32 +3228,34 @@ func (x *CancelExecutionResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CancelExecutionResponse.ProtoReflect.Descriptor instead.
 func (*CancelExecutionResponse) Descriptor() ([]byte, []int) {
-	return file_canvases_proto_rawDescGZIP(), []int{45}
+	return file_canvases_proto_rawDescGZIP(), []int{46}
 }
 
-type ResolveExecutionErrorsRequest struct {
+type GetExecutionLogsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	CanvasId      string                 `protobuf:"bytes,1,opt,name=canvas_id,json=canvasId,proto3" json:"canvas_id,omitempty"`
-	ExecutionIds  []string               `protobuf:"bytes,2,rep,name=execution_ids,json=executionIds,proto3" json:"execution_ids,omitempty"`
+	ExecutionId   string                 `protobuf:"bytes,2,opt,name=execution_id,json=executionId,proto3" json:"execution_id,omitempty"`
+	After         uint64                 `protobuf:"varint,3,opt,name=after,proto3" json:"after,omitempty"`
+	Limit         uint32                 `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ResolveExecutionErrorsRequest) Reset() {
-	*x = ResolveExecutionErrorsRequest{}
-	mi := &file_canvases_proto_msgTypes[46]
+func (x *GetExecutionLogsRequest) Reset() {
+	*x = GetExecutionLogsRequest{}
+	mi := &file_canvases_proto_msgTypes[47]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ResolveExecutionErrorsRequest) String() string {
+func (x *GetExecutionLogsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ResolveExecutionErrorsRequest) ProtoMessage() {}
+func (*GetExecutionLogsRequest) ProtoMessage() {}
 
-func (x *ResolveExecutionErrorsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_canvases_proto_msgTypes[46]
+func (x *GetExecutionLogsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[47]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2982,85 +3266,63 @@ func (x *ResolveExecutionErrorsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ResolveExecutionErrorsRequest.ProtoReflect.Descriptor instead.
-func (*ResolveExecutionErrorsRequest) Descriptor() ([]byte, []int) {
-	return file_canvases_proto_rawDescGZIP(), []int{46}
+// Deprecated: Use GetExecutionLogsRequest.ProtoReflect.Descriptor instead.
+func (*GetExecutionLogsRequest) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{47}
 }
 
-func (x *ResolveExecutionErrorsRequest) GetCanvasId() string {
+func (x *GetExecutionLogsRequest) GetCanvasId() string {
 	if x != nil {
 		return x.CanvasId
 	}
 	return ""
 }
 
-func (x *ResolveExecutionErrorsRequest) GetExecutionIds() []string {
+func (x *GetExecutionLogsRequest) GetExecutionId() string {
 	if x != nil {
-		return x.ExecutionIds
+		return x.ExecutionId
 	}
-	return nil
-}
-
-type ResolveExecutionErrorsResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *ResolveExecutionErrorsResponse) Reset() {
-	*x = ResolveExecutionErrorsResponse{}
-	mi := &file_canvases_proto_msgTypes[47]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *ResolveExecutionErrorsResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+	return ""
 }
 
-func (*ResolveExecutionErrorsResponse) ProtoMessage() {}
-
-func (x *ResolveExecutionErrorsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_canvases_proto_msgTypes[47]
+func (x *GetExecutionLogsRequest) GetAfter() uint64 {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.After
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use ResolveExecutionErrorsResponse.ProtoReflect.Descriptor instead.
-func (*ResolveExecutionErrorsResponse) Descriptor() ([]byte, []int) {
-	return file_canvases_proto_rawDescGZIP(), []int{47}
+func (x *GetExecutionLogsRequest) GetLimit() uint32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
 }
 
-type CanvasAiNodeContext struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Label         string                 `protobuf:"bytes,3,opt,name=label,proto3" json:"label,omitempty"`
-	Type          string                 `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"`
+type GetExecutionLogsResponse struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	Logs          []*CanvasNodeExecutionLog `protobuf:"bytes,1,rep,name=logs,proto3" json:"logs,omitempty"`
+	LastId        uint64                    `protobuf:"varint,2,opt,name=last_id,json=lastId,proto3" json:"last_id,omitempty"`
+	HasMore       bool                      `protobuf:"varint,3,opt,name=has_more,json=hasMore,proto3" json:"has_more,omitempty"`
+	Finished      bool                      `protobuf:"varint,4,opt,name=finished,proto3" json:"finished,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CanvasAiNodeContext) Reset() {
-	*x = CanvasAiNodeContext{}
+func (x *GetExecutionLogsResponse) Reset() {
+	*x = GetExecutionLogsResponse{}
 	mi := &file_canvases_proto_msgTypes[48]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CanvasAiNodeContext) String() string {
+func (x *GetExecutionLogsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CanvasAiNodeContext) ProtoMessage() {}
+func (*GetExecutionLogsResponse) ProtoMessage() {}
 
-func (x *CanvasAiNodeContext) ProtoReflect() protoreflect.Message {
+func (x *GetExecutionLogsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_canvases_proto_msgTypes[48]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3072,62 +3334,65 @@ func (x *CanvasAiNodeContext) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CanvasAiNodeContext.ProtoReflect.Descriptor instead.
-func (*CanvasAiNodeContext) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetExecutionLogsResponse.ProtoReflect.Descriptor instead.
+func (*GetExecutionLogsResponse) Descriptor() ([]byte, []int) {
 	return file_canvases_proto_rawDescGZIP(), []int{48}
 }
 
-func (x *CanvasAiNodeContext) GetId() string {
+func (x *GetExecutionLogsResponse) GetLogs() []*CanvasNodeExecutionLog {
 	if x != nil {
-		return x.Id
+		return x.Logs
 	}
-	return ""
+	return nil
 }
 
-func (x *CanvasAiNodeContext) GetName() string {
+func (x *GetExecutionLogsResponse) GetLastId() uint64 {
 	if x != nil {
-		return x.Name
+		return x.LastId
 	}
-	return ""
+	return 0
 }
 
-func (x *CanvasAiNodeContext) GetLabel() string {
+func (x *GetExecutionLogsResponse) GetHasMore() bool {
 	if x != nil {
-		return x.Label
+		return x.HasMore
 	}
-	return ""
+	return false
 }
 
-func (x *CanvasAiNodeContext) GetType() string {
+func (x *GetExecutionLogsResponse) GetFinished() bool {
 	if x != nil {
-		return x.Type
+		return x.Finished
 	}
-	return ""
+	return false
 }
 
-type CanvasAiBlockContext struct {
+type CanvasNodeExecutionLog struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Label         string                 `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`
-	Type          string                 `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	ExecutionId   string                 `protobuf:"bytes,2,opt,name=execution_id,json=executionId,proto3" json:"execution_id,omitempty"`
+	NodeId        string                 `protobuf:"bytes,3,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	Stream        string                 `protobuf:"bytes,4,opt,name=stream,proto3" json:"stream,omitempty"`
+	Message       string                 `protobuf:"bytes,5,opt,name=message,proto3" json:"message,omitempty"`
+	CreatedAt     *timestamp.Timestamp   `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CanvasAiBlockContext) Reset() {
-	*x = CanvasAiBlockContext{}
+func (x *CanvasNodeExecutionLog) Reset() {
+	*x = CanvasNodeExecutionLog{}
 	mi := &file_canvases_proto_msgTypes[49]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CanvasAiBlockContext) String() string {
+func (x *CanvasNodeExecutionLog) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CanvasAiBlockContext) ProtoMessage() {}
+func (*CanvasNodeExecutionLog) ProtoMessage() {}
 
-func (x *CanvasAiBlockContext) ProtoReflect() protoreflect.Message {
+func (x *CanvasNodeExecutionLog) ProtoReflect() protoreflect.Message {
 	mi := &file_canvases_proto_msgTypes[49]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3139,54 +3404,75 @@ func (x *CanvasAiBlockContext) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CanvasAiBlockContext.ProtoReflect.Descriptor instead.
-func (*CanvasAiBlockContext) Descriptor() ([]byte, []int) {
+// Deprecated: Use CanvasNodeExecutionLog.ProtoReflect.Descriptor instead.
+func (*CanvasNodeExecutionLog) Descriptor() ([]byte, []int) {
 	return file_canvases_proto_rawDescGZIP(), []int{49}
 }
 
-func (x *CanvasAiBlockContext) GetName() string {
+func (x *CanvasNodeExecutionLog) GetId() uint64 {
 	if x != nil {
-		return x.Name
+		return x.Id
 	}
-	return ""
+	return 0
 }
 
-func (x *CanvasAiBlockContext) GetLabel() string {
+func (x *CanvasNodeExecutionLog) GetExecutionId() string {
 	if x != nil {
-		return x.Label
+		return x.ExecutionId
 	}
 	return ""
 }
 
-func (x *CanvasAiBlockContext) GetType() string {
+func (x *CanvasNodeExecutionLog) GetNodeId() string {
 	if x != nil {
-		return x.Type
+		return x.NodeId
 	}
 	return ""
 }
 
-type CanvasAiContext struct {
-	state           protoimpl.MessageState  `protogen:"open.v1"`
-	Nodes           []*CanvasAiNodeContext  `protobuf:"bytes,1,rep,name=nodes,proto3" json:"nodes,omitempty"`
-	AvailableBlocks []*CanvasAiBlockContext `protobuf:"bytes,2,rep,name=available_blocks,json=availableBlocks,proto3" json:"available_blocks,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+func (x *CanvasNodeExecutionLog) GetStream() string {
+	if x != nil {
+		return x.Stream
+	}
+	return ""
 }
 
-func (x *CanvasAiContext) Reset() {
-	*x = CanvasAiContext{}
+func (x *CanvasNodeExecutionLog) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *CanvasNodeExecutionLog) GetCreatedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type ListExecutionArtifactsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CanvasId      string                 `protobuf:"bytes,1,opt,name=canvas_id,json=canvasId,proto3" json:"canvas_id,omitempty"`
+	ExecutionId   string                 `protobuf:"bytes,2,opt,name=execution_id,json=executionId,proto3" json:"execution_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListExecutionArtifactsRequest) Reset() {
+	*x = ListExecutionArtifactsRequest{}
 	mi := &file_canvases_proto_msgTypes[50]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CanvasAiContext) String() string {
+func (x *ListExecutionArtifactsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CanvasAiContext) ProtoMessage() {}
+func (*ListExecutionArtifactsRequest) ProtoMessage() {}
 
-func (x *CanvasAiContext) ProtoReflect() protoreflect.Message {
+func (x *ListExecutionArtifactsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_canvases_proto_msgTypes[50]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3198,48 +3484,46 @@ func (x *CanvasAiContext) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CanvasAiContext.ProtoReflect.Descriptor instead.
-func (*CanvasAiContext) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListExecutionArtifactsRequest.ProtoReflect.Descriptor instead.
+func (*ListExecutionArtifactsRequest) Descriptor() ([]byte, []int) {
 	return file_canvases_proto_rawDescGZIP(), []int{50}
 }
 
-func (x *CanvasAiContext) GetNodes() []*CanvasAiNodeContext {
+func (x *ListExecutionArtifactsRequest) GetCanvasId() string {
 	if x != nil {
-		return x.Nodes
+		return x.CanvasId
 	}
-	return nil
+	return ""
 }
 
-func (x *CanvasAiContext) GetAvailableBlocks() []*CanvasAiBlockContext {
+func (x *ListExecutionArtifactsRequest) GetExecutionId() string {
 	if x != nil {
-		return x.AvailableBlocks
+		return x.ExecutionId
 	}
-	return nil
+	return ""
 }
 
-type SendAiMessageRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	CanvasId      string                 `protobuf:"bytes,1,opt,name=canvas_id,json=canvasId,proto3" json:"canvas_id,omitempty"`
-	Prompt        string                 `protobuf:"bytes,2,opt,name=prompt,proto3" json:"prompt,omitempty"`
-	CanvasContext *CanvasAiContext       `protobuf:"bytes,3,opt,name=canvas_context,json=canvasContext,proto3" json:"canvas_context,omitempty"`
+type ListExecutionArtifactsResponse struct {
+	state         protoimpl.MessageState         `protogen:"open.v1"`
+	Artifacts     []*CanvasNodeExecutionArtifact `protobuf:"bytes,1,rep,name=artifacts,proto3" json:"artifacts,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SendAiMessageRequest) Reset() {
-	*x = SendAiMessageRequest{}
+func (x *ListExecutionArtifactsResponse) Reset() {
+	*x = ListExecutionArtifactsResponse{}
 	mi := &file_canvases_proto_msgTypes[51]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SendAiMessageRequest) String() string {
+func (x *ListExecutionArtifactsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SendAiMessageRequest) ProtoMessage() {}
+func (*ListExecutionArtifactsResponse) ProtoMessage() {}
 
-func (x *SendAiMessageRequest) ProtoReflect() protoreflect.Message {
+func (x *ListExecutionArtifactsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_canvases_proto_msgTypes[51]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3251,55 +3535,2212 @@ func (x *SendAiMessageRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SendAiMessageRequest.ProtoReflect.Descriptor instead.
-func (*SendAiMessageRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListExecutionArtifactsResponse.ProtoReflect.Descriptor instead.
+func (*ListExecutionArtifactsResponse) Descriptor() ([]byte, []int) {
 	return file_canvases_proto_rawDescGZIP(), []int{51}
 }
 
-func (x *SendAiMessageRequest) GetCanvasId() string {
+func (x *ListExecutionArtifactsResponse) GetArtifacts() []*CanvasNodeExecutionArtifact {
+	if x != nil {
+		return x.Artifacts
+	}
+	return nil
+}
+
+type DownloadExecutionArtifactRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CanvasId      string                 `protobuf:"bytes,1,opt,name=canvas_id,json=canvasId,proto3" json:"canvas_id,omitempty"`
+	ExecutionId   string                 `protobuf:"bytes,2,opt,name=execution_id,json=executionId,proto3" json:"execution_id,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DownloadExecutionArtifactRequest) Reset() {
+	*x = DownloadExecutionArtifactRequest{}
+	mi := &file_canvases_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DownloadExecutionArtifactRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DownloadExecutionArtifactRequest) ProtoMessage() {}
+
+func (x *DownloadExecutionArtifactRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DownloadExecutionArtifactRequest.ProtoReflect.Descriptor instead.
+func (*DownloadExecutionArtifactRequest) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *DownloadExecutionArtifactRequest) GetCanvasId() string {
 	if x != nil {
 		return x.CanvasId
 	}
 	return ""
 }
 
-func (x *SendAiMessageRequest) GetPrompt() string {
+func (x *DownloadExecutionArtifactRequest) GetExecutionId() string {
+	if x != nil {
+		return x.ExecutionId
+	}
+	return ""
+}
+
+func (x *DownloadExecutionArtifactRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type DownloadExecutionArtifactResponse struct {
+	state         protoimpl.MessageState       `protogen:"open.v1"`
+	Artifact      *CanvasNodeExecutionArtifact `protobuf:"bytes,1,opt,name=artifact,proto3" json:"artifact,omitempty"`
+	Content       []byte                       `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DownloadExecutionArtifactResponse) Reset() {
+	*x = DownloadExecutionArtifactResponse{}
+	mi := &file_canvases_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DownloadExecutionArtifactResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DownloadExecutionArtifactResponse) ProtoMessage() {}
+
+func (x *DownloadExecutionArtifactResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DownloadExecutionArtifactResponse.ProtoReflect.Descriptor instead.
+func (*DownloadExecutionArtifactResponse) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *DownloadExecutionArtifactResponse) GetArtifact() *CanvasNodeExecutionArtifact {
+	if x != nil {
+		return x.Artifact
+	}
+	return nil
+}
+
+func (x *DownloadExecutionArtifactResponse) GetContent() []byte {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+type CanvasNodeExecutionArtifact struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ExecutionId   string                 `protobuf:"bytes,2,opt,name=execution_id,json=executionId,proto3" json:"execution_id,omitempty"`
+	NodeId        string                 `protobuf:"bytes,3,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	Name          string                 `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	ContentType   string                 `protobuf:"bytes,5,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	Size          int64                  `protobuf:"varint,6,opt,name=size,proto3" json:"size,omitempty"`
+	Sha256        string                 `protobuf:"bytes,7,opt,name=sha256,proto3" json:"sha256,omitempty"`
+	CreatedAt     *timestamp.Timestamp   `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CanvasNodeExecutionArtifact) Reset() {
+	*x = CanvasNodeExecutionArtifact{}
+	mi := &file_canvases_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CanvasNodeExecutionArtifact) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CanvasNodeExecutionArtifact) ProtoMessage() {}
+
+func (x *CanvasNodeExecutionArtifact) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CanvasNodeExecutionArtifact.ProtoReflect.Descriptor instead.
+func (*CanvasNodeExecutionArtifact) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *CanvasNodeExecutionArtifact) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CanvasNodeExecutionArtifact) GetExecutionId() string {
+	if x != nil {
+		return x.ExecutionId
+	}
+	return ""
+}
+
+func (x *CanvasNodeExecutionArtifact) GetNodeId() string {
+	if x != nil {
+		return x.NodeId
+	}
+	return ""
+}
+
+func (x *CanvasNodeExecutionArtifact) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CanvasNodeExecutionArtifact) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *CanvasNodeExecutionArtifact) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *CanvasNodeExecutionArtifact) GetSha256() string {
+	if x != nil {
+		return x.Sha256
+	}
+	return ""
+}
+
+func (x *CanvasNodeExecutionArtifact) GetCreatedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type ResolveExecutionErrorsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CanvasId      string                 `protobuf:"bytes,1,opt,name=canvas_id,json=canvasId,proto3" json:"canvas_id,omitempty"`
+	ExecutionIds  []string               `protobuf:"bytes,2,rep,name=execution_ids,json=executionIds,proto3" json:"execution_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResolveExecutionErrorsRequest) Reset() {
+	*x = ResolveExecutionErrorsRequest{}
+	mi := &file_canvases_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResolveExecutionErrorsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolveExecutionErrorsRequest) ProtoMessage() {}
+
+func (x *ResolveExecutionErrorsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolveExecutionErrorsRequest.ProtoReflect.Descriptor instead.
+func (*ResolveExecutionErrorsRequest) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *ResolveExecutionErrorsRequest) GetCanvasId() string {
+	if x != nil {
+		return x.CanvasId
+	}
+	return ""
+}
+
+func (x *ResolveExecutionErrorsRequest) GetExecutionIds() []string {
+	if x != nil {
+		return x.ExecutionIds
+	}
+	return nil
+}
+
+type ResolveExecutionErrorsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResolveExecutionErrorsResponse) Reset() {
+	*x = ResolveExecutionErrorsResponse{}
+	mi := &file_canvases_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResolveExecutionErrorsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolveExecutionErrorsResponse) ProtoMessage() {}
+
+func (x *ResolveExecutionErrorsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolveExecutionErrorsResponse.ProtoReflect.Descriptor instead.
+func (*ResolveExecutionErrorsResponse) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{56}
+}
+
+type CanvasAiNodeContext struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Label         string                 `protobuf:"bytes,3,opt,name=label,proto3" json:"label,omitempty"`
+	Type          string                 `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CanvasAiNodeContext) Reset() {
+	*x = CanvasAiNodeContext{}
+	mi := &file_canvases_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CanvasAiNodeContext) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CanvasAiNodeContext) ProtoMessage() {}
+
+func (x *CanvasAiNodeContext) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CanvasAiNodeContext.ProtoReflect.Descriptor instead.
+func (*CanvasAiNodeContext) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *CanvasAiNodeContext) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CanvasAiNodeContext) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CanvasAiNodeContext) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *CanvasAiNodeContext) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+type CanvasAiBlockContext struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Label         string                 `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`
+	Type          string                 `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CanvasAiBlockContext) Reset() {
+	*x = CanvasAiBlockContext{}
+	mi := &file_canvases_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CanvasAiBlockContext) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CanvasAiBlockContext) ProtoMessage() {}
+
+func (x *CanvasAiBlockContext) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CanvasAiBlockContext.ProtoReflect.Descriptor instead.
+func (*CanvasAiBlockContext) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *CanvasAiBlockContext) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CanvasAiBlockContext) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *CanvasAiBlockContext) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+type CanvasAiContext struct {
+	state           protoimpl.MessageState  `protogen:"open.v1"`
+	Nodes           []*CanvasAiNodeContext  `protobuf:"bytes,1,rep,name=nodes,proto3" json:"nodes,omitempty"`
+	AvailableBlocks []*CanvasAiBlockContext `protobuf:"bytes,2,rep,name=available_blocks,json=availableBlocks,proto3" json:"available_blocks,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *CanvasAiContext) Reset() {
+	*x = CanvasAiContext{}
+	mi := &file_canvases_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CanvasAiContext) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CanvasAiContext) ProtoMessage() {}
+
+func (x *CanvasAiContext) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CanvasAiContext.ProtoReflect.Descriptor instead.
+func (*CanvasAiContext) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *CanvasAiContext) GetNodes() []*CanvasAiNodeContext {
+	if x != nil {
+		return x.Nodes
+	}
+	return nil
+}
+
+func (x *CanvasAiContext) GetAvailableBlocks() []*CanvasAiBlockContext {
+	if x != nil {
+		return x.AvailableBlocks
+	}
+	return nil
+}
+
+type SendAiMessageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CanvasId      string                 `protobuf:"bytes,1,opt,name=canvas_id,json=canvasId,proto3" json:"canvas_id,omitempty"`
+	Prompt        string                 `protobuf:"bytes,2,opt,name=prompt,proto3" json:"prompt,omitempty"`
+	CanvasContext *CanvasAiContext       `protobuf:"bytes,3,opt,name=canvas_context,json=canvasContext,proto3" json:"canvas_context,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SendAiMessageRequest) Reset() {
+	*x = SendAiMessageRequest{}
+	mi := &file_canvases_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendAiMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendAiMessageRequest) ProtoMessage() {}
+
+func (x *SendAiMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendAiMessageRequest.ProtoReflect.Descriptor instead.
+func (*SendAiMessageRequest) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *SendAiMessageRequest) GetCanvasId() string {
+	if x != nil {
+		return x.CanvasId
+	}
+	return ""
+}
+
+func (x *SendAiMessageRequest) GetPrompt() string {
+	if x != nil {
+		return x.Prompt
+	}
+	return ""
+}
+
+func (x *SendAiMessageRequest) GetCanvasContext() *CanvasAiContext {
+	if x != nil {
+		return x.CanvasContext
+	}
+	return nil
+}
+
+type SendAiMessageResponse struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	AssistantMessage string                 `protobuf:"bytes,1,opt,name=assistant_message,json=assistantMessage,proto3" json:"assistant_message,omitempty"`
+	Operations       []*_struct.Struct      `protobuf:"bytes,2,rep,name=operations,proto3" json:"operations,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *SendAiMessageResponse) Reset() {
+	*x = SendAiMessageResponse{}
+	mi := &file_canvases_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendAiMessageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendAiMessageResponse) ProtoMessage() {}
+
+func (x *SendAiMessageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendAiMessageResponse.ProtoReflect.Descriptor instead.
+func (*SendAiMessageResponse) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *SendAiMessageResponse) GetAssistantMessage() string {
+	if x != nil {
+		return x.AssistantMessage
+	}
+	return ""
+}
+
+func (x *SendAiMessageResponse) GetOperations() []*_struct.Struct {
+	if x != nil {
+		return x.Operations
+	}
+	return nil
+}
+
+type CanvasNodeEventMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	CanvasId      string                 `protobuf:"bytes,2,opt,name=canvas_id,json=canvasId,proto3" json:"canvas_id,omitempty"`
+	NodeId        string                 `protobuf:"bytes,3,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	Timestamp     *timestamp.Timestamp   `protobuf:"bytes,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CanvasNodeEventMessage) Reset() {
+	*x = CanvasNodeEventMessage{}
+	mi := &file_canvases_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CanvasNodeEventMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CanvasNodeEventMessage) ProtoMessage() {}
+
+func (x *CanvasNodeEventMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CanvasNodeEventMessage.ProtoReflect.Descriptor instead.
+func (*CanvasNodeEventMessage) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *CanvasNodeEventMessage) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CanvasNodeEventMessage) GetCanvasId() string {
+	if x != nil {
+		return x.CanvasId
+	}
+	return ""
+}
+
+func (x *CanvasNodeEventMessage) GetNodeId() string {
+	if x != nil {
+		return x.NodeId
+	}
+	return ""
+}
+
+func (x *CanvasNodeEventMessage) GetTimestamp() *timestamp.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+type CanvasNodeExecutionMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	CanvasId      string                 `protobuf:"bytes,2,opt,name=canvas_id,json=canvasId,proto3" json:"canvas_id,omitempty"`
+	NodeId        string                 `protobuf:"bytes,3,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	Timestamp     *timestamp.Timestamp   `protobuf:"bytes,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CanvasNodeExecutionMessage) Reset() {
+	*x = CanvasNodeExecutionMessage{}
+	mi := &file_canvases_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CanvasNodeExecutionMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CanvasNodeExecutionMessage) ProtoMessage() {}
+
+func (x *CanvasNodeExecutionMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CanvasNodeExecutionMessage.ProtoReflect.Descriptor instead.
+func (*CanvasNodeExecutionMessage) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *CanvasNodeExecutionMessage) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CanvasNodeExecutionMessage) GetCanvasId() string {
+	if x != nil {
+		return x.CanvasId
+	}
+	return ""
+}
+
+func (x *CanvasNodeExecutionMessage) GetNodeId() string {
+	if x != nil {
+		return x.NodeId
+	}
+	return ""
+}
+
+func (x *CanvasNodeExecutionMessage) GetTimestamp() *timestamp.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+type CanvasNodeExecutionLogMessage struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	CanvasId      string                  `protobuf:"bytes,1,opt,name=canvas_id,json=canvasId,proto3" json:"canvas_id,omitempty"`
+	Log           *CanvasNodeExecutionLog `protobuf:"bytes,2,opt,name=log,proto3" json:"log,omitempty"`
+	Timestamp     *timestamp.Timestamp    `protobuf:"bytes,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CanvasNodeExecutionLogMessage) Reset() {
+	*x = CanvasNodeExecutionLogMessage{}
+	mi := &file_canvases_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CanvasNodeExecutionLogMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CanvasNodeExecutionLogMessage) ProtoMessage() {}
+
+func (x *CanvasNodeExecutionLogMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CanvasNodeExecutionLogMessage.ProtoReflect.Descriptor instead.
+func (*CanvasNodeExecutionLogMessage) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *CanvasNodeExecutionLogMessage) GetCanvasId() string {
+	if x != nil {
+		return x.CanvasId
+	}
+	return ""
+}
+
+func (x *CanvasNodeExecutionLogMessage) GetLog() *CanvasNodeExecutionLog {
+	if x != nil {
+		return x.Log
+	}
+	return nil
+}
+
+func (x *CanvasNodeExecutionLogMessage) GetTimestamp() *timestamp.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+type CanvasNodeQueueItemMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	CanvasId      string                 `protobuf:"bytes,2,opt,name=canvas_id,json=canvasId,proto3" json:"canvas_id,omitempty"`
+	NodeId        string                 `protobuf:"bytes,3,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	Timestamp     *timestamp.Timestamp   `protobuf:"bytes,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CanvasNodeQueueItemMessage) Reset() {
+	*x = CanvasNodeQueueItemMessage{}
+	mi := &file_canvases_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CanvasNodeQueueItemMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CanvasNodeQueueItemMessage) ProtoMessage() {}
+
+func (x *CanvasNodeQueueItemMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CanvasNodeQueueItemMessage.ProtoReflect.Descriptor instead.
+func (*CanvasNodeQueueItemMessage) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *CanvasNodeQueueItemMessage) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CanvasNodeQueueItemMessage) GetCanvasId() string {
+	if x != nil {
+		return x.CanvasId
+	}
+	return ""
+}
+
+func (x *CanvasNodeQueueItemMessage) GetNodeId() string {
+	if x != nil {
+		return x.NodeId
+	}
+	return ""
+}
+
+func (x *CanvasNodeQueueItemMessage) GetTimestamp() *timestamp.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+type CanvasMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	CanvasId      string                 `protobuf:"bytes,2,opt,name=canvas_id,json=canvasId,proto3" json:"canvas_id,omitempty"`
+	Timestamp     *timestamp.Timestamp   `protobuf:"bytes,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CanvasMessage) Reset() {
+	*x = CanvasMessage{}
+	mi := &file_canvases_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CanvasMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CanvasMessage) ProtoMessage() {}
+
+func (x *CanvasMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CanvasMessage.ProtoReflect.Descriptor instead.
+func (*CanvasMessage) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *CanvasMessage) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CanvasMessage) GetCanvasId() string {
+	if x != nil {
+		return x.CanvasId
+	}
+	return ""
+}
+
+func (x *CanvasMessage) GetTimestamp() *timestamp.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+type GetCanvasStatsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CanvasId      string                 `protobuf:"bytes,1,opt,name=canvas_id,json=canvasId,proto3" json:"canvas_id,omitempty"`
+	From          *timestamp.Timestamp   `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	To            *timestamp.Timestamp   `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCanvasStatsRequest) Reset() {
+	*x = GetCanvasStatsRequest{}
+	mi := &file_canvases_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCanvasStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCanvasStatsRequest) ProtoMessage() {}
+
+func (x *GetCanvasStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCanvasStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetCanvasStatsRequest) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *GetCanvasStatsRequest) GetCanvasId() string {
+	if x != nil {
+		return x.CanvasId
+	}
+	return ""
+}
+
+func (x *GetCanvasStatsRequest) GetFrom() *timestamp.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *GetCanvasStatsRequest) GetTo() *timestamp.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+type GetCanvasStatsResponse struct {
+	state         protoimpl.MessageState      `protogen:"open.v1"`
+	Canvas        *CanvasExecutionStats       `protobuf:"bytes,1,opt,name=canvas,proto3" json:"canvas,omitempty"`
+	Nodes         []*CanvasNodeExecutionStats `protobuf:"bytes,2,rep,name=nodes,proto3" json:"nodes,omitempty"`
+	From          *timestamp.Timestamp        `protobuf:"bytes,3,opt,name=from,proto3" json:"from,omitempty"`
+	To            *timestamp.Timestamp        `protobuf:"bytes,4,opt,name=to,proto3" json:"to,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCanvasStatsResponse) Reset() {
+	*x = GetCanvasStatsResponse{}
+	mi := &file_canvases_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCanvasStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCanvasStatsResponse) ProtoMessage() {}
+
+func (x *GetCanvasStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCanvasStatsResponse.ProtoReflect.Descriptor instead.
+func (*GetCanvasStatsResponse) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *GetCanvasStatsResponse) GetCanvas() *CanvasExecutionStats {
+	if x != nil {
+		return x.Canvas
+	}
+	return nil
+}
+
+func (x *GetCanvasStatsResponse) GetNodes() []*CanvasNodeExecutionStats {
+	if x != nil {
+		return x.Nodes
+	}
+	return nil
+}
+
+func (x *GetCanvasStatsResponse) GetFrom() *timestamp.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *GetCanvasStatsResponse) GetTo() *timestamp.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+type CanvasExecutionStats struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Total              int64                  `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`
+	Passed             int64                  `protobuf:"varint,2,opt,name=passed,proto3" json:"passed,omitempty"`
+	Failed             int64                  `protobuf:"varint,3,opt,name=failed,proto3" json:"failed,omitempty"`
+	Cancelled          int64                  `protobuf:"varint,4,opt,name=cancelled,proto3" json:"cancelled,omitempty"`
+	SuccessRate        float64                `protobuf:"fixed64,5,opt,name=success_rate,json=successRate,proto3" json:"success_rate,omitempty"`
+	P50DurationSeconds float64                `protobuf:"fixed64,6,opt,name=p50_duration_seconds,json=p50DurationSeconds,proto3" json:"p50_duration_seconds,omitempty"`
+	P95DurationSeconds float64                `protobuf:"fixed64,7,opt,name=p95_duration_seconds,json=p95DurationSeconds,proto3" json:"p95_duration_seconds,omitempty"`
+	ExecutionsPerHour  float64                `protobuf:"fixed64,8,opt,name=executions_per_hour,json=executionsPerHour,proto3" json:"executions_per_hour,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *CanvasExecutionStats) Reset() {
+	*x = CanvasExecutionStats{}
+	mi := &file_canvases_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CanvasExecutionStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CanvasExecutionStats) ProtoMessage() {}
+
+func (x *CanvasExecutionStats) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CanvasExecutionStats.ProtoReflect.Descriptor instead.
+func (*CanvasExecutionStats) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *CanvasExecutionStats) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *CanvasExecutionStats) GetPassed() int64 {
+	if x != nil {
+		return x.Passed
+	}
+	return 0
+}
+
+func (x *CanvasExecutionStats) GetFailed() int64 {
+	if x != nil {
+		return x.Failed
+	}
+	return 0
+}
+
+func (x *CanvasExecutionStats) GetCancelled() int64 {
+	if x != nil {
+		return x.Cancelled
+	}
+	return 0
+}
+
+func (x *CanvasExecutionStats) GetSuccessRate() float64 {
+	if x != nil {
+		return x.SuccessRate
+	}
+	return 0
+}
+
+func (x *CanvasExecutionStats) GetP50DurationSeconds() float64 {
+	if x != nil {
+		return x.P50DurationSeconds
+	}
+	return 0
+}
+
+func (x *CanvasExecutionStats) GetP95DurationSeconds() float64 {
+	if x != nil {
+		return x.P95DurationSeconds
+	}
+	return 0
+}
+
+func (x *CanvasExecutionStats) GetExecutionsPerHour() float64 {
+	if x != nil {
+		return x.ExecutionsPerHour
+	}
+	return 0
+}
+
+type CanvasNodeExecutionStats struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	NodeId        string                 `protobuf:"bytes,1,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	NodeName      string                 `protobuf:"bytes,2,opt,name=node_name,json=nodeName,proto3" json:"node_name,omitempty"`
+	Component     string                 `protobuf:"bytes,3,opt,name=component,proto3" json:"component,omitempty"`
+	Stats         *CanvasExecutionStats  `protobuf:"bytes,4,opt,name=stats,proto3" json:"stats,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CanvasNodeExecutionStats) Reset() {
+	*x = CanvasNodeExecutionStats{}
+	mi := &file_canvases_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CanvasNodeExecutionStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CanvasNodeExecutionStats) ProtoMessage() {}
+
+func (x *CanvasNodeExecutionStats) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CanvasNodeExecutionStats.ProtoReflect.Descriptor instead.
+func (*CanvasNodeExecutionStats) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *CanvasNodeExecutionStats) GetNodeId() string {
+	if x != nil {
+		return x.NodeId
+	}
+	return ""
+}
+
+func (x *CanvasNodeExecutionStats) GetNodeName() string {
+	if x != nil {
+		return x.NodeName
+	}
+	return ""
+}
+
+func (x *CanvasNodeExecutionStats) GetComponent() string {
+	if x != nil {
+		return x.Component
+	}
+	return ""
+}
+
+func (x *CanvasNodeExecutionStats) GetStats() *CanvasExecutionStats {
+	if x != nil {
+		return x.Stats
+	}
+	return nil
+}
+
+type DoraSettings struct {
+	state           protoimpl.MessageState         `protogen:"open.v1"`
+	DeploymentNodes []*DoraSettings_DeploymentNode `protobuf:"bytes,1,rep,name=deployment_nodes,json=deploymentNodes,proto3" json:"deployment_nodes,omitempty"`
+	IncidentNodes   []*DoraSettings_IncidentNode   `protobuf:"bytes,2,rep,name=incident_nodes,json=incidentNodes,proto3" json:"incident_nodes,omitempty"`
+	UpdatedAt       *timestamp.Timestamp           `protobuf:"bytes,3,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *DoraSettings) Reset() {
+	*x = DoraSettings{}
+	mi := &file_canvases_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DoraSettings) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DoraSettings) ProtoMessage() {}
+
+func (x *DoraSettings) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DoraSettings.ProtoReflect.Descriptor instead.
+func (*DoraSettings) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *DoraSettings) GetDeploymentNodes() []*DoraSettings_DeploymentNode {
+	if x != nil {
+		return x.DeploymentNodes
+	}
+	return nil
+}
+
+func (x *DoraSettings) GetIncidentNodes() []*DoraSettings_IncidentNode {
+	if x != nil {
+		return x.IncidentNodes
+	}
+	return nil
+}
+
+func (x *DoraSettings) GetUpdatedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type GetCanvasDoraSettingsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CanvasId      string                 `protobuf:"bytes,1,opt,name=canvas_id,json=canvasId,proto3" json:"canvas_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCanvasDoraSettingsRequest) Reset() {
+	*x = GetCanvasDoraSettingsRequest{}
+	mi := &file_canvases_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCanvasDoraSettingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCanvasDoraSettingsRequest) ProtoMessage() {}
+
+func (x *GetCanvasDoraSettingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCanvasDoraSettingsRequest.ProtoReflect.Descriptor instead.
+func (*GetCanvasDoraSettingsRequest) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *GetCanvasDoraSettingsRequest) GetCanvasId() string {
+	if x != nil {
+		return x.CanvasId
+	}
+	return ""
+}
+
+type GetCanvasDoraSettingsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Settings      *DoraSettings          `protobuf:"bytes,1,opt,name=settings,proto3" json:"settings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCanvasDoraSettingsResponse) Reset() {
+	*x = GetCanvasDoraSettingsResponse{}
+	mi := &file_canvases_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCanvasDoraSettingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCanvasDoraSettingsResponse) ProtoMessage() {}
+
+func (x *GetCanvasDoraSettingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCanvasDoraSettingsResponse.ProtoReflect.Descriptor instead.
+func (*GetCanvasDoraSettingsResponse) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *GetCanvasDoraSettingsResponse) GetSettings() *DoraSettings {
+	if x != nil {
+		return x.Settings
+	}
+	return nil
+}
+
+type UpdateCanvasDoraSettingsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CanvasId      string                 `protobuf:"bytes,1,opt,name=canvas_id,json=canvasId,proto3" json:"canvas_id,omitempty"`
+	Settings      *DoraSettings          `protobuf:"bytes,2,opt,name=settings,proto3" json:"settings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateCanvasDoraSettingsRequest) Reset() {
+	*x = UpdateCanvasDoraSettingsRequest{}
+	mi := &file_canvases_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateCanvasDoraSettingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCanvasDoraSettingsRequest) ProtoMessage() {}
+
+func (x *UpdateCanvasDoraSettingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateCanvasDoraSettingsRequest.ProtoReflect.Descriptor instead.
+func (*UpdateCanvasDoraSettingsRequest) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *UpdateCanvasDoraSettingsRequest) GetCanvasId() string {
+	if x != nil {
+		return x.CanvasId
+	}
+	return ""
+}
+
+func (x *UpdateCanvasDoraSettingsRequest) GetSettings() *DoraSettings {
+	if x != nil {
+		return x.Settings
+	}
+	return nil
+}
+
+type UpdateCanvasDoraSettingsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Settings      *DoraSettings          `protobuf:"bytes,1,opt,name=settings,proto3" json:"settings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateCanvasDoraSettingsResponse) Reset() {
+	*x = UpdateCanvasDoraSettingsResponse{}
+	mi := &file_canvases_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateCanvasDoraSettingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCanvasDoraSettingsResponse) ProtoMessage() {}
+
+func (x *UpdateCanvasDoraSettingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateCanvasDoraSettingsResponse.ProtoReflect.Descriptor instead.
+func (*UpdateCanvasDoraSettingsResponse) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *UpdateCanvasDoraSettingsResponse) GetSettings() *DoraSettings {
+	if x != nil {
+		return x.Settings
+	}
+	return nil
+}
+
+type DoraMetrics struct {
+	state                     protoimpl.MessageState `protogen:"open.v1"`
+	Start                     *timestamp.Timestamp   `protobuf:"bytes,1,opt,name=start,proto3" json:"start,omitempty"`
+	End                       *timestamp.Timestamp   `protobuf:"bytes,2,opt,name=end,proto3" json:"end,omitempty"`
+	Deployments               int64                  `protobuf:"varint,3,opt,name=deployments,proto3" json:"deployments,omitempty"`
+	FailedDeployments         int64                  `protobuf:"varint,4,opt,name=failed_deployments,json=failedDeployments,proto3" json:"failed_deployments,omitempty"`
+	DeploymentFrequencyPerDay float64                `protobuf:"fixed64,5,opt,name=deployment_frequency_per_day,json=deploymentFrequencyPerDay,proto3" json:"deployment_frequency_per_day,omitempty"`
+	LeadTimeSeconds           float64                `protobuf:"fixed64,6,opt,name=lead_time_seconds,json=leadTimeSeconds,proto3" json:"lead_time_seconds,omitempty"`
+	ChangeFailureRate         float64                `protobuf:"fixed64,7,opt,name=change_failure_rate,json=changeFailureRate,proto3" json:"change_failure_rate,omitempty"`
+	Incidents                 int64                  `protobuf:"varint,8,opt,name=incidents,proto3" json:"incidents,omitempty"`
+	MttrSeconds               float64                `protobuf:"fixed64,9,opt,name=mttr_seconds,json=mttrSeconds,proto3" json:"mttr_seconds,omitempty"`
+	unknownFields             protoimpl.UnknownFields
+	sizeCache                 protoimpl.SizeCache
+}
+
+func (x *DoraMetrics) Reset() {
+	*x = DoraMetrics{}
+	mi := &file_canvases_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DoraMetrics) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DoraMetrics) ProtoMessage() {}
+
+func (x *DoraMetrics) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DoraMetrics.ProtoReflect.Descriptor instead.
+func (*DoraMetrics) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *DoraMetrics) GetStart() *timestamp.Timestamp {
+	if x != nil {
+		return x.Start
+	}
+	return nil
+}
+
+func (x *DoraMetrics) GetEnd() *timestamp.Timestamp {
+	if x != nil {
+		return x.End
+	}
+	return nil
+}
+
+func (x *DoraMetrics) GetDeployments() int64 {
+	if x != nil {
+		return x.Deployments
+	}
+	return 0
+}
+
+func (x *DoraMetrics) GetFailedDeployments() int64 {
+	if x != nil {
+		return x.FailedDeployments
+	}
+	return 0
+}
+
+func (x *DoraMetrics) GetDeploymentFrequencyPerDay() float64 {
+	if x != nil {
+		return x.DeploymentFrequencyPerDay
+	}
+	return 0
+}
+
+func (x *DoraMetrics) GetLeadTimeSeconds() float64 {
+	if x != nil {
+		return x.LeadTimeSeconds
+	}
+	return 0
+}
+
+func (x *DoraMetrics) GetChangeFailureRate() float64 {
+	if x != nil {
+		return x.ChangeFailureRate
+	}
+	return 0
+}
+
+func (x *DoraMetrics) GetIncidents() int64 {
+	if x != nil {
+		return x.Incidents
+	}
+	return 0
+}
+
+func (x *DoraMetrics) GetMttrSeconds() float64 {
+	if x != nil {
+		return x.MttrSeconds
+	}
+	return 0
+}
+
+type GetCanvasDoraMetricsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CanvasId      string                 `protobuf:"bytes,1,opt,name=canvas_id,json=canvasId,proto3" json:"canvas_id,omitempty"`
+	From          *timestamp.Timestamp   `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	To            *timestamp.Timestamp   `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
+	Interval      DoraInterval           `protobuf:"varint,4,opt,name=interval,proto3,enum=Superplane.Canvases.DoraInterval" json:"interval,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCanvasDoraMetricsRequest) Reset() {
+	*x = GetCanvasDoraMetricsRequest{}
+	mi := &file_canvases_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCanvasDoraMetricsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCanvasDoraMetricsRequest) ProtoMessage() {}
+
+func (x *GetCanvasDoraMetricsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCanvasDoraMetricsRequest.ProtoReflect.Descriptor instead.
+func (*GetCanvasDoraMetricsRequest) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *GetCanvasDoraMetricsRequest) GetCanvasId() string {
+	if x != nil {
+		return x.CanvasId
+	}
+	return ""
+}
+
+func (x *GetCanvasDoraMetricsRequest) GetFrom() *timestamp.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *GetCanvasDoraMetricsRequest) GetTo() *timestamp.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+func (x *GetCanvasDoraMetricsRequest) GetInterval() DoraInterval {
+	if x != nil {
+		return x.Interval
+	}
+	return DoraInterval_DORA_INTERVAL_UNSPECIFIED
+}
+
+type GetCanvasDoraMetricsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Summary       *DoraMetrics           `protobuf:"bytes,1,opt,name=summary,proto3" json:"summary,omitempty"`
+	Series        []*DoraMetrics         `protobuf:"bytes,2,rep,name=series,proto3" json:"series,omitempty"`
+	Interval      DoraInterval           `protobuf:"varint,3,opt,name=interval,proto3,enum=Superplane.Canvases.DoraInterval" json:"interval,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCanvasDoraMetricsResponse) Reset() {
+	*x = GetCanvasDoraMetricsResponse{}
+	mi := &file_canvases_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCanvasDoraMetricsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCanvasDoraMetricsResponse) ProtoMessage() {}
+
+func (x *GetCanvasDoraMetricsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCanvasDoraMetricsResponse.ProtoReflect.Descriptor instead.
+func (*GetCanvasDoraMetricsResponse) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *GetCanvasDoraMetricsResponse) GetSummary() *DoraMetrics {
+	if x != nil {
+		return x.Summary
+	}
+	return nil
+}
+
+func (x *GetCanvasDoraMetricsResponse) GetSeries() []*DoraMetrics {
+	if x != nil {
+		return x.Series
+	}
+	return nil
+}
+
+func (x *GetCanvasDoraMetricsResponse) GetInterval() DoraInterval {
+	if x != nil {
+		return x.Interval
+	}
+	return DoraInterval_DORA_INTERVAL_UNSPECIFIED
+}
+
+type ExportCanvasDoraMetricsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CanvasId      string                 `protobuf:"bytes,1,opt,name=canvas_id,json=canvasId,proto3" json:"canvas_id,omitempty"`
+	From          *timestamp.Timestamp   `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	To            *timestamp.Timestamp   `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
+	Interval      DoraInterval           `protobuf:"varint,4,opt,name=interval,proto3,enum=Superplane.Canvases.DoraInterval" json:"interval,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportCanvasDoraMetricsRequest) Reset() {
+	*x = ExportCanvasDoraMetricsRequest{}
+	mi := &file_canvases_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportCanvasDoraMetricsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportCanvasDoraMetricsRequest) ProtoMessage() {}
+
+func (x *ExportCanvasDoraMetricsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[79]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportCanvasDoraMetricsRequest.ProtoReflect.Descriptor instead.
+func (*ExportCanvasDoraMetricsRequest) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *ExportCanvasDoraMetricsRequest) GetCanvasId() string {
+	if x != nil {
+		return x.CanvasId
+	}
+	return ""
+}
+
+func (x *ExportCanvasDoraMetricsRequest) GetFrom() *timestamp.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *ExportCanvasDoraMetricsRequest) GetTo() *timestamp.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+func (x *ExportCanvasDoraMetricsRequest) GetInterval() DoraInterval {
+	if x != nil {
+		return x.Interval
+	}
+	return DoraInterval_DORA_INTERVAL_UNSPECIFIED
+}
+
+type ExportCanvasDoraMetricsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Filename      string                 `protobuf:"bytes,1,opt,name=filename,proto3" json:"filename,omitempty"`
+	ContentType   string                 `protobuf:"bytes,2,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	Content       []byte                 `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportCanvasDoraMetricsResponse) Reset() {
+	*x = ExportCanvasDoraMetricsResponse{}
+	mi := &file_canvases_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportCanvasDoraMetricsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportCanvasDoraMetricsResponse) ProtoMessage() {}
+
+func (x *ExportCanvasDoraMetricsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportCanvasDoraMetricsResponse.ProtoReflect.Descriptor instead.
+func (*ExportCanvasDoraMetricsResponse) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *ExportCanvasDoraMetricsResponse) GetFilename() string {
+	if x != nil {
+		return x.Filename
+	}
+	return ""
+}
+
+func (x *ExportCanvasDoraMetricsResponse) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *ExportCanvasDoraMetricsResponse) GetContent() []byte {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+// A change streamed by the Watch RPCs.
+// Pass the resume_token of the last change received
+// when reconnecting, to continue from where the stream stopped.
+// Changes are delivered at least once.
+type CanvasChange struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          CanvasChangeType       `protobuf:"varint,1,opt,name=type,proto3,enum=Superplane.Canvases.CanvasChangeType" json:"type,omitempty"`
+	ResumeToken   string                 `protobuf:"bytes,2,opt,name=resume_token,json=resumeToken,proto3" json:"resume_token,omitempty"`
+	Timestamp     *timestamp.Timestamp   `protobuf:"bytes,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Event         *CanvasEvent           `protobuf:"bytes,4,opt,name=event,proto3" json:"event,omitempty"`
+	Execution     *CanvasNodeExecution   `protobuf:"bytes,5,opt,name=execution,proto3" json:"execution,omitempty"`
+	QueueItem     *CanvasNodeQueueItem   `protobuf:"bytes,6,opt,name=queue_item,json=queueItem,proto3" json:"queue_item,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CanvasChange) Reset() {
+	*x = CanvasChange{}
+	mi := &file_canvases_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CanvasChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CanvasChange) ProtoMessage() {}
+
+func (x *CanvasChange) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[81]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CanvasChange.ProtoReflect.Descriptor instead.
+func (*CanvasChange) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *CanvasChange) GetType() CanvasChangeType {
+	if x != nil {
+		return x.Type
+	}
+	return CanvasChangeType_CANVAS_CHANGE_TYPE_UNKNOWN
+}
+
+func (x *CanvasChange) GetResumeToken() string {
+	if x != nil {
+		return x.ResumeToken
+	}
+	return ""
+}
+
+func (x *CanvasChange) GetTimestamp() *timestamp.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+func (x *CanvasChange) GetEvent() *CanvasEvent {
+	if x != nil {
+		return x.Event
+	}
+	return nil
+}
+
+func (x *CanvasChange) GetExecution() *CanvasNodeExecution {
+	if x != nil {
+		return x.Execution
+	}
+	return nil
+}
+
+func (x *CanvasChange) GetQueueItem() *CanvasNodeQueueItem {
+	if x != nil {
+		return x.QueueItem
+	}
+	return nil
+}
+
+type WatchCanvasRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CanvasId      string                 `protobuf:"bytes,1,opt,name=canvas_id,json=canvasId,proto3" json:"canvas_id,omitempty"`
+	ResumeToken   string                 `protobuf:"bytes,2,opt,name=resume_token,json=resumeToken,proto3" json:"resume_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchCanvasRequest) Reset() {
+	*x = WatchCanvasRequest{}
+	mi := &file_canvases_proto_msgTypes[82]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchCanvasRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchCanvasRequest) ProtoMessage() {}
+
+func (x *WatchCanvasRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[82]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchCanvasRequest.ProtoReflect.Descriptor instead.
+func (*WatchCanvasRequest) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *WatchCanvasRequest) GetCanvasId() string {
+	if x != nil {
+		return x.CanvasId
+	}
+	return ""
+}
+
+func (x *WatchCanvasRequest) GetResumeToken() string {
+	if x != nil {
+		return x.ResumeToken
+	}
+	return ""
+}
+
+type WatchCanvasResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Change        *CanvasChange          `protobuf:"bytes,1,opt,name=change,proto3" json:"change,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchCanvasResponse) Reset() {
+	*x = WatchCanvasResponse{}
+	mi := &file_canvases_proto_msgTypes[83]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchCanvasResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchCanvasResponse) ProtoMessage() {}
+
+func (x *WatchCanvasResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[83]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchCanvasResponse.ProtoReflect.Descriptor instead.
+func (*WatchCanvasResponse) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *WatchCanvasResponse) GetChange() *CanvasChange {
+	if x != nil {
+		return x.Change
+	}
+	return nil
+}
+
+type WatchExecutionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CanvasId      string                 `protobuf:"bytes,1,opt,name=canvas_id,json=canvasId,proto3" json:"canvas_id,omitempty"`
+	ExecutionId   string                 `protobuf:"bytes,2,opt,name=execution_id,json=executionId,proto3" json:"execution_id,omitempty"`
+	ResumeToken   string                 `protobuf:"bytes,3,opt,name=resume_token,json=resumeToken,proto3" json:"resume_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchExecutionRequest) Reset() {
+	*x = WatchExecutionRequest{}
+	mi := &file_canvases_proto_msgTypes[84]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchExecutionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchExecutionRequest) ProtoMessage() {}
+
+func (x *WatchExecutionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[84]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchExecutionRequest.ProtoReflect.Descriptor instead.
+func (*WatchExecutionRequest) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *WatchExecutionRequest) GetCanvasId() string {
+	if x != nil {
+		return x.CanvasId
+	}
+	return ""
+}
+
+func (x *WatchExecutionRequest) GetExecutionId() string {
+	if x != nil {
+		return x.ExecutionId
+	}
+	return ""
+}
+
+func (x *WatchExecutionRequest) GetResumeToken() string {
+	if x != nil {
+		return x.ResumeToken
+	}
+	return ""
+}
+
+type WatchExecutionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Change        *CanvasChange          `protobuf:"bytes,1,opt,name=change,proto3" json:"change,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchExecutionResponse) Reset() {
+	*x = WatchExecutionResponse{}
+	mi := &file_canvases_proto_msgTypes[85]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchExecutionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchExecutionResponse) ProtoMessage() {}
+
+func (x *WatchExecutionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[85]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchExecutionResponse.ProtoReflect.Descriptor instead.
+func (*WatchExecutionResponse) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *WatchExecutionResponse) GetChange() *CanvasChange {
+	if x != nil {
+		return x.Change
+	}
+	return nil
+}
+
+type CanvasPlan_FieldChange struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Path          string                 `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	Before        string                 `protobuf:"bytes,2,opt,name=before,proto3" json:"before,omitempty"`
+	After         string                 `protobuf:"bytes,3,opt,name=after,proto3" json:"after,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CanvasPlan_FieldChange) Reset() {
+	*x = CanvasPlan_FieldChange{}
+	mi := &file_canvases_proto_msgTypes[86]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CanvasPlan_FieldChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CanvasPlan_FieldChange) ProtoMessage() {}
+
+func (x *CanvasPlan_FieldChange) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[86]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CanvasPlan_FieldChange.ProtoReflect.Descriptor instead.
+func (*CanvasPlan_FieldChange) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{9, 0}
+}
+
+func (x *CanvasPlan_FieldChange) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *CanvasPlan_FieldChange) GetBefore() string {
 	if x != nil {
-		return x.Prompt
+		return x.Before
 	}
 	return ""
 }
 
-func (x *SendAiMessageRequest) GetCanvasContext() *CanvasAiContext {
+func (x *CanvasPlan_FieldChange) GetAfter() string {
 	if x != nil {
-		return x.CanvasContext
+		return x.After
 	}
-	return nil
+	return ""
 }
 
-type SendAiMessageResponse struct {
-	state            protoimpl.MessageState `protogen:"open.v1"`
-	AssistantMessage string                 `protobuf:"bytes,1,opt,name=assistant_message,json=assistantMessage,proto3" json:"assistant_message,omitempty"`
-	Operations       []*_struct.Struct      `protobuf:"bytes,2,rep,name=operations,proto3" json:"operations,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+type CanvasPlan_NodeChange struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	Action        CanvasPlan_Action         `protobuf:"varint,1,opt,name=action,proto3,enum=Superplane.Canvases.CanvasPlan_Action" json:"action,omitempty"`
+	NodeId        string                    `protobuf:"bytes,2,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	NodeName      string                    `protobuf:"bytes,3,opt,name=node_name,json=nodeName,proto3" json:"node_name,omitempty"`
+	Ref           string                    `protobuf:"bytes,4,opt,name=ref,proto3" json:"ref,omitempty"`
+	Fields        []*CanvasPlan_FieldChange `protobuf:"bytes,5,rep,name=fields,proto3" json:"fields,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SendAiMessageResponse) Reset() {
-	*x = SendAiMessageResponse{}
-	mi := &file_canvases_proto_msgTypes[52]
+func (x *CanvasPlan_NodeChange) Reset() {
+	*x = CanvasPlan_NodeChange{}
+	mi := &file_canvases_proto_msgTypes[87]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SendAiMessageResponse) String() string {
+func (x *CanvasPlan_NodeChange) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SendAiMessageResponse) ProtoMessage() {}
+func (*CanvasPlan_NodeChange) ProtoMessage() {}
 
-func (x *SendAiMessageResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_canvases_proto_msgTypes[52]
+func (x *CanvasPlan_NodeChange) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[87]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3310,50 +5751,71 @@ func (x *SendAiMessageResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SendAiMessageResponse.ProtoReflect.Descriptor instead.
-func (*SendAiMessageResponse) Descriptor() ([]byte, []int) {
-	return file_canvases_proto_rawDescGZIP(), []int{52}
+// Deprecated: Use CanvasPlan_NodeChange.ProtoReflect.Descriptor instead.
+func (*CanvasPlan_NodeChange) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{9, 1}
 }
 
-func (x *SendAiMessageResponse) GetAssistantMessage() string {
+func (x *CanvasPlan_NodeChange) GetAction() CanvasPlan_Action {
 	if x != nil {
-		return x.AssistantMessage
+		return x.Action
+	}
+	return CanvasPlan_ACTION_UNSPECIFIED
+}
+
+func (x *CanvasPlan_NodeChange) GetNodeId() string {
+	if x != nil {
+		return x.NodeId
 	}
 	return ""
 }
 
-func (x *SendAiMessageResponse) GetOperations() []*_struct.Struct {
+func (x *CanvasPlan_NodeChange) GetNodeName() string {
 	if x != nil {
-		return x.Operations
+		return x.NodeName
+	}
+	return ""
+}
+
+func (x *CanvasPlan_NodeChange) GetRef() string {
+	if x != nil {
+		return x.Ref
+	}
+	return ""
+}
+
+func (x *CanvasPlan_NodeChange) GetFields() []*CanvasPlan_FieldChange {
+	if x != nil {
+		return x.Fields
 	}
 	return nil
 }
 
-type CanvasNodeEventMessage struct {
+type CanvasPlan_EdgeChange struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	CanvasId      string                 `protobuf:"bytes,2,opt,name=canvas_id,json=canvasId,proto3" json:"canvas_id,omitempty"`
-	NodeId        string                 `protobuf:"bytes,3,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
-	Timestamp     *timestamp.Timestamp   `protobuf:"bytes,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Action        CanvasPlan_Action      `protobuf:"varint,1,opt,name=action,proto3,enum=Superplane.Canvases.CanvasPlan_Action" json:"action,omitempty"`
+	SourceId      string                 `protobuf:"bytes,2,opt,name=source_id,json=sourceId,proto3" json:"source_id,omitempty"`
+	TargetId      string                 `protobuf:"bytes,3,opt,name=target_id,json=targetId,proto3" json:"target_id,omitempty"`
+	Channel       string                 `protobuf:"bytes,4,opt,name=channel,proto3" json:"channel,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CanvasNodeEventMessage) Reset() {
-	*x = CanvasNodeEventMessage{}
-	mi := &file_canvases_proto_msgTypes[53]
+func (x *CanvasPlan_EdgeChange) Reset() {
+	*x = CanvasPlan_EdgeChange{}
+	mi := &file_canvases_proto_msgTypes[88]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CanvasNodeEventMessage) String() string {
+func (x *CanvasPlan_EdgeChange) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CanvasNodeEventMessage) ProtoMessage() {}
+func (*CanvasPlan_EdgeChange) ProtoMessage() {}
 
-func (x *CanvasNodeEventMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_canvases_proto_msgTypes[53]
+func (x *CanvasPlan_EdgeChange) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[88]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3364,64 +5826,68 @@ func (x *CanvasNodeEventMessage) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CanvasNodeEventMessage.ProtoReflect.Descriptor instead.
-func (*CanvasNodeEventMessage) Descriptor() ([]byte, []int) {
-	return file_canvases_proto_rawDescGZIP(), []int{53}
+// Deprecated: Use CanvasPlan_EdgeChange.ProtoReflect.Descriptor instead.
+func (*CanvasPlan_EdgeChange) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{9, 2}
 }
 
-func (x *CanvasNodeEventMessage) GetId() string {
+func (x *CanvasPlan_EdgeChange) GetAction() CanvasPlan_Action {
 	if x != nil {
-		return x.Id
+		return x.Action
 	}
-	return ""
+	return CanvasPlan_ACTION_UNSPECIFIED
 }
 
-func (x *CanvasNodeEventMessage) GetCanvasId() string {
+func (x *CanvasPlan_EdgeChange) GetSourceId() string {
 	if x != nil {
-		return x.CanvasId
+		return x.SourceId
 	}
 	return ""
 }
 
-func (x *CanvasNodeEventMessage) GetNodeId() string {
+func (x *CanvasPlan_EdgeChange) GetTargetId() string {
 	if x != nil {
-		return x.NodeId
+		return x.TargetId
 	}
 	return ""
 }
 
-func (x *CanvasNodeEventMessage) GetTimestamp() *timestamp.Timestamp {
+func (x *CanvasPlan_EdgeChange) GetChannel() string {
 	if x != nil {
-		return x.Timestamp
+		return x.Channel
 	}
-	return nil
+	return ""
 }
 
-type CanvasNodeExecutionMessage struct {
+// Webhooks are added when nodes that can receive webhooks are set up,
+// removed when the last node using them is removed,
+// and changed when the configuration of a node using them changes.
+type CanvasPlan_WebhookChange struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	CanvasId      string                 `protobuf:"bytes,2,opt,name=canvas_id,json=canvasId,proto3" json:"canvas_id,omitempty"`
-	NodeId        string                 `protobuf:"bytes,3,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
-	Timestamp     *timestamp.Timestamp   `protobuf:"bytes,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Action        CanvasPlan_Action      `protobuf:"varint,1,opt,name=action,proto3,enum=Superplane.Canvases.CanvasPlan_Action" json:"action,omitempty"`
+	NodeId        string                 `protobuf:"bytes,2,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	NodeName      string                 `protobuf:"bytes,3,opt,name=node_name,json=nodeName,proto3" json:"node_name,omitempty"`
+	WebhookId     string                 `protobuf:"bytes,4,opt,name=webhook_id,json=webhookId,proto3" json:"webhook_id,omitempty"`
+	Integration   string                 `protobuf:"bytes,5,opt,name=integration,proto3" json:"integration,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CanvasNodeExecutionMessage) Reset() {
-	*x = CanvasNodeExecutionMessage{}
-	mi := &file_canvases_proto_msgTypes[54]
+func (x *CanvasPlan_WebhookChange) Reset() {
+	*x = CanvasPlan_WebhookChange{}
+	mi := &file_canvases_proto_msgTypes[89]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CanvasNodeExecutionMessage) String() string {
+func (x *CanvasPlan_WebhookChange) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CanvasNodeExecutionMessage) ProtoMessage() {}
+func (*CanvasPlan_WebhookChange) ProtoMessage() {}
 
-func (x *CanvasNodeExecutionMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_canvases_proto_msgTypes[54]
+func (x *CanvasPlan_WebhookChange) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[89]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3432,64 +5898,71 @@ func (x *CanvasNodeExecutionMessage) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CanvasNodeExecutionMessage.ProtoReflect.Descriptor instead.
-func (*CanvasNodeExecutionMessage) Descriptor() ([]byte, []int) {
-	return file_canvases_proto_rawDescGZIP(), []int{54}
+// Deprecated: Use CanvasPlan_WebhookChange.ProtoReflect.Descriptor instead.
+func (*CanvasPlan_WebhookChange) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{9, 3}
 }
 
-func (x *CanvasNodeExecutionMessage) GetId() string {
+func (x *CanvasPlan_WebhookChange) GetAction() CanvasPlan_Action {
 	if x != nil {
-		return x.Id
+		return x.Action
+	}
+	return CanvasPlan_ACTION_UNSPECIFIED
+}
+
+func (x *CanvasPlan_WebhookChange) GetNodeId() string {
+	if x != nil {
+		return x.NodeId
 	}
 	return ""
 }
 
-func (x *CanvasNodeExecutionMessage) GetCanvasId() string {
+func (x *CanvasPlan_WebhookChange) GetNodeName() string {
 	if x != nil {
-		return x.CanvasId
+		return x.NodeName
 	}
 	return ""
 }
 
-func (x *CanvasNodeExecutionMessage) GetNodeId() string {
+func (x *CanvasPlan_WebhookChange) GetWebhookId() string {
 	if x != nil {
-		return x.NodeId
+		return x.WebhookId
 	}
 	return ""
 }
 
-func (x *CanvasNodeExecutionMessage) GetTimestamp() *timestamp.Timestamp {
+func (x *CanvasPlan_WebhookChange) GetIntegration() string {
 	if x != nil {
-		return x.Timestamp
+		return x.Integration
 	}
-	return nil
+	return ""
 }
 
-type CanvasNodeQueueItemMessage struct {
+type CanvasPlan_SubscriptionChange struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	CanvasId      string                 `protobuf:"bytes,2,opt,name=canvas_id,json=canvasId,proto3" json:"canvas_id,omitempty"`
-	NodeId        string                 `protobuf:"bytes,3,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
-	Timestamp     *timestamp.Timestamp   `protobuf:"bytes,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Action        CanvasPlan_Action      `protobuf:"varint,1,opt,name=action,proto3,enum=Superplane.Canvases.CanvasPlan_Action" json:"action,omitempty"`
+	NodeId        string                 `protobuf:"bytes,2,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	NodeName      string                 `protobuf:"bytes,3,opt,name=node_name,json=nodeName,proto3" json:"node_name,omitempty"`
+	IntegrationId string                 `protobuf:"bytes,4,opt,name=integration_id,json=integrationId,proto3" json:"integration_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CanvasNodeQueueItemMessage) Reset() {
-	*x = CanvasNodeQueueItemMessage{}
-	mi := &file_canvases_proto_msgTypes[55]
+func (x *CanvasPlan_SubscriptionChange) Reset() {
+	*x = CanvasPlan_SubscriptionChange{}
+	mi := &file_canvases_proto_msgTypes[90]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CanvasNodeQueueItemMessage) String() string {
+func (x *CanvasPlan_SubscriptionChange) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CanvasNodeQueueItemMessage) ProtoMessage() {}
+func (*CanvasPlan_SubscriptionChange) ProtoMessage() {}
 
-func (x *CanvasNodeQueueItemMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_canvases_proto_msgTypes[55]
+func (x *CanvasPlan_SubscriptionChange) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[90]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3500,63 +5973,64 @@ func (x *CanvasNodeQueueItemMessage) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CanvasNodeQueueItemMessage.ProtoReflect.Descriptor instead.
-func (*CanvasNodeQueueItemMessage) Descriptor() ([]byte, []int) {
-	return file_canvases_proto_rawDescGZIP(), []int{55}
+// Deprecated: Use CanvasPlan_SubscriptionChange.ProtoReflect.Descriptor instead.
+func (*CanvasPlan_SubscriptionChange) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{9, 4}
 }
 
-func (x *CanvasNodeQueueItemMessage) GetId() string {
+func (x *CanvasPlan_SubscriptionChange) GetAction() CanvasPlan_Action {
 	if x != nil {
-		return x.Id
+		return x.Action
 	}
-	return ""
+	return CanvasPlan_ACTION_UNSPECIFIED
 }
 
-func (x *CanvasNodeQueueItemMessage) GetCanvasId() string {
+func (x *CanvasPlan_SubscriptionChange) GetNodeId() string {
 	if x != nil {
-		return x.CanvasId
+		return x.NodeId
 	}
 	return ""
 }
 
-func (x *CanvasNodeQueueItemMessage) GetNodeId() string {
+func (x *CanvasPlan_SubscriptionChange) GetNodeName() string {
 	if x != nil {
-		return x.NodeId
+		return x.NodeName
 	}
 	return ""
 }
 
-func (x *CanvasNodeQueueItemMessage) GetTimestamp() *timestamp.Timestamp {
+func (x *CanvasPlan_SubscriptionChange) GetIntegrationId() string {
 	if x != nil {
-		return x.Timestamp
+		return x.IntegrationId
 	}
-	return nil
+	return ""
 }
 
-type CanvasMessage struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	CanvasId      string                 `protobuf:"bytes,2,opt,name=canvas_id,json=canvasId,proto3" json:"canvas_id,omitempty"`
-	Timestamp     *timestamp.Timestamp   `protobuf:"bytes,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type CanvasPlan_OrphanedNode struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	NodeId            string                 `protobuf:"bytes,1,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	NodeName          string                 `protobuf:"bytes,2,opt,name=node_name,json=nodeName,proto3" json:"node_name,omitempty"`
+	RunningExecutions int64                  `protobuf:"varint,3,opt,name=running_executions,json=runningExecutions,proto3" json:"running_executions,omitempty"`
+	QueueItems        int64                  `protobuf:"varint,4,opt,name=queue_items,json=queueItems,proto3" json:"queue_items,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
-func (x *CanvasMessage) Reset() {
-	*x = CanvasMessage{}
-	mi := &file_canvases_proto_msgTypes[56]
+func (x *CanvasPlan_OrphanedNode) Reset() {
+	*x = CanvasPlan_OrphanedNode{}
+	mi := &file_canvases_proto_msgTypes[91]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CanvasMessage) String() string {
+func (x *CanvasPlan_OrphanedNode) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CanvasMessage) ProtoMessage() {}
+func (*CanvasPlan_OrphanedNode) ProtoMessage() {}
 
-func (x *CanvasMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_canvases_proto_msgTypes[56]
+func (x *CanvasPlan_OrphanedNode) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[91]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3567,30 +6041,37 @@ func (x *CanvasMessage) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CanvasMessage.ProtoReflect.Descriptor instead.
-func (*CanvasMessage) Descriptor() ([]byte, []int) {
-	return file_canvases_proto_rawDescGZIP(), []int{56}
+// Deprecated: Use CanvasPlan_OrphanedNode.ProtoReflect.Descriptor instead.
+func (*CanvasPlan_OrphanedNode) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{9, 5}
 }
 
-func (x *CanvasMessage) GetId() string {
+func (x *CanvasPlan_OrphanedNode) GetNodeId() string {
 	if x != nil {
-		return x.Id
+		return x.NodeId
 	}
 	return ""
 }
 
-func (x *CanvasMessage) GetCanvasId() string {
+func (x *CanvasPlan_OrphanedNode) GetNodeName() string {
 	if x != nil {
-		return x.CanvasId
+		return x.NodeName
 	}
 	return ""
 }
 
-func (x *CanvasMessage) GetTimestamp() *timestamp.Timestamp {
+func (x *CanvasPlan_OrphanedNode) GetRunningExecutions() int64 {
 	if x != nil {
-		return x.Timestamp
+		return x.RunningExecutions
 	}
-	return nil
+	return 0
+}
+
+func (x *CanvasPlan_OrphanedNode) GetQueueItems() int64 {
+	if x != nil {
+		return x.QueueItems
+	}
+	return 0
 }
 
 type Canvas_Metadata struct {
@@ -3609,7 +6090,7 @@ type Canvas_Metadata struct {
 
 func (x *Canvas_Metadata) Reset() {
 	*x = Canvas_Metadata{}
-	mi := &file_canvases_proto_msgTypes[57]
+	mi := &file_canvases_proto_msgTypes[92]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3621,7 +6102,7 @@ func (x *Canvas_Metadata) String() string {
 func (*Canvas_Metadata) ProtoMessage() {}
 
 func (x *Canvas_Metadata) ProtoReflect() protoreflect.Message {
-	mi := &file_canvases_proto_msgTypes[57]
+	mi := &file_canvases_proto_msgTypes[92]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3634,7 +6115,7 @@ func (x *Canvas_Metadata) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Canvas_Metadata.ProtoReflect.Descriptor instead.
 func (*Canvas_Metadata) Descriptor() ([]byte, []int) {
-	return file_canvases_proto_rawDescGZIP(), []int{12, 0}
+	return file_canvases_proto_rawDescGZIP(), []int{13, 0}
 }
 
 func (x *Canvas_Metadata) GetId() string {
@@ -3703,7 +6184,7 @@ type Canvas_Spec struct {
 
 func (x *Canvas_Spec) Reset() {
 	*x = Canvas_Spec{}
-	mi := &file_canvases_proto_msgTypes[58]
+	mi := &file_canvases_proto_msgTypes[93]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3715,7 +6196,7 @@ func (x *Canvas_Spec) String() string {
 func (*Canvas_Spec) ProtoMessage() {}
 
 func (x *Canvas_Spec) ProtoReflect() protoreflect.Message {
-	mi := &file_canvases_proto_msgTypes[58]
+	mi := &file_canvases_proto_msgTypes[93]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3728,7 +6209,7 @@ func (x *Canvas_Spec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Canvas_Spec.ProtoReflect.Descriptor instead.
 func (*Canvas_Spec) Descriptor() ([]byte, []int) {
-	return file_canvases_proto_rawDescGZIP(), []int{12, 1}
+	return file_canvases_proto_rawDescGZIP(), []int{13, 1}
 }
 
 func (x *Canvas_Spec) GetNodes() []*components.Node {
@@ -3756,7 +6237,7 @@ type Canvas_Status struct {
 
 func (x *Canvas_Status) Reset() {
 	*x = Canvas_Status{}
-	mi := &file_canvases_proto_msgTypes[59]
+	mi := &file_canvases_proto_msgTypes[94]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3768,7 +6249,7 @@ func (x *Canvas_Status) String() string {
 func (*Canvas_Status) ProtoMessage() {}
 
 func (x *Canvas_Status) ProtoReflect() protoreflect.Message {
-	mi := &file_canvases_proto_msgTypes[59]
+	mi := &file_canvases_proto_msgTypes[94]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3781,7 +6262,7 @@ func (x *Canvas_Status) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Canvas_Status.ProtoReflect.Descriptor instead.
 func (*Canvas_Status) Descriptor() ([]byte, []int) {
-	return file_canvases_proto_rawDescGZIP(), []int{12, 2}
+	return file_canvases_proto_rawDescGZIP(), []int{13, 2}
 }
 
 func (x *Canvas_Status) GetLastExecutions() []*CanvasNodeExecution {
@@ -3805,6 +6286,118 @@ func (x *Canvas_Status) GetLastEvents() []*CanvasEvent {
 	return nil
 }
 
+type DoraSettings_DeploymentNode struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	NodeId               string                 `protobuf:"bytes,1,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	CommitTimestampField string                 `protobuf:"bytes,2,opt,name=commit_timestamp_field,json=commitTimestampField,proto3" json:"commit_timestamp_field,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *DoraSettings_DeploymentNode) Reset() {
+	*x = DoraSettings_DeploymentNode{}
+	mi := &file_canvases_proto_msgTypes[95]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DoraSettings_DeploymentNode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DoraSettings_DeploymentNode) ProtoMessage() {}
+
+func (x *DoraSettings_DeploymentNode) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[95]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DoraSettings_DeploymentNode.ProtoReflect.Descriptor instead.
+func (*DoraSettings_DeploymentNode) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{71, 0}
+}
+
+func (x *DoraSettings_DeploymentNode) GetNodeId() string {
+	if x != nil {
+		return x.NodeId
+	}
+	return ""
+}
+
+func (x *DoraSettings_DeploymentNode) GetCommitTimestampField() string {
+	if x != nil {
+		return x.CommitTimestampField
+	}
+	return ""
+}
+
+type DoraSettings_IncidentNode struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	NodeId          string                 `protobuf:"bytes,1,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	StartedAtField  string                 `protobuf:"bytes,2,opt,name=started_at_field,json=startedAtField,proto3" json:"started_at_field,omitempty"`
+	ResolvedAtField string                 `protobuf:"bytes,3,opt,name=resolved_at_field,json=resolvedAtField,proto3" json:"resolved_at_field,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *DoraSettings_IncidentNode) Reset() {
+	*x = DoraSettings_IncidentNode{}
+	mi := &file_canvases_proto_msgTypes[96]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DoraSettings_IncidentNode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DoraSettings_IncidentNode) ProtoMessage() {}
+
+func (x *DoraSettings_IncidentNode) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[96]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DoraSettings_IncidentNode.ProtoReflect.Descriptor instead.
+func (*DoraSettings_IncidentNode) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{71, 1}
+}
+
+func (x *DoraSettings_IncidentNode) GetNodeId() string {
+	if x != nil {
+		return x.NodeId
+	}
+	return ""
+}
+
+func (x *DoraSettings_IncidentNode) GetStartedAtField() string {
+	if x != nil {
+		return x.StartedAtField
+	}
+	return ""
+}
+
+func (x *DoraSettings_IncidentNode) GetResolvedAtField() string {
+	if x != nil {
+		return x.ResolvedAtField
+	}
+	return ""
+}
+
 var File_canvases_proto protoreflect.FileDescriptor
 
 const file_canvases_proto_rawDesc = "" +
@@ -3833,14 +6426,65 @@ const file_canvases_proto_rawDesc = "" +
 	"\x11SCOPE_UNSPECIFIED\x10\x00\x12\x15\n" +
 	"\x11SCOPE_FULL_CANVAS\x10\x01\x12\x1d\n" +
 	"\x19SCOPE_CONNECTED_COMPONENT\x10\x02\x12\x13\n" +
-	"\x0fSCOPE_EXACT_SET\x10\x03\"\xa2\x01\n" +
+	"\x0fSCOPE_EXACT_SET\x10\x03\"\xbb\x01\n" +
 	"\x13UpdateCanvasRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x123\n" +
 	"\x06canvas\x18\x02 \x01(\v2\x1b.Superplane.Canvases.CanvasR\x06canvas\x12F\n" +
 	"\vauto_layout\x18\x03 \x01(\v2%.Superplane.Canvases.CanvasAutoLayoutR\n" +
-	"autoLayout\"K\n" +
+	"autoLayout\x12\x17\n" +
+	"\adry_run\x18\x04 \x01(\bR\x06dryRun\"\x80\x01\n" +
 	"\x14UpdateCanvasResponse\x123\n" +
-	"\x06canvas\x18\x01 \x01(\v2\x1b.Superplane.Canvases.CanvasR\x06canvas\"%\n" +
+	"\x06canvas\x18\x01 \x01(\v2\x1b.Superplane.Canvases.CanvasR\x06canvas\x123\n" +
+	"\x04plan\x18\x02 \x01(\v2\x1f.Superplane.Canvases.CanvasPlanR\x04plan\"\x80\f\n" +
+	"\n" +
+	"CanvasPlan\x12@\n" +
+	"\x05nodes\x18\x01 \x03(\v2*.Superplane.Canvases.CanvasPlan.NodeChangeR\x05nodes\x12@\n" +
+	"\x05edges\x18\x02 \x03(\v2*.Superplane.Canvases.CanvasPlan.EdgeChangeR\x05edges\x12I\n" +
+	"\bwebhooks\x18\x03 \x03(\v2-.Superplane.Canvases.CanvasPlan.WebhookChangeR\bwebhooks\x12X\n" +
+	"\rsubscriptions\x18\x04 \x03(\v22.Superplane.Canvases.CanvasPlan.SubscriptionChangeR\rsubscriptions\x12H\n" +
+	"\borphaned\x18\x05 \x03(\v2,.Superplane.Canvases.CanvasPlan.OrphanedNodeR\borphaned\x12C\n" +
+	"\x06canvas\x18\x06 \x03(\v2+.Superplane.Canvases.CanvasPlan.FieldChangeR\x06canvas\x1aO\n" +
+	"\vFieldChange\x12\x12\n" +
+	"\x04path\x18\x01 \x01(\tR\x04path\x12\x16\n" +
+	"\x06before\x18\x02 \x01(\tR\x06before\x12\x14\n" +
+	"\x05after\x18\x03 \x01(\tR\x05after\x1a\xd9\x01\n" +
+	"\n" +
+	"NodeChange\x12>\n" +
+	"\x06action\x18\x01 \x01(\x0e2&.Superplane.Canvases.CanvasPlan.ActionR\x06action\x12\x17\n" +
+	"\anode_id\x18\x02 \x01(\tR\x06nodeId\x12\x1b\n" +
+	"\tnode_name\x18\x03 \x01(\tR\bnodeName\x12\x10\n" +
+	"\x03ref\x18\x04 \x01(\tR\x03ref\x12C\n" +
+	"\x06fields\x18\x05 \x03(\v2+.Superplane.Canvases.CanvasPlan.FieldChangeR\x06fields\x1a\xa0\x01\n" +
+	"\n" +
+	"EdgeChange\x12>\n" +
+	"\x06action\x18\x01 \x01(\x0e2&.Superplane.Canvases.CanvasPlan.ActionR\x06action\x12\x1b\n" +
+	"\tsource_id\x18\x02 \x01(\tR\bsourceId\x12\x1b\n" +
+	"\ttarget_id\x18\x03 \x01(\tR\btargetId\x12\x18\n" +
+	"\achannel\x18\x04 \x01(\tR\achannel\x1a\xc6\x01\n" +
+	"\rWebhookChange\x12>\n" +
+	"\x06action\x18\x01 \x01(\x0e2&.Superplane.Canvases.CanvasPlan.ActionR\x06action\x12\x17\n" +
+	"\anode_id\x18\x02 \x01(\tR\x06nodeId\x12\x1b\n" +
+	"\tnode_name\x18\x03 \x01(\tR\bnodeName\x12\x1d\n" +
+	"\n" +
+	"webhook_id\x18\x04 \x01(\tR\twebhookId\x12 \n" +
+	"\vintegration\x18\x05 \x01(\tR\vintegration\x1a\xb1\x01\n" +
+	"\x12SubscriptionChange\x12>\n" +
+	"\x06action\x18\x01 \x01(\x0e2&.Superplane.Canvases.CanvasPlan.ActionR\x06action\x12\x17\n" +
+	"\anode_id\x18\x02 \x01(\tR\x06nodeId\x12\x1b\n" +
+	"\tnode_name\x18\x03 \x01(\tR\bnodeName\x12%\n" +
+	"\x0eintegration_id\x18\x04 \x01(\tR\rintegrationId\x1a\x94\x01\n" +
+	"\fOrphanedNode\x12\x17\n" +
+	"\anode_id\x18\x01 \x01(\tR\x06nodeId\x12\x1b\n" +
+	"\tnode_name\x18\x02 \x01(\tR\bnodeName\x12-\n" +
+	"\x12running_executions\x18\x03 \x01(\x03R\x11runningExecutions\x12\x1f\n" +
+	"\vqueue_items\x18\x04 \x01(\x03R\n" +
+	"queueItems\"V\n" +
+	"\x06Action\x12\x16\n" +
+	"\x12ACTION_UNSPECIFIED\x10\x00\x12\x0e\n" +
+	"\n" +
+	"ACTION_ADD\x10\x01\x12\x11\n" +
+	"\rACTION_REMOVE\x10\x02\x12\x11\n" +
+	"\rACTION_CHANGE\x10\x03\"%\n" +
 	"\x13DeleteCanvasRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\"\x16\n" +
 	"\x14DeleteCanvasResponse\"-\n" +
@@ -3988,8 +6632,9 @@ const file_canvases_proto_rawDesc = "" +
 	"actionName\x127\n" +
 	"\n" +
 	"parameters\x18\x04 \x01(\v2\x17.google.protobuf.StructR\n" +
-	"parameters\"#\n" +
-	"!InvokeNodeExecutionActionResponse\"\xb0\x01\n" +
+	"parameters\"G\n" +
+	"!InvokeNodeExecutionActionResponse\x12\"\n" +
+	"\rroot_event_id\x18\x01 \x01(\tR\vrootEventId\"\xb0\x01\n" +
 	"\x1eInvokeNodeTriggerActionRequest\x12\x1b\n" +
 	"\tcanvas_id\x18\x01 \x01(\tR\bcanvasId\x12\x17\n" +
 	"\anode_id\x18\x02 \x01(\tR\x06nodeId\x12\x1f\n" +
@@ -4047,15 +6692,56 @@ const file_canvases_proto_rawDesc = "" +
 	"customName\"T\n" +
 	"\x1aListEventExecutionsRequest\x12\x1b\n" +
 	"\tcanvas_id\x18\x01 \x01(\tR\bcanvasId\x12\x19\n" +
-	"\bevent_id\x18\x02 \x01(\tR\aeventId\"g\n" +
+	"\bevent_id\x18\x02 \x01(\tR\aeventId\"\x83\x01\n" +
 	"\x1bListEventExecutionsResponse\x12H\n" +
 	"\n" +
 	"executions\x18\x01 \x03(\v2(.Superplane.Canvases.CanvasNodeExecutionR\n" +
-	"executions\"X\n" +
+	"executions\x12\x1a\n" +
+	"\bfinished\x18\x02 \x01(\bR\bfinished\"X\n" +
 	"\x16CancelExecutionRequest\x12\x1b\n" +
 	"\tcanvas_id\x18\x01 \x01(\tR\bcanvasId\x12!\n" +
 	"\fexecution_id\x18\x02 \x01(\tR\vexecutionId\"\x19\n" +
-	"\x17CancelExecutionResponse\"a\n" +
+	"\x17CancelExecutionResponse\"\x85\x01\n" +
+	"\x17GetExecutionLogsRequest\x12\x1b\n" +
+	"\tcanvas_id\x18\x01 \x01(\tR\bcanvasId\x12!\n" +
+	"\fexecution_id\x18\x02 \x01(\tR\vexecutionId\x12\x14\n" +
+	"\x05after\x18\x03 \x01(\x04R\x05after\x12\x14\n" +
+	"\x05limit\x18\x04 \x01(\rR\x05limit\"\xab\x01\n" +
+	"\x18GetExecutionLogsResponse\x12?\n" +
+	"\x04logs\x18\x01 \x03(\v2+.Superplane.Canvases.CanvasNodeExecutionLogR\x04logs\x12\x17\n" +
+	"\alast_id\x18\x02 \x01(\x04R\x06lastId\x12\x19\n" +
+	"\bhas_more\x18\x03 \x01(\bR\ahasMore\x12\x1a\n" +
+	"\bfinished\x18\x04 \x01(\bR\bfinished\"\xd1\x01\n" +
+	"\x16CanvasNodeExecutionLog\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12!\n" +
+	"\fexecution_id\x18\x02 \x01(\tR\vexecutionId\x12\x17\n" +
+	"\anode_id\x18\x03 \x01(\tR\x06nodeId\x12\x16\n" +
+	"\x06stream\x18\x04 \x01(\tR\x06stream\x12\x18\n" +
+	"\amessage\x18\x05 \x01(\tR\amessage\x129\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"_\n" +
+	"\x1dListExecutionArtifactsRequest\x12\x1b\n" +
+	"\tcanvas_id\x18\x01 \x01(\tR\bcanvasId\x12!\n" +
+	"\fexecution_id\x18\x02 \x01(\tR\vexecutionId\"p\n" +
+	"\x1eListExecutionArtifactsResponse\x12N\n" +
+	"\tartifacts\x18\x01 \x03(\v20.Superplane.Canvases.CanvasNodeExecutionArtifactR\tartifacts\"v\n" +
+	" DownloadExecutionArtifactRequest\x12\x1b\n" +
+	"\tcanvas_id\x18\x01 \x01(\tR\bcanvasId\x12!\n" +
+	"\fexecution_id\x18\x02 \x01(\tR\vexecutionId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\"\x8b\x01\n" +
+	"!DownloadExecutionArtifactResponse\x12L\n" +
+	"\bartifact\x18\x01 \x01(\v20.Superplane.Canvases.CanvasNodeExecutionArtifactR\bartifact\x12\x18\n" +
+	"\acontent\x18\x02 \x01(\fR\acontent\"\x87\x02\n" +
+	"\x1bCanvasNodeExecutionArtifact\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12!\n" +
+	"\fexecution_id\x18\x02 \x01(\tR\vexecutionId\x12\x17\n" +
+	"\anode_id\x18\x03 \x01(\tR\x06nodeId\x12\x12\n" +
+	"\x04name\x18\x04 \x01(\tR\x04name\x12!\n" +
+	"\fcontent_type\x18\x05 \x01(\tR\vcontentType\x12\x12\n" +
+	"\x04size\x18\x06 \x01(\x03R\x04size\x12\x16\n" +
+	"\x06sha256\x18\a \x01(\tR\x06sha256\x129\n" +
+	"\n" +
+	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"a\n" +
 	"\x1dResolveExecutionErrorsRequest\x12\x1b\n" +
 	"\tcanvas_id\x18\x01 \x01(\tR\bcanvasId\x12#\n" +
 	"\rexecution_ids\x18\x02 \x03(\tR\fexecutionIds\" \n" +
@@ -4090,7 +6776,11 @@ const file_canvases_proto_rawDesc = "" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
 	"\tcanvas_id\x18\x02 \x01(\tR\bcanvasId\x12\x17\n" +
 	"\anode_id\x18\x03 \x01(\tR\x06nodeId\x128\n" +
-	"\ttimestamp\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp\"\x9c\x01\n" +
+	"\ttimestamp\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp\"\xb5\x01\n" +
+	"\x1dCanvasNodeExecutionLogMessage\x12\x1b\n" +
+	"\tcanvas_id\x18\x01 \x01(\tR\bcanvasId\x12=\n" +
+	"\x03log\x18\x02 \x01(\v2+.Superplane.Canvases.CanvasNodeExecutionLogR\x03log\x128\n" +
+	"\ttimestamp\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp\"\x9c\x01\n" +
 	"\x1aCanvasNodeQueueItemMessage\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
 	"\tcanvas_id\x18\x02 \x01(\tR\bcanvasId\x12\x17\n" +
@@ -4099,7 +6789,109 @@ const file_canvases_proto_rawDesc = "" +
 	"\rCanvasMessage\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
 	"\tcanvas_id\x18\x02 \x01(\tR\bcanvasId\x128\n" +
-	"\ttimestamp\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp2\xd4*\n" +
+	"\ttimestamp\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp\"\x90\x01\n" +
+	"\x15GetCanvasStatsRequest\x12\x1b\n" +
+	"\tcanvas_id\x18\x01 \x01(\tR\bcanvasId\x12.\n" +
+	"\x04from\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x04from\x12*\n" +
+	"\x02to\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x02to\"\xfc\x01\n" +
+	"\x16GetCanvasStatsResponse\x12A\n" +
+	"\x06canvas\x18\x01 \x01(\v2).Superplane.Canvases.CanvasExecutionStatsR\x06canvas\x12C\n" +
+	"\x05nodes\x18\x02 \x03(\v2-.Superplane.Canvases.CanvasNodeExecutionStatsR\x05nodes\x12.\n" +
+	"\x04from\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x04from\x12*\n" +
+	"\x02to\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x02to\"\xb1\x02\n" +
+	"\x14CanvasExecutionStats\x12\x14\n" +
+	"\x05total\x18\x01 \x01(\x03R\x05total\x12\x16\n" +
+	"\x06passed\x18\x02 \x01(\x03R\x06passed\x12\x16\n" +
+	"\x06failed\x18\x03 \x01(\x03R\x06failed\x12\x1c\n" +
+	"\tcancelled\x18\x04 \x01(\x03R\tcancelled\x12!\n" +
+	"\fsuccess_rate\x18\x05 \x01(\x01R\vsuccessRate\x120\n" +
+	"\x14p50_duration_seconds\x18\x06 \x01(\x01R\x12p50DurationSeconds\x120\n" +
+	"\x14p95_duration_seconds\x18\a \x01(\x01R\x12p95DurationSeconds\x12.\n" +
+	"\x13executions_per_hour\x18\b \x01(\x01R\x11executionsPerHour\"\xaf\x01\n" +
+	"\x18CanvasNodeExecutionStats\x12\x17\n" +
+	"\anode_id\x18\x01 \x01(\tR\x06nodeId\x12\x1b\n" +
+	"\tnode_name\x18\x02 \x01(\tR\bnodeName\x12\x1c\n" +
+	"\tcomponent\x18\x03 \x01(\tR\tcomponent\x12?\n" +
+	"\x05stats\x18\x04 \x01(\v2).Superplane.Canvases.CanvasExecutionStatsR\x05stats\"\xdd\x03\n" +
+	"\fDoraSettings\x12[\n" +
+	"\x10deployment_nodes\x18\x01 \x03(\v20.Superplane.Canvases.DoraSettings.DeploymentNodeR\x0fdeploymentNodes\x12U\n" +
+	"\x0eincident_nodes\x18\x02 \x03(\v2..Superplane.Canvases.DoraSettings.IncidentNodeR\rincidentNodes\x129\n" +
+	"\n" +
+	"updated_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x1a_\n" +
+	"\x0eDeploymentNode\x12\x17\n" +
+	"\anode_id\x18\x01 \x01(\tR\x06nodeId\x124\n" +
+	"\x16commit_timestamp_field\x18\x02 \x01(\tR\x14commitTimestampField\x1a}\n" +
+	"\fIncidentNode\x12\x17\n" +
+	"\anode_id\x18\x01 \x01(\tR\x06nodeId\x12(\n" +
+	"\x10started_at_field\x18\x02 \x01(\tR\x0estartedAtField\x12*\n" +
+	"\x11resolved_at_field\x18\x03 \x01(\tR\x0fresolvedAtField\";\n" +
+	"\x1cGetCanvasDoraSettingsRequest\x12\x1b\n" +
+	"\tcanvas_id\x18\x01 \x01(\tR\bcanvasId\"^\n" +
+	"\x1dGetCanvasDoraSettingsResponse\x12=\n" +
+	"\bsettings\x18\x01 \x01(\v2!.Superplane.Canvases.DoraSettingsR\bsettings\"}\n" +
+	"\x1fUpdateCanvasDoraSettingsRequest\x12\x1b\n" +
+	"\tcanvas_id\x18\x01 \x01(\tR\bcanvasId\x12=\n" +
+	"\bsettings\x18\x02 \x01(\v2!.Superplane.Canvases.DoraSettingsR\bsettings\"a\n" +
+	" UpdateCanvasDoraSettingsResponse\x12=\n" +
+	"\bsettings\x18\x01 \x01(\v2!.Superplane.Canvases.DoraSettingsR\bsettings\"\x9c\x03\n" +
+	"\vDoraMetrics\x120\n" +
+	"\x05start\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\x05start\x12,\n" +
+	"\x03end\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x03end\x12 \n" +
+	"\vdeployments\x18\x03 \x01(\x03R\vdeployments\x12-\n" +
+	"\x12failed_deployments\x18\x04 \x01(\x03R\x11failedDeployments\x12?\n" +
+	"\x1cdeployment_frequency_per_day\x18\x05 \x01(\x01R\x19deploymentFrequencyPerDay\x12*\n" +
+	"\x11lead_time_seconds\x18\x06 \x01(\x01R\x0fleadTimeSeconds\x12.\n" +
+	"\x13change_failure_rate\x18\a \x01(\x01R\x11changeFailureRate\x12\x1c\n" +
+	"\tincidents\x18\b \x01(\x03R\tincidents\x12!\n" +
+	"\fmttr_seconds\x18\t \x01(\x01R\vmttrSeconds\"\xd5\x01\n" +
+	"\x1bGetCanvasDoraMetricsRequest\x12\x1b\n" +
+	"\tcanvas_id\x18\x01 \x01(\tR\bcanvasId\x12.\n" +
+	"\x04from\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x04from\x12*\n" +
+	"\x02to\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x02to\x12=\n" +
+	"\binterval\x18\x04 \x01(\x0e2!.Superplane.Canvases.DoraIntervalR\binterval\"\xd3\x01\n" +
+	"\x1cGetCanvasDoraMetricsResponse\x12:\n" +
+	"\asummary\x18\x01 \x01(\v2 .Superplane.Canvases.DoraMetricsR\asummary\x128\n" +
+	"\x06series\x18\x02 \x03(\v2 .Superplane.Canvases.DoraMetricsR\x06series\x12=\n" +
+	"\binterval\x18\x03 \x01(\x0e2!.Superplane.Canvases.DoraIntervalR\binterval\"\xd8\x01\n" +
+	"\x1eExportCanvasDoraMetricsRequest\x12\x1b\n" +
+	"\tcanvas_id\x18\x01 \x01(\tR\bcanvasId\x12.\n" +
+	"\x04from\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x04from\x12*\n" +
+	"\x02to\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x02to\x12=\n" +
+	"\binterval\x18\x04 \x01(\x0e2!.Superplane.Canvases.DoraIntervalR\binterval\"z\n" +
+	"\x1fExportCanvasDoraMetricsResponse\x12\x1a\n" +
+	"\bfilename\x18\x01 \x01(\tR\bfilename\x12!\n" +
+	"\fcontent_type\x18\x02 \x01(\tR\vcontentType\x12\x18\n" +
+	"\acontent\x18\x03 \x01(\fR\acontent\"\xef\x02\n" +
+	"\fCanvasChange\x129\n" +
+	"\x04type\x18\x01 \x01(\x0e2%.Superplane.Canvases.CanvasChangeTypeR\x04type\x12!\n" +
+	"\fresume_token\x18\x02 \x01(\tR\vresumeToken\x128\n" +
+	"\ttimestamp\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp\x126\n" +
+	"\x05event\x18\x04 \x01(\v2 .Superplane.Canvases.CanvasEventR\x05event\x12F\n" +
+	"\texecution\x18\x05 \x01(\v2(.Superplane.Canvases.CanvasNodeExecutionR\texecution\x12G\n" +
+	"\n" +
+	"queue_item\x18\x06 \x01(\v2(.Superplane.Canvases.CanvasNodeQueueItemR\tqueueItem\"T\n" +
+	"\x12WatchCanvasRequest\x12\x1b\n" +
+	"\tcanvas_id\x18\x01 \x01(\tR\bcanvasId\x12!\n" +
+	"\fresume_token\x18\x02 \x01(\tR\vresumeToken\"P\n" +
+	"\x13WatchCanvasResponse\x129\n" +
+	"\x06change\x18\x01 \x01(\v2!.Superplane.Canvases.CanvasChangeR\x06change\"z\n" +
+	"\x15WatchExecutionRequest\x12\x1b\n" +
+	"\tcanvas_id\x18\x01 \x01(\tR\bcanvasId\x12!\n" +
+	"\fexecution_id\x18\x02 \x01(\tR\vexecutionId\x12!\n" +
+	"\fresume_token\x18\x03 \x01(\tR\vresumeToken\"S\n" +
+	"\x16WatchExecutionResponse\x129\n" +
+	"\x06change\x18\x01 \x01(\v2!.Superplane.Canvases.CanvasChangeR\x06change*\\\n" +
+	"\fDoraInterval\x12\x1d\n" +
+	"\x19DORA_INTERVAL_UNSPECIFIED\x10\x00\x12\x15\n" +
+	"\x11DORA_INTERVAL_DAY\x10\x01\x12\x16\n" +
+	"\x12DORA_INTERVAL_WEEK\x10\x02*\xfa\x01\n" +
+	"\x10CanvasChangeType\x12\x1e\n" +
+	"\x1aCANVAS_CHANGE_TYPE_UNKNOWN\x10\x00\x12 \n" +
+	"\x1cCANVAS_CHANGE_TYPE_HEARTBEAT\x10\x01\x12$\n" +
+	" CANVAS_CHANGE_TYPE_EVENT_CREATED\x10\x02\x12(\n" +
+	"$CANVAS_CHANGE_TYPE_EXECUTION_UPDATED\x10\x03\x12)\n" +
+	"%CANVAS_CHANGE_TYPE_QUEUE_ITEM_CREATED\x10\x04\x12)\n" +
+	"%CANVAS_CHANGE_TYPE_QUEUE_ITEM_DELETED\x10\x052\x9bD\n" +
 	"\bCanvases\x12\xb7\x01\n" +
 	"\fListCanvases\x12(.Superplane.Canvases.ListCanvasesRequest\x1a).Superplane.Canvases.ListCanvasesResponse\"R\x92A7\n" +
 	"\x06Canvas\x12\rList canvases\x1a\x1eReturns a list of all canvases\x82\xd3\xe4\x93\x02\x12\x12\x10/api/v1/canvases\x12\xb0\x01\n" +
@@ -4137,7 +6929,13 @@ const file_canvases_proto_rawDesc = "" +
 	"\x13ListChildExecutions\x12/.Superplane.Canvases.ListChildExecutionsRequest\x1a0.Superplane.Canvases.ListChildExecutionsResponse\"\xb2\x01\x92Ae\n" +
 	"\x13CanvasNodeExecution\x12&List child executions for an execution\x1a&List child executions for an execution\x82\xd3\xe4\x93\x02D:\x01*\"?/api/v1/canvases/{canvas_id}/executions/{execution_id}/children\x12\x8a\x02\n" +
 	"\x0fCancelExecution\x12+.Superplane.Canvases.CancelExecutionRequest\x1a,.Superplane.Canvases.CancelExecutionResponse\"\x9b\x01\x92AP\n" +
-	"\x13CanvasNodeExecution\x12\x10Cancel execution\x1a'Cancels a running canvas node execution\x82\xd3\xe4\x93\x02B:\x01*2=/api/v1/canvases/{canvas_id}/executions/{execution_id}/cancel\x12\xa0\x02\n" +
+	"\x13CanvasNodeExecution\x12\x10Cancel execution\x1a'Cancels a running canvas node execution\x82\xd3\xe4\x93\x02B:\x01*2=/api/v1/canvases/{canvas_id}/executions/{execution_id}/cancel\x12\x94\x02\n" +
+	"\x10GetExecutionLogs\x12,.Superplane.Canvases.GetExecutionLogsRequest\x1a-.Superplane.Canvases.GetExecutionLogsResponse\"\xa2\x01\x92A\\\n" +
+	"\x13CanvasNodeExecution\x12\x12Get execution logs\x1a1Returns the log output of a canvas node execution\x82\xd3\xe4\x93\x02=\x12;/api/v1/canvases/{canvas_id}/executions/{execution_id}/logs\x12\xb9\x02\n" +
+	"\x16ListExecutionArtifacts\x122.Superplane.Canvases.ListExecutionArtifactsRequest\x1a3.Superplane.Canvases.ListExecutionArtifactsResponse\"\xb5\x01\x92Aj\n" +
+	"\x13CanvasNodeExecution\x12\x18List execution artifacts\x1a9Returns the artifacts attached to a canvas node execution\x82\xd3\xe4\x93\x02B\x12@/api/v1/canvases/{canvas_id}/executions/{execution_id}/artifacts\x12\x91\x03\n" +
+	"\x19DownloadExecutionArtifact\x125.Superplane.Canvases.DownloadExecutionArtifactRequest\x1a6.Superplane.Canvases.DownloadExecutionArtifactResponse\"\x82\x02\x92A\xad\x01\n" +
+	"\x13CanvasNodeExecution\x12\x1bDownload execution artifact\x1ayStreams the contents of an artifact attached to a canvas node execution in chunks. The first chunk also has the artifact.\x82\xd3\xe4\x93\x02K\x12I/api/v1/canvases/{canvas_id}/executions/{execution_id}/artifacts/download0\x01\x12\xa0\x02\n" +
 	"\x16ResolveExecutionErrors\x122.Superplane.Canvases.ResolveExecutionErrorsRequest\x1a3.Superplane.Canvases.ResolveExecutionErrorsResponse\"\x9c\x01\x92A_\n" +
 	"\x13CanvasNodeExecution\x12\x18Resolve execution errors\x1a.Marks canvas node execution errors as resolved\x82\xd3\xe4\x93\x024:\x01*2//api/v1/canvases/{canvas_id}/executions/resolve\x12\x86\x02\n" +
 	"\x10ListCanvasEvents\x12,.Superplane.Canvases.ListCanvasEventsRequest\x1a-.Superplane.Canvases.ListCanvasEventsResponse\"\x94\x01\x92Af\n" +
@@ -4149,7 +6947,21 @@ const file_canvases_proto_rawDesc = "" +
 	"\x13ListEventExecutions\x12/.Superplane.Canvases.ListEventExecutionsRequest\x1a0.Superplane.Canvases.ListEventExecutionsResponse\"\xa9\x01\x92Ae\n" +
 	"\vCanvasEvent\x12\x15List event executions\x1a?Returns a list of all node executions triggered by a root event\x82\xd3\xe4\x93\x02;\x129/api/v1/canvases/{canvas_id}/events/{event_id}/executions\x12\x9b\x02\n" +
 	"\rSendAiMessage\x12).Superplane.Canvases.SendAiMessageRequest\x1a*.Superplane.Canvases.SendAiMessageResponse\"\xb2\x01\x92A|\n" +
-	"\x06Canvas\x12\x1bGenerate AI canvas proposal\x1aUGenerates a structured, non-persistent canvas proposal from a natural language prompt\x82\xd3\xe4\x93\x02-:\x01*\"(/api/v1/canvases/{canvas_id}/ai/messagesB\xc8\x01\x92A\x8c\x01\x12b\n" +
+	"\x06Canvas\x12\x1bGenerate AI canvas proposal\x1aUGenerates a structured, non-persistent canvas proposal from a natural language prompt\x82\xd3\xe4\x93\x02-:\x01*\"(/api/v1/canvases/{canvas_id}/ai/messages\x12\xa3\x02\n" +
+	"\x0eGetCanvasStats\x12*.Superplane.Canvases.GetCanvasStatsRequest\x1a+.Superplane.Canvases.GetCanvasStatsResponse\"\xb7\x01\x92A\x89\x01\n" +
+	"\x06Canvas\x12\x10Get canvas stats\x1amReturns success rates, durations and throughput of the executions of a canvas and its nodes over a time range\x82\xd3\xe4\x93\x02$\x12\"/api/v1/canvases/{canvas_id}/stats\x12\xb0\x02\n" +
+	"\x15GetCanvasDoraSettings\x121.Superplane.Canvases.GetCanvasDoraSettingsRequest\x1a2.Superplane.Canvases.GetCanvasDoraSettingsResponse\"\xaf\x01\x92Az\n" +
+	"\x06Canvas\x12\x18Get canvas DORA settings\x1aVReturns the deployment and incident nodes used to compute the DORA metrics of a canvas\x82\xd3\xe4\x93\x02,\x12*/api/v1/canvases/{canvas_id}/dora/settings\x12\xd9\x02\n" +
+	"\x18UpdateCanvasDoraSettings\x124.Superplane.Canvases.UpdateCanvasDoraSettingsRequest\x1a5.Superplane.Canvases.UpdateCanvasDoraSettingsResponse\"\xcf\x01\x92A\x96\x01\n" +
+	"\x06Canvas\x12\x1bUpdate canvas DORA settings\x1aoMarks canvas nodes as deployments or incidents, and maps the root event fields used to compute the DORA metrics\x82\xd3\xe4\x93\x02/:\x01*\x1a*/api/v1/canvases/{canvas_id}/dora/settings\x12\xc5\x02\n" +
+	"\x14GetCanvasDoraMetrics\x120.Superplane.Canvases.GetCanvasDoraMetricsRequest\x1a1.Superplane.Canvases.GetCanvasDoraMetricsResponse\"\xc7\x01\x92A\x92\x01\n" +
+	"\x06Canvas\x12\x17Get canvas DORA metrics\x1aoReturns deployment frequency, lead time for changes, change failure rate and MTTR of a canvas over a time range\x82\xd3\xe4\x93\x02+\x12)/api/v1/canvases/{canvas_id}/dora/metrics\x12\x9f\x02\n" +
+	"\x17ExportCanvasDoraMetrics\x123.Superplane.Canvases.ExportCanvasDoraMetricsRequest\x1a4.Superplane.Canvases.ExportCanvasDoraMetricsResponse\"\x98\x01\x92A]\n" +
+	"\x06Canvas\x12\x1aExport canvas DORA metrics\x1a7Returns the DORA metrics time series of a canvas as CSV\x82\xd3\xe4\x93\x022\x120/api/v1/canvases/{canvas_id}/dora/metrics/export\x12\xb4\x02\n" +
+	"\vWatchCanvas\x12'.Superplane.Canvases.WatchCanvasRequest\x1a(.Superplane.Canvases.WatchCanvasResponse\"\xcf\x01\x92A\xa1\x01\n" +
+	"\x06Canvas\x12\fWatch canvas\x1a\x88\x01Streams the events, executions and queue items of a canvas as they change. Send Accept: text/event-stream to receive server-sent events.\x82\xd3\xe4\x93\x02$\x12\"/api/v1/canvases/{canvas_id}/watch0\x01\x12\x87\x03\n" +
+	"\x0eWatchExecution\x12*.Superplane.Canvases.WatchExecutionRequest\x1a+.Superplane.Canvases.WatchExecutionResponse\"\x99\x02\x92A\xd1\x01\n" +
+	"\x13CanvasNodeExecution\x12\x0fWatch execution\x1a\xa8\x01Streams the changes of an execution, its child executions and output events, until the execution finishes. Send Accept: text/event-stream to receive server-sent events.\x82\xd3\xe4\x93\x02>\x12</api/v1/canvases/{canvas_id}/executions/{execution_id}/watch0\x01B\xc8\x01\x92A\x8c\x01\x12b\n" +
 	"\x17Superplane Canvases API\x12\x1bAPI for Superplane canvases\"%\n" +
 	"\vAPI Support\x1a\x16support@superplane.com2\x031.0*\x02\x01\x022\x10application/json:\x10application/jsonZ6github.com/superplanehq/superplane/pkg/protos/canvasesb\x06proto3"
 
@@ -4165,199 +6977,309 @@ func file_canvases_proto_rawDescGZIP() []byte {
 	return file_canvases_proto_rawDescData
 }
 
-var file_canvases_proto_enumTypes = make([]protoimpl.EnumInfo, 5)
-var file_canvases_proto_msgTypes = make([]protoimpl.MessageInfo, 60)
+var file_canvases_proto_enumTypes = make([]protoimpl.EnumInfo, 8)
+var file_canvases_proto_msgTypes = make([]protoimpl.MessageInfo, 97)
 var file_canvases_proto_goTypes = []any{
-	(CanvasAutoLayout_Algorithm)(0),           // 0: Superplane.Canvases.CanvasAutoLayout.Algorithm
-	(CanvasAutoLayout_Scope)(0),               // 1: Superplane.Canvases.CanvasAutoLayout.Scope
-	(CanvasNodeExecution_State)(0),            // 2: Superplane.Canvases.CanvasNodeExecution.State
-	(CanvasNodeExecution_Result)(0),           // 3: Superplane.Canvases.CanvasNodeExecution.Result
-	(CanvasNodeExecution_ResultReason)(0),     // 4: Superplane.Canvases.CanvasNodeExecution.ResultReason
-	(*ListCanvasesRequest)(nil),               // 5: Superplane.Canvases.ListCanvasesRequest
-	(*ListCanvasesResponse)(nil),              // 6: Superplane.Canvases.ListCanvasesResponse
-	(*DescribeCanvasRequest)(nil),             // 7: Superplane.Canvases.DescribeCanvasRequest
-	(*DescribeCanvasResponse)(nil),            // 8: Superplane.Canvases.DescribeCanvasResponse
-	(*CreateCanvasRequest)(nil),               // 9: Superplane.Canvases.CreateCanvasRequest
-	(*CreateCanvasResponse)(nil),              // 10: Superplane.Canvases.CreateCanvasResponse
-	(*CanvasAutoLayout)(nil),                  // 11: Superplane.Canvases.CanvasAutoLayout
-	(*UpdateCanvasRequest)(nil),               // 12: Superplane.Canvases.UpdateCanvasRequest
-	(*UpdateCanvasResponse)(nil),              // 13: Superplane.Canvases.UpdateCanvasResponse
-	(*DeleteCanvasRequest)(nil),               // 14: Superplane.Canvases.DeleteCanvasRequest
-	(*DeleteCanvasResponse)(nil),              // 15: Superplane.Canvases.DeleteCanvasResponse
-	(*UserRef)(nil),                           // 16: Superplane.Canvases.UserRef
-	(*Canvas)(nil),                            // 17: Superplane.Canvases.Canvas
-	(*ListNodeEventsRequest)(nil),             // 18: Superplane.Canvases.ListNodeEventsRequest
-	(*ListNodeEventsResponse)(nil),            // 19: Superplane.Canvases.ListNodeEventsResponse
-	(*EmitNodeEventRequest)(nil),              // 20: Superplane.Canvases.EmitNodeEventRequest
-	(*EmitNodeEventResponse)(nil),             // 21: Superplane.Canvases.EmitNodeEventResponse
-	(*ListNodeQueueItemsRequest)(nil),         // 22: Superplane.Canvases.ListNodeQueueItemsRequest
-	(*ListNodeQueueItemsResponse)(nil),        // 23: Superplane.Canvases.ListNodeQueueItemsResponse
-	(*DeleteNodeQueueItemRequest)(nil),        // 24: Superplane.Canvases.DeleteNodeQueueItemRequest
-	(*DeleteNodeQueueItemResponse)(nil),       // 25: Superplane.Canvases.DeleteNodeQueueItemResponse
-	(*UpdateNodePauseRequest)(nil),            // 26: Superplane.Canvases.UpdateNodePauseRequest
-	(*UpdateNodePauseResponse)(nil),           // 27: Superplane.Canvases.UpdateNodePauseResponse
-	(*ListNodeExecutionsRequest)(nil),         // 28: Superplane.Canvases.ListNodeExecutionsRequest
-	(*ListNodeExecutionsResponse)(nil),        // 29: Superplane.Canvases.ListNodeExecutionsResponse
-	(*ListChildExecutionsRequest)(nil),        // 30: Superplane.Canvases.ListChildExecutionsRequest
-	(*ListChildExecutionsResponse)(nil),       // 31: Superplane.Canvases.ListChildExecutionsResponse
-	(*CanvasNodeExecution)(nil),               // 32: Superplane.Canvases.CanvasNodeExecution
-	(*CanvasNodeQueueItem)(nil),               // 33: Superplane.Canvases.CanvasNodeQueueItem
-	(*InvokeNodeExecutionActionRequest)(nil),  // 34: Superplane.Canvases.InvokeNodeExecutionActionRequest
-	(*InvokeNodeExecutionActionResponse)(nil), // 35: Superplane.Canvases.InvokeNodeExecutionActionResponse
-	(*InvokeNodeTriggerActionRequest)(nil),    // 36: Superplane.Canvases.InvokeNodeTriggerActionRequest
-	(*InvokeNodeTriggerActionResponse)(nil),   // 37: Superplane.Canvases.InvokeNodeTriggerActionResponse
-	(*ListCanvasEventsRequest)(nil),           // 38: Superplane.Canvases.ListCanvasEventsRequest
-	(*ListCanvasEventsResponse)(nil),          // 39: Superplane.Canvases.ListCanvasEventsResponse
-	(*CanvasMemory)(nil),                      // 40: Superplane.Canvases.CanvasMemory
-	(*ListCanvasMemoriesRequest)(nil),         // 41: Superplane.Canvases.ListCanvasMemoriesRequest
-	(*ListCanvasMemoriesResponse)(nil),        // 42: Superplane.Canvases.ListCanvasMemoriesResponse
-	(*DeleteCanvasMemoryRequest)(nil),         // 43: Superplane.Canvases.DeleteCanvasMemoryRequest
-	(*DeleteCanvasMemoryResponse)(nil),        // 44: Superplane.Canvases.DeleteCanvasMemoryResponse
-	(*CanvasEvent)(nil),                       // 45: Superplane.Canvases.CanvasEvent
-	(*CanvasEventWithExecutions)(nil),         // 46: Superplane.Canvases.CanvasEventWithExecutions
-	(*ListEventExecutionsRequest)(nil),        // 47: Superplane.Canvases.ListEventExecutionsRequest
-	(*ListEventExecutionsResponse)(nil),       // 48: Superplane.Canvases.ListEventExecutionsResponse
-	(*CancelExecutionRequest)(nil),            // 49: Superplane.Canvases.CancelExecutionRequest
-	(*CancelExecutionResponse)(nil),           // 50: Superplane.Canvases.CancelExecutionResponse
-	(*ResolveExecutionErrorsRequest)(nil),     // 51: Superplane.Canvases.ResolveExecutionErrorsRequest
-	(*ResolveExecutionErrorsResponse)(nil),    // 52: Superplane.Canvases.ResolveExecutionErrorsResponse
-	(*CanvasAiNodeContext)(nil),               // 53: Superplane.Canvases.CanvasAiNodeContext
-	(*CanvasAiBlockContext)(nil),              // 54: Superplane.Canvases.CanvasAiBlockContext
-	(*CanvasAiContext)(nil),                   // 55: Superplane.Canvases.CanvasAiContext
-	(*SendAiMessageRequest)(nil),              // 56: Superplane.Canvases.SendAiMessageRequest
-	(*SendAiMessageResponse)(nil),             // 57: Superplane.Canvases.SendAiMessageResponse
-	(*CanvasNodeEventMessage)(nil),            // 58: Superplane.Canvases.CanvasNodeEventMessage
-	(*CanvasNodeExecutionMessage)(nil),        // 59: Superplane.Canvases.CanvasNodeExecutionMessage
-	(*CanvasNodeQueueItemMessage)(nil),        // 60: Superplane.Canvases.CanvasNodeQueueItemMessage
-	(*CanvasMessage)(nil),                     // 61: Superplane.Canvases.CanvasMessage
-	(*Canvas_Metadata)(nil),                   // 62: Superplane.Canvases.Canvas.Metadata
-	(*Canvas_Spec)(nil),                       // 63: Superplane.Canvases.Canvas.Spec
-	(*Canvas_Status)(nil),                     // 64: Superplane.Canvases.Canvas.Status
-	(*timestamp.Timestamp)(nil),               // 65: google.protobuf.Timestamp
-	(*_struct.Struct)(nil),                    // 66: google.protobuf.Struct
-	(*components.Node)(nil),                   // 67: Superplane.Components.Node
-	(*_struct.Value)(nil),                     // 68: google.protobuf.Value
-	(*components.Edge)(nil),                   // 69: Superplane.Components.Edge
+	(DoraInterval)(0),                         // 0: Superplane.Canvases.DoraInterval
+	(CanvasChangeType)(0),                     // 1: Superplane.Canvases.CanvasChangeType
+	(CanvasAutoLayout_Algorithm)(0),           // 2: Superplane.Canvases.CanvasAutoLayout.Algorithm
+	(CanvasAutoLayout_Scope)(0),               // 3: Superplane.Canvases.CanvasAutoLayout.Scope
+	(CanvasPlan_Action)(0),                    // 4: Superplane.Canvases.CanvasPlan.Action
+	(CanvasNodeExecution_State)(0),            // 5: Superplane.Canvases.CanvasNodeExecution.State
+	(CanvasNodeExecution_Result)(0),           // 6: Superplane.Canvases.CanvasNodeExecution.Result
+	(CanvasNodeExecution_ResultReason)(0),     // 7: Superplane.Canvases.CanvasNodeExecution.ResultReason
+	(*ListCanvasesRequest)(nil),               // 8: Superplane.Canvases.ListCanvasesRequest
+	(*ListCanvasesResponse)(nil),              // 9: Superplane.Canvases.ListCanvasesResponse
+	(*DescribeCanvasRequest)(nil),             // 10: Superplane.Canvases.DescribeCanvasRequest
+	(*DescribeCanvasResponse)(nil),            // 11: Superplane.Canvases.DescribeCanvasResponse
+	(*CreateCanvasRequest)(nil),               // 12: Superplane.Canvases.CreateCanvasRequest
+	(*CreateCanvasResponse)(nil),              // 13: Superplane.Canvases.CreateCanvasResponse
+	(*CanvasAutoLayout)(nil),                  // 14: Superplane.Canvases.CanvasAutoLayout
+	(*UpdateCanvasRequest)(nil),               // 15: Superplane.Canvases.UpdateCanvasRequest
+	(*UpdateCanvasResponse)(nil),              // 16: Superplane.Canvases.UpdateCanvasResponse
+	(*CanvasPlan)(nil),                        // 17: Superplane.Canvases.CanvasPlan
+	(*DeleteCanvasRequest)(nil),               // 18: Superplane.Canvases.DeleteCanvasRequest
+	(*DeleteCanvasResponse)(nil),              // 19: Superplane.Canvases.DeleteCanvasResponse
+	(*UserRef)(nil),                           // 20: Superplane.Canvases.UserRef
+	(*Canvas)(nil),                            // 21: Superplane.Canvases.Canvas
+	(*ListNodeEventsRequest)(nil),             // 22: Superplane.Canvases.ListNodeEventsRequest
+	(*ListNodeEventsResponse)(nil),            // 23: Superplane.Canvases.ListNodeEventsResponse
+	(*EmitNodeEventRequest)(nil),              // 24: Superplane.Canvases.EmitNodeEventRequest
+	(*EmitNodeEventResponse)(nil),             // 25: Superplane.Canvases.EmitNodeEventResponse
+	(*ListNodeQueueItemsRequest)(nil),         // 26: Superplane.Canvases.ListNodeQueueItemsRequest
+	(*ListNodeQueueItemsResponse)(nil),        // 27: Superplane.Canvases.ListNodeQueueItemsResponse
+	(*DeleteNodeQueueItemRequest)(nil),        // 28: Superplane.Canvases.DeleteNodeQueueItemRequest
+	(*DeleteNodeQueueItemResponse)(nil),       // 29: Superplane.Canvases.DeleteNodeQueueItemResponse
+	(*UpdateNodePauseRequest)(nil),            // 30: Superplane.Canvases.UpdateNodePauseRequest
+	(*UpdateNodePauseResponse)(nil),           // 31: Superplane.Canvases.UpdateNodePauseResponse
+	(*ListNodeExecutionsRequest)(nil),         // 32: Superplane.Canvases.ListNodeExecutionsRequest
+	(*ListNodeExecutionsResponse)(nil),        // 33: Superplane.Canvases.ListNodeExecutionsResponse
+	(*ListChildExecutionsRequest)(nil),        // 34: Superplane.Canvases.ListChildExecutionsRequest
+	(*ListChildExecutionsResponse)(nil),       // 35: Superplane.Canvases.ListChildExecutionsResponse
+	(*CanvasNodeExecution)(nil),               // 36: Superplane.Canvases.CanvasNodeExecution
+	(*CanvasNodeQueueItem)(nil),               // 37: Superplane.Canvases.CanvasNodeQueueItem
+	(*InvokeNodeExecutionActionRequest)(nil),  // 38: Superplane.Canvases.InvokeNodeExecutionActionRequest
+	(*InvokeNodeExecutionActionResponse)(nil), // 39: Superplane.Canvases.InvokeNodeExecutionActionResponse
+	(*InvokeNodeTriggerActionRequest)(nil),    // 40: Superplane.Canvases.InvokeNodeTriggerActionRequest
+	(*InvokeNodeTriggerActionResponse)(nil),   // 41: Superplane.Canvases.InvokeNodeTriggerActionResponse
+	(*ListCanvasEventsRequest)(nil),           // 42: Superplane.Canvases.ListCanvasEventsRequest
+	(*ListCanvasEventsResponse)(nil),          // 43: Superplane.Canvases.ListCanvasEventsResponse
+	(*CanvasMemory)(nil),                      // 44: Superplane.Canvases.CanvasMemory
+	(*ListCanvasMemoriesRequest)(nil),         // 45: Superplane.Canvases.ListCanvasMemoriesRequest
+	(*ListCanvasMemoriesResponse)(nil),        // 46: Superplane.Canvases.ListCanvasMemoriesResponse
+	(*DeleteCanvasMemoryRequest)(nil),         // 47: Superplane.Canvases.DeleteCanvasMemoryRequest
+	(*DeleteCanvasMemoryResponse)(nil),        // 48: Superplane.Canvases.DeleteCanvasMemoryResponse
+	(*CanvasEvent)(nil),                       // 49: Superplane.Canvases.CanvasEvent
+	(*CanvasEventWithExecutions)(nil),         // 50: Superplane.Canvases.CanvasEventWithExecutions
+	(*ListEventExecutionsRequest)(nil),        // 51: Superplane.Canvases.ListEventExecutionsRequest
+	(*ListEventExecutionsResponse)(nil),       // 52: Superplane.Canvases.ListEventExecutionsResponse
+	(*CancelExecutionRequest)(nil),            // 53: Superplane.Canvases.CancelExecutionRequest
+	(*CancelExecutionResponse)(nil),           // 54: Superplane.Canvases.CancelExecutionResponse
+	(*GetExecutionLogsRequest)(nil),           // 55: Superplane.Canvases.GetExecutionLogsRequest
+	(*GetExecutionLogsResponse)(nil),          // 56: Superplane.Canvases.GetExecutionLogsResponse
+	(*CanvasNodeExecutionLog)(nil),            // 57: Superplane.Canvases.CanvasNodeExecutionLog
+	(*ListExecutionArtifactsRequest)(nil),     // 58: Superplane.Canvases.ListExecutionArtifactsRequest
+	(*ListExecutionArtifactsResponse)(nil),    // 59: Superplane.Canvases.ListExecutionArtifactsResponse
+	(*DownloadExecutionArtifactRequest)(nil),  // 60: Superplane.Canvases.DownloadExecutionArtifactRequest
+	(*DownloadExecutionArtifactResponse)(nil), // 61: Superplane.Canvases.DownloadExecutionArtifactResponse
+	(*CanvasNodeExecutionArtifact)(nil),       // 62: Superplane.Canvases.CanvasNodeExecutionArtifact
+	(*ResolveExecutionErrorsRequest)(nil),     // 63: Superplane.Canvases.ResolveExecutionErrorsRequest
+	(*ResolveExecutionErrorsResponse)(nil),    // 64: Superplane.Canvases.ResolveExecutionErrorsResponse
+	(*CanvasAiNodeContext)(nil),               // 65: Superplane.Canvases.CanvasAiNodeContext
+	(*CanvasAiBlockContext)(nil),              // 66: Superplane.Canvases.CanvasAiBlockContext
+	(*CanvasAiContext)(nil),                   // 67: Superplane.Canvases.CanvasAiContext
+	(*SendAiMessageRequest)(nil),              // 68: Superplane.Canvases.SendAiMessageRequest
+	(*SendAiMessageResponse)(nil),             // 69: Superplane.Canvases.SendAiMessageResponse
+	(*CanvasNodeEventMessage)(nil),            // 70: Superplane.Canvases.CanvasNodeEventMessage
+	(*CanvasNodeExecutionMessage)(nil),        // 71: Superplane.Canvases.CanvasNodeExecutionMessage
+	(*CanvasNodeExecutionLogMessage)(nil),     // 72: Superplane.Canvases.CanvasNodeExecutionLogMessage
+	(*CanvasNodeQueueItemMessage)(nil),        // 73: Superplane.Canvases.CanvasNodeQueueItemMessage
+	(*CanvasMessage)(nil),                     // 74: Superplane.Canvases.CanvasMessage
+	(*GetCanvasStatsRequest)(nil),             // 75: Superplane.Canvases.GetCanvasStatsRequest
+	(*GetCanvasStatsResponse)(nil),            // 76: Superplane.Canvases.GetCanvasStatsResponse
+	(*CanvasExecutionStats)(nil),              // 77: Superplane.Canvases.CanvasExecutionStats
+	(*CanvasNodeExecutionStats)(nil),          // 78: Superplane.Canvases.CanvasNodeExecutionStats
+	(*DoraSettings)(nil),                      // 79: Superplane.Canvases.DoraSettings
+	(*GetCanvasDoraSettingsRequest)(nil),      // 80: Superplane.Canvases.GetCanvasDoraSettingsRequest
+	(*GetCanvasDoraSettingsResponse)(nil),     // 81: Superplane.Canvases.GetCanvasDoraSettingsResponse
+	(*UpdateCanvasDoraSettingsRequest)(nil),   // 82: Superplane.Canvases.UpdateCanvasDoraSettingsRequest
+	(*UpdateCanvasDoraSettingsResponse)(nil),  // 83: Superplane.Canvases.UpdateCanvasDoraSettingsResponse
+	(*DoraMetrics)(nil),                       // 84: Superplane.Canvases.DoraMetrics
+	(*GetCanvasDoraMetricsRequest)(nil),       // 85: Superplane.Canvases.GetCanvasDoraMetricsRequest
+	(*GetCanvasDoraMetricsResponse)(nil),      // 86: Superplane.Canvases.GetCanvasDoraMetricsResponse
+	(*ExportCanvasDoraMetricsRequest)(nil),    // 87: Superplane.Canvases.ExportCanvasDoraMetricsRequest
+	(*ExportCanvasDoraMetricsResponse)(nil),   // 88: Superplane.Canvases.ExportCanvasDoraMetricsResponse
+	(*CanvasChange)(nil),                      // 89: Superplane.Canvases.CanvasChange
+	(*WatchCanvasRequest)(nil),                // 90: Superplane.Canvases.WatchCanvasRequest
+	(*WatchCanvasResponse)(nil),               // 91: Superplane.Canvases.WatchCanvasResponse
+	(*WatchExecutionRequest)(nil),             // 92: Superplane.Canvases.WatchExecutionRequest
+	(*WatchExecutionResponse)(nil),            // 93: Superplane.Canvases.WatchExecutionResponse
+	(*CanvasPlan_FieldChange)(nil),            // 94: Superplane.Canvases.CanvasPlan.FieldChange
+	(*CanvasPlan_NodeChange)(nil),             // 95: Superplane.Canvases.CanvasPlan.NodeChange
+	(*CanvasPlan_EdgeChange)(nil),             // 96: Superplane.Canvases.CanvasPlan.EdgeChange
+	(*CanvasPlan_WebhookChange)(nil),          // 97: Superplane.Canvases.CanvasPlan.WebhookChange
+	(*CanvasPlan_SubscriptionChange)(nil),     // 98: Superplane.Canvases.CanvasPlan.SubscriptionChange
+	(*CanvasPlan_OrphanedNode)(nil),           // 99: Superplane.Canvases.CanvasPlan.OrphanedNode
+	(*Canvas_Metadata)(nil),                   // 100: Superplane.Canvases.Canvas.Metadata
+	(*Canvas_Spec)(nil),                       // 101: Superplane.Canvases.Canvas.Spec
+	(*Canvas_Status)(nil),                     // 102: Superplane.Canvases.Canvas.Status
+	(*DoraSettings_DeploymentNode)(nil),       // 103: Superplane.Canvases.DoraSettings.DeploymentNode
+	(*DoraSettings_IncidentNode)(nil),         // 104: Superplane.Canvases.DoraSettings.IncidentNode
+	(*timestamp.Timestamp)(nil),               // 105: google.protobuf.Timestamp
+	(*_struct.Struct)(nil),                    // 106: google.protobuf.Struct
+	(*components.Node)(nil),                   // 107: Superplane.Components.Node
+	(*_struct.Value)(nil),                     // 108: google.protobuf.Value
+	(*components.Edge)(nil),                   // 109: Superplane.Components.Edge
 }
 var file_canvases_proto_depIdxs = []int32{
-	17, // 0: Superplane.Canvases.ListCanvasesResponse.canvases:type_name -> Superplane.Canvases.Canvas
-	17, // 1: Superplane.Canvases.DescribeCanvasResponse.canvas:type_name -> Superplane.Canvases.Canvas
-	17, // 2: Superplane.Canvases.CreateCanvasRequest.canvas:type_name -> Superplane.Canvases.Canvas
-	17, // 3: Superplane.Canvases.CreateCanvasResponse.canvas:type_name -> Superplane.Canvases.Canvas
-	0,  // 4: Superplane.Canvases.CanvasAutoLayout.algorithm:type_name -> Superplane.Canvases.CanvasAutoLayout.Algorithm
-	1,  // 5: Superplane.Canvases.CanvasAutoLayout.scope:type_name -> Superplane.Canvases.CanvasAutoLayout.Scope
-	17, // 6: Superplane.Canvases.UpdateCanvasRequest.canvas:type_name -> Superplane.Canvases.Canvas
-	11, // 7: Superplane.Canvases.UpdateCanvasRequest.auto_layout:type_name -> Superplane.Canvases.CanvasAutoLayout
-	17, // 8: Superplane.Canvases.UpdateCanvasResponse.canvas:type_name -> Superplane.Canvases.Canvas
-	62, // 9: Superplane.Canvases.Canvas.metadata:type_name -> Superplane.Canvases.Canvas.Metadata
-	63, // 10: Superplane.Canvases.Canvas.spec:type_name -> Superplane.Canvases.Canvas.Spec
-	64, // 11: Superplane.Canvases.Canvas.status:type_name -> Superplane.Canvases.Canvas.Status
-	65, // 12: Superplane.Canvases.ListNodeEventsRequest.before:type_name -> google.protobuf.Timestamp
-	45, // 13: Superplane.Canvases.ListNodeEventsResponse.events:type_name -> Superplane.Canvases.CanvasEvent
-	65, // 14: Superplane.Canvases.ListNodeEventsResponse.last_timestamp:type_name -> google.protobuf.Timestamp
-	66, // 15: Superplane.Canvases.EmitNodeEventRequest.data:type_name -> google.protobuf.Struct
-	65, // 16: Superplane.Canvases.ListNodeQueueItemsRequest.before:type_name -> google.protobuf.Timestamp
-	33, // 17: Superplane.Canvases.ListNodeQueueItemsResponse.items:type_name -> Superplane.Canvases.CanvasNodeQueueItem
-	65, // 18: Superplane.Canvases.ListNodeQueueItemsResponse.last_timestamp:type_name -> google.protobuf.Timestamp
-	67, // 19: Superplane.Canvases.UpdateNodePauseResponse.node:type_name -> Superplane.Components.Node
-	2,  // 20: Superplane.Canvases.ListNodeExecutionsRequest.states:type_name -> Superplane.Canvases.CanvasNodeExecution.State
-	3,  // 21: Superplane.Canvases.ListNodeExecutionsRequest.results:type_name -> Superplane.Canvases.CanvasNodeExecution.Result
-	65, // 22: Superplane.Canvases.ListNodeExecutionsRequest.before:type_name -> google.protobuf.Timestamp
-	32, // 23: Superplane.Canvases.ListNodeExecutionsResponse.executions:type_name -> Superplane.Canvases.CanvasNodeExecution
-	65, // 24: Superplane.Canvases.ListNodeExecutionsResponse.last_timestamp:type_name -> google.protobuf.Timestamp
-	32, // 25: Superplane.Canvases.ListChildExecutionsResponse.executions:type_name -> Superplane.Canvases.CanvasNodeExecution
-	2,  // 26: Superplane.Canvases.CanvasNodeExecution.state:type_name -> Superplane.Canvases.CanvasNodeExecution.State
-	3,  // 27: Superplane.Canvases.CanvasNodeExecution.result:type_name -> Superplane.Canvases.CanvasNodeExecution.Result
-	4,  // 28: Superplane.Canvases.CanvasNodeExecution.result_reason:type_name -> Superplane.Canvases.CanvasNodeExecution.ResultReason
-	66, // 29: Superplane.Canvases.CanvasNodeExecution.input:type_name -> google.protobuf.Struct
-	66, // 30: Superplane.Canvases.CanvasNodeExecution.outputs:type_name -> google.protobuf.Struct
-	65, // 31: Superplane.Canvases.CanvasNodeExecution.created_at:type_name -> google.protobuf.Timestamp
-	65, // 32: Superplane.Canvases.CanvasNodeExecution.updated_at:type_name -> google.protobuf.Timestamp
-	66, // 33: Superplane.Canvases.CanvasNodeExecution.metadata:type_name -> google.protobuf.Struct
-	66, // 34: Superplane.Canvases.CanvasNodeExecution.configuration:type_name -> google.protobuf.Struct
-	32, // 35: Superplane.Canvases.CanvasNodeExecution.child_executions:type_name -> Superplane.Canvases.CanvasNodeExecution
-	45, // 36: Superplane.Canvases.CanvasNodeExecution.root_event:type_name -> Superplane.Canvases.CanvasEvent
-	16, // 37: Superplane.Canvases.CanvasNodeExecution.cancelled_by:type_name -> Superplane.Canvases.UserRef
-	66, // 38: Superplane.Canvases.CanvasNodeQueueItem.input:type_name -> google.protobuf.Struct
-	45, // 39: Superplane.Canvases.CanvasNodeQueueItem.root_event:type_name -> Superplane.Canvases.CanvasEvent
-	65, // 40: Superplane.Canvases.CanvasNodeQueueItem.created_at:type_name -> google.protobuf.Timestamp
-	66, // 41: Superplane.Canvases.InvokeNodeExecutionActionRequest.parameters:type_name -> google.protobuf.Struct
-	66, // 42: Superplane.Canvases.InvokeNodeTriggerActionRequest.parameters:type_name -> google.protobuf.Struct
-	66, // 43: Superplane.Canvases.InvokeNodeTriggerActionResponse.result:type_name -> google.protobuf.Struct
-	65, // 44: Superplane.Canvases.ListCanvasEventsRequest.before:type_name -> google.protobuf.Timestamp
-	46, // 45: Superplane.Canvases.ListCanvasEventsResponse.events:type_name -> Superplane.Canvases.CanvasEventWithExecutions
-	65, // 46: Superplane.Canvases.ListCanvasEventsResponse.last_timestamp:type_name -> google.protobuf.Timestamp
-	68, // 47: Superplane.Canvases.CanvasMemory.values:type_name -> google.protobuf.Value
-	40, // 48: Superplane.Canvases.ListCanvasMemoriesResponse.items:type_name -> Superplane.Canvases.CanvasMemory
-	66, // 49: Superplane.Canvases.CanvasEvent.data:type_name -> google.protobuf.Struct
-	65, // 50: Superplane.Canvases.CanvasEvent.created_at:type_name -> google.protobuf.Timestamp
-	66, // 51: Superplane.Canvases.CanvasEventWithExecutions.data:type_name -> google.protobuf.Struct
-	65, // 52: Superplane.Canvases.CanvasEventWithExecutions.created_at:type_name -> google.protobuf.Timestamp
-	32, // 53: Superplane.Canvases.CanvasEventWithExecutions.executions:type_name -> Superplane.Canvases.CanvasNodeExecution
-	32, // 54: Superplane.Canvases.ListEventExecutionsResponse.executions:type_name -> Superplane.Canvases.CanvasNodeExecution
-	53, // 55: Superplane.Canvases.CanvasAiContext.nodes:type_name -> Superplane.Canvases.CanvasAiNodeContext
-	54, // 56: Superplane.Canvases.CanvasAiContext.available_blocks:type_name -> Superplane.Canvases.CanvasAiBlockContext
-	55, // 57: Superplane.Canvases.SendAiMessageRequest.canvas_context:type_name -> Superplane.Canvases.CanvasAiContext
-	66, // 58: Superplane.Canvases.SendAiMessageResponse.operations:type_name -> google.protobuf.Struct
-	65, // 59: Superplane.Canvases.CanvasNodeEventMessage.timestamp:type_name -> google.protobuf.Timestamp
-	65, // 60: Superplane.Canvases.CanvasNodeExecutionMessage.timestamp:type_name -> google.protobuf.Timestamp
-	65, // 61: Superplane.Canvases.CanvasNodeQueueItemMessage.timestamp:type_name -> google.protobuf.Timestamp
-	65, // 62: Superplane.Canvases.CanvasMessage.timestamp:type_name -> google.protobuf.Timestamp
-	65, // 63: Superplane.Canvases.Canvas.Metadata.created_at:type_name -> google.protobuf.Timestamp
-	65, // 64: Superplane.Canvases.Canvas.Metadata.updated_at:type_name -> google.protobuf.Timestamp
-	16, // 65: Superplane.Canvases.Canvas.Metadata.created_by:type_name -> Superplane.Canvases.UserRef
-	67, // 66: Superplane.Canvases.Canvas.Spec.nodes:type_name -> Superplane.Components.Node
-	69, // 67: Superplane.Canvases.Canvas.Spec.edges:type_name -> Superplane.Components.Edge
-	32, // 68: Superplane.Canvases.Canvas.Status.last_executions:type_name -> Superplane.Canvases.CanvasNodeExecution
-	33, // 69: Superplane.Canvases.Canvas.Status.next_queue_items:type_name -> Superplane.Canvases.CanvasNodeQueueItem
-	45, // 70: Superplane.Canvases.Canvas.Status.last_events:type_name -> Superplane.Canvases.CanvasEvent
-	5,  // 71: Superplane.Canvases.Canvases.ListCanvases:input_type -> Superplane.Canvases.ListCanvasesRequest
-	9,  // 72: Superplane.Canvases.Canvases.CreateCanvas:input_type -> Superplane.Canvases.CreateCanvasRequest
-	7,  // 73: Superplane.Canvases.Canvases.DescribeCanvas:input_type -> Superplane.Canvases.DescribeCanvasRequest
-	12, // 74: Superplane.Canvases.Canvases.UpdateCanvas:input_type -> Superplane.Canvases.UpdateCanvasRequest
-	14, // 75: Superplane.Canvases.Canvases.DeleteCanvas:input_type -> Superplane.Canvases.DeleteCanvasRequest
-	22, // 76: Superplane.Canvases.Canvases.ListNodeQueueItems:input_type -> Superplane.Canvases.ListNodeQueueItemsRequest
-	24, // 77: Superplane.Canvases.Canvases.DeleteNodeQueueItem:input_type -> Superplane.Canvases.DeleteNodeQueueItemRequest
-	26, // 78: Superplane.Canvases.Canvases.UpdateNodePause:input_type -> Superplane.Canvases.UpdateNodePauseRequest
-	28, // 79: Superplane.Canvases.Canvases.ListNodeExecutions:input_type -> Superplane.Canvases.ListNodeExecutionsRequest
-	18, // 80: Superplane.Canvases.Canvases.ListNodeEvents:input_type -> Superplane.Canvases.ListNodeEventsRequest
-	20, // 81: Superplane.Canvases.Canvases.EmitNodeEvent:input_type -> Superplane.Canvases.EmitNodeEventRequest
-	34, // 82: Superplane.Canvases.Canvases.InvokeNodeExecutionAction:input_type -> Superplane.Canvases.InvokeNodeExecutionActionRequest
-	36, // 83: Superplane.Canvases.Canvases.InvokeNodeTriggerAction:input_type -> Superplane.Canvases.InvokeNodeTriggerActionRequest
-	30, // 84: Superplane.Canvases.Canvases.ListChildExecutions:input_type -> Superplane.Canvases.ListChildExecutionsRequest
-	49, // 85: Superplane.Canvases.Canvases.CancelExecution:input_type -> Superplane.Canvases.CancelExecutionRequest
-	51, // 86: Superplane.Canvases.Canvases.ResolveExecutionErrors:input_type -> Superplane.Canvases.ResolveExecutionErrorsRequest
-	38, // 87: Superplane.Canvases.Canvases.ListCanvasEvents:input_type -> Superplane.Canvases.ListCanvasEventsRequest
-	41, // 88: Superplane.Canvases.Canvases.ListCanvasMemories:input_type -> Superplane.Canvases.ListCanvasMemoriesRequest
-	43, // 89: Superplane.Canvases.Canvases.DeleteCanvasMemory:input_type -> Superplane.Canvases.DeleteCanvasMemoryRequest
-	47, // 90: Superplane.Canvases.Canvases.ListEventExecutions:input_type -> Superplane.Canvases.ListEventExecutionsRequest
-	56, // 91: Superplane.Canvases.Canvases.SendAiMessage:input_type -> Superplane.Canvases.SendAiMessageRequest
-	6,  // 92: Superplane.Canvases.Canvases.ListCanvases:output_type -> Superplane.Canvases.ListCanvasesResponse
-	10, // 93: Superplane.Canvases.Canvases.CreateCanvas:output_type -> Superplane.Canvases.CreateCanvasResponse
-	8,  // 94: Superplane.Canvases.Canvases.DescribeCanvas:output_type -> Superplane.Canvases.DescribeCanvasResponse
-	13, // 95: Superplane.Canvases.Canvases.UpdateCanvas:output_type -> Superplane.Canvases.UpdateCanvasResponse
-	15, // 96: Superplane.Canvases.Canvases.DeleteCanvas:output_type -> Superplane.Canvases.DeleteCanvasResponse
-	23, // 97: Superplane.Canvases.Canvases.ListNodeQueueItems:output_type -> Superplane.Canvases.ListNodeQueueItemsResponse
-	25, // 98: Superplane.Canvases.Canvases.DeleteNodeQueueItem:output_type -> Superplane.Canvases.DeleteNodeQueueItemResponse
-	27, // 99: Superplane.Canvases.Canvases.UpdateNodePause:output_type -> Superplane.Canvases.UpdateNodePauseResponse
-	29, // 100: Superplane.Canvases.Canvases.ListNodeExecutions:output_type -> Superplane.Canvases.ListNodeExecutionsResponse
-	19, // 101: Superplane.Canvases.Canvases.ListNodeEvents:output_type -> Superplane.Canvases.ListNodeEventsResponse
-	21, // 102: Superplane.Canvases.Canvases.EmitNodeEvent:output_type -> Superplane.Canvases.EmitNodeEventResponse
-	35, // 103: Superplane.Canvases.Canvases.InvokeNodeExecutionAction:output_type -> Superplane.Canvases.InvokeNodeExecutionActionResponse
-	37, // 104: Superplane.Canvases.Canvases.InvokeNodeTriggerAction:output_type -> Superplane.Canvases.InvokeNodeTriggerActionResponse
-	31, // 105: Superplane.Canvases.Canvases.ListChildExecutions:output_type -> Superplane.Canvases.ListChildExecutionsResponse
-	50, // 106: Superplane.Canvases.Canvases.CancelExecution:output_type -> Superplane.Canvases.CancelExecutionResponse
-	52, // 107: Superplane.Canvases.Canvases.ResolveExecutionErrors:output_type -> Superplane.Canvases.ResolveExecutionErrorsResponse
-	39, // 108: Superplane.Canvases.Canvases.ListCanvasEvents:output_type -> Superplane.Canvases.ListCanvasEventsResponse
-	42, // 109: Superplane.Canvases.Canvases.ListCanvasMemories:output_type -> Superplane.Canvases.ListCanvasMemoriesResponse
-	44, // 110: Superplane.Canvases.Canvases.DeleteCanvasMemory:output_type -> Superplane.Canvases.DeleteCanvasMemoryResponse
-	48, // 111: Superplane.Canvases.Canvases.ListEventExecutions:output_type -> Superplane.Canvases.ListEventExecutionsResponse
-	57, // 112: Superplane.Canvases.Canvases.SendAiMessage:output_type -> Superplane.Canvases.SendAiMessageResponse
-	92, // [92:113] is the sub-list for method output_type
-	71, // [71:92] is the sub-list for method input_type
-	71, // [71:71] is the sub-list for extension type_name
-	71, // [71:71] is the sub-list for extension extendee
-	0,  // [0:71] is the sub-list for field type_name
+	21,  // 0: Superplane.Canvases.ListCanvasesResponse.canvases:type_name -> Superplane.Canvases.Canvas
+	21,  // 1: Superplane.Canvases.DescribeCanvasResponse.canvas:type_name -> Superplane.Canvases.Canvas
+	21,  // 2: Superplane.Canvases.CreateCanvasRequest.canvas:type_name -> Superplane.Canvases.Canvas
+	21,  // 3: Superplane.Canvases.CreateCanvasResponse.canvas:type_name -> Superplane.Canvases.Canvas
+	2,   // 4: Superplane.Canvases.CanvasAutoLayout.algorithm:type_name -> Superplane.Canvases.CanvasAutoLayout.Algorithm
+	3,   // 5: Superplane.Canvases.CanvasAutoLayout.scope:type_name -> Superplane.Canvases.CanvasAutoLayout.Scope
+	21,  // 6: Superplane.Canvases.UpdateCanvasRequest.canvas:type_name -> Superplane.Canvases.Canvas
+	14,  // 7: Superplane.Canvases.UpdateCanvasRequest.auto_layout:type_name -> Superplane.Canvases.CanvasAutoLayout
+	21,  // 8: Superplane.Canvases.UpdateCanvasResponse.canvas:type_name -> Superplane.Canvases.Canvas
+	17,  // 9: Superplane.Canvases.UpdateCanvasResponse.plan:type_name -> Superplane.Canvases.CanvasPlan
+	95,  // 10: Superplane.Canvases.CanvasPlan.nodes:type_name -> Superplane.Canvases.CanvasPlan.NodeChange
+	96,  // 11: Superplane.Canvases.CanvasPlan.edges:type_name -> Superplane.Canvases.CanvasPlan.EdgeChange
+	97,  // 12: Superplane.Canvases.CanvasPlan.webhooks:type_name -> Superplane.Canvases.CanvasPlan.WebhookChange
+	98,  // 13: Superplane.Canvases.CanvasPlan.subscriptions:type_name -> Superplane.Canvases.CanvasPlan.SubscriptionChange
+	99,  // 14: Superplane.Canvases.CanvasPlan.orphaned:type_name -> Superplane.Canvases.CanvasPlan.OrphanedNode
+	94,  // 15: Superplane.Canvases.CanvasPlan.canvas:type_name -> Superplane.Canvases.CanvasPlan.FieldChange
+	100, // 16: Superplane.Canvases.Canvas.metadata:type_name -> Superplane.Canvases.Canvas.Metadata
+	101, // 17: Superplane.Canvases.Canvas.spec:type_name -> Superplane.Canvases.Canvas.Spec
+	102, // 18: Superplane.Canvases.Canvas.status:type_name -> Superplane.Canvases.Canvas.Status
+	105, // 19: Superplane.Canvases.ListNodeEventsRequest.before:type_name -> google.protobuf.Timestamp
+	49,  // 20: Superplane.Canvases.ListNodeEventsResponse.events:type_name -> Superplane.Canvases.CanvasEvent
+	105, // 21: Superplane.Canvases.ListNodeEventsResponse.last_timestamp:type_name -> google.protobuf.Timestamp
+	106, // 22: Superplane.Canvases.EmitNodeEventRequest.data:type_name -> google.protobuf.Struct
+	105, // 23: Superplane.Canvases.ListNodeQueueItemsRequest.before:type_name -> google.protobuf.Timestamp
+	37,  // 24: Superplane.Canvases.ListNodeQueueItemsResponse.items:type_name -> Superplane.Canvases.CanvasNodeQueueItem
+	105, // 25: Superplane.Canvases.ListNodeQueueItemsResponse.last_timestamp:type_name -> google.protobuf.Timestamp
+	107, // 26: Superplane.Canvases.UpdateNodePauseResponse.node:type_name -> Superplane.Components.Node
+	5,   // 27: Superplane.Canvases.ListNodeExecutionsRequest.states:type_name -> Superplane.Canvases.CanvasNodeExecution.State
+	6,   // 28: Superplane.Canvases.ListNodeExecutionsRequest.results:type_name -> Superplane.Canvases.CanvasNodeExecution.Result
+	105, // 29: Superplane.Canvases.ListNodeExecutionsRequest.before:type_name -> google.protobuf.Timestamp
+	36,  // 30: Superplane.Canvases.ListNodeExecutionsResponse.executions:type_name -> Superplane.Canvases.CanvasNodeExecution
+	105, // 31: Superplane.Canvases.ListNodeExecutionsResponse.last_timestamp:type_name -> google.protobuf.Timestamp
+	36,  // 32: Superplane.Canvases.ListChildExecutionsResponse.executions:type_name -> Superplane.Canvases.CanvasNodeExecution
+	5,   // 33: Superplane.Canvases.CanvasNodeExecution.state:type_name -> Superplane.Canvases.CanvasNodeExecution.State
+	6,   // 34: Superplane.Canvases.CanvasNodeExecution.result:type_name -> Superplane.Canvases.CanvasNodeExecution.Result
+	7,   // 35: Superplane.Canvases.CanvasNodeExecution.result_reason:type_name -> Superplane.Canvases.CanvasNodeExecution.ResultReason
+	106, // 36: Superplane.Canvases.CanvasNodeExecution.input:type_name -> google.protobuf.Struct
+	106, // 37: Superplane.Canvases.CanvasNodeExecution.outputs:type_name -> google.protobuf.Struct
+	105, // 38: Superplane.Canvases.CanvasNodeExecution.created_at:type_name -> google.protobuf.Timestamp
+	105, // 39: Superplane.Canvases.CanvasNodeExecution.updated_at:type_name -> google.protobuf.Timestamp
+	106, // 40: Superplane.Canvases.CanvasNodeExecution.metadata:type_name -> google.protobuf.Struct
+	106, // 41: Superplane.Canvases.CanvasNodeExecution.configuration:type_name -> google.protobuf.Struct
+	36,  // 42: Superplane.Canvases.CanvasNodeExecution.child_executions:type_name -> Superplane.Canvases.CanvasNodeExecution
+	49,  // 43: Superplane.Canvases.CanvasNodeExecution.root_event:type_name -> Superplane.Canvases.CanvasEvent
+	20,  // 44: Superplane.Canvases.CanvasNodeExecution.cancelled_by:type_name -> Superplane.Canvases.UserRef
+	106, // 45: Superplane.Canvases.CanvasNodeQueueItem.input:type_name -> google.protobuf.Struct
+	49,  // 46: Superplane.Canvases.CanvasNodeQueueItem.root_event:type_name -> Superplane.Canvases.CanvasEvent
+	105, // 47: Superplane.Canvases.CanvasNodeQueueItem.created_at:type_name -> google.protobuf.Timestamp
+	106, // 48: Superplane.Canvases.InvokeNodeExecutionActionRequest.parameters:type_name -> google.protobuf.Struct
+	106, // 49: Superplane.Canvases.InvokeNodeTriggerActionRequest.parameters:type_name -> google.protobuf.Struct
+	106, // 50: Superplane.Canvases.InvokeNodeTriggerActionResponse.result:type_name -> google.protobuf.Struct
+	105, // 51: Superplane.Canvases.ListCanvasEventsRequest.before:type_name -> google.protobuf.Timestamp
+	50,  // 52: Superplane.Canvases.ListCanvasEventsResponse.events:type_name -> Superplane.Canvases.CanvasEventWithExecutions
+	105, // 53: Superplane.Canvases.ListCanvasEventsResponse.last_timestamp:type_name -> google.protobuf.Timestamp
+	108, // 54: Superplane.Canvases.CanvasMemory.values:type_name -> google.protobuf.Value
+	44,  // 55: Superplane.Canvases.ListCanvasMemoriesResponse.items:type_name -> Superplane.Canvases.CanvasMemory
+	106, // 56: Superplane.Canvases.CanvasEvent.data:type_name -> google.protobuf.Struct
+	105, // 57: Superplane.Canvases.CanvasEvent.created_at:type_name -> google.protobuf.Timestamp
+	106, // 58: Superplane.Canvases.CanvasEventWithExecutions.data:type_name -> google.protobuf.Struct
+	105, // 59: Superplane.Canvases.CanvasEventWithExecutions.created_at:type_name -> google.protobuf.Timestamp
+	36,  // 60: Superplane.Canvases.CanvasEventWithExecutions.executions:type_name -> Superplane.Canvases.CanvasNodeExecution
+	36,  // 61: Superplane.Canvases.ListEventExecutionsResponse.executions:type_name -> Superplane.Canvases.CanvasNodeExecution
+	57,  // 62: Superplane.Canvases.GetExecutionLogsResponse.logs:type_name -> Superplane.Canvases.CanvasNodeExecutionLog
+	105, // 63: Superplane.Canvases.CanvasNodeExecutionLog.created_at:type_name -> google.protobuf.Timestamp
+	62,  // 64: Superplane.Canvases.ListExecutionArtifactsResponse.artifacts:type_name -> Superplane.Canvases.CanvasNodeExecutionArtifact
+	62,  // 65: Superplane.Canvases.DownloadExecutionArtifactResponse.artifact:type_name -> Superplane.Canvases.CanvasNodeExecutionArtifact
+	105, // 66: Superplane.Canvases.CanvasNodeExecutionArtifact.created_at:type_name -> google.protobuf.Timestamp
+	65,  // 67: Superplane.Canvases.CanvasAiContext.nodes:type_name -> Superplane.Canvases.CanvasAiNodeContext
+	66,  // 68: Superplane.Canvases.CanvasAiContext.available_blocks:type_name -> Superplane.Canvases.CanvasAiBlockContext
+	67,  // 69: Superplane.Canvases.SendAiMessageRequest.canvas_context:type_name -> Superplane.Canvases.CanvasAiContext
+	106, // 70: Superplane.Canvases.SendAiMessageResponse.operations:type_name -> google.protobuf.Struct
+	105, // 71: Superplane.Canvases.CanvasNodeEventMessage.timestamp:type_name -> google.protobuf.Timestamp
+	105, // 72: Superplane.Canvases.CanvasNodeExecutionMessage.timestamp:type_name -> google.protobuf.Timestamp
+	57,  // 73: Superplane.Canvases.CanvasNodeExecutionLogMessage.log:type_name -> Superplane.Canvases.CanvasNodeExecutionLog
+	105, // 74: Superplane.Canvases.CanvasNodeExecutionLogMessage.timestamp:type_name -> google.protobuf.Timestamp
+	105, // 75: Superplane.Canvases.CanvasNodeQueueItemMessage.timestamp:type_name -> google.protobuf.Timestamp
+	105, // 76: Superplane.Canvases.CanvasMessage.timestamp:type_name -> google.protobuf.Timestamp
+	105, // 77: Superplane.Canvases.GetCanvasStatsRequest.from:type_name -> google.protobuf.Timestamp
+	105, // 78: Superplane.Canvases.GetCanvasStatsRequest.to:type_name -> google.protobuf.Timestamp
+	77,  // 79: Superplane.Canvases.GetCanvasStatsResponse.canvas:type_name -> Superplane.Canvases.CanvasExecutionStats
+	78,  // 80: Superplane.Canvases.GetCanvasStatsResponse.nodes:type_name -> Superplane.Canvases.CanvasNodeExecutionStats
+	105, // 81: Superplane.Canvases.GetCanvasStatsResponse.from:type_name -> google.protobuf.Timestamp
+	105, // 82: Superplane.Canvases.GetCanvasStatsResponse.to:type_name -> google.protobuf.Timestamp
+	77,  // 83: Superplane.Canvases.CanvasNodeExecutionStats.stats:type_name -> Superplane.Canvases.CanvasExecutionStats
+	103, // 84: Superplane.Canvases.DoraSettings.deployment_nodes:type_name -> Superplane.Canvases.DoraSettings.DeploymentNode
+	104, // 85: Superplane.Canvases.DoraSettings.incident_nodes:type_name -> Superplane.Canvases.DoraSettings.IncidentNode
+	105, // 86: Superplane.Canvases.DoraSettings.updated_at:type_name -> google.protobuf.Timestamp
+	79,  // 87: Superplane.Canvases.GetCanvasDoraSettingsResponse.settings:type_name -> Superplane.Canvases.DoraSettings
+	79,  // 88: Superplane.Canvases.UpdateCanvasDoraSettingsRequest.settings:type_name -> Superplane.Canvases.DoraSettings
+	79,  // 89: Superplane.Canvases.UpdateCanvasDoraSettingsResponse.settings:type_name -> Superplane.Canvases.DoraSettings
+	105, // 90: Superplane.Canvases.DoraMetrics.start:type_name -> google.protobuf.Timestamp
+	105, // 91: Superplane.Canvases.DoraMetrics.end:type_name -> google.protobuf.Timestamp
+	105, // 92: Superplane.Canvases.GetCanvasDoraMetricsRequest.from:type_name -> google.protobuf.Timestamp
+	105, // 93: Superplane.Canvases.GetCanvasDoraMetricsRequest.to:type_name -> google.protobuf.Timestamp
+	0,   // 94: Superplane.Canvases.GetCanvasDoraMetricsRequest.interval:type_name -> Superplane.Canvases.DoraInterval
+	84,  // 95: Superplane.Canvases.GetCanvasDoraMetricsResponse.summary:type_name -> Superplane.Canvases.DoraMetrics
+	84,  // 96: Superplane.Canvases.GetCanvasDoraMetricsResponse.series:type_name -> Superplane.Canvases.DoraMetrics
+	0,   // 97: Superplane.Canvases.GetCanvasDoraMetricsResponse.interval:type_name -> Superplane.Canvases.DoraInterval
+	105, // 98: Superplane.Canvases.ExportCanvasDoraMetricsRequest.from:type_name -> google.protobuf.Timestamp
+	105, // 99: Superplane.Canvases.ExportCanvasDoraMetricsRequest.to:type_name -> google.protobuf.Timestamp
+	0,   // 100: Superplane.Canvases.ExportCanvasDoraMetricsRequest.interval:type_name -> Superplane.Canvases.DoraInterval
+	1,   // 101: Superplane.Canvases.CanvasChange.type:type_name -> Superplane.Canvases.CanvasChangeType
+	105, // 102: Superplane.Canvases.CanvasChange.timestamp:type_name -> google.protobuf.Timestamp
+	49,  // 103: Superplane.Canvases.CanvasChange.event:type_name -> Superplane.Canvases.CanvasEvent
+	36,  // 104: Superplane.Canvases.CanvasChange.execution:type_name -> Superplane.Canvases.CanvasNodeExecution
+	37,  // 105: Superplane.Canvases.CanvasChange.queue_item:type_name -> Superplane.Canvases.CanvasNodeQueueItem
+	89,  // 106: Superplane.Canvases.WatchCanvasResponse.change:type_name -> Superplane.Canvases.CanvasChange
+	89,  // 107: Superplane.Canvases.WatchExecutionResponse.change:type_name -> Superplane.Canvases.CanvasChange
+	4,   // 108: Superplane.Canvases.CanvasPlan.NodeChange.action:type_name -> Superplane.Canvases.CanvasPlan.Action
+	94,  // 109: Superplane.Canvases.CanvasPlan.NodeChange.fields:type_name -> Superplane.Canvases.CanvasPlan.FieldChange
+	4,   // 110: Superplane.Canvases.CanvasPlan.EdgeChange.action:type_name -> Superplane.Canvases.CanvasPlan.Action
+	4,   // 111: Superplane.Canvases.CanvasPlan.WebhookChange.action:type_name -> Superplane.Canvases.CanvasPlan.Action
+	4,   // 112: Superplane.Canvases.CanvasPlan.SubscriptionChange.action:type_name -> Superplane.Canvases.CanvasPlan.Action
+	105, // 113: Superplane.Canvases.Canvas.Metadata.created_at:type_name -> google.protobuf.Timestamp
+	105, // 114: Superplane.Canvases.Canvas.Metadata.updated_at:type_name -> google.protobuf.Timestamp
+	20,  // 115: Superplane.Canvases.Canvas.Metadata.created_by:type_name -> Superplane.Canvases.UserRef
+	107, // 116: Superplane.Canvases.Canvas.Spec.nodes:type_name -> Superplane.Components.Node
+	109, // 117: Superplane.Canvases.Canvas.Spec.edges:type_name -> Superplane.Components.Edge
+	36,  // 118: Superplane.Canvases.Canvas.Status.last_executions:type_name -> Superplane.Canvases.CanvasNodeExecution
+	37,  // 119: Superplane.Canvases.Canvas.Status.next_queue_items:type_name -> Superplane.Canvases.CanvasNodeQueueItem
+	49,  // 120: Superplane.Canvases.Canvas.Status.last_events:type_name -> Superplane.Canvases.CanvasEvent
+	8,   // 121: Superplane.Canvases.Canvases.ListCanvases:input_type -> Superplane.Canvases.ListCanvasesRequest
+	12,  // 122: Superplane.Canvases.Canvases.CreateCanvas:input_type -> Superplane.Canvases.CreateCanvasRequest
+	10,  // 123: Superplane.Canvases.Canvases.DescribeCanvas:input_type -> Superplane.Canvases.DescribeCanvasRequest
+	15,  // 124: Superplane.Canvases.Canvases.UpdateCanvas:input_type -> Superplane.Canvases.UpdateCanvasRequest
+	18,  // 125: Superplane.Canvases.Canvases.DeleteCanvas:input_type -> Superplane.Canvases.DeleteCanvasRequest
+	26,  // 126: Superplane.Canvases.Canvases.ListNodeQueueItems:input_type -> Superplane.Canvases.ListNodeQueueItemsRequest
+	28,  // 127: Superplane.Canvases.Canvases.DeleteNodeQueueItem:input_type -> Superplane.Canvases.DeleteNodeQueueItemRequest
+	30,  // 128: Superplane.Canvases.Canvases.UpdateNodePause:input_type -> Superplane.Canvases.UpdateNodePauseRequest
+	32,  // 129: Superplane.Canvases.Canvases.ListNodeExecutions:input_type -> Superplane.Canvases.ListNodeExecutionsRequest
+	22,  // 130: Superplane.Canvases.Canvases.ListNodeEvents:input_type -> Superplane.Canvases.ListNodeEventsRequest
+	24,  // 131: Superplane.Canvases.Canvases.EmitNodeEvent:input_type -> Superplane.Canvases.EmitNodeEventRequest
+	38,  // 132: Superplane.Canvases.Canvases.InvokeNodeExecutionAction:input_type -> Superplane.Canvases.InvokeNodeExecutionActionRequest
+	40,  // 133: Superplane.Canvases.Canvases.InvokeNodeTriggerAction:input_type -> Superplane.Canvases.InvokeNodeTriggerActionRequest
+	34,  // 134: Superplane.Canvases.Canvases.ListChildExecutions:input_type -> Superplane.Canvases.ListChildExecutionsRequest
+	53,  // 135: Superplane.Canvases.Canvases.CancelExecution:input_type -> Superplane.Canvases.CancelExecutionRequest
+	55,  // 136: Superplane.Canvases.Canvases.GetExecutionLogs:input_type -> Superplane.Canvases.GetExecutionLogsRequest
+	58,  // 137: Superplane.Canvases.Canvases.ListExecutionArtifacts:input_type -> Superplane.Canvases.ListExecutionArtifactsRequest
+	60,  // 138: Superplane.Canvases.Canvases.DownloadExecutionArtifact:input_type -> Superplane.Canvases.DownloadExecutionArtifactRequest
+	63,  // 139: Superplane.Canvases.Canvases.ResolveExecutionErrors:input_type -> Superplane.Canvases.ResolveExecutionErrorsRequest
+	42,  // 140: Superplane.Canvases.Canvases.ListCanvasEvents:input_type -> Superplane.Canvases.ListCanvasEventsRequest
+	45,  // 141: Superplane.Canvases.Canvases.ListCanvasMemories:input_type -> Superplane.Canvases.ListCanvasMemoriesRequest
+	47,  // 142: Superplane.Canvases.Canvases.DeleteCanvasMemory:input_type -> Superplane.Canvases.DeleteCanvasMemoryRequest
+	51,  // 143: Superplane.Canvases.Canvases.ListEventExecutions:input_type -> Superplane.Canvases.ListEventExecutionsRequest
+	68,  // 144: Superplane.Canvases.Canvases.SendAiMessage:input_type -> Superplane.Canvases.SendAiMessageRequest
+	75,  // 145: Superplane.Canvases.Canvases.GetCanvasStats:input_type -> Superplane.Canvases.GetCanvasStatsRequest
+	80,  // 146: Superplane.Canvases.Canvases.GetCanvasDoraSettings:input_type -> Superplane.Canvases.GetCanvasDoraSettingsRequest
+	82,  // 147: Superplane.Canvases.Canvases.UpdateCanvasDoraSettings:input_type -> Superplane.Canvases.UpdateCanvasDoraSettingsRequest
+	85,  // 148: Superplane.Canvases.Canvases.GetCanvasDoraMetrics:input_type -> Superplane.Canvases.GetCanvasDoraMetricsRequest
+	87,  // 149: Superplane.Canvases.Canvases.ExportCanvasDoraMetrics:input_type -> Superplane.Canvases.ExportCanvasDoraMetricsRequest
+	90,  // 150: Superplane.Canvases.Canvases.WatchCanvas:input_type -> Superplane.Canvases.WatchCanvasRequest
+	92,  // 151: Superplane.Canvases.Canvases.WatchExecution:input_type -> Superplane.Canvases.WatchExecutionRequest
+	9,   // 152: Superplane.Canvases.Canvases.ListCanvases:output_type -> Superplane.Canvases.ListCanvasesResponse
+	13,  // 153: Superplane.Canvases.Canvases.CreateCanvas:output_type -> Superplane.Canvases.CreateCanvasResponse
+	11,  // 154: Superplane.Canvases.Canvases.DescribeCanvas:output_type -> Superplane.Canvases.DescribeCanvasResponse
+	16,  // 155: Superplane.Canvases.Canvases.UpdateCanvas:output_type -> Superplane.Canvases.UpdateCanvasResponse
+	19,  // 156: Superplane.Canvases.Canvases.DeleteCanvas:output_type -> Superplane.Canvases.DeleteCanvasResponse
+	27,  // 157: Superplane.Canvases.Canvases.ListNodeQueueItems:output_type -> Superplane.Canvases.ListNodeQueueItemsResponse
+	29,  // 158: Superplane.Canvases.Canvases.DeleteNodeQueueItem:output_type -> Superplane.Canvases.DeleteNodeQueueItemResponse
+	31,  // 159: Superplane.Canvases.Canvases.UpdateNodePause:output_type -> Superplane.Canvases.UpdateNodePauseResponse
+	33,  // 160: Superplane.Canvases.Canvases.ListNodeExecutions:output_type -> Superplane.Canvases.ListNodeExecutionsResponse
+	23,  // 161: Superplane.Canvases.Canvases.ListNodeEvents:output_type -> Superplane.Canvases.ListNodeEventsResponse
+	25,  // 162: Superplane.Canvases.Canvases.EmitNodeEvent:output_type -> Superplane.Canvases.EmitNodeEventResponse
+	39,  // 163: Superplane.Canvases.Canvases.InvokeNodeExecutionAction:output_type -> Superplane.Canvases.InvokeNodeExecutionActionResponse
+	41,  // 164: Superplane.Canvases.Canvases.InvokeNodeTriggerAction:output_type -> Superplane.Canvases.InvokeNodeTriggerActionResponse
+	35,  // 165: Superplane.Canvases.Canvases.ListChildExecutions:output_type -> Superplane.Canvases.ListChildExecutionsResponse
+	54,  // 166: Superplane.Canvases.Canvases.CancelExecution:output_type -> Superplane.Canvases.CancelExecutionResponse
+	56,  // 167: Superplane.Canvases.Canvases.GetExecutionLogs:output_type -> Superplane.Canvases.GetExecutionLogsResponse
+	59,  // 168: Superplane.Canvases.Canvases.ListExecutionArtifacts:output_type -> Superplane.Canvases.ListExecutionArtifactsResponse
+	61,  // 169: Superplane.Canvases.Canvases.DownloadExecutionArtifact:output_type -> Superplane.Canvases.DownloadExecutionArtifactResponse
+	64,  // 170: Superplane.Canvases.Canvases.ResolveExecutionErrors:output_type -> Superplane.Canvases.ResolveExecutionErrorsResponse
+	43,  // 171: Superplane.Canvases.Canvases.ListCanvasEvents:output_type -> Superplane.Canvases.ListCanvasEventsResponse
+	46,  // 172: Superplane.Canvases.Canvases.ListCanvasMemories:output_type -> Superplane.Canvases.ListCanvasMemoriesResponse
+	48,  // 173: Superplane.Canvases.Canvases.DeleteCanvasMemory:output_type -> Superplane.Canvases.DeleteCanvasMemoryResponse
+	52,  // 174: Superplane.Canvases.Canvases.ListEventExecutions:output_type -> Superplane.Canvases.ListEventExecutionsResponse
+	69,  // 175: Superplane.Canvases.Canvases.SendAiMessage:output_type -> Superplane.Canvases.SendAiMessageResponse
+	76,  // 176: Superplane.Canvases.Canvases.GetCanvasStats:output_type -> Superplane.Canvases.GetCanvasStatsResponse
+	81,  // 177: Superplane.Canvases.Canvases.GetCanvasDoraSettings:output_type -> Superplane.Canvases.GetCanvasDoraSettingsResponse
+	83,  // 178: Superplane.Canvases.Canvases.UpdateCanvasDoraSettings:output_type -> Superplane.Canvases.UpdateCanvasDoraSettingsResponse
+	86,  // 179: Superplane.Canvases.Canvases.GetCanvasDoraMetrics:output_type -> Superplane.Canvases.GetCanvasDoraMetricsResponse
+	88,  // 180: Superplane.Canvases.Canvases.ExportCanvasDoraMetrics:output_type -> Superplane.Canvases.ExportCanvasDoraMetricsResponse
+	91,  // 181: Superplane.Canvases.Canvases.WatchCanvas:output_type -> Superplane.Canvases.WatchCanvasResponse
+	93,  // 182: Superplane.Canvases.Canvases.WatchExecution:output_type -> Superplane.Canvases.WatchExecutionResponse
+	152, // [152:183] is the sub-list for method output_type
+	121, // [121:152] is the sub-list for method input_type
+	121, // [121:121] is the sub-list for extension type_name
+	121, // [121:121] is the sub-list for extension extendee
+	0,   // [0:121] is the sub-list for field type_name
 }
 
 func init() { file_canvases_proto_init() }
@@ -4370,8 +7292,8 @@ func file_canvases_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_canvases_proto_rawDesc), len(file_canvases_proto_rawDesc)),
-			NumEnums:      5,
-			NumMessages:   60,
+			NumEnums:      8,
+			NumMessages:   97,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -34,12 +34,22 @@ const (
 	Canvases_InvokeNodeTriggerAction_FullMethodName   = "/Superplane.Canvases.Canvases/InvokeNodeTriggerAction"
 	Canvases_ListChildExecutions_FullMethodName       = "/Superplane.Canvases.Canvases/ListChildExecutions"
 	Canvases_CancelExecution_FullMethodName           = "/Superplane.Canvases.Canvases/CancelExecution"
+	Canvases_GetExecutionLogs_FullMethodName          = "/Superplane.Canvases.Canvases/GetExecutionLogs"
+	Canvases_ListExecutionArtifacts_FullMethodName    = "/Superplane.Canvases.Canvases/ListExecutionArtifacts"
+	Canvases_DownloadExecutionArtifact_FullMethodName = "/Superplane.Canvases.Canvases/DownloadExecutionArtifact"
 	Canvases_ResolveExecutionErrors_FullMethodName    = "/Superplane.Canvases.Canvases/ResolveExecutionErrors"
 	Canvases_ListCanvasEvents_FullMethodName          = "/Superplane.Canvases.Canvases/ListCanvasEvents"
 	Canvases_ListCanvasMemories_FullMethodName        = "/Superplane.Canvases.Canvases/ListCanvasMemories"
 	Canvases_DeleteCanvasMemory_FullMethodName        = "/Superplane.Canvases.Canvases/DeleteCanvasMemory"
 	Canvases_ListEventExecutions_FullMethodName       = "/Superplane.Canvases.Canvases/ListEventExecutions"
 	Canvases_SendAiMessage_FullMethodName             = "/Superplane.Canvases.Canvases/SendAiMessage"
+	Canvases_GetCanvasStats_FullMethodName            = "/Superplane.Canvases.Canvases/GetCanvasStats"
+	Canvases_GetCanvasDoraSettings_FullMethodName     = "/Superplane.Canvases.Canvases/GetCanvasDoraSettings"
+	Canvases_UpdateCanvasDoraSettings_FullMethodName  = "/Superplane.Canvases.Canvases/UpdateCanvasDoraSettings"
+	Canvases_GetCanvasDoraMetrics_FullMethodName      = "/Superplane.Canvases.Canvases/GetCanvasDoraMetrics"
+	Canvases_ExportCanvasDoraMetrics_FullMethodName   = "/Superplane.Canvases.Canvases/ExportCanvasDoraMetrics"
+	Canvases_WatchCanvas_FullMethodName               = "/Superplane.Canvases.Canvases/WatchCanvas"
+	Canvases_WatchExecution_FullMethodName            = "/Superplane.Canvases.Canvases/WatchExecution"
 )
 
 // CanvasesClient is the client API for Canvases service.
@@ -61,12 +71,22 @@ type CanvasesClient interface {
 	InvokeNodeTriggerAction(ctx context.Context, in *InvokeNodeTriggerActionRequest, opts ...grpc.CallOption) (*InvokeNodeTriggerActionResponse, error)
 	ListChildExecutions(ctx context.Context, in *ListChildExecutionsRequest, opts ...grpc.CallOption) (*ListChildExecutionsResponse, error)
 	CancelExecution(ctx context.Context, in *CancelExecutionRequest, opts ...grpc.CallOption) (*CancelExecutionResponse, error)
+	GetExecutionLogs(ctx context.Context, in *GetExecutionLogsRequest, opts ...grpc.CallOption) (*GetExecutionLogsResponse, error)
+	ListExecutionArtifacts(ctx context.Context, in *ListExecutionArtifactsRequest, opts ...grpc.CallOption) (*ListExecutionArtifactsResponse, error)
+	DownloadExecutionArtifact(ctx context.Context, in *DownloadExecutionArtifactRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[DownloadExecutionArtifactResponse], error)
 	ResolveExecutionErrors(ctx context.Context, in *ResolveExecutionErrorsRequest, opts ...grpc.CallOption) (*ResolveExecutionErrorsResponse, error)
 	ListCanvasEvents(ctx context.Context, in *ListCanvasEventsRequest, opts ...grpc.CallOption) (*ListCanvasEventsResponse, error)
 	ListCanvasMemories(ctx context.Context, in *ListCanvasMemoriesRequest, opts ...grpc.CallOption) (*ListCanvasMemoriesResponse, error)
 	DeleteCanvasMemory(ctx context.Context, in *DeleteCanvasMemoryRequest, opts ...grpc.CallOption) (*DeleteCanvasMemoryResponse, error)
 	ListEventExecutions(ctx context.Context, in *ListEventExecutionsRequest, opts ...grpc.CallOption) (*ListEventExecutionsResponse, error)
 	SendAiMessage(ctx context.Context, in *SendAiMessageRequest, opts ...grpc.CallOption) (*SendAiMessageResponse, error)
+	GetCanvasStats(ctx context.Context, in *GetCanvasStatsRequest, opts ...grpc.CallOption) (*GetCanvasStatsResponse, error)
+	GetCanvasDoraSettings(ctx context.Context, in *GetCanvasDoraSettingsRequest, opts ...grpc.CallOption) (*GetCanvasDoraSettingsResponse, error)
+	UpdateCanvasDoraSettings(ctx context.Context, in *UpdateCanvasDoraSettingsRequest, opts ...grpc.CallOption) (*UpdateCanvasDoraSettingsResponse, error)
+	GetCanvasDoraMetrics(ctx context.Context, in *GetCanvasDoraMetricsRequest, opts ...grpc.CallOption) (*GetCanvasDoraMetricsResponse, error)
+	ExportCanvasDoraMetrics(ctx context.Context, in *ExportCanvasDoraMetricsRequest, opts ...grpc.CallOption) (*ExportCanvasDoraMetricsResponse, error)
+	WatchCanvas(ctx context.Context, in *WatchCanvasRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[WatchCanvasResponse], error)
+	WatchExecution(ctx context.Context, in *WatchExecutionRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[WatchExecutionResponse], error)
 }
 
 type canvasesClient struct {
@@ -227,6 +247,45 @@ func (c *canvasesClient) CancelExecution(ctx context.Context, in *CancelExecutio
 	return out, nil
 }
 
+func (c *canvasesClient) GetExecutionLogs(ctx context.Context, in *GetExecutionLogsRequest, opts ...grpc.CallOption) (*GetExecutionLogsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetExecutionLogsResponse)
+	err := c.cc.Invoke(ctx, Canvases_GetExecutionLogs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *canvasesClient) ListExecutionArtifacts(ctx context.Context, in *ListExecutionArtifactsRequest, opts ...grpc.CallOption) (*ListExecutionArtifactsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListExecutionArtifactsResponse)
+	err := c.cc.Invoke(ctx, Canvases_ListExecutionArtifacts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *canvasesClient) DownloadExecutionArtifact(ctx context.Context, in *DownloadExecutionArtifactRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[DownloadExecutionArtifactResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &Canvases_ServiceDesc.Streams[0], Canvases_DownloadExecutionArtifact_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[DownloadExecutionArtifactRequest, DownloadExecutionArtifactResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Canvases_DownloadExecutionArtifactClient = grpc.ServerStreamingClient[DownloadExecutionArtifactResponse]
+
 func (c *canvasesClient) ResolveExecutionErrors(ctx context.Context, in *ResolveExecutionErrorsRequest, opts ...grpc.CallOption) (*ResolveExecutionErrorsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ResolveExecutionErrorsResponse)
@@ -287,6 +346,94 @@ func (c *canvasesClient) SendAiMessage(ctx context.Context, in *SendAiMessageReq
 	return out, nil
 }
 
+func (c *canvasesClient) GetCanvasStats(ctx context.Context, in *GetCanvasStatsRequest, opts ...grpc.CallOption) (*GetCanvasStatsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCanvasStatsResponse)
+	err := c.cc.Invoke(ctx, Canvases_GetCanvasStats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *canvasesClient) GetCanvasDoraSettings(ctx context.Context, in *GetCanvasDoraSettingsRequest, opts ...grpc.CallOption) (*GetCanvasDoraSettingsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCanvasDoraSettingsResponse)
+	err := c.cc.Invoke(ctx, Canvases_GetCanvasDoraSettings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *canvasesClient) UpdateCanvasDoraSettings(ctx context.Context, in *UpdateCanvasDoraSettingsRequest, opts ...grpc.CallOption) (*UpdateCanvasDoraSettingsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateCanvasDoraSettingsResponse)
+	err := c.cc.Invoke(ctx, Canvases_UpdateCanvasDoraSettings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *canvasesClient) GetCanvasDoraMetrics(ctx context.Context, in *GetCanvasDoraMetricsRequest, opts ...grpc.CallOption) (*GetCanvasDoraMetricsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCanvasDoraMetricsResponse)
+	err := c.cc.Invoke(ctx, Canvases_GetCanvasDoraMetrics_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *canvasesClient) ExportCanvasDoraMetrics(ctx context.Context, in *ExportCanvasDoraMetricsRequest, opts ...grpc.CallOption) (*ExportCanvasDoraMetricsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExportCanvasDoraMetricsResponse)
+	err := c.cc.Invoke(ctx, Canvases_ExportCanvasDoraMetrics_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *canvasesClient) WatchCanvas(ctx context.Context, in *WatchCanvasRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[WatchCanvasResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &Canvases_ServiceDesc.Streams[1], Canvases_WatchCanvas_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[WatchCanvasRequest, WatchCanvasResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Canvases_WatchCanvasClient = grpc.ServerStreamingClient[WatchCanvasResponse]
+
+func (c *canvasesClient) WatchExecution(ctx context.Context, in *WatchExecutionRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[WatchExecutionResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &Canvases_ServiceDesc.Streams[2], Canvases_WatchExecution_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[WatchExecutionRequest, WatchExecutionResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Canvases_WatchExecutionClient = grpc.ServerStreamingClient[WatchExecutionResponse]
+
 // CanvasesServer is the server API for Canvases service.
 // All implementations should embed UnimplementedCanvasesServer
 // for forward compatibility.
@@ -306,12 +453,22 @@ type CanvasesServer interface {
 	InvokeNodeTriggerAction(context.Context, *InvokeNodeTriggerActionRequest) (*InvokeNodeTriggerActionResponse, error)
 	ListChildExecutions(context.Context, *ListChildExecutionsRequest) (*ListChildExecutionsResponse, error)
 	CancelExecution(context.Context, *CancelExecutionRequest) (*CancelExecutionResponse, error)
+	GetExecutionLogs(context.Context, *GetExecutionLogsRequest) (*GetExecutionLogsResponse, error)
+	ListExecutionArtifacts(context.Context, *ListExecutionArtifactsRequest) (*ListExecutionArtifactsResponse, error)
+	DownloadExecutionArtifact(*DownloadExecutionArtifactRequest, grpc.ServerStreamingServer[DownloadExecutionArtifactResponse]) error
 	ResolveExecutionErrors(context.Context, *ResolveExecutionErrorsRequest) (*ResolveExecutionErrorsResponse, error)
 	ListCanvasEvents(context.Context, *ListCanvasEventsRequest) (*ListCanvasEventsResponse, error)
 	ListCanvasMemories(context.Context, *ListCanvasMemoriesRequest) (*ListCanvasMemoriesResponse, error)
 	DeleteCanvasMemory(context.Context, *DeleteCanvasMemoryRequest) (*DeleteCanvasMemoryResponse, error)
 	ListEventExecutions(context.Context, *ListEventExecutionsRequest) (*ListEventExecutionsResponse, error)
 	SendAiMessage(context.Context, *SendAiMessageRequest) (*SendAiMessageResponse, error)
+	GetCanvasStats(context.Context, *GetCanvasStatsRequest) (*GetCanvasStatsResponse, error)
+	GetCanvasDoraSettings(context.Context, *GetCanvasDoraSettingsRequest) (*GetCanvasDoraSettingsResponse, error)
+	UpdateCanvasDoraSettings(context.Context, *UpdateCanvasDoraSettingsRequest) (*UpdateCanvasDoraSettingsResponse, error)
+	GetCanvasDoraMetrics(context.Context, *GetCanvasDoraMetricsRequest) (*GetCanvasDoraMetricsResponse, error)
+	ExportCanvasDoraMetrics(context.Context, *ExportCanvasDoraMetricsRequest) (*ExportCanvasDoraMetricsResponse, error)
+	WatchCanvas(*WatchCanvasRequest, grpc.ServerStreamingServer[WatchCanvasResponse]) error
+	WatchExecution(*WatchExecutionRequest, grpc.ServerStreamingServer[WatchExecutionResponse]) error
 }
 
 // UnimplementedCanvasesServer should be embedded to have
@@ -366,6 +523,15 @@ func (UnimplementedCanvasesServer) ListChildExecutions(context.Context, *ListChi
 func (UnimplementedCanvasesServer) CancelExecution(context.Context, *CancelExecutionRequest) (*CancelExecutionResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method CancelExecution not implemented")
 }
+func (UnimplementedCanvasesServer) GetExecutionLogs(context.Context, *GetExecutionLogsRequest) (*GetExecutionLogsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetExecutionLogs not implemented")
+}
+func (UnimplementedCanvasesServer) ListExecutionArtifacts(context.Context, *ListExecutionArtifactsRequest) (*ListExecutionArtifactsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListExecutionArtifacts not implemented")
+}
+func (UnimplementedCanvasesServer) DownloadExecutionArtifact(*DownloadExecutionArtifactRequest, grpc.ServerStreamingServer[DownloadExecutionArtifactResponse]) error {
+	return status.Error(codes.Unimplemented, "method DownloadExecutionArtifact not implemented")
+}
 func (UnimplementedCanvasesServer) ResolveExecutionErrors(context.Context, *ResolveExecutionErrorsRequest) (*ResolveExecutionErrorsResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method ResolveExecutionErrors not implemented")
 }
@@ -384,6 +550,27 @@ func (UnimplementedCanvasesServer) ListEventExecutions(context.Context, *ListEve
 func (UnimplementedCanvasesServer) SendAiMessage(context.Context, *SendAiMessageRequest) (*SendAiMessageResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method SendAiMessage not implemented")
 }
+func (UnimplementedCanvasesServer) GetCanvasStats(context.Context, *GetCanvasStatsRequest) (*GetCanvasStatsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetCanvasStats not implemented")
+}
+func (UnimplementedCanvasesServer) GetCanvasDoraSettings(context.Context, *GetCanvasDoraSettingsRequest) (*GetCanvasDoraSettingsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetCanvasDoraSettings not implemented")
+}
+func (UnimplementedCanvasesServer) UpdateCanvasDoraSettings(context.Context, *UpdateCanvasDoraSettingsRequest) (*UpdateCanvasDoraSettingsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateCanvasDoraSettings not implemented")
+}
+func (UnimplementedCanvasesServer) GetCanvasDoraMetrics(context.Context, *GetCanvasDoraMetricsRequest) (*GetCanvasDoraMetricsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetCanvasDoraMetrics not implemented")
+}
+func (UnimplementedCanvasesServer) ExportCanvasDoraMetrics(context.Context, *ExportCanvasDoraMetricsRequest) (*ExportCanvasDoraMetricsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ExportCanvasDoraMetrics not implemented")
+}
+func (UnimplementedCanvasesServer) WatchCanvas(*WatchCanvasRequest, grpc.ServerStreamingServer[WatchCanvasResponse]) error {
+	return status.Error(codes.Unimplemented, "method WatchCanvas not implemented")
+}
+func (UnimplementedCanvasesServer) WatchExecution(*WatchExecutionRequest, grpc.ServerStreamingServer[WatchExecutionResponse]) error {
+	return status.Error(codes.Unimplemented, "method WatchExecution not implemented")
+}
 func (UnimplementedCanvasesServer) testEmbeddedByValue() {}
 
 // UnsafeCanvasesServer may be embedded to opt out of forward compatibility for this service.
@@ -674,6 +861,53 @@ func _Canvases_CancelExecution_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Canvases_GetExecutionLogs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetExecutionLogsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CanvasesServer).GetExecutionLogs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Canvases_GetExecutionLogs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CanvasesServer).GetExecutionLogs(ctx, req.(*GetExecutionLogsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Canvases_ListExecutionArtifacts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListExecutionArtifactsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CanvasesServer).ListExecutionArtifacts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Canvases_ListExecutionArtifacts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CanvasesServer).ListExecutionArtifacts(ctx, req.(*ListExecutionArtifactsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Canvases_DownloadExecutionArtifact_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(DownloadExecutionArtifactRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(CanvasesServer).DownloadExecutionArtifact(m, &grpc.GenericServerStream[DownloadExecutionArtifactRequest, DownloadExecutionArtifactResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Canvases_DownloadExecutionArtifactServer = grpc.ServerStreamingServer[DownloadExecutionArtifactResponse]
+
 func _Canvases_ResolveExecutionErrors_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ResolveExecutionErrorsRequest)
 	if err := dec(in); err != nil {
@@ -782,6 +1016,118 @@ func _Canvases_SendAiMessage_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Canvases_GetCanvasStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCanvasStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CanvasesServer).GetCanvasStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Canvases_GetCanvasStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CanvasesServer).GetCanvasStats(ctx, req.(*GetCanvasStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Canvases_GetCanvasDoraSettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCanvasDoraSettingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CanvasesServer).GetCanvasDoraSettings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Canvases_GetCanvasDoraSettings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CanvasesServer).GetCanvasDoraSettings(ctx, req.(*GetCanvasDoraSettingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Canvases_UpdateCanvasDoraSettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateCanvasDoraSettingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CanvasesServer).UpdateCanvasDoraSettings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Canvases_UpdateCanvasDoraSettings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CanvasesServer).UpdateCanvasDoraSettings(ctx, req.(*UpdateCanvasDoraSettingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Canvases_GetCanvasDoraMetrics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCanvasDoraMetricsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CanvasesServer).GetCanvasDoraMetrics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Canvases_GetCanvasDoraMetrics_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CanvasesServer).GetCanvasDoraMetrics(ctx, req.(*GetCanvasDoraMetricsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Canvases_ExportCanvasDoraMetrics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportCanvasDoraMetricsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CanvasesServer).ExportCanvasDoraMetrics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Canvases_ExportCanvasDoraMetrics_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CanvasesServer).ExportCanvasDoraMetrics(ctx, req.(*ExportCanvasDoraMetricsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Canvases_WatchCanvas_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchCanvasRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(CanvasesServer).WatchCanvas(m, &grpc.GenericServerStream[WatchCanvasRequest, WatchCanvasResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Canvases_WatchCanvasServer = grpc.ServerStreamingServer[WatchCanvasResponse]
+
+func _Canvases_WatchExecution_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchExecutionRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(CanvasesServer).WatchExecution(m, &grpc.GenericServerStream[WatchExecutionRequest, WatchExecutionResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Canvases_WatchExecutionServer = grpc.ServerStreamingServer[WatchExecutionResponse]
+
 // Canvases_ServiceDesc is the grpc.ServiceDesc for Canvases service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -849,6 +1195,14 @@ var Canvases_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CancelExecution",
 			Handler:    _Canvases_CancelExecution_Handler,
 		},
+		{
+			MethodName: "GetExecutionLogs",
+			Handler:    _Canvases_GetExecutionLogs_Handler,
+		},
+		{
+			MethodName: "ListExecutionArtifacts",
+			Handler:    _Canvases_ListExecutionArtifacts_Handler,
+		},
 		{
 			MethodName: "ResolveExecutionErrors",
 			Handler:    _Canvases_ResolveExecutionErrors_Handler,
@@ -873,7 +1227,43 @@ var Canvases_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SendAiMessage",
 			Handler:    _Canvases_SendAiMessage_Handler,
 		},
+		{
+			MethodName: "GetCanvasStats",
+			Handler:    _Canvases_GetCanvasStats_Handler,
+		},
+		{
+			MethodName: "GetCanvasDoraSettings",
+			Handler:    _Canvases_GetCanvasDoraSettings_Handler,
+		},
+		{
+			MethodName: "UpdateCanvasDoraSettings",
+			Handler:    _Canvases_UpdateCanvasDoraSettings_Handler,
+		},
+		{
+			MethodName: "GetCanvasDoraMetrics",
+			Handler:    _Canvases_GetCanvasDoraMetrics_Handler,
+		},
+		{
+			MethodName: "ExportCanvasDoraMetrics",
+			Handler:    _Canvases_ExportCanvasDoraMetrics_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "DownloadExecutionArtifact",
+			Handler:       _Canvases_DownloadExecutionArtifact_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "WatchCanvas",
+			Handler:       _Canvases_WatchCanvas_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "WatchExecution",
+			Handler:       _Canvases_WatchExecution_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "canvases.proto",
 }
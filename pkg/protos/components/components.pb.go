@@ -555,24 +555,25 @@ func (x *ListComponentActionsResponse) GetActions() []*ComponentAction {
 }
 
 type Node struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name           string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Type           Node_Type              `protobuf:"varint,3,opt,name=type,proto3,enum=Superplane.Components.Node_Type" json:"type,omitempty"`
-	Configuration  *_struct.Struct        `protobuf:"bytes,4,opt,name=configuration,proto3" json:"configuration,omitempty"`
-	Metadata       *_struct.Struct        `protobuf:"bytes,5,opt,name=metadata,proto3" json:"metadata,omitempty"`
-	Position       *Position              `protobuf:"bytes,6,opt,name=position,proto3" json:"position,omitempty"`
-	Component      *Node_ComponentRef     `protobuf:"bytes,7,opt,name=component,proto3" json:"component,omitempty"`
-	Blueprint      *Node_BlueprintRef     `protobuf:"bytes,8,opt,name=blueprint,proto3" json:"blueprint,omitempty"`
-	Trigger        *Node_TriggerRef       `protobuf:"bytes,9,opt,name=trigger,proto3" json:"trigger,omitempty"`
-	Widget         *Node_WidgetRef        `protobuf:"bytes,10,opt,name=widget,proto3" json:"widget,omitempty"`
-	IsCollapsed    bool                   `protobuf:"varint,11,opt,name=is_collapsed,json=isCollapsed,proto3" json:"is_collapsed,omitempty"`
-	Integration    *IntegrationRef        `protobuf:"bytes,12,opt,name=integration,proto3" json:"integration,omitempty"`
-	ErrorMessage   string                 `protobuf:"bytes,13,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
-	WarningMessage string                 `protobuf:"bytes,14,opt,name=warning_message,json=warningMessage,proto3" json:"warning_message,omitempty"`
-	Paused         bool                   `protobuf:"varint,15,opt,name=paused,proto3" json:"paused,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Id                  string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name                string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Type                Node_Type              `protobuf:"varint,3,opt,name=type,proto3,enum=Superplane.Components.Node_Type" json:"type,omitempty"`
+	Configuration       *_struct.Struct        `protobuf:"bytes,4,opt,name=configuration,proto3" json:"configuration,omitempty"`
+	Metadata            *_struct.Struct        `protobuf:"bytes,5,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	Position            *Position              `protobuf:"bytes,6,opt,name=position,proto3" json:"position,omitempty"`
+	Component           *Node_ComponentRef     `protobuf:"bytes,7,opt,name=component,proto3" json:"component,omitempty"`
+	Blueprint           *Node_BlueprintRef     `protobuf:"bytes,8,opt,name=blueprint,proto3" json:"blueprint,omitempty"`
+	Trigger             *Node_TriggerRef       `protobuf:"bytes,9,opt,name=trigger,proto3" json:"trigger,omitempty"`
+	Widget              *Node_WidgetRef        `protobuf:"bytes,10,opt,name=widget,proto3" json:"widget,omitempty"`
+	IsCollapsed         bool                   `protobuf:"varint,11,opt,name=is_collapsed,json=isCollapsed,proto3" json:"is_collapsed,omitempty"`
+	Integration         *IntegrationRef        `protobuf:"bytes,12,opt,name=integration,proto3" json:"integration,omitempty"`
+	ErrorMessage        string                 `protobuf:"bytes,13,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	WarningMessage      string                 `protobuf:"bytes,14,opt,name=warning_message,json=warningMessage,proto3" json:"warning_message,omitempty"`
+	Paused              bool                   `protobuf:"varint,15,opt,name=paused,proto3" json:"paused,omitempty"`
+	ErrorChannelEnabled bool                   `protobuf:"varint,16,opt,name=error_channel_enabled,json=errorChannelEnabled,proto3" json:"error_channel_enabled,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
 }
 
 func (x *Node) Reset() {
@@ -710,6 +711,13 @@ func (x *Node) GetPaused() bool {
 	return false
 }
 
+func (x *Node) GetErrorChannelEnabled() bool {
+	if x != nil {
+		return x.ErrorChannelEnabled
+	}
+	return false
+}
+
 type Position struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	X             int32                  `protobuf:"varint,1,opt,name=x,proto3" json:"x,omitempty"`
@@ -1195,7 +1203,7 @@ const file_components_proto_rawDesc = "" +
 	"parameters\x18\x03 \x03(\v2\x1f.Superplane.Configuration.FieldR\n" +
 	"parameters\"`\n" +
 	"\x1cListComponentActionsResponse\x12@\n" +
-	"\aactions\x18\x01 \x03(\v2&.Superplane.Components.ComponentActionR\aactions\"\xce\a\n" +
+	"\aactions\x18\x01 \x03(\v2&.Superplane.Components.ComponentActionR\aactions\"\x82\b\n" +
 	"\x04Node\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x124\n" +
@@ -1212,7 +1220,8 @@ const file_components_proto_rawDesc = "" +
 	"\vintegration\x18\f \x01(\v2%.Superplane.Components.IntegrationRefR\vintegration\x12#\n" +
 	"\rerror_message\x18\r \x01(\tR\ferrorMessage\x12'\n" +
 	"\x0fwarning_message\x18\x0e \x01(\tR\x0ewarningMessage\x12\x16\n" +
-	"\x06paused\x18\x0f \x01(\bR\x06paused\x1a\"\n" +
+	"\x06paused\x18\x0f \x01(\bR\x06paused\x122\n" +
+	"\x15error_channel_enabled\x18\x10 \x01(\bR\x13errorChannelEnabled\x1a\"\n" +
 	"\fComponentRef\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x1a \n" +
 	"\n" +
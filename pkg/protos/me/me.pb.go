@@ -25,6 +25,61 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type NotificationChannelType int32
+
+const (
+	NotificationChannelType_NOTIFICATION_CHANNEL_TYPE_UNSPECIFIED NotificationChannelType = 0
+	NotificationChannelType_NOTIFICATION_CHANNEL_TYPE_EMAIL       NotificationChannelType = 1
+	NotificationChannelType_NOTIFICATION_CHANNEL_TYPE_SLACK       NotificationChannelType = 2
+	NotificationChannelType_NOTIFICATION_CHANNEL_TYPE_TELEGRAM    NotificationChannelType = 3
+	NotificationChannelType_NOTIFICATION_CHANNEL_TYPE_WEBHOOK     NotificationChannelType = 4
+)
+
+// Enum value maps for NotificationChannelType.
+var (
+	NotificationChannelType_name = map[int32]string{
+		0: "NOTIFICATION_CHANNEL_TYPE_UNSPECIFIED",
+		1: "NOTIFICATION_CHANNEL_TYPE_EMAIL",
+		2: "NOTIFICATION_CHANNEL_TYPE_SLACK",
+		3: "NOTIFICATION_CHANNEL_TYPE_TELEGRAM",
+		4: "NOTIFICATION_CHANNEL_TYPE_WEBHOOK",
+	}
+	NotificationChannelType_value = map[string]int32{
+		"NOTIFICATION_CHANNEL_TYPE_UNSPECIFIED": 0,
+		"NOTIFICATION_CHANNEL_TYPE_EMAIL":       1,
+		"NOTIFICATION_CHANNEL_TYPE_SLACK":       2,
+		"NOTIFICATION_CHANNEL_TYPE_TELEGRAM":    3,
+		"NOTIFICATION_CHANNEL_TYPE_WEBHOOK":     4,
+	}
+)
+
+func (x NotificationChannelType) Enum() *NotificationChannelType {
+	p := new(NotificationChannelType)
+	*p = x
+	return p
+}
+
+func (x NotificationChannelType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (NotificationChannelType) Descriptor() protoreflect.EnumDescriptor {
+	return file_me_proto_enumTypes[0].Descriptor()
+}
+
+func (NotificationChannelType) Type() protoreflect.EnumType {
+	return &file_me_proto_enumTypes[0]
+}
+
+func (x NotificationChannelType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use NotificationChannelType.Descriptor instead.
+func (NotificationChannelType) EnumDescriptor() ([]byte, []int) {
+	return file_me_proto_rawDescGZIP(), []int{0}
+}
+
 type User struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
 	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -145,6 +200,314 @@ func (x *RegenerateTokenResponse) GetToken() string {
 	return ""
 }
 
+// The target is the Slack member ID for Slack, the chat ID for Telegram,
+// and the URL for webhooks. Emails go to the email of the user.
+type NotificationChannel struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Type          NotificationChannelType `protobuf:"varint,1,opt,name=type,proto3,enum=Superplane.Me.NotificationChannelType" json:"type,omitempty"`
+	Target        string                  `protobuf:"bytes,2,opt,name=target,proto3" json:"target,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NotificationChannel) Reset() {
+	*x = NotificationChannel{}
+	mi := &file_me_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NotificationChannel) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NotificationChannel) ProtoMessage() {}
+
+func (x *NotificationChannel) ProtoReflect() protoreflect.Message {
+	mi := &file_me_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NotificationChannel.ProtoReflect.Descriptor instead.
+func (*NotificationChannel) Descriptor() ([]byte, []int) {
+	return file_me_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *NotificationChannel) GetType() NotificationChannelType {
+	if x != nil {
+		return x.Type
+	}
+	return NotificationChannelType_NOTIFICATION_CHANNEL_TYPE_UNSPECIFIED
+}
+
+func (x *NotificationChannel) GetTarget() string {
+	if x != nil {
+		return x.Target
+	}
+	return ""
+}
+
+// Quiet hours are given as HH:MM, in the timezone of the preferences.
+// Notifications sent during quiet hours are delivered when they end.
+type QuietHours struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Start         string                 `protobuf:"bytes,1,opt,name=start,proto3" json:"start,omitempty"`
+	End           string                 `protobuf:"bytes,2,opt,name=end,proto3" json:"end,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QuietHours) Reset() {
+	*x = QuietHours{}
+	mi := &file_me_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QuietHours) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuietHours) ProtoMessage() {}
+
+func (x *QuietHours) ProtoReflect() protoreflect.Message {
+	mi := &file_me_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuietHours.ProtoReflect.Descriptor instead.
+func (*QuietHours) Descriptor() ([]byte, []int) {
+	return file_me_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *QuietHours) GetStart() string {
+	if x != nil {
+		return x.Start
+	}
+	return ""
+}
+
+func (x *QuietHours) GetEnd() string {
+	if x != nil {
+		return x.End
+	}
+	return ""
+}
+
+type NotificationPreferences struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	Channels              []*NotificationChannel `protobuf:"bytes,1,rep,name=channels,proto3" json:"channels,omitempty"`
+	QuietHours            *QuietHours            `protobuf:"bytes,2,opt,name=quiet_hours,json=quietHours,proto3" json:"quiet_hours,omitempty"`
+	Timezone              string                 `protobuf:"bytes,3,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	DigestIntervalMinutes int32                  `protobuf:"varint,4,opt,name=digest_interval_minutes,json=digestIntervalMinutes,proto3" json:"digest_interval_minutes,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *NotificationPreferences) Reset() {
+	*x = NotificationPreferences{}
+	mi := &file_me_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NotificationPreferences) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NotificationPreferences) ProtoMessage() {}
+
+func (x *NotificationPreferences) ProtoReflect() protoreflect.Message {
+	mi := &file_me_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NotificationPreferences.ProtoReflect.Descriptor instead.
+func (*NotificationPreferences) Descriptor() ([]byte, []int) {
+	return file_me_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *NotificationPreferences) GetChannels() []*NotificationChannel {
+	if x != nil {
+		return x.Channels
+	}
+	return nil
+}
+
+func (x *NotificationPreferences) GetQuietHours() *QuietHours {
+	if x != nil {
+		return x.QuietHours
+	}
+	return nil
+}
+
+func (x *NotificationPreferences) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *NotificationPreferences) GetDigestIntervalMinutes() int32 {
+	if x != nil {
+		return x.DigestIntervalMinutes
+	}
+	return 0
+}
+
+type GetNotificationPreferencesResponse struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Preferences   *NotificationPreferences `protobuf:"bytes,1,opt,name=preferences,proto3" json:"preferences,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetNotificationPreferencesResponse) Reset() {
+	*x = GetNotificationPreferencesResponse{}
+	mi := &file_me_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetNotificationPreferencesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNotificationPreferencesResponse) ProtoMessage() {}
+
+func (x *GetNotificationPreferencesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_me_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNotificationPreferencesResponse.ProtoReflect.Descriptor instead.
+func (*GetNotificationPreferencesResponse) Descriptor() ([]byte, []int) {
+	return file_me_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetNotificationPreferencesResponse) GetPreferences() *NotificationPreferences {
+	if x != nil {
+		return x.Preferences
+	}
+	return nil
+}
+
+type UpdateNotificationPreferencesRequest struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Preferences   *NotificationPreferences `protobuf:"bytes,1,opt,name=preferences,proto3" json:"preferences,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateNotificationPreferencesRequest) Reset() {
+	*x = UpdateNotificationPreferencesRequest{}
+	mi := &file_me_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateNotificationPreferencesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateNotificationPreferencesRequest) ProtoMessage() {}
+
+func (x *UpdateNotificationPreferencesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_me_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateNotificationPreferencesRequest.ProtoReflect.Descriptor instead.
+func (*UpdateNotificationPreferencesRequest) Descriptor() ([]byte, []int) {
+	return file_me_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *UpdateNotificationPreferencesRequest) GetPreferences() *NotificationPreferences {
+	if x != nil {
+		return x.Preferences
+	}
+	return nil
+}
+
+type UpdateNotificationPreferencesResponse struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Preferences   *NotificationPreferences `protobuf:"bytes,1,opt,name=preferences,proto3" json:"preferences,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateNotificationPreferencesResponse) Reset() {
+	*x = UpdateNotificationPreferencesResponse{}
+	mi := &file_me_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateNotificationPreferencesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateNotificationPreferencesResponse) ProtoMessage() {}
+
+func (x *UpdateNotificationPreferencesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_me_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateNotificationPreferencesResponse.ProtoReflect.Descriptor instead.
+func (*UpdateNotificationPreferencesResponse) Descriptor() ([]byte, []int) {
+	return file_me_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *UpdateNotificationPreferencesResponse) GetPreferences() *NotificationPreferences {
+	if x != nil {
+		return x.Preferences
+	}
+	return nil
+}
+
 var File_me_proto protoreflect.FileDescriptor
 
 const file_me_proto_rawDesc = "" +
@@ -158,13 +521,42 @@ const file_me_proto_rawDesc = "" +
 	"created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12\x1b\n" +
 	"\thas_token\x18\x05 \x01(\bR\bhasToken\"/\n" +
 	"\x17RegenerateTokenResponse\x12\x14\n" +
-	"\x05token\x18\x01 \x01(\tR\x05token2\xd2\x02\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\"i\n" +
+	"\x13NotificationChannel\x12:\n" +
+	"\x04type\x18\x01 \x01(\x0e2&.Superplane.Me.NotificationChannelTypeR\x04type\x12\x16\n" +
+	"\x06target\x18\x02 \x01(\tR\x06target\"4\n" +
+	"\n" +
+	"QuietHours\x12\x14\n" +
+	"\x05start\x18\x01 \x01(\tR\x05start\x12\x10\n" +
+	"\x03end\x18\x02 \x01(\tR\x03end\"\xe9\x01\n" +
+	"\x17NotificationPreferences\x12>\n" +
+	"\bchannels\x18\x01 \x03(\v2\".Superplane.Me.NotificationChannelR\bchannels\x12:\n" +
+	"\vquiet_hours\x18\x02 \x01(\v2\x19.Superplane.Me.QuietHoursR\n" +
+	"quietHours\x12\x1a\n" +
+	"\btimezone\x18\x03 \x01(\tR\btimezone\x126\n" +
+	"\x17digest_interval_minutes\x18\x04 \x01(\x05R\x15digestIntervalMinutes\"n\n" +
+	"\"GetNotificationPreferencesResponse\x12H\n" +
+	"\vpreferences\x18\x01 \x01(\v2&.Superplane.Me.NotificationPreferencesR\vpreferences\"p\n" +
+	"$UpdateNotificationPreferencesRequest\x12H\n" +
+	"\vpreferences\x18\x01 \x01(\v2&.Superplane.Me.NotificationPreferencesR\vpreferences\"q\n" +
+	"%UpdateNotificationPreferencesResponse\x12H\n" +
+	"\vpreferences\x18\x01 \x01(\v2&.Superplane.Me.NotificationPreferencesR\vpreferences*\xdd\x01\n" +
+	"\x17NotificationChannelType\x12)\n" +
+	"%NOTIFICATION_CHANNEL_TYPE_UNSPECIFIED\x10\x00\x12#\n" +
+	"\x1fNOTIFICATION_CHANNEL_TYPE_EMAIL\x10\x01\x12#\n" +
+	"\x1fNOTIFICATION_CHANNEL_TYPE_SLACK\x10\x02\x12&\n" +
+	"\"NOTIFICATION_CHANNEL_TYPE_TELEGRAM\x10\x03\x12%\n" +
+	"!NOTIFICATION_CHANNEL_TYPE_WEBHOOK\x10\x042\xc8\a\n" +
 	"\x02Me\x12\x88\x01\n" +
 	"\x02Me\x12\x16.google.protobuf.Empty\x1a\x13.Superplane.Me.User\"U\x92A@\n" +
 	"\x02Me\x12\x10Get current user\x1a(Returns the currently authenticated user\x82\xd3\xe4\x93\x02\f\x12\n" +
 	"/api/v1/me\x12\xc0\x01\n" +
 	"\x0fRegenerateToken\x12\x16.google.protobuf.Empty\x1a&.Superplane.Me.RegenerateTokenResponse\"m\x92AR\n" +
-	"\x02Me\x12\x14Regenerate API token\x1a6Regenerates the currently authencated user's API token\x82\xd3\xe4\x93\x02\x12\"\x10/api/v1/me/tokenB\x9e\x01\x92Ai\x12?\n" +
+	"\x02Me\x12\x14Regenerate API token\x1a6Regenerates the currently authencated user's API token\x82\xd3\xe4\x93\x02\x12\"\x10/api/v1/me/token\x12\xa3\x02\n" +
+	"\x1aGetNotificationPreferences\x12\x16.google.protobuf.Empty\x1a1.Superplane.Me.GetNotificationPreferencesResponse\"\xb9\x01\x92A\x8a\x01\n" +
+	"\x02Me\x12\x1cGet notification preferences\x1afReturns the notification channels, quiet hours and digest interval of the currently authenticated user\x82\xd3\xe4\x93\x02%\x12#/api/v1/me/notification-preferences\x12\xcd\x02\n" +
+	"\x1dUpdateNotificationPreferences\x123.Superplane.Me.UpdateNotificationPreferencesRequest\x1a4.Superplane.Me.UpdateNotificationPreferencesResponse\"\xc0\x01\x92A\x8e\x01\n" +
+	"\x02Me\x12\x1fUpdate notification preferences\x1agReplaces the notification channels, quiet hours and digest interval of the currently authenticated user\x82\xd3\xe4\x93\x02(:\x01*\x1a#/api/v1/me/notification-preferencesB\x9e\x01\x92Ai\x12?\n" +
 	"\x11Superplane Me API\"%\n" +
 	"\vAPI Support\x1a\x16support@superplane.com2\x031.0*\x02\x01\x022\x10application/json:\x10application/jsonZ0github.com/superplanehq/superplane/pkg/protos/meb\x06proto3"
 
@@ -180,24 +572,42 @@ func file_me_proto_rawDescGZIP() []byte {
 	return file_me_proto_rawDescData
 }
 
-var file_me_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_me_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_me_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
 var file_me_proto_goTypes = []any{
-	(*User)(nil),                    // 0: Superplane.Me.User
-	(*RegenerateTokenResponse)(nil), // 1: Superplane.Me.RegenerateTokenResponse
-	(*timestamp.Timestamp)(nil),     // 2: google.protobuf.Timestamp
-	(*empty.Empty)(nil),             // 3: google.protobuf.Empty
+	(NotificationChannelType)(0),                  // 0: Superplane.Me.NotificationChannelType
+	(*User)(nil),                                  // 1: Superplane.Me.User
+	(*RegenerateTokenResponse)(nil),               // 2: Superplane.Me.RegenerateTokenResponse
+	(*NotificationChannel)(nil),                   // 3: Superplane.Me.NotificationChannel
+	(*QuietHours)(nil),                            // 4: Superplane.Me.QuietHours
+	(*NotificationPreferences)(nil),               // 5: Superplane.Me.NotificationPreferences
+	(*GetNotificationPreferencesResponse)(nil),    // 6: Superplane.Me.GetNotificationPreferencesResponse
+	(*UpdateNotificationPreferencesRequest)(nil),  // 7: Superplane.Me.UpdateNotificationPreferencesRequest
+	(*UpdateNotificationPreferencesResponse)(nil), // 8: Superplane.Me.UpdateNotificationPreferencesResponse
+	(*timestamp.Timestamp)(nil),                   // 9: google.protobuf.Timestamp
+	(*empty.Empty)(nil),                           // 10: google.protobuf.Empty
 }
 var file_me_proto_depIdxs = []int32{
-	2, // 0: Superplane.Me.User.created_at:type_name -> google.protobuf.Timestamp
-	3, // 1: Superplane.Me.Me.Me:input_type -> google.protobuf.Empty
-	3, // 2: Superplane.Me.Me.RegenerateToken:input_type -> google.protobuf.Empty
-	0, // 3: Superplane.Me.Me.Me:output_type -> Superplane.Me.User
-	1, // 4: Superplane.Me.Me.RegenerateToken:output_type -> Superplane.Me.RegenerateTokenResponse
-	3, // [3:5] is the sub-list for method output_type
-	1, // [1:3] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	9,  // 0: Superplane.Me.User.created_at:type_name -> google.protobuf.Timestamp
+	0,  // 1: Superplane.Me.NotificationChannel.type:type_name -> Superplane.Me.NotificationChannelType
+	3,  // 2: Superplane.Me.NotificationPreferences.channels:type_name -> Superplane.Me.NotificationChannel
+	4,  // 3: Superplane.Me.NotificationPreferences.quiet_hours:type_name -> Superplane.Me.QuietHours
+	5,  // 4: Superplane.Me.GetNotificationPreferencesResponse.preferences:type_name -> Superplane.Me.NotificationPreferences
+	5,  // 5: Superplane.Me.UpdateNotificationPreferencesRequest.preferences:type_name -> Superplane.Me.NotificationPreferences
+	5,  // 6: Superplane.Me.UpdateNotificationPreferencesResponse.preferences:type_name -> Superplane.Me.NotificationPreferences
+	10, // 7: Superplane.Me.Me.Me:input_type -> google.protobuf.Empty
+	10, // 8: Superplane.Me.Me.RegenerateToken:input_type -> google.protobuf.Empty
+	10, // 9: Superplane.Me.Me.GetNotificationPreferences:input_type -> google.protobuf.Empty
+	7,  // 10: Superplane.Me.Me.UpdateNotificationPreferences:input_type -> Superplane.Me.UpdateNotificationPreferencesRequest
+	1,  // 11: Superplane.Me.Me.Me:output_type -> Superplane.Me.User
+	2,  // 12: Superplane.Me.Me.RegenerateToken:output_type -> Superplane.Me.RegenerateTokenResponse
+	6,  // 13: Superplane.Me.Me.GetNotificationPreferences:output_type -> Superplane.Me.GetNotificationPreferencesResponse
+	8,  // 14: Superplane.Me.Me.UpdateNotificationPreferences:output_type -> Superplane.Me.UpdateNotificationPreferencesResponse
+	11, // [11:15] is the sub-list for method output_type
+	7,  // [7:11] is the sub-list for method input_type
+	7,  // [7:7] is the sub-list for extension type_name
+	7,  // [7:7] is the sub-list for extension extendee
+	0,  // [0:7] is the sub-list for field type_name
 }
 
 func init() { file_me_proto_init() }
@@ -210,13 +620,14 @@ func file_me_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_me_proto_rawDesc), len(file_me_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   2,
+			NumEnums:      1,
+			NumMessages:   8,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_me_proto_goTypes,
 		DependencyIndexes: file_me_proto_depIdxs,
+		EnumInfos:         file_me_proto_enumTypes,
 		MessageInfos:      file_me_proto_msgTypes,
 	}.Build()
 	File_me_proto = out.File
@@ -20,8 +20,10 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	Me_Me_FullMethodName              = "/Superplane.Me.Me/Me"
-	Me_RegenerateToken_FullMethodName = "/Superplane.Me.Me/RegenerateToken"
+	Me_Me_FullMethodName                            = "/Superplane.Me.Me/Me"
+	Me_RegenerateToken_FullMethodName               = "/Superplane.Me.Me/RegenerateToken"
+	Me_GetNotificationPreferences_FullMethodName    = "/Superplane.Me.Me/GetNotificationPreferences"
+	Me_UpdateNotificationPreferences_FullMethodName = "/Superplane.Me.Me/UpdateNotificationPreferences"
 )
 
 // MeClient is the client API for Me service.
@@ -32,6 +34,10 @@ type MeClient interface {
 	Me(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*User, error)
 	// Endpoint for regenerating the currently authenticated user's API token.
 	RegenerateToken(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*RegenerateTokenResponse, error)
+	// Endpoint for getting how the currently authenticated user receives notifications.
+	GetNotificationPreferences(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*GetNotificationPreferencesResponse, error)
+	// Endpoint for updating how the currently authenticated user receives notifications.
+	UpdateNotificationPreferences(ctx context.Context, in *UpdateNotificationPreferencesRequest, opts ...grpc.CallOption) (*UpdateNotificationPreferencesResponse, error)
 }
 
 type meClient struct {
@@ -62,6 +68,26 @@ func (c *meClient) RegenerateToken(ctx context.Context, in *empty.Empty, opts ..
 	return out, nil
 }
 
+func (c *meClient) GetNotificationPreferences(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*GetNotificationPreferencesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetNotificationPreferencesResponse)
+	err := c.cc.Invoke(ctx, Me_GetNotificationPreferences_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *meClient) UpdateNotificationPreferences(ctx context.Context, in *UpdateNotificationPreferencesRequest, opts ...grpc.CallOption) (*UpdateNotificationPreferencesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateNotificationPreferencesResponse)
+	err := c.cc.Invoke(ctx, Me_UpdateNotificationPreferences_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MeServer is the server API for Me service.
 // All implementations should embed UnimplementedMeServer
 // for forward compatibility.
@@ -70,6 +96,10 @@ type MeServer interface {
 	Me(context.Context, *empty.Empty) (*User, error)
 	// Endpoint for regenerating the currently authenticated user's API token.
 	RegenerateToken(context.Context, *empty.Empty) (*RegenerateTokenResponse, error)
+	// Endpoint for getting how the currently authenticated user receives notifications.
+	GetNotificationPreferences(context.Context, *empty.Empty) (*GetNotificationPreferencesResponse, error)
+	// Endpoint for updating how the currently authenticated user receives notifications.
+	UpdateNotificationPreferences(context.Context, *UpdateNotificationPreferencesRequest) (*UpdateNotificationPreferencesResponse, error)
 }
 
 // UnimplementedMeServer should be embedded to have
@@ -85,6 +115,12 @@ func (UnimplementedMeServer) Me(context.Context, *empty.Empty) (*User, error) {
 func (UnimplementedMeServer) RegenerateToken(context.Context, *empty.Empty) (*RegenerateTokenResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method RegenerateToken not implemented")
 }
+func (UnimplementedMeServer) GetNotificationPreferences(context.Context, *empty.Empty) (*GetNotificationPreferencesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetNotificationPreferences not implemented")
+}
+func (UnimplementedMeServer) UpdateNotificationPreferences(context.Context, *UpdateNotificationPreferencesRequest) (*UpdateNotificationPreferencesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateNotificationPreferences not implemented")
+}
 func (UnimplementedMeServer) testEmbeddedByValue() {}
 
 // UnsafeMeServer may be embedded to opt out of forward compatibility for this service.
@@ -141,6 +177,42 @@ func _Me_RegenerateToken_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Me_GetNotificationPreferences_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MeServer).GetNotificationPreferences(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Me_GetNotificationPreferences_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MeServer).GetNotificationPreferences(ctx, req.(*empty.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Me_UpdateNotificationPreferences_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateNotificationPreferencesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MeServer).UpdateNotificationPreferences(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Me_UpdateNotificationPreferences_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MeServer).UpdateNotificationPreferences(ctx, req.(*UpdateNotificationPreferencesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Me_ServiceDesc is the grpc.ServiceDesc for Me service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -156,6 +228,14 @@ var Me_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RegenerateToken",
 			Handler:    _Me_RegenerateToken_Handler,
 		},
+		{
+			MethodName: "GetNotificationPreferences",
+			Handler:    _Me_GetNotificationPreferences_Handler,
+		},
+		{
+			MethodName: "UpdateNotificationPreferences",
+			Handler:    _Me_UpdateNotificationPreferences_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "me.proto",
@@ -25,6 +25,125 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type SSOProtocol int32
+
+const (
+	SSOProtocol_SSO_PROTOCOL_UNSPECIFIED SSOProtocol = 0
+	SSOProtocol_SSO_PROTOCOL_SAML        SSOProtocol = 1
+	SSOProtocol_SSO_PROTOCOL_OIDC        SSOProtocol = 2
+)
+
+// Enum value maps for SSOProtocol.
+var (
+	SSOProtocol_name = map[int32]string{
+		0: "SSO_PROTOCOL_UNSPECIFIED",
+		1: "SSO_PROTOCOL_SAML",
+		2: "SSO_PROTOCOL_OIDC",
+	}
+	SSOProtocol_value = map[string]int32{
+		"SSO_PROTOCOL_UNSPECIFIED": 0,
+		"SSO_PROTOCOL_SAML":        1,
+		"SSO_PROTOCOL_OIDC":        2,
+	}
+)
+
+func (x SSOProtocol) Enum() *SSOProtocol {
+	p := new(SSOProtocol)
+	*p = x
+	return p
+}
+
+func (x SSOProtocol) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SSOProtocol) Descriptor() protoreflect.EnumDescriptor {
+	return file_organizations_proto_enumTypes[0].Descriptor()
+}
+
+func (SSOProtocol) Type() protoreflect.EnumType {
+	return &file_organizations_proto_enumTypes[0]
+}
+
+func (x SSOProtocol) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SSOProtocol.Descriptor instead.
+func (SSOProtocol) EnumDescriptor() ([]byte, []int) {
+	return file_organizations_proto_rawDescGZIP(), []int{0}
+}
+
+type TwoFactorEventType int32
+
+const (
+	TwoFactorEventType_TWO_FACTOR_EVENT_TYPE_UNSPECIFIED                TwoFactorEventType = 0
+	TwoFactorEventType_TWO_FACTOR_EVENT_TYPE_ENROLLED                   TwoFactorEventType = 1
+	TwoFactorEventType_TWO_FACTOR_EVENT_TYPE_DISABLED                   TwoFactorEventType = 2
+	TwoFactorEventType_TWO_FACTOR_EVENT_TYPE_RECOVERY_CODES_REGENERATED TwoFactorEventType = 3
+	TwoFactorEventType_TWO_FACTOR_EVENT_TYPE_LOGIN_SUCCEEDED            TwoFactorEventType = 4
+	TwoFactorEventType_TWO_FACTOR_EVENT_TYPE_VERIFICATION_FAILED        TwoFactorEventType = 5
+	TwoFactorEventType_TWO_FACTOR_EVENT_TYPE_RECOVERY_CODE_USED         TwoFactorEventType = 6
+	TwoFactorEventType_TWO_FACTOR_EVENT_TYPE_RESET                      TwoFactorEventType = 7
+	TwoFactorEventType_TWO_FACTOR_EVENT_TYPE_POLICY_ENABLED             TwoFactorEventType = 8
+	TwoFactorEventType_TWO_FACTOR_EVENT_TYPE_POLICY_DISABLED            TwoFactorEventType = 9
+)
+
+// Enum value maps for TwoFactorEventType.
+var (
+	TwoFactorEventType_name = map[int32]string{
+		0: "TWO_FACTOR_EVENT_TYPE_UNSPECIFIED",
+		1: "TWO_FACTOR_EVENT_TYPE_ENROLLED",
+		2: "TWO_FACTOR_EVENT_TYPE_DISABLED",
+		3: "TWO_FACTOR_EVENT_TYPE_RECOVERY_CODES_REGENERATED",
+		4: "TWO_FACTOR_EVENT_TYPE_LOGIN_SUCCEEDED",
+		5: "TWO_FACTOR_EVENT_TYPE_VERIFICATION_FAILED",
+		6: "TWO_FACTOR_EVENT_TYPE_RECOVERY_CODE_USED",
+		7: "TWO_FACTOR_EVENT_TYPE_RESET",
+		8: "TWO_FACTOR_EVENT_TYPE_POLICY_ENABLED",
+		9: "TWO_FACTOR_EVENT_TYPE_POLICY_DISABLED",
+	}
+	TwoFactorEventType_value = map[string]int32{
+		"TWO_FACTOR_EVENT_TYPE_UNSPECIFIED":                0,
+		"TWO_FACTOR_EVENT_TYPE_ENROLLED":                   1,
+		"TWO_FACTOR_EVENT_TYPE_DISABLED":                   2,
+		"TWO_FACTOR_EVENT_TYPE_RECOVERY_CODES_REGENERATED": 3,
+		"TWO_FACTOR_EVENT_TYPE_LOGIN_SUCCEEDED":            4,
+		"TWO_FACTOR_EVENT_TYPE_VERIFICATION_FAILED":        5,
+		"TWO_FACTOR_EVENT_TYPE_RECOVERY_CODE_USED":         6,
+		"TWO_FACTOR_EVENT_TYPE_RESET":                      7,
+		"TWO_FACTOR_EVENT_TYPE_POLICY_ENABLED":             8,
+		"TWO_FACTOR_EVENT_TYPE_POLICY_DISABLED":            9,
+	}
+)
+
+func (x TwoFactorEventType) Enum() *TwoFactorEventType {
+	p := new(TwoFactorEventType)
+	*p = x
+	return p
+}
+
+func (x TwoFactorEventType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TwoFactorEventType) Descriptor() protoreflect.EnumDescriptor {
+	return file_organizations_proto_enumTypes[1].Descriptor()
+}
+
+func (TwoFactorEventType) Type() protoreflect.EnumType {
+	return &file_organizations_proto_enumTypes[1]
+}
+
+func (x TwoFactorEventType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TwoFactorEventType.Descriptor instead.
+func (TwoFactorEventType) EnumDescriptor() ([]byte, []int) {
+	return file_organizations_proto_rawDescGZIP(), []int{1}
+}
+
 type Organization struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Metadata      *Organization_Metadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
@@ -547,69 +666,1644 @@ func (x *AgentOpenAIKey) GetLast4() string {
 	if x != nil {
 		return x.Last4
 	}
-	return ""
+	return ""
+}
+
+func (x *AgentOpenAIKey) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *AgentOpenAIKey) GetValidationError() string {
+	if x != nil {
+		return x.ValidationError
+	}
+	return ""
+}
+
+func (x *AgentOpenAIKey) GetValidatedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.ValidatedAt
+	}
+	return nil
+}
+
+func (x *AgentOpenAIKey) GetUpdatedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *AgentOpenAIKey) GetUpdatedBy() string {
+	if x != nil {
+		return x.UpdatedBy
+	}
+	return ""
+}
+
+type AgentSettings struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId     string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	AgentModeEnabled   bool                   `protobuf:"varint,2,opt,name=agent_mode_enabled,json=agentModeEnabled,proto3" json:"agent_mode_enabled,omitempty"`
+	AgentModeEffective bool                   `protobuf:"varint,3,opt,name=agent_mode_effective,json=agentModeEffective,proto3" json:"agent_mode_effective,omitempty"`
+	OpenaiKey          *AgentOpenAIKey        `protobuf:"bytes,4,opt,name=openai_key,json=openaiKey,proto3" json:"openai_key,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *AgentSettings) Reset() {
+	*x = AgentSettings{}
+	mi := &file_organizations_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AgentSettings) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AgentSettings) ProtoMessage() {}
+
+func (x *AgentSettings) ProtoReflect() protoreflect.Message {
+	mi := &file_organizations_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AgentSettings.ProtoReflect.Descriptor instead.
+func (*AgentSettings) Descriptor() ([]byte, []int) {
+	return file_organizations_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *AgentSettings) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *AgentSettings) GetAgentModeEnabled() bool {
+	if x != nil {
+		return x.AgentModeEnabled
+	}
+	return false
+}
+
+func (x *AgentSettings) GetAgentModeEffective() bool {
+	if x != nil {
+		return x.AgentModeEffective
+	}
+	return false
+}
+
+func (x *AgentSettings) GetOpenaiKey() *AgentOpenAIKey {
+	if x != nil {
+		return x.OpenaiKey
+	}
+	return nil
+}
+
+type SSOGroupMapping struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	IdpGroup      string                 `protobuf:"bytes,1,opt,name=idp_group,json=idpGroup,proto3" json:"idp_group,omitempty"`
+	Group         string                 `protobuf:"bytes,2,opt,name=group,proto3" json:"group,omitempty"`
+	Role          string                 `protobuf:"bytes,3,opt,name=role,proto3" json:"role,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SSOGroupMapping) Reset() {
+	*x = SSOGroupMapping{}
+	mi := &file_organizations_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SSOGroupMapping) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SSOGroupMapping) ProtoMessage() {}
+
+func (x *SSOGroupMapping) ProtoReflect() protoreflect.Message {
+	mi := &file_organizations_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SSOGroupMapping.ProtoReflect.Descriptor instead.
+func (*SSOGroupMapping) Descriptor() ([]byte, []int) {
+	return file_organizations_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *SSOGroupMapping) GetIdpGroup() string {
+	if x != nil {
+		return x.IdpGroup
+	}
+	return ""
+}
+
+func (x *SSOGroupMapping) GetGroup() string {
+	if x != nil {
+		return x.Group
+	}
+	return ""
+}
+
+func (x *SSOGroupMapping) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+type SSOSAMLSettings struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	IdpMetadataUrl string                 `protobuf:"bytes,1,opt,name=idp_metadata_url,json=idpMetadataUrl,proto3" json:"idp_metadata_url,omitempty"`
+	IdpMetadata    string                 `protobuf:"bytes,2,opt,name=idp_metadata,json=idpMetadata,proto3" json:"idp_metadata,omitempty"`
+	IdpEntityId    string                 `protobuf:"bytes,3,opt,name=idp_entity_id,json=idpEntityId,proto3" json:"idp_entity_id,omitempty"`
+	SpEntityId     string                 `protobuf:"bytes,4,opt,name=sp_entity_id,json=spEntityId,proto3" json:"sp_entity_id,omitempty"`
+	SpAcsUrl       string                 `protobuf:"bytes,5,opt,name=sp_acs_url,json=spAcsUrl,proto3" json:"sp_acs_url,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *SSOSAMLSettings) Reset() {
+	*x = SSOSAMLSettings{}
+	mi := &file_organizations_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SSOSAMLSettings) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SSOSAMLSettings) ProtoMessage() {}
+
+func (x *SSOSAMLSettings) ProtoReflect() protoreflect.Message {
+	mi := &file_organizations_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SSOSAMLSettings.ProtoReflect.Descriptor instead.
+func (*SSOSAMLSettings) Descriptor() ([]byte, []int) {
+	return file_organizations_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *SSOSAMLSettings) GetIdpMetadataUrl() string {
+	if x != nil {
+		return x.IdpMetadataUrl
+	}
+	return ""
+}
+
+func (x *SSOSAMLSettings) GetIdpMetadata() string {
+	if x != nil {
+		return x.IdpMetadata
+	}
+	return ""
+}
+
+func (x *SSOSAMLSettings) GetIdpEntityId() string {
+	if x != nil {
+		return x.IdpEntityId
+	}
+	return ""
+}
+
+func (x *SSOSAMLSettings) GetSpEntityId() string {
+	if x != nil {
+		return x.SpEntityId
+	}
+	return ""
+}
+
+func (x *SSOSAMLSettings) GetSpAcsUrl() string {
+	if x != nil {
+		return x.SpAcsUrl
+	}
+	return ""
+}
+
+type SSOOIDCSettings struct {
+	state                  protoimpl.MessageState `protogen:"open.v1"`
+	IssuerUrl              string                 `protobuf:"bytes,1,opt,name=issuer_url,json=issuerUrl,proto3" json:"issuer_url,omitempty"`
+	ClientId               string                 `protobuf:"bytes,2,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	ClientSecret           string                 `protobuf:"bytes,3,opt,name=client_secret,json=clientSecret,proto3" json:"client_secret,omitempty"`
+	ClientSecretConfigured bool                   `protobuf:"varint,4,opt,name=client_secret_configured,json=clientSecretConfigured,proto3" json:"client_secret_configured,omitempty"`
+	RedirectUrl            string                 `protobuf:"bytes,5,opt,name=redirect_url,json=redirectUrl,proto3" json:"redirect_url,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *SSOOIDCSettings) Reset() {
+	*x = SSOOIDCSettings{}
+	mi := &file_organizations_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SSOOIDCSettings) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SSOOIDCSettings) ProtoMessage() {}
+
+func (x *SSOOIDCSettings) ProtoReflect() protoreflect.Message {
+	mi := &file_organizations_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SSOOIDCSettings.ProtoReflect.Descriptor instead.
+func (*SSOOIDCSettings) Descriptor() ([]byte, []int) {
+	return file_organizations_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *SSOOIDCSettings) GetIssuerUrl() string {
+	if x != nil {
+		return x.IssuerUrl
+	}
+	return ""
+}
+
+func (x *SSOOIDCSettings) GetClientId() string {
+	if x != nil {
+		return x.ClientId
+	}
+	return ""
+}
+
+func (x *SSOOIDCSettings) GetClientSecret() string {
+	if x != nil {
+		return x.ClientSecret
+	}
+	return ""
+}
+
+func (x *SSOOIDCSettings) GetClientSecretConfigured() bool {
+	if x != nil {
+		return x.ClientSecretConfigured
+	}
+	return false
+}
+
+func (x *SSOOIDCSettings) GetRedirectUrl() string {
+	if x != nil {
+		return x.RedirectUrl
+	}
+	return ""
+}
+
+type SSOConfig struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId  string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	Protocol        SSOProtocol            `protobuf:"varint,2,opt,name=protocol,proto3,enum=Superplane.Organizations.SSOProtocol" json:"protocol,omitempty"`
+	Enabled         bool                   `protobuf:"varint,3,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	EnforceSso      bool                   `protobuf:"varint,4,opt,name=enforce_sso,json=enforceSso,proto3" json:"enforce_sso,omitempty"`
+	Saml            *SSOSAMLSettings       `protobuf:"bytes,5,opt,name=saml,proto3" json:"saml,omitempty"`
+	Oidc            *SSOOIDCSettings       `protobuf:"bytes,6,opt,name=oidc,proto3" json:"oidc,omitempty"`
+	EmailAttribute  string                 `protobuf:"bytes,7,opt,name=email_attribute,json=emailAttribute,proto3" json:"email_attribute,omitempty"`
+	NameAttribute   string                 `protobuf:"bytes,8,opt,name=name_attribute,json=nameAttribute,proto3" json:"name_attribute,omitempty"`
+	GroupsAttribute string                 `protobuf:"bytes,9,opt,name=groups_attribute,json=groupsAttribute,proto3" json:"groups_attribute,omitempty"`
+	DefaultRole     string                 `protobuf:"bytes,10,opt,name=default_role,json=defaultRole,proto3" json:"default_role,omitempty"`
+	GroupMappings   []*SSOGroupMapping     `protobuf:"bytes,11,rep,name=group_mappings,json=groupMappings,proto3" json:"group_mappings,omitempty"`
+	LoginUrl        string                 `protobuf:"bytes,12,opt,name=login_url,json=loginUrl,proto3" json:"login_url,omitempty"`
+	UpdatedAt       *timestamp.Timestamp   `protobuf:"bytes,13,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	UpdatedBy       string                 `protobuf:"bytes,14,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *SSOConfig) Reset() {
+	*x = SSOConfig{}
+	mi := &file_organizations_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SSOConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SSOConfig) ProtoMessage() {}
+
+func (x *SSOConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_organizations_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SSOConfig.ProtoReflect.Descriptor instead.
+func (*SSOConfig) Descriptor() ([]byte, []int) {
+	return file_organizations_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *SSOConfig) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *SSOConfig) GetProtocol() SSOProtocol {
+	if x != nil {
+		return x.Protocol
+	}
+	return SSOProtocol_SSO_PROTOCOL_UNSPECIFIED
+}
+
+func (x *SSOConfig) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *SSOConfig) GetEnforceSso() bool {
+	if x != nil {
+		return x.EnforceSso
+	}
+	return false
+}
+
+func (x *SSOConfig) GetSaml() *SSOSAMLSettings {
+	if x != nil {
+		return x.Saml
+	}
+	return nil
+}
+
+func (x *SSOConfig) GetOidc() *SSOOIDCSettings {
+	if x != nil {
+		return x.Oidc
+	}
+	return nil
+}
+
+func (x *SSOConfig) GetEmailAttribute() string {
+	if x != nil {
+		return x.EmailAttribute
+	}
+	return ""
+}
+
+func (x *SSOConfig) GetNameAttribute() string {
+	if x != nil {
+		return x.NameAttribute
+	}
+	return ""
+}
+
+func (x *SSOConfig) GetGroupsAttribute() string {
+	if x != nil {
+		return x.GroupsAttribute
+	}
+	return ""
+}
+
+func (x *SSOConfig) GetDefaultRole() string {
+	if x != nil {
+		return x.DefaultRole
+	}
+	return ""
+}
+
+func (x *SSOConfig) GetGroupMappings() []*SSOGroupMapping {
+	if x != nil {
+		return x.GroupMappings
+	}
+	return nil
+}
+
+func (x *SSOConfig) GetLoginUrl() string {
+	if x != nil {
+		return x.LoginUrl
+	}
+	return ""
+}
+
+func (x *SSOConfig) GetUpdatedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *SSOConfig) GetUpdatedBy() string {
+	if x != nil {
+		return x.UpdatedBy
+	}
+	return ""
+}
+
+type GetSSOConfigRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSSOConfigRequest) Reset() {
+	*x = GetSSOConfigRequest{}
+	mi := &file_organizations_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSSOConfigRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSSOConfigRequest) ProtoMessage() {}
+
+func (x *GetSSOConfigRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_organizations_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSSOConfigRequest.ProtoReflect.Descriptor instead.
+func (*GetSSOConfigRequest) Descriptor() ([]byte, []int) {
+	return file_organizations_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *GetSSOConfigRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetSSOConfigResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SsoConfig     *SSOConfig             `protobuf:"bytes,1,opt,name=sso_config,json=ssoConfig,proto3" json:"sso_config,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSSOConfigResponse) Reset() {
+	*x = GetSSOConfigResponse{}
+	mi := &file_organizations_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSSOConfigResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSSOConfigResponse) ProtoMessage() {}
+
+func (x *GetSSOConfigResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_organizations_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSSOConfigResponse.ProtoReflect.Descriptor instead.
+func (*GetSSOConfigResponse) Descriptor() ([]byte, []int) {
+	return file_organizations_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *GetSSOConfigResponse) GetSsoConfig() *SSOConfig {
+	if x != nil {
+		return x.SsoConfig
+	}
+	return nil
+}
+
+type UpdateSSOConfigRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	SsoConfig     *SSOConfig             `protobuf:"bytes,2,opt,name=sso_config,json=ssoConfig,proto3" json:"sso_config,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateSSOConfigRequest) Reset() {
+	*x = UpdateSSOConfigRequest{}
+	mi := &file_organizations_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateSSOConfigRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateSSOConfigRequest) ProtoMessage() {}
+
+func (x *UpdateSSOConfigRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_organizations_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateSSOConfigRequest.ProtoReflect.Descriptor instead.
+func (*UpdateSSOConfigRequest) Descriptor() ([]byte, []int) {
+	return file_organizations_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *UpdateSSOConfigRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateSSOConfigRequest) GetSsoConfig() *SSOConfig {
+	if x != nil {
+		return x.SsoConfig
+	}
+	return nil
+}
+
+type UpdateSSOConfigResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SsoConfig     *SSOConfig             `protobuf:"bytes,1,opt,name=sso_config,json=ssoConfig,proto3" json:"sso_config,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateSSOConfigResponse) Reset() {
+	*x = UpdateSSOConfigResponse{}
+	mi := &file_organizations_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateSSOConfigResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateSSOConfigResponse) ProtoMessage() {}
+
+func (x *UpdateSSOConfigResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_organizations_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateSSOConfigResponse.ProtoReflect.Descriptor instead.
+func (*UpdateSSOConfigResponse) Descriptor() ([]byte, []int) {
+	return file_organizations_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *UpdateSSOConfigResponse) GetSsoConfig() *SSOConfig {
+	if x != nil {
+		return x.SsoConfig
+	}
+	return nil
+}
+
+type DeleteSSOConfigRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteSSOConfigRequest) Reset() {
+	*x = DeleteSSOConfigRequest{}
+	mi := &file_organizations_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteSSOConfigRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteSSOConfigRequest) ProtoMessage() {}
+
+func (x *DeleteSSOConfigRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_organizations_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteSSOConfigRequest.ProtoReflect.Descriptor instead.
+func (*DeleteSSOConfigRequest) Descriptor() ([]byte, []int) {
+	return file_organizations_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *DeleteSSOConfigRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DeleteSSOConfigResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteSSOConfigResponse) Reset() {
+	*x = DeleteSSOConfigResponse{}
+	mi := &file_organizations_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteSSOConfigResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteSSOConfigResponse) ProtoMessage() {}
+
+func (x *DeleteSSOConfigResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_organizations_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteSSOConfigResponse.ProtoReflect.Descriptor instead.
+func (*DeleteSSOConfigResponse) Descriptor() ([]byte, []int) {
+	return file_organizations_proto_rawDescGZIP(), []int{20}
+}
+
+type SCIMConfig struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId  string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	BaseUrl         string                 `protobuf:"bytes,2,opt,name=base_url,json=baseUrl,proto3" json:"base_url,omitempty"`
+	TokenConfigured bool                   `protobuf:"varint,3,opt,name=token_configured,json=tokenConfigured,proto3" json:"token_configured,omitempty"`
+	TokenCreatedAt  *timestamp.Timestamp   `protobuf:"bytes,4,opt,name=token_created_at,json=tokenCreatedAt,proto3" json:"token_created_at,omitempty"`
+	TokenLastUsedAt *timestamp.Timestamp   `protobuf:"bytes,5,opt,name=token_last_used_at,json=tokenLastUsedAt,proto3" json:"token_last_used_at,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *SCIMConfig) Reset() {
+	*x = SCIMConfig{}
+	mi := &file_organizations_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SCIMConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SCIMConfig) ProtoMessage() {}
+
+func (x *SCIMConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_organizations_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SCIMConfig.ProtoReflect.Descriptor instead.
+func (*SCIMConfig) Descriptor() ([]byte, []int) {
+	return file_organizations_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *SCIMConfig) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *SCIMConfig) GetBaseUrl() string {
+	if x != nil {
+		return x.BaseUrl
+	}
+	return ""
+}
+
+func (x *SCIMConfig) GetTokenConfigured() bool {
+	if x != nil {
+		return x.TokenConfigured
+	}
+	return false
+}
+
+func (x *SCIMConfig) GetTokenCreatedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.TokenCreatedAt
+	}
+	return nil
+}
+
+func (x *SCIMConfig) GetTokenLastUsedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.TokenLastUsedAt
+	}
+	return nil
+}
+
+type GetSCIMConfigRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSCIMConfigRequest) Reset() {
+	*x = GetSCIMConfigRequest{}
+	mi := &file_organizations_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSCIMConfigRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSCIMConfigRequest) ProtoMessage() {}
+
+func (x *GetSCIMConfigRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_organizations_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSCIMConfigRequest.ProtoReflect.Descriptor instead.
+func (*GetSCIMConfigRequest) Descriptor() ([]byte, []int) {
+	return file_organizations_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *GetSCIMConfigRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetSCIMConfigResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ScimConfig    *SCIMConfig            `protobuf:"bytes,1,opt,name=scim_config,json=scimConfig,proto3" json:"scim_config,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSCIMConfigResponse) Reset() {
+	*x = GetSCIMConfigResponse{}
+	mi := &file_organizations_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSCIMConfigResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSCIMConfigResponse) ProtoMessage() {}
+
+func (x *GetSCIMConfigResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_organizations_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSCIMConfigResponse.ProtoReflect.Descriptor instead.
+func (*GetSCIMConfigResponse) Descriptor() ([]byte, []int) {
+	return file_organizations_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *GetSCIMConfigResponse) GetScimConfig() *SCIMConfig {
+	if x != nil {
+		return x.ScimConfig
+	}
+	return nil
+}
+
+type ResetSCIMTokenRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResetSCIMTokenRequest) Reset() {
+	*x = ResetSCIMTokenRequest{}
+	mi := &file_organizations_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResetSCIMTokenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResetSCIMTokenRequest) ProtoMessage() {}
+
+func (x *ResetSCIMTokenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_organizations_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResetSCIMTokenRequest.ProtoReflect.Descriptor instead.
+func (*ResetSCIMTokenRequest) Descriptor() ([]byte, []int) {
+	return file_organizations_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ResetSCIMTokenRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ResetSCIMTokenResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	ScimConfig    *SCIMConfig            `protobuf:"bytes,2,opt,name=scim_config,json=scimConfig,proto3" json:"scim_config,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResetSCIMTokenResponse) Reset() {
+	*x = ResetSCIMTokenResponse{}
+	mi := &file_organizations_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResetSCIMTokenResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResetSCIMTokenResponse) ProtoMessage() {}
+
+func (x *ResetSCIMTokenResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_organizations_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResetSCIMTokenResponse.ProtoReflect.Descriptor instead.
+func (*ResetSCIMTokenResponse) Descriptor() ([]byte, []int) {
+	return file_organizations_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ResetSCIMTokenResponse) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *ResetSCIMTokenResponse) GetScimConfig() *SCIMConfig {
+	if x != nil {
+		return x.ScimConfig
+	}
+	return nil
+}
+
+type DeleteSCIMTokenRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteSCIMTokenRequest) Reset() {
+	*x = DeleteSCIMTokenRequest{}
+	mi := &file_organizations_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteSCIMTokenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteSCIMTokenRequest) ProtoMessage() {}
+
+func (x *DeleteSCIMTokenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_organizations_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteSCIMTokenRequest.ProtoReflect.Descriptor instead.
+func (*DeleteSCIMTokenRequest) Descriptor() ([]byte, []int) {
+	return file_organizations_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *DeleteSCIMTokenRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DeleteSCIMTokenResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteSCIMTokenResponse) Reset() {
+	*x = DeleteSCIMTokenResponse{}
+	mi := &file_organizations_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteSCIMTokenResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteSCIMTokenResponse) ProtoMessage() {}
+
+func (x *DeleteSCIMTokenResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_organizations_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteSCIMTokenResponse.ProtoReflect.Descriptor instead.
+func (*DeleteSCIMTokenResponse) Descriptor() ([]byte, []int) {
+	return file_organizations_proto_rawDescGZIP(), []int{27}
+}
+
+type TwoFactorMember struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Email         string                 `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	PasswordLogin bool                   `protobuf:"varint,3,opt,name=password_login,json=passwordLogin,proto3" json:"password_login,omitempty"`
+	Enabled       bool                   `protobuf:"varint,4,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TwoFactorMember) Reset() {
+	*x = TwoFactorMember{}
+	mi := &file_organizations_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TwoFactorMember) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TwoFactorMember) ProtoMessage() {}
+
+func (x *TwoFactorMember) ProtoReflect() protoreflect.Message {
+	mi := &file_organizations_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TwoFactorMember.ProtoReflect.Descriptor instead.
+func (*TwoFactorMember) Descriptor() ([]byte, []int) {
+	return file_organizations_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *TwoFactorMember) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *TwoFactorMember) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *TwoFactorMember) GetPasswordLogin() bool {
+	if x != nil {
+		return x.PasswordLogin
+	}
+	return false
+}
+
+func (x *TwoFactorMember) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+type TwoFactorPolicy struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	Required       bool                   `protobuf:"varint,2,opt,name=required,proto3" json:"required,omitempty"`
+	Members        []*TwoFactorMember     `protobuf:"bytes,3,rep,name=members,proto3" json:"members,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *TwoFactorPolicy) Reset() {
+	*x = TwoFactorPolicy{}
+	mi := &file_organizations_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TwoFactorPolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TwoFactorPolicy) ProtoMessage() {}
+
+func (x *TwoFactorPolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_organizations_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TwoFactorPolicy.ProtoReflect.Descriptor instead.
+func (*TwoFactorPolicy) Descriptor() ([]byte, []int) {
+	return file_organizations_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *TwoFactorPolicy) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *TwoFactorPolicy) GetRequired() bool {
+	if x != nil {
+		return x.Required
+	}
+	return false
+}
+
+func (x *TwoFactorPolicy) GetMembers() []*TwoFactorMember {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+type GetTwoFactorPolicyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTwoFactorPolicyRequest) Reset() {
+	*x = GetTwoFactorPolicyRequest{}
+	mi := &file_organizations_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTwoFactorPolicyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTwoFactorPolicyRequest) ProtoMessage() {}
+
+func (x *GetTwoFactorPolicyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_organizations_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTwoFactorPolicyRequest.ProtoReflect.Descriptor instead.
+func (*GetTwoFactorPolicyRequest) Descriptor() ([]byte, []int) {
+	return file_organizations_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *GetTwoFactorPolicyRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetTwoFactorPolicyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Policy        *TwoFactorPolicy       `protobuf:"bytes,1,opt,name=policy,proto3" json:"policy,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTwoFactorPolicyResponse) Reset() {
+	*x = GetTwoFactorPolicyResponse{}
+	mi := &file_organizations_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTwoFactorPolicyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTwoFactorPolicyResponse) ProtoMessage() {}
+
+func (x *GetTwoFactorPolicyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_organizations_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTwoFactorPolicyResponse.ProtoReflect.Descriptor instead.
+func (*GetTwoFactorPolicyResponse) Descriptor() ([]byte, []int) {
+	return file_organizations_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *GetTwoFactorPolicyResponse) GetPolicy() *TwoFactorPolicy {
+	if x != nil {
+		return x.Policy
+	}
+	return nil
+}
+
+type UpdateTwoFactorPolicyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Required      bool                   `protobuf:"varint,2,opt,name=required,proto3" json:"required,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateTwoFactorPolicyRequest) Reset() {
+	*x = UpdateTwoFactorPolicyRequest{}
+	mi := &file_organizations_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateTwoFactorPolicyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTwoFactorPolicyRequest) ProtoMessage() {}
+
+func (x *UpdateTwoFactorPolicyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_organizations_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTwoFactorPolicyRequest.ProtoReflect.Descriptor instead.
+func (*UpdateTwoFactorPolicyRequest) Descriptor() ([]byte, []int) {
+	return file_organizations_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *UpdateTwoFactorPolicyRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateTwoFactorPolicyRequest) GetRequired() bool {
+	if x != nil {
+		return x.Required
+	}
+	return false
+}
+
+type UpdateTwoFactorPolicyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Policy        *TwoFactorPolicy       `protobuf:"bytes,1,opt,name=policy,proto3" json:"policy,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateTwoFactorPolicyResponse) Reset() {
+	*x = UpdateTwoFactorPolicyResponse{}
+	mi := &file_organizations_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateTwoFactorPolicyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTwoFactorPolicyResponse) ProtoMessage() {}
+
+func (x *UpdateTwoFactorPolicyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_organizations_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTwoFactorPolicyResponse.ProtoReflect.Descriptor instead.
+func (*UpdateTwoFactorPolicyResponse) Descriptor() ([]byte, []int) {
+	return file_organizations_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *UpdateTwoFactorPolicyResponse) GetPolicy() *TwoFactorPolicy {
+	if x != nil {
+		return x.Policy
+	}
+	return nil
+}
+
+type ResetUserTwoFactorRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResetUserTwoFactorRequest) Reset() {
+	*x = ResetUserTwoFactorRequest{}
+	mi := &file_organizations_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResetUserTwoFactorRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResetUserTwoFactorRequest) ProtoMessage() {}
+
+func (x *ResetUserTwoFactorRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_organizations_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResetUserTwoFactorRequest.ProtoReflect.Descriptor instead.
+func (*ResetUserTwoFactorRequest) Descriptor() ([]byte, []int) {
+	return file_organizations_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *ResetUserTwoFactorRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ResetUserTwoFactorRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type ResetUserTwoFactorResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResetUserTwoFactorResponse) Reset() {
+	*x = ResetUserTwoFactorResponse{}
+	mi := &file_organizations_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResetUserTwoFactorResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResetUserTwoFactorResponse) ProtoMessage() {}
+
+func (x *ResetUserTwoFactorResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_organizations_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResetUserTwoFactorResponse.ProtoReflect.Descriptor instead.
+func (*ResetUserTwoFactorResponse) Descriptor() ([]byte, []int) {
+	return file_organizations_proto_rawDescGZIP(), []int{35}
+}
+
+type TwoFactorEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Type          TwoFactorEventType     `protobuf:"varint,2,opt,name=type,proto3,enum=Superplane.Organizations.TwoFactorEventType" json:"type,omitempty"`
+	AccountEmail  string                 `protobuf:"bytes,3,opt,name=account_email,json=accountEmail,proto3" json:"account_email,omitempty"`
+	ActorEmail    string                 `protobuf:"bytes,4,opt,name=actor_email,json=actorEmail,proto3" json:"actor_email,omitempty"`
+	RemoteAddr    string                 `protobuf:"bytes,5,opt,name=remote_addr,json=remoteAddr,proto3" json:"remote_addr,omitempty"`
+	CreatedAt     *timestamp.Timestamp   `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TwoFactorEvent) Reset() {
+	*x = TwoFactorEvent{}
+	mi := &file_organizations_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TwoFactorEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TwoFactorEvent) ProtoMessage() {}
+
+func (x *TwoFactorEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_organizations_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TwoFactorEvent.ProtoReflect.Descriptor instead.
+func (*TwoFactorEvent) Descriptor() ([]byte, []int) {
+	return file_organizations_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *TwoFactorEvent) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *TwoFactorEvent) GetType() TwoFactorEventType {
+	if x != nil {
+		return x.Type
+	}
+	return TwoFactorEventType_TWO_FACTOR_EVENT_TYPE_UNSPECIFIED
+}
+
+func (x *TwoFactorEvent) GetAccountEmail() string {
+	if x != nil {
+		return x.AccountEmail
+	}
+	return ""
+}
+
+func (x *TwoFactorEvent) GetActorEmail() string {
+	if x != nil {
+		return x.ActorEmail
+	}
+	return ""
+}
+
+func (x *TwoFactorEvent) GetRemoteAddr() string {
+	if x != nil {
+		return x.RemoteAddr
+	}
+	return ""
+}
+
+func (x *TwoFactorEvent) GetCreatedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type ListTwoFactorEventsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Limit         uint32                 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	Before        *timestamp.Timestamp   `protobuf:"bytes,3,opt,name=before,proto3" json:"before,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTwoFactorEventsRequest) Reset() {
+	*x = ListTwoFactorEventsRequest{}
+	mi := &file_organizations_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTwoFactorEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTwoFactorEventsRequest) ProtoMessage() {}
+
+func (x *ListTwoFactorEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_organizations_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-func (x *AgentOpenAIKey) GetStatus() string {
-	if x != nil {
-		return x.Status
-	}
-	return ""
+// Deprecated: Use ListTwoFactorEventsRequest.ProtoReflect.Descriptor instead.
+func (*ListTwoFactorEventsRequest) Descriptor() ([]byte, []int) {
+	return file_organizations_proto_rawDescGZIP(), []int{37}
 }
 
-func (x *AgentOpenAIKey) GetValidationError() string {
+func (x *ListTwoFactorEventsRequest) GetId() string {
 	if x != nil {
-		return x.ValidationError
+		return x.Id
 	}
 	return ""
 }
 
-func (x *AgentOpenAIKey) GetValidatedAt() *timestamp.Timestamp {
+func (x *ListTwoFactorEventsRequest) GetLimit() uint32 {
 	if x != nil {
-		return x.ValidatedAt
+		return x.Limit
 	}
-	return nil
+	return 0
 }
 
-func (x *AgentOpenAIKey) GetUpdatedAt() *timestamp.Timestamp {
+func (x *ListTwoFactorEventsRequest) GetBefore() *timestamp.Timestamp {
 	if x != nil {
-		return x.UpdatedAt
+		return x.Before
 	}
 	return nil
 }
 
-func (x *AgentOpenAIKey) GetUpdatedBy() string {
-	if x != nil {
-		return x.UpdatedBy
-	}
-	return ""
-}
-
-type AgentSettings struct {
-	state              protoimpl.MessageState `protogen:"open.v1"`
-	OrganizationId     string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
-	AgentModeEnabled   bool                   `protobuf:"varint,2,opt,name=agent_mode_enabled,json=agentModeEnabled,proto3" json:"agent_mode_enabled,omitempty"`
-	AgentModeEffective bool                   `protobuf:"varint,3,opt,name=agent_mode_effective,json=agentModeEffective,proto3" json:"agent_mode_effective,omitempty"`
-	OpenaiKey          *AgentOpenAIKey        `protobuf:"bytes,4,opt,name=openai_key,json=openaiKey,proto3" json:"openai_key,omitempty"`
-	unknownFields      protoimpl.UnknownFields
-	sizeCache          protoimpl.SizeCache
+type ListTwoFactorEventsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Events        []*TwoFactorEvent      `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+	HasNextPage   bool                   `protobuf:"varint,2,opt,name=has_next_page,json=hasNextPage,proto3" json:"has_next_page,omitempty"`
+	LastTimestamp *timestamp.Timestamp   `protobuf:"bytes,3,opt,name=last_timestamp,json=lastTimestamp,proto3" json:"last_timestamp,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AgentSettings) Reset() {
-	*x = AgentSettings{}
-	mi := &file_organizations_proto_msgTypes[10]
+func (x *ListTwoFactorEventsResponse) Reset() {
+	*x = ListTwoFactorEventsResponse{}
+	mi := &file_organizations_proto_msgTypes[38]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AgentSettings) String() string {
+func (x *ListTwoFactorEventsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AgentSettings) ProtoMessage() {}
+func (*ListTwoFactorEventsResponse) ProtoMessage() {}
 
-func (x *AgentSettings) ProtoReflect() protoreflect.Message {
-	mi := &file_organizations_proto_msgTypes[10]
+func (x *ListTwoFactorEventsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_organizations_proto_msgTypes[38]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -620,35 +2314,28 @@ func (x *AgentSettings) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AgentSettings.ProtoReflect.Descriptor instead.
-func (*AgentSettings) Descriptor() ([]byte, []int) {
-	return file_organizations_proto_rawDescGZIP(), []int{10}
-}
-
-func (x *AgentSettings) GetOrganizationId() string {
-	if x != nil {
-		return x.OrganizationId
-	}
-	return ""
+// Deprecated: Use ListTwoFactorEventsResponse.ProtoReflect.Descriptor instead.
+func (*ListTwoFactorEventsResponse) Descriptor() ([]byte, []int) {
+	return file_organizations_proto_rawDescGZIP(), []int{38}
 }
 
-func (x *AgentSettings) GetAgentModeEnabled() bool {
+func (x *ListTwoFactorEventsResponse) GetEvents() []*TwoFactorEvent {
 	if x != nil {
-		return x.AgentModeEnabled
+		return x.Events
 	}
-	return false
+	return nil
 }
 
-func (x *AgentSettings) GetAgentModeEffective() bool {
+func (x *ListTwoFactorEventsResponse) GetHasNextPage() bool {
 	if x != nil {
-		return x.AgentModeEffective
+		return x.HasNextPage
 	}
 	return false
 }
 
-func (x *AgentSettings) GetOpenaiKey() *AgentOpenAIKey {
+func (x *ListTwoFactorEventsResponse) GetLastTimestamp() *timestamp.Timestamp {
 	if x != nil {
-		return x.OpenaiKey
+		return x.LastTimestamp
 	}
 	return nil
 }
@@ -663,7 +2350,7 @@ type CreateInvitationRequest struct {
 
 func (x *CreateInvitationRequest) Reset() {
 	*x = CreateInvitationRequest{}
-	mi := &file_organizations_proto_msgTypes[11]
+	mi := &file_organizations_proto_msgTypes[39]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -675,7 +2362,7 @@ func (x *CreateInvitationRequest) String() string {
 func (*CreateInvitationRequest) ProtoMessage() {}
 
 func (x *CreateInvitationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_organizations_proto_msgTypes[11]
+	mi := &file_organizations_proto_msgTypes[39]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -688,7 +2375,7 @@ func (x *CreateInvitationRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateInvitationRequest.ProtoReflect.Descriptor instead.
 func (*CreateInvitationRequest) Descriptor() ([]byte, []int) {
-	return file_organizations_proto_rawDescGZIP(), []int{11}
+	return file_organizations_proto_rawDescGZIP(), []int{39}
 }
 
 func (x *CreateInvitationRequest) GetId() string {
@@ -714,7 +2401,7 @@ type CreateInvitationResponse struct {
 
 func (x *CreateInvitationResponse) Reset() {
 	*x = CreateInvitationResponse{}
-	mi := &file_organizations_proto_msgTypes[12]
+	mi := &file_organizations_proto_msgTypes[40]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -726,7 +2413,7 @@ func (x *CreateInvitationResponse) String() string {
 func (*CreateInvitationResponse) ProtoMessage() {}
 
 func (x *CreateInvitationResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_organizations_proto_msgTypes[12]
+	mi := &file_organizations_proto_msgTypes[40]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -739,7 +2426,7 @@ func (x *CreateInvitationResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateInvitationResponse.ProtoReflect.Descriptor instead.
 func (*CreateInvitationResponse) Descriptor() ([]byte, []int) {
-	return file_organizations_proto_rawDescGZIP(), []int{12}
+	return file_organizations_proto_rawDescGZIP(), []int{40}
 }
 
 func (x *CreateInvitationResponse) GetInvitation() *Invitation {
@@ -758,7 +2445,7 @@ type ListInvitationsRequest struct {
 
 func (x *ListInvitationsRequest) Reset() {
 	*x = ListInvitationsRequest{}
-	mi := &file_organizations_proto_msgTypes[13]
+	mi := &file_organizations_proto_msgTypes[41]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -770,7 +2457,7 @@ func (x *ListInvitationsRequest) String() string {
 func (*ListInvitationsRequest) ProtoMessage() {}
 
 func (x *ListInvitationsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_organizations_proto_msgTypes[13]
+	mi := &file_organizations_proto_msgTypes[41]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -783,7 +2470,7 @@ func (x *ListInvitationsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListInvitationsRequest.ProtoReflect.Descriptor instead.
 func (*ListInvitationsRequest) Descriptor() ([]byte, []int) {
-	return file_organizations_proto_rawDescGZIP(), []int{13}
+	return file_organizations_proto_rawDescGZIP(), []int{41}
 }
 
 func (x *ListInvitationsRequest) GetId() string {
@@ -802,7 +2489,7 @@ type ListInvitationsResponse struct {
 
 func (x *ListInvitationsResponse) Reset() {
 	*x = ListInvitationsResponse{}
-	mi := &file_organizations_proto_msgTypes[14]
+	mi := &file_organizations_proto_msgTypes[42]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -814,7 +2501,7 @@ func (x *ListInvitationsResponse) String() string {
 func (*ListInvitationsResponse) ProtoMessage() {}
 
 func (x *ListInvitationsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_organizations_proto_msgTypes[14]
+	mi := &file_organizations_proto_msgTypes[42]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -827,7 +2514,7 @@ func (x *ListInvitationsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListInvitationsResponse.ProtoReflect.Descriptor instead.
 func (*ListInvitationsResponse) Descriptor() ([]byte, []int) {
-	return file_organizations_proto_rawDescGZIP(), []int{14}
+	return file_organizations_proto_rawDescGZIP(), []int{42}
 }
 
 func (x *ListInvitationsResponse) GetInvitations() []*Invitation {
@@ -847,7 +2534,7 @@ type RemoveInvitationRequest struct {
 
 func (x *RemoveInvitationRequest) Reset() {
 	*x = RemoveInvitationRequest{}
-	mi := &file_organizations_proto_msgTypes[15]
+	mi := &file_organizations_proto_msgTypes[43]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -859,7 +2546,7 @@ func (x *RemoveInvitationRequest) String() string {
 func (*RemoveInvitationRequest) ProtoMessage() {}
 
 func (x *RemoveInvitationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_organizations_proto_msgTypes[15]
+	mi := &file_organizations_proto_msgTypes[43]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -872,7 +2559,7 @@ func (x *RemoveInvitationRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RemoveInvitationRequest.ProtoReflect.Descriptor instead.
 func (*RemoveInvitationRequest) Descriptor() ([]byte, []int) {
-	return file_organizations_proto_rawDescGZIP(), []int{15}
+	return file_organizations_proto_rawDescGZIP(), []int{43}
 }
 
 func (x *RemoveInvitationRequest) GetId() string {
@@ -897,7 +2584,7 @@ type RemoveInvitationResponse struct {
 
 func (x *RemoveInvitationResponse) Reset() {
 	*x = RemoveInvitationResponse{}
-	mi := &file_organizations_proto_msgTypes[16]
+	mi := &file_organizations_proto_msgTypes[44]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -909,7 +2596,7 @@ func (x *RemoveInvitationResponse) String() string {
 func (*RemoveInvitationResponse) ProtoMessage() {}
 
 func (x *RemoveInvitationResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_organizations_proto_msgTypes[16]
+	mi := &file_organizations_proto_msgTypes[44]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -922,7 +2609,7 @@ func (x *RemoveInvitationResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RemoveInvitationResponse.ProtoReflect.Descriptor instead.
 func (*RemoveInvitationResponse) Descriptor() ([]byte, []int) {
-	return file_organizations_proto_rawDescGZIP(), []int{16}
+	return file_organizations_proto_rawDescGZIP(), []int{44}
 }
 
 type GetInviteLinkRequest struct {
@@ -934,7 +2621,7 @@ type GetInviteLinkRequest struct {
 
 func (x *GetInviteLinkRequest) Reset() {
 	*x = GetInviteLinkRequest{}
-	mi := &file_organizations_proto_msgTypes[17]
+	mi := &file_organizations_proto_msgTypes[45]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -946,7 +2633,7 @@ func (x *GetInviteLinkRequest) String() string {
 func (*GetInviteLinkRequest) ProtoMessage() {}
 
 func (x *GetInviteLinkRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_organizations_proto_msgTypes[17]
+	mi := &file_organizations_proto_msgTypes[45]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -959,7 +2646,7 @@ func (x *GetInviteLinkRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetInviteLinkRequest.ProtoReflect.Descriptor instead.
 func (*GetInviteLinkRequest) Descriptor() ([]byte, []int) {
-	return file_organizations_proto_rawDescGZIP(), []int{17}
+	return file_organizations_proto_rawDescGZIP(), []int{45}
 }
 
 func (x *GetInviteLinkRequest) GetId() string {
@@ -978,7 +2665,7 @@ type GetInviteLinkResponse struct {
 
 func (x *GetInviteLinkResponse) Reset() {
 	*x = GetInviteLinkResponse{}
-	mi := &file_organizations_proto_msgTypes[18]
+	mi := &file_organizations_proto_msgTypes[46]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -990,7 +2677,7 @@ func (x *GetInviteLinkResponse) String() string {
 func (*GetInviteLinkResponse) ProtoMessage() {}
 
 func (x *GetInviteLinkResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_organizations_proto_msgTypes[18]
+	mi := &file_organizations_proto_msgTypes[46]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1003,7 +2690,7 @@ func (x *GetInviteLinkResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetInviteLinkResponse.ProtoReflect.Descriptor instead.
 func (*GetInviteLinkResponse) Descriptor() ([]byte, []int) {
-	return file_organizations_proto_rawDescGZIP(), []int{18}
+	return file_organizations_proto_rawDescGZIP(), []int{46}
 }
 
 func (x *GetInviteLinkResponse) GetInviteLink() *InviteLink {
@@ -1023,7 +2710,7 @@ type UpdateInviteLinkRequest struct {
 
 func (x *UpdateInviteLinkRequest) Reset() {
 	*x = UpdateInviteLinkRequest{}
-	mi := &file_organizations_proto_msgTypes[19]
+	mi := &file_organizations_proto_msgTypes[47]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1035,7 +2722,7 @@ func (x *UpdateInviteLinkRequest) String() string {
 func (*UpdateInviteLinkRequest) ProtoMessage() {}
 
 func (x *UpdateInviteLinkRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_organizations_proto_msgTypes[19]
+	mi := &file_organizations_proto_msgTypes[47]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1048,7 +2735,7 @@ func (x *UpdateInviteLinkRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateInviteLinkRequest.ProtoReflect.Descriptor instead.
 func (*UpdateInviteLinkRequest) Descriptor() ([]byte, []int) {
-	return file_organizations_proto_rawDescGZIP(), []int{19}
+	return file_organizations_proto_rawDescGZIP(), []int{47}
 }
 
 func (x *UpdateInviteLinkRequest) GetId() string {
@@ -1074,7 +2761,7 @@ type UpdateInviteLinkResponse struct {
 
 func (x *UpdateInviteLinkResponse) Reset() {
 	*x = UpdateInviteLinkResponse{}
-	mi := &file_organizations_proto_msgTypes[20]
+	mi := &file_organizations_proto_msgTypes[48]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1086,7 +2773,7 @@ func (x *UpdateInviteLinkResponse) String() string {
 func (*UpdateInviteLinkResponse) ProtoMessage() {}
 
 func (x *UpdateInviteLinkResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_organizations_proto_msgTypes[20]
+	mi := &file_organizations_proto_msgTypes[48]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1099,7 +2786,7 @@ func (x *UpdateInviteLinkResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateInviteLinkResponse.ProtoReflect.Descriptor instead.
 func (*UpdateInviteLinkResponse) Descriptor() ([]byte, []int) {
-	return file_organizations_proto_rawDescGZIP(), []int{20}
+	return file_organizations_proto_rawDescGZIP(), []int{48}
 }
 
 func (x *UpdateInviteLinkResponse) GetInviteLink() *InviteLink {
@@ -1118,7 +2805,7 @@ type ResetInviteLinkRequest struct {
 
 func (x *ResetInviteLinkRequest) Reset() {
 	*x = ResetInviteLinkRequest{}
-	mi := &file_organizations_proto_msgTypes[21]
+	mi := &file_organizations_proto_msgTypes[49]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1130,7 +2817,7 @@ func (x *ResetInviteLinkRequest) String() string {
 func (*ResetInviteLinkRequest) ProtoMessage() {}
 
 func (x *ResetInviteLinkRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_organizations_proto_msgTypes[21]
+	mi := &file_organizations_proto_msgTypes[49]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1143,7 +2830,7 @@ func (x *ResetInviteLinkRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ResetInviteLinkRequest.ProtoReflect.Descriptor instead.
 func (*ResetInviteLinkRequest) Descriptor() ([]byte, []int) {
-	return file_organizations_proto_rawDescGZIP(), []int{21}
+	return file_organizations_proto_rawDescGZIP(), []int{49}
 }
 
 func (x *ResetInviteLinkRequest) GetId() string {
@@ -1162,7 +2849,7 @@ type ResetInviteLinkResponse struct {
 
 func (x *ResetInviteLinkResponse) Reset() {
 	*x = ResetInviteLinkResponse{}
-	mi := &file_organizations_proto_msgTypes[22]
+	mi := &file_organizations_proto_msgTypes[50]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1174,7 +2861,7 @@ func (x *ResetInviteLinkResponse) String() string {
 func (*ResetInviteLinkResponse) ProtoMessage() {}
 
 func (x *ResetInviteLinkResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_organizations_proto_msgTypes[22]
+	mi := &file_organizations_proto_msgTypes[50]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1187,7 +2874,7 @@ func (x *ResetInviteLinkResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ResetInviteLinkResponse.ProtoReflect.Descriptor instead.
 func (*ResetInviteLinkResponse) Descriptor() ([]byte, []int) {
-	return file_organizations_proto_rawDescGZIP(), []int{22}
+	return file_organizations_proto_rawDescGZIP(), []int{50}
 }
 
 func (x *ResetInviteLinkResponse) GetInviteLink() *InviteLink {
@@ -1206,7 +2893,7 @@ type GetAgentSettingsRequest struct {
 
 func (x *GetAgentSettingsRequest) Reset() {
 	*x = GetAgentSettingsRequest{}
-	mi := &file_organizations_proto_msgTypes[23]
+	mi := &file_organizations_proto_msgTypes[51]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1218,7 +2905,7 @@ func (x *GetAgentSettingsRequest) String() string {
 func (*GetAgentSettingsRequest) ProtoMessage() {}
 
 func (x *GetAgentSettingsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_organizations_proto_msgTypes[23]
+	mi := &file_organizations_proto_msgTypes[51]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1231,7 +2918,7 @@ func (x *GetAgentSettingsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetAgentSettingsRequest.ProtoReflect.Descriptor instead.
 func (*GetAgentSettingsRequest) Descriptor() ([]byte, []int) {
-	return file_organizations_proto_rawDescGZIP(), []int{23}
+	return file_organizations_proto_rawDescGZIP(), []int{51}
 }
 
 func (x *GetAgentSettingsRequest) GetId() string {
@@ -1250,7 +2937,7 @@ type GetAgentSettingsResponse struct {
 
 func (x *GetAgentSettingsResponse) Reset() {
 	*x = GetAgentSettingsResponse{}
-	mi := &file_organizations_proto_msgTypes[24]
+	mi := &file_organizations_proto_msgTypes[52]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1262,7 +2949,7 @@ func (x *GetAgentSettingsResponse) String() string {
 func (*GetAgentSettingsResponse) ProtoMessage() {}
 
 func (x *GetAgentSettingsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_organizations_proto_msgTypes[24]
+	mi := &file_organizations_proto_msgTypes[52]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1275,7 +2962,7 @@ func (x *GetAgentSettingsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetAgentSettingsResponse.ProtoReflect.Descriptor instead.
 func (*GetAgentSettingsResponse) Descriptor() ([]byte, []int) {
-	return file_organizations_proto_rawDescGZIP(), []int{24}
+	return file_organizations_proto_rawDescGZIP(), []int{52}
 }
 
 func (x *GetAgentSettingsResponse) GetAgentSettings() *AgentSettings {
@@ -1295,7 +2982,7 @@ type UpdateAgentSettingsRequest struct {
 
 func (x *UpdateAgentSettingsRequest) Reset() {
 	*x = UpdateAgentSettingsRequest{}
-	mi := &file_organizations_proto_msgTypes[25]
+	mi := &file_organizations_proto_msgTypes[53]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1307,7 +2994,7 @@ func (x *UpdateAgentSettingsRequest) String() string {
 func (*UpdateAgentSettingsRequest) ProtoMessage() {}
 
 func (x *UpdateAgentSettingsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_organizations_proto_msgTypes[25]
+	mi := &file_organizations_proto_msgTypes[53]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1320,7 +3007,7 @@ func (x *UpdateAgentSettingsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateAgentSettingsRequest.ProtoReflect.Descriptor instead.
 func (*UpdateAgentSettingsRequest) Descriptor() ([]byte, []int) {
-	return file_organizations_proto_rawDescGZIP(), []int{25}
+	return file_organizations_proto_rawDescGZIP(), []int{53}
 }
 
 func (x *UpdateAgentSettingsRequest) GetId() string {
@@ -1346,7 +3033,7 @@ type UpdateAgentSettingsResponse struct {
 
 func (x *UpdateAgentSettingsResponse) Reset() {
 	*x = UpdateAgentSettingsResponse{}
-	mi := &file_organizations_proto_msgTypes[26]
+	mi := &file_organizations_proto_msgTypes[54]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1358,7 +3045,7 @@ func (x *UpdateAgentSettingsResponse) String() string {
 func (*UpdateAgentSettingsResponse) ProtoMessage() {}
 
 func (x *UpdateAgentSettingsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_organizations_proto_msgTypes[26]
+	mi := &file_organizations_proto_msgTypes[54]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1371,7 +3058,7 @@ func (x *UpdateAgentSettingsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateAgentSettingsResponse.ProtoReflect.Descriptor instead.
 func (*UpdateAgentSettingsResponse) Descriptor() ([]byte, []int) {
-	return file_organizations_proto_rawDescGZIP(), []int{26}
+	return file_organizations_proto_rawDescGZIP(), []int{54}
 }
 
 func (x *UpdateAgentSettingsResponse) GetAgentSettings() *AgentSettings {
@@ -1392,7 +3079,7 @@ type SetAgentOpenAIKeyRequest struct {
 
 func (x *SetAgentOpenAIKeyRequest) Reset() {
 	*x = SetAgentOpenAIKeyRequest{}
-	mi := &file_organizations_proto_msgTypes[27]
+	mi := &file_organizations_proto_msgTypes[55]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1404,7 +3091,7 @@ func (x *SetAgentOpenAIKeyRequest) String() string {
 func (*SetAgentOpenAIKeyRequest) ProtoMessage() {}
 
 func (x *SetAgentOpenAIKeyRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_organizations_proto_msgTypes[27]
+	mi := &file_organizations_proto_msgTypes[55]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1417,7 +3104,7 @@ func (x *SetAgentOpenAIKeyRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SetAgentOpenAIKeyRequest.ProtoReflect.Descriptor instead.
 func (*SetAgentOpenAIKeyRequest) Descriptor() ([]byte, []int) {
-	return file_organizations_proto_rawDescGZIP(), []int{27}
+	return file_organizations_proto_rawDescGZIP(), []int{55}
 }
 
 func (x *SetAgentOpenAIKeyRequest) GetId() string {
@@ -1450,7 +3137,7 @@ type SetAgentOpenAIKeyResponse struct {
 
 func (x *SetAgentOpenAIKeyResponse) Reset() {
 	*x = SetAgentOpenAIKeyResponse{}
-	mi := &file_organizations_proto_msgTypes[28]
+	mi := &file_organizations_proto_msgTypes[56]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1462,7 +3149,7 @@ func (x *SetAgentOpenAIKeyResponse) String() string {
 func (*SetAgentOpenAIKeyResponse) ProtoMessage() {}
 
 func (x *SetAgentOpenAIKeyResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_organizations_proto_msgTypes[28]
+	mi := &file_organizations_proto_msgTypes[56]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1475,7 +3162,7 @@ func (x *SetAgentOpenAIKeyResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SetAgentOpenAIKeyResponse.ProtoReflect.Descriptor instead.
 func (*SetAgentOpenAIKeyResponse) Descriptor() ([]byte, []int) {
-	return file_organizations_proto_rawDescGZIP(), []int{28}
+	return file_organizations_proto_rawDescGZIP(), []int{56}
 }
 
 func (x *SetAgentOpenAIKeyResponse) GetAgentSettings() *AgentSettings {
@@ -1494,7 +3181,7 @@ type DeleteAgentOpenAIKeyRequest struct {
 
 func (x *DeleteAgentOpenAIKeyRequest) Reset() {
 	*x = DeleteAgentOpenAIKeyRequest{}
-	mi := &file_organizations_proto_msgTypes[29]
+	mi := &file_organizations_proto_msgTypes[57]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1506,7 +3193,7 @@ func (x *DeleteAgentOpenAIKeyRequest) String() string {
 func (*DeleteAgentOpenAIKeyRequest) ProtoMessage() {}
 
 func (x *DeleteAgentOpenAIKeyRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_organizations_proto_msgTypes[29]
+	mi := &file_organizations_proto_msgTypes[57]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1519,7 +3206,7 @@ func (x *DeleteAgentOpenAIKeyRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteAgentOpenAIKeyRequest.ProtoReflect.Descriptor instead.
 func (*DeleteAgentOpenAIKeyRequest) Descriptor() ([]byte, []int) {
-	return file_organizations_proto_rawDescGZIP(), []int{29}
+	return file_organizations_proto_rawDescGZIP(), []int{57}
 }
 
 func (x *DeleteAgentOpenAIKeyRequest) GetId() string {
@@ -1538,7 +3225,7 @@ type DeleteAgentOpenAIKeyResponse struct {
 
 func (x *DeleteAgentOpenAIKeyResponse) Reset() {
 	*x = DeleteAgentOpenAIKeyResponse{}
-	mi := &file_organizations_proto_msgTypes[30]
+	mi := &file_organizations_proto_msgTypes[58]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1550,7 +3237,7 @@ func (x *DeleteAgentOpenAIKeyResponse) String() string {
 func (*DeleteAgentOpenAIKeyResponse) ProtoMessage() {}
 
 func (x *DeleteAgentOpenAIKeyResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_organizations_proto_msgTypes[30]
+	mi := &file_organizations_proto_msgTypes[58]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1563,7 +3250,7 @@ func (x *DeleteAgentOpenAIKeyResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteAgentOpenAIKeyResponse.ProtoReflect.Descriptor instead.
 func (*DeleteAgentOpenAIKeyResponse) Descriptor() ([]byte, []int) {
-	return file_organizations_proto_rawDescGZIP(), []int{30}
+	return file_organizations_proto_rawDescGZIP(), []int{58}
 }
 
 func (x *DeleteAgentOpenAIKeyResponse) GetAgentSettings() *AgentSettings {
@@ -1583,7 +3270,7 @@ type RemoveUserRequest struct {
 
 func (x *RemoveUserRequest) Reset() {
 	*x = RemoveUserRequest{}
-	mi := &file_organizations_proto_msgTypes[31]
+	mi := &file_organizations_proto_msgTypes[59]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1595,7 +3282,7 @@ func (x *RemoveUserRequest) String() string {
 func (*RemoveUserRequest) ProtoMessage() {}
 
 func (x *RemoveUserRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_organizations_proto_msgTypes[31]
+	mi := &file_organizations_proto_msgTypes[59]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1608,7 +3295,7 @@ func (x *RemoveUserRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RemoveUserRequest.ProtoReflect.Descriptor instead.
 func (*RemoveUserRequest) Descriptor() ([]byte, []int) {
-	return file_organizations_proto_rawDescGZIP(), []int{31}
+	return file_organizations_proto_rawDescGZIP(), []int{59}
 }
 
 func (x *RemoveUserRequest) GetId() string {
@@ -1633,7 +3320,7 @@ type RemoveUserResponse struct {
 
 func (x *RemoveUserResponse) Reset() {
 	*x = RemoveUserResponse{}
-	mi := &file_organizations_proto_msgTypes[32]
+	mi := &file_organizations_proto_msgTypes[60]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1645,7 +3332,7 @@ func (x *RemoveUserResponse) String() string {
 func (*RemoveUserResponse) ProtoMessage() {}
 
 func (x *RemoveUserResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_organizations_proto_msgTypes[32]
+	mi := &file_organizations_proto_msgTypes[60]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1658,7 +3345,7 @@ func (x *RemoveUserResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RemoveUserResponse.ProtoReflect.Descriptor instead.
 func (*RemoveUserResponse) Descriptor() ([]byte, []int) {
-	return file_organizations_proto_rawDescGZIP(), []int{32}
+	return file_organizations_proto_rawDescGZIP(), []int{60}
 }
 
 type ListIntegrationsRequest struct {
@@ -1670,7 +3357,7 @@ type ListIntegrationsRequest struct {
 
 func (x *ListIntegrationsRequest) Reset() {
 	*x = ListIntegrationsRequest{}
-	mi := &file_organizations_proto_msgTypes[33]
+	mi := &file_organizations_proto_msgTypes[61]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1682,7 +3369,7 @@ func (x *ListIntegrationsRequest) String() string {
 func (*ListIntegrationsRequest) ProtoMessage() {}
 
 func (x *ListIntegrationsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_organizations_proto_msgTypes[33]
+	mi := &file_organizations_proto_msgTypes[61]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1695,7 +3382,7 @@ func (x *ListIntegrationsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListIntegrationsRequest.ProtoReflect.Descriptor instead.
 func (*ListIntegrationsRequest) Descriptor() ([]byte, []int) {
-	return file_organizations_proto_rawDescGZIP(), []int{33}
+	return file_organizations_proto_rawDescGZIP(), []int{61}
 }
 
 func (x *ListIntegrationsRequest) GetId() string {
@@ -1714,7 +3401,7 @@ type ListIntegrationsResponse struct {
 
 func (x *ListIntegrationsResponse) Reset() {
 	*x = ListIntegrationsResponse{}
-	mi := &file_organizations_proto_msgTypes[34]
+	mi := &file_organizations_proto_msgTypes[62]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1726,7 +3413,7 @@ func (x *ListIntegrationsResponse) String() string {
 func (*ListIntegrationsResponse) ProtoMessage() {}
 
 func (x *ListIntegrationsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_organizations_proto_msgTypes[34]
+	mi := &file_organizations_proto_msgTypes[62]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1739,7 +3426,7 @@ func (x *ListIntegrationsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListIntegrationsResponse.ProtoReflect.Descriptor instead.
 func (*ListIntegrationsResponse) Descriptor() ([]byte, []int) {
-	return file_organizations_proto_rawDescGZIP(), []int{34}
+	return file_organizations_proto_rawDescGZIP(), []int{62}
 }
 
 func (x *ListIntegrationsResponse) GetIntegrations() []*Integration {
@@ -1761,7 +3448,7 @@ type CreateIntegrationRequest struct {
 
 func (x *CreateIntegrationRequest) Reset() {
 	*x = CreateIntegrationRequest{}
-	mi := &file_organizations_proto_msgTypes[35]
+	mi := &file_organizations_proto_msgTypes[63]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1773,7 +3460,7 @@ func (x *CreateIntegrationRequest) String() string {
 func (*CreateIntegrationRequest) ProtoMessage() {}
 
 func (x *CreateIntegrationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_organizations_proto_msgTypes[35]
+	mi := &file_organizations_proto_msgTypes[63]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1786,7 +3473,7 @@ func (x *CreateIntegrationRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateIntegrationRequest.ProtoReflect.Descriptor instead.
 func (*CreateIntegrationRequest) Descriptor() ([]byte, []int) {
-	return file_organizations_proto_rawDescGZIP(), []int{35}
+	return file_organizations_proto_rawDescGZIP(), []int{63}
 }
 
 func (x *CreateIntegrationRequest) GetId() string {
@@ -1826,7 +3513,7 @@ type CreateIntegrationResponse struct {
 
 func (x *CreateIntegrationResponse) Reset() {
 	*x = CreateIntegrationResponse{}
-	mi := &file_organizations_proto_msgTypes[36]
+	mi := &file_organizations_proto_msgTypes[64]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1838,7 +3525,7 @@ func (x *CreateIntegrationResponse) String() string {
 func (*CreateIntegrationResponse) ProtoMessage() {}
 
 func (x *CreateIntegrationResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_organizations_proto_msgTypes[36]
+	mi := &file_organizations_proto_msgTypes[64]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1851,7 +3538,7 @@ func (x *CreateIntegrationResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateIntegrationResponse.ProtoReflect.Descriptor instead.
 func (*CreateIntegrationResponse) Descriptor() ([]byte, []int) {
-	return file_organizations_proto_rawDescGZIP(), []int{36}
+	return file_organizations_proto_rawDescGZIP(), []int{64}
 }
 
 func (x *CreateIntegrationResponse) GetIntegration() *Integration {
@@ -1871,7 +3558,7 @@ type DescribeIntegrationRequest struct {
 
 func (x *DescribeIntegrationRequest) Reset() {
 	*x = DescribeIntegrationRequest{}
-	mi := &file_organizations_proto_msgTypes[37]
+	mi := &file_organizations_proto_msgTypes[65]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1883,7 +3570,7 @@ func (x *DescribeIntegrationRequest) String() string {
 func (*DescribeIntegrationRequest) ProtoMessage() {}
 
 func (x *DescribeIntegrationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_organizations_proto_msgTypes[37]
+	mi := &file_organizations_proto_msgTypes[65]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1896,7 +3583,7 @@ func (x *DescribeIntegrationRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DescribeIntegrationRequest.ProtoReflect.Descriptor instead.
 func (*DescribeIntegrationRequest) Descriptor() ([]byte, []int) {
-	return file_organizations_proto_rawDescGZIP(), []int{37}
+	return file_organizations_proto_rawDescGZIP(), []int{65}
 }
 
 func (x *DescribeIntegrationRequest) GetId() string {
@@ -1922,7 +3609,7 @@ type DescribeIntegrationResponse struct {
 
 func (x *DescribeIntegrationResponse) Reset() {
 	*x = DescribeIntegrationResponse{}
-	mi := &file_organizations_proto_msgTypes[38]
+	mi := &file_organizations_proto_msgTypes[66]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1934,7 +3621,7 @@ func (x *DescribeIntegrationResponse) String() string {
 func (*DescribeIntegrationResponse) ProtoMessage() {}
 
 func (x *DescribeIntegrationResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_organizations_proto_msgTypes[38]
+	mi := &file_organizations_proto_msgTypes[66]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1947,7 +3634,7 @@ func (x *DescribeIntegrationResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DescribeIntegrationResponse.ProtoReflect.Descriptor instead.
 func (*DescribeIntegrationResponse) Descriptor() ([]byte, []int) {
-	return file_organizations_proto_rawDescGZIP(), []int{38}
+	return file_organizations_proto_rawDescGZIP(), []int{66}
 }
 
 func (x *DescribeIntegrationResponse) GetIntegration() *Integration {
@@ -1968,7 +3655,7 @@ type ListIntegrationResourcesRequest struct {
 
 func (x *ListIntegrationResourcesRequest) Reset() {
 	*x = ListIntegrationResourcesRequest{}
-	mi := &file_organizations_proto_msgTypes[39]
+	mi := &file_organizations_proto_msgTypes[67]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1980,7 +3667,7 @@ func (x *ListIntegrationResourcesRequest) String() string {
 func (*ListIntegrationResourcesRequest) ProtoMessage() {}
 
 func (x *ListIntegrationResourcesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_organizations_proto_msgTypes[39]
+	mi := &file_organizations_proto_msgTypes[67]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1993,7 +3680,7 @@ func (x *ListIntegrationResourcesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListIntegrationResourcesRequest.ProtoReflect.Descriptor instead.
 func (*ListIntegrationResourcesRequest) Descriptor() ([]byte, []int) {
-	return file_organizations_proto_rawDescGZIP(), []int{39}
+	return file_organizations_proto_rawDescGZIP(), []int{67}
 }
 
 func (x *ListIntegrationResourcesRequest) GetId() string {
@@ -2026,7 +3713,7 @@ type ListIntegrationResourcesResponse struct {
 
 func (x *ListIntegrationResourcesResponse) Reset() {
 	*x = ListIntegrationResourcesResponse{}
-	mi := &file_organizations_proto_msgTypes[40]
+	mi := &file_organizations_proto_msgTypes[68]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2038,7 +3725,7 @@ func (x *ListIntegrationResourcesResponse) String() string {
 func (*ListIntegrationResourcesResponse) ProtoMessage() {}
 
 func (x *ListIntegrationResourcesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_organizations_proto_msgTypes[40]
+	mi := &file_organizations_proto_msgTypes[68]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2051,7 +3738,7 @@ func (x *ListIntegrationResourcesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListIntegrationResourcesResponse.ProtoReflect.Descriptor instead.
 func (*ListIntegrationResourcesResponse) Descriptor() ([]byte, []int) {
-	return file_organizations_proto_rawDescGZIP(), []int{40}
+	return file_organizations_proto_rawDescGZIP(), []int{68}
 }
 
 func (x *ListIntegrationResourcesResponse) GetResources() []*IntegrationResourceRef {
@@ -2072,7 +3759,7 @@ type IntegrationResourceRef struct {
 
 func (x *IntegrationResourceRef) Reset() {
 	*x = IntegrationResourceRef{}
-	mi := &file_organizations_proto_msgTypes[41]
+	mi := &file_organizations_proto_msgTypes[69]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2084,7 +3771,7 @@ func (x *IntegrationResourceRef) String() string {
 func (*IntegrationResourceRef) ProtoMessage() {}
 
 func (x *IntegrationResourceRef) ProtoReflect() protoreflect.Message {
-	mi := &file_organizations_proto_msgTypes[41]
+	mi := &file_organizations_proto_msgTypes[69]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2097,7 +3784,7 @@ func (x *IntegrationResourceRef) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use IntegrationResourceRef.ProtoReflect.Descriptor instead.
 func (*IntegrationResourceRef) Descriptor() ([]byte, []int) {
-	return file_organizations_proto_rawDescGZIP(), []int{41}
+	return file_organizations_proto_rawDescGZIP(), []int{69}
 }
 
 func (x *IntegrationResourceRef) GetType() string {
@@ -2133,7 +3820,7 @@ type UpdateIntegrationRequest struct {
 
 func (x *UpdateIntegrationRequest) Reset() {
 	*x = UpdateIntegrationRequest{}
-	mi := &file_organizations_proto_msgTypes[42]
+	mi := &file_organizations_proto_msgTypes[70]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2145,7 +3832,7 @@ func (x *UpdateIntegrationRequest) String() string {
 func (*UpdateIntegrationRequest) ProtoMessage() {}
 
 func (x *UpdateIntegrationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_organizations_proto_msgTypes[42]
+	mi := &file_organizations_proto_msgTypes[70]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2158,7 +3845,7 @@ func (x *UpdateIntegrationRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateIntegrationRequest.ProtoReflect.Descriptor instead.
 func (*UpdateIntegrationRequest) Descriptor() ([]byte, []int) {
-	return file_organizations_proto_rawDescGZIP(), []int{42}
+	return file_organizations_proto_rawDescGZIP(), []int{70}
 }
 
 func (x *UpdateIntegrationRequest) GetId() string {
@@ -2198,7 +3885,7 @@ type UpdateIntegrationResponse struct {
 
 func (x *UpdateIntegrationResponse) Reset() {
 	*x = UpdateIntegrationResponse{}
-	mi := &file_organizations_proto_msgTypes[43]
+	mi := &file_organizations_proto_msgTypes[71]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2210,7 +3897,7 @@ func (x *UpdateIntegrationResponse) String() string {
 func (*UpdateIntegrationResponse) ProtoMessage() {}
 
 func (x *UpdateIntegrationResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_organizations_proto_msgTypes[43]
+	mi := &file_organizations_proto_msgTypes[71]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2223,7 +3910,7 @@ func (x *UpdateIntegrationResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateIntegrationResponse.ProtoReflect.Descriptor instead.
 func (*UpdateIntegrationResponse) Descriptor() ([]byte, []int) {
-	return file_organizations_proto_rawDescGZIP(), []int{43}
+	return file_organizations_proto_rawDescGZIP(), []int{71}
 }
 
 func (x *UpdateIntegrationResponse) GetIntegration() *Integration {
@@ -2243,7 +3930,7 @@ type DeleteIntegrationRequest struct {
 
 func (x *DeleteIntegrationRequest) Reset() {
 	*x = DeleteIntegrationRequest{}
-	mi := &file_organizations_proto_msgTypes[44]
+	mi := &file_organizations_proto_msgTypes[72]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2255,7 +3942,7 @@ func (x *DeleteIntegrationRequest) String() string {
 func (*DeleteIntegrationRequest) ProtoMessage() {}
 
 func (x *DeleteIntegrationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_organizations_proto_msgTypes[44]
+	mi := &file_organizations_proto_msgTypes[72]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2268,7 +3955,7 @@ func (x *DeleteIntegrationRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteIntegrationRequest.ProtoReflect.Descriptor instead.
 func (*DeleteIntegrationRequest) Descriptor() ([]byte, []int) {
-	return file_organizations_proto_rawDescGZIP(), []int{44}
+	return file_organizations_proto_rawDescGZIP(), []int{72}
 }
 
 func (x *DeleteIntegrationRequest) GetId() string {
@@ -2293,7 +3980,7 @@ type DeleteIntegrationResponse struct {
 
 func (x *DeleteIntegrationResponse) Reset() {
 	*x = DeleteIntegrationResponse{}
-	mi := &file_organizations_proto_msgTypes[45]
+	mi := &file_organizations_proto_msgTypes[73]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2305,7 +3992,7 @@ func (x *DeleteIntegrationResponse) String() string {
 func (*DeleteIntegrationResponse) ProtoMessage() {}
 
 func (x *DeleteIntegrationResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_organizations_proto_msgTypes[45]
+	mi := &file_organizations_proto_msgTypes[73]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2318,7 +4005,7 @@ func (x *DeleteIntegrationResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteIntegrationResponse.ProtoReflect.Descriptor instead.
 func (*DeleteIntegrationResponse) Descriptor() ([]byte, []int) {
-	return file_organizations_proto_rawDescGZIP(), []int{45}
+	return file_organizations_proto_rawDescGZIP(), []int{73}
 }
 
 type Integration struct {
@@ -2332,7 +4019,7 @@ type Integration struct {
 
 func (x *Integration) Reset() {
 	*x = Integration{}
-	mi := &file_organizations_proto_msgTypes[46]
+	mi := &file_organizations_proto_msgTypes[74]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2344,7 +4031,7 @@ func (x *Integration) String() string {
 func (*Integration) ProtoMessage() {}
 
 func (x *Integration) ProtoReflect() protoreflect.Message {
-	mi := &file_organizations_proto_msgTypes[46]
+	mi := &file_organizations_proto_msgTypes[74]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2357,7 +4044,7 @@ func (x *Integration) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Integration.ProtoReflect.Descriptor instead.
 func (*Integration) Descriptor() ([]byte, []int) {
-	return file_organizations_proto_rawDescGZIP(), []int{46}
+	return file_organizations_proto_rawDescGZIP(), []int{74}
 }
 
 func (x *Integration) GetMetadata() *Integration_Metadata {
@@ -2393,7 +4080,7 @@ type BrowserAction struct {
 
 func (x *BrowserAction) Reset() {
 	*x = BrowserAction{}
-	mi := &file_organizations_proto_msgTypes[47]
+	mi := &file_organizations_proto_msgTypes[75]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2405,7 +4092,7 @@ func (x *BrowserAction) String() string {
 func (*BrowserAction) ProtoMessage() {}
 
 func (x *BrowserAction) ProtoReflect() protoreflect.Message {
-	mi := &file_organizations_proto_msgTypes[47]
+	mi := &file_organizations_proto_msgTypes[75]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2418,7 +4105,7 @@ func (x *BrowserAction) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BrowserAction.ProtoReflect.Descriptor instead.
 func (*BrowserAction) Descriptor() ([]byte, []int) {
-	return file_organizations_proto_rawDescGZIP(), []int{47}
+	return file_organizations_proto_rawDescGZIP(), []int{75}
 }
 
 func (x *BrowserAction) GetUrl() string {
@@ -2460,7 +4147,7 @@ type OrganizationCreated struct {
 
 func (x *OrganizationCreated) Reset() {
 	*x = OrganizationCreated{}
-	mi := &file_organizations_proto_msgTypes[48]
+	mi := &file_organizations_proto_msgTypes[76]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2472,7 +4159,7 @@ func (x *OrganizationCreated) String() string {
 func (*OrganizationCreated) ProtoMessage() {}
 
 func (x *OrganizationCreated) ProtoReflect() protoreflect.Message {
-	mi := &file_organizations_proto_msgTypes[48]
+	mi := &file_organizations_proto_msgTypes[76]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2485,7 +4172,7 @@ func (x *OrganizationCreated) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use OrganizationCreated.ProtoReflect.Descriptor instead.
 func (*OrganizationCreated) Descriptor() ([]byte, []int) {
-	return file_organizations_proto_rawDescGZIP(), []int{48}
+	return file_organizations_proto_rawDescGZIP(), []int{76}
 }
 
 func (x *OrganizationCreated) GetOrganizationId() string {
@@ -2512,7 +4199,7 @@ type OrganizationUpdated struct {
 
 func (x *OrganizationUpdated) Reset() {
 	*x = OrganizationUpdated{}
-	mi := &file_organizations_proto_msgTypes[49]
+	mi := &file_organizations_proto_msgTypes[77]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2524,7 +4211,7 @@ func (x *OrganizationUpdated) String() string {
 func (*OrganizationUpdated) ProtoMessage() {}
 
 func (x *OrganizationUpdated) ProtoReflect() protoreflect.Message {
-	mi := &file_organizations_proto_msgTypes[49]
+	mi := &file_organizations_proto_msgTypes[77]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2537,7 +4224,7 @@ func (x *OrganizationUpdated) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use OrganizationUpdated.ProtoReflect.Descriptor instead.
 func (*OrganizationUpdated) Descriptor() ([]byte, []int) {
-	return file_organizations_proto_rawDescGZIP(), []int{49}
+	return file_organizations_proto_rawDescGZIP(), []int{77}
 }
 
 func (x *OrganizationUpdated) GetOrganizationId() string {
@@ -2564,7 +4251,7 @@ type OrganizationDeleted struct {
 
 func (x *OrganizationDeleted) Reset() {
 	*x = OrganizationDeleted{}
-	mi := &file_organizations_proto_msgTypes[50]
+	mi := &file_organizations_proto_msgTypes[78]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2576,7 +4263,7 @@ func (x *OrganizationDeleted) String() string {
 func (*OrganizationDeleted) ProtoMessage() {}
 
 func (x *OrganizationDeleted) ProtoReflect() protoreflect.Message {
-	mi := &file_organizations_proto_msgTypes[50]
+	mi := &file_organizations_proto_msgTypes[78]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2589,7 +4276,7 @@ func (x *OrganizationDeleted) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use OrganizationDeleted.ProtoReflect.Descriptor instead.
 func (*OrganizationDeleted) Descriptor() ([]byte, []int) {
-	return file_organizations_proto_rawDescGZIP(), []int{50}
+	return file_organizations_proto_rawDescGZIP(), []int{78}
 }
 
 func (x *OrganizationDeleted) GetOrganizationId() string {
@@ -2616,7 +4303,7 @@ type InvitationCreated struct {
 
 func (x *InvitationCreated) Reset() {
 	*x = InvitationCreated{}
-	mi := &file_organizations_proto_msgTypes[51]
+	mi := &file_organizations_proto_msgTypes[79]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2628,7 +4315,7 @@ func (x *InvitationCreated) String() string {
 func (*InvitationCreated) ProtoMessage() {}
 
 func (x *InvitationCreated) ProtoReflect() protoreflect.Message {
-	mi := &file_organizations_proto_msgTypes[51]
+	mi := &file_organizations_proto_msgTypes[79]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2641,7 +4328,7 @@ func (x *InvitationCreated) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use InvitationCreated.ProtoReflect.Descriptor instead.
 func (*InvitationCreated) Descriptor() ([]byte, []int) {
-	return file_organizations_proto_rawDescGZIP(), []int{51}
+	return file_organizations_proto_rawDescGZIP(), []int{79}
 }
 
 func (x *InvitationCreated) GetInvitationId() string {
@@ -2671,7 +4358,7 @@ type Organization_Metadata struct {
 
 func (x *Organization_Metadata) Reset() {
 	*x = Organization_Metadata{}
-	mi := &file_organizations_proto_msgTypes[52]
+	mi := &file_organizations_proto_msgTypes[80]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2683,7 +4370,7 @@ func (x *Organization_Metadata) String() string {
 func (*Organization_Metadata) ProtoMessage() {}
 
 func (x *Organization_Metadata) ProtoReflect() protoreflect.Message {
-	mi := &file_organizations_proto_msgTypes[52]
+	mi := &file_organizations_proto_msgTypes[80]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2746,7 +4433,7 @@ type Integration_Metadata struct {
 
 func (x *Integration_Metadata) Reset() {
 	*x = Integration_Metadata{}
-	mi := &file_organizations_proto_msgTypes[54]
+	mi := &file_organizations_proto_msgTypes[82]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2758,7 +4445,7 @@ func (x *Integration_Metadata) String() string {
 func (*Integration_Metadata) ProtoMessage() {}
 
 func (x *Integration_Metadata) ProtoReflect() protoreflect.Message {
-	mi := &file_organizations_proto_msgTypes[54]
+	mi := &file_organizations_proto_msgTypes[82]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2771,7 +4458,7 @@ func (x *Integration_Metadata) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Integration_Metadata.ProtoReflect.Descriptor instead.
 func (*Integration_Metadata) Descriptor() ([]byte, []int) {
-	return file_organizations_proto_rawDescGZIP(), []int{46, 0}
+	return file_organizations_proto_rawDescGZIP(), []int{74, 0}
 }
 
 func (x *Integration_Metadata) GetId() string {
@@ -2812,7 +4499,7 @@ type Integration_Spec struct {
 
 func (x *Integration_Spec) Reset() {
 	*x = Integration_Spec{}
-	mi := &file_organizations_proto_msgTypes[55]
+	mi := &file_organizations_proto_msgTypes[83]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2824,7 +4511,7 @@ func (x *Integration_Spec) String() string {
 func (*Integration_Spec) ProtoMessage() {}
 
 func (x *Integration_Spec) ProtoReflect() protoreflect.Message {
-	mi := &file_organizations_proto_msgTypes[55]
+	mi := &file_organizations_proto_msgTypes[83]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2837,7 +4524,7 @@ func (x *Integration_Spec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Integration_Spec.ProtoReflect.Descriptor instead.
 func (*Integration_Spec) Descriptor() ([]byte, []int) {
-	return file_organizations_proto_rawDescGZIP(), []int{46, 1}
+	return file_organizations_proto_rawDescGZIP(), []int{74, 1}
 }
 
 func (x *Integration_Spec) GetIntegrationName() string {
@@ -2867,7 +4554,7 @@ type Integration_Status struct {
 
 func (x *Integration_Status) Reset() {
 	*x = Integration_Status{}
-	mi := &file_organizations_proto_msgTypes[56]
+	mi := &file_organizations_proto_msgTypes[84]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2879,7 +4566,7 @@ func (x *Integration_Status) String() string {
 func (*Integration_Status) ProtoMessage() {}
 
 func (x *Integration_Status) ProtoReflect() protoreflect.Message {
-	mi := &file_organizations_proto_msgTypes[56]
+	mi := &file_organizations_proto_msgTypes[84]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2892,7 +4579,7 @@ func (x *Integration_Status) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Integration_Status.ProtoReflect.Descriptor instead.
 func (*Integration_Status) Descriptor() ([]byte, []int) {
-	return file_organizations_proto_rawDescGZIP(), []int{46, 2}
+	return file_organizations_proto_rawDescGZIP(), []int{74, 2}
 }
 
 func (x *Integration_Status) GetState() string {
@@ -2942,7 +4629,7 @@ type Integration_NodeRef struct {
 
 func (x *Integration_NodeRef) Reset() {
 	*x = Integration_NodeRef{}
-	mi := &file_organizations_proto_msgTypes[57]
+	mi := &file_organizations_proto_msgTypes[85]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2954,7 +4641,7 @@ func (x *Integration_NodeRef) String() string {
 func (*Integration_NodeRef) ProtoMessage() {}
 
 func (x *Integration_NodeRef) ProtoReflect() protoreflect.Message {
-	mi := &file_organizations_proto_msgTypes[57]
+	mi := &file_organizations_proto_msgTypes[85]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2967,7 +4654,7 @@ func (x *Integration_NodeRef) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Integration_NodeRef.ProtoReflect.Descriptor instead.
 func (*Integration_NodeRef) Descriptor() ([]byte, []int) {
-	return file_organizations_proto_rawDescGZIP(), []int{46, 3}
+	return file_organizations_proto_rawDescGZIP(), []int{74, 3}
 }
 
 func (x *Integration_NodeRef) GetCanvasId() string {
@@ -3060,7 +4747,121 @@ const file_organizations_proto_rawDesc = "" +
 	"\x12agent_mode_enabled\x18\x02 \x01(\bR\x10agentModeEnabled\x120\n" +
 	"\x14agent_mode_effective\x18\x03 \x01(\bR\x12agentModeEffective\x12G\n" +
 	"\n" +
-	"openai_key\x18\x04 \x01(\v2(.Superplane.Organizations.AgentOpenAIKeyR\topenaiKey\"?\n" +
+	"openai_key\x18\x04 \x01(\v2(.Superplane.Organizations.AgentOpenAIKeyR\topenaiKey\"X\n" +
+	"\x0fSSOGroupMapping\x12\x1b\n" +
+	"\tidp_group\x18\x01 \x01(\tR\bidpGroup\x12\x14\n" +
+	"\x05group\x18\x02 \x01(\tR\x05group\x12\x12\n" +
+	"\x04role\x18\x03 \x01(\tR\x04role\"\xc2\x01\n" +
+	"\x0fSSOSAMLSettings\x12(\n" +
+	"\x10idp_metadata_url\x18\x01 \x01(\tR\x0eidpMetadataUrl\x12!\n" +
+	"\fidp_metadata\x18\x02 \x01(\tR\vidpMetadata\x12\"\n" +
+	"\ridp_entity_id\x18\x03 \x01(\tR\vidpEntityId\x12 \n" +
+	"\fsp_entity_id\x18\x04 \x01(\tR\n" +
+	"spEntityId\x12\x1c\n" +
+	"\n" +
+	"sp_acs_url\x18\x05 \x01(\tR\bspAcsUrl\"\xcf\x01\n" +
+	"\x0fSSOOIDCSettings\x12\x1d\n" +
+	"\n" +
+	"issuer_url\x18\x01 \x01(\tR\tissuerUrl\x12\x1b\n" +
+	"\tclient_id\x18\x02 \x01(\tR\bclientId\x12#\n" +
+	"\rclient_secret\x18\x03 \x01(\tR\fclientSecret\x128\n" +
+	"\x18client_secret_configured\x18\x04 \x01(\bR\x16clientSecretConfigured\x12!\n" +
+	"\fredirect_url\x18\x05 \x01(\tR\vredirectUrl\"\x97\x05\n" +
+	"\tSSOConfig\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12A\n" +
+	"\bprotocol\x18\x02 \x01(\x0e2%.Superplane.Organizations.SSOProtocolR\bprotocol\x12\x18\n" +
+	"\aenabled\x18\x03 \x01(\bR\aenabled\x12\x1f\n" +
+	"\venforce_sso\x18\x04 \x01(\bR\n" +
+	"enforceSso\x12=\n" +
+	"\x04saml\x18\x05 \x01(\v2).Superplane.Organizations.SSOSAMLSettingsR\x04saml\x12=\n" +
+	"\x04oidc\x18\x06 \x01(\v2).Superplane.Organizations.SSOOIDCSettingsR\x04oidc\x12'\n" +
+	"\x0femail_attribute\x18\a \x01(\tR\x0eemailAttribute\x12%\n" +
+	"\x0ename_attribute\x18\b \x01(\tR\rnameAttribute\x12)\n" +
+	"\x10groups_attribute\x18\t \x01(\tR\x0fgroupsAttribute\x12!\n" +
+	"\fdefault_role\x18\n" +
+	" \x01(\tR\vdefaultRole\x12P\n" +
+	"\x0egroup_mappings\x18\v \x03(\v2).Superplane.Organizations.SSOGroupMappingR\rgroupMappings\x12\x1b\n" +
+	"\tlogin_url\x18\f \x01(\tR\bloginUrl\x129\n" +
+	"\n" +
+	"updated_at\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_by\x18\x0e \x01(\tR\tupdatedBy\"%\n" +
+	"\x13GetSSOConfigRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"Z\n" +
+	"\x14GetSSOConfigResponse\x12B\n" +
+	"\n" +
+	"sso_config\x18\x01 \x01(\v2#.Superplane.Organizations.SSOConfigR\tssoConfig\"l\n" +
+	"\x16UpdateSSOConfigRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12B\n" +
+	"\n" +
+	"sso_config\x18\x02 \x01(\v2#.Superplane.Organizations.SSOConfigR\tssoConfig\"]\n" +
+	"\x17UpdateSSOConfigResponse\x12B\n" +
+	"\n" +
+	"sso_config\x18\x01 \x01(\v2#.Superplane.Organizations.SSOConfigR\tssoConfig\"(\n" +
+	"\x16DeleteSSOConfigRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\x19\n" +
+	"\x17DeleteSSOConfigResponse\"\x8a\x02\n" +
+	"\n" +
+	"SCIMConfig\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12\x19\n" +
+	"\bbase_url\x18\x02 \x01(\tR\abaseUrl\x12)\n" +
+	"\x10token_configured\x18\x03 \x01(\bR\x0ftokenConfigured\x12D\n" +
+	"\x10token_created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x0etokenCreatedAt\x12G\n" +
+	"\x12token_last_used_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\x0ftokenLastUsedAt\"&\n" +
+	"\x14GetSCIMConfigRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"^\n" +
+	"\x15GetSCIMConfigResponse\x12E\n" +
+	"\vscim_config\x18\x01 \x01(\v2$.Superplane.Organizations.SCIMConfigR\n" +
+	"scimConfig\"'\n" +
+	"\x15ResetSCIMTokenRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"u\n" +
+	"\x16ResetSCIMTokenResponse\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x12E\n" +
+	"\vscim_config\x18\x02 \x01(\v2$.Superplane.Organizations.SCIMConfigR\n" +
+	"scimConfig\"(\n" +
+	"\x16DeleteSCIMTokenRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\x19\n" +
+	"\x17DeleteSCIMTokenResponse\"\x81\x01\n" +
+	"\x0fTwoFactorMember\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x14\n" +
+	"\x05email\x18\x02 \x01(\tR\x05email\x12%\n" +
+	"\x0epassword_login\x18\x03 \x01(\bR\rpasswordLogin\x12\x18\n" +
+	"\aenabled\x18\x04 \x01(\bR\aenabled\"\x9b\x01\n" +
+	"\x0fTwoFactorPolicy\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12\x1a\n" +
+	"\brequired\x18\x02 \x01(\bR\brequired\x12C\n" +
+	"\amembers\x18\x03 \x03(\v2).Superplane.Organizations.TwoFactorMemberR\amembers\"+\n" +
+	"\x19GetTwoFactorPolicyRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"_\n" +
+	"\x1aGetTwoFactorPolicyResponse\x12A\n" +
+	"\x06policy\x18\x01 \x01(\v2).Superplane.Organizations.TwoFactorPolicyR\x06policy\"J\n" +
+	"\x1cUpdateTwoFactorPolicyRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1a\n" +
+	"\brequired\x18\x02 \x01(\bR\brequired\"b\n" +
+	"\x1dUpdateTwoFactorPolicyResponse\x12A\n" +
+	"\x06policy\x18\x01 \x01(\v2).Superplane.Organizations.TwoFactorPolicyR\x06policy\"D\n" +
+	"\x19ResetUserTwoFactorRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\"\x1c\n" +
+	"\x1aResetUserTwoFactorResponse\"\x84\x02\n" +
+	"\x0eTwoFactorEvent\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12@\n" +
+	"\x04type\x18\x02 \x01(\x0e2,.Superplane.Organizations.TwoFactorEventTypeR\x04type\x12#\n" +
+	"\raccount_email\x18\x03 \x01(\tR\faccountEmail\x12\x1f\n" +
+	"\vactor_email\x18\x04 \x01(\tR\n" +
+	"actorEmail\x12\x1f\n" +
+	"\vremote_addr\x18\x05 \x01(\tR\n" +
+	"remoteAddr\x129\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"v\n" +
+	"\x1aListTwoFactorEventsRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\rR\x05limit\x122\n" +
+	"\x06before\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x06before\"\xc6\x01\n" +
+	"\x1bListTwoFactorEventsResponse\x12@\n" +
+	"\x06events\x18\x01 \x03(\v2(.Superplane.Organizations.TwoFactorEventR\x06events\x12\"\n" +
+	"\rhas_next_page\x18\x02 \x01(\bR\vhasNextPage\x12A\n" +
+	"\x0elast_timestamp\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\rlastTimestamp\"?\n" +
 	"\x17CreateInvitationRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
 	"\x05email\x18\x02 \x01(\tR\x05email\"`\n" +
@@ -3203,7 +5004,22 @@ const file_organizations_proto_rawDesc = "" +
 	"\ttimestamp\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp\"r\n" +
 	"\x11InvitationCreated\x12#\n" +
 	"\rinvitation_id\x18\x01 \x01(\tR\finvitationId\x128\n" +
-	"\ttimestamp\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp2\x96/\n" +
+	"\ttimestamp\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp*Y\n" +
+	"\vSSOProtocol\x12\x1c\n" +
+	"\x18SSO_PROTOCOL_UNSPECIFIED\x10\x00\x12\x15\n" +
+	"\x11SSO_PROTOCOL_SAML\x10\x01\x12\x15\n" +
+	"\x11SSO_PROTOCOL_OIDC\x10\x02*\xb7\x03\n" +
+	"\x12TwoFactorEventType\x12%\n" +
+	"!TWO_FACTOR_EVENT_TYPE_UNSPECIFIED\x10\x00\x12\"\n" +
+	"\x1eTWO_FACTOR_EVENT_TYPE_ENROLLED\x10\x01\x12\"\n" +
+	"\x1eTWO_FACTOR_EVENT_TYPE_DISABLED\x10\x02\x124\n" +
+	"0TWO_FACTOR_EVENT_TYPE_RECOVERY_CODES_REGENERATED\x10\x03\x12)\n" +
+	"%TWO_FACTOR_EVENT_TYPE_LOGIN_SUCCEEDED\x10\x04\x12-\n" +
+	")TWO_FACTOR_EVENT_TYPE_VERIFICATION_FAILED\x10\x05\x12,\n" +
+	"(TWO_FACTOR_EVENT_TYPE_RECOVERY_CODE_USED\x10\x06\x12\x1f\n" +
+	"\x1bTWO_FACTOR_EVENT_TYPE_RESET\x10\a\x12(\n" +
+	"$TWO_FACTOR_EVENT_TYPE_POLICY_ENABLED\x10\b\x12)\n" +
+	"%TWO_FACTOR_EVENT_TYPE_POLICY_DISABLED\x10\t2\xc1H\n" +
 	"\rOrganizations\x12\xa7\x02\n" +
 	"\x14DescribeOrganization\x125.Superplane.Organizations.DescribeOrganizationRequest\x1a6.Superplane.Organizations.DescribeOrganizationResponse\"\x9f\x01\x92Az\n" +
 	"\fOrganization\x12\x18Get organization details\x1aPReturns the details of a specific organization (can be referenced by ID or name)\x82\xd3\xe4\x93\x02\x1c\x12\x1a/api/v1/organizations/{id}\x12\x96\x02\n" +
@@ -3233,7 +5049,27 @@ const file_organizations_proto_rawDesc = "" +
 	"\x11SetAgentOpenAIKey\x122.Superplane.Organizations.SetAgentOpenAIKeyRequest\x1a3.Superplane.Organizations.SetAgentOpenAIKeyResponse\"\xbe\x01\x92A|\n" +
 	"\fOrganization\x127Create or update organization OpenAI key for Agent Mode\x1a3Sets the OpenAI key used by organization Agent Mode\x82\xd3\xe4\x93\x029:\x01*\x1a4/api/v1/organizations/{id}/agent-settings/openai-key\x12\xbc\x02\n" +
 	"\x14DeleteAgentOpenAIKey\x125.Superplane.Organizations.DeleteAgentOpenAIKeyRequest\x1a6.Superplane.Organizations.DeleteAgentOpenAIKeyResponse\"\xb4\x01\x92Au\n" +
-	"\fOrganization\x12-Delete organization OpenAI key for Agent Mode\x1a6Deletes the OpenAI key used by organization Agent Mode\x82\xd3\xe4\x93\x026*4/api/v1/organizations/{id}/agent-settings/openai-key\x12\xea\x01\n" +
+	"\fOrganization\x12-Delete organization OpenAI key for Agent Mode\x1a6Deletes the OpenAI key used by organization Agent Mode\x82\xd3\xe4\x93\x026*4/api/v1/organizations/{id}/agent-settings/openai-key\x12\xa2\x02\n" +
+	"\fGetSSOConfig\x12-.Superplane.Organizations.GetSSOConfigRequest\x1a..Superplane.Organizations.GetSSOConfigResponse\"\xb2\x01\x92A\x88\x01\n" +
+	"\fOrganization\x12-Get organization single sign-on configuration\x1aIReturns the SAML or OIDC single sign-on configuration for an organization\x82\xd3\xe4\x93\x02 \x12\x1e/api/v1/organizations/{id}/sso\x12\xd4\x02\n" +
+	"\x0fUpdateSSOConfig\x120.Superplane.Organizations.UpdateSSOConfigRequest\x1a1.Superplane.Organizations.UpdateSSOConfigResponse\"\xdb\x01\x92A\xae\x01\n" +
+	"\fOrganization\x12:Create or update organization single sign-on configuration\x1abConfigures SAML 2.0 or OIDC single sign-on, group mappings and SSO enforcement for an organization\x82\xd3\xe4\x93\x02#:\x01*\x1a\x1e/api/v1/organizations/{id}/sso\x12\xb1\x02\n" +
+	"\x0fDeleteSSOConfig\x120.Superplane.Organizations.DeleteSSOConfigRequest\x1a1.Superplane.Organizations.DeleteSSOConfigResponse\"\xb8\x01\x92A\x8e\x01\n" +
+	"\fOrganization\x120Delete organization single sign-on configuration\x1aLRemoves single sign-on from an organization, re-enabling other login methods\x82\xd3\xe4\x93\x02 *\x1e/api/v1/organizations/{id}/sso\x12\xb2\x02\n" +
+	"\rGetSCIMConfig\x12..Superplane.Organizations.GetSCIMConfigRequest\x1a/.Superplane.Organizations.GetSCIMConfigResponse\"\xbf\x01\x92A\x94\x01\n" +
+	"\fOrganization\x120Get organization SCIM provisioning configuration\x1aRReturns the SCIM 2.0 base URL and the status of the SCIM token for an organization\x82\xd3\xe4\x93\x02!\x12\x1f/api/v1/organizations/{id}/scim\x12\x9f\x02\n" +
+	"\x0eResetSCIMToken\x12/.Superplane.Organizations.ResetSCIMTokenRequest\x1a0.Superplane.Organizations.ResetSCIMTokenResponse\"\xa9\x01\x92Ay\n" +
+	"\fOrganization\x12\x1dReset organization SCIM token\x1aJGenerates a new SCIM token for an organization, replacing the existing one\x82\xd3\xe4\x93\x02'\"%/api/v1/organizations/{id}/scim/token\x12\xa0\x02\n" +
+	"\x0fDeleteSCIMToken\x120.Superplane.Organizations.DeleteSCIMTokenRequest\x1a1.Superplane.Organizations.DeleteSCIMTokenResponse\"\xa7\x01\x92Aw\n" +
+	"\fOrganization\x12\x1eDelete organization SCIM token\x1aGDeletes the SCIM token for an organization, disabling SCIM provisioning\x82\xd3\xe4\x93\x02'*%/api/v1/organizations/{id}/scim/token\x12\xe3\x02\n" +
+	"\x12GetTwoFactorPolicy\x123.Superplane.Organizations.GetTwoFactorPolicyRequest\x1a4.Superplane.Organizations.GetTwoFactorPolicyResponse\"\xe1\x01\x92A\xb0\x01\n" +
+	"\fOrganization\x121Get organization two-factor authentication policy\x1amReturns whether the organization requires two-factor authentication, and the two-factor status of its members\x82\xd3\xe4\x93\x02'\x12%/api/v1/organizations/{id}/two-factor\x12\xe4\x02\n" +
+	"\x15UpdateTwoFactorPolicy\x126.Superplane.Organizations.UpdateTwoFactorPolicyRequest\x1a7.Superplane.Organizations.UpdateTwoFactorPolicyResponse\"\xd9\x01\x92A\xa5\x01\n" +
+	"\fOrganization\x124Update organization two-factor authentication policy\x1a_Requires, or stops requiring, two-factor authentication for members who sign in with a password\x82\xd3\xe4\x93\x02*:\x01*\x1a%/api/v1/organizations/{id}/two-factor\x12\xde\x02\n" +
+	"\x12ResetUserTwoFactor\x123.Superplane.Organizations.ResetUserTwoFactorRequest\x1a4.Superplane.Organizations.ResetUserTwoFactorResponse\"\xdc\x01\x92A\x95\x01\n" +
+	"\fOrganization\x12*Reset a member's two-factor authentication\x1aYRemoves the two-factor authentication of an organization member, so they can enroll again\x82\xd3\xe4\x93\x02=\";/api/v1/organizations/{id}/users/{user_id}/two-factor/reset\x12\xd0\x02\n" +
+	"\x13ListTwoFactorEvents\x124.Superplane.Organizations.ListTwoFactorEventsRequest\x1a5.Superplane.Organizations.ListTwoFactorEventsResponse\"\xcb\x01\x92A\x93\x01\n" +
+	"\fOrganization\x12%List two-factor authentication events\x1a\\Returns the two-factor authentication audit events of the organization members, newest first\x82\xd3\xe4\x93\x02.\x12,/api/v1/organizations/{id}/two-factor/events\x12\xea\x01\n" +
 	"\x10AcceptInviteLink\x12$.Superplane.Organizations.InviteLink\x1a\x17.google.protobuf.Struct\"\x96\x01\x92Ah\n" +
 	"\fOrganization\x12\x15Accept an invite link\x1aAAccepts an organization invite link for the authenticated account\x82\xd3\xe4\x93\x02%\"#/api/v1/invite-links/{token}/accept\x12\x95\x02\n" +
 	"\x10ListIntegrations\x121.Superplane.Organizations.ListIntegrationsRequest\x1a2.Superplane.Organizations.ListIntegrationsResponse\"\x99\x01\x92Ag\n" +
@@ -3263,161 +5099,232 @@ func file_organizations_proto_rawDescGZIP() []byte {
 	return file_organizations_proto_rawDescData
 }
 
-var file_organizations_proto_msgTypes = make([]protoimpl.MessageInfo, 59)
+var file_organizations_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_organizations_proto_msgTypes = make([]protoimpl.MessageInfo, 87)
 var file_organizations_proto_goTypes = []any{
-	(*Organization)(nil),                     // 0: Superplane.Organizations.Organization
-	(*DescribeOrganizationRequest)(nil),      // 1: Superplane.Organizations.DescribeOrganizationRequest
-	(*DescribeOrganizationResponse)(nil),     // 2: Superplane.Organizations.DescribeOrganizationResponse
-	(*UpdateOrganizationRequest)(nil),        // 3: Superplane.Organizations.UpdateOrganizationRequest
-	(*UpdateOrganizationResponse)(nil),       // 4: Superplane.Organizations.UpdateOrganizationResponse
-	(*DeleteOrganizationRequest)(nil),        // 5: Superplane.Organizations.DeleteOrganizationRequest
-	(*DeleteOrganizationResponse)(nil),       // 6: Superplane.Organizations.DeleteOrganizationResponse
-	(*Invitation)(nil),                       // 7: Superplane.Organizations.Invitation
-	(*InviteLink)(nil),                       // 8: Superplane.Organizations.InviteLink
-	(*AgentOpenAIKey)(nil),                   // 9: Superplane.Organizations.AgentOpenAIKey
-	(*AgentSettings)(nil),                    // 10: Superplane.Organizations.AgentSettings
-	(*CreateInvitationRequest)(nil),          // 11: Superplane.Organizations.CreateInvitationRequest
-	(*CreateInvitationResponse)(nil),         // 12: Superplane.Organizations.CreateInvitationResponse
-	(*ListInvitationsRequest)(nil),           // 13: Superplane.Organizations.ListInvitationsRequest
-	(*ListInvitationsResponse)(nil),          // 14: Superplane.Organizations.ListInvitationsResponse
-	(*RemoveInvitationRequest)(nil),          // 15: Superplane.Organizations.RemoveInvitationRequest
-	(*RemoveInvitationResponse)(nil),         // 16: Superplane.Organizations.RemoveInvitationResponse
-	(*GetInviteLinkRequest)(nil),             // 17: Superplane.Organizations.GetInviteLinkRequest
-	(*GetInviteLinkResponse)(nil),            // 18: Superplane.Organizations.GetInviteLinkResponse
-	(*UpdateInviteLinkRequest)(nil),          // 19: Superplane.Organizations.UpdateInviteLinkRequest
-	(*UpdateInviteLinkResponse)(nil),         // 20: Superplane.Organizations.UpdateInviteLinkResponse
-	(*ResetInviteLinkRequest)(nil),           // 21: Superplane.Organizations.ResetInviteLinkRequest
-	(*ResetInviteLinkResponse)(nil),          // 22: Superplane.Organizations.ResetInviteLinkResponse
-	(*GetAgentSettingsRequest)(nil),          // 23: Superplane.Organizations.GetAgentSettingsRequest
-	(*GetAgentSettingsResponse)(nil),         // 24: Superplane.Organizations.GetAgentSettingsResponse
-	(*UpdateAgentSettingsRequest)(nil),       // 25: Superplane.Organizations.UpdateAgentSettingsRequest
-	(*UpdateAgentSettingsResponse)(nil),      // 26: Superplane.Organizations.UpdateAgentSettingsResponse
-	(*SetAgentOpenAIKeyRequest)(nil),         // 27: Superplane.Organizations.SetAgentOpenAIKeyRequest
-	(*SetAgentOpenAIKeyResponse)(nil),        // 28: Superplane.Organizations.SetAgentOpenAIKeyResponse
-	(*DeleteAgentOpenAIKeyRequest)(nil),      // 29: Superplane.Organizations.DeleteAgentOpenAIKeyRequest
-	(*DeleteAgentOpenAIKeyResponse)(nil),     // 30: Superplane.Organizations.DeleteAgentOpenAIKeyResponse
-	(*RemoveUserRequest)(nil),                // 31: Superplane.Organizations.RemoveUserRequest
-	(*RemoveUserResponse)(nil),               // 32: Superplane.Organizations.RemoveUserResponse
-	(*ListIntegrationsRequest)(nil),          // 33: Superplane.Organizations.ListIntegrationsRequest
-	(*ListIntegrationsResponse)(nil),         // 34: Superplane.Organizations.ListIntegrationsResponse
-	(*CreateIntegrationRequest)(nil),         // 35: Superplane.Organizations.CreateIntegrationRequest
-	(*CreateIntegrationResponse)(nil),        // 36: Superplane.Organizations.CreateIntegrationResponse
-	(*DescribeIntegrationRequest)(nil),       // 37: Superplane.Organizations.DescribeIntegrationRequest
-	(*DescribeIntegrationResponse)(nil),      // 38: Superplane.Organizations.DescribeIntegrationResponse
-	(*ListIntegrationResourcesRequest)(nil),  // 39: Superplane.Organizations.ListIntegrationResourcesRequest
-	(*ListIntegrationResourcesResponse)(nil), // 40: Superplane.Organizations.ListIntegrationResourcesResponse
-	(*IntegrationResourceRef)(nil),           // 41: Superplane.Organizations.IntegrationResourceRef
-	(*UpdateIntegrationRequest)(nil),         // 42: Superplane.Organizations.UpdateIntegrationRequest
-	(*UpdateIntegrationResponse)(nil),        // 43: Superplane.Organizations.UpdateIntegrationResponse
-	(*DeleteIntegrationRequest)(nil),         // 44: Superplane.Organizations.DeleteIntegrationRequest
-	(*DeleteIntegrationResponse)(nil),        // 45: Superplane.Organizations.DeleteIntegrationResponse
-	(*Integration)(nil),                      // 46: Superplane.Organizations.Integration
-	(*BrowserAction)(nil),                    // 47: Superplane.Organizations.BrowserAction
-	(*OrganizationCreated)(nil),              // 48: Superplane.Organizations.OrganizationCreated
-	(*OrganizationUpdated)(nil),              // 49: Superplane.Organizations.OrganizationUpdated
-	(*OrganizationDeleted)(nil),              // 50: Superplane.Organizations.OrganizationDeleted
-	(*InvitationCreated)(nil),                // 51: Superplane.Organizations.InvitationCreated
-	(*Organization_Metadata)(nil),            // 52: Superplane.Organizations.Organization.Metadata
-	nil,                                      // 53: Superplane.Organizations.ListIntegrationResourcesRequest.ParametersEntry
-	(*Integration_Metadata)(nil),             // 54: Superplane.Organizations.Integration.Metadata
-	(*Integration_Spec)(nil),                 // 55: Superplane.Organizations.Integration.Spec
-	(*Integration_Status)(nil),               // 56: Superplane.Organizations.Integration.Status
-	(*Integration_NodeRef)(nil),              // 57: Superplane.Organizations.Integration.NodeRef
-	nil,                                      // 58: Superplane.Organizations.BrowserAction.FormFieldsEntry
-	(*timestamp.Timestamp)(nil),              // 59: google.protobuf.Timestamp
-	(*_struct.Struct)(nil),                   // 60: google.protobuf.Struct
+	(SSOProtocol)(0),                         // 0: Superplane.Organizations.SSOProtocol
+	(TwoFactorEventType)(0),                  // 1: Superplane.Organizations.TwoFactorEventType
+	(*Organization)(nil),                     // 2: Superplane.Organizations.Organization
+	(*DescribeOrganizationRequest)(nil),      // 3: Superplane.Organizations.DescribeOrganizationRequest
+	(*DescribeOrganizationResponse)(nil),     // 4: Superplane.Organizations.DescribeOrganizationResponse
+	(*UpdateOrganizationRequest)(nil),        // 5: Superplane.Organizations.UpdateOrganizationRequest
+	(*UpdateOrganizationResponse)(nil),       // 6: Superplane.Organizations.UpdateOrganizationResponse
+	(*DeleteOrganizationRequest)(nil),        // 7: Superplane.Organizations.DeleteOrganizationRequest
+	(*DeleteOrganizationResponse)(nil),       // 8: Superplane.Organizations.DeleteOrganizationResponse
+	(*Invitation)(nil),                       // 9: Superplane.Organizations.Invitation
+	(*InviteLink)(nil),                       // 10: Superplane.Organizations.InviteLink
+	(*AgentOpenAIKey)(nil),                   // 11: Superplane.Organizations.AgentOpenAIKey
+	(*AgentSettings)(nil),                    // 12: Superplane.Organizations.AgentSettings
+	(*SSOGroupMapping)(nil),                  // 13: Superplane.Organizations.SSOGroupMapping
+	(*SSOSAMLSettings)(nil),                  // 14: Superplane.Organizations.SSOSAMLSettings
+	(*SSOOIDCSettings)(nil),                  // 15: Superplane.Organizations.SSOOIDCSettings
+	(*SSOConfig)(nil),                        // 16: Superplane.Organizations.SSOConfig
+	(*GetSSOConfigRequest)(nil),              // 17: Superplane.Organizations.GetSSOConfigRequest
+	(*GetSSOConfigResponse)(nil),             // 18: Superplane.Organizations.GetSSOConfigResponse
+	(*UpdateSSOConfigRequest)(nil),           // 19: Superplane.Organizations.UpdateSSOConfigRequest
+	(*UpdateSSOConfigResponse)(nil),          // 20: Superplane.Organizations.UpdateSSOConfigResponse
+	(*DeleteSSOConfigRequest)(nil),           // 21: Superplane.Organizations.DeleteSSOConfigRequest
+	(*DeleteSSOConfigResponse)(nil),          // 22: Superplane.Organizations.DeleteSSOConfigResponse
+	(*SCIMConfig)(nil),                       // 23: Superplane.Organizations.SCIMConfig
+	(*GetSCIMConfigRequest)(nil),             // 24: Superplane.Organizations.GetSCIMConfigRequest
+	(*GetSCIMConfigResponse)(nil),            // 25: Superplane.Organizations.GetSCIMConfigResponse
+	(*ResetSCIMTokenRequest)(nil),            // 26: Superplane.Organizations.ResetSCIMTokenRequest
+	(*ResetSCIMTokenResponse)(nil),           // 27: Superplane.Organizations.ResetSCIMTokenResponse
+	(*DeleteSCIMTokenRequest)(nil),           // 28: Superplane.Organizations.DeleteSCIMTokenRequest
+	(*DeleteSCIMTokenResponse)(nil),          // 29: Superplane.Organizations.DeleteSCIMTokenResponse
+	(*TwoFactorMember)(nil),                  // 30: Superplane.Organizations.TwoFactorMember
+	(*TwoFactorPolicy)(nil),                  // 31: Superplane.Organizations.TwoFactorPolicy
+	(*GetTwoFactorPolicyRequest)(nil),        // 32: Superplane.Organizations.GetTwoFactorPolicyRequest
+	(*GetTwoFactorPolicyResponse)(nil),       // 33: Superplane.Organizations.GetTwoFactorPolicyResponse
+	(*UpdateTwoFactorPolicyRequest)(nil),     // 34: Superplane.Organizations.UpdateTwoFactorPolicyRequest
+	(*UpdateTwoFactorPolicyResponse)(nil),    // 35: Superplane.Organizations.UpdateTwoFactorPolicyResponse
+	(*ResetUserTwoFactorRequest)(nil),        // 36: Superplane.Organizations.ResetUserTwoFactorRequest
+	(*ResetUserTwoFactorResponse)(nil),       // 37: Superplane.Organizations.ResetUserTwoFactorResponse
+	(*TwoFactorEvent)(nil),                   // 38: Superplane.Organizations.TwoFactorEvent
+	(*ListTwoFactorEventsRequest)(nil),       // 39: Superplane.Organizations.ListTwoFactorEventsRequest
+	(*ListTwoFactorEventsResponse)(nil),      // 40: Superplane.Organizations.ListTwoFactorEventsResponse
+	(*CreateInvitationRequest)(nil),          // 41: Superplane.Organizations.CreateInvitationRequest
+	(*CreateInvitationResponse)(nil),         // 42: Superplane.Organizations.CreateInvitationResponse
+	(*ListInvitationsRequest)(nil),           // 43: Superplane.Organizations.ListInvitationsRequest
+	(*ListInvitationsResponse)(nil),          // 44: Superplane.Organizations.ListInvitationsResponse
+	(*RemoveInvitationRequest)(nil),          // 45: Superplane.Organizations.RemoveInvitationRequest
+	(*RemoveInvitationResponse)(nil),         // 46: Superplane.Organizations.RemoveInvitationResponse
+	(*GetInviteLinkRequest)(nil),             // 47: Superplane.Organizations.GetInviteLinkRequest
+	(*GetInviteLinkResponse)(nil),            // 48: Superplane.Organizations.GetInviteLinkResponse
+	(*UpdateInviteLinkRequest)(nil),          // 49: Superplane.Organizations.UpdateInviteLinkRequest
+	(*UpdateInviteLinkResponse)(nil),         // 50: Superplane.Organizations.UpdateInviteLinkResponse
+	(*ResetInviteLinkRequest)(nil),           // 51: Superplane.Organizations.ResetInviteLinkRequest
+	(*ResetInviteLinkResponse)(nil),          // 52: Superplane.Organizations.ResetInviteLinkResponse
+	(*GetAgentSettingsRequest)(nil),          // 53: Superplane.Organizations.GetAgentSettingsRequest
+	(*GetAgentSettingsResponse)(nil),         // 54: Superplane.Organizations.GetAgentSettingsResponse
+	(*UpdateAgentSettingsRequest)(nil),       // 55: Superplane.Organizations.UpdateAgentSettingsRequest
+	(*UpdateAgentSettingsResponse)(nil),      // 56: Superplane.Organizations.UpdateAgentSettingsResponse
+	(*SetAgentOpenAIKeyRequest)(nil),         // 57: Superplane.Organizations.SetAgentOpenAIKeyRequest
+	(*SetAgentOpenAIKeyResponse)(nil),        // 58: Superplane.Organizations.SetAgentOpenAIKeyResponse
+	(*DeleteAgentOpenAIKeyRequest)(nil),      // 59: Superplane.Organizations.DeleteAgentOpenAIKeyRequest
+	(*DeleteAgentOpenAIKeyResponse)(nil),     // 60: Superplane.Organizations.DeleteAgentOpenAIKeyResponse
+	(*RemoveUserRequest)(nil),                // 61: Superplane.Organizations.RemoveUserRequest
+	(*RemoveUserResponse)(nil),               // 62: Superplane.Organizations.RemoveUserResponse
+	(*ListIntegrationsRequest)(nil),          // 63: Superplane.Organizations.ListIntegrationsRequest
+	(*ListIntegrationsResponse)(nil),         // 64: Superplane.Organizations.ListIntegrationsResponse
+	(*CreateIntegrationRequest)(nil),         // 65: Superplane.Organizations.CreateIntegrationRequest
+	(*CreateIntegrationResponse)(nil),        // 66: Superplane.Organizations.CreateIntegrationResponse
+	(*DescribeIntegrationRequest)(nil),       // 67: Superplane.Organizations.DescribeIntegrationRequest
+	(*DescribeIntegrationResponse)(nil),      // 68: Superplane.Organizations.DescribeIntegrationResponse
+	(*ListIntegrationResourcesRequest)(nil),  // 69: Superplane.Organizations.ListIntegrationResourcesRequest
+	(*ListIntegrationResourcesResponse)(nil), // 70: Superplane.Organizations.ListIntegrationResourcesResponse
+	(*IntegrationResourceRef)(nil),           // 71: Superplane.Organizations.IntegrationResourceRef
+	(*UpdateIntegrationRequest)(nil),         // 72: Superplane.Organizations.UpdateIntegrationRequest
+	(*UpdateIntegrationResponse)(nil),        // 73: Superplane.Organizations.UpdateIntegrationResponse
+	(*DeleteIntegrationRequest)(nil),         // 74: Superplane.Organizations.DeleteIntegrationRequest
+	(*DeleteIntegrationResponse)(nil),        // 75: Superplane.Organizations.DeleteIntegrationResponse
+	(*Integration)(nil),                      // 76: Superplane.Organizations.Integration
+	(*BrowserAction)(nil),                    // 77: Superplane.Organizations.BrowserAction
+	(*OrganizationCreated)(nil),              // 78: Superplane.Organizations.OrganizationCreated
+	(*OrganizationUpdated)(nil),              // 79: Superplane.Organizations.OrganizationUpdated
+	(*OrganizationDeleted)(nil),              // 80: Superplane.Organizations.OrganizationDeleted
+	(*InvitationCreated)(nil),                // 81: Superplane.Organizations.InvitationCreated
+	(*Organization_Metadata)(nil),            // 82: Superplane.Organizations.Organization.Metadata
+	nil,                                      // 83: Superplane.Organizations.ListIntegrationResourcesRequest.ParametersEntry
+	(*Integration_Metadata)(nil),             // 84: Superplane.Organizations.Integration.Metadata
+	(*Integration_Spec)(nil),                 // 85: Superplane.Organizations.Integration.Spec
+	(*Integration_Status)(nil),               // 86: Superplane.Organizations.Integration.Status
+	(*Integration_NodeRef)(nil),              // 87: Superplane.Organizations.Integration.NodeRef
+	nil,                                      // 88: Superplane.Organizations.BrowserAction.FormFieldsEntry
+	(*timestamp.Timestamp)(nil),              // 89: google.protobuf.Timestamp
+	(*_struct.Struct)(nil),                   // 90: google.protobuf.Struct
 }
 var file_organizations_proto_depIdxs = []int32{
-	52, // 0: Superplane.Organizations.Organization.metadata:type_name -> Superplane.Organizations.Organization.Metadata
-	0,  // 1: Superplane.Organizations.DescribeOrganizationResponse.organization:type_name -> Superplane.Organizations.Organization
-	0,  // 2: Superplane.Organizations.UpdateOrganizationRequest.organization:type_name -> Superplane.Organizations.Organization
-	0,  // 3: Superplane.Organizations.UpdateOrganizationResponse.organization:type_name -> Superplane.Organizations.Organization
-	59, // 4: Superplane.Organizations.Invitation.created_at:type_name -> google.protobuf.Timestamp
-	59, // 5: Superplane.Organizations.InviteLink.created_at:type_name -> google.protobuf.Timestamp
-	59, // 6: Superplane.Organizations.InviteLink.updated_at:type_name -> google.protobuf.Timestamp
-	59, // 7: Superplane.Organizations.AgentOpenAIKey.validated_at:type_name -> google.protobuf.Timestamp
-	59, // 8: Superplane.Organizations.AgentOpenAIKey.updated_at:type_name -> google.protobuf.Timestamp
-	9,  // 9: Superplane.Organizations.AgentSettings.openai_key:type_name -> Superplane.Organizations.AgentOpenAIKey
-	7,  // 10: Superplane.Organizations.CreateInvitationResponse.invitation:type_name -> Superplane.Organizations.Invitation
-	7,  // 11: Superplane.Organizations.ListInvitationsResponse.invitations:type_name -> Superplane.Organizations.Invitation
-	8,  // 12: Superplane.Organizations.GetInviteLinkResponse.invite_link:type_name -> Superplane.Organizations.InviteLink
-	8,  // 13: Superplane.Organizations.UpdateInviteLinkResponse.invite_link:type_name -> Superplane.Organizations.InviteLink
-	8,  // 14: Superplane.Organizations.ResetInviteLinkResponse.invite_link:type_name -> Superplane.Organizations.InviteLink
-	10, // 15: Superplane.Organizations.GetAgentSettingsResponse.agent_settings:type_name -> Superplane.Organizations.AgentSettings
-	10, // 16: Superplane.Organizations.UpdateAgentSettingsResponse.agent_settings:type_name -> Superplane.Organizations.AgentSettings
-	10, // 17: Superplane.Organizations.SetAgentOpenAIKeyResponse.agent_settings:type_name -> Superplane.Organizations.AgentSettings
-	10, // 18: Superplane.Organizations.DeleteAgentOpenAIKeyResponse.agent_settings:type_name -> Superplane.Organizations.AgentSettings
-	46, // 19: Superplane.Organizations.ListIntegrationsResponse.integrations:type_name -> Superplane.Organizations.Integration
-	60, // 20: Superplane.Organizations.CreateIntegrationRequest.configuration:type_name -> google.protobuf.Struct
-	46, // 21: Superplane.Organizations.CreateIntegrationResponse.integration:type_name -> Superplane.Organizations.Integration
-	46, // 22: Superplane.Organizations.DescribeIntegrationResponse.integration:type_name -> Superplane.Organizations.Integration
-	53, // 23: Superplane.Organizations.ListIntegrationResourcesRequest.parameters:type_name -> Superplane.Organizations.ListIntegrationResourcesRequest.ParametersEntry
-	41, // 24: Superplane.Organizations.ListIntegrationResourcesResponse.resources:type_name -> Superplane.Organizations.IntegrationResourceRef
-	60, // 25: Superplane.Organizations.UpdateIntegrationRequest.configuration:type_name -> google.protobuf.Struct
-	46, // 26: Superplane.Organizations.UpdateIntegrationResponse.integration:type_name -> Superplane.Organizations.Integration
-	54, // 27: Superplane.Organizations.Integration.metadata:type_name -> Superplane.Organizations.Integration.Metadata
-	55, // 28: Superplane.Organizations.Integration.spec:type_name -> Superplane.Organizations.Integration.Spec
-	56, // 29: Superplane.Organizations.Integration.status:type_name -> Superplane.Organizations.Integration.Status
-	58, // 30: Superplane.Organizations.BrowserAction.form_fields:type_name -> Superplane.Organizations.BrowserAction.FormFieldsEntry
-	59, // 31: Superplane.Organizations.OrganizationCreated.timestamp:type_name -> google.protobuf.Timestamp
-	59, // 32: Superplane.Organizations.OrganizationUpdated.timestamp:type_name -> google.protobuf.Timestamp
-	59, // 33: Superplane.Organizations.OrganizationDeleted.timestamp:type_name -> google.protobuf.Timestamp
-	59, // 34: Superplane.Organizations.InvitationCreated.timestamp:type_name -> google.protobuf.Timestamp
-	59, // 35: Superplane.Organizations.Organization.Metadata.created_at:type_name -> google.protobuf.Timestamp
-	59, // 36: Superplane.Organizations.Organization.Metadata.updated_at:type_name -> google.protobuf.Timestamp
-	59, // 37: Superplane.Organizations.Integration.Metadata.created_at:type_name -> google.protobuf.Timestamp
-	59, // 38: Superplane.Organizations.Integration.Metadata.updated_at:type_name -> google.protobuf.Timestamp
-	60, // 39: Superplane.Organizations.Integration.Spec.configuration:type_name -> google.protobuf.Struct
-	60, // 40: Superplane.Organizations.Integration.Status.metadata:type_name -> google.protobuf.Struct
-	47, // 41: Superplane.Organizations.Integration.Status.browser_action:type_name -> Superplane.Organizations.BrowserAction
-	57, // 42: Superplane.Organizations.Integration.Status.used_in:type_name -> Superplane.Organizations.Integration.NodeRef
-	1,  // 43: Superplane.Organizations.Organizations.DescribeOrganization:input_type -> Superplane.Organizations.DescribeOrganizationRequest
-	3,  // 44: Superplane.Organizations.Organizations.UpdateOrganization:input_type -> Superplane.Organizations.UpdateOrganizationRequest
-	5,  // 45: Superplane.Organizations.Organizations.DeleteOrganization:input_type -> Superplane.Organizations.DeleteOrganizationRequest
-	31, // 46: Superplane.Organizations.Organizations.RemoveUser:input_type -> Superplane.Organizations.RemoveUserRequest
-	11, // 47: Superplane.Organizations.Organizations.CreateInvitation:input_type -> Superplane.Organizations.CreateInvitationRequest
-	13, // 48: Superplane.Organizations.Organizations.ListInvitations:input_type -> Superplane.Organizations.ListInvitationsRequest
-	15, // 49: Superplane.Organizations.Organizations.RemoveInvitation:input_type -> Superplane.Organizations.RemoveInvitationRequest
-	17, // 50: Superplane.Organizations.Organizations.GetInviteLink:input_type -> Superplane.Organizations.GetInviteLinkRequest
-	19, // 51: Superplane.Organizations.Organizations.UpdateInviteLink:input_type -> Superplane.Organizations.UpdateInviteLinkRequest
-	21, // 52: Superplane.Organizations.Organizations.ResetInviteLink:input_type -> Superplane.Organizations.ResetInviteLinkRequest
-	23, // 53: Superplane.Organizations.Organizations.GetAgentSettings:input_type -> Superplane.Organizations.GetAgentSettingsRequest
-	25, // 54: Superplane.Organizations.Organizations.UpdateAgentSettings:input_type -> Superplane.Organizations.UpdateAgentSettingsRequest
-	27, // 55: Superplane.Organizations.Organizations.SetAgentOpenAIKey:input_type -> Superplane.Organizations.SetAgentOpenAIKeyRequest
-	29, // 56: Superplane.Organizations.Organizations.DeleteAgentOpenAIKey:input_type -> Superplane.Organizations.DeleteAgentOpenAIKeyRequest
-	8,  // 57: Superplane.Organizations.Organizations.AcceptInviteLink:input_type -> Superplane.Organizations.InviteLink
-	33, // 58: Superplane.Organizations.Organizations.ListIntegrations:input_type -> Superplane.Organizations.ListIntegrationsRequest
-	37, // 59: Superplane.Organizations.Organizations.DescribeIntegration:input_type -> Superplane.Organizations.DescribeIntegrationRequest
-	39, // 60: Superplane.Organizations.Organizations.ListIntegrationResources:input_type -> Superplane.Organizations.ListIntegrationResourcesRequest
-	35, // 61: Superplane.Organizations.Organizations.CreateIntegration:input_type -> Superplane.Organizations.CreateIntegrationRequest
-	42, // 62: Superplane.Organizations.Organizations.UpdateIntegration:input_type -> Superplane.Organizations.UpdateIntegrationRequest
-	44, // 63: Superplane.Organizations.Organizations.DeleteIntegration:input_type -> Superplane.Organizations.DeleteIntegrationRequest
-	2,  // 64: Superplane.Organizations.Organizations.DescribeOrganization:output_type -> Superplane.Organizations.DescribeOrganizationResponse
-	4,  // 65: Superplane.Organizations.Organizations.UpdateOrganization:output_type -> Superplane.Organizations.UpdateOrganizationResponse
-	6,  // 66: Superplane.Organizations.Organizations.DeleteOrganization:output_type -> Superplane.Organizations.DeleteOrganizationResponse
-	32, // 67: Superplane.Organizations.Organizations.RemoveUser:output_type -> Superplane.Organizations.RemoveUserResponse
-	12, // 68: Superplane.Organizations.Organizations.CreateInvitation:output_type -> Superplane.Organizations.CreateInvitationResponse
-	14, // 69: Superplane.Organizations.Organizations.ListInvitations:output_type -> Superplane.Organizations.ListInvitationsResponse
-	16, // 70: Superplane.Organizations.Organizations.RemoveInvitation:output_type -> Superplane.Organizations.RemoveInvitationResponse
-	18, // 71: Superplane.Organizations.Organizations.GetInviteLink:output_type -> Superplane.Organizations.GetInviteLinkResponse
-	20, // 72: Superplane.Organizations.Organizations.UpdateInviteLink:output_type -> Superplane.Organizations.UpdateInviteLinkResponse
-	22, // 73: Superplane.Organizations.Organizations.ResetInviteLink:output_type -> Superplane.Organizations.ResetInviteLinkResponse
-	24, // 74: Superplane.Organizations.Organizations.GetAgentSettings:output_type -> Superplane.Organizations.GetAgentSettingsResponse
-	26, // 75: Superplane.Organizations.Organizations.UpdateAgentSettings:output_type -> Superplane.Organizations.UpdateAgentSettingsResponse
-	28, // 76: Superplane.Organizations.Organizations.SetAgentOpenAIKey:output_type -> Superplane.Organizations.SetAgentOpenAIKeyResponse
-	30, // 77: Superplane.Organizations.Organizations.DeleteAgentOpenAIKey:output_type -> Superplane.Organizations.DeleteAgentOpenAIKeyResponse
-	60, // 78: Superplane.Organizations.Organizations.AcceptInviteLink:output_type -> google.protobuf.Struct
-	34, // 79: Superplane.Organizations.Organizations.ListIntegrations:output_type -> Superplane.Organizations.ListIntegrationsResponse
-	38, // 80: Superplane.Organizations.Organizations.DescribeIntegration:output_type -> Superplane.Organizations.DescribeIntegrationResponse
-	40, // 81: Superplane.Organizations.Organizations.ListIntegrationResources:output_type -> Superplane.Organizations.ListIntegrationResourcesResponse
-	36, // 82: Superplane.Organizations.Organizations.CreateIntegration:output_type -> Superplane.Organizations.CreateIntegrationResponse
-	43, // 83: Superplane.Organizations.Organizations.UpdateIntegration:output_type -> Superplane.Organizations.UpdateIntegrationResponse
-	45, // 84: Superplane.Organizations.Organizations.DeleteIntegration:output_type -> Superplane.Organizations.DeleteIntegrationResponse
-	64, // [64:85] is the sub-list for method output_type
-	43, // [43:64] is the sub-list for method input_type
-	43, // [43:43] is the sub-list for extension type_name
-	43, // [43:43] is the sub-list for extension extendee
-	0,  // [0:43] is the sub-list for field type_name
+	82, // 0: Superplane.Organizations.Organization.metadata:type_name -> Superplane.Organizations.Organization.Metadata
+	2,  // 1: Superplane.Organizations.DescribeOrganizationResponse.organization:type_name -> Superplane.Organizations.Organization
+	2,  // 2: Superplane.Organizations.UpdateOrganizationRequest.organization:type_name -> Superplane.Organizations.Organization
+	2,  // 3: Superplane.Organizations.UpdateOrganizationResponse.organization:type_name -> Superplane.Organizations.Organization
+	89, // 4: Superplane.Organizations.Invitation.created_at:type_name -> google.protobuf.Timestamp
+	89, // 5: Superplane.Organizations.InviteLink.created_at:type_name -> google.protobuf.Timestamp
+	89, // 6: Superplane.Organizations.InviteLink.updated_at:type_name -> google.protobuf.Timestamp
+	89, // 7: Superplane.Organizations.AgentOpenAIKey.validated_at:type_name -> google.protobuf.Timestamp
+	89, // 8: Superplane.Organizations.AgentOpenAIKey.updated_at:type_name -> google.protobuf.Timestamp
+	11, // 9: Superplane.Organizations.AgentSettings.openai_key:type_name -> Superplane.Organizations.AgentOpenAIKey
+	0,  // 10: Superplane.Organizations.SSOConfig.protocol:type_name -> Superplane.Organizations.SSOProtocol
+	14, // 11: Superplane.Organizations.SSOConfig.saml:type_name -> Superplane.Organizations.SSOSAMLSettings
+	15, // 12: Superplane.Organizations.SSOConfig.oidc:type_name -> Superplane.Organizations.SSOOIDCSettings
+	13, // 13: Superplane.Organizations.SSOConfig.group_mappings:type_name -> Superplane.Organizations.SSOGroupMapping
+	89, // 14: Superplane.Organizations.SSOConfig.updated_at:type_name -> google.protobuf.Timestamp
+	16, // 15: Superplane.Organizations.GetSSOConfigResponse.sso_config:type_name -> Superplane.Organizations.SSOConfig
+	16, // 16: Superplane.Organizations.UpdateSSOConfigRequest.sso_config:type_name -> Superplane.Organizations.SSOConfig
+	16, // 17: Superplane.Organizations.UpdateSSOConfigResponse.sso_config:type_name -> Superplane.Organizations.SSOConfig
+	89, // 18: Superplane.Organizations.SCIMConfig.token_created_at:type_name -> google.protobuf.Timestamp
+	89, // 19: Superplane.Organizations.SCIMConfig.token_last_used_at:type_name -> google.protobuf.Timestamp
+	23, // 20: Superplane.Organizations.GetSCIMConfigResponse.scim_config:type_name -> Superplane.Organizations.SCIMConfig
+	23, // 21: Superplane.Organizations.ResetSCIMTokenResponse.scim_config:type_name -> Superplane.Organizations.SCIMConfig
+	30, // 22: Superplane.Organizations.TwoFactorPolicy.members:type_name -> Superplane.Organizations.TwoFactorMember
+	31, // 23: Superplane.Organizations.GetTwoFactorPolicyResponse.policy:type_name -> Superplane.Organizations.TwoFactorPolicy
+	31, // 24: Superplane.Organizations.UpdateTwoFactorPolicyResponse.policy:type_name -> Superplane.Organizations.TwoFactorPolicy
+	1,  // 25: Superplane.Organizations.TwoFactorEvent.type:type_name -> Superplane.Organizations.TwoFactorEventType
+	89, // 26: Superplane.Organizations.TwoFactorEvent.created_at:type_name -> google.protobuf.Timestamp
+	89, // 27: Superplane.Organizations.ListTwoFactorEventsRequest.before:type_name -> google.protobuf.Timestamp
+	38, // 28: Superplane.Organizations.ListTwoFactorEventsResponse.events:type_name -> Superplane.Organizations.TwoFactorEvent
+	89, // 29: Superplane.Organizations.ListTwoFactorEventsResponse.last_timestamp:type_name -> google.protobuf.Timestamp
+	9,  // 30: Superplane.Organizations.CreateInvitationResponse.invitation:type_name -> Superplane.Organizations.Invitation
+	9,  // 31: Superplane.Organizations.ListInvitationsResponse.invitations:type_name -> Superplane.Organizations.Invitation
+	10, // 32: Superplane.Organizations.GetInviteLinkResponse.invite_link:type_name -> Superplane.Organizations.InviteLink
+	10, // 33: Superplane.Organizations.UpdateInviteLinkResponse.invite_link:type_name -> Superplane.Organizations.InviteLink
+	10, // 34: Superplane.Organizations.ResetInviteLinkResponse.invite_link:type_name -> Superplane.Organizations.InviteLink
+	12, // 35: Superplane.Organizations.GetAgentSettingsResponse.agent_settings:type_name -> Superplane.Organizations.AgentSettings
+	12, // 36: Superplane.Organizations.UpdateAgentSettingsResponse.agent_settings:type_name -> Superplane.Organizations.AgentSettings
+	12, // 37: Superplane.Organizations.SetAgentOpenAIKeyResponse.agent_settings:type_name -> Superplane.Organizations.AgentSettings
+	12, // 38: Superplane.Organizations.DeleteAgentOpenAIKeyResponse.agent_settings:type_name -> Superplane.Organizations.AgentSettings
+	76, // 39: Superplane.Organizations.ListIntegrationsResponse.integrations:type_name -> Superplane.Organizations.Integration
+	90, // 40: Superplane.Organizations.CreateIntegrationRequest.configuration:type_name -> google.protobuf.Struct
+	76, // 41: Superplane.Organizations.CreateIntegrationResponse.integration:type_name -> Superplane.Organizations.Integration
+	76, // 42: Superplane.Organizations.DescribeIntegrationResponse.integration:type_name -> Superplane.Organizations.Integration
+	83, // 43: Superplane.Organizations.ListIntegrationResourcesRequest.parameters:type_name -> Superplane.Organizations.ListIntegrationResourcesRequest.ParametersEntry
+	71, // 44: Superplane.Organizations.ListIntegrationResourcesResponse.resources:type_name -> Superplane.Organizations.IntegrationResourceRef
+	90, // 45: Superplane.Organizations.UpdateIntegrationRequest.configuration:type_name -> google.protobuf.Struct
+	76, // 46: Superplane.Organizations.UpdateIntegrationResponse.integration:type_name -> Superplane.Organizations.Integration
+	84, // 47: Superplane.Organizations.Integration.metadata:type_name -> Superplane.Organizations.Integration.Metadata
+	85, // 48: Superplane.Organizations.Integration.spec:type_name -> Superplane.Organizations.Integration.Spec
+	86, // 49: Superplane.Organizations.Integration.status:type_name -> Superplane.Organizations.Integration.Status
+	88, // 50: Superplane.Organizations.BrowserAction.form_fields:type_name -> Superplane.Organizations.BrowserAction.FormFieldsEntry
+	89, // 51: Superplane.Organizations.OrganizationCreated.timestamp:type_name -> google.protobuf.Timestamp
+	89, // 52: Superplane.Organizations.OrganizationUpdated.timestamp:type_name -> google.protobuf.Timestamp
+	89, // 53: Superplane.Organizations.OrganizationDeleted.timestamp:type_name -> google.protobuf.Timestamp
+	89, // 54: Superplane.Organizations.InvitationCreated.timestamp:type_name -> google.protobuf.Timestamp
+	89, // 55: Superplane.Organizations.Organization.Metadata.created_at:type_name -> google.protobuf.Timestamp
+	89, // 56: Superplane.Organizations.Organization.Metadata.updated_at:type_name -> google.protobuf.Timestamp
+	89, // 57: Superplane.Organizations.Integration.Metadata.created_at:type_name -> google.protobuf.Timestamp
+	89, // 58: Superplane.Organizations.Integration.Metadata.updated_at:type_name -> google.protobuf.Timestamp
+	90, // 59: Superplane.Organizations.Integration.Spec.configuration:type_name -> google.protobuf.Struct
+	90, // 60: Superplane.Organizations.Integration.Status.metadata:type_name -> google.protobuf.Struct
+	77, // 61: Superplane.Organizations.Integration.Status.browser_action:type_name -> Superplane.Organizations.BrowserAction
+	87, // 62: Superplane.Organizations.Integration.Status.used_in:type_name -> Superplane.Organizations.Integration.NodeRef
+	3,  // 63: Superplane.Organizations.Organizations.DescribeOrganization:input_type -> Superplane.Organizations.DescribeOrganizationRequest
+	5,  // 64: Superplane.Organizations.Organizations.UpdateOrganization:input_type -> Superplane.Organizations.UpdateOrganizationRequest
+	7,  // 65: Superplane.Organizations.Organizations.DeleteOrganization:input_type -> Superplane.Organizations.DeleteOrganizationRequest
+	61, // 66: Superplane.Organizations.Organizations.RemoveUser:input_type -> Superplane.Organizations.RemoveUserRequest
+	41, // 67: Superplane.Organizations.Organizations.CreateInvitation:input_type -> Superplane.Organizations.CreateInvitationRequest
+	43, // 68: Superplane.Organizations.Organizations.ListInvitations:input_type -> Superplane.Organizations.ListInvitationsRequest
+	45, // 69: Superplane.Organizations.Organizations.RemoveInvitation:input_type -> Superplane.Organizations.RemoveInvitationRequest
+	47, // 70: Superplane.Organizations.Organizations.GetInviteLink:input_type -> Superplane.Organizations.GetInviteLinkRequest
+	49, // 71: Superplane.Organizations.Organizations.UpdateInviteLink:input_type -> Superplane.Organizations.UpdateInviteLinkRequest
+	51, // 72: Superplane.Organizations.Organizations.ResetInviteLink:input_type -> Superplane.Organizations.ResetInviteLinkRequest
+	53, // 73: Superplane.Organizations.Organizations.GetAgentSettings:input_type -> Superplane.Organizations.GetAgentSettingsRequest
+	55, // 74: Superplane.Organizations.Organizations.UpdateAgentSettings:input_type -> Superplane.Organizations.UpdateAgentSettingsRequest
+	57, // 75: Superplane.Organizations.Organizations.SetAgentOpenAIKey:input_type -> Superplane.Organizations.SetAgentOpenAIKeyRequest
+	59, // 76: Superplane.Organizations.Organizations.DeleteAgentOpenAIKey:input_type -> Superplane.Organizations.DeleteAgentOpenAIKeyRequest
+	17, // 77: Superplane.Organizations.Organizations.GetSSOConfig:input_type -> Superplane.Organizations.GetSSOConfigRequest
+	19, // 78: Superplane.Organizations.Organizations.UpdateSSOConfig:input_type -> Superplane.Organizations.UpdateSSOConfigRequest
+	21, // 79: Superplane.Organizations.Organizations.DeleteSSOConfig:input_type -> Superplane.Organizations.DeleteSSOConfigRequest
+	24, // 80: Superplane.Organizations.Organizations.GetSCIMConfig:input_type -> Superplane.Organizations.GetSCIMConfigRequest
+	26, // 81: Superplane.Organizations.Organizations.ResetSCIMToken:input_type -> Superplane.Organizations.ResetSCIMTokenRequest
+	28, // 82: Superplane.Organizations.Organizations.DeleteSCIMToken:input_type -> Superplane.Organizations.DeleteSCIMTokenRequest
+	32, // 83: Superplane.Organizations.Organizations.GetTwoFactorPolicy:input_type -> Superplane.Organizations.GetTwoFactorPolicyRequest
+	34, // 84: Superplane.Organizations.Organizations.UpdateTwoFactorPolicy:input_type -> Superplane.Organizations.UpdateTwoFactorPolicyRequest
+	36, // 85: Superplane.Organizations.Organizations.ResetUserTwoFactor:input_type -> Superplane.Organizations.ResetUserTwoFactorRequest
+	39, // 86: Superplane.Organizations.Organizations.ListTwoFactorEvents:input_type -> Superplane.Organizations.ListTwoFactorEventsRequest
+	10, // 87: Superplane.Organizations.Organizations.AcceptInviteLink:input_type -> Superplane.Organizations.InviteLink
+	63, // 88: Superplane.Organizations.Organizations.ListIntegrations:input_type -> Superplane.Organizations.ListIntegrationsRequest
+	67, // 89: Superplane.Organizations.Organizations.DescribeIntegration:input_type -> Superplane.Organizations.DescribeIntegrationRequest
+	69, // 90: Superplane.Organizations.Organizations.ListIntegrationResources:input_type -> Superplane.Organizations.ListIntegrationResourcesRequest
+	65, // 91: Superplane.Organizations.Organizations.CreateIntegration:input_type -> Superplane.Organizations.CreateIntegrationRequest
+	72, // 92: Superplane.Organizations.Organizations.UpdateIntegration:input_type -> Superplane.Organizations.UpdateIntegrationRequest
+	74, // 93: Superplane.Organizations.Organizations.DeleteIntegration:input_type -> Superplane.Organizations.DeleteIntegrationRequest
+	4,  // 94: Superplane.Organizations.Organizations.DescribeOrganization:output_type -> Superplane.Organizations.DescribeOrganizationResponse
+	6,  // 95: Superplane.Organizations.Organizations.UpdateOrganization:output_type -> Superplane.Organizations.UpdateOrganizationResponse
+	8,  // 96: Superplane.Organizations.Organizations.DeleteOrganization:output_type -> Superplane.Organizations.DeleteOrganizationResponse
+	62, // 97: Superplane.Organizations.Organizations.RemoveUser:output_type -> Superplane.Organizations.RemoveUserResponse
+	42, // 98: Superplane.Organizations.Organizations.CreateInvitation:output_type -> Superplane.Organizations.CreateInvitationResponse
+	44, // 99: Superplane.Organizations.Organizations.ListInvitations:output_type -> Superplane.Organizations.ListInvitationsResponse
+	46, // 100: Superplane.Organizations.Organizations.RemoveInvitation:output_type -> Superplane.Organizations.RemoveInvitationResponse
+	48, // 101: Superplane.Organizations.Organizations.GetInviteLink:output_type -> Superplane.Organizations.GetInviteLinkResponse
+	50, // 102: Superplane.Organizations.Organizations.UpdateInviteLink:output_type -> Superplane.Organizations.UpdateInviteLinkResponse
+	52, // 103: Superplane.Organizations.Organizations.ResetInviteLink:output_type -> Superplane.Organizations.ResetInviteLinkResponse
+	54, // 104: Superplane.Organizations.Organizations.GetAgentSettings:output_type -> Superplane.Organizations.GetAgentSettingsResponse
+	56, // 105: Superplane.Organizations.Organizations.UpdateAgentSettings:output_type -> Superplane.Organizations.UpdateAgentSettingsResponse
+	58, // 106: Superplane.Organizations.Organizations.SetAgentOpenAIKey:output_type -> Superplane.Organizations.SetAgentOpenAIKeyResponse
+	60, // 107: Superplane.Organizations.Organizations.DeleteAgentOpenAIKey:output_type -> Superplane.Organizations.DeleteAgentOpenAIKeyResponse
+	18, // 108: Superplane.Organizations.Organizations.GetSSOConfig:output_type -> Superplane.Organizations.GetSSOConfigResponse
+	20, // 109: Superplane.Organizations.Organizations.UpdateSSOConfig:output_type -> Superplane.Organizations.UpdateSSOConfigResponse
+	22, // 110: Superplane.Organizations.Organizations.DeleteSSOConfig:output_type -> Superplane.Organizations.DeleteSSOConfigResponse
+	25, // 111: Superplane.Organizations.Organizations.GetSCIMConfig:output_type -> Superplane.Organizations.GetSCIMConfigResponse
+	27, // 112: Superplane.Organizations.Organizations.ResetSCIMToken:output_type -> Superplane.Organizations.ResetSCIMTokenResponse
+	29, // 113: Superplane.Organizations.Organizations.DeleteSCIMToken:output_type -> Superplane.Organizations.DeleteSCIMTokenResponse
+	33, // 114: Superplane.Organizations.Organizations.GetTwoFactorPolicy:output_type -> Superplane.Organizations.GetTwoFactorPolicyResponse
+	35, // 115: Superplane.Organizations.Organizations.UpdateTwoFactorPolicy:output_type -> Superplane.Organizations.UpdateTwoFactorPolicyResponse
+	37, // 116: Superplane.Organizations.Organizations.ResetUserTwoFactor:output_type -> Superplane.Organizations.ResetUserTwoFactorResponse
+	40, // 117: Superplane.Organizations.Organizations.ListTwoFactorEvents:output_type -> Superplane.Organizations.ListTwoFactorEventsResponse
+	90, // 118: Superplane.Organizations.Organizations.AcceptInviteLink:output_type -> google.protobuf.Struct
+	64, // 119: Superplane.Organizations.Organizations.ListIntegrations:output_type -> Superplane.Organizations.ListIntegrationsResponse
+	68, // 120: Superplane.Organizations.Organizations.DescribeIntegration:output_type -> Superplane.Organizations.DescribeIntegrationResponse
+	70, // 121: Superplane.Organizations.Organizations.ListIntegrationResources:output_type -> Superplane.Organizations.ListIntegrationResourcesResponse
+	66, // 122: Superplane.Organizations.Organizations.CreateIntegration:output_type -> Superplane.Organizations.CreateIntegrationResponse
+	73, // 123: Superplane.Organizations.Organizations.UpdateIntegration:output_type -> Superplane.Organizations.UpdateIntegrationResponse
+	75, // 124: Superplane.Organizations.Organizations.DeleteIntegration:output_type -> Superplane.Organizations.DeleteIntegrationResponse
+	94, // [94:125] is the sub-list for method output_type
+	63, // [63:94] is the sub-list for method input_type
+	63, // [63:63] is the sub-list for extension type_name
+	63, // [63:63] is the sub-list for extension extendee
+	0,  // [0:63] is the sub-list for field type_name
 }
 
 func init() { file_organizations_proto_init() }
@@ -3430,13 +5337,14 @@ func file_organizations_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_organizations_proto_rawDesc), len(file_organizations_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   59,
+			NumEnums:      2,
+			NumMessages:   87,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_organizations_proto_goTypes,
 		DependencyIndexes: file_organizations_proto_depIdxs,
+		EnumInfos:         file_organizations_proto_enumTypes,
 		MessageInfos:      file_organizations_proto_msgTypes,
 	}.Build()
 	File_organizations_proto = out.File
@@ -34,6 +34,16 @@ const (
 	Organizations_UpdateAgentSettings_FullMethodName      = "/Superplane.Organizations.Organizations/UpdateAgentSettings"
 	Organizations_SetAgentOpenAIKey_FullMethodName        = "/Superplane.Organizations.Organizations/SetAgentOpenAIKey"
 	Organizations_DeleteAgentOpenAIKey_FullMethodName     = "/Superplane.Organizations.Organizations/DeleteAgentOpenAIKey"
+	Organizations_GetSSOConfig_FullMethodName             = "/Superplane.Organizations.Organizations/GetSSOConfig"
+	Organizations_UpdateSSOConfig_FullMethodName          = "/Superplane.Organizations.Organizations/UpdateSSOConfig"
+	Organizations_DeleteSSOConfig_FullMethodName          = "/Superplane.Organizations.Organizations/DeleteSSOConfig"
+	Organizations_GetSCIMConfig_FullMethodName            = "/Superplane.Organizations.Organizations/GetSCIMConfig"
+	Organizations_ResetSCIMToken_FullMethodName           = "/Superplane.Organizations.Organizations/ResetSCIMToken"
+	Organizations_DeleteSCIMToken_FullMethodName          = "/Superplane.Organizations.Organizations/DeleteSCIMToken"
+	Organizations_GetTwoFactorPolicy_FullMethodName       = "/Superplane.Organizations.Organizations/GetTwoFactorPolicy"
+	Organizations_UpdateTwoFactorPolicy_FullMethodName    = "/Superplane.Organizations.Organizations/UpdateTwoFactorPolicy"
+	Organizations_ResetUserTwoFactor_FullMethodName       = "/Superplane.Organizations.Organizations/ResetUserTwoFactor"
+	Organizations_ListTwoFactorEvents_FullMethodName      = "/Superplane.Organizations.Organizations/ListTwoFactorEvents"
 	Organizations_AcceptInviteLink_FullMethodName         = "/Superplane.Organizations.Organizations/AcceptInviteLink"
 	Organizations_ListIntegrations_FullMethodName         = "/Superplane.Organizations.Organizations/ListIntegrations"
 	Organizations_DescribeIntegration_FullMethodName      = "/Superplane.Organizations.Organizations/DescribeIntegration"
@@ -61,6 +71,16 @@ type OrganizationsClient interface {
 	UpdateAgentSettings(ctx context.Context, in *UpdateAgentSettingsRequest, opts ...grpc.CallOption) (*UpdateAgentSettingsResponse, error)
 	SetAgentOpenAIKey(ctx context.Context, in *SetAgentOpenAIKeyRequest, opts ...grpc.CallOption) (*SetAgentOpenAIKeyResponse, error)
 	DeleteAgentOpenAIKey(ctx context.Context, in *DeleteAgentOpenAIKeyRequest, opts ...grpc.CallOption) (*DeleteAgentOpenAIKeyResponse, error)
+	GetSSOConfig(ctx context.Context, in *GetSSOConfigRequest, opts ...grpc.CallOption) (*GetSSOConfigResponse, error)
+	UpdateSSOConfig(ctx context.Context, in *UpdateSSOConfigRequest, opts ...grpc.CallOption) (*UpdateSSOConfigResponse, error)
+	DeleteSSOConfig(ctx context.Context, in *DeleteSSOConfigRequest, opts ...grpc.CallOption) (*DeleteSSOConfigResponse, error)
+	GetSCIMConfig(ctx context.Context, in *GetSCIMConfigRequest, opts ...grpc.CallOption) (*GetSCIMConfigResponse, error)
+	ResetSCIMToken(ctx context.Context, in *ResetSCIMTokenRequest, opts ...grpc.CallOption) (*ResetSCIMTokenResponse, error)
+	DeleteSCIMToken(ctx context.Context, in *DeleteSCIMTokenRequest, opts ...grpc.CallOption) (*DeleteSCIMTokenResponse, error)
+	GetTwoFactorPolicy(ctx context.Context, in *GetTwoFactorPolicyRequest, opts ...grpc.CallOption) (*GetTwoFactorPolicyResponse, error)
+	UpdateTwoFactorPolicy(ctx context.Context, in *UpdateTwoFactorPolicyRequest, opts ...grpc.CallOption) (*UpdateTwoFactorPolicyResponse, error)
+	ResetUserTwoFactor(ctx context.Context, in *ResetUserTwoFactorRequest, opts ...grpc.CallOption) (*ResetUserTwoFactorResponse, error)
+	ListTwoFactorEvents(ctx context.Context, in *ListTwoFactorEventsRequest, opts ...grpc.CallOption) (*ListTwoFactorEventsResponse, error)
 	AcceptInviteLink(ctx context.Context, in *InviteLink, opts ...grpc.CallOption) (*_struct.Struct, error)
 	ListIntegrations(ctx context.Context, in *ListIntegrationsRequest, opts ...grpc.CallOption) (*ListIntegrationsResponse, error)
 	DescribeIntegration(ctx context.Context, in *DescribeIntegrationRequest, opts ...grpc.CallOption) (*DescribeIntegrationResponse, error)
@@ -218,6 +238,106 @@ func (c *organizationsClient) DeleteAgentOpenAIKey(ctx context.Context, in *Dele
 	return out, nil
 }
 
+func (c *organizationsClient) GetSSOConfig(ctx context.Context, in *GetSSOConfigRequest, opts ...grpc.CallOption) (*GetSSOConfigResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetSSOConfigResponse)
+	err := c.cc.Invoke(ctx, Organizations_GetSSOConfig_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *organizationsClient) UpdateSSOConfig(ctx context.Context, in *UpdateSSOConfigRequest, opts ...grpc.CallOption) (*UpdateSSOConfigResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateSSOConfigResponse)
+	err := c.cc.Invoke(ctx, Organizations_UpdateSSOConfig_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *organizationsClient) DeleteSSOConfig(ctx context.Context, in *DeleteSSOConfigRequest, opts ...grpc.CallOption) (*DeleteSSOConfigResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteSSOConfigResponse)
+	err := c.cc.Invoke(ctx, Organizations_DeleteSSOConfig_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *organizationsClient) GetSCIMConfig(ctx context.Context, in *GetSCIMConfigRequest, opts ...grpc.CallOption) (*GetSCIMConfigResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetSCIMConfigResponse)
+	err := c.cc.Invoke(ctx, Organizations_GetSCIMConfig_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *organizationsClient) ResetSCIMToken(ctx context.Context, in *ResetSCIMTokenRequest, opts ...grpc.CallOption) (*ResetSCIMTokenResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ResetSCIMTokenResponse)
+	err := c.cc.Invoke(ctx, Organizations_ResetSCIMToken_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *organizationsClient) DeleteSCIMToken(ctx context.Context, in *DeleteSCIMTokenRequest, opts ...grpc.CallOption) (*DeleteSCIMTokenResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteSCIMTokenResponse)
+	err := c.cc.Invoke(ctx, Organizations_DeleteSCIMToken_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *organizationsClient) GetTwoFactorPolicy(ctx context.Context, in *GetTwoFactorPolicyRequest, opts ...grpc.CallOption) (*GetTwoFactorPolicyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetTwoFactorPolicyResponse)
+	err := c.cc.Invoke(ctx, Organizations_GetTwoFactorPolicy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *organizationsClient) UpdateTwoFactorPolicy(ctx context.Context, in *UpdateTwoFactorPolicyRequest, opts ...grpc.CallOption) (*UpdateTwoFactorPolicyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateTwoFactorPolicyResponse)
+	err := c.cc.Invoke(ctx, Organizations_UpdateTwoFactorPolicy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *organizationsClient) ResetUserTwoFactor(ctx context.Context, in *ResetUserTwoFactorRequest, opts ...grpc.CallOption) (*ResetUserTwoFactorResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ResetUserTwoFactorResponse)
+	err := c.cc.Invoke(ctx, Organizations_ResetUserTwoFactor_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *organizationsClient) ListTwoFactorEvents(ctx context.Context, in *ListTwoFactorEventsRequest, opts ...grpc.CallOption) (*ListTwoFactorEventsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTwoFactorEventsResponse)
+	err := c.cc.Invoke(ctx, Organizations_ListTwoFactorEvents_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *organizationsClient) AcceptInviteLink(ctx context.Context, in *InviteLink, opts ...grpc.CallOption) (*_struct.Struct, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(_struct.Struct)
@@ -306,6 +426,16 @@ type OrganizationsServer interface {
 	UpdateAgentSettings(context.Context, *UpdateAgentSettingsRequest) (*UpdateAgentSettingsResponse, error)
 	SetAgentOpenAIKey(context.Context, *SetAgentOpenAIKeyRequest) (*SetAgentOpenAIKeyResponse, error)
 	DeleteAgentOpenAIKey(context.Context, *DeleteAgentOpenAIKeyRequest) (*DeleteAgentOpenAIKeyResponse, error)
+	GetSSOConfig(context.Context, *GetSSOConfigRequest) (*GetSSOConfigResponse, error)
+	UpdateSSOConfig(context.Context, *UpdateSSOConfigRequest) (*UpdateSSOConfigResponse, error)
+	DeleteSSOConfig(context.Context, *DeleteSSOConfigRequest) (*DeleteSSOConfigResponse, error)
+	GetSCIMConfig(context.Context, *GetSCIMConfigRequest) (*GetSCIMConfigResponse, error)
+	ResetSCIMToken(context.Context, *ResetSCIMTokenRequest) (*ResetSCIMTokenResponse, error)
+	DeleteSCIMToken(context.Context, *DeleteSCIMTokenRequest) (*DeleteSCIMTokenResponse, error)
+	GetTwoFactorPolicy(context.Context, *GetTwoFactorPolicyRequest) (*GetTwoFactorPolicyResponse, error)
+	UpdateTwoFactorPolicy(context.Context, *UpdateTwoFactorPolicyRequest) (*UpdateTwoFactorPolicyResponse, error)
+	ResetUserTwoFactor(context.Context, *ResetUserTwoFactorRequest) (*ResetUserTwoFactorResponse, error)
+	ListTwoFactorEvents(context.Context, *ListTwoFactorEventsRequest) (*ListTwoFactorEventsResponse, error)
 	AcceptInviteLink(context.Context, *InviteLink) (*_struct.Struct, error)
 	ListIntegrations(context.Context, *ListIntegrationsRequest) (*ListIntegrationsResponse, error)
 	DescribeIntegration(context.Context, *DescribeIntegrationRequest) (*DescribeIntegrationResponse, error)
@@ -364,6 +494,36 @@ func (UnimplementedOrganizationsServer) SetAgentOpenAIKey(context.Context, *SetA
 func (UnimplementedOrganizationsServer) DeleteAgentOpenAIKey(context.Context, *DeleteAgentOpenAIKeyRequest) (*DeleteAgentOpenAIKeyResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method DeleteAgentOpenAIKey not implemented")
 }
+func (UnimplementedOrganizationsServer) GetSSOConfig(context.Context, *GetSSOConfigRequest) (*GetSSOConfigResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetSSOConfig not implemented")
+}
+func (UnimplementedOrganizationsServer) UpdateSSOConfig(context.Context, *UpdateSSOConfigRequest) (*UpdateSSOConfigResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateSSOConfig not implemented")
+}
+func (UnimplementedOrganizationsServer) DeleteSSOConfig(context.Context, *DeleteSSOConfigRequest) (*DeleteSSOConfigResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteSSOConfig not implemented")
+}
+func (UnimplementedOrganizationsServer) GetSCIMConfig(context.Context, *GetSCIMConfigRequest) (*GetSCIMConfigResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetSCIMConfig not implemented")
+}
+func (UnimplementedOrganizationsServer) ResetSCIMToken(context.Context, *ResetSCIMTokenRequest) (*ResetSCIMTokenResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ResetSCIMToken not implemented")
+}
+func (UnimplementedOrganizationsServer) DeleteSCIMToken(context.Context, *DeleteSCIMTokenRequest) (*DeleteSCIMTokenResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteSCIMToken not implemented")
+}
+func (UnimplementedOrganizationsServer) GetTwoFactorPolicy(context.Context, *GetTwoFactorPolicyRequest) (*GetTwoFactorPolicyResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetTwoFactorPolicy not implemented")
+}
+func (UnimplementedOrganizationsServer) UpdateTwoFactorPolicy(context.Context, *UpdateTwoFactorPolicyRequest) (*UpdateTwoFactorPolicyResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateTwoFactorPolicy not implemented")
+}
+func (UnimplementedOrganizationsServer) ResetUserTwoFactor(context.Context, *ResetUserTwoFactorRequest) (*ResetUserTwoFactorResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ResetUserTwoFactor not implemented")
+}
+func (UnimplementedOrganizationsServer) ListTwoFactorEvents(context.Context, *ListTwoFactorEventsRequest) (*ListTwoFactorEventsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListTwoFactorEvents not implemented")
+}
 func (UnimplementedOrganizationsServer) AcceptInviteLink(context.Context, *InviteLink) (*_struct.Struct, error) {
 	return nil, status.Error(codes.Unimplemented, "method AcceptInviteLink not implemented")
 }
@@ -657,6 +817,186 @@ func _Organizations_DeleteAgentOpenAIKey_Handler(srv interface{}, ctx context.Co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Organizations_GetSSOConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSSOConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrganizationsServer).GetSSOConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Organizations_GetSSOConfig_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrganizationsServer).GetSSOConfig(ctx, req.(*GetSSOConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Organizations_UpdateSSOConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateSSOConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrganizationsServer).UpdateSSOConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Organizations_UpdateSSOConfig_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrganizationsServer).UpdateSSOConfig(ctx, req.(*UpdateSSOConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Organizations_DeleteSSOConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteSSOConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrganizationsServer).DeleteSSOConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Organizations_DeleteSSOConfig_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrganizationsServer).DeleteSSOConfig(ctx, req.(*DeleteSSOConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Organizations_GetSCIMConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSCIMConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrganizationsServer).GetSCIMConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Organizations_GetSCIMConfig_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrganizationsServer).GetSCIMConfig(ctx, req.(*GetSCIMConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Organizations_ResetSCIMToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResetSCIMTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrganizationsServer).ResetSCIMToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Organizations_ResetSCIMToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrganizationsServer).ResetSCIMToken(ctx, req.(*ResetSCIMTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Organizations_DeleteSCIMToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteSCIMTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrganizationsServer).DeleteSCIMToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Organizations_DeleteSCIMToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrganizationsServer).DeleteSCIMToken(ctx, req.(*DeleteSCIMTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Organizations_GetTwoFactorPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTwoFactorPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrganizationsServer).GetTwoFactorPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Organizations_GetTwoFactorPolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrganizationsServer).GetTwoFactorPolicy(ctx, req.(*GetTwoFactorPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Organizations_UpdateTwoFactorPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateTwoFactorPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrganizationsServer).UpdateTwoFactorPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Organizations_UpdateTwoFactorPolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrganizationsServer).UpdateTwoFactorPolicy(ctx, req.(*UpdateTwoFactorPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Organizations_ResetUserTwoFactor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResetUserTwoFactorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrganizationsServer).ResetUserTwoFactor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Organizations_ResetUserTwoFactor_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrganizationsServer).ResetUserTwoFactor(ctx, req.(*ResetUserTwoFactorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Organizations_ListTwoFactorEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTwoFactorEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrganizationsServer).ListTwoFactorEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Organizations_ListTwoFactorEvents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrganizationsServer).ListTwoFactorEvents(ctx, req.(*ListTwoFactorEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Organizations_AcceptInviteLink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(InviteLink)
 	if err := dec(in); err != nil {
@@ -846,6 +1186,46 @@ var Organizations_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteAgentOpenAIKey",
 			Handler:    _Organizations_DeleteAgentOpenAIKey_Handler,
 		},
+		{
+			MethodName: "GetSSOConfig",
+			Handler:    _Organizations_GetSSOConfig_Handler,
+		},
+		{
+			MethodName: "UpdateSSOConfig",
+			Handler:    _Organizations_UpdateSSOConfig_Handler,
+		},
+		{
+			MethodName: "DeleteSSOConfig",
+			Handler:    _Organizations_DeleteSSOConfig_Handler,
+		},
+		{
+			MethodName: "GetSCIMConfig",
+			Handler:    _Organizations_GetSCIMConfig_Handler,
+		},
+		{
+			MethodName: "ResetSCIMToken",
+			Handler:    _Organizations_ResetSCIMToken_Handler,
+		},
+		{
+			MethodName: "DeleteSCIMToken",
+			Handler:    _Organizations_DeleteSCIMToken_Handler,
+		},
+		{
+			MethodName: "GetTwoFactorPolicy",
+			Handler:    _Organizations_GetTwoFactorPolicy_Handler,
+		},
+		{
+			MethodName: "UpdateTwoFactorPolicy",
+			Handler:    _Organizations_UpdateTwoFactorPolicy_Handler,
+		},
+		{
+			MethodName: "ResetUserTwoFactor",
+			Handler:    _Organizations_ResetUserTwoFactor_Handler,
+		},
+		{
+			MethodName: "ListTwoFactorEvents",
+			Handler:    _Organizations_ListTwoFactorEvents_Handler,
+		},
 		{
 			MethodName: "AcceptInviteLink",
 			Handler:    _Organizations_AcceptInviteLink_Handler,
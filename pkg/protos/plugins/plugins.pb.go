@@ -0,0 +1,3410 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v3.15.8
+// source: plugins.proto
+
+package plugins
+
+import (
+	empty "github.com/golang/protobuf/ptypes/empty"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Method int32
+
+const (
+	Method_METHOD_UNSPECIFIED    Method = 0
+	Method_METHOD_SETUP          Method = 1
+	Method_METHOD_EXECUTE        Method = 2
+	Method_METHOD_HANDLE_ACTION  Method = 3
+	Method_METHOD_HANDLE_WEBHOOK Method = 4
+	Method_METHOD_CANCEL         Method = 5
+	Method_METHOD_CLEANUP        Method = 6
+)
+
+// Enum value maps for Method.
+var (
+	Method_name = map[int32]string{
+		0: "METHOD_UNSPECIFIED",
+		1: "METHOD_SETUP",
+		2: "METHOD_EXECUTE",
+		3: "METHOD_HANDLE_ACTION",
+		4: "METHOD_HANDLE_WEBHOOK",
+		5: "METHOD_CANCEL",
+		6: "METHOD_CLEANUP",
+	}
+	Method_value = map[string]int32{
+		"METHOD_UNSPECIFIED":    0,
+		"METHOD_SETUP":          1,
+		"METHOD_EXECUTE":        2,
+		"METHOD_HANDLE_ACTION":  3,
+		"METHOD_HANDLE_WEBHOOK": 4,
+		"METHOD_CANCEL":         5,
+		"METHOD_CLEANUP":        6,
+	}
+)
+
+func (x Method) Enum() *Method {
+	p := new(Method)
+	*p = x
+	return p
+}
+
+func (x Method) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Method) Descriptor() protoreflect.EnumDescriptor {
+	return file_plugins_proto_enumTypes[0].Descriptor()
+}
+
+func (Method) Type() protoreflect.EnumType {
+	return &file_plugins_proto_enumTypes[0]
+}
+
+func (x Method) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Method.Descriptor instead.
+func (Method) EnumDescriptor() ([]byte, []int) {
+	return file_plugins_proto_rawDescGZIP(), []int{0}
+}
+
+type Kind int32
+
+const (
+	Kind_KIND_UNSPECIFIED Kind = 0
+	Kind_KIND_COMPONENT   Kind = 1
+	Kind_KIND_TRIGGER     Kind = 2
+)
+
+// Enum value maps for Kind.
+var (
+	Kind_name = map[int32]string{
+		0: "KIND_UNSPECIFIED",
+		1: "KIND_COMPONENT",
+		2: "KIND_TRIGGER",
+	}
+	Kind_value = map[string]int32{
+		"KIND_UNSPECIFIED": 0,
+		"KIND_COMPONENT":   1,
+		"KIND_TRIGGER":     2,
+	}
+)
+
+func (x Kind) Enum() *Kind {
+	p := new(Kind)
+	*p = x
+	return p
+}
+
+func (x Kind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Kind) Descriptor() protoreflect.EnumDescriptor {
+	return file_plugins_proto_enumTypes[1].Descriptor()
+}
+
+func (Kind) Type() protoreflect.EnumType {
+	return &file_plugins_proto_enumTypes[1]
+}
+
+func (x Kind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Kind.Descriptor instead.
+func (Kind) EnumDescriptor() ([]byte, []int) {
+	return file_plugins_proto_rawDescGZIP(), []int{1}
+}
+
+// Which of the metadata contexts of the invocation is used:
+// the Metadata or the NodeMetadata field of the core contexts.
+type MetadataField int32
+
+const (
+	MetadataField_METADATA_FIELD_UNSPECIFIED   MetadataField = 0
+	MetadataField_METADATA_FIELD_METADATA      MetadataField = 1
+	MetadataField_METADATA_FIELD_NODE_METADATA MetadataField = 2
+)
+
+// Enum value maps for MetadataField.
+var (
+	MetadataField_name = map[int32]string{
+		0: "METADATA_FIELD_UNSPECIFIED",
+		1: "METADATA_FIELD_METADATA",
+		2: "METADATA_FIELD_NODE_METADATA",
+	}
+	MetadataField_value = map[string]int32{
+		"METADATA_FIELD_UNSPECIFIED":   0,
+		"METADATA_FIELD_METADATA":      1,
+		"METADATA_FIELD_NODE_METADATA": 2,
+	}
+)
+
+func (x MetadataField) Enum() *MetadataField {
+	p := new(MetadataField)
+	*p = x
+	return p
+}
+
+func (x MetadataField) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MetadataField) Descriptor() protoreflect.EnumDescriptor {
+	return file_plugins_proto_enumTypes[2].Descriptor()
+}
+
+func (MetadataField) Type() protoreflect.EnumType {
+	return &file_plugins_proto_enumTypes[2]
+}
+
+func (x MetadataField) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MetadataField.Descriptor instead.
+func (MetadataField) EnumDescriptor() ([]byte, []int) {
+	return file_plugins_proto_rawDescGZIP(), []int{2}
+}
+
+type LogStream int32
+
+const (
+	LogStream_LOG_STREAM_UNSPECIFIED LogStream = 0
+	LogStream_LOG_STREAM_STDOUT      LogStream = 1
+	LogStream_LOG_STREAM_STDERR      LogStream = 2
+)
+
+// Enum value maps for LogStream.
+var (
+	LogStream_name = map[int32]string{
+		0: "LOG_STREAM_UNSPECIFIED",
+		1: "LOG_STREAM_STDOUT",
+		2: "LOG_STREAM_STDERR",
+	}
+	LogStream_value = map[string]int32{
+		"LOG_STREAM_UNSPECIFIED": 0,
+		"LOG_STREAM_STDOUT":      1,
+		"LOG_STREAM_STDERR":      2,
+	}
+)
+
+func (x LogStream) Enum() *LogStream {
+	p := new(LogStream)
+	*p = x
+	return p
+}
+
+func (x LogStream) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (LogStream) Descriptor() protoreflect.EnumDescriptor {
+	return file_plugins_proto_enumTypes[3].Descriptor()
+}
+
+func (LogStream) Type() protoreflect.EnumType {
+	return &file_plugins_proto_enumTypes[3]
+}
+
+func (x LogStream) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use LogStream.Descriptor instead.
+func (LogStream) EnumDescriptor() ([]byte, []int) {
+	return file_plugins_proto_rawDescGZIP(), []int{3}
+}
+
+type HandshakeRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ProtocolVersion uint32                 `protobuf:"varint,1,opt,name=protocol_version,json=protocolVersion,proto3" json:"protocol_version,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *HandshakeRequest) Reset() {
+	*x = HandshakeRequest{}
+	mi := &file_plugins_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HandshakeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HandshakeRequest) ProtoMessage() {}
+
+func (x *HandshakeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_plugins_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HandshakeRequest.ProtoReflect.Descriptor instead.
+func (*HandshakeRequest) Descriptor() ([]byte, []int) {
+	return file_plugins_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *HandshakeRequest) GetProtocolVersion() uint32 {
+	if x != nil {
+		return x.ProtocolVersion
+	}
+	return 0
+}
+
+// Capabilities are versioned with the protocol.
+// For version 1, they are:
+//
+//	components                - the plugin provides components
+//	triggers                  - the plugin provides triggers
+//	webhooks                  - components or triggers handle webhooks
+//	dynamic_output_channels   - OutputChannels is implemented
+//	trigger_input             - ValidateInput is implemented
+type HandshakeResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ProtocolVersion uint32                 `protobuf:"varint,1,opt,name=protocol_version,json=protocolVersion,proto3" json:"protocol_version,omitempty"`
+	Name            string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Version         string                 `protobuf:"bytes,3,opt,name=version,proto3" json:"version,omitempty"`
+	Capabilities    []string               `protobuf:"bytes,4,rep,name=capabilities,proto3" json:"capabilities,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *HandshakeResponse) Reset() {
+	*x = HandshakeResponse{}
+	mi := &file_plugins_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HandshakeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HandshakeResponse) ProtoMessage() {}
+
+func (x *HandshakeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_plugins_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HandshakeResponse.ProtoReflect.Descriptor instead.
+func (*HandshakeResponse) Descriptor() ([]byte, []int) {
+	return file_plugins_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *HandshakeResponse) GetProtocolVersion() uint32 {
+	if x != nil {
+		return x.ProtocolVersion
+	}
+	return 0
+}
+
+func (x *HandshakeResponse) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *HandshakeResponse) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *HandshakeResponse) GetCapabilities() []string {
+	if x != nil {
+		return x.Capabilities
+	}
+	return nil
+}
+
+type OutputChannel struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Label         string                 `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OutputChannel) Reset() {
+	*x = OutputChannel{}
+	mi := &file_plugins_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OutputChannel) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OutputChannel) ProtoMessage() {}
+
+func (x *OutputChannel) ProtoReflect() protoreflect.Message {
+	mi := &file_plugins_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OutputChannel.ProtoReflect.Descriptor instead.
+func (*OutputChannel) Descriptor() ([]byte, []int) {
+	return file_plugins_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *OutputChannel) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *OutputChannel) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *OutputChannel) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+type Action struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Name           string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description    string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	UserAccessible bool                   `protobuf:"varint,3,opt,name=user_accessible,json=userAccessible,proto3" json:"user_accessible,omitempty"`
+	Parameters     []byte                 `protobuf:"bytes,4,opt,name=parameters,proto3" json:"parameters,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Action) Reset() {
+	*x = Action{}
+	mi := &file_plugins_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Action) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Action) ProtoMessage() {}
+
+func (x *Action) ProtoReflect() protoreflect.Message {
+	mi := &file_plugins_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Action.ProtoReflect.Descriptor instead.
+func (*Action) Descriptor() ([]byte, []int) {
+	return file_plugins_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Action) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Action) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Action) GetUserAccessible() bool {
+	if x != nil {
+		return x.UserAccessible
+	}
+	return false
+}
+
+func (x *Action) GetParameters() []byte {
+	if x != nil {
+		return x.Parameters
+	}
+	return nil
+}
+
+type ComponentDefinition struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	Name                  string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Label                 string                 `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`
+	Description           string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Documentation         string                 `protobuf:"bytes,4,opt,name=documentation,proto3" json:"documentation,omitempty"`
+	Icon                  string                 `protobuf:"bytes,5,opt,name=icon,proto3" json:"icon,omitempty"`
+	Color                 string                 `protobuf:"bytes,6,opt,name=color,proto3" json:"color,omitempty"`
+	ExampleOutput         []byte                 `protobuf:"bytes,7,opt,name=example_output,json=exampleOutput,proto3" json:"example_output,omitempty"`
+	Configuration         []byte                 `protobuf:"bytes,8,opt,name=configuration,proto3" json:"configuration,omitempty"`
+	OutputChannels        []*OutputChannel       `protobuf:"bytes,9,rep,name=output_channels,json=outputChannels,proto3" json:"output_channels,omitempty"`
+	DynamicOutputChannels bool                   `protobuf:"varint,10,opt,name=dynamic_output_channels,json=dynamicOutputChannels,proto3" json:"dynamic_output_channels,omitempty"`
+	Actions               []*Action              `protobuf:"bytes,11,rep,name=actions,proto3" json:"actions,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *ComponentDefinition) Reset() {
+	*x = ComponentDefinition{}
+	mi := &file_plugins_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ComponentDefinition) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ComponentDefinition) ProtoMessage() {}
+
+func (x *ComponentDefinition) ProtoReflect() protoreflect.Message {
+	mi := &file_plugins_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ComponentDefinition.ProtoReflect.Descriptor instead.
+func (*ComponentDefinition) Descriptor() ([]byte, []int) {
+	return file_plugins_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ComponentDefinition) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ComponentDefinition) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *ComponentDefinition) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *ComponentDefinition) GetDocumentation() string {
+	if x != nil {
+		return x.Documentation
+	}
+	return ""
+}
+
+func (x *ComponentDefinition) GetIcon() string {
+	if x != nil {
+		return x.Icon
+	}
+	return ""
+}
+
+func (x *ComponentDefinition) GetColor() string {
+	if x != nil {
+		return x.Color
+	}
+	return ""
+}
+
+func (x *ComponentDefinition) GetExampleOutput() []byte {
+	if x != nil {
+		return x.ExampleOutput
+	}
+	return nil
+}
+
+func (x *ComponentDefinition) GetConfiguration() []byte {
+	if x != nil {
+		return x.Configuration
+	}
+	return nil
+}
+
+func (x *ComponentDefinition) GetOutputChannels() []*OutputChannel {
+	if x != nil {
+		return x.OutputChannels
+	}
+	return nil
+}
+
+func (x *ComponentDefinition) GetDynamicOutputChannels() bool {
+	if x != nil {
+		return x.DynamicOutputChannels
+	}
+	return false
+}
+
+func (x *ComponentDefinition) GetActions() []*Action {
+	if x != nil {
+		return x.Actions
+	}
+	return nil
+}
+
+type TriggerDefinition struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Label         string                 `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Documentation string                 `protobuf:"bytes,4,opt,name=documentation,proto3" json:"documentation,omitempty"`
+	Icon          string                 `protobuf:"bytes,5,opt,name=icon,proto3" json:"icon,omitempty"`
+	Color         string                 `protobuf:"bytes,6,opt,name=color,proto3" json:"color,omitempty"`
+	ExampleData   []byte                 `protobuf:"bytes,7,opt,name=example_data,json=exampleData,proto3" json:"example_data,omitempty"`
+	Configuration []byte                 `protobuf:"bytes,8,opt,name=configuration,proto3" json:"configuration,omitempty"`
+	Actions       []*Action              `protobuf:"bytes,9,rep,name=actions,proto3" json:"actions,omitempty"`
+	AcceptsInput  bool                   `protobuf:"varint,10,opt,name=accepts_input,json=acceptsInput,proto3" json:"accepts_input,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TriggerDefinition) Reset() {
+	*x = TriggerDefinition{}
+	mi := &file_plugins_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TriggerDefinition) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TriggerDefinition) ProtoMessage() {}
+
+func (x *TriggerDefinition) ProtoReflect() protoreflect.Message {
+	mi := &file_plugins_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TriggerDefinition.ProtoReflect.Descriptor instead.
+func (*TriggerDefinition) Descriptor() ([]byte, []int) {
+	return file_plugins_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *TriggerDefinition) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TriggerDefinition) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *TriggerDefinition) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *TriggerDefinition) GetDocumentation() string {
+	if x != nil {
+		return x.Documentation
+	}
+	return ""
+}
+
+func (x *TriggerDefinition) GetIcon() string {
+	if x != nil {
+		return x.Icon
+	}
+	return ""
+}
+
+func (x *TriggerDefinition) GetColor() string {
+	if x != nil {
+		return x.Color
+	}
+	return ""
+}
+
+func (x *TriggerDefinition) GetExampleData() []byte {
+	if x != nil {
+		return x.ExampleData
+	}
+	return nil
+}
+
+func (x *TriggerDefinition) GetConfiguration() []byte {
+	if x != nil {
+		return x.Configuration
+	}
+	return nil
+}
+
+func (x *TriggerDefinition) GetActions() []*Action {
+	if x != nil {
+		return x.Actions
+	}
+	return nil
+}
+
+func (x *TriggerDefinition) GetAcceptsInput() bool {
+	if x != nil {
+		return x.AcceptsInput
+	}
+	return false
+}
+
+type ListComponentsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Components    []*ComponentDefinition `protobuf:"bytes,1,rep,name=components,proto3" json:"components,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListComponentsResponse) Reset() {
+	*x = ListComponentsResponse{}
+	mi := &file_plugins_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListComponentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListComponentsResponse) ProtoMessage() {}
+
+func (x *ListComponentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_plugins_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListComponentsResponse.ProtoReflect.Descriptor instead.
+func (*ListComponentsResponse) Descriptor() ([]byte, []int) {
+	return file_plugins_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ListComponentsResponse) GetComponents() []*ComponentDefinition {
+	if x != nil {
+		return x.Components
+	}
+	return nil
+}
+
+type ListTriggersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Triggers      []*TriggerDefinition   `protobuf:"bytes,1,rep,name=triggers,proto3" json:"triggers,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTriggersResponse) Reset() {
+	*x = ListTriggersResponse{}
+	mi := &file_plugins_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTriggersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTriggersResponse) ProtoMessage() {}
+
+func (x *ListTriggersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_plugins_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTriggersResponse.ProtoReflect.Descriptor instead.
+func (*ListTriggersResponse) Descriptor() ([]byte, []int) {
+	return file_plugins_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ListTriggersResponse) GetTriggers() []*TriggerDefinition {
+	if x != nil {
+		return x.Triggers
+	}
+	return nil
+}
+
+type OutputChannelsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Component     string                 `protobuf:"bytes,1,opt,name=component,proto3" json:"component,omitempty"`
+	Configuration []byte                 `protobuf:"bytes,2,opt,name=configuration,proto3" json:"configuration,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OutputChannelsRequest) Reset() {
+	*x = OutputChannelsRequest{}
+	mi := &file_plugins_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OutputChannelsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OutputChannelsRequest) ProtoMessage() {}
+
+func (x *OutputChannelsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_plugins_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OutputChannelsRequest.ProtoReflect.Descriptor instead.
+func (*OutputChannelsRequest) Descriptor() ([]byte, []int) {
+	return file_plugins_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *OutputChannelsRequest) GetComponent() string {
+	if x != nil {
+		return x.Component
+	}
+	return ""
+}
+
+func (x *OutputChannelsRequest) GetConfiguration() []byte {
+	if x != nil {
+		return x.Configuration
+	}
+	return nil
+}
+
+type OutputChannelsResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OutputChannels []*OutputChannel       `protobuf:"bytes,1,rep,name=output_channels,json=outputChannels,proto3" json:"output_channels,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *OutputChannelsResponse) Reset() {
+	*x = OutputChannelsResponse{}
+	mi := &file_plugins_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OutputChannelsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OutputChannelsResponse) ProtoMessage() {}
+
+func (x *OutputChannelsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_plugins_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OutputChannelsResponse.ProtoReflect.Descriptor instead.
+func (*OutputChannelsResponse) Descriptor() ([]byte, []int) {
+	return file_plugins_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *OutputChannelsResponse) GetOutputChannels() []*OutputChannel {
+	if x != nil {
+		return x.OutputChannels
+	}
+	return nil
+}
+
+type ValidateInputRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Trigger       string                 `protobuf:"bytes,1,opt,name=trigger,proto3" json:"trigger,omitempty"`
+	Configuration []byte                 `protobuf:"bytes,2,opt,name=configuration,proto3" json:"configuration,omitempty"`
+	Input         []byte                 `protobuf:"bytes,3,opt,name=input,proto3" json:"input,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ValidateInputRequest) Reset() {
+	*x = ValidateInputRequest{}
+	mi := &file_plugins_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ValidateInputRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidateInputRequest) ProtoMessage() {}
+
+func (x *ValidateInputRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_plugins_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidateInputRequest.ProtoReflect.Descriptor instead.
+func (*ValidateInputRequest) Descriptor() ([]byte, []int) {
+	return file_plugins_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ValidateInputRequest) GetTrigger() string {
+	if x != nil {
+		return x.Trigger
+	}
+	return ""
+}
+
+func (x *ValidateInputRequest) GetConfiguration() []byte {
+	if x != nil {
+		return x.Configuration
+	}
+	return nil
+}
+
+func (x *ValidateInputRequest) GetInput() []byte {
+	if x != nil {
+		return x.Input
+	}
+	return nil
+}
+
+type ValidateInputResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Input         []byte                 `protobuf:"bytes,1,opt,name=input,proto3" json:"input,omitempty"`
+	Error         string                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ValidateInputResponse) Reset() {
+	*x = ValidateInputResponse{}
+	mi := &file_plugins_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ValidateInputResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidateInputResponse) ProtoMessage() {}
+
+func (x *ValidateInputResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_plugins_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidateInputResponse.ProtoReflect.Descriptor instead.
+func (*ValidateInputResponse) Descriptor() ([]byte, []int) {
+	return file_plugins_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ValidateInputResponse) GetInput() []byte {
+	if x != nil {
+		return x.Input
+	}
+	return nil
+}
+
+func (x *ValidateInputResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type Execution struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	WorkflowId     string                 `protobuf:"bytes,2,opt,name=workflow_id,json=workflowId,proto3" json:"workflow_id,omitempty"`
+	OrganizationId string                 `protobuf:"bytes,3,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	NodeId         string                 `protobuf:"bytes,4,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	SourceNodeId   string                 `protobuf:"bytes,5,opt,name=source_node_id,json=sourceNodeId,proto3" json:"source_node_id,omitempty"`
+	BaseUrl        string                 `protobuf:"bytes,6,opt,name=base_url,json=baseUrl,proto3" json:"base_url,omitempty"`
+	Data           []byte                 `protobuf:"bytes,7,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Execution) Reset() {
+	*x = Execution{}
+	mi := &file_plugins_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Execution) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Execution) ProtoMessage() {}
+
+func (x *Execution) ProtoReflect() protoreflect.Message {
+	mi := &file_plugins_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Execution.ProtoReflect.Descriptor instead.
+func (*Execution) Descriptor() ([]byte, []int) {
+	return file_plugins_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *Execution) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Execution) GetWorkflowId() string {
+	if x != nil {
+		return x.WorkflowId
+	}
+	return ""
+}
+
+func (x *Execution) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *Execution) GetNodeId() string {
+	if x != nil {
+		return x.NodeId
+	}
+	return ""
+}
+
+func (x *Execution) GetSourceNodeId() string {
+	if x != nil {
+		return x.SourceNodeId
+	}
+	return ""
+}
+
+func (x *Execution) GetBaseUrl() string {
+	if x != nil {
+		return x.BaseUrl
+	}
+	return ""
+}
+
+func (x *Execution) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type User struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Email         string                 `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *User) Reset() {
+	*x = User{}
+	mi := &file_plugins_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *User) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*User) ProtoMessage() {}
+
+func (x *User) ProtoReflect() protoreflect.Message {
+	mi := &file_plugins_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use User.ProtoReflect.Descriptor instead.
+func (*User) Descriptor() ([]byte, []int) {
+	return file_plugins_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *User) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *User) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *User) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+type Header struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Values        []string               `protobuf:"bytes,1,rep,name=values,proto3" json:"values,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Header) Reset() {
+	*x = Header{}
+	mi := &file_plugins_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Header) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Header) ProtoMessage() {}
+
+func (x *Header) ProtoReflect() protoreflect.Message {
+	mi := &file_plugins_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Header.ProtoReflect.Descriptor instead.
+func (*Header) Descriptor() ([]byte, []int) {
+	return file_plugins_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *Header) GetValues() []string {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+type WebhookRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WorkflowId    string                 `protobuf:"bytes,1,opt,name=workflow_id,json=workflowId,proto3" json:"workflow_id,omitempty"`
+	NodeId        string                 `protobuf:"bytes,2,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	Body          []byte                 `protobuf:"bytes,3,opt,name=body,proto3" json:"body,omitempty"`
+	Headers       map[string]*Header     `protobuf:"bytes,4,rep,name=headers,proto3" json:"headers,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WebhookRequest) Reset() {
+	*x = WebhookRequest{}
+	mi := &file_plugins_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WebhookRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebhookRequest) ProtoMessage() {}
+
+func (x *WebhookRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_plugins_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WebhookRequest.ProtoReflect.Descriptor instead.
+func (*WebhookRequest) Descriptor() ([]byte, []int) {
+	return file_plugins_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *WebhookRequest) GetWorkflowId() string {
+	if x != nil {
+		return x.WorkflowId
+	}
+	return ""
+}
+
+func (x *WebhookRequest) GetNodeId() string {
+	if x != nil {
+		return x.NodeId
+	}
+	return ""
+}
+
+func (x *WebhookRequest) GetBody() []byte {
+	if x != nil {
+		return x.Body
+	}
+	return nil
+}
+
+func (x *WebhookRequest) GetHeaders() map[string]*Header {
+	if x != nil {
+		return x.Headers
+	}
+	return nil
+}
+
+type Invocation struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Kind          Kind                   `protobuf:"varint,1,opt,name=kind,proto3,enum=Superplane.Plugins.Kind" json:"kind,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Method        Method                 `protobuf:"varint,3,opt,name=method,proto3,enum=Superplane.Plugins.Method" json:"method,omitempty"`
+	Configuration []byte                 `protobuf:"bytes,4,opt,name=configuration,proto3" json:"configuration,omitempty"`
+	Execution     *Execution             `protobuf:"bytes,5,opt,name=execution,proto3" json:"execution,omitempty"`
+	Action        string                 `protobuf:"bytes,6,opt,name=action,proto3" json:"action,omitempty"`
+	Parameters    []byte                 `protobuf:"bytes,7,opt,name=parameters,proto3" json:"parameters,omitempty"`
+	Webhook       *WebhookRequest        `protobuf:"bytes,8,opt,name=webhook,proto3" json:"webhook,omitempty"`
+	User          *User                  `protobuf:"bytes,9,opt,name=user,proto3" json:"user,omitempty"`
+	//
+	// Names of the contexts available to the invocation,
+	// like "metadata", "executionState" or "webhook",
+	// following the fields of the core contexts.
+	//
+	Contexts       []string `protobuf:"bytes,10,rep,name=contexts,proto3" json:"contexts,omitempty"`
+	WebhookBaseUrl string   `protobuf:"bytes,11,opt,name=webhook_base_url,json=webhookBaseUrl,proto3" json:"webhook_base_url,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Invocation) Reset() {
+	*x = Invocation{}
+	mi := &file_plugins_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Invocation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Invocation) ProtoMessage() {}
+
+func (x *Invocation) ProtoReflect() protoreflect.Message {
+	mi := &file_plugins_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Invocation.ProtoReflect.Descriptor instead.
+func (*Invocation) Descriptor() ([]byte, []int) {
+	return file_plugins_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *Invocation) GetKind() Kind {
+	if x != nil {
+		return x.Kind
+	}
+	return Kind_KIND_UNSPECIFIED
+}
+
+func (x *Invocation) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Invocation) GetMethod() Method {
+	if x != nil {
+		return x.Method
+	}
+	return Method_METHOD_UNSPECIFIED
+}
+
+func (x *Invocation) GetConfiguration() []byte {
+	if x != nil {
+		return x.Configuration
+	}
+	return nil
+}
+
+func (x *Invocation) GetExecution() *Execution {
+	if x != nil {
+		return x.Execution
+	}
+	return nil
+}
+
+func (x *Invocation) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *Invocation) GetParameters() []byte {
+	if x != nil {
+		return x.Parameters
+	}
+	return nil
+}
+
+func (x *Invocation) GetWebhook() *WebhookRequest {
+	if x != nil {
+		return x.Webhook
+	}
+	return nil
+}
+
+func (x *Invocation) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+func (x *Invocation) GetContexts() []string {
+	if x != nil {
+		return x.Contexts
+	}
+	return nil
+}
+
+func (x *Invocation) GetWebhookBaseUrl() string {
+	if x != nil {
+		return x.WebhookBaseUrl
+	}
+	return ""
+}
+
+type InvocationResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Error         string                 `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
+	StatusCode    int32                  `protobuf:"varint,2,opt,name=status_code,json=statusCode,proto3" json:"status_code,omitempty"`
+	Output        []byte                 `protobuf:"bytes,3,opt,name=output,proto3" json:"output,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InvocationResult) Reset() {
+	*x = InvocationResult{}
+	mi := &file_plugins_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InvocationResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InvocationResult) ProtoMessage() {}
+
+func (x *InvocationResult) ProtoReflect() protoreflect.Message {
+	mi := &file_plugins_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InvocationResult.ProtoReflect.Descriptor instead.
+func (*InvocationResult) Descriptor() ([]byte, []int) {
+	return file_plugins_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *InvocationResult) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *InvocationResult) GetStatusCode() int32 {
+	if x != nil {
+		return x.StatusCode
+	}
+	return 0
+}
+
+func (x *InvocationResult) GetOutput() []byte {
+	if x != nil {
+		return x.Output
+	}
+	return nil
+}
+
+type HostMessage struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Message:
+	//
+	//	*HostMessage_Invocation
+	//	*HostMessage_CallbackResponse
+	Message       isHostMessage_Message `protobuf_oneof:"message"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HostMessage) Reset() {
+	*x = HostMessage{}
+	mi := &file_plugins_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HostMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HostMessage) ProtoMessage() {}
+
+func (x *HostMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_plugins_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HostMessage.ProtoReflect.Descriptor instead.
+func (*HostMessage) Descriptor() ([]byte, []int) {
+	return file_plugins_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *HostMessage) GetMessage() isHostMessage_Message {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+func (x *HostMessage) GetInvocation() *Invocation {
+	if x != nil {
+		if x, ok := x.Message.(*HostMessage_Invocation); ok {
+			return x.Invocation
+		}
+	}
+	return nil
+}
+
+func (x *HostMessage) GetCallbackResponse() *CallbackResponse {
+	if x != nil {
+		if x, ok := x.Message.(*HostMessage_CallbackResponse); ok {
+			return x.CallbackResponse
+		}
+	}
+	return nil
+}
+
+type isHostMessage_Message interface {
+	isHostMessage_Message()
+}
+
+type HostMessage_Invocation struct {
+	Invocation *Invocation `protobuf:"bytes,1,opt,name=invocation,proto3,oneof"`
+}
+
+type HostMessage_CallbackResponse struct {
+	CallbackResponse *CallbackResponse `protobuf:"bytes,2,opt,name=callback_response,json=callbackResponse,proto3,oneof"`
+}
+
+func (*HostMessage_Invocation) isHostMessage_Message() {}
+
+func (*HostMessage_CallbackResponse) isHostMessage_Message() {}
+
+type PluginMessage struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Message:
+	//
+	//	*PluginMessage_Callback
+	//	*PluginMessage_Result
+	Message       isPluginMessage_Message `protobuf_oneof:"message"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PluginMessage) Reset() {
+	*x = PluginMessage{}
+	mi := &file_plugins_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PluginMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PluginMessage) ProtoMessage() {}
+
+func (x *PluginMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_plugins_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PluginMessage.ProtoReflect.Descriptor instead.
+func (*PluginMessage) Descriptor() ([]byte, []int) {
+	return file_plugins_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *PluginMessage) GetMessage() isPluginMessage_Message {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+func (x *PluginMessage) GetCallback() *Callback {
+	if x != nil {
+		if x, ok := x.Message.(*PluginMessage_Callback); ok {
+			return x.Callback
+		}
+	}
+	return nil
+}
+
+func (x *PluginMessage) GetResult() *InvocationResult {
+	if x != nil {
+		if x, ok := x.Message.(*PluginMessage_Result); ok {
+			return x.Result
+		}
+	}
+	return nil
+}
+
+type isPluginMessage_Message interface {
+	isPluginMessage_Message()
+}
+
+type PluginMessage_Callback struct {
+	Callback *Callback `protobuf:"bytes,1,opt,name=callback,proto3,oneof"`
+}
+
+type PluginMessage_Result struct {
+	Result *InvocationResult `protobuf:"bytes,2,opt,name=result,proto3,oneof"`
+}
+
+func (*PluginMessage_Callback) isPluginMessage_Message() {}
+
+func (*PluginMessage_Result) isPluginMessage_Message() {}
+
+type GetMetadata struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Field         MetadataField          `protobuf:"varint,1,opt,name=field,proto3,enum=Superplane.Plugins.MetadataField" json:"field,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMetadata) Reset() {
+	*x = GetMetadata{}
+	mi := &file_plugins_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMetadata) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMetadata) ProtoMessage() {}
+
+func (x *GetMetadata) ProtoReflect() protoreflect.Message {
+	mi := &file_plugins_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMetadata.ProtoReflect.Descriptor instead.
+func (*GetMetadata) Descriptor() ([]byte, []int) {
+	return file_plugins_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *GetMetadata) GetField() MetadataField {
+	if x != nil {
+		return x.Field
+	}
+	return MetadataField_METADATA_FIELD_UNSPECIFIED
+}
+
+type SetMetadata struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Field         MetadataField          `protobuf:"varint,1,opt,name=field,proto3,enum=Superplane.Plugins.MetadataField" json:"field,omitempty"`
+	Value         []byte                 `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetMetadata) Reset() {
+	*x = SetMetadata{}
+	mi := &file_plugins_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetMetadata) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetMetadata) ProtoMessage() {}
+
+func (x *SetMetadata) ProtoReflect() protoreflect.Message {
+	mi := &file_plugins_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetMetadata.ProtoReflect.Descriptor instead.
+func (*SetMetadata) Descriptor() ([]byte, []int) {
+	return file_plugins_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *SetMetadata) GetField() MetadataField {
+	if x != nil {
+		return x.Field
+	}
+	return MetadataField_METADATA_FIELD_UNSPECIFIED
+}
+
+func (x *SetMetadata) GetValue() []byte {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+type IsFinished struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IsFinished) Reset() {
+	*x = IsFinished{}
+	mi := &file_plugins_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IsFinished) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IsFinished) ProtoMessage() {}
+
+func (x *IsFinished) ProtoReflect() protoreflect.Message {
+	mi := &file_plugins_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IsFinished.ProtoReflect.Descriptor instead.
+func (*IsFinished) Descriptor() ([]byte, []int) {
+	return file_plugins_proto_rawDescGZIP(), []int{22}
+}
+
+type SetKV struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Value         string                 `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetKV) Reset() {
+	*x = SetKV{}
+	mi := &file_plugins_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetKV) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetKV) ProtoMessage() {}
+
+func (x *SetKV) ProtoReflect() protoreflect.Message {
+	mi := &file_plugins_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetKV.ProtoReflect.Descriptor instead.
+func (*SetKV) Descriptor() ([]byte, []int) {
+	return file_plugins_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *SetKV) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *SetKV) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+type EmitOutput struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Channel       string                 `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"`
+	PayloadType   string                 `protobuf:"bytes,2,opt,name=payload_type,json=payloadType,proto3" json:"payload_type,omitempty"`
+	Payloads      []byte                 `protobuf:"bytes,3,opt,name=payloads,proto3" json:"payloads,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EmitOutput) Reset() {
+	*x = EmitOutput{}
+	mi := &file_plugins_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EmitOutput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EmitOutput) ProtoMessage() {}
+
+func (x *EmitOutput) ProtoReflect() protoreflect.Message {
+	mi := &file_plugins_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EmitOutput.ProtoReflect.Descriptor instead.
+func (*EmitOutput) Descriptor() ([]byte, []int) {
+	return file_plugins_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *EmitOutput) GetChannel() string {
+	if x != nil {
+		return x.Channel
+	}
+	return ""
+}
+
+func (x *EmitOutput) GetPayloadType() string {
+	if x != nil {
+		return x.PayloadType
+	}
+	return ""
+}
+
+func (x *EmitOutput) GetPayloads() []byte {
+	if x != nil {
+		return x.Payloads
+	}
+	return nil
+}
+
+type Pass struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Pass) Reset() {
+	*x = Pass{}
+	mi := &file_plugins_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Pass) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Pass) ProtoMessage() {}
+
+func (x *Pass) ProtoReflect() protoreflect.Message {
+	mi := &file_plugins_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Pass.ProtoReflect.Descriptor instead.
+func (*Pass) Descriptor() ([]byte, []int) {
+	return file_plugins_proto_rawDescGZIP(), []int{25}
+}
+
+type Fail struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Reason        string                 `protobuf:"bytes,1,opt,name=reason,proto3" json:"reason,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Fail) Reset() {
+	*x = Fail{}
+	mi := &file_plugins_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Fail) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Fail) ProtoMessage() {}
+
+func (x *Fail) ProtoReflect() protoreflect.Message {
+	mi := &file_plugins_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Fail.ProtoReflect.Descriptor instead.
+func (*Fail) Descriptor() ([]byte, []int) {
+	return file_plugins_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *Fail) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *Fail) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type WriteLog struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Stream        LogStream              `protobuf:"varint,1,opt,name=stream,proto3,enum=Superplane.Plugins.LogStream" json:"stream,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WriteLog) Reset() {
+	*x = WriteLog{}
+	mi := &file_plugins_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WriteLog) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WriteLog) ProtoMessage() {}
+
+func (x *WriteLog) ProtoReflect() protoreflect.Message {
+	mi := &file_plugins_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WriteLog.ProtoReflect.Descriptor instead.
+func (*WriteLog) Descriptor() ([]byte, []int) {
+	return file_plugins_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *WriteLog) GetStream() LogStream {
+	if x != nil {
+		return x.Stream
+	}
+	return LogStream_LOG_STREAM_UNSPECIFIED
+}
+
+func (x *WriteLog) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type PutArtifact struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	ContentType   string                 `protobuf:"bytes,2,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	Content       []byte                 `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PutArtifact) Reset() {
+	*x = PutArtifact{}
+	mi := &file_plugins_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PutArtifact) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PutArtifact) ProtoMessage() {}
+
+func (x *PutArtifact) ProtoReflect() protoreflect.Message {
+	mi := &file_plugins_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PutArtifact.ProtoReflect.Descriptor instead.
+func (*PutArtifact) Descriptor() ([]byte, []int) {
+	return file_plugins_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *PutArtifact) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PutArtifact) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *PutArtifact) GetContent() []byte {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+type HTTPRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Method        string                 `protobuf:"bytes,1,opt,name=method,proto3" json:"method,omitempty"`
+	Url           string                 `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	Headers       map[string]*Header     `protobuf:"bytes,3,rep,name=headers,proto3" json:"headers,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Body          []byte                 `protobuf:"bytes,4,opt,name=body,proto3" json:"body,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HTTPRequest) Reset() {
+	*x = HTTPRequest{}
+	mi := &file_plugins_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HTTPRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HTTPRequest) ProtoMessage() {}
+
+func (x *HTTPRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_plugins_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HTTPRequest.ProtoReflect.Descriptor instead.
+func (*HTTPRequest) Descriptor() ([]byte, []int) {
+	return file_plugins_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *HTTPRequest) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *HTTPRequest) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *HTTPRequest) GetHeaders() map[string]*Header {
+	if x != nil {
+		return x.Headers
+	}
+	return nil
+}
+
+func (x *HTTPRequest) GetBody() []byte {
+	if x != nil {
+		return x.Body
+	}
+	return nil
+}
+
+type HTTPResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StatusCode    int32                  `protobuf:"varint,1,opt,name=status_code,json=statusCode,proto3" json:"status_code,omitempty"`
+	Headers       map[string]*Header     `protobuf:"bytes,2,rep,name=headers,proto3" json:"headers,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Body          []byte                 `protobuf:"bytes,3,opt,name=body,proto3" json:"body,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HTTPResponse) Reset() {
+	*x = HTTPResponse{}
+	mi := &file_plugins_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HTTPResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HTTPResponse) ProtoMessage() {}
+
+func (x *HTTPResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_plugins_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HTTPResponse.ProtoReflect.Descriptor instead.
+func (*HTTPResponse) Descriptor() ([]byte, []int) {
+	return file_plugins_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *HTTPResponse) GetStatusCode() int32 {
+	if x != nil {
+		return x.StatusCode
+	}
+	return 0
+}
+
+func (x *HTTPResponse) GetHeaders() map[string]*Header {
+	if x != nil {
+		return x.Headers
+	}
+	return nil
+}
+
+func (x *HTTPResponse) GetBody() []byte {
+	if x != nil {
+		return x.Body
+	}
+	return nil
+}
+
+type GetSecretKey struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Secret        string                 `protobuf:"bytes,1,opt,name=secret,proto3" json:"secret,omitempty"`
+	Key           string                 `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSecretKey) Reset() {
+	*x = GetSecretKey{}
+	mi := &file_plugins_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSecretKey) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSecretKey) ProtoMessage() {}
+
+func (x *GetSecretKey) ProtoReflect() protoreflect.Message {
+	mi := &file_plugins_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSecretKey.ProtoReflect.Descriptor instead.
+func (*GetSecretKey) Descriptor() ([]byte, []int) {
+	return file_plugins_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *GetSecretKey) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+func (x *GetSecretKey) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+type ScheduleActionCall struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Action          string                 `protobuf:"bytes,1,opt,name=action,proto3" json:"action,omitempty"`
+	Parameters      []byte                 `protobuf:"bytes,2,opt,name=parameters,proto3" json:"parameters,omitempty"`
+	IntervalSeconds int64                  `protobuf:"varint,3,opt,name=interval_seconds,json=intervalSeconds,proto3" json:"interval_seconds,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ScheduleActionCall) Reset() {
+	*x = ScheduleActionCall{}
+	mi := &file_plugins_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScheduleActionCall) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduleActionCall) ProtoMessage() {}
+
+func (x *ScheduleActionCall) ProtoReflect() protoreflect.Message {
+	mi := &file_plugins_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScheduleActionCall.ProtoReflect.Descriptor instead.
+func (*ScheduleActionCall) Descriptor() ([]byte, []int) {
+	return file_plugins_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *ScheduleActionCall) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *ScheduleActionCall) GetParameters() []byte {
+	if x != nil {
+		return x.Parameters
+	}
+	return nil
+}
+
+func (x *ScheduleActionCall) GetIntervalSeconds() int64 {
+	if x != nil {
+		return x.IntervalSeconds
+	}
+	return 0
+}
+
+type EmitEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PayloadType   string                 `protobuf:"bytes,1,opt,name=payload_type,json=payloadType,proto3" json:"payload_type,omitempty"`
+	Payload       []byte                 `protobuf:"bytes,2,opt,name=payload,proto3" json:"payload,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EmitEvent) Reset() {
+	*x = EmitEvent{}
+	mi := &file_plugins_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EmitEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EmitEvent) ProtoMessage() {}
+
+func (x *EmitEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_plugins_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EmitEvent.ProtoReflect.Descriptor instead.
+func (*EmitEvent) Descriptor() ([]byte, []int) {
+	return file_plugins_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *EmitEvent) GetPayloadType() string {
+	if x != nil {
+		return x.PayloadType
+	}
+	return ""
+}
+
+func (x *EmitEvent) GetPayload() []byte {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+type SendNotification struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Body          string                 `protobuf:"bytes,2,opt,name=body,proto3" json:"body,omitempty"`
+	Url           string                 `protobuf:"bytes,3,opt,name=url,proto3" json:"url,omitempty"`
+	UrlLabel      string                 `protobuf:"bytes,4,opt,name=url_label,json=urlLabel,proto3" json:"url_label,omitempty"`
+	Emails        []string               `protobuf:"bytes,5,rep,name=emails,proto3" json:"emails,omitempty"`
+	Groups        []string               `protobuf:"bytes,6,rep,name=groups,proto3" json:"groups,omitempty"`
+	Roles         []string               `protobuf:"bytes,7,rep,name=roles,proto3" json:"roles,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SendNotification) Reset() {
+	*x = SendNotification{}
+	mi := &file_plugins_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendNotification) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendNotification) ProtoMessage() {}
+
+func (x *SendNotification) ProtoReflect() protoreflect.Message {
+	mi := &file_plugins_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendNotification.ProtoReflect.Descriptor instead.
+func (*SendNotification) Descriptor() ([]byte, []int) {
+	return file_plugins_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *SendNotification) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *SendNotification) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+func (x *SendNotification) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *SendNotification) GetUrlLabel() string {
+	if x != nil {
+		return x.UrlLabel
+	}
+	return ""
+}
+
+func (x *SendNotification) GetEmails() []string {
+	if x != nil {
+		return x.Emails
+	}
+	return nil
+}
+
+func (x *SendNotification) GetGroups() []string {
+	if x != nil {
+		return x.Groups
+	}
+	return nil
+}
+
+func (x *SendNotification) GetRoles() []string {
+	if x != nil {
+		return x.Roles
+	}
+	return nil
+}
+
+type SetupWebhook struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetupWebhook) Reset() {
+	*x = SetupWebhook{}
+	mi := &file_plugins_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetupWebhook) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetupWebhook) ProtoMessage() {}
+
+func (x *SetupWebhook) ProtoReflect() protoreflect.Message {
+	mi := &file_plugins_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetupWebhook.ProtoReflect.Descriptor instead.
+func (*SetupWebhook) Descriptor() ([]byte, []int) {
+	return file_plugins_proto_rawDescGZIP(), []int{35}
+}
+
+type GetWebhookSecret struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetWebhookSecret) Reset() {
+	*x = GetWebhookSecret{}
+	mi := &file_plugins_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetWebhookSecret) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWebhookSecret) ProtoMessage() {}
+
+func (x *GetWebhookSecret) ProtoReflect() protoreflect.Message {
+	mi := &file_plugins_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWebhookSecret.ProtoReflect.Descriptor instead.
+func (*GetWebhookSecret) Descriptor() ([]byte, []int) {
+	return file_plugins_proto_rawDescGZIP(), []int{36}
+}
+
+type SetWebhookSecret struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Secret        []byte                 `protobuf:"bytes,1,opt,name=secret,proto3" json:"secret,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetWebhookSecret) Reset() {
+	*x = SetWebhookSecret{}
+	mi := &file_plugins_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetWebhookSecret) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetWebhookSecret) ProtoMessage() {}
+
+func (x *SetWebhookSecret) ProtoReflect() protoreflect.Message {
+	mi := &file_plugins_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetWebhookSecret.ProtoReflect.Descriptor instead.
+func (*SetWebhookSecret) Descriptor() ([]byte, []int) {
+	return file_plugins_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *SetWebhookSecret) GetSecret() []byte {
+	if x != nil {
+		return x.Secret
+	}
+	return nil
+}
+
+type Callback struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Types that are valid to be assigned to Request:
+	//
+	//	*Callback_GetMetadata
+	//	*Callback_SetMetadata
+	//	*Callback_IsFinished
+	//	*Callback_SetKv
+	//	*Callback_EmitOutput
+	//	*Callback_Pass
+	//	*Callback_Fail
+	//	*Callback_WriteLog
+	//	*Callback_PutArtifact
+	//	*Callback_HttpRequest
+	//	*Callback_GetSecretKey
+	//	*Callback_ScheduleActionCall
+	//	*Callback_EmitEvent
+	//	*Callback_SendNotification
+	//	*Callback_SetupWebhook
+	//	*Callback_GetWebhookSecret
+	//	*Callback_SetWebhookSecret
+	Request       isCallback_Request `protobuf_oneof:"request"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Callback) Reset() {
+	*x = Callback{}
+	mi := &file_plugins_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Callback) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Callback) ProtoMessage() {}
+
+func (x *Callback) ProtoReflect() protoreflect.Message {
+	mi := &file_plugins_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Callback.ProtoReflect.Descriptor instead.
+func (*Callback) Descriptor() ([]byte, []int) {
+	return file_plugins_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *Callback) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Callback) GetRequest() isCallback_Request {
+	if x != nil {
+		return x.Request
+	}
+	return nil
+}
+
+func (x *Callback) GetGetMetadata() *GetMetadata {
+	if x != nil {
+		if x, ok := x.Request.(*Callback_GetMetadata); ok {
+			return x.GetMetadata
+		}
+	}
+	return nil
+}
+
+func (x *Callback) GetSetMetadata() *SetMetadata {
+	if x != nil {
+		if x, ok := x.Request.(*Callback_SetMetadata); ok {
+			return x.SetMetadata
+		}
+	}
+	return nil
+}
+
+func (x *Callback) GetIsFinished() *IsFinished {
+	if x != nil {
+		if x, ok := x.Request.(*Callback_IsFinished); ok {
+			return x.IsFinished
+		}
+	}
+	return nil
+}
+
+func (x *Callback) GetSetKv() *SetKV {
+	if x != nil {
+		if x, ok := x.Request.(*Callback_SetKv); ok {
+			return x.SetKv
+		}
+	}
+	return nil
+}
+
+func (x *Callback) GetEmitOutput() *EmitOutput {
+	if x != nil {
+		if x, ok := x.Request.(*Callback_EmitOutput); ok {
+			return x.EmitOutput
+		}
+	}
+	return nil
+}
+
+func (x *Callback) GetPass() *Pass {
+	if x != nil {
+		if x, ok := x.Request.(*Callback_Pass); ok {
+			return x.Pass
+		}
+	}
+	return nil
+}
+
+func (x *Callback) GetFail() *Fail {
+	if x != nil {
+		if x, ok := x.Request.(*Callback_Fail); ok {
+			return x.Fail
+		}
+	}
+	return nil
+}
+
+func (x *Callback) GetWriteLog() *WriteLog {
+	if x != nil {
+		if x, ok := x.Request.(*Callback_WriteLog); ok {
+			return x.WriteLog
+		}
+	}
+	return nil
+}
+
+func (x *Callback) GetPutArtifact() *PutArtifact {
+	if x != nil {
+		if x, ok := x.Request.(*Callback_PutArtifact); ok {
+			return x.PutArtifact
+		}
+	}
+	return nil
+}
+
+func (x *Callback) GetHttpRequest() *HTTPRequest {
+	if x != nil {
+		if x, ok := x.Request.(*Callback_HttpRequest); ok {
+			return x.HttpRequest
+		}
+	}
+	return nil
+}
+
+func (x *Callback) GetGetSecretKey() *GetSecretKey {
+	if x != nil {
+		if x, ok := x.Request.(*Callback_GetSecretKey); ok {
+			return x.GetSecretKey
+		}
+	}
+	return nil
+}
+
+func (x *Callback) GetScheduleActionCall() *ScheduleActionCall {
+	if x != nil {
+		if x, ok := x.Request.(*Callback_ScheduleActionCall); ok {
+			return x.ScheduleActionCall
+		}
+	}
+	return nil
+}
+
+func (x *Callback) GetEmitEvent() *EmitEvent {
+	if x != nil {
+		if x, ok := x.Request.(*Callback_EmitEvent); ok {
+			return x.EmitEvent
+		}
+	}
+	return nil
+}
+
+func (x *Callback) GetSendNotification() *SendNotification {
+	if x != nil {
+		if x, ok := x.Request.(*Callback_SendNotification); ok {
+			return x.SendNotification
+		}
+	}
+	return nil
+}
+
+func (x *Callback) GetSetupWebhook() *SetupWebhook {
+	if x != nil {
+		if x, ok := x.Request.(*Callback_SetupWebhook); ok {
+			return x.SetupWebhook
+		}
+	}
+	return nil
+}
+
+func (x *Callback) GetGetWebhookSecret() *GetWebhookSecret {
+	if x != nil {
+		if x, ok := x.Request.(*Callback_GetWebhookSecret); ok {
+			return x.GetWebhookSecret
+		}
+	}
+	return nil
+}
+
+func (x *Callback) GetSetWebhookSecret() *SetWebhookSecret {
+	if x != nil {
+		if x, ok := x.Request.(*Callback_SetWebhookSecret); ok {
+			return x.SetWebhookSecret
+		}
+	}
+	return nil
+}
+
+type isCallback_Request interface {
+	isCallback_Request()
+}
+
+type Callback_GetMetadata struct {
+	GetMetadata *GetMetadata `protobuf:"bytes,2,opt,name=get_metadata,json=getMetadata,proto3,oneof"`
+}
+
+type Callback_SetMetadata struct {
+	SetMetadata *SetMetadata `protobuf:"bytes,3,opt,name=set_metadata,json=setMetadata,proto3,oneof"`
+}
+
+type Callback_IsFinished struct {
+	IsFinished *IsFinished `protobuf:"bytes,4,opt,name=is_finished,json=isFinished,proto3,oneof"`
+}
+
+type Callback_SetKv struct {
+	SetKv *SetKV `protobuf:"bytes,5,opt,name=set_kv,json=setKv,proto3,oneof"`
+}
+
+type Callback_EmitOutput struct {
+	EmitOutput *EmitOutput `protobuf:"bytes,6,opt,name=emit_output,json=emitOutput,proto3,oneof"`
+}
+
+type Callback_Pass struct {
+	Pass *Pass `protobuf:"bytes,7,opt,name=pass,proto3,oneof"`
+}
+
+type Callback_Fail struct {
+	Fail *Fail `protobuf:"bytes,8,opt,name=fail,proto3,oneof"`
+}
+
+type Callback_WriteLog struct {
+	WriteLog *WriteLog `protobuf:"bytes,9,opt,name=write_log,json=writeLog,proto3,oneof"`
+}
+
+type Callback_PutArtifact struct {
+	PutArtifact *PutArtifact `protobuf:"bytes,10,opt,name=put_artifact,json=putArtifact,proto3,oneof"`
+}
+
+type Callback_HttpRequest struct {
+	HttpRequest *HTTPRequest `protobuf:"bytes,11,opt,name=http_request,json=httpRequest,proto3,oneof"`
+}
+
+type Callback_GetSecretKey struct {
+	GetSecretKey *GetSecretKey `protobuf:"bytes,12,opt,name=get_secret_key,json=getSecretKey,proto3,oneof"`
+}
+
+type Callback_ScheduleActionCall struct {
+	ScheduleActionCall *ScheduleActionCall `protobuf:"bytes,13,opt,name=schedule_action_call,json=scheduleActionCall,proto3,oneof"`
+}
+
+type Callback_EmitEvent struct {
+	EmitEvent *EmitEvent `protobuf:"bytes,14,opt,name=emit_event,json=emitEvent,proto3,oneof"`
+}
+
+type Callback_SendNotification struct {
+	SendNotification *SendNotification `protobuf:"bytes,15,opt,name=send_notification,json=sendNotification,proto3,oneof"`
+}
+
+type Callback_SetupWebhook struct {
+	SetupWebhook *SetupWebhook `protobuf:"bytes,16,opt,name=setup_webhook,json=setupWebhook,proto3,oneof"`
+}
+
+type Callback_GetWebhookSecret struct {
+	GetWebhookSecret *GetWebhookSecret `protobuf:"bytes,17,opt,name=get_webhook_secret,json=getWebhookSecret,proto3,oneof"`
+}
+
+type Callback_SetWebhookSecret struct {
+	SetWebhookSecret *SetWebhookSecret `protobuf:"bytes,18,opt,name=set_webhook_secret,json=setWebhookSecret,proto3,oneof"`
+}
+
+func (*Callback_GetMetadata) isCallback_Request() {}
+
+func (*Callback_SetMetadata) isCallback_Request() {}
+
+func (*Callback_IsFinished) isCallback_Request() {}
+
+func (*Callback_SetKv) isCallback_Request() {}
+
+func (*Callback_EmitOutput) isCallback_Request() {}
+
+func (*Callback_Pass) isCallback_Request() {}
+
+func (*Callback_Fail) isCallback_Request() {}
+
+func (*Callback_WriteLog) isCallback_Request() {}
+
+func (*Callback_PutArtifact) isCallback_Request() {}
+
+func (*Callback_HttpRequest) isCallback_Request() {}
+
+func (*Callback_GetSecretKey) isCallback_Request() {}
+
+func (*Callback_ScheduleActionCall) isCallback_Request() {}
+
+func (*Callback_EmitEvent) isCallback_Request() {}
+
+func (*Callback_SendNotification) isCallback_Request() {}
+
+func (*Callback_SetupWebhook) isCallback_Request() {}
+
+func (*Callback_GetWebhookSecret) isCallback_Request() {}
+
+func (*Callback_SetWebhookSecret) isCallback_Request() {}
+
+// The value depends on the callback: JSON for metadata, artifacts
+// and the finished state, the raw bytes of secrets and webhook secrets,
+// and the URL for webhooks.
+type CallbackResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Error         string                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	Value         []byte                 `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+	HttpResponse  *HTTPResponse          `protobuf:"bytes,4,opt,name=http_response,json=httpResponse,proto3" json:"http_response,omitempty"`
+	NotFound      bool                   `protobuf:"varint,5,opt,name=not_found,json=notFound,proto3" json:"not_found,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CallbackResponse) Reset() {
+	*x = CallbackResponse{}
+	mi := &file_plugins_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CallbackResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CallbackResponse) ProtoMessage() {}
+
+func (x *CallbackResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_plugins_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CallbackResponse.ProtoReflect.Descriptor instead.
+func (*CallbackResponse) Descriptor() ([]byte, []int) {
+	return file_plugins_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *CallbackResponse) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *CallbackResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *CallbackResponse) GetValue() []byte {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+func (x *CallbackResponse) GetHttpResponse() *HTTPResponse {
+	if x != nil {
+		return x.HttpResponse
+	}
+	return nil
+}
+
+func (x *CallbackResponse) GetNotFound() bool {
+	if x != nil {
+		return x.NotFound
+	}
+	return false
+}
+
+var File_plugins_proto protoreflect.FileDescriptor
+
+const file_plugins_proto_rawDesc = "" +
+	"\n" +
+	"\rplugins.proto\x12\x12Superplane.Plugins\x1a\x1bgoogle/protobuf/empty.proto\"=\n" +
+	"\x10HandshakeRequest\x12)\n" +
+	"\x10protocol_version\x18\x01 \x01(\rR\x0fprotocolVersion\"\x90\x01\n" +
+	"\x11HandshakeResponse\x12)\n" +
+	"\x10protocol_version\x18\x01 \x01(\rR\x0fprotocolVersion\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x18\n" +
+	"\aversion\x18\x03 \x01(\tR\aversion\x12\"\n" +
+	"\fcapabilities\x18\x04 \x03(\tR\fcapabilities\"[\n" +
+	"\rOutputChannel\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x14\n" +
+	"\x05label\x18\x02 \x01(\tR\x05label\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\"\x87\x01\n" +
+	"\x06Action\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\x12'\n" +
+	"\x0fuser_accessible\x18\x03 \x01(\bR\x0euserAccessible\x12\x1e\n" +
+	"\n" +
+	"parameters\x18\x04 \x01(\fR\n" +
+	"parameters\"\xb8\x03\n" +
+	"\x13ComponentDefinition\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x14\n" +
+	"\x05label\x18\x02 \x01(\tR\x05label\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12$\n" +
+	"\rdocumentation\x18\x04 \x01(\tR\rdocumentation\x12\x12\n" +
+	"\x04icon\x18\x05 \x01(\tR\x04icon\x12\x14\n" +
+	"\x05color\x18\x06 \x01(\tR\x05color\x12%\n" +
+	"\x0eexample_output\x18\a \x01(\fR\rexampleOutput\x12$\n" +
+	"\rconfiguration\x18\b \x01(\fR\rconfiguration\x12J\n" +
+	"\x0foutput_channels\x18\t \x03(\v2!.Superplane.Plugins.OutputChannelR\x0eoutputChannels\x126\n" +
+	"\x17dynamic_output_channels\x18\n" +
+	" \x01(\bR\x15dynamicOutputChannels\x124\n" +
+	"\aactions\x18\v \x03(\v2\x1a.Superplane.Plugins.ActionR\aactions\"\xd3\x02\n" +
+	"\x11TriggerDefinition\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x14\n" +
+	"\x05label\x18\x02 \x01(\tR\x05label\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12$\n" +
+	"\rdocumentation\x18\x04 \x01(\tR\rdocumentation\x12\x12\n" +
+	"\x04icon\x18\x05 \x01(\tR\x04icon\x12\x14\n" +
+	"\x05color\x18\x06 \x01(\tR\x05color\x12!\n" +
+	"\fexample_data\x18\a \x01(\fR\vexampleData\x12$\n" +
+	"\rconfiguration\x18\b \x01(\fR\rconfiguration\x124\n" +
+	"\aactions\x18\t \x03(\v2\x1a.Superplane.Plugins.ActionR\aactions\x12#\n" +
+	"\raccepts_input\x18\n" +
+	" \x01(\bR\facceptsInput\"a\n" +
+	"\x16ListComponentsResponse\x12G\n" +
+	"\n" +
+	"components\x18\x01 \x03(\v2'.Superplane.Plugins.ComponentDefinitionR\n" +
+	"components\"Y\n" +
+	"\x14ListTriggersResponse\x12A\n" +
+	"\btriggers\x18\x01 \x03(\v2%.Superplane.Plugins.TriggerDefinitionR\btriggers\"[\n" +
+	"\x15OutputChannelsRequest\x12\x1c\n" +
+	"\tcomponent\x18\x01 \x01(\tR\tcomponent\x12$\n" +
+	"\rconfiguration\x18\x02 \x01(\fR\rconfiguration\"d\n" +
+	"\x16OutputChannelsResponse\x12J\n" +
+	"\x0foutput_channels\x18\x01 \x03(\v2!.Superplane.Plugins.OutputChannelR\x0eoutputChannels\"l\n" +
+	"\x14ValidateInputRequest\x12\x18\n" +
+	"\atrigger\x18\x01 \x01(\tR\atrigger\x12$\n" +
+	"\rconfiguration\x18\x02 \x01(\fR\rconfiguration\x12\x14\n" +
+	"\x05input\x18\x03 \x01(\fR\x05input\"C\n" +
+	"\x15ValidateInputResponse\x12\x14\n" +
+	"\x05input\x18\x01 \x01(\fR\x05input\x12\x14\n" +
+	"\x05error\x18\x02 \x01(\tR\x05error\"\xd3\x01\n" +
+	"\tExecution\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1f\n" +
+	"\vworkflow_id\x18\x02 \x01(\tR\n" +
+	"workflowId\x12'\n" +
+	"\x0forganization_id\x18\x03 \x01(\tR\x0eorganizationId\x12\x17\n" +
+	"\anode_id\x18\x04 \x01(\tR\x06nodeId\x12$\n" +
+	"\x0esource_node_id\x18\x05 \x01(\tR\fsourceNodeId\x12\x19\n" +
+	"\bbase_url\x18\x06 \x01(\tR\abaseUrl\x12\x12\n" +
+	"\x04data\x18\a \x01(\fR\x04data\"@\n" +
+	"\x04User\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
+	"\x05email\x18\x03 \x01(\tR\x05email\" \n" +
+	"\x06Header\x12\x16\n" +
+	"\x06values\x18\x01 \x03(\tR\x06values\"\x81\x02\n" +
+	"\x0eWebhookRequest\x12\x1f\n" +
+	"\vworkflow_id\x18\x01 \x01(\tR\n" +
+	"workflowId\x12\x17\n" +
+	"\anode_id\x18\x02 \x01(\tR\x06nodeId\x12\x12\n" +
+	"\x04body\x18\x03 \x01(\fR\x04body\x12I\n" +
+	"\aheaders\x18\x04 \x03(\v2/.Superplane.Plugins.WebhookRequest.HeadersEntryR\aheaders\x1aV\n" +
+	"\fHeadersEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x120\n" +
+	"\x05value\x18\x02 \x01(\v2\x1a.Superplane.Plugins.HeaderR\x05value:\x028\x01\"\xcf\x03\n" +
+	"\n" +
+	"Invocation\x12,\n" +
+	"\x04kind\x18\x01 \x01(\x0e2\x18.Superplane.Plugins.KindR\x04kind\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x122\n" +
+	"\x06method\x18\x03 \x01(\x0e2\x1a.Superplane.Plugins.MethodR\x06method\x12$\n" +
+	"\rconfiguration\x18\x04 \x01(\fR\rconfiguration\x12;\n" +
+	"\texecution\x18\x05 \x01(\v2\x1d.Superplane.Plugins.ExecutionR\texecution\x12\x16\n" +
+	"\x06action\x18\x06 \x01(\tR\x06action\x12\x1e\n" +
+	"\n" +
+	"parameters\x18\a \x01(\fR\n" +
+	"parameters\x12<\n" +
+	"\awebhook\x18\b \x01(\v2\".Superplane.Plugins.WebhookRequestR\awebhook\x12,\n" +
+	"\x04user\x18\t \x01(\v2\x18.Superplane.Plugins.UserR\x04user\x12\x1a\n" +
+	"\bcontexts\x18\n" +
+	" \x03(\tR\bcontexts\x12(\n" +
+	"\x10webhook_base_url\x18\v \x01(\tR\x0ewebhookBaseUrl\"a\n" +
+	"\x10InvocationResult\x12\x14\n" +
+	"\x05error\x18\x01 \x01(\tR\x05error\x12\x1f\n" +
+	"\vstatus_code\x18\x02 \x01(\x05R\n" +
+	"statusCode\x12\x16\n" +
+	"\x06output\x18\x03 \x01(\fR\x06output\"\xaf\x01\n" +
+	"\vHostMessage\x12@\n" +
+	"\n" +
+	"invocation\x18\x01 \x01(\v2\x1e.Superplane.Plugins.InvocationH\x00R\n" +
+	"invocation\x12S\n" +
+	"\x11callback_response\x18\x02 \x01(\v2$.Superplane.Plugins.CallbackResponseH\x00R\x10callbackResponseB\t\n" +
+	"\amessage\"\x96\x01\n" +
+	"\rPluginMessage\x12:\n" +
+	"\bcallback\x18\x01 \x01(\v2\x1c.Superplane.Plugins.CallbackH\x00R\bcallback\x12>\n" +
+	"\x06result\x18\x02 \x01(\v2$.Superplane.Plugins.InvocationResultH\x00R\x06resultB\t\n" +
+	"\amessage\"F\n" +
+	"\vGetMetadata\x127\n" +
+	"\x05field\x18\x01 \x01(\x0e2!.Superplane.Plugins.MetadataFieldR\x05field\"\\\n" +
+	"\vSetMetadata\x127\n" +
+	"\x05field\x18\x01 \x01(\x0e2!.Superplane.Plugins.MetadataFieldR\x05field\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\fR\x05value\"\f\n" +
+	"\n" +
+	"IsFinished\"/\n" +
+	"\x05SetKV\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value\"e\n" +
+	"\n" +
+	"EmitOutput\x12\x18\n" +
+	"\achannel\x18\x01 \x01(\tR\achannel\x12!\n" +
+	"\fpayload_type\x18\x02 \x01(\tR\vpayloadType\x12\x1a\n" +
+	"\bpayloads\x18\x03 \x01(\fR\bpayloads\"\x06\n" +
+	"\x04Pass\"8\n" +
+	"\x04Fail\x12\x16\n" +
+	"\x06reason\x18\x01 \x01(\tR\x06reason\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"[\n" +
+	"\bWriteLog\x125\n" +
+	"\x06stream\x18\x01 \x01(\x0e2\x1d.Superplane.Plugins.LogStreamR\x06stream\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"^\n" +
+	"\vPutArtifact\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12!\n" +
+	"\fcontent_type\x18\x02 \x01(\tR\vcontentType\x12\x18\n" +
+	"\acontent\x18\x03 \x01(\fR\acontent\"\xeb\x01\n" +
+	"\vHTTPRequest\x12\x16\n" +
+	"\x06method\x18\x01 \x01(\tR\x06method\x12\x10\n" +
+	"\x03url\x18\x02 \x01(\tR\x03url\x12F\n" +
+	"\aheaders\x18\x03 \x03(\v2,.Superplane.Plugins.HTTPRequest.HeadersEntryR\aheaders\x12\x12\n" +
+	"\x04body\x18\x04 \x01(\fR\x04body\x1aV\n" +
+	"\fHeadersEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x120\n" +
+	"\x05value\x18\x02 \x01(\v2\x1a.Superplane.Plugins.HeaderR\x05value:\x028\x01\"\xe4\x01\n" +
+	"\fHTTPResponse\x12\x1f\n" +
+	"\vstatus_code\x18\x01 \x01(\x05R\n" +
+	"statusCode\x12G\n" +
+	"\aheaders\x18\x02 \x03(\v2-.Superplane.Plugins.HTTPResponse.HeadersEntryR\aheaders\x12\x12\n" +
+	"\x04body\x18\x03 \x01(\fR\x04body\x1aV\n" +
+	"\fHeadersEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x120\n" +
+	"\x05value\x18\x02 \x01(\v2\x1a.Superplane.Plugins.HeaderR\x05value:\x028\x01\"8\n" +
+	"\fGetSecretKey\x12\x16\n" +
+	"\x06secret\x18\x01 \x01(\tR\x06secret\x12\x10\n" +
+	"\x03key\x18\x02 \x01(\tR\x03key\"w\n" +
+	"\x12ScheduleActionCall\x12\x16\n" +
+	"\x06action\x18\x01 \x01(\tR\x06action\x12\x1e\n" +
+	"\n" +
+	"parameters\x18\x02 \x01(\fR\n" +
+	"parameters\x12)\n" +
+	"\x10interval_seconds\x18\x03 \x01(\x03R\x0fintervalSeconds\"H\n" +
+	"\tEmitEvent\x12!\n" +
+	"\fpayload_type\x18\x01 \x01(\tR\vpayloadType\x12\x18\n" +
+	"\apayload\x18\x02 \x01(\fR\apayload\"\xb1\x01\n" +
+	"\x10SendNotification\x12\x14\n" +
+	"\x05title\x18\x01 \x01(\tR\x05title\x12\x12\n" +
+	"\x04body\x18\x02 \x01(\tR\x04body\x12\x10\n" +
+	"\x03url\x18\x03 \x01(\tR\x03url\x12\x1b\n" +
+	"\turl_label\x18\x04 \x01(\tR\burlLabel\x12\x16\n" +
+	"\x06emails\x18\x05 \x03(\tR\x06emails\x12\x16\n" +
+	"\x06groups\x18\x06 \x03(\tR\x06groups\x12\x14\n" +
+	"\x05roles\x18\a \x03(\tR\x05roles\"\x0e\n" +
+	"\fSetupWebhook\"\x12\n" +
+	"\x10GetWebhookSecret\"*\n" +
+	"\x10SetWebhookSecret\x12\x16\n" +
+	"\x06secret\x18\x01 \x01(\fR\x06secret\"\xc4\t\n" +
+	"\bCallback\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12D\n" +
+	"\fget_metadata\x18\x02 \x01(\v2\x1f.Superplane.Plugins.GetMetadataH\x00R\vgetMetadata\x12D\n" +
+	"\fset_metadata\x18\x03 \x01(\v2\x1f.Superplane.Plugins.SetMetadataH\x00R\vsetMetadata\x12A\n" +
+	"\vis_finished\x18\x04 \x01(\v2\x1e.Superplane.Plugins.IsFinishedH\x00R\n" +
+	"isFinished\x122\n" +
+	"\x06set_kv\x18\x05 \x01(\v2\x19.Superplane.Plugins.SetKVH\x00R\x05setKv\x12A\n" +
+	"\vemit_output\x18\x06 \x01(\v2\x1e.Superplane.Plugins.EmitOutputH\x00R\n" +
+	"emitOutput\x12.\n" +
+	"\x04pass\x18\a \x01(\v2\x18.Superplane.Plugins.PassH\x00R\x04pass\x12.\n" +
+	"\x04fail\x18\b \x01(\v2\x18.Superplane.Plugins.FailH\x00R\x04fail\x12;\n" +
+	"\twrite_log\x18\t \x01(\v2\x1c.Superplane.Plugins.WriteLogH\x00R\bwriteLog\x12D\n" +
+	"\fput_artifact\x18\n" +
+	" \x01(\v2\x1f.Superplane.Plugins.PutArtifactH\x00R\vputArtifact\x12D\n" +
+	"\fhttp_request\x18\v \x01(\v2\x1f.Superplane.Plugins.HTTPRequestH\x00R\vhttpRequest\x12H\n" +
+	"\x0eget_secret_key\x18\f \x01(\v2 .Superplane.Plugins.GetSecretKeyH\x00R\fgetSecretKey\x12Z\n" +
+	"\x14schedule_action_call\x18\r \x01(\v2&.Superplane.Plugins.ScheduleActionCallH\x00R\x12scheduleActionCall\x12>\n" +
+	"\n" +
+	"emit_event\x18\x0e \x01(\v2\x1d.Superplane.Plugins.EmitEventH\x00R\temitEvent\x12S\n" +
+	"\x11send_notification\x18\x0f \x01(\v2$.Superplane.Plugins.SendNotificationH\x00R\x10sendNotification\x12G\n" +
+	"\rsetup_webhook\x18\x10 \x01(\v2 .Superplane.Plugins.SetupWebhookH\x00R\fsetupWebhook\x12T\n" +
+	"\x12get_webhook_secret\x18\x11 \x01(\v2$.Superplane.Plugins.GetWebhookSecretH\x00R\x10getWebhookSecret\x12T\n" +
+	"\x12set_webhook_secret\x18\x12 \x01(\v2$.Superplane.Plugins.SetWebhookSecretH\x00R\x10setWebhookSecretB\t\n" +
+	"\arequest\"\xb2\x01\n" +
+	"\x10CallbackResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x14\n" +
+	"\x05error\x18\x02 \x01(\tR\x05error\x12\x14\n" +
+	"\x05value\x18\x03 \x01(\fR\x05value\x12E\n" +
+	"\rhttp_response\x18\x04 \x01(\v2 .Superplane.Plugins.HTTPResponseR\fhttpResponse\x12\x1b\n" +
+	"\tnot_found\x18\x05 \x01(\bR\bnotFound*\xa2\x01\n" +
+	"\x06Method\x12\x16\n" +
+	"\x12METHOD_UNSPECIFIED\x10\x00\x12\x10\n" +
+	"\fMETHOD_SETUP\x10\x01\x12\x12\n" +
+	"\x0eMETHOD_EXECUTE\x10\x02\x12\x18\n" +
+	"\x14METHOD_HANDLE_ACTION\x10\x03\x12\x19\n" +
+	"\x15METHOD_HANDLE_WEBHOOK\x10\x04\x12\x11\n" +
+	"\rMETHOD_CANCEL\x10\x05\x12\x12\n" +
+	"\x0eMETHOD_CLEANUP\x10\x06*B\n" +
+	"\x04Kind\x12\x14\n" +
+	"\x10KIND_UNSPECIFIED\x10\x00\x12\x12\n" +
+	"\x0eKIND_COMPONENT\x10\x01\x12\x10\n" +
+	"\fKIND_TRIGGER\x10\x02*n\n" +
+	"\rMetadataField\x12\x1e\n" +
+	"\x1aMETADATA_FIELD_UNSPECIFIED\x10\x00\x12\x1b\n" +
+	"\x17METADATA_FIELD_METADATA\x10\x01\x12 \n" +
+	"\x1cMETADATA_FIELD_NODE_METADATA\x10\x02*U\n" +
+	"\tLogStream\x12\x1a\n" +
+	"\x16LOG_STREAM_UNSPECIFIED\x10\x00\x12\x15\n" +
+	"\x11LOG_STREAM_STDOUT\x10\x01\x12\x15\n" +
+	"\x11LOG_STREAM_STDERR\x10\x022\xab\x04\n" +
+	"\x06Plugin\x12X\n" +
+	"\tHandshake\x12$.Superplane.Plugins.HandshakeRequest\x1a%.Superplane.Plugins.HandshakeResponse\x12T\n" +
+	"\x0eListComponents\x12\x16.google.protobuf.Empty\x1a*.Superplane.Plugins.ListComponentsResponse\x12P\n" +
+	"\fListTriggers\x12\x16.google.protobuf.Empty\x1a(.Superplane.Plugins.ListTriggersResponse\x12g\n" +
+	"\x0eOutputChannels\x12).Superplane.Plugins.OutputChannelsRequest\x1a*.Superplane.Plugins.OutputChannelsResponse\x12d\n" +
+	"\rValidateInput\x12(.Superplane.Plugins.ValidateInputRequest\x1a).Superplane.Plugins.ValidateInputResponse\x12P\n" +
+	"\x06Invoke\x12\x1f.Superplane.Plugins.HostMessage\x1a!.Superplane.Plugins.PluginMessage(\x010\x01B7Z5github.com/superplanehq/superplane/pkg/protos/pluginsb\x06proto3"
+
+var (
+	file_plugins_proto_rawDescOnce sync.Once
+	file_plugins_proto_rawDescData []byte
+)
+
+func file_plugins_proto_rawDescGZIP() []byte {
+	file_plugins_proto_rawDescOnce.Do(func() {
+		file_plugins_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_plugins_proto_rawDesc), len(file_plugins_proto_rawDesc)))
+	})
+	return file_plugins_proto_rawDescData
+}
+
+var file_plugins_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
+var file_plugins_proto_msgTypes = make([]protoimpl.MessageInfo, 43)
+var file_plugins_proto_goTypes = []any{
+	(Method)(0),                    // 0: Superplane.Plugins.Method
+	(Kind)(0),                      // 1: Superplane.Plugins.Kind
+	(MetadataField)(0),             // 2: Superplane.Plugins.MetadataField
+	(LogStream)(0),                 // 3: Superplane.Plugins.LogStream
+	(*HandshakeRequest)(nil),       // 4: Superplane.Plugins.HandshakeRequest
+	(*HandshakeResponse)(nil),      // 5: Superplane.Plugins.HandshakeResponse
+	(*OutputChannel)(nil),          // 6: Superplane.Plugins.OutputChannel
+	(*Action)(nil),                 // 7: Superplane.Plugins.Action
+	(*ComponentDefinition)(nil),    // 8: Superplane.Plugins.ComponentDefinition
+	(*TriggerDefinition)(nil),      // 9: Superplane.Plugins.TriggerDefinition
+	(*ListComponentsResponse)(nil), // 10: Superplane.Plugins.ListComponentsResponse
+	(*ListTriggersResponse)(nil),   // 11: Superplane.Plugins.ListTriggersResponse
+	(*OutputChannelsRequest)(nil),  // 12: Superplane.Plugins.OutputChannelsRequest
+	(*OutputChannelsResponse)(nil), // 13: Superplane.Plugins.OutputChannelsResponse
+	(*ValidateInputRequest)(nil),   // 14: Superplane.Plugins.ValidateInputRequest
+	(*ValidateInputResponse)(nil),  // 15: Superplane.Plugins.ValidateInputResponse
+	(*Execution)(nil),              // 16: Superplane.Plugins.Execution
+	(*User)(nil),                   // 17: Superplane.Plugins.User
+	(*Header)(nil),                 // 18: Superplane.Plugins.Header
+	(*WebhookRequest)(nil),         // 19: Superplane.Plugins.WebhookRequest
+	(*Invocation)(nil),             // 20: Superplane.Plugins.Invocation
+	(*InvocationResult)(nil),       // 21: Superplane.Plugins.InvocationResult
+	(*HostMessage)(nil),            // 22: Superplane.Plugins.HostMessage
+	(*PluginMessage)(nil),          // 23: Superplane.Plugins.PluginMessage
+	(*GetMetadata)(nil),            // 24: Superplane.Plugins.GetMetadata
+	(*SetMetadata)(nil),            // 25: Superplane.Plugins.SetMetadata
+	(*IsFinished)(nil),             // 26: Superplane.Plugins.IsFinished
+	(*SetKV)(nil),                  // 27: Superplane.Plugins.SetKV
+	(*EmitOutput)(nil),             // 28: Superplane.Plugins.EmitOutput
+	(*Pass)(nil),                   // 29: Superplane.Plugins.Pass
+	(*Fail)(nil),                   // 30: Superplane.Plugins.Fail
+	(*WriteLog)(nil),               // 31: Superplane.Plugins.WriteLog
+	(*PutArtifact)(nil),            // 32: Superplane.Plugins.PutArtifact
+	(*HTTPRequest)(nil),            // 33: Superplane.Plugins.HTTPRequest
+	(*HTTPResponse)(nil),           // 34: Superplane.Plugins.HTTPResponse
+	(*GetSecretKey)(nil),           // 35: Superplane.Plugins.GetSecretKey
+	(*ScheduleActionCall)(nil),     // 36: Superplane.Plugins.ScheduleActionCall
+	(*EmitEvent)(nil),              // 37: Superplane.Plugins.EmitEvent
+	(*SendNotification)(nil),       // 38: Superplane.Plugins.SendNotification
+	(*SetupWebhook)(nil),           // 39: Superplane.Plugins.SetupWebhook
+	(*GetWebhookSecret)(nil),       // 40: Superplane.Plugins.GetWebhookSecret
+	(*SetWebhookSecret)(nil),       // 41: Superplane.Plugins.SetWebhookSecret
+	(*Callback)(nil),               // 42: Superplane.Plugins.Callback
+	(*CallbackResponse)(nil),       // 43: Superplane.Plugins.CallbackResponse
+	nil,                            // 44: Superplane.Plugins.WebhookRequest.HeadersEntry
+	nil,                            // 45: Superplane.Plugins.HTTPRequest.HeadersEntry
+	nil,                            // 46: Superplane.Plugins.HTTPResponse.HeadersEntry
+	(*empty.Empty)(nil),            // 47: google.protobuf.Empty
+}
+var file_plugins_proto_depIdxs = []int32{
+	6,  // 0: Superplane.Plugins.ComponentDefinition.output_channels:type_name -> Superplane.Plugins.OutputChannel
+	7,  // 1: Superplane.Plugins.ComponentDefinition.actions:type_name -> Superplane.Plugins.Action
+	7,  // 2: Superplane.Plugins.TriggerDefinition.actions:type_name -> Superplane.Plugins.Action
+	8,  // 3: Superplane.Plugins.ListComponentsResponse.components:type_name -> Superplane.Plugins.ComponentDefinition
+	9,  // 4: Superplane.Plugins.ListTriggersResponse.triggers:type_name -> Superplane.Plugins.TriggerDefinition
+	6,  // 5: Superplane.Plugins.OutputChannelsResponse.output_channels:type_name -> Superplane.Plugins.OutputChannel
+	44, // 6: Superplane.Plugins.WebhookRequest.headers:type_name -> Superplane.Plugins.WebhookRequest.HeadersEntry
+	1,  // 7: Superplane.Plugins.Invocation.kind:type_name -> Superplane.Plugins.Kind
+	0,  // 8: Superplane.Plugins.Invocation.method:type_name -> Superplane.Plugins.Method
+	16, // 9: Superplane.Plugins.Invocation.execution:type_name -> Superplane.Plugins.Execution
+	19, // 10: Superplane.Plugins.Invocation.webhook:type_name -> Superplane.Plugins.WebhookRequest
+	17, // 11: Superplane.Plugins.Invocation.user:type_name -> Superplane.Plugins.User
+	20, // 12: Superplane.Plugins.HostMessage.invocation:type_name -> Superplane.Plugins.Invocation
+	43, // 13: Superplane.Plugins.HostMessage.callback_response:type_name -> Superplane.Plugins.CallbackResponse
+	42, // 14: Superplane.Plugins.PluginMessage.callback:type_name -> Superplane.Plugins.Callback
+	21, // 15: Superplane.Plugins.PluginMessage.result:type_name -> Superplane.Plugins.InvocationResult
+	2,  // 16: Superplane.Plugins.GetMetadata.field:type_name -> Superplane.Plugins.MetadataField
+	2,  // 17: Superplane.Plugins.SetMetadata.field:type_name -> Superplane.Plugins.MetadataField
+	3,  // 18: Superplane.Plugins.WriteLog.stream:type_name -> Superplane.Plugins.LogStream
+	45, // 19: Superplane.Plugins.HTTPRequest.headers:type_name -> Superplane.Plugins.HTTPRequest.HeadersEntry
+	46, // 20: Superplane.Plugins.HTTPResponse.headers:type_name -> Superplane.Plugins.HTTPResponse.HeadersEntry
+	24, // 21: Superplane.Plugins.Callback.get_metadata:type_name -> Superplane.Plugins.GetMetadata
+	25, // 22: Superplane.Plugins.Callback.set_metadata:type_name -> Superplane.Plugins.SetMetadata
+	26, // 23: Superplane.Plugins.Callback.is_finished:type_name -> Superplane.Plugins.IsFinished
+	27, // 24: Superplane.Plugins.Callback.set_kv:type_name -> Superplane.Plugins.SetKV
+	28, // 25: Superplane.Plugins.Callback.emit_output:type_name -> Superplane.Plugins.EmitOutput
+	29, // 26: Superplane.Plugins.Callback.pass:type_name -> Superplane.Plugins.Pass
+	30, // 27: Superplane.Plugins.Callback.fail:type_name -> Superplane.Plugins.Fail
+	31, // 28: Superplane.Plugins.Callback.write_log:type_name -> Superplane.Plugins.WriteLog
+	32, // 29: Superplane.Plugins.Callback.put_artifact:type_name -> Superplane.Plugins.PutArtifact
+	33, // 30: Superplane.Plugins.Callback.http_request:type_name -> Superplane.Plugins.HTTPRequest
+	35, // 31: Superplane.Plugins.Callback.get_secret_key:type_name -> Superplane.Plugins.GetSecretKey
+	36, // 32: Superplane.Plugins.Callback.schedule_action_call:type_name -> Superplane.Plugins.ScheduleActionCall
+	37, // 33: Superplane.Plugins.Callback.emit_event:type_name -> Superplane.Plugins.EmitEvent
+	38, // 34: Superplane.Plugins.Callback.send_notification:type_name -> Superplane.Plugins.SendNotification
+	39, // 35: Superplane.Plugins.Callback.setup_webhook:type_name -> Superplane.Plugins.SetupWebhook
+	40, // 36: Superplane.Plugins.Callback.get_webhook_secret:type_name -> Superplane.Plugins.GetWebhookSecret
+	41, // 37: Superplane.Plugins.Callback.set_webhook_secret:type_name -> Superplane.Plugins.SetWebhookSecret
+	34, // 38: Superplane.Plugins.CallbackResponse.http_response:type_name -> Superplane.Plugins.HTTPResponse
+	18, // 39: Superplane.Plugins.WebhookRequest.HeadersEntry.value:type_name -> Superplane.Plugins.Header
+	18, // 40: Superplane.Plugins.HTTPRequest.HeadersEntry.value:type_name -> Superplane.Plugins.Header
+	18, // 41: Superplane.Plugins.HTTPResponse.HeadersEntry.value:type_name -> Superplane.Plugins.Header
+	4,  // 42: Superplane.Plugins.Plugin.Handshake:input_type -> Superplane.Plugins.HandshakeRequest
+	47, // 43: Superplane.Plugins.Plugin.ListComponents:input_type -> google.protobuf.Empty
+	47, // 44: Superplane.Plugins.Plugin.ListTriggers:input_type -> google.protobuf.Empty
+	12, // 45: Superplane.Plugins.Plugin.OutputChannels:input_type -> Superplane.Plugins.OutputChannelsRequest
+	14, // 46: Superplane.Plugins.Plugin.ValidateInput:input_type -> Superplane.Plugins.ValidateInputRequest
+	22, // 47: Superplane.Plugins.Plugin.Invoke:input_type -> Superplane.Plugins.HostMessage
+	5,  // 48: Superplane.Plugins.Plugin.Handshake:output_type -> Superplane.Plugins.HandshakeResponse
+	10, // 49: Superplane.Plugins.Plugin.ListComponents:output_type -> Superplane.Plugins.ListComponentsResponse
+	11, // 50: Superplane.Plugins.Plugin.ListTriggers:output_type -> Superplane.Plugins.ListTriggersResponse
+	13, // 51: Superplane.Plugins.Plugin.OutputChannels:output_type -> Superplane.Plugins.OutputChannelsResponse
+	15, // 52: Superplane.Plugins.Plugin.ValidateInput:output_type -> Superplane.Plugins.ValidateInputResponse
+	23, // 53: Superplane.Plugins.Plugin.Invoke:output_type -> Superplane.Plugins.PluginMessage
+	48, // [48:54] is the sub-list for method output_type
+	42, // [42:48] is the sub-list for method input_type
+	42, // [42:42] is the sub-list for extension type_name
+	42, // [42:42] is the sub-list for extension extendee
+	0,  // [0:42] is the sub-list for field type_name
+}
+
+func init() { file_plugins_proto_init() }
+func file_plugins_proto_init() {
+	if File_plugins_proto != nil {
+		return
+	}
+	file_plugins_proto_msgTypes[18].OneofWrappers = []any{
+		(*HostMessage_Invocation)(nil),
+		(*HostMessage_CallbackResponse)(nil),
+	}
+	file_plugins_proto_msgTypes[19].OneofWrappers = []any{
+		(*PluginMessage_Callback)(nil),
+		(*PluginMessage_Result)(nil),
+	}
+	file_plugins_proto_msgTypes[38].OneofWrappers = []any{
+		(*Callback_GetMetadata)(nil),
+		(*Callback_SetMetadata)(nil),
+		(*Callback_IsFinished)(nil),
+		(*Callback_SetKv)(nil),
+		(*Callback_EmitOutput)(nil),
+		(*Callback_Pass)(nil),
+		(*Callback_Fail)(nil),
+		(*Callback_WriteLog)(nil),
+		(*Callback_PutArtifact)(nil),
+		(*Callback_HttpRequest)(nil),
+		(*Callback_GetSecretKey)(nil),
+		(*Callback_ScheduleActionCall)(nil),
+		(*Callback_EmitEvent)(nil),
+		(*Callback_SendNotification)(nil),
+		(*Callback_SetupWebhook)(nil),
+		(*Callback_GetWebhookSecret)(nil),
+		(*Callback_SetWebhookSecret)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_plugins_proto_rawDesc), len(file_plugins_proto_rawDesc)),
+			NumEnums:      4,
+			NumMessages:   43,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_plugins_proto_goTypes,
+		DependencyIndexes: file_plugins_proto_depIdxs,
+		EnumInfos:         file_plugins_proto_enumTypes,
+		MessageInfos:      file_plugins_proto_msgTypes,
+	}.Build()
+	File_plugins_proto = out.File
+	file_plugins_proto_goTypes = nil
+	file_plugins_proto_depIdxs = nil
+}
@@ -0,0 +1,347 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.6.0
+// - protoc             v3.15.8
+// source: plugins.proto
+
+package plugins
+
+import (
+	context "context"
+	empty "github.com/golang/protobuf/ptypes/empty"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	Plugin_Handshake_FullMethodName      = "/Superplane.Plugins.Plugin/Handshake"
+	Plugin_ListComponents_FullMethodName = "/Superplane.Plugins.Plugin/ListComponents"
+	Plugin_ListTriggers_FullMethodName   = "/Superplane.Plugins.Plugin/ListTriggers"
+	Plugin_OutputChannels_FullMethodName = "/Superplane.Plugins.Plugin/OutputChannels"
+	Plugin_ValidateInput_FullMethodName  = "/Superplane.Plugins.Plugin/ValidateInput"
+	Plugin_Invoke_FullMethodName         = "/Superplane.Plugins.Plugin/Invoke"
+)
+
+// PluginClient is the client API for Plugin service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Plugin is served by out-of-process plugins, which provide components
+// and triggers to SuperPlane without being compiled into it.
+//
+// Plugins also serve the standard grpc.health.v1.Health service,
+// which SuperPlane uses to check if they are still available.
+//
+// Values that are arbitrary JSON in the core interfaces,
+// like configurations, payloads and metadata, are JSON encoded bytes.
+type PluginClient interface {
+	// Called once, when SuperPlane starts, to agree on the protocol version
+	// and find out what the plugin is capable of.
+	Handshake(ctx context.Context, in *HandshakeRequest, opts ...grpc.CallOption) (*HandshakeResponse, error)
+	// Definitions of the components and triggers provided by the plugin.
+	ListComponents(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*ListComponentsResponse, error)
+	ListTriggers(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*ListTriggersResponse, error)
+	// Output channels of components depending on their configuration.
+	// Only called for components with dynamic_output_channels.
+	OutputChannels(ctx context.Context, in *OutputChannelsRequest, opts ...grpc.CallOption) (*OutputChannelsResponse, error)
+	// Validation of the input of a manual run of a trigger.
+	// Only called for triggers with accepts_input.
+	ValidateInput(ctx context.Context, in *ValidateInputRequest, opts ...grpc.CallOption) (*ValidateInputResponse, error)
+	// Invoke calls a method of a component or trigger.
+	// SuperPlane sends the invocation first. While handling it, the plugin uses
+	// the contexts of the invocation - metadata, execution state, HTTP, secrets -
+	// by sending callbacks, which SuperPlane answers on the same stream.
+	// The plugin finishes by sending the result of the invocation.
+	Invoke(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[HostMessage, PluginMessage], error)
+}
+
+type pluginClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewPluginClient(cc grpc.ClientConnInterface) PluginClient {
+	return &pluginClient{cc}
+}
+
+func (c *pluginClient) Handshake(ctx context.Context, in *HandshakeRequest, opts ...grpc.CallOption) (*HandshakeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(HandshakeResponse)
+	err := c.cc.Invoke(ctx, Plugin_Handshake_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pluginClient) ListComponents(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*ListComponentsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListComponentsResponse)
+	err := c.cc.Invoke(ctx, Plugin_ListComponents_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pluginClient) ListTriggers(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*ListTriggersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTriggersResponse)
+	err := c.cc.Invoke(ctx, Plugin_ListTriggers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pluginClient) OutputChannels(ctx context.Context, in *OutputChannelsRequest, opts ...grpc.CallOption) (*OutputChannelsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(OutputChannelsResponse)
+	err := c.cc.Invoke(ctx, Plugin_OutputChannels_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pluginClient) ValidateInput(ctx context.Context, in *ValidateInputRequest, opts ...grpc.CallOption) (*ValidateInputResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ValidateInputResponse)
+	err := c.cc.Invoke(ctx, Plugin_ValidateInput_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pluginClient) Invoke(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[HostMessage, PluginMessage], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &Plugin_ServiceDesc.Streams[0], Plugin_Invoke_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[HostMessage, PluginMessage]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Plugin_InvokeClient = grpc.BidiStreamingClient[HostMessage, PluginMessage]
+
+// PluginServer is the server API for Plugin service.
+// All implementations should embed UnimplementedPluginServer
+// for forward compatibility.
+//
+// Plugin is served by out-of-process plugins, which provide components
+// and triggers to SuperPlane without being compiled into it.
+//
+// Plugins also serve the standard grpc.health.v1.Health service,
+// which SuperPlane uses to check if they are still available.
+//
+// Values that are arbitrary JSON in the core interfaces,
+// like configurations, payloads and metadata, are JSON encoded bytes.
+type PluginServer interface {
+	// Called once, when SuperPlane starts, to agree on the protocol version
+	// and find out what the plugin is capable of.
+	Handshake(context.Context, *HandshakeRequest) (*HandshakeResponse, error)
+	// Definitions of the components and triggers provided by the plugin.
+	ListComponents(context.Context, *empty.Empty) (*ListComponentsResponse, error)
+	ListTriggers(context.Context, *empty.Empty) (*ListTriggersResponse, error)
+	// Output channels of components depending on their configuration.
+	// Only called for components with dynamic_output_channels.
+	OutputChannels(context.Context, *OutputChannelsRequest) (*OutputChannelsResponse, error)
+	// Validation of the input of a manual run of a trigger.
+	// Only called for triggers with accepts_input.
+	ValidateInput(context.Context, *ValidateInputRequest) (*ValidateInputResponse, error)
+	// Invoke calls a method of a component or trigger.
+	// SuperPlane sends the invocation first. While handling it, the plugin uses
+	// the contexts of the invocation - metadata, execution state, HTTP, secrets -
+	// by sending callbacks, which SuperPlane answers on the same stream.
+	// The plugin finishes by sending the result of the invocation.
+	Invoke(grpc.BidiStreamingServer[HostMessage, PluginMessage]) error
+}
+
+// UnimplementedPluginServer should be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedPluginServer struct{}
+
+func (UnimplementedPluginServer) Handshake(context.Context, *HandshakeRequest) (*HandshakeResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method Handshake not implemented")
+}
+func (UnimplementedPluginServer) ListComponents(context.Context, *empty.Empty) (*ListComponentsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListComponents not implemented")
+}
+func (UnimplementedPluginServer) ListTriggers(context.Context, *empty.Empty) (*ListTriggersResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListTriggers not implemented")
+}
+func (UnimplementedPluginServer) OutputChannels(context.Context, *OutputChannelsRequest) (*OutputChannelsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method OutputChannels not implemented")
+}
+func (UnimplementedPluginServer) ValidateInput(context.Context, *ValidateInputRequest) (*ValidateInputResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ValidateInput not implemented")
+}
+func (UnimplementedPluginServer) Invoke(grpc.BidiStreamingServer[HostMessage, PluginMessage]) error {
+	return status.Error(codes.Unimplemented, "method Invoke not implemented")
+}
+func (UnimplementedPluginServer) testEmbeddedByValue() {}
+
+// UnsafePluginServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to PluginServer will
+// result in compilation errors.
+type UnsafePluginServer interface {
+	mustEmbedUnimplementedPluginServer()
+}
+
+func RegisterPluginServer(s grpc.ServiceRegistrar, srv PluginServer) {
+	// If the following call panics, it indicates UnimplementedPluginServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&Plugin_ServiceDesc, srv)
+}
+
+func _Plugin_Handshake_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HandshakeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PluginServer).Handshake(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Plugin_Handshake_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PluginServer).Handshake(ctx, req.(*HandshakeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Plugin_ListComponents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PluginServer).ListComponents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Plugin_ListComponents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PluginServer).ListComponents(ctx, req.(*empty.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Plugin_ListTriggers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PluginServer).ListTriggers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Plugin_ListTriggers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PluginServer).ListTriggers(ctx, req.(*empty.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Plugin_OutputChannels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OutputChannelsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PluginServer).OutputChannels(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Plugin_OutputChannels_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PluginServer).OutputChannels(ctx, req.(*OutputChannelsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Plugin_ValidateInput_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValidateInputRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PluginServer).ValidateInput(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Plugin_ValidateInput_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PluginServer).ValidateInput(ctx, req.(*ValidateInputRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Plugin_Invoke_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(PluginServer).Invoke(&grpc.GenericServerStream[HostMessage, PluginMessage]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Plugin_InvokeServer = grpc.BidiStreamingServer[HostMessage, PluginMessage]
+
+// Plugin_ServiceDesc is the grpc.ServiceDesc for Plugin service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Plugin_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "Superplane.Plugins.Plugin",
+	HandlerType: (*PluginServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Handshake",
+			Handler:    _Plugin_Handshake_Handler,
+		},
+		{
+			MethodName: "ListComponents",
+			Handler:    _Plugin_ListComponents_Handler,
+		},
+		{
+			MethodName: "ListTriggers",
+			Handler:    _Plugin_ListTriggers_Handler,
+		},
+		{
+			MethodName: "OutputChannels",
+			Handler:    _Plugin_OutputChannels_Handler,
+		},
+		{
+			MethodName: "ValidateInput",
+			Handler:    _Plugin_ValidateInput_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Invoke",
+			Handler:       _Plugin_Invoke_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+	},
+	Metadata: "plugins.proto",
+}
@@ -0,0 +1,1024 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v3.15.8
+// source: subscriptions.proto
+
+package subscriptions
+
+import (
+	timestamp "github.com/golang/protobuf/ptypes/timestamp"
+	_ "github.com/grpc-ecosystem/grpc-gateway/v2/protoc-gen-openapiv2/options"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type DeliveryState int32
+
+const (
+	DeliveryState_DELIVERY_STATE_UNKNOWN   DeliveryState = 0
+	DeliveryState_DELIVERY_STATE_PENDING   DeliveryState = 1
+	DeliveryState_DELIVERY_STATE_DELIVERED DeliveryState = 2
+	DeliveryState_DELIVERY_STATE_FAILED    DeliveryState = 3
+)
+
+// Enum value maps for DeliveryState.
+var (
+	DeliveryState_name = map[int32]string{
+		0: "DELIVERY_STATE_UNKNOWN",
+		1: "DELIVERY_STATE_PENDING",
+		2: "DELIVERY_STATE_DELIVERED",
+		3: "DELIVERY_STATE_FAILED",
+	}
+	DeliveryState_value = map[string]int32{
+		"DELIVERY_STATE_UNKNOWN":   0,
+		"DELIVERY_STATE_PENDING":   1,
+		"DELIVERY_STATE_DELIVERED": 2,
+		"DELIVERY_STATE_FAILED":    3,
+	}
+)
+
+func (x DeliveryState) Enum() *DeliveryState {
+	p := new(DeliveryState)
+	*p = x
+	return p
+}
+
+func (x DeliveryState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DeliveryState) Descriptor() protoreflect.EnumDescriptor {
+	return file_subscriptions_proto_enumTypes[0].Descriptor()
+}
+
+func (DeliveryState) Type() protoreflect.EnumType {
+	return &file_subscriptions_proto_enumTypes[0]
+}
+
+func (x DeliveryState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DeliveryState.Descriptor instead.
+func (DeliveryState) EnumDescriptor() ([]byte, []int) {
+	return file_subscriptions_proto_rawDescGZIP(), []int{0}
+}
+
+type Subscription struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Url           string                 `protobuf:"bytes,3,opt,name=url,proto3" json:"url,omitempty"`
+	EventTypes    []string               `protobuf:"bytes,4,rep,name=event_types,json=eventTypes,proto3" json:"event_types,omitempty"`
+	CanvasIds     []string               `protobuf:"bytes,5,rep,name=canvas_ids,json=canvasIds,proto3" json:"canvas_ids,omitempty"`
+	NodeIds       []string               `protobuf:"bytes,6,rep,name=node_ids,json=nodeIds,proto3" json:"node_ids,omitempty"`
+	Enabled       bool                   `protobuf:"varint,7,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	CreatedBy     string                 `protobuf:"bytes,8,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	CreatedAt     *timestamp.Timestamp   `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamp.Timestamp   `protobuf:"bytes,10,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Subscription) Reset() {
+	*x = Subscription{}
+	mi := &file_subscriptions_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Subscription) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Subscription) ProtoMessage() {}
+
+func (x *Subscription) ProtoReflect() protoreflect.Message {
+	mi := &file_subscriptions_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Subscription.ProtoReflect.Descriptor instead.
+func (*Subscription) Descriptor() ([]byte, []int) {
+	return file_subscriptions_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Subscription) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Subscription) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Subscription) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *Subscription) GetEventTypes() []string {
+	if x != nil {
+		return x.EventTypes
+	}
+	return nil
+}
+
+func (x *Subscription) GetCanvasIds() []string {
+	if x != nil {
+		return x.CanvasIds
+	}
+	return nil
+}
+
+func (x *Subscription) GetNodeIds() []string {
+	if x != nil {
+		return x.NodeIds
+	}
+	return nil
+}
+
+func (x *Subscription) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *Subscription) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+func (x *Subscription) GetCreatedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Subscription) GetUpdatedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type Delivery struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	EventId        string                 `protobuf:"bytes,2,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	EventType      string                 `protobuf:"bytes,3,opt,name=event_type,json=eventType,proto3" json:"event_type,omitempty"`
+	State          DeliveryState          `protobuf:"varint,4,opt,name=state,proto3,enum=Superplane.Subscriptions.DeliveryState" json:"state,omitempty"`
+	Attempts       int32                  `protobuf:"varint,5,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	ResponseStatus int32                  `protobuf:"varint,6,opt,name=response_status,json=responseStatus,proto3" json:"response_status,omitempty"`
+	LastError      string                 `protobuf:"bytes,7,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	NextAttemptAt  *timestamp.Timestamp   `protobuf:"bytes,8,opt,name=next_attempt_at,json=nextAttemptAt,proto3" json:"next_attempt_at,omitempty"`
+	DeliveredAt    *timestamp.Timestamp   `protobuf:"bytes,9,opt,name=delivered_at,json=deliveredAt,proto3" json:"delivered_at,omitempty"`
+	CreatedAt      *timestamp.Timestamp   `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Delivery) Reset() {
+	*x = Delivery{}
+	mi := &file_subscriptions_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Delivery) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Delivery) ProtoMessage() {}
+
+func (x *Delivery) ProtoReflect() protoreflect.Message {
+	mi := &file_subscriptions_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Delivery.ProtoReflect.Descriptor instead.
+func (*Delivery) Descriptor() ([]byte, []int) {
+	return file_subscriptions_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Delivery) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Delivery) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *Delivery) GetEventType() string {
+	if x != nil {
+		return x.EventType
+	}
+	return ""
+}
+
+func (x *Delivery) GetState() DeliveryState {
+	if x != nil {
+		return x.State
+	}
+	return DeliveryState_DELIVERY_STATE_UNKNOWN
+}
+
+func (x *Delivery) GetAttempts() int32 {
+	if x != nil {
+		return x.Attempts
+	}
+	return 0
+}
+
+func (x *Delivery) GetResponseStatus() int32 {
+	if x != nil {
+		return x.ResponseStatus
+	}
+	return 0
+}
+
+func (x *Delivery) GetLastError() string {
+	if x != nil {
+		return x.LastError
+	}
+	return ""
+}
+
+func (x *Delivery) GetNextAttemptAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.NextAttemptAt
+	}
+	return nil
+}
+
+func (x *Delivery) GetDeliveredAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.DeliveredAt
+	}
+	return nil
+}
+
+func (x *Delivery) GetCreatedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type CreateSubscriptionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Subscription  *Subscription          `protobuf:"bytes,1,opt,name=subscription,proto3" json:"subscription,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateSubscriptionRequest) Reset() {
+	*x = CreateSubscriptionRequest{}
+	mi := &file_subscriptions_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateSubscriptionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateSubscriptionRequest) ProtoMessage() {}
+
+func (x *CreateSubscriptionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_subscriptions_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateSubscriptionRequest.ProtoReflect.Descriptor instead.
+func (*CreateSubscriptionRequest) Descriptor() ([]byte, []int) {
+	return file_subscriptions_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CreateSubscriptionRequest) GetSubscription() *Subscription {
+	if x != nil {
+		return x.Subscription
+	}
+	return nil
+}
+
+type CreateSubscriptionResponse struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	Subscription *Subscription          `protobuf:"bytes,1,opt,name=subscription,proto3" json:"subscription,omitempty"`
+	//
+	// The secret used to sign the deliveries.
+	// It is only returned when the subscription is created.
+	//
+	Secret        string `protobuf:"bytes,2,opt,name=secret,proto3" json:"secret,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateSubscriptionResponse) Reset() {
+	*x = CreateSubscriptionResponse{}
+	mi := &file_subscriptions_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateSubscriptionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateSubscriptionResponse) ProtoMessage() {}
+
+func (x *CreateSubscriptionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_subscriptions_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateSubscriptionResponse.ProtoReflect.Descriptor instead.
+func (*CreateSubscriptionResponse) Descriptor() ([]byte, []int) {
+	return file_subscriptions_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *CreateSubscriptionResponse) GetSubscription() *Subscription {
+	if x != nil {
+		return x.Subscription
+	}
+	return nil
+}
+
+func (x *CreateSubscriptionResponse) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+type ListSubscriptionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSubscriptionsRequest) Reset() {
+	*x = ListSubscriptionsRequest{}
+	mi := &file_subscriptions_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSubscriptionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSubscriptionsRequest) ProtoMessage() {}
+
+func (x *ListSubscriptionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_subscriptions_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSubscriptionsRequest.ProtoReflect.Descriptor instead.
+func (*ListSubscriptionsRequest) Descriptor() ([]byte, []int) {
+	return file_subscriptions_proto_rawDescGZIP(), []int{4}
+}
+
+type ListSubscriptionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Subscriptions []*Subscription        `protobuf:"bytes,1,rep,name=subscriptions,proto3" json:"subscriptions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSubscriptionsResponse) Reset() {
+	*x = ListSubscriptionsResponse{}
+	mi := &file_subscriptions_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSubscriptionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSubscriptionsResponse) ProtoMessage() {}
+
+func (x *ListSubscriptionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_subscriptions_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSubscriptionsResponse.ProtoReflect.Descriptor instead.
+func (*ListSubscriptionsResponse) Descriptor() ([]byte, []int) {
+	return file_subscriptions_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ListSubscriptionsResponse) GetSubscriptions() []*Subscription {
+	if x != nil {
+		return x.Subscriptions
+	}
+	return nil
+}
+
+type DescribeSubscriptionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DescribeSubscriptionRequest) Reset() {
+	*x = DescribeSubscriptionRequest{}
+	mi := &file_subscriptions_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DescribeSubscriptionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DescribeSubscriptionRequest) ProtoMessage() {}
+
+func (x *DescribeSubscriptionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_subscriptions_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DescribeSubscriptionRequest.ProtoReflect.Descriptor instead.
+func (*DescribeSubscriptionRequest) Descriptor() ([]byte, []int) {
+	return file_subscriptions_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *DescribeSubscriptionRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DescribeSubscriptionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Subscription  *Subscription          `protobuf:"bytes,1,opt,name=subscription,proto3" json:"subscription,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DescribeSubscriptionResponse) Reset() {
+	*x = DescribeSubscriptionResponse{}
+	mi := &file_subscriptions_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DescribeSubscriptionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DescribeSubscriptionResponse) ProtoMessage() {}
+
+func (x *DescribeSubscriptionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_subscriptions_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DescribeSubscriptionResponse.ProtoReflect.Descriptor instead.
+func (*DescribeSubscriptionResponse) Descriptor() ([]byte, []int) {
+	return file_subscriptions_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *DescribeSubscriptionResponse) GetSubscription() *Subscription {
+	if x != nil {
+		return x.Subscription
+	}
+	return nil
+}
+
+type UpdateSubscriptionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Subscription  *Subscription          `protobuf:"bytes,2,opt,name=subscription,proto3" json:"subscription,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateSubscriptionRequest) Reset() {
+	*x = UpdateSubscriptionRequest{}
+	mi := &file_subscriptions_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateSubscriptionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateSubscriptionRequest) ProtoMessage() {}
+
+func (x *UpdateSubscriptionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_subscriptions_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateSubscriptionRequest.ProtoReflect.Descriptor instead.
+func (*UpdateSubscriptionRequest) Descriptor() ([]byte, []int) {
+	return file_subscriptions_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *UpdateSubscriptionRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateSubscriptionRequest) GetSubscription() *Subscription {
+	if x != nil {
+		return x.Subscription
+	}
+	return nil
+}
+
+type UpdateSubscriptionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Subscription  *Subscription          `protobuf:"bytes,1,opt,name=subscription,proto3" json:"subscription,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateSubscriptionResponse) Reset() {
+	*x = UpdateSubscriptionResponse{}
+	mi := &file_subscriptions_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateSubscriptionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateSubscriptionResponse) ProtoMessage() {}
+
+func (x *UpdateSubscriptionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_subscriptions_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateSubscriptionResponse.ProtoReflect.Descriptor instead.
+func (*UpdateSubscriptionResponse) Descriptor() ([]byte, []int) {
+	return file_subscriptions_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *UpdateSubscriptionResponse) GetSubscription() *Subscription {
+	if x != nil {
+		return x.Subscription
+	}
+	return nil
+}
+
+type DeleteSubscriptionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteSubscriptionRequest) Reset() {
+	*x = DeleteSubscriptionRequest{}
+	mi := &file_subscriptions_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteSubscriptionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteSubscriptionRequest) ProtoMessage() {}
+
+func (x *DeleteSubscriptionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_subscriptions_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteSubscriptionRequest.ProtoReflect.Descriptor instead.
+func (*DeleteSubscriptionRequest) Descriptor() ([]byte, []int) {
+	return file_subscriptions_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *DeleteSubscriptionRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DeleteSubscriptionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteSubscriptionResponse) Reset() {
+	*x = DeleteSubscriptionResponse{}
+	mi := &file_subscriptions_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteSubscriptionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteSubscriptionResponse) ProtoMessage() {}
+
+func (x *DeleteSubscriptionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_subscriptions_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteSubscriptionResponse.ProtoReflect.Descriptor instead.
+func (*DeleteSubscriptionResponse) Descriptor() ([]byte, []int) {
+	return file_subscriptions_proto_rawDescGZIP(), []int{11}
+}
+
+type ListSubscriptionDeliveriesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Limit         uint32                 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSubscriptionDeliveriesRequest) Reset() {
+	*x = ListSubscriptionDeliveriesRequest{}
+	mi := &file_subscriptions_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSubscriptionDeliveriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSubscriptionDeliveriesRequest) ProtoMessage() {}
+
+func (x *ListSubscriptionDeliveriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_subscriptions_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSubscriptionDeliveriesRequest.ProtoReflect.Descriptor instead.
+func (*ListSubscriptionDeliveriesRequest) Descriptor() ([]byte, []int) {
+	return file_subscriptions_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ListSubscriptionDeliveriesRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ListSubscriptionDeliveriesRequest) GetLimit() uint32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListSubscriptionDeliveriesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Deliveries    []*Delivery            `protobuf:"bytes,1,rep,name=deliveries,proto3" json:"deliveries,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSubscriptionDeliveriesResponse) Reset() {
+	*x = ListSubscriptionDeliveriesResponse{}
+	mi := &file_subscriptions_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSubscriptionDeliveriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSubscriptionDeliveriesResponse) ProtoMessage() {}
+
+func (x *ListSubscriptionDeliveriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_subscriptions_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSubscriptionDeliveriesResponse.ProtoReflect.Descriptor instead.
+func (*ListSubscriptionDeliveriesResponse) Descriptor() ([]byte, []int) {
+	return file_subscriptions_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ListSubscriptionDeliveriesResponse) GetDeliveries() []*Delivery {
+	if x != nil {
+		return x.Deliveries
+	}
+	return nil
+}
+
+var File_subscriptions_proto protoreflect.FileDescriptor
+
+const file_subscriptions_proto_rawDesc = "" +
+	"\n" +
+	"\x13subscriptions.proto\x12\x18Superplane.Subscriptions\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\"\xce\x02\n" +
+	"\fSubscription\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x10\n" +
+	"\x03url\x18\x03 \x01(\tR\x03url\x12\x1f\n" +
+	"\vevent_types\x18\x04 \x03(\tR\n" +
+	"eventTypes\x12\x1d\n" +
+	"\n" +
+	"canvas_ids\x18\x05 \x03(\tR\tcanvasIds\x12\x19\n" +
+	"\bnode_ids\x18\x06 \x03(\tR\anodeIds\x12\x18\n" +
+	"\aenabled\x18\a \x01(\bR\aenabled\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\b \x01(\tR\tcreatedBy\x129\n" +
+	"\n" +
+	"created_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xb5\x03\n" +
+	"\bDelivery\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
+	"\bevent_id\x18\x02 \x01(\tR\aeventId\x12\x1d\n" +
+	"\n" +
+	"event_type\x18\x03 \x01(\tR\teventType\x12=\n" +
+	"\x05state\x18\x04 \x01(\x0e2'.Superplane.Subscriptions.DeliveryStateR\x05state\x12\x1a\n" +
+	"\battempts\x18\x05 \x01(\x05R\battempts\x12'\n" +
+	"\x0fresponse_status\x18\x06 \x01(\x05R\x0eresponseStatus\x12\x1d\n" +
+	"\n" +
+	"last_error\x18\a \x01(\tR\tlastError\x12B\n" +
+	"\x0fnext_attempt_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\rnextAttemptAt\x12=\n" +
+	"\fdelivered_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\vdeliveredAt\x129\n" +
+	"\n" +
+	"created_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"g\n" +
+	"\x19CreateSubscriptionRequest\x12J\n" +
+	"\fsubscription\x18\x01 \x01(\v2&.Superplane.Subscriptions.SubscriptionR\fsubscription\"\x80\x01\n" +
+	"\x1aCreateSubscriptionResponse\x12J\n" +
+	"\fsubscription\x18\x01 \x01(\v2&.Superplane.Subscriptions.SubscriptionR\fsubscription\x12\x16\n" +
+	"\x06secret\x18\x02 \x01(\tR\x06secret\"\x1a\n" +
+	"\x18ListSubscriptionsRequest\"i\n" +
+	"\x19ListSubscriptionsResponse\x12L\n" +
+	"\rsubscriptions\x18\x01 \x03(\v2&.Superplane.Subscriptions.SubscriptionR\rsubscriptions\"-\n" +
+	"\x1bDescribeSubscriptionRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"j\n" +
+	"\x1cDescribeSubscriptionResponse\x12J\n" +
+	"\fsubscription\x18\x01 \x01(\v2&.Superplane.Subscriptions.SubscriptionR\fsubscription\"w\n" +
+	"\x19UpdateSubscriptionRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12J\n" +
+	"\fsubscription\x18\x02 \x01(\v2&.Superplane.Subscriptions.SubscriptionR\fsubscription\"h\n" +
+	"\x1aUpdateSubscriptionResponse\x12J\n" +
+	"\fsubscription\x18\x01 \x01(\v2&.Superplane.Subscriptions.SubscriptionR\fsubscription\"+\n" +
+	"\x19DeleteSubscriptionRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\x1c\n" +
+	"\x1aDeleteSubscriptionResponse\"I\n" +
+	"!ListSubscriptionDeliveriesRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\rR\x05limit\"h\n" +
+	"\"ListSubscriptionDeliveriesResponse\x12B\n" +
+	"\n" +
+	"deliveries\x18\x01 \x03(\v2\".Superplane.Subscriptions.DeliveryR\n" +
+	"deliveries*\x80\x01\n" +
+	"\rDeliveryState\x12\x1a\n" +
+	"\x16DELIVERY_STATE_UNKNOWN\x10\x00\x12\x1a\n" +
+	"\x16DELIVERY_STATE_PENDING\x10\x01\x12\x1c\n" +
+	"\x18DELIVERY_STATE_DELIVERED\x10\x02\x12\x19\n" +
+	"\x15DELIVERY_STATE_FAILED\x10\x032\xab\r\n" +
+	"\rSubscriptions\x12\x9e\x02\n" +
+	"\x12CreateSubscription\x123.Superplane.Subscriptions.CreateSubscriptionRequest\x1a4.Superplane.Subscriptions.CreateSubscriptionResponse\"\x9c\x01\x92Ay\n" +
+	"\rSubscriptions\x12\x1cCreate an event subscription\x1aJCreates a webhook subscription for the canvas activity of the organization\x82\xd3\xe4\x93\x02\x1a:\x01*\"\x15/api/v1/subscriptions\x12\xff\x01\n" +
+	"\x11ListSubscriptions\x122.Superplane.Subscriptions.ListSubscriptionsRequest\x1a3.Superplane.Subscriptions.ListSubscriptionsResponse\"\x80\x01\x92A`\n" +
+	"\rSubscriptions\x12\x18List event subscriptions\x1a5Returns the webhook subscriptions of the organization\x82\xd3\xe4\x93\x02\x17\x12\x15/api/v1/subscriptions\x12\x90\x02\n" +
+	"\x14DescribeSubscription\x125.Superplane.Subscriptions.DescribeSubscriptionRequest\x1a6.Superplane.Subscriptions.DescribeSubscriptionResponse\"\x88\x01\x92Ac\n" +
+	"\rSubscriptions\x12\x1eDescribe an event subscription\x1a2Returns a webhook subscription of the organization\x82\xd3\xe4\x93\x02\x1c\x12\x1a/api/v1/subscriptions/{id}\x12\x9f\x02\n" +
+	"\x12UpdateSubscription\x123.Superplane.Subscriptions.UpdateSubscriptionRequest\x1a4.Superplane.Subscriptions.UpdateSubscriptionResponse\"\x9d\x01\x92Aj\n" +
+	"\rSubscriptions\x12\x1cUpdate an event subscription\x1a;Updates the URL, filters or state of a webhook subscription\x82\xd3\xe4\x93\x02*:\fsubscription\x1a\x1a/api/v1/subscriptions/{id}\x12\x89\x02\n" +
+	"\x12DeleteSubscription\x123.Superplane.Subscriptions.DeleteSubscriptionRequest\x1a4.Superplane.Subscriptions.DeleteSubscriptionResponse\"\x87\x01\x92Ab\n" +
+	"\rSubscriptions\x12\x1cDelete an event subscription\x1a3Deletes a webhook subscription and its delivery log\x82\xd3\xe4\x93\x02\x1c*\x1a/api/v1/subscriptions/{id}\x12\xb5\x02\n" +
+	"\x1aListSubscriptionDeliveries\x12;.Superplane.Subscriptions.ListSubscriptionDeliveriesRequest\x1a<.Superplane.Subscriptions.ListSubscriptionDeliveriesResponse\"\x9b\x01\x92Ak\n" +
+	"\rSubscriptions\x12\x1cList subscription deliveries\x1a<Returns the most recent deliveries of a webhook subscription\x82\xd3\xe4\x93\x02'\x12%/api/v1/subscriptions/{id}/deliveriesB\xe6\x01\x92A\xa5\x01\x12{\n" +
+	"\x1cSuperplane Subscriptions API\x12/API for Superplane outbound event subscriptions\"%\n" +
+	"\vAPI Support\x1a\x16support@superplane.com2\x031.0*\x02\x01\x022\x10application/json:\x10application/jsonZ;github.com/superplanehq/superplane/pkg/protos/subscriptionsb\x06proto3"
+
+var (
+	file_subscriptions_proto_rawDescOnce sync.Once
+	file_subscriptions_proto_rawDescData []byte
+)
+
+func file_subscriptions_proto_rawDescGZIP() []byte {
+	file_subscriptions_proto_rawDescOnce.Do(func() {
+		file_subscriptions_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_subscriptions_proto_rawDesc), len(file_subscriptions_proto_rawDesc)))
+	})
+	return file_subscriptions_proto_rawDescData
+}
+
+var file_subscriptions_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_subscriptions_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
+var file_subscriptions_proto_goTypes = []any{
+	(DeliveryState)(0),                         // 0: Superplane.Subscriptions.DeliveryState
+	(*Subscription)(nil),                       // 1: Superplane.Subscriptions.Subscription
+	(*Delivery)(nil),                           // 2: Superplane.Subscriptions.Delivery
+	(*CreateSubscriptionRequest)(nil),          // 3: Superplane.Subscriptions.CreateSubscriptionRequest
+	(*CreateSubscriptionResponse)(nil),         // 4: Superplane.Subscriptions.CreateSubscriptionResponse
+	(*ListSubscriptionsRequest)(nil),           // 5: Superplane.Subscriptions.ListSubscriptionsRequest
+	(*ListSubscriptionsResponse)(nil),          // 6: Superplane.Subscriptions.ListSubscriptionsResponse
+	(*DescribeSubscriptionRequest)(nil),        // 7: Superplane.Subscriptions.DescribeSubscriptionRequest
+	(*DescribeSubscriptionResponse)(nil),       // 8: Superplane.Subscriptions.DescribeSubscriptionResponse
+	(*UpdateSubscriptionRequest)(nil),          // 9: Superplane.Subscriptions.UpdateSubscriptionRequest
+	(*UpdateSubscriptionResponse)(nil),         // 10: Superplane.Subscriptions.UpdateSubscriptionResponse
+	(*DeleteSubscriptionRequest)(nil),          // 11: Superplane.Subscriptions.DeleteSubscriptionRequest
+	(*DeleteSubscriptionResponse)(nil),         // 12: Superplane.Subscriptions.DeleteSubscriptionResponse
+	(*ListSubscriptionDeliveriesRequest)(nil),  // 13: Superplane.Subscriptions.ListSubscriptionDeliveriesRequest
+	(*ListSubscriptionDeliveriesResponse)(nil), // 14: Superplane.Subscriptions.ListSubscriptionDeliveriesResponse
+	(*timestamp.Timestamp)(nil),                // 15: google.protobuf.Timestamp
+}
+var file_subscriptions_proto_depIdxs = []int32{
+	15, // 0: Superplane.Subscriptions.Subscription.created_at:type_name -> google.protobuf.Timestamp
+	15, // 1: Superplane.Subscriptions.Subscription.updated_at:type_name -> google.protobuf.Timestamp
+	0,  // 2: Superplane.Subscriptions.Delivery.state:type_name -> Superplane.Subscriptions.DeliveryState
+	15, // 3: Superplane.Subscriptions.Delivery.next_attempt_at:type_name -> google.protobuf.Timestamp
+	15, // 4: Superplane.Subscriptions.Delivery.delivered_at:type_name -> google.protobuf.Timestamp
+	15, // 5: Superplane.Subscriptions.Delivery.created_at:type_name -> google.protobuf.Timestamp
+	1,  // 6: Superplane.Subscriptions.CreateSubscriptionRequest.subscription:type_name -> Superplane.Subscriptions.Subscription
+	1,  // 7: Superplane.Subscriptions.CreateSubscriptionResponse.subscription:type_name -> Superplane.Subscriptions.Subscription
+	1,  // 8: Superplane.Subscriptions.ListSubscriptionsResponse.subscriptions:type_name -> Superplane.Subscriptions.Subscription
+	1,  // 9: Superplane.Subscriptions.DescribeSubscriptionResponse.subscription:type_name -> Superplane.Subscriptions.Subscription
+	1,  // 10: Superplane.Subscriptions.UpdateSubscriptionRequest.subscription:type_name -> Superplane.Subscriptions.Subscription
+	1,  // 11: Superplane.Subscriptions.UpdateSubscriptionResponse.subscription:type_name -> Superplane.Subscriptions.Subscription
+	2,  // 12: Superplane.Subscriptions.ListSubscriptionDeliveriesResponse.deliveries:type_name -> Superplane.Subscriptions.Delivery
+	3,  // 13: Superplane.Subscriptions.Subscriptions.CreateSubscription:input_type -> Superplane.Subscriptions.CreateSubscriptionRequest
+	5,  // 14: Superplane.Subscriptions.Subscriptions.ListSubscriptions:input_type -> Superplane.Subscriptions.ListSubscriptionsRequest
+	7,  // 15: Superplane.Subscriptions.Subscriptions.DescribeSubscription:input_type -> Superplane.Subscriptions.DescribeSubscriptionRequest
+	9,  // 16: Superplane.Subscriptions.Subscriptions.UpdateSubscription:input_type -> Superplane.Subscriptions.UpdateSubscriptionRequest
+	11, // 17: Superplane.Subscriptions.Subscriptions.DeleteSubscription:input_type -> Superplane.Subscriptions.DeleteSubscriptionRequest
+	13, // 18: Superplane.Subscriptions.Subscriptions.ListSubscriptionDeliveries:input_type -> Superplane.Subscriptions.ListSubscriptionDeliveriesRequest
+	4,  // 19: Superplane.Subscriptions.Subscriptions.CreateSubscription:output_type -> Superplane.Subscriptions.CreateSubscriptionResponse
+	6,  // 20: Superplane.Subscriptions.Subscriptions.ListSubscriptions:output_type -> Superplane.Subscriptions.ListSubscriptionsResponse
+	8,  // 21: Superplane.Subscriptions.Subscriptions.DescribeSubscription:output_type -> Superplane.Subscriptions.DescribeSubscriptionResponse
+	10, // 22: Superplane.Subscriptions.Subscriptions.UpdateSubscription:output_type -> Superplane.Subscriptions.UpdateSubscriptionResponse
+	12, // 23: Superplane.Subscriptions.Subscriptions.DeleteSubscription:output_type -> Superplane.Subscriptions.DeleteSubscriptionResponse
+	14, // 24: Superplane.Subscriptions.Subscriptions.ListSubscriptionDeliveries:output_type -> Superplane.Subscriptions.ListSubscriptionDeliveriesResponse
+	19, // [19:25] is the sub-list for method output_type
+	13, // [13:19] is the sub-list for method input_type
+	13, // [13:13] is the sub-list for extension type_name
+	13, // [13:13] is the sub-list for extension extendee
+	0,  // [0:13] is the sub-list for field type_name
+}
+
+func init() { file_subscriptions_proto_init() }
+func file_subscriptions_proto_init() {
+	if File_subscriptions_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_subscriptions_proto_rawDesc), len(file_subscriptions_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   14,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_subscriptions_proto_goTypes,
+		DependencyIndexes: file_subscriptions_proto_depIdxs,
+		EnumInfos:         file_subscriptions_proto_enumTypes,
+		MessageInfos:      file_subscriptions_proto_msgTypes,
+	}.Build()
+	File_subscriptions_proto = out.File
+	file_subscriptions_proto_goTypes = nil
+	file_subscriptions_proto_depIdxs = nil
+}
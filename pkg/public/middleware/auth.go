@@ -10,20 +10,29 @@ import (
 	"strings"
 	"sync"
 
+	jwtlib "github.com/golang-jwt/jwt/v4"
 	"github.com/gorilla/mux"
+	log "github.com/sirupsen/logrus"
 	"github.com/superplanehq/superplane/pkg/authentication"
 	"github.com/superplanehq/superplane/pkg/crypto"
 	"github.com/superplanehq/superplane/pkg/database"
 	"github.com/superplanehq/superplane/pkg/jwt"
 	"github.com/superplanehq/superplane/pkg/models"
+	"github.com/superplanehq/superplane/pkg/sso"
+	"github.com/superplanehq/superplane/pkg/twofactor"
+	"gorm.io/gorm"
 )
 
 type contextKey string
 
 const AccountContextKey contextKey = "account"
 const UserContextKey contextKey = "user"
+const APITokenContextKey contextKey = "apiToken"
+const SSOOrganizationContextKey contextKey = "ssoOrganization"
 const OrganizationNotFoundError string = "organization_not_found_error"
 const AccountNotFoundError string = "account_not_found_error"
+const SSORequiredError string = "sso_required_error"
+const TwoFactorRequiredError string = "two_factor_required_error"
 
 var ownerSetupEnabled = os.Getenv("OWNER_SETUP_ENABLED") == "yes"
 
@@ -113,7 +122,7 @@ func AccountAuthMiddleware(jwtSigner *jwt.Signer) mux.MiddlewareFunc {
 				return
 			}
 
-			accountID, err := getAccountFromCookie(r, jwtSigner)
+			claims, err := getAccountClaimsFromCookie(r, jwtSigner)
 			if err != nil {
 				if isAccountAPIPath(r.URL.Path) {
 					http.Error(w, "Unauthorized", http.StatusUnauthorized)
@@ -125,7 +134,13 @@ func AccountAuthMiddleware(jwtSigner *jwt.Signer) mux.MiddlewareFunc {
 				return
 			}
 
-			account, err := models.FindAccountByID(accountID)
+			ssoOrganizationID, _ := claims[authentication.SSOOrganizationClaim].(string)
+			if ssoOrganizationID != "" && isAccountAPIPath(r.URL.Path) && !isSSOAccountRequest(r) {
+				http.Error(w, "Forbidden", http.StatusForbidden)
+				return
+			}
+
+			account, err := models.FindAccountByID(claims["sub"].(string))
 			if err != nil {
 				if isAccountAPIPath(r.URL.Path) {
 					http.Error(w, "Unauthorized", http.StatusUnauthorized)
@@ -138,12 +153,41 @@ func AccountAuthMiddleware(jwtSigner *jwt.Signer) mux.MiddlewareFunc {
 			}
 
 			ctx := context.WithValue(r.Context(), AccountContextKey, account)
+			if ssoOrganizationID != "" {
+				ctx = context.WithValue(ctx, SSOOrganizationContextKey, ssoOrganizationID)
+			}
+
 			r = r.WithContext(ctx)
 			next.ServeHTTP(w, r)
 		})
 	}
 }
 
+//
+// Sessions started through an organization's identity provider
+// only grant access to that organization, so they can read the account
+// and list its organizations, but can't change the account,
+// create organizations or join other ones.
+//
+
+func isSSOAccountRequest(r *http.Request) bool {
+	if r.Method != http.MethodGet {
+		return false
+	}
+
+	return r.URL.Path == "/account" || r.URL.Path == "/organizations"
+}
+
+//
+// GetSSOOrganizationFromContext returns the organization
+// an account session is restricted to, if it was started through SSO.
+//
+
+func GetSSOOrganizationFromContext(ctx context.Context) (string, bool) {
+	organizationID, ok := ctx.Value(SSOOrganizationContextKey).(string)
+	return organizationID, ok
+}
+
 func OrganizationAuthMiddleware(jwtSigner *jwt.Signer) mux.MiddlewareFunc {
 	return func(next http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -153,13 +197,17 @@ func OrganizationAuthMiddleware(jwtSigner *jwt.Signer) mux.MiddlewareFunc {
 			// we expect a user API token.
 			//
 			if r.Header.Get("Authorization") != "" {
-				user, err := authenticateUserByToken(r)
+				user, token, err := authenticateUserByToken(r)
 				if err != nil {
 					http.Error(w, "Unauthorized", http.StatusUnauthorized)
 					return
 				}
 
 				ctx := context.WithValue(r.Context(), UserContextKey, user)
+				if token != nil {
+					ctx = context.WithValue(ctx, APITokenContextKey, token)
+				}
+
 				r = r.WithContext(ctx)
 				next.ServeHTTP(w, r)
 				return
@@ -176,6 +224,18 @@ func OrganizationAuthMiddleware(jwtSigner *jwt.Signer) mux.MiddlewareFunc {
 					return
 				}
 
+				if err.Error() == SSORequiredError {
+					w.Header().Set("X-SSO-Login-URL", sso.LoginPath(findOrganizationID(r)))
+					http.Error(w, "Single sign-on required", http.StatusForbidden)
+					return
+				}
+
+				if err.Error() == TwoFactorRequiredError {
+					w.Header().Set(authentication.TwoFactorRequiredHeader, "true")
+					http.Error(w, "Two-factor authentication required", http.StatusForbidden)
+					return
+				}
+
 				http.Error(w, "Unauthorized", http.StatusUnauthorized)
 				return
 			}
@@ -187,27 +247,54 @@ func OrganizationAuthMiddleware(jwtSigner *jwt.Signer) mux.MiddlewareFunc {
 	}
 }
 
-func authenticateUserByToken(r *http.Request) (*models.User, error) {
+//
+// The bearer token is either a named API token, which can have
+// an expiry and scopes, or the legacy token kept on the user itself.
+//
+
+func authenticateUserByToken(r *http.Request) (*models.User, *models.APIToken, error) {
 	authHeader := r.Header.Get("Authorization")
 	if authHeader == "" {
-		return nil, fmt.Errorf("authorization header not found")
+		return nil, nil, fmt.Errorf("authorization header not found")
 	}
 
 	headerParts := strings.Split(authHeader, "Bearer ")
 	if len(headerParts) != 2 {
-		return nil, fmt.Errorf("invalid authorization header")
+		return nil, nil, fmt.Errorf("invalid authorization header")
 	}
 
 	hashedToken := crypto.HashToken(headerParts[1])
-	return models.FindActiveUserByTokenHash(hashedToken)
+	token, err := models.FindAPITokenByHash(hashedToken)
+	if err != nil {
+		user, err := models.FindActiveUserByTokenHash(hashedToken)
+		return user, nil, err
+	}
+
+	if token.IsExpired() {
+		return nil, nil, fmt.Errorf("token expired")
+	}
+
+	user, err := models.FindActiveUserByID(token.OrganizationID.String(), token.UserID.String())
+	if err != nil {
+		return nil, nil, err
+	}
+
+	err = token.MarkAsUsed()
+	if err != nil {
+		log.Warnf("failed to update last usage of token %s: %v", token.ID, err)
+	}
+
+	return user, token, nil
 }
 
 func authenticateUserByCookie(jwtSigner *jwt.Signer, r *http.Request) (*models.User, error) {
-	accountID, err := getAccountFromCookie(r, jwtSigner)
+	claims, err := getAccountClaimsFromCookie(r, jwtSigner)
 	if err != nil {
 		return nil, errors.New(AccountNotFoundError)
 	}
 
+	accountID := claims["sub"].(string)
+
 	organizationID := findOrganizationID(r)
 	if organizationID == "" {
 		return nil, errors.New(OrganizationNotFoundError)
@@ -223,9 +310,106 @@ func authenticateUserByCookie(jwtSigner *jwt.Signer, r *http.Request) (*models.U
 		return nil, errors.New(OrganizationNotFoundError)
 	}
 
+	if err := checkSSOSession(claims, organizationID); err != nil {
+		return nil, err
+	}
+
+	if err := checkTwoFactorSession(claims, account, organizationID); err != nil {
+		return nil, err
+	}
+
 	return user, nil
 }
 
+//
+// Sessions started through an organization's identity provider
+// are only valid for that organization. Organizations that enforce SSO
+// only accept those sessions, so password and social logins are rejected
+// for their members. API tokens are not affected, and remain
+// the way to fix a broken SSO configuration.
+//
+
+func checkSSOSession(claims jwtlib.MapClaims, organizationID string) error {
+	ssoOrganizationID, _ := claims[authentication.SSOOrganizationClaim].(string)
+	if ssoOrganizationID != "" && ssoOrganizationID != organizationID {
+		return errors.New(AccountNotFoundError)
+	}
+
+	config, err := models.FindOrganizationSSOConfig(organizationID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil
+		}
+
+		log.Errorf("failed to load SSO config for organization %s: %v", organizationID, err)
+		return errors.New(AccountNotFoundError)
+	}
+
+	if config.IsEnforced() && ssoOrganizationID != organizationID {
+		return errors.New(SSORequiredError)
+	}
+
+	return nil
+}
+
+//
+// Organizations requiring two-factor authentication reject sessions
+// of members who sign in with a password, unless the session went through
+// a second factor. Members with a password who sign in some other way
+// still need two-factor authentication enabled, since their password
+// alone is enough to access the organization. SSO sessions are left
+// to the identity provider, and API tokens are not affected.
+//
+
+func checkTwoFactorSession(claims jwtlib.MapClaims, account *models.Account, organizationID string) error {
+	if claims[authentication.SSOOrganizationClaim] == organizationID {
+		return nil
+	}
+
+	organization, err := models.FindOrganizationByID(organizationID)
+	if err != nil {
+		log.Errorf("failed to load organization %s: %v", organizationID, err)
+		return errors.New(OrganizationNotFoundError)
+	}
+
+	if !organization.RequireTwoFactor {
+		return nil
+	}
+
+	if authentication.IsPasswordSession(claims) {
+		if !authentication.IsTwoFactorSession(claims) {
+			return errors.New(TwoFactorRequiredError)
+		}
+	} else {
+		_, err = models.FindAccountPasswordAuthByAccountID(account.ID)
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return nil
+			}
+
+			log.Errorf("failed to load password auth for account %s: %v", account.ID, err)
+			return errors.New(AccountNotFoundError)
+		}
+	}
+
+	//
+	// Two-factor authentication may have been disabled,
+	// or reset by an admin, after the session started.
+	//
+
+	enabled, err := twofactor.IsEnabled(account.ID)
+	if err != nil {
+		log.Errorf("failed to check two-factor authentication for account %s: %v", account.ID, err)
+		return errors.New(AccountNotFoundError)
+	}
+
+	if !enabled {
+		return errors.New(TwoFactorRequiredError)
+	}
+
+	return nil
+}
+
 func findOrganizationID(r *http.Request) string {
 	organizationID := r.Header.Get("x-organization-id")
 	if organizationID != "" {
@@ -270,32 +454,35 @@ func isAccountAPIPath(path string) bool {
 	case "/account", "/organizations":
 		return true
 	default:
-		return strings.HasPrefix(path, "/api/v1/invite-links/")
+		return strings.HasPrefix(path, "/api/v1/invite-links/") || strings.HasPrefix(path, "/account/")
 	}
 }
 
-func getAccountFromCookie(r *http.Request, jwtSigner *jwt.Signer) (string, error) {
+func getAccountClaimsFromCookie(r *http.Request, jwtSigner *jwt.Signer) (jwtlib.MapClaims, error) {
 	cookie, err := r.Cookie("account_token")
 	if err != nil {
-		return "", fmt.Errorf("account token cookie not found")
+		return nil, fmt.Errorf("account token cookie not found")
 	}
 
 	claims, err := jwtSigner.ValidateAndGetClaims(cookie.Value)
 	if err != nil {
-		return "", fmt.Errorf("invalid account token: %v", err)
+		return nil, fmt.Errorf("invalid account token: %v", err)
+	}
+
+	if _, exists := claims[authentication.TokenPurposeClaim]; exists {
+		return nil, fmt.Errorf("token is not an account token")
 	}
 
 	accountClaim, exists := claims["sub"]
 	if !exists {
-		return "", fmt.Errorf("account ID missing from token")
+		return nil, fmt.Errorf("account ID missing from token")
 	}
 
-	accountID, ok := accountClaim.(string)
-	if !ok {
-		return "", fmt.Errorf("invalid account ID in token")
+	if _, ok := accountClaim.(string); !ok {
+		return nil, fmt.Errorf("invalid account ID in token")
 	}
 
-	return accountID, nil
+	return claims, nil
 }
 
 func GetUserFromContext(ctx context.Context) (*models.User, bool) {
@@ -303,6 +490,11 @@ func GetUserFromContext(ctx context.Context) (*models.User, bool) {
 	return user, ok
 }
 
+func GetAPITokenFromContext(ctx context.Context) (*models.APIToken, bool) {
+	token, ok := ctx.Value(APITokenContextKey).(*models.APIToken)
+	return token, ok
+}
+
 func redirectToLoginWithOriginalURL(w http.ResponseWriter, r *http.Request) {
 	redirectURL := url.QueryEscape(r.URL.RequestURI())
 	loginURL := fmt.Sprintf("/login?redirect=%s", redirectURL)
@@ -9,7 +9,11 @@ import (
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/authentication"
+	"github.com/superplanehq/superplane/pkg/crypto"
+	"github.com/superplanehq/superplane/pkg/database"
 	"github.com/superplanehq/superplane/pkg/jwt"
+	"github.com/superplanehq/superplane/pkg/models"
 	"github.com/superplanehq/superplane/test/support"
 )
 
@@ -66,3 +70,284 @@ func TestOrganizationAuthMiddleware_CookieAuthErrors(t *testing.T) {
 		assert.Equal(t, http.StatusNoContent, res.Code)
 	})
 }
+
+func TestOrganizationAuthMiddleware_APITokens(t *testing.T) {
+	r := support.Setup(t)
+	signer := jwt.NewSigner("test-secret")
+
+	var tokenInContext *models.APIToken
+	handler := OrganizationAuthMiddleware(signer)(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		tokenInContext, _ = GetAPITokenFromContext(req.Context())
+		w.WriteHeader(http.StatusNoContent)
+	}))
+
+	createToken := func(t *testing.T, expiresAt *time.Time) (*models.APIToken, string) {
+		plainToken, err := crypto.Base64String(64)
+		require.NoError(t, err)
+
+		token := &models.APIToken{
+			OrganizationID: r.Organization.ID,
+			UserID:         r.User,
+			Name:           support.RandomName("token"),
+			TokenHash:      crypto.HashToken(plainToken),
+			Scopes:         []models.APITokenScope{{Permission: "canvases:read"}},
+			ExpiresAt:      expiresAt,
+		}
+
+		require.NoError(t, models.CreateAPIToken(token))
+		return token, plainToken
+	}
+
+	request := func(plainToken string) *httptest.ResponseRecorder {
+		tokenInContext = nil
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/canvases", nil)
+		req.Header.Set("Authorization", "Bearer "+plainToken)
+		res := httptest.NewRecorder()
+		handler.ServeHTTP(res, req)
+		return res
+	}
+
+	t.Run("valid token reaches next handler and records usage", func(t *testing.T) {
+		token, plainToken := createToken(t, nil)
+
+		res := request(plainToken)
+		assert.Equal(t, http.StatusNoContent, res.Code)
+		require.NotNil(t, tokenInContext)
+		assert.Equal(t, token.ID, tokenInContext.ID)
+
+		token, err := models.FindAPIToken(r.Organization.ID.String(), token.ID.String())
+		require.NoError(t, err)
+		assert.NotNil(t, token.LastUsedAt)
+	})
+
+	t.Run("expired token returns unauthorized", func(t *testing.T) {
+		expiresAt := time.Now().Add(-time.Minute)
+		_, plainToken := createToken(t, &expiresAt)
+
+		res := request(plainToken)
+		assert.Equal(t, http.StatusUnauthorized, res.Code)
+	})
+
+	t.Run("revoked token returns unauthorized", func(t *testing.T) {
+		token, plainToken := createToken(t, nil)
+		require.NoError(t, token.Delete())
+
+		res := request(plainToken)
+		assert.Equal(t, http.StatusUnauthorized, res.Code)
+	})
+
+	t.Run("legacy user token still works", func(t *testing.T) {
+		plainToken, err := crypto.Base64String(64)
+		require.NoError(t, err)
+
+		user, err := models.FindActiveUserByID(r.Organization.ID.String(), r.User.String())
+		require.NoError(t, err)
+		require.NoError(t, user.UpdateTokenHash(crypto.HashToken(plainToken)))
+
+		res := request(plainToken)
+		assert.Equal(t, http.StatusNoContent, res.Code)
+		assert.Nil(t, tokenInContext)
+	})
+}
+
+func TestOrganizationAuthMiddleware_SSO(t *testing.T) {
+	r := support.Setup(t)
+	signer := jwt.NewSigner("test-secret")
+	orgID := r.Organization.ID.String()
+
+	handler := OrganizationAuthMiddleware(signer)(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}))
+
+	request := func(token string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/users", nil)
+		req.AddCookie(&http.Cookie{Name: "account_token", Value: token})
+		req.Header.Set("x-organization-id", orgID)
+
+		res := httptest.NewRecorder()
+		handler.ServeHTTP(res, req)
+		return res
+	}
+
+	passwordToken, err := signer.Generate(r.Account.ID.String(), time.Hour)
+	require.NoError(t, err)
+
+	ssoToken, err := signer.GenerateWithClaims(r.Account.ID.String(), time.Hour, map[string]any{
+		authentication.SSOOrganizationClaim: orgID,
+	})
+	require.NoError(t, err)
+
+	otherOrgToken, err := signer.GenerateWithClaims(r.Account.ID.String(), time.Hour, map[string]any{
+		authentication.SSOOrganizationClaim: uuid.NewString(),
+	})
+	require.NoError(t, err)
+
+	stateToken, err := signer.GenerateWithClaims(r.Account.ID.String(), time.Hour, map[string]any{
+		authentication.TokenPurposeClaim: "sso_state",
+	})
+	require.NoError(t, err)
+
+	metadata := "<EntityDescriptor/>"
+	config := &models.OrganizationSSOConfig{
+		OrganizationID:  r.Organization.ID,
+		Protocol:        models.SSOProtocolSAML,
+		Enabled:         true,
+		SAMLIdPMetadata: &metadata,
+		EmailAttribute:  models.SSODefaultEmailAttribute,
+		NameAttribute:   models.SSODefaultNameAttribute,
+		GroupsAttribute: models.SSODefaultGroupsAttribute,
+		DefaultRole:     models.RoleOrgViewer,
+	}
+
+	require.NoError(t, models.UpsertOrganizationSSOConfig(config))
+
+	t.Run("SSO not enforced -> any session works", func(t *testing.T) {
+		assert.Equal(t, http.StatusNoContent, request(passwordToken).Code)
+		assert.Equal(t, http.StatusNoContent, request(ssoToken).Code)
+	})
+
+	t.Run("session from another organization's SSO -> unauthorized", func(t *testing.T) {
+		assert.Equal(t, http.StatusUnauthorized, request(otherOrgToken).Code)
+	})
+
+	t.Run("token with a purpose -> unauthorized", func(t *testing.T) {
+		assert.Equal(t, http.StatusUnauthorized, request(stateToken).Code)
+	})
+
+	t.Run("SSO enforced -> only SSO sessions work", func(t *testing.T) {
+		config.EnforceSSO = true
+		require.NoError(t, models.UpsertOrganizationSSOConfig(config))
+
+		res := request(passwordToken)
+		assert.Equal(t, http.StatusForbidden, res.Code)
+		assert.Equal(t, "/auth/sso/"+orgID, res.Header().Get("X-SSO-Login-URL"))
+
+		assert.Equal(t, http.StatusNoContent, request(ssoToken).Code)
+	})
+}
+
+func TestAccountAuthMiddleware_SSO(t *testing.T) {
+	r := support.Setup(t)
+	signer := jwt.NewSigner("test-secret")
+	orgID := r.Organization.ID.String()
+
+	var ssoOrganizationID string
+	handler := AccountAuthMiddleware(signer)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ssoOrganizationID, _ = GetSSOOrganizationFromContext(r.Context())
+		w.WriteHeader(http.StatusNoContent)
+	}))
+
+	request := func(method, path, token string) *httptest.ResponseRecorder {
+		ssoOrganizationID = ""
+		req := httptest.NewRequest(method, path, nil)
+		req.AddCookie(&http.Cookie{Name: "account_token", Value: token})
+
+		res := httptest.NewRecorder()
+		handler.ServeHTTP(res, req)
+		return res
+	}
+
+	passwordToken, err := signer.Generate(r.Account.ID.String(), time.Hour)
+	require.NoError(t, err)
+
+	ssoToken, err := signer.GenerateWithClaims(r.Account.ID.String(), time.Hour, map[string]any{
+		authentication.SSOOrganizationClaim: orgID,
+	})
+	require.NoError(t, err)
+
+	t.Run("regular session -> all account routes", func(t *testing.T) {
+		assert.Equal(t, http.StatusNoContent, request(http.MethodGet, "/account", passwordToken).Code)
+		assert.Equal(t, http.StatusNoContent, request(http.MethodPost, "/organizations", passwordToken).Code)
+		assert.Equal(t, http.StatusNoContent, request(http.MethodPost, "/account/two-factor/setup", passwordToken).Code)
+		assert.Empty(t, ssoOrganizationID)
+	})
+
+	t.Run("SSO session -> reads scoped to its organization", func(t *testing.T) {
+		assert.Equal(t, http.StatusNoContent, request(http.MethodGet, "/account", ssoToken).Code)
+		assert.Equal(t, orgID, ssoOrganizationID)
+
+		assert.Equal(t, http.StatusNoContent, request(http.MethodGet, "/organizations", ssoToken).Code)
+		assert.Equal(t, orgID, ssoOrganizationID)
+	})
+
+	t.Run("SSO session -> account changes forbidden", func(t *testing.T) {
+		assert.Equal(t, http.StatusForbidden, request(http.MethodPost, "/organizations", ssoToken).Code)
+		assert.Equal(t, http.StatusForbidden, request(http.MethodPost, "/account/two-factor/setup", ssoToken).Code)
+		assert.Equal(t, http.StatusForbidden, request(http.MethodPost, "/api/v1/invite-links/token/accept", ssoToken).Code)
+	})
+}
+
+func TestOrganizationAuthMiddleware_TwoFactor(t *testing.T) {
+	r := support.Setup(t)
+	signer := jwt.NewSigner("test-secret")
+	orgID := r.Organization.ID.String()
+
+	handler := OrganizationAuthMiddleware(signer)(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}))
+
+	request := func(token string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/users", nil)
+		req.AddCookie(&http.Cookie{Name: "account_token", Value: token})
+		req.Header.Set("x-organization-id", orgID)
+
+		res := httptest.NewRecorder()
+		handler.ServeHTTP(res, req)
+		return res
+	}
+
+	passwordHash, err := crypto.HashPassword("password")
+	require.NoError(t, err)
+	_, err = models.CreateAccountPasswordAuth(r.Account.ID, passwordHash)
+	require.NoError(t, err)
+
+	passwordToken, err := signer.GenerateWithClaims(r.Account.ID.String(), time.Hour, authentication.SessionClaims(authentication.AuthMethodPassword))
+	require.NoError(t, err)
+
+	twoFactorToken, err := signer.GenerateWithClaims(r.Account.ID.String(), time.Hour, authentication.SessionClaims(authentication.AuthMethodPassword, authentication.AuthMethodOTP))
+	require.NoError(t, err)
+
+	providerToken, err := signer.Generate(r.Account.ID.String(), time.Hour)
+	require.NoError(t, err)
+
+	ssoToken, err := signer.GenerateWithClaims(r.Account.ID.String(), time.Hour, map[string]any{
+		authentication.SSOOrganizationClaim: orgID,
+	})
+	require.NoError(t, err)
+
+	t.Run("two-factor not required -> any session works", func(t *testing.T) {
+		assert.Equal(t, http.StatusNoContent, request(passwordToken).Code)
+		assert.Equal(t, http.StatusNoContent, request(providerToken).Code)
+	})
+
+	require.NoError(t, database.Conn().Model(r.Organization).Update("require_two_factor", true).Error)
+
+	t.Run("two-factor required and not enabled -> only SSO sessions work", func(t *testing.T) {
+		res := request(passwordToken)
+		assert.Equal(t, http.StatusForbidden, res.Code)
+		assert.Equal(t, "true", res.Header().Get(authentication.TwoFactorRequiredHeader))
+
+		assert.Equal(t, http.StatusForbidden, request(twoFactorToken).Code)
+		assert.Equal(t, http.StatusForbidden, request(providerToken).Code)
+		assert.Equal(t, http.StatusNoContent, request(ssoToken).Code)
+	})
+
+	require.NoError(t, database.Conn().Create(&models.AccountTwoFactorAuth{
+		AccountID: r.Account.ID,
+		Secret:    []byte("secret"),
+		EnabledAt: func() *time.Time { now := time.Now(); return &now }(),
+	}).Error)
+
+	t.Run("two-factor required and enabled -> password sessions need a second factor", func(t *testing.T) {
+		assert.Equal(t, http.StatusForbidden, request(passwordToken).Code)
+		assert.Equal(t, http.StatusNoContent, request(twoFactorToken).Code)
+		assert.Equal(t, http.StatusNoContent, request(providerToken).Code)
+	})
+
+	t.Run("account without password -> not affected", func(t *testing.T) {
+		require.NoError(t, models.DeleteAccountTwoFactorAuth(r.Account.ID))
+		require.NoError(t, database.Conn().Where("account_id = ?", r.Account.ID).Delete(&models.AccountPasswordAuth{}).Error)
+
+		assert.Equal(t, http.StatusNoContent, request(providerToken).Code)
+	})
+}
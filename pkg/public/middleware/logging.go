@@ -124,6 +124,12 @@ func (lrw *loggingResponseWriter) WriteHeader(code int) {
 	lrw.ResponseWriter.WriteHeader(code)
 }
 
+// Unwrap gives http.ResponseController access to the underlying writer,
+// so streaming responses can be flushed.
+func (lrw *loggingResponseWriter) Unwrap() http.ResponseWriter {
+	return lrw.ResponseWriter
+}
+
 // Implement http.Hijacker interface to support WebSocket upgrades
 func (lrw *loggingResponseWriter) Hijack() (net.Conn, *bufio.ReadWriter, error) {
 	hijacker, ok := lrw.ResponseWriter.(http.Hijacker)
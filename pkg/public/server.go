@@ -10,6 +10,7 @@ import (
 	"net/http/httputil"
 	"net/url"
 	"os"
+	"slices"
 	"strconv"
 	"strings"
 	"time"
@@ -27,6 +28,7 @@ import (
 	"github.com/superplanehq/superplane/pkg/jwt"
 	"github.com/superplanehq/superplane/pkg/logging"
 	"github.com/superplanehq/superplane/pkg/registry"
+	"github.com/superplanehq/superplane/pkg/scim"
 	"github.com/superplanehq/superplane/pkg/workers/contexts"
 	"go.opentelemetry.io/contrib/instrumentation/github.com/gorilla/mux/otelmux"
 	nooptrace "go.opentelemetry.io/otel/trace/noop"
@@ -44,6 +46,8 @@ import (
 	pbRoles "github.com/superplanehq/superplane/pkg/protos/roles"
 	pbSecret "github.com/superplanehq/superplane/pkg/protos/secrets"
 	pbServiceAccounts "github.com/superplanehq/superplane/pkg/protos/service_accounts"
+	pbSubscriptions "github.com/superplanehq/superplane/pkg/protos/subscriptions"
+	pbTokens "github.com/superplanehq/superplane/pkg/protos/tokens"
 	pbTriggers "github.com/superplanehq/superplane/pkg/protos/triggers"
 	pbUsers "github.com/superplanehq/superplane/pkg/protos/users"
 	pbWidgets "github.com/superplanehq/superplane/pkg/protos/widgets"
@@ -106,6 +110,7 @@ func NewServer(
 	authHandler := authentication.NewHandler(jwtSigner, encryptor, authorizationService, appEnv, templateDir, blockSignup, passwordLoginEnabled)
 	providers := getOAuthProviders()
 	authHandler.InitializeProviders(providers)
+	authHandler.InitializeSSO(baseURL)
 
 	server := &Server{
 		BaseURL:               baseURL,
@@ -170,6 +175,7 @@ func (s *Server) RegisterGRPCGateway(grpcServerAddr string) error {
 	grpcGatewayMux := runtime.NewServeMux(
 		runtime.WithIncomingHeaderMatcher(headersMatcher),
 		runtime.SetQueryParameterParser(&grpc.QueryParser{}),
+		runtime.WithMarshalerOption(grpc.SSEContentType, grpc.NewSSEMarshaler()),
 	)
 
 	opts := []grpcLib.DialOption{grpcLib.WithTransportCredentials(insecure.NewCredentials())}
@@ -239,6 +245,16 @@ func (s *Server) RegisterGRPCGateway(grpcServerAddr string) error {
 		return err
 	}
 
+	err = pbTokens.RegisterTokensHandlerFromEndpoint(ctx, grpcGatewayMux, grpcServerAddr, opts)
+	if err != nil {
+		return err
+	}
+
+	err = pbSubscriptions.RegisterSubscriptionsHandlerFromEndpoint(ctx, grpcGatewayMux, grpcServerAddr, opts)
+	if err != nil {
+		return err
+	}
+
 	// Public health check
 	s.Router.HandleFunc("/api/v1/canvases/is-alive", func(w http.ResponseWriter, r *http.Request) {
 		w.WriteHeader(http.StatusOK)
@@ -265,6 +281,8 @@ func (s *Server) RegisterGRPCGateway(grpcServerAddr string) error {
 	s.Router.PathPrefix("/api/v1/widgets").Handler(protectedGRPCHandler)
 	s.Router.PathPrefix("/api/v1/blueprints").Handler(protectedGRPCHandler)
 	s.Router.PathPrefix("/api/v1/service-accounts").Handler(protectedGRPCHandler)
+	s.Router.PathPrefix("/api/v1/tokens").Handler(protectedGRPCHandler)
+	s.Router.PathPrefix("/api/v1/subscriptions").Handler(protectedGRPCHandler)
 	s.Router.PathPrefix("/api/v1/workflows").Handler(protectedGRPCHandler)
 
 	return nil
@@ -272,7 +290,7 @@ func (s *Server) RegisterGRPCGateway(grpcServerAddr string) error {
 
 func headersMatcher(key string) (string, bool) {
 	switch key {
-	case "X-User-Id", "X-Organization-Id", "X-Account-Id":
+	case "X-User-Id", "X-Organization-Id", "X-Account-Id", "X-Token-Id":
 		return key, true
 	default:
 		return runtime.DefaultHeaderMatcher(key)
@@ -293,10 +311,49 @@ func (s *Server) grpcGatewayHandler(grpcGatewayMux *runtime.ServeMux) http.Handl
 		*r2.URL = *r.URL
 		r2.Header.Set("x-User-id", user.ID.String())
 		r2.Header.Set("x-Organization-id", user.OrganizationID.String())
+
+		//
+		// The token ID is only forwarded when the request was authenticated
+		// with an API token, so its scopes can be enforced by the gRPC server.
+		// Never trust a value sent by the client.
+		//
+		r2.Header.Del("x-Token-id")
+		if token, ok := middleware.GetAPITokenFromContext(r.Context()); ok {
+			r2.Header.Set("x-Token-id", token.ID.String())
+		}
+
+		if strings.HasSuffix(r2.URL.Path, "/watch") {
+			prepareWatchRequest(w, r2)
+		}
+
 		grpcGatewayMux.ServeHTTP(w, r2.WithContext(r.Context()))
 	})
 }
 
+//
+// Watch requests stream until the client disconnects,
+// so they are not subject to the write timeout of the server.
+// EventSource clients reconnect with the Last-Event-ID header,
+// which holds the resume token of the last change they received.
+//
+
+func prepareWatchRequest(w http.ResponseWriter, r *http.Request) {
+	err := http.NewResponseController(w).SetWriteDeadline(time.Time{})
+	if err != nil {
+		log.Warnf("Error clearing write deadline for %s: %v", r.URL.Path, err)
+	}
+
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("X-Accel-Buffering", "no")
+
+	lastEventID := r.Header.Get("Last-Event-ID")
+	query := r.URL.Query()
+	if lastEventID != "" && query.Get("resume_token") == "" && query.Get("resumeToken") == "" {
+		query.Set("resume_token", lastEventID)
+		r.URL.RawQuery = query.Encode()
+	}
+}
+
 func (s *Server) grpcGatewayAccountHandler(grpcGatewayMux *runtime.ServeMux) http.HandlerFunc {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		account, ok := middleware.GetAccountFromContext(r.Context())
@@ -385,6 +442,9 @@ func (s *Server) InitRouter(additionalMiddlewares ...mux.MiddlewareFunc) {
 	// Register authentication routes (no auth required)
 	s.authHandler.RegisterRoutes(r)
 
+	// SCIM provisioning endpoints (authenticated with the organization's SCIM token)
+	scim.NewServer(s.authService, s.BaseURL).RegisterRoutes(r)
+
 	//
 	// Public routes (no authentication required)
 	//
@@ -431,6 +491,7 @@ func (s *Server) InitRouter(additionalMiddlewares ...mux.MiddlewareFunc) {
 	accountRoute.HandleFunc("/account", s.getAccount).Methods("GET")
 	accountRoute.HandleFunc("/organizations", s.listAccountOrganizations).Methods("GET")
 	accountRoute.HandleFunc("/organizations", s.createOrganization).Methods("POST")
+	s.registerTwoFactorRoutes(accountRoute)
 
 	// Apply additional middlewares
 	for _, middleware := range additionalMiddlewares {
@@ -667,6 +728,12 @@ func (s *Server) listAccountOrganizations(w http.ResponseWriter, r *http.Request
 		return
 	}
 
+	if ssoOrganizationID, ok := middleware.GetSSOOrganizationFromContext(r.Context()); ok {
+		organizations = slices.DeleteFunc(organizations, func(organization models.Organization) bool {
+			return organization.ID.String() != ssoOrganizationID
+		})
+	}
+
 	orgIDs := make([]string, 0, len(organizations))
 	for _, organization := range organizations {
 		orgIDs = append(orgIDs, organization.ID.String())
@@ -7,6 +7,7 @@ import (
 	"time"
 
 	log "github.com/sirupsen/logrus"
+	"github.com/superplanehq/superplane/pkg/authentication"
 	"github.com/superplanehq/superplane/pkg/crypto"
 	"github.com/superplanehq/superplane/pkg/database"
 	"github.com/superplanehq/superplane/pkg/models"
@@ -148,22 +149,14 @@ func (s *Server) setupOwner(w http.ResponseWriter, r *http.Request) {
 	middleware.MarkOwnerSetupCompleted()
 
 	// Create account cookie so the owner is signed in
-	token, err := s.jwt.Generate(account.ID.String(), 24*time.Hour)
+	token, err := s.jwt.GenerateWithClaims(account.ID.String(), 24*time.Hour, authentication.SessionClaims(authentication.AuthMethodPassword))
 	if err != nil {
 		log.Errorf("Failed to generate account token for owner: %v", err)
 		http.Error(w, "Failed to create owner session", http.StatusInternalServerError)
 		return
 	}
 
-	http.SetCookie(w, &http.Cookie{
-		Name:     "account_token",
-		Value:    token,
-		Path:     "/",
-		MaxAge:   int(24 * time.Hour.Seconds()),
-		HttpOnly: true,
-		Secure:   r.TLS != nil,
-		SameSite: http.SameSiteLaxMode,
-	})
+	authentication.SetAccountCookie(w, r, token)
 
 	w.Header().Set("Content-Type", "application/json")
 	json.NewEncoder(w).Encode(SetupOwnerResponse{
@@ -0,0 +1,214 @@
+package public
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/gorilla/mux"
+	log "github.com/sirupsen/logrus"
+	"github.com/superplanehq/superplane/pkg/authentication"
+	"github.com/superplanehq/superplane/pkg/models"
+	"github.com/superplanehq/superplane/pkg/public/middleware"
+	"github.com/superplanehq/superplane/pkg/twofactor"
+	"gorm.io/gorm"
+)
+
+type TwoFactorStatusResponse struct {
+	Enabled                bool       `json:"enabled"`
+	EnabledAt              *time.Time `json:"enabled_at,omitempty"`
+	RecoveryCodesRemaining int        `json:"recovery_codes_remaining"`
+}
+
+type TwoFactorEnrollmentResponse struct {
+	Secret          string `json:"secret"`
+	ProvisioningURI string `json:"provisioning_uri"`
+	QRCode          string `json:"qr_code"`
+}
+
+type TwoFactorCodeRequest struct {
+	Code string `json:"code"`
+}
+
+type TwoFactorRecoveryCodesResponse struct {
+	RecoveryCodes []string `json:"recovery_codes"`
+}
+
+func (s *Server) registerTwoFactorRoutes(accountRoute *mux.Router) {
+	accountRoute.HandleFunc("/account/two-factor", s.getTwoFactorStatus).Methods("GET")
+	accountRoute.HandleFunc("/account/two-factor/enrollment", s.beginTwoFactorEnrollment).Methods("POST")
+	accountRoute.HandleFunc("/account/two-factor/enable", s.enableTwoFactor).Methods("POST")
+	accountRoute.HandleFunc("/account/two-factor/recovery-codes", s.regenerateTwoFactorRecoveryCodes).Methods("POST")
+	accountRoute.HandleFunc("/account/two-factor/disable", s.disableTwoFactor).Methods("POST")
+}
+
+func (s *Server) getTwoFactorStatus(w http.ResponseWriter, r *http.Request) {
+	account, ok := middleware.GetAccountFromContext(r.Context())
+	if !ok {
+		http.Error(w, "", http.StatusUnauthorized)
+		return
+	}
+
+	response := TwoFactorStatusResponse{}
+
+	auth, err := models.FindEnabledAccountTwoFactorAuth(account.ID)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		log.Errorf("Error loading two-factor authentication for %s: %v", account.Email, err)
+		http.Error(w, "", http.StatusInternalServerError)
+		return
+	}
+
+	if auth != nil {
+		response.Enabled = true
+		response.EnabledAt = auth.EnabledAt
+		response.RecoveryCodesRemaining = len(auth.RecoveryCodes)
+	}
+
+	writeTwoFactorJSON(w, response)
+}
+
+//
+// Enrollment returns the secret in three forms: the secret itself,
+// for manual entry, the otpauth:// provisioning URI, and a QR code of it.
+// Nothing changes for the account until the first code is verified.
+//
+
+func (s *Server) beginTwoFactorEnrollment(w http.ResponseWriter, r *http.Request) {
+	account, ok := middleware.GetAccountFromContext(r.Context())
+	if !ok {
+		http.Error(w, "", http.StatusUnauthorized)
+		return
+	}
+
+	enrollment, err := twofactor.Begin(r.Context(), s.encryptor, account)
+	if err != nil {
+		writeTwoFactorError(w, account, err)
+		return
+	}
+
+	writeTwoFactorJSON(w, TwoFactorEnrollmentResponse{
+		Secret:          enrollment.Secret,
+		ProvisioningURI: enrollment.ProvisioningURI,
+		QRCode:          enrollment.QRCode,
+	})
+}
+
+//
+// Enabling two-factor authentication also upgrades the current password session,
+// so members of organizations requiring it don't need to sign in again.
+// Other sessions are kept as they are, since they may be restricted,
+// like the ones started through an organization's identity provider.
+//
+
+func (s *Server) enableTwoFactor(w http.ResponseWriter, r *http.Request) {
+	account, ok := middleware.GetAccountFromContext(r.Context())
+	if !ok {
+		http.Error(w, "", http.StatusUnauthorized)
+		return
+	}
+
+	var req TwoFactorCodeRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil || req.Code == "" {
+		http.Error(w, "Verification code is required", http.StatusBadRequest)
+		return
+	}
+
+	codes, err := twofactor.Enable(r.Context(), s.encryptor, account, req.Code, r.RemoteAddr)
+	if err != nil {
+		writeTwoFactorError(w, account, err)
+		return
+	}
+
+	if s.isPasswordSession(r) {
+		token, err := s.jwt.GenerateWithClaims(account.ID.String(), 24*time.Hour, authentication.SessionClaims(authentication.AuthMethodPassword, authentication.AuthMethodOTP))
+		if err != nil {
+			log.Errorf("Failed to generate account token for %s: %v", account.Email, err)
+			http.Error(w, "", http.StatusInternalServerError)
+			return
+		}
+
+		authentication.SetAccountCookie(w, r, token)
+	}
+
+	writeTwoFactorJSON(w, TwoFactorRecoveryCodesResponse{RecoveryCodes: codes})
+}
+
+func (s *Server) regenerateTwoFactorRecoveryCodes(w http.ResponseWriter, r *http.Request) {
+	account, ok := middleware.GetAccountFromContext(r.Context())
+	if !ok {
+		http.Error(w, "", http.StatusUnauthorized)
+		return
+	}
+
+	var req TwoFactorCodeRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil || req.Code == "" {
+		http.Error(w, "Verification code is required", http.StatusBadRequest)
+		return
+	}
+
+	codes, err := twofactor.RegenerateRecoveryCodes(r.Context(), s.encryptor, account, req.Code, r.RemoteAddr)
+	if err != nil {
+		writeTwoFactorError(w, account, err)
+		return
+	}
+
+	writeTwoFactorJSON(w, TwoFactorRecoveryCodesResponse{RecoveryCodes: codes})
+}
+
+func (s *Server) disableTwoFactor(w http.ResponseWriter, r *http.Request) {
+	account, ok := middleware.GetAccountFromContext(r.Context())
+	if !ok {
+		http.Error(w, "", http.StatusUnauthorized)
+		return
+	}
+
+	var req TwoFactorCodeRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil || req.Code == "" {
+		http.Error(w, "Verification code is required", http.StatusBadRequest)
+		return
+	}
+
+	err := twofactor.Disable(r.Context(), s.encryptor, account, req.Code, r.RemoteAddr)
+	if err != nil {
+		writeTwoFactorError(w, account, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) isPasswordSession(r *http.Request) bool {
+	cookie, err := r.Cookie("account_token")
+	if err != nil {
+		return false
+	}
+
+	claims, err := s.jwt.ValidateAndGetClaims(cookie.Value)
+	if err != nil {
+		return false
+	}
+
+	return authentication.IsPasswordSession(claims)
+}
+
+func writeTwoFactorError(w http.ResponseWriter, account *models.Account, err error) {
+	switch {
+	case errors.Is(err, twofactor.ErrInvalidCode):
+		http.Error(w, "Invalid verification code", http.StatusUnprocessableEntity)
+	case errors.Is(err, twofactor.ErrTooManyAttempts):
+		http.Error(w, "Too many failed attempts, try again later", http.StatusTooManyRequests)
+	case errors.Is(err, twofactor.ErrAlreadyEnabled):
+		http.Error(w, err.Error(), http.StatusConflict)
+	case errors.Is(err, twofactor.ErrNotEnabled), errors.Is(err, twofactor.ErrNotStarted), errors.Is(err, twofactor.ErrPasswordRequired):
+		http.Error(w, err.Error(), http.StatusBadRequest)
+	default:
+		log.Errorf("Error updating two-factor authentication for %s: %v", account.Email, err)
+		http.Error(w, "", http.StatusInternalServerError)
+	}
+}
+
+func writeTwoFactorJSON(w http.ResponseWriter, response any) {
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(response)
+}
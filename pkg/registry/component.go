@@ -168,3 +168,7 @@ func (s *PanicableComponent) OnIntegrationMessage(ctx core.IntegrationMessageCon
 
 	return integrationComponent.OnIntegrationMessage(ctx)
 }
+
+func (s *PanicableComponent) SideEffectFree() bool {
+	return core.IsSideEffectFree(s.underlying)
+}
@@ -10,9 +10,13 @@ import (
 	"strings"
 	"syscall"
 	"time"
+
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+	"go.opentelemetry.io/otel/trace"
 )
 
 type HTTPContext struct {
+	ctx              context.Context
 	client           *http.Client
 	dialer           *net.Dialer
 	blockedHosts     []string
@@ -70,7 +74,12 @@ func NewHTTPContext(options HTTPOptions) (*HTTPContext, error) {
 
 	httpCtx.client = &http.Client{
 		Timeout: 30 * time.Second,
-		Transport: &http.Transport{
+
+		//
+		// Requests get a client span, and the trace context
+		// is propagated to the called services through the request headers.
+		//
+		Transport: otelhttp.NewTransport(&http.Transport{
 			ForceAttemptHTTP2:     true,
 			MaxIdleConns:          100,
 			IdleConnTimeout:       90 * time.Second,
@@ -79,7 +88,7 @@ func NewHTTPContext(options HTTPOptions) (*HTTPContext, error) {
 			DialContext: func(ctx context.Context, network, addr string) (net.Conn, error) {
 				return httpCtx.dialer.DialContext(ctx, network, addr)
 			},
-		},
+		}),
 		CheckRedirect: func(req *http.Request, via []*http.Request) error {
 			if len(via) >= 10 {
 				return fmt.Errorf("stopped after 10 redirects")
@@ -96,6 +105,19 @@ func NewHTTPContext(options HTTPOptions) (*HTTPContext, error) {
 	return httpCtx, nil
 }
 
+//
+// WithContext returns a copy of the HTTP context that attaches
+// the requests it makes to the span in ctx, like the span for the node execution
+// that is making them. Components build their requests without a context,
+// so this is how their calls end up in the trace of the execution.
+//
+
+func (c *HTTPContext) WithContext(ctx context.Context) *HTTPContext {
+	httpCtx := *c
+	httpCtx.ctx = ctx
+	return &httpCtx
+}
+
 func (c *HTTPContext) Do(request *http.Request) (*http.Response, error) {
 	if len(c.privateIPRanges) == 0 && len(c.blockedHosts) == 0 {
 		return c.do(request)
@@ -110,6 +132,11 @@ func (c *HTTPContext) Do(request *http.Request) (*http.Response, error) {
 }
 
 func (c *HTTPContext) do(request *http.Request) (*http.Response, error) {
+	if c.ctx != nil && !trace.SpanContextFromContext(request.Context()).IsValid() {
+		span := trace.SpanFromContext(c.ctx)
+		request = request.WithContext(trace.ContextWithSpan(request.Context(), span))
+	}
+
 	resp, err := c.client.Do(request)
 	if err != nil {
 		return nil, err
@@ -1,6 +1,7 @@
 package registry
 
 import (
+	"context"
 	"io"
 	"net"
 	"net/http"
@@ -13,6 +14,11 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
 )
 
 func Test__NewHTTPContext_InvalidCIDR(t *testing.T) {
@@ -186,6 +192,50 @@ func Test__HTTPContext__Do(t *testing.T) {
 	})
 }
 
+func Test__HTTPContext__WithContext(t *testing.T) {
+	previousPropagator := otel.GetTextMapPropagator()
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+	t.Cleanup(func() { otel.SetTextMapPropagator(previousPropagator) })
+
+	var traceParent atomic.Value
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		traceParent.Store(r.Header.Get("traceparent"))
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	t.Cleanup(testServer.Close)
+
+	provider := sdktrace.NewTracerProvider()
+	spanCtx, span := provider.Tracer("test").Start(context.Background(), "canvas.node_execution")
+	defer span.End()
+
+	httpCtx, err := NewHTTPContext(HTTPOptions{})
+	require.NoError(t, err)
+
+	t.Run("requests without context have no trace parent", func(t *testing.T) {
+		req, err := http.NewRequest(http.MethodGet, testServer.URL, nil)
+		require.NoError(t, err)
+
+		resp, err := httpCtx.Do(req)
+		require.NoError(t, err)
+		_ = resp.Body.Close()
+		assert.Empty(t, traceParent.Load())
+	})
+
+	t.Run("requests with context propagate the trace", func(t *testing.T) {
+		req, err := http.NewRequest(http.MethodGet, testServer.URL, nil)
+		require.NoError(t, err)
+
+		resp, err := httpCtx.WithContext(spanCtx).Do(req)
+		require.NoError(t, err)
+		_ = resp.Body.Close()
+
+		carrier := propagation.MapCarrier{"traceparent": traceParent.Load().(string)}
+		propagated := trace.SpanContextFromContext(propagation.TraceContext{}.Extract(context.Background(), carrier))
+		assert.Equal(t, span.SpanContext().TraceID(), propagated.TraceID())
+	})
+}
+
 func Test__HTTPContext__Do__RedirectLimit(t *testing.T) {
 	var hits atomic.Int32
 
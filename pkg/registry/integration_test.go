@@ -10,6 +10,7 @@ import (
 
 	"github.com/superplanehq/superplane/pkg/configuration"
 	"github.com/superplanehq/superplane/pkg/core"
+	"github.com/superplanehq/superplane/pkg/crypto"
 )
 
 // panickingIntegration is an integration that panics in all panicable methods
@@ -65,3 +66,21 @@ func TestPanicableIntegration_HandleRequest_CatchesPanic(t *testing.T) {
 
 	assert.Equal(t, 500, recorder.Code)
 }
+
+func TestRegistry_AddIntegration(t *testing.T) {
+	r, err := NewRegistry(&crypto.NoOpEncryptor{}, HTTPOptions{})
+	require.NoError(t, err)
+
+	t.Run("new integration -> registered and panics are recovered", func(t *testing.T) {
+		require.NoError(t, r.AddIntegration(&panickingIntegration{}))
+
+		integration, err := r.GetIntegration("panicking-integration")
+		require.NoError(t, err)
+		assert.Error(t, integration.Sync(core.SyncContext{Logger: log.NewEntry(log.New())}))
+	})
+
+	t.Run("existing integration -> error", func(t *testing.T) {
+		err := r.AddIntegration(&panickingIntegration{})
+		assert.ErrorContains(t, err, "already registered")
+	})
+}
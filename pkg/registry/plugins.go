@@ -0,0 +1,100 @@
+package registry
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/superplanehq/superplane/pkg/plugins"
+)
+
+var (
+	PluginConnectAttempts      = 10
+	PluginConnectRetryInterval = 3 * time.Second
+	PluginHealthCheckInterval  = 10 * time.Second
+)
+
+//
+// LoadPlugins connects to the plugins at the given addresses,
+// and registers their components and triggers.
+// Plugins that can't be loaded are skipped, so one broken plugin
+// doesn't prevent SuperPlane from starting.
+//
+
+func (r *Registry) LoadPlugins(ctx context.Context, addresses []string) []*plugins.Plugin {
+	loaded := []*plugins.Plugin{}
+	for _, address := range addresses {
+		plugin, err := plugins.ConnectWithRetry(ctx, address, PluginConnectAttempts, PluginConnectRetryInterval)
+		if err != nil {
+			log.Errorf("Skipping plugin %s: %v", address, err)
+			continue
+		}
+
+		err = r.RegisterPlugin(plugin)
+		if err != nil {
+			log.Errorf("Skipping plugin %s: %v", address, err)
+			_ = plugin.Close()
+			continue
+		}
+
+		plugin.StartHealthChecks(ctx, PluginHealthCheckInterval)
+		loaded = append(loaded, plugin)
+
+		log.Infof(
+			"Loaded plugin %s %s from %s: %d components, %d triggers",
+			plugin.Name, plugin.Version, address, len(plugin.Components()), len(plugin.Triggers()),
+		)
+	}
+
+	return loaded
+}
+
+//
+// RegisterPlugin registers all components and triggers of a plugin, or none of them.
+// Plugins can't replace the built-in ones, and can't use dotted names,
+// since those are reserved for integrations.
+//
+
+func (r *Registry) RegisterPlugin(plugin *plugins.Plugin) error {
+	for _, component := range plugin.Components() {
+		err := r.validatePluginName(component.Name(), r.Components[component.Name()] != nil)
+		if err != nil {
+			return fmt.Errorf("component %s: %w", component.Name(), err)
+		}
+	}
+
+	for _, trigger := range plugin.Triggers() {
+		err := r.validatePluginName(trigger.Name(), r.Triggers[trigger.Name()] != nil)
+		if err != nil {
+			return fmt.Errorf("trigger %s: %w", trigger.Name(), err)
+		}
+	}
+
+	for _, component := range plugin.Components() {
+		r.Components[component.Name()] = NewPanicableComponent(component)
+	}
+
+	for _, trigger := range plugin.Triggers() {
+		r.Triggers[trigger.Name()] = NewPanicableTrigger(trigger)
+	}
+
+	return nil
+}
+
+func (r *Registry) validatePluginName(name string, registered bool) error {
+	if name == "" {
+		return fmt.Errorf("name is required")
+	}
+
+	if strings.Contains(name, ".") {
+		return fmt.Errorf("name must not contain dots")
+	}
+
+	if registered {
+		return fmt.Errorf("already registered")
+	}
+
+	return nil
+}
@@ -0,0 +1,68 @@
+package registry
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/superplanehq/superplane/pkg/core"
+	"github.com/superplanehq/superplane/pkg/crypto"
+	"github.com/superplanehq/superplane/pkg/plugins/sdk"
+)
+
+func servePlugin(t *testing.T, plugin sdk.Plugin) string {
+	server, err := sdk.NewServer(plugin)
+	require.NoError(t, err)
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	go func() { _ = server.Serve(listener) }()
+	t.Cleanup(server.Stop)
+
+	return listener.Addr().String()
+}
+
+func TestRegistry_LoadPlugins(t *testing.T) {
+	address := servePlugin(t, sdk.Plugin{
+		Name:     "acme",
+		Version:  "1.0.0",
+		Triggers: []core.Trigger{&panickingTrigger{name: "acmeRelease"}},
+	})
+
+	conflicting := servePlugin(t, sdk.Plugin{
+		Name:     "conflicting",
+		Version:  "1.0.0",
+		Triggers: []core.Trigger{&panickingTrigger{name: "start"}},
+	})
+
+	r, err := NewRegistry(&crypto.NoOpEncryptor{}, HTTPOptions{})
+	require.NoError(t, err)
+	r.Triggers["start"] = NewPanicableTrigger(&panickingTrigger{name: "start"})
+
+	attempts := PluginConnectAttempts
+	PluginConnectAttempts = 1
+	defer func() { PluginConnectAttempts = attempts }()
+
+	loaded := r.LoadPlugins(context.Background(), []string{address, conflicting, "127.0.0.1:1"})
+	for _, plugin := range loaded {
+		defer plugin.Close()
+	}
+
+	require.Len(t, loaded, 1)
+	assert.Equal(t, "acme", loaded[0].Name)
+
+	trigger, err := r.GetTrigger("acmeRelease")
+	require.NoError(t, err)
+	assert.Equal(t, "Panicking Trigger", trigger.Label())
+
+	err = trigger.Setup(core.TriggerContext{})
+	require.ErrorContains(t, err, "setup panic")
+
+	start, err := r.GetTrigger("start")
+	require.NoError(t, err)
+	assert.Equal(t, "start", start.Name())
+}
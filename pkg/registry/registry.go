@@ -268,3 +268,21 @@ func (r *Registry) GetIntegrationComponent(appName, componentName string) (core.
 
 	return nil, fmt.Errorf("component %s not found for integration %s", componentName, appName)
 }
+
+//
+// AddIntegration registers an integration defined outside of Go,
+// like the declarative ones. It can't replace an existing integration.
+//
+
+func (r *Registry) AddIntegration(integration core.Integration) error {
+	if integration.Name() == "" {
+		return fmt.Errorf("integration name is required")
+	}
+
+	if _, ok := r.Integrations[integration.Name()]; ok {
+		return fmt.Errorf("integration %s already registered", integration.Name())
+	}
+
+	r.Integrations[integration.Name()] = NewPanicableIntegration(integration)
+	return nil
+}
@@ -132,3 +132,37 @@ func (s *PanicableTrigger) OnIntegrationMessage(ctx core.IntegrationMessageConte
 
 	return integrationTrigger.OnIntegrationMessage(ctx)
 }
+
+func (s *PanicableTrigger) ValidateInput(configuration any, input map[string]any) (result map[string]any, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			result = nil
+			err = fmt.Errorf("trigger %s panicked in ValidateInput(): %v",
+				s.underlying.Name(), r)
+		}
+	}()
+
+	inputTrigger, ok := s.underlying.(core.InputTrigger)
+	if !ok {
+		return input, nil
+	}
+
+	return inputTrigger.ValidateInput(configuration, input)
+}
+
+func (s *PanicableTrigger) InputFields(config any) (fields []configuration.FormField, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			fields = nil
+			err = fmt.Errorf("trigger %s panicked in InputFields(): %v",
+				s.underlying.Name(), r)
+		}
+	}()
+
+	formTrigger, ok := s.underlying.(core.FormInputTrigger)
+	if !ok {
+		return nil, nil
+	}
+
+	return formTrigger.InputFields(config)
+}
@@ -0,0 +1,148 @@
+package scim
+
+import (
+	"net/http"
+)
+
+//
+// Discovery endpoints (RFC 7644, section 4) let identity providers
+// find out which features and attributes are supported.
+//
+
+func (s *Server) getServiceProviderConfig(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusOK, map[string]any{
+		"schemas":        []string{SchemaServiceProviderConfig},
+		"patch":          map[string]any{"supported": true},
+		"bulk":           map[string]any{"supported": false, "maxOperations": 0, "maxPayloadSize": 0},
+		"filter":         map[string]any{"supported": true, "maxResults": MaxPageSize},
+		"changePassword": map[string]any{"supported": false},
+		"sort":           map[string]any{"supported": false},
+		"etag":           map[string]any{"supported": false},
+		"authenticationSchemes": []map[string]any{
+			{
+				"type":        "oauthbearertoken",
+				"name":        "OAuth Bearer Token",
+				"description": "Authentication with the organization's SCIM token",
+				"primary":     true,
+			},
+		},
+		"meta": map[string]any{
+			"resourceType": "ServiceProviderConfig",
+			"location":     s.baseURL + "/ServiceProviderConfig",
+		},
+	})
+}
+
+func (s *Server) listResourceTypes(w http.ResponseWriter, r *http.Request) {
+	resourceTypes := []any{
+		map[string]any{
+			"schemas":     []string{SchemaResourceType},
+			"id":          "User",
+			"name":        "User",
+			"endpoint":    "/Users",
+			"description": "Organization members",
+			"schema":      SchemaUser,
+			"meta": map[string]any{
+				"resourceType": "ResourceType",
+				"location":     s.baseURL + "/ResourceTypes/User",
+			},
+		},
+		map[string]any{
+			"schemas":     []string{SchemaResourceType},
+			"id":          "Group",
+			"name":        "Group",
+			"endpoint":    "/Groups",
+			"description": "Organization groups",
+			"schema":      SchemaGroup,
+			"meta": map[string]any{
+				"resourceType": "ResourceType",
+				"location":     s.baseURL + "/ResourceTypes/Group",
+			},
+		},
+	}
+
+	writeJSON(w, http.StatusOK, &ListResponse{
+		Schemas:      []string{SchemaListResponse},
+		TotalResults: len(resourceTypes),
+		StartIndex:   1,
+		ItemsPerPage: len(resourceTypes),
+		Resources:    resourceTypes,
+	})
+}
+
+func (s *Server) listSchemas(w http.ResponseWriter, r *http.Request) {
+	schemas := []any{
+		schemaDefinition(s.baseURL, SchemaUser, "User", []map[string]any{
+			attributeDefinition("userName", "string", true, "immutable"),
+			attributeDefinition("displayName", "string", false, "readWrite"),
+			complexAttributeDefinition("name", false, []map[string]any{
+				attributeDefinition("formatted", "string", false, "readWrite"),
+				attributeDefinition("givenName", "string", false, "readWrite"),
+				attributeDefinition("familyName", "string", false, "readWrite"),
+			}),
+			complexAttributeDefinition("emails", true, []map[string]any{
+				attributeDefinition("value", "string", false, "readWrite"),
+				attributeDefinition("type", "string", false, "readWrite"),
+				attributeDefinition("primary", "boolean", false, "readWrite"),
+			}),
+			attributeDefinition("active", "boolean", false, "readWrite"),
+			complexAttributeDefinition("groups", true, []map[string]any{
+				attributeDefinition("value", "string", false, "readOnly"),
+				attributeDefinition("display", "string", false, "readOnly"),
+			}),
+		}),
+		schemaDefinition(s.baseURL, SchemaGroup, "Group", []map[string]any{
+			attributeDefinition("displayName", "string", true, "readWrite"),
+			complexAttributeDefinition("members", true, []map[string]any{
+				attributeDefinition("value", "string", false, "immutable"),
+				attributeDefinition("display", "string", false, "readOnly"),
+			}),
+		}),
+	}
+
+	writeJSON(w, http.StatusOK, &ListResponse{
+		Schemas:      []string{SchemaListResponse},
+		TotalResults: len(schemas),
+		StartIndex:   1,
+		ItemsPerPage: len(schemas),
+		Resources:    schemas,
+	})
+}
+
+func schemaDefinition(baseURL, id, name string, attributes []map[string]any) map[string]any {
+	return map[string]any{
+		"schemas":    []string{SchemaSchema},
+		"id":         id,
+		"name":       name,
+		"attributes": attributes,
+		"meta": map[string]any{
+			"resourceType": "Schema",
+			"location":     baseURL + "/Schemas/" + id,
+		},
+	}
+}
+
+func attributeDefinition(name, attributeType string, required bool, mutability string) map[string]any {
+	return map[string]any{
+		"name":        name,
+		"type":        attributeType,
+		"multiValued": false,
+		"required":    required,
+		"caseExact":   false,
+		"mutability":  mutability,
+		"returned":    "default",
+		"uniqueness":  "none",
+	}
+}
+
+func complexAttributeDefinition(name string, multiValued bool, subAttributes []map[string]any) map[string]any {
+	return map[string]any{
+		"name":          name,
+		"type":          "complex",
+		"multiValued":   multiValued,
+		"required":      false,
+		"mutability":    "readWrite",
+		"returned":      "default",
+		"subAttributes": subAttributes,
+	}
+}
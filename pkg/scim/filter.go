@@ -0,0 +1,509 @@
+package scim
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+//
+// Filter is a parsed SCIM filter expression (RFC 7644, section 3.4.2.2).
+// Filters are evaluated against the JSON representation of a resource,
+// so the same implementation serves users, groups and PATCH value paths.
+//
+
+type Filter interface {
+	Matches(resource map[string]any) bool
+}
+
+type logicalFilter struct {
+	and   bool
+	left  Filter
+	right Filter
+}
+
+func (f *logicalFilter) Matches(resource map[string]any) bool {
+	if f.and {
+		return f.left.Matches(resource) && f.right.Matches(resource)
+	}
+
+	return f.left.Matches(resource) || f.right.Matches(resource)
+}
+
+type notFilter struct {
+	inner Filter
+}
+
+func (f *notFilter) Matches(resource map[string]any) bool {
+	return !f.inner.Matches(resource)
+}
+
+type attributeFilter struct {
+	path     []string
+	operator string
+	value    any
+}
+
+func (f *attributeFilter) Matches(resource map[string]any) bool {
+	values := lookupAttribute(resource, f.path)
+
+	switch f.operator {
+	case "pr":
+		for _, value := range values {
+			if isPresent(value) {
+				return true
+			}
+		}
+
+		return false
+
+	case "ne":
+		for _, value := range values {
+			if compareValues("eq", value, f.value) {
+				return false
+			}
+		}
+
+		return true
+
+	default:
+		for _, value := range values {
+			if compareValues(f.operator, value, f.value) {
+				return true
+			}
+		}
+
+		return false
+	}
+}
+
+//
+// valuePathFilter matches multi-valued attributes,
+// like emails[type eq "work" and value co "@example.com"].
+//
+
+type valuePathFilter struct {
+	path   []string
+	filter Filter
+}
+
+func (f *valuePathFilter) Matches(resource map[string]any) bool {
+	for _, value := range lookupAttribute(resource, f.path) {
+		element, ok := value.(map[string]any)
+		if ok && f.filter.Matches(element) {
+			return true
+		}
+	}
+
+	return false
+}
+
+var filterOperators = map[string]bool{
+	"eq": true,
+	"ne": true,
+	"co": true,
+	"sw": true,
+	"ew": true,
+	"gt": true,
+	"ge": true,
+	"lt": true,
+	"le": true,
+}
+
+func ParseFilter(expression string) (Filter, error) {
+	tokens, err := tokenizeFilter(expression)
+	if err != nil {
+		return nil, err
+	}
+
+	parser := &filterParser{tokens: tokens}
+	filter, err := parser.parseOr()
+	if err != nil {
+		return nil, err
+	}
+
+	if !parser.done() {
+		return nil, fmt.Errorf("unexpected %q in filter", parser.peek().text)
+	}
+
+	return filter, nil
+}
+
+type filterToken struct {
+	text   string
+	quoted bool
+}
+
+func tokenizeFilter(expression string) ([]filterToken, error) {
+	tokens := []filterToken{}
+
+	for i := 0; i < len(expression); {
+		c := expression[i]
+
+		switch {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r':
+			i++
+
+		case c == '(' || c == ')' || c == '[' || c == ']':
+			tokens = append(tokens, filterToken{text: string(c)})
+			i++
+
+		case c == '"':
+			end := i + 1
+			for end < len(expression) && expression[end] != '"' {
+				if expression[end] == '\\' {
+					end++
+				}
+
+				end++
+			}
+
+			if end >= len(expression) {
+				return nil, fmt.Errorf("unterminated string in filter")
+			}
+
+			var value string
+			if err := json.Unmarshal([]byte(expression[i:end+1]), &value); err != nil {
+				return nil, fmt.Errorf("invalid string in filter: %v", err)
+			}
+
+			tokens = append(tokens, filterToken{text: value, quoted: true})
+			i = end + 1
+
+		default:
+			end := i
+			for end < len(expression) && !strings.ContainsRune(" \t\n\r()[]\"", rune(expression[end])) {
+				end++
+			}
+
+			tokens = append(tokens, filterToken{text: expression[i:end]})
+			i = end
+		}
+	}
+
+	return tokens, nil
+}
+
+type filterParser struct {
+	tokens   []filterToken
+	position int
+}
+
+func (p *filterParser) done() bool {
+	return p.position >= len(p.tokens)
+}
+
+func (p *filterParser) peek() filterToken {
+	if p.done() {
+		return filterToken{}
+	}
+
+	return p.tokens[p.position]
+}
+
+func (p *filterParser) next() filterToken {
+	token := p.peek()
+	p.position++
+	return token
+}
+
+func (p *filterParser) peekKeyword(keyword string) bool {
+	token := p.peek()
+	return !token.quoted && strings.EqualFold(token.text, keyword)
+}
+
+func (p *filterParser) expect(text string) error {
+	if p.done() || p.peek().quoted || p.peek().text != text {
+		return fmt.Errorf("expected %q in filter", text)
+	}
+
+	p.position++
+	return nil
+}
+
+func (p *filterParser) parseOr() (Filter, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+
+	for p.peekKeyword("or") {
+		p.next()
+
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+
+		left = &logicalFilter{left: left, right: right}
+	}
+
+	return left, nil
+}
+
+func (p *filterParser) parseAnd() (Filter, error) {
+	left, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+
+	for p.peekKeyword("and") {
+		p.next()
+
+		right, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+
+		left = &logicalFilter{and: true, left: left, right: right}
+	}
+
+	return left, nil
+}
+
+func (p *filterParser) parseUnary() (Filter, error) {
+	if p.peekKeyword("not") {
+		p.next()
+		if err := p.expect("("); err != nil {
+			return nil, err
+		}
+
+		inner, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+
+		if err := p.expect(")"); err != nil {
+			return nil, err
+		}
+
+		return &notFilter{inner: inner}, nil
+	}
+
+	if !p.peek().quoted && p.peek().text == "(" {
+		p.next()
+
+		inner, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+
+		if err := p.expect(")"); err != nil {
+			return nil, err
+		}
+
+		return inner, nil
+	}
+
+	return p.parseAttributeExpression()
+}
+
+func (p *filterParser) parseAttributeExpression() (Filter, error) {
+	if p.done() || p.peek().quoted {
+		return nil, fmt.Errorf("expected attribute in filter")
+	}
+
+	path := parseAttributePath(p.next().text)
+
+	if !p.peek().quoted && p.peek().text == "[" {
+		p.next()
+
+		inner, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+
+		if err := p.expect("]"); err != nil {
+			return nil, err
+		}
+
+		return &valuePathFilter{path: path, filter: inner}, nil
+	}
+
+	if p.done() || p.peek().quoted {
+		return nil, fmt.Errorf("expected operator in filter")
+	}
+
+	operator := strings.ToLower(p.next().text)
+	if operator == "pr" {
+		return &attributeFilter{path: path, operator: operator}, nil
+	}
+
+	if !filterOperators[operator] {
+		return nil, fmt.Errorf("unsupported filter operator %q", operator)
+	}
+
+	if p.done() {
+		return nil, fmt.Errorf("expected value in filter")
+	}
+
+	value, err := parseFilterValue(p.next())
+	if err != nil {
+		return nil, err
+	}
+
+	return &attributeFilter{path: path, operator: operator, value: value}, nil
+}
+
+func parseFilterValue(token filterToken) (any, error) {
+	if token.quoted {
+		return token.text, nil
+	}
+
+	switch strings.ToLower(token.text) {
+	case "true":
+		return true, nil
+	case "false":
+		return false, nil
+	case "null":
+		return nil, nil
+	}
+
+	number, err := strconv.ParseFloat(token.text, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid value %q in filter", token.text)
+	}
+
+	return number, nil
+}
+
+//
+// Attribute paths may be fully qualified with the schema URN,
+// like urn:ietf:params:scim:schemas:core:2.0:User:name.familyName.
+// Attribute names never contain colons, so everything up to the last one
+// is the schema.
+//
+
+func parseAttributePath(attribute string) []string {
+	if strings.HasPrefix(strings.ToLower(attribute), "urn:") {
+		attribute = attribute[strings.LastIndex(attribute, ":")+1:]
+	}
+
+	return strings.Split(attribute, ".")
+}
+
+func lookupAttribute(resource map[string]any, path []string) []any {
+	values := []any{resource}
+
+	for _, name := range path {
+		next := []any{}
+		for _, value := range values {
+			object, ok := value.(map[string]any)
+			if !ok {
+				continue
+			}
+
+			key, ok := findKey(object, name)
+			if !ok {
+				continue
+			}
+
+			if items, ok := object[key].([]any); ok {
+				next = append(next, items...)
+				continue
+			}
+
+			next = append(next, object[key])
+		}
+
+		values = next
+	}
+
+	return values
+}
+
+//
+// Attribute names are case insensitive in SCIM.
+//
+
+func findKey(object map[string]any, name string) (string, bool) {
+	if _, ok := object[name]; ok {
+		return name, true
+	}
+
+	for key := range object {
+		if strings.EqualFold(key, name) {
+			return key, true
+		}
+	}
+
+	return name, false
+}
+
+func isPresent(value any) bool {
+	switch v := value.(type) {
+	case nil:
+		return false
+	case string:
+		return v != ""
+	case []any:
+		return len(v) > 0
+	case map[string]any:
+		return len(v) > 0
+	default:
+		return true
+	}
+}
+
+func compareValues(operator string, actual, expected any) bool {
+	switch e := expected.(type) {
+	case nil:
+		return operator == "eq" && actual == nil
+
+	case bool:
+		a, ok := actual.(bool)
+		return ok && operator == "eq" && a == e
+
+	case float64:
+		a, ok := actual.(float64)
+		if !ok {
+			return false
+		}
+
+		switch operator {
+		case "eq":
+			return a == e
+		case "gt":
+			return a > e
+		case "ge":
+			return a >= e
+		case "lt":
+			return a < e
+		case "le":
+			return a <= e
+		}
+
+		return false
+
+	case string:
+		a, ok := actual.(string)
+		if !ok {
+			return false
+		}
+
+		a = strings.ToLower(a)
+		e = strings.ToLower(e)
+
+		switch operator {
+		case "eq":
+			return a == e
+		case "co":
+			return strings.Contains(a, e)
+		case "sw":
+			return strings.HasPrefix(a, e)
+		case "ew":
+			return strings.HasSuffix(a, e)
+		case "gt":
+			return a > e
+		case "ge":
+			return a >= e
+		case "lt":
+			return a < e
+		case "le":
+			return a <= e
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,71 @@
+package scim
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test__ParseFilter(t *testing.T) {
+	user := map[string]any{
+		"userName":    "Jane@Example.com",
+		"displayName": "Jane Doe",
+		"active":      true,
+		"name":        map[string]any{"givenName": "Jane", "familyName": "Doe"},
+		"emails": []any{
+			map[string]any{"value": "jane@example.com", "type": "work", "primary": true},
+			map[string]any{"value": "jane@home.com", "type": "home"},
+		},
+		"meta": map[string]any{"lastModified": "2026-10-01T10:00:00Z"},
+	}
+
+	testCases := []struct {
+		filter  string
+		matches bool
+	}{
+		{`userName eq "jane@example.com"`, true},
+		{`userName eq "john@example.com"`, false},
+		{`USERNAME Eq "JANE@EXAMPLE.COM"`, true},
+		{`urn:ietf:params:scim:schemas:core:2.0:User:userName eq "jane@example.com"`, true},
+		{`userName ne "john@example.com"`, true},
+		{`displayName co "doe"`, true},
+		{`displayName sw "Jane"`, true},
+		{`displayName ew "Smith"`, false},
+		{`name.familyName eq "Doe"`, true},
+		{`emails.value eq "jane@home.com"`, true},
+		{`emails[type eq "work" and value co "example.com"]`, true},
+		{`emails[type eq "work" and value co "home.com"]`, false},
+		{`active eq true`, true},
+		{`active eq false`, false},
+		{`title pr`, false},
+		{`displayName pr`, true},
+		{`meta.lastModified gt "2026-09-01T00:00:00Z"`, true},
+		{`userName eq "john@example.com" or displayName eq "Jane Doe"`, true},
+		{`userName eq "jane@example.com" and not (active eq true)`, false},
+		{`(userName eq "john@example.com" or active eq true) and displayName pr`, true},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.filter, func(t *testing.T) {
+			filter, err := ParseFilter(testCase.filter)
+			require.NoError(t, err)
+			assert.Equal(t, testCase.matches, filter.Matches(user))
+		})
+	}
+
+	t.Run("invalid filters -> error", func(t *testing.T) {
+		for _, expression := range []string{
+			`userName`,
+			`userName xx "jane"`,
+			`userName eq`,
+			`userName eq "jane`,
+			`(userName eq "jane"`,
+			`emails[type eq "work"`,
+			`userName eq "jane" extra`,
+		} {
+			_, err := ParseFilter(expression)
+			assert.Error(t, err, expression)
+		}
+	})
+}
@@ -0,0 +1,434 @@
+package scim
+
+import (
+	"errors"
+	"net/http"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/superplanehq/superplane/pkg/models"
+	"gorm.io/gorm"
+)
+
+type Group struct {
+	Schemas     []string    `json:"schemas"`
+	ID          string      `json:"id,omitempty"`
+	DisplayName string      `json:"displayName"`
+	Members     []MemberRef `json:"members"`
+	Meta        *Meta       `json:"meta,omitempty"`
+}
+
+//
+// group is an organization group, with everything needed
+// to serialize it and the memberships of its users.
+//
+
+type group struct {
+	name        string
+	displayName string
+	description string
+	members     []string
+	createdAt   time.Time
+	updatedAt   time.Time
+}
+
+var groupNameInvalidCharacters = regexp.MustCompile(`[^a-z0-9]+`)
+
+func (s *Server) loadGroups(orgID string) ([]group, error) {
+	names, err := s.authService.GetGroups(orgID, models.DomainTypeOrganization)
+	if err != nil {
+		return nil, err
+	}
+
+	slices.Sort(names)
+
+	groups := make([]group, 0, len(names))
+	for _, name := range names {
+		g, err := s.loadGroup(orgID, name)
+		if err != nil {
+			return nil, err
+		}
+
+		groups = append(groups, *g)
+	}
+
+	return groups, nil
+}
+
+func (s *Server) loadGroup(orgID, name string) (*group, error) {
+	members, err := s.authService.GetGroupUsers(orgID, models.DomainTypeOrganization, name)
+	if err != nil {
+		return nil, err
+	}
+
+	g := &group{name: name, displayName: name, members: members}
+
+	metadata, err := models.FindGroupMetadata(name, models.DomainTypeOrganization, orgID)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, err
+	}
+
+	if metadata != nil {
+		g.displayName = metadata.DisplayName
+		g.description = metadata.Description
+		g.createdAt = metadata.CreatedAt
+		g.updatedAt = metadata.UpdatedAt
+	}
+
+	return g, nil
+}
+
+func (s *Server) findGroup(orgID, name string) (*group, error) {
+	names, err := s.authService.GetGroups(orgID, models.DomainTypeOrganization)
+	if err != nil {
+		return nil, err
+	}
+
+	if !slices.Contains(names, name) {
+		return nil, newError(http.StatusNotFound, "", "Group "+name+" not found")
+	}
+
+	return s.loadGroup(orgID, name)
+}
+
+func (s *Server) listGroups(w http.ResponseWriter, r *http.Request) {
+	orgID := organizationID(r)
+
+	groups, err := s.loadGroups(orgID)
+	if err != nil {
+		writeOperationError(w, err)
+		return
+	}
+
+	users, err := models.FindMaybeDeletedHumanUsersByOrganization(orgID)
+	if err != nil {
+		writeOperationError(w, err)
+		return
+	}
+
+	resources := make([]any, 0, len(groups))
+	for _, g := range groups {
+		resources = append(resources, s.serializeGroup(&g, users))
+	}
+
+	response, err := listResources(r, resources)
+	if err != nil {
+		writeOperationError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, response)
+}
+
+func (s *Server) getGroup(w http.ResponseWriter, r *http.Request) {
+	orgID := organizationID(r)
+
+	g, err := s.findGroup(orgID, mux.Vars(r)["id"])
+	if err != nil {
+		writeOperationError(w, err)
+		return
+	}
+
+	s.writeGroup(w, http.StatusOK, orgID, g)
+}
+
+//
+// Groups created through SCIM get a name derived from their display name,
+// and the role the SSO group mappings give to the identity provider group
+// with the same name, or the viewer role.
+//
+
+func (s *Server) createGroup(w http.ResponseWriter, r *http.Request) {
+	orgID := organizationID(r)
+
+	var resource Group
+	if err := readJSON(r, &resource); err != nil {
+		writeOperationError(w, err)
+		return
+	}
+
+	displayName := strings.TrimSpace(resource.DisplayName)
+	if displayName == "" {
+		writeError(w, http.StatusBadRequest, "invalidValue", "displayName is required")
+		return
+	}
+
+	name := groupNameFor(displayName)
+
+	names, err := s.authService.GetGroups(orgID, models.DomainTypeOrganization)
+	if err != nil {
+		writeOperationError(w, err)
+		return
+	}
+
+	if slices.Contains(names, name) {
+		writeError(w, http.StatusConflict, "uniqueness", "Group "+displayName+" already exists")
+		return
+	}
+
+	members, err := memberIDs(orgID, resource.Members)
+	if err != nil {
+		writeOperationError(w, err)
+		return
+	}
+
+	err = s.authService.CreateGroup(orgID, models.DomainTypeOrganization, name, s.groupRole(orgID, displayName), displayName, "")
+	if err != nil {
+		writeOperationError(w, err)
+		return
+	}
+
+	g := &group{name: name, displayName: displayName}
+	if err := s.syncMembers(orgID, g, members); err != nil {
+		writeOperationError(w, err)
+		return
+	}
+
+	w.Header().Set("Location", s.baseURL+"/Groups/"+name)
+	s.writeGroup(w, http.StatusCreated, orgID, g)
+}
+
+func (s *Server) replaceGroup(w http.ResponseWriter, r *http.Request) {
+	orgID := organizationID(r)
+
+	g, err := s.findGroup(orgID, mux.Vars(r)["id"])
+	if err != nil {
+		writeOperationError(w, err)
+		return
+	}
+
+	var resource Group
+	if err := readJSON(r, &resource); err != nil {
+		writeOperationError(w, err)
+		return
+	}
+
+	if err := s.updateGroup(orgID, g, &resource); err != nil {
+		writeOperationError(w, err)
+		return
+	}
+
+	s.writeGroup(w, http.StatusOK, orgID, g)
+}
+
+func (s *Server) patchGroup(w http.ResponseWriter, r *http.Request) {
+	orgID := organizationID(r)
+
+	g, err := s.findGroup(orgID, mux.Vars(r)["id"])
+	if err != nil {
+		writeOperationError(w, err)
+		return
+	}
+
+	var patch PatchRequest
+	if err := readJSON(r, &patch); err != nil {
+		writeOperationError(w, err)
+		return
+	}
+
+	users, err := models.FindMaybeDeletedHumanUsersByOrganization(orgID)
+	if err != nil {
+		writeOperationError(w, err)
+		return
+	}
+
+	document, err := toDocument(s.serializeGroup(g, users))
+	if err != nil {
+		writeOperationError(w, err)
+		return
+	}
+
+	if err := ApplyPatch(document, patch.Operations); err != nil {
+		writeError(w, http.StatusBadRequest, "invalidPath", err.Error())
+		return
+	}
+
+	var resource Group
+	if err := fromDocument(document, &resource); err != nil {
+		writeOperationError(w, err)
+		return
+	}
+
+	if err := s.updateGroup(orgID, g, &resource); err != nil {
+		writeOperationError(w, err)
+		return
+	}
+
+	s.writeGroup(w, http.StatusOK, orgID, g)
+}
+
+func (s *Server) deleteGroup(w http.ResponseWriter, r *http.Request) {
+	orgID := organizationID(r)
+
+	g, err := s.findGroup(orgID, mux.Vars(r)["id"])
+	if err != nil {
+		writeOperationError(w, err)
+		return
+	}
+
+	if err := s.authService.DeleteGroup(orgID, models.DomainTypeOrganization, g.name); err != nil {
+		writeOperationError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) writeGroup(w http.ResponseWriter, status int, orgID string, g *group) {
+	g, err := s.loadGroup(orgID, g.name)
+	if err != nil {
+		writeOperationError(w, err)
+		return
+	}
+
+	users, err := models.FindMaybeDeletedHumanUsersByOrganization(orgID)
+	if err != nil {
+		writeOperationError(w, err)
+		return
+	}
+
+	writeJSON(w, status, s.serializeGroup(g, users))
+}
+
+func (s *Server) serializeGroup(g *group, users []models.User) *Group {
+	names := make(map[string]string, len(users))
+	for _, user := range users {
+		names[user.ID.String()] = user.Name
+	}
+
+	members := []MemberRef{}
+	for _, member := range g.members {
+		members = append(members, MemberRef{
+			Value:   member,
+			Display: names[member],
+			Ref:     s.baseURL + "/Users/" + member,
+		})
+	}
+
+	meta := &Meta{
+		ResourceType: "Group",
+		Location:     s.baseURL + "/Groups/" + g.name,
+	}
+
+	if !g.createdAt.IsZero() {
+		meta.Created = g.createdAt.UTC().Format(time.RFC3339)
+		meta.LastModified = g.updatedAt.UTC().Format(time.RFC3339)
+	}
+
+	return &Group{
+		Schemas:     []string{SchemaGroup},
+		ID:          g.name,
+		DisplayName: g.displayName,
+		Members:     members,
+		Meta:        meta,
+	}
+}
+
+func (s *Server) updateGroup(orgID string, g *group, resource *Group) error {
+	members, err := memberIDs(orgID, resource.Members)
+	if err != nil {
+		return err
+	}
+
+	displayName := strings.TrimSpace(resource.DisplayName)
+	if displayName != "" && displayName != g.displayName {
+		role, err := s.authService.GetGroupRole(orgID, models.DomainTypeOrganization, g.name)
+		if err != nil {
+			return err
+		}
+
+		err = s.authService.UpdateGroup(orgID, models.DomainTypeOrganization, g.name, role, displayName, g.description)
+		if err != nil {
+			return err
+		}
+
+		g.displayName = displayName
+	}
+
+	return s.syncMembers(orgID, g, members)
+}
+
+//
+// syncMembers makes the group members match the ones pushed
+// by the identity provider. Members get the group's role through
+// the group itself, so there are no role assignments to update here.
+//
+
+func (s *Server) syncMembers(orgID string, g *group, members []string) error {
+	for _, member := range members {
+		if !slices.Contains(g.members, member) {
+			if err := s.authService.AddUserToGroup(orgID, models.DomainTypeOrganization, member, g.name); err != nil {
+				return err
+			}
+		}
+	}
+
+	for _, member := range g.members {
+		if !slices.Contains(members, member) {
+			if err := s.authService.RemoveUserFromGroup(orgID, models.DomainTypeOrganization, member, g.name); err != nil {
+				return err
+			}
+		}
+	}
+
+	g.members = members
+	return nil
+}
+
+//
+// Only active organization users can be group members,
+// so identity providers have to push users before their groups.
+//
+
+func memberIDs(orgID string, members []MemberRef) ([]string, error) {
+	ids := []string{}
+	for _, member := range members {
+		if slices.Contains(ids, member.Value) {
+			continue
+		}
+
+		ids = append(ids, member.Value)
+	}
+
+	if len(ids) == 0 {
+		return ids, nil
+	}
+
+	users, err := models.ListActiveUsersByID(orgID, ids)
+	if err != nil {
+		return nil, newError(http.StatusBadRequest, "invalidValue", "Invalid group members")
+	}
+
+	if len(users) != len(ids) {
+		return nil, newError(http.StatusBadRequest, "invalidValue", "Group members must be active organization users")
+	}
+
+	return ids, nil
+}
+
+func (s *Server) groupRole(orgID, displayName string) string {
+	config, err := models.FindOrganizationSSOConfig(orgID)
+	if err != nil {
+		return models.RoleOrgViewer
+	}
+
+	for _, mapping := range config.GroupMappings {
+		if strings.EqualFold(mapping.IdPGroup, displayName) && mapping.Role != "" && mapping.Role != models.RoleOrgOwner {
+			return mapping.Role
+		}
+	}
+
+	return models.RoleOrgViewer
+}
+
+func groupNameFor(displayName string) string {
+	name := strings.Trim(groupNameInvalidCharacters.ReplaceAllString(strings.ToLower(displayName), "-"), "-")
+	if name == "" {
+		return "group"
+	}
+
+	return name
+}
@@ -0,0 +1,288 @@
+package scim
+
+import (
+	"fmt"
+	"strings"
+)
+
+type PatchRequest struct {
+	Schemas    []string         `json:"schemas"`
+	Operations []PatchOperation `json:"Operations"`
+}
+
+type PatchOperation struct {
+	Op    string `json:"op"`
+	Path  string `json:"path,omitempty"`
+	Value any    `json:"value,omitempty"`
+}
+
+//
+// ApplyPatch applies PATCH operations (RFC 7644, section 3.5.2)
+// to the JSON representation of a resource. The handlers then treat
+// the patched document as a full replacement, so PATCH and PUT
+// share the same validation and side effects.
+//
+
+func ApplyPatch(resource map[string]any, operations []PatchOperation) error {
+	for _, operation := range operations {
+		op := strings.ToLower(operation.Op)
+		if op != "add" && op != "replace" && op != "remove" {
+			return fmt.Errorf("unsupported patch operation %q", operation.Op)
+		}
+
+		if operation.Path != "" {
+			if err := applyPatchPath(resource, op, operation.Path, operation.Value); err != nil {
+				return err
+			}
+
+			continue
+		}
+
+		if op == "remove" {
+			return fmt.Errorf("remove operations require a path")
+		}
+
+		//
+		// Without a path, the value holds the attributes to update.
+		// Some identity providers use attribute paths as keys here,
+		// like "name.givenName", so each key is handled as a path.
+		//
+		values, ok := operation.Value.(map[string]any)
+		if !ok {
+			return fmt.Errorf("patch operations without a path require an object value")
+		}
+
+		for path, value := range values {
+			if err := applyPatchPath(resource, op, path, value); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func applyPatchPath(resource map[string]any, op, path string, value any) error {
+	attribute, filter, subAttribute, err := parsePatchPath(path)
+	if err != nil {
+		return err
+	}
+
+	target := resource
+	for _, name := range attribute[:len(attribute)-1] {
+		key, _ := findKey(target, name)
+
+		child, ok := target[key].(map[string]any)
+		if !ok {
+			if op == "remove" {
+				return nil
+			}
+
+			child = map[string]any{}
+			target[key] = child
+		}
+
+		target = child
+	}
+
+	key, exists := findKey(target, attribute[len(attribute)-1])
+	if filter == nil {
+		applyToAttribute(target, key, exists, op, value)
+		return nil
+	}
+
+	items, _ := target[key].([]any)
+	target[key] = applyToMatchingItems(items, filter, subAttribute, op, value)
+	return nil
+}
+
+func applyToAttribute(target map[string]any, key string, exists bool, op string, value any) {
+	switch op {
+	case "remove":
+		//
+		// Removing specific items from a multi-valued attribute,
+		// like members, can be done by listing them in the value.
+		//
+		items, isList := target[key].([]any)
+		toRemove, hasValue := value.([]any)
+		if !isList || !hasValue {
+			delete(target, key)
+			return
+		}
+
+		target[key] = removeItems(items, toRemove)
+
+	case "add":
+		items, isList := target[key].([]any)
+		if exists && isList {
+			if values, ok := value.([]any); ok {
+				target[key] = append(items, values...)
+			} else {
+				target[key] = append(items, value)
+			}
+
+			return
+		}
+
+		if object, ok := value.(map[string]any); ok {
+			if existing, ok := target[key].(map[string]any); ok {
+				mergeObject(existing, object)
+				return
+			}
+		}
+
+		target[key] = value
+
+	case "replace":
+		if object, ok := value.(map[string]any); ok {
+			if existing, ok := target[key].(map[string]any); ok {
+				mergeObject(existing, object)
+				return
+			}
+		}
+
+		target[key] = value
+	}
+}
+
+func applyToMatchingItems(items []any, filter Filter, subAttribute, op string, value any) []any {
+	result := []any{}
+	matched := false
+
+	for _, item := range items {
+		object, ok := item.(map[string]any)
+		if !ok || !filter.Matches(object) {
+			result = append(result, item)
+			continue
+		}
+
+		matched = true
+
+		switch {
+		case op == "remove" && subAttribute == "":
+			continue
+
+		case op == "remove":
+			key, _ := findKey(object, subAttribute)
+			delete(object, key)
+
+		case subAttribute != "":
+			key, _ := findKey(object, subAttribute)
+			object[key] = value
+
+		default:
+			if update, ok := value.(map[string]any); ok {
+				mergeObject(object, update)
+			}
+		}
+
+		result = append(result, object)
+	}
+
+	//
+	// Setting emails[type eq "work"].value when there is no work email
+	// yet adds one, which is what identity providers expect.
+	//
+	if !matched && op != "remove" {
+		if item := newItemFromFilter(filter, subAttribute, value); item != nil {
+			result = append(result, item)
+		}
+	}
+
+	return result
+}
+
+func newItemFromFilter(filter Filter, subAttribute string, value any) map[string]any {
+	equality, ok := filter.(*attributeFilter)
+	if !ok || equality.operator != "eq" || len(equality.path) != 1 {
+		return nil
+	}
+
+	item := map[string]any{equality.path[0]: equality.value}
+	if subAttribute != "" {
+		item[subAttribute] = value
+		return item
+	}
+
+	object, ok := value.(map[string]any)
+	if !ok {
+		return nil
+	}
+
+	mergeObject(item, object)
+	return item
+}
+
+func removeItems(items []any, toRemove []any) []any {
+	result := []any{}
+
+	for _, item := range items {
+		if !containsItem(toRemove, item) {
+			result = append(result, item)
+		}
+	}
+
+	return result
+}
+
+//
+// Items of multi-valued attributes are identified by their value,
+// which is how members are referenced in PATCH requests.
+//
+
+func containsItem(items []any, item any) bool {
+	value := itemValue(item)
+
+	for _, candidate := range items {
+		if value != "" && strings.EqualFold(itemValue(candidate), value) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func itemValue(item any) string {
+	object, ok := item.(map[string]any)
+	if !ok {
+		value, _ := item.(string)
+		return value
+	}
+
+	key, _ := findKey(object, "value")
+	value, _ := object[key].(string)
+	return value
+}
+
+func mergeObject(target, values map[string]any) {
+	for name, value := range values {
+		key, _ := findKey(target, name)
+		target[key] = value
+	}
+}
+
+//
+// parsePatchPath splits a PATCH path, like members[value eq "id"]
+// or emails[type eq "work"].value, into the attribute path,
+// the optional value filter and the optional sub-attribute.
+//
+
+func parsePatchPath(path string) ([]string, Filter, string, error) {
+	open := strings.Index(path, "[")
+	if open < 0 {
+		return parseAttributePath(path), nil, "", nil
+	}
+
+	closing := strings.LastIndex(path, "]")
+	if closing < open {
+		return nil, nil, "", fmt.Errorf("invalid path %q", path)
+	}
+
+	filter, err := ParseFilter(path[open+1 : closing])
+	if err != nil {
+		return nil, nil, "", fmt.Errorf("invalid path %q: %v", path, err)
+	}
+
+	subAttribute := strings.TrimPrefix(path[closing+1:], ".")
+	return parseAttributePath(path[:open]), filter, subAttribute, nil
+}
@@ -0,0 +1,100 @@
+package scim
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test__ApplyPatch(t *testing.T) {
+	document := func() map[string]any {
+		return map[string]any{
+			"displayName": "Jane Doe",
+			"active":      true,
+			"name":        map[string]any{"givenName": "Jane", "familyName": "Doe"},
+			"emails": []any{
+				map[string]any{"value": "jane@example.com", "type": "work"},
+			},
+			"members": []any{
+				map[string]any{"value": "u1"},
+				map[string]any{"value": "u2"},
+			},
+		}
+	}
+
+	operations := func(t *testing.T, raw string) []PatchOperation {
+		var request PatchRequest
+		require.NoError(t, json.Unmarshal([]byte(raw), &request))
+		return request.Operations
+	}
+
+	t.Run("replace with path", func(t *testing.T) {
+		d := document()
+		require.NoError(t, ApplyPatch(d, operations(t, `{"Operations":[{"op":"Replace","path":"active","value":"False"}]}`)))
+		assert.Equal(t, "False", d["active"])
+
+		var user User
+		require.NoError(t, fromDocument(d, &user))
+		assert.False(t, user.isActive())
+	})
+
+	t.Run("replace without path uses keys as paths", func(t *testing.T) {
+		d := document()
+		require.NoError(t, ApplyPatch(d, operations(t, `{"Operations":[{"op":"replace","value":{"displayName":"Jane Smith","name.familyName":"Smith"}}]}`)))
+		assert.Equal(t, "Jane Smith", d["displayName"])
+		assert.Equal(t, "Smith", d["name"].(map[string]any)["familyName"])
+		assert.Equal(t, "Jane", d["name"].(map[string]any)["givenName"])
+	})
+
+	t.Run("add appends to multi-valued attributes", func(t *testing.T) {
+		d := document()
+		require.NoError(t, ApplyPatch(d, operations(t, `{"Operations":[{"op":"add","path":"members","value":[{"value":"u3"}]}]}`)))
+		assert.Len(t, d["members"], 3)
+	})
+
+	t.Run("remove with value filter", func(t *testing.T) {
+		d := document()
+		require.NoError(t, ApplyPatch(d, operations(t, `{"Operations":[{"op":"remove","path":"members[value eq \"u1\"]"}]}`)))
+		assert.Equal(t, []any{map[string]any{"value": "u2"}}, d["members"])
+	})
+
+	t.Run("remove with listed values", func(t *testing.T) {
+		d := document()
+		require.NoError(t, ApplyPatch(d, operations(t, `{"Operations":[{"op":"remove","path":"members","value":[{"value":"u2"}]}]}`)))
+		assert.Equal(t, []any{map[string]any{"value": "u1"}}, d["members"])
+	})
+
+	t.Run("remove whole attribute", func(t *testing.T) {
+		d := document()
+		require.NoError(t, ApplyPatch(d, operations(t, `{"Operations":[{"op":"remove","path":"members"}]}`)))
+		assert.NotContains(t, d, "members")
+	})
+
+	t.Run("replace sub-attribute of matching item", func(t *testing.T) {
+		d := document()
+		require.NoError(t, ApplyPatch(d, operations(t, `{"Operations":[{"op":"replace","path":"emails[type eq \"work\"].value","value":"jane@new.com"}]}`)))
+		assert.Equal(t, "jane@new.com", d["emails"].([]any)[0].(map[string]any)["value"])
+	})
+
+	t.Run("replace sub-attribute without matching item adds it", func(t *testing.T) {
+		d := document()
+		require.NoError(t, ApplyPatch(d, operations(t, `{"Operations":[{"op":"add","path":"emails[type eq \"home\"].value","value":"jane@home.com"}]}`)))
+		assert.Len(t, d["emails"], 2)
+		assert.Equal(t, map[string]any{"type": "home", "value": "jane@home.com"}, d["emails"].([]any)[1])
+	})
+
+	t.Run("attribute names are case insensitive", func(t *testing.T) {
+		d := document()
+		require.NoError(t, ApplyPatch(d, operations(t, `{"Operations":[{"op":"replace","path":"DISPLAYNAME","value":"JD"}]}`)))
+		assert.Equal(t, "JD", d["displayName"])
+	})
+
+	t.Run("invalid operations -> error", func(t *testing.T) {
+		assert.Error(t, ApplyPatch(document(), operations(t, `{"Operations":[{"op":"move","path":"active"}]}`)))
+		assert.Error(t, ApplyPatch(document(), operations(t, `{"Operations":[{"op":"remove"}]}`)))
+		assert.Error(t, ApplyPatch(document(), operations(t, `{"Operations":[{"op":"replace","value":"x"}]}`)))
+		assert.Error(t, ApplyPatch(document(), operations(t, `{"Operations":[{"op":"remove","path":"members[value eq]"}]}`)))
+	})
+}
@@ -0,0 +1,300 @@
+package scim
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gorilla/mux"
+	log "github.com/sirupsen/logrus"
+	"github.com/superplanehq/superplane/pkg/authorization"
+	"github.com/superplanehq/superplane/pkg/crypto"
+	"github.com/superplanehq/superplane/pkg/models"
+)
+
+//
+// SuperPlane acts as a SCIM 2.0 service provider (RFC 7643 and RFC 7644),
+// so identity providers can provision organization members and groups.
+// Users map to organization users, and groups map to organization groups,
+// so the roles bound to a group apply to the members the identity provider pushes.
+//
+
+const (
+	BasePath = "/scim/v2"
+
+	ContentType = "application/scim+json"
+
+	SchemaUser                  = "urn:ietf:params:scim:schemas:core:2.0:User"
+	SchemaGroup                 = "urn:ietf:params:scim:schemas:core:2.0:Group"
+	SchemaListResponse          = "urn:ietf:params:scim:api:messages:2.0:ListResponse"
+	SchemaPatchOp               = "urn:ietf:params:scim:api:messages:2.0:PatchOp"
+	SchemaError                 = "urn:ietf:params:scim:api:messages:2.0:Error"
+	SchemaServiceProviderConfig = "urn:ietf:params:scim:schemas:core:2.0:ServiceProviderConfig"
+	SchemaResourceType          = "urn:ietf:params:scim:schemas:core:2.0:ResourceType"
+	SchemaSchema                = "urn:ietf:params:scim:schemas:core:2.0:Schema"
+
+	DefaultPageSize = 100
+	MaxPageSize     = 200
+)
+
+type contextKey string
+
+const organizationIDContextKey contextKey = "scim-organization-id"
+
+type Server struct {
+	authService authorization.Authorization
+	baseURL     string
+}
+
+func NewServer(authService authorization.Authorization, baseURL string) *Server {
+	return &Server{
+		authService: authService,
+		baseURL:     strings.TrimRight(baseURL, "/") + BasePath,
+	}
+}
+
+func (s *Server) RegisterRoutes(router *mux.Router) {
+	r := router.PathPrefix(BasePath).Subrouter()
+	r.Use(s.authenticate)
+
+	r.HandleFunc("/ServiceProviderConfig", s.getServiceProviderConfig).Methods("GET")
+	r.HandleFunc("/ResourceTypes", s.listResourceTypes).Methods("GET")
+	r.HandleFunc("/Schemas", s.listSchemas).Methods("GET")
+
+	r.HandleFunc("/Users", s.listUsers).Methods("GET")
+	r.HandleFunc("/Users", s.createUser).Methods("POST")
+	r.HandleFunc("/Users/{id}", s.getUser).Methods("GET")
+	r.HandleFunc("/Users/{id}", s.replaceUser).Methods("PUT")
+	r.HandleFunc("/Users/{id}", s.patchUser).Methods("PATCH")
+	r.HandleFunc("/Users/{id}", s.deleteUser).Methods("DELETE")
+
+	r.HandleFunc("/Groups", s.listGroups).Methods("GET")
+	r.HandleFunc("/Groups", s.createGroup).Methods("POST")
+	r.HandleFunc("/Groups/{id}", s.getGroup).Methods("GET")
+	r.HandleFunc("/Groups/{id}", s.replaceGroup).Methods("PUT")
+	r.HandleFunc("/Groups/{id}", s.patchGroup).Methods("PATCH")
+	r.HandleFunc("/Groups/{id}", s.deleteGroup).Methods("DELETE")
+}
+
+//
+// Requests are authenticated with the organization's SCIM token,
+// which also determines the organization being provisioned.
+//
+
+func (s *Server) authenticate(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		plainToken, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+		if !ok || plainToken == "" {
+			writeError(w, http.StatusUnauthorized, "", "Missing bearer token")
+			return
+		}
+
+		token, err := models.FindOrganizationSCIMTokenByHash(crypto.HashToken(plainToken))
+		if err != nil {
+			writeError(w, http.StatusUnauthorized, "", "Invalid bearer token")
+			return
+		}
+
+		if err := token.MarkAsUsed(); err != nil {
+			log.Warnf("failed to update last usage of SCIM token for organization %s: %v", token.OrganizationID, err)
+		}
+
+		ctx := context.WithValue(r.Context(), organizationIDContextKey, token.OrganizationID.String())
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
+func organizationID(r *http.Request) string {
+	orgID, _ := r.Context().Value(organizationIDContextKey).(string)
+	return orgID
+}
+
+type Meta struct {
+	ResourceType string `json:"resourceType"`
+	Created      string `json:"created,omitempty"`
+	LastModified string `json:"lastModified,omitempty"`
+	Location     string `json:"location,omitempty"`
+}
+
+type ListResponse struct {
+	Schemas      []string `json:"schemas"`
+	TotalResults int      `json:"totalResults"`
+	StartIndex   int      `json:"startIndex"`
+	ItemsPerPage int      `json:"itemsPerPage"`
+	Resources    []any    `json:"Resources"`
+}
+
+type Error struct {
+	Schemas  []string `json:"schemas"`
+	Status   string   `json:"status"`
+	ScimType string   `json:"scimType,omitempty"`
+	Detail   string   `json:"detail,omitempty"`
+}
+
+//
+// scimError is returned by the resource operations,
+// and carries what writeError needs to build the SCIM error response.
+//
+
+type scimError struct {
+	status   int
+	scimType string
+	detail   string
+}
+
+func (e *scimError) Error() string {
+	return e.detail
+}
+
+func newError(status int, scimType, detail string) error {
+	return &scimError{status: status, scimType: scimType, detail: detail}
+}
+
+func writeError(w http.ResponseWriter, status int, scimType, detail string) {
+	writeJSON(w, status, Error{
+		Schemas:  []string{SchemaError},
+		Status:   strconv.Itoa(status),
+		ScimType: scimType,
+		Detail:   detail,
+	})
+}
+
+func writeOperationError(w http.ResponseWriter, err error) {
+	var e *scimError
+	if errors.As(err, &e) {
+		writeError(w, e.status, e.scimType, e.detail)
+		return
+	}
+
+	log.Errorf("SCIM operation failed: %v", err)
+	writeError(w, http.StatusInternalServerError, "", "Internal server error")
+}
+
+func writeJSON(w http.ResponseWriter, status int, body any) {
+	w.Header().Set("Content-Type", ContentType)
+	w.WriteHeader(status)
+
+	if err := json.NewEncoder(w).Encode(body); err != nil {
+		log.Errorf("failed to write SCIM response: %v", err)
+	}
+}
+
+func readJSON(r *http.Request, target any) error {
+	body, err := io.ReadAll(io.LimitReader(r.Body, 1<<20))
+	if err != nil {
+		return newError(http.StatusBadRequest, "invalidSyntax", "Failed to read request body")
+	}
+
+	if err := json.Unmarshal(body, target); err != nil {
+		return newError(http.StatusBadRequest, "invalidSyntax", "Request body is not valid JSON")
+	}
+
+	return nil
+}
+
+//
+// toDocument converts a resource to its generic JSON form,
+// which is what filters and PATCH operations work on.
+//
+
+func toDocument(resource any) (map[string]any, error) {
+	data, err := json.Marshal(resource)
+	if err != nil {
+		return nil, err
+	}
+
+	document := map[string]any{}
+	return document, json.Unmarshal(data, &document)
+}
+
+func fromDocument(document map[string]any, target any) error {
+	data, err := json.Marshal(document)
+	if err != nil {
+		return err
+	}
+
+	if err := json.Unmarshal(data, target); err != nil {
+		return newError(http.StatusBadRequest, "invalidValue", err.Error())
+	}
+
+	return nil
+}
+
+//
+// listResources filters and paginates resources, as described in
+// RFC 7644, section 3.4.2. Organizations are small enough for
+// filtering to happen in memory, on the same JSON the client sees.
+//
+
+func listResources(r *http.Request, resources []any) (*ListResponse, error) {
+	query := r.URL.Query()
+
+	var filter Filter
+	if expression := query.Get("filter"); expression != "" {
+		var err error
+		filter, err = ParseFilter(expression)
+		if err != nil {
+			return nil, newError(http.StatusBadRequest, "invalidFilter", err.Error())
+		}
+	}
+
+	startIndex, err := queryInt(query.Get("startIndex"), 1)
+	if err != nil {
+		return nil, err
+	}
+
+	count, err := queryInt(query.Get("count"), DefaultPageSize)
+	if err != nil {
+		return nil, err
+	}
+
+	startIndex = max(startIndex, 1)
+	count = min(max(count, 0), MaxPageSize)
+
+	matching := []any{}
+	for _, resource := range resources {
+		if filter == nil {
+			matching = append(matching, resource)
+			continue
+		}
+
+		document, err := toDocument(resource)
+		if err != nil {
+			return nil, err
+		}
+
+		if filter.Matches(document) {
+			matching = append(matching, resource)
+		}
+	}
+
+	page := []any{}
+	if startIndex <= len(matching) {
+		page = matching[startIndex-1 : min(startIndex-1+count, len(matching))]
+	}
+
+	return &ListResponse{
+		Schemas:      []string{SchemaListResponse},
+		TotalResults: len(matching),
+		StartIndex:   startIndex,
+		ItemsPerPage: len(page),
+		Resources:    page,
+	}, nil
+}
+
+func queryInt(value string, defaultValue int) (int, error) {
+	if value == "" {
+		return defaultValue, nil
+	}
+
+	number, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, newError(http.StatusBadRequest, "invalidValue", "invalid pagination parameter "+value)
+	}
+
+	return number, nil
+}
@@ -0,0 +1,249 @@
+package scim
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/crypto"
+	"github.com/superplanehq/superplane/pkg/models"
+	"github.com/superplanehq/superplane/test/support"
+)
+
+func Test__SCIMServer(t *testing.T) {
+	r := support.Setup(t)
+	orgID := r.Organization.ID.String()
+
+	plainToken, err := crypto.Base64String(64)
+	require.NoError(t, err)
+	require.NoError(t, models.ResetOrganizationSCIMToken(&models.OrganizationSCIMToken{
+		OrganizationID: r.Organization.ID,
+		TokenHash:      crypto.HashToken(plainToken),
+	}))
+
+	router := mux.NewRouter()
+	NewServer(r.AuthService, "http://superplane.test").RegisterRoutes(router)
+
+	request := func(method, path, token string, body any) *httptest.ResponseRecorder {
+		var reader *bytes.Reader
+		if body != nil {
+			data, err := json.Marshal(body)
+			require.NoError(t, err)
+			reader = bytes.NewReader(data)
+		} else {
+			reader = bytes.NewReader(nil)
+		}
+
+		req := httptest.NewRequest(method, BasePath+path, reader)
+		req.Header.Set("Content-Type", ContentType)
+		if token != "" {
+			req.Header.Set("Authorization", "Bearer "+token)
+		}
+
+		res := httptest.NewRecorder()
+		router.ServeHTTP(res, req)
+		return res
+	}
+
+	decode := func(t *testing.T, res *httptest.ResponseRecorder, target any) {
+		require.NoError(t, json.Unmarshal(res.Body.Bytes(), target))
+	}
+
+	roleOf := func(t *testing.T, userID string) string {
+		roles, err := r.AuthService.GetUserRolesForOrg(userID, orgID)
+		require.NoError(t, err)
+		require.NotEmpty(t, roles)
+		return roles[0].Name
+	}
+
+	var userID string
+
+	t.Run("missing or invalid token -> unauthorized", func(t *testing.T) {
+		assert.Equal(t, http.StatusUnauthorized, request("GET", "/Users", "", nil).Code)
+		assert.Equal(t, http.StatusUnauthorized, request("GET", "/Users", "not-the-token", nil).Code)
+	})
+
+	t.Run("service provider config is available", func(t *testing.T) {
+		res := request("GET", "/ServiceProviderConfig", plainToken, nil)
+		require.Equal(t, http.StatusOK, res.Code)
+		assert.Equal(t, ContentType, res.Header().Get("Content-Type"))
+	})
+
+	t.Run("create user -> organization user with default role", func(t *testing.T) {
+		res := request("POST", "/Users", plainToken, map[string]any{
+			"schemas":  []string{SchemaUser},
+			"userName": "Alice@Example.com",
+			"name":     map[string]any{"givenName": "Alice", "familyName": "Smith"},
+			"active":   true,
+		})
+		require.Equal(t, http.StatusCreated, res.Code, res.Body.String())
+
+		var user User
+		decode(t, res, &user)
+		assert.Equal(t, "alice@example.com", user.UserName)
+		assert.Equal(t, "Alice Smith", user.DisplayName)
+		assert.True(t, user.isActive())
+		assert.Equal(t, "http://superplane.test/scim/v2/Users/"+user.ID, res.Header().Get("Location"))
+
+		userID = user.ID
+		_, err := models.FindActiveUserByID(orgID, userID)
+		require.NoError(t, err)
+		assert.Equal(t, models.RoleOrgViewer, roleOf(t, userID))
+	})
+
+	t.Run("create existing user -> conflict", func(t *testing.T) {
+		res := request("POST", "/Users", plainToken, map[string]any{"userName": "alice@example.com"})
+		assert.Equal(t, http.StatusConflict, res.Code)
+	})
+
+	t.Run("filter users by userName", func(t *testing.T) {
+		res := request("GET", "/Users?filter="+url.QueryEscape(`userName eq "alice@example.com"`), plainToken, nil)
+		require.Equal(t, http.StatusOK, res.Code)
+
+		var list ListResponse
+		decode(t, res, &list)
+		assert.Equal(t, 1, list.TotalResults)
+		require.Len(t, list.Resources, 1)
+		assert.Equal(t, userID, list.Resources[0].(map[string]any)["id"])
+	})
+
+	t.Run("invalid filter -> bad request", func(t *testing.T) {
+		res := request("GET", "/Users?filter="+url.QueryEscape(`userName zz "x"`), plainToken, nil)
+		assert.Equal(t, http.StatusBadRequest, res.Code)
+	})
+
+	t.Run("create group with members -> organization group", func(t *testing.T) {
+		res := request("POST", "/Groups", plainToken, map[string]any{
+			"schemas":     []string{SchemaGroup},
+			"displayName": "Platform Engineers",
+			"members":     []map[string]any{{"value": userID}},
+		})
+		require.Equal(t, http.StatusCreated, res.Code, res.Body.String())
+
+		var group Group
+		decode(t, res, &group)
+		assert.Equal(t, "platform-engineers", group.ID)
+		assert.Equal(t, "Platform Engineers", group.DisplayName)
+		require.Len(t, group.Members, 1)
+		assert.Equal(t, userID, group.Members[0].Value)
+
+		members, err := r.AuthService.GetGroupUsers(orgID, models.DomainTypeOrganization, "platform-engineers")
+		require.NoError(t, err)
+		assert.Equal(t, []string{userID}, members)
+
+		role, err := r.AuthService.GetGroupRole(orgID, models.DomainTypeOrganization, "platform-engineers")
+		require.NoError(t, err)
+		assert.Equal(t, models.RoleOrgViewer, role)
+	})
+
+	t.Run("create group with unknown member -> bad request", func(t *testing.T) {
+		res := request("POST", "/Groups", plainToken, map[string]any{
+			"displayName": "Other",
+			"members":     []map[string]any{{"value": "00000000-0000-0000-0000-000000000000"}},
+		})
+		assert.Equal(t, http.StatusBadRequest, res.Code)
+	})
+
+	t.Run("patch group -> members and display name updated", func(t *testing.T) {
+		res := request("PATCH", "/Groups/platform-engineers", plainToken, map[string]any{
+			"schemas": []string{SchemaPatchOp},
+			"Operations": []map[string]any{
+				{"op": "replace", "path": "displayName", "value": "Platform"},
+				{"op": "remove", "path": `members[value eq "` + userID + `"]`},
+			},
+		})
+		require.Equal(t, http.StatusOK, res.Code, res.Body.String())
+
+		var group Group
+		decode(t, res, &group)
+		assert.Equal(t, "Platform", group.DisplayName)
+		assert.Empty(t, group.Members)
+
+		res = request("PATCH", "/Groups/platform-engineers", plainToken, map[string]any{
+			"schemas":    []string{SchemaPatchOp},
+			"Operations": []map[string]any{{"op": "add", "path": "members", "value": []map[string]any{{"value": userID}}}},
+		})
+		require.Equal(t, http.StatusOK, res.Code, res.Body.String())
+
+		members, err := r.AuthService.GetGroupUsers(orgID, models.DomainTypeOrganization, "platform-engineers")
+		require.NoError(t, err)
+		assert.Equal(t, []string{userID}, members)
+	})
+
+	t.Run("deactivate user -> removed from organization and groups", func(t *testing.T) {
+		res := request("PATCH", "/Users/"+userID, plainToken, map[string]any{
+			"schemas":    []string{SchemaPatchOp},
+			"Operations": []map[string]any{{"op": "Replace", "path": "active", "value": "False"}},
+		})
+		require.Equal(t, http.StatusOK, res.Code, res.Body.String())
+
+		var user User
+		decode(t, res, &user)
+		assert.False(t, user.isActive())
+		assert.Empty(t, user.Groups)
+
+		_, err := models.FindActiveUserByID(orgID, userID)
+		require.Error(t, err)
+
+		members, err := r.AuthService.GetGroupUsers(orgID, models.DomainTypeOrganization, "platform-engineers")
+		require.NoError(t, err)
+		assert.Empty(t, members)
+
+		res = request("GET", "/Users/"+userID, plainToken, nil)
+		require.Equal(t, http.StatusOK, res.Code)
+	})
+
+	t.Run("reactivate user -> restored with default role", func(t *testing.T) {
+		res := request("PATCH", "/Users/"+userID, plainToken, map[string]any{
+			"schemas":    []string{SchemaPatchOp},
+			"Operations": []map[string]any{{"op": "replace", "value": map[string]any{"active": true}}},
+		})
+		require.Equal(t, http.StatusOK, res.Code, res.Body.String())
+
+		_, err := models.FindActiveUserByID(orgID, userID)
+		require.NoError(t, err)
+		assert.Equal(t, models.RoleOrgViewer, roleOf(t, userID))
+	})
+
+	t.Run("changing userName -> bad request", func(t *testing.T) {
+		res := request("PUT", "/Users/"+userID, plainToken, map[string]any{
+			"schemas":  []string{SchemaUser},
+			"userName": "someone-else@example.com",
+		})
+		assert.Equal(t, http.StatusBadRequest, res.Code)
+	})
+
+	t.Run("deactivate last owner -> bad request", func(t *testing.T) {
+		res := request("DELETE", "/Users/"+r.User.String(), plainToken, nil)
+		assert.Equal(t, http.StatusBadRequest, res.Code)
+
+		_, err := models.FindActiveUserByID(orgID, r.User.String())
+		require.NoError(t, err)
+	})
+
+	t.Run("delete user -> deactivated", func(t *testing.T) {
+		res := request("DELETE", "/Users/"+userID, plainToken, nil)
+		require.Equal(t, http.StatusNoContent, res.Code)
+
+		_, err := models.FindActiveUserByID(orgID, userID)
+		require.Error(t, err)
+	})
+
+	t.Run("delete group -> removed", func(t *testing.T) {
+		res := request("DELETE", "/Groups/platform-engineers", plainToken, nil)
+		require.Equal(t, http.StatusNoContent, res.Code)
+
+		res = request("GET", "/Groups/platform-engineers", plainToken, nil)
+		assert.Equal(t, http.StatusNotFound, res.Code)
+	})
+
+	t.Run("unknown user -> not found", func(t *testing.T) {
+		assert.Equal(t, http.StatusNotFound, request("GET", "/Users/not-a-uuid", plainToken, nil).Code)
+	})
+}
@@ -0,0 +1,505 @@
+package scim
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+	"github.com/superplanehq/superplane/pkg/models"
+	"github.com/superplanehq/superplane/pkg/utils"
+	"gorm.io/gorm"
+)
+
+type User struct {
+	Schemas     []string    `json:"schemas"`
+	ID          string      `json:"id,omitempty"`
+	UserName    string      `json:"userName"`
+	Name        *Name       `json:"name,omitempty"`
+	DisplayName string      `json:"displayName,omitempty"`
+	Emails      []Email     `json:"emails,omitempty"`
+	Active      *Bool       `json:"active,omitempty"`
+	Groups      []MemberRef `json:"groups,omitempty"`
+	Meta        *Meta       `json:"meta,omitempty"`
+}
+
+type Name struct {
+	Formatted  string `json:"formatted,omitempty"`
+	GivenName  string `json:"givenName,omitempty"`
+	FamilyName string `json:"familyName,omitempty"`
+}
+
+type Email struct {
+	Value   string `json:"value"`
+	Type    string `json:"type,omitempty"`
+	Primary bool   `json:"primary,omitempty"`
+}
+
+type MemberRef struct {
+	Value   string `json:"value"`
+	Display string `json:"display,omitempty"`
+	Ref     string `json:"$ref,omitempty"`
+}
+
+//
+// Bool accepts "True" and "False" strings too,
+// since some identity providers send them in PATCH requests.
+//
+
+type Bool bool
+
+func (b *Bool) UnmarshalJSON(data []byte) error {
+	var value bool
+	if err := json.Unmarshal(data, &value); err == nil {
+		*b = Bool(value)
+		return nil
+	}
+
+	var text string
+	if err := json.Unmarshal(data, &text); err != nil {
+		return err
+	}
+
+	value, err := strconv.ParseBool(strings.ToLower(text))
+	if err != nil {
+		return err
+	}
+
+	*b = Bool(value)
+	return nil
+}
+
+func (u *User) isActive() bool {
+	return u.Active == nil || bool(*u.Active)
+}
+
+//
+// The email is what links organization users to accounts,
+// so it comes from userName, falling back to the primary email.
+//
+
+func (u *User) email() string {
+	if strings.Contains(u.UserName, "@") {
+		return utils.NormalizeEmail(u.UserName)
+	}
+
+	for _, email := range u.Emails {
+		if email.Primary {
+			return utils.NormalizeEmail(email.Value)
+		}
+	}
+
+	if len(u.Emails) > 0 {
+		return utils.NormalizeEmail(u.Emails[0].Value)
+	}
+
+	return ""
+}
+
+func (u *User) name(fallback string) string {
+	switch {
+	case strings.TrimSpace(u.DisplayName) != "":
+		return strings.TrimSpace(u.DisplayName)
+
+	case u.Name != nil && strings.TrimSpace(u.Name.Formatted) != "":
+		return strings.TrimSpace(u.Name.Formatted)
+
+	case u.Name != nil && strings.TrimSpace(u.Name.GivenName+" "+u.Name.FamilyName) != "":
+		return strings.TrimSpace(u.Name.GivenName + " " + u.Name.FamilyName)
+
+	default:
+		return fallback
+	}
+}
+
+func (s *Server) listUsers(w http.ResponseWriter, r *http.Request) {
+	orgID := organizationID(r)
+
+	users, err := models.FindMaybeDeletedHumanUsersByOrganization(orgID)
+	if err != nil {
+		writeOperationError(w, err)
+		return
+	}
+
+	groups, err := s.loadGroups(orgID)
+	if err != nil {
+		writeOperationError(w, err)
+		return
+	}
+
+	resources := make([]any, 0, len(users))
+	for _, user := range users {
+		resources = append(resources, s.serializeUser(&user, groups))
+	}
+
+	response, err := listResources(r, resources)
+	if err != nil {
+		writeOperationError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, response)
+}
+
+func (s *Server) getUser(w http.ResponseWriter, r *http.Request) {
+	orgID := organizationID(r)
+
+	user, err := findUser(orgID, mux.Vars(r)["id"])
+	if err != nil {
+		writeOperationError(w, err)
+		return
+	}
+
+	s.writeUser(w, http.StatusOK, orgID, user)
+}
+
+func (s *Server) createUser(w http.ResponseWriter, r *http.Request) {
+	orgID := organizationID(r)
+
+	var resource User
+	if err := readJSON(r, &resource); err != nil {
+		writeOperationError(w, err)
+		return
+	}
+
+	user, err := s.provisionUser(orgID, &resource)
+	if err != nil {
+		writeOperationError(w, err)
+		return
+	}
+
+	w.Header().Set("Location", s.baseURL+"/Users/"+user.ID.String())
+	s.writeUser(w, http.StatusCreated, orgID, user)
+}
+
+func (s *Server) replaceUser(w http.ResponseWriter, r *http.Request) {
+	orgID := organizationID(r)
+
+	user, err := findUser(orgID, mux.Vars(r)["id"])
+	if err != nil {
+		writeOperationError(w, err)
+		return
+	}
+
+	var resource User
+	if err := readJSON(r, &resource); err != nil {
+		writeOperationError(w, err)
+		return
+	}
+
+	if err := s.updateUser(orgID, user, &resource); err != nil {
+		writeOperationError(w, err)
+		return
+	}
+
+	s.writeUser(w, http.StatusOK, orgID, user)
+}
+
+func (s *Server) patchUser(w http.ResponseWriter, r *http.Request) {
+	orgID := organizationID(r)
+
+	user, err := findUser(orgID, mux.Vars(r)["id"])
+	if err != nil {
+		writeOperationError(w, err)
+		return
+	}
+
+	var patch PatchRequest
+	if err := readJSON(r, &patch); err != nil {
+		writeOperationError(w, err)
+		return
+	}
+
+	groups, err := s.loadGroups(orgID)
+	if err != nil {
+		writeOperationError(w, err)
+		return
+	}
+
+	document, err := toDocument(s.serializeUser(user, groups))
+	if err != nil {
+		writeOperationError(w, err)
+		return
+	}
+
+	if err := ApplyPatch(document, patch.Operations); err != nil {
+		writeError(w, http.StatusBadRequest, "invalidPath", err.Error())
+		return
+	}
+
+	var resource User
+	if err := fromDocument(document, &resource); err != nil {
+		writeOperationError(w, err)
+		return
+	}
+
+	//
+	// The name is exposed as displayName and name.formatted,
+	// so only the attributes changed by the patch are considered.
+	//
+	if resource.DisplayName == user.Name {
+		resource.DisplayName = ""
+	}
+
+	if resource.Name != nil && resource.Name.Formatted == user.Name {
+		resource.Name.Formatted = ""
+	}
+
+	if err := s.updateUser(orgID, user, &resource); err != nil {
+		writeOperationError(w, err)
+		return
+	}
+
+	s.writeUser(w, http.StatusOK, orgID, user)
+}
+
+//
+// Deleting a user deactivates it, like setting active to false.
+// Users are never hard deleted, since they are referenced
+// by the executions, approvals and other resources they created.
+//
+
+func (s *Server) deleteUser(w http.ResponseWriter, r *http.Request) {
+	orgID := organizationID(r)
+
+	user, err := findUser(orgID, mux.Vars(r)["id"])
+	if err != nil {
+		writeOperationError(w, err)
+		return
+	}
+
+	if err := s.setUserActive(orgID, user, false); err != nil {
+		writeOperationError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) writeUser(w http.ResponseWriter, status int, orgID string, user *models.User) {
+	groups, err := s.loadGroups(orgID)
+	if err != nil {
+		writeOperationError(w, err)
+		return
+	}
+
+	writeJSON(w, status, s.serializeUser(user, groups))
+}
+
+func (s *Server) serializeUser(user *models.User, groups []group) *User {
+	active := Bool(!user.DeletedAt.Valid)
+	email := user.GetEmail()
+
+	memberships := []MemberRef{}
+	for _, group := range groups {
+		if slices.Contains(group.members, user.ID.String()) {
+			memberships = append(memberships, MemberRef{
+				Value:   group.name,
+				Display: group.displayName,
+				Ref:     s.baseURL + "/Groups/" + group.name,
+			})
+		}
+	}
+
+	return &User{
+		Schemas:     []string{SchemaUser},
+		ID:          user.ID.String(),
+		UserName:    email,
+		Name:        &Name{Formatted: user.Name},
+		DisplayName: user.Name,
+		Emails:      []Email{{Value: email, Type: "work", Primary: true}},
+		Active:      &active,
+		Groups:      memberships,
+		Meta: &Meta{
+			ResourceType: "User",
+			Created:      user.CreatedAt.UTC().Format(time.RFC3339),
+			LastModified: user.UpdatedAt.UTC().Format(time.RFC3339),
+			Location:     s.baseURL + "/Users/" + user.ID.String(),
+		},
+	}
+}
+
+func findUser(orgID, id string) (*models.User, error) {
+	if _, err := uuid.Parse(id); err != nil {
+		return nil, newError(http.StatusNotFound, "", "User "+id+" not found")
+	}
+
+	user, err := models.FindMaybeDeletedUserByID(orgID, id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, newError(http.StatusNotFound, "", "User "+id+" not found")
+		}
+
+		return nil, err
+	}
+
+	if user.IsServiceAccount() {
+		return nil, newError(http.StatusNotFound, "", "User "+id+" not found")
+	}
+
+	return user, nil
+}
+
+//
+// provisionUser adds the account with the user's email to the organization,
+// creating the account if needed. Users deactivated before are restored,
+// so re-provisioning someone keeps their history.
+//
+
+func (s *Server) provisionUser(orgID string, resource *User) (*models.User, error) {
+	email := resource.email()
+	if !strings.Contains(email, "@") {
+		return nil, newError(http.StatusBadRequest, "invalidValue", "userName must be an email address")
+	}
+
+	existing, err := models.FindMaybeDeletedUserByEmail(orgID, email)
+	if err == nil {
+		if !existing.DeletedAt.Valid {
+			return nil, newError(http.StatusConflict, "uniqueness", "User "+email+" already exists")
+		}
+
+		if err := s.updateUser(orgID, existing, resource); err != nil {
+			return nil, err
+		}
+
+		return existing, nil
+	}
+
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, err
+	}
+
+	account, err := models.FindAccountByEmail(email)
+	if err != nil {
+		if !errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, err
+		}
+
+		account, err = models.CreateAccount(resource.name(email), email)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	user, err := models.CreateUser(uuid.MustParse(orgID), account.ID, email, resource.name(email))
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.authService.AssignRole(user.ID.String(), s.defaultRole(orgID), orgID, models.DomainTypeOrganization); err != nil {
+		return nil, err
+	}
+
+	if !resource.isActive() {
+		if err := s.setUserActive(orgID, user, false); err != nil {
+			return nil, err
+		}
+	}
+
+	return user, nil
+}
+
+func (s *Server) updateUser(orgID string, user *models.User, resource *User) error {
+	email := resource.email()
+	if email != "" && email != user.GetEmail() {
+		return newError(http.StatusBadRequest, "mutability", "userName cannot be changed")
+	}
+
+	name := resource.name(user.Name)
+	if name != user.Name {
+		if err := user.UpdateName(name); err != nil {
+			return err
+		}
+	}
+
+	return s.setUserActive(orgID, user, resource.isActive())
+}
+
+//
+// Deactivating a user removes it from the organization the same way
+// removing a member does: roles and group memberships are dropped,
+// and the user is soft deleted, which ends its sessions and API tokens.
+// Reactivated users get the default role back, and their groups
+// are pushed again by the identity provider.
+//
+
+func (s *Server) setUserActive(orgID string, user *models.User, active bool) error {
+	userID := user.ID.String()
+	deleted := user.DeletedAt.Valid
+
+	if active {
+		if !deleted {
+			return nil
+		}
+
+		if err := user.Restore(); err != nil {
+			return err
+		}
+
+		user.DeletedAt = gorm.DeletedAt{}
+		return s.authService.AssignRole(userID, s.defaultRole(orgID), orgID, models.DomainTypeOrganization)
+	}
+
+	if deleted {
+		return nil
+	}
+
+	ownerIDs, err := s.authService.GetOrgUsersForRole(models.RoleOrgOwner, orgID)
+	if err != nil {
+		return err
+	}
+
+	if len(ownerIDs) <= 1 && slices.Contains(ownerIDs, userID) {
+		return newError(http.StatusBadRequest, "mutability", "The last organization owner cannot be deactivated")
+	}
+
+	groups, err := s.loadGroups(orgID)
+	if err != nil {
+		return err
+	}
+
+	for _, group := range groups {
+		if slices.Contains(group.members, userID) {
+			if err := s.authService.RemoveUserFromGroup(orgID, models.DomainTypeOrganization, userID, group.name); err != nil {
+				return err
+			}
+		}
+	}
+
+	roles, err := s.authService.GetUserRolesForOrg(userID, orgID)
+	if err != nil {
+		return err
+	}
+
+	for _, role := range roles {
+		if err := s.authService.RemoveRole(userID, role.Name, orgID, models.DomainTypeOrganization); err != nil {
+			return err
+		}
+	}
+
+	if err := user.Delete(); err != nil {
+		return err
+	}
+
+	user.DeletedAt = gorm.DeletedAt{Time: time.Now(), Valid: true}
+	return nil
+}
+
+//
+// New users get the default role of the organization's SSO configuration,
+// so SCIM and just-in-time provisioning behave the same way.
+//
+
+func (s *Server) defaultRole(orgID string) string {
+	config, err := models.FindOrganizationSSOConfig(orgID)
+	if err != nil || config.DefaultRole == "" || config.DefaultRole == models.RoleOrgOwner {
+		return models.RoleOrgViewer
+	}
+
+	return config.DefaultRole
+}
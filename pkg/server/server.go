@@ -3,18 +3,23 @@ package server
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"os"
 	"strconv"
 	"strings"
 	"time"
 
 	log "github.com/sirupsen/logrus"
+	"github.com/superplanehq/superplane/pkg/artifacts"
 	"github.com/superplanehq/superplane/pkg/authorization"
 	"github.com/superplanehq/superplane/pkg/config"
 	"github.com/superplanehq/superplane/pkg/crypto"
 	grpc "github.com/superplanehq/superplane/pkg/grpc"
+	"github.com/superplanehq/superplane/pkg/integrations/declarative"
 	"github.com/superplanehq/superplane/pkg/jwt"
+	"github.com/superplanehq/superplane/pkg/metrics"
 	"github.com/superplanehq/superplane/pkg/oidc"
+	"github.com/superplanehq/superplane/pkg/models"
 	"github.com/superplanehq/superplane/pkg/public"
 	registry "github.com/superplanehq/superplane/pkg/registry"
 	"github.com/superplanehq/superplane/pkg/services"
@@ -27,6 +32,7 @@ import (
 	_ "github.com/superplanehq/superplane/pkg/components/approval"
 	_ "github.com/superplanehq/superplane/pkg/components/filter"
 	_ "github.com/superplanehq/superplane/pkg/components/http"
+	_ "github.com/superplanehq/superplane/pkg/components/humaninput"
 	_ "github.com/superplanehq/superplane/pkg/components/if"
 	_ "github.com/superplanehq/superplane/pkg/components/merge"
 	_ "github.com/superplanehq/superplane/pkg/components/noop"
@@ -82,7 +88,7 @@ func startWorkers(encryptor crypto.Encryptor, registry *registry.Registry, oidcP
 	}
 
 	if os.Getenv("START_CONSUMERS") == "yes" {
-		startEmailConsumers(rabbitMQURL, encryptor, baseURL, authService)
+		startEmailConsumers(rabbitMQURL, encryptor, registry, baseURL, authService)
 	}
 
 	if os.Getenv("START_WORKFLOW_EVENT_ROUTER") == "yes" || os.Getenv("START_EVENT_ROUTER") == "yes" {
@@ -108,6 +114,13 @@ func startWorkers(encryptor crypto.Encryptor, registry *registry.Registry, oidcP
 		go w.Start(context.Background())
 	}
 
+	if os.Getenv("START_EVENT_SUBSCRIPTION_DELIVERY_WORKER") == "yes" {
+		log.Println("Starting Event Subscription Delivery Worker")
+
+		w := workers.NewEventSubscriptionDeliveryWorker(encryptor, registry.HTTPContext())
+		go w.Start(context.Background())
+	}
+
 	if os.Getenv("START_APP_INSTALLATION_REQUEST_WORKER") == "yes" || os.Getenv("START_INTEGRATION_REQUEST_WORKER") == "yes" {
 		log.Println("Starting Integration Request Worker")
 
@@ -156,7 +169,7 @@ func startWorkers(encryptor crypto.Encryptor, registry *registry.Registry, oidcP
 	}
 }
 
-func startEmailConsumers(rabbitMQURL string, encryptor crypto.Encryptor, baseURL string, authService authorization.Authorization) {
+func startEmailConsumers(rabbitMQURL string, encryptor crypto.Encryptor, registry *registry.Registry, baseURL string, authService authorization.Authorization) {
 	templateDir := os.Getenv("TEMPLATE_DIR")
 	if templateDir == "" {
 		log.Warn("Email Consumers not started - missing required environment variable (TEMPLATE_DIR)")
@@ -167,7 +180,7 @@ func startEmailConsumers(rabbitMQURL string, encryptor crypto.Encryptor, baseURL
 		log.Println("Starting SMTP Email Consumers (self-hosted)")
 		settingsProvider := &services.DatabaseEmailSettingsProvider{Encryptor: encryptor}
 		emailService := services.NewSMTPEmailService(settingsProvider, templateDir)
-		startEmailConsumersWithService(rabbitMQURL, emailService, baseURL, authService)
+		startEmailConsumersWithService(rabbitMQURL, emailService, encryptor, registry, baseURL, authService)
 		return
 	}
 
@@ -180,10 +193,10 @@ func startEmailConsumers(rabbitMQURL string, encryptor crypto.Encryptor, baseURL
 	}
 
 	emailService := services.NewResendEmailService(resendAPIKey, fromName, fromEmail, templateDir)
-	startEmailConsumersWithService(rabbitMQURL, emailService, baseURL, authService)
+	startEmailConsumersWithService(rabbitMQURL, emailService, encryptor, registry, baseURL, authService)
 }
 
-func startEmailConsumersWithService(rabbitMQURL string, emailService services.EmailService, baseURL string, authService authorization.Authorization) {
+func startEmailConsumersWithService(rabbitMQURL string, emailService services.EmailService, encryptor crypto.Encryptor, registry *registry.Registry, baseURL string, authService authorization.Authorization) {
 	log.Println("Starting Invitation Email Consumer")
 	invitationEmailConsumer := workers.NewInvitationEmailConsumer(rabbitMQURL, emailService, baseURL)
 	go invitationEmailConsumer.Start()
@@ -191,6 +204,10 @@ func startEmailConsumersWithService(rabbitMQURL string, emailService services.Em
 	log.Println("Starting Notification Email Consumer")
 	notificationEmailConsumer := workers.NewNotificationEmailConsumer(rabbitMQURL, emailService, authService)
 	go notificationEmailConsumer.Start()
+
+	log.Println("Starting Notification Delivery Worker")
+	notificationDeliveryWorker := workers.NewNotificationDeliveryWorker(encryptor, registry, emailService, registry.HTTPContext())
+	go notificationDeliveryWorker.Start(context.Background())
 }
 
 func startInternalAPI(baseURL, webhooksBaseURL, basePath string, encryptor crypto.Encryptor, authService authorization.Authorization, registry *registry.Registry, oidcProvider oidc.Provider) {
@@ -296,18 +313,61 @@ func configureLogging() {
 }
 
 func setupOtelMetrics() {
-	if os.Getenv("OTEL_ENABLED") != "yes" {
+	otelEnabled := os.Getenv("OTEL_ENABLED") == "yes"
+	prometheusPort := os.Getenv("PROMETHEUS_METRICS_PORT")
+	if !otelEnabled && prometheusPort == "" {
+		return
+	}
+
+	businessMetrics, err := metrics.OptionsFromEnv()
+	if err != nil {
+		log.Warnf("Failed to initialize metrics: %v", err)
 		return
 	}
 
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 	defer cancel()
 
-	if err := telemetry.InitMetrics(ctx); err != nil {
+	err = telemetry.InitMetrics(ctx, telemetry.MetricsOptions{
+		OTLP:       otelEnabled,
+		Prometheus: prometheusPort != "",
+		Business:   businessMetrics,
+	})
+
+	if err != nil {
 		log.Warnf("Failed to initialize OpenTelemetry metrics: %v", err)
 	} else {
 		log.Info("OpenTelemetry metrics initialized")
 	}
+
+	if err == nil && prometheusPort != "" {
+		go startPrometheusMetricsServer(prometheusPort)
+	}
+
+	if !otelEnabled {
+		return
+	}
+
+	if err := telemetry.InitTracing(ctx); err != nil {
+		log.Warnf("Failed to initialize OpenTelemetry tracing: %v", err)
+	} else {
+		log.Info("OpenTelemetry tracing initialized")
+	}
+}
+
+//
+// Metrics are served on their own port,
+// so they are not exposed through the public API.
+//
+
+func startPrometheusMetricsServer(port string) {
+	router := http.NewServeMux()
+	router.Handle("/metrics", telemetry.PrometheusHandler())
+
+	log.Infof("Serving Prometheus metrics on :%s/metrics", port)
+	if err := http.ListenAndServe(":"+port, router); err != nil {
+		log.Errorf("Prometheus metrics server stopped: %v", err)
+	}
 }
 
 func Start() {
@@ -315,7 +375,7 @@ func Start() {
 	setupOtelMetrics()
 
 	telemetry.InitSentry()
-	telemetry.StartBeacon()
+	telemetry.StartBeacon(models.GetInstallationID)
 
 	encryptionKey := os.Getenv("ENCRYPTION_KEY")
 	if encryptionKey == "" {
@@ -357,6 +417,11 @@ func Start() {
 		panic("OIDC_KEYS_PATH must be set")
 	}
 
+	_, err = artifacts.DefaultStorage()
+	if err != nil {
+		panic(fmt.Sprintf("failed to set up artifact storage: %v", err))
+	}
+
 	jwtSigner := jwt.NewSigner(jwtSecret)
 	webhooksBaseURL := getWebhookBaseURL(baseURL)
 	oidcProvider, err := oidc.NewProviderFromKeyDir(webhooksBaseURL, oidcKeysPath)
@@ -374,6 +439,14 @@ func Start() {
 		panic(fmt.Sprintf("failed to create registry: %v", err))
 	}
 
+	if addresses := getPluginAddresses(); len(addresses) > 0 {
+		registry.LoadPlugins(context.Background(), addresses)
+	}
+
+	if dir := os.Getenv("INTEGRATIONS_DIR"); dir != "" {
+		loadDeclarativeIntegrations(registry, dir)
+	}
+
 	templates.Setup(registry)
 
 	if os.Getenv("START_PUBLIC_API") == "yes" {
@@ -461,3 +534,40 @@ func getPrivateIPRanges() []string {
 
 	return strings.Split(blockedPrivateIPRanges, ",")
 }
+
+/*
+ * PLUGINS is a comma-separated list of addresses
+ * of out-of-process plugins, like "deployer:50051,localhost:50052".
+ */
+func getPluginAddresses() []string {
+	addresses := []string{}
+	for _, address := range strings.Split(os.Getenv("PLUGINS"), ",") {
+		address = strings.TrimSpace(address)
+		if address != "" {
+			addresses = append(addresses, address)
+		}
+	}
+
+	return addresses
+}
+
+/*
+ * INTEGRATIONS_DIR is a directory with YAML files defining
+ * declarative HTTP integrations. Invalid files are logged and skipped.
+ */
+func loadDeclarativeIntegrations(r *registry.Registry, dir string) {
+	integrations, err := declarative.LoadDir(dir)
+	if err != nil {
+		log.Errorf("Error loading integrations from %s: %v", dir, err)
+	}
+
+	for _, integration := range integrations {
+		err := r.AddIntegration(integration)
+		if err != nil {
+			log.Errorf("Error registering integration %s: %v", integration.Name(), err)
+			continue
+		}
+
+		log.Infof("Registered integration %s from %s", integration.Name(), dir)
+	}
+}
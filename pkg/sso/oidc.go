@@ -0,0 +1,136 @@
+package sso
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	gooidc "github.com/coreos/go-oidc/v3/oidc"
+	"github.com/superplanehq/superplane/pkg/models"
+	"golang.org/x/oauth2"
+)
+
+//
+// OIDCRelyingParty is the SuperPlane side of an OpenID Connect
+// authorization code login for a single organization.
+// Endpoints and signing keys come from the issuer's discovery document.
+// Groups are read from the ID token, so the provider must be set up
+// to include the configured groups claim in it.
+//
+
+type OIDCRelyingParty struct {
+	config   *models.OrganizationSSOConfig
+	oauth2   *oauth2.Config
+	verifier *gooidc.IDTokenVerifier
+}
+
+func NewOIDCRelyingParty(ctx context.Context, config *models.OrganizationSSOConfig, clientSecret string, baseURL string) (*OIDCRelyingParty, error) {
+	if config.OIDCIssuerURL == nil || *config.OIDCIssuerURL == "" {
+		return nil, fmt.Errorf("OIDC issuer URL is not configured")
+	}
+
+	if config.OIDCClientID == nil || *config.OIDCClientID == "" {
+		return nil, fmt.Errorf("OIDC client ID is not configured")
+	}
+
+	provider, err := gooidc.NewProvider(ctx, *config.OIDCIssuerURL)
+	if err != nil {
+		return nil, fmt.Errorf("OIDC discovery failed: %v", err)
+	}
+
+	return &OIDCRelyingParty{
+		config: config,
+		oauth2: &oauth2.Config{
+			ClientID:     *config.OIDCClientID,
+			ClientSecret: clientSecret,
+			Endpoint:     provider.Endpoint(),
+			RedirectURL:  strings.TrimRight(baseURL, "/") + OIDCCallbackPath(config.OrganizationID.String()),
+			Scopes:       []string{gooidc.ScopeOpenID, "email", "profile"},
+		},
+		verifier: provider.Verifier(&gooidc.Config{ClientID: *config.OIDCClientID}),
+	}, nil
+}
+
+func (p *OIDCRelyingParty) AuthURL(state, nonce string) string {
+	return p.oauth2.AuthCodeURL(state, gooidc.Nonce(nonce))
+}
+
+//
+// Exchange redeems the authorization code, verifies the ID token
+// signature, audience, expiry and nonce, and extracts the identity from its claims.
+//
+
+func (p *OIDCRelyingParty) Exchange(ctx context.Context, code, nonce string) (*Identity, error) {
+	token, err := p.oauth2.Exchange(ctx, code)
+	if err != nil {
+		return nil, fmt.Errorf("failed to exchange authorization code: %v", err)
+	}
+
+	rawIDToken, ok := token.Extra("id_token").(string)
+	if !ok || rawIDToken == "" {
+		return nil, fmt.Errorf("token response has no id_token")
+	}
+
+	idToken, err := p.verifier.Verify(ctx, rawIDToken)
+	if err != nil {
+		return nil, fmt.Errorf("invalid ID token: %v", err)
+	}
+
+	if idToken.Nonce != nonce {
+		return nil, fmt.Errorf("invalid ID token nonce")
+	}
+
+	claims := map[string]any{}
+	if err := idToken.Claims(&claims); err != nil {
+		return nil, fmt.Errorf("invalid ID token claims: %v", err)
+	}
+
+	if verified, ok := claims["email_verified"].(bool); ok && !verified {
+		return nil, fmt.Errorf("identity provider reports the email as unverified")
+	}
+
+	identity := &Identity{
+		Subject: idToken.Subject,
+		Email:   claimString(claims, attributeOrDefault(p.config.EmailAttribute, models.SSODefaultEmailAttribute)),
+		Name:    claimString(claims, attributeOrDefault(p.config.NameAttribute, models.SSODefaultNameAttribute)),
+		Groups:  claimStrings(claims, attributeOrDefault(p.config.GroupsAttribute, models.SSODefaultGroupsAttribute)),
+	}
+
+	if err := validateIdentity(identity); err != nil {
+		return nil, err
+	}
+
+	return identity, nil
+}
+
+func claimString(claims map[string]any, name string) string {
+	value, ok := claims[name].(string)
+	if !ok {
+		return ""
+	}
+
+	return strings.TrimSpace(value)
+}
+
+//
+// Providers disagree on how to send multi-valued claims:
+// most use an array, but some send a single string.
+//
+
+func claimStrings(claims map[string]any, name string) []string {
+	values := []string{}
+	switch v := claims[name].(type) {
+	case string:
+		if v != "" {
+			values = append(values, v)
+		}
+	case []any:
+		for _, item := range v {
+			if s, ok := item.(string); ok && s != "" {
+				values = append(values, s)
+			}
+		}
+	}
+
+	return values
+}
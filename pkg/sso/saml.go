@@ -0,0 +1,240 @@
+package sso
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/crewjam/saml"
+	"github.com/crewjam/saml/samlsp"
+	"github.com/superplanehq/superplane/pkg/models"
+)
+
+const maxSAMLMetadataSize = 1024 * 1024
+
+//
+// SAMLServiceProvider is the SuperPlane side of an SP-initiated
+// SAML 2.0 login for a single organization.
+// AuthnRequests are sent with the HTTP-Redirect binding,
+// and responses are expected on the ACS endpoint with the HTTP-POST binding.
+//
+
+type SAMLServiceProvider struct {
+	config *models.OrganizationSSOConfig
+	sp     *saml.ServiceProvider
+}
+
+func NewSAMLServiceProvider(config *models.OrganizationSSOConfig, baseURL string) (*SAMLServiceProvider, error) {
+	if config.SAMLIdPMetadata == nil || *config.SAMLIdPMetadata == "" {
+		return nil, fmt.Errorf("SAML identity provider metadata is not configured")
+	}
+
+	idpMetadata, err := ParseSAMLMetadata([]byte(*config.SAMLIdPMetadata))
+	if err != nil {
+		return nil, err
+	}
+
+	orgID := config.OrganizationID.String()
+	metadataURL, err := url.Parse(strings.TrimRight(baseURL, "/") + SAMLMetadataPath(orgID))
+	if err != nil {
+		return nil, fmt.Errorf("invalid base URL: %v", err)
+	}
+
+	acsURL, err := url.Parse(strings.TrimRight(baseURL, "/") + SAMLACSPath(orgID))
+	if err != nil {
+		return nil, fmt.Errorf("invalid base URL: %v", err)
+	}
+
+	return &SAMLServiceProvider{
+		config: config,
+		sp: &saml.ServiceProvider{
+			EntityID:          metadataURL.String(),
+			MetadataURL:       *metadataURL,
+			AcsURL:            *acsURL,
+			IDPMetadata:       idpMetadata,
+			AuthnNameIDFormat: saml.UnspecifiedNameIDFormat,
+		},
+	}, nil
+}
+
+//
+// SAMLAuthRequest is an AuthnRequest about to be sent to the identity provider.
+// Its ID must be presented again when the response is parsed,
+// so callers carry it through the login, usually in the RelayState.
+//
+
+type SAMLAuthRequest struct {
+	ID string
+
+	request *saml.AuthnRequest
+	sp      *saml.ServiceProvider
+}
+
+func (p *SAMLServiceProvider) NewAuthRequest() (*SAMLAuthRequest, error) {
+	location := p.sp.GetSSOBindingLocation(saml.HTTPRedirectBinding)
+	if location == "" {
+		return nil, fmt.Errorf("identity provider does not support the HTTP-Redirect binding")
+	}
+
+	request, err := p.sp.MakeAuthenticationRequest(location, saml.HTTPRedirectBinding, saml.HTTPPostBinding)
+	if err != nil {
+		return nil, err
+	}
+
+	return &SAMLAuthRequest{ID: request.ID, request: request, sp: p.sp}, nil
+}
+
+func (r *SAMLAuthRequest) RedirectURL(relayState string) (string, error) {
+	redirectURL, err := r.request.Redirect(url.QueryEscape(relayState), r.sp)
+	if err != nil {
+		return "", err
+	}
+
+	return redirectURL.String(), nil
+}
+
+func (p *SAMLServiceProvider) Metadata() ([]byte, error) {
+	return xml.MarshalIndent(p.sp.Metadata(), "", "  ")
+}
+
+//
+// ParseResponse verifies the base64-encoded SAMLResponse
+// posted to the ACS endpoint and extracts the identity from it.
+// The signature, issuer, audience, destination, validity window and
+// InResponseTo are all checked against the request we issued.
+//
+
+func (p *SAMLServiceProvider) ParseResponse(samlResponse string, requestID string) (*Identity, error) {
+	raw, err := base64.StdEncoding.DecodeString(samlResponse)
+	if err != nil {
+		return nil, fmt.Errorf("invalid SAML response encoding: %v", err)
+	}
+
+	assertion, err := p.sp.ParseXMLResponse(raw, []string{requestID}, p.sp.AcsURL)
+	if err != nil {
+		if invalid, ok := err.(*saml.InvalidResponseError); ok {
+			return nil, fmt.Errorf("invalid SAML response: %v", invalid.PrivateErr)
+		}
+
+		return nil, fmt.Errorf("invalid SAML response: %v", err)
+	}
+
+	identity := &Identity{}
+	if assertion.Subject != nil && assertion.Subject.NameID != nil {
+		identity.Subject = assertion.Subject.NameID.Value
+	}
+
+	identity.Email = firstAttributeValue(assertion, attributeOrDefault(p.config.EmailAttribute, models.SSODefaultEmailAttribute))
+	if identity.Email == "" && strings.Contains(identity.Subject, "@") {
+		identity.Email = identity.Subject
+	}
+
+	identity.Name = firstAttributeValue(assertion, attributeOrDefault(p.config.NameAttribute, models.SSODefaultNameAttribute))
+	identity.Groups = attributeValues(assertion, attributeOrDefault(p.config.GroupsAttribute, models.SSODefaultGroupsAttribute))
+
+	if err := validateIdentity(identity); err != nil {
+		return nil, err
+	}
+
+	return identity, nil
+}
+
+//
+// ParseSAMLMetadata accepts either an EntityDescriptor or an
+// EntitiesDescriptor with a single identity provider in it,
+// which is what most providers export.
+//
+
+func ParseSAMLMetadata(data []byte) (*saml.EntityDescriptor, error) {
+	metadata, err := samlsp.ParseMetadata(data)
+	if err != nil {
+		return nil, fmt.Errorf("invalid SAML metadata: %v", err)
+	}
+
+	if len(metadata.IDPSSODescriptors) == 0 {
+		return nil, fmt.Errorf("SAML metadata has no identity provider descriptor")
+	}
+
+	if metadata.EntityID == "" {
+		return nil, fmt.Errorf("SAML metadata has no entity ID")
+	}
+
+	return metadata, nil
+}
+
+func FetchSAMLMetadata(ctx context.Context, metadataURL string) (string, error) {
+	parsedURL, err := url.Parse(metadataURL)
+	if err != nil || (parsedURL.Scheme != "https" && parsedURL.Scheme != "http") {
+		return "", fmt.Errorf("invalid SAML metadata URL")
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, parsedURL.String(), nil)
+	if err != nil {
+		return "", err
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("failed to fetch SAML metadata: %v", err)
+	}
+
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("failed to fetch SAML metadata: status %d", resp.StatusCode)
+	}
+
+	data, err := io.ReadAll(io.LimitReader(resp.Body, maxSAMLMetadataSize))
+	if err != nil {
+		return "", fmt.Errorf("failed to read SAML metadata: %v", err)
+	}
+
+	if _, err := ParseSAMLMetadata(data); err != nil {
+		return "", err
+	}
+
+	return string(data), nil
+}
+
+func firstAttributeValue(assertion *saml.Assertion, name string) string {
+	values := attributeValues(assertion, name)
+	if len(values) == 0 {
+		return ""
+	}
+
+	return values[0]
+}
+
+//
+// Attributes are matched by either their name or friendly name,
+// so both "email" and "urn:oid:0.9.2342.19200300.100.1.3" styles work.
+//
+
+func attributeValues(assertion *saml.Assertion, name string) []string {
+	values := []string{}
+	for _, statement := range assertion.AttributeStatements {
+		for _, attribute := range statement.Attributes {
+			if attribute.Name != name && attribute.FriendlyName != name {
+				continue
+			}
+
+			for _, value := range attribute.Values {
+				v := strings.TrimSpace(value.Value)
+				if v != "" {
+					values = append(values, v)
+				}
+			}
+		}
+	}
+
+	return values
+}
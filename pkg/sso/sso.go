@@ -0,0 +1,121 @@
+package sso
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/superplanehq/superplane/pkg/models"
+)
+
+//
+// Identity is the user information asserted by
+// an organization's identity provider after a successful login.
+//
+
+type Identity struct {
+	Subject string
+	Email   string
+	Name    string
+	Groups  []string
+}
+
+//
+// Access is what an identity is entitled to in the organization,
+// according to the group mappings in the SSO configuration.
+//
+// Role is empty when no mapping assigns one.
+// ManagedGroups holds every group referenced by a mapping:
+// membership in those groups is synced on each login,
+// while membership in any other group is left untouched.
+//
+
+type Access struct {
+	Role          string
+	Groups        []string
+	ManagedGroups []string
+}
+
+func LoginPath(organizationID string) string {
+	return fmt.Sprintf("/auth/sso/%s", organizationID)
+}
+
+func SAMLMetadataPath(organizationID string) string {
+	return fmt.Sprintf("/auth/sso/%s/saml/metadata", organizationID)
+}
+
+func SAMLACSPath(organizationID string) string {
+	return fmt.Sprintf("/auth/sso/%s/saml/acs", organizationID)
+}
+
+func OIDCCallbackPath(organizationID string) string {
+	return fmt.Sprintf("/auth/sso/%s/oidc/callback", organizationID)
+}
+
+//
+// Mappings are evaluated in order. Every matching mapping
+// adds its group, and the first matching mapping with a role wins.
+// IdP group names are compared case-insensitively,
+// since providers are not consistent about casing.
+//
+
+func ResolveAccess(mappings []models.SSOGroupMapping, idpGroups []string) *Access {
+	access := &Access{
+		Groups:        []string{},
+		ManagedGroups: []string{},
+	}
+
+	for _, mapping := range mappings {
+		if mapping.Group != "" && !slices.Contains(access.ManagedGroups, mapping.Group) {
+			access.ManagedGroups = append(access.ManagedGroups, mapping.Group)
+		}
+
+		if !containsFold(idpGroups, mapping.IdPGroup) {
+			continue
+		}
+
+		if mapping.Group != "" && !slices.Contains(access.Groups, mapping.Group) {
+			access.Groups = append(access.Groups, mapping.Group)
+		}
+
+		if mapping.Role != "" && access.Role == "" {
+			access.Role = mapping.Role
+		}
+	}
+
+	return access
+}
+
+func containsFold(values []string, value string) bool {
+	for _, v := range values {
+		if strings.EqualFold(v, value) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func attributeOrDefault(value, defaultValue string) string {
+	if value == "" {
+		return defaultValue
+	}
+
+	return value
+}
+
+func validateIdentity(identity *Identity) error {
+	if identity.Subject == "" {
+		return fmt.Errorf("identity provider did not return a subject")
+	}
+
+	if identity.Email == "" || !strings.Contains(identity.Email, "@") {
+		return fmt.Errorf("identity provider did not return a valid email")
+	}
+
+	if identity.Name == "" {
+		identity.Name = identity.Email
+	}
+
+	return nil
+}
@@ -0,0 +1,207 @@
+package sso
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/models"
+	"github.com/superplanehq/superplane/test/support"
+)
+
+const testBaseURL = "http://superplane.test"
+
+func Test__ResolveAccess(t *testing.T) {
+	mappings := []models.SSOGroupMapping{
+		{IdPGroup: "engineering", Group: "engineers"},
+		{IdPGroup: "platform", Group: "engineers", Role: models.RoleOrgAdmin},
+		{IdPGroup: "Admins", Role: models.RoleOrgAdmin},
+		{IdPGroup: "everyone", Role: models.RoleOrgViewer},
+		{IdPGroup: "sre", Group: "oncall"},
+	}
+
+	t.Run("matching groups are added and the first matching role wins", func(t *testing.T) {
+		access := ResolveAccess(mappings, []string{"everyone", "ADMINS", "engineering"})
+		assert.Equal(t, models.RoleOrgAdmin, access.Role)
+		assert.Equal(t, []string{"engineers"}, access.Groups)
+		assert.Equal(t, []string{"engineers", "oncall"}, access.ManagedGroups)
+	})
+
+	t.Run("no matching groups -> no role and no groups", func(t *testing.T) {
+		access := ResolveAccess(mappings, []string{"marketing"})
+		assert.Empty(t, access.Role)
+		assert.Empty(t, access.Groups)
+		assert.Equal(t, []string{"engineers", "oncall"}, access.ManagedGroups)
+	})
+}
+
+func Test__SAMLServiceProvider(t *testing.T) {
+	idp := support.NewTestIdentityProvider(t)
+	idp.SetUser(support.TestIdPUser{
+		Subject: "00u1",
+		Email:   "jane@example.com",
+		Name:    "Jane Doe",
+		Groups:  []string{"engineering", "everyone"},
+	})
+
+	metadata := idp.SAMLMetadata()
+	config := &models.OrganizationSSOConfig{
+		OrganizationID:  uuid.New(),
+		Protocol:        models.SSOProtocolSAML,
+		SAMLIdPMetadata: &metadata,
+	}
+
+	sp, err := NewSAMLServiceProvider(config, testBaseURL)
+	require.NoError(t, err)
+
+	spMetadata, err := sp.Metadata()
+	require.NoError(t, err)
+	require.NoError(t, idp.RegisterServiceProvider(spMetadata))
+
+	t.Run("valid response -> identity", func(t *testing.T) {
+		request, err := sp.NewAuthRequest()
+		require.NoError(t, err)
+
+		authURL, err := request.RedirectURL("relay-state")
+		require.NoError(t, err)
+
+		samlResponse, relayState, err := idp.SAMLLogin(authURL)
+		require.NoError(t, err)
+		assert.Equal(t, "relay-state", relayState)
+
+		identity, err := sp.ParseResponse(samlResponse, request.ID)
+		require.NoError(t, err)
+		assert.Equal(t, "00u1", identity.Subject)
+		assert.Equal(t, "jane@example.com", identity.Email)
+		assert.Equal(t, "Jane Doe", identity.Name)
+		assert.Equal(t, []string{"engineering", "everyone"}, identity.Groups)
+	})
+
+	t.Run("response to a different request -> error", func(t *testing.T) {
+		request, err := sp.NewAuthRequest()
+		require.NoError(t, err)
+
+		authURL, err := request.RedirectURL("relay-state")
+		require.NoError(t, err)
+
+		samlResponse, _, err := idp.SAMLLogin(authURL)
+		require.NoError(t, err)
+
+		_, err = sp.ParseResponse(samlResponse, "id-some-other-request")
+		require.Error(t, err)
+	})
+
+	t.Run("response signed by another identity provider -> error", func(t *testing.T) {
+		other := support.NewTestIdentityProvider(t)
+		other.SetUser(support.TestIdPUser{Subject: "00u1", Email: "jane@example.com"})
+		require.NoError(t, other.RegisterServiceProvider(spMetadata))
+
+		otherMetadata := other.SAMLMetadata()
+		otherSP, err := NewSAMLServiceProvider(&models.OrganizationSSOConfig{
+			OrganizationID:  config.OrganizationID,
+			SAMLIdPMetadata: &otherMetadata,
+		}, testBaseURL)
+		require.NoError(t, err)
+
+		request, err := otherSP.NewAuthRequest()
+		require.NoError(t, err)
+
+		authURL, err := request.RedirectURL("relay-state")
+		require.NoError(t, err)
+
+		samlResponse, _, err := other.SAMLLogin(authURL)
+		require.NoError(t, err)
+
+		_, err = sp.ParseResponse(samlResponse, request.ID)
+		require.Error(t, err)
+	})
+
+	t.Run("invalid metadata -> error", func(t *testing.T) {
+		invalid := "<EntityDescriptor/>"
+		_, err := NewSAMLServiceProvider(&models.OrganizationSSOConfig{
+			OrganizationID:  uuid.New(),
+			SAMLIdPMetadata: &invalid,
+		}, testBaseURL)
+		require.Error(t, err)
+	})
+}
+
+func Test__OIDCRelyingParty(t *testing.T) {
+	idp := support.NewTestIdentityProvider(t)
+	idp.SetUser(support.TestIdPUser{
+		Subject: "00u2",
+		Email:   "john@example.com",
+		Name:    "John Doe",
+		Groups:  []string{"sre"},
+	})
+
+	issuer := idp.Issuer()
+	clientID := support.TestIdPClientID
+	config := &models.OrganizationSSOConfig{
+		OrganizationID: uuid.New(),
+		Protocol:       models.SSOProtocolOIDC,
+		OIDCIssuerURL:  &issuer,
+		OIDCClientID:   &clientID,
+	}
+
+	rp, err := NewOIDCRelyingParty(context.Background(), config, support.TestIdPClientSecret, testBaseURL)
+	require.NoError(t, err)
+
+	authorize := func(t *testing.T, nonce string) url.Values {
+		client := &http.Client{
+			CheckRedirect: func(req *http.Request, via []*http.Request) error {
+				return http.ErrUseLastResponse
+			},
+		}
+
+		resp, err := client.Get(rp.AuthURL("state", nonce))
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		require.Equal(t, http.StatusFound, resp.StatusCode)
+
+		location, err := url.Parse(resp.Header.Get("Location"))
+		require.NoError(t, err)
+		assert.Equal(t, OIDCCallbackPath(config.OrganizationID.String()), location.Path)
+		return location.Query()
+	}
+
+	t.Run("valid code -> identity", func(t *testing.T) {
+		query := authorize(t, "nonce-1")
+		assert.Equal(t, "state", query.Get("state"))
+
+		identity, err := rp.Exchange(context.Background(), query.Get("code"), "nonce-1")
+		require.NoError(t, err)
+		assert.Equal(t, "00u2", identity.Subject)
+		assert.Equal(t, "john@example.com", identity.Email)
+		assert.Equal(t, "John Doe", identity.Name)
+		assert.Equal(t, []string{"sre"}, identity.Groups)
+	})
+
+	t.Run("nonce mismatch -> error", func(t *testing.T) {
+		query := authorize(t, "nonce-2")
+		_, err := rp.Exchange(context.Background(), query.Get("code"), "another-nonce")
+		require.ErrorContains(t, err, "nonce")
+	})
+
+	t.Run("code reuse -> error", func(t *testing.T) {
+		query := authorize(t, "nonce-3")
+		_, err := rp.Exchange(context.Background(), query.Get("code"), "nonce-3")
+		require.NoError(t, err)
+
+		_, err = rp.Exchange(context.Background(), query.Get("code"), "nonce-3")
+		require.Error(t, err)
+	})
+
+	t.Run("wrong client secret -> error", func(t *testing.T) {
+		other, err := NewOIDCRelyingParty(context.Background(), config, "wrong", testBaseURL)
+		require.NoError(t, err)
+
+		query := authorize(t, "nonce-4")
+		_, err = other.Exchange(context.Background(), query.Get("code"), "nonce-4")
+		require.Error(t, err)
+	})
+}
@@ -9,7 +9,6 @@ import (
 	"time"
 
 	log "github.com/sirupsen/logrus"
-	"github.com/superplanehq/superplane/pkg/models"
 )
 
 const defaultBeaconURL = "https://analytics.superplane.com/beacon"
@@ -19,22 +18,27 @@ type beaconPayload struct {
 	InstallationID   string `json:"installation_id"`
 }
 
-func StartBeacon() {
+//
+// The installation ID is loaded by the caller,
+// since the models record their traces through this package.
+//
+
+func StartBeacon(installationID func() (string, error)) {
 	if !isBeaconEnabled() {
 		return
 	}
 
-	go beaconSender()
+	go beaconSender(installationID)
 }
 
-func beaconSender() {
-	sendBeacon()
+func beaconSender(installationID func() (string, error)) {
+	sendBeacon(installationID)
 
 	ticker := time.NewTicker(time.Hour)
 	go func() {
 		defer ticker.Stop()
 		for range ticker.C {
-			sendBeacon()
+			sendBeacon(installationID)
 		}
 	}()
 }
@@ -43,7 +47,7 @@ func isBeaconEnabled() bool {
 	return os.Getenv("SUPERPLANE_BEACON_ENABLED") == "yes"
 }
 
-func sendBeacon() {
+func sendBeacon(installationID func() (string, error)) {
 	client := &http.Client{Timeout: 5 * time.Second}
 
 	installationType := os.Getenv("SUPERPLANE_INSTALLATION_TYPE")
@@ -52,7 +56,7 @@ func sendBeacon() {
 		return
 	}
 
-	installationID, err := models.GetInstallationID()
+	ID, err := installationID()
 	if err != nil {
 		log.WithError(err).Warn("Failed to load installation ID")
 		return
@@ -60,7 +64,7 @@ func sendBeacon() {
 
 	payload := beaconPayload{
 		InstallationType: installationType,
-		InstallationID:   installationID,
+		InstallationID:   ID,
 	}
 
 	body, err := json.Marshal(payload)
@@ -2,13 +2,18 @@ package telemetry
 
 import (
 	"context"
+	"net/http"
 	"sync/atomic"
 	"time"
 
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"github.com/superplanehq/superplane/pkg/metrics"
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/exporters/otlp/otlpmetric/otlpmetricgrpc"
 	"go.opentelemetry.io/otel/metric"
 
+	otelprometheus "go.opentelemetry.io/otel/exporters/prometheus"
 	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
 )
 
@@ -34,23 +39,60 @@ var (
 
 	dbLocksCountHistogram       metric.Int64Histogram
 	dbLongQueriesCountHistogram metric.Int64Histogram
+
+	prometheusHandler http.Handler
 )
 
-func InitMetrics(ctx context.Context) error {
-	exporter, err := otlpmetricgrpc.New(ctx)
-	if err != nil {
-		return err
+type MetricsOptions struct {
+	//
+	// Push metrics to the OTLP collector
+	// configured through the OTEL_EXPORTER_OTLP_* variables.
+	//
+	OTLP bool
+
+	//
+	// Expose metrics for Prometheus to scrape, through PrometheusHandler().
+	//
+	Prometheus bool
+
+	//
+	// Cardinality controls for the canvas execution metrics.
+	//
+	Business metrics.Options
+}
+
+func InitMetrics(ctx context.Context, options MetricsOptions) error {
+	readers := []sdkmetric.Option{}
+	if options.OTLP {
+		exporter, err := otlpmetricgrpc.New(ctx)
+		if err != nil {
+			return err
+		}
+
+		readers = append(readers, sdkmetric.WithReader(sdkmetric.NewPeriodicReader(exporter)))
 	}
 
-	provider := sdkmetric.NewMeterProvider(
-		sdkmetric.WithReader(
-			sdkmetric.NewPeriodicReader(exporter),
-		),
-	)
+	if options.Prometheus {
+		registry := prometheus.NewRegistry()
+		exporter, err := otelprometheus.New(otelprometheus.WithRegisterer(registry))
+		if err != nil {
+			return err
+		}
+
+		readers = append(readers, sdkmetric.WithReader(exporter))
+		prometheusHandler = promhttp.HandlerFor(registry, promhttp.HandlerOpts{})
+	}
+
+	provider := sdkmetric.NewMeterProvider(readers...)
 
 	otel.SetMeterProvider(provider)
 	meter = provider.Meter("superplane")
 
+	err := metrics.Init(meter, options.Business)
+	if err != nil {
+		return err
+	}
+
 	queueWorkerTickHistogram, err = meter.Float64Histogram(
 		"queue_worker.tick.duration.seconds",
 		metric.WithDescription("Duration of each WorkflowNodeQueueWorker tick"),
@@ -175,6 +217,15 @@ func InitMetrics(ctx context.Context) error {
 	return nil
 }
 
+//
+// PrometheusHandler serves the /metrics endpoint.
+// It is nil if metrics were not initialized with Prometheus enabled.
+//
+
+func PrometheusHandler() http.Handler {
+	return prometheusHandler
+}
+
 func StartPeriodicMetricsReporter() {
 	p := NewPeriodic(context.Background())
 	p.Start()
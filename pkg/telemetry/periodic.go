@@ -50,7 +50,7 @@ func (p *Periodic) reportDatabaseLocks() {
 }
 
 func (p *Periodic) reportStuckQueueItems() {
-	count, err := countStuckQueueNodes()
+	count, err := CountStuckQueueNodes()
 	if err != nil {
 		return
 	}
@@ -75,7 +75,12 @@ func (p *Periodic) reportLongQueries() {
 	RecordDBLongQueriesCount(p.ctx, count)
 }
 
-func countStuckQueueNodes() (int64, error) {
+//
+// CountStuckQueueNodes counts the nodes with queued items
+// and no execution that would pick them up.
+//
+
+func CountStuckQueueNodes() (int64, error) {
 	db := database.Conn()
 
 	var count int64
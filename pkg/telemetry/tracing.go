@@ -0,0 +1,109 @@
+package telemetry
+
+import (
+	"context"
+	"sync/atomic"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+var (
+	tracer         = otel.Tracer("superplane")
+	tracingEnabled atomic.Bool
+
+	//
+	// Trace context is persisted and forwarded in the W3C traceparent format,
+	// independently of the propagator configured globally.
+	//
+	propagator = propagation.TraceContext{}
+)
+
+func InitTracing(ctx context.Context) error {
+	exporter, err := otlptracegrpc.New(ctx)
+	if err != nil {
+		return err
+	}
+
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+	)
+
+	otel.SetTracerProvider(provider)
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(
+		propagation.TraceContext{},
+		propagation.Baggage{},
+	))
+
+	tracer = provider.Tracer("superplane")
+	tracingEnabled.Store(true)
+	return nil
+}
+
+func TracingEnabled() bool {
+	return tracingEnabled.Load()
+}
+
+func StartSpan(ctx context.Context, name string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	return tracer.Start(ctx, name, trace.WithAttributes(attrs...))
+}
+
+//
+// StartTrace records the root span of a new trace, and returns
+// its traceparent, so the spans for the work that happens later,
+// possibly on other workers, can be attached to the same trace.
+//
+
+func StartTrace(name string, attrs ...attribute.KeyValue) *string {
+	_, span := tracer.Start(context.Background(), name, trace.WithNewRoot(), trace.WithAttributes(attrs...))
+	defer span.End()
+
+	return TraceParent(trace.ContextWithSpan(context.Background(), span))
+}
+
+//
+// RecordSpan records a span that started in the past and ends now,
+// like the time an item spent waiting in a node queue.
+//
+
+func RecordSpan(ctx context.Context, name string, start time.Time, attrs ...attribute.KeyValue) {
+	_, span := tracer.Start(ctx, name, trace.WithTimestamp(start), trace.WithAttributes(attrs...))
+	span.End()
+}
+
+func TraceParent(ctx context.Context) *string {
+	carrier := propagation.MapCarrier{}
+	propagator.Inject(ctx, carrier)
+
+	traceParent, ok := carrier["traceparent"]
+	if !ok {
+		return nil
+	}
+
+	return &traceParent
+}
+
+func ContextFromTraceParent(traceParent *string) context.Context {
+	if traceParent == nil || *traceParent == "" {
+		return context.Background()
+	}
+
+	carrier := propagation.MapCarrier{"traceparent": *traceParent}
+	return propagator.Extract(context.Background(), carrier)
+}
+
+func InjectTraceContext(ctx context.Context) map[string]string {
+	carrier := propagation.MapCarrier{}
+	propagator.Inject(ctx, carrier)
+	return carrier
+}
+
+func ExtractTraceContext(ctx context.Context, values map[string]string) context.Context {
+	return propagator.Extract(ctx, propagation.MapCarrier(values))
+}
@@ -0,0 +1,93 @@
+package telemetry
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/trace"
+
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func useRecorder(t *testing.T) *tracetest.SpanRecorder {
+	recorder := tracetest.NewSpanRecorder()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+
+	previous := tracer
+	tracer = provider.Tracer("test")
+	t.Cleanup(func() { tracer = previous })
+
+	return recorder
+}
+
+func Test__StartTrace(t *testing.T) {
+	recorder := useRecorder(t)
+
+	traceParent := StartTrace("canvas.event")
+	require.NotNil(t, traceParent)
+
+	spans := recorder.Ended()
+	require.Len(t, spans, 1)
+	assert.Equal(t, "canvas.event", spans[0].Name())
+	assert.False(t, spans[0].Parent().IsValid())
+
+	spanContext := trace.SpanContextFromContext(ContextFromTraceParent(traceParent))
+	assert.Equal(t, spans[0].SpanContext().TraceID(), spanContext.TraceID())
+	assert.Equal(t, spans[0].SpanContext().SpanID(), spanContext.SpanID())
+}
+
+func Test__ContextFromTraceParent(t *testing.T) {
+	t.Run("no trace parent -> empty context", func(t *testing.T) {
+		assert.False(t, trace.SpanContextFromContext(ContextFromTraceParent(nil)).IsValid())
+
+		empty := ""
+		assert.False(t, trace.SpanContextFromContext(ContextFromTraceParent(&empty)).IsValid())
+	})
+
+	t.Run("invalid trace parent -> empty context", func(t *testing.T) {
+		invalid := "not-a-trace-parent"
+		assert.False(t, trace.SpanContextFromContext(ContextFromTraceParent(&invalid)).IsValid())
+	})
+
+	t.Run("round trip", func(t *testing.T) {
+		traceParent := "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01"
+		ctx := ContextFromTraceParent(&traceParent)
+		assert.Equal(t, traceParent, *TraceParent(ctx))
+	})
+}
+
+func Test__RecordSpan(t *testing.T) {
+	recorder := useRecorder(t)
+
+	traceParent := StartTrace("canvas.event")
+	start := time.Now().Add(-time.Minute)
+	RecordSpan(ContextFromTraceParent(traceParent), "canvas.queue_item", start)
+
+	spans := recorder.Ended()
+	require.Len(t, spans, 2)
+
+	root := spans[0]
+	queued := spans[1]
+	assert.Equal(t, "canvas.queue_item", queued.Name())
+	assert.Equal(t, root.SpanContext().TraceID(), queued.SpanContext().TraceID())
+	assert.Equal(t, root.SpanContext().SpanID(), queued.Parent().SpanID())
+	assert.True(t, queued.StartTime().Equal(start))
+}
+
+func Test__InjectAndExtract(t *testing.T) {
+	useRecorder(t)
+
+	ctx, span := StartSpan(context.Background(), "canvas.node_execution")
+	defer span.End()
+
+	values := InjectTraceContext(ctx)
+	require.Contains(t, values, "traceparent")
+
+	extracted := trace.SpanContextFromContext(ExtractTraceContext(context.Background(), values))
+	assert.Equal(t, span.SpanContext().TraceID(), extracted.TraceID())
+	assert.Equal(t, span.SpanContext().SpanID(), extracted.SpanID())
+}
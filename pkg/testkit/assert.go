@@ -0,0 +1,290 @@
+package testkit
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/core"
+)
+
+//
+// AssertEmitted checks that the execution passed,
+// emitting payloads of a type on a channel, and returns them.
+//
+
+func AssertEmitted(t testing.TB, state *ExecutionState, channel, payloadType string) []any {
+	t.Helper()
+
+	output := findOutput(state, channel)
+	require.NotNil(t, output, "nothing emitted on channel %q, outputs: %s", channel, describeOutputs(state))
+	require.Equal(t, payloadType, output.Type, "unexpected payload type on channel %q", channel)
+	return output.Payloads
+}
+
+//
+// AssertPayload checks that the execution emitted a single payload on a channel,
+// and returns it decoded as JSON, so structs can be compared with maps.
+//
+
+func AssertPayload(t testing.TB, state *ExecutionState, channel string) map[string]any {
+	t.Helper()
+
+	output := findOutput(state, channel)
+	require.NotNil(t, output, "nothing emitted on channel %q, outputs: %s", channel, describeOutputs(state))
+	require.Len(t, output.Payloads, 1, "expected a single payload on channel %q", channel)
+
+	payload, ok := normalize(output.Payloads[0]).(map[string]any)
+	require.True(t, ok, "payload on channel %q is not an object", channel)
+	return payload
+}
+
+func AssertPassed(t testing.TB, state *ExecutionState) {
+	t.Helper()
+
+	require.True(t, state.IsFinished(), "execution is not finished")
+	require.True(t, state.Passed, "execution failed: %s - %s", state.FailureReason, state.FailureMessage)
+}
+
+func AssertFailed(t testing.TB, state *ExecutionState, reason string) {
+	t.Helper()
+
+	require.True(t, state.IsFinished(), "execution is not finished")
+	require.False(t, state.Passed, "execution passed, outputs: %s", describeOutputs(state))
+	require.Equal(t, reason, state.FailureReason)
+}
+
+func AssertNotFinished(t testing.TB, state *ExecutionState) {
+	t.Helper()
+	require.False(t, state.IsFinished(), "execution finished, outputs: %s", describeOutputs(state))
+}
+
+//
+// AssertEvent checks that a single event of a type was emitted,
+// and returns its payload.
+//
+
+func AssertEvent(t testing.TB, events *Events, payloadType string) any {
+	t.Helper()
+
+	events.mu.Lock()
+	defer events.mu.Unlock()
+
+	found := []Event{}
+	for _, event := range events.Emitted {
+		if event.Type == payloadType {
+			found = append(found, event)
+		}
+	}
+
+	require.Len(t, found, 1, "expected one %q event, got %d of %d events", payloadType, len(found), len(events.Emitted))
+	return found[0].Payload
+}
+
+func AssertNoEvents(t testing.TB, events *Events) {
+	t.Helper()
+
+	events.mu.Lock()
+	defer events.mu.Unlock()
+	require.Empty(t, events.Emitted, "expected no events")
+}
+
+//
+// AssertAllFixturesUsed checks that every request a test expects
+// was actually made by the implementation.
+//
+
+func AssertAllFixturesUsed(t testing.TB, http *HTTP) {
+	t.Helper()
+	require.Empty(t, http.Unused(), "some fixtures were not used")
+}
+
+//
+// ValidateExampleOutput checks that the example output of a component
+// matches what it emitted in a real execution: same payload type,
+// and every field of the example present in the payload, with the same kind of value.
+// Payloads can have more fields than the example.
+//
+
+func ValidateExampleOutput(t testing.TB, component core.Component, state *ExecutionState) {
+	t.Helper()
+
+	example := component.ExampleOutput()
+	require.NotNil(t, example, "%s has no example output", component.Name())
+
+	state.mu.Lock()
+	outputs := append([]Output{}, state.Outputs...)
+	state.mu.Unlock()
+
+	require.NotEmpty(t, outputs, "%s emitted nothing", component.Name())
+	require.NotEmpty(t, outputs[0].Payloads, "%s emitted no payloads", component.Name())
+	require.Equal(t, example["type"], outputs[0].Type, "example output type of %s", component.Name())
+
+	err := MatchShape(example["data"], outputs[0].Payloads[0])
+	require.NoError(t, err, "example output of %s doesn't match the emitted payload", component.Name())
+}
+
+//
+// ValidateExampleData does the same as ValidateExampleOutput,
+// for the example data of a trigger and the first event it emitted.
+//
+
+func ValidateExampleData(t testing.TB, trigger core.Trigger, events *Events) {
+	t.Helper()
+
+	example := trigger.ExampleData()
+	require.NotNil(t, example, "%s has no example data", trigger.Name())
+
+	events.mu.Lock()
+	emitted := append([]Event{}, events.Emitted...)
+	events.mu.Unlock()
+
+	require.NotEmpty(t, emitted, "%s emitted no events", trigger.Name())
+
+	//
+	// Example data is wrapped like the emitted events, with type, data and timestamp,
+	// but some triggers only have the data.
+	//
+	data, wrapped := example["data"]
+	if !wrapped {
+		err := MatchShape(example, emitted[0].Payload)
+		require.NoError(t, err, "example data of %s doesn't match the emitted event", trigger.Name())
+		return
+	}
+
+	if exampleType, ok := example["type"]; ok {
+		require.Equal(t, exampleType, emitted[0].Type, "example data type of %s", trigger.Name())
+	}
+
+	err := MatchShape(data, emitted[0].Payload)
+	require.NoError(t, err, "example data of %s doesn't match the emitted event", trigger.Name())
+}
+
+//
+// MatchShape checks that every field in the expected value exists in the actual one,
+// with the same kind of value. Both are compared as JSON, so structs can be used.
+// Null values in the expected value match anything, and lists are
+// compared by their first element.
+//
+
+func MatchShape(expected, actual any) error {
+	return matchShape("$", normalize(expected), normalize(actual))
+}
+
+func matchShape(path string, expected, actual any) error {
+	if expected == nil {
+		return nil
+	}
+
+	switch e := expected.(type) {
+	case map[string]any:
+		a, ok := actual.(map[string]any)
+		if !ok {
+			return fmt.Errorf("%s: expected an object, got %s", path, kind(actual))
+		}
+
+		keys := make([]string, 0, len(e))
+		for key := range e {
+			keys = append(keys, key)
+		}
+
+		sort.Strings(keys)
+		for _, key := range keys {
+			value, ok := a[key]
+			if !ok {
+				return fmt.Errorf("%s.%s: missing", path, key)
+			}
+
+			err := matchShape(path+"."+key, e[key], value)
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+
+	case []any:
+		a, ok := actual.([]any)
+		if !ok {
+			return fmt.Errorf("%s: expected a list, got %s", path, kind(actual))
+		}
+
+		if len(e) == 0 || len(a) == 0 {
+			return nil
+		}
+
+		return matchShape(path+"[0]", e[0], a[0])
+
+	default:
+		if kind(expected) != kind(actual) {
+			return fmt.Errorf("%s: expected %s, got %s", path, kind(expected), kind(actual))
+		}
+
+		return nil
+	}
+}
+
+func kind(value any) string {
+	switch value.(type) {
+	case nil:
+		return "null"
+	case map[string]any:
+		return "an object"
+	case []any:
+		return "a list"
+	case string:
+		return "a string"
+	case float64:
+		return "a number"
+	case bool:
+		return "a boolean"
+	default:
+		return fmt.Sprintf("%T", value)
+	}
+}
+
+func normalize(value any) any {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return value
+	}
+
+	var normalized any
+	err = json.Unmarshal(data, &normalized)
+	if err != nil {
+		return value
+	}
+
+	return normalized
+}
+
+func findOutput(state *ExecutionState, channel string) *Output {
+	state.mu.Lock()
+	defer state.mu.Unlock()
+
+	for _, output := range state.Outputs {
+		if output.Channel == channel {
+			return &output
+		}
+	}
+
+	return nil
+}
+
+func describeOutputs(state *ExecutionState) string {
+	state.mu.Lock()
+	defer state.mu.Unlock()
+
+	if len(state.Outputs) == 0 {
+		return "none"
+	}
+
+	channels := []string{}
+	for _, output := range state.Outputs {
+		channels = append(channels, fmt.Sprintf("%s (%s)", output.Channel, output.Type))
+	}
+
+	return fmt.Sprintf("%v", channels)
+}
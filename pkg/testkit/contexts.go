@@ -0,0 +1,568 @@
+package testkit
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/superplanehq/superplane/pkg/core"
+)
+
+//
+// Recording fakes for the core contexts.
+// They keep everything the implementation does with them,
+// so tests can assert on it, and are safe for concurrent use.
+//
+
+type Metadata struct {
+	mu    sync.Mutex
+	Value any
+}
+
+func (m *Metadata) Get() any {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.Value
+}
+
+func (m *Metadata) Set(value any) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.Value = value
+	return nil
+}
+
+type Output struct {
+	Channel  string
+	Type     string
+	Payloads []any
+}
+
+type ExecutionState struct {
+	mu             sync.Mutex
+	Finished       bool
+	Passed         bool
+	FailureReason  string
+	FailureMessage string
+	KVs            map[string]string
+	Outputs        []Output
+}
+
+func (s *ExecutionState) IsFinished() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.Finished
+}
+
+func (s *ExecutionState) SetKV(key, value string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.KVs == nil {
+		s.KVs = map[string]string{}
+	}
+
+	s.KVs[key] = value
+	return nil
+}
+
+func (s *ExecutionState) Emit(channel, payloadType string, payloads []any) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.Finished {
+		return fmt.Errorf("execution already finished")
+	}
+
+	s.Finished = true
+	s.Passed = true
+	s.Outputs = append(s.Outputs, Output{Channel: channel, Type: payloadType, Payloads: payloads})
+	return nil
+}
+
+func (s *ExecutionState) Pass() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.Finished {
+		return fmt.Errorf("execution already finished")
+	}
+
+	s.Finished = true
+	s.Passed = true
+	return nil
+}
+
+func (s *ExecutionState) Fail(reason, message string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.Finished {
+		return fmt.Errorf("execution already finished")
+	}
+
+	s.Finished = true
+	s.Passed = false
+	s.FailureReason = reason
+	s.FailureMessage = message
+	return nil
+}
+
+type Event struct {
+	Type    string
+	Payload any
+}
+
+type Events struct {
+	mu      sync.Mutex
+	Emitted []Event
+}
+
+func (e *Events) Emit(payloadType string, payload any) error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.Emitted = append(e.Emitted, Event{Type: payloadType, Payload: payload})
+	return nil
+}
+
+type ActionCall struct {
+	Name       string
+	Parameters map[string]any
+	Interval   time.Duration
+}
+
+type Requests struct {
+	mu    sync.Mutex
+	Calls []ActionCall
+}
+
+func (r *Requests) ScheduleActionCall(name string, parameters map[string]any, interval time.Duration) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.Calls = append(r.Calls, ActionCall{Name: name, Parameters: parameters, Interval: interval})
+	return nil
+}
+
+type Logs struct {
+	mu          sync.Mutex
+	StdoutLines []string
+	StderrLines []string
+}
+
+func (l *Logs) Stdout(message string) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.StdoutLines = append(l.StdoutLines, message)
+	return nil
+}
+
+func (l *Logs) Stderr(message string) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.StderrLines = append(l.StderrLines, message)
+	return nil
+}
+
+type StoredArtifact struct {
+	core.Artifact
+	Content []byte
+}
+
+type Artifacts struct {
+	mu     sync.Mutex
+	Stored map[string]StoredArtifact
+}
+
+func (a *Artifacts) Put(name string, contentType string, content []byte) (*core.Artifact, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	checksum := sha256.Sum256(content)
+	artifact := core.Artifact{
+		Name:        name,
+		ContentType: contentType,
+		Size:        int64(len(content)),
+		SHA256:      hex.EncodeToString(checksum[:]),
+	}
+
+	if a.Stored == nil {
+		a.Stored = map[string]StoredArtifact{}
+	}
+
+	a.Stored[name] = StoredArtifact{Artifact: artifact, Content: content}
+	return &artifact, nil
+}
+
+type Auth struct {
+	User   *core.User
+	Users  []core.User
+	Roles  []string
+	Groups []string
+}
+
+func (a *Auth) AuthenticatedUser() *core.User {
+	return a.User
+}
+
+func (a *Auth) GetUser(id uuid.UUID) (*core.User, error) {
+	for _, user := range a.Users {
+		if user.ID == id.String() {
+			return &user, nil
+		}
+	}
+
+	return nil, fmt.Errorf("user not found: %s", id)
+}
+
+func (a *Auth) HasRole(role string) (bool, error) {
+	if a.User == nil {
+		return false, fmt.Errorf("user not authenticated")
+	}
+
+	return contains(a.Roles, role), nil
+}
+
+func (a *Auth) InGroup(group string) (bool, error) {
+	if a.User == nil {
+		return false, fmt.Errorf("user not authenticated")
+	}
+
+	return contains(a.Groups, group), nil
+}
+
+type Notification struct {
+	Title     string
+	Body      string
+	URL       string
+	URLLabel  string
+	Receivers core.NotificationReceivers
+}
+
+type Notifications struct {
+	mu   sync.Mutex
+	Sent []Notification
+}
+
+func (n *Notifications) Send(title, body, url, urlLabel string, receivers core.NotificationReceivers) error {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.Sent = append(n.Sent, Notification{Title: title, Body: body, URL: url, URLLabel: urlLabel, Receivers: receivers})
+	return nil
+}
+
+//
+// Secrets are keyed by secret name, then key name.
+//
+
+type Secrets struct {
+	Values map[string]map[string]string
+}
+
+func (s *Secrets) GetKey(secretName, keyName string) ([]byte, error) {
+	secret, ok := s.Values[secretName]
+	if !ok {
+		return nil, fmt.Errorf("secret %s not found", secretName)
+	}
+
+	value, ok := secret[keyName]
+	if !ok {
+		return nil, fmt.Errorf("key %s not found in secret %s", keyName, secretName)
+	}
+
+	return []byte(value), nil
+}
+
+//
+// IntegrationResources are keyed by integration ID, then resource type.
+//
+
+type IntegrationResources struct {
+	IDs map[string]map[string][]string
+}
+
+func (r *IntegrationResources) ListResourceIDs(integrationID, resourceType string) ([]string, error) {
+	resources, ok := r.IDs[integrationID]
+	if !ok {
+		return nil, fmt.Errorf("integration %s not found", integrationID)
+	}
+
+	return resources[resourceType], nil
+}
+
+//
+// Members are the IDs of the active users of the organization.
+//
+
+type Members struct {
+	UserIDs []string
+}
+
+func (m *Members) IsActiveMember(userID string) (bool, error) {
+	return slices.Contains(m.UserIDs, userID), nil
+}
+
+type CanvasMemory struct {
+	mu     sync.Mutex
+	Values map[string][]any
+}
+
+func (c *CanvasMemory) Add(namespace string, values any) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.Values == nil {
+		c.Values = map[string][]any{}
+	}
+
+	c.Values[namespace] = append(c.Values[namespace], values)
+	return nil
+}
+
+type NodeWebhook struct {
+	mu         sync.Mutex
+	URL        string
+	Secret     []byte
+	BaseURL    string
+	SetupCalls int
+}
+
+func (w *NodeWebhook) Setup() (string, error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	w.SetupCalls++
+	if w.URL == "" {
+		w.URL = w.GetBaseURL() + "/webhooks/" + uuid.NewString()
+	}
+
+	return w.URL, nil
+}
+
+func (w *NodeWebhook) GetSecret() ([]byte, error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return w.Secret, nil
+}
+
+func (w *NodeWebhook) SetSecret(secret []byte) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.Secret = secret
+	return nil
+}
+
+func (w *NodeWebhook) ResetSecret() ([]byte, []byte, error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	old := w.Secret
+	w.Secret = []byte(uuid.NewString())
+	return w.Secret, old, nil
+}
+
+func (w *NodeWebhook) GetBaseURL() string {
+	if w.BaseURL == "" {
+		return "http://localhost:8000/api/v1"
+	}
+
+	return w.BaseURL
+}
+
+type IntegrationActionCall struct {
+	Name       string
+	Parameters any
+	Interval   time.Duration
+}
+
+type Subscription struct {
+	mu       sync.Mutex
+	ID       uuid.UUID
+	Config   any
+	Messages []any
+}
+
+func (s *Subscription) Configuration() any {
+	return s.Config
+}
+
+func (s *Subscription) SendMessage(message any) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.Messages = append(s.Messages, message)
+	return nil
+}
+
+//
+// Integration configuration values are returned by GetConfig as they are,
+// so sensitive values should be set in plain text.
+// Only string values are supported, like in the real context.
+//
+
+type Integration struct {
+	mu               sync.Mutex
+	IntegrationID    uuid.UUID
+	Configuration    map[string]any
+	Metadata         any
+	State            string
+	StateDescription string
+	BrowserAction    *core.BrowserAction
+	Secrets          []core.IntegrationSecret
+	WebhookRequests  []any
+	ResyncIntervals  []time.Duration
+	ActionCalls      []IntegrationActionCall
+	Subscriptions    []*Subscription
+}
+
+func (i *Integration) ID() uuid.UUID {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	if i.IntegrationID == uuid.Nil {
+		i.IntegrationID = uuid.New()
+	}
+
+	return i.IntegrationID
+}
+
+func (i *Integration) GetMetadata() any {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	return i.Metadata
+}
+
+func (i *Integration) SetMetadata(metadata any) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	i.Metadata = metadata
+}
+
+func (i *Integration) GetConfig(name string) ([]byte, error) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	value, ok := i.Configuration[name]
+	if !ok {
+		return nil, fmt.Errorf("config not found: %s", name)
+	}
+
+	s, ok := value.(string)
+	if !ok {
+		return nil, fmt.Errorf("config is not a string: %s", name)
+	}
+
+	return []byte(s), nil
+}
+
+func (i *Integration) Ready() {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	i.State = "ready"
+	i.StateDescription = ""
+}
+
+func (i *Integration) Error(message string) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	i.State = "error"
+	i.StateDescription = message
+}
+
+func (i *Integration) NewBrowserAction(action core.BrowserAction) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	i.BrowserAction = &action
+}
+
+func (i *Integration) RemoveBrowserAction() {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	i.BrowserAction = nil
+}
+
+func (i *Integration) SetSecret(name string, value []byte) error {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	for index, secret := range i.Secrets {
+		if secret.Name == name {
+			i.Secrets[index].Value = value
+			return nil
+		}
+	}
+
+	i.Secrets = append(i.Secrets, core.IntegrationSecret{Name: name, Value: value})
+	return nil
+}
+
+func (i *Integration) GetSecrets() ([]core.IntegrationSecret, error) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	return append([]core.IntegrationSecret{}, i.Secrets...), nil
+}
+
+func (i *Integration) RequestWebhook(configuration any) error {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	i.WebhookRequests = append(i.WebhookRequests, configuration)
+	return nil
+}
+
+func (i *Integration) Subscribe(configuration any) (*uuid.UUID, error) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	subscription := &Subscription{ID: uuid.New(), Config: configuration}
+	i.Subscriptions = append(i.Subscriptions, subscription)
+	return &subscription.ID, nil
+}
+
+func (i *Integration) ScheduleResync(interval time.Duration) error {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	i.ResyncIntervals = append(i.ResyncIntervals, interval)
+	return nil
+}
+
+func (i *Integration) ScheduleActionCall(name string, parameters any, interval time.Duration) error {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	i.ActionCalls = append(i.ActionCalls, IntegrationActionCall{Name: name, Parameters: parameters, Interval: interval})
+	return nil
+}
+
+func (i *Integration) ListSubscriptions() ([]core.IntegrationSubscriptionContext, error) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	subscriptions := make([]core.IntegrationSubscriptionContext, 0, len(i.Subscriptions))
+	for _, subscription := range i.Subscriptions {
+		subscriptions = append(subscriptions, subscription)
+	}
+
+	return subscriptions, nil
+}
+
+func (i *Integration) FindSubscription(predicate func(core.IntegrationSubscriptionContext) bool) (core.IntegrationSubscriptionContext, error) {
+	subscriptions, _ := i.ListSubscriptions()
+	for _, subscription := range subscriptions {
+		if predicate(subscription) {
+			return subscription, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,313 @@
+package testkit
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/ghodss/yaml"
+	"github.com/superplanehq/superplane/pkg/core"
+)
+
+//
+// Fixture is a canned response for requests matching a method and URL.
+//
+// URLs starting with "/" are matched against the path of the request,
+// and also against its query, if they have one. Other URLs must match exactly.
+// An empty method matches any method.
+//
+// Each fixture answers a single request, unless Repeat is set.
+// A string body is sent as is, anything else is sent as JSON.
+//
+
+type Fixture struct {
+	Method  string            `json:"method,omitempty"`
+	URL     string            `json:"url"`
+	Status  int               `json:"status,omitempty"`
+	Headers map[string]string `json:"headers,omitempty"`
+	Body    any               `json:"body,omitempty"`
+	Repeat  bool              `json:"repeat,omitempty"`
+}
+
+type RecordedRequest struct {
+	Method string
+	URL    string
+	Header http.Header
+	Body   []byte
+}
+
+//
+// JSON decodes the body of the request.
+//
+
+func (r RecordedRequest) JSON() (map[string]any, error) {
+	body := map[string]any{}
+	err := json.Unmarshal(r.Body, &body)
+	if err != nil {
+		return nil, err
+	}
+
+	return body, nil
+}
+
+//
+// HTTP is a core.HTTPContext replaying fixtures.
+// Requests without a matching fixture fail,
+// so tests never reach real services by accident.
+//
+
+type HTTP struct {
+	mu       sync.Mutex
+	Fixtures []Fixture
+	Requests []RecordedRequest
+	used     []bool
+}
+
+func NewHTTP(fixtures ...Fixture) *HTTP {
+	return &HTTP{Fixtures: fixtures}
+}
+
+//
+// LoadFixtures reads fixtures from a YAML or JSON file,
+// like the ones saved by a Recorder.
+//
+
+func LoadFixtures(path string) ([]Fixture, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	fixtures := []Fixture{}
+	err = yaml.Unmarshal(data, &fixtures)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing %s: %w", path, err)
+	}
+
+	return fixtures, nil
+}
+
+func (h *HTTP) Add(fixtures ...Fixture) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.Fixtures = append(h.Fixtures, fixtures...)
+}
+
+func (h *HTTP) Do(request *http.Request) (*http.Response, error) {
+	recorded, err := record(request)
+	if err != nil {
+		return nil, err
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.Requests = append(h.Requests, recorded)
+	for len(h.used) < len(h.Fixtures) {
+		h.used = append(h.used, false)
+	}
+
+	for i, fixture := range h.Fixtures {
+		if h.used[i] || !fixture.matches(request) {
+			continue
+		}
+
+		if !fixture.Repeat {
+			h.used[i] = true
+		}
+
+		return fixture.response(request)
+	}
+
+	return nil, fmt.Errorf("no fixture for %s %s", request.Method, request.URL.String())
+}
+
+//
+// Unused returns the fixtures that were not used by any request.
+// Repeated fixtures are never considered unused.
+//
+
+func (h *HTTP) Unused() []Fixture {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	unused := []Fixture{}
+	for i, fixture := range h.Fixtures {
+		if fixture.Repeat || (i < len(h.used) && h.used[i]) {
+			continue
+		}
+
+		unused = append(unused, fixture)
+	}
+
+	return unused
+}
+
+func (f *Fixture) matches(request *http.Request) bool {
+	if f.Method != "" && !strings.EqualFold(f.Method, request.Method) {
+		return false
+	}
+
+	if !strings.HasPrefix(f.URL, "/") {
+		return f.URL == request.URL.String()
+	}
+
+	if strings.Contains(f.URL, "?") {
+		return f.URL == request.URL.RequestURI()
+	}
+
+	return f.URL == request.URL.Path
+}
+
+func (f *Fixture) response(request *http.Request) (*http.Response, error) {
+	body, contentType, err := encodeBody(f.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	status := f.Status
+	if status == 0 {
+		status = http.StatusOK
+	}
+
+	header := http.Header{}
+	if contentType != "" {
+		header.Set("Content-Type", contentType)
+	}
+
+	for name, value := range f.Headers {
+		header.Set(name, value)
+	}
+
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", status, http.StatusText(status)),
+		StatusCode:    status,
+		Header:        header,
+		Body:          io.NopCloser(bytes.NewReader(body)),
+		ContentLength: int64(len(body)),
+		Request:       request,
+	}, nil
+}
+
+func encodeBody(body any) ([]byte, string, error) {
+	switch b := body.(type) {
+	case nil:
+		return []byte{}, "", nil
+	case string:
+		return []byte(b), "", nil
+	default:
+		data, err := json.Marshal(b)
+		if err != nil {
+			return nil, "", fmt.Errorf("error encoding fixture body: %w", err)
+		}
+
+		return data, "application/json", nil
+	}
+}
+
+func record(request *http.Request) (RecordedRequest, error) {
+	recorded := RecordedRequest{
+		Method: request.Method,
+		URL:    request.URL.String(),
+		Header: request.Header.Clone(),
+	}
+
+	if request.Body == nil {
+		return recorded, nil
+	}
+
+	body, err := io.ReadAll(request.Body)
+	if err != nil {
+		return recorded, fmt.Errorf("error reading request body: %w", err)
+	}
+
+	request.Body.Close()
+	request.Body = io.NopCloser(bytes.NewReader(body))
+	recorded.Body = body
+	return recorded, nil
+}
+
+//
+// Recorder sends requests through a real core.HTTPContext,
+// and keeps the responses as fixtures, which can be saved
+// and replayed later with HTTP. Request headers are never saved,
+// so credentials don't end up in fixture files.
+//
+
+type Recorder struct {
+	mu       sync.Mutex
+	HTTP     core.HTTPContext
+	Fixtures []Fixture
+}
+
+func NewRecorder(httpCtx core.HTTPContext) *Recorder {
+	return &Recorder{HTTP: httpCtx}
+}
+
+func (r *Recorder) Do(request *http.Request) (*http.Response, error) {
+	response, err := r.HTTP.Do(request)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := io.ReadAll(response.Body)
+	response.Body.Close()
+	if err != nil {
+		return nil, fmt.Errorf("error reading response body: %w", err)
+	}
+
+	response.Body = io.NopCloser(bytes.NewReader(body))
+
+	fixture := Fixture{
+		Method: request.Method,
+		URL:    request.URL.String(),
+		Status: response.StatusCode,
+	}
+
+	var decoded any
+	if json.Unmarshal(body, &decoded) == nil {
+		fixture.Body = decoded
+	} else if len(body) > 0 {
+		fixture.Body = string(body)
+	}
+
+	if contentType := response.Header.Get("Content-Type"); contentType != "" && !strings.Contains(contentType, "json") {
+		fixture.Headers = map[string]string{"Content-Type": contentType}
+	}
+
+	r.mu.Lock()
+	r.Fixtures = append(r.Fixtures, fixture)
+	r.mu.Unlock()
+
+	return response, nil
+}
+
+//
+// Save writes the recorded fixtures to a file,
+// as JSON if the path ends with .json, and as YAML otherwise.
+//
+
+func (r *Recorder) Save(path string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var data []byte
+	var err error
+	if filepath.Ext(path) == ".json" {
+		data, err = json.MarshalIndent(r.Fixtures, "", "  ")
+	} else {
+		data, err = yaml.Marshal(r.Fixtures)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(path, data, 0644)
+}
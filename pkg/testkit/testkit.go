@@ -0,0 +1,358 @@
+// Package testkit helps testing components, triggers and integrations
+// without a database or real services.
+//
+// A Kit holds recording fakes for all the core contexts,
+// builds the context for each step of the lifecycle from them,
+// and drives the steps the way the engine does:
+//
+//	kit := testkit.New()
+//	kit.Configuration = map[string]any{"repository": "hello"}
+//	kit.HTTP = testkit.NewHTTP(testkit.Fixture{URL: "/repos/hello", Body: map[string]any{"id": 1}})
+//
+//	err := kit.Run(component, map[string]any{"ref": "main"})
+//	payloads := testkit.AssertEmitted(t, kit.ExecutionState, core.DefaultOutputChannel.Name, "github.repository")
+//	testkit.ValidateExampleOutput(t, component, kit.ExecutionState)
+package testkit
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/google/uuid"
+	log "github.com/sirupsen/logrus"
+	"github.com/superplanehq/superplane/pkg/core"
+)
+
+type Kit struct {
+	WorkflowID     string
+	NodeID         string
+	OrganizationID string
+	BaseURL        string
+	Configuration  any
+	Logger         *log.Entry
+
+	//
+	// ExpressionEnv is nil by default,
+	// so components build the environment from their input.
+	//
+	ExpressionEnv func(expression string) (map[string]any, error)
+
+	//
+	// HTTP replays fixtures by default.
+	// Any core.HTTPContext can be used instead,
+	// like a Recorder, or http.DefaultClient for httptest servers.
+	//
+	HTTP core.HTTPContext
+
+	Metadata       *Metadata
+	NodeMetadata   *Metadata
+	ExecutionState *ExecutionState
+	Events         *Events
+	Requests       *Requests
+	Logs           *Logs
+	Artifacts      *Artifacts
+	Auth           *Auth
+	Notifications  *Notifications
+	Secrets        *Secrets
+	Resources      *IntegrationResources
+	Members        *Members
+	CanvasMemory   *CanvasMemory
+	Webhook        *NodeWebhook
+	Integration    *Integration
+	Queue          *Queue
+}
+
+//
+// Queue records what ProcessQueueItem did with the queue item.
+//
+
+type Queue struct {
+	Dequeued        bool
+	NodeState       string
+	Executions      []uuid.UUID
+	IncomingSources int
+}
+
+func New() *Kit {
+	return &Kit{
+		WorkflowID:     uuid.NewString(),
+		NodeID:         "node-1",
+		OrganizationID: uuid.NewString(),
+		BaseURL:        "http://localhost:8000",
+		Configuration:  map[string]any{},
+		Logger:         log.NewEntry(log.StandardLogger()),
+		HTTP:           NewHTTP(),
+		Metadata:       &Metadata{},
+		NodeMetadata:   &Metadata{},
+		ExecutionState: &ExecutionState{},
+		Events:         &Events{},
+		Requests:       &Requests{},
+		Logs:           &Logs{},
+		Artifacts:      &Artifacts{},
+		Auth:           &Auth{},
+		Notifications:  &Notifications{},
+		Secrets:        &Secrets{},
+		Resources:      &IntegrationResources{},
+		Members:        &Members{},
+		CanvasMemory:   &CanvasMemory{},
+		Webhook:        &NodeWebhook{},
+		Integration:    &Integration{Configuration: map[string]any{}},
+		Queue:          &Queue{IncomingSources: 1},
+	}
+}
+
+func (k *Kit) SetupContext() core.SetupContext {
+	return core.SetupContext{
+		Logger:        k.Logger,
+		Configuration: k.Configuration,
+		HTTP:          k.HTTP,
+		Metadata:      k.Metadata,
+		Requests:      k.Requests,
+		Auth:          k.Auth,
+		Integration:   k.Integration,
+		Webhook:       k.Webhook,
+	}
+}
+
+func (k *Kit) ExecutionContext(input any) core.ExecutionContext {
+	return core.ExecutionContext{
+		ID:             uuid.New(),
+		WorkflowID:     k.WorkflowID,
+		OrganizationID: k.OrganizationID,
+		NodeID:         k.NodeID,
+		BaseURL:        k.BaseURL,
+		Data:           input,
+		Configuration:  k.Configuration,
+		ExpressionEnv:  k.ExpressionEnv,
+		Logger:         k.Logger,
+		Logs:           k.Logs,
+		Artifacts:      k.Artifacts,
+		HTTP:           k.HTTP,
+		Metadata:       k.Metadata,
+		NodeMetadata:   k.NodeMetadata,
+		ExecutionState: k.ExecutionState,
+		Requests:       k.Requests,
+		Auth:           k.Auth,
+		Integration:    k.Integration,
+		Notifications:  k.Notifications,
+		Secrets:        k.Secrets,
+		CanvasMemory:   k.CanvasMemory,
+		Webhook:        k.Webhook,
+	}
+}
+
+//
+// ProcessQueueContext creates executions with ExecutionContext.
+// FindExecutionByKV finds the execution of the kit,
+// if a key-value pair was set on its state.
+//
+
+func (k *Kit) ProcessQueueContext(input any) core.ProcessQueueContext {
+	ctx := core.ProcessQueueContext{
+		WorkflowID:    k.WorkflowID,
+		NodeID:        k.NodeID,
+		RootEventID:   uuid.NewString(),
+		EventID:       uuid.NewString(),
+		Configuration: k.Configuration,
+		Input:         input,
+		ExpressionEnv: k.ExpressionEnv,
+	}
+
+	ctx.DequeueItem = func() error {
+		k.Queue.Dequeued = true
+		return nil
+	}
+
+	ctx.UpdateNodeState = func(state string) error {
+		k.Queue.NodeState = state
+		return nil
+	}
+
+	ctx.CreateExecution = func() (*core.ExecutionContext, error) {
+		execution := k.ExecutionContext(input)
+		k.Queue.Executions = append(k.Queue.Executions, execution.ID)
+		return &execution, nil
+	}
+
+	ctx.FindExecutionByKV = k.findExecutionByKV
+	ctx.DefaultProcessing = func() (*uuid.UUID, error) {
+		execution, err := ctx.CreateExecution()
+		if err != nil {
+			return nil, err
+		}
+
+		err = ctx.DequeueItem()
+		if err != nil {
+			return nil, err
+		}
+
+		return &execution.ID, nil
+	}
+
+	ctx.CountDistinctIncomingSources = func() (int, error) {
+		return k.Queue.IncomingSources, nil
+	}
+
+	return ctx
+}
+
+func (k *Kit) ActionContext(name string, parameters map[string]any) core.ActionContext {
+	return core.ActionContext{
+		Name:           name,
+		Configuration:  k.Configuration,
+		Parameters:     parameters,
+		Logger:         k.Logger,
+		Logs:           k.Logs,
+		Artifacts:      k.Artifacts,
+		HTTP:           k.HTTP,
+		Metadata:       k.Metadata,
+		ExecutionState: k.ExecutionState,
+		Auth:           k.Auth,
+		Requests:       k.Requests,
+		Integration:    k.Integration,
+		Notifications:  k.Notifications,
+		Secrets:        k.Secrets,
+
+		IntegrationResources: k.Resources,
+		Members:              k.Members,
+	}
+}
+
+func (k *Kit) TriggerContext() core.TriggerContext {
+	return core.TriggerContext{
+		Logger:        k.Logger,
+		Configuration: k.Configuration,
+		HTTP:          k.HTTP,
+		Metadata:      k.Metadata,
+		Requests:      k.Requests,
+		Events:        k.Events,
+		Webhook:       k.Webhook,
+		Integration:   k.Integration,
+	}
+}
+
+func (k *Kit) TriggerActionContext(name string, parameters map[string]any) core.TriggerActionContext {
+	return core.TriggerActionContext{
+		Name:          name,
+		Parameters:    parameters,
+		Configuration: k.Configuration,
+		Logger:        k.Logger,
+		HTTP:          k.HTTP,
+		Metadata:      k.Metadata,
+		Requests:      k.Requests,
+		Events:        k.Events,
+		Webhook:       k.Webhook,
+		Integration:   k.Integration,
+	}
+}
+
+func (k *Kit) WebhookRequestContext(body []byte, headers http.Header) core.WebhookRequestContext {
+	if headers == nil {
+		headers = http.Header{}
+	}
+
+	return core.WebhookRequestContext{
+		Body:              body,
+		Headers:           headers,
+		WorkflowID:        k.WorkflowID,
+		NodeID:            k.NodeID,
+		Configuration:     k.Configuration,
+		Metadata:          k.Metadata,
+		Logger:            k.Logger,
+		Webhook:           k.Webhook,
+		Events:            k.Events,
+		Integration:       k.Integration,
+		FindExecutionByKV: k.findExecutionByKV,
+		HTTP:              k.HTTP,
+	}
+}
+
+//
+// Run drives a component through Setup, ProcessQueueItem and Execute,
+// like the engine does when an event reaches its node.
+// Execute only runs if ProcessQueueItem created an execution.
+//
+
+func (k *Kit) Run(component core.Component, input any) error {
+	err := component.Setup(k.SetupContext())
+	if err != nil {
+		return fmt.Errorf("setup: %w", err)
+	}
+
+	id, err := component.ProcessQueueItem(k.ProcessQueueContext(input))
+	if err != nil {
+		return fmt.Errorf("process queue item: %w", err)
+	}
+
+	if id == nil {
+		return nil
+	}
+
+	err = component.Execute(k.ExecutionContext(input))
+	if err != nil {
+		return fmt.Errorf("execute: %w", err)
+	}
+
+	return nil
+}
+
+func (k *Kit) Setup(component core.Component) error {
+	return component.Setup(k.SetupContext())
+}
+
+func (k *Kit) ProcessQueueItem(component core.Component, input any) (*uuid.UUID, error) {
+	return component.ProcessQueueItem(k.ProcessQueueContext(input))
+}
+
+func (k *Kit) Execute(component core.Component, input any) error {
+	return component.Execute(k.ExecutionContext(input))
+}
+
+func (k *Kit) HandleAction(component core.Component, name string, parameters map[string]any) error {
+	return component.HandleAction(k.ActionContext(name, parameters))
+}
+
+func (k *Kit) HandleWebhook(component core.Component, body []byte, headers http.Header) (int, error) {
+	return component.HandleWebhook(k.WebhookRequestContext(body, headers))
+}
+
+func (k *Kit) Cancel(component core.Component) error {
+	return component.Cancel(k.ExecutionContext(nil))
+}
+
+func (k *Kit) SetupTrigger(trigger core.Trigger) error {
+	return trigger.Setup(k.TriggerContext())
+}
+
+func (k *Kit) HandleTriggerAction(trigger core.Trigger, name string, parameters map[string]any) (map[string]any, error) {
+	return trigger.HandleAction(k.TriggerActionContext(name, parameters))
+}
+
+func (k *Kit) HandleTriggerWebhook(trigger core.Trigger, body []byte, headers http.Header) (int, error) {
+	return trigger.HandleWebhook(k.WebhookRequestContext(body, headers))
+}
+
+func (k *Kit) SyncIntegration(integration core.Integration) error {
+	return integration.Sync(core.SyncContext{
+		Logger:          k.Logger,
+		HTTP:            k.HTTP,
+		Integration:     k.Integration,
+		Configuration:   k.Integration.Configuration,
+		BaseURL:         k.BaseURL,
+		WebhooksBaseURL: k.BaseURL,
+		OrganizationID:  k.OrganizationID,
+	})
+}
+
+func (k *Kit) findExecutionByKV(key, value string) (*core.ExecutionContext, error) {
+	k.ExecutionState.mu.Lock()
+	found := k.ExecutionState.KVs[key] == value
+	k.ExecutionState.mu.Unlock()
+
+	if !found {
+		return nil, fmt.Errorf("execution not found")
+	}
+
+	execution := k.ExecutionContext(nil)
+	return &execution, nil
+}
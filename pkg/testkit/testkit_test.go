@@ -0,0 +1,333 @@
+package testkit
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/configuration"
+	"github.com/superplanehq/superplane/pkg/core"
+)
+
+//
+// getRepository fetches a repository and emits it,
+// storing its ID in the node metadata on setup.
+//
+
+type getRepository struct {
+	example map[string]any
+}
+
+func (c *getRepository) Name() string                              { return "test.getRepository" }
+func (c *getRepository) Label() string                             { return "Get Repository" }
+func (c *getRepository) Description() string                       { return "" }
+func (c *getRepository) Documentation() string                     { return "" }
+func (c *getRepository) Icon() string                              { return "github" }
+func (c *getRepository) Color() string                             { return "gray" }
+func (c *getRepository) ExampleOutput() map[string]any             { return c.example }
+func (c *getRepository) Configuration() []configuration.Field      { return nil }
+func (c *getRepository) Actions() []core.Action                    { return nil }
+func (c *getRepository) Cancel(ctx core.ExecutionContext) error    { return nil }
+func (c *getRepository) Cleanup(ctx core.SetupContext) error       { return nil }
+func (c *getRepository) HandleAction(ctx core.ActionContext) error { return nil }
+
+func (c *getRepository) OutputChannels(configuration any) []core.OutputChannel {
+	return []core.OutputChannel{core.DefaultOutputChannel}
+}
+
+func (c *getRepository) Setup(ctx core.SetupContext) error {
+	repository := ctx.Configuration.(map[string]any)["repository"]
+	if repository == "" {
+		return fmt.Errorf("repository is required")
+	}
+
+	return ctx.Metadata.Set(map[string]any{"repository": repository})
+}
+
+func (c *getRepository) ProcessQueueItem(ctx core.ProcessQueueContext) (*uuid.UUID, error) {
+	return ctx.DefaultProcessing()
+}
+
+func (c *getRepository) Execute(ctx core.ExecutionContext) error {
+	repository := ctx.Configuration.(map[string]any)["repository"].(string)
+	request, _ := http.NewRequest(http.MethodGet, "https://api.example.com/repos/"+repository, nil)
+	response, err := ctx.HTTP.Do(request)
+	if err != nil {
+		return err
+	}
+
+	defer response.Body.Close()
+	if response.StatusCode != http.StatusOK {
+		return ctx.ExecutionState.Fail("error", fmt.Sprintf("status %d", response.StatusCode))
+	}
+
+	payload := map[string]any{}
+	err = json.NewDecoder(response.Body).Decode(&payload)
+	if err != nil {
+		return err
+	}
+
+	return ctx.ExecutionState.Emit(core.DefaultOutputChannel.Name, "test.repository", []any{payload})
+}
+
+func (c *getRepository) HandleWebhook(ctx core.WebhookRequestContext) (int, error) {
+	execution, err := ctx.FindExecutionByKV("delivery", ctx.Headers.Get("X-Delivery"))
+	if err != nil {
+		return http.StatusNotFound, err
+	}
+
+	return http.StatusOK, execution.ExecutionState.Pass()
+}
+
+func Test__Kit__Run(t *testing.T) {
+	t.Run("setup, queue and execution -> payload is emitted", func(t *testing.T) {
+		kit := New()
+		kit.Configuration = map[string]any{"repository": "hello"}
+		fixtures := NewHTTP(Fixture{Method: http.MethodGet, URL: "/repos/hello", Body: map[string]any{"id": 1, "name": "hello"}})
+		kit.HTTP = fixtures
+
+		component := &getRepository{example: map[string]any{
+			"type": "test.repository",
+			"data": map[string]any{"id": 10, "name": "world"},
+		}}
+
+		require.NoError(t, kit.Run(component, map[string]any{}))
+		assert.Equal(t, map[string]any{"repository": "hello"}, kit.Metadata.Get())
+		assert.True(t, kit.Queue.Dequeued)
+		assert.Len(t, kit.Queue.Executions, 1)
+
+		AssertPassed(t, kit.ExecutionState)
+		AssertEmitted(t, kit.ExecutionState, core.DefaultOutputChannel.Name, "test.repository")
+		assert.Equal(t, map[string]any{"id": float64(1), "name": "hello"}, AssertPayload(t, kit.ExecutionState, core.DefaultOutputChannel.Name))
+		AssertAllFixturesUsed(t, fixtures)
+		ValidateExampleOutput(t, component, kit.ExecutionState)
+
+		require.Len(t, fixtures.Requests, 1)
+		assert.Equal(t, "https://api.example.com/repos/hello", fixtures.Requests[0].URL)
+	})
+
+	t.Run("setup fails -> error and nothing executed", func(t *testing.T) {
+		kit := New()
+		kit.Configuration = map[string]any{"repository": ""}
+
+		err := kit.Run(&getRepository{}, nil)
+		require.ErrorContains(t, err, "setup: repository is required")
+		assert.Empty(t, kit.Queue.Executions)
+		AssertNotFinished(t, kit.ExecutionState)
+	})
+
+	t.Run("execution fails -> failure is recorded", func(t *testing.T) {
+		kit := New()
+		kit.Configuration = map[string]any{"repository": "hello"}
+		kit.HTTP = NewHTTP(Fixture{URL: "/repos/hello", Status: http.StatusNotFound})
+
+		require.NoError(t, kit.Run(&getRepository{}, nil))
+		AssertFailed(t, kit.ExecutionState, "error")
+		assert.Equal(t, "status 404", kit.ExecutionState.FailureMessage)
+	})
+
+	t.Run("no fixture -> request fails", func(t *testing.T) {
+		kit := New()
+		kit.Configuration = map[string]any{"repository": "hello"}
+
+		err := kit.Run(&getRepository{}, nil)
+		require.ErrorContains(t, err, "no fixture for GET https://api.example.com/repos/hello")
+	})
+
+	t.Run("webhook finds the execution by key-value pair", func(t *testing.T) {
+		kit := New()
+		require.NoError(t, kit.ExecutionState.SetKV("delivery", "d-1"))
+
+		headers := http.Header{}
+		headers.Set("X-Delivery", "d-2")
+		code, err := kit.HandleWebhook(&getRepository{}, nil, headers)
+		require.Error(t, err)
+		assert.Equal(t, http.StatusNotFound, code)
+
+		headers.Set("X-Delivery", "d-1")
+		code, err = kit.HandleWebhook(&getRepository{}, nil, headers)
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusOK, code)
+		AssertPassed(t, kit.ExecutionState)
+	})
+}
+
+func Test__ValidateExampleOutput(t *testing.T) {
+	kit := New()
+	kit.Configuration = map[string]any{"repository": "hello"}
+	kit.HTTP = NewHTTP(Fixture{URL: "/repos/hello", Body: map[string]any{"id": 1}})
+	require.NoError(t, kit.Run(&getRepository{}, nil))
+
+	component := &getRepository{example: map[string]any{
+		"type": "test.repository",
+		"data": map[string]any{"id": 1, "name": "hello"},
+	}}
+
+	fake := &recordingT{TB: t}
+	func() {
+		defer func() { _ = recover() }()
+		ValidateExampleOutput(fake, component, kit.ExecutionState)
+	}()
+
+	require.True(t, fake.failed)
+	assert.Contains(t, fake.message, "$.name: missing")
+}
+
+func Test__MatchShape(t *testing.T) {
+	type repository struct {
+		ID     int      `json:"id"`
+		Topics []string `json:"topics"`
+	}
+
+	cases := []struct {
+		name     string
+		expected any
+		actual   any
+		err      string
+	}{
+		{name: "same shape", expected: map[string]any{"id": 1, "topics": []any{"go"}}, actual: repository{ID: 2, Topics: []string{"rust"}}},
+		{name: "extra fields", expected: map[string]any{"id": 1}, actual: map[string]any{"id": 2, "name": "x"}},
+		{name: "null matches anything", expected: map[string]any{"id": nil}, actual: map[string]any{"id": "x"}},
+		{name: "empty lists", expected: []any{}, actual: []any{1}},
+		{name: "missing field", expected: map[string]any{"owner": map[string]any{"login": "x"}}, actual: map[string]any{"owner": map[string]any{}}, err: "$.owner.login: missing"},
+		{name: "different kind", expected: map[string]any{"id": "1"}, actual: map[string]any{"id": 1}, err: "$.id: expected a string, got a number"},
+		{name: "list items", expected: []any{map[string]any{"id": 1}}, actual: []any{map[string]any{"id": true}}, err: "$[0].id: expected a number, got a boolean"},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			err := MatchShape(c.expected, c.actual)
+			if c.err == "" {
+				require.NoError(t, err)
+			} else {
+				require.EqualError(t, err, c.err)
+			}
+		})
+	}
+}
+
+func Test__HTTP(t *testing.T) {
+	get := func(h core.HTTPContext, url string) (*http.Response, error) {
+		request, _ := http.NewRequest(http.MethodGet, url, nil)
+		return h.Do(request)
+	}
+
+	t.Run("fixtures are used once, in order", func(t *testing.T) {
+		fixtures := NewHTTP(
+			Fixture{URL: "/status", Body: "first"},
+			Fixture{URL: "/status", Body: "second"},
+		)
+
+		for _, expected := range []string{"first", "second"} {
+			response, err := get(fixtures, "https://api.example.com/status")
+			require.NoError(t, err)
+			body, _ := io.ReadAll(response.Body)
+			assert.Equal(t, expected, string(body))
+		}
+
+		_, err := get(fixtures, "https://api.example.com/status")
+		require.ErrorContains(t, err, "no fixture")
+		assert.Empty(t, fixtures.Unused())
+	})
+
+	t.Run("repeated fixtures, queries and full URLs", func(t *testing.T) {
+		fixtures := NewHTTP(
+			Fixture{URL: "/items?page=2", Status: http.StatusAccepted},
+			Fixture{URL: "https://other.example.com/health", Repeat: true},
+			Fixture{Method: http.MethodPost, URL: "/items"},
+		)
+
+		response, err := get(fixtures, "https://api.example.com/items?page=2")
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusAccepted, response.StatusCode)
+
+		for i := 0; i < 3; i++ {
+			_, err = get(fixtures, "https://other.example.com/health")
+			require.NoError(t, err)
+		}
+
+		_, err = get(fixtures, "https://api.example.com/items")
+		require.ErrorContains(t, err, "no fixture")
+		assert.Len(t, fixtures.Unused(), 1)
+	})
+
+	t.Run("recorded fixtures can be saved and replayed", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"path": "` + r.URL.Path + `"}`))
+		}))
+
+		defer server.Close()
+
+		recorder := NewRecorder(http.DefaultClient)
+		response, err := get(recorder, server.URL+"/repos/hello")
+		require.NoError(t, err)
+		body, _ := io.ReadAll(response.Body)
+		assert.JSONEq(t, `{"path": "/repos/hello"}`, string(body))
+
+		for _, name := range []string{"fixtures.yaml", "fixtures.json"} {
+			path := filepath.Join(t.TempDir(), name)
+			require.NoError(t, recorder.Save(path))
+
+			fixtures, err := LoadFixtures(path)
+			require.NoError(t, err)
+
+			response, err := get(NewHTTP(fixtures...), server.URL+"/repos/hello")
+			require.NoError(t, err)
+			body, _ := io.ReadAll(response.Body)
+			assert.JSONEq(t, `{"path": "/repos/hello"}`, string(body))
+		}
+	})
+}
+
+func Test__Integration(t *testing.T) {
+	integration := &Integration{Configuration: map[string]any{"token": "t0k3n", "count": 1}}
+
+	value, err := integration.GetConfig("token")
+	require.NoError(t, err)
+	assert.Equal(t, "t0k3n", string(value))
+
+	_, err = integration.GetConfig("count")
+	require.ErrorContains(t, err, "not a string")
+
+	_, err = integration.Subscribe(map[string]any{"repository": "hello"})
+	require.NoError(t, err)
+
+	subscription, err := integration.FindSubscription(func(s core.IntegrationSubscriptionContext) bool {
+		return s.Configuration().(map[string]any)["repository"] == "hello"
+	})
+
+	require.NoError(t, err)
+	require.NoError(t, subscription.SendMessage("push"))
+	assert.Equal(t, []any{"push"}, integration.Subscriptions[0].Messages)
+}
+
+//
+// recordingT records failures instead of failing the test,
+// to test the assertions themselves.
+//
+
+type recordingT struct {
+	testing.TB
+	failed  bool
+	message string
+}
+
+func (r *recordingT) Errorf(format string, args ...any) {
+	r.failed = true
+	r.message += fmt.Sprintf(format, args...)
+}
+
+func (r *recordingT) FailNow() {
+	r.failed = true
+	panic("fail now")
+}
+
+func (r *recordingT) Helper() {}
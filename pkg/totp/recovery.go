@@ -0,0 +1,61 @@
+package totp
+
+import (
+	"crypto/rand"
+	"math/big"
+	"strings"
+)
+
+//
+// Recovery codes are single-use codes that replace a one-time password
+// when the authenticator is not available. They are shown once,
+// formatted as xxxxx-xxxxx, and only their hashes are stored.
+//
+
+const (
+	RecoveryCodeCount  = 10
+	recoveryCodeLength = 10
+	recoveryAlphabet   = "abcdefghjkmnpqrstuvwxyz23456789"
+)
+
+func GenerateRecoveryCodes() ([]string, error) {
+	codes := make([]string, 0, RecoveryCodeCount)
+	for range RecoveryCodeCount {
+		code, err := generateRecoveryCode()
+		if err != nil {
+			return nil, err
+		}
+
+		codes = append(codes, code)
+	}
+
+	return codes, nil
+}
+
+func NormalizeRecoveryCode(code string) string {
+	return strings.ToLower(NormalizeCode(code))
+}
+
+func IsRecoveryCode(code string) bool {
+	return len(NormalizeRecoveryCode(code)) == recoveryCodeLength
+}
+
+func generateRecoveryCode() (string, error) {
+	var b strings.Builder
+	max := big.NewInt(int64(len(recoveryAlphabet)))
+
+	for i := range recoveryCodeLength {
+		if i == recoveryCodeLength/2 {
+			b.WriteByte('-')
+		}
+
+		n, err := rand.Int(rand.Reader, max)
+		if err != nil {
+			return "", err
+		}
+
+		b.WriteByte(recoveryAlphabet[n.Int64()])
+	}
+
+	return b.String(), nil
+}
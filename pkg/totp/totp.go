@@ -0,0 +1,140 @@
+package totp
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1"
+	"crypto/subtle"
+	"encoding/base32"
+	"encoding/binary"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	qrcode "github.com/skip2/go-qrcode"
+)
+
+//
+// Time-based one-time passwords (RFC 6238), with the parameters
+// every authenticator app supports: HMAC-SHA1, 6 digits and 30 second steps.
+//
+
+const (
+	Digits     = 6
+	Period     = 30 * time.Second
+	SecretSize = 20
+
+	//
+	// Codes from the previous and next steps are also accepted,
+	// to account for clock drift and for codes typed close to the end of a step.
+	//
+	Skew = 1
+)
+
+var secretEncoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+func GenerateSecret() (string, error) {
+	buf := make([]byte, SecretSize)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+
+	return secretEncoding.EncodeToString(buf), nil
+}
+
+func Step(t time.Time) int64 {
+	return t.Unix() / int64(Period.Seconds())
+}
+
+func GenerateCode(secret string, step int64) (string, error) {
+	key, err := decodeSecret(secret)
+	if err != nil {
+		return "", err
+	}
+
+	return generateCode(key, step), nil
+}
+
+//
+// Validate returns the step of the code, so callers can reject
+// a code that was already used. Codes are normalized first,
+// since people often type them with spaces in the middle.
+//
+
+func Validate(secret, code string, t time.Time) (int64, bool) {
+	key, err := decodeSecret(secret)
+	if err != nil {
+		return 0, false
+	}
+
+	code = NormalizeCode(code)
+	if len(code) != Digits {
+		return 0, false
+	}
+
+	current := Step(t)
+	for step := current - Skew; step <= current+Skew; step++ {
+		if subtle.ConstantTimeCompare([]byte(generateCode(key, step)), []byte(code)) == 1 {
+			return step, true
+		}
+	}
+
+	return 0, false
+}
+
+func NormalizeCode(code string) string {
+	return strings.ReplaceAll(strings.ReplaceAll(strings.TrimSpace(code), " ", ""), "-", "")
+}
+
+//
+// ProvisioningURI is the otpauth:// URI authenticator apps
+// understand, usually scanned from a QR code.
+// See https://github.com/google/google-authenticator/wiki/Key-Uri-Format.
+//
+
+func ProvisioningURI(issuer, accountName, secret string) string {
+	label := url.PathEscape(issuer) + ":" + url.PathEscape(accountName)
+
+	query := url.Values{}
+	query.Set("secret", secret)
+	query.Set("issuer", issuer)
+	query.Set("algorithm", "SHA1")
+	query.Set("digits", fmt.Sprintf("%d", Digits))
+	query.Set("period", fmt.Sprintf("%d", int(Period.Seconds())))
+
+	return "otpauth://totp/" + label + "?" + query.Encode()
+}
+
+func QRCodePNG(uri string, size int) ([]byte, error) {
+	return qrcode.Encode(uri, qrcode.Medium, size)
+}
+
+func decodeSecret(secret string) ([]byte, error) {
+	key, err := secretEncoding.DecodeString(strings.ToUpper(strings.TrimRight(secret, "=")))
+	if err != nil {
+		return nil, fmt.Errorf("invalid secret: %v", err)
+	}
+
+	return key, nil
+}
+
+// See RFC 4226, section 5.3.
+func generateCode(key []byte, step int64) string {
+	counter := make([]byte, 8)
+	binary.BigEndian.PutUint64(counter, uint64(step))
+
+	mac := hmac.New(sha1.New, key)
+	mac.Write(counter)
+	sum := mac.Sum(nil)
+
+	offset := sum[len(sum)-1] & 0x0f
+	value := binary.BigEndian.Uint32(sum[offset:offset+4]) & 0x7fffffff
+
+	modulo := uint32(1)
+	for i := 0; i < Digits; i++ {
+		modulo *= 10
+	}
+
+	return fmt.Sprintf("%0*d", Digits, value%modulo)
+}
@@ -0,0 +1,114 @@
+package totp
+
+import (
+	"encoding/base32"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// The SHA1 test vectors from RFC 6238, appendix B, truncated to 6 digits.
+var rfcSecret = base32.StdEncoding.EncodeToString([]byte("12345678901234567890"))
+
+func Test__GenerateCode(t *testing.T) {
+	vectors := map[int64]string{
+		59:         "287082",
+		1111111109: "081804",
+		1111111111: "050471",
+		1234567890: "005924",
+		2000000000: "279037",
+	}
+
+	for unix, expected := range vectors {
+		code, err := GenerateCode(rfcSecret, Step(time.Unix(unix, 0)))
+		require.NoError(t, err)
+		assert.Equal(t, expected, code, "time %d", unix)
+	}
+}
+
+func Test__Validate(t *testing.T) {
+	secret, err := GenerateSecret()
+	require.NoError(t, err)
+
+	now := time.Unix(1700000000, 0)
+	code, err := GenerateCode(secret, Step(now))
+	require.NoError(t, err)
+
+	t.Run("current code -> valid, with its step", func(t *testing.T) {
+		step, ok := Validate(secret, code, now)
+		assert.True(t, ok)
+		assert.Equal(t, Step(now), step)
+	})
+
+	t.Run("code with spaces -> valid", func(t *testing.T) {
+		_, ok := Validate(secret, code[:3]+" "+code[3:], now)
+		assert.True(t, ok)
+	})
+
+	t.Run("code from the previous step -> valid", func(t *testing.T) {
+		_, ok := Validate(secret, code, now.Add(Period))
+		assert.True(t, ok)
+	})
+
+	t.Run("code from two steps ago -> invalid", func(t *testing.T) {
+		_, ok := Validate(secret, code, now.Add(2*Period))
+		assert.False(t, ok)
+	})
+
+	t.Run("wrong code -> invalid", func(t *testing.T) {
+		wrong := "000000"
+		if code == wrong {
+			wrong = "111111"
+		}
+
+		_, ok := Validate(secret, wrong, now)
+		assert.False(t, ok)
+	})
+
+	t.Run("malformed code or secret -> invalid", func(t *testing.T) {
+		_, ok := Validate(secret, "12345", now)
+		assert.False(t, ok)
+
+		_, ok = Validate("not base32!", code, now)
+		assert.False(t, ok)
+	})
+}
+
+func Test__ProvisioningURI(t *testing.T) {
+	uri := ProvisioningURI("SuperPlane", "alice@example.com", "JBSWY3DPEHPK3PXP")
+
+	parsed, err := url.Parse(uri)
+	require.NoError(t, err)
+	assert.Equal(t, "otpauth", parsed.Scheme)
+	assert.Equal(t, "totp", parsed.Host)
+	assert.Equal(t, "/SuperPlane:alice@example.com", parsed.Path)
+	assert.Equal(t, "JBSWY3DPEHPK3PXP", parsed.Query().Get("secret"))
+	assert.Equal(t, "SuperPlane", parsed.Query().Get("issuer"))
+	assert.Equal(t, "6", parsed.Query().Get("digits"))
+	assert.Equal(t, "30", parsed.Query().Get("period"))
+
+	png, err := QRCodePNG(uri, 256)
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(string(png), "\x89PNG"))
+}
+
+func Test__GenerateRecoveryCodes(t *testing.T) {
+	codes, err := GenerateRecoveryCodes()
+	require.NoError(t, err)
+	require.Len(t, codes, RecoveryCodeCount)
+
+	seen := map[string]bool{}
+	for _, code := range codes {
+		assert.Len(t, code, recoveryCodeLength+1)
+		assert.True(t, IsRecoveryCode(code))
+		assert.False(t, seen[code])
+		seen[code] = true
+	}
+
+	assert.Equal(t, NormalizeRecoveryCode(codes[0]), NormalizeRecoveryCode(" "+strings.ToUpper(codes[0])))
+	assert.False(t, IsRecoveryCode("123456"))
+}
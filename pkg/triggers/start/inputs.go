@@ -0,0 +1,47 @@
+package manual
+
+import (
+	"fmt"
+
+	"github.com/mitchellh/mapstructure"
+	"github.com/superplanehq/superplane/pkg/configuration"
+)
+
+type Spec struct {
+	Inputs []configuration.FormField `json:"inputs" mapstructure:"inputs"`
+}
+
+func DecodeSpec(config any) (*Spec, error) {
+	spec := Spec{}
+	decoder, err := mapstructure.NewDecoder(&mapstructure.DecoderConfig{
+		Result:           &spec,
+		WeaklyTypedInput: true,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if err := decoder.Decode(config); err != nil {
+		return nil, fmt.Errorf("failed to decode configuration: %w", err)
+	}
+
+	return &spec, nil
+}
+
+func (s *Spec) Validate() error {
+	return configuration.ValidateFormFields(s.Inputs)
+}
+
+//
+// ValidateInput checks the values given for a manual run against the declared inputs.
+// If no inputs are declared, the payload is free-form and is returned as is.
+//
+
+func (s *Spec) ValidateInput(input map[string]any) (map[string]any, error) {
+	if len(s.Inputs) == 0 {
+		return input, nil
+	}
+
+	return configuration.ValidateFormValues(s.Inputs, input)
+}
@@ -44,11 +44,32 @@ func (s *Start) Documentation() string {
 
 ## Configuration
 
-The Manual Run trigger requires no configuration. It's ready to use immediately after being added to a workflow.
+- **Templates**: Named payloads that can be picked when running the workflow manually.
+- **Inputs**: Typed parameters the workflow expects when started manually. When inputs are declared, the run dialog shows a form instead of a free-form payload editor.
+
+### Inputs
+
+Each input has a name, a label, a type and optional description, default value and required flag. Supported types:
+
+- **string** / **text**: Free-form text
+- **number**: A number, optionally bounded by a minimum and maximum
+- **boolean**: A true/false toggle
+- **select**: One of a fixed list of options
+- **git-ref**: A git branch or tag reference
+- **integration-resource**: A resource of an integration of the organization, e.g. a repository, with the integration and resource type set on the input
+- **user**: A member of the organization
+
+Inputs are validated when the run is started, from the UI, the API or the CLI:
+
+` + "```" + `
+superplane canvases run --canvas-id <id> --input environment=production --input replicas=3
+` + "```" + `
+
+Unknown inputs are rejected, missing inputs use their default values, and required inputs without a default must be given. Integration resources must be listed by their integration, and users must be active members of the organization. The CLI converts the --input values to their declared types and checks them before starting the run.
 
 ## Event Data
 
-Manual runs start with an empty event payload. You can use this as a starting point and add data through subsequent components.`
+Without inputs, manual runs start with the payload given - empty, or one of the templates. With inputs, the event payload contains one key per input, with values of the declared type, so downstream components can reference them directly, e.g. ` + "`{{ $['Manual Run'].data.replicas }}`" + `.`
 }
 
 func (s *Start) Icon() string {
@@ -94,6 +115,21 @@ func (s *Start) Configuration() []configuration.Field {
 				},
 			},
 		},
+		{
+			Name:        "inputs",
+			Label:       "Inputs",
+			Type:        configuration.FieldTypeList,
+			Description: "Typed parameters requested when the workflow is run manually",
+			TypeOptions: &configuration.TypeOptions{
+				List: &configuration.ListTypeOptions{
+					ItemLabel: "Input",
+					ItemDefinition: &configuration.ListItemDefinition{
+						Type:   configuration.FieldTypeObject,
+						Schema: configuration.FormFieldSchema(),
+					},
+				},
+			},
+		},
 	}
 }
 
@@ -102,7 +138,30 @@ func (s *Start) HandleWebhook(ctx core.WebhookRequestContext) (int, error) {
 }
 
 func (s *Start) Setup(ctx core.TriggerContext) error {
-	return nil
+	spec, err := DecodeSpec(ctx.Configuration)
+	if err != nil {
+		return err
+	}
+
+	return spec.Validate()
+}
+
+func (s *Start) ValidateInput(configuration any, input map[string]any) (map[string]any, error) {
+	spec, err := DecodeSpec(configuration)
+	if err != nil {
+		return nil, err
+	}
+
+	return spec.ValidateInput(input)
+}
+
+func (s *Start) InputFields(config any) ([]configuration.FormField, error) {
+	spec, err := DecodeSpec(config)
+	if err != nil {
+		return nil, err
+	}
+
+	return spec.Inputs, nil
 }
 
 func (s *Start) Actions() []core.Action {
@@ -0,0 +1,153 @@
+package manual
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/core"
+)
+
+func Test__Start__Setup(t *testing.T) {
+	start := &Start{}
+
+	t.Run("no inputs -> ok", func(t *testing.T) {
+		require.NoError(t, start.Setup(core.TriggerContext{Configuration: map[string]any{}}))
+	})
+
+	t.Run("valid inputs -> ok", func(t *testing.T) {
+		err := start.Setup(core.TriggerContext{
+			Configuration: map[string]any{
+				"inputs": []any{
+					map[string]any{"name": "environment", "type": "select", "options": []any{"staging", "production"}, "default": "staging"},
+					map[string]any{"name": "replicas", "type": "number", "min": 1, "max": 10, "default": "3"},
+					map[string]any{"name": "ref", "type": "git-ref"},
+					map[string]any{"name": "repository", "type": "integration-resource", "integrationId": "123", "resourceType": "repository"},
+				},
+			},
+		})
+
+		require.NoError(t, err)
+	})
+
+	t.Run("invalid inputs -> error", func(t *testing.T) {
+		cases := map[string]map[string]any{
+			"missing name":               {"type": "string"},
+			"invalid name":               {"name": "my-input", "type": "string"},
+			"invalid type":               {"name": "x", "type": "color"},
+			"select without options":     {"name": "x", "type": "select"},
+			"resource without type":      {"name": "x", "type": "integration-resource", "integrationId": "123"},
+			"default not in options":     {"name": "x", "type": "select", "options": []any{"a"}, "default": "b"},
+			"default out of range":       {"name": "x", "type": "number", "max": 5, "default": "6"},
+			"non-numeric number default": {"name": "x", "type": "number", "default": "many"},
+		}
+
+		for name, input := range cases {
+			t.Run(name, func(t *testing.T) {
+				err := start.Setup(core.TriggerContext{
+					Configuration: map[string]any{"inputs": []any{input}},
+				})
+
+				require.Error(t, err)
+			})
+		}
+	})
+
+	t.Run("duplicate names -> error", func(t *testing.T) {
+		err := start.Setup(core.TriggerContext{
+			Configuration: map[string]any{
+				"inputs": []any{
+					map[string]any{"name": "x", "type": "string"},
+					map[string]any{"name": "x", "type": "number"},
+				},
+			},
+		})
+
+		require.ErrorContains(t, err, "duplicate name")
+	})
+}
+
+func Test__Start__ValidateInput(t *testing.T) {
+	start := &Start{}
+	config := map[string]any{
+		"inputs": []any{
+			map[string]any{"name": "environment", "type": "select", "required": true, "options": []any{"staging", "production"}},
+			map[string]any{"name": "replicas", "type": "number", "min": 1, "max": 10, "default": "1"},
+			map[string]any{"name": "dryRun", "type": "boolean", "default": "true"},
+			map[string]any{"name": "ref", "type": "git-ref"},
+		},
+	}
+
+	t.Run("no inputs declared -> payload is returned as is", func(t *testing.T) {
+		payload := map[string]any{"anything": []any{1, 2}}
+		result, err := start.ValidateInput(map[string]any{}, payload)
+		require.NoError(t, err)
+		assert.Equal(t, payload, result)
+	})
+
+	t.Run("defaults are applied", func(t *testing.T) {
+		result, err := start.ValidateInput(config, map[string]any{"environment": "staging"})
+		require.NoError(t, err)
+		assert.Equal(t, map[string]any{
+			"environment": "staging",
+			"replicas":    float64(1),
+			"dryRun":      true,
+		}, result)
+	})
+
+	t.Run("string values are coerced", func(t *testing.T) {
+		result, err := start.ValidateInput(config, map[string]any{
+			"environment": "production",
+			"replicas":    "5",
+			"dryRun":      "false",
+			"ref":         "refs/heads/main",
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, map[string]any{
+			"environment": "production",
+			"replicas":    float64(5),
+			"dryRun":      false,
+			"ref":         "refs/heads/main",
+		}, result)
+	})
+
+	t.Run("missing required input -> error", func(t *testing.T) {
+		_, err := start.ValidateInput(config, map[string]any{})
+		require.ErrorContains(t, err, "field 'environment' is required")
+	})
+
+	t.Run("invalid option -> error", func(t *testing.T) {
+		_, err := start.ValidateInput(config, map[string]any{"environment": "dev"})
+		require.ErrorContains(t, err, "must be one of: staging, production")
+	})
+
+	t.Run("out of range number -> error", func(t *testing.T) {
+		_, err := start.ValidateInput(config, map[string]any{"environment": "staging", "replicas": 20})
+		require.ErrorContains(t, err, "must be at most 10")
+	})
+
+	t.Run("invalid boolean -> error", func(t *testing.T) {
+		_, err := start.ValidateInput(config, map[string]any{"environment": "staging", "dryRun": "maybe"})
+		require.ErrorContains(t, err, "must be a boolean")
+	})
+
+	t.Run("unknown input -> error", func(t *testing.T) {
+		_, err := start.ValidateInput(config, map[string]any{"environment": "staging", "other": "x"})
+		require.ErrorContains(t, err, "unknown field 'other'")
+	})
+}
+
+func Test__Start__InputFields(t *testing.T) {
+	start := &Start{}
+	fields, err := start.InputFields(map[string]any{
+		"inputs": []any{
+			map[string]any{"name": "repository", "type": "integration-resource", "integrationId": "123", "resourceType": "repository"},
+		},
+	})
+
+	require.NoError(t, err)
+	require.Len(t, fields, 1)
+	assert.Equal(t, "123", fields[0].IntegrationID)
+	assert.Equal(t, "repository", fields[0].ResourceType)
+}
@@ -0,0 +1,342 @@
+package twofactor
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	log "github.com/sirupsen/logrus"
+	"github.com/superplanehq/superplane/pkg/crypto"
+	"github.com/superplanehq/superplane/pkg/models"
+	"github.com/superplanehq/superplane/pkg/totp"
+	"gorm.io/gorm"
+)
+
+const (
+	Issuer     = "SuperPlane"
+	qrCodeSize = 256
+
+	//
+	// Failed verifications are counted over a sliding window,
+	// which caps how fast one-time passwords can be guessed.
+	//
+	MaxFailedAttempts    = 5
+	failedAttemptsWindow = 15 * time.Minute
+)
+
+var (
+	ErrAlreadyEnabled   = errors.New("two-factor authentication is already enabled")
+	ErrNotEnabled       = errors.New("two-factor authentication is not enabled")
+	ErrNotStarted       = errors.New("two-factor enrollment was not started")
+	ErrInvalidCode      = errors.New("invalid verification code")
+	ErrTooManyAttempts  = errors.New("too many failed verification attempts")
+	ErrPasswordRequired = errors.New("two-factor authentication is only available for password logins")
+)
+
+type Enrollment struct {
+	Secret          string
+	ProvisioningURI string
+	QRCode          string
+}
+
+//
+// Method tells how a verification succeeded.
+//
+
+type Method string
+
+const (
+	MethodTOTP         Method = "totp"
+	MethodRecoveryCode Method = "recovery_code"
+)
+
+//
+// Begin starts, or restarts, the enrollment of an account,
+// generating a new secret. The QR code is a PNG data URI
+// of the provisioning URI, ready to be shown to the user.
+//
+
+func Begin(ctx context.Context, encryptor crypto.Encryptor, account *models.Account) (*Enrollment, error) {
+	if _, err := models.FindAccountPasswordAuthByAccountID(account.ID); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrPasswordRequired
+		}
+
+		return nil, err
+	}
+
+	secret, err := totp.GenerateSecret()
+	if err != nil {
+		return nil, err
+	}
+
+	encrypted, err := encryptor.Encrypt(ctx, []byte(secret), []byte(account.ID.String()))
+	if err != nil {
+		return nil, fmt.Errorf("failed to encrypt secret: %v", err)
+	}
+
+	_, err = models.StartAccountTwoFactorEnrollment(account.ID, encrypted)
+	if err != nil {
+		if errors.Is(err, gorm.ErrDuplicatedKey) {
+			return nil, ErrAlreadyEnabled
+		}
+
+		return nil, err
+	}
+
+	uri := totp.ProvisioningURI(Issuer, account.Email, secret)
+	png, err := totp.QRCodePNG(uri, qrCodeSize)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate QR code: %v", err)
+	}
+
+	return &Enrollment{
+		Secret:          secret,
+		ProvisioningURI: uri,
+		QRCode:          "data:image/png;base64," + base64.StdEncoding.EncodeToString(png),
+	}, nil
+}
+
+//
+// Enable finishes the enrollment once the user proves their authenticator
+// produces valid codes, and returns the recovery codes. They are only
+// available here, since just their hashes are stored.
+//
+
+func Enable(ctx context.Context, encryptor crypto.Encryptor, account *models.Account, code, remoteAddr string) ([]string, error) {
+	auth, err := models.FindAccountTwoFactorAuth(account.ID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrNotStarted
+		}
+
+		return nil, err
+	}
+
+	if auth.IsEnabled() {
+		return nil, ErrAlreadyEnabled
+	}
+
+	secret, err := decryptSecret(ctx, encryptor, auth)
+	if err != nil {
+		return nil, err
+	}
+
+	step, ok := totp.Validate(secret, code, time.Now())
+	if !ok {
+		return nil, ErrInvalidCode
+	}
+
+	codes, hashes, err := generateRecoveryCodes()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := auth.Enable(step, hashes); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrAlreadyEnabled
+		}
+
+		return nil, err
+	}
+
+	record(account.ID, models.TwoFactorEventEnrolled, remoteAddr)
+	return codes, nil
+}
+
+//
+// Verify checks a one-time password or a recovery code during login.
+// Each one-time password and each recovery code is only accepted once.
+//
+
+func Verify(ctx context.Context, encryptor crypto.Encryptor, account *models.Account, code, remoteAddr string) (Method, error) {
+	method, err := verify(ctx, encryptor, account, code, remoteAddr)
+	if err != nil {
+		return "", err
+	}
+
+	if method == MethodRecoveryCode {
+		record(account.ID, models.TwoFactorEventRecoveryCodeUsed, remoteAddr)
+	}
+
+	record(account.ID, models.TwoFactorEventLoginSucceeded, remoteAddr)
+	return method, nil
+}
+
+func Disable(ctx context.Context, encryptor crypto.Encryptor, account *models.Account, code, remoteAddr string) error {
+	if _, err := verify(ctx, encryptor, account, code, remoteAddr); err != nil {
+		return err
+	}
+
+	if err := models.DeleteAccountTwoFactorAuth(account.ID); err != nil {
+		return err
+	}
+
+	record(account.ID, models.TwoFactorEventDisabled, remoteAddr)
+	return nil
+}
+
+func RegenerateRecoveryCodes(ctx context.Context, encryptor crypto.Encryptor, account *models.Account, code, remoteAddr string) ([]string, error) {
+	if _, err := verify(ctx, encryptor, account, code, remoteAddr); err != nil {
+		return nil, err
+	}
+
+	auth, err := models.FindEnabledAccountTwoFactorAuth(account.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	codes, hashes, err := generateRecoveryCodes()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := auth.UpdateRecoveryCodes(hashes); err != nil {
+		return nil, err
+	}
+
+	record(account.ID, models.TwoFactorEventRecoveryCodesRegenerated, remoteAddr)
+	return codes, nil
+}
+
+//
+// Reset removes the two-factor authentication of an account on behalf
+// of an organization admin, for members who lost their authenticator
+// and their recovery codes. The member can enroll again on their next login.
+//
+
+func Reset(tx *gorm.DB, accountID, organizationID uuid.UUID, actorID *uuid.UUID) error {
+	if err := models.DeleteAccountTwoFactorAuthInTransaction(tx, accountID); err != nil {
+		return err
+	}
+
+	return models.CreateTwoFactorEventInTransaction(tx, &models.TwoFactorEvent{
+		AccountID:      &accountID,
+		OrganizationID: &organizationID,
+		ActorID:        actorID,
+		Type:           models.TwoFactorEventReset,
+	})
+}
+
+func IsEnabled(accountID uuid.UUID) (bool, error) {
+	_, err := models.FindEnabledAccountTwoFactorAuth(accountID)
+	if err == nil {
+		return true, nil
+	}
+
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return false, nil
+	}
+
+	return false, err
+}
+
+func verify(ctx context.Context, encryptor crypto.Encryptor, account *models.Account, code, remoteAddr string) (Method, error) {
+	auth, err := models.FindEnabledAccountTwoFactorAuth(account.ID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return "", ErrNotEnabled
+		}
+
+		return "", err
+	}
+
+	failures, err := models.CountTwoFactorEventsSince(account.ID, models.TwoFactorEventVerificationFailed, time.Now().Add(-failedAttemptsWindow))
+	if err != nil {
+		return "", err
+	}
+
+	if failures >= MaxFailedAttempts {
+		return "", ErrTooManyAttempts
+	}
+
+	method, err := check(ctx, encryptor, auth, code)
+	if err != nil {
+		if errors.Is(err, ErrInvalidCode) {
+			record(account.ID, models.TwoFactorEventVerificationFailed, remoteAddr)
+		}
+
+		return "", err
+	}
+
+	return method, nil
+}
+
+func check(ctx context.Context, encryptor crypto.Encryptor, auth *models.AccountTwoFactorAuth, code string) (Method, error) {
+	if totp.IsRecoveryCode(code) {
+		ok, err := auth.UseRecoveryCode(crypto.HashToken(totp.NormalizeRecoveryCode(code)))
+		if err != nil {
+			return "", err
+		}
+
+		if !ok {
+			return "", ErrInvalidCode
+		}
+
+		return MethodRecoveryCode, nil
+	}
+
+	secret, err := decryptSecret(ctx, encryptor, auth)
+	if err != nil {
+		return "", err
+	}
+
+	step, ok := totp.Validate(secret, code, time.Now())
+	if !ok {
+		return "", ErrInvalidCode
+	}
+
+	ok, err = auth.UseStep(step)
+	if err != nil {
+		return "", err
+	}
+
+	if !ok {
+		return "", ErrInvalidCode
+	}
+
+	return MethodTOTP, nil
+}
+
+func decryptSecret(ctx context.Context, encryptor crypto.Encryptor, auth *models.AccountTwoFactorAuth) (string, error) {
+	secret, err := encryptor.Decrypt(ctx, auth.Secret, []byte(auth.AccountID.String()))
+	if err != nil {
+		return "", fmt.Errorf("failed to decrypt secret: %v", err)
+	}
+
+	return string(secret), nil
+}
+
+func generateRecoveryCodes() ([]string, []string, error) {
+	codes, err := totp.GenerateRecoveryCodes()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	hashes := make([]string, 0, len(codes))
+	for _, code := range codes {
+		hashes = append(hashes, crypto.HashToken(totp.NormalizeRecoveryCode(code)))
+	}
+
+	return codes, hashes, nil
+}
+
+//
+// Audit events are best-effort: failing to record one
+// should not fail the login or the change that caused it.
+//
+
+func record(accountID uuid.UUID, eventType, remoteAddr string) {
+	err := models.CreateTwoFactorEvent(&models.TwoFactorEvent{
+		AccountID:  &accountID,
+		Type:       eventType,
+		RemoteAddr: remoteAddr,
+	})
+
+	if err != nil {
+		log.Errorf("failed to record two-factor event %s for account %s: %v", eventType, accountID, err)
+	}
+}
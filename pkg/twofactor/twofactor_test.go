@@ -0,0 +1,177 @@
+package twofactor
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/crypto"
+	"github.com/superplanehq/superplane/pkg/database"
+	"github.com/superplanehq/superplane/pkg/models"
+	"github.com/superplanehq/superplane/pkg/totp"
+	"github.com/superplanehq/superplane/test/support"
+)
+
+func Test__TwoFactor(t *testing.T) {
+	r := support.Setup(t)
+	ctx := context.Background()
+
+	passwordHash, err := crypto.HashPassword("password")
+	require.NoError(t, err)
+	_, err = models.CreateAccountPasswordAuth(r.Account.ID, passwordHash)
+	require.NoError(t, err)
+
+	events := func(t *testing.T, eventType string) int64 {
+		count, err := models.CountTwoFactorEventsSince(r.Account.ID, eventType, time.Now().Add(-time.Hour))
+		require.NoError(t, err)
+		return count
+	}
+
+	codeAt := func(t *testing.T, secret string, at time.Time) string {
+		code, err := totp.GenerateCode(secret, totp.Step(at))
+		require.NoError(t, err)
+		return code
+	}
+
+	var secret string
+	var recoveryCodes []string
+
+	t.Run("account without password -> cannot enroll", func(t *testing.T) {
+		account, err := models.CreateAccount("Other", "other@example.com")
+		require.NoError(t, err)
+
+		_, err = Begin(ctx, r.Encryptor, account)
+		assert.ErrorIs(t, err, ErrPasswordRequired)
+	})
+
+	t.Run("enable before enrolling -> error", func(t *testing.T) {
+		_, err := Enable(ctx, r.Encryptor, r.Account, "123456", "")
+		assert.ErrorIs(t, err, ErrNotStarted)
+	})
+
+	t.Run("begin enrollment -> secret, provisioning URI and QR code", func(t *testing.T) {
+		enrollment, err := Begin(ctx, r.Encryptor, r.Account)
+		require.NoError(t, err)
+		assert.Contains(t, enrollment.ProvisioningURI, "secret="+enrollment.Secret)
+		assert.True(t, strings.HasPrefix(enrollment.QRCode, "data:image/png;base64,"))
+
+		enabled, err := IsEnabled(r.Account.ID)
+		require.NoError(t, err)
+		assert.False(t, enabled)
+
+		secret = enrollment.Secret
+	})
+
+	t.Run("enable with wrong code -> not enabled", func(t *testing.T) {
+		_, err := Enable(ctx, r.Encryptor, r.Account, "abcdef", "")
+		assert.ErrorIs(t, err, ErrInvalidCode)
+
+		enabled, err := IsEnabled(r.Account.ID)
+		require.NoError(t, err)
+		assert.False(t, enabled)
+	})
+
+	t.Run("enable with valid code -> enabled, with recovery codes", func(t *testing.T) {
+		codes, err := Enable(ctx, r.Encryptor, r.Account, codeAt(t, secret, time.Now().Add(-totp.Period)), "127.0.0.1")
+		require.NoError(t, err)
+		assert.Len(t, codes, totp.RecoveryCodeCount)
+
+		enabled, err := IsEnabled(r.Account.ID)
+		require.NoError(t, err)
+		assert.True(t, enabled)
+		assert.Equal(t, int64(1), events(t, models.TwoFactorEventEnrolled))
+
+		recoveryCodes = codes
+	})
+
+	t.Run("begin enrollment again -> already enabled", func(t *testing.T) {
+		_, err := Begin(ctx, r.Encryptor, r.Account)
+		assert.ErrorIs(t, err, ErrAlreadyEnabled)
+	})
+
+	t.Run("verify current code -> succeeds once", func(t *testing.T) {
+		code := codeAt(t, secret, time.Now())
+
+		method, err := Verify(ctx, r.Encryptor, r.Account, code, "")
+		require.NoError(t, err)
+		assert.Equal(t, MethodTOTP, method)
+
+		_, err = Verify(ctx, r.Encryptor, r.Account, code, "")
+		assert.ErrorIs(t, err, ErrInvalidCode)
+	})
+
+	t.Run("verify recovery code -> succeeds once", func(t *testing.T) {
+		method, err := Verify(ctx, r.Encryptor, r.Account, strings.ToUpper(recoveryCodes[0]), "")
+		require.NoError(t, err)
+		assert.Equal(t, MethodRecoveryCode, method)
+
+		_, err = Verify(ctx, r.Encryptor, r.Account, recoveryCodes[0], "")
+		assert.ErrorIs(t, err, ErrInvalidCode)
+
+		auth, err := models.FindEnabledAccountTwoFactorAuth(r.Account.ID)
+		require.NoError(t, err)
+		assert.Len(t, auth.RecoveryCodes, totp.RecoveryCodeCount-1)
+		assert.Equal(t, int64(1), events(t, models.TwoFactorEventRecoveryCodeUsed))
+	})
+
+	t.Run("regenerate recovery codes -> old codes no longer work", func(t *testing.T) {
+		codes, err := RegenerateRecoveryCodes(ctx, r.Encryptor, r.Account, recoveryCodes[1], "")
+		require.NoError(t, err)
+		assert.Len(t, codes, totp.RecoveryCodeCount)
+
+		_, err = Verify(ctx, r.Encryptor, r.Account, recoveryCodes[2], "")
+		assert.ErrorIs(t, err, ErrInvalidCode)
+
+		recoveryCodes = codes
+	})
+
+	t.Run("too many failed attempts -> rejected even with a valid code", func(t *testing.T) {
+		for events(t, models.TwoFactorEventVerificationFailed) < MaxFailedAttempts {
+			_, err := Verify(ctx, r.Encryptor, r.Account, "000000-0000", "")
+			require.ErrorIs(t, err, ErrInvalidCode)
+		}
+
+		_, err := Verify(ctx, r.Encryptor, r.Account, recoveryCodes[0], "")
+		assert.ErrorIs(t, err, ErrTooManyAttempts)
+
+		require.NoError(t, database.Conn().
+			Where("account_id = ?", r.Account.ID).
+			Where("type = ?", models.TwoFactorEventVerificationFailed).
+			Delete(&models.TwoFactorEvent{}).
+			Error)
+	})
+
+	t.Run("disable -> not enabled anymore", func(t *testing.T) {
+		require.NoError(t, Disable(ctx, r.Encryptor, r.Account, recoveryCodes[0], ""))
+
+		enabled, err := IsEnabled(r.Account.ID)
+		require.NoError(t, err)
+		assert.False(t, enabled)
+
+		_, err = Verify(ctx, r.Encryptor, r.Account, recoveryCodes[1], "")
+		assert.ErrorIs(t, err, ErrNotEnabled)
+	})
+
+	t.Run("reset by admin -> removed, with audit event", func(t *testing.T) {
+		enrollment, err := Begin(ctx, r.Encryptor, r.Account)
+		require.NoError(t, err)
+		_, err = Enable(ctx, r.Encryptor, r.Account, codeAt(t, enrollment.Secret, time.Now()), "")
+		require.NoError(t, err)
+
+		require.NoError(t, Reset(database.Conn(), r.Account.ID, r.Organization.ID, &r.Account.ID))
+
+		enabled, err := IsEnabled(r.Account.ID)
+		require.NoError(t, err)
+		assert.False(t, enabled)
+
+		list, err := models.ListTwoFactorEventsForOrganization(r.Organization.ID.String(), 1, nil)
+		require.NoError(t, err)
+		require.Len(t, list, 1)
+		assert.Equal(t, models.TwoFactorEventReset, list[0].Type)
+		assert.Equal(t, r.Account.Email, list[0].AccountEmail)
+		assert.Equal(t, r.Account.Email, list[0].ActorEmail)
+	})
+}
@@ -10,6 +10,7 @@ import (
 	"gorm.io/gorm"
 
 	log "github.com/sirupsen/logrus"
+	"github.com/superplanehq/superplane/pkg/artifacts"
 	"github.com/superplanehq/superplane/pkg/database"
 	"github.com/superplanehq/superplane/pkg/models"
 	"github.com/superplanehq/superplane/pkg/telemetry"
@@ -153,13 +154,16 @@ func (w *CanvasCleanupWorker) deleteNodeResourcesBatched(tx *gorm.DB, workflowID
 	}{
 		{&models.CanvasNodeRequest{}, "canvas_node_requests"},
 		{&models.CanvasNodeExecutionKV{}, "canvas_node_execution_kvs"},
+		{&models.CanvasNodeExecutionLog{}, "canvas_node_execution_logs"},
 		{&models.CanvasNodeExecution{}, "canvas_node_executions"},
 		{&models.CanvasNodeQueueItem{}, "canvas_node_queue_items"},
 		{&models.CanvasEvent{}, "canvas_events"},
 	}
 
-	totalDeleted := 0
-	allDeleted := true
+	totalDeleted, allDeleted, err := w.deleteNodeArtifactsBatched(tx, workflowID, nodeID, maxResources)
+	if err != nil || !allDeleted {
+		return totalDeleted, false, err
+	}
 
 	for _, resourceType := range resourceTypes {
 		if totalDeleted >= maxResources {
@@ -196,3 +200,39 @@ func (w *CanvasCleanupWorker) deleteNodeResourcesBatched(tx *gorm.DB, workflowID
 
 	return totalDeleted, allDeleted, nil
 }
+
+// Artifact contents live outside of the database,
+// so they need to be removed from the artifact storage
+// before their records are deleted.
+func (w *CanvasCleanupWorker) deleteNodeArtifactsBatched(tx *gorm.DB, workflowID uuid.UUID, nodeID string, maxResources int) (int, bool, error) {
+	nodeArtifacts, err := models.ListNodeArtifactsInTransaction(tx, workflowID, nodeID, maxResources)
+	if err != nil {
+		return 0, false, fmt.Errorf("failed to list canvas_node_execution_artifacts: %w", err)
+	}
+
+	if len(nodeArtifacts) == 0 {
+		return 0, true, nil
+	}
+
+	storage, err := artifacts.DefaultStorage()
+	if err != nil {
+		return 0, false, fmt.Errorf("failed to get artifact storage: %w", err)
+	}
+
+	ids := make([]uuid.UUID, 0, len(nodeArtifacts))
+	for _, artifact := range nodeArtifacts {
+		err := storage.Delete(context.Background(), artifact.StorageKey)
+		if err != nil {
+			return 0, false, fmt.Errorf("failed to delete artifact %s: %w", artifact.StorageKey, err)
+		}
+
+		ids = append(ids, artifact.ID)
+	}
+
+	err = tx.Unscoped().Where("id IN ?", ids).Delete(&models.CanvasNodeExecutionArtifact{}).Error
+	if err != nil {
+		return 0, false, fmt.Errorf("failed to delete canvas_node_execution_artifacts: %w", err)
+	}
+
+	return len(ids), len(nodeArtifacts) < maxResources, nil
+}
@@ -0,0 +1,173 @@
+package contexts
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/superplanehq/superplane/pkg/artifacts"
+	"github.com/superplanehq/superplane/pkg/models"
+	"gorm.io/gorm"
+)
+
+//
+// ChainStore is where the NodeConfigurationBuilder reads the nodes and edges
+// of a canvas, and the executions, events and artifacts of an execution chain from.
+// Workers use the database, and canvas tests use an in-memory store
+// with the executions they simulate.
+//
+
+type ChainStore interface {
+
+	//
+	// Nodes and edges of the canvas.
+	//
+	ListNodes() ([]models.CanvasNode, error)
+	ListEdges() ([]models.Edge, error)
+
+	//
+	// Returns the event with the given ID.
+	//
+	FindEvent(id uuid.UUID) (*models.CanvasEvent, error)
+
+	//
+	// Returns the execution with the given ID, and the executions
+	// before it, following PreviousExecutionID, newest first.
+	//
+	ListPreviousExecutions(executionID uuid.UUID) ([]models.CanvasNodeExecution, error)
+
+	//
+	// Returns the executions of the given nodes started by a root event.
+	//
+	ListRootEventExecutions(rootEventID uuid.UUID, nodeIDs []string) ([]models.CanvasNodeExecution, error)
+
+	//
+	// Returns the events emitted by the given executions.
+	//
+	ListExecutionEvents(executionIDs []uuid.UUID) ([]models.CanvasEvent, error)
+
+	//
+	// Returns an artifact of an execution, or gorm.ErrRecordNotFound.
+	//
+	FindArtifact(executionID uuid.UUID, name string) (*models.CanvasNodeExecutionArtifact, error)
+
+	//
+	// Returns the content of an artifact.
+	//
+	ReadArtifact(artifact *models.CanvasNodeExecutionArtifact) ([]byte, error)
+}
+
+type databaseChainStore struct {
+	tx         *gorm.DB
+	workflowID uuid.UUID
+}
+
+func NewDatabaseChainStore(tx *gorm.DB, workflowID uuid.UUID) ChainStore {
+	return &databaseChainStore{tx: tx, workflowID: workflowID}
+}
+
+func (s *databaseChainStore) ListNodes() ([]models.CanvasNode, error) {
+	return models.FindCanvasNodesInTransaction(s.tx, s.workflowID)
+}
+
+func (s *databaseChainStore) ListEdges() ([]models.Edge, error) {
+	canvas, err := models.FindCanvasWithoutOrgScopeInTransaction(s.tx, s.workflowID)
+	if err != nil {
+		return nil, err
+	}
+
+	return canvas.Edges, nil
+}
+
+func (s *databaseChainStore) FindEvent(id uuid.UUID) (*models.CanvasEvent, error) {
+	return models.FindCanvasEventInTransaction(s.tx, id)
+}
+
+func (s *databaseChainStore) ListPreviousExecutions(executionID uuid.UUID) ([]models.CanvasNodeExecution, error) {
+	var executions []models.CanvasNodeExecution
+
+	err := s.tx.Raw(`
+		WITH RECURSIVE execution_chain AS (
+			SELECT
+				id,
+				workflow_id,
+				node_id,
+				root_event_id,
+				event_id,
+				previous_execution_id,
+				parent_execution_id,
+				state,
+				result,
+				result_reason,
+				result_message,
+				metadata,
+				configuration,
+				created_at,
+				updated_at
+			FROM workflow_node_executions
+			WHERE id = ? AND workflow_id = ?
+
+			UNION ALL
+
+			-- Recursive case: Get the previous execution
+			SELECT
+				wne.id,
+				wne.workflow_id,
+				wne.node_id,
+				wne.root_event_id,
+				wne.event_id,
+				wne.previous_execution_id,
+				wne.parent_execution_id,
+				wne.state,
+				wne.result,
+				wne.result_reason,
+				wne.result_message,
+				wne.metadata,
+				wne.configuration,
+				wne.created_at,
+				wne.updated_at
+			FROM workflow_node_executions wne
+			INNER JOIN execution_chain ec ON wne.id = ec.previous_execution_id
+			WHERE wne.workflow_id = ?
+		)
+		SELECT *
+		FROM execution_chain
+		ORDER BY created_at DESC;
+	`, executionID, s.workflowID, s.workflowID).Scan(&executions).Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return executions, nil
+}
+
+func (s *databaseChainStore) ListRootEventExecutions(rootEventID uuid.UUID, nodeIDs []string) ([]models.CanvasNodeExecution, error) {
+	var executions []models.CanvasNodeExecution
+	err := s.tx.
+		Where("workflow_id = ? AND root_event_id = ? AND node_id IN ?", s.workflowID, rootEventID, nodeIDs).
+		Find(&executions).
+		Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return executions, nil
+}
+
+func (s *databaseChainStore) ListExecutionEvents(executionIDs []uuid.UUID) ([]models.CanvasEvent, error) {
+	return models.ListCanvasEventsForExecutionsInTransaction(s.tx, executionIDs)
+}
+
+func (s *databaseChainStore) FindArtifact(executionID uuid.UUID, name string) (*models.CanvasNodeExecutionArtifact, error) {
+	return models.FindNodeExecutionArtifactInTransaction(s.tx, s.workflowID, executionID, name)
+}
+
+func (s *databaseChainStore) ReadArtifact(artifact *models.CanvasNodeExecutionArtifact) ([]byte, error) {
+	storage, err := artifacts.DefaultStorage()
+	if err != nil {
+		return nil, err
+	}
+
+	return storage.Get(context.Background(), artifact.StorageKey)
+}
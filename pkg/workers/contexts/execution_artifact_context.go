@@ -0,0 +1,127 @@
+package contexts
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
+
+	"github.com/superplanehq/superplane/pkg/artifacts"
+	"github.com/superplanehq/superplane/pkg/core"
+	"github.com/superplanehq/superplane/pkg/models"
+)
+
+const MaxArtifactNameLength = 255
+
+var artifactNameRegex = regexp.MustCompile(`^[A-Za-z0-9._-]+(/[A-Za-z0-9._-]+)*$`)
+
+//
+// ExecutionArtifactContext stores artifacts for an execution.
+//
+// Like execution logs, artifacts are written outside of the transaction
+// used to process the execution, so reports produced by a failed execution
+// are still available for troubleshooting.
+//
+
+type ExecutionArtifactContext struct {
+	execution *models.CanvasNodeExecution
+	storage   artifacts.Storage
+}
+
+func NewExecutionArtifactContext(execution *models.CanvasNodeExecution) *ExecutionArtifactContext {
+	return &ExecutionArtifactContext{execution: execution}
+}
+
+func (c *ExecutionArtifactContext) WithStorage(storage artifacts.Storage) *ExecutionArtifactContext {
+	c.storage = storage
+	return c
+}
+
+func (c *ExecutionArtifactContext) Put(name string, contentType string, content []byte) (*core.Artifact, error) {
+	err := ValidateArtifactName(name)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(content) > artifacts.MaxArtifactSize {
+		return nil, fmt.Errorf("artifact %s is too large: %d bytes, limit is %d bytes", name, len(content), artifacts.MaxArtifactSize)
+	}
+
+	if contentType == "" {
+		contentType = http.DetectContentType(content)
+	}
+
+	storage, err := c.getStorage()
+	if err != nil {
+		return nil, err
+	}
+
+	key := ArtifactStorageKey(c.execution, name)
+	err = storage.Put(context.Background(), key, contentType, content)
+	if err != nil {
+		return nil, fmt.Errorf("failed to store artifact %s: %w", name, err)
+	}
+
+	checksum := sha256.Sum256(content)
+	artifact := models.CanvasNodeExecutionArtifact{
+		WorkflowID:  c.execution.WorkflowID,
+		NodeID:      c.execution.NodeID,
+		ExecutionID: c.execution.ID,
+		Name:        name,
+		ContentType: contentType,
+		Size:        int64(len(content)),
+		SHA256:      hex.EncodeToString(checksum[:]),
+		StorageKey:  key,
+	}
+
+	err = models.UpsertNodeExecutionArtifact(&artifact)
+	if err != nil {
+		return nil, fmt.Errorf("failed to save artifact %s: %w", name, err)
+	}
+
+	return &core.Artifact{
+		Name:        artifact.Name,
+		ContentType: artifact.ContentType,
+		Size:        artifact.Size,
+		SHA256:      artifact.SHA256,
+	}, nil
+}
+
+func (c *ExecutionArtifactContext) getStorage() (artifacts.Storage, error) {
+	if c.storage != nil {
+		return c.storage, nil
+	}
+
+	return artifacts.DefaultStorage()
+}
+
+// ValidateArtifactName checks an artifact name is safe to use as part of a storage key.
+// Names may contain slashes to group artifacts, e.g. "reports/junit.xml".
+func ValidateArtifactName(name string) error {
+	if name == "" {
+		return fmt.Errorf("artifact name is required")
+	}
+
+	if len(name) > MaxArtifactNameLength {
+		return fmt.Errorf("artifact name must be at most %d characters", MaxArtifactNameLength)
+	}
+
+	if !artifactNameRegex.MatchString(name) {
+		return fmt.Errorf("invalid artifact name %q: only letters, digits, '.', '_', '-' and '/' are allowed", name)
+	}
+
+	for _, part := range strings.Split(name, "/") {
+		if part == "." || part == ".." {
+			return fmt.Errorf("invalid artifact name %q", name)
+		}
+	}
+
+	return nil
+}
+
+func ArtifactStorageKey(execution *models.CanvasNodeExecution, name string) string {
+	return fmt.Sprintf("%s/%s/%s", execution.WorkflowID, execution.ID, name)
+}
@@ -0,0 +1,80 @@
+package contexts
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/artifacts"
+	"github.com/superplanehq/superplane/pkg/models"
+	"github.com/superplanehq/superplane/test/support"
+	"gorm.io/datatypes"
+)
+
+func Test__ExecutionArtifactContext(t *testing.T) {
+	r := support.Setup(t)
+	defer r.Close()
+
+	storage, err := artifacts.NewFilesystemStorage(t.TempDir())
+	require.NoError(t, err)
+
+	componentNodeID := "component-1"
+	canvas, _ := support.CreateCanvas(
+		t,
+		r.Organization.ID,
+		r.User,
+		[]models.CanvasNode{
+			{
+				NodeID: componentNodeID,
+				Name:   componentNodeID,
+				Type:   models.NodeTypeComponent,
+				Ref:    datatypes.NewJSONType(models.NodeRef{Component: &models.ComponentRef{Name: "noop"}}),
+			},
+		},
+		[]models.Edge{},
+	)
+
+	rootEvent := support.EmitCanvasEventForNode(t, canvas.ID, componentNodeID, "default", nil)
+	execution := support.CreateCanvasNodeExecution(t, canvas.ID, componentNodeID, rootEvent.ID, rootEvent.ID, nil)
+	ctx := NewExecutionArtifactContext(execution).WithStorage(storage)
+
+	t.Run("stores content and metadata", func(t *testing.T) {
+		artifact, err := ctx.Put("reports/junit.xml", "application/xml", []byte("<testsuites/>"))
+		require.NoError(t, err)
+		assert.Equal(t, "reports/junit.xml", artifact.Name)
+		assert.Equal(t, "application/xml", artifact.ContentType)
+		assert.Equal(t, int64(13), artifact.Size)
+		assert.Len(t, artifact.SHA256, 64)
+
+		record, err := models.FindNodeExecutionArtifact(canvas.ID, execution.ID, "reports/junit.xml")
+		require.NoError(t, err)
+		assert.Equal(t, componentNodeID, record.NodeID)
+		assert.Equal(t, ArtifactStorageKey(execution, "reports/junit.xml"), record.StorageKey)
+
+		content, err := storage.Get(context.Background(), record.StorageKey)
+		require.NoError(t, err)
+		assert.Equal(t, "<testsuites/>", string(content))
+	})
+
+	t.Run("storing the same name again replaces the artifact", func(t *testing.T) {
+		_, err := ctx.Put("plan.txt", "", []byte("first"))
+		require.NoError(t, err)
+		artifact, err := ctx.Put("plan.txt", "", []byte("second plan"))
+		require.NoError(t, err)
+		assert.Equal(t, "text/plain; charset=utf-8", artifact.ContentType)
+
+		all, err := models.ListNodeExecutionArtifacts(canvas.ID, execution.ID)
+		require.NoError(t, err)
+		require.Len(t, all, 2)
+		assert.Equal(t, "plan.txt", all[0].Name)
+		assert.Equal(t, int64(11), all[0].Size)
+	})
+
+	t.Run("invalid names are rejected", func(t *testing.T) {
+		for _, name := range []string{"", "../secrets", "/etc/passwd", "a/../b", "with space"} {
+			_, err := ctx.Put(name, "text/plain", []byte("x"))
+			assert.Error(t, err, name)
+		}
+	})
+}
@@ -0,0 +1,132 @@
+package contexts
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/superplanehq/superplane/pkg/grpc/actions/messages"
+	"github.com/superplanehq/superplane/pkg/models"
+)
+
+//
+// ExecutionLogContext writes log output for an execution.
+//
+// Logs are written outside of the transaction used to process the execution,
+// so they are visible while the execution is still running,
+// and are kept even if processing the execution fails.
+//
+
+type ExecutionLogContext struct {
+	execution *models.CanvasNodeExecution
+	mutex     sync.Mutex
+	size      int
+	loaded    bool
+	truncated bool
+}
+
+func NewExecutionLogContext(execution *models.CanvasNodeExecution) *ExecutionLogContext {
+	return &ExecutionLogContext{execution: execution}
+}
+
+func (c *ExecutionLogContext) Stdout(message string) error {
+	return c.Write(models.CanvasNodeExecutionLogStreamStdout, message)
+}
+
+func (c *ExecutionLogContext) Stderr(message string) error {
+	return c.Write(models.CanvasNodeExecutionLogStreamStderr, message)
+}
+
+//
+// Write stores one line of output.
+// Blank lines are stored too, since they are part of the output.
+//
+
+func (c *ExecutionLogContext) Write(stream, message string) error {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if c.truncated {
+		return nil
+	}
+
+	if !c.loaded {
+		size, err := models.NodeExecutionLogSize(c.execution.ID)
+		if err != nil {
+			return fmt.Errorf("failed to determine execution log size: %w", err)
+		}
+
+		c.size = size
+		c.loaded = true
+	}
+
+	//
+	// Once the limit is reached, we write one last line
+	// saying the output was truncated, and drop everything else.
+	//
+	if c.size >= models.CanvasNodeExecutionLogMaxSize {
+		c.truncated = true
+		return nil
+	}
+
+	if c.size+len(message) > models.CanvasNodeExecutionLogMaxSize {
+		c.truncated = true
+		stream = models.CanvasNodeExecutionLogStreamSystem
+		message = fmt.Sprintf("log output truncated: limit of %d bytes reached", models.CanvasNodeExecutionLogMaxSize)
+	}
+
+	record, err := models.CreateNodeExecutionLog(c.execution.WorkflowID, c.execution.NodeID, c.execution.ID, stream, message)
+	if err != nil {
+		return fmt.Errorf("failed to write execution log: %w", err)
+	}
+
+	c.size += len(message)
+
+	err = messages.NewCanvasExecutionLogMessage(c.execution.WorkflowID.String(), record).Publish()
+	if err != nil {
+		log.Errorf("failed to publish execution log message for %s: %v", c.execution.ID, err)
+	}
+
+	return nil
+}
+
+// Logger returns a copy of the logger entry which also writes
+// everything logged at info level or above to the execution log.
+func (c *ExecutionLogContext) Logger(entry *log.Entry) *log.Entry {
+	logger := &log.Logger{
+		Out:          entry.Logger.Out,
+		Formatter:    entry.Logger.Formatter,
+		ReportCaller: entry.Logger.ReportCaller,
+		Level:        entry.Logger.GetLevel(),
+		ExitFunc:     entry.Logger.ExitFunc,
+		Hooks:        make(log.LevelHooks),
+	}
+
+	for level, hooks := range entry.Logger.Hooks {
+		logger.Hooks[level] = append(logger.Hooks[level], hooks...)
+	}
+
+	logger.AddHook(&executionLogHook{logs: c})
+
+	return log.NewEntry(logger).WithFields(entry.Data)
+}
+
+type executionLogHook struct {
+	logs *ExecutionLogContext
+}
+
+func (h *executionLogHook) Levels() []log.Level {
+	return []log.Level{
+		log.PanicLevel,
+		log.FatalLevel,
+		log.ErrorLevel,
+		log.WarnLevel,
+		log.InfoLevel,
+	}
+}
+
+func (h *executionLogHook) Fire(entry *log.Entry) error {
+	message := fmt.Sprintf("[%s] %s", strings.ToUpper(entry.Level.String()), entry.Message)
+	return h.logs.Write(models.CanvasNodeExecutionLogStreamSystem, message)
+}
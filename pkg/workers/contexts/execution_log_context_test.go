@@ -0,0 +1,99 @@
+package contexts
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/models"
+	"github.com/superplanehq/superplane/test/support"
+	"gorm.io/datatypes"
+)
+
+func Test__ExecutionLogContext(t *testing.T) {
+	r := support.Setup(t)
+	defer r.Close()
+
+	componentNodeID := "component-1"
+	canvas, _ := support.CreateCanvas(
+		t,
+		r.Organization.ID,
+		r.User,
+		[]models.CanvasNode{
+			{
+				NodeID: componentNodeID,
+				Name:   componentNodeID,
+				Type:   models.NodeTypeComponent,
+				Ref:    datatypes.NewJSONType(models.NodeRef{Component: &models.ComponentRef{Name: "noop"}}),
+			},
+		},
+		[]models.Edge{},
+	)
+
+	t.Run("writes stdout and stderr", func(t *testing.T) {
+		rootEvent := support.EmitCanvasEventForNode(t, canvas.ID, componentNodeID, "default", nil)
+		execution := support.CreateCanvasNodeExecution(t, canvas.ID, componentNodeID, rootEvent.ID, rootEvent.ID, nil)
+
+		ctx := NewExecutionLogContext(execution)
+		require.NoError(t, ctx.Stdout("hello"))
+		require.NoError(t, ctx.Stdout(""))
+		require.NoError(t, ctx.Stderr("oops"))
+
+		logs := listExecutionLogs(t, canvas.ID, execution.ID)
+		require.Len(t, logs, 3)
+		assert.Equal(t, models.CanvasNodeExecutionLogStreamStdout, logs[0].Stream)
+		assert.Equal(t, "hello", logs[0].Message)
+		assert.Equal(t, models.CanvasNodeExecutionLogStreamStdout, logs[1].Stream)
+		assert.Equal(t, "", logs[1].Message)
+		assert.Equal(t, models.CanvasNodeExecutionLogStreamStderr, logs[2].Stream)
+		assert.Equal(t, "oops", logs[2].Message)
+	})
+
+	t.Run("logger writes info and above", func(t *testing.T) {
+		rootEvent := support.EmitCanvasEventForNode(t, canvas.ID, componentNodeID, "default", nil)
+		execution := support.CreateCanvasNodeExecution(t, canvas.ID, componentNodeID, rootEvent.ID, rootEvent.ID, nil)
+
+		ctx := NewExecutionLogContext(execution)
+		logger := ctx.Logger(log.NewEntry(log.StandardLogger()))
+		logger.Debug("not persisted")
+		logger.Info("connecting")
+		logger.Errorf("failed: %s", "timeout")
+
+		logs := listExecutionLogs(t, canvas.ID, execution.ID)
+		require.Len(t, logs, 2)
+		assert.Equal(t, models.CanvasNodeExecutionLogStreamSystem, logs[0].Stream)
+		assert.Equal(t, "[INFO] connecting", logs[0].Message)
+		assert.Equal(t, "[ERROR] failed: timeout", logs[1].Message)
+	})
+
+	t.Run("output is truncated when limit is reached", func(t *testing.T) {
+		rootEvent := support.EmitCanvasEventForNode(t, canvas.ID, componentNodeID, "default", nil)
+		execution := support.CreateCanvasNodeExecution(t, canvas.ID, componentNodeID, rootEvent.ID, rootEvent.ID, nil)
+
+		ctx := NewExecutionLogContext(execution)
+		require.NoError(t, ctx.Stdout(strings.Repeat("a", models.CanvasNodeExecutionLogMaxSize-10)))
+		require.NoError(t, ctx.Stdout("this does not fit"))
+		require.NoError(t, ctx.Stdout("dropped"))
+
+		logs := listExecutionLogs(t, canvas.ID, execution.ID)
+		require.Len(t, logs, 2)
+		assert.Equal(t, models.CanvasNodeExecutionLogStreamSystem, logs[1].Stream)
+		assert.Contains(t, logs[1].Message, "log output truncated")
+
+		//
+		// A new context for the same execution
+		// does not write anything else either.
+		//
+		require.NoError(t, NewExecutionLogContext(execution).Stdout("dropped"))
+		assert.Len(t, listExecutionLogs(t, canvas.ID, execution.ID), 2)
+	})
+}
+
+func listExecutionLogs(t *testing.T, canvasID, executionID uuid.UUID) []models.CanvasNodeExecutionLog {
+	logs, err := models.ListNodeExecutionLogs(canvasID, executionID, 0, 100)
+	require.NoError(t, err)
+	return logs
+}
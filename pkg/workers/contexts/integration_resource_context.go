@@ -0,0 +1,70 @@
+package contexts
+
+import (
+	"fmt"
+
+	"github.com/google/uuid"
+	log "github.com/sirupsen/logrus"
+	"github.com/superplanehq/superplane/pkg/core"
+	"github.com/superplanehq/superplane/pkg/models"
+	"github.com/superplanehq/superplane/pkg/registry"
+	"gorm.io/gorm"
+)
+
+//
+// IntegrationResourceContext lists the resources of the integrations
+// of an organization, the same way the resources endpoint does.
+//
+
+type IntegrationResourceContext struct {
+	tx       *gorm.DB
+	orgID    uuid.UUID
+	registry *registry.Registry
+}
+
+func NewIntegrationResourceContext(tx *gorm.DB, orgID uuid.UUID, registry *registry.Registry) *IntegrationResourceContext {
+	return &IntegrationResourceContext{
+		tx:       tx,
+		orgID:    orgID,
+		registry: registry,
+	}
+}
+
+func (c *IntegrationResourceContext) ListResourceIDs(integrationID, resourceType string) ([]string, error) {
+	ID, err := uuid.Parse(integrationID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid integration ID %s", integrationID)
+	}
+
+	instance, err := models.FindIntegrationInTransaction(c.tx, c.orgID, ID)
+	if err != nil {
+		return nil, fmt.Errorf("integration %s not found", integrationID)
+	}
+
+	integration, err := c.registry.GetIntegration(instance.AppName)
+	if err != nil {
+		return nil, fmt.Errorf("integration %s not found", instance.AppName)
+	}
+
+	resources, err := integration.ListResources(resourceType, core.ListResourcesContext{
+		Logger: log.WithFields(log.Fields{
+			"integration_id":   instance.ID.String(),
+			"integration_name": instance.AppName,
+			"resource_type":    resourceType,
+		}),
+		HTTP:        c.registry.HTTPContext(),
+		Integration: NewIntegrationContext(c.tx, nil, instance, c.registry.Encryptor, c.registry),
+		Parameters:  map[string]string{"type": resourceType},
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]string, 0, len(resources))
+	for _, resource := range resources {
+		ids = append(ids, resource.ID)
+	}
+
+	return ids, nil
+}
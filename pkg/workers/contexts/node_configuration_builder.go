@@ -1,6 +1,7 @@
 package contexts
 
 import (
+	"errors"
 	"fmt"
 	"regexp"
 	"sort"
@@ -17,12 +18,15 @@ import (
 	"gorm.io/gorm"
 )
 
+// Maximum size of an artifact that can be read with artifactContent().
+// Bigger artifacts should be downloaded through the API instead.
+const MaxArtifactExpressionContentSize = 1024 * 1024
+
 var expressionRegex = regexp.MustCompile(`\{\{(.*?)\}\}`)
 var previousDepthRegex = regexp.MustCompile(`\bprevious\s*\(([^)]*)\)`)
 
 type NodeConfigurationBuilder struct {
-	tx                  *gorm.DB
-	workflowID          uuid.UUID
+	store               ChainStore
 	nodeID              string
 	previousExecutionID *uuid.UUID
 	rootEventID         *uuid.UUID
@@ -32,10 +36,11 @@ type NodeConfigurationBuilder struct {
 }
 
 func NewNodeConfigurationBuilder(tx *gorm.DB, workflowID uuid.UUID) *NodeConfigurationBuilder {
-	return &NodeConfigurationBuilder{
-		tx:         tx,
-		workflowID: workflowID,
-	}
+	return NewNodeConfigurationBuilderWithStore(NewDatabaseChainStore(tx, workflowID))
+}
+
+func NewNodeConfigurationBuilderWithStore(store ChainStore) *NodeConfigurationBuilder {
+	return &NodeConfigurationBuilder{store: store}
 }
 
 func (b *NodeConfigurationBuilder) ForBlueprintNode(parentBlueprintNode *models.CanvasNode) *NodeConfigurationBuilder {
@@ -332,6 +337,29 @@ func (b *NodeConfigurationBuilder) resolveExpression(expression string) (any, er
 
 			return b.resolvePreviousPayload(depth)
 		}),
+		expr.Function("artifact", func(params ...any) (any, error) {
+			artifact, err := b.resolveArtifactParams("artifact", params)
+			if err != nil {
+				return nil, err
+			}
+
+			return map[string]any{
+				"name":        artifact.Name,
+				"contentType": artifact.ContentType,
+				"size":        artifact.Size,
+				"sha256":      artifact.SHA256,
+				"nodeId":      artifact.NodeID,
+				"executionId": artifact.ExecutionID.String(),
+			}, nil
+		}),
+		expr.Function("artifactContent", func(params ...any) (any, error) {
+			artifact, err := b.resolveArtifactParams("artifactContent", params)
+			if err != nil {
+				return nil, err
+			}
+
+			return b.readArtifactContent(artifact)
+		}),
 	}
 
 	vm, err := expr.Compile(expression, exprOptions...)
@@ -347,6 +375,80 @@ func (b *NodeConfigurationBuilder) resolveExpression(expression string) (any, er
 	return output, nil
 }
 
+// Artifacts are referenced by the name of the node that produced them,
+// in the same way node outputs are referenced with $['Node name'].
+// Only executions in the current execution chain are considered.
+func (b *NodeConfigurationBuilder) resolveArtifactParams(function string, params []any) (*models.CanvasNodeExecutionArtifact, error) {
+	if len(params) != 2 {
+		return nil, fmt.Errorf("%s() takes two arguments: node name and artifact name", function)
+	}
+
+	nodeRef, ok := params[0].(string)
+	if !ok {
+		return nil, fmt.Errorf("%s(): node name must be a string", function)
+	}
+
+	name, ok := params[1].(string)
+	if !ok {
+		return nil, fmt.Errorf("%s(): artifact name must be a string", function)
+	}
+
+	return b.resolveArtifact(nodeRef, name)
+}
+
+func (b *NodeConfigurationBuilder) resolveArtifact(nodeRef, name string) (*models.CanvasNodeExecutionArtifact, error) {
+	if b.previousExecutionID == nil {
+		return nil, fmt.Errorf("node name %s not found in execution chain", nodeRef)
+	}
+
+	executionsInChain, err := b.listExecutionsInChain()
+	if err != nil {
+		return nil, err
+	}
+
+	executionChainNodeIDs := make([]string, 0, len(executionsInChain))
+	for _, execution := range executionsInChain {
+		executionChainNodeIDs = append(executionChainNodeIDs, execution.NodeID)
+	}
+
+	refToNodeID, err := b.resolveNodeRefs([]string{nodeRef}, executionChainNodeIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, execution := range executionsInChain {
+		if execution.NodeID != refToNodeID[nodeRef] {
+			continue
+		}
+
+		artifact, err := b.store.FindArtifact(execution.ID, name)
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return nil, fmt.Errorf("artifact %s not found for node %s", name, nodeRef)
+			}
+
+			return nil, err
+		}
+
+		return artifact, nil
+	}
+
+	return nil, fmt.Errorf("node %s not found in execution chain", nodeRef)
+}
+
+func (b *NodeConfigurationBuilder) readArtifactContent(artifact *models.CanvasNodeExecutionArtifact) (string, error) {
+	if artifact.Size > MaxArtifactExpressionContentSize {
+		return "", fmt.Errorf("artifact %s is too large to be used in expressions: %d bytes, limit is %d bytes", artifact.Name, artifact.Size, MaxArtifactExpressionContentSize)
+	}
+
+	content, err := b.store.ReadArtifact(artifact)
+	if err != nil {
+		return "", fmt.Errorf("failed to read artifact %s: %w", artifact.Name, err)
+	}
+
+	return string(content), nil
+}
+
 func (b *NodeConfigurationBuilder) buildMessageChain(referencedNodes []string) (map[string]any, error) {
 	messageChain := map[string]any{}
 	inputMap := extractInputMap(b.input)
@@ -421,7 +523,7 @@ func extractInputMap(input any) map[string]any {
 }
 
 func (b *NodeConfigurationBuilder) resolveNodeRefs(nodeRefs []string, executionChainNodeIDs []string) (map[string]string, error) {
-	nodes, err := models.FindCanvasNodesInTransaction(b.tx, b.workflowID)
+	nodes, err := b.store.ListNodes()
 	if err != nil {
 		return nil, err
 	}
@@ -494,7 +596,7 @@ func (b *NodeConfigurationBuilder) fetchRootEvent() (*models.CanvasEvent, error)
 		return nil, nil
 	}
 
-	rootEvent, err := models.FindCanvasEventInTransaction(b.tx, *b.rootEventID)
+	rootEvent, err := b.store.FindEvent(*b.rootEventID)
 	if err != nil {
 		return nil, err
 	}
@@ -559,7 +661,7 @@ func (b *NodeConfigurationBuilder) populateFromExecutions(messageChain map[strin
 		executionIDByRef[nodeRef] = execution.ID
 	}
 
-	events, err := models.ListCanvasEventsForExecutionsInTransaction(b.tx, executionIDs)
+	events, err := b.store.ListExecutionEvents(executionIDs)
 	if err != nil {
 		return err
 	}
@@ -707,7 +809,7 @@ func (b *NodeConfigurationBuilder) resolveFromExecutions(depth int, step int, ha
 		executionIDs = append(executionIDs, execution.ID)
 	}
 
-	events, err := models.ListCanvasEventsForExecutionsInTransaction(b.tx, executionIDs)
+	events, err := b.store.ListExecutionEvents(executionIDs)
 	if err != nil {
 		return step, nil, err
 	}
@@ -787,11 +889,7 @@ func (b *NodeConfigurationBuilder) listExecutionsInChain() ([]models.CanvasNodeE
 		return executions, nil
 	}
 
-	var upstreamExecutions []models.CanvasNodeExecution
-	err = b.tx.
-		Where("workflow_id = ? AND root_event_id = ? AND node_id IN ?", b.workflowID, *b.rootEventID, upstreamNodeIDs).
-		Find(&upstreamExecutions).
-		Error
+	upstreamExecutions, err := b.store.ListRootEventExecutions(*b.rootEventID, upstreamNodeIDs)
 	if err != nil {
 		return nil, err
 	}
@@ -834,62 +932,7 @@ func (b *NodeConfigurationBuilder) listLinearExecutionsInChain() ([]models.Canva
 		return nil, nil
 	}
 
-	var executions []models.CanvasNodeExecution
-
-	err := b.tx.Raw(`
-		WITH RECURSIVE execution_chain AS (
-			SELECT
-				id,
-				workflow_id,
-				node_id,
-				root_event_id,
-				event_id,
-				previous_execution_id,
-				parent_execution_id,
-				state,
-				result,
-				result_reason,
-				result_message,
-				metadata,
-				configuration,
-				created_at,
-				updated_at
-			FROM workflow_node_executions
-			WHERE id = ? AND workflow_id = ?
-
-			UNION ALL
-
-			-- Recursive case: Get the previous execution
-			SELECT
-				wne.id,
-				wne.workflow_id,
-				wne.node_id,
-				wne.root_event_id,
-				wne.event_id,
-				wne.previous_execution_id,
-				wne.parent_execution_id,
-				wne.state,
-				wne.result,
-				wne.result_reason,
-				wne.result_message,
-				wne.metadata,
-				wne.configuration,
-				wne.created_at,
-				wne.updated_at
-			FROM workflow_node_executions wne
-			INNER JOIN execution_chain ec ON wne.id = ec.previous_execution_id
-			WHERE wne.workflow_id = ?
-		)
-		SELECT *
-		FROM execution_chain
-		ORDER BY created_at DESC;
-	`, b.previousExecutionID, b.workflowID, b.workflowID).Scan(&executions).Error
-
-	if err != nil {
-		return nil, err
-	}
-
-	return executions, nil
+	return b.store.ListPreviousExecutions(*b.previousExecutionID)
 }
 
 func (b *NodeConfigurationBuilder) listUpstreamNodeIDs() ([]string, error) {
@@ -897,16 +940,16 @@ func (b *NodeConfigurationBuilder) listUpstreamNodeIDs() ([]string, error) {
 		return nil, nil
 	}
 
-	canvas, err := models.FindCanvasWithoutOrgScopeInTransaction(b.tx, b.workflowID)
+	edges, err := b.store.ListEdges()
 	if err != nil {
 		return nil, err
 	}
-	if len(canvas.Edges) == 0 {
+	if len(edges) == 0 {
 		return nil, nil
 	}
 
-	incoming := make(map[string][]string, len(canvas.Edges))
-	for _, edge := range canvas.Edges {
+	incoming := make(map[string][]string, len(edges))
+	for _, edge := range edges {
 		incoming[edge.TargetID] = append(incoming[edge.TargetID], edge.SourceID)
 	}
 
@@ -5,6 +5,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/artifacts"
 	"github.com/superplanehq/superplane/pkg/configuration"
 	"github.com/superplanehq/superplane/pkg/database"
 	"github.com/superplanehq/superplane/pkg/models"
@@ -1251,3 +1252,69 @@ func Test_NodeConfigurationBuilder_DisallowExpression_ListItems(t *testing.T) {
 	assert.Equal(t, "resolved", item["allowed"])
 	assert.Equal(t, "{{ $[\"node-1\"].disallowed }}", item["disallowed"])
 }
+
+func Test_NodeConfigurationBuilder_Artifacts(t *testing.T) {
+	r := support.Setup(t)
+	defer r.Close()
+
+	storage, err := artifacts.NewFilesystemStorage(t.TempDir())
+	require.NoError(t, err)
+	artifacts.SetDefaultStorage(storage)
+	defer artifacts.SetDefaultStorage(nil)
+
+	canvas, _ := support.CreateCanvas(
+		t,
+		r.Organization.ID,
+		r.User,
+		[]models.CanvasNode{
+			{
+				NodeID: "node-1",
+				Name:   "build",
+				Type:   models.NodeTypeComponent,
+				Ref:    datatypes.NewJSONType(models.NodeRef{Component: &models.ComponentRef{Name: "noop"}}),
+			},
+			{
+				NodeID: "node-2",
+				Name:   "notify",
+				Type:   models.NodeTypeComponent,
+				Ref:    datatypes.NewJSONType(models.NodeRef{Component: &models.ComponentRef{Name: "noop"}}),
+			},
+		},
+		[]models.Edge{
+			{SourceID: "node-1", TargetID: "node-2", Channel: "default"},
+		},
+	)
+
+	rootEvent := support.EmitCanvasEventForNode(t, canvas.ID, "node-1", "default", nil)
+	execution := support.CreateCanvasNodeExecution(t, canvas.ID, "node-1", rootEvent.ID, rootEvent.ID, nil)
+	_, err = NewExecutionArtifactContext(execution).Put("CHANGELOG.md", "text/markdown", []byte("- fixed things"))
+	require.NoError(t, err)
+
+	builder := NewNodeConfigurationBuilder(database.Conn(), canvas.ID).
+		WithNodeID("node-2").
+		WithPreviousExecution(&execution.ID).
+		WithRootEvent(&rootEvent.ID)
+
+	t.Run("artifact metadata and content", func(t *testing.T) {
+		result, err := builder.Build(map[string]any{
+			"size":    `{{ artifact("build", "CHANGELOG.md").size }}`,
+			"type":    `{{ artifact("build", "CHANGELOG.md").contentType }}`,
+			"content": `Changes: {{ artifactContent("build", "CHANGELOG.md") }}`,
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, "14", result["size"])
+		assert.Equal(t, "text/markdown", result["type"])
+		assert.Equal(t, "Changes: - fixed things", result["content"])
+	})
+
+	t.Run("missing artifact", func(t *testing.T) {
+		_, err := builder.Build(map[string]any{"content": `{{ artifactContent("build", "missing.txt") }}`})
+		require.ErrorContains(t, err, "artifact missing.txt not found for node build")
+	})
+
+	t.Run("node not in chain", func(t *testing.T) {
+		_, err := builder.Build(map[string]any{"content": `{{ artifact("unknown", "CHANGELOG.md") }}`})
+		require.ErrorContains(t, err, "node name unknown not found in execution chain")
+	})
+}
@@ -0,0 +1,44 @@
+package contexts
+
+import (
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/superplanehq/superplane/pkg/models"
+	"gorm.io/gorm"
+)
+
+//
+// OrganizationMemberContext checks the users of an organization,
+// only counting the ones that were not removed from it.
+//
+
+type OrganizationMemberContext struct {
+	tx    *gorm.DB
+	orgID uuid.UUID
+}
+
+func NewOrganizationMemberContext(tx *gorm.DB, orgID uuid.UUID) *OrganizationMemberContext {
+	return &OrganizationMemberContext{
+		tx:    tx,
+		orgID: orgID,
+	}
+}
+
+func (c *OrganizationMemberContext) IsActiveMember(userID string) (bool, error) {
+	ID, err := uuid.Parse(userID)
+	if err != nil {
+		return false, nil
+	}
+
+	_, err = models.FindActiveUserByIDInTransaction(c.tx, c.orgID.String(), ID.String())
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return false, nil
+	}
+
+	if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
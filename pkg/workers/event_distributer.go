@@ -13,6 +13,7 @@ import (
 	"github.com/superplanehq/superplane/pkg/logging"
 	"github.com/superplanehq/superplane/pkg/public/ws"
 	"github.com/superplanehq/superplane/pkg/workers/eventdistributer"
+	"go.opentelemetry.io/otel/codes"
 )
 
 const (
@@ -51,19 +52,20 @@ func (e *EventDistributer) Start() error {
 	routes := []struct {
 		Exchange   string
 		RoutingKey string
-		Handler    func(delivery tackle.Delivery) error
+		Handler    func([]byte, *ws.Hub) error
 	}{
-		{messages.WorkflowExchange, messages.WorkflowEventCreatedRoutingKey, e.createHandler(eventdistributer.HandleCanvasEventCreated)},
-		{messages.WorkflowExchange, messages.WorkflowExecutionRoutingKey, e.createHandler(eventdistributer.HandleCanvasExecution)},
-		{messages.WorkflowExchange, messages.WorkflowQueueItemCreatedRoutingKey, e.createHandler(eventdistributer.HandleQueueItemCreated)},
-		{messages.WorkflowExchange, messages.WorkflowQueueItemConsumedRoutingKey, e.createHandler(eventdistributer.HandleQueueItemConsumed)},
-		{messages.WorkflowExchange, messages.WorkflowCanvasUpdatedRoutingKey, e.createHandler(eventdistributer.HandleCanvasUpdated)},
-		{messages.WorkflowExchange, messages.WorkflowCanvasDeletedRoutingKey, e.createHandler(eventdistributer.HandleCanvasDeleted)},
+		{messages.WorkflowExchange, messages.WorkflowEventCreatedRoutingKey, eventdistributer.HandleCanvasEventCreated},
+		{messages.WorkflowExchange, messages.WorkflowExecutionRoutingKey, eventdistributer.HandleCanvasExecution},
+		{messages.WorkflowExchange, messages.WorkflowExecutionLogRoutingKey, eventdistributer.HandleCanvasExecutionLog},
+		{messages.WorkflowExchange, messages.WorkflowQueueItemCreatedRoutingKey, eventdistributer.HandleQueueItemCreated},
+		{messages.WorkflowExchange, messages.WorkflowQueueItemConsumedRoutingKey, eventdistributer.HandleQueueItemConsumed},
+		{messages.WorkflowExchange, messages.WorkflowCanvasUpdatedRoutingKey, eventdistributer.HandleCanvasUpdated},
+		{messages.WorkflowExchange, messages.WorkflowCanvasDeletedRoutingKey, eventdistributer.HandleCanvasDeleted},
 	}
 
 	// Start a consumer for each route
 	for _, route := range routes {
-		go e.consumeMessages(amqpURL, route.Exchange, route.RoutingKey, route.Handler)
+		go e.consumeMessages(amqpURL, route.Exchange, route.RoutingKey, e.createHandler(route.RoutingKey, route.Handler))
 	}
 
 	// Block until shutdown signal
@@ -71,13 +73,18 @@ func (e *EventDistributer) Start() error {
 	return nil
 }
 
-// createHandler returns a tackle handler that calls the given processing function
-func (e *EventDistributer) createHandler(processFn func([]byte, *ws.Hub) error) func(delivery tackle.Delivery) error {
+// createHandler returns a tackle handler that calls the given processing function,
+// in a span attached to the trace that published the message
+func (e *EventDistributer) createHandler(routingKey string, processFn func([]byte, *ws.Hub) error) func(delivery tackle.Delivery) error {
 	return func(delivery tackle.Delivery) error {
+		_, span := messages.StartConsumeSpan(delivery, routingKey)
+		defer span.End()
+
 		// Call the Body() function to get the message body bytes
 		messageBody := delivery.Body()
 		err := processFn(messageBody, e.wsHub)
 		if err != nil {
+			span.SetStatus(codes.Error, err.Error())
 			log.Errorf("Error processing message: %v", err)
 			// Don't return the error to avoid redelivery, just log it
 		}
@@ -14,6 +14,8 @@ import (
 	"github.com/superplanehq/superplane/pkg/logging"
 	"github.com/superplanehq/superplane/pkg/models"
 	"github.com/superplanehq/superplane/pkg/telemetry"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
 )
 
 type EventRouter struct {
@@ -68,6 +70,16 @@ func (w *EventRouter) Start(ctx context.Context) {
 }
 
 func (w *EventRouter) LockAndProcessEvent(logger *log.Entry, event models.CanvasEvent) error {
+	ctx, span := telemetry.StartSpan(
+		telemetry.ContextFromTraceParent(event.TraceParent),
+		"canvas.event.route",
+		attribute.String("canvas.id", event.WorkflowID.String()),
+		attribute.String("event.id", event.ID.String()),
+		attribute.String("node.id", event.NodeID),
+	)
+
+	defer span.End()
+
 	var createdQueueItems []models.CanvasNodeQueueItem
 	var execution *models.CanvasNodeExecution
 	err := database.Conn().Transaction(func(tx *gorm.DB) error {
@@ -86,9 +98,11 @@ func (w *EventRouter) LockAndProcessEvent(logger *log.Entry, event models.Canvas
 	})
 
 	if err != nil {
+		span.SetStatus(codes.Error, err.Error())
 		return err
 	}
 
+	span.SetAttributes(attribute.Int("queue_items.count", len(createdQueueItems)))
 	messages.NewCanvasEventCreatedMessage(event.WorkflowID.String(), &event).Publish()
 
 	if len(createdQueueItems) > 0 {
@@ -106,7 +120,7 @@ func (w *EventRouter) LockAndProcessEvent(logger *log.Entry, event models.Canvas
 			event.WorkflowID.String(),
 			execution.ID.String(),
 			execution.NodeID,
-		).Publish()
+		).PublishWithContext(ctx)
 	}
 
 	return nil
@@ -7,6 +7,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/superplanehq/superplane/pkg/config"
+	"github.com/superplanehq/superplane/pkg/core"
 	"github.com/superplanehq/superplane/pkg/grpc/actions/messages"
 	"github.com/superplanehq/superplane/pkg/models"
 	testconsumer "github.com/superplanehq/superplane/test/consumer"
@@ -134,6 +135,104 @@ func Test__EventRouter_ProcessExecutionEvent(t *testing.T) {
 	assert.True(t, queueConsumer.HasReceivedMessage())
 }
 
+func Test__EventRouter_ProcessErrorChannelEvent(t *testing.T) {
+	router := NewEventRouter()
+	logger := log.NewEntry(log.New())
+	r := support.Setup(t)
+
+	trigger1 := "trigger-1"
+	node1 := "component-1"
+	node2 := "component-2"
+	node3 := "component-3"
+	canvas, _ := support.CreateCanvas(
+		t,
+		r.Organization.ID,
+		r.User,
+		[]models.CanvasNode{
+			{NodeID: trigger1, Type: models.NodeTypeTrigger},
+			{NodeID: node1, Type: models.NodeTypeComponent, ErrorChannelEnabled: true},
+			{NodeID: node2, Type: models.NodeTypeComponent},
+			{NodeID: node3, Type: models.NodeTypeComponent},
+		},
+		[]models.Edge{
+			{SourceID: trigger1, TargetID: node1, Channel: "default"},
+			{SourceID: node1, TargetID: node2, Channel: "default"},
+			{SourceID: node1, TargetID: node3, Channel: "error"},
+		},
+	)
+
+	//
+	// Create root event for trigger node,
+	// and fail the execution for node1.
+	//
+	triggerEvent := support.EmitCanvasEventForNode(t, canvas.ID, trigger1, "default", nil)
+	execution := support.CreateCanvasNodeExecution(t, canvas.ID, node1, triggerEvent.ID, triggerEvent.ID, nil)
+	require.NoError(t, execution.Fail(models.CanvasNodeExecutionResultReasonError, "boom"))
+
+	//
+	// An event is emitted on the error channel,
+	// carrying the failure reason and the execution input.
+	//
+	events, err := models.ListCanvasEvents(canvas.ID, node1, 10, nil)
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	errorEvent := events[0]
+	assert.Equal(t, core.ErrorOutputChannel.Name, errorEvent.Channel)
+	require.NotNil(t, errorEvent.ExecutionID)
+	assert.Equal(t, execution.ID, *errorEvent.ExecutionID)
+
+	payload, ok := errorEvent.Data.Data().(map[string]any)
+	require.True(t, ok)
+	assert.Equal(t, models.CanvasNodeExecutionErrorPayloadType, payload["type"])
+	data, ok := payload["data"].(map[string]any)
+	require.True(t, ok)
+	assert.Equal(t, models.CanvasNodeExecutionResultReasonError, data["reason"])
+	assert.Equal(t, "boom", data["message"])
+	assert.Equal(t, map[string]any{"key": "value"}, data["input"])
+
+	//
+	// The error event is routed only along the error edge.
+	//
+	err = router.LockAndProcessEvent(logger, errorEvent)
+	require.NoError(t, err)
+
+	queueItems, err := models.ListNodeQueueItems(canvas.ID, node3, 10, nil)
+	require.NoError(t, err)
+	require.Len(t, queueItems, 1)
+	assert.Equal(t, errorEvent.ID, queueItems[0].EventID)
+
+	queueItems, err = models.ListNodeQueueItems(canvas.ID, node2, 10, nil)
+	require.NoError(t, err)
+	assert.Empty(t, queueItems)
+}
+
+func Test__EventRouter_ErrorChannelDisabled(t *testing.T) {
+	r := support.Setup(t)
+
+	trigger1 := "trigger-1"
+	node1 := "component-1"
+	canvas, _ := support.CreateCanvas(
+		t,
+		r.Organization.ID,
+		r.User,
+		[]models.CanvasNode{
+			{NodeID: trigger1, Type: models.NodeTypeTrigger},
+			{NodeID: node1, Type: models.NodeTypeComponent},
+		},
+		[]models.Edge{
+			{SourceID: trigger1, TargetID: node1, Channel: "default"},
+		},
+	)
+
+	triggerEvent := support.EmitCanvasEventForNode(t, canvas.ID, trigger1, "default", nil)
+	execution := support.CreateCanvasNodeExecution(t, canvas.ID, node1, triggerEvent.ID, triggerEvent.ID, nil)
+	require.NoError(t, execution.Fail(models.CanvasNodeExecutionResultReasonError, "boom"))
+
+	events, err := models.ListCanvasEvents(canvas.ID, node1, 10, nil)
+	require.NoError(t, err)
+	assert.Empty(t, events)
+}
+
 func Test__EventRouter_CustomComponent_RespectsOutputChannels(t *testing.T) {
 	router := NewEventRouter()
 	logger := log.NewEntry(log.New())
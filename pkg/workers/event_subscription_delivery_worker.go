@@ -0,0 +1,222 @@
+package workers
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	log "github.com/sirupsen/logrus"
+	"golang.org/x/sync/semaphore"
+	"gorm.io/gorm"
+
+	"github.com/superplanehq/superplane/pkg/core"
+	"github.com/superplanehq/superplane/pkg/crypto"
+	"github.com/superplanehq/superplane/pkg/database"
+	"github.com/superplanehq/superplane/pkg/models"
+)
+
+const (
+	EventSubscriptionDeliveryMaxAttempts = 8
+	EventSubscriptionDeliveryBaseBackoff = 30 * time.Second
+	EventSubscriptionDeliveryMaxBackoff  = time.Hour
+
+	//
+	// How long a delivery is claimed by the worker sending it.
+	// Longer than any request takes, so it's only reached
+	// if the worker stops before recording the result.
+	//
+	EventSubscriptionDeliveryClaimTimeout = 5 * time.Minute
+
+	EventSubscriptionSignatureHeader = "X-Superplane-Signature"
+	EventSubscriptionTimestampHeader = "X-Superplane-Timestamp"
+	EventSubscriptionEventHeader     = "X-Superplane-Event"
+	EventSubscriptionDeliveryHeader  = "X-Superplane-Delivery"
+
+	eventSubscriptionDeliveryBatchSize = 100
+	eventSubscriptionMaxErrorLength    = 1024
+)
+
+//
+// EventSubscriptionDeliveryWorker sends the pending deliveries
+// to the URLs of the event subscriptions. Failed attempts are retried
+// with exponential backoff, until EventSubscriptionDeliveryMaxAttempts is reached.
+//
+// Requests are signed with the secret of the subscription:
+// X-Superplane-Signature is sha256=<HMAC-SHA256 of "<timestamp>.<body>">,
+// where timestamp is the value of the X-Superplane-Timestamp header.
+//
+
+type EventSubscriptionDeliveryWorker struct {
+	semaphore *semaphore.Weighted
+	encryptor crypto.Encryptor
+	http      core.HTTPContext
+	logger    *log.Entry
+}
+
+func NewEventSubscriptionDeliveryWorker(encryptor crypto.Encryptor, http core.HTTPContext) *EventSubscriptionDeliveryWorker {
+	return &EventSubscriptionDeliveryWorker{
+		semaphore: semaphore.NewWeighted(25),
+		encryptor: encryptor,
+		http:      http,
+		logger:    log.WithFields(log.Fields{"worker": "EventSubscriptionDeliveryWorker"}),
+	}
+}
+
+func (w *EventSubscriptionDeliveryWorker) Start(ctx context.Context) {
+	ticker := time.NewTicker(1 * time.Second)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			deliveries, err := models.ListPendingEventSubscriptionDeliveries(eventSubscriptionDeliveryBatchSize)
+			if err != nil {
+				w.logger.Errorf("Error finding pending deliveries: %v", err)
+				continue
+			}
+
+			for _, delivery := range deliveries {
+				if err := w.semaphore.Acquire(context.Background(), 1); err != nil {
+					w.logger.Errorf("Error acquiring semaphore: %v", err)
+					continue
+				}
+
+				go func(delivery models.EventSubscriptionDelivery) {
+					defer w.semaphore.Release(1)
+
+					if err := w.LockAndProcessDelivery(delivery.ID); err != nil {
+						w.logger.Errorf("Error processing delivery %s: %v", delivery.ID, err)
+					}
+				}(delivery)
+			}
+		}
+	}
+}
+
+//
+// The delivery is claimed in a short transaction, and the request is sent
+// after it commits, so no row lock is held while waiting for the subscriber.
+// The result is recorded afterwards, with a single update.
+//
+
+func (w *EventSubscriptionDeliveryWorker) LockAndProcessDelivery(id uuid.UUID) error {
+	var delivery *models.EventSubscriptionDelivery
+	err := database.Conn().Transaction(func(tx *gorm.DB) error {
+		locked, err := models.LockEventSubscriptionDelivery(tx, id)
+		if err != nil {
+			w.logger.Infof("Delivery %s already being processed - skipping", id)
+			return nil
+		}
+
+		delivery = locked
+		return delivery.ClaimInTransaction(tx, time.Now().Add(EventSubscriptionDeliveryClaimTimeout))
+	})
+
+	if err != nil || delivery == nil {
+		return err
+	}
+
+	return w.processDelivery(delivery)
+}
+
+func (w *EventSubscriptionDeliveryWorker) processDelivery(delivery *models.EventSubscriptionDelivery) error {
+	subscription, err := models.FindEventSubscriptionByID(delivery.SubscriptionID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return delivery.MarkAttemptFailedInTransaction(database.Conn(), nil, "subscription not found", nil)
+		}
+
+		return err
+	}
+
+	if !subscription.Enabled {
+		return delivery.MarkAttemptFailedInTransaction(database.Conn(), nil, "subscription is disabled", nil)
+	}
+
+	secret, err := w.encryptor.Decrypt(context.Background(), subscription.Secret, []byte(subscription.ID.String()))
+	if err != nil {
+		return fmt.Errorf("error decrypting secret: %w", err)
+	}
+
+	statusCode, err := w.send(subscription, delivery, secret)
+	if err == nil {
+		w.logger.Infof("Delivered %s event %s to subscription %s", delivery.EventType, delivery.EventID, subscription.ID)
+		return delivery.MarkDeliveredInTransaction(database.Conn(), *statusCode)
+	}
+
+	message := err.Error()
+	if len(message) > eventSubscriptionMaxErrorLength {
+		message = message[:eventSubscriptionMaxErrorLength]
+	}
+
+	if delivery.Attempts+1 >= EventSubscriptionDeliveryMaxAttempts {
+		w.logger.Warnf("Giving up on delivery %s after %d attempts: %s", delivery.ID, delivery.Attempts+1, message)
+		return delivery.MarkAttemptFailedInTransaction(database.Conn(), statusCode, message, nil)
+	}
+
+	nextAttemptAt := time.Now().Add(EventSubscriptionDeliveryBackoff(delivery.Attempts + 1))
+	return delivery.MarkAttemptFailedInTransaction(database.Conn(), statusCode, message, &nextAttemptAt)
+}
+
+func (w *EventSubscriptionDeliveryWorker) send(subscription *models.EventSubscription, delivery *models.EventSubscriptionDelivery, secret []byte) (*int, error) {
+	body, err := json.Marshal(delivery.Payload.Data())
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling payload: %w", err)
+	}
+
+	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+	signature := crypto.Sign(secret, append([]byte(timestamp+"."), body...))
+
+	request, err := http.NewRequest(http.MethodPost, subscription.URL, bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("error creating request: %w", err)
+	}
+
+	request.Header.Set("Content-Type", "application/json")
+	request.Header.Set("User-Agent", "Superplane-Webhooks")
+	request.Header.Set(EventSubscriptionEventHeader, delivery.EventType)
+	request.Header.Set(EventSubscriptionDeliveryHeader, delivery.ID.String())
+	request.Header.Set(EventSubscriptionTimestampHeader, timestamp)
+	request.Header.Set(EventSubscriptionSignatureHeader, "sha256="+signature)
+
+	response, err := w.http.Do(request)
+	if err != nil {
+		return nil, err
+	}
+
+	defer response.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(response.Body, 64*1024))
+
+	statusCode := response.StatusCode
+	if statusCode < 200 || statusCode >= 300 {
+		return &statusCode, fmt.Errorf("received status %d", statusCode)
+	}
+
+	return &statusCode, nil
+}
+
+//
+// EventSubscriptionDeliveryBackoff returns how long to wait
+// before the next attempt, after the given number of failed attempts.
+//
+
+func EventSubscriptionDeliveryBackoff(attempts int) time.Duration {
+	backoff := EventSubscriptionDeliveryBaseBackoff
+	for i := 1; i < attempts; i++ {
+		backoff *= 2
+		if backoff >= EventSubscriptionDeliveryMaxBackoff {
+			return EventSubscriptionDeliveryMaxBackoff
+		}
+	}
+
+	return backoff
+}
@@ -0,0 +1,186 @@
+package workers
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/crypto"
+	"github.com/superplanehq/superplane/pkg/database"
+	"github.com/superplanehq/superplane/pkg/models"
+	"github.com/superplanehq/superplane/test/support"
+)
+
+func Test__EventSubscriptionDeliveryWorker(t *testing.T) {
+	r := support.Setup(t)
+	defer r.Close()
+
+	var failures atomic.Int32
+	var received atomic.Value
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if failures.Load() > 0 {
+			failures.Add(-1)
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+
+		body, _ := io.ReadAll(req.Body)
+		received.Store(map[string]string{
+			"body":      string(body),
+			"signature": req.Header.Get(EventSubscriptionSignatureHeader),
+			"timestamp": req.Header.Get(EventSubscriptionTimestampHeader),
+			"event":     req.Header.Get(EventSubscriptionEventHeader),
+		})
+
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	defer server.Close()
+
+	canvas, _ := support.CreateCanvas(t, r.Organization.ID, r.User, []models.CanvasNode{}, []models.Edge{})
+
+	subscriptionID := uuid.New()
+	secret, encryptedSecret, err := crypto.NewRandomKey(context.Background(), r.Encryptor, subscriptionID.String())
+	require.NoError(t, err)
+
+	subscription := &models.EventSubscription{
+		ID:             subscriptionID,
+		OrganizationID: r.Organization.ID,
+		Name:           "portal",
+		URL:            server.URL,
+		Secret:         encryptedSecret,
+		EventTypes:     []string{models.EventSubscriptionEventExecutionFinished, models.EventSubscriptionEventNodeError},
+		Enabled:        true,
+	}
+
+	require.NoError(t, models.CreateEventSubscription(subscription))
+
+	worker := NewEventSubscriptionDeliveryWorker(r.Encryptor, http.DefaultClient)
+
+	finishExecution := func(t *testing.T, nodeID string) *models.CanvasNodeExecution {
+		rootEvent := support.EmitCanvasEventForNode(t, canvas.ID, nodeID, "default", nil)
+		execution := support.CreateCanvasNodeExecution(t, canvas.ID, nodeID, rootEvent.ID, rootEvent.ID, nil)
+		require.NoError(t, execution.Fail(models.CanvasNodeExecutionResultReasonError, "boom"))
+		return execution
+	}
+
+	t.Run("finished executions are delivered, signed", func(t *testing.T) {
+		execution := finishExecution(t, "node-1")
+
+		deliveries, err := models.ListEventSubscriptionDeliveries(subscription.ID, 10)
+		require.NoError(t, err)
+		require.Len(t, deliveries, 2)
+
+		for _, delivery := range deliveries {
+			require.NoError(t, worker.LockAndProcessDelivery(delivery.ID))
+		}
+
+		deliveries, err = models.ListEventSubscriptionDeliveries(subscription.ID, 10)
+		require.NoError(t, err)
+		for _, delivery := range deliveries {
+			assert.Equal(t, models.EventSubscriptionDeliveryStateDelivered, delivery.State)
+			assert.Equal(t, 1, delivery.Attempts)
+			require.NotNil(t, delivery.ResponseStatus)
+			assert.Equal(t, http.StatusOK, *delivery.ResponseStatus)
+		}
+
+		request := received.Load().(map[string]string)
+		signature := strings.TrimPrefix(request["signature"], "sha256=")
+		require.NoError(t, crypto.VerifySignature([]byte(secret), []byte(request["timestamp"]+"."+request["body"]), signature))
+
+		event := models.EventSubscriptionEvent{}
+		require.NoError(t, json.Unmarshal([]byte(request["body"]), &event))
+		assert.Equal(t, request["event"], event.Type)
+		assert.Equal(t, r.Organization.ID.String(), event.OrganizationID)
+		assert.Equal(t, canvas.ID.String(), event.CanvasID)
+		assert.Equal(t, "node-1", event.NodeID)
+		assert.Equal(t, execution.ID.String(), event.Data["executionId"])
+		assert.Equal(t, models.CanvasNodeExecutionResultFailed, event.Data["result"])
+	})
+
+	t.Run("failed attempts are retried with backoff", func(t *testing.T) {
+		require.NoError(t, database.Conn().Where("subscription_id = ?", subscription.ID).Delete(&models.EventSubscriptionDelivery{}).Error)
+		failures.Store(1)
+
+		finishExecution(t, "node-2")
+
+		deliveries, err := models.ListEventSubscriptionDeliveries(subscription.ID, 10)
+		require.NoError(t, err)
+		require.NotEmpty(t, deliveries)
+
+		delivery := deliveries[0]
+		require.NoError(t, worker.LockAndProcessDelivery(delivery.ID))
+
+		updated, err := models.ListEventSubscriptionDeliveries(subscription.ID, 10)
+		require.NoError(t, err)
+
+		var retried *models.EventSubscriptionDelivery
+		for i := range updated {
+			if updated[i].ID == delivery.ID {
+				retried = &updated[i]
+			}
+		}
+
+		require.NotNil(t, retried)
+		assert.Equal(t, models.EventSubscriptionDeliveryStatePending, retried.State)
+		assert.Equal(t, 1, retried.Attempts)
+		require.NotNil(t, retried.ResponseStatus)
+		assert.Equal(t, http.StatusServiceUnavailable, *retried.ResponseStatus)
+		assert.True(t, retried.NextAttemptAt.After(time.Now().Add(20*time.Second)))
+	})
+
+	t.Run("claimed deliveries are not sent again", func(t *testing.T) {
+		require.NoError(t, database.Conn().Where("subscription_id = ?", subscription.ID).Delete(&models.EventSubscriptionDelivery{}).Error)
+		received.Store(map[string]string{})
+
+		finishExecution(t, "node-4")
+
+		deliveries, err := models.ListEventSubscriptionDeliveries(subscription.ID, 10)
+		require.NoError(t, err)
+		require.NotEmpty(t, deliveries)
+
+		delivery := deliveries[0]
+		require.NoError(t, delivery.ClaimInTransaction(database.Conn(), time.Now().Add(EventSubscriptionDeliveryClaimTimeout)))
+		require.NoError(t, worker.LockAndProcessDelivery(delivery.ID))
+
+		updated, err := models.ListEventSubscriptionDeliveries(subscription.ID, 10)
+		require.NoError(t, err)
+		for _, d := range updated {
+			if d.ID == delivery.ID {
+				assert.Equal(t, models.EventSubscriptionDeliveryStatePending, d.State)
+				assert.Equal(t, 0, d.Attempts)
+			}
+		}
+
+		assert.Empty(t, received.Load())
+	})
+
+	t.Run("events not in the subscription are not delivered", func(t *testing.T) {
+		require.NoError(t, database.Conn().Where("subscription_id = ?", subscription.ID).Delete(&models.EventSubscriptionDelivery{}).Error)
+
+		subscription.EventTypes = []string{models.EventSubscriptionEventApprovalRequested}
+		require.NoError(t, subscription.Update())
+
+		finishExecution(t, "node-3")
+
+		deliveries, err := models.ListEventSubscriptionDeliveries(subscription.ID, 10)
+		require.NoError(t, err)
+		assert.Empty(t, deliveries)
+	})
+}
+
+func Test__EventSubscriptionDeliveryBackoff(t *testing.T) {
+	assert.Equal(t, 30*time.Second, EventSubscriptionDeliveryBackoff(1))
+	assert.Equal(t, time.Minute, EventSubscriptionDeliveryBackoff(2))
+	assert.Equal(t, 4*time.Minute, EventSubscriptionDeliveryBackoff(4))
+	assert.Equal(t, time.Hour, EventSubscriptionDeliveryBackoff(10))
+}
@@ -0,0 +1,55 @@
+package eventdistributer
+
+import (
+	"encoding/json"
+	"fmt"
+
+	log "github.com/sirupsen/logrus"
+	pb "github.com/superplanehq/superplane/pkg/protos/canvases"
+	"github.com/superplanehq/superplane/pkg/public/ws"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+type ExecutionLogWebsocketEvent struct {
+	Event   string          `json:"event"`
+	Payload json.RawMessage `json:"payload"`
+}
+
+const ExecutionLogEvent = "execution_log"
+
+func HandleCanvasExecutionLog(messageBody []byte, wsHub *ws.Hub) error {
+	log.Debugf("Received execution log event")
+
+	pbMsg := &pb.CanvasNodeExecutionLogMessage{}
+	if err := proto.Unmarshal(messageBody, pbMsg); err != nil {
+		return fmt.Errorf("failed to unmarshal execution log event: %w", err)
+	}
+
+	if pbMsg.Log == nil {
+		return fmt.Errorf("execution log event has no log")
+	}
+
+	//
+	// The log line is already in the message,
+	// so there is no need to go to the database here.
+	//
+	serializedLogJSON, err := protojson.Marshal(pbMsg.Log)
+	if err != nil {
+		return fmt.Errorf("failed to marshal execution log: %w", err)
+	}
+
+	event, err := json.Marshal(ExecutionLogWebsocketEvent{
+		Event:   ExecutionLogEvent,
+		Payload: json.RawMessage(serializedLogJSON),
+	})
+
+	if err != nil {
+		return fmt.Errorf("failed to marshal websocket event: %w", err)
+	}
+
+	wsHub.BroadcastToWorkflow(pbMsg.CanvasId, event)
+	log.Debugf("Broadcasted %s event to workflow %s", ExecutionLogEvent, pbMsg.CanvasId)
+
+	return nil
+}
@@ -12,6 +12,7 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/sirupsen/logrus"
+	"github.com/superplanehq/superplane/pkg/components/approval"
 	"github.com/superplanehq/superplane/pkg/configuration"
 	"github.com/superplanehq/superplane/pkg/core"
 	"github.com/superplanehq/superplane/pkg/crypto"
@@ -22,6 +23,8 @@ import (
 	"github.com/superplanehq/superplane/pkg/registry"
 	"github.com/superplanehq/superplane/pkg/telemetry"
 	"github.com/superplanehq/superplane/pkg/workers/contexts"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
 )
 
 var ErrRecordLocked = errors.New("record locked")
@@ -275,6 +278,17 @@ func (w *NodeExecutor) executeComponentNode(tx *gorm.DB, execution *models.Canva
 		return fmt.Errorf("failed to find workflow: %v", err)
 	}
 
+	spanCtx, span := telemetry.StartSpan(
+		telemetry.ContextFromTraceParent(inputEvent.TraceParent),
+		"canvas.node_execution",
+		attribute.String("canvas.id", execution.WorkflowID.String()),
+		attribute.String("node.id", execution.NodeID),
+		attribute.String("component", ref.Component.Name),
+		attribute.String("execution.id", execution.ID.String()),
+	)
+
+	defer span.End()
+
 	ctx := core.ExecutionContext{
 		ID:             execution.ID,
 		WorkflowID:     execution.WorkflowID.String(),
@@ -284,7 +298,7 @@ func (w *NodeExecutor) executeComponentNode(tx *gorm.DB, execution *models.Canva
 		BaseURL:        w.baseURL,
 		Configuration:  execution.Configuration.Data(),
 		Data:           input,
-		HTTP:           w.registry.HTTPContext(),
+		HTTP:           w.registry.HTTPContext().WithContext(spanCtx),
 		Metadata:       contexts.NewExecutionMetadataContext(tx, execution),
 		NodeMetadata:   contexts.NewNodeMetadataContext(tx, node),
 		ExecutionState: contexts.NewExecutionStateContext(tx, execution),
@@ -322,14 +336,49 @@ func (w *NodeExecutor) executeComponentNode(tx *gorm.DB, execution *models.Canva
 		ctx.Integration = contexts.NewIntegrationContext(tx, node, instance, w.encryptor, w.registry)
 	}
 
-	ctx.Logger = logger
+	logs := contexts.NewExecutionLogContext(execution)
+	ctx.Logs = logs
+	ctx.Artifacts = contexts.NewExecutionArtifactContext(execution)
+	ctx.Logger = logs.Logger(logger)
 	if err := component.Execute(ctx); err != nil {
-		logger.Errorf("failed to execute component: %v", err)
+		span.SetStatus(codes.Error, err.Error())
+		ctx.Logger.Errorf("failed to execute component: %v", err)
 		err = execution.FailInTransaction(tx, models.CanvasNodeExecutionResultReasonError, err.Error())
 		return err
 	}
 
 	logger.Info("Component executed successfully")
 
+	err = w.enqueueApprovalRequested(tx, execution, ref.Component.Name)
+	if err != nil {
+		logger.Errorf("failed to enqueue approval event: %v", err)
+		return fmt.Errorf("failed to enqueue approval event: %w", err)
+	}
+
 	return tx.Save(execution).Error
 }
+
+//
+// Approvals keep the execution running until someone acts on them,
+// so event subscriptions are notified when that happens,
+// with the approval records in the execution metadata.
+//
+
+func (w *NodeExecutor) enqueueApprovalRequested(tx *gorm.DB, execution *models.CanvasNodeExecution, componentName string) error {
+	if componentName != approval.ComponentName || execution.State == models.CanvasNodeExecutionStateFinished || execution.ParentExecutionID != nil {
+		return nil
+	}
+
+	event := models.NewEventSubscriptionEvent(
+		models.EventSubscriptionEventApprovalRequested,
+		execution.WorkflowID,
+		execution.NodeID,
+		map[string]any{
+			"executionId": execution.ID.String(),
+			"rootEventId": execution.RootEventID.String(),
+			"approval":    execution.Metadata.Data(),
+		},
+	)
+
+	return models.EnqueueEventSubscriptionDeliveriesInTransaction(tx, execution.WorkflowID, event)
+}
@@ -16,10 +16,12 @@ import (
 	"github.com/superplanehq/superplane/pkg/database"
 	"github.com/superplanehq/superplane/pkg/grpc/actions/messages"
 	"github.com/superplanehq/superplane/pkg/logging"
+	"github.com/superplanehq/superplane/pkg/metrics"
 	"github.com/superplanehq/superplane/pkg/models"
 	"github.com/superplanehq/superplane/pkg/registry"
 	"github.com/superplanehq/superplane/pkg/telemetry"
 	"github.com/superplanehq/superplane/pkg/workers/contexts"
+	"go.opentelemetry.io/otel/attribute"
 )
 
 type NodeQueueWorker struct {
@@ -89,6 +91,8 @@ func (w *NodeQueueWorker) LockAndProcessNode(logger *log.Entry, node models.Canv
 	})
 
 	if err == nil {
+		ctx := w.recordQueueItemSpan(queueItem)
+		w.recordQueueWait(&node, queueItem)
 		if len(executionIDs) > 0 {
 			for _, executionID := range executionIDs {
 				if executionID == nil {
@@ -99,7 +103,7 @@ func (w *NodeQueueWorker) LockAndProcessNode(logger *log.Entry, node models.Canv
 					node.WorkflowID.String(),
 					executionID.String(),
 					node.NodeID,
-				).Publish()
+				).PublishWithContext(ctx)
 			}
 		}
 
@@ -115,6 +119,49 @@ func (w *NodeQueueWorker) LockAndProcessNode(logger *log.Entry, node models.Canv
 	return err
 }
 
+//
+// Records the time the item spent in the node queue
+// as part of the trace of the event chain that queued it.
+//
+
+func (w *NodeQueueWorker) recordQueueItemSpan(queueItem *models.CanvasNodeQueueItem) context.Context {
+	if queueItem == nil || queueItem.CreatedAt == nil || !telemetry.TracingEnabled() {
+		return context.Background()
+	}
+
+	rootEvent, err := models.FindCanvasEvent(queueItem.RootEventID)
+	if err != nil {
+		w.logger.Warnf("Error finding root event %s for queue item %s: %v", queueItem.RootEventID, queueItem.ID, err)
+		return context.Background()
+	}
+
+	ctx := telemetry.ContextFromTraceParent(rootEvent.TraceParent)
+	telemetry.RecordSpan(
+		ctx,
+		"canvas.queue_item",
+		*queueItem.CreatedAt,
+		attribute.String("canvas.id", queueItem.WorkflowID.String()),
+		attribute.String("node.id", queueItem.NodeID),
+		attribute.String("queue_item.id", queueItem.ID.String()),
+	)
+
+	return ctx
+}
+
+func (w *NodeQueueWorker) recordQueueWait(node *models.CanvasNode, queueItem *models.CanvasNodeQueueItem) {
+	if queueItem == nil || queueItem.CreatedAt == nil || !metrics.Enabled() {
+		return
+	}
+
+	labels, err := models.NodeMetricLabelsInTransaction(database.Conn(), node.WorkflowID, node)
+	if err != nil {
+		w.logger.Warnf("Error finding metric labels for node %s: %v", node.NodeID, err)
+		return
+	}
+
+	metrics.RecordQueueWait(context.Background(), labels, time.Since(*queueItem.CreatedAt))
+}
+
 func (w *NodeQueueWorker) processNode(tx *gorm.DB, logger *log.Entry, node *models.CanvasNode) ([]*uuid.UUID, *models.CanvasNodeQueueItem, error) {
 	queueItem, err := node.FirstQueueItem(tx)
 	if err != nil {
@@ -266,6 +313,13 @@ func (w *NodeQueueWorker) handleNodeConfigurationError(tx *gorm.DB, logger *log.
 	}
 
 	if parentExecutionID == nil {
+		if configErr.Node.ErrorChannelEnabled {
+			_, err = execution.EmitErrorEventInTransaction(tx, execution.ResultReason, execution.ResultMessage)
+			if err != nil {
+				return nil, err
+			}
+		}
+
 		return []*uuid.UUID{&execution.ID}, nil
 	}
 
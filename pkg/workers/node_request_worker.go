@@ -305,6 +305,9 @@ func (w *NodeRequestWorker) invokeParentNodeComponentAction(tx *gorm.DB, request
 		Notifications:  contexts.NewNotificationContext(tx, uuid.Nil, node.WorkflowID),
 		Auth:           contexts.NewAuthContext(tx, workflow.OrganizationID, nil, nil),
 		Secrets:        contexts.NewSecretsContext(tx, workflow.OrganizationID, w.encryptor),
+
+		IntegrationResources: contexts.NewIntegrationResourceContext(tx, workflow.OrganizationID, w.registry),
+		Members:              contexts.NewOrganizationMemberContext(tx, workflow.OrganizationID),
 	}
 
 	if node.AppInstallationID != nil {
@@ -317,7 +320,10 @@ func (w *NodeRequestWorker) invokeParentNodeComponentAction(tx *gorm.DB, request
 		actionCtx.Integration = contexts.NewIntegrationContext(tx, node, instance, w.encryptor, w.registry)
 	}
 
-	actionCtx.Logger = logger
+	logs := contexts.NewExecutionLogContext(execution)
+	actionCtx.Logs = logs
+	actionCtx.Artifacts = contexts.NewExecutionArtifactContext(execution)
+	actionCtx.Logger = logs.Logger(logger)
 	err = component.HandleAction(actionCtx)
 	if err != nil {
 		return fmt.Errorf("action execution failed: %w", err)
@@ -374,11 +380,14 @@ func (w *NodeRequestWorker) invokeChildNodeComponentAction(tx *gorm.DB, request
 		return fmt.Errorf("workflow not found: %w", err)
 	}
 
+	logs := contexts.NewExecutionLogContext(execution)
 	actionCtx := core.ActionContext{
 		Name:           actionName,
 		Configuration:  execution.Configuration.Data(),
 		Parameters:     spec.InvokeAction.Parameters,
-		Logger:         logging.ForExecution(execution, parentExecution),
+		Logger:         logs.Logger(logging.ForExecution(execution, parentExecution)),
+		Logs:           logs,
+		Artifacts:      contexts.NewExecutionArtifactContext(execution),
 		HTTP:           w.registry.HTTPContext(),
 		Metadata:       contexts.NewExecutionMetadataContext(tx, execution),
 		ExecutionState: contexts.NewExecutionStateContext(tx, execution),
@@ -386,6 +395,9 @@ func (w *NodeRequestWorker) invokeChildNodeComponentAction(tx *gorm.DB, request
 		Notifications:  contexts.NewNotificationContext(tx, uuid.Nil, execution.WorkflowID),
 		Auth:           contexts.NewAuthContext(tx, workflow.OrganizationID, nil, nil),
 		Secrets:        contexts.NewSecretsContext(tx, workflow.OrganizationID, w.encryptor),
+
+		IntegrationResources: contexts.NewIntegrationResourceContext(tx, workflow.OrganizationID, w.registry),
+		Members:              contexts.NewOrganizationMemberContext(tx, workflow.OrganizationID),
 	}
 
 	err = component.HandleAction(actionCtx)
@@ -0,0 +1,312 @@
+package workers
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+	log "github.com/sirupsen/logrus"
+	"golang.org/x/sync/semaphore"
+	"gorm.io/gorm"
+
+	"github.com/superplanehq/superplane/pkg/core"
+	"github.com/superplanehq/superplane/pkg/crypto"
+	"github.com/superplanehq/superplane/pkg/database"
+	"github.com/superplanehq/superplane/pkg/integrations/slack"
+	"github.com/superplanehq/superplane/pkg/integrations/telegram"
+	"github.com/superplanehq/superplane/pkg/models"
+	"github.com/superplanehq/superplane/pkg/notifications"
+	"github.com/superplanehq/superplane/pkg/registry"
+	"github.com/superplanehq/superplane/pkg/services"
+	"github.com/superplanehq/superplane/pkg/utils"
+	"github.com/superplanehq/superplane/pkg/workers/contexts"
+)
+
+const (
+	NotificationDeliveryMaxAttempts = 5
+	NotificationDeliveryBaseBackoff = time.Minute
+
+	//
+	// How long notifications are claimed by the worker sending them.
+	// Longer than any delivery takes, so it's only reached
+	// if the worker stops before recording the result.
+	//
+	NotificationDeliveryClaimTimeout = 5 * time.Minute
+
+	notificationDeliveryBatchSize      = 100
+	notificationDeliveryMaxErrorLength = 1024
+)
+
+//
+// NotificationDeliveryWorker delivers the pending notifications
+// scheduled by the notification consumer. The due notifications
+// for the same user, channel and target are delivered as a single message.
+//
+// Slack and Telegram messages are sent through the Slack and Telegram
+// integrations of the organization, so they need to be installed.
+// Failed deliveries are retried with exponential backoff,
+// until NotificationDeliveryMaxAttempts is reached.
+//
+
+type NotificationDeliveryWorker struct {
+	semaphore    *semaphore.Weighted
+	encryptor    crypto.Encryptor
+	registry     *registry.Registry
+	emailService services.EmailService
+	http         core.HTTPContext
+	logger       *log.Entry
+}
+
+func NewNotificationDeliveryWorker(encryptor crypto.Encryptor, registry *registry.Registry, emailService services.EmailService, http core.HTTPContext) *NotificationDeliveryWorker {
+	return &NotificationDeliveryWorker{
+		semaphore:    semaphore.NewWeighted(25),
+		encryptor:    encryptor,
+		registry:     registry,
+		emailService: emailService,
+		http:         http,
+		logger:       log.WithFields(log.Fields{"worker": "NotificationDeliveryWorker"}),
+	}
+}
+
+func (w *NotificationDeliveryWorker) Start(ctx context.Context) {
+	ticker := time.NewTicker(1 * time.Second)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			batches, err := models.ListDuePendingNotificationBatches(notificationDeliveryBatchSize)
+			if err != nil {
+				w.logger.Errorf("Error finding pending notifications: %v", err)
+				continue
+			}
+
+			for _, batch := range batches {
+				if err := w.semaphore.Acquire(context.Background(), 1); err != nil {
+					w.logger.Errorf("Error acquiring semaphore: %v", err)
+					continue
+				}
+
+				go func(batch models.PendingNotificationBatch) {
+					defer w.semaphore.Release(1)
+
+					if err := w.LockAndProcessBatch(batch); err != nil {
+						w.logger.Errorf("Error delivering %s notifications to user %s: %v", batch.Channel, batch.UserID, err)
+					}
+				}(batch)
+			}
+		}
+	}
+}
+
+//
+// The notifications are claimed in a short transaction, and sent
+// after it commits, so no row lock is held while waiting for
+// Slack, Telegram or the webhook. The result is recorded afterwards.
+//
+
+func (w *NotificationDeliveryWorker) LockAndProcessBatch(batch models.PendingNotificationBatch) error {
+	var pending []models.PendingNotification
+	err := database.Conn().Transaction(func(tx *gorm.DB) error {
+		locked, err := models.LockDuePendingNotifications(tx, batch)
+		if err != nil {
+			return err
+		}
+
+		if len(locked) == 0 {
+			w.logger.Infof("%s notifications for user %s already being processed - skipping", batch.Channel, batch.UserID)
+			return nil
+		}
+
+		pending = locked
+		return models.ClaimPendingNotificationsInTransaction(tx, notificationIDs(pending), time.Now().Add(NotificationDeliveryClaimTimeout))
+	})
+
+	if err != nil || len(pending) == 0 {
+		return err
+	}
+
+	return w.processBatch(pending)
+}
+
+func notificationIDs(pending []models.PendingNotification) []uuid.UUID {
+	ids := make([]uuid.UUID, 0, len(pending))
+	for _, notification := range pending {
+		ids = append(ids, notification.ID)
+	}
+
+	return ids
+}
+
+func (w *NotificationDeliveryWorker) processBatch(pending []models.PendingNotification) error {
+	ids := notificationIDs(pending)
+	attempts := 0
+	for _, notification := range pending {
+		attempts = max(attempts, notification.Attempts)
+	}
+
+	first := pending[0]
+	message := notifications.Compose(pending)
+
+	err := w.send(first.OrganizationID, first.UserID, first.Batch(), message, pending)
+	if err == nil {
+		w.logger.Infof("Delivered %d %s notifications to user %s", len(pending), first.Channel, first.UserID)
+		return models.DeletePendingNotificationsInTransaction(database.Conn(), ids)
+	}
+
+	errorMessage := err.Error()
+	if len(errorMessage) > notificationDeliveryMaxErrorLength {
+		errorMessage = errorMessage[:notificationDeliveryMaxErrorLength]
+	}
+
+	if attempts+1 >= NotificationDeliveryMaxAttempts {
+		w.logger.Warnf("Giving up on %d %s notifications to user %s after %d attempts: %s", len(pending), first.Channel, first.UserID, attempts+1, errorMessage)
+		return models.DeletePendingNotificationsInTransaction(database.Conn(), ids)
+	}
+
+	nextAttemptAt := time.Now().Add(NotificationDeliveryBackoff(attempts + 1))
+	return models.MarkPendingNotificationsFailedInTransaction(database.Conn(), ids, errorMessage, nextAttemptAt)
+}
+
+func (w *NotificationDeliveryWorker) send(orgID, userID uuid.UUID, batch models.PendingNotificationBatch, message notifications.Message, pending []models.PendingNotification) error {
+	switch batch.Channel {
+	case models.NotificationChannelEmail:
+		return w.sendEmail(orgID, userID, message)
+	case models.NotificationChannelSlack:
+		return w.sendSlack(orgID, batch.Target, message)
+	case models.NotificationChannelTelegram:
+		return w.sendTelegram(orgID, batch.Target, message)
+	case models.NotificationChannelWebhook:
+		return w.sendWebhook(orgID, userID, batch.Target, message, pending)
+	default:
+		return fmt.Errorf("unknown channel %s", batch.Channel)
+	}
+}
+
+func (w *NotificationDeliveryWorker) sendEmail(orgID, userID uuid.UUID, message notifications.Message) error {
+	user, err := models.FindActiveUserByID(orgID.String(), userID.String())
+	if err != nil {
+		return fmt.Errorf("user not found: %w", err)
+	}
+
+	email := utils.NormalizeEmail(user.GetEmail())
+	if email == "" {
+		return fmt.Errorf("user has no email")
+	}
+
+	return w.emailService.SendNotificationEmail([]string{email}, message.Title, message.Body, message.URL, message.URLLabel)
+}
+
+func (w *NotificationDeliveryWorker) sendSlack(orgID uuid.UUID, memberID string, message notifications.Message) error {
+	integrationCtx, err := w.integrationContext(orgID, models.NotificationChannelSlack)
+	if err != nil {
+		return err
+	}
+
+	client, err := slack.NewClient(integrationCtx)
+	if err != nil {
+		return err
+	}
+
+	//
+	// Posting to a member ID sends a direct message from the app.
+	//
+	_, err = client.PostMessage(slack.ChatPostMessageRequest{
+		Channel: memberID,
+		Text:    notifications.Text(message),
+	})
+
+	return err
+}
+
+func (w *NotificationDeliveryWorker) sendTelegram(orgID uuid.UUID, chatID string, message notifications.Message) error {
+	integrationCtx, err := w.integrationContext(orgID, models.NotificationChannelTelegram)
+	if err != nil {
+		return err
+	}
+
+	client, err := telegram.NewClient(integrationCtx)
+	if err != nil {
+		return err
+	}
+
+	_, err = client.SendMessage(chatID, notifications.Text(message), "")
+	return err
+}
+
+func (w *NotificationDeliveryWorker) integrationContext(orgID uuid.UUID, appName string) (core.IntegrationContext, error) {
+	integration, err := models.FindOldestReadyIntegration(orgID, appName)
+	if err != nil {
+		return nil, fmt.Errorf("no %s integration ready: %w", appName, err)
+	}
+
+	return contexts.NewIntegrationContext(database.Conn(), nil, integration, w.encryptor, w.registry), nil
+}
+
+type notificationWebhookPayload struct {
+	OrganizationID string                  `json:"organization_id"`
+	UserID         string                  `json:"user_id"`
+	Message        notifications.Message   `json:"message"`
+	Notifications  []notifications.Message `json:"notifications"`
+}
+
+func (w *NotificationDeliveryWorker) sendWebhook(orgID, userID uuid.UUID, URL string, message notifications.Message, pending []models.PendingNotification) error {
+	payload := notificationWebhookPayload{
+		OrganizationID: orgID.String(),
+		UserID:         userID.String(),
+		Message:        message,
+		Notifications:  make([]notifications.Message, 0, len(pending)),
+	}
+
+	for _, notification := range pending {
+		payload.Notifications = append(payload.Notifications, notifications.Compose([]models.PendingNotification{notification}))
+	}
+
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("error marshaling payload: %w", err)
+	}
+
+	request, err := http.NewRequest(http.MethodPost, URL, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("error creating request: %w", err)
+	}
+
+	request.Header.Set("Content-Type", "application/json")
+	request.Header.Set("User-Agent", "Superplane-Webhooks")
+
+	response, err := w.http.Do(request)
+	if err != nil {
+		return err
+	}
+
+	defer response.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(response.Body, 64*1024))
+
+	if response.StatusCode < 200 || response.StatusCode >= 300 {
+		return fmt.Errorf("received status %d", response.StatusCode)
+	}
+
+	return nil
+}
+
+//
+// NotificationDeliveryBackoff returns how long to wait
+// before the next attempt, after the given number of failed attempts.
+//
+
+func NotificationDeliveryBackoff(attempts int) time.Duration {
+	backoff := NotificationDeliveryBaseBackoff
+	for i := 1; i < attempts; i++ {
+		backoff *= 2
+	}
+
+	return backoff
+}
@@ -0,0 +1,173 @@
+package workers
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/database"
+	"github.com/superplanehq/superplane/pkg/models"
+	"github.com/superplanehq/superplane/pkg/services"
+	"github.com/superplanehq/superplane/test/support"
+)
+
+func Test__NotificationDeliveryWorker(t *testing.T) {
+	r := support.Setup(t)
+	defer r.Close()
+
+	var failures atomic.Int32
+	var received atomic.Value
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if failures.Load() > 0 {
+			failures.Add(-1)
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+
+		body, _ := io.ReadAll(req.Body)
+		received.Store(body)
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	defer server.Close()
+
+	emailService := services.NewNoopEmailService()
+	worker := NewNotificationDeliveryWorker(r.Encryptor, r.Registry, emailService, http.DefaultClient)
+
+	schedule := func(t *testing.T, channel, target, title string, deliverAfter time.Time) {
+		require.NoError(t, models.CreatePendingNotification(&models.PendingNotification{
+			OrganizationID: r.Organization.ID,
+			UserID:         r.User,
+			Channel:        channel,
+			Target:         target,
+			Title:          title,
+			URL:            "https://app.superplane.com/" + title,
+			DeliverAfter:   deliverAfter,
+		}))
+	}
+
+	countPending := func(t *testing.T) int64 {
+		var count int64
+		require.NoError(t, database.Conn().Model(&models.PendingNotification{}).Count(&count).Error)
+		return count
+	}
+
+	t.Run("notifications not due yet -> not delivered", func(t *testing.T) {
+		schedule(t, models.NotificationChannelEmail, "", "later", time.Now().Add(time.Hour))
+
+		batches, err := models.ListDuePendingNotificationBatches(10)
+		require.NoError(t, err)
+		assert.Empty(t, batches)
+
+		require.NoError(t, database.Conn().Where("1 = 1").Delete(&models.PendingNotification{}).Error)
+	})
+
+	t.Run("due email notifications -> single digest email", func(t *testing.T) {
+		emailService.Reset()
+		schedule(t, models.NotificationChannelEmail, "", "first", time.Now().Add(-time.Minute))
+		schedule(t, models.NotificationChannelEmail, "", "second", time.Now().Add(-time.Minute))
+
+		batches, err := models.ListDuePendingNotificationBatches(10)
+		require.NoError(t, err)
+		require.Len(t, batches, 1)
+		require.NoError(t, worker.LockAndProcessBatch(batches[0]))
+
+		sent := emailService.SentNotificationEmails()
+		require.Len(t, sent, 1)
+		assert.Len(t, sent[0].Bcc, 1)
+		assert.Equal(t, "2 notifications", sent[0].Title)
+		assert.Contains(t, sent[0].Body, "first")
+		assert.Contains(t, sent[0].Body, "second")
+		assert.Zero(t, countPending(t))
+	})
+
+	t.Run("due webhook notification -> delivered", func(t *testing.T) {
+		schedule(t, models.NotificationChannelWebhook, server.URL, "approval", time.Now())
+
+		batches, err := models.ListDuePendingNotificationBatches(10)
+		require.NoError(t, err)
+		require.Len(t, batches, 1)
+		require.NoError(t, worker.LockAndProcessBatch(batches[0]))
+
+		payload := notificationWebhookPayload{}
+		require.NoError(t, json.Unmarshal(received.Load().([]byte), &payload))
+		assert.Equal(t, r.Organization.ID.String(), payload.OrganizationID)
+		assert.Equal(t, r.User.String(), payload.UserID)
+		assert.Equal(t, "approval", payload.Message.Title)
+		assert.Equal(t, "https://app.superplane.com/approval", payload.Message.URL)
+		require.Len(t, payload.Notifications, 1)
+		assert.Zero(t, countPending(t))
+	})
+
+	t.Run("failed delivery -> retried later", func(t *testing.T) {
+		failures.Store(1)
+		schedule(t, models.NotificationChannelWebhook, server.URL, "retry", time.Now())
+
+		batches, err := models.ListDuePendingNotificationBatches(10)
+		require.NoError(t, err)
+		require.Len(t, batches, 1)
+		require.NoError(t, worker.LockAndProcessBatch(batches[0]))
+
+		var pending models.PendingNotification
+		require.NoError(t, database.Conn().First(&pending).Error)
+		assert.Equal(t, 1, pending.Attempts)
+		require.NotNil(t, pending.LastError)
+		assert.Contains(t, *pending.LastError, "503")
+		assert.True(t, pending.DeliverAfter.After(time.Now()))
+
+		require.NoError(t, database.Conn().Model(&pending).Update("deliver_after", time.Now()).Error)
+		require.NoError(t, worker.LockAndProcessBatch(batches[0]))
+		assert.Zero(t, countPending(t))
+	})
+
+	t.Run("claimed notifications -> not sent again", func(t *testing.T) {
+		received.Store([]byte{})
+		schedule(t, models.NotificationChannelWebhook, server.URL, "claimed", time.Now())
+
+		var pending models.PendingNotification
+		require.NoError(t, database.Conn().First(&pending).Error)
+		require.NoError(t, models.ClaimPendingNotificationsInTransaction(database.Conn(), []uuid.UUID{pending.ID}, time.Now().Add(NotificationDeliveryClaimTimeout)))
+
+		require.NoError(t, worker.LockAndProcessBatch(models.PendingNotificationBatch{
+			UserID:  r.User,
+			Channel: models.NotificationChannelWebhook,
+			Target:  server.URL,
+		}))
+
+		assert.Empty(t, received.Load())
+		require.NoError(t, database.Conn().First(&pending).Error)
+		assert.Equal(t, 0, pending.Attempts)
+
+		require.NoError(t, database.Conn().Where("1 = 1").Delete(&models.PendingNotification{}).Error)
+	})
+
+	t.Run("slack without integration -> given up after max attempts", func(t *testing.T) {
+		schedule(t, models.NotificationChannelSlack, "U0123", "slack", time.Now())
+		require.NoError(t, database.Conn().
+			Model(&models.PendingNotification{}).
+			Where("1 = 1").
+			Update("attempts", NotificationDeliveryMaxAttempts-1).
+			Error)
+
+		require.NoError(t, worker.LockAndProcessBatch(models.PendingNotificationBatch{
+			UserID:  r.User,
+			Channel: models.NotificationChannelSlack,
+			Target:  "U0123",
+		}))
+
+		assert.Zero(t, countPending(t))
+	})
+}
+
+func Test__NotificationDeliveryBackoff(t *testing.T) {
+	assert.Equal(t, time.Minute, NotificationDeliveryBackoff(1))
+	assert.Equal(t, 2*time.Minute, NotificationDeliveryBackoff(2))
+	assert.Equal(t, 8*time.Minute, NotificationDeliveryBackoff(4))
+}
@@ -7,13 +7,16 @@ import (
 	"github.com/renderedtext/go-tackle"
 	log "github.com/sirupsen/logrus"
 	"github.com/superplanehq/superplane/pkg/authorization"
+	"github.com/superplanehq/superplane/pkg/database"
 	"github.com/superplanehq/superplane/pkg/grpc/actions/messages"
 	"github.com/superplanehq/superplane/pkg/logging"
 	"github.com/superplanehq/superplane/pkg/models"
+	"github.com/superplanehq/superplane/pkg/notifications"
 	protos "github.com/superplanehq/superplane/pkg/protos/components"
 	"github.com/superplanehq/superplane/pkg/services"
 	"github.com/superplanehq/superplane/pkg/utils"
 	"google.golang.org/protobuf/proto"
+	"gorm.io/gorm"
 )
 
 const NotificationEmailServiceName = "superplane" + "." + messages.WorkflowExchange + "." + messages.NotificationEmailRequestedRoutingKey + ".worker-consumer"
@@ -74,6 +77,14 @@ func (c *NotificationEmailConsumer) Stop() {
 	c.Consumer.Stop()
 }
 
+//
+// Consume fans the notification out according to the preferences of its recipients.
+// Recipients receiving it by email right away get a single email, like addresses
+// that don't belong to any user. Everything else, like Slack and Telegram messages,
+// webhooks, digests and notifications held during quiet hours, becomes a pending
+// notification, delivered by the NotificationDeliveryWorker.
+//
+
 func (c *NotificationEmailConsumer) Consume(delivery tackle.Delivery) error {
 	data := &protos.NotificationEmailRequested{}
 	err := proto.Unmarshal(delivery.Body(), data)
@@ -88,43 +99,154 @@ func (c *NotificationEmailConsumer) Consume(delivery tackle.Delivery) error {
 		return nil
 	}
 
-	recipients, err := c.resolveRecipients(orgID, data)
+	users, emails, err := c.resolveRecipients(orgID, data)
 	if err != nil {
 		log.Errorf("Error resolving notification recipients: %v", err)
 		return err
 	}
 
-	if len(recipients) == 0 {
+	if len(users) == 0 && len(emails) == 0 {
 		log.Warnf("No recipients found for notification in org %s", orgID)
 		return nil
 	}
 
+	userEmails, pending, err := c.fanOut(orgID, users, data)
+	if err != nil {
+		log.Errorf("Error scheduling notifications for org %s: %v", orgID, err)
+		return err
+	}
+
+	recipients := append(emails, userEmails...)
+	if len(recipients) == 0 {
+		log.Infof("Scheduled %d notifications for org %s", pending, orgID)
+		return nil
+	}
+
+	//
+	// Once pending notifications are scheduled, the message is not redelivered
+	// if the email fails, since that would schedule all of them again.
+	//
 	err = c.EmailService.SendNotificationEmail(recipients, data.Title, data.Body, data.Url, data.UrlLabel)
+	if err != nil && pending > 0 {
+		log.Errorf("Failed to send notification email for org %s, scheduled %d notifications: %v", orgID, pending, err)
+		return nil
+	}
+
 	if err != nil {
 		log.Errorf("Failed to send notification email for org %s: %v", orgID, err)
 		return err
 	}
 
-	log.Infof("Successfully sent notification email for org %s to %d recipients", orgID, len(recipients))
+	log.Infof("Successfully sent notification email for org %s to %d recipients, scheduled %d notifications", orgID, len(recipients), pending)
 	return nil
 }
 
-func (c *NotificationEmailConsumer) resolveRecipients(orgID uuid.UUID, data *protos.NotificationEmailRequested) ([]string, error) {
-	unique := map[string]struct{}{}
+//
+// fanOut returns the emails of the users receiving the notification
+// by email right away, and schedules it for all the other channels.
+// Pending notifications are created in a single transaction,
+// so a failure doesn't leave some of them scheduled.
+//
+
+func (c *NotificationEmailConsumer) fanOut(orgID uuid.UUID, users []models.User, data *protos.NotificationEmailRequested) ([]string, int, error) {
+	userIDs := make([]uuid.UUID, 0, len(users))
+	for _, user := range users {
+		userIDs = append(userIDs, user.ID)
+	}
+
+	preferences, err := models.ListNotificationPreferences(orgID, userIDs)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	now := time.Now()
+	emails := []string{}
+	pending := 0
+
+	err = database.Conn().Transaction(func(tx *gorm.DB) error {
+		for _, user := range users {
+			preference := preferences[user.ID]
+			for _, channel := range preference.Channels {
+				batch := models.PendingNotificationBatch{UserID: user.ID, Channel: channel.Type, Target: channel.Target}
+				batchTime, err := models.FindPendingNotificationBatchTimeInTransaction(tx, batch)
+				if err != nil {
+					return err
+				}
+
+				deliverAt, err := notifications.DeliveryTime(preference, now, batchTime)
+				if err != nil {
+					log.Warnf("Invalid notification preference for user %s: %v", user.ID, err)
+					deliverAt = now
+				}
+
+				email := utils.NormalizeEmail(user.GetEmail())
+				if channel.Type == models.NotificationChannelEmail && !deliverAt.After(now) {
+					if email != "" {
+						emails = append(emails, email)
+					}
+
+					continue
+				}
+
+				err = models.CreatePendingNotificationInTransaction(tx, &models.PendingNotification{
+					OrganizationID: orgID,
+					UserID:         user.ID,
+					Channel:        channel.Type,
+					Target:         channel.Target,
+					Title:          data.Title,
+					Body:           data.Body,
+					URL:            data.Url,
+					URLLabel:       data.UrlLabel,
+					DeliverAfter:   deliverAt,
+				})
+
+				if err != nil {
+					return err
+				}
+
+				pending++
+			}
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return emails, pending, nil
+}
+
+//
+// resolveRecipients returns the users receiving the notification,
+// and the email addresses that don't belong to any user of the organization.
+//
+
+func (c *NotificationEmailConsumer) resolveRecipients(orgID uuid.UUID, data *protos.NotificationEmailRequested) ([]models.User, []string, error) {
+	users := map[uuid.UUID]models.User{}
+	emails := map[string]struct{}{}
 
 	for _, email := range data.Emails {
 		normalized := utils.NormalizeEmail(email)
 		if normalized == "" {
 			continue
 		}
-		unique[normalized] = struct{}{}
+
+		user, err := models.FindActiveUserByEmail(orgID.String(), normalized)
+		if err != nil {
+			emails[normalized] = struct{}{}
+			continue
+		}
+
+		users[user.ID] = *user
 	}
 
 	if c.AuthService == nil {
 		if len(data.Groups) > 0 || len(data.Roles) > 0 {
 			log.Warn("Notification email consumer cannot resolve group/role recipients without auth service")
 		}
-		return mapKeys(unique), nil
+		return userValues(users), mapKeys(emails), nil
 	}
 
 	for _, group := range data.Groups {
@@ -134,7 +256,7 @@ func (c *NotificationEmailConsumer) resolveRecipients(orgID uuid.UUID, data *pro
 			continue
 		}
 
-		addUsersToRecipientSet(orgID, userIDs, unique)
+		addUsersToRecipientSet(orgID, userIDs, users)
 	}
 
 	for _, role := range data.Roles {
@@ -144,13 +266,13 @@ func (c *NotificationEmailConsumer) resolveRecipients(orgID uuid.UUID, data *pro
 			continue
 		}
 
-		addUsersToRecipientSet(orgID, userIDs, unique)
+		addUsersToRecipientSet(orgID, userIDs, users)
 	}
 
-	return mapKeys(unique), nil
+	return userValues(users), mapKeys(emails), nil
 }
 
-func addUsersToRecipientSet(orgID uuid.UUID, userIDs []string, recipients map[string]struct{}) {
+func addUsersToRecipientSet(orgID uuid.UUID, userIDs []string, recipients map[uuid.UUID]models.User) {
 	users, err := models.ListActiveUsersByID(orgID.String(), userIDs)
 	if err != nil {
 		log.Errorf("Error finding users for notification: %v", err)
@@ -158,13 +280,16 @@ func addUsersToRecipientSet(orgID uuid.UUID, userIDs []string, recipients map[st
 	}
 
 	for _, user := range users {
-		normalized := utils.NormalizeEmail(user.GetEmail())
-		if normalized == "" {
-			continue
-		}
+		recipients[user.ID] = user
+	}
+}
 
-		recipients[normalized] = struct{}{}
+func userValues(input map[uuid.UUID]models.User) []models.User {
+	result := make([]models.User, 0, len(input))
+	for _, user := range input {
+		result = append(result, user)
 	}
+	return result
 }
 
 func mapKeys(input map[string]struct{}) []string {
@@ -1,16 +1,22 @@
 package workers
 
 import (
+	"errors"
 	"sort"
 	"testing"
 	"time"
 
+	amqp "github.com/rabbitmq/amqp091-go"
+	"github.com/renderedtext/go-tackle"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/database"
 	"github.com/superplanehq/superplane/pkg/grpc/actions/messages"
 	"github.com/superplanehq/superplane/pkg/models"
+	protos "github.com/superplanehq/superplane/pkg/protos/components"
 	"github.com/superplanehq/superplane/pkg/services"
 	"github.com/superplanehq/superplane/test/support"
+	"google.golang.org/protobuf/proto"
 )
 
 func Test__NotificationEmailConsumer(t *testing.T) {
@@ -91,4 +97,97 @@ func Test__NotificationEmailConsumer(t *testing.T) {
 		assert.Equal(t, "https://app.superplane.com/approvals/123", sentEmails[0].URL)
 		assert.Equal(t, "Review approval", sentEmails[0].URLLabel)
 	})
+	t.Run("should fan out according to notification preferences", func(t *testing.T) {
+		testEmailService.Reset()
+
+		emailUser := support.CreateUser(t, r, r.Organization.ID)
+		webhookUser := support.CreateUser(t, r, r.Organization.ID)
+		require.NoError(t, models.SaveNotificationPreference(&models.NotificationPreference{
+			UserID:         webhookUser.ID,
+			OrganizationID: r.Organization.ID,
+			Channels: []models.NotificationChannel{
+				{Type: models.NotificationChannelEmail},
+				{Type: models.NotificationChannelWebhook, Target: "https://example.com/hook"},
+			},
+			Timezone:              "UTC",
+			DigestIntervalMinutes: 60,
+		}))
+
+		message := messages.NewNotificationEmailRequestedMessage(
+			r.Organization.ID.String(),
+			"Run failed",
+			"",
+			"",
+			"",
+			[]string{emailUser.GetEmail(), webhookUser.GetEmail()},
+			nil,
+			nil,
+		)
+
+		require.NoError(t, message.Publish())
+
+		require.Eventually(t, func() bool {
+			return len(testEmailService.SentNotificationEmails()) > 0
+		}, time.Second*5, 100*time.Millisecond)
+
+		sentEmails := testEmailService.SentNotificationEmails()
+		require.Len(t, sentEmails, 1)
+		assert.Equal(t, []string{emailUser.GetEmail()}, sentEmails[0].Bcc)
+
+		var pending []models.PendingNotification
+		require.NoError(t, database.Conn().Where("user_id = ?", webhookUser.ID).Order("channel").Find(&pending).Error)
+		require.Len(t, pending, 2)
+		assert.Equal(t, models.NotificationChannelEmail, pending[0].Channel)
+		assert.Equal(t, models.NotificationChannelWebhook, pending[1].Channel)
+		assert.Equal(t, "https://example.com/hook", pending[1].Target)
+		assert.Equal(t, "Run failed", pending[1].Title)
+		assert.True(t, pending[1].DeliverAfter.After(time.Now().Add(50*time.Minute)))
+	})
+}
+
+type failingEmailService struct {
+	*services.NoopEmailService
+}
+
+func (s *failingEmailService) SendNotificationEmail(bccEmails []string, title, body, url, urlLabel string) error {
+	return errors.New("email provider is down")
+}
+
+func Test__NotificationEmailConsumer__EmailFailure(t *testing.T) {
+	r := support.SetupWithOptions(t, support.SetupOptions{})
+	consumer := NewNotificationEmailConsumer("", &failingEmailService{services.NewNoopEmailService()}, r.AuthService)
+
+	consume := func(emails ...string) error {
+		data, err := proto.Marshal(&protos.NotificationEmailRequested{
+			OrganizationId: r.Organization.ID.String(),
+			Title:          "Run failed",
+			Emails:         emails,
+		})
+
+		require.NoError(t, err)
+		return consumer.Consume(tackle.NewDelivery(&amqp.Delivery{Body: data}))
+	}
+
+	t.Run("nothing scheduled -> error, so the message is redelivered", func(t *testing.T) {
+		require.Error(t, consume("external@example.com"))
+	})
+
+	t.Run("notifications scheduled -> no error, so they are not scheduled again", func(t *testing.T) {
+		webhookUser := support.CreateUser(t, r, r.Organization.ID)
+		require.NoError(t, models.SaveNotificationPreference(&models.NotificationPreference{
+			UserID:         webhookUser.ID,
+			OrganizationID: r.Organization.ID,
+			Channels: []models.NotificationChannel{
+				{Type: models.NotificationChannelWebhook, Target: "https://example.com/hook"},
+			},
+			Timezone: "UTC",
+		}))
+
+		require.NoError(t, consume(webhookUser.GetEmail(), "external@example.com"))
+
+		var pending []models.PendingNotification
+		require.NoError(t, database.Conn().Where("user_id = ?", webhookUser.ID).Find(&pending).Error)
+		require.Len(t, pending, 1)
+		assert.Equal(t, models.NotificationChannelWebhook, pending[0].Channel)
+	})
 }
@@ -40,11 +40,13 @@ START_CONSUMERS="${START_CONSUMERS:-yes}"
 START_WEB_SERVER="${START_WEB_SERVER:-yes}"
 JWT_SECRET="${JWT_SECRET:-}"
 OIDC_KEYS_PATH="${OIDC_KEYS_PATH:-/app/data/oidc-keys}"
+ARTIFACT_STORAGE_PATH="${ARTIFACT_STORAGE_PATH:-/app/data/artifacts}"
 START_EVENT_DISTRIBUTER="${START_EVENT_DISTRIBUTER:-yes}"
 START_EVENT_ROUTER="${START_EVENT_ROUTER:-yes}"
 START_NODE_EXECUTOR="${START_NODE_EXECUTOR:-yes}"
 START_NODE_QUEUE_WORKER="${START_NODE_QUEUE_WORKER:-yes}"
 START_NODE_REQUEST_WORKER="${START_NODE_REQUEST_WORKER:-yes}"
+START_EVENT_SUBSCRIPTION_DELIVERY_WORKER="${START_EVENT_SUBSCRIPTION_DELIVERY_WORKER:-yes}"
 START_INTEGRATION_REQUEST_WORKER="${START_INTEGRATION_REQUEST_WORKER:-yes}"
 START_WEBHOOK_PROVISIONER="${START_WEBHOOK_PROVISIONER:-yes}"
 START_WEBHOOK_CLEANUP_WORKER="${START_WEBHOOK_CLEANUP_WORKER:-yes}"
@@ -134,6 +136,7 @@ export START_EVENT_ROUTER="${START_EVENT_ROUTER}"
 export START_NODE_EXECUTOR="${START_NODE_EXECUTOR}"
 export START_NODE_QUEUE_WORKER="${START_NODE_QUEUE_WORKER}"
 export START_NODE_REQUEST_WORKER="${START_NODE_REQUEST_WORKER}"
+export START_EVENT_SUBSCRIPTION_DELIVERY_WORKER="${START_EVENT_SUBSCRIPTION_DELIVERY_WORKER}"
 export START_INTEGRATION_REQUEST_WORKER="${START_INTEGRATION_REQUEST_WORKER}"
 export START_WEBHOOK_PROVISIONER="${START_WEBHOOK_PROVISIONER}"
 export START_WEBHOOK_CLEANUP_WORKER="${START_WEBHOOK_CLEANUP_WORKER}"
@@ -141,6 +144,7 @@ export START_CANVAS_CLEANUP_WORKER="${START_CANVAS_CLEANUP_WORKER}"
 export ENCRYPTION_KEY="${ENCRYPTION_KEY}"
 export JWT_SECRET="${JWT_SECRET}"
 export OIDC_KEYS_PATH="${OIDC_KEYS_PATH}"
+export ARTIFACT_STORAGE_PATH="${ARTIFACT_STORAGE_PATH}"
 export SESSION_SECRET="${SESSION_SECRET}"
 export NO_ENCRYPTION="${NO_ENCRYPTION}"
 export SUPERPLANE_BEACON_ENABLED="${SUPERPLANE_BEACON_ENABLED}"
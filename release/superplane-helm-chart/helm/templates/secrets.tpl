@@ -39,6 +39,14 @@
 {{- end }}
 {{- end }}
 
+{{- define "secrets.artifacts.name" }}
+{{- if eq .Values.artifacts.secretName "" }}
+{{- printf "%s-artifacts" .Release.Name }}
+{{- else }}
+{{- .Values.artifacts.secretName }}
+{{- end }}
+{{- end }}
+
 {{- define "secrets.encryption.name" }}
 {{- if eq .Values.encryption.secretName "" }}
 {{- printf "%s-encryption" .Release.Name }}
@@ -106,6 +106,8 @@ TEMPLATE_DIR=/app/templates
 ENCRYPTION_KEY=${ENCRYPTION_KEY}
 JWT_SECRET=${JWT_SECRET}
 OIDC_KEYS_PATH=${OIDC_KEYS_CONTAINER_PATH}
+ARTIFACT_STORAGE=filesystem
+ARTIFACT_STORAGE_PATH=/app/artifacts
 SESSION_SECRET=${SESSION_SECRET}
 NO_ENCRYPTION=no
 
@@ -122,6 +124,7 @@ START_EVENT_ROUTER=yes
 START_NODE_EXECUTOR=yes
 START_NODE_QUEUE_WORKER=yes
 START_NODE_REQUEST_WORKER=yes
+START_EVENT_SUBSCRIPTION_DELIVERY_WORKER=yes
 START_INTEGRATION_REQUEST_WORKER=yes
 START_WEBHOOK_PROVISIONER=yes
 START_WEBHOOK_CLEANUP_WORKER=yes
@@ -0,0 +1,71 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/superplanehq/superplane/pkg/canvaslint"
+	"github.com/superplanehq/superplane/pkg/crypto"
+	"github.com/superplanehq/superplane/pkg/grpc/actions"
+	"github.com/superplanehq/superplane/pkg/registry"
+
+	// Import server to auto-register all integrations, components, and triggers via init()
+	_ "github.com/superplanehq/superplane/pkg/server"
+)
+
+const (
+	catalogPath = "pkg/canvaslint/catalog.json"
+	schemaPath  = "docs/schemas/canvas.schema.json"
+)
+
+func main() {
+	reg, err := registry.NewRegistry(crypto.NewNoOpEncryptor(), registry.HTTPOptions{})
+	if err != nil {
+		exitWithError(err)
+	}
+
+	components := reg.ListComponents()
+	triggers := reg.ListTriggers()
+	for _, integration := range reg.ListIntegrations() {
+		components = append(components, integration.Components()...)
+		triggers = append(triggers, integration.Triggers()...)
+	}
+
+	catalog := canvaslint.NewCatalog(components, triggers, reg.ListWidgets())
+	for _, trigger := range catalog.Triggers {
+		trigger.Configuration = actions.AppendGlobalTriggerFields(trigger.Configuration)
+	}
+
+	data, err := catalog.Marshal()
+	if err != nil {
+		exitWithError(err)
+	}
+
+	if err := writeFile(catalogPath, data); err != nil {
+		exitWithError(err)
+	}
+
+	schema, err := json.MarshalIndent(canvaslint.Schema(catalog), "", "  ")
+	if err != nil {
+		exitWithError(err)
+	}
+
+	if err := writeFile(schemaPath, schema); err != nil {
+		exitWithError(err)
+	}
+}
+
+func writeFile(path string, data []byte) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+
+	return os.WriteFile(path, append(data, '\n'), 0o644)
+}
+
+func exitWithError(err error) {
+	fmt.Fprintln(os.Stderr, err)
+	os.Exit(1)
+}
@@ -0,0 +1,180 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/superplanehq/superplane/pkg/openapi_client"
+)
+
+var blueprintSpecFields = []string{"nodes", "edges", "configuration", "outputChannels", "icon", "color"}
+
+type blueprintResource struct {
+	resourceBase
+}
+
+type blueprintModel struct {
+	ID          types.String         `tfsdk:"id"`
+	Name        types.String         `tfsdk:"name"`
+	Description types.String         `tfsdk:"description"`
+	Spec        jsontypes.Normalized `tfsdk:"spec"`
+}
+
+func newBlueprintResource() resource.Resource {
+	return &blueprintResource{}
+}
+
+func (r *blueprintResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_blueprint"
+}
+
+func (r *blueprintResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "A blueprint: a reusable group of nodes that canvases use as a single component.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description:   "ID of the blueprint, used to reference it from canvas nodes.",
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"name": schema.StringAttribute{
+				Description: "Name of the blueprint, unique in the organization.",
+				Required:    true,
+			},
+			"description": schema.StringAttribute{
+				Description: "Description of the blueprint.",
+				Optional:    true,
+			},
+			"spec": schema.StringAttribute{
+				Description: "JSON object with the nodes, edges, configuration, outputChannels, icon and color of the blueprint.",
+				CustomType:  jsontypes.NormalizedType{},
+				Required:    true,
+			},
+		},
+	}
+}
+
+func (r *blueprintResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan blueprintModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	blueprint, err := plan.toAPI()
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("spec"), "Invalid blueprint spec", err.Error())
+		return
+	}
+
+	request := openapi_client.BlueprintsCreateBlueprintRequest{}
+	request.SetBlueprint(blueprint)
+	response, _, err := r.client.api.BlueprintAPI.BlueprintsCreateBlueprint(ctx).Body(request).Execute()
+	if err != nil {
+		addAPIError(&resp.Diagnostics, "Error creating blueprint", err)
+		return
+	}
+
+	created := response.GetBlueprint()
+	plan.ID = types.StringValue(created.GetId())
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *blueprintResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state blueprintModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, httpResponse, err := r.client.api.BlueprintAPI.BlueprintsDescribeBlueprint(ctx, state.ID.ValueString()).Execute()
+	if isNotFound(httpResponse) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		addAPIError(&resp.Diagnostics, "Error reading blueprint", err)
+		return
+	}
+
+	blueprint := response.GetBlueprint()
+	spec := map[string]any{}
+	err = convert(blueprint, &spec)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading blueprint", err.Error())
+		return
+	}
+
+	removeServerNodeFields(spec)
+	state.Spec, err = reconcileJSON(state.Spec, pick(spec, blueprintSpecFields...))
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading blueprint", err.Error())
+		return
+	}
+
+	state.Name = types.StringValue(blueprint.GetName())
+	state.Description = optionalString(blueprint.GetDescription())
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+func (r *blueprintResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan blueprintModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	blueprint, err := plan.toAPI()
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("spec"), "Invalid blueprint spec", err.Error())
+		return
+	}
+
+	body := openapi_client.BlueprintsUpdateBlueprintBody{}
+	body.SetBlueprint(blueprint)
+	_, _, err = r.client.api.BlueprintAPI.BlueprintsUpdateBlueprint(ctx, plan.ID.ValueString()).Body(body).Execute()
+	if err != nil {
+		addAPIError(&resp.Diagnostics, "Error updating blueprint", err)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *blueprintResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state blueprintModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, httpResponse, err := r.client.api.BlueprintAPI.BlueprintsDeleteBlueprint(ctx, state.ID.ValueString()).Execute()
+	if err != nil && !isNotFound(httpResponse) {
+		addAPIError(&resp.Diagnostics, "Error deleting blueprint", err)
+	}
+}
+
+func (r *blueprintResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func (m blueprintModel) toAPI() (openapi_client.BlueprintsBlueprint, error) {
+	blueprint := openapi_client.BlueprintsBlueprint{}
+	spec, err := decodeObject(m.Spec)
+	if err != nil {
+		return blueprint, err
+	}
+
+	fields := compact(pick(spec, blueprintSpecFields...)).(map[string]any)
+	fields["name"] = m.Name.ValueString()
+	fields["description"] = m.Description.ValueString()
+	err = convert(fields, &blueprint)
+	return blueprint, err
+}
@@ -0,0 +1,184 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/superplanehq/superplane/pkg/openapi_client"
+)
+
+type canvasResource struct {
+	resourceBase
+}
+
+type canvasModel struct {
+	ID          types.String         `tfsdk:"id"`
+	Name        types.String         `tfsdk:"name"`
+	Description types.String         `tfsdk:"description"`
+	Spec        jsontypes.Normalized `tfsdk:"spec"`
+}
+
+func newCanvasResource() resource.Resource {
+	return &canvasResource{}
+}
+
+func (r *canvasResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_canvas"
+}
+
+func (r *canvasResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "A canvas: a workflow of connected triggers, components and blueprints.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description:   "ID of the canvas.",
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"name": schema.StringAttribute{
+				Description: "Name of the canvas, unique in the organization.",
+				Required:    true,
+			},
+			"description": schema.StringAttribute{
+				Description: "Description of the canvas.",
+				Optional:    true,
+			},
+			"spec": schema.StringAttribute{
+				Description: "JSON object with the nodes and edges of the canvas, as in the spec of a canvas file.",
+				CustomType:  jsontypes.NormalizedType{},
+				Required:    true,
+			},
+		},
+	}
+}
+
+func (r *canvasResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan canvasModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	canvas, err := plan.toAPI()
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("spec"), "Invalid canvas spec", err.Error())
+		return
+	}
+
+	request := openapi_client.CanvasesCreateCanvasRequest{}
+	request.SetCanvas(canvas)
+	response, _, err := r.client.api.CanvasAPI.CanvasesCreateCanvas(ctx).Body(request).Execute()
+	if err != nil {
+		addAPIError(&resp.Diagnostics, "Error creating canvas", err)
+		return
+	}
+
+	created := response.GetCanvas()
+	metadata := created.GetMetadata()
+	plan.ID = types.StringValue(metadata.GetId())
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *canvasResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state canvasModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, httpResponse, err := r.client.api.CanvasAPI.CanvasesDescribeCanvas(ctx, state.ID.ValueString()).Execute()
+	if isNotFound(httpResponse) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		addAPIError(&resp.Diagnostics, "Error reading canvas", err)
+		return
+	}
+
+	canvas := response.GetCanvas()
+	metadata := canvas.GetMetadata()
+	spec := map[string]any{}
+	err = convert(canvas.GetSpec(), &spec)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading canvas", err.Error())
+		return
+	}
+
+	removeServerNodeFields(spec)
+	state.Spec, err = reconcileJSON(state.Spec, pick(spec, "nodes", "edges"))
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading canvas", err.Error())
+		return
+	}
+
+	state.Name = types.StringValue(metadata.GetName())
+	state.Description = optionalString(metadata.GetDescription())
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+func (r *canvasResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan canvasModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	canvas, err := plan.toAPI()
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("spec"), "Invalid canvas spec", err.Error())
+		return
+	}
+
+	body := openapi_client.CanvasesUpdateCanvasBody{}
+	body.SetCanvas(canvas)
+	_, _, err = r.client.api.CanvasAPI.CanvasesUpdateCanvas(ctx, plan.ID.ValueString()).Body(body).Execute()
+	if err != nil {
+		addAPIError(&resp.Diagnostics, "Error updating canvas", err)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *canvasResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state canvasModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, httpResponse, err := r.client.api.CanvasAPI.CanvasesDeleteCanvas(ctx, state.ID.ValueString()).Execute()
+	if err != nil && !isNotFound(httpResponse) {
+		addAPIError(&resp.Diagnostics, "Error deleting canvas", err)
+	}
+}
+
+func (r *canvasResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func (m canvasModel) toAPI() (openapi_client.CanvasesCanvas, error) {
+	canvas := openapi_client.CanvasesCanvas{}
+	spec, err := decodeObject(m.Spec)
+	if err != nil {
+		return canvas, err
+	}
+
+	err = convert(map[string]any{
+		"metadata": map[string]any{
+			"name":        m.Name.ValueString(),
+			"description": m.Description.ValueString(),
+		},
+		"spec": compact(pick(spec, "nodes", "edges")),
+	}, &canvas)
+
+	return canvas, err
+}
@@ -0,0 +1,130 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/superplanehq/superplane/pkg/openapi_client"
+)
+
+const organizationDomainType = openapi_client.AUTHORIZATIONDOMAINTYPE_DOMAIN_TYPE_ORGANIZATION
+
+//
+// client is shared by all resources and data sources.
+// The organization is the one of the API token, found on first use.
+//
+
+type client struct {
+	api *openapi_client.APIClient
+
+	mu             sync.Mutex
+	organizationID string
+}
+
+func newClient(url, token, version string) *client {
+	config := openapi_client.NewConfiguration()
+	config.Servers = openapi_client.ServerConfigurations{{URL: url}}
+	config.DefaultHeader["Authorization"] = "Bearer " + token
+	config.UserAgent = "terraform-provider-superplane/" + version
+	config.HTTPClient = &http.Client{Timeout: 30 * time.Second}
+
+	return &client{api: openapi_client.NewAPIClient(config)}
+}
+
+func (c *client) organization(ctx context.Context) (string, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.organizationID != "" {
+		return c.organizationID, nil
+	}
+
+	me, _, err := c.api.MeAPI.MeMe(ctx).Execute()
+	if err != nil {
+		return "", err
+	}
+
+	if strings.TrimSpace(me.GetOrganizationId()) == "" {
+		return "", fmt.Errorf("organization id not found for the API token")
+	}
+
+	c.organizationID = me.GetOrganizationId()
+	return c.organizationID, nil
+}
+
+//
+// Resources and data sources embed these to receive the client.
+//
+
+type resourceBase struct {
+	client *client
+}
+
+func (r *resourceBase) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.client = providerClient(req.ProviderData, &resp.Diagnostics)
+}
+
+type dataSourceBase struct {
+	client *client
+}
+
+func (d *dataSourceBase) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	d.client = providerClient(req.ProviderData, &resp.Diagnostics)
+}
+
+func providerClient(data any, diags *diag.Diagnostics) *client {
+	if data == nil {
+		return nil
+	}
+
+	c, ok := data.(*client)
+	if !ok {
+		diags.AddError("Unexpected provider data", fmt.Sprintf("expected *client, got %T", data))
+		return nil
+	}
+
+	return c
+}
+
+func isNotFound(response *http.Response) bool {
+	return response != nil && response.StatusCode == http.StatusNotFound
+}
+
+//
+// addAPIError reports an API error with the message the server returned,
+// instead of only its status.
+//
+
+func addAPIError(diags *diag.Diagnostics, summary string, err error) {
+	detail := err.Error()
+
+	var apiErr *openapi_client.GenericOpenAPIError
+	if errors.As(err, &apiErr) {
+		var body struct {
+			Message string `json:"message"`
+		}
+
+		if json.Unmarshal(apiErr.Body(), &body) == nil && body.Message != "" {
+			detail = body.Message
+		}
+	}
+
+	diags.AddError(summary, detail)
+}
+
+func diagnosticsError(diags diag.Diagnostics) error {
+	for _, d := range diags.Errors() {
+		return fmt.Errorf("%s: %s", d.Summary(), d.Detail())
+	}
+
+	return nil
+}
@@ -0,0 +1,108 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type componentDataSource struct {
+	dataSourceBase
+}
+
+type componentModel struct {
+	Name           types.String         `tfsdk:"name"`
+	Label          types.String         `tfsdk:"label"`
+	Description    types.String         `tfsdk:"description"`
+	Icon           types.String         `tfsdk:"icon"`
+	Color          types.String         `tfsdk:"color"`
+	OutputChannels []types.String       `tfsdk:"output_channels"`
+	Configuration  jsontypes.Normalized `tfsdk:"configuration"`
+}
+
+func newComponentDataSource() datasource.DataSource {
+	return &componentDataSource{}
+}
+
+func (d *componentDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_component"
+}
+
+func (d *componentDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "A component that canvas and blueprint nodes can use.",
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Description: "Name of the component, like http or approval.",
+				Required:    true,
+			},
+			"label": schema.StringAttribute{
+				Description: "Label of the component.",
+				Computed:    true,
+			},
+			"description": schema.StringAttribute{
+				Description: "Description of the component.",
+				Computed:    true,
+			},
+			"icon": schema.StringAttribute{
+				Description: "Icon of the component.",
+				Computed:    true,
+			},
+			"color": schema.StringAttribute{
+				Description: "Color of the component.",
+				Computed:    true,
+			},
+			"output_channels": schema.ListAttribute{
+				Description: "Names of the output channels of the component, to connect edges from.",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"configuration": schema.StringAttribute{
+				Description: "JSON list with the configuration fields of the component.",
+				CustomType:  jsontypes.NormalizedType{},
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (d *componentDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config componentModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, _, err := d.client.api.ComponentAPI.ComponentsDescribeComponent(ctx, config.Name.ValueString()).Execute()
+	if err != nil {
+		addAPIError(&resp.Diagnostics, "Error reading component", err)
+		return
+	}
+
+	component := response.GetComponent()
+	configuration, err := json.Marshal(component.GetConfiguration())
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading component", err.Error())
+		return
+	}
+
+	state := componentModel{
+		Name:           types.StringValue(component.GetName()),
+		Label:          types.StringValue(component.GetLabel()),
+		Description:    types.StringValue(component.GetDescription()),
+		Icon:           types.StringValue(component.GetIcon()),
+		Color:          types.StringValue(component.GetColor()),
+		OutputChannels: []types.String{},
+		Configuration:  jsontypes.NewNormalizedValue(string(configuration)),
+	}
+
+	for _, channel := range component.GetOutputChannels() {
+		state.OutputChannels = append(state.OutputChannels, types.StringValue(channel.GetName()))
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
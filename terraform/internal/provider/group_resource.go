@@ -0,0 +1,230 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/superplanehq/superplane/pkg/openapi_client"
+)
+
+type groupResource struct {
+	resourceBase
+}
+
+type groupModel struct {
+	Name        types.String `tfsdk:"name"`
+	Role        types.String `tfsdk:"role"`
+	DisplayName types.String `tfsdk:"display_name"`
+	Description types.String `tfsdk:"description"`
+}
+
+func newGroupResource() resource.Resource {
+	return &groupResource{}
+}
+
+func (r *groupResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_group"
+}
+
+func (r *groupResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "A group of users of the organization, who share a role.",
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Description:   "Name of the group, unique in the organization. Changing it replaces the group.",
+				Required:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"role": schema.StringAttribute{
+				Description: "Name of the role of the group members.",
+				Required:    true,
+			},
+			"display_name": schema.StringAttribute{
+				Description:   "Display name of the group. Defaults to its name.",
+				Optional:      true,
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"description": schema.StringAttribute{
+				Description:   "Description of the group.",
+				Optional:      true,
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+		},
+	}
+}
+
+func (r *groupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan groupModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	organizationID, err := r.client.organization(ctx)
+	if err != nil {
+		addAPIError(&resp.Diagnostics, "Error finding organization", err)
+		return
+	}
+
+	request := openapi_client.GroupsCreateGroupRequest{}
+	request.SetDomainType(organizationDomainType)
+	request.SetDomainId(organizationID)
+	request.SetGroup(plan.toAPI())
+	_, _, err = r.client.api.GroupsAPI.GroupsCreateGroup(ctx).Body(request).Execute()
+	if err != nil {
+		addAPIError(&resp.Diagnostics, "Error creating group", err)
+		return
+	}
+
+	found := r.read(ctx, &plan, &resp.Diagnostics)
+	if !found && !resp.Diagnostics.HasError() {
+		resp.Diagnostics.AddError("Error reading group", "group "+plan.Name.ValueString()+" not found")
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *groupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state groupModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	found := r.read(ctx, &state, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !found {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+func (r *groupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan groupModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	organizationID, err := r.client.organization(ctx)
+	if err != nil {
+		addAPIError(&resp.Diagnostics, "Error finding organization", err)
+		return
+	}
+
+	body := openapi_client.GroupsUpdateGroupBody{}
+	body.SetDomainType(organizationDomainType)
+	body.SetDomainId(organizationID)
+	body.SetGroup(plan.toAPI())
+	_, _, err = r.client.api.GroupsAPI.GroupsUpdateGroup(ctx, plan.Name.ValueString()).Body(body).Execute()
+	if err != nil {
+		addAPIError(&resp.Diagnostics, "Error updating group", err)
+		return
+	}
+
+	found := r.read(ctx, &plan, &resp.Diagnostics)
+	if !found && !resp.Diagnostics.HasError() {
+		resp.Diagnostics.AddError("Error reading group", "group "+plan.Name.ValueString()+" not found")
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *groupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state groupModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	organizationID, err := r.client.organization(ctx)
+	if err != nil {
+		addAPIError(&resp.Diagnostics, "Error finding organization", err)
+		return
+	}
+
+	_, httpResponse, err := r.client.api.GroupsAPI.GroupsDeleteGroup(ctx, state.Name.ValueString()).
+		DomainType(string(organizationDomainType)).
+		DomainId(organizationID).
+		Execute()
+
+	if err != nil && !isNotFound(httpResponse) {
+		addAPIError(&resp.Diagnostics, "Error deleting group", err)
+	}
+}
+
+func (r *groupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
+}
+
+//
+// read sets the model from the group in the API,
+// and reports whether the group still exists.
+//
+
+func (r *groupResource) read(ctx context.Context, model *groupModel, diags *diag.Diagnostics) bool {
+	organizationID, err := r.client.organization(ctx)
+	if err != nil {
+		addAPIError(diags, "Error finding organization", err)
+		return false
+	}
+
+	response, httpResponse, err := r.client.api.GroupsAPI.GroupsDescribeGroup(ctx, model.Name.ValueString()).
+		DomainType(string(organizationDomainType)).
+		DomainId(organizationID).
+		Execute()
+
+	if isNotFound(httpResponse) {
+		return false
+	}
+
+	if err != nil {
+		addAPIError(diags, "Error reading group", err)
+		return false
+	}
+
+	group := response.GetGroup()
+	metadata := group.GetMetadata()
+	spec := group.GetSpec()
+	model.Name = types.StringValue(metadata.GetName())
+	model.Role = types.StringValue(spec.GetRole())
+	model.DisplayName = types.StringValue(spec.GetDisplayName())
+	model.Description = types.StringValue(spec.GetDescription())
+	return true
+}
+
+func (m groupModel) toAPI() openapi_client.GroupsGroup {
+	metadata := openapi_client.GroupsGroupMetadata{}
+	metadata.SetName(m.Name.ValueString())
+
+	spec := openapi_client.GroupsGroupSpec{}
+	spec.SetRole(m.Role.ValueString())
+	spec.SetDisplayName(m.DisplayName.ValueString())
+	spec.SetDescription(m.Description.ValueString())
+
+	group := openapi_client.GroupsGroup{}
+	group.SetMetadata(metadata)
+	group.SetSpec(spec)
+	return group
+}
@@ -0,0 +1,283 @@
+package provider
+
+import (
+	"context"
+	"maps"
+
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/superplanehq/superplane/pkg/openapi_client"
+)
+
+//
+// The API returns sensitive configuration fields with this value,
+// and keeps the stored value of fields updated with it.
+//
+
+const redactedValue = "<redacted>"
+
+type integrationResource struct {
+	resourceBase
+}
+
+type integrationModel struct {
+	ID                     types.String         `tfsdk:"id"`
+	Name                   types.String         `tfsdk:"name"`
+	IntegrationName        types.String         `tfsdk:"integration_name"`
+	Configuration          jsontypes.Normalized `tfsdk:"configuration"`
+	SensitiveConfiguration types.Map            `tfsdk:"sensitive_configuration"`
+}
+
+func newIntegrationResource() resource.Resource {
+	return &integrationResource{}
+}
+
+func (r *integrationResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_integration"
+}
+
+func (r *integrationResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "An integration of the organization with an external service, like GitHub or Semaphore.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description:   "ID of the integration.",
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"name": schema.StringAttribute{
+				Description: "Name of the integration, unique in the organization.",
+				Required:    true,
+			},
+			"integration_name": schema.StringAttribute{
+				Description:   "Kind of integration, like github. Changing it replaces the integration.",
+				Required:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"configuration": schema.StringAttribute{
+				Description: "JSON object with the configuration of the integration, without its sensitive fields.",
+				CustomType:  jsontypes.NormalizedType{},
+				Optional:    true,
+			},
+			"sensitive_configuration": schema.MapAttribute{
+				Description: "Sensitive fields of the configuration, like tokens. Imported integrations read them as " + redactedValue + ", which keeps the stored values.",
+				ElementType: types.StringType,
+				Optional:    true,
+				Sensitive:   true,
+			},
+		},
+	}
+}
+
+func (r *integrationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan integrationModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	organizationID, err := r.client.organization(ctx)
+	if err != nil {
+		addAPIError(&resp.Diagnostics, "Error finding organization", err)
+		return
+	}
+
+	configuration, err := plan.configuration(ctx)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("configuration"), "Invalid integration configuration", err.Error())
+		return
+	}
+
+	body := openapi_client.OrganizationsCreateIntegrationBody{}
+	body.SetName(plan.Name.ValueString())
+	body.SetIntegrationName(plan.IntegrationName.ValueString())
+	body.SetConfiguration(configuration)
+	response, _, err := r.client.api.OrganizationAPI.OrganizationsCreateIntegration(ctx, organizationID).Body(body).Execute()
+	if err != nil {
+		addAPIError(&resp.Diagnostics, "Error creating integration", err)
+		return
+	}
+
+	integration := response.GetIntegration()
+	metadata := integration.GetMetadata()
+	plan.ID = types.StringValue(metadata.GetId())
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *integrationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state integrationModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	organizationID, err := r.client.organization(ctx)
+	if err != nil {
+		addAPIError(&resp.Diagnostics, "Error finding organization", err)
+		return
+	}
+
+	response, _, err := r.client.api.OrganizationAPI.OrganizationsDescribeIntegration(ctx, organizationID, state.ID.ValueString()).Execute()
+	if err != nil {
+		exists, listErr := r.exists(ctx, organizationID, state.ID.ValueString())
+		if listErr == nil && !exists {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		addAPIError(&resp.Diagnostics, "Error reading integration", err)
+		return
+	}
+
+	current := map[string]string{}
+	if !state.SensitiveConfiguration.IsNull() {
+		resp.Diagnostics.Append(state.SensitiveConfiguration.ElementsAs(ctx, &current, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	integration := response.GetIntegration()
+	metadata := integration.GetMetadata()
+	spec := integration.GetSpec()
+	configuration := map[string]any{}
+	sensitive := map[string]string{}
+	for key, value := range spec.GetConfiguration() {
+		if value != redactedValue {
+			configuration[key] = value
+			continue
+		}
+
+		sensitive[key] = redactedValue
+		if v, ok := current[key]; ok {
+			sensitive[key] = v
+		}
+	}
+
+	if len(configuration) > 0 || !state.Configuration.IsNull() {
+		state.Configuration, err = reconcileJSON(state.Configuration, configuration)
+		if err != nil {
+			resp.Diagnostics.AddError("Error reading integration", err.Error())
+			return
+		}
+	}
+
+	if len(sensitive) > 0 || !state.SensitiveConfiguration.IsNull() {
+		sensitiveValue, diags := types.MapValueFrom(ctx, types.StringType, sensitive)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		state.SensitiveConfiguration = sensitiveValue
+	}
+
+	state.ID = types.StringValue(metadata.GetId())
+	state.Name = types.StringValue(metadata.GetName())
+	state.IntegrationName = types.StringValue(spec.GetIntegrationName())
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+func (r *integrationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state integrationModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	organizationID, err := r.client.organization(ctx)
+	if err != nil {
+		addAPIError(&resp.Diagnostics, "Error finding organization", err)
+		return
+	}
+
+	configuration, err := plan.configuration(ctx)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("configuration"), "Invalid integration configuration", err.Error())
+		return
+	}
+
+	body := openapi_client.OrganizationsUpdateIntegrationBody{}
+	body.SetName(plan.Name.ValueString())
+	body.SetConfiguration(configuration)
+	_, _, err = r.client.api.OrganizationAPI.OrganizationsUpdateIntegration(ctx, organizationID, state.ID.ValueString()).Body(body).Execute()
+	if err != nil {
+		addAPIError(&resp.Diagnostics, "Error updating integration", err)
+		return
+	}
+
+	plan.ID = state.ID
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *integrationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state integrationModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	organizationID, err := r.client.organization(ctx)
+	if err != nil {
+		addAPIError(&resp.Diagnostics, "Error finding organization", err)
+		return
+	}
+
+	_, httpResponse, err := r.client.api.OrganizationAPI.OrganizationsDeleteIntegration(ctx, organizationID, state.ID.ValueString()).Execute()
+	if err != nil && !isNotFound(httpResponse) {
+		addAPIError(&resp.Diagnostics, "Error deleting integration", err)
+	}
+}
+
+func (r *integrationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+//
+// The API does not report a missing integration as not found,
+// so an integration is only considered deleted if it is not listed either.
+//
+
+func (r *integrationResource) exists(ctx context.Context, organizationID, id string) (bool, error) {
+	response, _, err := r.client.api.OrganizationAPI.OrganizationsListIntegrations(ctx, organizationID).Execute()
+	if err != nil {
+		return false, err
+	}
+
+	for _, integration := range response.GetIntegrations() {
+		metadata := integration.GetMetadata()
+		if metadata.GetId() == id {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+func (m integrationModel) configuration(ctx context.Context) (map[string]any, error) {
+	configuration, err := decodeObject(m.Configuration)
+	if err != nil {
+		return nil, err
+	}
+
+	sensitive := map[string]string{}
+	if !m.SensitiveConfiguration.IsNull() && !m.SensitiveConfiguration.IsUnknown() {
+		diags := m.SensitiveConfiguration.ElementsAs(ctx, &sensitive, false)
+		if diags.HasError() {
+			return nil, diagnosticsError(diags)
+		}
+	}
+
+	result := maps.Clone(configuration)
+	for key, value := range sensitive {
+		result[key] = value
+	}
+
+	return result, nil
+}
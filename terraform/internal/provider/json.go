@@ -0,0 +1,193 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+//
+// Canvases and blueprints are given as JSON documents, like the spec of their files,
+// since nodes have a configuration of their own that no schema can describe.
+//
+// The server fills in defaults the configuration does not mention,
+// so the JSON read back is compared with the state as a superset of it:
+// a state value that is still contained in what the server returns is kept,
+// otherwise what the server returns replaces it, and Terraform reports the drift.
+//
+
+func reconcileJSON(state jsontypes.Normalized, remote any) (jsontypes.Normalized, error) {
+	remote = compact(remote)
+	if !state.IsNull() && !state.IsUnknown() && state.ValueString() != "" {
+		var desired any
+		if json.Unmarshal([]byte(state.ValueString()), &desired) == nil && subset(compact(desired), remote) {
+			return state, nil
+		}
+	}
+
+	data, err := json.Marshal(remote)
+	if err != nil {
+		return state, err
+	}
+
+	return jsontypes.NewNormalizedValue(string(data)), nil
+}
+
+//
+// subset reports whether every value of desired is also in actual.
+// Lists must have the same length, and their items are compared in order.
+//
+
+func subset(desired, actual any) bool {
+	switch d := desired.(type) {
+	case map[string]any:
+		a, ok := actual.(map[string]any)
+		if !ok {
+			return false
+		}
+
+		for key, value := range d {
+			actualValue, ok := a[key]
+			if !ok {
+				if isEmpty(value) {
+					continue
+				}
+
+				return false
+			}
+
+			if !subset(value, actualValue) {
+				return false
+			}
+		}
+
+		return true
+
+	case []any:
+		a, ok := actual.([]any)
+		if !ok || len(a) != len(d) {
+			return false
+		}
+
+		for i := range d {
+			if !subset(d[i], a[i]) {
+				return false
+			}
+		}
+
+		return true
+
+	default:
+		return reflect.DeepEqual(desired, actual)
+	}
+}
+
+func decodeObject(value jsontypes.Normalized) (map[string]any, error) {
+	object := map[string]any{}
+	if value.IsNull() || value.IsUnknown() || value.ValueString() == "" {
+		return object, nil
+	}
+
+	err := json.Unmarshal([]byte(value.ValueString()), &object)
+	if err != nil {
+		return nil, fmt.Errorf("must be a JSON object: %w", err)
+	}
+
+	return object, nil
+}
+
+func convert(from any, to any) error {
+	data, err := json.Marshal(from)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(data, to)
+}
+
+//
+// compact removes empty values, so fields left out of the configuration
+// match fields the API returns with their zero value.
+//
+
+func compact(value any) any {
+	switch v := value.(type) {
+	case map[string]any:
+		result := map[string]any{}
+		for key, item := range v {
+			item = compact(item)
+			if !isEmpty(item) {
+				result[key] = item
+			}
+		}
+
+		return result
+
+	case []any:
+		result := make([]any, len(v))
+		for i, item := range v {
+			result[i] = compact(item)
+		}
+
+		return result
+
+	default:
+		return v
+	}
+}
+
+func isEmpty(value any) bool {
+	switch v := value.(type) {
+	case nil:
+		return true
+	case string:
+		return v == ""
+	case bool:
+		return !v
+	case map[string]any:
+		return len(v) == 0
+	case []any:
+		return len(v) == 0
+	default:
+		return false
+	}
+}
+
+//
+// Fields of nodes set by the server, or that only change at runtime.
+//
+
+var serverNodeFields = []string{"errorMessage", "warningMessage", "metadata", "paused"}
+
+func removeServerNodeFields(spec map[string]any) {
+	nodes, _ := spec["nodes"].([]any)
+	for _, node := range nodes {
+		if n, ok := node.(map[string]any); ok {
+			for _, field := range serverNodeFields {
+				delete(n, field)
+			}
+		}
+	}
+}
+
+func pick(source map[string]any, fields ...string) map[string]any {
+	result := map[string]any{}
+	for _, field := range fields {
+		if value, ok := source[field]; ok {
+			result[field] = value
+		}
+	}
+
+	return result
+}
+
+func optionalString(value string) types.String {
+	if value == "" {
+		return types.StringNull()
+	}
+
+	return types.StringValue(value)
+}
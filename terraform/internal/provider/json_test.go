@@ -0,0 +1,76 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test__Subset(t *testing.T) {
+	actual := map[string]any{
+		"nodes": []any{
+			map[string]any{"id": "a", "type": "TYPE_COMPONENT", "position": map[string]any{"x": 0.0, "y": 0.0}},
+		},
+		"edges": []any{},
+	}
+
+	t.Run("fields the server added are ignored", func(t *testing.T) {
+		desired := map[string]any{"nodes": []any{map[string]any{"id": "a", "type": "TYPE_COMPONENT"}}}
+		assert.True(t, subset(desired, actual))
+	})
+
+	t.Run("empty fields match missing ones", func(t *testing.T) {
+		desired := map[string]any{"nodes": []any{map[string]any{"id": "a", "name": ""}}, "edges": []any{}}
+		assert.True(t, subset(desired, actual))
+	})
+
+	t.Run("changed values do not match", func(t *testing.T) {
+		desired := map[string]any{"nodes": []any{map[string]any{"id": "b"}}}
+		assert.False(t, subset(desired, actual))
+	})
+
+	t.Run("lists must have the same length", func(t *testing.T) {
+		desired := map[string]any{"nodes": []any{map[string]any{"id": "a"}, map[string]any{"id": "b"}}}
+		assert.False(t, subset(desired, actual))
+	})
+}
+
+func Test__ReconcileJSON(t *testing.T) {
+	remote := map[string]any{
+		"nodes": []any{map[string]any{"id": "a", "name": "first", "configuration": map[string]any{}}},
+		"edges": nil,
+	}
+
+	t.Run("state is kept while the remote value contains it", func(t *testing.T) {
+		state := jsontypes.NewNormalizedValue(`{"nodes": [{"id": "a"}]}`)
+		result, err := reconcileJSON(state, remote)
+		require.NoError(t, err)
+		assert.Equal(t, state.ValueString(), result.ValueString())
+	})
+
+	t.Run("remote value replaces a state that drifted", func(t *testing.T) {
+		state := jsontypes.NewNormalizedValue(`{"nodes": [{"id": "a", "name": "renamed"}]}`)
+		result, err := reconcileJSON(state, remote)
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"nodes": [{"id": "a", "name": "first"}]}`, result.ValueString())
+	})
+
+	t.Run("remote value is used without a state", func(t *testing.T) {
+		result, err := reconcileJSON(jsontypes.NewNormalizedNull(), remote)
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"nodes": [{"id": "a", "name": "first"}]}`, result.ValueString())
+	})
+}
+
+func Test__RemoveServerNodeFields(t *testing.T) {
+	spec := map[string]any{
+		"nodes": []any{
+			map[string]any{"id": "a", "errorMessage": "boom", "warningMessage": "careful", "metadata": map[string]any{}, "paused": true},
+		},
+	}
+
+	removeServerNodeFields(spec)
+	assert.Equal(t, map[string]any{"nodes": []any{map[string]any{"id": "a"}}}, spec)
+}
@@ -0,0 +1,120 @@
+// Package provider is the SuperPlane Terraform provider,
+// built on the plugin framework and the generated OpenAPI client.
+package provider
+
+import (
+	"context"
+	"os"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const (
+	defaultURL  = "http://localhost:8000"
+	envURL      = "SUPERPLANE_API_URL"
+	envAPIToken = "SUPERPLANE_API_TOKEN"
+)
+
+type superplaneProvider struct {
+	version string
+}
+
+type providerModel struct {
+	URL      types.String `tfsdk:"url"`
+	APIToken types.String `tfsdk:"api_token"`
+}
+
+func New(version string) func() provider.Provider {
+	return func() provider.Provider {
+		return &superplaneProvider{version: version}
+	}
+}
+
+func (p *superplaneProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
+	resp.TypeName = "superplane"
+	resp.Version = p.version
+}
+
+func (p *superplaneProvider) Schema(ctx context.Context, req provider.SchemaRequest, resp *provider.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages the canvases, blueprints, secrets, integrations and access control of a SuperPlane organization.",
+		Attributes: map[string]schema.Attribute{
+			"url": schema.StringAttribute{
+				Description: "URL of the SuperPlane API. Defaults to the " + envURL + " environment variable, or " + defaultURL + ".",
+				Optional:    true,
+			},
+			"api_token": schema.StringAttribute{
+				Description: "API token of a user or service account. Defaults to the " + envAPIToken + " environment variable.",
+				Optional:    true,
+				Sensitive:   true,
+			},
+		},
+	}
+}
+
+func (p *superplaneProvider) Configure(ctx context.Context, req provider.ConfigureRequest, resp *provider.ConfigureResponse) {
+	var config providerModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.URL.IsUnknown() || config.APIToken.IsUnknown() {
+		resp.Diagnostics.AddError(
+			"Unknown provider configuration",
+			"The url and api_token of the provider must be known when planning.",
+		)
+		return
+	}
+
+	url := valueOrEnv(config.URL, envURL, defaultURL)
+	token := valueOrEnv(config.APIToken, envAPIToken, "")
+	if token == "" {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("api_token"),
+			"Missing API token",
+			"Set api_token in the provider configuration, or the "+envAPIToken+" environment variable.",
+		)
+		return
+	}
+
+	c := newClient(url, token, p.version)
+	resp.DataSourceData = c
+	resp.ResourceData = c
+}
+
+func (p *superplaneProvider) Resources(ctx context.Context) []func() resource.Resource {
+	return []func() resource.Resource{
+		newCanvasResource,
+		newBlueprintResource,
+		newSecretResource,
+		newGroupResource,
+		newRoleResource,
+		newServiceAccountResource,
+		newIntegrationResource,
+	}
+}
+
+func (p *superplaneProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
+	return []func() datasource.DataSource{
+		newComponentDataSource,
+		newTriggerDataSource,
+	}
+}
+
+func valueOrEnv(value types.String, env, fallback string) string {
+	if !value.IsNull() && value.ValueString() != "" {
+		return value.ValueString()
+	}
+
+	if v := os.Getenv(env); v != "" {
+		return v
+	}
+
+	return fallback
+}
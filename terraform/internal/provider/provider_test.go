@@ -0,0 +1,61 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test__ProviderSchemas(t *testing.T) {
+	ctx := context.Background()
+	p := New("test")()
+
+	providerSchema := &provider.SchemaResponse{}
+	p.Schema(ctx, provider.SchemaRequest{}, providerSchema)
+	require.False(t, providerSchema.Diagnostics.HasError(), providerSchema.Diagnostics)
+	require.False(t, providerSchema.Schema.ValidateImplementation(ctx).HasError())
+
+	for _, newResource := range p.Resources(ctx) {
+		r := newResource()
+		metadata := &resource.MetadataResponse{}
+		r.Metadata(ctx, resource.MetadataRequest{ProviderTypeName: "superplane"}, metadata)
+
+		t.Run(metadata.TypeName, func(t *testing.T) {
+			response := &resource.SchemaResponse{}
+			r.Schema(ctx, resource.SchemaRequest{}, response)
+			require.False(t, response.Diagnostics.HasError(), response.Diagnostics)
+			require.False(t, response.Schema.ValidateImplementation(ctx).HasError())
+
+			_, ok := r.(resource.ResourceWithImportState)
+			assert.True(t, ok, "resource must support import")
+		})
+	}
+
+	for _, newDataSource := range p.DataSources(ctx) {
+		d := newDataSource()
+		metadata := &datasource.MetadataResponse{}
+		d.Metadata(ctx, datasource.MetadataRequest{ProviderTypeName: "superplane"}, metadata)
+
+		t.Run(metadata.TypeName, func(t *testing.T) {
+			response := &datasource.SchemaResponse{}
+			d.Schema(ctx, datasource.SchemaRequest{}, response)
+			require.False(t, response.Diagnostics.HasError(), response.Diagnostics)
+			require.False(t, response.Schema.ValidateImplementation(ctx).HasError())
+		})
+	}
+}
+
+func Test__AddAPIError(t *testing.T) {
+	diags := diag.Diagnostics{}
+	addAPIError(&diags, "Error creating canvas", errors.New("500 Internal Server Error"))
+	require.Len(t, diags, 1)
+	assert.Equal(t, "Error creating canvas", diags[0].Summary())
+	assert.Equal(t, "500 Internal Server Error", diags[0].Detail())
+}
@@ -0,0 +1,282 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/superplanehq/superplane/pkg/openapi_client"
+)
+
+type roleResource struct {
+	resourceBase
+}
+
+type roleModel struct {
+	Name          types.String      `tfsdk:"name"`
+	DisplayName   types.String      `tfsdk:"display_name"`
+	Description   types.String      `tfsdk:"description"`
+	InheritedRole types.String      `tfsdk:"inherited_role"`
+	Permissions   []permissionModel `tfsdk:"permissions"`
+}
+
+type permissionModel struct {
+	Resource types.String `tfsdk:"resource"`
+	Action   types.String `tfsdk:"action"`
+}
+
+func newRoleResource() resource.Resource {
+	return &roleResource{}
+}
+
+func (r *roleResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_role"
+}
+
+func (r *roleResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "A custom role of the organization.",
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Description:   "Name of the role, unique in the organization. Changing it replaces the role.",
+				Required:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"display_name": schema.StringAttribute{
+				Description:   "Display name of the role. Defaults to its name.",
+				Optional:      true,
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"description": schema.StringAttribute{
+				Description:   "Description of the role.",
+				Optional:      true,
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"inherited_role": schema.StringAttribute{
+				Description: "Name of a role whose permissions this role also has.",
+				Optional:    true,
+			},
+			"permissions": schema.SetNestedAttribute{
+				Description: "Permissions of the role.",
+				Required:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"resource": schema.StringAttribute{
+							Description: "Resource the permission is for, like canvases or secrets.",
+							Required:    true,
+						},
+						"action": schema.StringAttribute{
+							Description: "Action the permission allows, like read, create, update or delete.",
+							Required:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *roleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan roleModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	organizationID, err := r.client.organization(ctx)
+	if err != nil {
+		addAPIError(&resp.Diagnostics, "Error finding organization", err)
+		return
+	}
+
+	request := openapi_client.RolesCreateRoleRequest{}
+	request.SetDomainType(organizationDomainType)
+	request.SetDomainId(organizationID)
+	request.SetRole(plan.toAPI())
+	_, _, err = r.client.api.RolesAPI.RolesCreateRole(ctx).Body(request).Execute()
+	if err != nil {
+		addAPIError(&resp.Diagnostics, "Error creating role", err)
+		return
+	}
+
+	found := r.read(ctx, &plan, &resp.Diagnostics)
+	if !found && !resp.Diagnostics.HasError() {
+		resp.Diagnostics.AddError("Error reading role", "role "+plan.Name.ValueString()+" not found")
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *roleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state roleModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	found := r.read(ctx, &state, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !found {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+func (r *roleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan roleModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	organizationID, err := r.client.organization(ctx)
+	if err != nil {
+		addAPIError(&resp.Diagnostics, "Error finding organization", err)
+		return
+	}
+
+	body := openapi_client.RolesUpdateRoleBody{}
+	body.SetDomainType(organizationDomainType)
+	body.SetDomainId(organizationID)
+	body.SetRole(plan.toAPI())
+	_, _, err = r.client.api.RolesAPI.RolesUpdateRole(ctx, plan.Name.ValueString()).Body(body).Execute()
+	if err != nil {
+		addAPIError(&resp.Diagnostics, "Error updating role", err)
+		return
+	}
+
+	found := r.read(ctx, &plan, &resp.Diagnostics)
+	if !found && !resp.Diagnostics.HasError() {
+		resp.Diagnostics.AddError("Error reading role", "role "+plan.Name.ValueString()+" not found")
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *roleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state roleModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	organizationID, err := r.client.organization(ctx)
+	if err != nil {
+		addAPIError(&resp.Diagnostics, "Error finding organization", err)
+		return
+	}
+
+	_, httpResponse, err := r.client.api.RolesAPI.RolesDeleteRole(ctx, state.Name.ValueString()).
+		DomainType(string(organizationDomainType)).
+		DomainId(organizationID).
+		Execute()
+
+	if err != nil && !isNotFound(httpResponse) {
+		addAPIError(&resp.Diagnostics, "Error deleting role", err)
+	}
+}
+
+func (r *roleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
+}
+
+//
+// read sets the model from the role in the API,
+// and reports whether the role still exists.
+//
+
+func (r *roleResource) read(ctx context.Context, model *roleModel, diags *diag.Diagnostics) bool {
+	organizationID, err := r.client.organization(ctx)
+	if err != nil {
+		addAPIError(diags, "Error finding organization", err)
+		return false
+	}
+
+	response, httpResponse, err := r.client.api.RolesAPI.RolesDescribeRole(ctx, model.Name.ValueString()).
+		DomainType(string(organizationDomainType)).
+		DomainId(organizationID).
+		Execute()
+
+	if isNotFound(httpResponse) {
+		return false
+	}
+
+	if err != nil {
+		addAPIError(diags, "Error reading role", err)
+		return false
+	}
+
+	role := response.GetRole()
+	metadata := role.GetMetadata()
+	spec := role.GetSpec()
+	model.Name = types.StringValue(metadata.GetName())
+	model.DisplayName = types.StringValue(spec.GetDisplayName())
+	model.Description = types.StringValue(spec.GetDescription())
+	model.InheritedRole = types.StringNull()
+	if inherited, ok := spec.GetInheritedRoleOk(); ok {
+		inheritedMetadata := inherited.GetMetadata()
+		model.InheritedRole = optionalString(inheritedMetadata.GetName())
+	}
+
+	model.Permissions = []permissionModel{}
+	for _, permission := range spec.GetPermissions() {
+		model.Permissions = append(model.Permissions, permissionModel{
+			Resource: types.StringValue(permission.GetResource()),
+			Action:   types.StringValue(permission.GetAction()),
+		})
+	}
+
+	return true
+}
+
+func (m roleModel) toAPI() openapi_client.RolesRole {
+	metadata := openapi_client.RolesRoleMetadata{}
+	metadata.SetName(m.Name.ValueString())
+
+	spec := openapi_client.RolesRoleSpec{}
+	spec.SetDisplayName(m.DisplayName.ValueString())
+	spec.SetDescription(m.Description.ValueString())
+
+	permissions := []openapi_client.AuthorizationPermission{}
+	for _, p := range m.Permissions {
+		permission := openapi_client.AuthorizationPermission{}
+		permission.SetResource(p.Resource.ValueString())
+		permission.SetAction(p.Action.ValueString())
+		permission.SetDomainType(organizationDomainType)
+		permissions = append(permissions, permission)
+	}
+
+	spec.SetPermissions(permissions)
+	if m.InheritedRole.ValueString() != "" {
+		inheritedMetadata := openapi_client.RolesRoleMetadata{}
+		inheritedMetadata.SetName(m.InheritedRole.ValueString())
+		inherited := openapi_client.RolesRole{}
+		inherited.SetMetadata(inheritedMetadata)
+		spec.SetInheritedRole(inherited)
+	}
+
+	role := openapi_client.RolesRole{}
+	role.SetMetadata(metadata)
+	role.SetSpec(spec)
+	return role
+}
@@ -0,0 +1,281 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/superplanehq/superplane/pkg/openapi_client"
+)
+
+//
+// The API never returns the values of a secret, only its keys,
+// so Read keeps the values in the state for the keys that still exist.
+// Keys added outside Terraform are read with this placeholder, to show up as drift.
+//
+
+const secretValuePlaceholder = "***"
+
+type secretResource struct {
+	resourceBase
+}
+
+type secretModel struct {
+	ID   types.String `tfsdk:"id"`
+	Name types.String `tfsdk:"name"`
+	Data types.Map    `tfsdk:"data"`
+}
+
+func newSecretResource() resource.Resource {
+	return &secretResource{}
+}
+
+func (r *secretResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_secret"
+}
+
+func (r *secretResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "A secret of the organization, stored by SuperPlane.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description:   "ID of the secret.",
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"name": schema.StringAttribute{
+				Description: "Name of the secret, unique in the organization.",
+				Required:    true,
+			},
+			"data": schema.MapAttribute{
+				Description: "Keys and values of the secret.",
+				ElementType: types.StringType,
+				Required:    true,
+				Sensitive:   true,
+			},
+		},
+	}
+}
+
+func (r *secretResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan secretModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	organizationID, err := r.client.organization(ctx)
+	if err != nil {
+		addAPIError(&resp.Diagnostics, "Error finding organization", err)
+		return
+	}
+
+	secret, err := plan.toAPI(ctx)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("data"), "Invalid secret data", err.Error())
+		return
+	}
+
+	request := openapi_client.SecretsCreateSecretRequest{}
+	request.SetDomainType(organizationDomainType)
+	request.SetDomainId(organizationID)
+	request.SetSecret(secret)
+	response, _, err := r.client.api.SecretAPI.SecretsCreateSecret(ctx).Body(request).Execute()
+	if err != nil {
+		addAPIError(&resp.Diagnostics, "Error creating secret", err)
+		return
+	}
+
+	created := response.GetSecret()
+	metadata := created.GetMetadata()
+	plan.ID = types.StringValue(metadata.GetId())
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *secretResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state secretModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	organizationID, err := r.client.organization(ctx)
+	if err != nil {
+		addAPIError(&resp.Diagnostics, "Error finding organization", err)
+		return
+	}
+
+	response, _, err := r.client.api.SecretAPI.SecretsDescribeSecret(ctx, state.ID.ValueString()).
+		DomainType(string(organizationDomainType)).
+		DomainId(organizationID).
+		Execute()
+
+	if err != nil {
+		exists, listErr := r.exists(ctx, organizationID, state.ID.ValueString())
+		if listErr == nil && !exists {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		addAPIError(&resp.Diagnostics, "Error reading secret", err)
+		return
+	}
+
+	current := map[string]string{}
+	if !state.Data.IsNull() {
+		resp.Diagnostics.Append(state.Data.ElementsAs(ctx, &current, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	secret := response.GetSecret()
+	metadata := secret.GetMetadata()
+	spec := secret.GetSpec()
+	local := spec.GetLocal()
+	data := map[string]string{}
+	for key := range local.GetData() {
+		value, ok := current[key]
+		if !ok {
+			value = secretValuePlaceholder
+		}
+
+		data[key] = value
+	}
+
+	dataValue, diags := types.MapValueFrom(ctx, types.StringType, data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state.Data = dataValue
+	state.ID = types.StringValue(metadata.GetId())
+	state.Name = types.StringValue(metadata.GetName())
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+func (r *secretResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state secretModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	organizationID, err := r.client.organization(ctx)
+	if err != nil {
+		addAPIError(&resp.Diagnostics, "Error finding organization", err)
+		return
+	}
+
+	//
+	// Updating the data of a secret does not rename it,
+	// so a new name is set first, through its own endpoint.
+	//
+	if plan.Name.ValueString() != state.Name.ValueString() {
+		body := openapi_client.SecretsUpdateSecretNameBody{}
+		body.SetName(plan.Name.ValueString())
+		body.SetDomainType(organizationDomainType)
+		body.SetDomainId(organizationID)
+		_, _, err = r.client.api.SecretAPI.SecretsUpdateSecretName(ctx, state.ID.ValueString()).Body(body).Execute()
+		if err != nil {
+			addAPIError(&resp.Diagnostics, "Error renaming secret", err)
+			return
+		}
+	}
+
+	secret, err := plan.toAPI(ctx)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("data"), "Invalid secret data", err.Error())
+		return
+	}
+
+	body := openapi_client.SecretsUpdateSecretBody{}
+	body.SetDomainType(organizationDomainType)
+	body.SetDomainId(organizationID)
+	body.SetSecret(secret)
+	_, _, err = r.client.api.SecretAPI.SecretsUpdateSecret(ctx, state.ID.ValueString()).Body(body).Execute()
+	if err != nil {
+		addAPIError(&resp.Diagnostics, "Error updating secret", err)
+		return
+	}
+
+	plan.ID = state.ID
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *secretResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state secretModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	organizationID, err := r.client.organization(ctx)
+	if err != nil {
+		addAPIError(&resp.Diagnostics, "Error finding organization", err)
+		return
+	}
+
+	_, httpResponse, err := r.client.api.SecretAPI.SecretsDeleteSecret(ctx, state.ID.ValueString()).
+		DomainType(string(organizationDomainType)).
+		DomainId(organizationID).
+		Execute()
+
+	if err != nil && !isNotFound(httpResponse) {
+		addAPIError(&resp.Diagnostics, "Error deleting secret", err)
+	}
+}
+
+func (r *secretResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+//
+// The API reports a missing secret as an invalid argument, like other bad requests,
+// so a secret is only considered deleted if it is not listed either.
+//
+
+func (r *secretResource) exists(ctx context.Context, organizationID, idOrName string) (bool, error) {
+	response, _, err := r.client.api.SecretAPI.SecretsListSecrets(ctx).
+		DomainType(string(organizationDomainType)).
+		DomainId(organizationID).
+		Execute()
+
+	if err != nil {
+		return false, err
+	}
+
+	for _, secret := range response.GetSecrets() {
+		metadata := secret.GetMetadata()
+		if metadata.GetId() == idOrName || metadata.GetName() == idOrName {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+func (m secretModel) toAPI(ctx context.Context) (openapi_client.SecretsSecret, error) {
+	secret := openapi_client.SecretsSecret{}
+	data := map[string]string{}
+	diags := m.Data.ElementsAs(ctx, &data, false)
+	if diags.HasError() {
+		return secret, diagnosticsError(diags)
+	}
+
+	err := convert(map[string]any{
+		"metadata": map[string]any{"name": m.Name.ValueString()},
+		"spec": map[string]any{
+			"provider": openapi_client.SECRETPROVIDER_PROVIDER_LOCAL,
+			"local":    map[string]any{"data": data},
+		},
+	}, &secret)
+
+	return secret, err
+}
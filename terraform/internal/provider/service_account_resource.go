@@ -0,0 +1,204 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/superplanehq/superplane/pkg/openapi_client"
+)
+
+type serviceAccountResource struct {
+	resourceBase
+}
+
+type serviceAccountModel struct {
+	ID          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	Role        types.String `tfsdk:"role"`
+	Token       types.String `tfsdk:"token"`
+}
+
+func newServiceAccountResource() resource.Resource {
+	return &serviceAccountResource{}
+}
+
+func (r *serviceAccountResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_service_account"
+}
+
+func (r *serviceAccountResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "A service account of the organization, with an API token for automation.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description:   "ID of the service account.",
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"name": schema.StringAttribute{
+				Description: "Name of the service account.",
+				Required:    true,
+			},
+			"description": schema.StringAttribute{
+				Description: "Description of the service account.",
+				Optional:    true,
+			},
+			"role": schema.StringAttribute{
+				Description: "Role of the service account in the organization: org_admin or org_viewer.",
+				Required:    true,
+			},
+			"token": schema.StringAttribute{
+				Description:   "API token of the service account. Only known to resources created by Terraform, not to imported ones.",
+				Computed:      true,
+				Sensitive:     true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+		},
+	}
+}
+
+func (r *serviceAccountResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan serviceAccountModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	request := openapi_client.ServiceAccountsCreateServiceAccountRequest{}
+	request.SetName(plan.Name.ValueString())
+	request.SetDescription(plan.Description.ValueString())
+	request.SetRole(plan.Role.ValueString())
+	response, _, err := r.client.api.ServiceAccountsAPI.ServiceAccountsCreateServiceAccount(ctx).Body(request).Execute()
+	if err != nil {
+		addAPIError(&resp.Diagnostics, "Error creating service account", err)
+		return
+	}
+
+	serviceAccount := response.GetServiceAccount()
+	plan.ID = types.StringValue(serviceAccount.GetId())
+	plan.Token = types.StringValue(response.GetToken())
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *serviceAccountResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state serviceAccountModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, httpResponse, err := r.client.api.ServiceAccountsAPI.ServiceAccountsDescribeServiceAccount(ctx, state.ID.ValueString()).Execute()
+	if isNotFound(httpResponse) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		addAPIError(&resp.Diagnostics, "Error reading service account", err)
+		return
+	}
+
+	serviceAccount := response.GetServiceAccount()
+	state.Name = types.StringValue(serviceAccount.GetName())
+	state.Description = optionalString(serviceAccount.GetDescription())
+	state.Role = r.role(ctx, state.ID.ValueString(), &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+func (r *serviceAccountResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state serviceAccountModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	body := openapi_client.ServiceAccountsUpdateServiceAccountBody{}
+	body.SetName(plan.Name.ValueString())
+	body.SetDescription(plan.Description.ValueString())
+	_, _, err := r.client.api.ServiceAccountsAPI.ServiceAccountsUpdateServiceAccount(ctx, state.ID.ValueString()).Body(body).Execute()
+	if err != nil {
+		addAPIError(&resp.Diagnostics, "Error updating service account", err)
+		return
+	}
+
+	if plan.Role.ValueString() != state.Role.ValueString() {
+		organizationID, err := r.client.organization(ctx)
+		if err != nil {
+			addAPIError(&resp.Diagnostics, "Error finding organization", err)
+			return
+		}
+
+		body := openapi_client.RolesAssignRoleBody{}
+		body.SetDomainType(organizationDomainType)
+		body.SetDomainId(organizationID)
+		body.SetUserId(state.ID.ValueString())
+		_, _, err = r.client.api.RolesAPI.RolesAssignRole(ctx, plan.Role.ValueString()).Body(body).Execute()
+		if err != nil {
+			addAPIError(&resp.Diagnostics, "Error assigning role to service account", err)
+			return
+		}
+	}
+
+	plan.ID = state.ID
+	plan.Token = state.Token
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *serviceAccountResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state serviceAccountModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, httpResponse, err := r.client.api.ServiceAccountsAPI.ServiceAccountsDeleteServiceAccount(ctx, state.ID.ValueString()).Execute()
+	if err != nil && !isNotFound(httpResponse) {
+		addAPIError(&resp.Diagnostics, "Error deleting service account", err)
+	}
+}
+
+func (r *serviceAccountResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+//
+// The role of a service account is not part of it in the API,
+// but one of the roles of its user in the organization.
+//
+
+func (r *serviceAccountResource) role(ctx context.Context, id string, diags *diag.Diagnostics) types.String {
+	organizationID, err := r.client.organization(ctx)
+	if err != nil {
+		addAPIError(diags, "Error finding organization", err)
+		return types.StringNull()
+	}
+
+	response, _, err := r.client.api.UsersAPI.UsersListUserRoles(ctx, id).
+		DomainType(string(organizationDomainType)).
+		DomainId(organizationID).
+		Execute()
+
+	if err != nil {
+		addAPIError(diags, "Error reading service account role", err)
+		return types.StringNull()
+	}
+
+	for _, role := range response.GetRoles() {
+		metadata := role.GetMetadata()
+		return types.StringValue(metadata.GetName())
+	}
+
+	return types.StringNull()
+}
@@ -0,0 +1,97 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type triggerDataSource struct {
+	dataSourceBase
+}
+
+type triggerModel struct {
+	Name          types.String         `tfsdk:"name"`
+	Label         types.String         `tfsdk:"label"`
+	Description   types.String         `tfsdk:"description"`
+	Icon          types.String         `tfsdk:"icon"`
+	Color         types.String         `tfsdk:"color"`
+	Configuration jsontypes.Normalized `tfsdk:"configuration"`
+}
+
+func newTriggerDataSource() datasource.DataSource {
+	return &triggerDataSource{}
+}
+
+func (d *triggerDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_trigger"
+}
+
+func (d *triggerDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "A trigger that starts the executions of a canvas.",
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Description: "Name of the trigger, like schedule or webhook.",
+				Required:    true,
+			},
+			"label": schema.StringAttribute{
+				Description: "Label of the trigger.",
+				Computed:    true,
+			},
+			"description": schema.StringAttribute{
+				Description: "Description of the trigger.",
+				Computed:    true,
+			},
+			"icon": schema.StringAttribute{
+				Description: "Icon of the trigger.",
+				Computed:    true,
+			},
+			"color": schema.StringAttribute{
+				Description: "Color of the trigger.",
+				Computed:    true,
+			},
+			"configuration": schema.StringAttribute{
+				Description: "JSON list with the configuration fields of the trigger.",
+				CustomType:  jsontypes.NormalizedType{},
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (d *triggerDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config triggerModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, _, err := d.client.api.TriggerAPI.TriggersDescribeTrigger(ctx, config.Name.ValueString()).Execute()
+	if err != nil {
+		addAPIError(&resp.Diagnostics, "Error reading trigger", err)
+		return
+	}
+
+	trigger := response.GetTrigger()
+	configuration, err := json.Marshal(trigger.GetConfiguration())
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading trigger", err.Error())
+		return
+	}
+
+	state := triggerModel{
+		Name:          types.StringValue(trigger.GetName()),
+		Label:         types.StringValue(trigger.GetLabel()),
+		Description:   types.StringValue(trigger.GetDescription()),
+		Icon:          types.StringValue(trigger.GetIcon()),
+		Color:         types.StringValue(trigger.GetColor()),
+		Configuration: jsontypes.NewNormalizedValue(string(configuration)),
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
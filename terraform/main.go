@@ -0,0 +1,27 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/superplanehq/superplane/terraform/internal/provider"
+)
+
+var version = "dev"
+
+func main() {
+	var debug bool
+	flag.BoolVar(&debug, "debug", false, "start the provider with support for debuggers like delve")
+	flag.Parse()
+
+	err := providerserver.Serve(context.Background(), provider.New(version), providerserver.ServeOpts{
+		Address: "registry.terraform.io/superplanehq/superplane",
+		Debug:   debug,
+	})
+
+	if err != nil {
+		log.Fatal(err)
+	}
+}
@@ -1,6 +1,8 @@
 package contexts
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
 	"net/http"
 	"time"
@@ -336,3 +338,46 @@ func (c *HTTPContext) Do(request *http.Request) (*http.Response, error) {
 	c.Responses = c.Responses[1:]
 	return response, nil
 }
+
+type ArtifactContext struct {
+	Artifacts map[string][]byte
+}
+
+func (c *ArtifactContext) Put(name string, contentType string, content []byte) (*core.Artifact, error) {
+	if c.Artifacts == nil {
+		c.Artifacts = map[string][]byte{}
+	}
+
+	c.Artifacts[name] = content
+	checksum := sha256.Sum256(content)
+	return &core.Artifact{
+		Name:        name,
+		ContentType: contentType,
+		Size:        int64(len(content)),
+		SHA256:      hex.EncodeToString(checksum[:]),
+	}, nil
+}
+
+type Notification struct {
+	Title     string
+	Body      string
+	URL       string
+	URLLabel  string
+	Receivers core.NotificationReceivers
+}
+
+type NotificationContext struct {
+	Sent []Notification
+}
+
+func (c *NotificationContext) Send(title, body, url, urlLabel string, receivers core.NotificationReceivers) error {
+	c.Sent = append(c.Sent, Notification{
+		Title:     title,
+		Body:      body,
+		URL:       url,
+		URLLabel:  urlLabel,
+		Receivers: receivers,
+	})
+
+	return nil
+}
@@ -0,0 +1,356 @@
+package support
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"html"
+	"io"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"regexp"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/crewjam/saml"
+	"github.com/crewjam/saml/logger"
+	"github.com/go-jose/go-jose/v4"
+	"github.com/google/uuid"
+)
+
+const (
+	TestIdPClientID     = "superplane-test-client"
+	TestIdPClientSecret = "superplane-test-secret"
+	testIdPKeyID        = "test-idp-key"
+)
+
+//
+// TestIdentityProvider is a local stand-in for Okta / Azure AD.
+// It speaks just enough SAML 2.0 (HTTP-Redirect in, HTTP-POST out)
+// and OpenID Connect (discovery, authorization code, JWKS)
+// to drive the SSO login flows end to end, and it always
+// authenticates whichever user was last passed to SetUser.
+//
+
+type TestIdentityProvider struct {
+	Server *httptest.Server
+
+	key         *rsa.PrivateKey
+	certificate *x509.Certificate
+	saml        *saml.IdentityProvider
+
+	mu               sync.Mutex
+	user             TestIdPUser
+	serviceProviders map[string]*saml.EntityDescriptor
+	codes            map[string]testIdPCode
+}
+
+type TestIdPUser struct {
+	Subject string
+	Email   string
+	Name    string
+	Groups  []string
+}
+
+type testIdPCode struct {
+	user        TestIdPUser
+	nonce       string
+	redirectURI string
+}
+
+func NewTestIdentityProvider(t testing.TB) *TestIdentityProvider {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("failed to generate identity provider key: %v", err)
+	}
+
+	idp := &TestIdentityProvider{
+		key:              key,
+		serviceProviders: map[string]*saml.EntityDescriptor{},
+		codes:            map[string]testIdPCode{},
+	}
+
+	mux := http.NewServeMux()
+	idp.Server = httptest.NewServer(mux)
+	t.Cleanup(idp.Server.Close)
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "superplane-test-idp"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(24 * time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatalf("failed to create identity provider certificate: %v", err)
+	}
+
+	idp.certificate, err = x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatalf("failed to parse identity provider certificate: %v", err)
+	}
+
+	metadataURL, _ := url.Parse(idp.Server.URL + "/saml/metadata")
+	ssoURL, _ := url.Parse(idp.Server.URL + "/saml/sso")
+	idp.saml = &saml.IdentityProvider{
+		Key:                     key,
+		Certificate:             idp.certificate,
+		Logger:                  logger.DefaultLogger,
+		MetadataURL:             *metadataURL,
+		SSOURL:                  *ssoURL,
+		ServiceProviderProvider: idp,
+		SessionProvider:         idp,
+	}
+
+	mux.HandleFunc("/saml/metadata", idp.saml.ServeMetadata)
+	mux.HandleFunc("/saml/sso", idp.saml.ServeSSO)
+	mux.HandleFunc("/.well-known/openid-configuration", idp.serveDiscovery)
+	mux.HandleFunc("/oidc/authorize", idp.serveAuthorize)
+	mux.HandleFunc("/oidc/token", idp.serveToken)
+	mux.HandleFunc("/oidc/jwks", idp.serveJWKS)
+
+	return idp
+}
+
+func (i *TestIdentityProvider) Issuer() string {
+	return i.Server.URL
+}
+
+func (i *TestIdentityProvider) SetUser(user TestIdPUser) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	i.user = user
+}
+
+func (i *TestIdentityProvider) SAMLMetadata() string {
+	data, err := xml.Marshal(i.saml.Metadata())
+	if err != nil {
+		panic(err)
+	}
+
+	return string(data)
+}
+
+//
+// RegisterServiceProvider is the equivalent of creating
+// the SuperPlane application in the identity provider's admin console.
+//
+
+func (i *TestIdentityProvider) RegisterServiceProvider(metadata []byte) error {
+	var descriptor saml.EntityDescriptor
+	if err := xml.Unmarshal(metadata, &descriptor); err != nil {
+		return err
+	}
+
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	i.serviceProviders[descriptor.EntityID] = &descriptor
+	return nil
+}
+
+//
+// SAMLLogin follows the SP-initiated redirect to the identity provider
+// and returns the SAMLResponse and RelayState the browser would post back.
+//
+
+func (i *TestIdentityProvider) SAMLLogin(authURL string) (string, string, error) {
+	resp, err := http.Get(authURL)
+	if err != nil {
+		return "", "", err
+	}
+
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", "", err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return "", "", fmt.Errorf("identity provider returned %d: %s", resp.StatusCode, body)
+	}
+
+	samlResponse := formValuePattern("SAMLResponse").FindStringSubmatch(string(body))
+	relayState := formValuePattern("RelayState").FindStringSubmatch(string(body))
+	if samlResponse == nil || relayState == nil {
+		return "", "", fmt.Errorf("identity provider did not return a SAML response form")
+	}
+
+	return html.UnescapeString(samlResponse[1]), html.UnescapeString(relayState[1]), nil
+}
+
+func (i *TestIdentityProvider) GetServiceProvider(r *http.Request, serviceProviderID string) (*saml.EntityDescriptor, error) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	descriptor, ok := i.serviceProviders[serviceProviderID]
+	if !ok {
+		return nil, os.ErrNotExist
+	}
+
+	return descriptor, nil
+}
+
+func (i *TestIdentityProvider) GetSession(w http.ResponseWriter, r *http.Request, req *saml.IdpAuthnRequest) *saml.Session {
+	i.mu.Lock()
+	user := i.user
+	i.mu.Unlock()
+
+	groupValues := []saml.AttributeValue{}
+	for _, group := range user.Groups {
+		groupValues = append(groupValues, saml.AttributeValue{Type: "xs:string", Value: group})
+	}
+
+	return &saml.Session{
+		ID:         uuid.NewString(),
+		CreateTime: time.Now(),
+		ExpireTime: time.Now().Add(time.Hour),
+		Index:      uuid.NewString(),
+		NameID:     user.Subject,
+		CustomAttributes: []saml.Attribute{
+			{Name: "email", Values: []saml.AttributeValue{{Type: "xs:string", Value: user.Email}}},
+			{Name: "name", Values: []saml.AttributeValue{{Type: "xs:string", Value: user.Name}}},
+			{Name: "groups", Values: groupValues},
+		},
+	}
+}
+
+func (i *TestIdentityProvider) serveDiscovery(w http.ResponseWriter, r *http.Request) {
+	writeTestIdPJSON(w, map[string]any{
+		"issuer":                                i.Issuer(),
+		"authorization_endpoint":                i.Issuer() + "/oidc/authorize",
+		"token_endpoint":                        i.Issuer() + "/oidc/token",
+		"jwks_uri":                              i.Issuer() + "/oidc/jwks",
+		"response_types_supported":              []string{"code"},
+		"subject_types_supported":               []string{"public"},
+		"id_token_signing_alg_values_supported": []string{"RS256"},
+	})
+}
+
+func (i *TestIdentityProvider) serveAuthorize(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	if query.Get("client_id") != TestIdPClientID || query.Get("response_type") != "code" {
+		http.Error(w, "invalid authorization request", http.StatusBadRequest)
+		return
+	}
+
+	redirectURI, err := url.Parse(query.Get("redirect_uri"))
+	if err != nil {
+		http.Error(w, "invalid redirect_uri", http.StatusBadRequest)
+		return
+	}
+
+	code := uuid.NewString()
+	i.mu.Lock()
+	i.codes[code] = testIdPCode{user: i.user, nonce: query.Get("nonce"), redirectURI: redirectURI.String()}
+	i.mu.Unlock()
+
+	values := redirectURI.Query()
+	values.Set("code", code)
+	values.Set("state", query.Get("state"))
+	redirectURI.RawQuery = values.Encode()
+	http.Redirect(w, r, redirectURI.String(), http.StatusFound)
+}
+
+func (i *TestIdentityProvider) serveToken(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseForm(); err != nil {
+		http.Error(w, "invalid token request", http.StatusBadRequest)
+		return
+	}
+
+	clientID, clientSecret, ok := r.BasicAuth()
+	if !ok {
+		clientID, clientSecret = r.PostForm.Get("client_id"), r.PostForm.Get("client_secret")
+	}
+
+	if clientID != TestIdPClientID || clientSecret != TestIdPClientSecret {
+		http.Error(w, `{"error":"invalid_client"}`, http.StatusUnauthorized)
+		return
+	}
+
+	i.mu.Lock()
+	grant, ok := i.codes[r.PostForm.Get("code")]
+	delete(i.codes, r.PostForm.Get("code"))
+	i.mu.Unlock()
+
+	if !ok || grant.redirectURI != r.PostForm.Get("redirect_uri") {
+		http.Error(w, `{"error":"invalid_grant"}`, http.StatusBadRequest)
+		return
+	}
+
+	now := time.Now()
+	idToken, err := i.signIDToken(map[string]any{
+		"iss":            i.Issuer(),
+		"sub":            grant.user.Subject,
+		"aud":            TestIdPClientID,
+		"iat":            now.Unix(),
+		"exp":            now.Add(time.Hour).Unix(),
+		"nonce":          grant.nonce,
+		"email":          grant.user.Email,
+		"email_verified": true,
+		"name":           grant.user.Name,
+		"groups":         grant.user.Groups,
+	})
+
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	writeTestIdPJSON(w, map[string]any{
+		"access_token": uuid.NewString(),
+		"token_type":   "Bearer",
+		"expires_in":   3600,
+		"id_token":     idToken,
+	})
+}
+
+func (i *TestIdentityProvider) serveJWKS(w http.ResponseWriter, r *http.Request) {
+	writeTestIdPJSON(w, jose.JSONWebKeySet{
+		Keys: []jose.JSONWebKey{
+			{Key: &i.key.PublicKey, KeyID: testIdPKeyID, Algorithm: string(jose.RS256), Use: "sig"},
+		},
+	})
+}
+
+func (i *TestIdentityProvider) signIDToken(claims map[string]any) (string, error) {
+	signer, err := jose.NewSigner(
+		jose.SigningKey{Algorithm: jose.RS256, Key: i.key},
+		(&jose.SignerOptions{}).WithType("JWT").WithHeader("kid", testIdPKeyID),
+	)
+
+	if err != nil {
+		return "", err
+	}
+
+	payload, err := json.Marshal(claims)
+	if err != nil {
+		return "", err
+	}
+
+	signature, err := signer.Sign(payload)
+	if err != nil {
+		return "", err
+	}
+
+	return signature.CompactSerialize()
+}
+
+func writeTestIdPJSON(w http.ResponseWriter, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func formValuePattern(name string) *regexp.Regexp {
+	return regexp.MustCompile(`name="` + name + `" value="([^"]*)"`)
+}
@@ -24,6 +24,7 @@ import (
 	_ "github.com/superplanehq/superplane/pkg/components/approval"
 	_ "github.com/superplanehq/superplane/pkg/components/filter"
 	_ "github.com/superplanehq/superplane/pkg/components/http"
+	_ "github.com/superplanehq/superplane/pkg/components/humaninput"
 	_ "github.com/superplanehq/superplane/pkg/components/if"
 	_ "github.com/superplanehq/superplane/pkg/components/merge"
 	_ "github.com/superplanehq/superplane/pkg/components/noop"
@@ -312,6 +313,8 @@ func CreateCanvas(t *testing.T, orgID uuid.UUID, userID uuid.UUID, nodes []model
 			Metadata:      node.Metadata.Data(),
 			Position:      node.Position.Data(),
 			IsCollapsed:   node.IsCollapsed,
+
+			ErrorChannelEnabled: node.ErrorChannelEnabled,
 		}
 	}
 
@@ -360,6 +363,8 @@ func CreateCanvas(t *testing.T, orgID uuid.UUID, userID uuid.UUID, nodes []model
 			IsCollapsed:   node.IsCollapsed,
 			CreatedAt:     &now,
 			UpdatedAt:     &now,
+
+			ErrorChannelEnabled: node.ErrorChannelEnabled,
 		}
 
 		require.NoError(t, database.Conn().Clauses(clause.Returning{}).Create(&canvasNode).Error)
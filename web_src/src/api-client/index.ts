@@ -12,7 +12,13 @@ export {
   canvasesDeleteCanvasMemory,
   canvasesDeleteNodeQueueItem,
   canvasesDescribeCanvas,
+  canvasesDownloadExecutionArtifact,
   canvasesEmitNodeEvent,
+  canvasesExportCanvasDoraMetrics,
+  canvasesGetCanvasDoraMetrics,
+  canvasesGetCanvasDoraSettings,
+  canvasesGetCanvasStats,
+  canvasesGetExecutionLogs,
   canvasesInvokeNodeExecutionAction,
   canvasesInvokeNodeTriggerAction,
   canvasesListCanvases,
@@ -20,13 +26,17 @@ export {
   canvasesListCanvasMemories,
   canvasesListChildExecutions,
   canvasesListEventExecutions,
+  canvasesListExecutionArtifacts,
   canvasesListNodeEvents,
   canvasesListNodeExecutions,
   canvasesListNodeQueueItems,
   canvasesResolveExecutionErrors,
   canvasesSendAiMessage,
   canvasesUpdateCanvas,
+  canvasesUpdateCanvasDoraSettings,
   canvasesUpdateNodePause,
+  canvasesWatchCanvas,
+  canvasesWatchExecution,
   componentsDescribeComponent,
   componentsListComponentActions,
   componentsListComponents,
@@ -39,8 +49,10 @@ export {
   groupsRemoveUserFromGroup,
   groupsUpdateGroup,
   integrationsListIntegrations,
+  meGetNotificationPreferences,
   meMe,
   meRegenerateToken,
+  meUpdateNotificationPreferences,
   type Options,
   organizationsAcceptInviteLink,
   organizationsCreateIntegration,
@@ -48,21 +60,31 @@ export {
   organizationsDeleteAgentOpenAiKey,
   organizationsDeleteIntegration,
   organizationsDeleteOrganization,
+  organizationsDeleteScimToken,
+  organizationsDeleteSsoConfig,
   organizationsDescribeIntegration,
   organizationsDescribeOrganization,
   organizationsGetAgentSettings,
   organizationsGetInviteLink,
+  organizationsGetScimConfig,
+  organizationsGetSsoConfig,
+  organizationsGetTwoFactorPolicy,
   organizationsListIntegrationResources,
   organizationsListIntegrations,
   organizationsListInvitations,
+  organizationsListTwoFactorEvents,
   organizationsRemoveInvitation,
   organizationsRemoveUser,
   organizationsResetInviteLink,
+  organizationsResetScimToken,
+  organizationsResetUserTwoFactor,
   organizationsSetAgentOpenAiKey,
   organizationsUpdateAgentSettings,
   organizationsUpdateIntegration,
   organizationsUpdateInviteLink,
   organizationsUpdateOrganization,
+  organizationsUpdateSsoConfig,
+  organizationsUpdateTwoFactorPolicy,
   rolesAssignRole,
   rolesCreateRole,
   rolesDeleteRole,
@@ -83,6 +105,15 @@ export {
   serviceAccountsListServiceAccounts,
   serviceAccountsRegenerateServiceAccountToken,
   serviceAccountsUpdateServiceAccount,
+  subscriptionsCreateSubscription,
+  subscriptionsDeleteSubscription,
+  subscriptionsDescribeSubscription,
+  subscriptionsListSubscriptionDeliveries,
+  subscriptionsListSubscriptions,
+  subscriptionsUpdateSubscription,
+  tokensCreateToken,
+  tokensListTokens,
+  tokensRevokeToken,
   triggersDescribeTrigger,
   triggersListTriggers,
   usersListUserPermissions,
@@ -141,12 +172,19 @@ export type {
   CanvasesCanvasAiContext,
   CanvasesCanvasAiNodeContext,
   CanvasesCanvasAutoLayout,
+  CanvasesCanvasChange,
+  CanvasesCanvasChangeType,
   CanvasesCanvasEvent,
   CanvasesCanvasEventWithExecutions,
+  CanvasesCanvasExecutionStats,
   CanvasesCanvasMemory,
   CanvasesCanvasMetadata,
   CanvasesCanvasNodeExecution,
+  CanvasesCanvasNodeExecutionArtifact,
+  CanvasesCanvasNodeExecutionLog,
+  CanvasesCanvasNodeExecutionStats,
   CanvasesCanvasNodeQueueItem,
+  CanvasesCanvasPlan,
   CanvasesCanvasSpec,
   CanvasesCanvasStatus,
   CanvasesCreateCanvasData,
@@ -180,6 +218,15 @@ export type {
   CanvasesDescribeCanvasResponse,
   CanvasesDescribeCanvasResponse2,
   CanvasesDescribeCanvasResponses,
+  CanvasesDoraInterval,
+  CanvasesDoraMetrics,
+  CanvasesDoraSettings,
+  CanvasesDownloadExecutionArtifactData,
+  CanvasesDownloadExecutionArtifactError,
+  CanvasesDownloadExecutionArtifactErrors,
+  CanvasesDownloadExecutionArtifactResponse,
+  CanvasesDownloadExecutionArtifactResponse2,
+  CanvasesDownloadExecutionArtifactResponses,
   CanvasesEmitNodeEventBody,
   CanvasesEmitNodeEventData,
   CanvasesEmitNodeEventError,
@@ -187,6 +234,36 @@ export type {
   CanvasesEmitNodeEventResponse,
   CanvasesEmitNodeEventResponse2,
   CanvasesEmitNodeEventResponses,
+  CanvasesExportCanvasDoraMetricsData,
+  CanvasesExportCanvasDoraMetricsError,
+  CanvasesExportCanvasDoraMetricsErrors,
+  CanvasesExportCanvasDoraMetricsResponse,
+  CanvasesExportCanvasDoraMetricsResponse2,
+  CanvasesExportCanvasDoraMetricsResponses,
+  CanvasesGetCanvasDoraMetricsData,
+  CanvasesGetCanvasDoraMetricsError,
+  CanvasesGetCanvasDoraMetricsErrors,
+  CanvasesGetCanvasDoraMetricsResponse,
+  CanvasesGetCanvasDoraMetricsResponse2,
+  CanvasesGetCanvasDoraMetricsResponses,
+  CanvasesGetCanvasDoraSettingsData,
+  CanvasesGetCanvasDoraSettingsError,
+  CanvasesGetCanvasDoraSettingsErrors,
+  CanvasesGetCanvasDoraSettingsResponse,
+  CanvasesGetCanvasDoraSettingsResponse2,
+  CanvasesGetCanvasDoraSettingsResponses,
+  CanvasesGetCanvasStatsData,
+  CanvasesGetCanvasStatsError,
+  CanvasesGetCanvasStatsErrors,
+  CanvasesGetCanvasStatsResponse,
+  CanvasesGetCanvasStatsResponse2,
+  CanvasesGetCanvasStatsResponses,
+  CanvasesGetExecutionLogsData,
+  CanvasesGetExecutionLogsError,
+  CanvasesGetExecutionLogsErrors,
+  CanvasesGetExecutionLogsResponse,
+  CanvasesGetExecutionLogsResponse2,
+  CanvasesGetExecutionLogsResponses,
   CanvasesInvokeNodeExecutionActionBody,
   CanvasesInvokeNodeExecutionActionData,
   CanvasesInvokeNodeExecutionActionError,
@@ -232,6 +309,12 @@ export type {
   CanvasesListEventExecutionsResponse,
   CanvasesListEventExecutionsResponse2,
   CanvasesListEventExecutionsResponses,
+  CanvasesListExecutionArtifactsData,
+  CanvasesListExecutionArtifactsError,
+  CanvasesListExecutionArtifactsErrors,
+  CanvasesListExecutionArtifactsResponse,
+  CanvasesListExecutionArtifactsResponse2,
+  CanvasesListExecutionArtifactsResponses,
   CanvasesListNodeEventsData,
   CanvasesListNodeEventsError,
   CanvasesListNodeEventsErrors,
@@ -266,6 +349,13 @@ export type {
   CanvasesSendAiMessageResponses,
   CanvasesUpdateCanvasBody,
   CanvasesUpdateCanvasData,
+  CanvasesUpdateCanvasDoraSettingsBody,
+  CanvasesUpdateCanvasDoraSettingsData,
+  CanvasesUpdateCanvasDoraSettingsError,
+  CanvasesUpdateCanvasDoraSettingsErrors,
+  CanvasesUpdateCanvasDoraSettingsResponse,
+  CanvasesUpdateCanvasDoraSettingsResponse2,
+  CanvasesUpdateCanvasDoraSettingsResponses,
   CanvasesUpdateCanvasError,
   CanvasesUpdateCanvasErrors,
   CanvasesUpdateCanvasResponse,
@@ -278,9 +368,28 @@ export type {
   CanvasesUpdateNodePauseResponse,
   CanvasesUpdateNodePauseResponse2,
   CanvasesUpdateNodePauseResponses,
+  CanvasesWatchCanvasData,
+  CanvasesWatchCanvasError,
+  CanvasesWatchCanvasErrors,
+  CanvasesWatchCanvasResponse,
+  CanvasesWatchCanvasResponse2,
+  CanvasesWatchCanvasResponses,
+  CanvasesWatchExecutionData,
+  CanvasesWatchExecutionError,
+  CanvasesWatchExecutionErrors,
+  CanvasesWatchExecutionResponse,
+  CanvasesWatchExecutionResponse2,
+  CanvasesWatchExecutionResponses,
   CanvasNodeExecutionResult,
   CanvasNodeExecutionResultReason,
   CanvasNodeExecutionState,
+  CanvasPlanAction,
+  CanvasPlanEdgeChange,
+  CanvasPlanFieldChange,
+  CanvasPlanNodeChange,
+  CanvasPlanOrphanedNode,
+  CanvasPlanSubscriptionChange,
+  CanvasPlanWebhookChange,
   ClientOptions,
   ComponentsComponent,
   ComponentsComponentAction,
@@ -329,6 +438,8 @@ export type {
   ConfigurationTypeOptions,
   ConfigurationValidationRule,
   ConfigurationVisibilityCondition,
+  DoraSettingsDeploymentNode,
+  DoraSettingsIncidentNode,
   GooglerpcStatus,
   GroupsAddUserToGroupBody,
   GroupsAddUserToGroupData,
@@ -393,17 +504,34 @@ export type {
   IntegrationsListIntegrationsErrors,
   IntegrationsListIntegrationsResponse,
   IntegrationsListIntegrationsResponses,
+  MeGetNotificationPreferencesData,
+  MeGetNotificationPreferencesError,
+  MeGetNotificationPreferencesErrors,
+  MeGetNotificationPreferencesResponse,
+  MeGetNotificationPreferencesResponse2,
+  MeGetNotificationPreferencesResponses,
   MeMeData,
   MeMeError,
   MeMeErrors,
   MeMeResponse,
   MeMeResponses,
+  MeNotificationChannel,
+  MeNotificationChannelType,
+  MeNotificationPreferences,
+  MeQuietHours,
   MeRegenerateTokenData,
   MeRegenerateTokenError,
   MeRegenerateTokenErrors,
   MeRegenerateTokenResponse,
   MeRegenerateTokenResponse2,
   MeRegenerateTokenResponses,
+  MeUpdateNotificationPreferencesData,
+  MeUpdateNotificationPreferencesError,
+  MeUpdateNotificationPreferencesErrors,
+  MeUpdateNotificationPreferencesRequest,
+  MeUpdateNotificationPreferencesResponse,
+  MeUpdateNotificationPreferencesResponse2,
+  MeUpdateNotificationPreferencesResponses,
   NodeBlueprintRef,
   NodeComponentRef,
   NodeTriggerRef,
@@ -448,6 +576,18 @@ export type {
   OrganizationsDeleteOrganizationResponse,
   OrganizationsDeleteOrganizationResponse2,
   OrganizationsDeleteOrganizationResponses,
+  OrganizationsDeleteScimTokenData,
+  OrganizationsDeleteScimTokenError,
+  OrganizationsDeleteScimTokenErrors,
+  OrganizationsDeleteScimTokenResponse,
+  OrganizationsDeleteScimTokenResponse2,
+  OrganizationsDeleteScimTokenResponses,
+  OrganizationsDeleteSsoConfigData,
+  OrganizationsDeleteSsoConfigError,
+  OrganizationsDeleteSsoConfigErrors,
+  OrganizationsDeleteSsoConfigResponse,
+  OrganizationsDeleteSsoConfigResponse2,
+  OrganizationsDeleteSsoConfigResponses,
   OrganizationsDescribeIntegrationData,
   OrganizationsDescribeIntegrationError,
   OrganizationsDescribeIntegrationErrors,
@@ -472,6 +612,24 @@ export type {
   OrganizationsGetInviteLinkResponse,
   OrganizationsGetInviteLinkResponse2,
   OrganizationsGetInviteLinkResponses,
+  OrganizationsGetScimConfigData,
+  OrganizationsGetScimConfigError,
+  OrganizationsGetScimConfigErrors,
+  OrganizationsGetScimConfigResponse,
+  OrganizationsGetScimConfigResponse2,
+  OrganizationsGetScimConfigResponses,
+  OrganizationsGetSsoConfigData,
+  OrganizationsGetSsoConfigError,
+  OrganizationsGetSsoConfigErrors,
+  OrganizationsGetSsoConfigResponse,
+  OrganizationsGetSsoConfigResponse2,
+  OrganizationsGetSsoConfigResponses,
+  OrganizationsGetTwoFactorPolicyData,
+  OrganizationsGetTwoFactorPolicyError,
+  OrganizationsGetTwoFactorPolicyErrors,
+  OrganizationsGetTwoFactorPolicyResponse,
+  OrganizationsGetTwoFactorPolicyResponse2,
+  OrganizationsGetTwoFactorPolicyResponses,
   OrganizationsIntegration,
   OrganizationsIntegrationMetadata,
   OrganizationsIntegrationResourceRef,
@@ -496,6 +654,12 @@ export type {
   OrganizationsListInvitationsResponse,
   OrganizationsListInvitationsResponse2,
   OrganizationsListInvitationsResponses,
+  OrganizationsListTwoFactorEventsData,
+  OrganizationsListTwoFactorEventsError,
+  OrganizationsListTwoFactorEventsErrors,
+  OrganizationsListTwoFactorEventsResponse,
+  OrganizationsListTwoFactorEventsResponse2,
+  OrganizationsListTwoFactorEventsResponses,
   OrganizationsOrganization,
   OrganizationsOrganizationMetadata,
   OrganizationsRemoveInvitationData,
@@ -516,6 +680,19 @@ export type {
   OrganizationsResetInviteLinkResponse,
   OrganizationsResetInviteLinkResponse2,
   OrganizationsResetInviteLinkResponses,
+  OrganizationsResetScimTokenData,
+  OrganizationsResetScimTokenError,
+  OrganizationsResetScimTokenErrors,
+  OrganizationsResetScimTokenResponse,
+  OrganizationsResetScimTokenResponse2,
+  OrganizationsResetScimTokenResponses,
+  OrganizationsResetUserTwoFactorData,
+  OrganizationsResetUserTwoFactorError,
+  OrganizationsResetUserTwoFactorErrors,
+  OrganizationsResetUserTwoFactorResponse,
+  OrganizationsResetUserTwoFactorResponse2,
+  OrganizationsResetUserTwoFactorResponses,
+  OrganizationsScimConfig,
   OrganizationsSetAgentOpenAiKeyBody,
   OrganizationsSetAgentOpenAiKeyData,
   OrganizationsSetAgentOpenAiKeyError,
@@ -523,6 +700,15 @@ export type {
   OrganizationsSetAgentOpenAiKeyResponse,
   OrganizationsSetAgentOpenAiKeyResponse2,
   OrganizationsSetAgentOpenAiKeyResponses,
+  OrganizationsSsoConfig,
+  OrganizationsSsoGroupMapping,
+  OrganizationsSsooidcSettings,
+  OrganizationsSsoProtocol,
+  OrganizationsSsosamlSettings,
+  OrganizationsTwoFactorEvent,
+  OrganizationsTwoFactorEventType,
+  OrganizationsTwoFactorMember,
+  OrganizationsTwoFactorPolicy,
   OrganizationsUpdateAgentSettingsBody,
   OrganizationsUpdateAgentSettingsData,
   OrganizationsUpdateAgentSettingsError,
@@ -551,6 +737,20 @@ export type {
   OrganizationsUpdateOrganizationResponse,
   OrganizationsUpdateOrganizationResponse2,
   OrganizationsUpdateOrganizationResponses,
+  OrganizationsUpdateSsoConfigBody,
+  OrganizationsUpdateSsoConfigData,
+  OrganizationsUpdateSsoConfigError,
+  OrganizationsUpdateSsoConfigErrors,
+  OrganizationsUpdateSsoConfigResponse,
+  OrganizationsUpdateSsoConfigResponse2,
+  OrganizationsUpdateSsoConfigResponses,
+  OrganizationsUpdateTwoFactorPolicyBody,
+  OrganizationsUpdateTwoFactorPolicyData,
+  OrganizationsUpdateTwoFactorPolicyError,
+  OrganizationsUpdateTwoFactorPolicyErrors,
+  OrganizationsUpdateTwoFactorPolicyResponse,
+  OrganizationsUpdateTwoFactorPolicyResponse2,
+  OrganizationsUpdateTwoFactorPolicyResponses,
   ProtobufAny,
   ProtobufNullValue,
   RolesAssignRoleBody,
@@ -692,6 +892,46 @@ export type {
   ServiceAccountsUpdateServiceAccountResponse,
   ServiceAccountsUpdateServiceAccountResponse2,
   ServiceAccountsUpdateServiceAccountResponses,
+  SubscriptionsCreateSubscriptionData,
+  SubscriptionsCreateSubscriptionError,
+  SubscriptionsCreateSubscriptionErrors,
+  SubscriptionsCreateSubscriptionRequest,
+  SubscriptionsCreateSubscriptionResponse,
+  SubscriptionsCreateSubscriptionResponse2,
+  SubscriptionsCreateSubscriptionResponses,
+  SubscriptionsDeleteSubscriptionData,
+  SubscriptionsDeleteSubscriptionError,
+  SubscriptionsDeleteSubscriptionErrors,
+  SubscriptionsDeleteSubscriptionResponse,
+  SubscriptionsDeleteSubscriptionResponse2,
+  SubscriptionsDeleteSubscriptionResponses,
+  SubscriptionsDelivery,
+  SubscriptionsDeliveryState,
+  SubscriptionsDescribeSubscriptionData,
+  SubscriptionsDescribeSubscriptionError,
+  SubscriptionsDescribeSubscriptionErrors,
+  SubscriptionsDescribeSubscriptionResponse,
+  SubscriptionsDescribeSubscriptionResponse2,
+  SubscriptionsDescribeSubscriptionResponses,
+  SubscriptionsListSubscriptionDeliveriesData,
+  SubscriptionsListSubscriptionDeliveriesError,
+  SubscriptionsListSubscriptionDeliveriesErrors,
+  SubscriptionsListSubscriptionDeliveriesResponse,
+  SubscriptionsListSubscriptionDeliveriesResponse2,
+  SubscriptionsListSubscriptionDeliveriesResponses,
+  SubscriptionsListSubscriptionsData,
+  SubscriptionsListSubscriptionsError,
+  SubscriptionsListSubscriptionsErrors,
+  SubscriptionsListSubscriptionsResponse,
+  SubscriptionsListSubscriptionsResponse2,
+  SubscriptionsListSubscriptionsResponses,
+  SubscriptionsSubscription,
+  SubscriptionsUpdateSubscriptionData,
+  SubscriptionsUpdateSubscriptionError,
+  SubscriptionsUpdateSubscriptionErrors,
+  SubscriptionsUpdateSubscriptionResponse,
+  SubscriptionsUpdateSubscriptionResponse2,
+  SubscriptionsUpdateSubscriptionResponses,
   SuperplaneBlueprintsOutputChannel,
   SuperplaneBlueprintsUserRef,
   SuperplaneCanvasesUserRef,
@@ -700,6 +940,27 @@ export type {
   SuperplaneMeUser,
   SuperplaneOrganizationsListIntegrationsResponse,
   SuperplaneUsersUser,
+  TokensCreateTokenData,
+  TokensCreateTokenError,
+  TokensCreateTokenErrors,
+  TokensCreateTokenRequest,
+  TokensCreateTokenResponse,
+  TokensCreateTokenResponse2,
+  TokensCreateTokenResponses,
+  TokensListTokensData,
+  TokensListTokensError,
+  TokensListTokensErrors,
+  TokensListTokensResponse,
+  TokensListTokensResponse2,
+  TokensListTokensResponses,
+  TokensRevokeTokenData,
+  TokensRevokeTokenError,
+  TokensRevokeTokenErrors,
+  TokensRevokeTokenResponse,
+  TokensRevokeTokenResponse2,
+  TokensRevokeTokenResponses,
+  TokensToken,
+  TokensTokenScope,
   TriggersDescribeTriggerData,
   TriggersDescribeTriggerError,
   TriggersDescribeTriggerErrors,
@@ -36,9 +36,27 @@ import type {
   CanvasesDescribeCanvasData,
   CanvasesDescribeCanvasErrors,
   CanvasesDescribeCanvasResponses,
+  CanvasesDownloadExecutionArtifactData,
+  CanvasesDownloadExecutionArtifactErrors,
+  CanvasesDownloadExecutionArtifactResponses,
   CanvasesEmitNodeEventData,
   CanvasesEmitNodeEventErrors,
   CanvasesEmitNodeEventResponses,
+  CanvasesExportCanvasDoraMetricsData,
+  CanvasesExportCanvasDoraMetricsErrors,
+  CanvasesExportCanvasDoraMetricsResponses,
+  CanvasesGetCanvasDoraMetricsData,
+  CanvasesGetCanvasDoraMetricsErrors,
+  CanvasesGetCanvasDoraMetricsResponses,
+  CanvasesGetCanvasDoraSettingsData,
+  CanvasesGetCanvasDoraSettingsErrors,
+  CanvasesGetCanvasDoraSettingsResponses,
+  CanvasesGetCanvasStatsData,
+  CanvasesGetCanvasStatsErrors,
+  CanvasesGetCanvasStatsResponses,
+  CanvasesGetExecutionLogsData,
+  CanvasesGetExecutionLogsErrors,
+  CanvasesGetExecutionLogsResponses,
   CanvasesInvokeNodeExecutionActionData,
   CanvasesInvokeNodeExecutionActionErrors,
   CanvasesInvokeNodeExecutionActionResponses,
@@ -60,6 +78,9 @@ import type {
   CanvasesListEventExecutionsData,
   CanvasesListEventExecutionsErrors,
   CanvasesListEventExecutionsResponses,
+  CanvasesListExecutionArtifactsData,
+  CanvasesListExecutionArtifactsErrors,
+  CanvasesListExecutionArtifactsResponses,
   CanvasesListNodeEventsData,
   CanvasesListNodeEventsErrors,
   CanvasesListNodeEventsResponses,
@@ -76,11 +97,20 @@ import type {
   CanvasesSendAiMessageErrors,
   CanvasesSendAiMessageResponses,
   CanvasesUpdateCanvasData,
+  CanvasesUpdateCanvasDoraSettingsData,
+  CanvasesUpdateCanvasDoraSettingsErrors,
+  CanvasesUpdateCanvasDoraSettingsResponses,
   CanvasesUpdateCanvasErrors,
   CanvasesUpdateCanvasResponses,
   CanvasesUpdateNodePauseData,
   CanvasesUpdateNodePauseErrors,
   CanvasesUpdateNodePauseResponses,
+  CanvasesWatchCanvasData,
+  CanvasesWatchCanvasErrors,
+  CanvasesWatchCanvasResponses,
+  CanvasesWatchExecutionData,
+  CanvasesWatchExecutionErrors,
+  CanvasesWatchExecutionResponses,
   ComponentsDescribeComponentData,
   ComponentsDescribeComponentErrors,
   ComponentsDescribeComponentResponses,
@@ -117,12 +147,18 @@ import type {
   IntegrationsListIntegrationsData,
   IntegrationsListIntegrationsErrors,
   IntegrationsListIntegrationsResponses,
+  MeGetNotificationPreferencesData,
+  MeGetNotificationPreferencesErrors,
+  MeGetNotificationPreferencesResponses,
   MeMeData,
   MeMeErrors,
   MeMeResponses,
   MeRegenerateTokenData,
   MeRegenerateTokenErrors,
   MeRegenerateTokenResponses,
+  MeUpdateNotificationPreferencesData,
+  MeUpdateNotificationPreferencesErrors,
+  MeUpdateNotificationPreferencesResponses,
   OrganizationsAcceptInviteLinkData,
   OrganizationsAcceptInviteLinkErrors,
   OrganizationsAcceptInviteLinkResponses,
@@ -141,6 +177,12 @@ import type {
   OrganizationsDeleteOrganizationData,
   OrganizationsDeleteOrganizationErrors,
   OrganizationsDeleteOrganizationResponses,
+  OrganizationsDeleteScimTokenData,
+  OrganizationsDeleteScimTokenErrors,
+  OrganizationsDeleteScimTokenResponses,
+  OrganizationsDeleteSsoConfigData,
+  OrganizationsDeleteSsoConfigErrors,
+  OrganizationsDeleteSsoConfigResponses,
   OrganizationsDescribeIntegrationData,
   OrganizationsDescribeIntegrationErrors,
   OrganizationsDescribeIntegrationResponses,
@@ -153,6 +195,15 @@ import type {
   OrganizationsGetInviteLinkData,
   OrganizationsGetInviteLinkErrors,
   OrganizationsGetInviteLinkResponses,
+  OrganizationsGetScimConfigData,
+  OrganizationsGetScimConfigErrors,
+  OrganizationsGetScimConfigResponses,
+  OrganizationsGetSsoConfigData,
+  OrganizationsGetSsoConfigErrors,
+  OrganizationsGetSsoConfigResponses,
+  OrganizationsGetTwoFactorPolicyData,
+  OrganizationsGetTwoFactorPolicyErrors,
+  OrganizationsGetTwoFactorPolicyResponses,
   OrganizationsListIntegrationResourcesData,
   OrganizationsListIntegrationResourcesErrors,
   OrganizationsListIntegrationResourcesResponses,
@@ -162,6 +213,9 @@ import type {
   OrganizationsListInvitationsData,
   OrganizationsListInvitationsErrors,
   OrganizationsListInvitationsResponses,
+  OrganizationsListTwoFactorEventsData,
+  OrganizationsListTwoFactorEventsErrors,
+  OrganizationsListTwoFactorEventsResponses,
   OrganizationsRemoveInvitationData,
   OrganizationsRemoveInvitationErrors,
   OrganizationsRemoveInvitationResponses,
@@ -171,6 +225,12 @@ import type {
   OrganizationsResetInviteLinkData,
   OrganizationsResetInviteLinkErrors,
   OrganizationsResetInviteLinkResponses,
+  OrganizationsResetScimTokenData,
+  OrganizationsResetScimTokenErrors,
+  OrganizationsResetScimTokenResponses,
+  OrganizationsResetUserTwoFactorData,
+  OrganizationsResetUserTwoFactorErrors,
+  OrganizationsResetUserTwoFactorResponses,
   OrganizationsSetAgentOpenAiKeyData,
   OrganizationsSetAgentOpenAiKeyErrors,
   OrganizationsSetAgentOpenAiKeyResponses,
@@ -186,6 +246,12 @@ import type {
   OrganizationsUpdateOrganizationData,
   OrganizationsUpdateOrganizationErrors,
   OrganizationsUpdateOrganizationResponses,
+  OrganizationsUpdateSsoConfigData,
+  OrganizationsUpdateSsoConfigErrors,
+  OrganizationsUpdateSsoConfigResponses,
+  OrganizationsUpdateTwoFactorPolicyData,
+  OrganizationsUpdateTwoFactorPolicyErrors,
+  OrganizationsUpdateTwoFactorPolicyResponses,
   RolesAssignRoleData,
   RolesAssignRoleErrors,
   RolesAssignRoleResponses,
@@ -246,6 +312,33 @@ import type {
   ServiceAccountsUpdateServiceAccountData,
   ServiceAccountsUpdateServiceAccountErrors,
   ServiceAccountsUpdateServiceAccountResponses,
+  SubscriptionsCreateSubscriptionData,
+  SubscriptionsCreateSubscriptionErrors,
+  SubscriptionsCreateSubscriptionResponses,
+  SubscriptionsDeleteSubscriptionData,
+  SubscriptionsDeleteSubscriptionErrors,
+  SubscriptionsDeleteSubscriptionResponses,
+  SubscriptionsDescribeSubscriptionData,
+  SubscriptionsDescribeSubscriptionErrors,
+  SubscriptionsDescribeSubscriptionResponses,
+  SubscriptionsListSubscriptionDeliveriesData,
+  SubscriptionsListSubscriptionDeliveriesErrors,
+  SubscriptionsListSubscriptionDeliveriesResponses,
+  SubscriptionsListSubscriptionsData,
+  SubscriptionsListSubscriptionsErrors,
+  SubscriptionsListSubscriptionsResponses,
+  SubscriptionsUpdateSubscriptionData,
+  SubscriptionsUpdateSubscriptionErrors,
+  SubscriptionsUpdateSubscriptionResponses,
+  TokensCreateTokenData,
+  TokensCreateTokenErrors,
+  TokensCreateTokenResponses,
+  TokensListTokensData,
+  TokensListTokensErrors,
+  TokensListTokensResponses,
+  TokensRevokeTokenData,
+  TokensRevokeTokenErrors,
+  TokensRevokeTokenResponses,
   TriggersDescribeTriggerData,
   TriggersDescribeTriggerErrors,
   TriggersDescribeTriggerResponses,
@@ -405,6 +498,69 @@ export const canvasesSendAiMessage = <ThrowOnError extends boolean = true>(
     },
   });
 
+/**
+ * Get canvas DORA metrics
+ *
+ * Returns deployment frequency, lead time for changes, change failure rate and MTTR of a canvas over a time range
+ */
+export const canvasesGetCanvasDoraMetrics = <ThrowOnError extends boolean = true>(
+  options: Options<CanvasesGetCanvasDoraMetricsData, ThrowOnError>,
+) =>
+  (options.client ?? client).get<
+    CanvasesGetCanvasDoraMetricsResponses,
+    CanvasesGetCanvasDoraMetricsErrors,
+    ThrowOnError
+  >({ url: "/api/v1/canvases/{canvasId}/dora/metrics", ...options });
+
+/**
+ * Export canvas DORA metrics
+ *
+ * Returns the DORA metrics time series of a canvas as CSV
+ */
+export const canvasesExportCanvasDoraMetrics = <ThrowOnError extends boolean = true>(
+  options: Options<CanvasesExportCanvasDoraMetricsData, ThrowOnError>,
+) =>
+  (options.client ?? client).get<
+    CanvasesExportCanvasDoraMetricsResponses,
+    CanvasesExportCanvasDoraMetricsErrors,
+    ThrowOnError
+  >({ url: "/api/v1/canvases/{canvasId}/dora/metrics/export", ...options });
+
+/**
+ * Get canvas DORA settings
+ *
+ * Returns the deployment and incident nodes used to compute the DORA metrics of a canvas
+ */
+export const canvasesGetCanvasDoraSettings = <ThrowOnError extends boolean = true>(
+  options: Options<CanvasesGetCanvasDoraSettingsData, ThrowOnError>,
+) =>
+  (options.client ?? client).get<
+    CanvasesGetCanvasDoraSettingsResponses,
+    CanvasesGetCanvasDoraSettingsErrors,
+    ThrowOnError
+  >({ url: "/api/v1/canvases/{canvasId}/dora/settings", ...options });
+
+/**
+ * Update canvas DORA settings
+ *
+ * Marks canvas nodes as deployments or incidents, and maps the root event fields used to compute the DORA metrics
+ */
+export const canvasesUpdateCanvasDoraSettings = <ThrowOnError extends boolean = true>(
+  options: Options<CanvasesUpdateCanvasDoraSettingsData, ThrowOnError>,
+) =>
+  (options.client ?? client).put<
+    CanvasesUpdateCanvasDoraSettingsResponses,
+    CanvasesUpdateCanvasDoraSettingsErrors,
+    ThrowOnError
+  >({
+    url: "/api/v1/canvases/{canvasId}/dora/settings",
+    ...options,
+    headers: {
+      "Content-Type": "application/json",
+      ...options.headers,
+    },
+  });
+
 /**
  * List canvas events
  *
@@ -472,6 +628,34 @@ export const canvasesInvokeNodeExecutionAction = <ThrowOnError extends boolean =
     },
   });
 
+/**
+ * List execution artifacts
+ *
+ * Returns the artifacts attached to a canvas node execution
+ */
+export const canvasesListExecutionArtifacts = <ThrowOnError extends boolean = true>(
+  options: Options<CanvasesListExecutionArtifactsData, ThrowOnError>,
+) =>
+  (options.client ?? client).get<
+    CanvasesListExecutionArtifactsResponses,
+    CanvasesListExecutionArtifactsErrors,
+    ThrowOnError
+  >({ url: "/api/v1/canvases/{canvasId}/executions/{executionId}/artifacts", ...options });
+
+/**
+ * Download execution artifact
+ *
+ * Streams the contents of an artifact attached to a canvas node execution in chunks. The first chunk also has the artifact.
+ */
+export const canvasesDownloadExecutionArtifact = <ThrowOnError extends boolean = true>(
+  options: Options<CanvasesDownloadExecutionArtifactData, ThrowOnError>,
+) =>
+  (options.client ?? client).get<
+    CanvasesDownloadExecutionArtifactResponses,
+    CanvasesDownloadExecutionArtifactErrors,
+    ThrowOnError
+  >({ url: "/api/v1/canvases/{canvasId}/executions/{executionId}/artifacts/download", ...options });
+
 /**
  * Cancel execution
  *
@@ -510,6 +694,32 @@ export const canvasesListChildExecutions = <ThrowOnError extends boolean = true>
     },
   });
 
+/**
+ * Get execution logs
+ *
+ * Returns the log output of a canvas node execution
+ */
+export const canvasesGetExecutionLogs = <ThrowOnError extends boolean = true>(
+  options: Options<CanvasesGetExecutionLogsData, ThrowOnError>,
+) =>
+  (options.client ?? client).get<CanvasesGetExecutionLogsResponses, CanvasesGetExecutionLogsErrors, ThrowOnError>({
+    url: "/api/v1/canvases/{canvasId}/executions/{executionId}/logs",
+    ...options,
+  });
+
+/**
+ * Watch execution
+ *
+ * Streams the changes of an execution, its child executions and output events, until the execution finishes. Send Accept: text/event-stream to receive server-sent events.
+ */
+export const canvasesWatchExecution = <ThrowOnError extends boolean = true>(
+  options: Options<CanvasesWatchExecutionData, ThrowOnError>,
+) =>
+  (options.client ?? client).get<CanvasesWatchExecutionResponses, CanvasesWatchExecutionErrors, ThrowOnError>({
+    url: "/api/v1/canvases/{canvasId}/executions/{executionId}/watch",
+    ...options,
+  });
+
 /**
  * List canvas memories
  *
@@ -624,6 +834,19 @@ export const canvasesDeleteNodeQueueItem = <ThrowOnError extends boolean = true>
     ThrowOnError
   >({ url: "/api/v1/canvases/{canvasId}/nodes/{nodeId}/queue/{itemId}", ...options });
 
+/**
+ * Get canvas stats
+ *
+ * Returns success rates, durations and throughput of the executions of a canvas and its nodes over a time range
+ */
+export const canvasesGetCanvasStats = <ThrowOnError extends boolean = true>(
+  options: Options<CanvasesGetCanvasStatsData, ThrowOnError>,
+) =>
+  (options.client ?? client).get<CanvasesGetCanvasStatsResponses, CanvasesGetCanvasStatsErrors, ThrowOnError>({
+    url: "/api/v1/canvases/{canvasId}/stats",
+    ...options,
+  });
+
 /**
  * Invoke trigger action
  *
@@ -645,6 +868,19 @@ export const canvasesInvokeNodeTriggerAction = <ThrowOnError extends boolean = t
     },
   });
 
+/**
+ * Watch canvas
+ *
+ * Streams the events, executions and queue items of a canvas as they change. Send Accept: text/event-stream to receive server-sent events.
+ */
+export const canvasesWatchCanvas = <ThrowOnError extends boolean = true>(
+  options: Options<CanvasesWatchCanvasData, ThrowOnError>,
+) =>
+  (options.client ?? client).get<CanvasesWatchCanvasResponses, CanvasesWatchCanvasErrors, ThrowOnError>({
+    url: "/api/v1/canvases/{canvasId}/watch",
+    ...options,
+  });
+
 /**
  * Delete canvas
  *
@@ -883,6 +1119,41 @@ export const organizationsAcceptInviteLink = <ThrowOnError extends boolean = tru
 export const meMe = <ThrowOnError extends boolean = true>(options?: Options<MeMeData, ThrowOnError>) =>
   (options?.client ?? client).get<MeMeResponses, MeMeErrors, ThrowOnError>({ url: "/api/v1/me", ...options });
 
+/**
+ * Get notification preferences
+ *
+ * Returns the notification channels, quiet hours and digest interval of the currently authenticated user
+ */
+export const meGetNotificationPreferences = <ThrowOnError extends boolean = true>(
+  options?: Options<MeGetNotificationPreferencesData, ThrowOnError>,
+) =>
+  (options?.client ?? client).get<
+    MeGetNotificationPreferencesResponses,
+    MeGetNotificationPreferencesErrors,
+    ThrowOnError
+  >({ url: "/api/v1/me/notification-preferences", ...options });
+
+/**
+ * Update notification preferences
+ *
+ * Replaces the notification channels, quiet hours and digest interval of the currently authenticated user
+ */
+export const meUpdateNotificationPreferences = <ThrowOnError extends boolean = true>(
+  options: Options<MeUpdateNotificationPreferencesData, ThrowOnError>,
+) =>
+  (options.client ?? client).put<
+    MeUpdateNotificationPreferencesResponses,
+    MeUpdateNotificationPreferencesErrors,
+    ThrowOnError
+  >({
+    url: "/api/v1/me/notification-preferences",
+    ...options,
+    headers: {
+      "Content-Type": "application/json",
+      ...options.headers,
+    },
+  });
+
 /**
  * Regenerate API token
  *
@@ -1210,6 +1481,144 @@ export const organizationsResetInviteLink = <ThrowOnError extends boolean = true
     ThrowOnError
   >({ url: "/api/v1/organizations/{id}/invite-link/reset", ...options });
 
+/**
+ * Get organization SCIM provisioning configuration
+ *
+ * Returns the SCIM 2.0 base URL and the status of the SCIM token for an organization
+ */
+export const organizationsGetScimConfig = <ThrowOnError extends boolean = true>(
+  options: Options<OrganizationsGetScimConfigData, ThrowOnError>,
+) =>
+  (options.client ?? client).get<OrganizationsGetScimConfigResponses, OrganizationsGetScimConfigErrors, ThrowOnError>({
+    url: "/api/v1/organizations/{id}/scim",
+    ...options,
+  });
+
+/**
+ * Delete organization SCIM token
+ *
+ * Deletes the SCIM token for an organization, disabling SCIM provisioning
+ */
+export const organizationsDeleteScimToken = <ThrowOnError extends boolean = true>(
+  options: Options<OrganizationsDeleteScimTokenData, ThrowOnError>,
+) =>
+  (options.client ?? client).delete<
+    OrganizationsDeleteScimTokenResponses,
+    OrganizationsDeleteScimTokenErrors,
+    ThrowOnError
+  >({ url: "/api/v1/organizations/{id}/scim/token", ...options });
+
+/**
+ * Reset organization SCIM token
+ *
+ * Generates a new SCIM token for an organization, replacing the existing one
+ */
+export const organizationsResetScimToken = <ThrowOnError extends boolean = true>(
+  options: Options<OrganizationsResetScimTokenData, ThrowOnError>,
+) =>
+  (options.client ?? client).post<
+    OrganizationsResetScimTokenResponses,
+    OrganizationsResetScimTokenErrors,
+    ThrowOnError
+  >({ url: "/api/v1/organizations/{id}/scim/token", ...options });
+
+/**
+ * Delete organization single sign-on configuration
+ *
+ * Removes single sign-on from an organization, re-enabling other login methods
+ */
+export const organizationsDeleteSsoConfig = <ThrowOnError extends boolean = true>(
+  options: Options<OrganizationsDeleteSsoConfigData, ThrowOnError>,
+) =>
+  (options.client ?? client).delete<
+    OrganizationsDeleteSsoConfigResponses,
+    OrganizationsDeleteSsoConfigErrors,
+    ThrowOnError
+  >({ url: "/api/v1/organizations/{id}/sso", ...options });
+
+/**
+ * Get organization single sign-on configuration
+ *
+ * Returns the SAML or OIDC single sign-on configuration for an organization
+ */
+export const organizationsGetSsoConfig = <ThrowOnError extends boolean = true>(
+  options: Options<OrganizationsGetSsoConfigData, ThrowOnError>,
+) =>
+  (options.client ?? client).get<OrganizationsGetSsoConfigResponses, OrganizationsGetSsoConfigErrors, ThrowOnError>({
+    url: "/api/v1/organizations/{id}/sso",
+    ...options,
+  });
+
+/**
+ * Create or update organization single sign-on configuration
+ *
+ * Configures SAML 2.0 or OIDC single sign-on, group mappings and SSO enforcement for an organization
+ */
+export const organizationsUpdateSsoConfig = <ThrowOnError extends boolean = true>(
+  options: Options<OrganizationsUpdateSsoConfigData, ThrowOnError>,
+) =>
+  (options.client ?? client).put<
+    OrganizationsUpdateSsoConfigResponses,
+    OrganizationsUpdateSsoConfigErrors,
+    ThrowOnError
+  >({
+    url: "/api/v1/organizations/{id}/sso",
+    ...options,
+    headers: {
+      "Content-Type": "application/json",
+      ...options.headers,
+    },
+  });
+
+/**
+ * Get organization two-factor authentication policy
+ *
+ * Returns whether the organization requires two-factor authentication, and the two-factor status of its members
+ */
+export const organizationsGetTwoFactorPolicy = <ThrowOnError extends boolean = true>(
+  options: Options<OrganizationsGetTwoFactorPolicyData, ThrowOnError>,
+) =>
+  (options.client ?? client).get<
+    OrganizationsGetTwoFactorPolicyResponses,
+    OrganizationsGetTwoFactorPolicyErrors,
+    ThrowOnError
+  >({ url: "/api/v1/organizations/{id}/two-factor", ...options });
+
+/**
+ * Update organization two-factor authentication policy
+ *
+ * Requires, or stops requiring, two-factor authentication for members who sign in with a password
+ */
+export const organizationsUpdateTwoFactorPolicy = <ThrowOnError extends boolean = true>(
+  options: Options<OrganizationsUpdateTwoFactorPolicyData, ThrowOnError>,
+) =>
+  (options.client ?? client).put<
+    OrganizationsUpdateTwoFactorPolicyResponses,
+    OrganizationsUpdateTwoFactorPolicyErrors,
+    ThrowOnError
+  >({
+    url: "/api/v1/organizations/{id}/two-factor",
+    ...options,
+    headers: {
+      "Content-Type": "application/json",
+      ...options.headers,
+    },
+  });
+
+/**
+ * List two-factor authentication events
+ *
+ * Returns the two-factor authentication audit events of the organization members, newest first
+ */
+export const organizationsListTwoFactorEvents = <ThrowOnError extends boolean = true>(
+  options: Options<OrganizationsListTwoFactorEventsData, ThrowOnError>,
+) =>
+  (options.client ?? client).get<
+    OrganizationsListTwoFactorEventsResponses,
+    OrganizationsListTwoFactorEventsErrors,
+    ThrowOnError
+  >({ url: "/api/v1/organizations/{id}/two-factor/events", ...options });
+
 /**
  * Remove a user from an organization
  *
@@ -1223,6 +1632,20 @@ export const organizationsRemoveUser = <ThrowOnError extends boolean = true>(
     ...options,
   });
 
+/**
+ * Reset a member's two-factor authentication
+ *
+ * Removes the two-factor authentication of an organization member, so they can enroll again
+ */
+export const organizationsResetUserTwoFactor = <ThrowOnError extends boolean = true>(
+  options: Options<OrganizationsResetUserTwoFactorData, ThrowOnError>,
+) =>
+  (options.client ?? client).post<
+    OrganizationsResetUserTwoFactorResponses,
+    OrganizationsResetUserTwoFactorErrors,
+    ThrowOnError
+  >({ url: "/api/v1/organizations/{id}/users/{userId}/two-factor/reset", ...options });
+
 /**
  * List roles
  *
@@ -1538,6 +1961,147 @@ export const serviceAccountsRegenerateServiceAccountToken = <ThrowOnError extend
     },
   });
 
+/**
+ * List event subscriptions
+ *
+ * Returns the webhook subscriptions of the organization
+ */
+export const subscriptionsListSubscriptions = <ThrowOnError extends boolean = true>(
+  options?: Options<SubscriptionsListSubscriptionsData, ThrowOnError>,
+) =>
+  (options?.client ?? client).get<
+    SubscriptionsListSubscriptionsResponses,
+    SubscriptionsListSubscriptionsErrors,
+    ThrowOnError
+  >({ url: "/api/v1/subscriptions", ...options });
+
+/**
+ * Create an event subscription
+ *
+ * Creates a webhook subscription for the canvas activity of the organization
+ */
+export const subscriptionsCreateSubscription = <ThrowOnError extends boolean = true>(
+  options: Options<SubscriptionsCreateSubscriptionData, ThrowOnError>,
+) =>
+  (options.client ?? client).post<
+    SubscriptionsCreateSubscriptionResponses,
+    SubscriptionsCreateSubscriptionErrors,
+    ThrowOnError
+  >({
+    url: "/api/v1/subscriptions",
+    ...options,
+    headers: {
+      "Content-Type": "application/json",
+      ...options.headers,
+    },
+  });
+
+/**
+ * Delete an event subscription
+ *
+ * Deletes a webhook subscription and its delivery log
+ */
+export const subscriptionsDeleteSubscription = <ThrowOnError extends boolean = true>(
+  options: Options<SubscriptionsDeleteSubscriptionData, ThrowOnError>,
+) =>
+  (options.client ?? client).delete<
+    SubscriptionsDeleteSubscriptionResponses,
+    SubscriptionsDeleteSubscriptionErrors,
+    ThrowOnError
+  >({ url: "/api/v1/subscriptions/{id}", ...options });
+
+/**
+ * Describe an event subscription
+ *
+ * Returns a webhook subscription of the organization
+ */
+export const subscriptionsDescribeSubscription = <ThrowOnError extends boolean = true>(
+  options: Options<SubscriptionsDescribeSubscriptionData, ThrowOnError>,
+) =>
+  (options.client ?? client).get<
+    SubscriptionsDescribeSubscriptionResponses,
+    SubscriptionsDescribeSubscriptionErrors,
+    ThrowOnError
+  >({ url: "/api/v1/subscriptions/{id}", ...options });
+
+/**
+ * Update an event subscription
+ *
+ * Updates the URL, filters or state of a webhook subscription
+ */
+export const subscriptionsUpdateSubscription = <ThrowOnError extends boolean = true>(
+  options: Options<SubscriptionsUpdateSubscriptionData, ThrowOnError>,
+) =>
+  (options.client ?? client).put<
+    SubscriptionsUpdateSubscriptionResponses,
+    SubscriptionsUpdateSubscriptionErrors,
+    ThrowOnError
+  >({
+    url: "/api/v1/subscriptions/{id}",
+    ...options,
+    headers: {
+      "Content-Type": "application/json",
+      ...options.headers,
+    },
+  });
+
+/**
+ * List subscription deliveries
+ *
+ * Returns the most recent deliveries of a webhook subscription
+ */
+export const subscriptionsListSubscriptionDeliveries = <ThrowOnError extends boolean = true>(
+  options: Options<SubscriptionsListSubscriptionDeliveriesData, ThrowOnError>,
+) =>
+  (options.client ?? client).get<
+    SubscriptionsListSubscriptionDeliveriesResponses,
+    SubscriptionsListSubscriptionDeliveriesErrors,
+    ThrowOnError
+  >({ url: "/api/v1/subscriptions/{id}/deliveries", ...options });
+
+/**
+ * List API tokens
+ *
+ * Returns the API tokens of the current user, or of a service account
+ */
+export const tokensListTokens = <ThrowOnError extends boolean = true>(
+  options?: Options<TokensListTokensData, ThrowOnError>,
+) =>
+  (options?.client ?? client).get<TokensListTokensResponses, TokensListTokensErrors, ThrowOnError>({
+    url: "/api/v1/tokens",
+    ...options,
+  });
+
+/**
+ * Create an API token
+ *
+ * Creates a named API token for the current user, or for a service account
+ */
+export const tokensCreateToken = <ThrowOnError extends boolean = true>(
+  options: Options<TokensCreateTokenData, ThrowOnError>,
+) =>
+  (options.client ?? client).post<TokensCreateTokenResponses, TokensCreateTokenErrors, ThrowOnError>({
+    url: "/api/v1/tokens",
+    ...options,
+    headers: {
+      "Content-Type": "application/json",
+      ...options.headers,
+    },
+  });
+
+/**
+ * Revoke an API token
+ *
+ * Revokes an API token of the current user, or of a service account
+ */
+export const tokensRevokeToken = <ThrowOnError extends boolean = true>(
+  options: Options<TokensRevokeTokenData, ThrowOnError>,
+) =>
+  (options.client ?? client).delete<TokensRevokeTokenResponses, TokensRevokeTokenErrors, ThrowOnError>({
+    url: "/api/v1/tokens/{id}",
+    ...options,
+  });
+
 /**
  * List triggers
  *
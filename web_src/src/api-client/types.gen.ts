@@ -79,6 +79,56 @@ export type CanvasNodeExecutionResultReason =
 
 export type CanvasNodeExecutionState = "STATE_UNKNOWN" | "STATE_PENDING" | "STATE_STARTED" | "STATE_FINISHED";
 
+export type CanvasPlanAction = "ACTION_UNSPECIFIED" | "ACTION_ADD" | "ACTION_REMOVE" | "ACTION_CHANGE";
+
+export type CanvasPlanEdgeChange = {
+  action?: CanvasPlanAction;
+  sourceId?: string;
+  targetId?: string;
+  channel?: string;
+};
+
+export type CanvasPlanFieldChange = {
+  path?: string;
+  before?: string;
+  after?: string;
+};
+
+export type CanvasPlanNodeChange = {
+  action?: CanvasPlanAction;
+  nodeId?: string;
+  nodeName?: string;
+  ref?: string;
+  fields?: Array<CanvasPlanFieldChange>;
+};
+
+export type CanvasPlanOrphanedNode = {
+  nodeId?: string;
+  nodeName?: string;
+  runningExecutions?: string;
+  queueItems?: string;
+};
+
+export type CanvasPlanSubscriptionChange = {
+  action?: CanvasPlanAction;
+  nodeId?: string;
+  nodeName?: string;
+  integrationId?: string;
+};
+
+/**
+ * Webhooks are added when nodes that can receive webhooks are set up,
+ * removed when the last node using them is removed,
+ * and changed when the configuration of a node using them changes.
+ */
+export type CanvasPlanWebhookChange = {
+  action?: CanvasPlanAction;
+  nodeId?: string;
+  nodeName?: string;
+  webhookId?: string;
+  integration?: string;
+};
+
 export type CanvasesCancelExecutionBody = {
   [key: string]: unknown;
 };
@@ -117,6 +167,29 @@ export type CanvasesCanvasAutoLayout = {
   scope?: CanvasAutoLayoutScope;
 };
 
+/**
+ * A change streamed by the Watch RPCs.
+ * Pass the resume_token of the last change received
+ * when reconnecting, to continue from where the stream stopped.
+ * Changes are delivered at least once.
+ */
+export type CanvasesCanvasChange = {
+  type?: CanvasesCanvasChangeType;
+  resumeToken?: string;
+  timestamp?: string;
+  event?: CanvasesCanvasEvent;
+  execution?: CanvasesCanvasNodeExecution;
+  queueItem?: CanvasesCanvasNodeQueueItem;
+};
+
+export type CanvasesCanvasChangeType =
+  | "CANVAS_CHANGE_TYPE_UNKNOWN"
+  | "CANVAS_CHANGE_TYPE_HEARTBEAT"
+  | "CANVAS_CHANGE_TYPE_EVENT_CREATED"
+  | "CANVAS_CHANGE_TYPE_EXECUTION_UPDATED"
+  | "CANVAS_CHANGE_TYPE_QUEUE_ITEM_CREATED"
+  | "CANVAS_CHANGE_TYPE_QUEUE_ITEM_DELETED";
+
 export type CanvasesCanvasEvent = {
   id?: string;
   canvasId?: string;
@@ -142,6 +215,17 @@ export type CanvasesCanvasEventWithExecutions = {
   customName?: string;
 };
 
+export type CanvasesCanvasExecutionStats = {
+  total?: string;
+  passed?: string;
+  failed?: string;
+  cancelled?: string;
+  successRate?: number;
+  p50DurationSeconds?: number;
+  p95DurationSeconds?: number;
+  executionsPerHour?: number;
+};
+
 export type CanvasesCanvasMemory = {
   id?: string;
   namespace?: string;
@@ -188,6 +272,33 @@ export type CanvasesCanvasNodeExecution = {
   cancelledBy?: SuperplaneCanvasesUserRef;
 };
 
+export type CanvasesCanvasNodeExecutionArtifact = {
+  id?: string;
+  executionId?: string;
+  nodeId?: string;
+  name?: string;
+  contentType?: string;
+  size?: string;
+  sha256?: string;
+  createdAt?: string;
+};
+
+export type CanvasesCanvasNodeExecutionLog = {
+  id?: string;
+  executionId?: string;
+  nodeId?: string;
+  stream?: string;
+  message?: string;
+  createdAt?: string;
+};
+
+export type CanvasesCanvasNodeExecutionStats = {
+  nodeId?: string;
+  nodeName?: string;
+  component?: string;
+  stats?: CanvasesCanvasExecutionStats;
+};
+
 export type CanvasesCanvasNodeQueueItem = {
   id?: string;
   canvasId?: string;
@@ -199,6 +310,19 @@ export type CanvasesCanvasNodeQueueItem = {
   createdAt?: string;
 };
 
+/**
+ * CanvasPlan describes what an update does, without doing it.
+ * It is returned by UpdateCanvas when dry_run is set.
+ */
+export type CanvasesCanvasPlan = {
+  nodes?: Array<CanvasPlanNodeChange>;
+  edges?: Array<CanvasPlanEdgeChange>;
+  webhooks?: Array<CanvasPlanWebhookChange>;
+  subscriptions?: Array<CanvasPlanSubscriptionChange>;
+  orphaned?: Array<CanvasPlanOrphanedNode>;
+  canvas?: Array<CanvasPlanFieldChange>;
+};
+
 export type CanvasesCanvasSpec = {
   nodes?: Array<ComponentsNode>;
   edges?: Array<ComponentsEdge>;
@@ -234,6 +358,31 @@ export type CanvasesDescribeCanvasResponse = {
   canvas?: CanvasesCanvas;
 };
 
+export type CanvasesDoraInterval = "DORA_INTERVAL_UNSPECIFIED" | "DORA_INTERVAL_DAY" | "DORA_INTERVAL_WEEK";
+
+export type CanvasesDoraMetrics = {
+  start?: string;
+  end?: string;
+  deployments?: string;
+  failedDeployments?: string;
+  deploymentFrequencyPerDay?: number;
+  leadTimeSeconds?: number;
+  changeFailureRate?: number;
+  incidents?: string;
+  mttrSeconds?: number;
+};
+
+export type CanvasesDoraSettings = {
+  deploymentNodes?: Array<DoraSettingsDeploymentNode>;
+  incidentNodes?: Array<DoraSettingsIncidentNode>;
+  updatedAt?: string;
+};
+
+export type CanvasesDownloadExecutionArtifactResponse = {
+  artifact?: CanvasesCanvasNodeExecutionArtifact;
+  content?: string;
+};
+
 export type CanvasesEmitNodeEventBody = {
   channel?: string;
   data?: {
@@ -245,6 +394,36 @@ export type CanvasesEmitNodeEventResponse = {
   eventId?: string;
 };
 
+export type CanvasesExportCanvasDoraMetricsResponse = {
+  filename?: string;
+  contentType?: string;
+  content?: string;
+};
+
+export type CanvasesGetCanvasDoraMetricsResponse = {
+  summary?: CanvasesDoraMetrics;
+  series?: Array<CanvasesDoraMetrics>;
+  interval?: CanvasesDoraInterval;
+};
+
+export type CanvasesGetCanvasDoraSettingsResponse = {
+  settings?: CanvasesDoraSettings;
+};
+
+export type CanvasesGetCanvasStatsResponse = {
+  canvas?: CanvasesCanvasExecutionStats;
+  nodes?: Array<CanvasesCanvasNodeExecutionStats>;
+  from?: string;
+  to?: string;
+};
+
+export type CanvasesGetExecutionLogsResponse = {
+  logs?: Array<CanvasesCanvasNodeExecutionLog>;
+  lastId?: string;
+  hasMore?: boolean;
+  finished?: boolean;
+};
+
 export type CanvasesInvokeNodeExecutionActionBody = {
   parameters?: {
     [key: string]: unknown;
@@ -252,7 +431,7 @@ export type CanvasesInvokeNodeExecutionActionBody = {
 };
 
 export type CanvasesInvokeNodeExecutionActionResponse = {
-  [key: string]: unknown;
+  rootEventId?: string;
 };
 
 export type CanvasesInvokeNodeTriggerActionBody = {
@@ -292,6 +471,16 @@ export type CanvasesListChildExecutionsResponse = {
 
 export type CanvasesListEventExecutionsResponse = {
   executions?: Array<CanvasesCanvasNodeExecution>;
+  /**
+   * True once the chain started by the event is over:
+   * every event of the chain was routed, no queue item is left,
+   * and every execution finished.
+   */
+  finished?: boolean;
+};
+
+export type CanvasesListExecutionArtifactsResponse = {
+  artifacts?: Array<CanvasesCanvasNodeExecutionArtifact>;
 };
 
 export type CanvasesListNodeEventsResponse = {
@@ -338,10 +527,20 @@ export type CanvasesSendAiMessageResponse = {
 export type CanvasesUpdateCanvasBody = {
   canvas?: CanvasesCanvas;
   autoLayout?: CanvasesCanvasAutoLayout;
+  dryRun?: boolean;
+};
+
+export type CanvasesUpdateCanvasDoraSettingsBody = {
+  settings?: CanvasesDoraSettings;
+};
+
+export type CanvasesUpdateCanvasDoraSettingsResponse = {
+  settings?: CanvasesDoraSettings;
 };
 
 export type CanvasesUpdateCanvasResponse = {
   canvas?: CanvasesCanvas;
+  plan?: CanvasesCanvasPlan;
 };
 
 export type CanvasesUpdateNodePauseBody = {
@@ -352,6 +551,14 @@ export type CanvasesUpdateNodePauseResponse = {
   node?: ComponentsNode;
 };
 
+export type CanvasesWatchCanvasResponse = {
+  change?: CanvasesCanvasChange;
+};
+
+export type CanvasesWatchExecutionResponse = {
+  change?: CanvasesCanvasChange;
+};
+
 export type ComponentsComponent = {
   name?: string;
   label?: string;
@@ -414,6 +621,7 @@ export type ComponentsNode = {
   errorMessage?: string;
   warningMessage?: string;
   paused?: boolean;
+  errorChannelEnabled?: boolean;
 };
 
 export type ComponentsNodeType = "TYPE_COMPONENT" | "TYPE_BLUEPRINT" | "TYPE_TRIGGER" | "TYPE_WIDGET";
@@ -553,6 +761,17 @@ export type ConfigurationVisibilityCondition = {
   values?: Array<string>;
 };
 
+export type DoraSettingsDeploymentNode = {
+  nodeId?: string;
+  commitTimestampField?: string;
+};
+
+export type DoraSettingsIncidentNode = {
+  nodeId?: string;
+  startedAtField?: string;
+  resolvedAtField?: string;
+};
+
 export type GroupsAddUserToGroupBody = {
   domainType?: AuthorizationDomainType;
   domainId?: string;
@@ -654,10 +873,54 @@ export type IntegrationsIntegrationDefinition = {
   instructions?: string;
 };
 
+export type MeGetNotificationPreferencesResponse = {
+  preferences?: MeNotificationPreferences;
+};
+
+/**
+ * The target is the Slack member ID for Slack, the chat ID for Telegram,
+ * and the URL for webhooks. Emails go to the email of the user.
+ */
+export type MeNotificationChannel = {
+  type?: MeNotificationChannelType;
+  target?: string;
+};
+
+export type MeNotificationChannelType =
+  | "NOTIFICATION_CHANNEL_TYPE_UNSPECIFIED"
+  | "NOTIFICATION_CHANNEL_TYPE_EMAIL"
+  | "NOTIFICATION_CHANNEL_TYPE_SLACK"
+  | "NOTIFICATION_CHANNEL_TYPE_TELEGRAM"
+  | "NOTIFICATION_CHANNEL_TYPE_WEBHOOK";
+
+export type MeNotificationPreferences = {
+  channels?: Array<MeNotificationChannel>;
+  quietHours?: MeQuietHours;
+  timezone?: string;
+  digestIntervalMinutes?: number;
+};
+
+/**
+ * Quiet hours are given as HH:MM, in the timezone of the preferences.
+ * Notifications sent during quiet hours are delivered when they end.
+ */
+export type MeQuietHours = {
+  start?: string;
+  end?: string;
+};
+
 export type MeRegenerateTokenResponse = {
   token?: string;
 };
 
+export type MeUpdateNotificationPreferencesRequest = {
+  preferences?: MeNotificationPreferences;
+};
+
+export type MeUpdateNotificationPreferencesResponse = {
+  preferences?: MeNotificationPreferences;
+};
+
 export type NodeBlueprintRef = {
   id?: string;
 };
@@ -732,6 +995,14 @@ export type OrganizationsDeleteOrganizationResponse = {
   [key: string]: unknown;
 };
 
+export type OrganizationsDeleteScimTokenResponse = {
+  [key: string]: unknown;
+};
+
+export type OrganizationsDeleteSsoConfigResponse = {
+  [key: string]: unknown;
+};
+
 export type OrganizationsDescribeIntegrationResponse = {
   integration?: OrganizationsIntegration;
 };
@@ -748,6 +1019,18 @@ export type OrganizationsGetInviteLinkResponse = {
   inviteLink?: OrganizationsInviteLink;
 };
 
+export type OrganizationsGetScimConfigResponse = {
+  scimConfig?: OrganizationsScimConfig;
+};
+
+export type OrganizationsGetSsoConfigResponse = {
+  ssoConfig?: OrganizationsSsoConfig;
+};
+
+export type OrganizationsGetTwoFactorPolicyResponse = {
+  policy?: OrganizationsTwoFactorPolicy;
+};
+
 export type OrganizationsIntegration = {
   metadata?: OrganizationsIntegrationMetadata;
   spec?: OrganizationsIntegrationSpec;
@@ -809,6 +1092,12 @@ export type OrganizationsListInvitationsResponse = {
   invitations?: Array<OrganizationsInvitation>;
 };
 
+export type OrganizationsListTwoFactorEventsResponse = {
+  events?: Array<OrganizationsTwoFactorEvent>;
+  hasNextPage?: boolean;
+  lastTimestamp?: string;
+};
+
 export type OrganizationsOrganization = {
   metadata?: OrganizationsOrganizationMetadata;
 };
@@ -833,6 +1122,64 @@ export type OrganizationsResetInviteLinkResponse = {
   inviteLink?: OrganizationsInviteLink;
 };
 
+export type OrganizationsResetScimTokenResponse = {
+  token?: string;
+  scimConfig?: OrganizationsScimConfig;
+};
+
+export type OrganizationsResetUserTwoFactorResponse = {
+  [key: string]: unknown;
+};
+
+export type OrganizationsScimConfig = {
+  organizationId?: string;
+  baseUrl?: string;
+  tokenConfigured?: boolean;
+  tokenCreatedAt?: string;
+  tokenLastUsedAt?: string;
+};
+
+export type OrganizationsSsoConfig = {
+  organizationId?: string;
+  protocol?: OrganizationsSsoProtocol;
+  enabled?: boolean;
+  enforceSso?: boolean;
+  saml?: OrganizationsSsosamlSettings;
+  oidc?: OrganizationsSsooidcSettings;
+  emailAttribute?: string;
+  nameAttribute?: string;
+  groupsAttribute?: string;
+  defaultRole?: string;
+  groupMappings?: Array<OrganizationsSsoGroupMapping>;
+  loginUrl?: string;
+  updatedAt?: string;
+  updatedBy?: string;
+};
+
+export type OrganizationsSsoGroupMapping = {
+  idpGroup?: string;
+  group?: string;
+  role?: string;
+};
+
+export type OrganizationsSsooidcSettings = {
+  issuerUrl?: string;
+  clientId?: string;
+  clientSecret?: string;
+  clientSecretConfigured?: boolean;
+  redirectUrl?: string;
+};
+
+export type OrganizationsSsoProtocol = "SSO_PROTOCOL_UNSPECIFIED" | "SSO_PROTOCOL_SAML" | "SSO_PROTOCOL_OIDC";
+
+export type OrganizationsSsosamlSettings = {
+  idpMetadataUrl?: string;
+  idpMetadata?: string;
+  idpEntityId?: string;
+  spEntityId?: string;
+  spAcsUrl?: string;
+};
+
 export type OrganizationsSetAgentOpenAiKeyBody = {
   apiKey?: string;
   validate?: boolean;
@@ -842,6 +1189,40 @@ export type OrganizationsSetAgentOpenAiKeyResponse = {
   agentSettings?: OrganizationsAgentSettings;
 };
 
+export type OrganizationsTwoFactorEvent = {
+  id?: string;
+  type?: OrganizationsTwoFactorEventType;
+  accountEmail?: string;
+  actorEmail?: string;
+  remoteAddr?: string;
+  createdAt?: string;
+};
+
+export type OrganizationsTwoFactorEventType =
+  | "TWO_FACTOR_EVENT_TYPE_UNSPECIFIED"
+  | "TWO_FACTOR_EVENT_TYPE_ENROLLED"
+  | "TWO_FACTOR_EVENT_TYPE_DISABLED"
+  | "TWO_FACTOR_EVENT_TYPE_RECOVERY_CODES_REGENERATED"
+  | "TWO_FACTOR_EVENT_TYPE_LOGIN_SUCCEEDED"
+  | "TWO_FACTOR_EVENT_TYPE_VERIFICATION_FAILED"
+  | "TWO_FACTOR_EVENT_TYPE_RECOVERY_CODE_USED"
+  | "TWO_FACTOR_EVENT_TYPE_RESET"
+  | "TWO_FACTOR_EVENT_TYPE_POLICY_ENABLED"
+  | "TWO_FACTOR_EVENT_TYPE_POLICY_DISABLED";
+
+export type OrganizationsTwoFactorMember = {
+  userId?: string;
+  email?: string;
+  passwordLogin?: boolean;
+  enabled?: boolean;
+};
+
+export type OrganizationsTwoFactorPolicy = {
+  organizationId?: string;
+  required?: boolean;
+  members?: Array<OrganizationsTwoFactorMember>;
+};
+
 export type OrganizationsUpdateAgentSettingsBody = {
   agentModeEnabled?: boolean;
 };
@@ -877,6 +1258,22 @@ export type OrganizationsUpdateOrganizationResponse = {
   organization?: OrganizationsOrganization;
 };
 
+export type OrganizationsUpdateSsoConfigBody = {
+  ssoConfig?: OrganizationsSsoConfig;
+};
+
+export type OrganizationsUpdateSsoConfigResponse = {
+  ssoConfig?: OrganizationsSsoConfig;
+};
+
+export type OrganizationsUpdateTwoFactorPolicyBody = {
+  required?: boolean;
+};
+
+export type OrganizationsUpdateTwoFactorPolicyResponse = {
+  policy?: OrganizationsTwoFactorPolicy;
+};
+
 export type RolesAssignRoleBody = {
   domainType?: AuthorizationDomainType;
   domainId?: string;
@@ -1076,6 +1473,71 @@ export type ServiceAccountsUpdateServiceAccountResponse = {
   serviceAccount?: ServiceAccountsServiceAccount;
 };
 
+export type SubscriptionsCreateSubscriptionRequest = {
+  subscription?: SubscriptionsSubscription;
+};
+
+export type SubscriptionsCreateSubscriptionResponse = {
+  subscription?: SubscriptionsSubscription;
+  /**
+   * The secret used to sign the deliveries.
+   * It is only returned when the subscription is created.
+   */
+  secret?: string;
+};
+
+export type SubscriptionsDeleteSubscriptionResponse = {
+  [key: string]: unknown;
+};
+
+export type SubscriptionsDelivery = {
+  id?: string;
+  eventId?: string;
+  eventType?: string;
+  state?: SubscriptionsDeliveryState;
+  attempts?: number;
+  responseStatus?: number;
+  lastError?: string;
+  nextAttemptAt?: string;
+  deliveredAt?: string;
+  createdAt?: string;
+};
+
+export type SubscriptionsDeliveryState =
+  | "DELIVERY_STATE_UNKNOWN"
+  | "DELIVERY_STATE_PENDING"
+  | "DELIVERY_STATE_DELIVERED"
+  | "DELIVERY_STATE_FAILED";
+
+export type SubscriptionsDescribeSubscriptionResponse = {
+  subscription?: SubscriptionsSubscription;
+};
+
+export type SubscriptionsListSubscriptionDeliveriesResponse = {
+  deliveries?: Array<SubscriptionsDelivery>;
+};
+
+export type SubscriptionsListSubscriptionsResponse = {
+  subscriptions?: Array<SubscriptionsSubscription>;
+};
+
+export type SubscriptionsSubscription = {
+  id?: string;
+  name?: string;
+  url?: string;
+  eventTypes?: Array<string>;
+  canvasIds?: Array<string>;
+  nodeIds?: Array<string>;
+  enabled?: boolean;
+  createdBy?: string;
+  createdAt?: string;
+  updatedAt?: string;
+};
+
+export type SubscriptionsUpdateSubscriptionResponse = {
+  subscription?: SubscriptionsSubscription;
+};
+
 export type SuperplaneBlueprintsOutputChannel = {
   name?: string;
   nodeId?: string;
@@ -1120,6 +1582,42 @@ export type SuperplaneUsersUser = {
   status?: UsersUserStatus;
 };
 
+export type TokensCreateTokenRequest = {
+  name?: string;
+  scopes?: Array<TokensTokenScope>;
+  expiresAt?: string;
+  serviceAccountId?: string;
+};
+
+export type TokensCreateTokenResponse = {
+  token?: TokensToken;
+  value?: string;
+};
+
+export type TokensListTokensResponse = {
+  tokens?: Array<TokensToken>;
+};
+
+export type TokensRevokeTokenResponse = {
+  [key: string]: unknown;
+};
+
+export type TokensToken = {
+  id?: string;
+  name?: string;
+  userId?: string;
+  scopes?: Array<TokensTokenScope>;
+  expiresAt?: string;
+  lastUsedAt?: string;
+  createdAt?: string;
+  createdBy?: string;
+};
+
+export type TokensTokenScope = {
+  permission?: string;
+  canvasIds?: Array<string>;
+};
+
 export type TriggersDescribeTriggerResponse = {
   trigger?: TriggersTrigger;
 };
@@ -1447,98 +1945,222 @@ export type CanvasesSendAiMessageResponses = {
 
 export type CanvasesSendAiMessageResponse2 = CanvasesSendAiMessageResponses[keyof CanvasesSendAiMessageResponses];
 
-export type CanvasesListCanvasEventsData = {
+export type CanvasesGetCanvasDoraMetricsData = {
   body?: never;
   path: {
     canvasId: string;
   };
   query?: {
-    limit?: number;
-    before?: string;
+    from?: string;
+    to?: string;
+    interval?: "DORA_INTERVAL_UNSPECIFIED" | "DORA_INTERVAL_DAY" | "DORA_INTERVAL_WEEK";
   };
-  url: "/api/v1/canvases/{canvasId}/events";
+  url: "/api/v1/canvases/{canvasId}/dora/metrics";
 };
 
-export type CanvasesListCanvasEventsErrors = {
+export type CanvasesGetCanvasDoraMetricsErrors = {
   /**
    * An unexpected error response.
    */
   default: GooglerpcStatus;
 };
 
-export type CanvasesListCanvasEventsError = CanvasesListCanvasEventsErrors[keyof CanvasesListCanvasEventsErrors];
+export type CanvasesGetCanvasDoraMetricsError =
+  CanvasesGetCanvasDoraMetricsErrors[keyof CanvasesGetCanvasDoraMetricsErrors];
 
-export type CanvasesListCanvasEventsResponses = {
+export type CanvasesGetCanvasDoraMetricsResponses = {
   /**
    * A successful response.
    */
-  200: CanvasesListCanvasEventsResponse;
+  200: CanvasesGetCanvasDoraMetricsResponse;
 };
 
-export type CanvasesListCanvasEventsResponse2 =
-  CanvasesListCanvasEventsResponses[keyof CanvasesListCanvasEventsResponses];
+export type CanvasesGetCanvasDoraMetricsResponse2 =
+  CanvasesGetCanvasDoraMetricsResponses[keyof CanvasesGetCanvasDoraMetricsResponses];
 
-export type CanvasesListEventExecutionsData = {
+export type CanvasesExportCanvasDoraMetricsData = {
   body?: never;
   path: {
     canvasId: string;
-    eventId: string;
   };
-  query?: never;
-  url: "/api/v1/canvases/{canvasId}/events/{eventId}/executions";
+  query?: {
+    from?: string;
+    to?: string;
+    interval?: "DORA_INTERVAL_UNSPECIFIED" | "DORA_INTERVAL_DAY" | "DORA_INTERVAL_WEEK";
+  };
+  url: "/api/v1/canvases/{canvasId}/dora/metrics/export";
 };
 
-export type CanvasesListEventExecutionsErrors = {
+export type CanvasesExportCanvasDoraMetricsErrors = {
   /**
    * An unexpected error response.
    */
   default: GooglerpcStatus;
 };
 
-export type CanvasesListEventExecutionsError =
-  CanvasesListEventExecutionsErrors[keyof CanvasesListEventExecutionsErrors];
+export type CanvasesExportCanvasDoraMetricsError =
+  CanvasesExportCanvasDoraMetricsErrors[keyof CanvasesExportCanvasDoraMetricsErrors];
 
-export type CanvasesListEventExecutionsResponses = {
+export type CanvasesExportCanvasDoraMetricsResponses = {
   /**
    * A successful response.
    */
-  200: CanvasesListEventExecutionsResponse;
+  200: CanvasesExportCanvasDoraMetricsResponse;
 };
 
-export type CanvasesListEventExecutionsResponse2 =
-  CanvasesListEventExecutionsResponses[keyof CanvasesListEventExecutionsResponses];
+export type CanvasesExportCanvasDoraMetricsResponse2 =
+  CanvasesExportCanvasDoraMetricsResponses[keyof CanvasesExportCanvasDoraMetricsResponses];
 
-export type CanvasesResolveExecutionErrorsData = {
-  body: CanvasesResolveExecutionErrorsBody;
+export type CanvasesGetCanvasDoraSettingsData = {
+  body?: never;
   path: {
     canvasId: string;
   };
   query?: never;
-  url: "/api/v1/canvases/{canvasId}/executions/resolve";
+  url: "/api/v1/canvases/{canvasId}/dora/settings";
 };
 
-export type CanvasesResolveExecutionErrorsErrors = {
+export type CanvasesGetCanvasDoraSettingsErrors = {
   /**
    * An unexpected error response.
    */
   default: GooglerpcStatus;
 };
 
-export type CanvasesResolveExecutionErrorsError =
-  CanvasesResolveExecutionErrorsErrors[keyof CanvasesResolveExecutionErrorsErrors];
+export type CanvasesGetCanvasDoraSettingsError =
+  CanvasesGetCanvasDoraSettingsErrors[keyof CanvasesGetCanvasDoraSettingsErrors];
 
-export type CanvasesResolveExecutionErrorsResponses = {
+export type CanvasesGetCanvasDoraSettingsResponses = {
   /**
    * A successful response.
    */
-  200: CanvasesResolveExecutionErrorsResponse;
+  200: CanvasesGetCanvasDoraSettingsResponse;
 };
 
-export type CanvasesResolveExecutionErrorsResponse2 =
-  CanvasesResolveExecutionErrorsResponses[keyof CanvasesResolveExecutionErrorsResponses];
+export type CanvasesGetCanvasDoraSettingsResponse2 =
+  CanvasesGetCanvasDoraSettingsResponses[keyof CanvasesGetCanvasDoraSettingsResponses];
 
-export type CanvasesInvokeNodeExecutionActionData = {
-  body: CanvasesInvokeNodeExecutionActionBody;
+export type CanvasesUpdateCanvasDoraSettingsData = {
+  body: CanvasesUpdateCanvasDoraSettingsBody;
+  path: {
+    canvasId: string;
+  };
+  query?: never;
+  url: "/api/v1/canvases/{canvasId}/dora/settings";
+};
+
+export type CanvasesUpdateCanvasDoraSettingsErrors = {
+  /**
+   * An unexpected error response.
+   */
+  default: GooglerpcStatus;
+};
+
+export type CanvasesUpdateCanvasDoraSettingsError =
+  CanvasesUpdateCanvasDoraSettingsErrors[keyof CanvasesUpdateCanvasDoraSettingsErrors];
+
+export type CanvasesUpdateCanvasDoraSettingsResponses = {
+  /**
+   * A successful response.
+   */
+  200: CanvasesUpdateCanvasDoraSettingsResponse;
+};
+
+export type CanvasesUpdateCanvasDoraSettingsResponse2 =
+  CanvasesUpdateCanvasDoraSettingsResponses[keyof CanvasesUpdateCanvasDoraSettingsResponses];
+
+export type CanvasesListCanvasEventsData = {
+  body?: never;
+  path: {
+    canvasId: string;
+  };
+  query?: {
+    limit?: number;
+    before?: string;
+  };
+  url: "/api/v1/canvases/{canvasId}/events";
+};
+
+export type CanvasesListCanvasEventsErrors = {
+  /**
+   * An unexpected error response.
+   */
+  default: GooglerpcStatus;
+};
+
+export type CanvasesListCanvasEventsError = CanvasesListCanvasEventsErrors[keyof CanvasesListCanvasEventsErrors];
+
+export type CanvasesListCanvasEventsResponses = {
+  /**
+   * A successful response.
+   */
+  200: CanvasesListCanvasEventsResponse;
+};
+
+export type CanvasesListCanvasEventsResponse2 =
+  CanvasesListCanvasEventsResponses[keyof CanvasesListCanvasEventsResponses];
+
+export type CanvasesListEventExecutionsData = {
+  body?: never;
+  path: {
+    canvasId: string;
+    eventId: string;
+  };
+  query?: never;
+  url: "/api/v1/canvases/{canvasId}/events/{eventId}/executions";
+};
+
+export type CanvasesListEventExecutionsErrors = {
+  /**
+   * An unexpected error response.
+   */
+  default: GooglerpcStatus;
+};
+
+export type CanvasesListEventExecutionsError =
+  CanvasesListEventExecutionsErrors[keyof CanvasesListEventExecutionsErrors];
+
+export type CanvasesListEventExecutionsResponses = {
+  /**
+   * A successful response.
+   */
+  200: CanvasesListEventExecutionsResponse;
+};
+
+export type CanvasesListEventExecutionsResponse2 =
+  CanvasesListEventExecutionsResponses[keyof CanvasesListEventExecutionsResponses];
+
+export type CanvasesResolveExecutionErrorsData = {
+  body: CanvasesResolveExecutionErrorsBody;
+  path: {
+    canvasId: string;
+  };
+  query?: never;
+  url: "/api/v1/canvases/{canvasId}/executions/resolve";
+};
+
+export type CanvasesResolveExecutionErrorsErrors = {
+  /**
+   * An unexpected error response.
+   */
+  default: GooglerpcStatus;
+};
+
+export type CanvasesResolveExecutionErrorsError =
+  CanvasesResolveExecutionErrorsErrors[keyof CanvasesResolveExecutionErrorsErrors];
+
+export type CanvasesResolveExecutionErrorsResponses = {
+  /**
+   * A successful response.
+   */
+  200: CanvasesResolveExecutionErrorsResponse;
+};
+
+export type CanvasesResolveExecutionErrorsResponse2 =
+  CanvasesResolveExecutionErrorsResponses[keyof CanvasesResolveExecutionErrorsResponses];
+
+export type CanvasesInvokeNodeExecutionActionData = {
+  body: CanvasesInvokeNodeExecutionActionBody;
   path: {
     canvasId: string;
     executionId: string;
@@ -1568,6 +2190,71 @@ export type CanvasesInvokeNodeExecutionActionResponses = {
 export type CanvasesInvokeNodeExecutionActionResponse2 =
   CanvasesInvokeNodeExecutionActionResponses[keyof CanvasesInvokeNodeExecutionActionResponses];
 
+export type CanvasesListExecutionArtifactsData = {
+  body?: never;
+  path: {
+    canvasId: string;
+    executionId: string;
+  };
+  query?: never;
+  url: "/api/v1/canvases/{canvasId}/executions/{executionId}/artifacts";
+};
+
+export type CanvasesListExecutionArtifactsErrors = {
+  /**
+   * An unexpected error response.
+   */
+  default: GooglerpcStatus;
+};
+
+export type CanvasesListExecutionArtifactsError =
+  CanvasesListExecutionArtifactsErrors[keyof CanvasesListExecutionArtifactsErrors];
+
+export type CanvasesListExecutionArtifactsResponses = {
+  /**
+   * A successful response.
+   */
+  200: CanvasesListExecutionArtifactsResponse;
+};
+
+export type CanvasesListExecutionArtifactsResponse2 =
+  CanvasesListExecutionArtifactsResponses[keyof CanvasesListExecutionArtifactsResponses];
+
+export type CanvasesDownloadExecutionArtifactData = {
+  body?: never;
+  path: {
+    canvasId: string;
+    executionId: string;
+  };
+  query?: {
+    name?: string;
+  };
+  url: "/api/v1/canvases/{canvasId}/executions/{executionId}/artifacts/download";
+};
+
+export type CanvasesDownloadExecutionArtifactErrors = {
+  /**
+   * An unexpected error response.
+   */
+  default: GooglerpcStatus;
+};
+
+export type CanvasesDownloadExecutionArtifactError =
+  CanvasesDownloadExecutionArtifactErrors[keyof CanvasesDownloadExecutionArtifactErrors];
+
+export type CanvasesDownloadExecutionArtifactResponses = {
+  /**
+   * A successful response.(streaming responses)
+   */
+  200: {
+    result?: CanvasesDownloadExecutionArtifactResponse;
+    error?: GooglerpcStatus;
+  };
+};
+
+export type CanvasesDownloadExecutionArtifactResponse2 =
+  CanvasesDownloadExecutionArtifactResponses[keyof CanvasesDownloadExecutionArtifactResponses];
+
 export type CanvasesCancelExecutionData = {
   body: CanvasesCancelExecutionBody;
   path: {
@@ -1626,6 +2313,71 @@ export type CanvasesListChildExecutionsResponses = {
 export type CanvasesListChildExecutionsResponse2 =
   CanvasesListChildExecutionsResponses[keyof CanvasesListChildExecutionsResponses];
 
+export type CanvasesGetExecutionLogsData = {
+  body?: never;
+  path: {
+    canvasId: string;
+    executionId: string;
+  };
+  query?: {
+    after?: string;
+    limit?: number;
+  };
+  url: "/api/v1/canvases/{canvasId}/executions/{executionId}/logs";
+};
+
+export type CanvasesGetExecutionLogsErrors = {
+  /**
+   * An unexpected error response.
+   */
+  default: GooglerpcStatus;
+};
+
+export type CanvasesGetExecutionLogsError = CanvasesGetExecutionLogsErrors[keyof CanvasesGetExecutionLogsErrors];
+
+export type CanvasesGetExecutionLogsResponses = {
+  /**
+   * A successful response.
+   */
+  200: CanvasesGetExecutionLogsResponse;
+};
+
+export type CanvasesGetExecutionLogsResponse2 =
+  CanvasesGetExecutionLogsResponses[keyof CanvasesGetExecutionLogsResponses];
+
+export type CanvasesWatchExecutionData = {
+  body?: never;
+  path: {
+    canvasId: string;
+    executionId: string;
+  };
+  query?: {
+    resumeToken?: string;
+  };
+  url: "/api/v1/canvases/{canvasId}/executions/{executionId}/watch";
+};
+
+export type CanvasesWatchExecutionErrors = {
+  /**
+   * An unexpected error response.
+   */
+  default: GooglerpcStatus;
+};
+
+export type CanvasesWatchExecutionError = CanvasesWatchExecutionErrors[keyof CanvasesWatchExecutionErrors];
+
+export type CanvasesWatchExecutionResponses = {
+  /**
+   * A successful response.(streaming responses)
+   */
+  200: {
+    result?: CanvasesWatchExecutionResponse;
+    error?: GooglerpcStatus;
+  };
+};
+
+export type CanvasesWatchExecutionResponse2 = CanvasesWatchExecutionResponses[keyof CanvasesWatchExecutionResponses];
+
 export type CanvasesListCanvasMemoriesData = {
   body?: never;
   path: {
@@ -1867,6 +2619,36 @@ export type CanvasesDeleteNodeQueueItemResponses = {
 export type CanvasesDeleteNodeQueueItemResponse2 =
   CanvasesDeleteNodeQueueItemResponses[keyof CanvasesDeleteNodeQueueItemResponses];
 
+export type CanvasesGetCanvasStatsData = {
+  body?: never;
+  path: {
+    canvasId: string;
+  };
+  query?: {
+    from?: string;
+    to?: string;
+  };
+  url: "/api/v1/canvases/{canvasId}/stats";
+};
+
+export type CanvasesGetCanvasStatsErrors = {
+  /**
+   * An unexpected error response.
+   */
+  default: GooglerpcStatus;
+};
+
+export type CanvasesGetCanvasStatsError = CanvasesGetCanvasStatsErrors[keyof CanvasesGetCanvasStatsErrors];
+
+export type CanvasesGetCanvasStatsResponses = {
+  /**
+   * A successful response.
+   */
+  200: CanvasesGetCanvasStatsResponse;
+};
+
+export type CanvasesGetCanvasStatsResponse2 = CanvasesGetCanvasStatsResponses[keyof CanvasesGetCanvasStatsResponses];
+
 export type CanvasesInvokeNodeTriggerActionData = {
   body: CanvasesInvokeNodeTriggerActionBody;
   path: {
@@ -1898,6 +2680,38 @@ export type CanvasesInvokeNodeTriggerActionResponses = {
 export type CanvasesInvokeNodeTriggerActionResponse2 =
   CanvasesInvokeNodeTriggerActionResponses[keyof CanvasesInvokeNodeTriggerActionResponses];
 
+export type CanvasesWatchCanvasData = {
+  body?: never;
+  path: {
+    canvasId: string;
+  };
+  query?: {
+    resumeToken?: string;
+  };
+  url: "/api/v1/canvases/{canvasId}/watch";
+};
+
+export type CanvasesWatchCanvasErrors = {
+  /**
+   * An unexpected error response.
+   */
+  default: GooglerpcStatus;
+};
+
+export type CanvasesWatchCanvasError = CanvasesWatchCanvasErrors[keyof CanvasesWatchCanvasErrors];
+
+export type CanvasesWatchCanvasResponses = {
+  /**
+   * A successful response.(streaming responses)
+   */
+  200: {
+    result?: CanvasesWatchCanvasResponse;
+    error?: GooglerpcStatus;
+  };
+};
+
+export type CanvasesWatchCanvasResponse2 = CanvasesWatchCanvasResponses[keyof CanvasesWatchCanvasResponses];
+
 export type CanvasesDeleteCanvasData = {
   body?: never;
   path: {
@@ -2377,36 +3191,90 @@ export type MeMeResponses = {
 
 export type MeMeResponse = MeMeResponses[keyof MeMeResponses];
 
-export type MeRegenerateTokenData = {
+export type MeGetNotificationPreferencesData = {
   body?: never;
   path?: never;
   query?: never;
-  url: "/api/v1/me/token";
+  url: "/api/v1/me/notification-preferences";
 };
 
-export type MeRegenerateTokenErrors = {
+export type MeGetNotificationPreferencesErrors = {
   /**
    * An unexpected error response.
    */
   default: GooglerpcStatus;
 };
 
-export type MeRegenerateTokenError = MeRegenerateTokenErrors[keyof MeRegenerateTokenErrors];
+export type MeGetNotificationPreferencesError =
+  MeGetNotificationPreferencesErrors[keyof MeGetNotificationPreferencesErrors];
 
-export type MeRegenerateTokenResponses = {
+export type MeGetNotificationPreferencesResponses = {
   /**
    * A successful response.
    */
-  200: MeRegenerateTokenResponse;
+  200: MeGetNotificationPreferencesResponse;
 };
 
-export type MeRegenerateTokenResponse2 = MeRegenerateTokenResponses[keyof MeRegenerateTokenResponses];
+export type MeGetNotificationPreferencesResponse2 =
+  MeGetNotificationPreferencesResponses[keyof MeGetNotificationPreferencesResponses];
 
-export type OrganizationsDeleteOrganizationData = {
-  body?: never;
-  path: {
-    id: string;
-  };
+export type MeUpdateNotificationPreferencesData = {
+  body: MeUpdateNotificationPreferencesRequest;
+  path?: never;
+  query?: never;
+  url: "/api/v1/me/notification-preferences";
+};
+
+export type MeUpdateNotificationPreferencesErrors = {
+  /**
+   * An unexpected error response.
+   */
+  default: GooglerpcStatus;
+};
+
+export type MeUpdateNotificationPreferencesError =
+  MeUpdateNotificationPreferencesErrors[keyof MeUpdateNotificationPreferencesErrors];
+
+export type MeUpdateNotificationPreferencesResponses = {
+  /**
+   * A successful response.
+   */
+  200: MeUpdateNotificationPreferencesResponse;
+};
+
+export type MeUpdateNotificationPreferencesResponse2 =
+  MeUpdateNotificationPreferencesResponses[keyof MeUpdateNotificationPreferencesResponses];
+
+export type MeRegenerateTokenData = {
+  body?: never;
+  path?: never;
+  query?: never;
+  url: "/api/v1/me/token";
+};
+
+export type MeRegenerateTokenErrors = {
+  /**
+   * An unexpected error response.
+   */
+  default: GooglerpcStatus;
+};
+
+export type MeRegenerateTokenError = MeRegenerateTokenErrors[keyof MeRegenerateTokenErrors];
+
+export type MeRegenerateTokenResponses = {
+  /**
+   * A successful response.
+   */
+  200: MeRegenerateTokenResponse;
+};
+
+export type MeRegenerateTokenResponse2 = MeRegenerateTokenResponses[keyof MeRegenerateTokenResponses];
+
+export type OrganizationsDeleteOrganizationData = {
+  body?: never;
+  path: {
+    id: string;
+  };
   query?: never;
   url: "/api/v1/organizations/{id}";
 };
@@ -2959,6 +3827,268 @@ export type OrganizationsResetInviteLinkResponses = {
 export type OrganizationsResetInviteLinkResponse2 =
   OrganizationsResetInviteLinkResponses[keyof OrganizationsResetInviteLinkResponses];
 
+export type OrganizationsGetScimConfigData = {
+  body?: never;
+  path: {
+    id: string;
+  };
+  query?: never;
+  url: "/api/v1/organizations/{id}/scim";
+};
+
+export type OrganizationsGetScimConfigErrors = {
+  /**
+   * An unexpected error response.
+   */
+  default: GooglerpcStatus;
+};
+
+export type OrganizationsGetScimConfigError = OrganizationsGetScimConfigErrors[keyof OrganizationsGetScimConfigErrors];
+
+export type OrganizationsGetScimConfigResponses = {
+  /**
+   * A successful response.
+   */
+  200: OrganizationsGetScimConfigResponse;
+};
+
+export type OrganizationsGetScimConfigResponse2 =
+  OrganizationsGetScimConfigResponses[keyof OrganizationsGetScimConfigResponses];
+
+export type OrganizationsDeleteScimTokenData = {
+  body?: never;
+  path: {
+    id: string;
+  };
+  query?: never;
+  url: "/api/v1/organizations/{id}/scim/token";
+};
+
+export type OrganizationsDeleteScimTokenErrors = {
+  /**
+   * An unexpected error response.
+   */
+  default: GooglerpcStatus;
+};
+
+export type OrganizationsDeleteScimTokenError =
+  OrganizationsDeleteScimTokenErrors[keyof OrganizationsDeleteScimTokenErrors];
+
+export type OrganizationsDeleteScimTokenResponses = {
+  /**
+   * A successful response.
+   */
+  200: OrganizationsDeleteScimTokenResponse;
+};
+
+export type OrganizationsDeleteScimTokenResponse2 =
+  OrganizationsDeleteScimTokenResponses[keyof OrganizationsDeleteScimTokenResponses];
+
+export type OrganizationsResetScimTokenData = {
+  body?: never;
+  path: {
+    id: string;
+  };
+  query?: never;
+  url: "/api/v1/organizations/{id}/scim/token";
+};
+
+export type OrganizationsResetScimTokenErrors = {
+  /**
+   * An unexpected error response.
+   */
+  default: GooglerpcStatus;
+};
+
+export type OrganizationsResetScimTokenError =
+  OrganizationsResetScimTokenErrors[keyof OrganizationsResetScimTokenErrors];
+
+export type OrganizationsResetScimTokenResponses = {
+  /**
+   * A successful response.
+   */
+  200: OrganizationsResetScimTokenResponse;
+};
+
+export type OrganizationsResetScimTokenResponse2 =
+  OrganizationsResetScimTokenResponses[keyof OrganizationsResetScimTokenResponses];
+
+export type OrganizationsDeleteSsoConfigData = {
+  body?: never;
+  path: {
+    id: string;
+  };
+  query?: never;
+  url: "/api/v1/organizations/{id}/sso";
+};
+
+export type OrganizationsDeleteSsoConfigErrors = {
+  /**
+   * An unexpected error response.
+   */
+  default: GooglerpcStatus;
+};
+
+export type OrganizationsDeleteSsoConfigError =
+  OrganizationsDeleteSsoConfigErrors[keyof OrganizationsDeleteSsoConfigErrors];
+
+export type OrganizationsDeleteSsoConfigResponses = {
+  /**
+   * A successful response.
+   */
+  200: OrganizationsDeleteSsoConfigResponse;
+};
+
+export type OrganizationsDeleteSsoConfigResponse2 =
+  OrganizationsDeleteSsoConfigResponses[keyof OrganizationsDeleteSsoConfigResponses];
+
+export type OrganizationsGetSsoConfigData = {
+  body?: never;
+  path: {
+    id: string;
+  };
+  query?: never;
+  url: "/api/v1/organizations/{id}/sso";
+};
+
+export type OrganizationsGetSsoConfigErrors = {
+  /**
+   * An unexpected error response.
+   */
+  default: GooglerpcStatus;
+};
+
+export type OrganizationsGetSsoConfigError = OrganizationsGetSsoConfigErrors[keyof OrganizationsGetSsoConfigErrors];
+
+export type OrganizationsGetSsoConfigResponses = {
+  /**
+   * A successful response.
+   */
+  200: OrganizationsGetSsoConfigResponse;
+};
+
+export type OrganizationsGetSsoConfigResponse2 =
+  OrganizationsGetSsoConfigResponses[keyof OrganizationsGetSsoConfigResponses];
+
+export type OrganizationsUpdateSsoConfigData = {
+  body: OrganizationsUpdateSsoConfigBody;
+  path: {
+    id: string;
+  };
+  query?: never;
+  url: "/api/v1/organizations/{id}/sso";
+};
+
+export type OrganizationsUpdateSsoConfigErrors = {
+  /**
+   * An unexpected error response.
+   */
+  default: GooglerpcStatus;
+};
+
+export type OrganizationsUpdateSsoConfigError =
+  OrganizationsUpdateSsoConfigErrors[keyof OrganizationsUpdateSsoConfigErrors];
+
+export type OrganizationsUpdateSsoConfigResponses = {
+  /**
+   * A successful response.
+   */
+  200: OrganizationsUpdateSsoConfigResponse;
+};
+
+export type OrganizationsUpdateSsoConfigResponse2 =
+  OrganizationsUpdateSsoConfigResponses[keyof OrganizationsUpdateSsoConfigResponses];
+
+export type OrganizationsGetTwoFactorPolicyData = {
+  body?: never;
+  path: {
+    id: string;
+  };
+  query?: never;
+  url: "/api/v1/organizations/{id}/two-factor";
+};
+
+export type OrganizationsGetTwoFactorPolicyErrors = {
+  /**
+   * An unexpected error response.
+   */
+  default: GooglerpcStatus;
+};
+
+export type OrganizationsGetTwoFactorPolicyError =
+  OrganizationsGetTwoFactorPolicyErrors[keyof OrganizationsGetTwoFactorPolicyErrors];
+
+export type OrganizationsGetTwoFactorPolicyResponses = {
+  /**
+   * A successful response.
+   */
+  200: OrganizationsGetTwoFactorPolicyResponse;
+};
+
+export type OrganizationsGetTwoFactorPolicyResponse2 =
+  OrganizationsGetTwoFactorPolicyResponses[keyof OrganizationsGetTwoFactorPolicyResponses];
+
+export type OrganizationsUpdateTwoFactorPolicyData = {
+  body: OrganizationsUpdateTwoFactorPolicyBody;
+  path: {
+    id: string;
+  };
+  query?: never;
+  url: "/api/v1/organizations/{id}/two-factor";
+};
+
+export type OrganizationsUpdateTwoFactorPolicyErrors = {
+  /**
+   * An unexpected error response.
+   */
+  default: GooglerpcStatus;
+};
+
+export type OrganizationsUpdateTwoFactorPolicyError =
+  OrganizationsUpdateTwoFactorPolicyErrors[keyof OrganizationsUpdateTwoFactorPolicyErrors];
+
+export type OrganizationsUpdateTwoFactorPolicyResponses = {
+  /**
+   * A successful response.
+   */
+  200: OrganizationsUpdateTwoFactorPolicyResponse;
+};
+
+export type OrganizationsUpdateTwoFactorPolicyResponse2 =
+  OrganizationsUpdateTwoFactorPolicyResponses[keyof OrganizationsUpdateTwoFactorPolicyResponses];
+
+export type OrganizationsListTwoFactorEventsData = {
+  body?: never;
+  path: {
+    id: string;
+  };
+  query?: {
+    limit?: number;
+    before?: string;
+  };
+  url: "/api/v1/organizations/{id}/two-factor/events";
+};
+
+export type OrganizationsListTwoFactorEventsErrors = {
+  /**
+   * An unexpected error response.
+   */
+  default: GooglerpcStatus;
+};
+
+export type OrganizationsListTwoFactorEventsError =
+  OrganizationsListTwoFactorEventsErrors[keyof OrganizationsListTwoFactorEventsErrors];
+
+export type OrganizationsListTwoFactorEventsResponses = {
+  /**
+   * A successful response.
+   */
+  200: OrganizationsListTwoFactorEventsResponse;
+};
+
+export type OrganizationsListTwoFactorEventsResponse2 =
+  OrganizationsListTwoFactorEventsResponses[keyof OrganizationsListTwoFactorEventsResponses];
+
 export type OrganizationsRemoveUserData = {
   body?: never;
   path: {
@@ -2987,6 +4117,36 @@ export type OrganizationsRemoveUserResponses = {
 
 export type OrganizationsRemoveUserResponse2 = OrganizationsRemoveUserResponses[keyof OrganizationsRemoveUserResponses];
 
+export type OrganizationsResetUserTwoFactorData = {
+  body?: never;
+  path: {
+    id: string;
+    userId: string;
+  };
+  query?: never;
+  url: "/api/v1/organizations/{id}/users/{userId}/two-factor/reset";
+};
+
+export type OrganizationsResetUserTwoFactorErrors = {
+  /**
+   * An unexpected error response.
+   */
+  default: GooglerpcStatus;
+};
+
+export type OrganizationsResetUserTwoFactorError =
+  OrganizationsResetUserTwoFactorErrors[keyof OrganizationsResetUserTwoFactorErrors];
+
+export type OrganizationsResetUserTwoFactorResponses = {
+  /**
+   * A successful response.
+   */
+  200: OrganizationsResetUserTwoFactorResponse;
+};
+
+export type OrganizationsResetUserTwoFactorResponse2 =
+  OrganizationsResetUserTwoFactorResponses[keyof OrganizationsResetUserTwoFactorResponses];
+
 export type RolesListRolesData = {
   body?: never;
   path?: never;
@@ -3550,6 +4710,257 @@ export type ServiceAccountsRegenerateServiceAccountTokenResponses = {
 export type ServiceAccountsRegenerateServiceAccountTokenResponse2 =
   ServiceAccountsRegenerateServiceAccountTokenResponses[keyof ServiceAccountsRegenerateServiceAccountTokenResponses];
 
+export type SubscriptionsListSubscriptionsData = {
+  body?: never;
+  path?: never;
+  query?: never;
+  url: "/api/v1/subscriptions";
+};
+
+export type SubscriptionsListSubscriptionsErrors = {
+  /**
+   * An unexpected error response.
+   */
+  default: GooglerpcStatus;
+};
+
+export type SubscriptionsListSubscriptionsError =
+  SubscriptionsListSubscriptionsErrors[keyof SubscriptionsListSubscriptionsErrors];
+
+export type SubscriptionsListSubscriptionsResponses = {
+  /**
+   * A successful response.
+   */
+  200: SubscriptionsListSubscriptionsResponse;
+};
+
+export type SubscriptionsListSubscriptionsResponse2 =
+  SubscriptionsListSubscriptionsResponses[keyof SubscriptionsListSubscriptionsResponses];
+
+export type SubscriptionsCreateSubscriptionData = {
+  body: SubscriptionsCreateSubscriptionRequest;
+  path?: never;
+  query?: never;
+  url: "/api/v1/subscriptions";
+};
+
+export type SubscriptionsCreateSubscriptionErrors = {
+  /**
+   * An unexpected error response.
+   */
+  default: GooglerpcStatus;
+};
+
+export type SubscriptionsCreateSubscriptionError =
+  SubscriptionsCreateSubscriptionErrors[keyof SubscriptionsCreateSubscriptionErrors];
+
+export type SubscriptionsCreateSubscriptionResponses = {
+  /**
+   * A successful response.
+   */
+  200: SubscriptionsCreateSubscriptionResponse;
+};
+
+export type SubscriptionsCreateSubscriptionResponse2 =
+  SubscriptionsCreateSubscriptionResponses[keyof SubscriptionsCreateSubscriptionResponses];
+
+export type SubscriptionsDeleteSubscriptionData = {
+  body?: never;
+  path: {
+    id: string;
+  };
+  query?: never;
+  url: "/api/v1/subscriptions/{id}";
+};
+
+export type SubscriptionsDeleteSubscriptionErrors = {
+  /**
+   * An unexpected error response.
+   */
+  default: GooglerpcStatus;
+};
+
+export type SubscriptionsDeleteSubscriptionError =
+  SubscriptionsDeleteSubscriptionErrors[keyof SubscriptionsDeleteSubscriptionErrors];
+
+export type SubscriptionsDeleteSubscriptionResponses = {
+  /**
+   * A successful response.
+   */
+  200: SubscriptionsDeleteSubscriptionResponse;
+};
+
+export type SubscriptionsDeleteSubscriptionResponse2 =
+  SubscriptionsDeleteSubscriptionResponses[keyof SubscriptionsDeleteSubscriptionResponses];
+
+export type SubscriptionsDescribeSubscriptionData = {
+  body?: never;
+  path: {
+    id: string;
+  };
+  query?: never;
+  url: "/api/v1/subscriptions/{id}";
+};
+
+export type SubscriptionsDescribeSubscriptionErrors = {
+  /**
+   * An unexpected error response.
+   */
+  default: GooglerpcStatus;
+};
+
+export type SubscriptionsDescribeSubscriptionError =
+  SubscriptionsDescribeSubscriptionErrors[keyof SubscriptionsDescribeSubscriptionErrors];
+
+export type SubscriptionsDescribeSubscriptionResponses = {
+  /**
+   * A successful response.
+   */
+  200: SubscriptionsDescribeSubscriptionResponse;
+};
+
+export type SubscriptionsDescribeSubscriptionResponse2 =
+  SubscriptionsDescribeSubscriptionResponses[keyof SubscriptionsDescribeSubscriptionResponses];
+
+export type SubscriptionsUpdateSubscriptionData = {
+  body: SubscriptionsSubscription;
+  path: {
+    id: string;
+  };
+  query?: never;
+  url: "/api/v1/subscriptions/{id}";
+};
+
+export type SubscriptionsUpdateSubscriptionErrors = {
+  /**
+   * An unexpected error response.
+   */
+  default: GooglerpcStatus;
+};
+
+export type SubscriptionsUpdateSubscriptionError =
+  SubscriptionsUpdateSubscriptionErrors[keyof SubscriptionsUpdateSubscriptionErrors];
+
+export type SubscriptionsUpdateSubscriptionResponses = {
+  /**
+   * A successful response.
+   */
+  200: SubscriptionsUpdateSubscriptionResponse;
+};
+
+export type SubscriptionsUpdateSubscriptionResponse2 =
+  SubscriptionsUpdateSubscriptionResponses[keyof SubscriptionsUpdateSubscriptionResponses];
+
+export type SubscriptionsListSubscriptionDeliveriesData = {
+  body?: never;
+  path: {
+    id: string;
+  };
+  query?: {
+    limit?: number;
+  };
+  url: "/api/v1/subscriptions/{id}/deliveries";
+};
+
+export type SubscriptionsListSubscriptionDeliveriesErrors = {
+  /**
+   * An unexpected error response.
+   */
+  default: GooglerpcStatus;
+};
+
+export type SubscriptionsListSubscriptionDeliveriesError =
+  SubscriptionsListSubscriptionDeliveriesErrors[keyof SubscriptionsListSubscriptionDeliveriesErrors];
+
+export type SubscriptionsListSubscriptionDeliveriesResponses = {
+  /**
+   * A successful response.
+   */
+  200: SubscriptionsListSubscriptionDeliveriesResponse;
+};
+
+export type SubscriptionsListSubscriptionDeliveriesResponse2 =
+  SubscriptionsListSubscriptionDeliveriesResponses[keyof SubscriptionsListSubscriptionDeliveriesResponses];
+
+export type TokensListTokensData = {
+  body?: never;
+  path?: never;
+  query?: {
+    serviceAccountId?: string;
+  };
+  url: "/api/v1/tokens";
+};
+
+export type TokensListTokensErrors = {
+  /**
+   * An unexpected error response.
+   */
+  default: GooglerpcStatus;
+};
+
+export type TokensListTokensError = TokensListTokensErrors[keyof TokensListTokensErrors];
+
+export type TokensListTokensResponses = {
+  /**
+   * A successful response.
+   */
+  200: TokensListTokensResponse;
+};
+
+export type TokensListTokensResponse2 = TokensListTokensResponses[keyof TokensListTokensResponses];
+
+export type TokensCreateTokenData = {
+  body: TokensCreateTokenRequest;
+  path?: never;
+  query?: never;
+  url: "/api/v1/tokens";
+};
+
+export type TokensCreateTokenErrors = {
+  /**
+   * An unexpected error response.
+   */
+  default: GooglerpcStatus;
+};
+
+export type TokensCreateTokenError = TokensCreateTokenErrors[keyof TokensCreateTokenErrors];
+
+export type TokensCreateTokenResponses = {
+  /**
+   * A successful response.
+   */
+  200: TokensCreateTokenResponse;
+};
+
+export type TokensCreateTokenResponse2 = TokensCreateTokenResponses[keyof TokensCreateTokenResponses];
+
+export type TokensRevokeTokenData = {
+  body?: never;
+  path: {
+    id: string;
+  };
+  query?: never;
+  url: "/api/v1/tokens/{id}";
+};
+
+export type TokensRevokeTokenErrors = {
+  /**
+   * An unexpected error response.
+   */
+  default: GooglerpcStatus;
+};
+
+export type TokensRevokeTokenError = TokensRevokeTokenErrors[keyof TokensRevokeTokenErrors];
+
+export type TokensRevokeTokenResponses = {
+  /**
+   * A successful response.
+   */
+  200: TokensRevokeTokenResponse;
+};
+
+export type TokensRevokeTokenResponse2 = TokensRevokeTokenResponses[keyof TokensRevokeTokenResponses];
+
 export type TriggersListTriggersData = {
   body?: never;
   path?: never;
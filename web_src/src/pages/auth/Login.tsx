@@ -61,6 +61,8 @@ export const Login: React.FC = () => {
   const [isSignupMode, setIsSignupMode] = useState(false);
   const [loginEmail, setLoginEmail] = useState("");
   const [loginPassword, setLoginPassword] = useState("");
+  const [twoFactorRequired, setTwoFactorRequired] = useState(false);
+  const [twoFactorCode, setTwoFactorCode] = useState("");
   const [signupFirstName, setSignupFirstName] = useState("");
   const [signupLastName, setSignupLastName] = useState("");
   const [signupEmail, setSignupEmail] = useState("");
@@ -150,7 +152,12 @@ export const Login: React.FC = () => {
     e.preventDefault();
     setFormError(null);
 
-    if (!loginEmail.trim() || !loginPassword) {
+    if (twoFactorRequired && !twoFactorCode.trim()) {
+      setFormError("Verification code is required");
+      return;
+    }
+
+    if (!twoFactorRequired && (!loginEmail.trim() || !loginPassword)) {
       setFormError("Email and password are required");
       return;
     }
@@ -159,10 +166,15 @@ export const Login: React.FC = () => {
 
     try {
       const formData = new URLSearchParams();
-      formData.append("email", loginEmail.trim());
-      formData.append("password", loginPassword);
+      if (twoFactorRequired) {
+        formData.append("code", twoFactorCode.trim());
+      } else {
+        formData.append("email", loginEmail.trim());
+        formData.append("password", loginPassword);
+      }
 
-      const url = redirectTarget ? `/login?redirect=${encodeURIComponent(redirectTarget)}` : "/login";
+      const path = twoFactorRequired ? "/login/two-factor" : "/login";
+      const url = redirectTarget ? `${path}?redirect=${encodeURIComponent(redirectTarget)}` : path;
       const response = await fetch(url, {
         method: "POST",
         headers: {
@@ -173,8 +185,14 @@ export const Login: React.FC = () => {
       });
 
       if (!response.ok) {
-        if (response.status === 401) {
+        if (response.status === 401 && response.headers.get("X-Two-Factor-Required")) {
+          setTwoFactorRequired(true);
+        } else if (response.status === 401 && twoFactorRequired) {
+          setFormError("Invalid verification code");
+        } else if (response.status === 401) {
           setFormError("Invalid email or password");
+        } else if (response.status === 429) {
+          setFormError("Too many failed attempts. Please try again later.");
         } else {
           setFormError("Login failed. Please try again.");
         }
@@ -334,34 +352,52 @@ export const Login: React.FC = () => {
 
           {!configLoading && !isSignupMode && canLoginWithPassword && (
             <form onSubmit={handleLoginSubmit} className="space-y-4">
-              <div className="space-y-2">
-                <Label>Email</Label>
-                <Input
-                  type="email"
-                  name="email"
-                  placeholder="Email"
-                  required
-                  autoComplete="email"
-                  value={loginEmail}
-                  onChange={(e) => setLoginEmail(e.target.value)}
-                />
-              </div>
-
-              <div className="space-y-2">
-                <Label>Password</Label>
-                <Input
-                  type="password"
-                  name="password"
-                  placeholder="Password"
-                  required
-                  autoComplete="current-password"
-                  value={loginPassword}
-                  onChange={(e) => setLoginPassword(e.target.value)}
-                />
-              </div>
+              {twoFactorRequired ? (
+                <div className="space-y-2">
+                  <Label>Verification code</Label>
+                  <Input
+                    type="text"
+                    name="code"
+                    placeholder="Authenticator or recovery code"
+                    required
+                    autoFocus
+                    autoComplete="one-time-code"
+                    value={twoFactorCode}
+                    onChange={(e) => setTwoFactorCode(e.target.value)}
+                  />
+                </div>
+              ) : (
+                <>
+                  <div className="space-y-2">
+                    <Label>Email</Label>
+                    <Input
+                      type="email"
+                      name="email"
+                      placeholder="Email"
+                      required
+                      autoComplete="email"
+                      value={loginEmail}
+                      onChange={(e) => setLoginEmail(e.target.value)}
+                    />
+                  </div>
+
+                  <div className="space-y-2">
+                    <Label>Password</Label>
+                    <Input
+                      type="password"
+                      name="password"
+                      placeholder="Password"
+                      required
+                      autoComplete="current-password"
+                      value={loginPassword}
+                      onChange={(e) => setLoginPassword(e.target.value)}
+                    />
+                  </div>
+                </>
+              )}
 
               <Button type="submit" disabled={submitLoading} className="w-full">
-                {submitLoading ? "Logging in..." : "Login"}
+                {submitLoading ? "Logging in..." : twoFactorRequired ? "Verify" : "Login"}
               </Button>
             </form>
           )}
@@ -120,6 +120,7 @@ import { LogEntry, LogRunItem } from "@/ui/CanvasLogSidebar";
 
 const BUNDLE_ICON_SLUG = "component";
 const BUNDLE_COLOR = "gray";
+const ERROR_CHANNEL = "error";
 const CANVAS_AUTO_SAVE_STORAGE_KEY = "canvas-auto-save-enabled";
 const LOCAL_CANVAS_UPDATE_SUPPRESSION_MS = 2000;
 
@@ -2434,24 +2435,19 @@ export function WorkflowPageV2() {
     async (nodeId: string, channel: string, data: any) => {
       if (!canvasId) return;
 
-      try {
-        await canvasesEmitNodeEvent(
-          withOrganizationHeader({
-            path: {
-              canvasId: canvasId,
-              nodeId: nodeId,
-            },
-            body: {
-              channel,
-              data,
-            },
-          }),
-        );
-        // Note: Success toast is shown by EmitEventModal
-      } catch (error) {
-        showErrorToast("Failed to emit event");
-        throw error; // Re-throw to let EmitEventModal handle it
-      }
+      // Note: Success and error toasts are shown by EmitEventModal
+      await canvasesEmitNodeEvent(
+        withOrganizationHeader({
+          path: {
+            canvasId: canvasId,
+            nodeId: nodeId,
+          },
+          body: {
+            channel,
+            data,
+          },
+        }),
+      );
     },
     [canvasId],
   );
@@ -2510,6 +2506,59 @@ export function WorkflowPageV2() {
     [canvasId, organizationId, canvas, queryClient],
   );
 
+  const handleToggleErrorChannel = useCallback(
+    async (nodeId: string) => {
+      if (!canvas || !organizationId || !canvasId) return;
+
+      const currentNode = canvas.spec?.nodes?.find((node) => node.id === nodeId);
+      if (!currentNode) return;
+
+      if (currentNode.type === "TYPE_TRIGGER") {
+        showErrorToast("Triggers do not have an error channel");
+        return;
+      }
+
+      saveWorkflowSnapshot(canvas);
+
+      const errorChannelEnabled = !currentNode.errorChannelEnabled;
+      const updatedNodes = canvas.spec?.nodes?.map((node) =>
+        node.id === nodeId ? { ...node, errorChannelEnabled } : node,
+      );
+
+      // Edges leaving the error channel are removed when it is disabled
+      const updatedEdges = errorChannelEnabled
+        ? canvas.spec?.edges
+        : canvas.spec?.edges?.filter((edge) => !(edge.sourceId === nodeId && edge.channel === ERROR_CHANNEL));
+
+      const updatedWorkflow = {
+        ...canvas,
+        spec: {
+          ...canvas.spec,
+          nodes: updatedNodes,
+          edges: updatedEdges,
+        },
+      };
+
+      queryClient.setQueryData(canvasKeys.detail(organizationId, canvasId), updatedWorkflow);
+
+      if (canAutoSave) {
+        await handleSaveWorkflow(updatedWorkflow, { showToast: false });
+      } else {
+        markUnsavedChange("structural");
+      }
+    },
+    [
+      canvas,
+      organizationId,
+      canvasId,
+      queryClient,
+      saveWorkflowSnapshot,
+      handleSaveWorkflow,
+      canAutoSave,
+      markUnsavedChange,
+    ],
+  );
+
   const handleReEmit = useCallback(
     async (nodeId: string, eventOrExecutionId: string) => {
       const nodeEvents = nodeEventsMap[nodeId];
@@ -3057,6 +3106,7 @@ export function WorkflowPageV2() {
         onToggleCollapse={!isReadOnly ? () => markUnsavedChange("structural") : undefined}
         onRun={handleRun}
         onTogglePause={!isReadOnly ? handleTogglePause : undefined}
+        onToggleErrorChannel={!isReadOnly ? handleToggleErrorChannel : undefined}
         onDuplicate={!isReadOnly ? handleNodeDuplicate : undefined}
         onConfigure={!isReadOnly ? handleConfigure : undefined}
         buildingBlocks={buildingBlocks}
@@ -3309,6 +3359,7 @@ function prepareData(
           workflowEdges,
         );
       })
+      .map((canvasNode, index) => withErrorChannel(workflow?.spec?.nodes![index]!, canvasNode))
       .map((node) => ({
         ...node,
         dragHandle: ".canvas-node-drag-handle",
@@ -3508,6 +3559,24 @@ function prepareNode(
   }
 }
 
+// Nodes with the error channel enabled get an extra "error" handle,
+// where failed executions are emitted.
+function withErrorChannel(node: ComponentsNode, canvasNode: CanvasNode): CanvasNode {
+  const channels = (canvasNode.data.outputChannels as string[] | undefined) || ["default"];
+  if (!node.errorChannelEnabled) {
+    return canvasNode;
+  }
+
+  return {
+    ...canvasNode,
+    data: {
+      ...canvasNode.data,
+      errorChannelEnabled: true,
+      outputChannels: channels.includes(ERROR_CHANNEL) ? channels : [...channels, ERROR_CHANNEL],
+    },
+  };
+}
+
 function prepareAnnotationNode(node: ComponentsNode): CanvasNode {
   const width = (node.configuration?.width as number) || 320;
   const height = (node.configuration?.height as number) || 200;
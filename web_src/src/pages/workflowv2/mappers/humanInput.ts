@@ -0,0 +1,256 @@
+/* eslint-disable @typescript-eslint/no-explicit-any */
+import { canvasesInvokeNodeExecutionAction } from "@/api-client";
+import {
+  AdditionalDataBuilderContext,
+  ComponentAdditionalDataBuilder,
+  ComponentBaseContext,
+  ComponentBaseMapper,
+  EventStateRegistry,
+  ExecutionDetailsContext,
+  ExecutionInfo,
+  NodeInfo,
+  StateFunction,
+  SubtitleContext,
+} from "./types";
+import {
+  ComponentBaseProps,
+  ComponentBaseSpec,
+  EventSection,
+  EventState,
+  EventStateMap,
+  DEFAULT_EVENT_STATE_MAP,
+} from "@/ui/componentBase";
+import { getTriggerRenderer } from ".";
+import { getBackgroundColorClass, getColorClass } from "@/utils/colors";
+import { HumanInputForm } from "@/ui/humanInputForm";
+import React from "react";
+import { withOrganizationHeader } from "@/utils/withOrganizationHeader";
+import { canvasKeys } from "@/hooks/useCanvasData";
+import { formatTimeAgo } from "@/utils/date";
+import { getApiErrorMessage } from "@/utils/errors";
+import { showErrorToast } from "@/utils/toast";
+import { parseFormFieldDefinitions, type FormFieldDefinition } from "@/utils/formFields";
+
+type HumanInputConfiguration = {
+  instructions?: string;
+  fields?: unknown;
+  responders?: { type: string; user?: string; role?: string; group?: string }[];
+};
+
+type HumanInputMetadata = {
+  state?: string;
+  submission?: {
+    values?: Record<string, unknown>;
+    submittedBy?: { id?: string; name?: string; email?: string };
+    submittedAt?: string;
+  };
+};
+
+type HumanInputAdditionalData = {
+  onSubmit?: (values: Record<string, unknown>) => Promise<void>;
+  organizationId?: string;
+};
+
+export const HUMAN_INPUT_STATE_MAP: EventStateMap = {
+  ...DEFAULT_EVENT_STATE_MAP,
+  waiting: {
+    icon: "clock",
+    textColor: "text-gray-800",
+    backgroundColor: "bg-orange-100",
+    badgeColor: "bg-yellow-600",
+  },
+  submitted: {
+    icon: "circle-check",
+    textColor: "text-gray-800",
+    backgroundColor: "bg-green-100",
+    badgeColor: "bg-emerald-500",
+  },
+  error: {
+    icon: "triangle-alert",
+    textColor: "text-gray-800",
+    backgroundColor: "bg-red-100",
+    badgeColor: "bg-red-400",
+  },
+};
+
+export const humanInputStateFunction: StateFunction = (execution: ExecutionInfo): EventState => {
+  if (execution.result === "RESULT_CANCELLED") {
+    return "cancelled";
+  }
+
+  if (execution.state === "STATE_FINISHED" && execution.result === "RESULT_FAILED") {
+    return "error";
+  }
+
+  if (execution.state === "STATE_PENDING" || execution.state === "STATE_STARTED") {
+    return "waiting";
+  }
+
+  if (execution.state === "STATE_FINISHED" && execution.result === "RESULT_PASSED") {
+    return "submitted";
+  }
+
+  return "error";
+};
+
+export const HUMAN_INPUT_STATE_REGISTRY: EventStateRegistry = {
+  stateMap: HUMAN_INPUT_STATE_MAP,
+  getState: humanInputStateFunction,
+};
+
+export const humanInputMapper: ComponentBaseMapper = {
+  props(context: ComponentBaseContext): ComponentBaseProps {
+    const lastExecution = context.lastExecutions.length > 0 ? context.lastExecutions[0] : null;
+    const configuration = (context.node.configuration || {}) as HumanInputConfiguration;
+    const fields = parseFormFieldDefinitions(configuration.fields);
+
+    return {
+      iconSlug: context.componentDefinition.icon || "clipboard-list",
+      iconColor: getColorClass("black"),
+      collapsedBackground: getBackgroundColorClass("orange"),
+      collapsed: context.node.isCollapsed,
+      title: context.node.name || context.componentDefinition?.label || "Human Input",
+      eventSections: lastExecution ? getEventSections(context.nodes, lastExecution) : undefined,
+      includeEmptyState: !lastExecution,
+      specs: getSpecs(fields),
+      customField: getCustomField(lastExecution, fields, configuration, context.additionalData),
+      eventStateMap: HUMAN_INPUT_STATE_MAP,
+    };
+  },
+
+  subtitle(context: SubtitleContext): string | React.ReactNode {
+    return getSubtitle(context.execution);
+  },
+
+  getExecutionDetails(context: ExecutionDetailsContext): Record<string, any> {
+    const details: Record<string, any> = {};
+    const metadata = context.execution.metadata as HumanInputMetadata | undefined;
+
+    if (context.execution.createdAt) {
+      details["Started at"] = new Date(context.execution.createdAt).toLocaleString();
+    }
+
+    const submission = metadata?.submission;
+    if (submission) {
+      if (submission.submittedAt) {
+        details["Submitted at"] = new Date(submission.submittedAt).toLocaleString();
+      }
+
+      details["Submitted by"] = submission.submittedBy?.name || submission.submittedBy?.email || "-";
+      Object.entries(submission.values || {}).forEach(([name, value]) => {
+        details[name] = typeof value === "string" ? value : JSON.stringify(value);
+      });
+    }
+
+    return details;
+  },
+};
+
+function getSpecs(fields: FormFieldDefinition[]): ComponentBaseSpec[] {
+  if (fields.length === 0) return [];
+
+  return [
+    {
+      title: fields.length === 1 ? "field" : "fields",
+      tooltipTitle: "form fields",
+      values: fields.map((field) => ({
+        badges: [
+          { label: `${field.label || field.name}:`, bgColor: "bg-gray-100", textColor: "text-gray-700" },
+          { label: field.type, bgColor: "bg-emerald-100", textColor: "text-emerald-800" },
+        ],
+      })),
+    },
+  ];
+}
+
+function getCustomField(
+  lastExecution: ExecutionInfo | null,
+  fields: FormFieldDefinition[],
+  configuration: HumanInputConfiguration,
+  additionalData?: unknown,
+): React.ReactNode | undefined {
+  if (!lastExecution || lastExecution.state !== "STATE_STARTED") return;
+
+  const { onSubmit, organizationId } = (additionalData || {}) as HumanInputAdditionalData;
+  if (!onSubmit || fields.length === 0) return;
+
+  return React.createElement(HumanInputForm, {
+    key: lastExecution.id,
+    fields,
+    instructions: configuration.instructions,
+    organizationId,
+    onSubmit,
+  });
+}
+
+function getEventSections(nodes: NodeInfo[], execution: ExecutionInfo): EventSection[] {
+  const rootTriggerNode = nodes.find((n) => n.id === execution.rootEvent?.nodeId);
+  const rootTriggerRenderer = getTriggerRenderer(rootTriggerNode?.componentName!);
+  const { title: eventTitle } = rootTriggerRenderer.getTitleAndSubtitle({ event: execution.rootEvent });
+
+  return [
+    {
+      receivedAt: new Date(execution.createdAt!),
+      eventTitle: eventTitle,
+      eventSubtitle: getSubtitle(execution),
+      eventState: humanInputStateFunction(execution),
+      eventId: execution.rootEvent!.id!,
+    },
+  ];
+}
+
+function getSubtitle(execution: ExecutionInfo): string {
+  if (execution.state === "STATE_STARTED" || execution.state === "STATE_PENDING") {
+    return execution.createdAt ? `Waiting for input · ${formatTimeAgo(new Date(execution.createdAt))}` : "";
+  }
+
+  const timestamp = execution.updatedAt || execution.createdAt;
+  if (!timestamp) return "";
+
+  const timeAgo = formatTimeAgo(new Date(timestamp));
+  const metadata = execution.metadata as HumanInputMetadata | undefined;
+  if (metadata?.state === "submitted") {
+    const submittedBy = metadata.submission?.submittedBy;
+    const who = submittedBy?.name || submittedBy?.email;
+    return who ? `Submitted by ${who} · ${timeAgo}` : `Submitted · ${timeAgo}`;
+  }
+
+  return timeAgo;
+}
+
+// ----------------------- Data Builder -----------------------
+
+export const humanInputDataBuilder: ComponentAdditionalDataBuilder = {
+  buildAdditionalData(context: AdditionalDataBuilderContext): HumanInputAdditionalData {
+    const { node, lastExecutions, canvasId, queryClient, organizationId } = context;
+    const execution = lastExecutions.length > 0 ? lastExecutions[0] : null;
+
+    return {
+      organizationId,
+      onSubmit: async (values: Record<string, unknown>) => {
+        if (!execution?.id) return;
+
+        try {
+          await canvasesInvokeNodeExecutionAction(
+            withOrganizationHeader({
+              path: {
+                canvasId: canvasId,
+                executionId: execution.id,
+                actionName: "submit",
+              },
+              body: {
+                parameters: { values },
+              },
+            }),
+          );
+
+          queryClient.invalidateQueries({
+            queryKey: canvasKeys.nodeExecution(canvasId, node.id!),
+          });
+        } catch (error) {
+          showErrorToast(getApiErrorMessage(error, "Failed to submit form"));
+        }
+      },
+    };
+  },
+};
@@ -185,6 +185,7 @@ import { filterMapper, FILTER_STATE_REGISTRY } from "./filter";
 import { sshMapper, SSH_STATE_REGISTRY } from "./ssh";
 import { waitCustomFieldRenderer, waitMapper, WAIT_STATE_REGISTRY } from "./wait";
 import { approvalMapper, approvalDataBuilder, APPROVAL_STATE_REGISTRY } from "./approval";
+import { humanInputMapper, humanInputDataBuilder, HUMAN_INPUT_STATE_REGISTRY } from "./humanInput";
 import { mergeMapper, MERGE_STATE_REGISTRY } from "./merge";
 import { DEFAULT_STATE_REGISTRY } from "./stateRegistry";
 import { startTriggerRenderer } from "./start";
@@ -210,6 +211,7 @@ const componentBaseMappers: Record<string, ComponentBaseMapper> = {
   filter: filterMapper,
   wait: waitMapper,
   approval: approvalMapper,
+  humanInput: humanInputMapper,
   merge: mergeMapper,
 };
 
@@ -319,10 +321,12 @@ const appEventStateRegistries: Record<string, Record<string, EventStateRegistry>
 
 const componentAdditionalDataBuilders: Record<string, ComponentAdditionalDataBuilder> = {
   approval: approvalDataBuilder,
+  humanInput: humanInputDataBuilder,
 };
 
 const eventStateRegistries: Record<string, EventStateRegistry> = {
   approval: APPROVAL_STATE_REGISTRY,
+  humanInput: HUMAN_INPUT_STATE_REGISTRY,
   http: HTTP_STATE_REGISTRY,
   ssh: SSH_STATE_REGISTRY,
   filter: FILTER_STATE_REGISTRY,
@@ -32,6 +32,9 @@ export interface BlockData {
   // output channels for this block (e.g., ['default'], ['true', 'false'])
   outputChannels?: string[];
 
+  // whether failed executions are emitted on the built-in error channel
+  errorChannelEnabled?: boolean;
+
   // trigger node specific props
   trigger?: TriggerProps;
 
@@ -379,6 +382,7 @@ function BlockContent({
   runDisabled,
   runDisabledTooltip,
   onTogglePause,
+  onToggleErrorChannel,
   onEdit,
   onConfigure,
   onDuplicate,
@@ -418,6 +422,8 @@ function BlockContent({
     runDisabled,
     runDisabledTooltip,
     onTogglePause: data.type === "trigger" ? undefined : onTogglePause,
+    onToggleErrorChannel: data.type === "trigger" ? undefined : onToggleErrorChannel,
+    errorChannelEnabled: data.errorChannelEnabled,
     onEdit,
     onDuplicate,
     onDeactivate,
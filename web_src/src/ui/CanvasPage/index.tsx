@@ -40,6 +40,7 @@ import {
 import { ComponentSidebar } from "../componentSidebar";
 import { TabData } from "../componentSidebar/SidebarEventItem/SidebarEventItem";
 import { EmitEventModal } from "../EmitEventModal";
+import { getStartInputs, type StartInput } from "../EmitEventModal/startInputs";
 import { EventState, EventStateMap } from "../componentBase";
 import { Block, BlockData } from "./Block";
 import "./canvas-reset.css";
@@ -199,6 +200,7 @@ export interface CanvasPageProps {
   onConfigure?: (nodeId: string) => void;
   onDeactivate?: (nodeId: string) => void;
   onTogglePause?: (nodeId: string) => void;
+  onToggleErrorChannel?: (nodeId: string) => void;
   onToggleView?: (nodeId: string) => void;
   onToggleCollapse?: () => void;
   onAutoLayout?: (selectedNodeIDs: string[]) => void | Promise<void>;
@@ -314,6 +316,11 @@ const nodeTypes = {
         onTogglePause={
           callbacks.onTogglePause.current ? () => callbacks.onTogglePause.current?.(nodeProps.id) : undefined
         }
+        onToggleErrorChannel={
+          callbacks.onToggleErrorChannel.current
+            ? () => callbacks.onToggleErrorChannel.current?.(nodeProps.id)
+            : undefined
+        }
         onToggleView={callbacks.onToggleView.current ? () => callbacks.onToggleView.current?.(nodeProps.id) : undefined}
         onToggleCollapse={
           callbacks.onToggleView.current ? () => callbacks.onToggleView.current?.(nodeProps.id) : undefined
@@ -379,6 +386,7 @@ function CanvasPage(props: CanvasPageProps) {
     nodeName: string;
     channels: string[];
     initialData?: string;
+    inputs?: StartInput[];
   } | null>(null);
 
   useEffect(() => {
@@ -465,9 +473,10 @@ function CanvasPage(props: CanvasPageProps) {
         nodeName,
         channels,
         initialData: actualInitialData,
+        inputs: getStartInputs(props.workflowNodes?.find((n) => n.id === actualNodeId)),
       });
     },
-    [state.nodes, props.runDisabled],
+    [state.nodes, props.runDisabled, props.workflowNodes],
   );
 
   const handleEmit = useCallback(
@@ -873,6 +882,7 @@ function CanvasPage(props: CanvasPageProps) {
                 onAnnotationUpdate={props.onAnnotationUpdate}
                 onAnnotationBlur={props.onAnnotationBlur}
                 onTogglePause={props.onTogglePause}
+                onToggleErrorChannel={props.onToggleErrorChannel}
                 runDisabled={props.runDisabled}
                 runDisabledTooltip={props.runDisabledTooltip}
                 onBuildingBlockDrop={handleBuildingBlockDrop}
@@ -984,6 +994,7 @@ function CanvasPage(props: CanvasPageProps) {
           channels={emitModalData.channels}
           onEmit={handleEmit}
           initialData={emitModalData.initialData}
+          inputs={emitModalData.inputs}
         />
       )}
     </div>
@@ -1358,6 +1369,7 @@ function CanvasContent({
   onConfigure,
   onDeactivate,
   onTogglePause,
+  onToggleErrorChannel,
   onToggleView,
   onToggleCollapse,
   onAutoLayout,
@@ -1407,6 +1419,7 @@ function CanvasContent({
   onConfigure?: (nodeId: string) => void;
   onDeactivate?: (nodeId: string) => void;
   onTogglePause?: (nodeId: string) => void;
+  onToggleErrorChannel?: (nodeId: string) => void;
   onToggleView?: (nodeId: string) => void;
   onToggleCollapse?: () => void;
   onAutoLayout?: (selectedNodeIDs: string[]) => void | Promise<void>;
@@ -1612,6 +1625,9 @@ function CanvasContent({
   const onTogglePauseRef = useRef(onTogglePause);
   onTogglePauseRef.current = onTogglePause;
 
+  const onToggleErrorChannelRef = useRef(onToggleErrorChannel);
+  onToggleErrorChannelRef.current = onToggleErrorChannel;
+
   const onToggleViewRef = useRef(onToggleView);
   onToggleViewRef.current = onToggleView;
 
@@ -1837,6 +1853,7 @@ function CanvasContent({
     onConfigure: onConfigureRef,
     onDeactivate: onDeactivateRef,
     onTogglePause: onTogglePauseRef,
+    onToggleErrorChannel: onToggleErrorChannelRef,
     onToggleView: onToggleViewRef,
     onAnnotationUpdate: onAnnotationUpdateRef,
     onAnnotationBlur: onAnnotationBlurRef,
@@ -1855,6 +1872,7 @@ function CanvasContent({
     onConfigure: onConfigureRef,
     onDeactivate: onDeactivateRef,
     onTogglePause: onTogglePauseRef,
+    onToggleErrorChannel: onToggleErrorChannelRef,
     onToggleView: onToggleViewRef,
     onAnnotationUpdate: onAnnotationUpdateRef,
     onAnnotationBlur: onAnnotationBlurRef,
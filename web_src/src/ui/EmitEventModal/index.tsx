@@ -2,11 +2,15 @@ import { Button } from "@/components/ui/button";
 import { Dialog, DialogContent, DialogDescription, DialogFooter, DialogTitle } from "@/components/ui/dialog";
 import { Label } from "@/components/ui/label";
 import { Select, SelectContent, SelectItem, SelectTrigger, SelectValue } from "@/components/ui/select";
+import { ConfigurationFieldRenderer } from "@/ui/configurationFieldRenderer";
+import { getApiErrorMessage } from "@/utils/errors";
 import { showErrorToast, showSuccessToast } from "@/utils/toast";
 import Editor from "@monaco-editor/react";
 import { Play } from "lucide-react";
 import type { editor } from "monaco-editor";
-import { useEffect, useRef, useState } from "react";
+import { useEffect, useMemo, useRef, useState } from "react";
+import { formFieldToConfigurationField, initialFormFieldValues } from "@/utils/formFields";
+import type { StartInput } from "./startInputs";
 
 interface EmitEventModalProps {
   isOpen: boolean;
@@ -18,9 +22,22 @@ interface EmitEventModalProps {
   channels: string[];
   onEmit: (channel: string, data: any) => Promise<void>;
   initialData?: string;
+  inputs?: StartInput[];
 }
 
-export const EmitEventModal = ({ isOpen, onClose, nodeName, channels, onEmit, initialData }: EmitEventModalProps) => {
+export const EmitEventModal = ({
+  isOpen,
+  onClose,
+  nodeName,
+  organizationId,
+  channels,
+  onEmit,
+  initialData,
+  inputs = [],
+}: EmitEventModalProps) => {
+  const hasInputs = inputs.length > 0;
+  const inputFields = useMemo(() => inputs.map(formFieldToConfigurationField), [inputs]);
+  const [inputValues, setInputValues] = useState<Record<string, unknown>>(() => initialFormFieldValues(inputs));
   const [selectedChannel, setSelectedChannel] = useState<string>(channels[0] || "default");
   const [eventData, setEventData] = useState<string>(() => {
     if (initialData) {
@@ -70,6 +87,7 @@ export const EmitEventModal = ({ isOpen, onClose, nodeName, channels, onEmit, in
     } else {
       setEventData("{}");
     }
+    setInputValues(initialFormFieldValues(inputs));
     setIsSubmitting(false);
     onClose();
   };
@@ -78,14 +96,18 @@ export const EmitEventModal = ({ isOpen, onClose, nodeName, channels, onEmit, in
     try {
       setIsSubmitting(true);
 
-      // Validate JSON
+      // Inputs are validated by the server against the trigger definition
       let parsedData;
-      try {
-        parsedData = JSON.parse(eventData);
-      } catch (e) {
-        showErrorToast("Invalid JSON format");
-        setIsSubmitting(false);
-        return;
+      if (hasInputs) {
+        parsedData = inputValues;
+      } else {
+        try {
+          parsedData = JSON.parse(eventData);
+        } catch (e) {
+          showErrorToast("Invalid JSON format");
+          setIsSubmitting(false);
+          return;
+        }
       }
 
       await onEmit(selectedChannel, parsedData);
@@ -93,7 +115,7 @@ export const EmitEventModal = ({ isOpen, onClose, nodeName, channels, onEmit, in
       handleClose();
     } catch (error) {
       console.error("Failed to emit event", error);
-      showErrorToast("Failed to emit event");
+      showErrorToast(getApiErrorMessage(error, "Failed to emit event"));
       setIsSubmitting(false);
     }
   };
@@ -128,24 +150,42 @@ export const EmitEventModal = ({ isOpen, onClose, nodeName, channels, onEmit, in
               </Select>
             </div>
 
-            <div className="border border-gray-200 dark:border-gray-700 rounded-md overflow-hidden">
-              <Editor
-                height="300px"
-                defaultLanguage="json"
-                value={eventData}
-                onChange={(value) => setEventData(value || "{}")}
-                onMount={(editor) => {
-                  editorRef.current = editor;
-                }}
-                options={{
-                  minimap: { enabled: false },
-                  fontSize: 13,
-                  lineNumbers: "on",
-                  scrollBeyondLastLine: false,
-                  automaticLayout: true,
-                }}
-              />
-            </div>
+            {hasInputs ? (
+              <div className="space-y-4 max-h-[50vh] overflow-y-auto pr-1" data-testid="emit-event-inputs">
+                {inputFields.map((field) => (
+                  <ConfigurationFieldRenderer
+                    key={field.name}
+                    field={field}
+                    value={inputValues[field.name!]}
+                    onChange={(value) => setInputValues((prev) => ({ ...prev, [field.name!]: value }))}
+                    allValues={inputValues}
+                    domainId={organizationId}
+                    domainType="DOMAIN_TYPE_ORGANIZATION"
+                    organizationId={organizationId}
+                    integrationId={inputs.find((input) => input.name === field.name)?.integrationId}
+                  />
+                ))}
+              </div>
+            ) : (
+              <div className="border border-gray-200 dark:border-gray-700 rounded-md overflow-hidden">
+                <Editor
+                  height="300px"
+                  defaultLanguage="json"
+                  value={eventData}
+                  onChange={(value) => setEventData(value || "{}")}
+                  onMount={(editor) => {
+                    editorRef.current = editor;
+                  }}
+                  options={{
+                    minimap: { enabled: false },
+                    fontSize: 13,
+                    lineNumbers: "on",
+                    scrollBeyondLastLine: false,
+                    automaticLayout: true,
+                  }}
+                />
+              </div>
+            )}
           </div>
 
           <DialogFooter>
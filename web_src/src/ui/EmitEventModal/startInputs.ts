@@ -0,0 +1,13 @@
+import type { ComponentsNode } from "@/api-client";
+import { parseFormFieldDefinitions, type FormFieldDefinition } from "@/utils/formFields";
+
+export type StartInput = FormFieldDefinition;
+
+// Returns the inputs declared on a start trigger node, if any.
+export function getStartInputs(node?: ComponentsNode): StartInput[] {
+  if (!node || node.trigger?.name !== "start") {
+    return [];
+  }
+
+  return parseFormFieldDefinitions((node.configuration as Record<string, unknown> | undefined)?.inputs);
+}
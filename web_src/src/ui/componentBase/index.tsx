@@ -254,6 +254,8 @@ export const ComponentBase: React.FC<ComponentBaseProps> = ({
   runDisabled,
   runDisabledTooltip: _runDisabledTooltip,
   onTogglePause,
+  onToggleErrorChannel,
+  errorChannelEnabled,
   onEdit: _onEdit,
   onConfigure: _onConfigure,
   onDuplicate,
@@ -278,6 +280,7 @@ export const ComponentBase: React.FC<ComponentBaseProps> = ({
   const hasBadge = hasError || hasWarning;
   const PauseIcon = React.useMemo(() => resolveIcon("pause"), []);
   const ResumeIcon = React.useMemo(() => resolveIcon("step-forward"), []);
+  const ErrorChannelIcon = React.useMemo(() => resolveIcon("triangle-alert"), []);
   const DuplicateIcon = React.useMemo(() => resolveIcon("copy"), []);
   const DeleteIcon = React.useMemo(() => resolveIcon("trash-2"), []);
   const ToggleViewIcon = React.useMemo(
@@ -315,6 +318,21 @@ export const ComponentBase: React.FC<ComponentBaseProps> = ({
                 <span>{paused ? "Resume" : "Pause"}</span>
               </button>
             )}
+            {onToggleErrorChannel && (
+              <button
+                type="button"
+                data-testid="node-action-toggle-error-channel"
+                title={errorChannelEnabled ? "Disable error channel" : "Enable error channel"}
+                onClick={(event) => {
+                  event.preventDefault();
+                  event.stopPropagation();
+                  onToggleErrorChannel();
+                }}
+                className={`flex items-center justify-center p-1 transition hover:text-gray-800 ${errorChannelEnabled ? "text-orange-500" : "text-gray-500"}`}
+              >
+                <ErrorChannelIcon className="h-4 w-4" />
+              </button>
+            )}
             {onDuplicate && (
               <button
                 type="button"
@@ -102,6 +102,8 @@ export const Composite: React.FC<CompositeProps> = ({
   onDuplicate,
   onDeactivate,
   onTogglePause,
+  onToggleErrorChannel,
+  errorChannelEnabled,
   onToggleView,
   onDelete,
   isCompactView,
@@ -223,6 +225,8 @@ export const Composite: React.FC<CompositeProps> = ({
       onDuplicate={onDuplicate}
       onDeactivate={onDeactivate}
       onTogglePause={onTogglePause}
+      onToggleErrorChannel={onToggleErrorChannel}
+      errorChannelEnabled={errorChannelEnabled}
       onToggleView={onToggleView}
       onDelete={onDelete}
       isCompactView={isCompactView}
@@ -0,0 +1,55 @@
+import React from "react";
+import { Button } from "@/components/ui/button";
+import { ConfigurationFieldRenderer } from "@/ui/configurationFieldRenderer";
+import {
+  formFieldToConfigurationField,
+  initialFormFieldValues,
+  type FormFieldDefinition,
+} from "@/utils/formFields";
+
+export interface HumanInputFormProps {
+  fields: FormFieldDefinition[];
+  instructions?: string;
+  organizationId?: string;
+  onSubmit: (values: Record<string, unknown>) => Promise<void>;
+}
+
+export const HumanInputForm: React.FC<HumanInputFormProps> = ({ fields, instructions, organizationId, onSubmit }) => {
+  const configurationFields = React.useMemo(() => fields.map(formFieldToConfigurationField), [fields]);
+  const [values, setValues] = React.useState<Record<string, unknown>>(() => initialFormFieldValues(fields));
+  const [isSubmitting, setIsSubmitting] = React.useState(false);
+
+  const handleSubmit = async (e: React.FormEvent) => {
+    e.preventDefault();
+    setIsSubmitting(true);
+    try {
+      await onSubmit(values);
+    } finally {
+      setIsSubmitting(false);
+    }
+  };
+
+  return (
+    <form className="w-full p-3 space-y-3" onSubmit={handleSubmit} data-testid="human-input-form">
+      {instructions && <p className="text-sm text-gray-600 whitespace-pre-wrap">{instructions}</p>}
+      {configurationFields.map((field) => (
+        <ConfigurationFieldRenderer
+          key={field.name}
+          field={field}
+          value={values[field.name!]}
+          onChange={(value) => setValues((prev) => ({ ...prev, [field.name!]: value }))}
+          allValues={values}
+          domainId={organizationId}
+          domainType="DOMAIN_TYPE_ORGANIZATION"
+          organizationId={organizationId}
+          integrationId={fields.find((definition) => definition.name === field.name)?.integrationId}
+        />
+      ))}
+      <div className="flex justify-end">
+        <Button type="submit" size="sm" disabled={isSubmitting} data-testid="human-input-submit-button">
+          {isSubmitting ? "Submitting..." : "Submit"}
+        </Button>
+      </div>
+    </form>
+  );
+};
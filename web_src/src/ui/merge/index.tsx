@@ -37,6 +37,8 @@ export const MergeComponent: React.FC<MergeComponentProps> = ({
   onDuplicate,
   onDeactivate,
   onTogglePause,
+  onToggleErrorChannel,
+  errorChannelEnabled,
   onToggleView,
   onDelete,
   isCompactView,
@@ -68,6 +70,8 @@ export const MergeComponent: React.FC<MergeComponentProps> = ({
       onDuplicate={onDuplicate}
       onDeactivate={onDeactivate}
       onTogglePause={onTogglePause}
+      onToggleErrorChannel={onToggleErrorChannel}
+      errorChannelEnabled={errorChannelEnabled}
       onToggleView={onToggleView}
       onDelete={onDelete}
       isCompactView={isCompactView}
@@ -45,6 +45,8 @@ export const SwitchComponent: React.FC<SwitchComponentProps> = ({
   onDuplicate,
   onDeactivate,
   onTogglePause,
+  onToggleErrorChannel,
+  errorChannelEnabled,
   onToggleView,
   onDelete,
   isCompactView,
@@ -107,6 +109,8 @@ export const SwitchComponent: React.FC<SwitchComponentProps> = ({
       onDuplicate={onDuplicate}
       onDeactivate={onDeactivate}
       onTogglePause={onTogglePause}
+      onToggleErrorChannel={onToggleErrorChannel}
+      errorChannelEnabled={errorChannelEnabled}
       onToggleView={onToggleView}
       onDelete={onDelete}
       isCompactView={isCompactView}
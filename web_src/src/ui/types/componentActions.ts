@@ -6,6 +6,9 @@ export interface ComponentActionsProps {
   runDisabled?: boolean;
   runDisabledTooltip?: string;
   onTogglePause?: () => void;
+  // Toggles the built-in error output channel of the node
+  onToggleErrorChannel?: () => void;
+  errorChannelEnabled?: boolean;
   onDuplicate?: () => void;
   onEdit?: () => void;
   onConfigure?: () => void;
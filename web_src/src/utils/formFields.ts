@@ -0,0 +1,78 @@
+import type { ConfigurationField } from "@/api-client";
+
+// A user-defined form field, as declared in node configuration
+// (start trigger inputs, human input fields).
+export interface FormFieldDefinition {
+  name: string;
+  label?: string;
+  type: string;
+  description?: string;
+  required?: boolean;
+  default?: string;
+  options?: string[];
+  min?: number;
+  max?: number;
+  integrationId?: string;
+  resourceType?: string;
+}
+
+export function parseFormFieldDefinitions(value: unknown): FormFieldDefinition[] {
+  if (!Array.isArray(value)) {
+    return [];
+  }
+
+  return value.filter(
+    (field): field is FormFieldDefinition => !!field && typeof field.name === "string" && !!field.name,
+  );
+}
+
+// Mirrors configuration.FormField.Field() on the server,
+// so forms use the same field renderers as node configuration.
+export function formFieldToConfigurationField(definition: FormFieldDefinition): ConfigurationField {
+  const field: ConfigurationField = {
+    name: definition.name,
+    label: definition.label || definition.name,
+    type: definition.type || "string",
+    description: definition.description,
+    required: definition.required,
+    defaultValue: definition.default,
+  };
+
+  switch (definition.type) {
+    case "number":
+      field.typeOptions = { number: { min: definition.min, max: definition.max } };
+      break;
+    case "select":
+      field.typeOptions = {
+        select: { options: (definition.options || []).map((option) => ({ label: option, value: option })) },
+      };
+      break;
+    case "integration-resource":
+      field.typeOptions = { resource: { type: definition.resourceType } };
+      break;
+  }
+
+  return field;
+}
+
+export function initialFormFieldValues(definitions: FormFieldDefinition[]): Record<string, unknown> {
+  const values: Record<string, unknown> = {};
+  for (const definition of definitions) {
+    if (definition.default === undefined || definition.default === null || definition.default === "") {
+      continue;
+    }
+
+    switch (definition.type) {
+      case "number":
+        values[definition.name] = Number(definition.default);
+        break;
+      case "boolean":
+        values[definition.name] = String(definition.default) === "true";
+        break;
+      default:
+        values[definition.name] = definition.default;
+    }
+  }
+
+  return values;
+}